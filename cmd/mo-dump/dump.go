@@ -0,0 +1,523 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// dumpToDir dumps the databases into opt.dir. The schema of each database is in <db>.sql,
+// and the data of each table is in a data file dumped by opt.parallel workers. All workers
+// read the data at the snapshot in the manifest, the finished tables and the finished chunks
+// of the tables are recorded in the manifest, so that a failed dump can be resumed with the
+// same snapshot.
+func dumpToDir(ctx context.Context, opt *dumpOption) error {
+	dir, err := filepath.Abs(opt.dir)
+	if err != nil {
+		return err
+	}
+	opt.dir = dir
+	if err = os.MkdirAll(opt.dir, 0755); err != nil {
+		return err
+	}
+	m, err := initManifest(ctx, opt)
+	if err != nil {
+		return err
+	}
+
+	// schema
+	c, err := newSnapshotConn(ctx, m.SnapshotTS)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	dbs, err := getDatabases(ctx, c, opt)
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		s, err := getSchema(ctx, c, db, opt.tables)
+		if err != nil {
+			return err
+		}
+		if err = writeSchemaFile(ctx, opt, m, db, s); err != nil {
+			return err
+		}
+	}
+	if err = saveManifest(opt.dir, m); err != nil {
+		return err
+	}
+
+	// data
+	jobs := make([]*TableManifest, 0, len(m.Tables))
+	for _, tbl := range m.Tables {
+		if !tbl.Done {
+			jobs = append(jobs, tbl)
+		}
+	}
+	return dumpTables(ctx, opt, m, jobs)
+}
+
+// initManifest loads the manifest in opt.dir to resume the dump, or creates a new one
+func initManifest(ctx context.Context, opt *dumpOption) (*Manifest, error) {
+	m, err := loadManifest(opt.dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if m != nil {
+		if !opt.resume {
+			return nil, moerr.NewInvalidInput(ctx, "%s has a dump, use -resume to resume it", opt.dir)
+		}
+		if m.Csv != opt.toCsv || m.Compress != opt.compress {
+			return nil, moerr.NewInvalidInput(ctx, "the format of the dump to resume is csv=%v compress=%s", m.Csv, m.Compress)
+		}
+		return m, nil
+	}
+	snapshotTS, err := getSnapshotTS(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Manifest{
+		SnapshotTS: snapshotTS,
+		Csv:        opt.toCsv,
+		Compress:   opt.compress,
+	}, nil
+}
+
+func loadManifest(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFile))
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if err = json.Unmarshal(data, m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// saveManifest writes the manifest to a temporary file and renames it,
+// so that a crash never leaves a broken manifest
+func saveManifest(dir string, m *Manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	name := filepath.Join(dir, manifestFile)
+	if err = os.WriteFile(name+tmpSuffix, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(name+tmpSuffix, name)
+}
+
+// addTable adds the table to the manifest, or returns the one recorded by the dump to resume
+func (m *Manifest) addTable(ctx context.Context, db, tbl, where string) (*TableManifest, error) {
+	for _, t := range m.Tables {
+		if t.Database == db && t.Table == tbl {
+			if t.Where != where {
+				return nil, moerr.NewInvalidInput(ctx, "the where of %s.%s is '%s' in the dump to resume", db, tbl, t.Where)
+			}
+			return t, nil
+		}
+	}
+	t := &TableManifest{
+		Database: db,
+		Table:    tbl,
+		Where:    where,
+		File:     dataFileName(db, tbl, m.Csv, m.Compress),
+	}
+	m.Tables = append(m.Tables, t)
+	return t, nil
+}
+
+func dataFileName(db, tbl string, toCsv bool, compress string) string {
+	ext := "sql"
+	if toCsv {
+		ext = "csv"
+	}
+	switch compress {
+	case compressGzip:
+		ext += ".gz"
+	case compressZstd:
+		ext += ".zst"
+	}
+	return fmt.Sprintf("%s.%s.%s", db, tbl, ext)
+}
+
+// writeSchemaFile writes the schema of the database into <db>.sql, and adds its tables to the manifest.
+// for csv, the load statements of the data files are in the schema file too.
+func writeSchemaFile(ctx context.Context, opt *dumpOption, m *Manifest, db string, s *schema) error {
+	f, err := os.Create(filepath.Join(opt.dir, db+".sql"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+	if len(opt.tables) == 0 {
+		showCreateDatabase(w, db, s.createDb)
+	} else {
+		fmt.Fprintf(w, "USE `%s`;\n\n\n", db)
+	}
+	for i, create := range s.createTable {
+		tbl := s.tables[i]
+		if err = showSchema(ctx, w, tbl, create); err != nil {
+			return err
+		}
+		if tbl.Kind != catalog.SystemOrdinaryRel {
+			continue
+		}
+		t, err := m.addTable(ctx, db, tbl.Name, opt.getWhere(db, tbl.Name))
+		if err != nil {
+			return err
+		}
+		if m.Csv {
+			showLoad(w, filepath.Join(opt.dir, t.File), tbl.Name, opt.localInfile)
+		}
+	}
+	return w.Flush()
+}
+
+// dumpTables dumps the data of the tables by opt.parallel workers
+func dumpTables(ctx context.Context, opt *dumpOption, m *Manifest, jobs []*TableManifest) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var mu sync.Mutex
+	var firstErr error
+	setErr := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		cancel()
+	}
+	bufPool := &sync.Pool{
+		New: func() any {
+			return &bytes.Buffer{}
+		},
+	}
+
+	ch := make(chan *TableManifest)
+	var wg sync.WaitGroup
+	for i := 0; i < opt.parallel && i < len(jobs); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c, err := newSnapshotConn(ctx, m.SnapshotTS)
+			if err != nil {
+				setErr(err)
+				return
+			}
+			defer c.Close()
+			save := func(fn func()) error {
+				mu.Lock()
+				defer mu.Unlock()
+				fn()
+				return saveManifest(opt.dir, m)
+			}
+			for t := range ch {
+				if err = dumpTable(ctx, c, opt, t, bufPool, save); err != nil {
+					setErr(err)
+					return
+				}
+			}
+		}()
+	}
+loop:
+	for _, t := range jobs {
+		select {
+		case ch <- t:
+		case <-ctx.Done():
+			break loop
+		}
+	}
+	close(ch)
+	wg.Wait()
+	return firstErr
+}
+
+// dumpTable dumps the data of the table into its data file. The table with primary key is
+// dumped in the chunks of opt.chunkRows rows ordered by the key, each chunk is recorded in
+// the manifest by save when finished, and the chunks are joined into the data file at last.
+func dumpTable(ctx context.Context, c *sql.Conn, opt *dumpOption, t *TableManifest, bufPool *sync.Pool, save func(func()) error) error {
+	var keys []string
+	if opt.chunkRows > 0 {
+		var err error
+		if keys, err = getPrimaryKeys(ctx, c, t.Database, t.Table); err != nil {
+			return err
+		}
+	}
+	if len(keys) == 0 {
+		err := writeDataFile(filepath.Join(opt.dir, t.File), opt.compress, func(w io.Writer) error {
+			if !opt.toCsv {
+				fmt.Fprintf(w, "USE `%s`;\n", t.Database)
+			}
+			return genOutput(ctx, c, w, t.Database, t.Table, t.Where, bufPool, opt.netBufferLength, opt.toCsv)
+		})
+		if err != nil {
+			return err
+		}
+		return save(func() {
+			t.Done = true
+		})
+	}
+
+	for len(t.Chunks) == 0 || len(t.Chunks[len(t.Chunks)-1].LastKey) > 0 {
+		var after []string
+		if len(t.Chunks) > 0 {
+			after = t.Chunks[len(t.Chunks)-1].LastKey
+		}
+		lastKey, err := getChunkLastKey(ctx, c, t, keys, after, opt.chunkRows)
+		if err != nil {
+			return err
+		}
+		chunk := ChunkManifest{
+			File:    fmt.Sprintf("%s%s%d", t.File, chunkSuffix, len(t.Chunks)),
+			LastKey: lastKey,
+		}
+		first := len(t.Chunks) == 0
+		where := chunkWhere(t.Where, keys, after, lastKey)
+		err = writeDataFile(filepath.Join(opt.dir, chunk.File), opt.compress, func(w io.Writer) error {
+			if !opt.toCsv && first {
+				fmt.Fprintf(w, "USE `%s`;\n", t.Database)
+			}
+			return genOutput(ctx, c, w, t.Database, t.Table, where, bufPool, opt.netBufferLength, opt.toCsv)
+		})
+		if err != nil {
+			return err
+		}
+		err = save(func() {
+			t.Chunks = append(t.Chunks, chunk)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if err := joinChunks(opt.dir, t); err != nil {
+		return err
+	}
+	err := save(func() {
+		t.Done = true
+	})
+	if err != nil {
+		return err
+	}
+	for _, chunk := range t.Chunks {
+		if err = os.Remove(filepath.Join(opt.dir, chunk.File)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeDataFile writes the data by fn into a temporary file, and renames it to name when finished
+func writeDataFile(name string, compress string, fn func(w io.Writer) error) error {
+	f, err := os.Create(name + tmpSuffix)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w, err := newCompressWriter(f, compress)
+	if err != nil {
+		return err
+	}
+	if err = fn(w); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(name+tmpSuffix, name)
+}
+
+// joinChunks concatenates the chunks into the data file of the table. Each chunk is a
+// complete gzip or zstd stream, and the concatenated streams are read as one.
+func joinChunks(dir string, t *TableManifest) error {
+	name := filepath.Join(dir, t.File)
+	f, err := os.Create(name + tmpSuffix)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	for _, chunk := range t.Chunks {
+		if err = appendFile(f, filepath.Join(dir, chunk.File)); err != nil {
+			return err
+		}
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(name+tmpSuffix, name)
+}
+
+func appendFile(w io.Writer, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(w, f)
+	return err
+}
+
+// getPrimaryKeys returns the primary key columns of the table
+func getPrimaryKeys(ctx context.Context, q queryer, db, tbl string) ([]string, error) {
+	r, err := q.QueryContext(ctx, "show columns from `"+db+"`.`"+tbl+"`")
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	cols, err := r.Columns()
+	if err != nil {
+		return nil, err
+	}
+	fieldIdx, keyIdx := -1, -1
+	for i, col := range cols {
+		switch strings.ToLower(col) {
+		case "field":
+			fieldIdx = i
+		case "key":
+			keyIdx = i
+		}
+	}
+	if fieldIdx < 0 || keyIdx < 0 {
+		return nil, moerr.NewInternalError(ctx, "the columns of %s.%s has no field or key", db, tbl)
+	}
+	vals := make([]sql.NullString, len(cols))
+	args := make([]any, len(cols))
+	for i := range vals {
+		args[i] = &vals[i]
+	}
+	var keys []string
+	for r.Next() {
+		if err = r.Scan(args...); err != nil {
+			return nil, err
+		}
+		if vals[keyIdx].String == "PRI" {
+			keys = append(keys, vals[fieldIdx].String)
+		}
+	}
+	return keys, r.Err()
+}
+
+// getChunkLastKey returns the key of the last row of the chunk after the key, or nil if the
+// rest of the table is less than a chunk
+func getChunkLastKey(ctx context.Context, q queryer, t *TableManifest, keys []string, after []string, chunkRows int) ([]string, error) {
+	orderBy := "`" + strings.Join(keys, "`,`") + "`"
+	query := "select " + orderBy + " from `" + t.Database + "`.`" + t.Table + "`"
+	if where := chunkWhere(t.Where, keys, after, nil); where != "" {
+		query += " where " + where
+	}
+	query += fmt.Sprintf(" order by %s limit 1 offset %d", orderBy, chunkRows-1)
+	r, err := q.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	colTypes, err := r.ColumnTypes()
+	if err != nil {
+		return nil, err
+	}
+	if !r.Next() {
+		return nil, r.Err()
+	}
+	vals := make([]any, len(colTypes))
+	for i := range vals {
+		var v sql.RawBytes
+		vals[i] = &v
+	}
+	if err = r.Scan(vals...); err != nil {
+		return nil, err
+	}
+	lastKey := make([]string, len(colTypes))
+	for i, col := range colTypes {
+		lastKey[i] = convertValue(vals[i], col.DatabaseTypeName())
+	}
+	return lastKey, r.Err()
+}
+
+// chunkWhere returns the filter of the rows of the table whose key is greater than after and
+// not greater than last, a nil key is no limit
+func chunkWhere(where string, keys []string, after, last []string) string {
+	var conds []string
+	if where != "" {
+		conds = append(conds, "("+where+")")
+	}
+	if len(after) > 0 {
+		conds = append(conds, keyGreaterThan(keys, after))
+	}
+	if len(last) > 0 {
+		conds = append(conds, "not "+keyGreaterThan(keys, last))
+	}
+	return strings.Join(conds, " and ")
+}
+
+// keyGreaterThan compares the keys with the values in the order of the keys
+func keyGreaterThan(keys []string, vals []string) string {
+	ors := make([]string, len(keys))
+	for i, key := range keys {
+		ands := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, fmt.Sprintf("`%s` = %s", keys[j], vals[j]))
+		}
+		ands = append(ands, fmt.Sprintf("`%s` > %s", key, vals[i]))
+		ors[i] = strings.Join(ands, " and ")
+	}
+	if len(ors) == 1 {
+		return "(" + ors[0] + ")"
+	}
+	return "((" + strings.Join(ors, ") or (") + "))"
+}
+
+type nopWriteCloser struct {
+	*bufio.Writer
+}
+
+func (w nopWriteCloser) Close() error {
+	return w.Flush()
+}
+
+// newCompressWriter returns a buffered writer which compresses the data into w,
+// Close flushes the data but does not close w
+func newCompressWriter(w io.Writer, compress string) (io.WriteCloser, error) {
+	switch compress {
+	case compressNone:
+		return nopWriteCloser{bufio.NewWriter(w)}, nil
+	case compressGzip:
+		return gzip.NewWriter(w), nil
+	case compressZstd:
+		return zstd.NewWriter(w)
+	default:
+		return nil, moerr.NewInvalidInputNoCtx("unsupported compress %s", compress)
+	}
+}
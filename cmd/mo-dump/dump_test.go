@@ -0,0 +1,335 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpOptionInit(t *testing.T) {
+	ctx := context.Background()
+	kases := []struct {
+		opt        dumpOption
+		excludeDbs stringList
+		wheres     stringList
+		ok         bool
+	}{
+		{opt: dumpOption{database: "db1", parallel: 1, compress: compressNone}, ok: true},
+		{opt: dumpOption{parallel: 1, compress: compressNone}, ok: false},
+		{opt: dumpOption{database: "db1", allDatabases: true, parallel: 1, compress: compressNone}, ok: false},
+		{opt: dumpOption{allDatabases: true, parallel: 1, compress: compressNone}, excludeDbs: stringList{"db1"}, ok: true},
+		{opt: dumpOption{database: "db1", parallel: 1, compress: compressNone}, excludeDbs: stringList{"db1"}, ok: false},
+		{opt: dumpOption{allDatabases: true, tables: Tables{{Name: "t1"}}, parallel: 1, compress: compressNone}, ok: false},
+		{opt: dumpOption{database: "db1", parallel: 0, compress: compressNone, dir: "d"}, ok: false},
+		{opt: dumpOption{database: "db1", parallel: 1, compress: "lz4", dir: "d"}, ok: false},
+		{opt: dumpOption{database: "db1", parallel: 1, compress: compressNone, dir: "d", chunkRows: -1}, ok: false},
+		{opt: dumpOption{database: "db1", parallel: 4, compress: compressNone}, ok: false},
+		{opt: dumpOption{database: "db1", parallel: 1, compress: compressGzip}, ok: false},
+		{opt: dumpOption{database: "db1", parallel: 1, compress: compressNone, resume: true}, ok: false},
+		{opt: dumpOption{database: "db1", parallel: 4, compress: compressZstd, dir: "d", resume: true}, ok: true},
+		{opt: dumpOption{database: "db1", parallel: 1, compress: compressNone}, wheres: stringList{"t1:a>1"}, ok: true},
+		{opt: dumpOption{database: "db1", parallel: 1, compress: compressNone}, wheres: stringList{"a>1"}, ok: false},
+	}
+	for i, kase := range kases {
+		err := kase.opt.init(ctx, kase.excludeDbs, kase.wheres)
+		assert.Equal(t, kase.ok, err == nil, "case %d: %v", i, err)
+	}
+}
+
+func TestParseWheres(t *testing.T) {
+	ctx := context.Background()
+	wheres, err := parseWheres(ctx, stringList{
+		"t1:a > 1",
+		"db1.t1: b = 'x:y'",
+		"t2:c is null",
+	})
+	require.NoError(t, err)
+	opt := &dumpOption{wheres: wheres}
+	assert.Equal(t, "b = 'x:y'", opt.getWhere("db1", "t1"))
+	assert.Equal(t, "a > 1", opt.getWhere("db2", "t1"))
+	assert.Equal(t, "c is null", opt.getWhere("db1", "t2"))
+	assert.Equal(t, "", opt.getWhere("db1", "t3"))
+
+	_, err = parseWheres(ctx, stringList{"t1:"})
+	assert.Error(t, err)
+	_, err = parseWheres(ctx, stringList{"t1:a > 1", "t1:a < 1"})
+	assert.Error(t, err)
+}
+
+func TestFilterDatabases(t *testing.T) {
+	dbs := filterDatabases(
+		[]string{"mo_catalog", "db1", "system", "db2", "db3", "information_schema"},
+		map[string]struct{}{"db2": {}},
+	)
+	assert.Equal(t, []string{"db1", "db3"}, dbs)
+}
+
+func TestParseSnapshotTS(t *testing.T) {
+	ctx := context.Background()
+	ts, err := parseSnapshotTS(ctx, `{"method": "GetSnapshot", "result": "1691467331557541000-1"}`)
+	require.NoError(t, err)
+	assert.Equal(t, "1691467331557541000-1", ts)
+
+	_, err = parseSnapshotTS(ctx, `{"method": "GetSnapshot"}`)
+	assert.Error(t, err)
+	_, err = parseSnapshotTS(ctx, `invalid`)
+	assert.Error(t, err)
+}
+
+func TestCompressWriter(t *testing.T) {
+	data := bytes.Repeat([]byte("INSERT INTO `t1` VALUES (1);\n"), 100)
+	for _, compress := range []string{compressNone, compressGzip, compressZstd} {
+		var buf bytes.Buffer
+		w, err := newCompressWriter(&buf, compress)
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		var r io.Reader = &buf
+		switch compress {
+		case compressGzip:
+			r, err = gzip.NewReader(r)
+			require.NoError(t, err)
+		case compressZstd:
+			r, err = zstd.NewReader(r)
+			require.NoError(t, err)
+		}
+		res, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, data, res, compress)
+	}
+	_, err := newCompressWriter(io.Discard, "lz4")
+	assert.Error(t, err)
+}
+
+func TestManifest(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	m := &Manifest{
+		SnapshotTS: "100-1",
+		Compress:   compressGzip,
+	}
+	t1, err := m.addTable(ctx, "db1", "t1", "a > 1")
+	require.NoError(t, err)
+	assert.Equal(t, "db1.t1.sql.gz", t1.File)
+	t1.Done = true
+	_, err = m.addTable(ctx, "db1", "t2", "")
+	require.NoError(t, err)
+	require.NoError(t, saveManifest(dir, m))
+
+	loaded, err := loadManifest(dir)
+	require.NoError(t, err)
+	assert.Equal(t, m, loaded)
+
+	// the tables are recorded
+	t1, err = loaded.addTable(ctx, "db1", "t1", "a > 1")
+	require.NoError(t, err)
+	assert.True(t, t1.Done)
+	assert.Equal(t, 2, len(loaded.Tables))
+	_, err = loaded.addTable(ctx, "db1", "t1", "a > 2")
+	assert.Error(t, err)
+
+	// resume
+	opt := &dumpOption{dir: dir, compress: compressGzip}
+	_, err = initManifest(ctx, opt)
+	assert.Error(t, err)
+	opt.resume = true
+	resumed, err := initManifest(ctx, opt)
+	require.NoError(t, err)
+	assert.Equal(t, "100-1", resumed.SnapshotTS)
+	opt.compress = compressZstd
+	_, err = initManifest(ctx, opt)
+	assert.Error(t, err)
+
+	assert.Equal(t, "db1.t1.csv.zst", dataFileName("db1", "t1", true, compressZstd))
+	assert.Equal(t, "db1.t1.sql", dataFileName("db1", "t1", false, compressNone))
+}
+
+func TestDumpToDir(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+	old := conn
+	conn = db
+	defer func() {
+		conn = old
+	}()
+
+	expectSchema := func() {
+		mock.ExpectExec("set snapshot_ts = '100-1'").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("show create database `db1`").
+			WillReturnRows(sqlmock.NewRows([]string{"Database", "Create Database"}).AddRow("db1", "create database `db1`"))
+		mock.ExpectQuery("select relname,relkind from mo_catalog.mo_tables where reldatabase = 'db1'").
+			WillReturnRows(sqlmock.NewRows([]string{"relname", "relkind"}).
+				AddRow("t1", catalog.SystemOrdinaryRel).
+				AddRow("v1", catalog.SystemViewRel))
+		mock.ExpectQuery("show create table `db1`.`t1`").
+			WillReturnRows(sqlmock.NewRows([]string{"Table", "Create Table"}).AddRow("t1", "create table t1 (a int)"))
+		mock.ExpectQuery("show create table `db1`.`v1`").
+			WillReturnRows(sqlmock.NewRows([]string{"View", "Create View"}).AddRow("v1", "create view v1 as select * from t1"))
+	}
+
+	mock.ExpectQuery("select mo_ctl('cn','GetSnapshot','')").
+		WillReturnRows(sqlmock.NewRows([]string{"mo_ctl"}).AddRow(`{"method": "GetSnapshot", "result": "100-1"}`))
+	expectSchema()
+	mock.ExpectExec("set snapshot_ts = '100-1'").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("select * from `db1`.`t1` where a > 1").
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(sqlmock.NewColumn("a").OfType("INT", int64(0))).
+			AddRow("2").AddRow("3"))
+
+	dir := t.TempDir()
+	opt := &dumpOption{
+		database:        "db1",
+		netBufferLength: defaultNetBufferLength,
+		parallel:        2,
+		dir:             dir,
+		compress:        compressGzip,
+	}
+	require.NoError(t, opt.init(ctx, nil, stringList{"t1:a > 1"}))
+	require.NoError(t, dumpToDir(ctx, opt))
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// schema
+	schema, err := os.ReadFile(filepath.Join(dir, "db1.sql"))
+	require.NoError(t, err)
+	assert.Contains(t, string(schema), "create table t1 (a int);")
+	assert.Contains(t, string(schema), "create view v1 as select * from t1;")
+
+	// data
+	f, err := os.Open(filepath.Join(dir, "db1.t1.sql.gz"))
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "USE `db1`;\nINSERT INTO `t1` VALUES (2),(3);\n")
+
+	m, err := loadManifest(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "100-1", m.SnapshotTS)
+	require.Equal(t, 1, len(m.Tables))
+	assert.True(t, m.Tables[0].Done)
+
+	// resume the finished dump, no data is read again
+	expectSchema()
+	opt.resume = true
+	require.NoError(t, dumpToDir(ctx, opt))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDumpTableChunks(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	defer db.Close()
+	c, err := db.Conn(ctx)
+	require.NoError(t, err)
+	defer c.Close()
+
+	dir := t.TempDir()
+	opt := &dumpOption{
+		netBufferLength: defaultNetBufferLength,
+		dir:             dir,
+		compress:        compressZstd,
+		chunkRows:       2,
+	}
+	m := &Manifest{SnapshotTS: "100-1", Compress: compressZstd}
+	tbl, err := m.addTable(ctx, "db1", "t1", "a > 0")
+	require.NoError(t, err)
+	save := func(fn func()) error {
+		fn()
+		return saveManifest(dir, m)
+	}
+	intCol := func(name string) *sqlmock.Column {
+		return sqlmock.NewColumn(name).OfType("INT", int64(0))
+	}
+	expectKeys := func() {
+		mock.ExpectQuery("show columns from `db1`.`t1`").
+			WillReturnRows(sqlmock.NewRows([]string{"Field", "Type", "Null", "Key", "Default", "Extra", "Comment"}).
+				AddRow("a", "INT(32)", "NO", "PRI", nil, "", "").
+				AddRow("b", "INT(32)", "YES", "", nil, "", ""))
+	}
+	expectKeys()
+	mock.ExpectQuery("select `a` from `db1`.`t1` where (a > 0) order by `a` limit 1 offset 1").
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(intCol("a")).AddRow("2"))
+	mock.ExpectQuery("select * from `db1`.`t1` where (a > 0) and not (`a` > 2)").
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(intCol("a"), intCol("b")).
+			AddRow("1", "10").AddRow("2", "20"))
+	mock.ExpectQuery("select `a` from `db1`.`t1` where (a > 0) and (`a` > 2) order by `a` limit 1 offset 1").
+		WillReturnError(io.ErrUnexpectedEOF)
+
+	// the first chunk is recorded when the dump fails
+	bufPool := &sync.Pool{
+		New: func() any {
+			return &bytes.Buffer{}
+		},
+	}
+	require.Error(t, dumpTable(ctx, c, opt, tbl, bufPool, save))
+	require.NoError(t, mock.ExpectationsWereMet())
+	m, err = loadManifest(dir)
+	require.NoError(t, err)
+	tbl = m.Tables[0]
+	require.Equal(t, 1, len(tbl.Chunks))
+	assert.Equal(t, []string{"2"}, tbl.Chunks[0].LastKey)
+	assert.False(t, tbl.Done)
+
+	// the resumed dump continues after the first chunk
+	expectKeys()
+	mock.ExpectQuery("select `a` from `db1`.`t1` where (a > 0) and (`a` > 2) order by `a` limit 1 offset 1").
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(intCol("a")))
+	mock.ExpectQuery("select * from `db1`.`t1` where (a > 0) and (`a` > 2)").
+		WillReturnRows(sqlmock.NewRowsWithColumnDefinition(intCol("a"), intCol("b")).AddRow("3", "30"))
+	require.NoError(t, dumpTable(ctx, c, opt, tbl, bufPool, save))
+	require.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, tbl.Done)
+	assert.Equal(t, 2, len(tbl.Chunks))
+	for _, chunk := range tbl.Chunks {
+		_, err = os.Stat(filepath.Join(dir, chunk.File))
+		assert.True(t, os.IsNotExist(err))
+	}
+
+	f, err := os.Open(filepath.Join(dir, tbl.File))
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := zstd.NewReader(f)
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "USE `db1`;\nINSERT INTO `t1` VALUES (1,10),(2,20);\n\n\n\nINSERT INTO `t1` VALUES (3,30);\n\n\n\n", string(data))
+}
+
+func TestChunkWhere(t *testing.T) {
+	keys := []string{"a", "b"}
+	assert.Equal(t, "", chunkWhere("", keys, nil, nil))
+	assert.Equal(t, "((`a` > 1) or (`a` = 1 and `b` > 'x')) and not ((`a` > 2) or (`a` = 2 and `b` > 'y'))",
+		chunkWhere("", keys, []string{"1", "'x'"}, []string{"2", "'y'"}))
+}
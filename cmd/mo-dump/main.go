@@ -19,6 +19,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/csv"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
@@ -41,15 +42,26 @@ func (t *Tables) Set(value string) error {
 	return nil
 }
 
+func (l *stringList) String() string {
+	return fmt.Sprint(*l)
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
 func main() {
 	var (
 		username, password, host, database string
 		tables                             Tables
-		port, netBufferLength              int
-		createDb                           string
-		createTable                        []string
+		excludeDbs, wheres                 stringList
+		port, netBufferLength, parallel    int
+		chunkRows                          int
+		dir, compress                      string
 		err                                error
 		toCsv, localInfile                 bool
+		allDatabases, resume               bool
 	)
 	dumpStart := time.Now()
 	defer func() {
@@ -64,7 +76,7 @@ func main() {
 		}
 		if err == nil {
 			fmt.Fprintf(os.Stdout, "/* MODUMP SUCCESS, COST %v */\n", time.Since(dumpStart))
-			if toCsv {
+			if toCsv && dir == "" {
 				fmt.Fprintf(os.Stdout, "/* !!!MUST KEEP FILE IN CURRENT DIRECTORY, OR YOU SHOULD CHANGE THE PATH IN LOAD DATA STMT!!! */ \n")
 			}
 		}
@@ -76,10 +88,18 @@ func main() {
 	flag.StringVar(&host, "h", defaultHost, "hostname")
 	flag.IntVar(&port, "P", defaultPort, "portNumber")
 	flag.IntVar(&netBufferLength, "net-buffer-length", defaultNetBufferLength, "net_buffer_length")
-	flag.StringVar(&database, "db", "", "databaseName, must be specified")
+	flag.StringVar(&database, "db", "", "databaseName, must be specified unless -all-databases is set")
+	flag.BoolVar(&allDatabases, "all-databases", false, "dump all databases except the system databases")
+	flag.Var(&excludeDbs, "exclude-db", "databaseName to skip when dumping all databases, can be specified multiple times")
 	flag.Var(&tables, "tbl", "tableNameList, default all")
+	flag.Var(&wheres, "where", "filter of a table as 'table:condition' or 'database.table:condition', can be specified multiple times")
 	flag.BoolVar(&toCsv, "csv", defaultCsv, "set export format to csv")
 	flag.BoolVar(&localInfile, "local-infile", defaultLocalInfile, "use load data local infile")
+	flag.StringVar(&dir, "dir", "", "dump into the directory, one file for the schema of each database and one file for the data of each table")
+	flag.IntVar(&parallel, "parallel", defaultParallel, "number of tables dumped in parallel, only for -dir")
+	flag.StringVar(&compress, "compress", compressNone, "compress the data files with none, gzip or zstd, only for -dir")
+	flag.BoolVar(&resume, "resume", false, "resume the dump in -dir from its manifest")
+	flag.IntVar(&chunkRows, "chunk-rows", defaultChunkRows, "rows of a chunk recorded in the manifest for the tables with primary key, 0 to dump the tables in one piece, only for -dir")
 	flag.Parse()
 	if netBufferLength < minNetBufferLength {
 		fmt.Fprintf(os.Stderr, "net_buffer_length must be greater than %d, set to %d\n", minNetBufferLength, minNetBufferLength)
@@ -89,8 +109,20 @@ func main() {
 		fmt.Fprintf(os.Stderr, "net_buffer_length must be less than %d, set to %d\n", maxNetBufferLength, maxNetBufferLength)
 		netBufferLength = maxNetBufferLength
 	}
-	if len(database) == 0 {
-		err = moerr.NewInvalidInput(ctx, "database must be specified")
+	opt := &dumpOption{
+		database:        database,
+		allDatabases:    allDatabases,
+		tables:          tables,
+		netBufferLength: netBufferLength,
+		toCsv:           toCsv,
+		localInfile:     localInfile,
+		parallel:        parallel,
+		dir:             dir,
+		compress:        compress,
+		resume:          resume,
+		chunkRows:       chunkRows,
+	}
+	if err = opt.init(ctx, excludeDbs, wheres); err != nil {
 		return
 	}
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s", username, password, host, port, database)
@@ -112,31 +144,245 @@ func main() {
 	if err != nil {
 		return
 	}
-	if len(tables) == 0 { //dump all tables
-		createDb, err = getCreateDB(ctx, database)
-		if err != nil {
-			return
+	if opt.dir == "" {
+		err = dumpToStdout(ctx, opt)
+	} else {
+		err = dumpToDir(ctx, opt)
+	}
+}
+
+// init checks the options and parses the database and filter lists
+func (opt *dumpOption) init(ctx context.Context, excludeDbs, wheres stringList) error {
+	if len(opt.database) == 0 && !opt.allDatabases {
+		return moerr.NewInvalidInput(ctx, "database must be specified")
+	}
+	if len(opt.database) != 0 && opt.allDatabases {
+		return moerr.NewInvalidInput(ctx, "-db and -all-databases can not be specified together")
+	}
+	if len(excludeDbs) > 0 && !opt.allDatabases {
+		return moerr.NewInvalidInput(ctx, "-exclude-db must be used with -all-databases")
+	}
+	if len(opt.tables) > 0 && opt.allDatabases {
+		return moerr.NewInvalidInput(ctx, "-tbl can not be used with -all-databases")
+	}
+	if opt.parallel < 1 {
+		return moerr.NewInvalidInput(ctx, "parallel must be greater than 0")
+	}
+	if opt.chunkRows < 0 {
+		return moerr.NewInvalidInput(ctx, "chunk-rows must not be less than 0")
+	}
+	switch opt.compress {
+	case compressNone, compressGzip, compressZstd:
+	default:
+		return moerr.NewInvalidInput(ctx, "unsupported compress %s", opt.compress)
+	}
+	if opt.dir == "" && (opt.parallel > 1 || opt.compress != compressNone || opt.resume) {
+		return moerr.NewInvalidInput(ctx, "-parallel, -compress and -resume must be used with -dir")
+	}
+	opt.excludeDbs = make(map[string]struct{}, len(excludeDbs))
+	for _, db := range excludeDbs {
+		opt.excludeDbs[db] = struct{}{}
+	}
+	var err error
+	opt.wheres, err = parseWheres(ctx, wheres)
+	return err
+}
+
+// parseWheres parses the filters like 'table:condition' or 'database.table:condition'
+func parseWheres(ctx context.Context, wheres stringList) (map[string]string, error) {
+	res := make(map[string]string, len(wheres))
+	for _, where := range wheres {
+		name, cond, ok := strings.Cut(where, ":")
+		name = strings.TrimSpace(name)
+		cond = strings.TrimSpace(cond)
+		if !ok || name == "" || cond == "" {
+			return nil, moerr.NewInvalidInput(ctx, "invalid where %s, should be 'table:condition'", where)
+		}
+		if _, ok = res[name]; ok {
+			return nil, moerr.NewInvalidInput(ctx, "duplicate where of %s", name)
 		}
-		fmt.Printf("DROP DATABASE IF EXISTS `%s`;\n", database)
-		fmt.Println(createDb, ";")
-		fmt.Printf("USE `%s`;\n\n\n", database)
+		res[name] = cond
+	}
+	return res, nil
+}
+
+// getWhere returns the filter of the table, the one with the database name first
+func (opt *dumpOption) getWhere(db, tbl string) string {
+	if cond, ok := opt.wheres[db+"."+tbl]; ok {
+		return cond
 	}
-	tables, err = getTables(database, tables)
+	return opt.wheres[tbl]
+}
+
+// dumpToStdout dumps the databases to the stdout one table after another,
+// the data of the tables are read at the same snapshot
+func dumpToStdout(ctx context.Context, opt *dumpOption) error {
+	snapshotTS, err := getSnapshotTS(ctx)
 	if err != nil {
-		return
+		return err
 	}
-	createTable = make([]string, len(tables))
-	for i, tbl := range tables {
-		createTable[i], err = getCreateTable(database, tbl.Name)
-		if err != nil {
-			return
-		}
+	c, err := newSnapshotConn(ctx, snapshotTS)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+	dbs, err := getDatabases(ctx, c, opt)
+	if err != nil {
+		return err
 	}
 	bufPool := &sync.Pool{
 		New: func() any {
 			return &bytes.Buffer{}
 		},
 	}
+	for _, db := range dbs {
+		s, err := getSchema(ctx, c, db, opt.tables)
+		if err != nil {
+			return err
+		}
+		if len(opt.tables) == 0 { //dump all tables
+			showCreateDatabase(os.Stdout, db, s.createDb)
+		}
+		for i, create := range s.createTable {
+			tbl := s.tables[i]
+			if err = showSchema(ctx, os.Stdout, tbl, create); err != nil {
+				return err
+			}
+			if tbl.Kind != catalog.SystemOrdinaryRel {
+				continue
+			}
+			if !opt.toCsv {
+				err = genOutput(ctx, c, os.Stdout, db, tbl.Name, opt.getWhere(db, tbl.Name), bufPool, opt.netBufferLength, false)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			fname := fmt.Sprintf("%s_%s.%s", db, tbl.Name, "csv")
+			err = genCsvFile(ctx, c, fname, db, tbl.Name, opt.getWhere(db, tbl.Name))
+			if err != nil {
+				return err
+			}
+			showLoad(os.Stdout, fmt.Sprintf("%s/%s", os.Getenv("PWD"), fname), tbl.Name, opt.localInfile)
+		}
+	}
+	return nil
+}
+
+func genCsvFile(ctx context.Context, q queryer, fname string, db, tbl, where string) error {
+	f, err := os.Create(fname)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return genOutput(ctx, q, f, db, tbl, where, nil, 0, true)
+}
+
+// getSnapshotTS gets the current timestamp of the cluster
+func getSnapshotTS(ctx context.Context) (string, error) {
+	var res string
+	err := conn.QueryRowContext(ctx, "select mo_ctl('cn','GetSnapshot','')").Scan(&res)
+	if err != nil {
+		return "", err
+	}
+	return parseSnapshotTS(ctx, res)
+}
+
+// parseSnapshotTS parses the json result of the GetSnapshot command of mo_ctl
+func parseSnapshotTS(ctx context.Context, res string) (string, error) {
+	var ret struct {
+		Result string `json:"result"`
+	}
+	if err := json.Unmarshal([]byte(res), &ret); err != nil {
+		return "", err
+	}
+	if ret.Result == "" {
+		return "", moerr.NewInternalError(ctx, "invalid snapshot %s", res)
+	}
+	return ret.Result, nil
+}
+
+// newSnapshotConn returns a connection whose transactions read the data at the snapshot
+func newSnapshotConn(ctx context.Context, snapshotTS string) (*sql.Conn, error) {
+	c, err := conn.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = c.ExecContext(ctx, "set snapshot_ts = '"+snapshotTS+"'"); err != nil {
+		c.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// getDatabases returns the databases to dump
+func getDatabases(ctx context.Context, q queryer, opt *dumpOption) ([]string, error) {
+	if !opt.allDatabases {
+		return []string{opt.database}, nil
+	}
+	r, err := q.QueryContext(ctx, "show databases")
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	var dbs []string
+	for r.Next() {
+		var db string
+		if err = r.Scan(&db); err != nil {
+			return nil, err
+		}
+		dbs = append(dbs, db)
+	}
+	if err = r.Err(); err != nil {
+		return nil, err
+	}
+	return filterDatabases(dbs, opt.excludeDbs), nil
+}
+
+// filterDatabases removes the system databases and the excluded databases
+func filterDatabases(dbs []string, excludeDbs map[string]struct{}) []string {
+	res := dbs[:0]
+	for _, db := range dbs {
+		if _, ok := sysDatabases[db]; ok {
+			continue
+		}
+		if _, ok := excludeDbs[db]; ok {
+			continue
+		}
+		res = append(res, db)
+	}
+	return res
+}
+
+type schema struct {
+	createDb    string
+	tables      Tables
+	createTable []string
+}
+
+// getSchema gets the create statements of the database and its tables,
+// the tables are ordered so that the views are created after the tables they depend on
+func getSchema(ctx context.Context, q queryer, db string, tables Tables) (*schema, error) {
+	var err error
+	s := &schema{}
+	if len(tables) == 0 {
+		s.createDb, err = getCreateDB(ctx, q, db)
+		if err != nil {
+			return nil, err
+		}
+	}
+	s.tables, err = getTables(ctx, q, db, tables)
+	if err != nil {
+		return nil, err
+	}
+	s.createTable = make([]string, len(s.tables))
+	for i, tbl := range s.tables {
+		s.createTable[i], err = getCreateTable(ctx, q, db, tbl.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	createTable, tables := s.createTable, s.tables
 	left, right := 0, len(createTable)-1
 	for left < right {
 		for left < len(createTable) && tables[left].Kind != catalog.SystemViewRel {
@@ -152,28 +398,32 @@ func main() {
 		tables[left], tables[right] = tables[right], tables[left]
 	}
 	adjustViewOrder(createTable, tables, left)
-	for i, create := range createTable {
-		tbl := tables[i]
-		switch tbl.Kind {
-		case catalog.SystemOrdinaryRel:
-			fmt.Printf("DROP TABLE IF EXISTS `%s`;\n", tbl.Name)
-			showCreateTable(create, false)
-			err = genOutput(database, tbl.Name, bufPool, netBufferLength, toCsv, localInfile)
-			if err != nil {
-				return
-			}
-		case catalog.SystemExternalRel:
-			fmt.Printf("/*!EXTERNAL TABLE `%s`*/\n", tbl.Name)
-			fmt.Printf("DROP TABLE IF EXISTS `%s`;\n", tbl.Name)
-			showCreateTable(create, true)
-		case catalog.SystemViewRel:
-			fmt.Printf("DROP VIEW IF EXISTS `%s`;\n", tbl.Name)
-			showCreateTable(create, true)
-		default:
-			err = moerr.NewNotSupported(ctx, "table type %s", tbl.Kind)
-			return
-		}
+	return s, nil
+}
+
+func showCreateDatabase(w io.Writer, db string, createDb string) {
+	fmt.Fprintf(w, "DROP DATABASE IF EXISTS `%s`;\n", db)
+	fmt.Fprintln(w, createDb, ";")
+	fmt.Fprintf(w, "USE `%s`;\n\n\n", db)
+}
+
+// showSchema writes the statements to recreate the table
+func showSchema(ctx context.Context, w io.Writer, tbl Table, create string) error {
+	switch tbl.Kind {
+	case catalog.SystemOrdinaryRel:
+		fmt.Fprintf(w, "DROP TABLE IF EXISTS `%s`;\n", tbl.Name)
+		showCreateTable(w, create, false)
+	case catalog.SystemExternalRel:
+		fmt.Fprintf(w, "/*!EXTERNAL TABLE `%s`*/\n", tbl.Name)
+		fmt.Fprintf(w, "DROP TABLE IF EXISTS `%s`;\n", tbl.Name)
+		showCreateTable(w, create, true)
+	case catalog.SystemViewRel:
+		fmt.Fprintf(w, "DROP VIEW IF EXISTS `%s`;\n", tbl.Name)
+		showCreateTable(w, create, true)
+	default:
+		return moerr.NewNotSupported(ctx, "table type %s", tbl.Kind)
 	}
+	return nil
 }
 
 func adjustViewOrder(createTable []string, tables Tables, start int) {
@@ -222,7 +472,7 @@ func adjustViewOrder(createTable []string, tables Tables, start int) {
 	_ = copy(tables[start:], newTables)
 }
 
-func showCreateTable(createSql string, withNextLine bool) {
+func showCreateTable(w io.Writer, createSql string, withNextLine bool) {
 	var suffix string
 	if !strings.HasSuffix(createSql, ";") {
 		suffix = ";"
@@ -230,10 +480,10 @@ func showCreateTable(createSql string, withNextLine bool) {
 	if withNextLine {
 		suffix += "\n\n"
 	}
-	fmt.Printf("%s%s\n", createSql, suffix)
+	fmt.Fprintf(w, "%s%s\n", createSql, suffix)
 }
 
-func getTables(db string, tables Tables) (Tables, error) {
+func getTables(ctx context.Context, q queryer, db string, tables Tables) (Tables, error) {
 	sql := "select relname,relkind from mo_catalog.mo_tables where reldatabase = '" + db + "'"
 	if len(tables) > 0 {
 		sql += " and relname in ("
@@ -245,7 +495,7 @@ func getTables(db string, tables Tables) (Tables, error) {
 		}
 		sql += ")"
 	}
-	r, err := conn.Query(sql) //TODO: after unified sys table prefix, add condition in where clause
+	r, err := q.QueryContext(ctx, sql) //TODO: after unified sys table prefix, add condition in where clause
 	if err != nil {
 		return nil, err
 	}
@@ -273,8 +523,8 @@ func getTables(db string, tables Tables) (Tables, error) {
 	return tables, nil
 }
 
-func getCreateDB(ctx context.Context, db string) (string, error) {
-	r := conn.QueryRow("show create database `" + db + "`")
+func getCreateDB(ctx context.Context, q queryer, db string) (string, error) {
+	r := q.QueryRowContext(ctx, "show create database `"+db+"`")
 	var create string
 	err := r.Scan(&db, &create)
 	if err != nil {
@@ -284,8 +534,8 @@ func getCreateDB(ctx context.Context, db string) (string, error) {
 	return create, err
 }
 
-func getCreateTable(db, tbl string) (string, error) {
-	r := conn.QueryRow("show create table `" + db + "`.`" + tbl + "`")
+func getCreateTable(ctx context.Context, q queryer, db, tbl string) (string, error) {
+	r := q.QueryRowContext(ctx, "show create table `"+db+"`.`"+tbl+"`")
 	var create string
 	err := r.Scan(&tbl, &create)
 	if err != nil {
@@ -294,7 +544,7 @@ func getCreateTable(db, tbl string) (string, error) {
 	return create, nil
 }
 
-func showInsert(r *sql.Rows, args []any, cols []*Column, tbl string, bufPool *sync.Pool, netBufferLength int) error {
+func showInsert(w io.Writer, r *sql.Rows, args []any, cols []*Column, tbl string, bufPool *sync.Pool, netBufferLength int) error {
 	var err error
 	buf := bufPool.Get().(*bytes.Buffer)
 	curBuf := bufPool.Get().(*bytes.Buffer)
@@ -340,7 +590,7 @@ func showInsert(r *sql.Rows, args []any, cols []*Column, tbl string, bufPool *sy
 		}
 		if buf.Len() > preLen {
 			buf.WriteString(";\n")
-			_, err = buf.WriteTo(os.Stdout)
+			_, err = buf.WriteTo(w)
 			if err != nil {
 				return err
 			}
@@ -355,33 +605,21 @@ func showInsert(r *sql.Rows, args []any, cols []*Column, tbl string, bufPool *sy
 	}
 	bufPool.Put(buf)
 	bufPool.Put(curBuf)
-	fmt.Printf("\n\n\n")
-	return nil
+	_, err = fmt.Fprintf(w, "\n\n\n")
+	return err
 }
 
-func showLoad(r *sql.Rows, rowResults []any, cols []*Column, db string, tbl string, localInfile bool) error {
-	fname := fmt.Sprintf("%s_%s.%s", db, tbl, "csv")
-	pwd := os.Getenv("PWD")
-	f, err := os.Create(fname)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	err = toCsv(r, f, rowResults, cols)
-	if err != nil {
-		return err
-	}
+// showLoad writes the load statement of the csv file
+func showLoad(w io.Writer, path string, tbl string, localInfile bool) {
 	if localInfile {
-		fmt.Printf("LOAD DATA LOCAL INFILE '%s' INTO TABLE `%s` FIELDS TERMINATED BY '\\t' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' PARALLEL 'TRUE';\n", fmt.Sprintf("%s/%s", pwd, fname), tbl)
+		fmt.Fprintf(w, "LOAD DATA LOCAL INFILE '%s' INTO TABLE `%s` FIELDS TERMINATED BY '\\t' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' PARALLEL 'TRUE';\n", path, tbl)
 	} else {
-		fmt.Printf("LOAD DATA INFILE '%s' INTO TABLE `%s` FIELDS TERMINATED BY '\\t' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' PARALLEL 'TRUE';\n", fmt.Sprintf("%s/%s", pwd, fname), tbl)
+		fmt.Fprintf(w, "LOAD DATA INFILE '%s' INTO TABLE `%s` FIELDS TERMINATED BY '\\t' ENCLOSED BY '\"' LINES TERMINATED BY '\\n' PARALLEL 'TRUE';\n", path, tbl)
 	}
-	return nil
 }
 
-// toCsv converts the result from mo to csv file
-func toCsv(r *sql.Rows, output io.Writer, rowResults []any, cols []*Column) error {
+// toCsvOutput converts the result from mo to csv file
+func toCsvOutput(r *sql.Rows, output io.Writer, rowResults []any, cols []*Column) error {
 	var err error
 	csvWriter := csv.NewWriter(output)
 	csvWriter.Comma = '\t'
@@ -421,11 +659,17 @@ func toCsvLine(csvWriter *csv.Writer, rowResults []any, cols []*Column, line []s
 	return err
 }
 
-func genOutput(db string, tbl string, bufPool *sync.Pool, netBufferLength int, toCsv bool, localInfile bool) error {
-	r, err := conn.Query("select * from `" + db + "`.`" + tbl + "`")
+// genOutput writes the data of the table to w, as insert statements or csv
+func genOutput(ctx context.Context, q queryer, w io.Writer, db string, tbl string, where string, bufPool *sync.Pool, netBufferLength int, toCsv bool) error {
+	query := "select * from `" + db + "`.`" + tbl + "`"
+	if where != "" {
+		query += " where " + where
+	}
+	r, err := q.QueryContext(ctx, query)
 	if err != nil {
 		return err
 	}
+	defer r.Close()
 	colTypes, err := r.ColumnTypes()
 	if err != nil {
 		return err
@@ -443,9 +687,14 @@ func genOutput(db string, tbl string, bufPool *sync.Pool, netBufferLength int, t
 		rowResults = append(rowResults, &v)
 	}
 	if !toCsv {
-		return showInsert(r, rowResults, cols, tbl, bufPool, netBufferLength)
+		err = showInsert(w, r, rowResults, cols, tbl, bufPool, netBufferLength)
+	} else {
+		err = toCsvOutput(r, w, rowResults, cols)
+	}
+	if err != nil {
+		return err
 	}
-	return showLoad(r, rowResults, cols, db, tbl, localInfile)
+	return r.Err()
 }
 
 func convertValue(v any, typ string) string {
@@ -106,7 +106,7 @@ func TestShowCreateTable(t *testing.T) {
 	for _, v := range kases {
 		r, w, _ := os.Pipe()
 		os.Stdout = w
-		showCreateTable(v.sql, v.withNextLine)
+		showCreateTable(os.Stdout, v.sql, v.withNextLine)
 
 		e := w.Close()
 		require.Nil(t, e)
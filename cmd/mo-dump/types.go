@@ -15,6 +15,7 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"time"
@@ -30,9 +31,23 @@ const (
 	maxNetBufferLength     = mpool.MB * 16
 	defaultCsv             = false
 	defaultLocalInfile     = true
+	defaultParallel        = 1
+	defaultChunkRows       = 1000000
 	timeout                = 10 * time.Second
 )
 
+const (
+	compressNone = "none"
+	compressGzip = "gzip"
+	compressZstd = "zstd"
+)
+
+const (
+	manifestFile = "manifest.json"
+	tmpSuffix    = ".tmp"
+	chunkSuffix  = ".part"
+)
+
 const (
 	quoteFmt   = "%q"
 	defaultFmt = "%s"
@@ -42,6 +57,16 @@ const (
 var (
 	conn      *sql.DB
 	nullBytes = []byte("\\N")
+
+	// the databases skipped when dumping all databases
+	sysDatabases = map[string]struct{}{
+		"mo_catalog":         {},
+		"information_schema": {},
+		"system":             {},
+		"system_metrics":     {},
+		"mysql":              {},
+		"mo_task":            {},
+	}
 )
 
 type Column struct {
@@ -55,3 +80,58 @@ type Table struct {
 }
 
 type Tables []Table
+
+// stringList is a flag which can be specified multiple times
+type stringList []string
+
+// queryer is implemented by *sql.DB and *sql.Conn
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type dumpOption struct {
+	database        string
+	allDatabases    bool
+	excludeDbs      map[string]struct{}
+	tables          Tables
+	wheres          map[string]string
+	netBufferLength int
+	toCsv           bool
+	localInfile     bool
+	parallel        int
+	dir             string
+	compress        string
+	resume          bool
+	chunkRows       int
+}
+
+// Manifest records the progress of a dump to a directory, so that a failed dump can be resumed
+type Manifest struct {
+	// SnapshotTS is the timestamp all tables are read at
+	SnapshotTS string           `json:"snapshot_ts"`
+	Csv        bool             `json:"csv"`
+	Compress   string           `json:"compress"`
+	Tables     []*TableManifest `json:"tables"`
+}
+
+type TableManifest struct {
+	Database string `json:"database"`
+	Table    string `json:"table"`
+	Where    string `json:"where,omitempty"`
+	// File is the data file of the table in the dump directory
+	File string `json:"file"`
+	// Chunks are the finished chunks of the table with primary key, a resumed dump
+	// continues after the last key of the last chunk
+	Chunks []ChunkManifest `json:"chunks,omitempty"`
+	Done   bool            `json:"done"`
+}
+
+// ChunkManifest is a part of the data file, the rows of the chunk are between the last key
+// of the previous chunk and its last key
+type ChunkManifest struct {
+	File string `json:"file"`
+	// LastKey is the primary key of the last row as sql literals, it is empty for the last
+	// chunk of the table
+	LastKey []string `json:"last_key,omitempty"`
+}
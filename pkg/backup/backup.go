@@ -31,32 +31,17 @@ import (
 // Note: ctx needs to support cancel. The user can cancel the backup task by canceling the ctx.
 func Backup(ctx context.Context, bs *tree.BackupStart, cfg *Config) error {
 	var err error
-	var s3Conf *s3Config
 	cfg.Metas = NewMetas()
 
 	// step 1 : setup fileservice
-	//1.1 setup ETL fileservice for general usage
-	if !bs.IsS3 {
-		cfg.GeneralDir, _, err = setupFilesystem(ctx, bs.Dir, true)
-		if err != nil {
-			return err
-		}
-		//for tae hakeeper
-		cfg.TaeDir, _, err = setupFilesystem(ctx, bs.Dir, false)
-		if err != nil {
-			return err
-		}
-	} else {
-		s3Conf, err = getS3Config(ctx, bs.Option)
-		if err != nil {
-			return err
-		}
-		cfg.GeneralDir, _, err = setupS3(ctx, s3Conf, true)
-		if err != nil {
-			return err
-		}
-		cfg.TaeDir, _, err = setupS3(ctx, s3Conf, false)
-		if err != nil {
+	cfg.GeneralDir, cfg.TaeDir, err = setupBackupDir(ctx, bs.IsS3, bs.Dir, bs.Option)
+	if err != nil {
+		return err
+	}
+
+	// for incremental backup, find the tae files in the parent
+	if bs.Parent != "" {
+		if err = loadParent(ctx, bs, cfg); err != nil {
 			return err
 		}
 	}
@@ -110,7 +95,36 @@ func backupConfigs(ctx context.Context, cfg *Config) error {
 
 func backupTae(ctx context.Context, config *Config) error {
 	fs := fileservice.SubPath(config.TaeDir, taeDir)
-	return BackupData(ctx, config.SharedFs, fs, "")
+	files, err := BackupData(ctx, config.SharedFs, fs, config.ParentTaeFiles)
+	if err != nil {
+		return err
+	}
+	return saveTaeList(ctx, config.TaeDir, files)
+}
+
+// loadParent checks the parent backup and loads its tae files
+func loadParent(ctx context.Context, bs *tree.BackupStart, cfg *Config) error {
+	generalDir, parentTaeDir, err := setupBackupDir(ctx, bs.IsS3, bs.Parent, bs.Option)
+	if err != nil {
+		return err
+	}
+	metas, err := loadMetas(ctx, generalDir)
+	if err != nil {
+		return err
+	}
+	if err = checkMetas(ctx, metas); err != nil {
+		return err
+	}
+	files, err := loadTaeList(ctx, parentTaeDir)
+	if err != nil {
+		return err
+	}
+	cfg.ParentTaeFiles = make(map[string]bool, len(files))
+	for _, file := range files {
+		cfg.ParentTaeFiles[file.Name] = true
+	}
+	cfg.Metas.AppendParent(bs.Parent)
+	return nil
 }
 
 func backupHakeeper(ctx context.Context, config *Config) error {
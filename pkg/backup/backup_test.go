@@ -113,7 +113,7 @@ func TestBackupData(t *testing.T) {
 	for _, location := range files {
 		locations = append(locations, location)
 	}
-	err = execBackup(ctx, db.Opts.Fs, service, locations)
+	_, err = execBackup(ctx, db.Opts.Fs, service, locations, nil)
 	assert.Nil(t, err)
 	db.Opts.Fs = service
 	db.Restart(ctx)
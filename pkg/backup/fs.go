@@ -45,6 +45,39 @@ func setupS3(ctx context.Context, s3 *s3Config, forETL bool) (res fileservice.Fi
 	})
 }
 
+// setupBackupDir returns the FileService for ETL and the FileService for Backup of a backup.
+// for s3, dir overrides the filepath in the option if it is not empty.
+func setupBackupDir(ctx context.Context, isS3 bool, dir string, option []string) (generalDir, taeDir fileservice.FileService, err error) {
+	if !isS3 {
+		generalDir, _, err = setupFilesystem(ctx, dir, true)
+		if err != nil {
+			return nil, nil, err
+		}
+		//for tae hakeeper
+		taeDir, _, err = setupFilesystem(ctx, dir, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		return
+	}
+	s3Conf, err := getS3Config(ctx, option)
+	if err != nil {
+		return nil, nil, err
+	}
+	if dir != "" {
+		s3Conf.filepath = dir
+	}
+	generalDir, _, err = setupS3(ctx, s3Conf, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	taeDir, _, err = setupS3(ctx, s3Conf, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	return
+}
+
 func setupFileservice(ctx context.Context, conf *pathConfig) (res fileservice.FileService, readPath string, err error) {
 	var s3opts string
 	if conf.isS3 {
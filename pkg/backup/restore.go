@@ -0,0 +1,473 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"encoding/csv"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/version"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	ckpDir = "ckp"
+	gcDir  = "gc"
+
+	// restoreFlushTimeout bounds the flush of the replayed wal
+	restoreFlushTimeout = time.Minute
+)
+
+// Restore rebuilds the tae storage and the hakeeper data from a backup made by Backup.
+// The tae data is restored to the state committed at cfg.Timestamp: the newest checkpoint
+// not after it is restored, and the txns in the wal of the backup committed after the
+// checkpoint and not after cfg.Timestamp are replayed into a new checkpoint. The wal of
+// a backup starts at its newest checkpoint, so a timestamp before that is restored at
+// checkpoint granularity. cfg.RestoredTS reports the timestamp restored to.
+// The hakeeper data is written to cfg.HAKeeperFs as HakeeperFile, and should be used as
+// the bootstrap.restore.file-path of the log service when bootstrapping the new cluster.
+// It is a library entry point for the backup tools, and must be run before the cluster
+// using cfg.SharedFs is started.
+// Note: ctx needs to support cancel. The user can cancel the restore task by canceling the ctx.
+func Restore(ctx context.Context, cfg *RestoreConfig) error {
+	var err error
+
+	// step 1 : setup fileservice
+	if cfg.GeneralDir == nil || cfg.TaeDir == nil {
+		cfg.GeneralDir, cfg.TaeDir, err = setupBackupDir(ctx, cfg.IsS3, cfg.Dir, cfg.Option)
+		if err != nil {
+			return err
+		}
+	}
+	if cfg.SharedFs == nil {
+		return moerr.NewInternalError(ctx, "restore target fileservice is nil")
+	}
+
+	// step 2 : check the backup
+	if cfg.Metas, err = loadMetas(ctx, cfg.GeneralDir); err != nil {
+		return err
+	}
+	if err = checkMetas(ctx, cfg.Metas); err != nil {
+		return err
+	}
+
+	// step 3 : restore mo
+	if err = restoreTae(ctx, cfg); err != nil {
+		return err
+	}
+
+	if err = restoreHakeeper(ctx, cfg); err != nil {
+		return err
+	}
+
+	logutil.Infof("restore from %s finished, restored to %s", cfg.Dir, cfg.RestoredTS.ToString())
+	return nil
+}
+
+// loadMetas reads the mo_meta saved by saveMetas
+func loadMetas(ctx context.Context, fs fileservice.FileService) (*Metas, error) {
+	data, err := readFile(ctx, fs, moMeta)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	metas := NewMetas()
+	for _, line := range lines {
+		meta, err := parseMeta(ctx, line)
+		if err != nil {
+			return nil, err
+		}
+		metas.Append(meta)
+	}
+	return metas, nil
+}
+
+func parseMeta(ctx context.Context, line []string) (*Meta, error) {
+	if len(line) != FileNameOrDirNamePos+1 {
+		return nil, moerr.NewInternalError(ctx, "invalid mo_meta line: %v", line)
+	}
+	switch line[TypePos] {
+	case TypeVersion.String():
+		return &Meta{
+			Typ:     TypeVersion,
+			Version: line[SubTypePos],
+		}, nil
+	case TypeBuildinfo.String():
+		return &Meta{
+			Typ:       TypeBuildinfo,
+			Buildinfo: line[SubTypePos],
+		}, nil
+	case TypeLaunchconfig.String():
+		return &Meta{
+			Typ:              TypeLaunchconfig,
+			SubTyp:           line[SubTypePos],
+			LaunchConfigFile: line[FileNameOrDirNamePos],
+		}, nil
+	case TypeParent.String():
+		return &Meta{
+			Typ:    TypeParent,
+			Parent: line[FileNameOrDirNamePos],
+		}, nil
+	default:
+		return nil, moerr.NewInternalError(ctx, "invalid mo_meta type: %s", line[TypePos])
+	}
+}
+
+// checkMetas checks the backup is made by a compatible backup version and mo version
+func checkMetas(ctx context.Context, metas *Metas) error {
+	var backupVersion, info string
+	for _, meta := range metas.metas {
+		switch meta.Typ {
+		case TypeVersion:
+			backupVersion = meta.Version
+		case TypeBuildinfo:
+			info = meta.Buildinfo
+		}
+	}
+	if backupVersion == "" {
+		return moerr.NewInternalError(ctx, "no backup version in mo_meta")
+	}
+	if backupVersion != Version {
+		return moerr.NewInternalError(ctx, "backup version %s is not supported, expected %s", backupVersion, Version)
+	}
+	if info == "" {
+		return moerr.NewInternalError(ctx, "no build info in mo_meta")
+	}
+	moVersion, ok := buildInfoField(info, "Version")
+	if !ok {
+		return moerr.NewInternalError(ctx, "invalid build info in mo_meta: %s", info)
+	}
+	if moVersion != version.Version {
+		return moerr.NewInternalError(ctx, "backup is made by mo %s, but current mo is %s", moVersion, version.Version)
+	}
+	return nil
+}
+
+// buildInfoField gets a field of the build info made by buildInfo
+func buildInfoField(info string, name string) (string, bool) {
+	for _, field := range strings.Split(info, "|") {
+		key, value, ok := strings.Cut(field, ": ")
+		if ok && key == name {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// restoreTae copies the tae data into the shared fs. checkpoint and gc metadata
+// files after the timestamp are skipped, so that the tae replays to the chosen checkpoint,
+// then the wal after the checkpoint is replayed up to the timestamp.
+// For an incremental backup, the files not in it are copied from its parents.
+func restoreTae(ctx context.Context, cfg *RestoreConfig) error {
+	dstFs := cfg.SharedFs
+
+	// the target must not have checkpoints, or the tae replays them instead
+	existed, err := dstFs.List(ctx, ckpDir)
+	if err != nil && !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return err
+	}
+	if len(existed) > 0 {
+		return moerr.NewInternalError(ctx, "restore target already has checkpoints")
+	}
+
+	owners, err := loadTaeOwners(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	names, err := loadNeededTaeFiles(ctx, cfg, owners)
+	if err != nil {
+		return err
+	}
+
+	ts := cfg.Timestamp
+	if ts.IsEmpty() {
+		ts = types.MaxTs()
+	}
+
+	// checkpoint metadata
+	var restoredTS types.TS
+	var gcs, wals, objects []string
+	for _, name := range names {
+		dir, file := path.Split(name)
+		switch path.Clean(dir) {
+		case db.WalBackupDir:
+			wals = append(wals, name)
+		case ckpDir:
+			_, end := blockio.DecodeCheckpointMetadataFileName(file)
+			if end.Greater(ts) {
+				continue
+			}
+			if err = copyTaeFile(ctx, owners, name, dstFs); err != nil {
+				return err
+			}
+			if end.Greater(restoredTS) {
+				restoredTS = end
+			}
+		case gcDir:
+			gcs = append(gcs, name)
+		default:
+			objects = append(objects, name)
+		}
+	}
+	if restoredTS.IsEmpty() {
+		return moerr.NewInternalError(ctx, "no checkpoint before %s in backup", ts.ToString())
+	}
+	cfg.RestoredTS = restoredTS
+
+	// gc metadata
+	for _, name := range gcs {
+		_, end, _ := blockio.DecodeGCMetadataFileName(path.Base(name))
+		if end.Greater(restoredTS) {
+			continue
+		}
+		if err = copyTaeFile(ctx, owners, name, dstFs); err != nil {
+			return err
+		}
+	}
+
+	// objects. objects only referenced by the skipped checkpoints are copied too,
+	// they are not visible to the tae and will be removed by gc.
+	for _, name := range objects {
+		if err = copyTaeFile(ctx, owners, name, dstFs); err != nil {
+			return err
+		}
+	}
+
+	return replayWal(ctx, cfg, owners, wals, ts)
+}
+
+// replayWal replays the txns of the wal backup committed after cfg.RestoredTS and not
+// after ts. The txns are written into the local wal of a tae opened on the restored
+// checkpoints, which replays them as in a restart, and they are flushed into a new
+// checkpoint before the tae is closed.
+func replayWal(ctx context.Context, cfg *RestoreConfig, owners map[string]*taeFile, wals []string, ts types.TS) error {
+	// the wal must start before the restored checkpoint, the one reaching
+	// furthest is used
+	var walName string
+	var walEnd types.TS
+	for _, name := range wals {
+		start, end := blockio.DecodeWalBackupFileName(path.Base(name))
+		if start.Greater(cfg.RestoredTS) {
+			continue
+		}
+		if walName == "" || end.Greater(walEnd) {
+			walName, walEnd = name, end
+		}
+	}
+	if walName == "" {
+		return nil
+	}
+	owner := owners[walName]
+	if owner == nil {
+		return moerr.NewInternalError(ctx, "tae file %s is not found in the backup chain", walName)
+	}
+	data, err := readFile(ctx, owner.fs, walName)
+	if err != nil {
+		return err
+	}
+	payloads, tss, err := db.DecodeWalBackup(ctx, data)
+	if err != nil {
+		return err
+	}
+	var replayed [][]byte
+	var maxTS types.TS
+	for i, payload := range payloads {
+		if tss[i].LessEq(cfg.RestoredTS) || tss[i].Greater(ts) {
+			continue
+		}
+		replayed = append(replayed, payload)
+		if tss[i].Greater(maxTS) {
+			maxTS = tss[i]
+		}
+	}
+	if len(replayed) == 0 {
+		return nil
+	}
+
+	dir, err := os.MkdirTemp("", "mo-restore")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+	if err = db.WriteWal(ctx, dir, replayed); err != nil {
+		return err
+	}
+	tae, err := db.Open(ctx, dir, &options.Options{
+		Ctx:       ctx,
+		Fs:        cfg.SharedFs,
+		LogStoreT: options.LogstoreBatchStore,
+	})
+	if err != nil {
+		return err
+	}
+	// the flush commits after the replayed txns, and the checkpoint must
+	// include it
+	err = tae.BGCheckpointRunner.ForceFlush(maxTS, ctx, restoreFlushTimeout)
+	if err == nil {
+		err = tae.BGCheckpointRunner.ForceIncrementalCheckpoint(tae.TxnMgr.StatMaxCommitTS())
+	}
+	if closeErr := tae.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	logutil.Infof("restore replayed %d txns of %s after the checkpoint at %s",
+		len(replayed), walName, cfg.RestoredTS.ToString())
+	cfg.RestoredTS = maxTS
+	return nil
+}
+
+// taeFile is a tae file and the tae dir of the backup containing it
+type taeFile struct {
+	fs    fileservice.FileService
+	entry fileservice.DirEntry
+}
+
+// loadTaeOwners walks the backup and its parents, and finds the backup containing each tae file
+func loadTaeOwners(ctx context.Context, cfg *RestoreConfig) (map[string]*taeFile, error) {
+	owners := make(map[string]*taeFile)
+	visited := map[string]bool{cfg.Dir: true}
+	srcFs := fileservice.SubPath(cfg.TaeDir, taeDir)
+	parent := cfg.Metas.Parent()
+	for {
+		if err := listTaeFiles(ctx, srcFs, owners); err != nil {
+			return nil, err
+		}
+		if parent == "" {
+			return owners, nil
+		}
+		if visited[parent] {
+			return nil, moerr.NewInternalError(ctx, "backup %s is in the parent chain twice", parent)
+		}
+		visited[parent] = true
+
+		generalDir, parentTaeDir, err := setupBackupDir(ctx, cfg.IsS3, parent, cfg.Option)
+		if err != nil {
+			return nil, err
+		}
+		metas, err := loadMetas(ctx, generalDir)
+		if err != nil {
+			return nil, err
+		}
+		if err = checkMetas(ctx, metas); err != nil {
+			return nil, err
+		}
+		srcFs = fileservice.SubPath(parentTaeDir, taeDir)
+		parent = metas.Parent()
+	}
+}
+
+// listTaeFiles adds the tae files in fs to owners, files already in owners are kept
+func listTaeFiles(ctx context.Context, fs fileservice.FileService, owners map[string]*taeFile) error {
+	for _, dir := range []string{"", ckpDir, gcDir, db.WalBackupDir} {
+		files, err := fs.List(ctx, dir)
+		if err != nil {
+			if dir != "" && moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+				continue
+			}
+			return err
+		}
+		for _, file := range files {
+			if file.IsDir {
+				continue
+			}
+			file.Name = path.Join(dir, file.Name)
+			if owners[file.Name] == nil {
+				owners[file.Name] = &taeFile{
+					fs:    fs,
+					entry: file,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// loadNeededTaeFiles returns the names of the tae files needed by the backup.
+// backups made before the tae list was introduced need all of their own files.
+func loadNeededTaeFiles(ctx context.Context, cfg *RestoreConfig, owners map[string]*taeFile) ([]string, error) {
+	files, err := loadTaeList(ctx, cfg.TaeDir)
+	if err == nil {
+		names := make([]string, 0, len(files))
+		for _, file := range files {
+			names = append(names, file.Name)
+		}
+		return names, nil
+	}
+	if !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) || cfg.Metas.Parent() != "" {
+		return nil, err
+	}
+	names := make([]string, 0, len(owners))
+	for name := range owners {
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+func copyTaeFile(ctx context.Context, owners map[string]*taeFile, name string, dstFs fileservice.FileService) error {
+	owner := owners[name]
+	if owner == nil {
+		return moerr.NewInternalError(ctx, "tae file %s is not found in the backup chain", name)
+	}
+	return CopyFile(ctx, owner.fs, dstFs, &owner.entry, "")
+}
+
+// restoreHakeeper checks and writes the hakeeper data for bootstrapping the log service
+func restoreHakeeper(ctx context.Context, cfg *RestoreConfig) error {
+	if cfg.HAKeeperFs == nil {
+		return moerr.NewInternalError(ctx, "hakeeper fileservice is nil")
+	}
+	srcFs := fileservice.SubPath(cfg.TaeDir, hakeeperDir)
+	haData, err := readFile(ctx, srcFs, HakeeperFile)
+	if err != nil {
+		return err
+	}
+	var data pb.BackupData
+	if err = data.Unmarshal(haData); err != nil {
+		return err
+	}
+	return writeFile(ctx, cfg.HAKeeperFs, HakeeperFile, haData)
+}
+
+func readFile(ctx context.Context, fs fileservice.FileService, filePath string) ([]byte, error) {
+	ioVec := &fileservice.IOVector{
+		FilePath: filePath,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   -1,
+			},
+		},
+		CachePolicy: fileservice.SkipAll,
+	}
+	if err := fs.Read(ctx, ioVec); err != nil {
+		return nil, err
+	}
+	return ioVec.Entries[0].Data, nil
+}
@@ -0,0 +1,424 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+)
+
+func newTestLocalFS(t *testing.T, ctx context.Context) fileservice.FileService {
+	fs, err := fileservice.NewFileService(ctx, fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: t.TempDir(),
+	}, nil)
+	require.NoError(t, err)
+	return fs
+}
+
+func saveTestMetas(t *testing.T, ctx context.Context, fs fileservice.FileService, version string) {
+	metas := NewMetas()
+	metas.AppendVersion(version)
+	metas.AppendBuildinfo(buildInfo())
+	metas.AppendLaunchconfig(CnConfig, "cn.toml_1")
+	require.NoError(t, saveMetas(ctx, &Config{
+		GeneralDir: fs,
+		Metas:      metas,
+	}))
+}
+
+func TestRestore(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOptsAndQuickGC(nil)
+	db := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer db.Close()
+
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 10
+	db.BindSchema(schema)
+	testutil.CreateRelation(t, db.DB, "db", schema, true)
+
+	totalRows := uint64(schema.BlockMaxRows * 10)
+	bat := catalog.MockBatch(schema, int(totalRows))
+	defer bat.Close()
+	bats := bat.Split(10)
+	for _, data := range bats {
+		txn, rel := db.GetRelation()
+		assert.NoError(t, rel.Append(context.Background(), data))
+		assert.NoError(t, txn.Commit(context.Background()))
+	}
+	db.ForceCheckpoint()
+	maxEnd := func() (ts types.TS) {
+		for _, ckp := range db.BGCheckpointRunner.GetAllCheckpoints() {
+			if ckp.GetEnd().Greater(ts) {
+				ts = ckp.GetEnd()
+			}
+		}
+		return
+	}
+	beforeDelete := maxEnd()
+
+	for _, data := range bats {
+		txn, rel := db.GetRelation()
+		v := testutil.GetSingleSortKeyValue(data, schema, 2)
+		filter := handle.NewEQFilter(v)
+		assert.NoError(t, rel.DeleteByFilter(context.Background(), filter))
+		assert.NoError(t, txn.Commit(context.Background()))
+	}
+	db.ForceCheckpoint()
+	db.BGCheckpointRunner.DisableCheckpoint()
+	afterDelete := maxEnd()
+	require.True(t, afterDelete.Greater(beforeDelete))
+
+	// make a backup
+	files := make(map[string]string, 0)
+	for _, candidate := range db.BGCheckpointRunner.GetAllCheckpoints() {
+		if files[candidate.GetLocation().Name().String()] == "" {
+			files[candidate.GetLocation().Name().String()] = fmt.Sprintf("%s:%d",
+				candidate.GetLocation().String(), candidate.GetVersion())
+		}
+	}
+	locations := make([]string, 0)
+	for _, location := range files {
+		locations = append(locations, location)
+	}
+	backupFs := newTestLocalFS(t, ctx)
+	_, err := execBackup(ctx, db.Opts.Fs, fileservice.SubPath(backupFs, taeDir), locations, nil)
+	require.NoError(t, err)
+	saveTestMetas(t, ctx, backupFs, Version)
+	haData, err := (&pb.BackupData{NextID: 100}).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, writeFile(ctx, fileservice.SubPath(backupFs, hakeeperDir), HakeeperFile, haData))
+
+	// restore to the checkpoint before deleting
+	sharedFs := newTestLocalFS(t, ctx)
+	haFs := newTestLocalFS(t, ctx)
+	cfg := &RestoreConfig{
+		Timestamp:  beforeDelete.Next(),
+		GeneralDir: backupFs,
+		TaeDir:     backupFs,
+		SharedFs:   sharedFs,
+		HAKeeperFs: haFs,
+	}
+	require.NoError(t, Restore(ctx, cfg))
+	assert.Equal(t, beforeDelete, cfg.RestoredTS)
+	ckps, err := sharedFs.List(ctx, ckpDir)
+	require.NoError(t, err)
+	assert.NotEmpty(t, ckps)
+	for _, ckp := range ckps {
+		_, end := blockio.DecodeCheckpointMetadataFileName(ckp.Name)
+		assert.True(t, end.LessEq(beforeDelete))
+	}
+	data, err := readFile(ctx, haFs, HakeeperFile)
+	require.NoError(t, err)
+	var restoredHAData pb.BackupData
+	require.NoError(t, restoredHAData.Unmarshal(data))
+	assert.Equal(t, uint64(100), restoredHAData.NextID)
+
+	// the target has checkpoints now
+	assert.Error(t, Restore(ctx, cfg))
+
+	// no checkpoint before the timestamp
+	cfg.SharedFs = newTestLocalFS(t, ctx)
+	cfg.Timestamp = types.BuildTS(1, 0)
+	assert.Error(t, Restore(ctx, cfg))
+
+	// restore to the latest checkpoint and replay it
+	sharedFs = newTestLocalFS(t, ctx)
+	cfg = &RestoreConfig{
+		GeneralDir: backupFs,
+		TaeDir:     backupFs,
+		SharedFs:   sharedFs,
+		HAKeeperFs: newTestLocalFS(t, ctx),
+	}
+	require.NoError(t, Restore(ctx, cfg))
+	assert.Equal(t, afterDelete, cfg.RestoredTS)
+	db.Opts.Fs = sharedFs
+	db.Restart(ctx)
+	txn, rel := testutil.GetDefaultRelation(t, db.DB, schema.Name)
+	testutil.CheckAllColRowsByScan(t, rel, int(totalRows)-len(bats), true)
+	assert.NoError(t, txn.Commit(context.Background()))
+}
+
+func TestRestoreToTimestamp(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOptsAndQuickGC(nil)
+	db := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer db.Close()
+
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 10
+	db.BindSchema(schema)
+	testutil.CreateRelation(t, db.DB, "db", schema, true)
+
+	bat := catalog.MockBatch(schema, int(schema.BlockMaxRows*10))
+	defer bat.Close()
+	bats := bat.Split(10)
+	for _, data := range bats[:5] {
+		txn, rel := db.GetRelation()
+		assert.NoError(t, rel.Append(context.Background(), data))
+		assert.NoError(t, txn.Commit(context.Background()))
+	}
+	db.ForceCheckpoint()
+	db.BGCheckpointRunner.DisableCheckpoint()
+	ckpEnd := db.BGCheckpointRunner.MaxCheckpoint().GetEnd()
+
+	// the txns after the checkpoint are only in the wal
+	var commits []types.TS
+	for _, data := range bats[5:] {
+		txn, rel := db.GetRelation()
+		assert.NoError(t, rel.Append(context.Background(), data))
+		assert.NoError(t, txn.Commit(context.Background()))
+		commits = append(commits, txn.GetCommitTS())
+	}
+	{
+		txn, rel := db.GetRelation()
+		v := testutil.GetSingleSortKeyValue(bats[0], schema, 2)
+		assert.NoError(t, rel.DeleteByFilter(context.Background(), handle.NewEQFilter(v)))
+		assert.NoError(t, txn.Commit(context.Background()))
+		commits = append(commits, txn.GetCommitTS())
+	}
+
+	// make a backup with the wal
+	walFile, err := db.BackupWal(ctx)
+	require.NoError(t, err)
+	files := make(map[string]string, 0)
+	for _, candidate := range db.BGCheckpointRunner.GetAllCheckpoints() {
+		files[candidate.GetLocation().Name().String()] = fmt.Sprintf("%s:%d",
+			candidate.GetLocation().String(), candidate.GetVersion())
+	}
+	locations := []string{walFile}
+	for _, location := range files {
+		locations = append(locations, location)
+	}
+	backupFs := newTestLocalFS(t, ctx)
+	_, err = execBackup(ctx, db.Opts.Fs, fileservice.SubPath(backupFs, taeDir), locations, nil)
+	require.NoError(t, err)
+	_, err = db.Opts.Fs.StatFile(ctx, walFile)
+	assert.Error(t, err)
+	saveTestMetas(t, ctx, backupFs, Version)
+	haData, err := (&pb.BackupData{NextID: 100}).Marshal()
+	require.NoError(t, err)
+	require.NoError(t, writeFile(ctx, fileservice.SubPath(backupFs, hakeeperDir), HakeeperFile, haData))
+
+	restore := func(ts types.TS) fileservice.FileService {
+		sharedFs := newTestLocalFS(t, ctx)
+		cfg := &RestoreConfig{
+			Timestamp:  ts,
+			GeneralDir: backupFs,
+			TaeDir:     backupFs,
+			SharedFs:   sharedFs,
+			HAKeeperFs: newTestLocalFS(t, ctx),
+		}
+		require.NoError(t, Restore(ctx, cfg))
+		if ts.IsEmpty() {
+			assert.Equal(t, commits[len(commits)-1], cfg.RestoredTS)
+		} else if ts.Less(commits[0]) {
+			assert.Equal(t, ckpEnd, cfg.RestoredTS)
+		} else {
+			assert.Equal(t, ts, cfg.RestoredTS)
+		}
+		return sharedFs
+	}
+	check := func(sharedFs fileservice.FileService, rows int) {
+		// open in a new dir, so that only the restored data is replayed
+		db.Dir = t.TempDir()
+		db.Opts.Fs = sharedFs
+		db.Restart(ctx)
+		txn, rel := testutil.GetDefaultRelation(t, db.DB, schema.Name)
+		testutil.CheckAllColRowsByScan(t, rel, rows, true)
+		assert.NoError(t, txn.Commit(context.Background()))
+	}
+
+	// between the txns after the checkpoint
+	check(restore(commits[1]), int(schema.BlockMaxRows*7))
+	// before the first txn after the checkpoint
+	check(restore(commits[0].Prev()), int(schema.BlockMaxRows*5))
+	// to the end of the wal
+	check(restore(types.TS{}), int(schema.BlockMaxRows*10-1))
+}
+
+func TestRestoreCheckMetas(t *testing.T) {
+	ctx := context.Background()
+
+	// unsupported backup version
+	fs := newTestLocalFS(t, ctx)
+	saveTestMetas(t, ctx, fs, "0000")
+	cfg := &RestoreConfig{
+		GeneralDir: fs,
+		TaeDir:     fs,
+		SharedFs:   newTestLocalFS(t, ctx),
+		HAKeeperFs: newTestLocalFS(t, ctx),
+	}
+	assert.Error(t, Restore(ctx, cfg))
+
+	// metas are loaded back
+	fs = newTestLocalFS(t, ctx)
+	saveTestMetas(t, ctx, fs, Version)
+	cfg.GeneralDir = fs
+	metas, err := loadMetas(ctx, fs)
+	require.NoError(t, err)
+	assert.NoError(t, checkMetas(ctx, metas))
+	assert.Equal(t, 3, len(metas.metas))
+	assert.Equal(t, "cn.toml_1", metas.metas[2].LaunchConfigFile)
+
+	// build by another version
+	metas = NewMetas()
+	metas.AppendVersion(Version)
+	metas.AppendBuildinfo("GoVersion: go|Version: v0.0.0-another")
+	assert.Error(t, checkMetas(ctx, metas))
+
+	// no mo_meta
+	cfg.GeneralDir = newTestLocalFS(t, ctx)
+	assert.Error(t, Restore(ctx, cfg))
+}
+
+func TestRestoreIncremental(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOptsAndQuickGC(nil)
+	db := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer db.Close()
+
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 10
+	db.BindSchema(schema)
+	testutil.CreateRelation(t, db.DB, "db", schema, true)
+
+	totalRows := uint64(schema.BlockMaxRows * 10)
+	bat := catalog.MockBatch(schema, int(totalRows))
+	defer bat.Close()
+	bats := bat.Split(10)
+	checkpoints := func() []string {
+		files := make(map[string]string, 0)
+		for _, candidate := range db.BGCheckpointRunner.GetAllCheckpoints() {
+			if files[candidate.GetLocation().Name().String()] == "" {
+				files[candidate.GetLocation().Name().String()] = fmt.Sprintf("%s:%d",
+					candidate.GetLocation().String(), candidate.GetVersion())
+			}
+		}
+		locations := make([]string, 0)
+		for _, location := range files {
+			locations = append(locations, location)
+		}
+		return locations
+	}
+	backup := func(dir string, parent string) []*fileservice.DirEntry {
+		generalDir, backupTaeDir, err := setupBackupDir(ctx, false, dir, nil)
+		require.NoError(t, err)
+		cfg := &Config{
+			GeneralDir: generalDir,
+			Metas:      NewMetas(),
+		}
+		if parent != "" {
+			require.NoError(t, loadParent(ctx, &tree.BackupStart{Parent: parent}, cfg))
+		}
+		cfg.Metas.AppendVersion(Version)
+		cfg.Metas.AppendBuildinfo(buildInfo())
+		files, err := execBackup(ctx, db.Opts.Fs, fileservice.SubPath(backupTaeDir, taeDir), checkpoints(), cfg.ParentTaeFiles)
+		require.NoError(t, err)
+		require.NoError(t, saveTaeList(ctx, backupTaeDir, files))
+		require.NoError(t, saveMetas(ctx, cfg))
+		haData, err := (&pb.BackupData{NextID: 100}).Marshal()
+		require.NoError(t, err)
+		require.NoError(t, writeFile(ctx, fileservice.SubPath(backupTaeDir, hakeeperDir), HakeeperFile, haData))
+		return files
+	}
+
+	// full backup
+	for _, data := range bats[:5] {
+		txn, rel := db.GetRelation()
+		assert.NoError(t, rel.Append(context.Background(), data))
+		assert.NoError(t, txn.Commit(context.Background()))
+	}
+	db.ForceCheckpoint()
+	fullDir := t.TempDir()
+	fullFiles := backup(fullDir, "")
+
+	// incremental backup
+	for _, data := range bats[5:] {
+		txn, rel := db.GetRelation()
+		assert.NoError(t, rel.Append(context.Background(), data))
+		assert.NoError(t, txn.Commit(context.Background()))
+	}
+	db.ForceCheckpoint()
+	db.BGCheckpointRunner.DisableCheckpoint()
+	incDir := t.TempDir()
+	incFiles := backup(incDir, fullDir)
+	assert.Greater(t, len(incFiles), len(fullFiles))
+
+	// files in the parent are not copied again
+	generalDir, incTaeDir, err := setupBackupDir(ctx, false, incDir, nil)
+	require.NoError(t, err)
+	metas, err := loadMetas(ctx, generalDir)
+	require.NoError(t, err)
+	assert.Equal(t, fullDir, metas.Parent())
+	copied := make(map[string]*taeFile)
+	require.NoError(t, listTaeFiles(ctx, fileservice.SubPath(incTaeDir, taeDir), copied))
+	assert.Less(t, len(copied), len(incFiles))
+	for _, file := range fullFiles {
+		assert.Nil(t, copied[file.Name])
+	}
+
+	// restore walks the chain
+	sharedFs := newTestLocalFS(t, ctx)
+	cfg := &RestoreConfig{
+		Dir:        incDir,
+		SharedFs:   sharedFs,
+		HAKeeperFs: newTestLocalFS(t, ctx),
+	}
+	require.NoError(t, Restore(ctx, cfg))
+	db.Opts.Fs = sharedFs
+	db.Restart(ctx)
+	txn, rel := testutil.GetDefaultRelation(t, db.DB, schema.Name)
+	testutil.CheckAllColRowsByScan(t, rel, int(totalRows), true)
+	assert.NoError(t, txn.Commit(context.Background()))
+
+	// the parent is lost
+	require.NoError(t, os.RemoveAll(fullDir))
+	cfg = &RestoreConfig{
+		Dir:        incDir,
+		SharedFs:   newTestLocalFS(t, ctx),
+		HAKeeperFs: newTestLocalFS(t, ctx),
+	}
+	assert.Error(t, Restore(ctx, cfg))
+}
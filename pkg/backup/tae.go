@@ -15,7 +15,9 @@
 package backup
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
@@ -25,6 +27,7 @@ import (
 	pb "github.com/matrixorigin/matrixone/pkg/pb/ctl"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 	"path"
@@ -60,17 +63,20 @@ func getFileNames(ctx context.Context, retBytes [][][]byte) ([]string, error) {
 	return fileName, err
 }
 
-func BackupData(ctx context.Context, srcFs, dstFs fileservice.FileService, dir string) error {
+// BackupData copies the tae files of the latest checkpoints and the wal after them from srcFs to dstFs.
+// files in parentFiles are in the parent backup, and are not copied.
+// It returns all files needed by the backup, including the ones in the parent.
+func BackupData(ctx context.Context, srcFs, dstFs fileservice.FileService, parentFiles map[string]bool) ([]*fileservice.DirEntry, error) {
 	v, ok := runtime.ProcessLevelRuntime().GetGlobalVariables(runtime.InternalSQLExecutor)
 	if !ok {
-		return moerr.NewNotSupported(ctx, "no implement sqlExecutor")
+		return nil, moerr.NewNotSupported(ctx, "no implement sqlExecutor")
 	}
 	exec := v.(executor.SQLExecutor)
 	opts := executor.Options{}
 	sql := "select mo_ctl('dn','Backup','')"
 	res, err := exec.Exec(ctx, sql, opts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var retByts [][][]byte
@@ -81,35 +87,40 @@ func BackupData(ctx context.Context, srcFs, dstFs fileservice.FileService, dir s
 
 	fileName, err := getFileNames(ctx, retByts)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return execBackup(ctx, srcFs, dstFs, fileName)
+	return execBackup(ctx, srcFs, dstFs, fileName, parentFiles)
 }
 
-func execBackup(ctx context.Context, srcFs, dstFs fileservice.FileService, names []string) error {
+func execBackup(ctx context.Context, srcFs, dstFs fileservice.FileService, names []string, parentFiles map[string]bool) ([]*fileservice.DirEntry, error) {
 	files := make(map[string]*fileservice.DirEntry, 0)
 	table := gc.NewGCTable()
 	gcFileMap := make(map[string]string)
+	var walFiles []string
 	for _, name := range names {
 		if len(name) == 0 {
 			continue
 		}
+		if strings.HasPrefix(name, db.WalBackupDir+"/") {
+			walFiles = append(walFiles, name)
+			continue
+		}
 		ckpStr := strings.Split(name, ":")
 		if len(ckpStr) != 2 {
-			return moerr.NewInternalError(ctx, "invalid checkpoint string")
+			return nil, moerr.NewInternalError(ctx, "invalid checkpoint string")
 		}
 		metaLoc := ckpStr[0]
 		version, err := strconv.ParseUint(ckpStr[1], 10, 32)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		key, err := blockio.EncodeLocationFromString(metaLoc)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		locations, data, err := logtail.LoadCheckpointEntriesFromKey(ctx, srcFs, key, uint32(version))
 		if err != nil {
-			return err
+			return nil, err
 		}
 		table.UpdateTable(data)
 		gcFiles := table.SoftGC()
@@ -122,55 +133,89 @@ func execBackup(ctx context.Context, srcFs, dstFs fileservice.FileService, names
 						isGC(gcFileMap, location.Name().String()) {
 						continue
 					} else {
-						return err
+						return nil, err
 					}
 				}
 				files[location.Name().String()] = dentry
 			}
 		}
 	}
+	var taeFiles []*fileservice.DirEntry
 	for _, dentry := range files {
 		if dentry.IsDir {
 			panic("not support dir")
 		}
+		if parentFiles[dentry.Name] {
+			taeFiles = append(taeFiles, dentry)
+			continue
+		}
 		err := CopyFile(ctx, srcFs, dstFs, dentry, "")
 		if err != nil {
 			if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) &&
 				isGC(gcFileMap, dentry.Name) {
 				continue
 			} else {
-				return err
+				return nil, err
 			}
 
 		}
+		taeFiles = append(taeFiles, dentry)
 	}
 
-	err := CopyDir(ctx, srcFs, dstFs, "ckp")
-	if err != nil {
-		return err
+	for _, dir := range []string{ckpDir, gcDir} {
+		dirFiles, err := CopyDir(ctx, srcFs, dstFs, dir, parentFiles)
+		if err != nil {
+			return nil, err
+		}
+		taeFiles = append(taeFiles, dirFiles...)
 	}
-	err = CopyDir(ctx, srcFs, dstFs, "gc")
-	if err != nil {
-		return err
+
+	// the wal after the checkpoints is written by the dn for this backup only,
+	// and is removed from srcFs once copied
+	for _, name := range walFiles {
+		dentry, err := srcFs.StatFile(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		dentry.Name = path.Base(name)
+		if err = CopyFile(ctx, srcFs, dstFs, dentry, db.WalBackupDir); err != nil {
+			return nil, err
+		}
+		if err = srcFs.Delete(ctx, name); err != nil {
+			return nil, err
+		}
+		taeFiles = append(taeFiles, &fileservice.DirEntry{
+			Name: name,
+			Size: dentry.Size,
+		})
 	}
-	return nil
+	return taeFiles, nil
 }
 
-func CopyDir(ctx context.Context, srcFs, dstFs fileservice.FileService, dir string) error {
+// CopyDir copies files in dir which are not in parentFiles, and returns all files in dir
+func CopyDir(ctx context.Context, srcFs, dstFs fileservice.FileService, dir string, parentFiles map[string]bool) ([]*fileservice.DirEntry, error) {
 	files, err := srcFs.List(ctx, dir)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	for _, file := range files {
+	taeFiles := make([]*fileservice.DirEntry, 0, len(files))
+	for i := range files {
+		file := &files[i]
 		if file.IsDir {
 			panic("not support dir")
 		}
-		err = CopyFile(ctx, srcFs, dstFs, &file, dir)
-		if err != nil {
-			return err
+		name := path.Join(dir, file.Name)
+		if !parentFiles[name] {
+			if err = CopyFile(ctx, srcFs, dstFs, file, dir); err != nil {
+				return nil, err
+			}
 		}
+		taeFiles = append(taeFiles, &fileservice.DirEntry{
+			Name: name,
+			Size: file.Size,
+		})
 	}
-	return nil
+	return taeFiles, nil
 }
 
 func CopyFile(ctx context.Context, srcFs, dstFs fileservice.FileService, dentry *fileservice.DirEntry, dstDir string) error {
@@ -206,6 +251,46 @@ func CopyFile(ctx context.Context, srcFs, dstFs fileservice.FileService, dentry
 	return err
 }
 
+// saveTaeList saves the names and sizes of the tae files needed by the backup
+func saveTaeList(ctx context.Context, fs fileservice.FileService, files []*fileservice.DirEntry) error {
+	lines := make([][]string, 0, len(files))
+	for _, file := range files {
+		lines = append(lines, []string{file.Name, strconv.FormatInt(file.Size, 10)})
+	}
+	data, err := ToCsvLine2(lines)
+	if err != nil {
+		return err
+	}
+	return writeFile(ctx, fs, taeList, []byte(data))
+}
+
+// loadTaeList loads the list saved by saveTaeList
+func loadTaeList(ctx context.Context, fs fileservice.FileService) ([]*fileservice.DirEntry, error) {
+	data, err := readFile(ctx, fs, taeList)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*fileservice.DirEntry, 0, len(lines))
+	for _, line := range lines {
+		if len(line) != 2 {
+			return nil, moerr.NewInternalError(ctx, "invalid tae list line: %v", line)
+		}
+		size, err := strconv.ParseInt(line[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &fileservice.DirEntry{
+			Name: line[0],
+			Size: size,
+		})
+	}
+	return files, nil
+}
+
 func mergeGCFile(gcFiles []string, gcFileMap map[string]string) {
 	for _, gcFile := range gcFiles {
 		if gcFileMap[gcFile] == "" {
@@ -33,6 +33,8 @@ const (
 	taeDir       = "tae"
 	hakeeperDir  = "hakeeper"
 	HakeeperFile = "hk_data"
+	// taeList lists all tae files needed by the backup, including the ones in the parents
+	taeList = "tae_list"
 )
 
 // Format :type,subtype,filename or dirname
@@ -51,12 +53,14 @@ const (
 	    Version   | Version
 	    Buildinfo | Buildinfo
 	              | Launchconfig
+	              | Parent
 	              | Tae
 	              | Hakeeper
 	*/
 	TypeVersion MetaType = iota
 	TypeBuildinfo
 	TypeLaunchconfig
+	TypeParent
 )
 
 func (t MetaType) String() string {
@@ -67,6 +71,8 @@ func (t MetaType) String() string {
 		return "buildinfo"
 	case TypeLaunchconfig:
 		return "launchconfig"
+	case TypeParent:
+		return "parent"
 	default:
 		return fmt.Sprintf("invalid type %d", t)
 	}
@@ -86,6 +92,9 @@ type Meta struct {
 
 	//launch config
 	LaunchConfigFile string
+
+	//parent backup of the incremental backup
+	Parent string
 }
 
 func (m *Meta) String() string {
@@ -103,6 +112,8 @@ func (m *Meta) CsvString() []string {
 		format[SubTypePos] = m.Buildinfo
 	case TypeLaunchconfig:
 		format[FileNameOrDirNamePos] = m.LaunchConfigFile
+	case TypeParent:
+		format[FileNameOrDirNamePos] = m.Parent
 	}
 	return format
 }
@@ -141,6 +152,23 @@ func (m *Metas) AppendLaunchconfig(subTyp, file string) {
 	})
 }
 
+func (m *Metas) AppendParent(parent string) {
+	m.Append(&Meta{
+		Typ:    TypeParent,
+		Parent: parent,
+	})
+}
+
+// Parent returns the parent backup, or empty if it is a full backup
+func (m *Metas) Parent() string {
+	for _, meta := range m.metas {
+		if meta.Typ == TypeParent {
+			return meta.Parent
+		}
+	}
+	return ""
+}
+
 func (m *Metas) orderTypes() []int {
 	idx := make([]int, 0, len(m.metas))
 	for i := range m.metas {
@@ -201,6 +229,39 @@ type Config struct {
 	HAkeeper logservice.CNHAKeeperClient
 
 	Metas *Metas
+
+	// For incremental backup, tae files already in the parent backups
+	ParentTaeFiles map[string]bool
+}
+
+// RestoreConfig is the config of Restore
+type RestoreConfig struct {
+	// Timestamp restores to the state committed at it. restores to the end of
+	// the wal in the backup if empty
+	Timestamp types.TS
+
+	// Dir is the backup directory, or the file path in the bucket if IsS3
+	Dir    string
+	IsS3   bool
+	Option []string
+
+	// For General usage, setup by Dir if nil
+	GeneralDir fileservice.FileService
+
+	// For tae and hakeeper in the backup, setup by Dir if nil
+	TaeDir fileservice.FileService
+
+	// For locating tae's storage fs to restore into
+	SharedFs fileservice.FileService
+
+	// For the restored hakeeper data
+	HAKeeperFs fileservice.FileService
+
+	Metas *Metas
+
+	// RestoredTS is the timestamp restored to, it is the commit ts of the last
+	// replayed txn, or the end of the checkpoint if no txn is replayed
+	RestoredTS types.TS
 }
 
 type s3Config struct {
@@ -426,7 +426,7 @@ func GenRows(bat *batch.Batch) [][]any {
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = col[j]
 			}
-		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_json, types.T_text:
+		case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_json, types.T_text, types.T_array_float32, types.T_array_float64:
 			for j := 0; j < vec.Length(); j++ {
 				rows[j][i] = vec.GetBytesAt(j)
 			}
@@ -448,6 +448,52 @@ func isTruncate(name string) (uint64, string, bool) {
 	return id, name[len(str)+Meta_Length:], true
 }
 
+// GetCompression returns the algorithm and the level used to compress the
+// column data of a table with the given properties. It is lz4 if the table
+// has no COMPRESSION option.
+func GetCompression(props []*plan.Property) (compress.T, int) {
+	for _, p := range props {
+		if p.Key != PropCompression {
+			continue
+		}
+		// the option is validated when the table is created
+		if alg, level, err := compress.ParseAlgorithm(p.Value); err == nil {
+			return alg, level
+		}
+	}
+	return compress.Lz4, 0
+}
+
+// GetTableDefCompression is GetCompression for the properties of tableDef
+func GetTableDefCompression(tableDef *plan.TableDef) (compress.T, int) {
+	var props []*plan.Property
+	for _, def := range tableDef.Defs {
+		if v, ok := def.Def.(*plan.TableDef_DefType_Properties); ok {
+			props = append(props, v.Properties.Properties...)
+		}
+	}
+	return GetCompression(props)
+}
+
+// GetCompressionFromConstraint is GetCompression for the serialized
+// constraint of a table, which carries the table properties.
+func GetCompressionFromConstraint(data []byte) (compress.T, int) {
+	if len(data) == 0 {
+		return compress.Lz4, 0
+	}
+	c := new(engine.ConstraintDef)
+	if err := c.UnmarshalBinary(data); err != nil {
+		return compress.Lz4, 0
+	}
+	var props []*plan.Property
+	for _, ct := range c.Cts {
+		if def, ok := ct.(*engine.StreamConfigsDef); ok {
+			props = append(props, def.Configs...)
+		}
+	}
+	return GetCompression(props)
+}
+
 func BuildQueryResultPath(accountName, statementId string, blockIdx int) string {
 	return fmt.Sprintf(QueryResultPath, accountName, statementId, blockIdx)
 }
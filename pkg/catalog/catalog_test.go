@@ -14,8 +14,36 @@
 
 package catalog
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
 
 func TestGenBlockMeta(t *testing.T) {
 	_ = GenBlockInfo(nil)
 }
+
+func TestGetCompression(t *testing.T) {
+	alg, level := GetCompressionFromConstraint(nil)
+	require.Equal(t, compress.T(compress.Lz4), alg)
+	require.Equal(t, 0, level)
+
+	c := &engine.ConstraintDef{
+		Cts: []engine.Constraint{
+			&engine.StreamConfigsDef{Configs: []*plan.Property{{Key: SystemRelAttr_Comment, Value: "t"}}},
+			&engine.StreamConfigsDef{Configs: []*plan.Property{{Key: PropCompression, Value: "zstd:9"}}},
+		},
+	}
+	data, err := c.MarshalBinary()
+	require.NoError(t, err)
+	alg, level = GetCompressionFromConstraint(data)
+	require.Equal(t, compress.T(compress.Zstd), alg)
+	require.Equal(t, 9, level)
+
+	alg, _ = GetCompression([]*plan.Property{{Key: PropCompression, Value: "snappy"}})
+	require.Equal(t, compress.T(compress.Snappy), alg)
+}
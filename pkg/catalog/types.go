@@ -40,21 +40,55 @@ const (
 	// IndexTable has two column at most, the first is idx col, the second is origin table primary col
 	IndexTableIndexColName   = "__mo_index_idx_col"
 	IndexTablePrimaryColName = "__mo_index_pri_col"
-	ExternalFilePath         = "__mo_filepath"
-	IndexTableNamePrefix     = "__mo_index_unique__"
+	// The hidden table of a fulltext index has a row for each token of the indexed columns, which
+	// is the word, the primary key of the origin table, the position of the token and the number
+	// of tokens of the row. The word, the primary key and the position make up the primary key.
+	FullTextIndexWordColName   = "__mo_index_word"
+	FullTextIndexDocIdColName  = "__mo_index_doc_id"
+	FullTextIndexPosColName    = "__mo_index_pos"
+	FullTextIndexDocLenColName = "__mo_index_doc_len"
+	// An ivfflat index has two hidden tables, the centroids table has the id and the vector of
+	// each centroid, and the entries table has the id of the nearest centroid of each row, and
+	// the primary key of the row in IndexTablePrimaryColName.
+	IvfFlatIndexCentroidIdColName = "__mo_index_centroid_id"
+	IvfFlatIndexCentroidColName   = "__mo_index_centroid"
+	ExternalFilePath              = "__mo_filepath"
+	IndexTableNamePrefix          = "__mo_index_unique__"
+	// MoIndexFullTextAlgo is the IndexAlgo of a fulltext index
+	MoIndexFullTextAlgo = "fulltext"
+	// MoIndexIvfFlatAlgo is the IndexAlgo of an ivfflat index, which has an IndexDef for each of
+	// its hidden tables, the IndexAlgoTableType tells which one it is.
+	MoIndexIvfFlatAlgo        = "ivfflat"
+	IvfFlatIndexCentroidsType = "centroids"
+	IvfFlatIndexEntriesType   = "entries"
 	// MOAutoIncrTable mo auto increment table name
 	MOAutoIncrTable = "mo_increment_columns"
+	// A materialized view which can be refreshed incrementally has the number of the rows of
+	// each group in MViewCountColName, and the number of the not null values of each SUM in
+	// MViewNotNullColPrefix followed by the position of the SUM, so a group is removed when
+	// it has no rows, and a SUM becomes NULL when it has no not null values.
+	MViewCountColName     = "__mo_mv_count"
+	MViewNotNullColPrefix = "__mo_mv_nn_"
+	// TableChangesSignColName is the column of mo_table_changes which is 1 for the inserted
+	// rows and -1 for the deleted rows.
+	TableChangesSignColName = "__mo_sign"
 )
 
 var InternalColumns = map[string]int8{
-	Row_ID:                   0,
-	PrefixPriColName:         0,
-	PrefixCBColName:          0,
-	PrefixIndexTableName:     0,
-	CPrimaryKeyColName:       0,
-	FakePrimaryKeyColName:    0,
-	IndexTableIndexColName:   0,
-	IndexTablePrimaryColName: 0,
+	Row_ID:                        0,
+	PrefixPriColName:              0,
+	PrefixCBColName:               0,
+	PrefixIndexTableName:          0,
+	CPrimaryKeyColName:            0,
+	FakePrimaryKeyColName:         0,
+	IndexTableIndexColName:        0,
+	IndexTablePrimaryColName:      0,
+	FullTextIndexWordColName:      0,
+	FullTextIndexDocIdColName:     0,
+	FullTextIndexPosColName:       0,
+	FullTextIndexDocLenColName:    0,
+	IvfFlatIndexCentroidIdColName: 0,
+	IvfFlatIndexCentroidColName:   0,
 }
 
 var InternalTableNames = map[string]int8{
@@ -90,6 +124,12 @@ const (
 	// MO_TABLE_PARTITIONS Data dictionary table of record table partition
 	MO_TABLE_PARTITIONS = "mo_table_partitions"
 
+	// MO_TRIGGERS Data dictionary table of the triggers
+	MO_TRIGGERS = "mo_triggers"
+
+	// MO_MVIEWS Data dictionary table of the materialized views
+	MO_MVIEWS = "mo_mviews"
+
 	// MOTaskDB mo task db name
 	MOTaskDB = "mo_task"
 )
@@ -140,6 +180,9 @@ const (
 	SystemRelAttr_Version        = "rel_version"
 	SystemRelAttr_CatalogVersion = "catalog_version"
 
+	// the table property set by the COMPRESSION table option, such as "zstd:9"
+	PropCompression = "compression"
+
 	// 'mo_columns' table
 	SystemColAttr_UniqName        = "att_uniq_name"
 	SystemColAttr_AccID           = "account_id"
@@ -131,6 +131,7 @@ func NewService(
 	srv.pu.LockService = srv.lockService
 	srv.pu.HAKeeperClient = srv._hakeeperClient
 	srv.pu.QueryService = srv.queryService
+	srv.pu.TaskService = srv.GetTaskService
 
 	if err = srv.initMOServer(ctx, pu, srv.aicm); err != nil {
 		return nil, err
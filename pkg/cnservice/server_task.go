@@ -292,4 +292,10 @@ func (s *service) registerExecutorsLocked() {
 	// init metric task
 	s.task.runner.RegisterExecutor(task.TaskCode_MetricStorageUsage,
 		mometric.GetMetricStorageUsageExecutor(ieFactory))
+	// init user defined event executor
+	s.task.runner.RegisterExecutor(task.TaskCode_UserDefinedEvent,
+		frontend.GetUserDefinedEventExecutor(ieFactory, ts))
+	// init materialized view refresh executor
+	s.task.runner.RegisterExecutor(task.TaskCode_MViewRefresh,
+		frontend.GetMViewRefreshExecutor(ieFactory, ts))
 }
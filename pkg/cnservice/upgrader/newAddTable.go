@@ -41,9 +41,67 @@ var (
     		  PRIMARY KEY table_id (table_id, name)
 			);`, catalog.MO_CATALOG, catalog.MO_TABLE_PARTITIONS),
 	}
+
+	// mo_event_history;
+	MoEventHistoryTable = &table.Table{
+		Account:  table.AccountAll,
+		Database: catalog.MO_CATALOG,
+		Table:    "mo_event_history",
+		CreateTableSql: fmt.Sprintf(`CREATE TABLE %s.%s (
+			  event_name varchar(64),
+			  event_db varchar(5000),
+			  start_time timestamp,
+			  end_time timestamp,
+			  duration bigint,
+			  status varchar(32),
+			  error text
+			);`, catalog.MO_CATALOG, "mo_event_history"),
+	}
+
+	// mo_triggers;
+	MoTriggersTable = &table.Table{
+		Account:  table.AccountAll,
+		Database: catalog.MO_CATALOG,
+		Table:    catalog.MO_TRIGGERS,
+		CreateTableSql: fmt.Sprintf(`CREATE TABLE %s.%s (
+			  trigger_name varchar(64),
+			  trigger_db varchar(5000),
+			  table_name varchar(5000),
+			  action_timing varchar(10),
+			  event_manipulation varchar(10),
+			  action_order int unsigned,
+			  action_statement text,
+			  definer varchar(300),
+			  created_time timestamp,
+			  PRIMARY KEY (trigger_db, trigger_name)
+			);`, catalog.MO_CATALOG, catalog.MO_TRIGGERS),
+	}
+
+	// mo_mviews;
+	MoMViewsTable = &table.Table{
+		Account:  table.AccountAll,
+		Database: catalog.MO_CATALOG,
+		Table:    catalog.MO_MVIEWS,
+		CreateTableSql: fmt.Sprintf(`CREATE TABLE %s.%s (
+			  database_name varchar(5000),
+			  mview_name varchar(5000),
+			  definition text,
+			  default_database varchar(5000),
+			  base_database varchar(5000),
+			  base_table varchar(5000),
+			  incremental bool,
+			  refresh_interval varchar(64),
+			  last_refresh_ts varchar(64),
+			  last_refresh_time timestamp,
+			  definer varchar(300),
+			  created_time timestamp,
+			  task_version bigint,
+			  PRIMARY KEY (database_name, mview_name)
+			);`, catalog.MO_CATALOG, catalog.MO_MVIEWS),
+	}
 )
 
-var needUpgradNewTable = []*table.Table{MoTablePartitionsTable}
+var needUpgradNewTable = []*table.Table{MoTablePartitionsTable, MoEventHistoryTable, MoTriggersTable, MoMViewsTable}
 
 var PARTITIONSView = &table.Table{
 	Account:  table.AccountAll,
@@ -91,4 +149,20 @@ var PARTITIONSView = &table.Table{
 		"WHERE `tbl`.`partitioned` = 1;",
 }
 
-var needUpgradNewView = []*table.Table{PARTITIONSView}
+var EVENTSHISTORYView = &table.Table{
+	Account:  table.AccountAll,
+	Database: sysview.InformationDBConst,
+	Table:    "EVENTS_HISTORY",
+	CreateViewSql: "CREATE VIEW IF NOT EXISTS `information_schema`.`EVENTS_HISTORY` AS " +
+		"SELECT 'def' AS `EVENT_CATALOG`," +
+		"`event_db` AS `EVENT_SCHEMA`," +
+		"`event_name` AS `EVENT_NAME`," +
+		"`start_time` AS `START_TIME`," +
+		"`end_time` AS `END_TIME`," +
+		"`duration` AS `DURATION`," +
+		"`status` AS `STATUS`," +
+		"`error` AS `ERROR` " +
+		"FROM `mo_catalog`.`mo_event_history`;",
+}
+
+var needUpgradNewView = []*table.Table{PARTITIONSView, EVENTSHISTORYView}
@@ -34,6 +34,27 @@ func NewJoinMap(sels [][]int32, expr *plan.Expr, ihm *IntHashMap, shm *StrHashMa
 	}
 }
 
+// NewSpilledJoinMap returns the join map of a build side spilled to disk.
+func NewSpilledJoinMap(spilled any, isDup bool) *JoinMap {
+	cnt := int64(1)
+	return &JoinMap{
+		cnt:     &cnt,
+		dupCnt:  new(int64),
+		spilled: spilled,
+		isDup:   isDup,
+	}
+}
+
+// Spilled returns the spilled build side, it is nil if the join map has a hash table.
+func (jm *JoinMap) Spilled() any {
+	return jm.spilled
+}
+
+// SetRelease sets the function called once the last reference of the join map is freed.
+func (jm *JoinMap) SetRelease(release func()) {
+	jm.release = release
+}
+
 func (jm *JoinMap) Sels() [][]int32 {
 	return jm.multiSels
 }
@@ -69,6 +90,13 @@ func (jm *JoinMap) NewIterator() Iterator {
 }
 
 func (jm *JoinMap) Dup() *JoinMap {
+	if jm.spilled != nil {
+		return &JoinMap{
+			cnt:     jm.cnt,
+			spilled: jm.spilled,
+			release: jm.release,
+		}
+	}
 	if jm.shm == nil {
 		m0 := &IntHashMap{
 			m:       jm.ihm.m,
@@ -88,6 +116,7 @@ func (jm *JoinMap) Dup() *JoinMap {
 			multiSels: jm.multiSels,
 			hasNull:   jm.hasNull,
 			cnt:       jm.cnt,
+			release:   jm.release,
 		}
 		if atomic.AddInt64(jm.dupCnt, -1) == 0 {
 			jm.ihm = nil
@@ -112,6 +141,7 @@ func (jm *JoinMap) Dup() *JoinMap {
 			multiSels: jm.multiSels,
 			hasNull:   jm.hasNull,
 			cnt:       jm.cnt,
+			release:   jm.release,
 		}
 		if atomic.AddInt64(jm.dupCnt, -1) == 0 {
 			jm.shm = nil
@@ -139,9 +169,12 @@ func (jm *JoinMap) Free() {
 	jm.multiSels = nil
 	if jm.ihm != nil {
 		jm.ihm.Free()
-	} else {
+	} else if jm.shm != nil {
 		jm.shm.Free()
 	}
+	if jm.release != nil {
+		jm.release()
+	}
 }
 
 func (jm *JoinMap) Size() int64 {
@@ -75,6 +75,10 @@ type JoinMap struct {
 	hasNull bool
 
 	isDup bool
+	// spilled is the build side spilled to disk, a spilled join map has no hash table.
+	spilled any
+	// release is called once the last reference of the join map is freed.
+	release func()
 }
 
 // StrHashMap key is []byte, value is an uint64 value (starting from 1)
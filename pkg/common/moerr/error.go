@@ -78,6 +78,10 @@ const (
 	ErrWrongValueCountOnRow uint16 = 20308
 	ErrBadFieldError        uint16 = 20309
 	ErrWrongDatetimeSpec    uint16 = 20310
+	ErrCheckViolated        uint16 = 20311
+	ErrCheckDupName         uint16 = 20312
+	ErrCheckNotFound        uint16 = 20313
+	ErrDependentByCheck     uint16 = 20314
 
 	// Group 4: unexpected state and io errors
 	ErrInvalidState                             uint16 = 20400
@@ -203,6 +207,7 @@ const (
 	ErrTAENeedRetry               uint16 = 20629
 	ErrTxnCannotRetry             uint16 = 20630
 	ErrTxnNeedRetryWithDefChanged uint16 = 20631
+	ErrTableChangesUnavailable    uint16 = 20632
 
 	// Group 7: lock service
 	// ErrDeadLockDetected lockservice has detected a deadlock and should abort the transaction if it receives this error
@@ -234,6 +239,13 @@ const (
 	ErrPartitionMaxvalue                   uint16 = 20817
 	ErrRangeNotIncreasing                  uint16 = 20818
 	ErrCheckRecursiveLevel                 uint16 = 20819
+	ErrPartitionMgmtOnNonpartitioned       uint16 = 20820
+	ErrDropPartitionNonExistent            uint16 = 20821
+	ErrDropLastPartition                   uint16 = 20822
+	ErrOnlyOnRangeListPartition            uint16 = 20823
+	ErrPartitionExchangePartTable          uint16 = 20824
+	ErrTablesDifferentMetadata             uint16 = 20825
+	ErrRowDoesNotMatchPartition            uint16 = 20826
 
 	// ErrEnd, the max value of MOErrorCode
 	ErrEnd uint16 = 65535
@@ -284,6 +296,10 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrWrongValueCountOnRow: {ER_WRONG_VALUE_COUNT_ON_ROW, []string{MySQLDefaultSqlState}, "Column count doesn't match value count at row %d"},
 	ErrBadFieldError:        {ER_BAD_FIELD_ERROR, []string{MySQLDefaultSqlState}, "Unknown column '%s' in '%s'"},
 	ErrWrongDatetimeSpec:    {ER_WRONG_DATETIME_SPEC, []string{MySQLDefaultSqlState}, "wrong date/time format specifier: %s"},
+	ErrCheckViolated:        {ER_CHECK_CONSTRAINT_VIOLATED, []string{MySQLDefaultSqlState}, "Check constraint '%s' is violated."},
+	ErrCheckDupName:         {ER_CHECK_CONSTRAINT_DUP_NAME, []string{MySQLDefaultSqlState}, "Duplicate check constraint name '%s'."},
+	ErrCheckNotFound:        {ER_CHECK_CONSTRAINT_NOT_FOUND, []string{MySQLDefaultSqlState}, "Check constraint '%s' is not found in the table."},
+	ErrDependentByCheck:     {ER_DEPENDENT_BY_CHECK_CONSTRAINT, []string{MySQLDefaultSqlState}, "Check constraint '%s' uses column '%s', hence column cannot be dropped or renamed."},
 
 	// Group 4: unexpected state or file io error
 	ErrInvalidState:                             {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "invalid state %s"},
@@ -396,6 +412,7 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrTAENeedRetry:               {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "tae need retry"},
 	ErrTxnCannotRetry:             {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "txn s3 writes can not retry in rc mode"},
 	ErrTxnNeedRetryWithDefChanged: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "txn need retry in rc mode, def changed"},
+	ErrTableChangesUnavailable:    {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "the changes of table '%s' after %s are not available"},
 
 	// Group 7: lock service
 	ErrDeadLockDetected:     {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "deadlock detected"},
@@ -423,6 +440,13 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrPartitionMaxvalue:                   {ER_PARTITION_MAXVALUE_ERROR, []string{MySQLDefaultSqlState}, "MAXVALUE can only be used in last partition definition"},
 	ErrRangeNotIncreasing:                  {ER_RANGE_NOT_INCREASING_ERROR, []string{MySQLDefaultSqlState}, "VALUES LESS THAN value must be strictly increasing for each partition"},
 	ErrCheckRecursiveLevel:                 {ErrCheckRecursiveLevel, []string{MySQLDefaultSqlState}, "recursive level out of range"},
+	ErrPartitionMgmtOnNonpartitioned:       {ER_PARTITION_MGMT_ON_NONPARTITIONED, []string{MySQLDefaultSqlState}, "Partition management on a not partitioned table is not possible"},
+	ErrDropPartitionNonExistent:            {ER_DROP_PARTITION_NON_EXISTENT, []string{MySQLDefaultSqlState}, "Error in list of partitions to %-.64s"},
+	ErrDropLastPartition:                   {ER_DROP_LAST_PARTITION, []string{MySQLDefaultSqlState}, "Cannot remove all partitions, use DROP TABLE instead"},
+	ErrOnlyOnRangeListPartition:            {ER_ONLY_ON_RANGE_LIST_PARTITION, []string{MySQLDefaultSqlState}, "%-.64s PARTITION can only be used on RANGE/LIST partitions"},
+	ErrPartitionExchangePartTable:          {ER_PARTITION_EXCHANGE_PART_TABLE, []string{MySQLDefaultSqlState}, "Table to exchange with partition is partitioned: '%-.64s'"},
+	ErrTablesDifferentMetadata:             {ER_TABLES_DIFFERENT_METADATA, []string{MySQLDefaultSqlState}, "Tables have different definitions"},
+	ErrRowDoesNotMatchPartition:            {ER_ROW_DOES_NOT_MATCH_PARTITION, []string{MySQLDefaultSqlState}, "Found a row that does not match the partition"},
 
 	// Group End: max value of MOErrorCode
 	ErrEnd: {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "internal error: end of errcode code"},
@@ -996,6 +1020,22 @@ func NewWrongDatetimeSpec(ctx context.Context, val string) *Error {
 	return newError(ctx, ErrWrongDatetimeSpec, val)
 }
 
+func NewCheckViolated(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCheckViolated, name)
+}
+
+func NewCheckDupName(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCheckDupName, name)
+}
+
+func NewCheckNotFound(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrCheckNotFound, name)
+}
+
+func NewDependentByCheck(ctx context.Context, name, column string) *Error {
+	return newError(ctx, ErrDependentByCheck, name, column)
+}
+
 func NewRoleGrantedToSelf(ctx context.Context, from, to string) *Error {
 	return newError(ctx, ErrRoleGrantedToSelf, from, to)
 }
@@ -1033,6 +1073,10 @@ func NewTxnNeedRetryWithDefChanged(ctx context.Context) *Error {
 	return newError(ctx, ErrTxnNeedRetryWithDefChanged)
 }
 
+func NewTableChangesUnavailable(ctx context.Context, table string, ts string) *Error {
+	return newError(ctx, ErrTableChangesUnavailable, table, ts)
+}
+
 func NewTxnCannotRetry(ctx context.Context) *Error {
 	return newError(ctx, ErrTxnCannotRetry)
 }
@@ -1131,6 +1175,34 @@ func NewCheckRecursiveLevel(ctx context.Context) *Error {
 	return newError(ctx, ErrCheckRecursiveLevel)
 }
 
+func NewPartitionMgmtOnNonpartitioned(ctx context.Context) *Error {
+	return newError(ctx, ErrPartitionMgmtOnNonpartitioned)
+}
+
+func NewDropPartitionNonExistent(ctx context.Context, op string) *Error {
+	return newError(ctx, ErrDropPartitionNonExistent, op)
+}
+
+func NewDropLastPartition(ctx context.Context) *Error {
+	return newError(ctx, ErrDropLastPartition)
+}
+
+func NewOnlyOnRangeListPartition(ctx context.Context, op string) *Error {
+	return newError(ctx, ErrOnlyOnRangeListPartition, op)
+}
+
+func NewPartitionExchangePartTable(ctx context.Context, name string) *Error {
+	return newError(ctx, ErrPartitionExchangePartTable, name)
+}
+
+func NewTablesDifferentMetadata(ctx context.Context) *Error {
+	return newError(ctx, ErrTablesDifferentMetadata)
+}
+
+func NewRowDoesNotMatchPartition(ctx context.Context) *Error {
+	return newError(ctx, ErrRowDoesNotMatchPartition)
+}
+
 func NewErrTooManyFields(ctx context.Context) *Error {
 	return newError(ctx, ErrTooManyFields)
 }
@@ -136,7 +136,7 @@ func New(typ types.Type, desc, nullsLast bool) Compare {
 		}
 		return newCompare(genericAscCompare[types.Enum], genericCopy[types.Enum], nullsLast)
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_json, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_json, types.T_text, types.T_array_float32, types.T_array_float64:
 		return &strCompare{
 			desc:        desc,
 			nullsLast:   nullsLast,
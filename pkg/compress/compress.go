@@ -15,15 +15,109 @@
 package compress
 
 import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/pierrec/lz4/v4"
 )
 
 var Algorithms map[string]int = map[string]int{
-	"lz4":  Lz4,
-	"none": None,
+	"lz4":    Lz4,
+	"none":   None,
+	"zstd":   Zstd,
+	"snappy": Snappy,
+}
+
+const (
+	// DefaultZstdLevel is the level used when no level is given
+	DefaultZstdLevel = 3
+	MinZstdLevel     = 1
+	MaxZstdLevel     = 22
+)
+
+// zstd encoders and decoders are expensive to create but safe for
+// concurrent use by EncodeAll/DecodeAll, so they are shared.
+var (
+	zstdEncoders [zstd.SpeedBestCompression + 1]struct {
+		once sync.Once
+		enc  *zstd.Encoder
+		err  error
+	}
+	zstdDecoderOnce sync.Once
+	zstdDecoder     *zstd.Decoder
+	zstdDecoderErr  error
+)
+
+func getZstdEncoder(level int) (*zstd.Encoder, error) {
+	if level == 0 {
+		level = DefaultZstdLevel
+	}
+	l := zstd.EncoderLevelFromZstd(level)
+	e := &zstdEncoders[l]
+	e.once.Do(func() {
+		e.enc, e.err = zstd.NewWriter(nil, zstd.WithEncoderLevel(l), zstd.WithEncoderConcurrency(1))
+	})
+	return e.enc, e.err
+}
+
+func getZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecoderOnce.Do(func() {
+		zstdDecoder, zstdDecoderErr = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+	})
+	return zstdDecoder, zstdDecoderErr
+}
+
+// ParseAlgorithm parses a compression option such as "lz4", "snappy",
+// "zstd" or "zstd:<level>". The returned level is 0 when not given.
+func ParseAlgorithm(s string) (T, int, error) {
+	name, levelStr, hasLevel := strings.Cut(strings.ToLower(strings.TrimSpace(s)), ":")
+	typ, ok := Algorithms[name]
+	if !ok {
+		return None, 0, moerr.NewNotSupportedNoCtx("compression algorithm '%s'", s)
+	}
+	if !hasLevel {
+		return T(typ), 0, nil
+	}
+	if typ != Zstd {
+		return None, 0, moerr.NewNotSupportedNoCtx("compression level for '%s'", name)
+	}
+	level, err := strconv.Atoi(levelStr)
+	if err != nil || level < MinZstdLevel || level > MaxZstdLevel {
+		return None, 0, moerr.NewInvalidInputNoCtx("zstd compression level should be in [%d, %d], got '%s'",
+			MinZstdLevel, MaxZstdLevel, levelStr)
+	}
+	return T(typ), level, nil
+}
+
+// CompressBound returns the max size of n bytes compressed by typ
+func CompressBound(typ int, n int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(n)
+	case Zstd:
+		// see ZSTD_COMPRESSBOUND
+		bound := n + n>>8
+		if n < 128<<10 {
+			bound += (128<<10 - n) >> 11
+		}
+		return bound
+	case Snappy:
+		return snappy.MaxEncodedLen(n)
+	}
+	return n
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
+	return CompressLevel(src, dst, typ, 0)
+}
+
+// CompressLevel is like Compress, the level is only used by zstd and 0 means
+// the default level.
+func CompressLevel(src, dst []byte, typ int, level int) ([]byte, error) {
 	switch typ {
 	case Lz4:
 		n, err := lz4.CompressBlock(src, dst, nil)
@@ -31,10 +125,20 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		enc, err := getZstdEncoder(level)
+		if err != nil {
+			return nil, err
+		}
+		return enc.EncodeAll(src, dst[:0]), nil
+	case Snappy:
+		return snappy.Encode(dst[:cap(dst)], src), nil
 	}
 	return nil, nil
 }
 
+// Decompress decompresses src into dst, dst should be large enough to hold
+// the decompressed data.
 func Decompress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
 	case Lz4:
@@ -43,6 +147,34 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		dec, err := getZstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		bs, err := dec.DecodeAll(src, dst[:0])
+		if err != nil {
+			return nil, err
+		}
+		return copyInto(bs, dst)
+	case Snappy:
+		bs, err := snappy.Decode(dst[:cap(dst)], src)
+		if err != nil {
+			return nil, err
+		}
+		return copyInto(bs, dst)
 	}
 	return nil, nil
 }
+
+// copyInto makes sure the decompressed data lives in dst, the caller owns
+// the memory of dst.
+func copyInto(bs, dst []byte) ([]byte, error) {
+	if len(bs) > len(dst) {
+		return nil, moerr.NewInternalErrorNoCtx("decompressed size %d exceeds buffer size %d", len(bs), len(dst))
+	}
+	if len(bs) > 0 && &bs[0] != &dst[0] {
+		copy(dst, bs)
+	}
+	return dst[:len(bs)], nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLz4(t *testing.T) {
@@ -41,3 +42,54 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestCompressAlgorithms(t *testing.T) {
+	xs := make([]int64, 8192)
+	for i := range xs {
+		xs[i] = int64(i % 100)
+	}
+	raw := types.EncodeSlice(xs)
+	for _, c := range []struct {
+		typ   int
+		level int
+	}{
+		{Lz4, 0}, {Zstd, 0}, {Zstd, 1}, {Zstd, 19}, {Snappy, 0},
+	} {
+		buf := make([]byte, CompressBound(c.typ, len(raw)))
+		data, err := CompressLevel(raw, buf, c.typ, c.level)
+		require.NoError(t, err)
+		require.Less(t, len(data), len(raw))
+		dst := make([]byte, len(raw))
+		out, err := Decompress(data, dst, c.typ)
+		require.NoError(t, err)
+		require.Equal(t, raw, out)
+		require.Equal(t, &dst[0], &out[0])
+
+		// the buffer is too small to hold the decompressed data
+		if c.typ != Lz4 {
+			_, err = Decompress(data, make([]byte, len(raw)/2), c.typ)
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestParseAlgorithm(t *testing.T) {
+	typ, level, err := ParseAlgorithm("ZSTD")
+	require.NoError(t, err)
+	require.Equal(t, T(Zstd), typ)
+	require.Equal(t, 0, level)
+	typ, level, err = ParseAlgorithm("zstd:19")
+	require.NoError(t, err)
+	require.Equal(t, T(Zstd), typ)
+	require.Equal(t, 19, level)
+	typ, _, err = ParseAlgorithm("snappy")
+	require.NoError(t, err)
+	require.Equal(t, "SNAPPY", typ.String())
+
+	_, _, err = ParseAlgorithm("gzip")
+	require.Error(t, err)
+	_, _, err = ParseAlgorithm("zstd:23")
+	require.Error(t, err)
+	_, _, err = ParseAlgorithm("lz4:1")
+	require.Error(t, err)
+}
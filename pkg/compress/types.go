@@ -19,6 +19,8 @@ import "fmt"
 const (
 	None = iota
 	Lz4
+	Zstd
+	Snappy
 )
 
 type T uint8
@@ -29,6 +31,10 @@ func (t T) String() string {
 		return "None"
 	case Lz4:
 		return "LZ4"
+	case Zstd:
+		return "ZSTD"
+	case Snappy:
+		return "SNAPPY"
 	}
 	return fmt.Sprintf("unexpected compress type: %d", t)
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/queryservice"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/util/toml"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -47,6 +48,9 @@ var (
 	//listening unix domain socket
 	defaultUnixAddr = "/tmp/mysql.sock"
 
+	//the authentication method announced in the handshake
+	defaultAuthPlugin = "mysql_native_password"
+
 	//guest mmu limitation.  1 << 40 = 1099511627776
 	defaultGuestMmuLimitation = 1099511627776
 
@@ -164,6 +168,9 @@ type FrontendParameters struct {
 	// UnixSocketAddress listening unix domain socket
 	UnixSocketAddress string `toml:"unix-socket"`
 
+	//pgPort defines which port the postgresql protocol listens on. default: 0, the postgresql protocol is disabled
+	PgPort int64 `toml:"pgPort"`
+
 	//guest mmu limitation. default: 1 << 40 = 1099511627776
 	GuestMmuLimitation int64 `toml:"guestMmuLimitation"`
 
@@ -224,6 +231,14 @@ type FrontendParameters struct {
 	//default is ''. Path of file that contains X509 key in PEM format for client
 	TlsKeyFile string `toml:"tlsKeyFile"`
 
+	//default is mysql_native_password. The authentication method announced in the handshake.
+	//The client using the other method is asked to switch to it.
+	DefaultAuthPlugin string `toml:"defaultAuthPlugin"`
+
+	//default is ''. Path of file that contains RSA private key in PEM format for caching_sha2_password.
+	//A key is generated when it is needed for the first time if it is empty.
+	RsaPrivateKeyFile string `toml:"rsaPrivateKeyFile"`
+
 	//default is 1
 	LogShardID uint64 `toml:"logshardid"`
 
@@ -264,6 +279,12 @@ type FrontendParameters struct {
 	// is needed, such as update the salt.
 	ProxyEnabled bool `toml:"proxy-enabled"`
 
+	// ProxyAllowedAddresses are the IP addresses or the CIDRs of the proxies. The salt
+	// and the labels are only received from the connections from them, and only these
+	// connections may replay the password as the scramble of mysql_native_password. Only
+	// the loopback addresses are allowed if it is empty.
+	ProxyAllowedAddresses []string `toml:"proxy-allowed-addresses"`
+
 	// SkipCheckPrivilege denotes the privilege check should be passed.
 	SkipCheckPrivilege bool `toml:"skipCheckPrivilege"`
 
@@ -285,6 +306,10 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.UnixSocketAddress = defaultUnixAddr
 	}
 
+	if fp.DefaultAuthPlugin == "" {
+		fp.DefaultAuthPlugin = defaultAuthPlugin
+	}
+
 	if fp.GuestMmuLimitation == 0 {
 		fp.GuestMmuLimitation = int64(toml.ByteSize(defaultGuestMmuLimitation))
 	}
@@ -670,6 +695,10 @@ type ParameterUnit struct {
 	// HAKeeper client, which is used to get connection ID
 	// from HAKeeper currently.
 	HAKeeperClient logservice.CNHAKeeperClient
+
+	// TaskService returns the task service of the CN. The task service is
+	// not ready until it is created by the HAKeeper.
+	TaskService func() (taskservice.TaskService, bool)
 }
 
 func NewParameterUnit(
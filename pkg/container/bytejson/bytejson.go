@@ -282,12 +282,18 @@ func (bj ByteJson) getValEntry(off int) ByteJson {
 	return ByteJson{Type: TpCode(tpCode), Data: bj.Data[valOff : valOff+dataBytes]}
 }
 
-func (bj ByteJson) queryValByKey(key []byte) ByteJson {
+// findKey returns the position of key in the object, keys are stored in order.
+func (bj ByteJson) findKey(key []byte) (int, bool) {
 	cnt := bj.GetElemCnt()
 	idx := sort.Search(cnt, func(i int) bool {
 		return bytes.Compare(bj.getObjectKey(i), key) >= 0
 	})
-	if idx >= cnt || !bytes.Equal(bj.getObjectKey(idx), key) {
+	return idx, idx < cnt && bytes.Equal(bj.getObjectKey(idx), key)
+}
+
+func (bj ByteJson) queryValByKey(key []byte) ByteJson {
+	idx, ok := bj.findKey(key)
+	if !ok {
 		dt := make([]byte, 1)
 		dt[0] = LiteralNull
 		return ByteJson{
@@ -298,7 +304,19 @@ func (bj ByteJson) queryValByKey(key []byte) ByteJson {
 	return bj.getObjectVal(idx)
 }
 
-func (bj ByteJson) query(cur []ByteJson, path *Path) []ByteJson {
+// queryMode decides how query treats the values which the path does not point to.
+type queryMode int
+
+const (
+	// queryExtract turns a missing value into json null, as JSON_EXTRACT does.
+	queryExtract queryMode = iota
+	// queryLookup skips the missing values, and treats a scalar as an array which
+	// only has itself, as the functions modifying the document do.
+	queryLookup
+)
+
+// query appends the values which the path points to in document order.
+func (bj ByteJson) query(cur []ByteJson, path *Path, mode queryMode) []ByteJson {
 	if path.empty() {
 		cur = append(cur, bj)
 		return cur
@@ -306,42 +324,55 @@ func (bj ByteJson) query(cur []ByteJson, path *Path) []ByteJson {
 	sub, nPath := path.step()
 
 	if sub.tp == subPathDoubleStar {
-		cur = bj.query(cur, &nPath)
+		cur = bj.query(cur, &nPath, mode)
 		if bj.Type == TpCodeObject {
 			cnt := bj.GetElemCnt()
 			for i := 0; i < cnt; i++ {
-				cur = bj.getObjectVal(i).query(cur, path) // take care here, the argument is path,not nPath
+				cur = bj.getObjectVal(i).query(cur, path, mode) // take care here, the argument is path,not nPath
 			}
 		} else if bj.Type == TpCodeArray {
 			cnt := bj.GetElemCnt()
 			for i := 0; i < cnt; i++ {
-				cur = bj.getArrayElem(i).query(cur, path) // take care here, the argument is path,not nPath
+				cur = bj.getArrayElem(i).query(cur, path, mode) // take care here, the argument is path,not nPath
 			}
 		}
 		return cur
 	}
 
-	if bj.Type == TpCodeObject {
+	if bj.Type == TpCodeObject || (mode == queryLookup && bj.Type != TpCodeArray) {
 		switch sub.tp {
 		case subPathIdx:
 			start, _, _ := sub.idx.genIndex(1)
 			if start == 0 {
-				cur = bj.query(cur, &nPath)
+				cur = bj.query(cur, &nPath, mode)
 			}
 		case subPathRange:
+			if mode == queryLookup {
+				if start, end := sub.iRange.lookupRange(1); start <= end {
+					cur = bj.query(cur, &nPath, mode)
+				}
+				break
+			}
 			se := sub.iRange.genRange(bj.GetElemCnt())
 			if se[0] == 0 {
-				cur = bj.query(cur, &nPath)
+				cur = bj.query(cur, &nPath, mode)
 			}
 		case subPathKey:
+			if bj.Type != TpCodeObject {
+				break
+			}
 			cnt := bj.GetElemCnt()
 			if sub.key == "*" {
 				for i := 0; i < cnt; i++ {
-					cur = bj.getObjectVal(i).query(cur, &nPath)
+					cur = bj.getObjectVal(i).query(cur, &nPath, mode)
+				}
+			} else if mode == queryLookup {
+				if idx, ok := bj.findKey(util.UnsafeStringToBytes(sub.key)); ok {
+					cur = bj.getObjectVal(idx).query(cur, &nPath, mode)
 				}
 			} else {
 				tmp := bj.queryValByKey(util.UnsafeStringToBytes(sub.key))
-				cur = tmp.query(cur, &nPath)
+				cur = tmp.query(cur, &nPath, mode)
 			}
 		}
 		return cur
@@ -353,18 +384,28 @@ func (bj ByteJson) query(cur []ByteJson, path *Path) []ByteJson {
 		case subPathIdx:
 			idx, _, last := sub.idx.genIndex(cnt)
 			if last && idx < 0 {
+				if mode == queryLookup {
+					return cur
+				}
 				tmp := ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralNull}}
 				cur = append(cur, tmp)
 				return cur
 			}
 			if idx == subPathIdxALL {
 				for i := 0; i < cnt; i++ {
-					cur = bj.getArrayElem(i).query(cur, &nPath)
+					cur = bj.getArrayElem(i).query(cur, &nPath, mode)
 				}
-			} else {
-				cur = bj.getArrayElem(idx).query(cur, &nPath)
+			} else if idx < cnt {
+				cur = bj.getArrayElem(idx).query(cur, &nPath, mode)
 			}
 		case subPathRange:
+			if mode == queryLookup {
+				start, end := sub.iRange.lookupRange(cnt)
+				for i := start; i <= end; i++ {
+					cur = bj.getArrayElem(i).query(cur, &nPath, mode)
+				}
+				break
+			}
 			se := sub.iRange.genRange(cnt)
 			if se[0] == subPathIdxErr {
 				tmp := ByteJson{Type: TpCodeLiteral, Data: []byte{LiteralNull}}
@@ -372,16 +413,17 @@ func (bj ByteJson) query(cur []ByteJson, path *Path) []ByteJson {
 				return cur
 			}
 			for i := se[0]; i <= se[1]; i++ {
-				cur = bj.getArrayElem(i).query(cur, &nPath)
+				cur = bj.getArrayElem(i).query(cur, &nPath, mode)
 			}
 		}
 	}
 	return cur
 }
+
 func (bj ByteJson) Query(paths []*Path) *ByteJson {
 	out := make([]ByteJson, 0, len(paths))
 	for _, path := range paths {
-		tmp := bj.query(nil, path)
+		tmp := bj.query(nil, path, queryExtract)
 		if len(tmp) > 0 {
 			allNull := checkAllNull(tmp)
 			if !allNull {
@@ -0,0 +1,363 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/util"
+)
+
+// ModifyType is the way json_insert, json_set and json_replace treat the path.
+type ModifyType byte
+
+const (
+	// ModifyInsert only adds the values which do not exist.
+	ModifyInsert ModifyType = iota + 1
+	// ModifySet adds the values which do not exist and replaces the existing ones.
+	ModifySet
+	// ModifyReplace only replaces the existing values.
+	ModifyReplace
+)
+
+// CreateArray builds a json array from the elements.
+func CreateArray(elems []ByteJson) ByteJson {
+	return *mergeToArray(elems)
+}
+
+// CreateObject builds a json object from the members, the last value wins if a key is duplicated.
+func CreateObject(keys []string, vals []ByteJson) (bj ByteJson, err error) {
+	obj := make(map[string]interface{}, len(keys))
+	for i, key := range keys {
+		obj[key] = vals[i]
+	}
+	err = bj.UnmarshalObject(obj)
+	return
+}
+
+// ArrayElems returns the elements of the array.
+func (bj ByteJson) ArrayElems() []ByteJson {
+	cnt := bj.GetElemCnt()
+	elems := make([]ByteJson, 0, cnt)
+	for i := 0; i < cnt; i++ {
+		elems = append(elems, bj.getArrayElem(i))
+	}
+	return elems
+}
+
+// ObjectMembers returns the keys and values of the object.
+func (bj ByteJson) ObjectMembers() ([]string, []ByteJson) {
+	cnt := bj.GetElemCnt()
+	keys := make([]string, 0, cnt)
+	vals := make([]ByteJson, 0, cnt)
+	for i := 0; i < cnt; i++ {
+		keys = append(keys, string(bj.getObjectKey(i)))
+		vals = append(vals, bj.getObjectVal(i))
+	}
+	return keys, vals
+}
+
+// IsSimple returns true if the path points to at most one value,
+// which means there is no wildcard or range in it.
+func (p *Path) IsSimple() bool {
+	if p.flag&(pathFlagSingleStar|pathFlagDoubleStar) != 0 {
+		return false
+	}
+	for _, sub := range p.paths {
+		if sub.tp == subPathRange {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckSimplePath returns an error if the path is not allowed by the functions
+// which only accept a path pointing to at most one value.
+func CheckSimplePath(path *Path) error {
+	if !path.IsSimple() {
+		return moerr.NewInvalidInputNoCtx("in this situation, path expressions may not contain the * and ** tokens or an array range")
+	}
+	return nil
+}
+
+// Lookup returns the value which the simple path points to. A scalar or an object
+// is treated as an array which only has itself, as MySQL does.
+func (bj ByteJson) Lookup(path *Path) (ByteJson, bool) {
+	vals := bj.query(nil, path, queryLookup)
+	if len(vals) == 0 {
+		return Null, false
+	}
+	return vals[0], true
+}
+
+// LookupAll returns all the values which the path points to in document order.
+// Unlike Query, a missing value is not turned into json null.
+func (bj ByteJson) LookupAll(path *Path) []ByteJson {
+	return bj.query(nil, path, queryLookup)
+}
+
+// Modify inserts or replaces the values at the paths one by one, the later path
+// sees the document modified by the former ones.
+func (bj ByteJson) Modify(paths []*Path, vals []ByteJson, tp ModifyType) (ByteJson, error) {
+	if len(paths) != len(vals) {
+		return Null, moerr.NewInvalidInputNoCtx("the count of paths %d and values %d are not equal", len(paths), len(vals))
+	}
+	var err error
+	for i, path := range paths {
+		if err = CheckSimplePath(path); err != nil {
+			return Null, err
+		}
+		if bj, err = bj.modify(path, vals[i], tp); err != nil {
+			return Null, err
+		}
+	}
+	return bj, nil
+}
+
+func (bj ByteJson) modify(path *Path, val ByteJson, tp ModifyType) (ByteJson, error) {
+	_, exists := bj.Lookup(path)
+	if (exists && tp == ModifyInsert) || (!exists && tp == ModifyReplace) {
+		return bj, nil
+	}
+	return bj.set(path, val)
+}
+
+// set sets the value at the simple path, the value is added if only the last leg of
+// the path is missing, otherwise the document is not changed.
+func (bj ByteJson) set(path *Path, val ByteJson) (ByteJson, error) {
+	if path.empty() {
+		return val, nil
+	}
+	sub, nPath := path.step()
+	switch sub.tp {
+	case subPathKey:
+		if bj.Type != TpCodeObject {
+			return bj, nil
+		}
+		keys, vals := bj.ObjectMembers()
+		idx, ok := bj.findKey(util.UnsafeStringToBytes(sub.key))
+		if ok {
+			child, err := vals[idx].set(&nPath, val)
+			if err != nil {
+				return Null, err
+			}
+			vals[idx] = child
+			return CreateObject(keys, vals)
+		}
+		if !nPath.empty() {
+			return bj, nil
+		}
+		return CreateObject(append(keys, sub.key), append(vals, val))
+	case subPathIdx:
+		if bj.Type != TpCodeArray {
+			idx, _, last := sub.idx.genIndex(1)
+			if idx == 0 {
+				return bj.set(&nPath, val)
+			}
+			if last || !nPath.empty() {
+				return bj, nil
+			}
+			// auto wrap the value into an array and append the new one
+			return CreateArray([]ByteJson{bj, val}), nil
+		}
+		elems := bj.ArrayElems()
+		idx, _, last := sub.idx.genIndex(len(elems))
+		if idx < 0 {
+			return bj, nil
+		}
+		if idx < len(elems) {
+			child, err := elems[idx].set(&nPath, val)
+			if err != nil {
+				return Null, err
+			}
+			elems[idx] = child
+			return CreateArray(elems), nil
+		}
+		if last || !nPath.empty() {
+			return bj, nil
+		}
+		return CreateArray(append(elems, val)), nil
+	}
+	return bj, nil
+}
+
+// Remove removes the values at the paths one by one.
+func (bj ByteJson) Remove(paths []*Path) (ByteJson, error) {
+	for _, path := range paths {
+		if err := CheckSimplePath(path); err != nil {
+			return Null, err
+		}
+		if path.empty() {
+			return Null, moerr.NewInvalidInputNoCtx("the path expression '$' is not allowed in this context")
+		}
+		if _, ok := bj.Lookup(path); !ok {
+			continue
+		}
+		var err error
+		if bj, err = bj.remove(path); err != nil {
+			return Null, err
+		}
+	}
+	return bj, nil
+}
+
+// remove removes the value at the simple path, which must exist in the document.
+func (bj ByteJson) remove(path *Path) (ByteJson, error) {
+	sub, nPath := path.step()
+	switch sub.tp {
+	case subPathKey:
+		idx, _ := bj.findKey(util.UnsafeStringToBytes(sub.key))
+		keys, vals := bj.ObjectMembers()
+		if nPath.empty() {
+			return CreateObject(append(keys[:idx], keys[idx+1:]...), append(vals[:idx], vals[idx+1:]...))
+		}
+		child, err := vals[idx].remove(&nPath)
+		if err != nil {
+			return Null, err
+		}
+		vals[idx] = child
+		return CreateObject(keys, vals)
+	case subPathIdx:
+		if bj.Type != TpCodeArray {
+			// the document itself can not be removed
+			if nPath.empty() {
+				return bj, nil
+			}
+			return bj.remove(&nPath)
+		}
+		elems := bj.ArrayElems()
+		idx, _, _ := sub.idx.genIndex(len(elems))
+		if nPath.empty() {
+			return CreateArray(append(elems[:idx], elems[idx+1:]...)), nil
+		}
+		child, err := elems[idx].remove(&nPath)
+		if err != nil {
+			return Null, err
+		}
+		elems[idx] = child
+		return CreateArray(elems), nil
+	}
+	return bj, nil
+}
+
+// Contains reports whether the candidate is contained in the document:
+//  1. a scalar is contained in a scalar if they are equal.
+//  2. an array is contained in an array if each of its elements is contained in the target.
+//  3. a non-array is contained in an array if it is contained in some element of the target.
+//  4. an object is contained in an object if each key of it exists in the target and
+//     its value is contained in the target's value of the same key.
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type {
+	case TpCodeObject:
+		if candidate.Type != TpCodeObject {
+			return false
+		}
+		cnt := candidate.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			idx, ok := bj.findKey(candidate.getObjectKey(i))
+			if !ok || !bj.getObjectVal(idx).Contains(candidate.getObjectVal(i)) {
+				return false
+			}
+		}
+		return true
+	case TpCodeArray:
+		if candidate.Type == TpCodeArray {
+			cnt := candidate.GetElemCnt()
+			for i := 0; i < cnt; i++ {
+				if !bj.Contains(candidate.getArrayElem(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		cnt := bj.GetElemCnt()
+		for i := 0; i < cnt; i++ {
+			if bj.getArrayElem(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	return scalarEqual(bj, candidate)
+}
+
+func (bj ByteJson) isNumber() bool {
+	return bj.Type == TpCodeInt64 || bj.Type == TpCodeUint64 || bj.Type == TpCodeFloat64
+}
+
+func scalarEqual(a, b ByteJson) bool {
+	if a.isNumber() && b.isNumber() {
+		switch {
+		case a.Type == b.Type && a.Type != TpCodeFloat64:
+			return a.GetUint64() == b.GetUint64()
+		case a.Type == TpCodeInt64 && b.Type == TpCodeUint64:
+			return a.GetInt64() >= 0 && uint64(a.GetInt64()) == b.GetUint64()
+		case a.Type == TpCodeUint64 && b.Type == TpCodeInt64:
+			return b.GetInt64() >= 0 && a.GetUint64() == uint64(b.GetInt64())
+		}
+		return toFloat64(a) == toFloat64(b)
+	}
+	if a.Type != b.Type {
+		return false
+	}
+	switch a.Type {
+	case TpCodeString:
+		return bytes.Equal(a.GetString(), b.GetString())
+	case TpCodeLiteral:
+		return a.Data[0] == b.Data[0]
+	}
+	return false
+}
+
+func toFloat64(bj ByteJson) float64 {
+	switch bj.Type {
+	case TpCodeInt64:
+		return float64(bj.GetInt64())
+	case TpCodeUint64:
+		return float64(bj.GetUint64())
+	case TpCodeFloat64:
+		return bj.GetFloat64()
+	}
+	return math.NaN()
+}
+
+// Keys returns the keys of the object as a json array, the second return value
+// is false if the document is not an object.
+func (bj ByteJson) Keys() (ByteJson, bool) {
+	if bj.Type != TpCodeObject {
+		return Null, false
+	}
+	cnt := bj.GetElemCnt()
+	keys := make([]interface{}, 0, cnt)
+	for i := 0; i < cnt; i++ {
+		keys = append(keys, string(bj.getObjectKey(i)))
+	}
+	var ret ByteJson
+	if err := ret.UnmarshalObject(keys); err != nil {
+		return Null, false
+	}
+	return ret, true
+}
+
+// Length returns the count of the elements of an array or the members of an object,
+// the length of a scalar is 1.
+func (bj ByteJson) Length() int {
+	if bj.Type == TpCodeArray || bj.Type == TpCodeObject {
+		return bj.GetElemCnt()
+	}
+	return 1
+}
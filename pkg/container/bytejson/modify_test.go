@@ -0,0 +1,204 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseJson(t *testing.T, s string) ByteJson {
+	bj, err := ParseFromString(s)
+	require.NoError(t, err)
+	return bj
+}
+
+func mustParsePath(t *testing.T, s string) *Path {
+	p, err := ParseJsonPath(s)
+	require.NoError(t, err)
+	return &p
+}
+
+func TestModify(t *testing.T) {
+	kases := []struct {
+		json string
+		path string
+		val  string
+		tp   ModifyType
+		want string
+	}{
+		{`{"a": 1}`, "$.a", "2", ModifySet, `{"a": 2}`},
+		{`{"a": 1}`, "$.b", "2", ModifySet, `{"a": 1, "b": 2}`},
+		{`{"a": 1}`, "$.a", "2", ModifyInsert, `{"a": 1}`},
+		{`{"a": 1}`, "$.b", "2", ModifyInsert, `{"a": 1, "b": 2}`},
+		{`{"a": 1}`, "$.a", "2", ModifyReplace, `{"a": 2}`},
+		{`{"a": 1}`, "$.b", "2", ModifyReplace, `{"a": 1}`},
+		{`{"a": 1}`, "$.b.c", "2", ModifySet, `{"a": 1}`},
+		{`{"a": {"b": [1, 2]}}`, "$.a.b[1]", `"x"`, ModifySet, `{"a": {"b": [1, "x"]}}`},
+		{`[1, 2]`, "$[5]", "3", ModifySet, `[1, 2, 3]`},
+		{`[1, 2]`, "$[5]", "3", ModifyReplace, `[1, 2]`},
+		{`[1, 2]`, "$[last]", "3", ModifySet, `[1, 3]`},
+		{`[1, 2]`, "$[0]", "3", ModifyInsert, `[1, 2]`},
+		{`1`, "$[0]", "3", ModifySet, `3`},
+		{`1`, "$[1]", "3", ModifySet, `[1, 3]`},
+		{`{"a": 1}`, "$[1]", "3", ModifyInsert, `[{"a": 1}, 3]`},
+		{`{"a": 1}`, "$", "3", ModifySet, `3`},
+		{`{"a": 1}`, "$", "3", ModifyInsert, `{"a": 1}`},
+		{`{"a": 1}`, "$.b", "null", ModifySet, `{"a": 1, "b": null}`},
+	}
+	for _, kase := range kases {
+		bj := mustParseJson(t, kase.json)
+		ret, err := bj.Modify([]*Path{mustParsePath(t, kase.path)}, []ByteJson{mustParseJson(t, kase.val)}, kase.tp)
+		require.NoError(t, err, kase.json)
+		require.Equal(t, kase.want, ret.String(), kase.json+" "+kase.path)
+	}
+
+	bj := mustParseJson(t, `{"a": 1}`)
+	ret, err := bj.Modify([]*Path{mustParsePath(t, "$.b"), mustParsePath(t, "$.b[1]")},
+		[]ByteJson{mustParseJson(t, "2"), mustParseJson(t, "3")}, ModifySet)
+	require.NoError(t, err)
+	require.Equal(t, `{"a": 1, "b": [2, 3]}`, ret.String())
+
+	_, err = bj.Modify([]*Path{mustParsePath(t, "$.*")}, []ByteJson{mustParseJson(t, "2")}, ModifySet)
+	require.Error(t, err)
+	_, err = bj.Modify([]*Path{mustParsePath(t, "$[0 to 1]")}, []ByteJson{mustParseJson(t, "2")}, ModifySet)
+	require.Error(t, err)
+}
+
+func TestRemove(t *testing.T) {
+	kases := []struct {
+		json string
+		path string
+		want string
+	}{
+		{`{"a": 1, "b": 2}`, "$.a", `{"b": 2}`},
+		{`{"a": 1, "b": 2}`, "$.c", `{"a": 1, "b": 2}`},
+		{`{"a": {"b": [1, 2, 3]}}`, "$.a.b[1]", `{"a": {"b": [1, 3]}}`},
+		{`[1, 2, 3]`, "$[last]", `[1, 2]`},
+		{`[1, 2, 3]`, "$[3]", `[1, 2, 3]`},
+		{`{"a": 1}`, "$[0].a", `{}`},
+	}
+	for _, kase := range kases {
+		bj := mustParseJson(t, kase.json)
+		ret, err := bj.Remove([]*Path{mustParsePath(t, kase.path)})
+		require.NoError(t, err)
+		require.Equal(t, kase.want, ret.String(), kase.json+" "+kase.path)
+	}
+
+	bj := mustParseJson(t, `{"a": 1}`)
+	_, err := bj.Remove([]*Path{mustParsePath(t, "$")})
+	require.Error(t, err)
+	_, err = bj.Remove([]*Path{mustParsePath(t, "$**.a")})
+	require.Error(t, err)
+}
+
+func TestContains(t *testing.T) {
+	kases := []struct {
+		target    string
+		candidate string
+		want      bool
+	}{
+		{`1`, `1`, true},
+		{`1`, `1.0`, true},
+		{`1`, `"1"`, false},
+		{`[1, 2, [3, 4]]`, `2`, true},
+		{`[1, 2, [3, 4]]`, `[1, 3]`, true},
+		{`[1, 2, [3, 4]]`, `[1, 5]`, false},
+		{`[1, 2]`, `[]`, true},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"b": {"c": 2}}`, true},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"a": 1, "d": 1}`, false},
+		{`{"a": 1}`, `1`, false},
+		{`[{"a": 1}]`, `{"a": 1}`, true},
+		{`null`, `null`, true},
+		{`true`, `false`, false},
+	}
+	for _, kase := range kases {
+		target := mustParseJson(t, kase.target)
+		candidate := mustParseJson(t, kase.candidate)
+		require.Equal(t, kase.want, target.Contains(candidate), kase.target+" "+kase.candidate)
+	}
+}
+
+func TestLookupKeysLength(t *testing.T) {
+	bj := mustParseJson(t, `{"b": [1, 2, {"c": null}], "a": 1}`)
+
+	v, ok := bj.Lookup(mustParsePath(t, "$.b[2].c"))
+	require.True(t, ok)
+	require.True(t, v.IsNull())
+	_, ok = bj.Lookup(mustParsePath(t, "$.c"))
+	require.False(t, ok)
+	v, ok = bj.Lookup(mustParsePath(t, "$.a[0]"))
+	require.True(t, ok)
+	require.Equal(t, "1", v.String())
+
+	keys, ok := bj.Keys()
+	require.True(t, ok)
+	require.Equal(t, `["a", "b"]`, keys.String())
+	_, ok = mustParseJson(t, `[1]`).Keys()
+	require.False(t, ok)
+
+	require.Equal(t, 2, bj.Length())
+	v, _ = bj.Lookup(mustParsePath(t, "$.b"))
+	require.Equal(t, 3, v.Length())
+	require.Equal(t, 1, mustParseJson(t, `"abc"`).Length())
+}
+
+func TestCreate(t *testing.T) {
+	arr := CreateArray([]ByteJson{mustParseJson(t, "1"), Null, mustParseJson(t, `{"a": [true]}`)})
+	require.Equal(t, `[1, null, {"a": [true]}]`, arr.String())
+	require.Equal(t, 3, len(arr.ArrayElems()))
+	require.Equal(t, `[]`, CreateArray(nil).String())
+
+	obj, err := CreateObject([]string{"b", "a", "b"}, []ByteJson{mustParseJson(t, "1"), arr, mustParseJson(t, `"x"`)})
+	require.NoError(t, err)
+	require.Equal(t, `{"a": [1, null, {"a": [true]}], "b": "x"}`, obj.String())
+	keys, vals := obj.ObjectMembers()
+	require.Equal(t, []string{"a", "b"}, keys)
+	require.Equal(t, `"x"`, vals[1].String())
+}
+
+func TestLookupAll(t *testing.T) {
+	bj := mustParseJson(t, `{"items": [{"id": 1, "tags": ["a"]}, {"id": 2}, {"name": "x"}]}`)
+	kases := []struct {
+		path string
+		want []string
+	}{
+		{"$.items[*].id", []string{"1", "2"}},
+		{"$.items[0 to 1].id", []string{"1", "2"}},
+		{"$.items[last].name", []string{`"x"`}},
+		{"$.items[5]", nil},
+		{"$.items[0].id[0]", []string{"1"}},
+		{"$**.tags", []string{`["a"]`}},
+		{"$.nothing", nil},
+		{"$.items[0].id[*]", nil},
+		{"$.items[1 to 5].id", []string{"2"}},
+		{"$.items[3 to 5]", nil},
+	}
+	for _, kase := range kases {
+		var got []string
+		for _, v := range bj.LookupAll(mustParsePath(t, kase.path)) {
+			got = append(got, v.String())
+		}
+		require.Equal(t, kase.want, got, kase.path)
+	}
+}
+
+func TestQueryOutOfRange(t *testing.T) {
+	bj := mustParseJson(t, `[1, 2]`)
+	require.Equal(t, "null", bj.Query([]*Path{mustParsePath(t, "$[5]")}).String())
+	require.Equal(t, "null", bj.Query([]*Path{mustParsePath(t, "$[last-5]")}).String())
+	require.Equal(t, "2", bj.Query([]*Path{mustParsePath(t, "$[last]")}).String())
+}
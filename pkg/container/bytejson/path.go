@@ -350,6 +350,20 @@ func (pi subPathIndices) genIndex(cnt int) (int, int, bool) {
 	return subPathIdxErr, subPathIdxErr, false
 }
 
+// lookupRange returns the first and the last position of the range in an array of cnt
+// elements, the range is empty if the first is greater than the last.
+func (pe subPathRangeExpr) lookupRange(cnt int) (int, int) {
+	start, _, _ := pe.start.genIndex(cnt)
+	end, _, _ := pe.end.genIndex(cnt)
+	if start < 0 {
+		start = 0
+	}
+	if end >= cnt {
+		end = cnt - 1
+	}
+	return start, end
+}
+
 func (pe subPathRangeExpr) genRange(cnt int) (ret [2]int) {
 	orig1, mdf1, _ := pe.start.genIndex(cnt)
 	orig2, mdf2, _ := pe.end.genIndex(cnt)
@@ -76,6 +76,12 @@ func addElem(buf []byte, in interface{}) (TpCode, []byte, error) {
 	case uint64:
 		tpCode = TpCodeUint64
 		buf = addUint64(buf, x)
+	case float64:
+		if err = checkFloat64(x); err != nil {
+			return tpCode, nil, err
+		}
+		tpCode = TpCodeFloat64
+		buf = addFloat64(buf, x)
 	case json.Number:
 		tpCode, buf, err = addJsonNumber(buf, x)
 	case string:
@@ -0,0 +1,106 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// MaxArrayDimension is the max dimension of vecf32 and vecf64.
+	MaxArrayDimension = 65535
+)
+
+// RealNumbers are the element types of the vector types.
+type RealNumbers interface {
+	float32 | float64
+}
+
+// BytesToArray returns the vector stored in b, it shares the memory with b.
+func BytesToArray[T RealNumbers](b []byte) []T {
+	return DecodeSlice[T](b)
+}
+
+// ArrayToBytes returns the storage format of the vector, it shares the memory with arr.
+func ArrayToBytes[T RealNumbers](arr []T) []byte {
+	return EncodeSlice[T](arr)
+}
+
+// StringToArray parses the text format of a vector, e.g. "[1, 2.5, 3]".
+func StringToArray[T RealNumbers](str string) ([]T, error) {
+	str = strings.TrimSpace(str)
+	if len(str) < 2 || str[0] != '[' || str[len(str)-1] != ']' {
+		return nil, moerr.NewInvalidInputNoCtx("malformed vector input: %s", str)
+	}
+	body := strings.TrimSpace(str[1 : len(str)-1])
+	if len(body) == 0 {
+		return nil, moerr.NewInvalidInputNoCtx("vector must have at least 1 dimension")
+	}
+
+	bitSize := 64
+	var zero T
+	if _, ok := any(zero).(float32); ok {
+		bitSize = 32
+	}
+	elems := strings.Split(body, ",")
+	if len(elems) > MaxArrayDimension {
+		return nil, moerr.NewInvalidInputNoCtx("vector dimension %d exceeds the max dimension %d", len(elems), MaxArrayDimension)
+	}
+	arr := make([]T, len(elems))
+	for i, elem := range elems {
+		v, err := strconv.ParseFloat(strings.TrimSpace(elem), bitSize)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("malformed vector input: %s", str)
+		}
+		arr[i] = T(v)
+	}
+	return arr, nil
+}
+
+// StringToArrayToBytes parses the text format of a vector into its storage format.
+func StringToArrayToBytes[T RealNumbers](str string) ([]byte, error) {
+	arr, err := StringToArray[T](str)
+	if err != nil {
+		return nil, err
+	}
+	return ArrayToBytes[T](arr), nil
+}
+
+// ArrayToString returns the text format of the vector.
+func ArrayToString[T RealNumbers](arr []T) string {
+	bitSize := 64
+	var zero T
+	if _, ok := any(zero).(float32); ok {
+		bitSize = 32
+	}
+	var buf strings.Builder
+	buf.WriteByte('[')
+	for i, v := range arr {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(strconv.FormatFloat(float64(v), 'g', -1, bitSize))
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// BytesToArrayToString returns the text format of the vector stored in b.
+func BytesToArrayToString[T RealNumbers](b []byte) string {
+	return ArrayToString[T](BytesToArray[T](b))
+}
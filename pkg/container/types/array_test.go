@@ -0,0 +1,55 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringToArray(t *testing.T) {
+	arr, err := StringToArray[float32](" [1, 2.5,-3] ")
+	require.NoError(t, err)
+	require.Equal(t, []float32{1, 2.5, -3}, arr)
+
+	arr64, err := StringToArray[float64]("[0.1]")
+	require.NoError(t, err)
+	require.Equal(t, []float64{0.1}, arr64)
+
+	for _, str := range []string{"", "[]", "1,2", "[1,,2]", "[a]", "[1, 2"} {
+		_, err = StringToArray[float32](str)
+		require.Error(t, err, str)
+	}
+}
+
+func TestArrayToString(t *testing.T) {
+	require.Equal(t, "[1, 2.5, -3]", ArrayToString([]float32{1, 2.5, -3}))
+	require.Equal(t, "[0.1]", ArrayToString([]float64{0.1}))
+	require.Equal(t, "[0.1]", ArrayToString([]float32{0.1}))
+
+	bs, err := StringToArrayToBytes[float64]("[1, 2]")
+	require.NoError(t, err)
+	require.Equal(t, 16, len(bs))
+	require.Equal(t, "[1, 2]", BytesToArrayToString[float64](bs))
+}
+
+func TestArrayType(t *testing.T) {
+	typ := New(T_array_float32, 3, 0)
+	require.Equal(t, "VECF32(3)", typ.DescString())
+	require.True(t, typ.IsVarlen())
+	require.True(t, typ.Oid.IsArrayRelate())
+	require.Equal(t, T_array_float64, Types["vecf64"])
+}
@@ -75,6 +75,10 @@ const (
 	T_blob T = 70
 	T_text T = 71
 
+	// vectors, the width of the type is the dimension of the vector
+	T_array_float32 T = 80
+	T_array_float64 T = 81
+
 	// Transaction TS
 	T_TS      T = 100
 	T_Rowid   T = 101
@@ -372,6 +376,9 @@ var Types map[string]T = map[string]T{
 	"blob": T_blob,
 	"uuid": T_uuid,
 
+	"vecf32": T_array_float32,
+	"vecf64": T_array_float64,
+
 	"transaction timestamp": T_TS,
 	"rowid":                 T_Rowid,
 	"blockid":               T_Blockid,
@@ -508,6 +515,10 @@ func (t Type) DescString() string {
 		return fmt.Sprintf("DECIMAL(%d,%d)", t.Width, t.Scale)
 	case T_decimal128:
 		return fmt.Sprintf("DECIAML(%d,%d)", t.Width, t.Scale)
+	case T_array_float32:
+		return fmt.Sprintf("VECF32(%d)", t.Width)
+	case T_array_float64:
+		return fmt.Sprintf("VECF64(%d)", t.Width)
 	}
 	return t.Oid.String()
 }
@@ -580,6 +591,9 @@ func (t T) ToType() Type {
 	case T_varbinary:
 		typ.Size = VarlenaSize
 		typ.Width = MaxVarBinaryLen
+	case T_array_float32, T_array_float64:
+		typ.Size = VarlenaSize
+		typ.Width = MaxArrayDimension
 	case T_enum:
 		typ.Size = 2
 	case T_any:
@@ -659,6 +673,10 @@ func (t T) String() string {
 		return "INTERVAL"
 	case T_enum:
 		return "ENUM"
+	case T_array_float32:
+		return "VECF32"
+	case T_array_float64:
+		return "VECF64"
 	}
 	return fmt.Sprintf("unexpected type: %d", t)
 }
@@ -728,6 +746,10 @@ func (t T) OidString() string {
 		return "T_interval"
 	case T_enum:
 		return "T_enum"
+	case T_array_float32:
+		return "T_array_float32"
+	case T_array_float64:
+		return "T_array_float64"
 	}
 	return "unknown_type"
 }
@@ -757,7 +779,7 @@ func (t T) TypeLen() int {
 		return 4
 	case T_float64:
 		return 8
-	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary:
+	case T_char, T_varchar, T_json, T_blob, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64:
 		return VarlenaSize
 	case T_decimal64:
 		return 8
@@ -808,7 +830,7 @@ func (t T) FixedLength() int {
 		return RowidSize
 	case T_Blockid:
 		return BlockidSize
-	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary:
+	case T_char, T_varchar, T_blob, T_json, T_text, T_binary, T_varbinary, T_array_float32, T_array_float64:
 		return -24
 	case T_enum:
 		return 2
@@ -889,3 +911,11 @@ func (t T) IsDecimal() bool {
 	}
 	return false
 }
+
+// IsArrayRelate return true if the types.T is a vector type
+func (t T) IsArrayRelate() bool {
+	if t == T_array_float32 || t == T_array_float64 {
+		return true
+	}
+	return false
+}
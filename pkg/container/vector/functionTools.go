@@ -513,7 +513,7 @@ func NewFunctionResultWrapper(
 	case types.T_char, types.T_varchar, types.T_blob, types.T_text, types.T_binary, types.T_varbinary:
 		// IF STRING type.
 		return newResultFunc[types.Varlena](v, getVectorMethod, putVectorMethod, mp)
-	case types.T_json:
+	case types.T_json, types.T_array_float32, types.T_array_float64:
 		return newResultFunc[types.Varlena](v, getVectorMethod, putVectorMethod, mp)
 	}
 
@@ -73,6 +73,22 @@ func MustStrCol(v *Vector) []string {
 	}
 }
 
+func MustArrayCol[T types.RealNumbers](v *Vector) [][]T {
+	if v.GetType().Oid == types.T_any || len(v.data) == 0 {
+		return nil
+	}
+	varcol := MustFixedCol[types.Varlena](v)
+	if v.class == CONSTANT {
+		return [][]T{types.BytesToArray[T]((&varcol[0]).GetByteSlice(v.area))}
+	} else {
+		ret := make([][]T, v.length)
+		for i := range varcol {
+			ret[i] = types.BytesToArray[T]((&varcol[i]).GetByteSlice(v.area))
+		}
+		return ret
+	}
+}
+
 // ExpandFixedCol decode data and return decoded []T.
 // For const/scalar vector we expand and return newly allocated slice.
 func ExpandFixedCol[T any](v *Vector) []T {
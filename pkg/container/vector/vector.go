@@ -220,6 +220,11 @@ func (v *Vector) GetStringAt(i int) string {
 	return bs[i].GetString(v.area)
 }
 
+// GetArrayAt returns the vector value of row i, it shares the memory with v.
+func GetArrayAt[T types.RealNumbers](v *Vector, i int) []T {
+	return types.BytesToArray[T](v.GetBytesAt(i))
+}
+
 func NewVec(typ types.Type) *Vector {
 	vec := &Vector{
 		typ:   typ,
@@ -636,7 +641,8 @@ func (v *Vector) Shrink(sels []int64, negate bool) {
 		shrinkFixed[float32](v, sels, negate)
 	case types.T_float64:
 		shrinkFixed[float64](v, sels, negate)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
+		types.T_array_float32, types.T_array_float64:
 		// XXX shrink varlena, but did not shrink area.  For our vector, this
 		// may well be the right thing.  If want to shrink area as well, we
 		// have to copy each varlena value and swizzle pointer.
@@ -697,7 +703,8 @@ func (v *Vector) Shuffle(sels []int64, mp *mpool.MPool) error {
 		shuffleFixed[float32](v, sels, mp)
 	case types.T_float64:
 		shuffleFixed[float64](v, sels, mp)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
+		types.T_array_float32, types.T_array_float64:
 		shuffleFixed[types.Varlena](v, sels, mp)
 	case types.T_date:
 		shuffleFixed[types.Date](v, sels, mp)
@@ -1400,7 +1407,7 @@ func GetUnionAllFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector) err
 			return nil
 		}
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
-		types.T_json, types.T_blob, types.T_text:
+		types.T_json, types.T_blob, types.T_text, types.T_array_float32, types.T_array_float64:
 		return func(v, w *Vector) error {
 			if w.IsConstNull() {
 				if err := appendMultiFixed(v, 0, true, w.length, mp); err != nil {
@@ -1700,7 +1707,7 @@ func GetUnionOneFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			return appendOneFixed(v, ws[sel], nulls.Contains(&w.nsp, uint64(sel)), mp)
 		}
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
-		types.T_json, types.T_blob, types.T_text:
+		types.T_json, types.T_blob, types.T_text, types.T_array_float32, types.T_array_float64:
 		return func(v, w *Vector, sel int64) error {
 			if w.IsConstNull() {
 				return appendOneFixed(v, types.Varlena{}, true, mp)
@@ -1978,7 +1985,7 @@ func GetConstSetFunction(typ types.Type, mp *mpool.MPool) func(v, w *Vector, sel
 			return SetConstFixed(v, ws[sel], length, mp)
 		}
 	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
-		types.T_json, types.T_blob, types.T_text:
+		types.T_json, types.T_blob, types.T_text, types.T_array_float32, types.T_array_float64:
 		return func(v, w *Vector, sel int64, length int) error {
 			if w.IsConstNull() || w.nsp.Contains(uint64(sel)) {
 				return SetConstNull(v, length, mp)
@@ -2429,6 +2436,10 @@ func (v *Vector) String() string {
 		} else {
 			return fmt.Sprintf("%v", col)
 		}
+	case types.T_array_float32:
+		return arrayVecToString[float32](v)
+	case types.T_array_float64:
+		return arrayVecToString[float64](v)
 	default:
 		panic("vec to string unknown types.")
 	}
@@ -2532,7 +2543,8 @@ func AppendAny(vec *Vector, val any, isNull bool, mp *mpool.MPool) error {
 		return appendOneFixed(vec, val.(types.Rowid), false, mp)
 	case types.T_Blockid:
 		return appendOneFixed(vec, val.(types.Blockid), false, mp)
-	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_json, types.T_blob, types.T_text,
+		types.T_array_float32, types.T_array_float64:
 		return appendOneBytes(vec, val.([]byte), false, mp)
 	}
 	return nil
@@ -2818,6 +2830,22 @@ func vecToString[T types.FixedSizeT](v *Vector) string {
 	}
 }
 
+func arrayVecToString[T types.RealNumbers](v *Vector) string {
+	col := MustArrayCol[T](v)
+	strs := make([]string, len(col))
+	for i := range col {
+		if nulls.Contains(&v.nsp, uint64(i)) {
+			strs[i] = "null"
+		} else {
+			strs[i] = types.ArrayToString[T](col[i])
+		}
+	}
+	if len(strs) == 1 {
+		return strs[0]
+	}
+	return fmt.Sprintf("%v", strs)
+}
+
 // Window returns a "window" into the Vec.
 // It selects a half-open range (i.e.[start, end)).
 // The returned object is NOT allowed to be modified (
@@ -3205,7 +3233,8 @@ func (v *Vector) GetMinMaxValue() (ok bool, minv, maxv []byte) {
 		minv = types.EncodeFixed(minVal)
 		maxv = types.EncodeFixed(maxVal)
 
-	case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+	case types.T_char, types.T_varchar, types.T_json, types.T_binary, types.T_varbinary, types.T_blob, types.T_text,
+		types.T_array_float32, types.T_array_float64:
 		minv, maxv = VarlenGetMinMax(v)
 
 	default:
@@ -48,6 +48,8 @@ const (
 	MYSQL_TYPE_TIME2       MysqlType = 0x13 /**< Internal to MySQL. Not used in protocol */
 	MYSQL_TYPE_TYPED_ARRAY MysqlType = 0x14 /**< Used for replication only */
 
+	MYSQL_TYPE_VECF32      MysqlType = 238 // add vecf32 for the vector type of float32
+	MYSQL_TYPE_VECF64      MysqlType = 239 // add vecf64 for the vector type of float64
 	MYSQL_TYPE_TEXT        MysqlType = 241 // add text to distinct blob and blob
 	MYSQL_TYPE_INVALID     MysqlType = 242
 	MYSQL_TYPE_UUID        MysqlType = 243
@@ -196,6 +198,18 @@ type VarScopeKey struct{}
 // Determine if it is a stored procedure
 type InSp struct{}
 
+// TriggerTablesKey is the tables changed by the statements which fire the triggers.
+// The statements of the trigger can not change these tables.
+type TriggerTablesKey struct{}
+
+// SkipTriggerKey determines the DML changes the row without firing the triggers,
+// because the triggers of the row are fired by the statement itself.
+type SkipTriggerKey struct{}
+
+// MViewRefreshKey determines the statement is executed to refresh a materialized view,
+// which can change the materialized view and read the changes of its base table.
+type MViewRefreshKey struct{}
+
 // PkCheckByDN whether DN does primary key uniqueness check against transaction's workspace or not.
 type PkCheckByDN struct{}
 
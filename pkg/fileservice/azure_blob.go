@@ -0,0 +1,487 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+)
+
+type AzureConfig struct {
+	// Endpoint is the blob service endpoint, https://<account>.blob.core.windows.net if empty
+	Endpoint string `toml:"endpoint"`
+	Account  string `toml:"account"`
+	// Key is the base64 encoded shared key of the account
+	Key string `toml:"key"`
+	// SASToken is the shared access signature, used if Key is empty
+	SASToken  string `toml:"sas-token"`
+	Container string `toml:"container"`
+	// KeyPrefix enables multiple fs instances in one container
+	KeyPrefix string `toml:"key-prefix"`
+}
+
+const azureAPIVersion = "2021-08-06"
+
+// azureBlobStorage implements ObjectStorage with the REST API of azure blob storage
+type azureBlobStorage struct {
+	client      *http.Client
+	endpoint    *url.URL
+	account     string
+	key         []byte
+	sasQuery    url.Values
+	container   string
+	listMaxKeys int
+}
+
+var _ ObjectStorage = new(azureBlobStorage)
+
+// NewAzureFS creates a FileService on azure blob storage
+func NewAzureFS(
+	ctx context.Context,
+	name string,
+	config AzureConfig,
+	cacheConfig CacheConfig,
+	perfCounterSets []*perfcounter.CounterSet,
+	noCache bool,
+) (*S3FS, error) {
+
+	fs, err := newAzureFS([]string{
+		"name=" + name,
+		"endpoint=" + config.Endpoint,
+		"account=" + config.Account,
+		"key=" + config.Key,
+		"sas=" + config.SASToken,
+		"container=" + config.Container,
+		"prefix=" + config.KeyPrefix,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fs.perfCounterSets = perfCounterSets
+
+	if !noCache {
+		if err := fs.initCaches(ctx, cacheConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	return fs, nil
+}
+
+func newAzureFS(arguments []string) (*S3FS, error) {
+	if len(arguments) == 0 {
+		return nil, moerr.NewInvalidInputNoCtx("invalid azure arguments")
+	}
+
+	// arguments
+	var endpoint, account, key, sas, container, prefix, name string
+	for _, pair := range arguments {
+		k, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, moerr.NewInvalidInputNoCtx("invalid azure argument: %s", pair)
+		}
+		switch k {
+		case "endpoint":
+			endpoint = value
+		case "account":
+			account = value
+		case "key":
+			key = value
+		case "sas":
+			sas = value
+		case "container":
+			container = value
+		case "prefix":
+			prefix = value
+		case "name":
+			name = value
+		default:
+			return nil, moerr.NewInvalidInputNoCtx("invalid azure argument: %s", pair)
+		}
+	}
+
+	if account == "" {
+		return nil, moerr.NewInvalidInputNoCtx("azure account not specified")
+	}
+	if container == "" {
+		return nil, moerr.NewInvalidInputNoCtx("azure container not specified")
+	}
+
+	// endpoint
+	if endpoint == "" {
+		endpoint = "https://" + account + ".blob.core.windows.net"
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if endpointURL.Scheme == "" {
+		endpointURL.Scheme = "https"
+	}
+	endpointURL.Path = strings.TrimRight(endpointURL.Path, "/")
+
+	storage := &azureBlobStorage{
+		client:    newObjectStorageHTTPClient(),
+		endpoint:  endpointURL,
+		account:   account,
+		container: container,
+	}
+
+	// credential
+	if key != "" {
+		storage.key, err = base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("invalid azure key: %v", err)
+		}
+	} else if sas != "" {
+		storage.sasQuery, err = url.ParseQuery(strings.TrimPrefix(sas, "?"))
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("invalid azure sas token: %v", err)
+		}
+	}
+
+	// validate container
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := storage.checkContainer(ctx); err != nil {
+		return nil, moerr.NewInternalErrorNoCtx("bad azure config: %v", err)
+	}
+
+	return newObjectStorageFS(name, storage, prefix), nil
+}
+
+func (a *azureBlobStorage) checkContainer(ctx context.Context) error {
+	query := url.Values{}
+	query.Set("restype", "container")
+	resp, err := a.do(ctx, http.MethodHead, "", query, nil, -1, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return newObjectStorageError("check container", resp)
+	}
+	return nil
+}
+
+type azureEnumerationResults struct {
+	XMLName xml.Name `xml:"EnumerationResults"`
+	Blobs   struct {
+		Blob       []azureBlobItem   `xml:"Blob"`
+		BlobPrefix []azureBlobPrefix `xml:"BlobPrefix"`
+	} `xml:"Blobs"`
+	NextMarker string `xml:"NextMarker"`
+}
+
+type azureBlobItem struct {
+	Name       string `xml:"Name"`
+	Properties struct {
+		ContentLength int64 `xml:"Content-Length"`
+	} `xml:"Properties"`
+}
+
+type azureBlobPrefix struct {
+	Name string `xml:"Name"`
+}
+
+func (a *azureBlobStorage) List(ctx context.Context, prefix string, fn func(isPrefix bool, key string, size int64) (bool, error)) error {
+	var marker string
+	for {
+		query := url.Values{}
+		query.Set("restype", "container")
+		query.Set("comp", "list")
+		query.Set("delimiter", "/")
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if marker != "" {
+			query.Set("marker", marker)
+		}
+		if a.listMaxKeys > 0 {
+			query.Set("maxresults", strconv.Itoa(a.listMaxKeys))
+		}
+
+		resp, err := a.do(ctx, http.MethodGet, "", query, nil, -1, nil)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err := newObjectStorageError("list blobs", resp)
+			resp.Body.Close()
+			return err
+		}
+		var result azureEnumerationResults
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, blob := range result.Blobs.Blob {
+			more, err := fn(false, blob.Name, blob.Properties.ContentLength)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+		for _, blobPrefix := range result.Blobs.BlobPrefix {
+			more, err := fn(true, blobPrefix.Name, 0)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		if result.NextMarker == "" {
+			return nil
+		}
+		marker = result.NextMarker
+	}
+}
+
+func (a *azureBlobStorage) Stat(ctx context.Context, key string) (int64, error) {
+	resp, err := a.do(ctx, http.MethodHead, key, nil, nil, -1, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.ContentLength, nil
+	case http.StatusNotFound:
+		return 0, moerr.NewFileNotFoundNoCtx(key)
+	default:
+		return 0, newObjectStorageError("get blob properties", resp)
+	}
+}
+
+func (a *azureBlobStorage) Write(ctx context.Context, key string, r io.Reader, size int64, expire *time.Time) error {
+	header := http.Header{}
+	header.Set("x-ms-blob-type", "BlockBlob")
+	if expire != nil {
+		// blob storage has no per-object expiration for flat namespace accounts,
+		// keep it as metadata for lifecycle management
+		header.Set("x-ms-meta-expireat", expire.UTC().Format(time.RFC3339))
+	}
+	resp, err := a.do(ctx, http.MethodPut, key, nil, header, size, r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		return newObjectStorageError("put blob", resp)
+	}
+	return nil
+}
+
+func (a *azureBlobStorage) Read(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error) {
+	header := http.Header{}
+	if min > 0 || max >= 0 {
+		if max >= 0 {
+			header.Set("x-ms-range", fmt.Sprintf("bytes=%d-%d", min, max-1))
+		} else {
+			header.Set("x-ms-range", fmt.Sprintf("bytes=%d-", min))
+		}
+	}
+	resp, err := a.do(ctx, http.MethodGet, key, nil, header, -1, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return resp.Body, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		// range out of blob, let the caller report
+		resp.Body.Close()
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, moerr.NewFileNotFoundNoCtx(key)
+	default:
+		defer resp.Body.Close()
+		return nil, newObjectStorageError("get blob", resp)
+	}
+}
+
+func (a *azureBlobStorage) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		if err := a.delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *azureBlobStorage) delete(ctx context.Context, key string) error {
+	resp, err := a.do(ctx, http.MethodDelete, key, nil, nil, -1, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusAccepted, http.StatusOK, http.StatusNotFound:
+		return nil
+	default:
+		return newObjectStorageError("delete blob", resp)
+	}
+}
+
+func (a *azureBlobStorage) do(
+	ctx context.Context,
+	method string,
+	key string,
+	query url.Values,
+	header http.Header,
+	size int64,
+	body io.Reader,
+) (*http.Response, error) {
+
+	u := *a.endpoint
+	u.Path = a.endpoint.Path + "/" + a.container
+	u.RawPath = escapePath(a.endpoint.Path) + "/" + url.PathEscape(a.container)
+	if key != "" {
+		u.Path += "/" + key
+		u.RawPath += "/" + escapePath(key)
+	}
+	if query == nil {
+		query = url.Values{}
+	}
+	for k, vs := range a.sasQuery {
+		query[k] = vs
+	}
+	u.RawQuery = query.Encode()
+
+	if body == nil {
+		body = http.NoBody
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, vs := range header {
+		req.Header[k] = vs
+	}
+	if size >= 0 {
+		req.ContentLength = size
+		if size == 0 {
+			req.Body = http.NoBody
+		}
+	}
+	req.Header.Set("x-ms-version", azureAPIVersion)
+	req.Header.Set("x-ms-date", time.Now().UTC().Format(http.TimeFormat))
+
+	if len(a.key) > 0 {
+		req.Header.Set("Authorization", "SharedKey "+a.account+":"+azureSharedKeySignature(a.account, a.key, req))
+	}
+
+	return a.client.Do(req)
+}
+
+// azureSharedKeySignature signs the request with the shared key authorization scheme
+// https://learn.microsoft.com/en-us/rest/api/storageservices/authorize-with-shared-key
+func azureSharedKeySignature(account string, key []byte, req *http.Request) string {
+	contentLength := ""
+	if req.ContentLength > 0 {
+		contentLength = strconv.FormatInt(req.ContentLength, 10)
+	}
+
+	// canonicalized headers
+	var msHeaders []string
+	for k := range req.Header {
+		k = strings.ToLower(k)
+		if strings.HasPrefix(k, "x-ms-") {
+			msHeaders = append(msHeaders, k)
+		}
+	}
+	sort.Strings(msHeaders)
+	var buf strings.Builder
+	for _, k := range msHeaders {
+		buf.WriteString(k)
+		buf.WriteString(":")
+		buf.WriteString(strings.TrimSpace(req.Header.Get(k)))
+		buf.WriteString("\n")
+	}
+	canonicalizedHeaders := buf.String()
+
+	// canonicalized resource
+	buf.Reset()
+	buf.WriteString("/")
+	buf.WriteString(account)
+	buf.WriteString(req.URL.EscapedPath())
+	query := req.URL.Query()
+	names := make([]string, 0, len(query))
+	for k := range query {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for _, k := range names {
+		values := query[k]
+		sort.Strings(values)
+		buf.WriteString("\n")
+		buf.WriteString(strings.ToLower(k))
+		buf.WriteString(":")
+		buf.WriteString(strings.Join(values, ","))
+	}
+	canonicalizedResource := buf.String()
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-Encoding"),
+		req.Header.Get("Content-Language"),
+		contentLength,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		req.Header.Get("Date"),
+		req.Header.Get("If-Modified-Since"),
+		req.Header.Get("If-Match"),
+		req.Header.Get("If-None-Match"),
+		req.Header.Get("If-Unmodified-Since"),
+		req.Header.Get("Range"),
+		canonicalizedHeaders + canonicalizedResource,
+	}, "\n")
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// escapePath escapes every segment of a slash separated path
+func escapePath(p string) string {
+	parts := strings.Split(p, "/")
+	for i, part := range parts {
+		parts[i] = url.PathEscape(part)
+	}
+	return strings.Join(parts, "/")
+}
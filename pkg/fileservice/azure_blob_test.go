@@ -0,0 +1,306 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	azureStubAccount   = "devstoreaccount1"
+	azureStubContainer = "test"
+)
+
+var azureStubKey = base64.StdEncoding.EncodeToString([]byte("azure-stub-shared-key"))
+
+// azureStub emulates the blob service of azurite, with path style urls:
+// http://<host>/<account>/<container>/<blob>
+type azureStub struct {
+	store *stubObjectStore
+	// failures is the number of requests to fail with 503 before serving
+	failures atomic.Int32
+}
+
+func newAzureStub(t *testing.T) (*azureStub, string) {
+	stub := &azureStub{
+		store: newStubObjectStore(),
+	}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	return stub, server.URL + "/" + azureStubAccount
+}
+
+func (a *azureStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if a.failures.Load() > 0 {
+		a.failures.Add(-1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	// auth
+	key, _ := base64.StdEncoding.DecodeString(azureStubKey)
+	expected := "SharedKey " + azureStubAccount + ":" + azureSharedKeySignature(azureStubAccount, key, r)
+	if r.Header.Get("Authorization") != expected || r.Header.Get("x-ms-version") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/"+azureStubAccount+"/")
+	container, name, _ := strings.Cut(path, "/")
+	if container != azureStubContainer {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	query := r.URL.Query()
+
+	if name == "" {
+		if query.Get("comp") == "list" {
+			a.list(w, query.Get("prefix"), query.Get("marker"), query.Get("maxresults"))
+			return
+		}
+		// container properties
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodHead:
+		data, ok := a.store.get(name)
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodGet:
+		data, ok := a.store.get(name)
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		start, end, status := parseStubRange(r.Header.Get("x-ms-range"), len(data))
+		if status >= 400 {
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write(data[start:end])
+
+	case http.MethodPut:
+		if r.Header.Get("x-ms-blob-type") != "BlockBlob" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		a.store.put(name, data)
+		w.WriteHeader(http.StatusCreated)
+
+	case http.MethodDelete:
+		if !a.store.delete(name) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (a *azureStub) list(w http.ResponseWriter, prefix string, marker string, maxResults string) {
+	max, _ := strconv.Atoi(maxResults)
+	keys, prefixes, next := a.store.list(prefix, marker, max)
+	var result azureEnumerationResults
+	for _, key := range keys {
+		data, _ := a.store.get(key)
+		item := azureBlobItem{
+			Name: key,
+		}
+		item.Properties.ContentLength = int64(len(data))
+		result.Blobs.Blob = append(result.Blobs.Blob, item)
+	}
+	for _, p := range prefixes {
+		result.Blobs.BlobPrefix = append(result.Blobs.BlobPrefix, azureBlobPrefix{
+			Name: p,
+		})
+	}
+	result.NextMarker = next
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	_ = xml.NewEncoder(w).Encode(result)
+}
+
+func newTestAzureFS(t *testing.T, endpoint string, name string, cacheConfig CacheConfig, noCache bool) *S3FS {
+	fs, err := NewAzureFS(
+		context.Background(),
+		name,
+		AzureConfig{
+			Endpoint:  endpoint,
+			Account:   azureStubAccount,
+			Key:       azureStubKey,
+			Container: azureStubContainer,
+			KeyPrefix: time.Now().Format("2006-01-02.15:04:05.000000"),
+		},
+		cacheConfig,
+		nil,
+		noCache,
+	)
+	require.Nil(t, err)
+	return fs
+}
+
+func TestAzureFS(t *testing.T) {
+	_, endpoint := newAzureStub(t)
+
+	t.Run("file service", func(t *testing.T) {
+		testFileService(t, func(name string) FileService {
+			fs := newTestAzureFS(t, endpoint, name, DisabledCacheConfig, true)
+			fs.storage.(*azureBlobStorage).listMaxKeys = 5 // to test continuation
+			return fs
+		})
+	})
+
+	t.Run("mem caching file service", func(t *testing.T) {
+		testCachingFileService(t, func() CachingFileService {
+			return newTestAzureFS(t, endpoint, "azure", CacheConfig{
+				MemoryCapacity: ptrTo[toml.ByteSize](128 * 1024),
+			}, false)
+		})
+	})
+
+	t.Run("disk caching file service", func(t *testing.T) {
+		testCachingFileService(t, func() CachingFileService {
+			return newTestAzureFS(t, endpoint, "azure", CacheConfig{
+				MemoryCapacity: ptrTo[toml.ByteSize](1),
+				DiskCapacity:   ptrTo[toml.ByteSize](128 * 1024),
+				DiskPath:       ptrTo(t.TempDir()),
+			}, false)
+		})
+	})
+}
+
+func TestAzureFSRetry(t *testing.T) {
+	stub, endpoint := newAzureStub(t)
+	fs := newTestAzureFS(t, endpoint, "azure", DisabledCacheConfig, true)
+	ctx := context.Background()
+	var counterSet perfcounter.CounterSet
+	ctx = perfcounter.WithCounterSet(ctx, &counterSet)
+
+	err := fs.Write(ctx, IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{
+				Size: 3,
+				Data: []byte("abc"),
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	// server errors are retried
+	stub.failures.Store(2)
+	vec := &IOVector{
+		FilePath: "foo",
+		Entries: []IOEntry{
+			{
+				Offset: 1,
+				Size:   2,
+			},
+		},
+	}
+	err = fs.Read(ctx, vec)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("bc"), vec.Entries[0].Data)
+	assert.True(t, counterSet.FileService.S3.Get.Load() > 0)
+
+	// not found
+	_, err = fs.StatFile(ctx, "bar")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+}
+
+func TestAzureBadConfig(t *testing.T) {
+	_, endpoint := newAzureStub(t)
+
+	// bad key
+	_, err := newAzureFS([]string{
+		"endpoint=" + endpoint,
+		"account=" + azureStubAccount,
+		"key=" + base64.StdEncoding.EncodeToString([]byte("bad")),
+		"container=" + azureStubContainer,
+	})
+	assert.NotNil(t, err)
+
+	// no such container
+	_, err = newAzureFS([]string{
+		"endpoint=" + endpoint,
+		"account=" + azureStubAccount,
+		"key=" + azureStubKey,
+		"container=foo",
+	})
+	assert.NotNil(t, err)
+
+	// bad argument
+	_, err = newAzureFS([]string{
+		"foo=bar",
+	})
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+}
+
+func TestAzureFileServiceConfig(t *testing.T) {
+	_, endpoint := newAzureStub(t)
+	fs, err := NewFileService(context.Background(), Config{
+		Name:    "azure",
+		Backend: "AZURE",
+		Azure: AzureConfig{
+			Endpoint:  endpoint,
+			Account:   azureStubAccount,
+			Key:       azureStubKey,
+			Container: azureStubContainer,
+		},
+		Cache: DisabledCacheConfig,
+	}, nil)
+	assert.Nil(t, err)
+	_, ok := fs.(ETLFileService)
+	assert.True(t, ok)
+
+	res, readPath, err := GetForETL(nil, JoinPath(
+		"azure-opts,endpoint="+endpoint+",account="+azureStubAccount+",key="+azureStubKey+",container="+azureStubContainer,
+		"foo/bar",
+	))
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+	assert.Equal(t, "foo/bar", readPath)
+}
@@ -29,16 +29,22 @@ const (
 	diskETLFileServiceBackend = "DISK-ETL"
 	s3FileServiceBackend      = "S3"
 	minioFileServiceBackend   = "MINIO"
+	azureFileServiceBackend   = "AZURE"
+	gcsFileServiceBackend     = "GCS"
 )
 
 // Config fileService config
 type Config struct {
 	// Name name of fileservice, describe what an instance of fileservice is used for
 	Name string `toml:"name"`
-	// Backend fileservice backend. [MEM|DISK|DISK-ETL|S3|MINIO]
+	// Backend fileservice backend. [MEM|DISK|DISK-ETL|S3|MINIO|AZURE|GCS]
 	Backend string `toml:"backend"`
 	// S3 used to create fileservice using s3 as the backend
 	S3 S3Config `toml:"s3"`
+	// Azure used to create fileservice using azure blob storage as the backend
+	Azure AzureConfig `toml:"azure"`
+	// GCS used to create fileservice using google cloud storage as the backend
+	GCS GCSConfig `toml:"gcs"`
 	// Cache specifies configs for cache
 	Cache CacheConfig `toml:"cache"`
 	// DataDir used to create fileservice using DISK as the backend
@@ -64,6 +70,10 @@ func NewFileService(ctx context.Context, cfg Config, perfCounterSets []*perfcoun
 		return newMinioFileService(ctx, cfg, perfCounterSets)
 	case s3FileServiceBackend:
 		return newS3FileService(ctx, cfg, perfCounterSets)
+	case azureFileServiceBackend:
+		return newAzureFileService(ctx, cfg, perfCounterSets)
+	case gcsFileServiceBackend:
+		return newGCSFileService(ctx, cfg, perfCounterSets)
 	default:
 		return nil, moerr.NewInternalErrorNoCtx("file service backend %s not implemented", cfg.Backend)
 	}
@@ -144,3 +154,33 @@ func newS3FileService(ctx context.Context, cfg Config, perfCounters []*perfcount
 	}
 	return fs, nil
 }
+
+func newAzureFileService(ctx context.Context, cfg Config, perfCounters []*perfcounter.CounterSet) (FileService, error) {
+	fs, err := NewAzureFS(
+		ctx,
+		cfg.Name,
+		cfg.Azure,
+		cfg.Cache,
+		perfCounters,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+func newGCSFileService(ctx context.Context, cfg Config, perfCounters []*perfcounter.CounterSet) (FileService, error) {
+	fs, err := NewGCSFS(
+		ctx,
+		cfg.Name,
+		cfg.GCS,
+		cfg.Cache,
+		perfCounters,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
@@ -17,6 +17,7 @@ package fileservice
 import (
 	"errors"
 	"io"
+	"net/http"
 	"strings"
 )
 
@@ -25,6 +26,18 @@ func isRetryableError(err error) bool {
 	if errors.Is(err, io.ErrUnexpectedEOF) {
 		return true
 	}
+	// throttled or server side errors of object storages
+	var storageErr *objectStorageError
+	if errors.As(err, &storageErr) {
+		switch storageErr.statusCode {
+		case http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout:
+			return true
+		}
+	}
 	str := err.Error()
 	// match exact string
 	switch str {
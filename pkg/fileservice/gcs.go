@@ -0,0 +1,421 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+)
+
+type GCSConfig struct {
+	// Endpoint is the storage api endpoint, https://storage.googleapis.com if empty
+	Endpoint string `toml:"endpoint"`
+	Bucket   string `toml:"bucket"`
+	// KeyPrefix enables multiple fs instances in one bucket
+	KeyPrefix string `toml:"key-prefix"`
+	// AccessToken is an OAuth2 access token.
+	// if empty and the endpoint is the default one, tokens of the default service account are fetched from the metadata server
+	AccessToken string `toml:"access-token"`
+}
+
+const (
+	gcsDefaultEndpoint = "https://storage.googleapis.com"
+	gcsMetadataToken   = "http://metadata.google.internal/computeMetadata/v1/instance/service-accounts/default/token"
+)
+
+// gcsStorage implements ObjectStorage with the JSON API of google cloud storage
+type gcsStorage struct {
+	client      *http.Client
+	endpoint    string
+	bucket      string
+	token       func(ctx context.Context) (string, error)
+	listMaxKeys int
+}
+
+var _ ObjectStorage = new(gcsStorage)
+
+// NewGCSFS creates a FileService on google cloud storage
+func NewGCSFS(
+	ctx context.Context,
+	name string,
+	config GCSConfig,
+	cacheConfig CacheConfig,
+	perfCounterSets []*perfcounter.CounterSet,
+	noCache bool,
+) (*S3FS, error) {
+
+	fs, err := newGCSFS([]string{
+		"name=" + name,
+		"endpoint=" + config.Endpoint,
+		"bucket=" + config.Bucket,
+		"prefix=" + config.KeyPrefix,
+		"token=" + config.AccessToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	fs.perfCounterSets = perfCounterSets
+
+	if !noCache {
+		if err := fs.initCaches(ctx, cacheConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	return fs, nil
+}
+
+func newGCSFS(arguments []string) (*S3FS, error) {
+	if len(arguments) == 0 {
+		return nil, moerr.NewInvalidInputNoCtx("invalid GCS arguments")
+	}
+
+	// arguments
+	var endpoint, bucket, prefix, token, name string
+	for _, pair := range arguments {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, moerr.NewInvalidInputNoCtx("invalid GCS argument: %s", pair)
+		}
+		switch key {
+		case "endpoint":
+			endpoint = value
+		case "bucket":
+			bucket = value
+		case "prefix":
+			prefix = value
+		case "token":
+			token = value
+		case "name":
+			name = value
+		default:
+			return nil, moerr.NewInvalidInputNoCtx("invalid GCS argument: %s", pair)
+		}
+	}
+
+	if bucket == "" {
+		return nil, moerr.NewInvalidInputNoCtx("GCS bucket not specified")
+	}
+
+	// endpoint
+	if endpoint == "" {
+		endpoint = gcsDefaultEndpoint
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if endpointURL.Scheme == "" {
+		endpointURL.Scheme = "https"
+	}
+	endpoint = strings.TrimRight(endpointURL.String(), "/")
+
+	storage := &gcsStorage{
+		client:   newObjectStorageHTTPClient(),
+		endpoint: endpoint,
+		bucket:   bucket,
+	}
+
+	// credential
+	if token != "" {
+		storage.token = func(context.Context) (string, error) {
+			return token, nil
+		}
+	} else if endpoint == gcsDefaultEndpoint {
+		storage.token = newGCSMetadataTokenSource(storage.client)
+	}
+
+	// validate bucket
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := storage.checkBucket(ctx); err != nil {
+		return nil, moerr.NewInternalErrorNoCtx("bad GCS config: %v", err)
+	}
+
+	return newObjectStorageFS(name, storage, prefix), nil
+}
+
+// newGCSMetadataTokenSource returns a function that gets access tokens of the default
+// service account from the metadata server, tokens are cached until expired
+func newGCSMetadataTokenSource(client *http.Client) func(ctx context.Context) (string, error) {
+	var mu sync.Mutex
+	var token string
+	var expireAt time.Time
+	return func(ctx context.Context) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if token != "" && time.Now().Before(expireAt) {
+			return token, nil
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, gcsMetadataToken, nil)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Metadata-Flavor", "Google")
+		resp, err := client.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", newObjectStorageError("get access token", resp)
+		}
+		var result struct {
+			AccessToken string `json:"access_token"`
+			ExpiresIn   int64  `json:"expires_in"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return "", err
+		}
+		token = result.AccessToken
+		// refresh before expiration
+		expireAt = time.Now().Add(time.Duration(result.ExpiresIn)*time.Second - time.Minute)
+		return token, nil
+	}
+}
+
+func (g *gcsStorage) checkBucket(ctx context.Context) error {
+	resp, err := g.do(ctx, http.MethodGet, g.bucketURL(), nil, -1, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return newObjectStorageError("get bucket", resp)
+	}
+	return nil
+}
+
+type gcsObjectList struct {
+	Items         []gcsObject `json:"items"`
+	Prefixes      []string    `json:"prefixes"`
+	NextPageToken string      `json:"nextPageToken"`
+}
+
+// gcsObject is the object resource, size is a decimal string
+type gcsObject struct {
+	Name string `json:"name"`
+	Size string `json:"size"`
+}
+
+func (g *gcsStorage) List(ctx context.Context, prefix string, fn func(isPrefix bool, key string, size int64) (bool, error)) error {
+	var pageToken string
+	for {
+		query := url.Values{}
+		query.Set("delimiter", "/")
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if pageToken != "" {
+			query.Set("pageToken", pageToken)
+		}
+		if g.listMaxKeys > 0 {
+			query.Set("maxResults", strconv.Itoa(g.listMaxKeys))
+		}
+
+		resp, err := g.do(ctx, http.MethodGet, g.bucketURL()+"/o?"+query.Encode(), nil, -1, nil)
+		if err != nil {
+			return err
+		}
+		if resp.StatusCode != http.StatusOK {
+			err := newObjectStorageError("list objects", resp)
+			resp.Body.Close()
+			return err
+		}
+		var result gcsObjectList
+		err = json.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, item := range result.Items {
+			size, err := strconv.ParseInt(item.Size, 10, 64)
+			if err != nil {
+				return err
+			}
+			more, err := fn(false, item.Name, size)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+		for _, p := range result.Prefixes {
+			more, err := fn(true, p, 0)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		if result.NextPageToken == "" {
+			return nil
+		}
+		pageToken = result.NextPageToken
+	}
+}
+
+func (g *gcsStorage) Stat(ctx context.Context, key string) (int64, error) {
+	resp, err := g.do(ctx, http.MethodGet, g.objectURL(key), nil, -1, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return 0, moerr.NewFileNotFoundNoCtx(key)
+	default:
+		return 0, newObjectStorageError("get object", resp)
+	}
+	var result gcsObject
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(result.Size, 10, 64)
+}
+
+func (g *gcsStorage) Write(ctx context.Context, key string, r io.Reader, size int64, expire *time.Time) error {
+	// simple media upload does not carry object metadata,
+	// expiration should be done by lifecycle rules of the bucket
+	_ = expire
+	query := url.Values{}
+	query.Set("uploadType", "media")
+	query.Set("name", key)
+	u := g.endpoint + "/upload/storage/v1/b/" + url.PathEscape(g.bucket) + "/o?" + query.Encode()
+	header := http.Header{}
+	header.Set("Content-Type", "application/octet-stream")
+	resp, err := g.do(ctx, http.MethodPost, u, header, size, r)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return newObjectStorageError("upload object", resp)
+	}
+	return nil
+}
+
+func (g *gcsStorage) Read(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error) {
+	header := http.Header{}
+	if min > 0 || max >= 0 {
+		if max >= 0 {
+			header.Set("Range", fmt.Sprintf("bytes=%d-%d", min, max-1))
+		} else {
+			header.Set("Range", fmt.Sprintf("bytes=%d-", min))
+		}
+	}
+	resp, err := g.do(ctx, http.MethodGet, g.objectURL(key)+"?alt=media", header, -1, nil)
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent:
+		return resp.Body, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		// range out of object, let the caller report
+		resp.Body.Close()
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, moerr.NewFileNotFoundNoCtx(key)
+	default:
+		defer resp.Body.Close()
+		return nil, newObjectStorageError("get object media", resp)
+	}
+}
+
+func (g *gcsStorage) Delete(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		if err := g.delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *gcsStorage) delete(ctx context.Context, key string) error {
+	resp, err := g.do(ctx, http.MethodDelete, g.objectURL(key), nil, -1, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusNoContent, http.StatusOK, http.StatusNotFound:
+		return nil
+	default:
+		return newObjectStorageError("delete object", resp)
+	}
+}
+
+func (g *gcsStorage) bucketURL() string {
+	return g.endpoint + "/storage/v1/b/" + url.PathEscape(g.bucket)
+}
+
+func (g *gcsStorage) objectURL(key string) string {
+	// object names are escaped as a whole, including the slashes
+	return g.bucketURL() + "/o/" + url.PathEscape(key)
+}
+
+func (g *gcsStorage) do(
+	ctx context.Context,
+	method string,
+	u string,
+	header http.Header,
+	size int64,
+	body io.Reader,
+) (*http.Response, error) {
+	if body == nil {
+		body = http.NoBody
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	for k, vs := range header {
+		req.Header[k] = vs
+	}
+	if size >= 0 {
+		req.ContentLength = size
+		if size == 0 {
+			req.Body = http.NoBody
+		}
+	}
+	if g.token != nil {
+		token, err := g.token(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return g.client.Do(req)
+}
@@ -0,0 +1,289 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	gcsStubBucket = "test"
+	gcsStubToken  = "gcs-stub-token"
+)
+
+// gcsStub emulates the JSON API of google cloud storage, like fake-gcs-server
+type gcsStub struct {
+	store *stubObjectStore
+	// failures is the number of requests to fail with 503 before serving
+	failures atomic.Int32
+}
+
+func newGCSStub(t *testing.T) (*gcsStub, string) {
+	stub := &gcsStub{
+		store: newStubObjectStore(),
+	}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	return stub, server.URL
+}
+
+func (g *gcsStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if g.failures.Load() > 0 {
+		g.failures.Add(-1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	// auth
+	if r.Header.Get("Authorization") != "Bearer "+gcsStubToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+	bucketPath := "/storage/v1/b/" + gcsStubBucket
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/upload"+bucketPath+"/o":
+		if query.Get("uploadType") != "media" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		g.store.put(query.Get("name"), data)
+		g.writeObject(w, query.Get("name"), data)
+
+	case r.URL.Path == bucketPath:
+		g.writeJSON(w, map[string]string{
+			"name": gcsStubBucket,
+		})
+
+	case r.URL.Path == bucketPath+"/o":
+		max, _ := strconv.Atoi(query.Get("maxResults"))
+		keys, prefixes, next := g.store.list(query.Get("prefix"), query.Get("pageToken"), max)
+		var result gcsObjectList
+		for _, key := range keys {
+			data, _ := g.store.get(key)
+			result.Items = append(result.Items, gcsObject{
+				Name: key,
+				Size: strconv.Itoa(len(data)),
+			})
+		}
+		result.Prefixes = prefixes
+		result.NextPageToken = next
+		g.writeJSON(w, result)
+
+	case strings.HasPrefix(r.URL.Path, bucketPath+"/o/"):
+		name := strings.TrimPrefix(r.URL.Path, bucketPath+"/o/")
+		data, ok := g.store.get(name)
+		if r.Method == http.MethodDelete {
+			if !g.store.delete(name) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if query.Get("alt") != "media" {
+			g.writeObject(w, name, data)
+			return
+		}
+		start, end, status := parseStubRange(r.Header.Get("Range"), len(data))
+		if status >= 400 {
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write(data[start:end])
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (g *gcsStub) writeObject(w http.ResponseWriter, name string, data []byte) {
+	g.writeJSON(w, gcsObject{
+		Name: name,
+		Size: strconv.Itoa(len(data)),
+	})
+}
+
+func (g *gcsStub) writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestGCSFS(t *testing.T, endpoint string, name string, cacheConfig CacheConfig, noCache bool) *S3FS {
+	fs, err := NewGCSFS(
+		context.Background(),
+		name,
+		GCSConfig{
+			Endpoint:    endpoint,
+			Bucket:      gcsStubBucket,
+			KeyPrefix:   time.Now().Format("2006-01-02.15:04:05.000000"),
+			AccessToken: gcsStubToken,
+		},
+		cacheConfig,
+		nil,
+		noCache,
+	)
+	require.Nil(t, err)
+	return fs
+}
+
+func TestGCSFS(t *testing.T) {
+	_, endpoint := newGCSStub(t)
+
+	t.Run("file service", func(t *testing.T) {
+		testFileService(t, func(name string) FileService {
+			fs := newTestGCSFS(t, endpoint, name, DisabledCacheConfig, true)
+			fs.storage.(*gcsStorage).listMaxKeys = 5 // to test continuation
+			return fs
+		})
+	})
+
+	t.Run("mem caching file service", func(t *testing.T) {
+		testCachingFileService(t, func() CachingFileService {
+			return newTestGCSFS(t, endpoint, "gcs", CacheConfig{
+				MemoryCapacity: ptrTo[toml.ByteSize](128 * 1024),
+			}, false)
+		})
+	})
+
+	t.Run("disk caching file service", func(t *testing.T) {
+		testCachingFileService(t, func() CachingFileService {
+			return newTestGCSFS(t, endpoint, "gcs", CacheConfig{
+				MemoryCapacity: ptrTo[toml.ByteSize](1),
+				DiskCapacity:   ptrTo[toml.ByteSize](128 * 1024),
+				DiskPath:       ptrTo(t.TempDir()),
+			}, false)
+		})
+	})
+}
+
+func TestGCSFSRetry(t *testing.T) {
+	stub, endpoint := newGCSStub(t)
+	fs := newTestGCSFS(t, endpoint, "gcs", DisabledCacheConfig, true)
+	ctx := context.Background()
+
+	err := fs.Write(ctx, IOVector{
+		FilePath: "foo/bar",
+		Entries: []IOEntry{
+			{
+				Size: 3,
+				Data: []byte("abc"),
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	// server errors are retried
+	stub.failures.Store(2)
+	entry, err := fs.StatFile(ctx, "foo/bar")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), entry.Size)
+
+	stub.failures.Store(2)
+	vec := &IOVector{
+		FilePath: "foo/bar",
+		Entries: []IOEntry{
+			{
+				Offset: 1,
+				Size:   -1,
+			},
+		},
+	}
+	err = fs.Read(ctx, vec)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("bc"), vec.Entries[0].Data)
+
+	err = fs.Delete(ctx, "foo/bar")
+	assert.Nil(t, err)
+	_, err = fs.StatFile(ctx, "foo/bar")
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+}
+
+func TestGCSBadConfig(t *testing.T) {
+	_, endpoint := newGCSStub(t)
+
+	// bad token
+	_, err := newGCSFS([]string{
+		"endpoint=" + endpoint,
+		"bucket=" + gcsStubBucket,
+		"token=foo",
+	})
+	assert.NotNil(t, err)
+
+	// no such bucket
+	_, err = newGCSFS([]string{
+		"endpoint=" + endpoint,
+		"bucket=foo",
+		"token=" + gcsStubToken,
+	})
+	assert.NotNil(t, err)
+
+	// bad argument
+	_, err = newGCSFS([]string{
+		"foo=bar",
+	})
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+}
+
+func TestGCSFileServiceConfig(t *testing.T) {
+	_, endpoint := newGCSStub(t)
+	fs, err := NewFileService(context.Background(), Config{
+		Name:    "gcs",
+		Backend: "gcs",
+		GCS: GCSConfig{
+			Endpoint:    endpoint,
+			Bucket:      gcsStubBucket,
+			AccessToken: gcsStubToken,
+		},
+		Cache: DisabledCacheConfig,
+	}, nil)
+	assert.Nil(t, err)
+	_, ok := fs.(ETLFileService)
+	assert.True(t, ok)
+
+	res, err := GetForBackup(JoinPath(
+		"gcs-opts,endpoint="+endpoint+",bucket="+gcsStubBucket+",token="+gcsStubToken,
+		"backup",
+	))
+	assert.Nil(t, err)
+	assert.NotNil(t, res)
+}
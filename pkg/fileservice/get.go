@@ -59,6 +59,8 @@ func Get[T any](fs FileService, name string) (res T, err error) {
 // s3-no-key,<endpoint>,<region>,<bucket>,<prefix>
 // minio,<endpoint>,<region>,<bucket>,<key>,<secret>,<prefix>
 // s3-opts,endpoint=<endpoint>,region=<region>,bucket=<bucket>,key=<key>,secret=<secret>,prefix=<prefix>,role-arn=<role arn>,external-id=<external id>
+// azure-opts,endpoint=<endpoint>,account=<account>,key=<key>,sas=<sas token>,container=<container>,prefix=<prefix>
+// gcs-opts,endpoint=<endpoint>,bucket=<bucket>,token=<access token>,prefix=<prefix>
 //
 //	key value pairs can be in any order
 func GetForETL(fs FileService, path string) (res ETLFileService, readPath string, err error) {
@@ -138,6 +140,18 @@ func GetForETL(fs FileService, path string) (res ETLFileService, readPath string
 				return
 			}
 
+		case "azure-opts":
+			res, err = newAzureFS(fsPath.ServiceArguments)
+			if err != nil {
+				return
+			}
+
+		case "gcs-opts":
+			res, err = newGCSFS(fsPath.ServiceArguments)
+			if err != nil {
+				return
+			}
+
 		case "minio":
 			arguments := fsPath.ServiceArguments
 			if len(arguments) < 6 {
@@ -191,6 +205,8 @@ func GetForETL(fs FileService, path string) (res ETLFileService, readPath string
 // if service part of path is argumented, a FileService instance will be created dynamically with those arguments
 // supported dynamic file service:
 // s3-opts,endpoint=<endpoint>,region=<region>,bucket=<bucket>,key=<key>,secret=<secret>,prefix=<prefix>,role-arn=<role arn>,external-id=<external id>,is-minio=<is-minio>
+// azure-opts,endpoint=<endpoint>,account=<account>,key=<key>,sas=<sas token>,container=<container>,prefix=<prefix>
+// gcs-opts,endpoint=<endpoint>,bucket=<bucket>,token=<access token>,prefix=<prefix>
 func GetForBackup(spec string) (res FileService, err error) {
 	fsPath, err := ParsePath(spec)
 	if err != nil {
@@ -214,6 +230,18 @@ func GetForBackup(spec string) (res FileService, err error) {
 				return
 			}
 
+		case "azure-opts":
+			res, err = newAzureFS(fsPath.ServiceArguments)
+			if err != nil {
+				return
+			}
+
+		case "gcs-opts":
+			res, err = newGCSFS(fsPath.ServiceArguments)
+			if err != nil {
+				return
+			}
+
 		default:
 			err = moerr.NewInvalidInputNoCtx("no such service: %s", fsPath.Service)
 		}
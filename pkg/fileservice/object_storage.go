@@ -0,0 +1,84 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ObjectStorage is a flat key-value object store, like azure blob storage or
+// google cloud storage. Implementations only do one attempt for each call,
+// retrying, caching and path mapping are done by S3FS.
+type ObjectStorage interface {
+	// List lists objects and common prefixes directly under prefix, using "/" as delimiter.
+	// fn is called for each entry, and listing stops if fn returns false
+	List(ctx context.Context, prefix string, fn func(isPrefix bool, key string, size int64) (bool, error)) error
+	// Stat returns the size of the object
+	// returns ErrFileNotFound if the object does not exist
+	Stat(ctx context.Context, key string) (size int64, err error)
+	// Write writes the object with size bytes read from r
+	Write(ctx context.Context, key string, r io.Reader, size int64, expire *time.Time) error
+	// Read returns the content of the object in [min, max)
+	// max < 0 means reading to the end of the object
+	// returns ErrFileNotFound if the object does not exist
+	Read(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error)
+	// Delete deletes the objects, deleting a not existed object is not an error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// objectStorageError is a failed response of the http api of an object storage
+type objectStorageError struct {
+	op         string
+	statusCode int
+	message    string
+}
+
+func (e *objectStorageError) Error() string {
+	return fmt.Sprintf("%s: status code %d: %s", e.op, e.statusCode, e.message)
+}
+
+func newObjectStorageError(op string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	return &objectStorageError{
+		op:         op,
+		statusCode: resp.StatusCode,
+		message:    strings.TrimSpace(string(body)),
+	}
+}
+
+func newObjectStorageHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		KeepAlive: 5 * time.Second,
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			DialContext:           dialer.DialContext,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       180 * time.Second,
+			MaxIdleConnsPerHost:   100,
+			MaxConnsPerHost:       100,
+			TLSHandshakeTimeout:   3 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			ForceAttemptHTTP2:     true,
+		},
+	}
+}
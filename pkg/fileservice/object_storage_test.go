@@ -0,0 +1,148 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stubObjectStore is the in-memory storage of the emulator-style http stubs
+type stubObjectStore struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newStubObjectStore() *stubObjectStore {
+	return &stubObjectStore{
+		objects: make(map[string][]byte),
+	}
+}
+
+func (s *stubObjectStore) get(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.objects[key]
+	return data, ok
+}
+
+func (s *stubObjectStore) put(key string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key] = data
+}
+
+func (s *stubObjectStore) delete(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.objects[key]
+	delete(s.objects, key)
+	return ok
+}
+
+// list lists keys and common prefixes under prefix with "/" as delimiter, in key order.
+// marker is the first entry to return, and next is the marker of the next page
+func (s *stubObjectStore) list(prefix string, marker string, max int) (keys []string, prefixes []string, next string) {
+	s.mu.Lock()
+	var entries []string
+	seen := make(map[string]bool)
+	for key := range s.objects {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		entry := key
+		if i := strings.Index(key[len(prefix):], "/"); i >= 0 {
+			entry = key[:len(prefix)+i+1]
+		}
+		if !seen[entry] {
+			seen[entry] = true
+			entries = append(entries, entry)
+		}
+	}
+	s.mu.Unlock()
+	sort.Strings(entries)
+
+	for i, entry := range entries {
+		if entry < marker {
+			continue
+		}
+		if max > 0 && len(keys)+len(prefixes) == max {
+			next = entries[i]
+			break
+		}
+		if strings.HasSuffix(entry, "/") {
+			prefixes = append(prefixes, entry)
+		} else {
+			keys = append(keys, entry)
+		}
+	}
+	return
+}
+
+// parseStubRange parses a "bytes=<start>-[<end>]" range header against an object of size bytes
+func parseStubRange(spec string, size int) (start int, end int, status int) {
+	if spec == "" {
+		return 0, size, http.StatusOK
+	}
+	startStr, endStr, ok := strings.Cut(strings.TrimPrefix(spec, "bytes="), "-")
+	if !ok {
+		return 0, 0, http.StatusBadRequest
+	}
+	start, err := strconv.Atoi(startStr)
+	if err != nil {
+		return 0, 0, http.StatusBadRequest
+	}
+	end = size
+	if endStr != "" {
+		end, err = strconv.Atoi(endStr)
+		if err != nil {
+			return 0, 0, http.StatusBadRequest
+		}
+		end++
+	}
+	if start >= size {
+		return 0, 0, http.StatusRequestedRangeNotSatisfiable
+	}
+	if end > size {
+		end = size
+	}
+	return start, end, http.StatusPartialContent
+}
+
+func TestIsRetryableObjectStorageError(t *testing.T) {
+	for _, c := range []struct {
+		code      int
+		retryable bool
+	}{
+		{http.StatusNotFound, false},
+		{http.StatusForbidden, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusServiceUnavailable, true},
+	} {
+		err := fmt.Errorf("wrapped: %w", &objectStorageError{
+			op:         "get",
+			statusCode: c.code,
+		})
+		assert.Equal(t, c.retryable, isRetryableError(err), "status code %d", c.code)
+	}
+}
@@ -17,8 +17,6 @@ package fileservice
 import (
 	"bytes"
 	"context"
-	"errors"
-	"fmt"
 	"io"
 	"math"
 	"net"
@@ -32,10 +30,8 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
-	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
@@ -43,11 +39,11 @@ import (
 	"go.uber.org/zap"
 )
 
-// S3FS is a FileService implementation backed by S3
+// S3FS is a FileService implementation backed by S3, or by another ObjectStorage
+// like azure blob storage or google cloud storage
 type S3FS struct {
 	name      string
-	s3Client  *s3.Client
-	bucket    string
+	storage   ObjectStorage
 	keyPrefix string
 
 	memCache              *MemCache
@@ -56,7 +52,6 @@ type S3FS struct {
 	writeDiskCacheOnWrite bool
 
 	perfCounterSets []*perfcounter.CounterSet
-	listMaxKeys     int32
 
 	ioLocks IOLocks
 }
@@ -66,6 +61,15 @@ type S3FS struct {
 
 var _ FileService = new(S3FS)
 
+func newObjectStorageFS(name string, storage ObjectStorage, keyPrefix string) *S3FS {
+	return &S3FS{
+		name:        name,
+		storage:     storage,
+		keyPrefix:   keyPrefix,
+		asyncUpdate: true,
+	}
+}
+
 func NewS3FS(
 	ctx context.Context,
 	sharedConfigProfile string,
@@ -197,48 +201,20 @@ func (s *S3FS) List(ctx context.Context, dirPath string) (entries []DirEntry, er
 	if prefix != "" {
 		prefix += "/"
 	}
-	var marker *string
-
-	for {
-		output, err := s.s3ListObjects(
-			ctx,
-			&s3.ListObjectsInput{
-				Bucket:    ptrTo(s.bucket),
-				Delimiter: ptrTo("/"),
-				Prefix:    ptrTo(prefix),
-				Marker:    marker,
-				MaxKeys:   s.listMaxKeys,
-			},
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		for _, obj := range output.Contents {
-			filePath := s.keyToPath(*obj.Key)
-			filePath = strings.TrimRight(filePath, "/")
-			_, name := pathpkg.Split(filePath)
-			entries = append(entries, DirEntry{
-				Name:  name,
-				IsDir: false,
-				Size:  obj.Size,
-			})
-		}
 
-		for _, prefix := range output.CommonPrefixes {
-			filePath := s.keyToPath(*prefix.Prefix)
-			filePath = strings.TrimRight(filePath, "/")
-			_, name := pathpkg.Split(filePath)
-			entries = append(entries, DirEntry{
-				Name:  name,
-				IsDir: true,
-			})
-		}
-
-		if !output.IsTruncated {
-			break
-		}
-		marker = output.NextMarker
+	err = s.objectList(ctx, prefix, func(isPrefix bool, key string, size int64) (bool, error) {
+		filePath := s.keyToPath(key)
+		filePath = strings.TrimRight(filePath, "/")
+		_, name := pathpkg.Split(filePath)
+		entries = append(entries, DirEntry{
+			Name:  name,
+			IsDir: isPrefix,
+			Size:  size,
+		})
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return
@@ -260,19 +236,10 @@ func (s *S3FS) StatFile(ctx context.Context, filePath string) (*DirEntry, error)
 	}
 	key := s.pathToKey(path.File)
 
-	output, err := s.s3HeadObject(
-		ctx,
-		&s3.HeadObjectInput{
-			Bucket: ptrTo(s.bucket),
-			Key:    ptrTo(key),
-		},
-	)
+	size, err := s.objectStat(ctx, key)
 	if err != nil {
-		var httpError *http.ResponseError
-		if errors.As(err, &httpError) {
-			if httpError.Response.StatusCode == 404 {
-				return nil, moerr.NewFileNotFound(ctx, filePath)
-			}
+		if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return nil, moerr.NewFileNotFound(ctx, filePath)
 		}
 		return nil, err
 	}
@@ -280,7 +247,7 @@ func (s *S3FS) StatFile(ctx context.Context, filePath string) (*DirEntry, error)
 	return &DirEntry{
 		Name:  pathpkg.Base(filePath),
 		IsDir: false,
-		Size:  output.ContentLength,
+		Size:  size,
 	}, nil
 }
 
@@ -300,29 +267,14 @@ func (s *S3FS) Write(ctx context.Context, vector IOVector) error {
 		return err
 	}
 	key := s.pathToKey(path.File)
-	output, err := s.s3HeadObject(
-		ctx,
-		&s3.HeadObjectInput{
-			Bucket: ptrTo(s.bucket),
-			Key:    ptrTo(key),
-		},
-	)
-	if err != nil {
-		var httpError *http.ResponseError
-		if errors.As(err, &httpError) {
-			if httpError.Response.StatusCode == 404 {
-				// key not exists, ok
-				err = nil
-			}
-		}
-		if err != nil {
-			return err
-		}
-	}
-	if output != nil {
+	_, err = s.objectStat(ctx, key)
+	if err == nil {
 		// key existed
 		return moerr.NewFileAlreadyExistsNoCtx(path.File)
 	}
+	if !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		return err
+	}
 
 	return s.write(ctx, vector)
 }
@@ -401,21 +353,7 @@ func (s *S3FS) write(ctx context.Context, vector IOVector) (err error) {
 	if !vector.ExpireAt.IsZero() {
 		expire = &vector.ExpireAt
 	}
-	_, err = s.s3PutObject(
-		ctx,
-		&s3.PutObjectInput{
-			Bucket:        ptrTo(s.bucket),
-			Key:           ptrTo(key),
-			Body:          r,
-			ContentLength: size,
-			Expires:       expire,
-		},
-	)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return s.objectWrite(ctx, key, r, size, expire)
 }
 
 func (s *S3FS) Read(ctx context.Context, vector *IOVector) (err error) {
@@ -537,32 +475,10 @@ func (s *S3FS) read(ctx context.Context, vector *IOVector) error {
 		defer spanR.End()
 
 		if readToEnd {
-			r, err := s.s3GetObject(
-				ctx,
-				min,
-				-1,
-				&s3.GetObjectInput{
-					Bucket: ptrTo(s.bucket),
-					Key:    ptrTo(key),
-				},
-			)
-			err = s.mapError(err, key)
-			if err != nil {
-				return nil, err
-			}
-			return r, nil
+			return s.objectRead(ctx, key, min, -1)
 		}
 
-		r, err := s.s3GetObject(
-			ctx,
-			min,
-			max,
-			&s3.GetObjectInput{
-				Bucket: ptrTo(s.bucket),
-				Key:    ptrTo(key),
-			},
-		)
-		err = s.mapError(err, key)
+		r, err := s.objectRead(ctx, key, min, max)
 		if err != nil {
 			return nil, err
 		}
@@ -594,7 +510,6 @@ func (s *S3FS) read(ctx context.Context, vector *IOVector) error {
 		}
 		defer reader.Close()
 		bs, err = io.ReadAll(reader)
-		err = s.mapError(err, key)
 		if err != nil {
 			return nil, err
 		}
@@ -668,7 +583,6 @@ func (s *S3FS) read(ctx context.Context, vector *IOVector) error {
 				put := ioBufferPool.Get(&buf)
 				defer put.Put()
 				_, err = io.CopyBuffer(w, reader, buf)
-				err = s.mapError(err, key)
 				if err != nil {
 					return err
 				}
@@ -734,83 +648,18 @@ func (s *S3FS) Delete(ctx context.Context, filePaths ...string) error {
 	ctx, span := trace.Start(ctx, "S3FS.Delete")
 	defer span.End()
 
-	if len(filePaths) == 0 {
-		return nil
-	}
-	if len(filePaths) == 1 {
-		return s.deleteSingle(ctx, filePaths[0])
-	}
-
-	objs := make([]types.ObjectIdentifier, 0, 1000)
+	keys := make([]string, 0, len(filePaths))
 	for _, filePath := range filePaths {
 		path, err := ParsePathAtService(filePath, s.name)
 		if err != nil {
 			return err
 		}
-		objs = append(objs, types.ObjectIdentifier{Key: ptrTo(s.pathToKey(path.File))})
-		if len(objs) == 1000 {
-			if err := s.deleteMultiObj(ctx, objs); err != nil {
-				return err
-			}
-			objs = objs[:0]
-		}
-	}
-	if err := s.deleteMultiObj(ctx, objs); err != nil {
-		return err
+		keys = append(keys, s.pathToKey(path.File))
 	}
-	return nil
-}
-
-func (s *S3FS) deleteMultiObj(ctx context.Context, objs []types.ObjectIdentifier) error {
-	ctx, span := trace.Start(ctx, "S3FS.deleteMultiObj")
-	defer span.End()
-	output, err := s.s3DeleteObjects(ctx, &s3.DeleteObjectsInput{
-		Bucket: ptrTo(s.bucket),
-		Delete: &types.Delete{
-			Objects: objs,
-			// In quiet mode the response includes only keys where the delete action encountered an error.
-			Quiet: true,
-		},
-	})
-	// delete api failed
-	if err != nil {
-		return err
-	}
-	// delete api success, but with delete file failed.
-	message := strings.Builder{}
-	if len(output.Errors) > 0 {
-		for _, Error := range output.Errors {
-			if *Error.Code == (*types.NoSuchKey)(nil).ErrorCode() {
-				continue
-			}
-			message.WriteString(fmt.Sprintf("%s: %s, %s;", *Error.Key, *Error.Code, *Error.Message))
-		}
-	}
-	if message.Len() > 0 {
-		return moerr.NewInternalErrorNoCtx("S3 Delete failed: %s", message.String())
-	}
-	return nil
-}
-
-func (s *S3FS) deleteSingle(ctx context.Context, filePath string) error {
-	ctx, span := trace.Start(ctx, "S3FS.deleteSingle")
-	defer span.End()
-	path, err := ParsePathAtService(filePath, s.name)
-	if err != nil {
-		return err
-	}
-	_, err = s.s3DeleteObject(
-		ctx,
-		&s3.DeleteObjectInput{
-			Bucket: ptrTo(s.bucket),
-			Key:    ptrTo(s.pathToKey(path.File)),
-		},
-	)
-	if err != nil {
-		return err
+	if len(keys) == 0 {
+		return nil
 	}
-
-	return nil
+	return s.objectDelete(ctx, keys...)
 }
 
 func (s *S3FS) pathToKey(filePath string) string {
@@ -823,19 +672,6 @@ func (s *S3FS) keyToPath(key string) string {
 	return path
 }
 
-func (s *S3FS) mapError(err error, path string) error {
-	if err == nil {
-		return nil
-	}
-	var httpError *http.ResponseError
-	if errors.As(err, &httpError) {
-		if httpError.Response.StatusCode == 404 {
-			return moerr.NewFileNotFoundNoCtx(path)
-		}
-	}
-	return err
-}
-
 var _ ETLFileService = new(S3FS)
 
 func (*S3FS) ETLCompatible() {}
@@ -1063,29 +899,31 @@ func newS3FS(arguments []string) (*S3FS, error) {
 		s3Options...,
 	)
 
-	fs := &S3FS{
-		name:        name,
-		s3Client:    client,
-		bucket:      bucket,
-		keyPrefix:   prefix,
-		asyncUpdate: true,
+	storage := &s3Storage{
+		client: client,
+		bucket: bucket,
 	}
 
 	// head bucket to validate
-	_, err = fs.s3HeadBucket(ctx, &s3.HeadBucketInput{
-		Bucket: ptrTo(bucket),
-	})
+	_, err = doWithRetry(
+		"s3 head bucket",
+		func() (struct{}, error) {
+			return struct{}{}, storage.headBucket(ctx)
+		},
+		maxRetryAttemps,
+		isRetryableError,
+	)
 	if err != nil {
 		return nil, moerr.NewInternalErrorNoCtx("bad s3 config: %v", err)
 	}
 
-	return fs, nil
+	return newObjectStorageFS(name, storage, prefix), nil
 }
 
 const maxRetryAttemps = 128
 
-func (s *S3FS) s3ListObjects(ctx context.Context, params *s3.ListObjectsInput, optFns ...func(*s3.Options)) (*s3.ListObjectsOutput, error) {
-	ctx, task := gotrace.NewTask(ctx, "S3FS.s3ListObjects")
+func (s *S3FS) objectList(ctx context.Context, prefix string, fn func(isPrefix bool, key string, size int64) (bool, error)) error {
+	ctx, task := gotrace.NewTask(ctx, "S3FS.objectList")
 	defer task.End()
 	t0 := time.Now()
 	defer func() {
@@ -1094,31 +932,45 @@ func (s *S3FS) s3ListObjects(ctx context.Context, params *s3.ListObjectsInput, o
 	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
 		counter.FileService.S3.List.Add(1)
 	}, s.perfCounterSets...)
-	return doWithRetry(
-		"s3 list objects",
-		func() (*s3.ListObjectsOutput, error) {
-			return s.s3Client.ListObjects(ctx, params, optFns...)
-		},
-		maxRetryAttemps,
-		isRetryableError,
-	)
-}
-
-func (s *S3FS) s3HeadBucket(ctx context.Context, params *s3.HeadBucketInput, optFns ...func(*s3.Options)) (*s3.HeadBucketOutput, error) {
-	ctx, task := gotrace.NewTask(ctx, "S3FS.s3HeadBucket")
-	defer task.End()
-	return doWithRetry(
-		"s3 head bucket",
-		func() (*s3.HeadBucketOutput, error) {
-			return s.s3Client.HeadBucket(ctx, params, optFns...)
+	type listEntry struct {
+		isPrefix bool
+		key      string
+		size     int64
+	}
+	// collect entries first, so a retried listing does not feed fn twice
+	entries, err := doWithRetry(
+		"object storage list",
+		func() (entries []listEntry, err error) {
+			err = s.storage.List(ctx, prefix, func(isPrefix bool, key string, size int64) (bool, error) {
+				entries = append(entries, listEntry{
+					isPrefix: isPrefix,
+					key:      key,
+					size:     size,
+				})
+				return true, nil
+			})
+			return
 		},
 		maxRetryAttemps,
 		isRetryableError,
 	)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		more, err := fn(entry.isPrefix, entry.key, entry.size)
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+	}
+	return nil
 }
 
-func (s *S3FS) s3HeadObject(ctx context.Context, params *s3.HeadObjectInput, optFns ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
-	ctx, task := gotrace.NewTask(ctx, "S3FS.s3HeadObject")
+func (s *S3FS) objectStat(ctx context.Context, key string) (int64, error) {
+	ctx, task := gotrace.NewTask(ctx, "S3FS.objectStat")
 	defer task.End()
 	t0 := time.Now()
 	defer func() {
@@ -1128,17 +980,17 @@ func (s *S3FS) s3HeadObject(ctx context.Context, params *s3.HeadObjectInput, opt
 		counter.FileService.S3.Head.Add(1)
 	}, s.perfCounterSets...)
 	return doWithRetry(
-		"s3 head object",
-		func() (*s3.HeadObjectOutput, error) {
-			return s.s3Client.HeadObject(ctx, params, optFns...)
+		"object storage stat",
+		func() (int64, error) {
+			return s.storage.Stat(ctx, key)
 		},
 		maxRetryAttemps,
 		isRetryableError,
 	)
 }
 
-func (s *S3FS) s3PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
-	ctx, task := gotrace.NewTask(ctx, "S3FS.s3PutObject")
+func (s *S3FS) objectWrite(ctx context.Context, key string, r io.Reader, size int64, expire *time.Time) error {
+	ctx, task := gotrace.NewTask(ctx, "S3FS.objectWrite")
 	defer task.End()
 	t0 := time.Now()
 	defer func() {
@@ -1148,11 +1000,11 @@ func (s *S3FS) s3PutObject(ctx context.Context, params *s3.PutObjectInput, optFn
 		counter.FileService.S3.Put.Add(1)
 	}, s.perfCounterSets...)
 	// not retryable because Reader may be half consumed
-	return s.s3Client.PutObject(ctx, params, optFns...)
+	return s.storage.Write(ctx, key, r, size, expire)
 }
 
-func (s *S3FS) s3GetObject(ctx context.Context, min int64, max int64, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (io.ReadCloser, error) {
-	ctx, task := gotrace.NewTask(ctx, "S3FS.s3GetObject")
+func (s *S3FS) objectRead(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error) {
+	ctx, task := gotrace.NewTask(ctx, "S3FS.objectRead")
 	defer task.End()
 	t0 := time.Now()
 	defer func() {
@@ -1163,25 +1015,14 @@ func (s *S3FS) s3GetObject(ctx context.Context, min int64, max int64, params *s3
 	}, s.perfCounterSets...)
 	r, err := newRetryableReader(
 		func(offset int64) (io.ReadCloser, error) {
-			var rang string
-			if max >= 0 {
-				rang = fmt.Sprintf("bytes=%d-%d", offset, max)
-			} else {
-				rang = fmt.Sprintf("bytes=%d-", offset)
-			}
-			params.Range = &rang
-			output, err := doWithRetry(
-				"s3 get object",
-				func() (*s3.GetObjectOutput, error) {
-					return s.s3Client.GetObject(ctx, params, optFns...)
+			return doWithRetry(
+				"object storage read",
+				func() (io.ReadCloser, error) {
+					return s.storage.Read(ctx, key, offset, max)
 				},
 				maxRetryAttemps,
 				isRetryableError,
 			)
-			if err != nil {
-				return nil, err
-			}
-			return output.Body, nil
 		},
 		min,
 		isRetryableError,
@@ -1189,47 +1030,38 @@ func (s *S3FS) s3GetObject(ctx context.Context, min int64, max int64, params *s3
 	if err != nil {
 		return nil, err
 	}
-	return r, nil
-}
-
-func (s *S3FS) s3DeleteObjects(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
-	ctx, task := gotrace.NewTask(ctx, "S3FS.s3DeleteObjects")
-	defer task.End()
-	t0 := time.Now()
-	defer func() {
-		FSProfileHandler.AddSample(time.Since(t0))
-	}()
-	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
-		counter.FileService.S3.DeleteMulti.Add(1)
-	}, s.perfCounterSets...)
-	return doWithRetry(
-		"s3 delete objects",
-		func() (*s3.DeleteObjectsOutput, error) {
-			return s.s3Client.DeleteObjects(ctx, params, optFns...)
-		},
-		maxRetryAttemps,
-		isRetryableError,
-	)
+	if max < 0 {
+		return r, nil
+	}
+	return &readCloser{
+		r:         io.LimitReader(r, max-min),
+		closeFunc: r.Close,
+	}, nil
 }
 
-func (s *S3FS) s3DeleteObject(ctx context.Context, params *s3.DeleteObjectInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
-	ctx, task := gotrace.NewTask(ctx, "S3FS.s3DeleteObject")
+func (s *S3FS) objectDelete(ctx context.Context, keys ...string) error {
+	ctx, task := gotrace.NewTask(ctx, "S3FS.objectDelete")
 	defer task.End()
 	t0 := time.Now()
 	defer func() {
 		FSProfileHandler.AddSample(time.Since(t0))
 	}()
 	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
-		counter.FileService.S3.Delete.Add(1)
+		if len(keys) == 1 {
+			counter.FileService.S3.Delete.Add(1)
+		} else {
+			counter.FileService.S3.DeleteMulti.Add(1)
+		}
 	}, s.perfCounterSets...)
-	return doWithRetry(
-		"s3 delete object",
-		func() (*s3.DeleteObjectOutput, error) {
-			return s.s3Client.DeleteObject(ctx, params, optFns...)
+	_, err := doWithRetry(
+		"object storage delete",
+		func() (struct{}, error) {
+			return struct{}{}, s.storage.Delete(ctx, keys...)
 		},
 		maxRetryAttemps,
 		isRetryableError,
 	)
+	return err
 }
 
 // from https://github.com/aws/aws-sdk-go-v2/issues/543
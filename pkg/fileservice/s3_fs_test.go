@@ -108,7 +108,7 @@ func TestS3FS(t *testing.T) {
 				true,
 			)
 			assert.Nil(t, err)
-			fs.listMaxKeys = 5 // to test continuation
+			fs.storage.(*s3Storage).listMaxKeys = 5 // to test continuation
 			return fs
 		})
 	})
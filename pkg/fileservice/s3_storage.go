@@ -0,0 +1,209 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// s3Storage implements ObjectStorage with the aws sdk
+type s3Storage struct {
+	client      *s3.Client
+	bucket      string
+	listMaxKeys int32
+}
+
+var _ ObjectStorage = new(s3Storage)
+
+func (s *s3Storage) headBucket(ctx context.Context) error {
+	_, err := s.client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: ptrTo(s.bucket),
+	})
+	return err
+}
+
+func (s *s3Storage) List(ctx context.Context, prefix string, fn func(isPrefix bool, key string, size int64) (bool, error)) error {
+	var marker *string
+	for {
+		output, err := s.client.ListObjects(
+			ctx,
+			&s3.ListObjectsInput{
+				Bucket:    ptrTo(s.bucket),
+				Delimiter: ptrTo("/"),
+				Prefix:    ptrTo(prefix),
+				Marker:    marker,
+				MaxKeys:   s.listMaxKeys,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, obj := range output.Contents {
+			more, err := fn(false, *obj.Key, obj.Size)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		for _, prefix := range output.CommonPrefixes {
+			more, err := fn(true, *prefix.Prefix, 0)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		if !output.IsTruncated {
+			return nil
+		}
+		marker = output.NextMarker
+	}
+}
+
+func (s *s3Storage) Stat(ctx context.Context, key string) (int64, error) {
+	output, err := s.client.HeadObject(
+		ctx,
+		&s3.HeadObjectInput{
+			Bucket: ptrTo(s.bucket),
+			Key:    ptrTo(key),
+		},
+	)
+	if err != nil {
+		return 0, s.mapError(err, key)
+	}
+	return output.ContentLength, nil
+}
+
+func (s *s3Storage) Write(ctx context.Context, key string, r io.Reader, size int64, expire *time.Time) error {
+	_, err := s.client.PutObject(
+		ctx,
+		&s3.PutObjectInput{
+			Bucket:        ptrTo(s.bucket),
+			Key:           ptrTo(key),
+			Body:          r,
+			ContentLength: size,
+			Expires:       expire,
+		},
+	)
+	return err
+}
+
+func (s *s3Storage) Read(ctx context.Context, key string, min int64, max int64) (io.ReadCloser, error) {
+	var rang string
+	if max >= 0 {
+		rang = fmt.Sprintf("bytes=%d-%d", min, max-1)
+	} else {
+		rang = fmt.Sprintf("bytes=%d-", min)
+	}
+	output, err := s.client.GetObject(
+		ctx,
+		&s3.GetObjectInput{
+			Bucket: ptrTo(s.bucket),
+			Key:    ptrTo(key),
+			Range:  ptrTo(rang),
+		},
+	)
+	if err != nil {
+		return nil, s.mapError(err, key)
+	}
+	return output.Body, nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	if len(keys) == 1 {
+		_, err := s.client.DeleteObject(
+			ctx,
+			&s3.DeleteObjectInput{
+				Bucket: ptrTo(s.bucket),
+				Key:    ptrTo(keys[0]),
+			},
+		)
+		return err
+	}
+
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > 1000 {
+			n = 1000
+		}
+		objs := make([]types.ObjectIdentifier, 0, n)
+		for _, key := range keys[:n] {
+			objs = append(objs, types.ObjectIdentifier{Key: ptrTo(key)})
+		}
+		if err := s.deleteMultiObj(ctx, objs); err != nil {
+			return err
+		}
+		keys = keys[n:]
+	}
+	return nil
+}
+
+func (s *s3Storage) deleteMultiObj(ctx context.Context, objs []types.ObjectIdentifier) error {
+	output, err := s.client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+		Bucket: ptrTo(s.bucket),
+		Delete: &types.Delete{
+			Objects: objs,
+			// In quiet mode the response includes only keys where the delete action encountered an error.
+			Quiet: true,
+		},
+	})
+	// delete api failed
+	if err != nil {
+		return err
+	}
+	// delete api success, but with delete file failed.
+	message := strings.Builder{}
+	if len(output.Errors) > 0 {
+		for _, Error := range output.Errors {
+			if *Error.Code == (*types.NoSuchKey)(nil).ErrorCode() {
+				continue
+			}
+			message.WriteString(fmt.Sprintf("%s: %s, %s;", *Error.Key, *Error.Code, *Error.Message))
+		}
+	}
+	if message.Len() > 0 {
+		return moerr.NewInternalErrorNoCtx("S3 Delete failed: %s", message.String())
+	}
+	return nil
+}
+
+func (s *s3Storage) mapError(err error, key string) error {
+	var httpError *http.ResponseError
+	if errors.As(err, &httpError) {
+		if httpError.Response.StatusCode == 404 {
+			return moerr.NewFileNotFoundNoCtx(key)
+		}
+	}
+	return err
+}
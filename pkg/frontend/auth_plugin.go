@@ -0,0 +1,360 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// the first byte of the AuthMoreData packet
+const authMoreDataHeader byte = 0x01
+
+// the data in the exchange of caching_sha2_password
+const (
+	cachingSha2RequestPublicKey byte = 0x02
+	cachingSha2FastAuthSuccess  byte = 0x03
+	cachingSha2PerformFullAuth  byte = 0x04
+)
+
+// the max count of the users whose digest is cached by caching_sha2_password
+const cachingSha2MaxCacheSize = 10000
+
+// AuthConn is the connection in the handshake seen by the AuthPlugin.
+type AuthConn interface {
+	// GetSalt returns the random data sent in the initial handshake.
+	GetSalt() []byte
+	// IsSecure returns true if the password can be sent in cleartext on the connection.
+	IsSecure() bool
+	// WriteAuthMoreData sends the AuthMoreData packet with the data to the client.
+	WriteAuthMoreData(data []byte) error
+	// ReadAuthData reads the next auth data from the client.
+	ReadAuthData(ctx context.Context) ([]byte, error)
+	// RSAKey returns the key to decrypt the password sent on the insecure connection.
+	RSAKey() (*rsa.PrivateKey, error)
+}
+
+// AuthPlugin is the server side of an authentication method in the handshake.
+// The plugins run after the account and the user are looked up, so an external
+// authentication, like LDAP, can be added as a plugin that checks the cleartext
+// password with the external service instead of the stored one.
+type AuthPlugin interface {
+	// Name returns the name of the authentication method in the protocol.
+	Name() string
+	// Authenticate checks the auth response from the client. pwd is the password
+	// stored for the user, which is SHA1(SHA1(password)). More data can be
+	// exchanged with the client by the conn.
+	Authenticate(ctx context.Context, conn AuthConn, user string, pwd []byte, authResponse []byte) error
+}
+
+var authPlugins = struct {
+	sync.RWMutex
+	plugins map[string]AuthPlugin
+}{
+	plugins: make(map[string]AuthPlugin),
+}
+
+func init() {
+	RegisterAuthPlugin(&nativePasswordPlugin{})
+	RegisterAuthPlugin(newCachingSha2PasswordPlugin())
+	RegisterAuthPlugin(&clearPasswordPlugin{})
+}
+
+// RegisterAuthPlugin adds the authentication method. The plugin with the same name is replaced.
+func RegisterAuthPlugin(plugin AuthPlugin) {
+	authPlugins.Lock()
+	defer authPlugins.Unlock()
+	authPlugins.plugins[plugin.Name()] = plugin
+}
+
+func getAuthPlugin(name string) (AuthPlugin, bool) {
+	authPlugins.RLock()
+	defer authPlugins.RUnlock()
+	plugin, ok := authPlugins.plugins[name]
+	return plugin, ok
+}
+
+// checkClearPassword checks the cleartext password against the stored SHA1(SHA1(password))
+func checkClearPassword(pwd, password []byte) bool {
+	return bytes.Equal(pwd, HashSha1(HashSha1(password)))
+}
+
+// nativePasswordPlugin is mysql_native_password.
+// the client sends SHA1(password) XOR SHA1(salt + SHA1(SHA1(password))).
+type nativePasswordPlugin struct{}
+
+func (p *nativePasswordPlugin) Name() string {
+	return AuthNativePassword
+}
+
+func (p *nativePasswordPlugin) Authenticate(ctx context.Context, conn AuthConn, user string, pwd []byte, authResponse []byte) error {
+	if !checkNativePassword(pwd, conn.GetSalt(), authResponse) {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	return nil
+}
+
+// checkNativePassword checks the auth response of mysql_native_password.
+// pwd is SHA1(SHA1(password)), auth is from client
+// hash1 = AUTH XOR SHA1( slat + pwd)
+// hash2 = SHA1(hash1)
+// check(hash2, hpwd)
+func checkNativePassword(pwd, salt, auth []byte) bool {
+	sha := sha1.New()
+	sha.Write(salt)
+	sha.Write(pwd)
+	hash1 := sha.Sum(nil)
+
+	if len(auth) != len(hash1) {
+		return false
+	}
+
+	for i := range hash1 {
+		hash1[i] ^= auth[i]
+	}
+
+	hash2 := HashSha1(hash1)
+	return bytes.Equal(pwd, hash2)
+}
+
+// makeNativePasswordScramble makes the auth response of mysql_native_password
+// from the cleartext password, as the client does.
+// SHA1(password) XOR SHA1(salt + SHA1(SHA1(password)))
+func makeNativePasswordScramble(password, salt []byte) []byte {
+	hash1 := HashSha1(password)
+	sha := sha1.New()
+	sha.Write(salt)
+	sha.Write(HashSha1(hash1))
+	scramble := sha.Sum(nil)
+	for i := range scramble {
+		scramble[i] ^= hash1[i]
+	}
+	return scramble
+}
+
+// clearPasswordPlugin is mysql_clear_password.
+// It is only accepted on the secure connection.
+type clearPasswordPlugin struct{}
+
+func (p *clearPasswordPlugin) Name() string {
+	return AuthClearPassword
+}
+
+func (p *clearPasswordPlugin) Authenticate(ctx context.Context, conn AuthConn, user string, pwd []byte, authResponse []byte) error {
+	if !conn.IsSecure() {
+		return moerr.NewInternalError(ctx, "%s requires secure connection", AuthClearPassword)
+	}
+	if !checkClearPassword(pwd, bytes.TrimSuffix(authResponse, []byte{0})) {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	return nil
+}
+
+// cachingSha2PasswordPlugin is caching_sha2_password.
+// the client sends SHA256(password) XOR SHA256(SHA256(SHA256(password)) + salt).
+// The server only stores SHA1(SHA1(password)), so the fast authentication is possible
+// after SHA256(SHA256(password)) of the user is cached by a full authentication, in which
+// the client sends the password on the secure connection or encrypted by the RSA key.
+type cachingSha2PasswordPlugin struct {
+	mu sync.Mutex
+	// user -> the cached digest
+	cache map[string]cachingSha2Entry
+}
+
+type cachingSha2Entry struct {
+	// the stored password when the digest was cached. The entry is stale
+	// after the password is changed.
+	pwd []byte
+	// SHA256(SHA256(password))
+	digest []byte
+}
+
+func newCachingSha2PasswordPlugin() *cachingSha2PasswordPlugin {
+	return &cachingSha2PasswordPlugin{
+		cache: make(map[string]cachingSha2Entry),
+	}
+}
+
+func (p *cachingSha2PasswordPlugin) Name() string {
+	return AuthCachingSha2Password
+}
+
+func (p *cachingSha2PasswordPlugin) Authenticate(ctx context.Context, conn AuthConn, user string, pwd []byte, authResponse []byte) error {
+	// the empty password
+	if len(authResponse) == 0 {
+		if !checkClearPassword(pwd, nil) {
+			return moerr.NewInternalError(ctx, "check password failed")
+		}
+		return nil
+	}
+
+	if digest, ok := p.getDigest(user, pwd); ok && checkCachingSha2Scramble(digest, conn.GetSalt(), authResponse) {
+		return conn.WriteAuthMoreData([]byte{cachingSha2FastAuthSuccess})
+	}
+
+	password, err := readCachingSha2Password(ctx, conn)
+	if err != nil {
+		return err
+	}
+	if !checkClearPassword(pwd, password) {
+		return moerr.NewInternalError(ctx, "check password failed")
+	}
+	p.setDigest(user, pwd, password)
+	return nil
+}
+
+// readCachingSha2Password asks the client to do the full authentication of caching_sha2_password.
+// The client sends the password on the secure connection, or encrypted by the RSA key.
+// return the cleartext password
+func readCachingSha2Password(ctx context.Context, conn AuthConn) ([]byte, error) {
+	if err := conn.WriteAuthMoreData([]byte{cachingSha2PerformFullAuth}); err != nil {
+		return nil, err
+	}
+	data, err := conn.ReadAuthData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	requestPublicKey := len(data) == 1 && data[0] == cachingSha2RequestPublicKey
+	if conn.IsSecure() && !requestPublicKey {
+		return bytes.TrimSuffix(data, []byte{0}), nil
+	}
+
+	key, err := conn.RSAKey()
+	if err != nil {
+		return nil, err
+	}
+	if requestPublicKey {
+		pubKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if err = conn.WriteAuthMoreData(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubKey})); err != nil {
+			return nil, err
+		}
+		if data, err = conn.ReadAuthData(ctx); err != nil {
+			return nil, err
+		}
+	}
+	password, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, key, data, nil)
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "decrypt password failed")
+	}
+	// the password is xor with the salt before encrypted
+	salt := conn.GetSalt()
+	for i := range password {
+		password[i] ^= salt[i%len(salt)]
+	}
+	return bytes.TrimSuffix(password, []byte{0}), nil
+}
+
+func (p *cachingSha2PasswordPlugin) getDigest(user string, pwd []byte) ([]byte, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	entry, ok := p.cache[user]
+	if !ok || !bytes.Equal(entry.pwd, pwd) {
+		return nil, false
+	}
+	return entry.digest, true
+}
+
+func (p *cachingSha2PasswordPlugin) setDigest(user string, pwd []byte, password []byte) {
+	hash1 := sha256.Sum256(password)
+	digest := sha256.Sum256(hash1[:])
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.cache) >= cachingSha2MaxCacheSize {
+		p.cache = make(map[string]cachingSha2Entry)
+	}
+	p.cache[user] = cachingSha2Entry{
+		pwd:    pwd,
+		digest: digest[:],
+	}
+}
+
+// checkCachingSha2Scramble checks the scramble of caching_sha2_password.
+// digest is SHA256(SHA256(password)), scramble is from client
+// hash1 = scramble XOR SHA256(digest + salt), which is SHA256(password)
+// check(SHA256(hash1), digest)
+func checkCachingSha2Scramble(digest, salt, scramble []byte) bool {
+	sha := sha256.New()
+	sha.Write(digest)
+	sha.Write(salt)
+	hash1 := sha.Sum(nil)
+	if len(scramble) != len(hash1) {
+		return false
+	}
+	for i := range hash1 {
+		hash1[i] ^= scramble[i]
+	}
+	hash2 := sha256.Sum256(hash1)
+	return bytes.Equal(hash2[:], digest)
+}
+
+var rsaKeys = struct {
+	sync.Mutex
+	// the file path -> the key. The key generated is saved with the empty path.
+	keys map[string]*rsa.PrivateKey
+}{
+	keys: make(map[string]*rsa.PrivateKey),
+}
+
+// loadRSAKey loads the RSA private key in PEM format from the file,
+// or generates one if the file is not specified.
+func loadRSAKey(ctx context.Context, file string) (*rsa.PrivateKey, error) {
+	rsaKeys.Lock()
+	defer rsaKeys.Unlock()
+	if key, ok := rsaKeys.keys[file]; ok {
+		return key, nil
+	}
+
+	var key *rsa.PrivateKey
+	var err error
+	if file == "" {
+		if key, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+			return nil, err
+		}
+	} else {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		block, _ := pem.Decode(data)
+		if block == nil {
+			return nil, moerr.NewInvalidInput(ctx, "no PEM data in the RSA private key file %s", file)
+		}
+		if key, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			parsed, err2 := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err2 != nil {
+				return nil, err
+			}
+			var ok bool
+			if key, ok = parsed.(*rsa.PrivateKey); !ok {
+				return nil, moerr.NewInvalidInput(ctx, "the key in %s is not RSA private key", file)
+			}
+		}
+	}
+	rsaKeys.keys[file] = key
+	return key, nil
+}
@@ -0,0 +1,287 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/stretchr/testify/require"
+)
+
+// makeCachingSha2Scramble makes the scramble of caching_sha2_password as the client
+func makeCachingSha2Scramble(password, salt []byte) []byte {
+	hash1 := sha256.Sum256(password)
+	hash2 := sha256.Sum256(hash1[:])
+	sha := sha256.New()
+	sha.Write(hash2[:])
+	sha.Write(salt)
+	scramble := sha.Sum(nil)
+	for i := range scramble {
+		scramble[i] ^= hash1[i]
+	}
+	return scramble
+}
+
+// testAuthConn plays the client in the exchange of the auth data
+type testAuthConn struct {
+	salt    []byte
+	secure  bool
+	key     *rsa.PrivateKey
+	written [][]byte
+	// reply makes the auth data for the AuthMoreData written
+	reply func(data []byte) []byte
+}
+
+func (c *testAuthConn) GetSalt() []byte {
+	return c.salt
+}
+
+func (c *testAuthConn) IsSecure() bool {
+	return c.secure
+}
+
+func (c *testAuthConn) WriteAuthMoreData(data []byte) error {
+	c.written = append(c.written, data)
+	return nil
+}
+
+func (c *testAuthConn) ReadAuthData(ctx context.Context) ([]byte, error) {
+	return c.reply(c.written[len(c.written)-1]), nil
+}
+
+func (c *testAuthConn) RSAKey() (*rsa.PrivateKey, error) {
+	return c.key, nil
+}
+
+func Test_cachingSha2Password(t *testing.T) {
+	ctx := context.TODO()
+	password := []byte("111")
+	pwd := HashSha1(HashSha1(password))
+	salt := []byte("01234567890123456789")
+	scramble := makeCachingSha2Scramble(password, salt)
+
+	plugin, ok := getAuthPlugin(AuthCachingSha2Password)
+	require.True(t, ok)
+	p := plugin.(*cachingSha2PasswordPlugin)
+	p.cache = make(map[string]cachingSha2Entry)
+
+	// full authentication on the secure connection
+	conn := &testAuthConn{
+		salt:   salt,
+		secure: true,
+		reply: func(data []byte) []byte {
+			require.Equal(t, []byte{cachingSha2PerformFullAuth}, data)
+			return append(password, 0)
+		},
+	}
+	require.NoError(t, p.Authenticate(ctx, conn, "u1", pwd, scramble))
+	require.Len(t, conn.written, 1)
+
+	// fast authentication with the cached digest
+	conn = &testAuthConn{salt: salt}
+	require.NoError(t, p.Authenticate(ctx, conn, "u1", pwd, scramble))
+	require.Equal(t, [][]byte{{cachingSha2FastAuthSuccess}}, conn.written)
+
+	// the wrong password falls back to the full authentication
+	conn = &testAuthConn{
+		salt:   salt,
+		secure: true,
+		reply: func(data []byte) []byte {
+			return []byte("222\x00")
+		},
+	}
+	require.Error(t, p.Authenticate(ctx, conn, "u1", pwd, makeCachingSha2Scramble([]byte("222"), salt)))
+
+	// the cached digest is stale after the password is changed
+	newPwd := HashSha1(HashSha1([]byte("333")))
+	conn = &testAuthConn{
+		salt:   salt,
+		secure: true,
+		reply: func(data []byte) []byte {
+			return []byte("333\x00")
+		},
+	}
+	require.NoError(t, p.Authenticate(ctx, conn, "u1", newPwd, makeCachingSha2Scramble([]byte("333"), salt)))
+	require.Equal(t, [][]byte{{cachingSha2PerformFullAuth}}, conn.written)
+}
+
+func Test_cachingSha2PasswordRSA(t *testing.T) {
+	ctx := context.TODO()
+	password := []byte("111")
+	pwd := HashSha1(HashSha1(password))
+	salt := []byte("01234567890123456789")
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	p := newCachingSha2PasswordPlugin()
+	conn := &testAuthConn{
+		salt: salt,
+		key:  key,
+		reply: func(data []byte) []byte {
+			if data[0] == cachingSha2PerformFullAuth {
+				return []byte{cachingSha2RequestPublicKey}
+			}
+			// encrypt the password with the public key as the client
+			block, _ := pem.Decode(data)
+			require.NotNil(t, block)
+			pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+			require.NoError(t, err)
+			plain := append([]byte(nil), password...)
+			plain = append(plain, 0)
+			for i := range plain {
+				plain[i] ^= salt[i%len(salt)]
+			}
+			encrypted, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, pub.(*rsa.PublicKey), plain, nil)
+			require.NoError(t, err)
+			return encrypted
+		},
+	}
+	require.NoError(t, p.Authenticate(ctx, conn, "u1", pwd, makeCachingSha2Scramble(password, salt)))
+	require.Len(t, conn.written, 2)
+
+	// the cleartext password is not accepted on the insecure connection
+	conn = &testAuthConn{
+		salt: salt,
+		key:  key,
+		reply: func(data []byte) []byte {
+			return append(password, 0)
+		},
+	}
+	require.Error(t, newCachingSha2PasswordPlugin().Authenticate(ctx, conn, "u1", pwd, makeCachingSha2Scramble(password, salt)))
+}
+
+func Test_nativeAndClearPassword(t *testing.T) {
+	ctx := context.TODO()
+	password := []byte("111")
+	pwd := HashSha1(HashSha1(password))
+	salt := []byte("01234567890123456789")
+
+	native, ok := getAuthPlugin(AuthNativePassword)
+	require.True(t, ok)
+	scramble := makeNativePasswordScramble(password, salt)
+	require.NoError(t, native.Authenticate(ctx, &testAuthConn{salt: salt}, "u1", pwd, scramble))
+	require.Error(t, native.Authenticate(ctx, &testAuthConn{salt: salt}, "u1", pwd, password))
+
+	clear, ok := getAuthPlugin(AuthClearPassword)
+	require.True(t, ok)
+	require.NoError(t, clear.Authenticate(ctx, &testAuthConn{secure: true}, "u1", pwd, password))
+	require.Error(t, clear.Authenticate(ctx, &testAuthConn{secure: true}, "u1", pwd, []byte("222")))
+	require.Error(t, clear.Authenticate(ctx, &testAuthConn{}, "u1", pwd, password))
+}
+
+func Test_AuthenticateForProxy(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	password := []byte("111")
+	var written [][]byte
+	var proto *MysqlProtocolImpl
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, _ goetty.WriteOptions) error {
+		written = append(written, msg.([]byte)[4:])
+		return nil
+	}).AnyTimes()
+	ioses.EXPECT().Read(gomock.Any()).DoAndReturn(func(_ goetty.ReadOptions) (interface{}, error) {
+		last := written[len(written)-1]
+		switch {
+		case last[0] == 0xfe:
+			// AuthSwitchRequest to caching_sha2_password
+			require.Contains(t, string(last), AuthCachingSha2Password)
+			return &Packet{Payload: makeCachingSha2Scramble(password, proto.GetSalt())}, nil
+		case last[0] == authMoreDataHeader && last[1] == cachingSha2PerformFullAuth:
+			return &Packet{Payload: append(password, 0)}, nil
+		}
+		return nil, nil
+	}).Times(2)
+
+	sv := &config.FrontendParameters{DefaultAuthPlugin: AuthCachingSha2Password}
+	proto = NewMysqlClientProtocol(0, ioses, 1024, sv)
+	proto.SetTlsEstablished()
+
+	info := response41{
+		capabilities: CLIENT_PROTOCOL_41 | CLIENT_SECURE_CONNECTION | CLIENT_CONNECT_WITH_DB | CLIENT_PLUGIN_AUTH |
+			CLIENT_CONNECT_ATTRS | CLIENT_ZSTD_COMPRESSION_ALGORITHM,
+		maxPacketSize:        1 << 24,
+		collationID:          uint8(utf8mb4BinCollationID),
+		username:             "acc:u1",
+		authResponse:         []byte("01234567890123456789"),
+		database:             "db1",
+		clientPluginName:     AuthNativePassword,
+		connectAttrs:         map[string]string{"_client_name": "libmysql"},
+		zstdCompressionLevel: 7,
+	}
+	ok, parsed, err := proto.analyseHandshakeResponse41(ctx, proto.makeHandshakeResponse41Payload(info))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, info, parsed)
+
+	payload, err := proto.AuthenticateForProxy(ctx, proto.makeHandshakeResponse41Payload(info))
+	require.NoError(t, err)
+	require.Len(t, written, 2)
+
+	// the handshake response replayed to the CN server
+	ok, replay, err := proto.analyseHandshakeResponse41(ctx, payload)
+	require.NoError(t, err)
+	require.True(t, ok)
+	// the compression is not replayed to the CN server
+	require.Zero(t, replay.capabilities&(CLIENT_COMPRESS|CLIENT_ZSTD_COMPRESSION_ALGORITHM))
+	require.Equal(t, info.username, replay.username)
+	require.Equal(t, info.database, replay.database)
+	require.Equal(t, info.connectAttrs, replay.connectAttrs)
+	// the password is replayed as the scramble of mysql_native_password
+	require.Equal(t, AuthNativePassword, replay.clientPluginName)
+	require.NotContains(t, string(replay.authResponse), string(password))
+	native, ok := getAuthPlugin(AuthNativePassword)
+	require.True(t, ok)
+	pwd := HashSha1(HashSha1(password))
+	require.NoError(t, native.Authenticate(ctx, &testAuthConn{salt: proto.GetSalt()}, "u1", pwd, replay.authResponse))
+}
+
+func Test_negotiateAuthPlugin(t *testing.T) {
+	ctx := context.TODO()
+	proto := &MysqlProtocolImpl{SV: &config.FrontendParameters{}}
+
+	proto.authPlugin = AuthNativePassword
+	plugin, _, err := proto.negotiateAuthPlugin(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, AuthNativePassword, plugin.Name())
+
+	// the client can not switch to caching_sha2_password
+	proto.SV.DefaultAuthPlugin = AuthCachingSha2Password
+	proto.authPlugin = ""
+	_, _, err = proto.negotiateAuthPlugin(ctx, nil)
+	require.Error(t, err)
+
+	// the scramble of mysql_native_password is only accepted from the proxy
+	proto.capability = CLIENT_PLUGIN_AUTH
+	proto.authPlugin = AuthNativePassword
+	proto.viaProxy = true
+	plugin, _, err = proto.negotiateAuthPlugin(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, AuthNativePassword, plugin.Name())
+}
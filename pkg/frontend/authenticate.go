@@ -783,6 +783,9 @@ var (
 		"mo_stored_procedure":         0,
 		"mo_mysql_compatibility_mode": 0,
 		"mo_stages":                   0,
+		"mo_event_history":            0,
+		catalog.MO_TRIGGERS:           0,
+		catalog.MO_MVIEWS:             0,
 		catalog.MOAutoIncrTable:       0,
 	}
 	configInitVariables = map[string]int8{
@@ -810,6 +813,9 @@ var (
 		"mo_table_partitions":         0,
 		"mo_pubs":                     0,
 		"mo_stages":                   0,
+		"mo_event_history":            0,
+		catalog.MO_TRIGGERS:           0,
+		catalog.MO_MVIEWS:             0,
 	}
 	createDbInformationSchemaSql = "create database information_schema;"
 	createAutoTableSql           = fmt.Sprintf(`create table if not exists %s (
@@ -984,6 +990,43 @@ var (
 				comment text,
 				primary key(stage_id)
 			);`,
+		`create table mo_event_history(
+				event_name varchar(64),
+				event_db varchar(5000),
+				start_time timestamp,
+				end_time timestamp,
+				duration bigint,
+				status varchar(32),
+				error text
+			);`,
+		fmt.Sprintf(`create table %s(
+				trigger_name varchar(64),
+				trigger_db varchar(5000),
+				table_name varchar(5000),
+				action_timing varchar(10),
+				event_manipulation varchar(10),
+				action_order int unsigned,
+				action_statement text,
+				definer varchar(300),
+				created_time timestamp,
+				primary key(trigger_db, trigger_name)
+			);`, catalog.MO_TRIGGERS),
+		fmt.Sprintf(`create table %s(
+				database_name varchar(5000),
+				mview_name varchar(5000),
+				definition text,
+				default_database varchar(5000),
+				base_database varchar(5000),
+				base_table varchar(5000),
+				incremental bool,
+				refresh_interval varchar(64),
+				last_refresh_ts varchar(64),
+				last_refresh_time timestamp,
+				definer varchar(300),
+				created_time timestamp,
+				task_version bigint,
+				primary key(database_name, mview_name)
+			);`, catalog.MO_MVIEWS),
 	}
 
 	//drop tables for the tenant
@@ -997,12 +1040,15 @@ var (
 		`drop table if exists mo_catalog.mo_stored_procedure;`,
 		`drop table if exists mo_catalog.mo_mysql_compatibility_mode;`,
 		`drop table if exists mo_catalog.mo_stages;`,
+		`drop table if exists mo_catalog.mo_event_history;`,
 	}
 	dropMoPubsSql         = `drop table if exists mo_catalog.mo_pubs;`
 	deleteMoPubsSql       = `delete from mo_catalog.mo_pubs;`
 	dropAutoIcrColSql     = fmt.Sprintf("drop table if exists mo_catalog.`%s`;", catalog.MOAutoIncrTable)
 	dropMoIndexes         = fmt.Sprintf(`drop table if exists %s.%s;`, catalog.MO_CATALOG, catalog.MO_INDEXES)
 	dropMoTablePartitions = fmt.Sprintf(`drop table if exists %s.%s;`, catalog.MO_CATALOG, catalog.MO_TABLE_PARTITIONS)
+	dropMoTriggers        = fmt.Sprintf(`drop table if exists %s.%s;`, catalog.MO_CATALOG, catalog.MO_TRIGGERS)
+	dropMoMViews          = fmt.Sprintf(`drop table if exists %s.%s;`, catalog.MO_CATALOG, catalog.MO_MVIEWS)
 
 	initMoMysqlCompatbilityModeFormat = `insert into mo_catalog.mo_mysql_compatibility_mode(
 		account_id,
@@ -4149,6 +4195,18 @@ func doDropAccount(ctx context.Context, ses *Session, da *tree.DropAccount) (err
 			return err
 		}
 
+		// drop mo_catalog.mo_triggers under general tenant
+		err = bh.Exec(deleteCtx, dropMoTriggers)
+		if err != nil {
+			return err
+		}
+
+		// drop mo_catalog.mo_mviews under general tenant
+		err = bh.Exec(deleteCtx, dropMoMViews)
+		if err != nil {
+			return err
+		}
+
 		//step 1 : delete the account in the mo_account of the sys account
 		sql, err = getSqlForDeleteAccountFromMoAccount(ctx, da.Name)
 		if err != nil {
@@ -5537,6 +5595,20 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		if st.Name != nil {
 			dbName = string(st.Name.SchemaName)
 		}
+	case *tree.CreateMaterializedView:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name != nil {
+			dbName = string(st.Name.SchemaName)
+		}
+	case *tree.RefreshMaterializedView:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name != nil {
+			dbName = string(st.Name.SchemaName)
+		}
 	case *tree.CreateStream:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
@@ -5580,6 +5652,18 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		if st.Table != nil {
 			dbName = string(st.Table.SchemaName)
 		}
+	case *tree.CreateTrigger:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Table != nil {
+			dbName = string(st.Table.SchemaName)
+		}
+	case *tree.DropTrigger:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.Name.SchemaName)
 	case *tree.CreateProcedure:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
@@ -5602,6 +5686,13 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		if len(st.Names) != 0 {
 			dbName = string(st.Names[0].SchemaName)
 		}
+	case *tree.DropMaterializedView:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeDropView, PrivilegeTypeDropObject, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Name != nil {
+			dbName = string(st.Name.SchemaName)
+		}
 	case *tree.DropSequence:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeDropObject, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
@@ -5732,6 +5823,21 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 	case *tree.CreateStage, *tree.AlterStage, *tree.DropStage:
 		objType = objectTypeNone
 		kind = privilegeKindNone
+	case *tree.CreateEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.Name.SchemaName)
+	case *tree.AlterEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.Name.SchemaName)
+	case *tree.DropEvent:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		dbName = string(st.Name.Name.SchemaName)
 	case *tree.BackupStart:
 		objType = objectTypeNone
 		kind = privilegeKindNone
@@ -6609,6 +6715,25 @@ func authenticateUserCanExecuteStatementWithObjectTypeAccountAndDatabase(ctx con
 	if priv.objectType() != objectTypeAccount && priv.objectType() != objectTypeDatabase { //do nothing
 		return true, nil
 	}
+
+	//the event without the database name is in the current database
+	eventDBNames, err := getEventDatabasesOfStatement(ses, stmt)
+	if err != nil {
+		return false, err
+	}
+	if len(eventDBNames) != 0 {
+		for _, dbName := range eventDBNames {
+			for i := range priv.entries {
+				priv.entries[i].databaseName = dbName
+			}
+			ok, err = determineUserHasPrivilegeSet(ctx, ses, priv, stmt)
+			if err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
 	ok, err = determineUserHasPrivilegeSet(ctx, ses, priv, stmt)
 	if err != nil {
 		return false, err
@@ -57,6 +57,7 @@ type CmdExecutorImpl struct {
 type UserInput struct {
 	sql           string
 	stmt          tree.Statement
+	stmts         []tree.Statement
 	sqlSourceType []string
 }
 
@@ -69,6 +70,12 @@ func (ui *UserInput) getStmt() tree.Statement {
 	return ui.stmt
 }
 
+// getStmts returns the statements parsed from the sql by the protocol in its own dialect.
+// if they are not nil, the sql is not parsed again.
+func (ui *UserInput) getStmts() []tree.Statement {
+	return ui.stmts
+}
+
 func (ui *UserInput) getSqlSourceTypes() []string {
 	return ui.sqlSourceType
 }
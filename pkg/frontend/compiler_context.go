@@ -391,6 +391,8 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 	var partitionInfo *plan2.PartitionByDef
 	var viewSql *plan2.ViewDef
 	var foreignKeys []*plan2.ForeignKeyDef
+	var checks []*plan2.CheckDef
+	var triggers []*plan2.TriggerDef
 	var primarykey *plan2.PrimaryKeyDef
 	var indexes []*plan2.IndexDef
 	var refChildTbls []uint64
@@ -455,6 +457,10 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 					indexes = k.Indexes
 				case *engine.ForeignKeyDef:
 					foreignKeys = k.Fkeys
+				case *engine.CheckDef:
+					checks = k.Checks
+				case *engine.TriggerDef:
+					triggers = k.Triggers
 				case *engine.RefChildTableDef:
 					refChildTbls = k.Tables
 				case *engine.PrimaryKeyDef:
@@ -534,6 +540,8 @@ func (tcc *TxnCompilerContext) getTableDef(ctx context.Context, table engine.Rel
 		ViewSql:      viewSql,
 		Partition:    partitionInfo,
 		Fkeys:        foreignKeys,
+		Checks:       checks,
+		Triggers:     triggers,
 		RefChildTbls: refChildTbls,
 		ClusterBy:    clusterByDef,
 		Indexes:      indexes,
@@ -230,6 +230,13 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		if err != nil {
 			return nil, err
 		}
+	} else if txnOp != nil && requestCtx.Value(defines.TriggerTablesKey{}) != nil {
+		// the statements run by the trigger see the changes of the statements before them
+		// in the same statement which fires the trigger.
+		err = txnOp.GetWorkspace().IncrStatementID(requestCtx, true)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	cacheHit := cwft.plan != nil
@@ -248,7 +255,8 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 			return nil, err
 		}
 	}
-	if _, ok := cwft.stmt.(*tree.Execute); ok {
+	_, prepared := cwft.stmt.(*tree.Execute)
+	if prepared {
 		executePlan := cwft.plan.GetDcl().GetExecute()
 		stmtName := executePlan.GetName()
 		prepareStmt, err := cwft.ses.GetPrepareStmt(stmtName)
@@ -329,6 +337,15 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 		*/
 	}
 
+	// the DML on the table with triggers changes the rows one by one and fires the triggers
+	tr, err := getTriggerRunner(requestCtx, cwft.ses, cwft.proc, cwft.stmt, cwft.plan, prepared)
+	if err != nil {
+		return nil, err
+	}
+	if tr != nil {
+		return tr, nil
+	}
+
 	addr := ""
 	if len(cwft.ses.GetParameterUnit().ClusterNodes) > 0 {
 		addr = cwft.ses.GetParameterUnit().ClusterNodes[0].Addr
@@ -406,6 +423,16 @@ func (cwft *TxnComputationWrapper) Compile(requestCtx context.Context, u interfa
 
 		cwft.ses.EnableInitTempEngine()
 	}
+	// the materialized view is populated after its table is created
+	if st, ok := cwft.stmt.(*tree.CreateMaterializedView); ok {
+		return &mviewCreateRunner{
+			ComputationRunner: cwft.compile,
+			ctx:               requestCtx,
+			ses:               cwft.ses,
+			stmt:              st,
+			pn:                cwft.plan,
+		}, nil
+	}
 	return cwft.compile, err
 }
 
@@ -0,0 +1,596 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"go.uber.org/zap"
+)
+
+const (
+	eventStatusSuccess = "SUCCESS"
+	eventStatusFailed  = "FAILED"
+
+	// oneTimeEventGracePeriod is how long the one-time event can still be executed
+	// after its execution time, in case the cron task is triggered late.
+	oneTimeEventGracePeriod = time.Hour * 24
+
+	insertIntoMoEventHistoryFormat = `insert into mo_catalog.mo_event_history(
+		event_name,
+		event_db,
+		start_time,
+		end_time,
+		duration,
+		status,
+		error) values ('%s', '%s', '%s', '%s', %d, '%s', '%s');`
+
+	evalEventTimeFormat = "unix_timestamp(cast(%s as timestamp))"
+
+	evalEventIntervalFormat = "cast(%s as bigint)"
+)
+
+// eventDefinition is the definition of the event. It is saved as the context of
+// the cron task of the event and copied into every task the cron task triggers.
+type eventDefinition struct {
+	AccountID   uint32 `json:"account_id"`
+	AccountName string `json:"account_name"`
+	UserID      uint32 `json:"user_id"`
+	UserName    string `json:"user_name"`
+	RoleID      uint32 `json:"role_id"`
+	Database    string `json:"database"`
+	Name        string `json:"name"`
+	Body        string `json:"body"`
+	// At is the unix time in seconds of the one-time event
+	At int64 `json:"at,omitempty"`
+	// Every and Unit are the interval of the recurring event
+	Every int64  `json:"every,omitempty"`
+	Unit  string `json:"unit,omitempty"`
+	// Starts and Ends are the unix time in seconds bounding the recurring event
+	Starts   int64  `json:"starts,omitempty"`
+	Ends     int64  `json:"ends,omitempty"`
+	Preserve bool   `json:"preserve"`
+	Enabled  bool   `json:"enabled"`
+	Comment  string `json:"comment,omitempty"`
+	// Version distinguishes the cron tasks of the event created or altered at
+	// different times, so the tasks they trigger never have the same id.
+	Version int64 `json:"version"`
+}
+
+// taskID returns the task metadata id of the cron task of the event
+func (def *eventDefinition) taskID() string {
+	return fmt.Sprintf("event:%d:%s.%s:%d", def.AccountID, def.Database, def.Name, def.Version)
+}
+
+func (def *eventDefinition) taskMetadata() (task.TaskMetadata, error) {
+	data, err := json.Marshal(def)
+	if err != nil {
+		return task.TaskMetadata{}, err
+	}
+	return task.TaskMetadata{
+		ID:       def.taskID(),
+		Executor: task.TaskCode_UserDefinedEvent,
+		Context:  data,
+	}, nil
+}
+
+// cronExpr converts the schedule of the event into the cron expression of the task
+// service, which is evaluated in the local time zone.
+func (def *eventDefinition) cronExpr(ctx context.Context) (string, error) {
+	if def.At != 0 {
+		at := time.Unix(def.At, 0)
+		return fmt.Sprintf("%d %d %d %d %d *", at.Second(), at.Minute(), at.Hour(), at.Day(), int(at.Month())), nil
+	}
+
+	if def.Every <= 0 {
+		return "", moerr.NewInvalidInput(ctx, "INTERVAL is either not positive or too big")
+	}
+	var unit time.Duration
+	months := int64(0)
+	switch def.Unit {
+	case "SECOND":
+		unit = time.Second
+	case "MINUTE":
+		unit = time.Minute
+	case "HOUR":
+		unit = time.Hour
+	case "DAY":
+		unit = time.Hour * 24
+	case "WEEK":
+		unit = time.Hour * 24 * 7
+	case "MONTH":
+		months = def.Every
+	case "QUARTER":
+		months = def.Every * 3
+	case "YEAR":
+		months = def.Every * 12
+	default:
+		return "", moerr.NewNotSupported(ctx, "event interval unit %s", def.Unit)
+	}
+	if months == 0 {
+		if def.Every > int64(time.Hour*24*365*10/unit) {
+			return "", moerr.NewInvalidInput(ctx, "INTERVAL is either not positive or too big")
+		}
+		return fmt.Sprintf("@every %s", time.Duration(def.Every)*unit), nil
+	}
+
+	// the months are anchored at STARTS, and must divide the year evenly to be
+	// described by the cron expression
+	if 12%months != 0 {
+		return "", moerr.NewNotSupported(ctx, "event interval %d %s", def.Every, def.Unit)
+	}
+	anchor := time.Unix(def.Version/int64(time.Second), 0)
+	if def.Starts != 0 {
+		anchor = time.Unix(def.Starts, 0)
+	}
+	monthList := make([]int, 0, 12/months)
+	for m := int64(0); m < 12; m += months {
+		monthList = append(monthList, (int(anchor.Month())-1+int(m))%12+1)
+	}
+	sort.Ints(monthList)
+	monthStrs := make([]string, len(monthList))
+	for i, m := range monthList {
+		monthStrs[i] = fmt.Sprint(m)
+	}
+	return fmt.Sprintf("%d %d %d %d %s *", anchor.Second(), anchor.Minute(), anchor.Hour(), anchor.Day(),
+		strings.Join(monthStrs, ",")), nil
+}
+
+// checkRun returns whether the event should be executed at now, and whether the
+// event is completed after now.
+func (def *eventDefinition) checkRun(now time.Time) (run bool, completed bool) {
+	if def.At != 0 {
+		at := time.Unix(def.At, 0)
+		return def.Enabled && !now.Before(at) && now.Before(at.Add(oneTimeEventGracePeriod)), !now.Before(at)
+	}
+	if def.Ends != 0 && now.After(time.Unix(def.Ends, 0)) {
+		return false, true
+	}
+	if def.Starts != 0 && now.Before(time.Unix(def.Starts, 0)) {
+		return false, false
+	}
+	return def.Enabled, false
+}
+
+// setSchedule evaluates the expressions of the schedule in the background session
+func (def *eventDefinition) setSchedule(ctx context.Context, bh BackgroundExec, schedule *tree.EventSchedule) error {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	var exprs []string
+	format := func(sqlFormat string, expr tree.Expr) {
+		fmtCtx.Reset()
+		expr.Format(fmtCtx)
+		exprs = append(exprs, fmt.Sprintf(sqlFormat, fmtCtx.String()))
+	}
+
+	def.At, def.Every, def.Unit, def.Starts, def.Ends = 0, 0, "", 0, 0
+	if schedule.At != nil {
+		format(evalEventTimeFormat, schedule.At)
+	} else {
+		def.Unit = strings.ToUpper(schedule.Unit)
+		format(evalEventIntervalFormat, schedule.Every)
+		if schedule.Starts != nil {
+			format(evalEventTimeFormat, schedule.Starts)
+		}
+		if schedule.Ends != nil {
+			format(evalEventTimeFormat, schedule.Ends)
+		}
+	}
+
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, "select "+strings.Join(exprs, ", ")); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewInternalError(ctx, "can not evaluate the schedule of the event")
+	}
+	values := make([]int64, len(exprs))
+	for i := range values {
+		if values[i], err = erArray[0].GetInt64(ctx, 0, uint64(i)); err != nil {
+			return err
+		}
+	}
+
+	if schedule.At != nil {
+		def.At = values[0]
+		return nil
+	}
+	def.Every = values[0]
+	values = values[1:]
+	if schedule.Starts != nil {
+		def.Starts, values = values[0], values[1:]
+	}
+	if schedule.Ends != nil {
+		def.Ends = values[0]
+	}
+	return nil
+}
+
+// checkSchedule checks the schedule of the event created or altered at now
+func (def *eventDefinition) checkSchedule(ctx context.Context, now time.Time) error {
+	if def.At != 0 && def.At < now.Unix() {
+		return moerr.NewInvalidInput(ctx, "event execution time is in the past")
+	}
+	if def.Ends != 0 {
+		if def.Ends < def.Starts || def.Ends < now.Unix() {
+			return moerr.NewInvalidInput(ctx, "ENDS is either invalid or before STARTS")
+		}
+	}
+	_, err := def.cronExpr(ctx)
+	return err
+}
+
+func formatEventBody(body tree.Statement) string {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	body.Format(fmtCtx)
+	return fmtCtx.String()
+}
+
+func getEventDatabase(ses *Session, name *tree.EventName) (string, error) {
+	if name.HasNoNameQualifier() {
+		if ses.DatabaseNameIsEmpty() {
+			return "", moerr.NewNoDBNoCtx()
+		}
+		return ses.GetDatabaseName(), nil
+	}
+	return string(name.Name.SchemaName), nil
+}
+
+// getEventDatabasesOfStatement returns the databases the privilege of the event statement
+// is checked on. The event renamed into another database needs the privilege on both.
+func getEventDatabasesOfStatement(ses *Session, stmt tree.Statement) ([]string, error) {
+	var names []*tree.EventName
+	switch st := stmt.(type) {
+	case *tree.CreateEvent:
+		names = append(names, st.Name)
+	case *tree.AlterEvent:
+		names = append(names, st.Name)
+		if st.RenameTo != nil {
+			names = append(names, st.RenameTo)
+		}
+	case *tree.DropEvent:
+		names = append(names, st.Name)
+	default:
+		return nil, nil
+	}
+	dbNames := make([]string, 0, len(names))
+	for _, name := range names {
+		dbName, err := getEventDatabase(ses, name)
+		if err != nil {
+			return nil, err
+		}
+		if len(dbNames) == 0 || dbNames[0] != dbName {
+			dbNames = append(dbNames, dbName)
+		}
+	}
+	return dbNames, nil
+}
+
+func getEventTaskService(ctx context.Context, ses *Session) (taskservice.TaskService, error) {
+	pu := ses.GetParameterUnit()
+	if pu == nil || pu.TaskService == nil {
+		return nil, moerr.NewInternalError(ctx, "task service not ready yet, please try again later.")
+	}
+	ts, ok := pu.TaskService()
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "task service not ready yet, please try again later.")
+	}
+	return ts, nil
+}
+
+// findEvent finds the event of the account in the cron tasks.
+// It returns nil if the event does not exist.
+func findEvent(ctx context.Context, ts taskservice.TaskService, accountID uint32, dbName, name string) (*eventDefinition, error) {
+	cronTasks, err := ts.QueryCronTask(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, cronTask := range cronTasks {
+		if cronTask.Metadata.Executor != task.TaskCode_UserDefinedEvent {
+			continue
+		}
+		def := &eventDefinition{}
+		if err = json.Unmarshal(cronTask.Metadata.Context, def); err != nil {
+			return nil, err
+		}
+		if def.AccountID == accountID && def.Database == dbName && def.Name == name {
+			return def, nil
+		}
+	}
+	return nil, nil
+}
+
+func checkEventDatabase(ctx context.Context, bh BackgroundExec, dbName string) error {
+	sql, err := getSqlForCheckDatabase(ctx, dbName)
+	if err != nil {
+		return err
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewBadDB(ctx, dbName)
+	}
+	return nil
+}
+
+func createEventCronTask(ctx context.Context, ts taskservice.TaskService, def *eventDefinition) error {
+	cronExpr, err := def.cronExpr(ctx)
+	if err != nil {
+		return err
+	}
+	metadata, err := def.taskMetadata()
+	if err != nil {
+		return err
+	}
+	return ts.CreateCronTask(ctx, metadata, cronExpr)
+}
+
+func doCreateEvent(ctx context.Context, ses *Session, ce *tree.CreateEvent) error {
+	ts, err := getEventTaskService(ctx, ses)
+	if err != nil {
+		return err
+	}
+	dbName, err := getEventDatabase(ses, ce.Name)
+	if err != nil {
+		return err
+	}
+	tenant := ses.GetTenantInfo()
+	name := string(ce.Name.Name.ObjectName)
+
+	old, err := findEvent(ctx, ts, tenant.GetTenantID(), dbName, name)
+	if err != nil {
+		return err
+	}
+	if old != nil {
+		if ce.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "event '%s' already exists", name)
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+	if err = checkEventDatabase(ctx, bh, dbName); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	def := &eventDefinition{
+		AccountID:   tenant.GetTenantID(),
+		AccountName: tenant.GetTenant(),
+		UserID:      tenant.GetUserID(),
+		UserName:    tenant.GetUser(),
+		RoleID:      tenant.GetDefaultRoleID(),
+		Database:    dbName,
+		Name:        name,
+		Body:        formatEventBody(ce.Body),
+		Preserve:    ce.Completion == tree.EventCompletionPreserve,
+		Enabled:     ce.Status != tree.EventStatusDisable,
+		Comment:     ce.Comment.Comment,
+		Version:     now.UnixNano(),
+	}
+	if err = def.setSchedule(ctx, bh, ce.Schedule); err != nil {
+		return err
+	}
+	if err = def.checkSchedule(ctx, now); err != nil {
+		return err
+	}
+	return createEventCronTask(ctx, ts, def)
+}
+
+func doAlterEvent(ctx context.Context, ses *Session, ae *tree.AlterEvent) error {
+	ts, err := getEventTaskService(ctx, ses)
+	if err != nil {
+		return err
+	}
+	dbName, err := getEventDatabase(ses, ae.Name)
+	if err != nil {
+		return err
+	}
+	tenant := ses.GetTenantInfo()
+	name := string(ae.Name.Name.ObjectName)
+
+	def, err := findEvent(ctx, ts, tenant.GetTenantID(), dbName, name)
+	if err != nil {
+		return err
+	}
+	if def == nil {
+		return moerr.NewInternalError(ctx, "unknown event '%s'", name)
+	}
+	oldTaskID := def.taskID()
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	if ae.RenameTo != nil {
+		newDBName, err := getEventDatabase(ses, ae.RenameTo)
+		if err != nil {
+			return err
+		}
+		newName := string(ae.RenameTo.Name.ObjectName)
+		if newDBName != def.Database || newName != def.Name {
+			exists, err := findEvent(ctx, ts, tenant.GetTenantID(), newDBName, newName)
+			if err != nil {
+				return err
+			}
+			if exists != nil {
+				return moerr.NewInternalError(ctx, "event '%s' already exists", newName)
+			}
+			if err = checkEventDatabase(ctx, bh, newDBName); err != nil {
+				return err
+			}
+			def.Database, def.Name = newDBName, newName
+		}
+	}
+
+	now := time.Now()
+	if ae.Schedule != nil {
+		if err = def.setSchedule(ctx, bh, ae.Schedule); err != nil {
+			return err
+		}
+	}
+	switch ae.Completion {
+	case tree.EventCompletionPreserve:
+		def.Preserve = true
+	case tree.EventCompletionNotPreserve:
+		def.Preserve = false
+	}
+	switch ae.Status {
+	case tree.EventStatusEnable:
+		def.Enabled = true
+	case tree.EventStatusDisable:
+		def.Enabled = false
+	}
+	if ae.Comment.Exist {
+		def.Comment = ae.Comment.Comment
+	}
+	if ae.Body != nil {
+		def.Body = formatEventBody(ae.Body)
+	}
+	if ae.Schedule != nil {
+		if err = def.checkSchedule(ctx, now); err != nil {
+			return err
+		}
+	}
+	def.UserID, def.UserName, def.RoleID = tenant.GetUserID(), tenant.GetUser(), tenant.GetDefaultRoleID()
+
+	// the cron task can not be changed after it is scheduled, so the altered event is
+	// scheduled as a new cron task before the old one is deleted. The event is kept
+	// unchanged if any of them fails.
+	def.Version = now.UnixNano()
+	if err = createEventCronTask(ctx, ts, def); err != nil {
+		return err
+	}
+	if err = ts.DeleteCronTask(ctx, oldTaskID); err != nil {
+		if e := ts.DeleteCronTask(ctx, def.taskID()); e != nil {
+			logutil.Error("delete the cron task of the altered event failed",
+				zap.String("event", def.taskID()),
+				zap.Error(e))
+		}
+		return err
+	}
+	return nil
+}
+
+func doDropEvent(ctx context.Context, ses *Session, de *tree.DropEvent) error {
+	ts, err := getEventTaskService(ctx, ses)
+	if err != nil {
+		return err
+	}
+	dbName, err := getEventDatabase(ses, de.Name)
+	if err != nil {
+		return err
+	}
+	name := string(de.Name.Name.ObjectName)
+
+	def, err := findEvent(ctx, ts, ses.GetTenantInfo().GetTenantID(), dbName, name)
+	if err != nil {
+		return err
+	}
+	if def == nil {
+		if de.IfExists {
+			return nil
+		}
+		return moerr.NewInternalError(ctx, "unknown event '%s'", name)
+	}
+	return ts.DeleteCronTask(ctx, def.taskID())
+}
+
+func getSqlForInsertIntoMoEventHistory(def *eventDefinition, start, end time.Time, status string, execErr error) string {
+	errMsg := ""
+	if execErr != nil {
+		errMsg = execErr.Error()
+	}
+	return fmt.Sprintf(insertIntoMoEventHistoryFormat,
+		util.EscapeSqlString(def.Name),
+		util.EscapeSqlString(def.Database),
+		start.UTC().Format("2006-01-02 15:04:05"),
+		end.UTC().Format("2006-01-02 15:04:05"),
+		end.Sub(start).Milliseconds(),
+		status,
+		util.EscapeSqlString(errMsg))
+}
+
+// GetUserDefinedEventExecutor returns the executor of the tasks triggered by the events.
+// The body of the event is executed in the account of the definer, and the result is
+// recorded into mo_catalog.mo_event_history of the account.
+func GetUserDefinedEventExecutor(ieFactory func() ie.InternalExecutor, ts taskservice.TaskService) taskservice.TaskExecutor {
+	return func(ctx context.Context, t task.Task) error {
+		def := &eventDefinition{}
+		if err := json.Unmarshal(t.Metadata.Context, def); err != nil {
+			return err
+		}
+
+		start := time.Now()
+		run, completed := def.checkRun(start)
+		if run {
+			execCtx := context.WithValue(ctx, defines.TenantIDKey{}, def.AccountID)
+			execCtx = context.WithValue(execCtx, defines.UserIDKey{}, def.UserID)
+			execCtx = context.WithValue(execCtx, defines.RoleIDKey{}, def.RoleID)
+			execErr := ieFactory().Exec(execCtx, def.Body, ie.NewOptsBuilder().
+				Database(def.Database).
+				Username(def.UserName).
+				AccountId(def.AccountID).
+				UserId(def.UserID).
+				DefaultRoleId(def.RoleID).
+				Finish())
+			end := time.Now()
+
+			status := eventStatusSuccess
+			if execErr != nil {
+				status = eventStatusFailed
+			}
+			historyCtx := context.WithValue(ctx, defines.TenantIDKey{}, def.AccountID)
+			if err := ieFactory().Exec(historyCtx,
+				getSqlForInsertIntoMoEventHistory(def, start, end, status, execErr),
+				ie.NewOptsBuilder().AccountId(def.AccountID).Finish()); err != nil {
+				logutil.Error("record event history failed",
+					zap.String("event", def.taskID()),
+					zap.Error(err))
+			}
+			if execErr != nil {
+				return execErr
+			}
+		}
+
+		if completed && !def.Preserve {
+			return ts.DeleteCronTask(ctx, def.taskID())
+		}
+		return nil
+	}
+}
@@ -0,0 +1,385 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_eventCronExpr(t *testing.T) {
+	ctx := context.TODO()
+	starts := time.Date(2023, 2, 15, 3, 4, 5, 0, time.Local)
+	cases := []struct {
+		def  eventDefinition
+		expr string
+		err  bool
+	}{
+		{def: eventDefinition{Every: 30, Unit: "SECOND"}, expr: "@every 30s"},
+		{def: eventDefinition{Every: 90, Unit: "MINUTE"}, expr: "@every 1h30m0s"},
+		{def: eventDefinition{Every: 2, Unit: "DAY"}, expr: "@every 48h0m0s"},
+		{def: eventDefinition{Every: 1, Unit: "WEEK"}, expr: "@every 168h0m0s"},
+		{def: eventDefinition{Every: 4, Unit: "MONTH", Starts: starts.Unix()}, expr: "5 4 3 15 2,6,10 *"},
+		{def: eventDefinition{Every: 1, Unit: "QUARTER", Starts: starts.Unix()}, expr: "5 4 3 15 2,5,8,11 *"},
+		{def: eventDefinition{Every: 1, Unit: "YEAR", Starts: starts.Unix()}, expr: "5 4 3 15 2 *"},
+		{def: eventDefinition{At: starts.Unix()}, expr: "5 4 3 15 2 *"},
+		{def: eventDefinition{Every: 5, Unit: "MONTH"}, err: true},
+		{def: eventDefinition{Every: 2, Unit: "YEAR"}, err: true},
+		{def: eventDefinition{Every: 1, Unit: "MINUTE_SECOND"}, err: true},
+		{def: eventDefinition{Every: 0, Unit: "SECOND"}, err: true},
+	}
+	for _, c := range cases {
+		expr, err := c.def.cronExpr(ctx)
+		if c.err {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, c.expr, expr)
+	}
+}
+
+func Test_eventCheckRun(t *testing.T) {
+	now := time.Now()
+	cases := []struct {
+		def       eventDefinition
+		run       bool
+		completed bool
+	}{
+		{def: eventDefinition{Every: 1, Enabled: true}, run: true},
+		{def: eventDefinition{Every: 1}},
+		{def: eventDefinition{Every: 1, Enabled: true, Starts: now.Add(time.Hour).Unix()}},
+		{def: eventDefinition{Every: 1, Enabled: true, Ends: now.Add(-time.Hour).Unix()}, completed: true},
+		{def: eventDefinition{At: now.Add(-time.Second).Unix(), Enabled: true}, run: true, completed: true},
+		{def: eventDefinition{At: now.Add(-time.Hour * 48).Unix(), Enabled: true}, completed: true},
+		{def: eventDefinition{At: now.Add(time.Hour).Unix(), Enabled: true}},
+	}
+	for _, c := range cases {
+		run, completed := c.def.checkRun(now)
+		require.Equal(t, c.run, run)
+		require.Equal(t, c.completed, completed)
+	}
+}
+
+func newMrsForEventSchedule(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	for i := range rows[0] {
+		col := &MysqlColumn{}
+		col.SetName(strings.Repeat("c", i+1))
+		col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func Test_doEvent(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	ses.SetTenantInfo(&TenantInfo{
+		Tenant:        sysAccountName,
+		User:          rootName,
+		DefaultRole:   moAdminRoleName,
+		TenantID:      sysAccountID,
+		UserID:        rootID,
+		DefaultRoleID: moAdminRoleID,
+	})
+	ses.SetDatabaseName("db1")
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundHandler, bh)
+	defer bhStub.Reset()
+
+	// the task service is not ready
+	err := doDropEvent(ctx, ses, &tree.DropEvent{Name: tree.NewEventName("e1", tree.ObjectNamePrefix{})})
+	require.Error(t, err)
+
+	ts := taskservice.NewTaskService(runtime.DefaultRuntime(), taskservice.NewMemTaskStorage())
+	defer func() {
+		require.NoError(t, ts.Close())
+	}()
+	ses.GetParameterUnit().TaskService = func() (taskservice.TaskService, bool) {
+		return ts, true
+	}
+
+	sql, _ := getSqlForCheckDatabase(ctx, "db1")
+	bh.sql2result[sql] = newMrsForEventSchedule([][]interface{}{{1}})
+	bh.sql2result["select cast(10 as bigint)"] = newMrsForEventSchedule([][]interface{}{{10}})
+	bh.sql2result[`select cast(1 as bigint), unix_timestamp(cast("2023-01-01 00:00:00" as timestamp))`] =
+		newMrsForEventSchedule([][]interface{}{{1, time.Date(2023, 1, 1, 0, 0, 0, 0, time.Local).Unix()}})
+
+	body := &tree.Insert{
+		Table: tree.NewTableName("t1", tree.ObjectNamePrefix{}),
+		Rows: tree.NewSelect(&tree.ValuesClause{Rows: []tree.Exprs{{
+			tree.NewNumValWithType(nil, "a'b", false, tree.P_char)}}}, nil, nil),
+	}
+	ce := &tree.CreateEvent{
+		Name: tree.NewEventName("e1", tree.ObjectNamePrefix{}),
+		Schedule: &tree.EventSchedule{
+			Every: tree.NewNumValWithType(nil, "10", false, tree.P_int64),
+			Unit:  "minute",
+		},
+		Body: body,
+	}
+	require.NoError(t, doCreateEvent(ctx, ses, ce))
+
+	cronTasks, err := ts.QueryCronTask(ctx)
+	require.NoError(t, err)
+	require.Len(t, cronTasks, 1)
+	require.Equal(t, "@every 10m0s", cronTasks[0].CronExpr)
+	require.Equal(t, task.TaskCode_UserDefinedEvent, cronTasks[0].Metadata.Executor)
+	def := &eventDefinition{}
+	require.NoError(t, json.Unmarshal(cronTasks[0].Metadata.Context, def))
+	require.Equal(t, "db1", def.Database)
+	require.Equal(t, "e1", def.Name)
+	require.Equal(t, `insert into t1 values ("a'b")`, def.Body)
+	require.True(t, def.Enabled)
+
+	// the event exists
+	require.Error(t, doCreateEvent(ctx, ses, ce))
+	ce.IfNotExists = true
+	require.NoError(t, doCreateEvent(ctx, ses, ce))
+
+	// the months can not be described by the cron expression
+	ce.Name = tree.NewEventName("e2", tree.ObjectNamePrefix{})
+	ce.Schedule.Unit = "month"
+	require.Error(t, doCreateEvent(ctx, ses, ce))
+
+	// ENDS before now
+	ae := &tree.AlterEvent{
+		Name: tree.NewEventName("e1", tree.ObjectNamePrefix{}),
+		Schedule: &tree.EventSchedule{
+			Every: tree.NewNumValWithType(nil, "1", false, tree.P_int64),
+			Unit:  "hour",
+			Ends:  tree.NewNumValWithType(nil, "2023-01-01 00:00:00", false, tree.P_char),
+		},
+	}
+	require.ErrorContains(t, doAlterEvent(ctx, ses, ae), "ENDS is either invalid or before STARTS")
+
+	ae = &tree.AlterEvent{
+		Name:     tree.NewEventName("e1", tree.ObjectNamePrefix{}),
+		RenameTo: tree.NewEventName("e3", tree.ObjectNamePrefix{SchemaName: "db1", ExplicitSchema: true}),
+		Status:   tree.EventStatusDisable,
+		Comment:  tree.EventComment{Exist: true, Comment: "renamed"},
+	}
+	require.NoError(t, doAlterEvent(ctx, ses, ae))
+	cronTasks, err = ts.QueryCronTask(ctx)
+	require.NoError(t, err)
+	require.Len(t, cronTasks, 1)
+	def = &eventDefinition{}
+	require.NoError(t, json.Unmarshal(cronTasks[0].Metadata.Context, def))
+	require.Equal(t, "e3", def.Name)
+	require.False(t, def.Enabled)
+	require.Equal(t, "renamed", def.Comment)
+	require.Equal(t, "@every 10m0s", cronTasks[0].CronExpr)
+
+	// the event is kept unchanged if the altered event can not be scheduled
+	failed := &testFailedTaskService{TaskService: ts, createErr: moerr.NewInternalErrorNoCtx("create failed")}
+	ses.GetParameterUnit().TaskService = func() (taskservice.TaskService, bool) {
+		return failed, true
+	}
+	ae = &tree.AlterEvent{
+		Name:    tree.NewEventName("e3", tree.ObjectNamePrefix{}),
+		Comment: tree.EventComment{Exist: true, Comment: "altered"},
+	}
+	require.ErrorContains(t, doAlterEvent(ctx, ses, ae), "create failed")
+	cronTasks, err = ts.QueryCronTask(ctx)
+	require.NoError(t, err)
+	require.Len(t, cronTasks, 1)
+	def = &eventDefinition{}
+	require.NoError(t, json.Unmarshal(cronTasks[0].Metadata.Context, def))
+	require.Equal(t, "renamed", def.Comment)
+
+	// the new cron task is removed if the old one can not be deleted
+	failed.createErr, failed.deleteErr = nil, moerr.NewInternalErrorNoCtx("delete failed")
+	failed.deleteID = cronTasks[0].Metadata.ID
+	require.ErrorContains(t, doAlterEvent(ctx, ses, ae), "delete failed")
+	cronTasks, err = ts.QueryCronTask(ctx)
+	require.NoError(t, err)
+	require.Len(t, cronTasks, 1)
+	require.Equal(t, failed.deleteID, cronTasks[0].Metadata.ID)
+	def = &eventDefinition{}
+	require.NoError(t, json.Unmarshal(cronTasks[0].Metadata.Context, def))
+	require.Equal(t, "renamed", def.Comment)
+	ses.GetParameterUnit().TaskService = func() (taskservice.TaskService, bool) {
+		return ts, true
+	}
+
+	require.Error(t, doAlterEvent(ctx, ses, &tree.AlterEvent{Name: tree.NewEventName("e1", tree.ObjectNamePrefix{})}))
+	require.Error(t, doDropEvent(ctx, ses, &tree.DropEvent{Name: tree.NewEventName("e1", tree.ObjectNamePrefix{})}))
+	require.NoError(t, doDropEvent(ctx, ses, &tree.DropEvent{Name: tree.NewEventName("e1", tree.ObjectNamePrefix{}), IfExists: true}))
+	require.NoError(t, doDropEvent(ctx, ses, &tree.DropEvent{Name: tree.NewEventName("e3", tree.ObjectNamePrefix{})}))
+	cronTasks, err = ts.QueryCronTask(ctx)
+	require.NoError(t, err)
+	require.Len(t, cronTasks, 0)
+}
+
+func Test_getEventDatabasesOfStatement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+
+	dbNames, err := getEventDatabasesOfStatement(ses, &tree.Select{})
+	require.NoError(t, err)
+	require.Empty(t, dbNames)
+
+	// no database is selected
+	_, err = getEventDatabasesOfStatement(ses, &tree.DropEvent{Name: tree.NewEventName("e1", tree.ObjectNamePrefix{})})
+	require.Error(t, err)
+
+	ses.SetDatabaseName("db1")
+	dbNames, err = getEventDatabasesOfStatement(ses, &tree.CreateEvent{Name: tree.NewEventName("e1", tree.ObjectNamePrefix{})})
+	require.NoError(t, err)
+	require.Equal(t, []string{"db1"}, dbNames)
+
+	ae := &tree.AlterEvent{
+		Name:     tree.NewEventName("e1", tree.ObjectNamePrefix{}),
+		RenameTo: tree.NewEventName("e2", tree.ObjectNamePrefix{SchemaName: "db2", ExplicitSchema: true}),
+	}
+	dbNames, err = getEventDatabasesOfStatement(ses, ae)
+	require.NoError(t, err)
+	require.Equal(t, []string{"db1", "db2"}, dbNames)
+}
+
+// testFailedTaskService fails to create the cron tasks or to delete the given one
+type testFailedTaskService struct {
+	taskservice.TaskService
+	createErr error
+	deleteErr error
+	deleteID  string
+}
+
+func (ts *testFailedTaskService) CreateCronTask(ctx context.Context, metadata task.TaskMetadata, cronExpr string) error {
+	if ts.createErr != nil {
+		return ts.createErr
+	}
+	return ts.TaskService.CreateCronTask(ctx, metadata, cronExpr)
+}
+
+func (ts *testFailedTaskService) DeleteCronTask(ctx context.Context, taskMetadataID string) error {
+	if ts.deleteErr != nil && taskMetadataID == ts.deleteID {
+		return ts.deleteErr
+	}
+	return ts.TaskService.DeleteCronTask(ctx, taskMetadataID)
+}
+
+// testEventExecutor records the sqls executed by the event executor
+type testEventExecutor struct {
+	sqls     []string
+	accounts []uint32
+	opts     []ie.SessionOverrideOptions
+	err      error
+}
+
+func (e *testEventExecutor) Exec(ctx context.Context, sql string, opts ie.SessionOverrideOptions) error {
+	e.sqls = append(e.sqls, sql)
+	e.accounts = append(e.accounts, ctx.Value(defines.TenantIDKey{}).(uint32))
+	e.opts = append(e.opts, opts)
+	if strings.HasPrefix(sql, "insert into mo_catalog.mo_event_history") {
+		return nil
+	}
+	return e.err
+}
+
+func (e *testEventExecutor) Query(context.Context, string, ie.SessionOverrideOptions) ie.InternalExecResult {
+	return nil
+}
+
+func (e *testEventExecutor) ApplySessionOverride(ie.SessionOverrideOptions) {}
+
+func Test_userDefinedEventExecutor(t *testing.T) {
+	ctx := context.TODO()
+	store := taskservice.NewMemTaskStorage()
+	ts := taskservice.NewTaskService(runtime.DefaultRuntime(), store)
+	defer func() {
+		require.NoError(t, ts.Close())
+	}()
+
+	exec := &testEventExecutor{}
+	executor := GetUserDefinedEventExecutor(func() ie.InternalExecutor { return exec }, ts)
+
+	def := &eventDefinition{
+		AccountID: 10,
+		UserID:    2,
+		RoleID:    3,
+		Database:  "db1",
+		Name:      "e1",
+		Body:      "insert into t1 values (1)",
+		Every:     1,
+		Unit:      "HOUR",
+		Enabled:   true,
+		Version:   1,
+	}
+	metadata, err := def.taskMetadata()
+	require.NoError(t, err)
+	require.NoError(t, ts.CreateCronTask(ctx, metadata, "@every 1h"))
+
+	require.NoError(t, executor(ctx, task.Task{Metadata: metadata}))
+	require.Len(t, exec.sqls, 2)
+	require.Equal(t, def.Body, exec.sqls[0])
+	require.Equal(t, []uint32{10, 10}, exec.accounts)
+	require.Equal(t, "db1", *exec.opts[0].Database)
+	require.Equal(t, uint32(2), *exec.opts[0].UserId)
+	require.Equal(t, uint32(3), *exec.opts[0].DefaultRoleId)
+	require.Contains(t, exec.sqls[1], "'SUCCESS'")
+
+	// the failure is recorded into the history
+	exec.err = moerr.NewInternalErrorNoCtx("it's failed")
+	require.Error(t, executor(ctx, task.Task{Metadata: metadata}))
+	require.Len(t, exec.sqls, 4)
+	require.Contains(t, exec.sqls[3], "'FAILED'")
+	require.Contains(t, exec.sqls[3], "it''s failed")
+
+	// the disabled event is not executed
+	def.Enabled = false
+	disabled, err := def.taskMetadata()
+	require.NoError(t, err)
+	require.NoError(t, executor(ctx, task.Task{Metadata: disabled}))
+	require.Len(t, exec.sqls, 4)
+
+	// the completed one-time event is dropped after it is executed
+	exec.err = nil
+	def.Enabled = true
+	def.At = time.Now().Add(-time.Second).Unix()
+	oneTime, err := def.taskMetadata()
+	require.NoError(t, err)
+	require.NoError(t, executor(ctx, task.Task{Metadata: oneTime}))
+	require.Len(t, exec.sqls, 6)
+	cronTasks, err := store.QueryCronTask(ctx)
+	require.NoError(t, err)
+	require.Len(t, cronTasks, 0)
+}
@@ -423,6 +423,12 @@ func constructByte(obj interface{}, bat *batch.Batch, index int32, ByteChan chan
 			case types.T_json:
 				val := types.DecodeJson(vec.GetBytesAt(i))
 				writeByte = appendBytes(writeByte, []byte(formatJsonString(val.String(), flag[j])), symbol[j], closeby, flag[j])
+			case types.T_array_float32:
+				val := types.BytesToArrayToString[float32](vec.GetBytesAt(i))
+				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
+			case types.T_array_float64:
+				val := types.BytesToArrayToString[float64](vec.GetBytesAt(i))
+				writeByte = appendBytes(writeByte, []byte(val), symbol[j], closeby, flag[j])
 			case types.T_bool:
 				val := vector.GetFixedAt[bool](vec, i)
 				if val {
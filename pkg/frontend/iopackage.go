@@ -15,7 +15,15 @@
 package frontend
 
 import (
+	"bytes"
+	"compress/zlib"
 	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 )
 
 const (
@@ -186,3 +194,185 @@ func (bio *IOPackageImpl) ReadUint64(data []byte, pos int) (uint64, int, bool) {
 		return binary.BigEndian.Uint64(data[pos : pos+8]), pos + 8, true
 	}
 }
+
+// CompressionAlgorithm is the algorithm of the compressed mysql protocol
+type CompressionAlgorithm int
+
+const (
+	CompressionNone CompressionAlgorithm = iota
+	CompressionZlib
+	CompressionZstd
+)
+
+const (
+	// compressedHeaderLength is the length of the header of the compressed packet.
+	// int<3> length of the compressed payload, int<1> compressed sequence id,
+	// int<3> length of the payload before compressed
+	compressedHeaderLength = 7
+	// minCompressLength is the length under which the payload is sent uncompressed
+	minCompressLength = 50
+)
+
+// compressedConn implements the compressed packet framing of the mysql protocol.
+// The packets of the protocol are seen as a byte stream which is split into the
+// compressed packets, so the packets above this conn are unchanged.
+// The compressed packets have their own sequence id. The client resets it to 0 at the
+// beginning of a command, and the server continues it in the response.
+type compressedConn struct {
+	net.Conn
+	algorithm CompressionAlgorithm
+	// level is only used by zstd
+	level int
+	// mu protects the sequence id, which is updated by the read and used by the write
+	mu  sync.Mutex
+	seq uint8
+	// in is the decompressed data that is not read yet
+	in     []byte
+	header [compressedHeaderLength]byte
+	// zlib writer and its output are reused
+	zlibBuf    bytes.Buffer
+	zlibWriter *zlib.Writer
+}
+
+// NewCompressedConn wraps the connection after the client and the server
+// agree on the compression in the handshake.
+func NewCompressedConn(conn net.Conn, algorithm CompressionAlgorithm, level int) net.Conn {
+	if level == 0 {
+		level = compress.DefaultZstdLevel
+	}
+	return &compressedConn{
+		Conn:      conn,
+		algorithm: algorithm,
+		level:     level,
+	}
+}
+
+func (c *compressedConn) Read(p []byte) (int, error) {
+	for len(c.in) == 0 {
+		if err := c.readCompressedPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.in)
+	c.in = c.in[n:]
+	return n, nil
+}
+
+// readCompressedPacket reads a compressed packet and decompresses the payload into c.in
+func (c *compressedConn) readCompressedPacket() error {
+	if _, err := io.ReadFull(c.Conn, c.header[:]); err != nil {
+		return err
+	}
+	compressedLength := int(readUint24(c.header[:], 0))
+	length := int(readUint24(c.header[:], 4))
+	c.mu.Lock()
+	c.seq = c.header[3] + 1
+	c.mu.Unlock()
+
+	payload := make([]byte, compressedLength)
+	if _, err := io.ReadFull(c.Conn, payload); err != nil {
+		return err
+	}
+	// the payload is not compressed
+	if length == 0 {
+		c.in = payload
+		return nil
+	}
+
+	data := make([]byte, length)
+	switch c.algorithm {
+	case CompressionZlib:
+		r, err := zlib.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		if _, err = io.ReadFull(r, data); err != nil {
+			return err
+		}
+	case CompressionZstd:
+		var err error
+		if data, err = compress.Decompress(payload, data, compress.Zstd); err != nil {
+			return err
+		}
+	}
+	if len(data) != length {
+		return moerr.NewInternalErrorNoCtx("invalid compressed packet: length %d, decompressed %d", length, len(data))
+	}
+	c.in = data
+	return nil
+}
+
+func (c *compressedConn) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	written := 0
+	for written < len(p) {
+		n := Min(int(MaxPayloadSize), len(p)-written)
+		if err := c.writeCompressedPacket(p[written : written+n]); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return written, nil
+}
+
+// writeCompressedPacket sends the data in a compressed packet. The small data, or
+// the data which can not be compressed, is sent as it is.
+func (c *compressedConn) writeCompressedPacket(data []byte) error {
+	payload := data
+	length := 0
+	if len(data) >= minCompressLength {
+		compressed, err := c.compress(data)
+		if err != nil {
+			return err
+		}
+		if len(compressed) < len(data) {
+			payload = compressed
+			length = len(data)
+		}
+	}
+
+	packet := make([]byte, compressedHeaderLength+len(payload))
+	writeUint24(packet, 0, uint32(len(payload)))
+	packet[3] = c.seq
+	writeUint24(packet, 4, uint32(length))
+	copy(packet[compressedHeaderLength:], payload)
+	if _, err := c.Conn.Write(packet); err != nil {
+		return err
+	}
+	c.seq++
+	return nil
+}
+
+func (c *compressedConn) compress(data []byte) ([]byte, error) {
+	switch c.algorithm {
+	case CompressionZlib:
+		c.zlibBuf.Reset()
+		if c.zlibWriter == nil {
+			c.zlibWriter = zlib.NewWriter(&c.zlibBuf)
+		} else {
+			c.zlibWriter.Reset(&c.zlibBuf)
+		}
+		if _, err := c.zlibWriter.Write(data); err != nil {
+			return nil, err
+		}
+		if err := c.zlibWriter.Close(); err != nil {
+			return nil, err
+		}
+		return c.zlibBuf.Bytes(), nil
+	case CompressionZstd:
+		return compress.CompressLevel(data, nil, compress.Zstd, c.level)
+	}
+	return data, nil
+}
+
+func readUint24(data []byte, pos int) uint32 {
+	return uint32(data[pos]) | uint32(data[pos+1])<<8 | uint32(data[pos+2])<<16
+}
+
+func writeUint24(data []byte, pos int, value uint32) {
+	data[pos] = byte(value)
+	data[pos+1] = byte(value >> 8)
+	data[pos+2] = byte(value >> 16)
+}
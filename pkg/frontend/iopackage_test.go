@@ -15,8 +15,11 @@
 package frontend
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"net"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -367,3 +370,49 @@ func Test_AppendUint(t *testing.T) {
 		convey.So(b, convey.ShouldEqual, true)
 	})
 }
+
+func Test_compressedConn(t *testing.T) {
+	convey.Convey("compressed packets", t, func() {
+		for _, algorithm := range []CompressionAlgorithm{CompressionZlib, CompressionZstd} {
+			client, server := net.Pipe()
+			cc := NewCompressedConn(client, algorithm, 0)
+			sc := NewCompressedConn(server, algorithm, 0)
+
+			small := []byte("select 1")
+			large := bytes.Repeat([]byte("0123456789"), 1000)
+			go func() {
+				_, _ = cc.Write(small)
+				_, _ = cc.Write(large)
+			}()
+
+			// the small data is not compressed
+			var header [compressedHeaderLength]byte
+			got := make([]byte, len(small))
+			_, err := io.ReadFull(sc, got)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(got, convey.ShouldResemble, small)
+			convey.So(sc.(*compressedConn).seq, convey.ShouldEqual, 1)
+
+			got = make([]byte, len(large))
+			_, err = io.ReadFull(sc, got)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(got, convey.ShouldResemble, large)
+			// the response continues the sequence id of the request
+			convey.So(sc.(*compressedConn).seq, convey.ShouldEqual, 2)
+
+			go func() {
+				_, _ = sc.Write(large)
+			}()
+			_, err = io.ReadFull(client, header[:])
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(header[3], convey.ShouldEqual, 2)
+			convey.So(readUint24(header[:], 4), convey.ShouldEqual, len(large))
+			convey.So(readUint24(header[:], 0), convey.ShouldBeLessThan, len(large))
+			_, err = io.CopyN(io.Discard, client, int64(readUint24(header[:], 0)))
+			convey.So(err, convey.ShouldBeNil)
+
+			_ = client.Close()
+			_ = server.Close()
+		}
+	})
+}
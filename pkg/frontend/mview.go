@@ -0,0 +1,837 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/taskservice"
+	util2 "github.com/matrixorigin/matrixone/pkg/util"
+	ie "github.com/matrixorigin/matrixone/pkg/util/internalExecutor"
+)
+
+const (
+	insertIntoMoMViewsFormat = `insert into mo_catalog.mo_mviews(
+		database_name,
+		mview_name,
+		definition,
+		default_database,
+		base_database,
+		base_table,
+		incremental,
+		refresh_interval,
+		last_refresh_ts,
+		definer,
+		created_time,
+		task_version) values ('%s', '%s', '%s', '%s', '%s', '%s', %v, '%s', '', '%s', current_timestamp(), %d);`
+
+	selectMoMViewsForUpdateFormat = "select definition, default_database, incremental, last_refresh_ts, definer from mo_catalog.mo_mviews where database_name = '%s' and mview_name = '%s' for update;"
+
+	selectMoMViewsTaskVersionFormat = "select task_version from mo_catalog.mo_mviews where database_name = '%s' and mview_name = '%s';"
+
+	updateMoMViewsRefreshFormat = "update mo_catalog.mo_mviews set last_refresh_ts = '%s', last_refresh_time = current_timestamp() where database_name = '%s' and mview_name = '%s';"
+
+	refreshMViewFormat = "refresh materialized view %s.%s incremental"
+
+	// mviewMaxChangedKeys is the max number of the changed groups which are merged
+	// by their keys, the whole materialized view is merged if more groups are changed.
+	mviewMaxChangedKeys = 1000
+
+	// mviewInsertBatchSize is the max number of the rows inserted by one statement
+	mviewInsertBatchSize = 1000
+
+	mviewMergeTableName   = "__mo_merge"
+	mviewRecomputeColName = "__mo_recompute"
+)
+
+// mviewRefreshTask is the context of the cron task which refreshes the materialized
+// view every interval. The refresh is run in the account of the definer.
+type mviewRefreshTask struct {
+	AccountID uint32 `json:"account_id"`
+	UserID    uint32 `json:"user_id"`
+	UserName  string `json:"user_name"`
+	RoleID    uint32 `json:"role_id"`
+	Database  string `json:"database"`
+	Name      string `json:"name"`
+	// Every and Unit are the interval of the refresh
+	Every int64  `json:"every"`
+	Unit  string `json:"unit"`
+	// Version is the task_version of the materialized view in mo_mviews. The task
+	// cancels itself if the materialized view is dropped or created again.
+	Version int64 `json:"version"`
+}
+
+// taskID returns the task metadata id of the cron task of the materialized view
+func (t *mviewRefreshTask) taskID() string {
+	return fmt.Sprintf("mview:%d:%s.%s:%d", t.AccountID, t.Database, t.Name, t.Version)
+}
+
+// cronExpr converts the interval into the cron expression in the same way as the event
+func (t *mviewRefreshTask) cronExpr(ctx context.Context) (string, error) {
+	def := &eventDefinition{Every: t.Every, Unit: t.Unit, Version: t.Version}
+	return def.cronExpr(ctx)
+}
+
+func (t *mviewRefreshTask) taskMetadata() (task.TaskMetadata, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return task.TaskMetadata{}, err
+	}
+	return task.TaskMetadata{
+		ID:       t.taskID(),
+		Executor: task.TaskCode_MViewRefresh,
+		Context:  data,
+	}, nil
+}
+
+// mview is the materialized view recorded in mo_catalog.mo_mviews.
+type mview struct {
+	dbName string
+	name   string
+	// query refers to the tables in defaultDB if they are not qualified
+	query     *tree.Select
+	defaultDB string
+	// spec is not nil if the materialized view can be refreshed incrementally
+	spec *plan2.MaterializedViewSpec
+	// cols are the columns of the table of the materialized view, which are the
+	// columns of the query followed by the helper columns of the incremental refresh,
+	// and colTypes are their types
+	cols     []string
+	colTypes []*plan.Type
+	// definer is the user who creates the materialized view, the query is checked
+	// against the privileges of the definer on each refresh
+	definer string
+}
+
+func (mv *mview) tableName() string {
+	return quoteMViewIdentifier(mv.dbName) + "." + quoteMViewIdentifier(mv.name)
+}
+
+func quoteMViewIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func formatMViewNode(node tree.NodeFormatter) string {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	node.Format(fmtCtx)
+	return fmtCtx.String()
+}
+
+// fromBaseTable returns the FROM clause reading the rows of the base table
+func (mv *mview) fromBaseTable() string {
+	return fmt.Sprintf(" from %s.%s as %s", quoteMViewIdentifier(mv.spec.Database),
+		quoteMViewIdentifier(mv.spec.Table), quoteMViewIdentifier(mv.spec.Alias))
+}
+
+// fromChanges returns the FROM clause reading the rows of the base table inserted and
+// deleted in (from, to], the deleted rows have -1 in the sign column.
+func (mv *mview) fromChanges(from, to string) string {
+	return fmt.Sprintf(" from mo_table_changes('%s', '%s', '%s', '%s') as %s",
+		util.EscapeSqlString(mv.spec.Database), util.EscapeSqlString(mv.spec.Table), from, to,
+		quoteMViewIdentifier(mv.spec.Alias))
+}
+
+func (mv *mview) where() string {
+	if mv.spec.Where == nil {
+		return ""
+	}
+	return " where " + formatMViewNode(mv.spec.Where)
+}
+
+// keyCols returns the columns of the group by keys of the materialized view
+func (mv *mview) keyCols() []string {
+	var cols []string
+	for i, column := range mv.spec.Columns {
+		if column.Agg == "" {
+			cols = append(cols, quoteMViewIdentifier(mv.cols[i]))
+		}
+	}
+	return cols
+}
+
+// rowKeys returns the values of the group by keys of the row of the materialized view
+func (mv *mview) rowKeys(row []*triggerValue) []*triggerValue {
+	var keys []*triggerValue
+	for i, column := range mv.spec.Columns {
+		if column.Agg == "" {
+			keys = append(keys, row[i])
+		}
+	}
+	return keys
+}
+
+// keyExprs returns the group by exprs in the order of the columns of the materialized view
+func (mv *mview) keyExprs() []string {
+	var exprs []string
+	for _, column := range mv.spec.Columns {
+		if column.Agg == "" {
+			exprs = append(exprs, formatMViewNode(column.Expr))
+		}
+	}
+	return exprs
+}
+
+func (mv *mview) aggExpr(column plan2.MaterializedViewColumn) string {
+	if column.Expr == nil {
+		return column.Agg + "(*)"
+	}
+	return column.Agg + "(" + formatMViewNode(column.Expr) + ")"
+}
+
+// fullRefreshSQL returns the statement which populates the empty materialized view, or the
+// groups of the materialized view matching the filter on the base table.
+func (mv *mview) fullRefreshSQL(filter string) string {
+	if mv.spec == nil {
+		return fmt.Sprintf("insert into %s %s", mv.tableName(), formatMViewNode(mv.query))
+	}
+	exprs := make([]string, 0, len(mv.cols))
+	for _, column := range mv.spec.Columns {
+		if column.Agg == "" {
+			exprs = append(exprs, formatMViewNode(column.Expr))
+		} else {
+			exprs = append(exprs, mv.aggExpr(column))
+		}
+	}
+	exprs = append(exprs, "count(*)")
+	for _, column := range mv.spec.Columns {
+		if column.Agg == "sum" {
+			exprs = append(exprs, "count("+formatMViewNode(column.Expr)+")")
+		}
+	}
+	groupBy := ""
+	if len(mv.spec.GroupBy) > 0 {
+		keys := make([]string, len(mv.spec.GroupBy))
+		for i, expr := range mv.spec.GroupBy {
+			keys[i] = formatMViewNode(expr)
+		}
+		groupBy = " group by " + strings.Join(keys, ", ")
+	}
+	where := mv.where()
+	if filter != "" {
+		if mv.spec.Where == nil {
+			where = " where " + filter
+		} else {
+			where = fmt.Sprintf(" where (%s) and (%s)", formatMViewNode(mv.spec.Where), filter)
+		}
+	}
+	return fmt.Sprintf("insert into %s select %s%s%s%s", mv.tableName(), strings.Join(exprs, ", "),
+		mv.fromBaseTable(), where, groupBy)
+}
+
+// changedKeysSQL returns the query of the keys of the groups changed in (from, to].
+// The query of the materialized view without GROUP BY returns 1 if anything is changed.
+func (mv *mview) changedKeysSQL(from, to string) string {
+	keys := mv.keyExprs()
+	if len(keys) == 0 {
+		return fmt.Sprintf("select 1%s%s limit 1", mv.fromChanges(from, to), mv.where())
+	}
+	return fmt.Sprintf("select distinct %s%s%s", strings.Join(keys, ", "), mv.fromChanges(from, to), mv.where())
+}
+
+// keysFilter returns the filter of the rows with the keys, cols are the columns or the exprs
+// of the keys. The keys are compared as the constants of the types of the keys, so the
+// filter is exact for the float and decimal keys and can be used to look up the keys.
+func (mv *mview) keysFilter(keys [][]*triggerValue, cols []string) string {
+	var typs []*plan.Type
+	for i, column := range mv.spec.Columns {
+		if column.Agg == "" {
+			var typ *plan.Type
+			if i < len(mv.colTypes) {
+				typ = mv.colTypes[i]
+			}
+			typs = append(typs, typ)
+		}
+	}
+	filters := make([]string, len(keys))
+	for i, key := range keys {
+		conds := make([]string, len(cols))
+		for j, col := range cols {
+			if key[j].isNull {
+				conds[j] = col + " is null"
+			} else {
+				conds[j] = col + " = " + mviewConstant(key[j], typs[j])
+			}
+		}
+		filters[i] = "(" + strings.Join(conds, " and ") + ")"
+	}
+	return strings.Join(filters, " or ")
+}
+
+// mviewConstant returns the constant of the value in the type, the string constant is
+// compared as the string if the type is unknown.
+func mviewConstant(v *triggerValue, typ *plan.Type) string {
+	s := "'" + util.EscapeSqlString(v.val) + "'"
+	if typ == nil {
+		return s
+	}
+	t := types.T(typ.Id)
+	switch {
+	case t.IsMySQLString():
+		return s
+	case t.IsDecimal():
+		return fmt.Sprintf("cast(%s as decimal(%d,%d))", s, typ.Width, typ.Scale)
+	case t == types.T_datetime || t == types.T_timestamp || t == types.T_time:
+		return fmt.Sprintf("cast(%s as %s(%d))", s, strings.ToLower(t.String()), typ.Scale)
+	}
+	return fmt.Sprintf("cast(%s as %s)", s, strings.ToLower(t.String()))
+}
+
+// mergeSQL returns the query which merges the rows of the materialized view matching the
+// filter with the changes in (from, to]. It returns the new rows of the materialized view,
+// and whether the MIN or MAX of the group must be recomputed because its extreme value
+// may be deleted.
+//
+// The rows of the materialized view and the changes are aggregated into the partial
+// aggregates of the same shape, and merged by the group by keys:
+//
+//	SUM:   the inserted sum, the deleted sum and the number of not null values
+//	COUNT: the number of the rows or the not null values
+//	MIN:   the inserted min, the deleted min and the old min
+//	MAX:   the inserted max, the deleted max and the old max
+func (mv *mview) mergeSQL(from, to, filter string) string {
+	var oldExprs, deltaExprs, exprs, recomputes []string
+	col := func(i int) string {
+		return fmt.Sprintf("%s.__mo_c%d", mviewMergeTableName, i)
+	}
+	n := 0
+	add := func(oldExpr, deltaExpr string) string {
+		oldExprs = append(oldExprs, fmt.Sprintf("%s as __mo_c%d", oldExpr, n))
+		deltaExprs = append(deltaExprs, deltaExpr)
+		n++
+		return col(n - 1)
+	}
+	sign := catalog.TableChangesSignColName
+	var nnExprs []string
+	var groupBy []string
+	for i, column := range mv.spec.Columns {
+		old := quoteMViewIdentifier(mv.cols[i])
+		var arg string
+		if column.Expr != nil {
+			arg = formatMViewNode(column.Expr)
+		}
+		switch column.Agg {
+		case "":
+			key := add(old, arg)
+			groupBy = append(groupBy, key)
+			exprs = append(exprs, key)
+		case "sum":
+			inserted := add(old, fmt.Sprintf("sum(case when %s = 1 then %s end)", sign, arg))
+			deleted := add("0", fmt.Sprintf("sum(case when %s = -1 then %s end)", sign, arg))
+			notNull := add(quoteMViewIdentifier(plan2.MaterializedViewNotNullColName(i)),
+				fmt.Sprintf("sum(case when %s is null then 0 else %s end)", arg, sign))
+			exprs = append(exprs, fmt.Sprintf("case when sum(%s) = 0 then null else coalesce(sum(%s), 0) - coalesce(sum(%s), 0) end",
+				notNull, inserted, deleted))
+			nnExprs = append(nnExprs, fmt.Sprintf("sum(%s)", notNull))
+		case "count":
+			delta := "sum(" + sign + ")"
+			if column.Expr != nil {
+				delta = fmt.Sprintf("sum(case when %s is null then 0 else %s end)", arg, sign)
+			}
+			exprs = append(exprs, fmt.Sprintf("sum(%s)", add(old, delta)))
+		case "min", "max":
+			cmp := "<="
+			if column.Agg == "max" {
+				cmp = ">="
+			}
+			inserted := add(old, fmt.Sprintf("%s(case when %s = 1 then %s end)", column.Agg, sign, arg))
+			deleted := add("null", fmt.Sprintf("%s(case when %s = -1 then %s end)", column.Agg, sign, arg))
+			oldValue := add(old, "null")
+			exprs = append(exprs, fmt.Sprintf("%s(%s)", column.Agg, inserted))
+			recomputes = append(recomputes, fmt.Sprintf("(%[1]s(%[2]s) is not null and (%[1]s(%[3]s) is null or %[1]s(%[2]s) %[4]s %[1]s(%[3]s)))",
+				column.Agg, deleted, oldValue, cmp))
+		}
+	}
+	count := add(quoteMViewIdentifier(catalog.MViewCountColName), "sum("+sign+")")
+	exprs = append(exprs, fmt.Sprintf("sum(%s)", count))
+	exprs = append(exprs, nnExprs...)
+	recompute := "0"
+	if len(recomputes) > 0 {
+		recompute = "case when " + strings.Join(recomputes, " or ") + " then 1 else 0 end"
+	}
+	exprs = append(exprs, recompute+" as "+mviewRecomputeColName)
+
+	oldWhere := ""
+	if filter != "" {
+		oldWhere = " where " + filter
+	}
+	deltaGroupBy := ""
+	outerGroupBy := ""
+	if keys := mv.keyExprs(); len(keys) > 0 {
+		deltaGroupBy = " group by " + strings.Join(keys, ", ")
+		outerGroupBy = " group by " + strings.Join(groupBy, ", ")
+	}
+	return fmt.Sprintf("select %s from (select %s from %s%s union all select %s%s%s%s) as %s%s",
+		strings.Join(exprs, ", "),
+		strings.Join(oldExprs, ", "), mv.tableName(), oldWhere,
+		strings.Join(deltaExprs, ", "), mv.fromChanges(from, to), mv.where(), deltaGroupBy,
+		mviewMergeTableName, outerGroupBy)
+}
+
+// insertValuesSQL returns the statement which inserts the rows into the materialized view
+func (mv *mview) insertValuesSQL(rows [][]*triggerValue) string {
+	var sb strings.Builder
+	sb.WriteString("insert into ")
+	sb.WriteString(mv.tableName())
+	sb.WriteString(" values ")
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteByte('(')
+		for j, v := range row {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			if v.isNull {
+				sb.WriteString("null")
+			} else {
+				sb.WriteByte('\'')
+				sb.WriteString(util.EscapeSqlString(v.val))
+				sb.WriteByte('\'')
+			}
+		}
+		sb.WriteByte(')')
+	}
+	return sb.String()
+}
+
+var _ ComputationRunner = &mviewCreateRunner{}
+
+// mviewCreateRunner creates the table of the materialized view, then records the
+// materialized view in mo_catalog.mo_mviews, populates it and schedules its refresh,
+// all in the transaction of the session.
+type mviewCreateRunner struct {
+	ComputationRunner
+	ctx  context.Context
+	ses  *Session
+	stmt *tree.CreateMaterializedView
+	pn   *plan.Plan
+}
+
+func (r *mviewCreateRunner) Run(ts uint64) (*util2.RunResult, error) {
+	// CREATE VIEW is checked for the statement, and SELECT on the tables of the query
+	if err := checkMViewQueryPrivilege(r.ctx, r.ses, r.stmt.AsSource); err != nil {
+		return nil, err
+	}
+	createTable := r.pn.GetDdl().GetCreateTable()
+	if createTable.IfNotExists {
+		if _, tableDef := r.ses.GetTxnCompileCtx().Resolve(createTable.Database, createTable.TableDef.Name); tableDef != nil {
+			return r.ComputationRunner.Run(ts)
+		}
+	}
+	result, err := r.ComputationRunner.Run(ts)
+	if err != nil {
+		return nil, err
+	}
+	if err = doCreateMView(r.ctx, r.ses, r.stmt, createTable); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func doCreateMView(ctx context.Context, ses *Session, st *tree.CreateMaterializedView, createTable *plan.CreateTable) error {
+	var ts taskservice.TaskService
+	var err error
+	if st.Refresh != nil {
+		if ts, err = getEventTaskService(ctx, ses); err != nil {
+			return err
+		}
+	}
+
+	bh := ses.GetShareTxnBackgroundExec(ctx, false)
+	defer bh.Close()
+	ctx = context.WithValue(ctx, defines.MViewRefreshKey{}, true)
+
+	mv := &mview{
+		dbName:    createTable.Database,
+		name:      createTable.TableDef.Name,
+		query:     st.AsSource,
+		defaultDB: ses.GetDatabaseName(),
+	}
+	incremental := false
+	for _, col := range createTable.TableDef.Cols {
+		if col.Hidden {
+			continue
+		}
+		mv.cols = append(mv.cols, col.Name)
+		mv.colTypes = append(mv.colTypes, col.Typ)
+		if col.Name == catalog.MViewCountColName {
+			incremental = true
+		}
+	}
+	var baseDB, baseTable string
+	if incremental {
+		mv.spec = plan2.GetMaterializedViewSpec(mv.query, mv.defaultDB)
+		baseDB, baseTable = mv.spec.Database, mv.spec.Table
+	}
+
+	var refreshTask *mviewRefreshTask
+	interval := ""
+	if st.Refresh != nil {
+		def := &eventDefinition{Version: time.Now().UnixNano()}
+		if err = def.setSchedule(ctx, bh, st.Refresh); err != nil {
+			return err
+		}
+		tenant := ses.GetTenantInfo()
+		refreshTask = &mviewRefreshTask{
+			AccountID: tenant.GetTenantID(),
+			UserID:    tenant.GetUserID(),
+			UserName:  tenant.GetUser(),
+			RoleID:    tenant.GetDefaultRoleID(),
+			Database:  mv.dbName,
+			Name:      mv.name,
+			Every:     def.Every,
+			Unit:      def.Unit,
+			Version:   def.Version,
+		}
+		if _, err = refreshTask.cronExpr(ctx); err != nil {
+			return err
+		}
+		interval = fmt.Sprintf("%d %s", def.Every, def.Unit)
+	}
+
+	version := int64(0)
+	if refreshTask != nil {
+		version = refreshTask.Version
+	}
+	sql := fmt.Sprintf(insertIntoMoMViewsFormat,
+		util.EscapeSqlString(mv.dbName),
+		util.EscapeSqlString(mv.name),
+		util.EscapeSqlString(formatMViewNode(mv.query)),
+		util.EscapeSqlString(mv.defaultDB),
+		util.EscapeSqlString(baseDB),
+		util.EscapeSqlString(baseTable),
+		incremental,
+		interval,
+		util.EscapeSqlString(ses.GetTenantInfo().GetUser()),
+		version)
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	if err = refreshMViewFully(ctx, ses, bh, mv); err != nil {
+		return err
+	}
+
+	if refreshTask == nil {
+		return nil
+	}
+	cronExpr, err := refreshTask.cronExpr(ctx)
+	if err != nil {
+		return err
+	}
+	metadata, err := refreshTask.taskMetadata()
+	if err != nil {
+		return err
+	}
+	return ts.CreateCronTask(ctx, metadata, cronExpr)
+}
+
+// snapshotTS returns the snapshot of the transaction of the session, which is the
+// snapshot of the last statement in the RC isolation.
+func snapshotTS(ses *Session) types.TS {
+	_, txnOp := ses.GetTxnHandler().GetTxnOperator()
+	return types.TimestampToTS(txnOp.Txn().SnapshotTS)
+}
+
+// refreshMViewFully replaces the rows of the materialized view with the result of its
+// query, and records the snapshot the query reads as the time of the refresh.
+func refreshMViewFully(ctx context.Context, ses *Session, bh BackgroundExec, mv *mview) error {
+	if err := bh.Exec(ctx, "delete from "+mv.tableName()); err != nil {
+		return err
+	}
+	if err := bh.Exec(ctx, mv.fullRefreshSQL("")); err != nil {
+		return err
+	}
+	return bh.Exec(ctx, fmt.Sprintf(updateMoMViewsRefreshFormat, snapshotTS(ses).ToString(),
+		util.EscapeSqlString(mv.dbName), util.EscapeSqlString(mv.name)))
+}
+
+// refreshMViewIncrementally applies the changes of the base table after the last refresh
+// to the materialized view. The groups whose MIN or MAX may be deleted are recomputed from
+// the base table. It returns false if the materialized view must be refreshed fully.
+func refreshMViewIncrementally(ctx context.Context, ses *Session, bh BackgroundExec, mv *mview, from string) (bool, error) {
+	to := snapshotTS(ses).ToString()
+	keys, err := fetchMViewRows(ctx, bh, mv.changedKeysSQL(from, to))
+	if err != nil {
+		return false, err
+	}
+	if len(keys) > 0 {
+		filter := ""
+		if len(mv.spec.GroupBy) > 0 && len(keys) <= mviewMaxChangedKeys {
+			filter = mv.keysFilter(keys, mv.keyCols())
+		}
+		rows, err := fetchMViewRows(ctx, bh, mv.mergeSQL(from, to, filter))
+		if err != nil {
+			return false, err
+		}
+		countIdx := len(mv.spec.Columns)
+		newRows := make([][]*triggerValue, 0, len(rows))
+		var recomputeKeys [][]*triggerValue
+		for _, row := range rows {
+			if recompute := row[len(row)-1]; recompute.val != "0" {
+				// the query without GROUP BY has only one group, which is the whole query
+				if len(mv.spec.GroupBy) == 0 {
+					return false, nil
+				}
+				recomputeKeys = append(recomputeKeys, mv.rowKeys(row))
+				continue
+			}
+			// the group without rows is removed
+			if len(mv.spec.GroupBy) > 0 && row[countIdx].val == "0" {
+				continue
+			}
+			newRows = append(newRows, row[:len(row)-1])
+		}
+		if len(recomputeKeys) > mviewMaxChangedKeys {
+			return false, nil
+		}
+
+		sql := "delete from " + mv.tableName()
+		if filter != "" {
+			sql += " where " + filter
+		}
+		if err = bh.Exec(ctx, sql); err != nil {
+			return false, err
+		}
+		for len(newRows) > 0 {
+			batch := newRows
+			if len(batch) > mviewInsertBatchSize {
+				batch = batch[:mviewInsertBatchSize]
+			}
+			if err = bh.Exec(ctx, mv.insertValuesSQL(batch)); err != nil {
+				return false, err
+			}
+			newRows = newRows[len(batch):]
+		}
+		if len(recomputeKeys) > 0 {
+			if err = bh.Exec(ctx, mv.fullRefreshSQL(mv.keysFilter(recomputeKeys, mv.keyExprs()))); err != nil {
+				return false, err
+			}
+		}
+	}
+	return true, bh.Exec(ctx, fmt.Sprintf(updateMoMViewsRefreshFormat, to,
+		util.EscapeSqlString(mv.dbName), util.EscapeSqlString(mv.name)))
+}
+
+// fetchMViewRows runs the query and returns its rows
+func fetchMViewRows(ctx context.Context, bh BackgroundExec, sql string) ([][]*triggerValue, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	mrs, ok := erArray[0].(*MysqlResultSet)
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "it is not the type of result set")
+	}
+	rows := make([][]*triggerValue, mrs.GetRowCount())
+	for i := range rows {
+		rows[i] = make([]*triggerValue, mrs.GetColumnCount())
+		for j := range rows[i] {
+			if rows[i][j], err = getTriggerValue(ctx, mrs, uint64(i), uint64(j)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rows, nil
+}
+
+// lockMView locks the materialized view in mo_mviews, so it is refreshed by one
+// transaction at a time, and returns the materialized view and its last refresh time.
+func lockMView(ctx context.Context, ses *Session, bh BackgroundExec, dbName, name string) (*mview, bool, string, error) {
+	_, tableDef := ses.GetTxnCompileCtx().Resolve(dbName, name)
+	if tableDef == nil {
+		return nil, false, "", moerr.NewNoSuchTable(ctx, dbName, name)
+	}
+	if tableDef.TableType != catalog.SystemMaterializedRel {
+		return nil, false, "", moerr.NewInvalidInput(ctx, "'%s.%s' is not a materialized view", dbName, name)
+	}
+
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(selectMoMViewsForUpdateFormat, util.EscapeSqlString(dbName), util.EscapeSqlString(name))); err != nil {
+		return nil, false, "", err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, false, "", err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, false, "", moerr.NewInternalError(ctx, "can not find the materialized view '%s.%s' in %s", dbName, name, catalog.MO_MVIEWS)
+	}
+	mv := &mview{dbName: dbName, name: name}
+	definition, err := erArray[0].GetString(ctx, 0, 0)
+	if err != nil {
+		return nil, false, "", err
+	}
+	if mv.defaultDB, err = erArray[0].GetString(ctx, 0, 1); err != nil {
+		return nil, false, "", err
+	}
+	incremental, err := erArray[0].GetInt64(ctx, 0, 2)
+	if err != nil {
+		return nil, false, "", err
+	}
+	lastRefreshTS, err := erArray[0].GetString(ctx, 0, 3)
+	if err != nil {
+		return nil, false, "", err
+	}
+	if mv.definer, err = erArray[0].GetString(ctx, 0, 4); err != nil {
+		return nil, false, "", err
+	}
+
+	stmts, err := parsers.Parse(ctx, dialect.MYSQL, definition, 1)
+	if err != nil {
+		return nil, false, "", err
+	}
+	mv.query = stmts[0].(*tree.Select)
+	if incremental != 0 {
+		mv.spec = plan2.GetMaterializedViewSpec(mv.query, mv.defaultDB)
+	}
+	for _, col := range tableDef.Cols {
+		if !col.Hidden {
+			mv.cols = append(mv.cols, col.Name)
+			mv.colTypes = append(mv.colTypes, col.Typ)
+		}
+	}
+	return mv, incremental != 0, lastRefreshTS, nil
+}
+
+// doRefreshMView refreshes the materialized view in the transaction of the session.
+// The incremental refresh falls back to the full refresh if the changes of the base
+// table are not available, or the materialized view can only be refreshed fully.
+func doRefreshMView(ctx context.Context, ses *Session, st *tree.RefreshMaterializedView) error {
+	dbName := string(st.Name.SchemaName)
+	if dbName == "" {
+		if ses.DatabaseNameIsEmpty() {
+			return moerr.NewNoDB(ctx)
+		}
+		dbName = ses.GetDatabaseName()
+	}
+	if _, _, err := ses.GetTxnHandler().GetTxn(); err != nil {
+		return err
+	}
+
+	bh := ses.GetShareTxnBackgroundExec(ctx, false)
+	defer bh.Close()
+	ctx = context.WithValue(ctx, defines.MViewRefreshKey{}, true)
+
+	mv, incremental, lastRefreshTS, err := lockMView(ctx, ses, bh, dbName, string(st.Name.ObjectName))
+	if err != nil {
+		return err
+	}
+	// the definer may lose the privileges on the tables of the query after the creation
+	if err = checkMViewDefinerPrivilege(ctx, ses, bh, mv); err != nil {
+		return err
+	}
+	if mv.defaultDB != "" {
+		if err = bh.Exec(ctx, "use "+quoteMViewIdentifier(mv.defaultDB)); err != nil {
+			return err
+		}
+	}
+	if st.Incremental && incremental && lastRefreshTS != "" {
+		done, err := refreshMViewIncrementally(ctx, ses, bh, mv, lastRefreshTS)
+		if err != nil && !moerr.IsMoErrCode(err, moerr.ErrTableChangesUnavailable) {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+	return refreshMViewFully(ctx, ses, bh, mv)
+}
+
+// checkMViewQueryPrivilege checks the SELECT privilege of the user of the session on the
+// tables scanned by the plan of the query.
+func checkMViewQueryPrivilege(ctx context.Context, ses *Session, query *tree.Select) error {
+	if ses.GetTenantInfo() == nil {
+		return nil
+	}
+	_, err := buildPlan(ctx, ses, ses.GetTxnCompileCtx(), query)
+	return err
+}
+
+// checkMViewDefinerPrivilege checks the query of the materialized view against the
+// privileges of the definer in the transaction of the session.
+func checkMViewDefinerPrivilege(ctx context.Context, ses *Session, bh BackgroundExec, mv *mview) error {
+	definer, err := getDefinerTenantInfo(ctx, ses, bh, mv.definer)
+	if err != nil {
+		return err
+	}
+	dbh, ctx := newDefinerExec(ctx, ses, definer, mv.defaultDB)
+	defer dbh.Close()
+	return checkMViewQueryPrivilege(ctx, dbh.ses.Session, mv.query)
+}
+
+// GetMViewRefreshExecutor returns the executor of the tasks which refresh the materialized
+// views incrementally in the account of the definer. The cron task is deleted if the
+// materialized view is dropped.
+func GetMViewRefreshExecutor(ieFactory func() ie.InternalExecutor, ts taskservice.TaskService) taskservice.TaskExecutor {
+	return func(ctx context.Context, t task.Task) error {
+		refreshTask := &mviewRefreshTask{}
+		if err := json.Unmarshal(t.Metadata.Context, refreshTask); err != nil {
+			return err
+		}
+
+		execCtx := context.WithValue(ctx, defines.TenantIDKey{}, refreshTask.AccountID)
+		execCtx = context.WithValue(execCtx, defines.UserIDKey{}, refreshTask.UserID)
+		execCtx = context.WithValue(execCtx, defines.RoleIDKey{}, refreshTask.RoleID)
+		opts := ie.NewOptsBuilder().
+			Database(refreshTask.Database).
+			Username(refreshTask.UserName).
+			AccountId(refreshTask.AccountID).
+			UserId(refreshTask.UserID).
+			DefaultRoleId(refreshTask.RoleID).
+			Finish()
+
+		result := ieFactory().Query(execCtx, fmt.Sprintf(selectMoMViewsTaskVersionFormat,
+			util.EscapeSqlString(refreshTask.Database), util.EscapeSqlString(refreshTask.Name)), opts)
+		if err := result.Error(); err != nil {
+			return err
+		}
+		if result.RowCount() == 0 {
+			return ts.DeleteCronTask(ctx, refreshTask.taskID())
+		}
+		version, err := result.Value(ctx, 0, 0)
+		if err != nil {
+			return err
+		}
+		if v, ok := version.(int64); !ok || v != refreshTask.Version {
+			return ts.DeleteCronTask(ctx, refreshTask.taskID())
+		}
+
+		return ieFactory().Exec(execCtx, fmt.Sprintf(refreshMViewFormat,
+			quoteMViewIdentifier(refreshTask.Database), quoteMViewIdentifier(refreshTask.Name)), opts)
+	}
+}
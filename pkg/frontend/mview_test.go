@@ -0,0 +1,150 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/task"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestMView(t *testing.T, sql string, cols ...string) *mview {
+	t.Helper()
+	stmt, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, sql, 1)
+	require.NoError(t, err)
+	query := stmt.(*tree.Select)
+	mv := &mview{
+		dbName:    "db1",
+		name:      "mv",
+		query:     query,
+		defaultDB: "db1",
+		spec:      plan2.GetMaterializedViewSpec(query, "db1"),
+		cols:      cols,
+	}
+	require.NotNil(t, mv.spec)
+	return mv
+}
+
+func Test_mviewFullRefreshSQL(t *testing.T) {
+	mv := newTestMView(t, "select a, sum(b) as s, count(*) as c from t1 where b > 0 group by a", "a", "s", "c")
+	require.Equal(t,
+		"insert into `db1`.`mv` select a, sum(b), count(*), count(*), count(b) from `db1`.`t1` as `t1` where b > 0 group by a",
+		mv.fullRefreshSQL(""))
+	// the groups matching the filter are refreshed
+	require.Equal(t,
+		"insert into `db1`.`mv` select a, sum(b), count(*), count(*), count(b) from `db1`.`t1` as `t1` where (b > 0) and ((a = '1')) group by a",
+		mv.fullRefreshSQL(mv.keysFilter([][]*triggerValue{{{val: "1"}}}, mv.keyExprs())))
+
+	// the query is refreshed as it is if it can not be refreshed incrementally
+	mv.spec = nil
+	require.Equal(t,
+		"insert into `db1`.`mv` select a, sum(b) as s, count(\"*\") as c from t1 where b > 0 group by a",
+		mv.fullRefreshSQL(""))
+}
+
+func Test_mviewChangedKeysSQL(t *testing.T) {
+	mv := newTestMView(t, "select a, max(b) from t1 as x group by a", "a", "max(b)")
+	require.Equal(t,
+		"select distinct a from mo_table_changes('db1', 't1', '1-0', '2-0') as `x`",
+		mv.changedKeysSQL("1-0", "2-0"))
+
+	mv = newTestMView(t, "select count(*) from t1", "count(*)")
+	require.Equal(t,
+		"select 1 from mo_table_changes('db1', 't1', '1-0', '2-0') as `t1` limit 1",
+		mv.changedKeysSQL("1-0", "2-0"))
+}
+
+func Test_mviewKeysFilter(t *testing.T) {
+	mv := newTestMView(t, "select a, b, min(c) from t1 group by a, b", "a", "b", "min(c)")
+	filter := mv.keysFilter([][]*triggerValue{
+		{{val: "1"}, {val: "x'y"}},
+		{{val: "2"}, {isNull: true}},
+	}, mv.keyCols())
+	require.Equal(t,
+		"(`a` = '1' and `b` = 'x''y') or (`a` = '2' and `b` is null)",
+		filter)
+
+	// the keys are compared in their types
+	mv.colTypes = []*plan.Type{
+		{Id: int32(types.T_decimal128), Width: 20, Scale: 4},
+		{Id: int32(types.T_float64)},
+		{Id: int32(types.T_int64)},
+	}
+	filter = mv.keysFilter([][]*triggerValue{{{val: "1.2500"}, {val: "0.1"}}}, mv.keyCols())
+	require.Equal(t,
+		"(`a` = cast('1.2500' as decimal(20,4)) and `b` = cast('0.1' as double))",
+		filter)
+	mv.colTypes[1] = &plan.Type{Id: int32(types.T_varchar), Width: 10}
+	filter = mv.keysFilter([][]*triggerValue{{{val: "1"}, {val: "x"}}}, mv.keyExprs())
+	require.Equal(t, "(a = cast('1' as decimal(20,4)) and b = 'x')", filter)
+}
+
+func Test_mviewMergeSQL(t *testing.T) {
+	mv := newTestMView(t, "select a, sum(b), min(b) from t1 group by a", "a", "sum(b)", "min(b)")
+	sql := mv.mergeSQL("1-0", "2-0", "")
+	// the merge query must be a valid statement
+	_, err := parsers.ParseOne(context.TODO(), dialect.MYSQL, sql, 1)
+	require.NoError(t, err)
+	require.Contains(t, sql, "mo_table_changes('db1', 't1', '1-0', '2-0')")
+	require.Contains(t, sql, mviewRecomputeColName)
+	require.NotContains(t, sql, " where ")
+	// the keys and the aggregates are merged in their types
+	require.NotContains(t, sql, "varchar")
+
+	sql = mv.mergeSQL("1-0", "2-0", "`a` is null")
+	require.Contains(t, sql, "from `db1`.`mv` where `a` is null union all")
+}
+
+func Test_mviewInsertValuesSQL(t *testing.T) {
+	mv := newTestMView(t, "select a, count(*) from t1 group by a", "a", "count(*)")
+	require.Equal(t,
+		"insert into `db1`.`mv` values ('1', '2', '2'), (null, '1', '1')",
+		mv.insertValuesSQL([][]*triggerValue{
+			{{val: "1"}, {val: "2"}, {val: "2"}},
+			{{isNull: true}, {val: "1"}, {val: "1"}},
+		}))
+}
+
+func Test_mviewRefreshTask(t *testing.T) {
+	ctx := context.TODO()
+	refreshTask := &mviewRefreshTask{
+		AccountID: 1,
+		Database:  "db1",
+		Name:      "mv",
+		Every:     5,
+		Unit:      "MINUTE",
+		Version:   100,
+	}
+	require.Equal(t, "mview:1:db1.mv:100", refreshTask.taskID())
+	cronExpr, err := refreshTask.cronExpr(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, cronExpr)
+
+	metadata, err := refreshTask.taskMetadata()
+	require.NoError(t, err)
+	require.Equal(t, task.TaskCode_MViewRefresh, metadata.Executor)
+	decoded := &mviewRefreshTask{}
+	require.NoError(t, json.Unmarshal(metadata.Context, decoded))
+	require.Equal(t, refreshTask, decoded)
+}
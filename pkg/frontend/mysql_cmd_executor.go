@@ -85,6 +85,10 @@ func unclassifiedStatementInUncommittedTxnErrorInfo() string {
 	return "unclassified statement appears in uncommitted transaction"
 }
 
+func writeStatementAtSnapshotErrorInfo() string {
+	return "only the read-only statements can be executed when snapshot_ts is set"
+}
+
 func abortTransactionErrorInfo() string {
 	return "Previous DML conflicts with existing constraints or data format. This transaction has to be aborted"
 }
@@ -1212,6 +1216,22 @@ func (mce *MysqlCmdExecutor) handleDropStage(ctx context.Context, ds *tree.DropS
 	return doDropStage(ctx, mce.GetSession(), ds)
 }
 
+func (mce *MysqlCmdExecutor) handleCreateEvent(ctx context.Context, ce *tree.CreateEvent) error {
+	return doCreateEvent(ctx, mce.GetSession(), ce)
+}
+
+func (mce *MysqlCmdExecutor) handleAlterEvent(ctx context.Context, ae *tree.AlterEvent) error {
+	return doAlterEvent(ctx, mce.GetSession(), ae)
+}
+
+func (mce *MysqlCmdExecutor) handleDropEvent(ctx context.Context, de *tree.DropEvent) error {
+	return doDropEvent(ctx, mce.GetSession(), de)
+}
+
+func (mce *MysqlCmdExecutor) handleRefreshMView(ctx context.Context, rm *tree.RefreshMaterializedView) error {
+	return doRefreshMView(ctx, mce.GetSession(), rm)
+}
+
 // handleCreateAccount creates a new user-level tenant in the context of the tenant SYS
 // which has been initialized.
 func (mce *MysqlCmdExecutor) handleCreateAccount(ctx context.Context, ca *tree.CreateAccount) error {
@@ -1543,9 +1563,9 @@ func getExplainOption(requestCtx context.Context, options []tree.OptionElem) (*e
 				if strings.EqualFold(v.Value, "TEXT") {
 					es.Format = explain.EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
-					return nil, moerr.NewNotSupported(requestCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_JSON
 				} else if strings.EqualFold(v.Value, "DOT") {
-					return nil, moerr.NewNotSupported(requestCtx, "Unsupport explain format '%s'", v.Value)
+					es.Format = explain.EXPLAIN_FORMAT_DOT
 				} else {
 					return nil, moerr.NewInvalidInput(requestCtx, "invalid explain option '%s', valud '%s'", v.Name, v.Value)
 				}
@@ -1701,6 +1721,8 @@ var GetComputationWrapper = func(db string, input *UserInput, user string, eng e
 	// if the input is an option ast, we should use it directly
 	if input.getStmt() != nil {
 		stmts = append(stmts, input.getStmt())
+	} else if input.getStmts() != nil {
+		stmts = input.getStmts()
 	} else if isCmdFieldListSql(input.getSql()) {
 		cmdFieldStmt, err = parseCmdFieldList(proc.Ctx, input.getSql())
 		if err != nil {
@@ -2393,6 +2415,22 @@ func (mce *MysqlCmdExecutor) canExecuteStatementInUncommittedTransaction(request
 	return nil
 }
 
+// canExecuteStatementAtSnapshot checks the statement only reads the data if snapshot_ts is set
+func (mce *MysqlCmdExecutor) canExecuteStatementAtSnapshot(requestCtx context.Context, stmt tree.Statement) error {
+	ses := mce.GetSession()
+	if str, ok := ses.GetSysVar("snapshot_ts").(string); !ok || str == "" {
+		return nil
+	}
+	can, err := statementCanBeExecutedAtSnapshot(ses, stmt)
+	if err != nil {
+		return err
+	}
+	if !can {
+		return moerr.NewInternalError(requestCtx, writeStatementAtSnapshotErrorInfo())
+	}
+	return nil
+}
+
 func (mce *MysqlCmdExecutor) processLoadLocal(ctx context.Context, param *tree.ExternParam, writer *io.PipeWriter) (err error) {
 	ses := mce.GetSession()
 	proto := ses.GetMysqlProtocol()
@@ -2601,7 +2639,10 @@ func (mce *MysqlCmdExecutor) executeStmt(requestCtx context.Context,
 				*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword, *tree.Delete, *tree.TruncateTable, *tree.Use,
 				*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 				*tree.LockTableStmt, *tree.UnLockTableStmt,
-				*tree.CreateStage, *tree.DropStage, *tree.AlterStage, *tree.CreateStream:
+				*tree.CreateStage, *tree.DropStage, *tree.AlterStage, *tree.CreateStream,
+				*tree.CreateEvent, *tree.AlterEvent, *tree.DropEvent,
+				*tree.CreateTrigger, *tree.DropTrigger,
+				*tree.CreateMaterializedView, *tree.DropMaterializedView, *tree.RefreshMaterializedView:
 				resp := mce.setResponse(i, len(cws), rspLen)
 				if _, ok := stmt.(*tree.Insert); ok {
 					resp.lastInsertId = proc.GetLastInsertID()
@@ -2959,6 +3000,26 @@ func (mce *MysqlCmdExecutor) executeStmt(requestCtx context.Context,
 		if err = mce.handleAlterStage(requestCtx, st); err != nil {
 			return err
 		}
+	case *tree.CreateEvent:
+		selfHandle = true
+		if err = mce.handleCreateEvent(requestCtx, st); err != nil {
+			return err
+		}
+	case *tree.AlterEvent:
+		selfHandle = true
+		if err = mce.handleAlterEvent(requestCtx, st); err != nil {
+			return err
+		}
+	case *tree.DropEvent:
+		selfHandle = true
+		if err = mce.handleDropEvent(requestCtx, st); err != nil {
+			return err
+		}
+	case *tree.RefreshMaterializedView:
+		selfHandle = true
+		if err = mce.handleRefreshMView(requestCtx, st); err != nil {
+			return err
+		}
 	case *tree.CreateAccount:
 		selfHandle = true
 		ses.InvalidatePrivilegeCache()
@@ -3273,6 +3334,8 @@ func (mce *MysqlCmdExecutor) executeStmt(requestCtx context.Context,
 		*tree.CreateIndex, *tree.DropIndex,
 		*tree.CreateView, *tree.DropView, *tree.AlterView, *tree.AlterTable,
 		*tree.CreateSequence, *tree.DropSequence,
+		*tree.CreateTrigger, *tree.DropTrigger,
+		*tree.CreateMaterializedView, *tree.DropMaterializedView,
 		*tree.Insert, *tree.Update, *tree.Replace,
 		*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 		*tree.SetVar,
@@ -3578,6 +3641,13 @@ func (mce *MysqlCmdExecutor) doComQuery(requestCtx context.Context, input *UserI
 			}
 		}
 
+		//the transactions read the data at snapshot_ts, the statements writing the data are rejected
+		err = mce.canExecuteStatementAtSnapshot(requestCtx, stmt)
+		if err != nil {
+			logStatementStatus(requestCtx, ses, stmt, fail, err)
+			return err
+		}
+
 		err = mce.executeStmt(requestCtx, ses, stmt, proc, cw, i, cws, proto, pu, tenant, userNameOnly)
 		if err != nil {
 			return err
@@ -3729,7 +3799,7 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 		var query = string(req.GetData().([]byte))
 		mce.addSqlCount(1)
 		logDebug(ses, ses.GetDebugString(), "query trace", logutil.ConnectionIdField(ses.GetConnectionID()), logutil.QueryField(SubStringFromBegin(query, int(ses.GetParameterUnit().SV.LengthOfQueryPrinted))))
-		err = doComQuery(requestCtx, &UserInput{sql: query})
+		err = doComQuery(requestCtx, &UserInput{sql: query, stmts: req.stmts})
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_QUERY, mce.ses.GetServerStatus(), err)
 		}
@@ -3770,7 +3840,12 @@ func (mce *MysqlCmdExecutor) ExecRequest(requestCtx context.Context, ses *Sessio
 		sql = fmt.Sprintf("prepare %s from %s", newStmtName, sql)
 		logDebug(ses, ses.GetDebugString(), "query trace", logutil.ConnectionIdField(ses.GetConnectionID()), logutil.QueryField(sql))
 
-		err = doComQuery(requestCtx, &UserInput{sql: sql})
+		// the statement parsed by the protocol is prepared as it is
+		var stmts []tree.Statement
+		if len(req.stmts) == 1 {
+			stmts = []tree.Statement{tree.NewPrepareStmt(tree.Identifier(newStmtName), req.stmts[0])}
+		}
+		err = doComQuery(requestCtx, &UserInput{sql: sql, stmts: stmts})
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_STMT_PREPARE, mce.ses.GetServerStatus(), err)
 		}
@@ -3909,6 +3984,9 @@ func convertEngineTypeToMysqlType(ctx context.Context, engineType types.T, col *
 		col.SetColumnType(defines.MYSQL_TYPE_NULL)
 	case types.T_json:
 		col.SetColumnType(defines.MYSQL_TYPE_JSON)
+	case types.T_array_float32, types.T_array_float64:
+		// the vectors are sent to the client as text
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
 	case types.T_bool:
 		col.SetColumnType(defines.MYSQL_TYPE_BOOL)
 	case types.T_int8:
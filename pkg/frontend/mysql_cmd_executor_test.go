@@ -1331,3 +1331,32 @@ func Test_RecordParseErrorStatement(t *testing.T) {
 	require.NotNil(t, si)
 
 }
+
+func Test_statementCanBeExecutedAtSnapshot(t *testing.T) {
+	args := []struct {
+		stmt tree.Statement
+		want bool
+	}{
+		{&tree.Select{}, true},
+		{&tree.ShowTables{}, true},
+		{&tree.ExplainStmt{}, true},
+		{&tree.SetVar{}, true},
+		{&tree.BeginTransaction{}, true},
+		{&tree.MoDump{}, true},
+		{&tree.PrepareStmt{Stmt: &tree.Select{}}, true},
+		{&tree.Insert{}, false},
+		{&tree.Update{}, false},
+		{&tree.Delete{}, false},
+		{&tree.Replace{}, false},
+		{&tree.Load{}, false},
+		{&tree.CreateTable{}, false},
+		{&tree.PrepareStmt{Stmt: &tree.Insert{}}, false},
+		{tree.NewExplainAnalyze(&tree.Delete{}, "text"), false},
+	}
+
+	for _, a := range args {
+		ret, err := statementCanBeExecutedAtSnapshot(nil, a.stmt)
+		assert.Nil(t, err)
+		assert.Equal(t, a.want, ret)
+	}
+}
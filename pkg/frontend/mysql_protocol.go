@@ -18,6 +18,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/rsa"
 	"crypto/sha1"
 	"encoding/binary"
 	"encoding/hex"
@@ -62,7 +63,9 @@ var DefaultCapability = CLIENT_LONG_PASSWORD |
 	CLIENT_PLUGIN_AUTH |
 	CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA |
 	CLIENT_DEPRECATE_EOF |
-	CLIENT_CONNECT_ATTRS
+	CLIENT_CONNECT_ATTRS |
+	CLIENT_COMPRESS |
+	CLIENT_ZSTD_COMPRESSION_ALGORITHM
 
 // DefaultClientConnStatus default server status
 var DefaultClientConnStatus = SERVER_STATUS_AUTOCOMMIT
@@ -150,7 +153,9 @@ const (
 
 	Utf8mb4CollationID uint8 = 45
 
-	AuthNativePassword string = "mysql_native_password"
+	AuthNativePassword      string = "mysql_native_password"
+	AuthCachingSha2Password string = "caching_sha2_password"
+	AuthClearPassword       string = "mysql_clear_password"
 
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
@@ -322,6 +327,15 @@ type MysqlProtocolImpl struct {
 	// indicated by the plugin name field.
 	authResponse []byte
 
+	// the authentication method used by the client in the handshake response
+	authPlugin string
+
+	// the connection comes from the proxy, which has sent the salt
+	viaProxy bool
+
+	// the level of zstd asked by the client for the compressed protocol
+	zstdCompressionLevel int
+
 	//the default database for the client
 	database string
 
@@ -446,6 +460,8 @@ type response41 struct {
 	clientPluginName  string
 	isAskForTlsHeader bool
 	connectAttrs      map[string]string
+	// the level of zstd if the client asks for CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	zstdCompressionLevel uint8
 }
 
 // handshake response 320
@@ -1120,33 +1136,8 @@ func (mp *MysqlProtocolImpl) writeZeros(data []byte, pos int, count int) int {
 
 // the server get the auth string from HandShakeResponse
 // pwd is SHA1(SHA1(password)), AUTH is from client
-// hash1 = AUTH XOR SHA1( slat + pwd)
-// hash2 = SHA1(hash1)
-// check(hash2, hpwd)
 func (mp *MysqlProtocolImpl) checkPassword(pwd, salt, auth []byte) bool {
-	sha := sha1.New()
-	_, err := sha.Write(salt)
-	if err != nil {
-		logutil.Errorf("SHA1(salt) failed.")
-		return false
-	}
-	_, err = sha.Write(pwd)
-	if err != nil {
-		logutil.Errorf("SHA1(hpwd) failed.")
-		return false
-	}
-	hash1 := sha.Sum(nil)
-
-	if len(auth) != len(hash1) {
-		return false
-	}
-
-	for i := range hash1 {
-		hash1[i] ^= auth[i]
-	}
-
-	hash2 := HashSha1(hash1)
-	return bytes.Equal(pwd, hash2)
+	return checkNativePassword(pwd, salt, auth)
 }
 
 // the server authenticate that the client can connect and use the database
@@ -1155,6 +1146,11 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 	var err error
 	var tenant *TenantInfo
 
+	plugin, authResponse, err := mp.negotiateAuthPlugin(ctx, authResponse)
+	if err != nil {
+		return err
+	}
+
 	ses := mp.GetSession()
 	if !mp.SV.SkipCheckUser {
 		logDebugf(mp.getDebugStringUnsafe(), "authenticate user 1")
@@ -1165,12 +1161,11 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 		logDebugf(mp.getDebugStringUnsafe(), "authenticate user 2")
 
 		//TO Check password
-		if mp.checkPassword(psw, mp.GetSalt(), authResponse) {
-			logDebugf(mp.getDebugStringUnsafe(), "check password succeeded")
-			ses.InitGlobalSystemVariables()
-		} else {
-			return moerr.NewInternalError(ctx, "check password failed")
+		if err = plugin.Authenticate(ctx, mp, mp.GetUserName(), psw, authResponse); err != nil {
+			return err
 		}
+		logDebugf(mp.getDebugStringUnsafe(), "check password succeeded")
+		ses.InitGlobalSystemVariables()
 	} else {
 		logDebugf(mp.getDebugStringUnsafe(), "skip authenticate user")
 		//Get tenant info
@@ -1216,6 +1211,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp41.authResponse
+		mp.authPlugin = resp41.clientPluginName
 		mp.capability = mp.capability & resp41.capabilities
 
 		if nameAndCharset, ok3 := collationID2CharsetAndName[int(resp41.collationID)]; !ok3 {
@@ -1230,6 +1226,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		mp.username = resp41.username
 		mp.database = resp41.database
 		mp.connectAttrs = resp41.connectAttrs
+		mp.zstdCompressionLevel = int(resp41.zstdCompressionLevel)
 	} else {
 		var resp320 response320
 		var ok2 bool
@@ -1243,6 +1240,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp320.authResponse
+		mp.authPlugin = AuthNativePassword
 		mp.capability = mp.capability & resp320.capabilities
 		mp.collationID = int(Utf8mb4CollationID)
 		mp.collationName = "utf8mb4_general_ci"
@@ -1279,9 +1277,32 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	mp.EnableCompression()
 	return nil
 }
 
+// compressionAlgorithm returns the compression agreed in the handshake
+func (mp *MysqlProtocolImpl) compressionAlgorithm() CompressionAlgorithm {
+	if mp.capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		return CompressionZstd
+	}
+	if mp.capability&CLIENT_COMPRESS != 0 {
+		return CompressionZlib
+	}
+	return CompressionNone
+}
+
+// EnableCompression switches the connection to the compressed protocol if the client
+// asks for it in the handshake. The packets after the OK packet of the handshake are compressed.
+func (mp *MysqlProtocolImpl) EnableCompression() {
+	algorithm := mp.compressionAlgorithm()
+	if algorithm == CompressionNone {
+		return
+	}
+	logDebugf(mp.getDebugStringUnsafe(), "enable compression %d", algorithm)
+	mp.tcpConn.UseConn(NewCompressedConn(mp.tcpConn.RawConn(), algorithm, mp.zstdCompressionLevel))
+}
+
 // the server makes a handshake v10 packet
 // return handshake packet
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
@@ -1334,7 +1355,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//string[NUL]    auth-plugin name
-		pos = mp.writeStringNUL(data, pos, AuthNativePassword)
+		pos = mp.writeStringNUL(data, pos, mp.defaultAuthPlugin())
 	}
 
 	return data[:pos]
@@ -1442,15 +1463,8 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		if !ok {
 			return false, info, moerr.NewInternalError(ctx, "get auth plugin name failed")
 		}
-
-		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
-			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
-				return false, info, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
-			}
-			info.clientPluginName = AuthNativePassword
-		}
+	} else {
+		info.clientPluginName = AuthNativePassword
 	}
 
 	// client connection attributes
@@ -1477,6 +1491,13 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		}
 	}
 
+	if info.capabilities&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		info.zstdCompressionLevel, pos, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get zstd compression level failed")
+		}
+	}
+
 	return true, info, nil
 }
 
@@ -1617,14 +1638,77 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 // the server can send AuthSwitchRequest to ask client to use designated authentication method,
 // if both server and client support CLIENT_PLUGIN_AUTH capability.
 // return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context) ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context, authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
 	}
+	return mp.ReadAuthData(ctx)
+}
+
+// negotiateAuthPlugin decides the authentication method. The client using a method
+// other than the default one is asked to switch to the default one, except the
+// scramble of mysql_native_password replayed by the proxy.
+// return the plugin and the auth data for it
+func (mp *MysqlProtocolImpl) negotiateAuthPlugin(ctx context.Context, authResponse []byte) (AuthPlugin, []byte, error) {
+	name := mp.defaultAuthPlugin()
+	if mp.authPlugin != name && !(mp.authPlugin == AuthNativePassword && mp.viaProxy) {
+		if mp.authPlugin == "" || mp.GetCapability()&CLIENT_PLUGIN_AUTH == 0 {
+			if name != AuthNativePassword {
+				return nil, nil, moerr.NewInternalError(ctx, "the client does not support the authentication method %s", name)
+			}
+		} else {
+			var err error
+			if authResponse, err = mp.negotiateAuthenticationMethod(ctx, name); err != nil {
+				return nil, nil, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
+			}
+			mp.authResponse = authResponse
+			mp.authPlugin = name
+		}
+	} else {
+		name = mp.authPlugin
+	}
+	plugin, ok := getAuthPlugin(name)
+	if !ok {
+		return nil, nil, moerr.NewInternalError(ctx, "unsupported authentication method %s", name)
+	}
+	return plugin, authResponse, nil
+}
+
+// defaultAuthPlugin returns the authentication method announced in the handshake
+func (mp *MysqlProtocolImpl) defaultAuthPlugin() string {
+	if mp.SV == nil || mp.SV.DefaultAuthPlugin == "" {
+		return AuthNativePassword
+	}
+	return mp.SV.DefaultAuthPlugin
+}
 
+// IsSecure implements the AuthConn interface. The connection with TLS or by
+// the unix socket is secure.
+func (mp *MysqlProtocolImpl) IsSecure() bool {
+	if mp.IsTlsEstablished() {
+		return true
+	}
+	if mp.tcpConn != nil {
+		if _, ok := mp.tcpConn.RawConn().(*net.UnixConn); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteAuthMoreData implements the AuthConn interface.
+func (mp *MysqlProtocolImpl) WriteAuthMoreData(data []byte) error {
+	payload := make([]byte, 0, len(data)+1)
+	payload = append(payload, authMoreDataHeader)
+	payload = append(payload, data...)
+	return mp.writePackets(payload)
+}
+
+// ReadAuthData implements the AuthConn interface.
+func (mp *MysqlProtocolImpl) ReadAuthData(ctx context.Context) ([]byte, error) {
 	read, err := mp.tcpConn.Read(goetty.ReadOptions{})
 	if err != nil {
 		return nil, err
@@ -1648,6 +1732,15 @@ func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context)
 	return data, nil
 }
 
+// RSAKey implements the AuthConn interface.
+func (mp *MysqlProtocolImpl) RSAKey() (*rsa.PrivateKey, error) {
+	file := ""
+	if mp.SV != nil {
+		file = mp.SV.RsaPrivateKeyFile
+	}
+	return loadRSAKey(context.TODO(), file)
+}
+
 // make a OK packet
 func (mp *MysqlProtocolImpl) makeOKPayload(affectedRows, lastInsertId uint64, statusFlags, warnings uint16, message string) []byte {
 	data := make([]byte, HeaderOffset+128+len(message)+10)
@@ -2704,6 +2797,130 @@ func (mp *MysqlProtocolImpl) MakeEOFPayload(warnings, status uint16) []byte {
 	return mp.makeEOFPayload(warnings, status)
 }
 
+// AuthenticateForProxy negotiates the authentication method with the client in the proxy,
+// and returns the handshake response replayed to the CN server. The proxy has no password
+// of the users, so the client of caching_sha2_password always does the full authentication,
+// and the password is replayed as the scramble of mysql_native_password with the salt shared
+// with the CN server, so it is never sent in cleartext between the proxy and the CN server.
+// The proxy and the CN servers must be configured with the same default authentication method.
+// The compression is only done between the client and the proxy, so it is not asked
+// in the replayed handshake response.
+func (mp *MysqlProtocolImpl) AuthenticateForProxy(ctx context.Context, payload []byte) ([]byte, error) {
+	capabilities, _, ok := mp.io.ReadUint16(payload, 0)
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "read capabilities from response packet failed")
+	}
+	if uint32(capabilities)&CLIENT_PROTOCOL_41 == 0 {
+		payload = append([]byte(nil), payload...)
+		mp.io.WriteUint16(payload, 0, capabilities&^uint16(CLIENT_COMPRESS))
+		return payload, nil
+	}
+	ok, info, err := mp.analyseHandshakeResponse41(ctx, payload)
+	if !ok {
+		return nil, err
+	}
+	info.capabilities &^= CLIENT_COMPRESS | CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	if info.capabilities&CLIENT_PLUGIN_AUTH == 0 {
+		return mp.makeHandshakeResponse41Payload(info), nil
+	}
+
+	name := mp.defaultAuthPlugin()
+	if info.clientPluginName != name {
+		if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx, name); err != nil {
+			return nil, err
+		}
+		info.clientPluginName = name
+	}
+	if info.clientPluginName == AuthCachingSha2Password {
+		var password []byte
+		if len(info.authResponse) > 0 {
+			if password, err = readCachingSha2Password(ctx, mp); err != nil {
+				return nil, err
+			}
+		}
+		info.clientPluginName = AuthNativePassword
+		info.authResponse = nil
+		if len(password) > 0 {
+			info.authResponse = makeNativePasswordScramble(password, mp.GetSalt())
+		}
+	}
+	return mp.makeHandshakeResponse41Payload(info), nil
+}
+
+// makeHandshakeResponse41Payload makes the handshake response41 as the client
+func (mp *MysqlProtocolImpl) makeHandshakeResponse41Payload(info response41) []byte {
+	size := 4 + 4 + 1 + 23 + len(info.username) + 1 + 9 + len(info.authResponse) + len(info.database) + 1 + len(info.clientPluginName) + 1 + 9 + 1
+	for key, value := range info.connectAttrs {
+		size += 9 + len(key) + 9 + len(value)
+	}
+	// the long auth response needs the length encoded integer
+	if len(info.authResponse) > 250 {
+		info.capabilities |= CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA
+	}
+
+	data := make([]byte, size)
+	pos := mp.io.WriteUint32(data, 0, info.capabilities)
+	pos = mp.io.WriteUint32(data, pos, info.maxPacketSize)
+	pos = mp.io.WriteUint8(data, pos, info.collationID)
+	pos = mp.writeZeros(data, pos, 23)
+	pos = mp.writeStringNUL(data, pos, info.username)
+	if info.capabilities&CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA != 0 {
+		pos = mp.writeIntLenEnc(data, pos, uint64(len(info.authResponse)))
+		pos = mp.writeCountOfBytes(data, pos, info.authResponse)
+	} else if info.capabilities&CLIENT_SECURE_CONNECTION != 0 {
+		pos = mp.io.WriteUint8(data, pos, uint8(len(info.authResponse)))
+		pos = mp.writeCountOfBytes(data, pos, info.authResponse)
+	} else {
+		pos = mp.writeStringNUL(data, pos, string(info.authResponse))
+	}
+	if info.capabilities&CLIENT_CONNECT_WITH_DB != 0 {
+		pos = mp.writeStringNUL(data, pos, info.database)
+	}
+	if info.capabilities&CLIENT_PLUGIN_AUTH != 0 {
+		pos = mp.writeStringNUL(data, pos, info.clientPluginName)
+	}
+	if info.capabilities&CLIENT_CONNECT_ATTRS != 0 {
+		attrs := make([]byte, size)
+		attrsPos := 0
+		for key, value := range info.connectAttrs {
+			attrsPos = mp.writeStringLenEnc(attrs, attrsPos, key)
+			attrsPos = mp.writeStringLenEnc(attrs, attrsPos, value)
+		}
+		pos = mp.writeIntLenEnc(data, pos, uint64(attrsPos))
+		pos = mp.writeCountOfBytes(data, pos, attrs[:attrsPos])
+	}
+	if info.capabilities&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		pos = mp.io.WriteUint8(data, pos, info.zstdCompressionLevel)
+	}
+	return data[:pos]
+}
+
+// isProxyAddress checks whether the remote address is one of the allowed addresses or
+// CIDRs of the proxies. Only the loopback addresses are allowed if none is configured.
+func isProxyAddress(remoteAddr string, allowed []string) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	if len(allowed) == 0 {
+		return ip.IsLoopback()
+	}
+	for _, addr := range allowed {
+		if _, ipNet, err := net.ParseCIDR(addr); err == nil {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		} else if allowedIP := net.ParseIP(addr); allowedIP != nil && allowedIP.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
 // receiveExtraInfo tries to receive salt and labels read from proxy module.
 func (mp *MysqlProtocolImpl) receiveExtraInfo(rs goetty.IOSession) {
 	saltLen := 20
@@ -2731,6 +2948,7 @@ func (mp *MysqlProtocolImpl) receiveExtraInfo(rs goetty.IOSession) {
 			zap.Error(err))
 	} else {
 		mp.SetSalt(data)
+		mp.viaProxy = true
 	}
 
 	// Read requested labels from proxy.
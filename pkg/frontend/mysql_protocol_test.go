@@ -2753,3 +2753,20 @@ func TestMysqlProtocolImpl_Close(t *testing.T) {
 	assert.Nil(t, proto.lenEncBuffer)
 	assert.Nil(t, proto.binaryNullBuffer)
 }
+
+func Test_isProxyAddress(t *testing.T) {
+	convey.Convey("isProxyAddress", t, func() {
+		convey.So(isProxyAddress("127.0.0.1:12345", nil), convey.ShouldBeTrue)
+		convey.So(isProxyAddress("[::1]:12345", nil), convey.ShouldBeTrue)
+		convey.So(isProxyAddress("10.1.2.3:12345", nil), convey.ShouldBeFalse)
+		convey.So(isProxyAddress("unknown", nil), convey.ShouldBeFalse)
+		convey.So(isProxyAddress("unknown", []string{"10.1.0.0/16"}), convey.ShouldBeFalse)
+
+		allowed := []string{"10.1.0.0/16", "192.168.1.5", "invalid"}
+		convey.So(isProxyAddress("10.1.2.3:12345", allowed), convey.ShouldBeTrue)
+		convey.So(isProxyAddress("10.2.2.3:12345", allowed), convey.ShouldBeFalse)
+		convey.So(isProxyAddress("192.168.1.5:12345", allowed), convey.ShouldBeTrue)
+		convey.So(isProxyAddress("192.168.1.6:12345", allowed), convey.ShouldBeFalse)
+		convey.So(isProxyAddress("127.0.0.1:12345", allowed), convey.ShouldBeFalse)
+	})
+}
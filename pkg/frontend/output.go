@@ -176,6 +176,10 @@ func extractRowFromVector(ses *Session, vec *vector.Vector, i int, row []interfa
 	switch vec.GetType().Oid { //get col
 	case types.T_json:
 		row[i] = types.DecodeJson(vec.GetBytesAt(rowIndex))
+	case types.T_array_float32:
+		row[i] = types.BytesToArrayToString[float32](vec.GetBytesAt(rowIndex))
+	case types.T_array_float64:
+		row[i] = types.BytesToArrayToString[float64](vec.GetBytesAt(rowIndex))
 	case types.T_bool:
 		row[i] = vector.GetFixedAt[bool](vec, rowIndex)
 	case types.T_int8:
@@ -0,0 +1,1081 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/fagongzi/goetty/v2/codec"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The postgresql frontend/backend protocol v3.
+// see https://www.postgresql.org/docs/current/protocol.html
+
+const (
+	pgProtocolVersion   uint32 = 196608 // 3.0
+	pgSSLRequestCode    uint32 = 80877103
+	pgCancelRequestCode uint32 = 80877102
+	pgGSSENCRequestCode uint32 = 80877104
+
+	pgMaxMessageLength = 1 << 30
+
+	pgServerVersion = "15.0-MatrixOne-v"
+)
+
+// the messages from the client
+const (
+	pgMsgStartup   byte = 0 // the startup messages have no type byte
+	pgMsgQuery     byte = 'Q'
+	pgMsgParse     byte = 'P'
+	pgMsgBind      byte = 'B'
+	pgMsgDescribe  byte = 'D'
+	pgMsgExecute   byte = 'E'
+	pgMsgClose     byte = 'C'
+	pgMsgSync      byte = 'S'
+	pgMsgFlush     byte = 'H'
+	pgMsgTerminate byte = 'X'
+	pgMsgPassword  byte = 'p'
+)
+
+// the messages from the server
+const (
+	pgMsgAuthentication       byte = 'R'
+	pgMsgParameterStatus      byte = 'S'
+	pgMsgBackendKeyData       byte = 'K'
+	pgMsgReadyForQuery        byte = 'Z'
+	pgMsgRowDescription       byte = 'T'
+	pgMsgDataRow              byte = 'D'
+	pgMsgCommandComplete      byte = 'C'
+	pgMsgEmptyQueryResponse   byte = 'I'
+	pgMsgErrorResponse        byte = 'E'
+	pgMsgParseComplete        byte = '1'
+	pgMsgBindComplete         byte = '2'
+	pgMsgCloseComplete        byte = '3'
+	pgMsgNoData               byte = 'n'
+	pgMsgParameterDescription byte = 't'
+)
+
+const (
+	pgAuthOk                = 0
+	pgAuthCleartextPassword = 3
+)
+
+const (
+	pgFormatText   int16 = 0
+	pgFormatBinary int16 = 1
+)
+
+// the oids of the postgresql types
+const (
+	pgOidBool      uint32 = 16
+	pgOidBytea     uint32 = 17
+	pgOidInt8      uint32 = 20
+	pgOidInt2      uint32 = 21
+	pgOidInt4      uint32 = 23
+	pgOidText      uint32 = 25
+	pgOidJson      uint32 = 114
+	pgOidFloat4    uint32 = 700
+	pgOidFloat8    uint32 = 701
+	pgOidBpchar    uint32 = 1042
+	pgOidVarchar   uint32 = 1043
+	pgOidDate      uint32 = 1082
+	pgOidTime      uint32 = 1083
+	pgOidTimestamp uint32 = 1114
+	pgOidNumeric   uint32 = 1700
+	pgOidUuid      uint32 = 2950
+)
+
+// sqlstates of the postgresql errors raised by the protocol layer
+const (
+	pgStateProtocolViolation    = "08P01"
+	pgStateInvalidAuthorization = "28000"
+	pgStateInvalidPassword      = "28P01"
+)
+
+type pgMessage struct {
+	typ     byte
+	payload []byte
+}
+
+// pgCodec decodes the postgresql messages. The startup messages have no type byte,
+// and the first byte of their length is always 0 which is not a valid message type.
+type pgCodec struct {
+	sqlCodec
+}
+
+func NewPgCodec() codec.Codec {
+	return &pgCodec{}
+}
+
+func (c *pgCodec) Decode(in *buf.ByteBuf) (interface{}, bool, error) {
+	readable := in.Readable()
+	if readable < 5 {
+		return nil, false, nil
+	}
+
+	header := in.PeekN(0, 5)
+	typ := header[0]
+	offset := 1
+	if typ == pgMsgStartup {
+		offset = 0
+	}
+	length := int(binary.BigEndian.Uint32(header[offset:]))
+	if length < 4 || length > pgMaxMessageLength {
+		return nil, false, moerr.NewInvalidInputNoCtx("invalid message length %d", length)
+	}
+	if readable < offset+length {
+		return nil, false, nil
+	}
+
+	in.Skip(offset + 4)
+	in.SetMarkIndex(in.GetReadIndex() + length - 4)
+	payload := in.ReadMarkedData()
+	return &pgMessage{
+		typ:     typ,
+		payload: payload,
+	}, true, nil
+}
+
+// pgStatement is the statement created by the Parse message
+type pgStatement struct {
+	name string
+	// sql with the placeholders $n rewritten to ?
+	sql  string
+	stmt tree.Statement
+	// the ith ? in sql is the parameter $(paramOrder[i]+1)
+	paramOrder []int
+	numParams  int
+	// the types of the parameters specified by the client, 0 means unspecified
+	paramOIDs []uint32
+	// the name of the prepared statement in the session. It is empty if the
+	// statement is not preparable, and it is executed as a simple query.
+	prepareName string
+	// RowDescription has been sent by Describe
+	described bool
+}
+
+// pgPortal is the portal created by the Bind message
+type pgPortal struct {
+	name      string
+	statement *pgStatement
+	// the values of the ? in the sql of the statement
+	params        []any
+	resultFormats []int16
+	// RowDescription has been sent by Describe
+	described bool
+}
+
+var _ MysqlProtocol = &PgProtocolImpl{}
+
+// PgProtocolImpl implements the postgresql protocol upon the interface of the mysql protocol,
+// so that the statements run through the same Session and MysqlCmdExecutor.
+type PgProtocolImpl struct {
+	ProtocolImpl
+
+	SV *config.FrontendParameters
+
+	ses *Session
+
+	username     string
+	database     string
+	connectAttrs map[string]string
+	authResponse []byte
+
+	// the key to cancel the running query in the CancelRequest
+	secretKey uint32
+
+	// the startup message has been received
+	startup bool
+
+	statements map[string]*pgStatement
+	portals    map[string]*pgPortal
+	// the statement waiting for the response of prepare
+	preparing *pgStatement
+	// the portal being executed
+	executing *pgPortal
+	// an error occurred in the extended query, the messages are discarded until Sync
+	failed bool
+
+	// the result set being sent
+	columns     []Column
+	rows        uint64
+	inResultSet bool
+	// in the extended query, RowDescription is sent by Describe
+	describeBySelf bool
+	resultFormats  []int16
+}
+
+func NewPgProtocol(connectionID uint32, tcp goetty.IOSession, SV *config.FrontendParameters) *PgProtocolImpl {
+	return &PgProtocolImpl{
+		ProtocolImpl: ProtocolImpl{
+			io:           NewIOPackage(false),
+			tcpConn:      tcp,
+			connectionID: connectionID,
+		},
+		SV:             SV,
+		secretKey:      rand.Uint32(),
+		statements:     make(map[string]*pgStatement),
+		portals:        make(map[string]*pgPortal),
+		describeBySelf: true,
+	}
+}
+
+func (pp *PgProtocolImpl) SetSession(ses *Session) {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	pp.ses = ses
+}
+
+func (pp *PgProtocolImpl) GetSession() *Session {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.ses
+}
+
+func (pp *PgProtocolImpl) GetRequest(payload []byte) *Request {
+	return &Request{
+		cmd:  COM_QUERY,
+		data: payload,
+	}
+}
+
+func (pp *PgProtocolImpl) GetDatabaseName() string {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.database
+}
+
+func (pp *PgProtocolImpl) SetDatabaseName(s string) {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	pp.database = s
+}
+
+func (pp *PgProtocolImpl) GetUserName() string {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.username
+}
+
+func (pp *PgProtocolImpl) SetUserName(s string) {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	pp.username = s
+}
+
+func (pp *PgProtocolImpl) GetCapability() uint32 {
+	return DefaultCapability
+}
+
+func (pp *PgProtocolImpl) GetConnectAttrs() map[string]string {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	return pp.connectAttrs
+}
+
+// HandleHandshake parses the StartupMessage. It returns true if the client asks for TLS.
+func (pp *PgProtocolImpl) HandleHandshake(ctx context.Context, payload []byte) (bool, error) {
+	code, pos, ok := pp.io.ReadUint32(payload, 0)
+	if !ok {
+		return false, moerr.NewInvalidInput(ctx, "received a broken startup message")
+	}
+	if code == pgSSLRequestCode {
+		return true, nil
+	}
+	if code != pgProtocolVersion {
+		return false, moerr.NewInvalidInput(ctx, "unsupported frontend protocol %d.%d", code>>16, code&0xffff)
+	}
+
+	attrs := make(map[string]string)
+	for pos < len(payload) && payload[pos] != 0 {
+		var key, value string
+		if key, pos, ok = pgReadString(payload, pos); !ok {
+			return false, moerr.NewInvalidInput(ctx, "received a broken startup message")
+		}
+		if value, pos, ok = pgReadString(payload, pos); !ok {
+			return false, moerr.NewInvalidInput(ctx, "received a broken startup message")
+		}
+		attrs[key] = value
+	}
+	if attrs["user"] == "" {
+		return false, moerr.NewInvalidInput(ctx, "no user name specified in the startup message")
+	}
+
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	pp.username = attrs["user"]
+	pp.database = attrs["database"]
+	pp.connectAttrs = attrs
+	pp.startup = true
+	return false, nil
+}
+
+// Authenticate checks the password received in the PasswordMessage, and
+// sends the messages after the authentication.
+func (pp *PgProtocolImpl) Authenticate(ctx context.Context) error {
+	if err := pp.authenticateUser(ctx); err != nil {
+		logutil.Errorf("authenticate user failed.error:%v", err)
+		msg := fmt.Sprintf("password authentication failed for user %s. %s", getUserPart(pp.GetUserName()), err.Error())
+		if err2 := pp.sendErrorResponse("FATAL", pgStateInvalidPassword, msg); err2 != nil {
+			return err2
+		}
+		return err
+	}
+
+	data := pgBeginMessage(nil, pgMsgAuthentication)
+	data = binary.BigEndian.AppendUint32(data, pgAuthOk)
+	if err := pp.writeMessage(data, false); err != nil {
+		return err
+	}
+	params := [][2]string{
+		{"server_version", pgServerVersion + serverVersion.Load().(string)},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"IntervalStyle", "postgres"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+		{"TimeZone", pp.GetSession().GetTimeZone().String()},
+		{"application_name", pp.GetConnectAttrs()["application_name"]},
+	}
+	for _, param := range params {
+		data = pgBeginMessage(data[:0], pgMsgParameterStatus)
+		data = pgAppendString(data, param[0])
+		data = pgAppendString(data, param[1])
+		if err := pp.writeMessage(data, false); err != nil {
+			return err
+		}
+	}
+	data = pgBeginMessage(data[:0], pgMsgBackendKeyData)
+	data = binary.BigEndian.AppendUint32(data, pp.ConnectionID())
+	data = binary.BigEndian.AppendUint32(data, pp.secretKey)
+	if err := pp.writeMessage(data, false); err != nil {
+		return err
+	}
+	return pp.sendReadyForQuery()
+}
+
+func (pp *PgProtocolImpl) authenticateUser(ctx context.Context) error {
+	ses := pp.GetSession()
+	if !pp.SV.SkipCheckUser {
+		psw, err := ses.AuthenticateUser(pp.GetUserName())
+		if err != nil {
+			return err
+		}
+		// the password is stored as SHA1(SHA1(password))
+		if !bytes.Equal(psw, HashSha1(HashSha1(pp.authResponse))) {
+			return moerr.NewInternalError(ctx, "check password failed")
+		}
+		return ses.InitGlobalSystemVariables()
+	}
+
+	tenant, err := GetTenantInfo(ctx, pp.GetUserName())
+	if err != nil {
+		return err
+	}
+	if ses != nil {
+		ses.SetTenantInfo(tenant)
+	}
+	return nil
+}
+
+// IsSecure returns true if the connection is encrypted by TLS or by the unix socket.
+func (pp *PgProtocolImpl) IsSecure() bool {
+	if pp.IsTlsEstablished() {
+		return true
+	}
+	if pp.tcpConn != nil {
+		if _, ok := pp.tcpConn.RawConn().(*net.UnixConn); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// sendAuthenticationRequest asks the client for the cleartext password. The password
+// is stored as SHA1(SHA1(password)) which can't be checked by MD5 or SCRAM-SHA-256, so
+// the cleartext password is only asked on the secure connection, and the client must
+// request SSL first.
+func (pp *PgProtocolImpl) sendAuthenticationRequest(ctx context.Context) error {
+	if !pp.IsSecure() {
+		msg := "SSL is required to send the password, connect with sslmode=require"
+		if err := pp.sendErrorResponse("FATAL", pgStateInvalidAuthorization, msg); err != nil {
+			return err
+		}
+		return moerr.NewInternalError(ctx, msg)
+	}
+	data := pgBeginMessage(nil, pgMsgAuthentication)
+	data = binary.BigEndian.AppendUint32(data, pgAuthCleartextPassword)
+	return pp.writeMessage(data, true)
+}
+
+func (pp *PgProtocolImpl) sendReadyForQuery() error {
+	status := byte('I')
+	if ses := pp.GetSession(); ses != nil && ses.InActiveMultiStmtTransaction() {
+		status = 'T'
+	}
+	data := pgBeginMessage(nil, pgMsgReadyForQuery)
+	data = append(data, status)
+	return pp.writeMessage(data, true)
+}
+
+// sendEmptyMessage sends the message without any content, such as ParseComplete
+func (pp *PgProtocolImpl) sendEmptyMessage(typ byte) error {
+	return pp.writeMessage(pgBeginMessage(nil, typ), false)
+}
+
+func (pp *PgProtocolImpl) sendErrorResponse(severity, sqlState, msg string) error {
+	data := pgBeginMessage(nil, pgMsgErrorResponse)
+	data = append(data, 'S')
+	data = pgAppendString(data, severity)
+	data = append(data, 'V')
+	data = pgAppendString(data, severity)
+	data = append(data, 'C')
+	data = pgAppendString(data, sqlState)
+	data = append(data, 'M')
+	data = pgAppendString(data, msg)
+	data = append(data, 0)
+	return pp.writeMessage(data, true)
+}
+
+// sendError sends the error, and the messages of the extended query are discarded until Sync
+func (pp *PgProtocolImpl) sendError(err error) error {
+	pp.failed = true
+	sqlState := DefaultMySQLState
+	if moe, ok := err.(*moerr.Error); ok {
+		sqlState = moe.SqlState()
+	}
+	msg := err.Error()
+	if attachAbort := pp.getAbortTransactionErrorInfo(); attachAbort != "" {
+		msg = fmt.Sprintf("%s\n%s", msg, attachAbort)
+	}
+	return pp.sendErrorResponse("ERROR", sqlState, msg)
+}
+
+func (pp *PgProtocolImpl) getAbortTransactionErrorInfo() string {
+	ses := pp.GetSession()
+	if ses != nil && ses.OptionBitsIsSet(OPTION_ATTACH_ABORT_TRANSACTION_ERROR) {
+		ses.ClearOptionBits(OPTION_ATTACH_ABORT_TRANSACTION_ERROR)
+		return abortTransactionErrorInfo()
+	}
+	return ""
+}
+
+func (pp *PgProtocolImpl) sendParameterDescription(oids []uint32) error {
+	data := pgBeginMessage(nil, pgMsgParameterDescription)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(oids)))
+	for _, oid := range oids {
+		data = binary.BigEndian.AppendUint32(data, oid)
+	}
+	return pp.writeMessage(data, false)
+}
+
+func (pp *PgProtocolImpl) sendRowDescription(columns []Column, formats []int16) error {
+	if len(columns) == 0 {
+		return pp.sendEmptyMessage(pgMsgNoData)
+	}
+	data := pgBeginMessage(nil, pgMsgRowDescription)
+	data = binary.BigEndian.AppendUint16(data, uint16(len(columns)))
+	for i, column := range columns {
+		oid := pgTypeOID(column)
+		data = pgAppendString(data, column.Name())
+		// table oid and column number
+		data = binary.BigEndian.AppendUint32(data, 0)
+		data = binary.BigEndian.AppendUint16(data, 0)
+		data = binary.BigEndian.AppendUint32(data, oid)
+		data = binary.BigEndian.AppendUint16(data, uint16(pgTypeLen(oid)))
+		// type modifier
+		data = binary.BigEndian.AppendUint32(data, math.MaxUint32)
+		data = binary.BigEndian.AppendUint16(data, uint16(pgFormat(formats, i)))
+	}
+	return pp.writeMessage(data, false)
+}
+
+// sendCommandComplete ends the result of the statement
+func (pp *PgProtocolImpl) sendCommandComplete(tag string) error {
+	pp.columns = pp.columns[:0]
+	pp.rows = 0
+	pp.inResultSet = false
+	data := pgBeginMessage(nil, pgMsgCommandComplete)
+	data = pgAppendString(data, tag)
+	return pp.writeMessage(data, false)
+}
+
+// currentStatement returns the statement being executed
+func (pp *PgProtocolImpl) currentStatement() tree.Statement {
+	if pp.executing != nil {
+		return pp.executing.statement.stmt
+	}
+	if ses := pp.GetSession(); ses != nil {
+		return ses.ast
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) sendDataRows(mrs *MysqlResultSet, cnt uint64) error {
+	ctx := context.TODO()
+	if ses := pp.GetSession(); ses != nil {
+		ctx = ses.GetRequestContext()
+	}
+	var data []byte
+	for r := uint64(0); r < cnt; r++ {
+		data = pgBeginMessage(data[:0], pgMsgDataRow)
+		data = binary.BigEndian.AppendUint16(data, uint16(mrs.GetColumnCount()))
+		for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+			column, err := mrs.GetColumn(ctx, i)
+			if err != nil {
+				return err
+			}
+			if isNil, err := mrs.ColumnIsNull(ctx, r, i); err != nil {
+				return err
+			} else if isNil {
+				data = binary.BigEndian.AppendUint32(data, math.MaxUint32)
+				continue
+			}
+			lenPos := len(data)
+			data = append(data, 0, 0, 0, 0)
+			if pgFormat(pp.resultFormats, int(i)) == pgFormatBinary {
+				data, err = pgAppendBinaryValue(ctx, data, mrs, r, i, column)
+			} else {
+				data, err = pgAppendTextValue(ctx, data, mrs, r, i, column)
+			}
+			if err != nil {
+				return err
+			}
+			binary.BigEndian.PutUint32(data[lenPos:], uint32(len(data)-lenPos-4))
+		}
+		if err := pp.writeMessage(data, false); err != nil {
+			return err
+		}
+	}
+	pp.rows += cnt
+	return nil
+}
+
+func (pp *PgProtocolImpl) SendResponse(ctx context.Context, resp *Response) error {
+	switch resp.category {
+	case OkResponse:
+		return pp.sendOKPacket(resp.affectedRows, resp.lastInsertId, resp.status, resp.warnings, "")
+	case EoFResponse:
+		return pp.sendEOFOrOkPacket(0, resp.status)
+	case ErrorResponse:
+		err, _ := resp.data.(error)
+		if err == nil {
+			return pp.sendOKPacket(0, 0, resp.status, 0, "")
+		}
+		return pp.sendError(err)
+	case ResultResponse:
+		mer, _ := resp.data.(*MysqlExecutionResult)
+		if mer == nil || mer.Mrs() == nil {
+			return pp.sendOKPacket(0, 0, resp.status, 0, "")
+		}
+		mrs := mer.Mrs()
+		for i := uint64(0); i < mrs.GetColumnCount(); i++ {
+			column, err := mrs.GetColumn(ctx, i)
+			if err != nil {
+				return err
+			}
+			pp.columns = append(pp.columns, column)
+		}
+		pp.inResultSet = true
+		if err := pp.SendEOFPacketIf(0, resp.status); err != nil {
+			return err
+		}
+		if err := pp.sendDataRows(mrs, mrs.GetRowCount()); err != nil {
+			return err
+		}
+		return pp.sendEOFOrOkPacket(0, resp.status)
+	case LocalInfileRequest:
+		return pp.sendLocalInfileRequest("")
+	default:
+		return moerr.NewInternalError(ctx, "unsupported response:%d ", resp.category)
+	}
+}
+
+// SendPrepareResponse responds ParseComplete for the Parse message
+func (pp *PgProtocolImpl) SendPrepareResponse(ctx context.Context, stmt *PrepareStmt) error {
+	if pp.preparing != nil {
+		pp.preparing.prepareName = stmt.Name
+	}
+	return pp.sendEmptyMessage(pgMsgParseComplete)
+}
+
+func (pp *PgProtocolImpl) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	return pp.sendDataRows(mrs, cnt)
+}
+
+func (pp *PgProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error {
+	return pp.sendDataRows(mrs, cnt)
+}
+
+func (pp *PgProtocolImpl) SendColumnDefinitionPacket(ctx context.Context, column Column, cmd int) error {
+	pp.columns = append(pp.columns, column)
+	return nil
+}
+
+func (pp *PgProtocolImpl) SendColumnCountPacket(count uint64) error {
+	pp.columns = make([]Column, 0, count)
+	pp.rows = 0
+	pp.inResultSet = true
+	return nil
+}
+
+// SendEOFPacketIf sends RowDescription after all the columns are received
+func (pp *PgProtocolImpl) SendEOFPacketIf(warnings uint16, status uint16) error {
+	if !pp.inResultSet || !pp.describeBySelf {
+		return nil
+	}
+	return pp.sendRowDescription(pp.columns, pp.resultFormats)
+}
+
+func (pp *PgProtocolImpl) sendOKPacket(affectedRows uint64, lastInsertId uint64, status uint16, warnings uint16, message string) error {
+	return pp.sendCommandComplete(pgCommandTag(pp.currentStatement(), affectedRows))
+}
+
+func (pp *PgProtocolImpl) sendEOFOrOkPacket(warnings uint16, status uint16) error {
+	if pp.inResultSet {
+		return pp.sendCommandComplete(fmt.Sprintf("SELECT %d", pp.rows))
+	}
+	return pp.sendOKPacket(0, 0, status, warnings, "")
+}
+
+func (pp *PgProtocolImpl) sendLocalInfileRequest(filename string) error {
+	return moerr.NewNotSupportedNoCtx("load data local in the postgresql protocol")
+}
+
+func (pp *PgProtocolImpl) ResetStatistics() {}
+
+func (pp *PgProtocolImpl) GetStats() string {
+	return ""
+}
+
+func (pp *PgProtocolImpl) CalculateOutTrafficBytes() int64 {
+	return 0
+}
+
+// ParseExecuteData sets the parameters bound to the portal being executed
+func (pp *PgProtocolImpl) ParseExecuteData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error {
+	dcPrepare, ok := stmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return moerr.NewInternalError(ctx, "can not get Prepare plan in prepareStmt")
+	}
+	numParams := len(dcPrepare.Prepare.ParamTypes)
+	if pp.executing == nil || len(pp.executing.params) != numParams {
+		return moerr.NewInternalError(ctx, "the parameters of the prepared statement %s are not bound", stmt.Name)
+	}
+
+	var err error
+	if stmt.params == nil {
+		stmt.params = proc.GetVector(types.T_text.ToType())
+		for i := 0; i < numParams; i++ {
+			err = vector.AppendBytes(stmt.params, []byte{}, false, proc.GetMPool())
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for i, param := range pp.executing.params {
+		if err = util.SetAnyToStringVector(proc, param, stmt.params, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *PgProtocolImpl) ParseSendLongData(ctx context.Context, proc *process.Process, stmt *PrepareStmt, data []byte, pos int) error {
+	return moerr.NewNotSupported(ctx, "send long data in the postgresql protocol")
+}
+
+// writeMessage writes the message made by pgBeginMessage
+func (pp *PgProtocolImpl) writeMessage(data []byte, flush bool) error {
+	if len(data) > 1 {
+		binary.BigEndian.PutUint32(data[1:], uint32(len(data)-1))
+	}
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	if pp.tcpConn == nil {
+		return nil
+	}
+	return pp.tcpConn.Write(data, goetty.WriteOptions{Flush: flush})
+}
+
+func (pp *PgProtocolImpl) flush() error {
+	pp.m.Lock()
+	defer pp.m.Unlock()
+	if pp.tcpConn == nil {
+		return nil
+	}
+	return pp.tcpConn.Write([]byte{}, goetty.WriteOptions{Flush: true})
+}
+
+// pgBeginMessage appends the type and the placeholder of the length of the message
+func pgBeginMessage(data []byte, typ byte) []byte {
+	return append(data, typ, 0, 0, 0, 0)
+}
+
+func pgAppendString(data []byte, s string) []byte {
+	data = append(data, s...)
+	return append(data, 0)
+}
+
+// pgReadString reads the string terminated by 0
+func pgReadString(data []byte, pos int) (string, int, bool) {
+	if pos >= len(data) {
+		return "", 0, false
+	}
+	end := bytes.IndexByte(data[pos:], 0)
+	if end < 0 {
+		return "", 0, false
+	}
+	return string(data[pos : pos+end]), pos + end + 1, true
+}
+
+// pgFormat returns the format of the ith column or parameter. no format means
+// all are text, and one format applies to all.
+func pgFormat(formats []int16, i int) int16 {
+	switch len(formats) {
+	case 0:
+		return pgFormatText
+	case 1:
+		return formats[0]
+	default:
+		if i < len(formats) {
+			return formats[i]
+		}
+		return pgFormatText
+	}
+}
+
+// pgTypeOID maps the type of the mysql column to the postgresql type
+func pgTypeOID(column Column) uint32 {
+	signed := true
+	if mc, ok := column.(*MysqlColumn); ok {
+		signed = mc.IsSigned()
+	}
+	switch column.ColumnType() {
+	case defines.MYSQL_TYPE_BOOL:
+		return pgOidBool
+	case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT:
+		return pgOidInt2
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_YEAR:
+		return pgOidInt4
+	case defines.MYSQL_TYPE_LONG:
+		if signed {
+			return pgOidInt4
+		}
+		return pgOidInt8
+	case defines.MYSQL_TYPE_LONGLONG:
+		if signed {
+			return pgOidInt8
+		}
+		return pgOidNumeric
+	case defines.MYSQL_TYPE_FLOAT:
+		return pgOidFloat4
+	case defines.MYSQL_TYPE_DOUBLE:
+		return pgOidFloat8
+	case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL:
+		return pgOidNumeric
+	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
+		return pgOidVarchar
+	case defines.MYSQL_TYPE_STRING:
+		return pgOidBpchar
+	case defines.MYSQL_TYPE_BLOB:
+		return pgOidBytea
+	case defines.MYSQL_TYPE_DATE:
+		return pgOidDate
+	case defines.MYSQL_TYPE_TIME:
+		return pgOidTime
+	case defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		return pgOidTimestamp
+	case defines.MYSQL_TYPE_JSON:
+		return pgOidJson
+	case defines.MYSQL_TYPE_UUID:
+		return pgOidUuid
+	default:
+		return pgOidText
+	}
+}
+
+// pgEngineTypeOID maps the engine type to the postgresql type
+func pgEngineTypeOID(ctx context.Context, typ types.T) uint32 {
+	column := new(MysqlColumn)
+	if err := convertEngineTypeToMysqlType(ctx, typ, column); err != nil {
+		return pgOidText
+	}
+	return pgTypeOID(column)
+}
+
+// pgTypeLen returns the size of the fixed length type, -1 for the variable length type
+func pgTypeLen(oid uint32) int16 {
+	switch oid {
+	case pgOidBool:
+		return 1
+	case pgOidInt2:
+		return 2
+	case pgOidInt4, pgOidFloat4, pgOidDate:
+		return 4
+	case pgOidInt8, pgOidFloat8, pgOidTime, pgOidTimestamp:
+		return 8
+	case pgOidUuid:
+		return 16
+	default:
+		return -1
+	}
+}
+
+func pgAppendTextValue(ctx context.Context, data []byte, mrs *MysqlResultSet, r, i uint64, column Column) ([]byte, error) {
+	switch pgTypeOID(column) {
+	case pgOidBool:
+		value, err := mrs.GetValue(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		if b, ok := value.(bool); ok {
+			if b {
+				return append(data, 't'), nil
+			}
+			return append(data, 'f'), nil
+		}
+	case pgOidFloat4, pgOidFloat8:
+		value, err := mrs.GetValue(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		switch v := value.(type) {
+		case float32:
+			return strconv.AppendFloat(data, float64(v), 'g', -1, 32), nil
+		case float64:
+			return strconv.AppendFloat(data, v, 'g', -1, 64), nil
+		}
+	case pgOidDate:
+		value, err := mrs.GetValue(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		if d, ok := value.(types.Date); ok {
+			return append(data, d.String()...), nil
+		}
+	case pgOidBytea:
+		value, err := mrs.GetString(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, `\x`...)
+		return append(data, hex.EncodeToString([]byte(value))...), nil
+	}
+	value, err := mrs.GetString(ctx, r, i)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, value...), nil
+}
+
+func pgAppendBinaryValue(ctx context.Context, data []byte, mrs *MysqlResultSet, r, i uint64, column Column) ([]byte, error) {
+	oid := pgTypeOID(column)
+	switch oid {
+	case pgOidBool:
+		value, err := mrs.GetValue(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		if b, ok := value.(bool); ok && b {
+			return append(data, 1), nil
+		}
+		return append(data, 0), nil
+	case pgOidInt2, pgOidInt4, pgOidInt8:
+		value, err := mrs.GetInt64(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		switch oid {
+		case pgOidInt2:
+			return binary.BigEndian.AppendUint16(data, uint16(value)), nil
+		case pgOidInt4:
+			return binary.BigEndian.AppendUint32(data, uint32(value)), nil
+		default:
+			return binary.BigEndian.AppendUint64(data, uint64(value)), nil
+		}
+	case pgOidFloat4:
+		value, err := mrs.GetFloat64(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint32(data, math.Float32bits(float32(value))), nil
+	case pgOidFloat8:
+		value, err := mrs.GetFloat64(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		return binary.BigEndian.AppendUint64(data, math.Float64bits(value)), nil
+	case pgOidText, pgOidVarchar, pgOidBpchar, pgOidBytea, pgOidJson:
+		value, err := mrs.GetString(ctx, r, i)
+		if err != nil {
+			return nil, err
+		}
+		return append(data, value...), nil
+	default:
+		return nil, moerr.NewNotSupported(ctx, "the binary format of the type %d", oid)
+	}
+}
+
+// pgDecodeBinaryParam decodes the parameter in the binary format
+func pgDecodeBinaryParam(ctx context.Context, oid uint32, value []byte) (any, error) {
+	switch oid {
+	case pgOidBool:
+		if len(value) != 1 {
+			return nil, moerr.NewInvalidInput(ctx, "invalid binary bool parameter")
+		}
+		return value[0] != 0, nil
+	case pgOidInt2:
+		if len(value) != 2 {
+			return nil, moerr.NewInvalidInput(ctx, "invalid binary int2 parameter")
+		}
+		return int64(int16(binary.BigEndian.Uint16(value))), nil
+	case pgOidInt4:
+		if len(value) != 4 {
+			return nil, moerr.NewInvalidInput(ctx, "invalid binary int4 parameter")
+		}
+		return int64(int32(binary.BigEndian.Uint32(value))), nil
+	case pgOidInt8:
+		if len(value) != 8 {
+			return nil, moerr.NewInvalidInput(ctx, "invalid binary int8 parameter")
+		}
+		return int64(binary.BigEndian.Uint64(value)), nil
+	case pgOidFloat4:
+		if len(value) != 4 {
+			return nil, moerr.NewInvalidInput(ctx, "invalid binary float4 parameter")
+		}
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(value))), nil
+	case pgOidFloat8:
+		if len(value) != 8 {
+			return nil, moerr.NewInvalidInput(ctx, "invalid binary float8 parameter")
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(value)), nil
+	default:
+		return string(value), nil
+	}
+}
+
+// pgCommandTag makes the tag of CommandComplete for the statement without result set
+func pgCommandTag(stmt tree.Statement, affectedRows uint64) string {
+	switch stmt.(type) {
+	case nil:
+		return "OK"
+	case *tree.Insert, *tree.Replace, *tree.Load:
+		return fmt.Sprintf("INSERT 0 %d", affectedRows)
+	case *tree.Update:
+		return fmt.Sprintf("UPDATE %d", affectedRows)
+	case *tree.Delete:
+		return fmt.Sprintf("DELETE %d", affectedRows)
+	case *tree.BeginTransaction:
+		return "BEGIN"
+	case *tree.CommitTransaction:
+		return "COMMIT"
+	case *tree.RollbackTransaction:
+		return "ROLLBACK"
+	default:
+		return strings.ToUpper(stmt.GetStatementType())
+	}
+}
+
+// pgRewritePlaceholders rewrites the placeholders $n of postgresql to ? of mysql.
+// It returns the rewritten sql, the index of the parameter of each ? and the count
+// of the parameters.
+func pgRewritePlaceholders(sql string) (string, []int, int) {
+	var sb strings.Builder
+	var order []int
+	numParams := 0
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			// skip the quoted string or identifier
+			j := i + 1
+			for ; j < len(sql); j++ {
+				if sql[j] == '\\' && c != '`' {
+					j++
+				} else if sql[j] == c {
+					if j+1 < len(sql) && sql[j+1] == c {
+						j++
+					} else {
+						break
+					}
+				}
+			}
+			j = Min(j, len(sql)-1)
+			sb.WriteString(sql[i : j+1])
+			i = j
+		case c == '-' && i+1 < len(sql) && sql[i+1] == '-':
+			j := strings.IndexByte(sql[i:], '\n')
+			if j < 0 {
+				j = len(sql) - i - 1
+			}
+			sb.WriteString(sql[i : i+j+1])
+			i += j
+		case c == '/' && i+1 < len(sql) && sql[i+1] == '*':
+			j := strings.Index(sql[i+2:], "*/")
+			if j < 0 {
+				j = len(sql) - i - 1
+			} else {
+				j += 3
+			}
+			sb.WriteString(sql[i : i+j+1])
+			i += j
+		case c == '$' && i+1 < len(sql) && sql[i+1] >= '0' && sql[i+1] <= '9' &&
+			(i == 0 || !pgIsIdentChar(sql[i-1])):
+			j := i + 1
+			for j < len(sql) && sql[j] >= '0' && sql[j] <= '9' {
+				j++
+			}
+			n, err := strconv.Atoi(sql[i+1 : j])
+			if err != nil || n == 0 {
+				sb.WriteString(sql[i:j])
+			} else {
+				sb.WriteByte('?')
+				order = append(order, n-1)
+				numParams = Max(numParams, n)
+			}
+			i = j - 1
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String(), order, numParams
+}
+
+func pgIsIdentChar(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,375 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/fagongzi/goetty/v2/buf"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPgProtocol(t *testing.T) (*PgProtocolImpl, *[][]byte) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+	var written [][]byte
+	ioses := mock_frontend.NewMockIOSession(ctrl)
+	ioses.EXPECT().RemoteAddress().Return("").AnyTimes()
+	ioses.EXPECT().RawConn().Return(nil).AnyTimes()
+	ioses.EXPECT().Write(gomock.Any(), gomock.Any()).DoAndReturn(func(msg interface{}, _ goetty.WriteOptions) error {
+		if data := msg.([]byte); len(data) > 0 {
+			written = append(written, append([]byte(nil), data...))
+		}
+		return nil
+	}).AnyTimes()
+	return NewPgProtocol(1001, ioses, &config.FrontendParameters{}), &written
+}
+
+func Test_pgCodecDecode(t *testing.T) {
+	c := NewPgCodec()
+	in := buf.NewByteBuf(64)
+
+	// the startup message without type
+	startup := binary.BigEndian.AppendUint32(nil, 8)
+	startup = binary.BigEndian.AppendUint32(startup, pgSSLRequestCode)
+	_, _ = in.Write(startup[:6])
+	msg, ok, err := c.Decode(in)
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Nil(t, msg)
+
+	_, _ = in.Write(startup[6:])
+	msg, ok, err = c.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, pgMsgStartup, msg.(*pgMessage).typ)
+	require.Equal(t, pgSSLRequestCode, binary.BigEndian.Uint32(msg.(*pgMessage).payload))
+
+	// the typed message
+	query := []byte{pgMsgQuery}
+	query = binary.BigEndian.AppendUint32(query, 4+9)
+	query = append(query, "select 1\x00"...)
+	_, _ = in.Write(query)
+	msg, ok, err = c.Decode(in)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, pgMsgQuery, msg.(*pgMessage).typ)
+	require.Equal(t, "select 1\x00", string(msg.(*pgMessage).payload))
+
+	// the invalid length
+	_, _ = in.Write([]byte{pgMsgSync, 0, 0, 0, 1})
+	_, _, err = c.Decode(in)
+	require.Error(t, err)
+}
+
+func Test_pgRewritePlaceholders(t *testing.T) {
+	kases := []struct {
+		sql       string
+		want      string
+		order     []int
+		numParams int
+	}{
+		{"select 1", "select 1", nil, 0},
+		{"select * from t where a = $1 and b > $2", "select * from t where a = ? and b > ?", []int{0, 1}, 2},
+		{"insert into t values ($2, $1, $2)", "insert into t values (?, ?, ?)", []int{1, 0, 1}, 2},
+		{"select '$1', \"$2\", `$3`, $1", "select '$1', \"$2\", `$3`, ?", []int{0}, 1},
+		{"select 'it''s $1' -- $2\n, $1 /* $3 */", "select 'it''s $1' -- $2\n, ? /* $3 */", []int{0}, 1},
+		{"select a$1, $0 from t", "select a$1, $0 from t", nil, 0},
+		{"select 'unterminated $1", "select 'unterminated $1", nil, 0},
+	}
+	for _, k := range kases {
+		sql, order, numParams := pgRewritePlaceholders(k.sql)
+		require.Equal(t, k.want, sql, k.sql)
+		require.Equal(t, k.order, order, k.sql)
+		require.Equal(t, k.numParams, numParams, k.sql)
+	}
+}
+
+func Test_pgTypeOID(t *testing.T) {
+	kases := []struct {
+		typ    defines.MysqlType
+		signed bool
+		oid    uint32
+	}{
+		{defines.MYSQL_TYPE_BOOL, true, pgOidBool},
+		{defines.MYSQL_TYPE_SHORT, true, pgOidInt2},
+		{defines.MYSQL_TYPE_LONG, true, pgOidInt4},
+		{defines.MYSQL_TYPE_LONG, false, pgOidInt8},
+		{defines.MYSQL_TYPE_LONGLONG, true, pgOidInt8},
+		{defines.MYSQL_TYPE_LONGLONG, false, pgOidNumeric},
+		{defines.MYSQL_TYPE_DOUBLE, true, pgOidFloat8},
+		{defines.MYSQL_TYPE_DECIMAL, true, pgOidNumeric},
+		{defines.MYSQL_TYPE_VARCHAR, true, pgOidVarchar},
+		{defines.MYSQL_TYPE_BLOB, true, pgOidBytea},
+		{defines.MYSQL_TYPE_DATETIME, true, pgOidTimestamp},
+		{defines.MYSQL_TYPE_JSON, true, pgOidJson},
+		{defines.MYSQL_TYPE_ENUM, true, pgOidText},
+	}
+	for _, k := range kases {
+		column := new(MysqlColumn)
+		column.SetColumnType(k.typ)
+		column.SetSigned(k.signed)
+		require.Equal(t, k.oid, pgTypeOID(column))
+	}
+	require.Equal(t, int16(4), pgTypeLen(pgOidInt4))
+	require.Equal(t, int16(-1), pgTypeLen(pgOidText))
+}
+
+func Test_pgCommandTag(t *testing.T) {
+	require.Equal(t, "INSERT 0 3", pgCommandTag(&tree.Insert{}, 3))
+	require.Equal(t, "UPDATE 2", pgCommandTag(&tree.Update{}, 2))
+	require.Equal(t, "DELETE 1", pgCommandTag(&tree.Delete{}, 1))
+	require.Equal(t, "BEGIN", pgCommandTag(&tree.BeginTransaction{}, 0))
+	require.Equal(t, "CREATE TABLE", pgCommandTag(&tree.CreateTable{}, 0))
+	require.Equal(t, "OK", pgCommandTag(nil, 0))
+}
+
+func Test_pgDecodeBinaryParam(t *testing.T) {
+	ctx := context.TODO()
+	v, err := pgDecodeBinaryParam(ctx, pgOidInt4, binary.BigEndian.AppendUint32(nil, uint32(0xfffffffe)))
+	require.NoError(t, err)
+	require.Equal(t, int64(-2), v)
+
+	v, err = pgDecodeBinaryParam(ctx, pgOidFloat8, binary.BigEndian.AppendUint64(nil, math.Float64bits(1.5)))
+	require.NoError(t, err)
+	require.Equal(t, 1.5, v)
+
+	v, err = pgDecodeBinaryParam(ctx, pgOidText, []byte("abc"))
+	require.NoError(t, err)
+	require.Equal(t, "abc", v)
+
+	_, err = pgDecodeBinaryParam(ctx, pgOidInt8, []byte{1, 2})
+	require.Error(t, err)
+
+	require.Equal(t, pgFormatText, pgFormat(nil, 3))
+	require.Equal(t, pgFormatBinary, pgFormat([]int16{pgFormatBinary}, 3))
+	require.Equal(t, pgFormatText, pgFormat([]int16{pgFormatBinary, pgFormatText}, 1))
+}
+
+func Test_pgHandleHandshake(t *testing.T) {
+	ctx := context.TODO()
+	pp, _ := newTestPgProtocol(t)
+
+	payload := binary.BigEndian.AppendUint32(nil, pgProtocolVersion)
+	payload = append(payload, "user\x00dump\x00database\x00db1\x00application_name\x00psql\x00\x00"...)
+	isTls, err := pp.HandleHandshake(ctx, payload)
+	require.NoError(t, err)
+	require.False(t, isTls)
+	require.Equal(t, "dump", pp.GetUserName())
+	require.Equal(t, "db1", pp.GetDatabaseName())
+	require.Equal(t, "psql", pp.GetConnectAttrs()["application_name"])
+
+	isTls, err = pp.HandleHandshake(ctx, binary.BigEndian.AppendUint32(nil, pgSSLRequestCode))
+	require.NoError(t, err)
+	require.True(t, isTls)
+
+	_, err = pp.HandleHandshake(ctx, binary.BigEndian.AppendUint32(nil, 2<<16))
+	require.Error(t, err)
+
+	payload = binary.BigEndian.AppendUint32(nil, pgProtocolVersion)
+	payload = append(payload, "database\x00db1\x00\x00"...)
+	_, err = pp.HandleHandshake(ctx, payload)
+	require.Error(t, err)
+}
+
+func Test_pgSendAuthenticationRequest(t *testing.T) {
+	ctx := context.TODO()
+	pp, written := newTestPgProtocol(t)
+
+	// the cleartext password is not asked without SSL
+	require.False(t, pp.IsSecure())
+	require.Error(t, pp.sendAuthenticationRequest(ctx))
+	require.Len(t, *written, 1)
+	require.Equal(t, pgMsgErrorResponse, (*written)[0][0])
+	require.Contains(t, string((*written)[0]), pgStateInvalidAuthorization)
+
+	pp.SetTlsEstablished()
+	require.True(t, pp.IsSecure())
+	require.NoError(t, pp.sendAuthenticationRequest(ctx))
+	require.Len(t, *written, 2)
+	require.Equal(t, pgMsgAuthentication, (*written)[1][0])
+	require.Equal(t, uint32(pgAuthCleartextPassword), binary.BigEndian.Uint32((*written)[1][5:]))
+}
+
+func Test_pgParse(t *testing.T) {
+	ctx := context.TODO()
+	stmts, err := pgParse(ctx, "use db1", 1)
+	require.NoError(t, err)
+	require.IsType(t, &tree.Use{}, stmts[0])
+
+	stmts, err = pgParse(ctx, "select a::int from \"T1\" where b = ?", 1)
+	require.NoError(t, err)
+	require.IsType(t, &tree.Select{}, stmts[0])
+
+	stmts, err = pgParse(ctx, " -- comment", 1)
+	require.NoError(t, err)
+	require.Empty(t, stmts)
+
+	_, err = pgParse(ctx, "selec 1", 1)
+	require.Error(t, err)
+
+	// the statements are not parsed in the mysql dialect
+	_, err = pgParse(ctx, "select a from t1 limit 1, 2", 1)
+	require.Error(t, err)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrParseError))
+}
+
+type testPgCmdExecutor struct {
+	CmdExecutorImpl
+	reqs []*Request
+}
+
+func (exe *testPgCmdExecutor) SetSession(*Session) {}
+
+func (exe *testPgCmdExecutor) SetCancelFunc(context.CancelFunc) {}
+
+func (exe *testPgCmdExecutor) ExecRequest(_ context.Context, _ *Session, req *Request) (*Response, error) {
+	exe.reqs = append(exe.reqs, req)
+	return nil, nil
+}
+
+func Test_pgSimpleQuery(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	pp, written := newTestPgProtocol(t)
+	exe := &testPgCmdExecutor{}
+	ses := newTestSession(t, ctrl)
+	ses.SetTenantInfo(&TenantInfo{Tenant: sysAccountName, User: rootName})
+	routine := NewRoutine(ctx, pp, exe, &config.FrontendParameters{}, nil)
+	routine.setSession(ses)
+	prm := &pgRoutineManager{}
+
+	// the query is parsed in the postgresql dialect
+	query := &pgMessage{typ: pgMsgQuery, payload: []byte("select a::int, \"B\" from t1 -- comment\x00")}
+	require.NoError(t, prm.handleMessage(ctx, routine, pp, query))
+	require.Len(t, exe.reqs, 1)
+	require.Equal(t, COM_QUERY, exe.reqs[0].cmd)
+	require.Len(t, exe.reqs[0].stmts, 1)
+	sel, ok := exe.reqs[0].stmts[0].(*tree.Select)
+	require.True(t, ok)
+	exprs := sel.Select.(*tree.SelectClause).Exprs
+	require.IsType(t, &tree.CastExpr{}, exprs[0].Expr)
+	// the double quoted string is an identifier instead of a string literal
+	require.Equal(t, "b", exprs[1].Expr.(*tree.UnresolvedName).Parts[0])
+	require.Len(t, *written, 1)
+	require.Equal(t, pgMsgReadyForQuery, (*written)[0][0])
+
+	// the parse error is returned instead of executing the query in the mysql dialect
+	*written = nil
+	query = &pgMessage{typ: pgMsgQuery, payload: []byte("select a from t1 limit 1, 2\x00")}
+	require.NoError(t, prm.handleMessage(ctx, routine, pp, query))
+	require.Len(t, exe.reqs, 1)
+	require.Len(t, *written, 2)
+	require.Equal(t, pgMsgErrorResponse, (*written)[0][0])
+	require.Contains(t, string((*written)[0]), "C"+moerr.NewParseError(ctx, "").SqlState()+"\x00")
+	require.Equal(t, pgMsgReadyForQuery, (*written)[1][0])
+
+	*written = nil
+	query = &pgMessage{typ: pgMsgQuery, payload: []byte(" ;\x00")}
+	require.NoError(t, prm.handleMessage(ctx, routine, pp, query))
+	require.Len(t, exe.reqs, 1)
+	require.Len(t, *written, 2)
+	require.Equal(t, pgMsgEmptyQueryResponse, (*written)[0][0])
+	require.Equal(t, pgMsgReadyForQuery, (*written)[1][0])
+}
+
+func Test_pgSendResultSet(t *testing.T) {
+	ctx := context.TODO()
+	pp, written := newTestPgProtocol(t)
+
+	mrs := &MysqlResultSet{}
+	col1 := new(MysqlColumn)
+	col1.SetName("a")
+	col1.SetColumnType(defines.MYSQL_TYPE_LONG)
+	col1.SetSigned(true)
+	col2 := new(MysqlColumn)
+	col2.SetName("b")
+	col2.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	mrs.AddColumn(col1)
+	mrs.AddColumn(col2)
+	mrs.AddRow([]interface{}{int32(1), "x"})
+	mrs.AddRow([]interface{}{int32(2), nil})
+
+	require.NoError(t, pp.SendColumnCountPacket(2))
+	require.NoError(t, pp.SendColumnDefinitionPacket(ctx, col1, int(COM_QUERY)))
+	require.NoError(t, pp.SendColumnDefinitionPacket(ctx, col2, int(COM_QUERY)))
+	require.NoError(t, pp.SendEOFPacketIf(0, 0))
+	require.NoError(t, pp.SendResultSetTextBatchRowSpeedup(mrs, mrs.GetRowCount()))
+	require.NoError(t, pp.sendEOFOrOkPacket(0, 0))
+
+	require.Len(t, *written, 4)
+	rowDesc := (*written)[0]
+	require.Equal(t, pgMsgRowDescription, rowDesc[0])
+	require.Equal(t, uint32(len(rowDesc)-1), binary.BigEndian.Uint32(rowDesc[1:]))
+	require.Equal(t, uint16(2), binary.BigEndian.Uint16(rowDesc[5:]))
+	require.Equal(t, "a", string(rowDesc[7:8]))
+	require.Equal(t, pgOidInt4, binary.BigEndian.Uint32(rowDesc[9+6:]))
+
+	row := (*written)[1]
+	require.Equal(t, pgMsgDataRow, row[0])
+	require.Equal(t, []byte{0, 2, 0, 0, 0, 1, '1', 0, 0, 0, 1, 'x'}, row[5:])
+	row = (*written)[2]
+	require.Equal(t, []byte{0, 2, 0, 0, 0, 1, '2', 0xff, 0xff, 0xff, 0xff}, row[5:])
+
+	complete := (*written)[3]
+	require.Equal(t, pgMsgCommandComplete, complete[0])
+	require.Equal(t, "SELECT 2\x00", string(complete[5:]))
+
+	// the extended query has sent RowDescription in Describe
+	*written = nil
+	pp.describeBySelf = false
+	pp.resultFormats = []int16{pgFormatBinary}
+	require.NoError(t, pp.SendColumnCountPacket(2))
+	require.NoError(t, pp.SendColumnDefinitionPacket(ctx, col1, int(COM_STMT_EXECUTE)))
+	require.NoError(t, pp.SendColumnDefinitionPacket(ctx, col2, int(COM_STMT_EXECUTE)))
+	require.NoError(t, pp.SendEOFPacketIf(0, 0))
+	require.NoError(t, pp.SendResultSetTextBatchRowSpeedup(mrs, 1))
+	require.NoError(t, pp.sendEOFOrOkPacket(0, 0))
+	require.Len(t, *written, 2)
+	require.Equal(t, []byte{0, 2, 0, 0, 0, 4, 0, 0, 0, 1, 0, 0, 0, 1, 'x'}, (*written)[0][5:])
+}
+
+func Test_pgSendResponse(t *testing.T) {
+	ctx := context.TODO()
+	pp, written := newTestPgProtocol(t)
+
+	pp.executing = &pgPortal{statement: &pgStatement{stmt: &tree.Update{}}}
+	require.NoError(t, pp.SendResponse(ctx, NewOkResponse(5, 0, 0, 0, int(COM_QUERY), "")))
+	require.Len(t, *written, 1)
+	require.Equal(t, "UPDATE 5\x00", string((*written)[0][5:]))
+
+	*written = nil
+	err := moerr.NewNoSuchTable(ctx, "db", "t")
+	require.NoError(t, pp.SendResponse(ctx, NewGeneralErrorResponse(COM_QUERY, 0, err)))
+	require.True(t, pp.failed)
+	require.Len(t, *written, 1)
+	msg := (*written)[0]
+	require.Equal(t, pgMsgErrorResponse, msg[0])
+	require.Contains(t, string(msg), "SERROR\x00")
+	require.Contains(t, string(msg), "C"+err.SqlState()+"\x00")
+	require.Contains(t, string(msg), "no such table db.t")
+	require.Equal(t, byte(0), msg[len(msg)-1])
+}
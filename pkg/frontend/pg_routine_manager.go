@@ -0,0 +1,586 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"time"
+
+	"github.com/fagongzi/goetty/v2"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	planPb "github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"go.uber.org/zap"
+)
+
+// pgRoutineManager serves the connections of the postgresql protocol. The routines
+// share the RoutineManager with the mysql protocol, so that they can be listed and
+// killed in the same way.
+type pgRoutineManager struct {
+	*RoutineManager
+}
+
+func (prm *pgRoutineManager) Created(rs goetty.IOSession) {
+	logutil.Debugf("get the postgresql connection from %s", rs.RemoteAddress())
+	rm := prm.RoutineManager
+	pu := rm.getParameterUnit()
+	connID, err := rm.getConnID()
+	if err != nil {
+		logutil.Errorf("failed to get connection ID from HAKeeper: %v", err)
+		return
+	}
+	pro := NewPgProtocol(connID, rs, pu.SV)
+	exe := NewMysqlCmdExecutor()
+	exe.SetRoutineManager(rm)
+	exe.ChooseDoQueryFunc(pu.SV.EnableDoComQueryInProgress)
+
+	routine := NewRoutine(rm.getCtx(), pro, exe, pu.SV, rs)
+
+	ses := NewSession(routine.getProtocol(), nil, pu, GSysVariables, true, rm.aicm, nil)
+	ses.SetRequestContext(routine.getCancelRoutineCtx())
+	ses.SetConnectContext(routine.getCancelRoutineCtx())
+	ses.SetFromRealUser(true)
+	ses.setRoutineManager(rm)
+	ses.setRoutine(routine)
+
+	routine.setSession(ses)
+	pro.SetSession(ses)
+
+	// the client starts the conversation with the startup message
+	logDebugf(pro.GetDebugString(), "have done some preparation for the postgresql connection %s", rs.RemoteAddress())
+	rm.setRoutine(rs, routine)
+}
+
+func (prm *pgRoutineManager) Handler(rs goetty.IOSession, msg interface{}, received uint64) error {
+	logutil.Debugf("get postgresql request from %d:%s", rs.ID(), rs.RemoteAddress())
+	ctx, span := trace.Start(prm.getCtx(), "pgRoutineManager.Handler")
+	defer span.End()
+	routine := prm.getRoutine(rs)
+	if routine == nil {
+		err := moerr.NewInternalError(ctx, "routine does not exist")
+		logutil.Errorf("%s error:%v", getConnectionInfo(rs), err)
+		return err
+	}
+	routine.updateGoroutineId()
+	routine.setInProcessRequest(true)
+	defer routine.setInProcessRequest(false)
+
+	message, ok := msg.(*pgMessage)
+	if !ok {
+		err := moerr.NewInternalError(ctx, "message is not postgresql message")
+		logError(routine.ses, routine.ses.GetDebugString(),
+			"Error occurred",
+			zap.Error(err))
+		return err
+	}
+	protocol := routine.getProtocol().(*PgProtocolImpl)
+
+	var err error
+	if !protocol.IsEstablished() {
+		err = prm.handleStartup(ctx, rs, routine, protocol, message)
+	} else {
+		err = prm.handleMessage(ctx, routine, protocol, message)
+	}
+	if err != nil {
+		logError(routine.ses, routine.ses.GetDebugString(),
+			"Error occurred",
+			zap.Error(err))
+	}
+	return err
+}
+
+// handleStartup handles the messages before the connection is established
+func (prm *pgRoutineManager) handleStartup(ctx context.Context, rs goetty.IOSession, routine *Routine, protocol *PgProtocolImpl, message *pgMessage) error {
+	if message.typ == pgMsgPassword && protocol.startup {
+		protocol.authResponse = pgTrimString(message.payload)
+		if err := protocol.Authenticate(ctx); err != nil {
+			return err
+		}
+		protocol.SetEstablished()
+		ses := routine.getSession()
+		if dbName := protocol.GetDatabaseName(); ses != nil && dbName != "" {
+			ses.SetDatabaseName(dbName)
+		}
+		prm.sessionManager.AddSession(ses)
+		return nil
+	}
+	if message.typ != pgMsgStartup || len(message.payload) < 4 {
+		_ = protocol.sendErrorResponse("FATAL", pgStateProtocolViolation, "expected the startup message")
+		return moerr.NewInvalidInput(ctx, "unexpected message %c before the startup message", message.typ)
+	}
+
+	switch binary.BigEndian.Uint32(message.payload) {
+	case pgSSLRequestCode:
+		if prm.getTlsConfig() == nil || protocol.IsTlsEstablished() {
+			return rs.Write([]byte{'N'}, goetty.WriteOptions{Flush: true})
+		}
+		if err := rs.Write([]byte{'S'}, goetty.WriteOptions{Flush: true}); err != nil {
+			return err
+		}
+		tlsConn := tls.Server(rs.RawConn(), prm.getTlsConfig())
+		newCtx, cancelFun := context.WithTimeout(ctx, 20*time.Second)
+		defer cancelFun()
+		if err := tlsConn.HandshakeContext(newCtx); err != nil {
+			return err
+		}
+		rs.UseConn(tlsConn)
+		protocol.SetTlsEstablished()
+		return nil
+	case pgGSSENCRequestCode:
+		return rs.Write([]byte{'N'}, goetty.WriteOptions{Flush: true})
+	case pgCancelRequestCode:
+		// the cancel request comes from a new connection which is closed at once
+		defer protocol.Quit()
+		if len(message.payload) != 12 {
+			return moerr.NewInvalidInput(ctx, "received a broken cancel request")
+		}
+		id := binary.BigEndian.Uint32(message.payload[4:])
+		key := binary.BigEndian.Uint32(message.payload[8:])
+		target := prm.getRoutineById(uint64(id))
+		if target == nil {
+			return nil
+		}
+		if pp, ok := target.getProtocol().(*PgProtocolImpl); ok && pp.secretKey == key {
+			logutil.Infof("cancel the query on the postgresql connection %d", id)
+			target.killQuery(false, "")
+		}
+		return nil
+	}
+
+	if _, err := protocol.HandleHandshake(ctx, message.payload); err != nil {
+		_ = protocol.sendErrorResponse("FATAL", pgStateProtocolViolation, err.Error())
+		return err
+	}
+	return protocol.sendAuthenticationRequest(ctx)
+}
+
+// handleMessage handles the messages after the connection is established
+func (prm *pgRoutineManager) handleMessage(ctx context.Context, routine *Routine, protocol *PgProtocolImpl, message *pgMessage) error {
+	// after an error in the extended query, the messages are discarded until Sync
+	if protocol.failed && message.typ != pgMsgSync && message.typ != pgMsgQuery && message.typ != pgMsgTerminate {
+		return nil
+	}
+
+	var err error
+	switch message.typ {
+	case pgMsgQuery:
+		query := pgTrimString(message.payload)
+		protocol.failed = false
+		protocol.executing = nil
+		protocol.describeBySelf = true
+		protocol.resultFormats = nil
+		var stmts []tree.Statement
+		if stmts, err = pgParse(ctx, string(query), pgLowerCaseTableNames(routine.getSession())); err != nil {
+			err = protocol.sendError(err)
+		} else if len(stmts) == 0 {
+			err = protocol.sendEmptyMessage(pgMsgEmptyQueryResponse)
+		} else {
+			err = routine.handleRequest(&Request{cmd: COM_QUERY, data: query, stmts: stmts})
+		}
+		if err != nil {
+			return err
+		}
+		protocol.failed = false
+		return protocol.sendReadyForQuery()
+	case pgMsgParse:
+		err = prm.handleParse(ctx, routine, protocol, message.payload)
+	case pgMsgBind:
+		err = prm.handleBind(ctx, protocol, message.payload)
+	case pgMsgDescribe:
+		err = prm.handleDescribe(ctx, routine, protocol, message.payload)
+	case pgMsgExecute:
+		err = prm.handleExecute(ctx, routine, protocol, message.payload)
+	case pgMsgClose:
+		err = prm.handleClose(ctx, routine, protocol, message.payload)
+	case pgMsgSync:
+		protocol.failed = false
+		return protocol.sendReadyForQuery()
+	case pgMsgFlush:
+		return protocol.flush()
+	case pgMsgTerminate:
+		protocol.Quit()
+		return nil
+	default:
+		err = moerr.NewNotSupported(ctx, "postgresql message %c", message.typ)
+	}
+	if err != nil {
+		return protocol.sendError(err)
+	}
+	return nil
+}
+
+func (prm *pgRoutineManager) handleParse(ctx context.Context, routine *Routine, protocol *PgProtocolImpl, payload []byte) error {
+	name, pos, ok := pgReadString(payload, 0)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken parse message")
+	}
+	query, pos, ok := pgReadString(payload, pos)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken parse message")
+	}
+	count, pos, ok := protocol.io.ReadUint16(payload, pos)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken parse message")
+	}
+	oids := make([]uint32, count)
+	for i := range oids {
+		if oids[i], pos, ok = protocol.io.ReadUint32(payload, pos); !ok {
+			return moerr.NewInvalidInput(ctx, "received a broken parse message")
+		}
+	}
+
+	if name != "" {
+		if _, ok := protocol.statements[name]; ok {
+			return moerr.NewInvalidInput(ctx, "prepared statement %s already exists", name)
+		}
+	} else if err := prm.closeStatement(routine, protocol, ""); err != nil {
+		return err
+	}
+
+	sql, order, numParams := pgRewritePlaceholders(query)
+	statement := &pgStatement{
+		name:       name,
+		sql:        sql,
+		paramOrder: order,
+		numParams:  Max(numParams, len(oids)),
+		paramOIDs:  oids,
+	}
+	stmts, err := pgParse(ctx, sql, pgLowerCaseTableNames(routine.getSession()))
+	if err != nil {
+		return err
+	}
+	switch len(stmts) {
+	case 0:
+	case 1:
+		statement.stmt = stmts[0]
+	default:
+		return moerr.NewInvalidInput(ctx, "cannot insert multiple commands into a prepared statement")
+	}
+
+	if len(order) == 0 && !pgPreparable(statement.stmt) {
+		protocol.statements[name] = statement
+		return protocol.sendEmptyMessage(pgMsgParseComplete)
+	}
+
+	protocol.preparing = statement
+	defer func() {
+		protocol.preparing = nil
+	}()
+	if err = routine.handleRequest(&Request{cmd: COM_STMT_PREPARE, data: []byte(sql), stmts: []tree.Statement{statement.stmt}}); err != nil {
+		return err
+	}
+	if statement.prepareName != "" {
+		protocol.statements[name] = statement
+	}
+	return nil
+}
+
+func (prm *pgRoutineManager) handleBind(ctx context.Context, protocol *PgProtocolImpl, payload []byte) error {
+	portalName, pos, ok := pgReadString(payload, 0)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken bind message")
+	}
+	name, pos, ok := pgReadString(payload, pos)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken bind message")
+	}
+	statement, ok := protocol.statements[name]
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "prepared statement %s does not exist", name)
+	}
+	if portalName != "" {
+		if _, ok := protocol.portals[portalName]; ok {
+			return moerr.NewInvalidInput(ctx, "portal %s already exists", portalName)
+		}
+	}
+
+	paramFormats, pos, ok := pgReadInt16s(protocol.io, payload, pos)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken bind message")
+	}
+	count, pos, ok := protocol.io.ReadUint16(payload, pos)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken bind message")
+	}
+	if int(count) != statement.numParams {
+		return moerr.NewInvalidInput(ctx, "bind message supplies %d parameters, but prepared statement %s requires %d", count, name, statement.numParams)
+	}
+	values := make([]any, count)
+	for i := range values {
+		var length uint32
+		if length, pos, ok = protocol.io.ReadUint32(payload, pos); !ok {
+			return moerr.NewInvalidInput(ctx, "received a broken bind message")
+		}
+		if int32(length) == -1 {
+			continue
+		}
+		if pos+int(length) > len(payload) {
+			return moerr.NewInvalidInput(ctx, "received a broken bind message")
+		}
+		value := payload[pos : pos+int(length)]
+		pos += int(length)
+		if pgFormat(paramFormats, i) == pgFormatBinary {
+			oid := uint32(0)
+			if i < len(statement.paramOIDs) {
+				oid = statement.paramOIDs[i]
+			}
+			var err error
+			if values[i], err = pgDecodeBinaryParam(ctx, oid, value); err != nil {
+				return err
+			}
+		} else {
+			values[i] = string(value)
+		}
+	}
+	resultFormats, _, ok := pgReadInt16s(protocol.io, payload, pos)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken bind message")
+	}
+
+	portal := &pgPortal{
+		name:          portalName,
+		statement:     statement,
+		params:        make([]any, len(statement.paramOrder)),
+		resultFormats: resultFormats,
+		described:     statement.described,
+	}
+	for i, idx := range statement.paramOrder {
+		portal.params[i] = values[idx]
+	}
+	protocol.portals[portalName] = portal
+	return protocol.sendEmptyMessage(pgMsgBindComplete)
+}
+
+func (prm *pgRoutineManager) handleDescribe(ctx context.Context, routine *Routine, protocol *PgProtocolImpl, payload []byte) error {
+	if len(payload) < 2 {
+		return moerr.NewInvalidInput(ctx, "received a broken describe message")
+	}
+	name, _, ok := pgReadString(payload, 1)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken describe message")
+	}
+
+	switch payload[0] {
+	case 'S':
+		statement, ok := protocol.statements[name]
+		if !ok {
+			return moerr.NewInvalidInput(ctx, "prepared statement %s does not exist", name)
+		}
+		paramTypes, columns, err := prm.describeStatement(ctx, routine, statement)
+		if err != nil {
+			return err
+		}
+		oids := make([]uint32, statement.numParams)
+		for i := range oids {
+			oids[i] = pgOidText
+			if i < len(statement.paramOIDs) && statement.paramOIDs[i] != 0 {
+				oids[i] = statement.paramOIDs[i]
+				continue
+			}
+			for j, idx := range statement.paramOrder {
+				if idx == i && j < len(paramTypes) {
+					oids[i] = pgEngineTypeOID(ctx, types.T(paramTypes[j]))
+					break
+				}
+			}
+		}
+		if err = protocol.sendParameterDescription(oids); err != nil {
+			return err
+		}
+		statement.described = statement.prepareName != ""
+		return protocol.sendRowDescription(columns, nil)
+	case 'P':
+		portal, ok := protocol.portals[name]
+		if !ok {
+			return moerr.NewInvalidInput(ctx, "portal %s does not exist", name)
+		}
+		_, columns, err := prm.describeStatement(ctx, routine, portal.statement)
+		if err != nil {
+			return err
+		}
+		portal.described = portal.statement.prepareName != ""
+		return protocol.sendRowDescription(columns, portal.resultFormats)
+	default:
+		return moerr.NewInvalidInput(ctx, "invalid describe message subtype %d", payload[0])
+	}
+}
+
+// describeStatement returns the types of the parameters and the columns of the result
+func (prm *pgRoutineManager) describeStatement(ctx context.Context, routine *Routine, statement *pgStatement) ([]int32, []Column, error) {
+	if statement.prepareName == "" {
+		return nil, nil, nil
+	}
+	preStmt, err := routine.getSession().GetPrepareStmt(statement.prepareName)
+	if err != nil {
+		return nil, nil, err
+	}
+	dcPrepare, ok := preStmt.PreparePlan.GetDcl().Control.(*planPb.DataControl_Prepare)
+	if !ok {
+		return nil, nil, moerr.NewInternalError(ctx, "can not get Prepare plan in prepareStmt")
+	}
+	colDefs := plan2.GetResultColumnsFromPlan(dcPrepare.Prepare.Plan)
+	columns := make([]Column, len(colDefs))
+	for i, colDef := range colDefs {
+		column := new(MysqlColumn)
+		column.SetName(colDef.Name)
+		if err = convertEngineTypeToMysqlType(ctx, types.T(colDef.Typ.Id), column); err != nil {
+			return nil, nil, err
+		}
+		columns[i] = column
+	}
+	return dcPrepare.Prepare.ParamTypes, columns, nil
+}
+
+func (prm *pgRoutineManager) handleExecute(ctx context.Context, routine *Routine, protocol *PgProtocolImpl, payload []byte) error {
+	// the limit of the rows is ignored, all the rows are returned at once
+	name, _, ok := pgReadString(payload, 0)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken execute message")
+	}
+	portal, ok := protocol.portals[name]
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "portal %s does not exist", name)
+	}
+	statement := portal.statement
+	if statement.stmt == nil {
+		return protocol.sendEmptyMessage(pgMsgEmptyQueryResponse)
+	}
+
+	protocol.executing = portal
+	protocol.describeBySelf = !portal.described
+	protocol.resultFormats = portal.resultFormats
+	defer func() {
+		protocol.executing = nil
+		protocol.describeBySelf = true
+		protocol.resultFormats = nil
+	}()
+	if statement.prepareName == "" {
+		return routine.handleRequest(&Request{cmd: COM_QUERY, data: []byte(statement.sql), stmts: []tree.Statement{statement.stmt}})
+	}
+	stmtID, err := GetPrepareStmtID(ctx, statement.prepareName)
+	if err != nil {
+		return err
+	}
+	data := binary.LittleEndian.AppendUint32(nil, uint32(stmtID))
+	return routine.handleRequest(&Request{cmd: COM_STMT_EXECUTE, data: data})
+}
+
+func (prm *pgRoutineManager) handleClose(ctx context.Context, routine *Routine, protocol *PgProtocolImpl, payload []byte) error {
+	if len(payload) < 2 {
+		return moerr.NewInvalidInput(ctx, "received a broken close message")
+	}
+	name, _, ok := pgReadString(payload, 1)
+	if !ok {
+		return moerr.NewInvalidInput(ctx, "received a broken close message")
+	}
+	switch payload[0] {
+	case 'S':
+		if err := prm.closeStatement(routine, protocol, name); err != nil {
+			return err
+		}
+	case 'P':
+		delete(protocol.portals, name)
+	default:
+		return moerr.NewInvalidInput(ctx, "invalid close message subtype %d", payload[0])
+	}
+	return protocol.sendEmptyMessage(pgMsgCloseComplete)
+}
+
+// closeStatement removes the statement and deallocates the prepared statement in the session
+func (prm *pgRoutineManager) closeStatement(routine *Routine, protocol *PgProtocolImpl, name string) error {
+	statement, ok := protocol.statements[name]
+	if !ok {
+		return nil
+	}
+	delete(protocol.statements, name)
+	for portalName, portal := range protocol.portals {
+		if portal.statement == statement {
+			delete(protocol.portals, portalName)
+		}
+	}
+	if statement.prepareName == "" {
+		return nil
+	}
+	stmtID, err := GetPrepareStmtID(routine.getCancelRoutineCtx(), statement.prepareName)
+	if err != nil {
+		return err
+	}
+	data := binary.LittleEndian.AppendUint32(nil, uint32(stmtID))
+	return routine.handleRequest(&Request{cmd: COM_STMT_CLOSE, data: data})
+}
+
+// pgPreparable checks the statement can be prepared to describe its result
+func pgPreparable(stmt tree.Statement) bool {
+	switch stmt.(type) {
+	case *tree.Select, *tree.ValuesStatement, *tree.Insert, *tree.Replace, *tree.Update, *tree.Delete:
+		return true
+	default:
+		return false
+	}
+}
+
+// pgParse parses the statements in the postgresql dialect
+func pgParse(ctx context.Context, sql string, lower int64) ([]tree.Statement, error) {
+	stmts, err := parsers.Parse(ctx, dialect.POSTGRESQL, sql, lower)
+	if err != nil {
+		if _, ok := err.(*moerr.Error); !ok {
+			err = moerr.NewParseError(ctx, err.Error())
+		}
+		return nil, err
+	}
+	return stmts, nil
+}
+
+// pgLowerCaseTableNames returns the lower_case_table_names of the session to parse the statements
+func pgLowerCaseTableNames(ses *Session) int64 {
+	v, err := ses.GetGlobalVar("lower_case_table_names")
+	if err != nil {
+		return 1
+	}
+	return v.(int64)
+}
+
+// pgTrimString removes the terminating 0 of the string
+func pgTrimString(data []byte) []byte {
+	if len(data) > 0 && data[len(data)-1] == 0 {
+		return data[:len(data)-1]
+	}
+	return data
+}
+
+// pgReadInt16s reads the count and the array of int16
+func pgReadInt16s(io IOPackage, data []byte, pos int) ([]int16, int, bool) {
+	count, pos, ok := io.ReadUint16(data, pos)
+	if !ok {
+		return nil, 0, false
+	}
+	values := make([]int16, count)
+	for i := range values {
+		var v uint16
+		if v, pos, ok = io.ReadUint16(data, pos); !ok {
+			return nil, 0, false
+		}
+		values[i] = int16(v)
+	}
+	return values, pos, true
+}
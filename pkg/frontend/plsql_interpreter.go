@@ -23,9 +23,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 )
 
 type SpStatus int
@@ -48,6 +50,7 @@ type Interpreter struct {
 	argsAttr    map[string]tree.InOutArgType // used for IN, OUT, IN/OUT check
 	argsMap     map[string]tree.Expr         // used for argument to parameter mapping
 	outParamMap map[string]interface{}       // used for storing and updating OUT type arg
+	trigger     *plan.TriggerDef             // the trigger whose body is interpreted, nil for the stored procedure
 }
 
 func (interpreter *Interpreter) GetResult() []ExecResult {
@@ -95,6 +98,52 @@ func (interpreter *Interpreter) SetSpVar(name string, value interface{}) error {
 	return moerr.NewNotSupported(interpreter.ctx, fmt.Sprintf("variable %s has to be declared using DECLARE.", name))
 }
 
+// SetTriggerRowVar evaluates the value and sets it to the column of the NEW row of the trigger.
+// The value is cast to the type of the column.
+func (interpreter *Interpreter) SetTriggerRowVar(name string, value tree.Expr) error {
+	name = strings.ToLower(name)
+	if strings.HasPrefix(name, triggerOldRow+".") {
+		return moerr.NewInvalidInput(interpreter.ctx, "updating of OLD row is not allowed in trigger")
+	}
+	if interpreter.trigger.Event == tree.TriggerEventDelete.String() {
+		return moerr.NewInvalidInput(interpreter.ctx, "there is no NEW row in on DELETE trigger")
+	}
+	if interpreter.trigger.Timing == tree.TriggerTimeAfter.String() {
+		return moerr.NewInvalidInput(interpreter.ctx, "updating of NEW row is not allowed in after trigger")
+	}
+	// the rows are bound in the outermost scope
+	row := (*interpreter.varScope)[0]
+	cur, ok := row[name].(*plan.Expr)
+	if !ok {
+		return moerr.NewInvalidInput(interpreter.ctx, "unknown column '%s' in 'NEW'", strings.TrimPrefix(name, triggerNewRow+"."))
+	}
+
+	interpreter.bh.ClearExecResultSet()
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.VarScopeKey{}, interpreter.varScope)
+	interpreter.ctx = context.WithValue(interpreter.ctx, defines.InSp{}, true)
+	err := interpreter.bh.Exec(interpreter.ctx, "select "+interpreter.GetExprString(value))
+	if err != nil {
+		return err
+	}
+	erArray, err := getResultSet(interpreter.ctx, interpreter.bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return moerr.NewInternalError(interpreter.ctx, "no value of the expression for '%s'", name)
+	}
+	mrs, ok := erArray[0].(*MysqlResultSet)
+	if !ok {
+		return moerr.NewInternalError(interpreter.ctx, "it is not the type of result set")
+	}
+	v, err := getTriggerValue(interpreter.ctx, mrs, 0, 0)
+	if err != nil {
+		return err
+	}
+	row[name], err = plan2.MakePlan2TypedConstExpr(interpreter.ctx, v.val, v.isNull, cur.Typ)
+	return err
+}
+
 func (interpreter *Interpreter) FlushParam() error {
 	for k, v := range (*interpreter.varScope)[0] {
 		if _, ok := interpreter.argsMap[k]; ok && interpreter.argsAttr[k] == tree.TYPE_INOUT {
@@ -514,6 +563,14 @@ func (interpreter *Interpreter) interpret(stmt tree.Statement) (SpStatus, error)
 		for _, assign := range st.Assignments {
 			name := assign.Name
 
+			// the column of the row in the trigger
+			if interpreter.trigger != nil && isTriggerRowVar(name) {
+				if err := interpreter.SetTriggerRowVar(name, assign.Value); err != nil {
+					return SpNotOk, err
+				}
+				continue
+			}
+
 			// if this is a system set, ignore if it's not a INOUT/OUT arg
 			if strings.Contains(interpreter.GetExprString(st), "@") {
 				str := interpreter.GetExprString(st)
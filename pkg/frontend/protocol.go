@@ -22,6 +22,7 @@ import (
 	"sync/atomic"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 
 	"github.com/fagongzi/goetty/v2"
@@ -49,6 +50,9 @@ type Request struct {
 	seq uint8
 	//the data from the client
 	data interface{}
+	//the statements of the data parsed by the protocol in its own dialect.
+	//the data is parsed in the mysql dialect if it is nil.
+	stmts []tree.Statement
 }
 
 func (req *Request) GetData() interface{} {
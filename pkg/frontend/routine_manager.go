@@ -252,8 +252,13 @@ func (rm *RoutineManager) Created(rs goetty.IOSession) {
 	logDebugf(pro.GetDebugString(), "have done some preparation for the connection %s", rs.RemoteAddress())
 
 	// With proxy module enabled, we try to update salt value and label info from proxy.
+	// The info is only received from the allowed addresses of the proxies.
 	if rm.pu.SV.ProxyEnabled {
-		pro.receiveExtraInfo(rs)
+		if isProxyAddress(rs.RemoteAddress(), rm.pu.SV.ProxyAllowedAddresses) {
+			pro.receiveExtraInfo(rs)
+		} else {
+			logDebugf(pro.GetDebugString(), "the connection %s is not from the allowed proxies", rs.RemoteAddress())
+		}
 	}
 
 	hsV10pkt := pro.makeHandshakeV10Payload()
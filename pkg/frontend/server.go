@@ -16,6 +16,7 @@ package frontend
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"sync/atomic"
 
@@ -38,6 +39,9 @@ type MOServer struct {
 	uaddr string
 	app   goetty.NetApplication
 	rm    *RoutineManager
+	// pgAddr and pgApp serve the postgresql protocol, nil if it is disabled
+	pgAddr string
+	pgApp  goetty.NetApplication
 }
 
 // BaseService is an interface which indicates that the instance is
@@ -57,10 +61,22 @@ func (mo *MOServer) GetRoutineManager() *RoutineManager {
 
 func (mo *MOServer) Start() error {
 	logutil.Infof("Server Listening on : %s ", mo.addr)
-	return mo.app.Start()
+	if err := mo.app.Start(); err != nil {
+		return err
+	}
+	if mo.pgApp != nil {
+		logutil.Infof("Postgresql Server Listening on : %s ", mo.pgAddr)
+		return mo.pgApp.Start()
+	}
+	return nil
 }
 
 func (mo *MOServer) Stop() error {
+	if mo.pgApp != nil {
+		if err := mo.pgApp.Stop(); err != nil {
+			return err
+		}
+	}
 	return mo.app.Stop()
 }
 
@@ -107,12 +123,30 @@ func NewMOServer(
 	if err != nil {
 		logutil.Panicf("start server failed with %+v", err)
 	}
-	return &MOServer{
+	mo := &MOServer{
 		addr:  addr,
 		app:   app,
 		uaddr: pu.SV.UnixSocketAddress,
 		rm:    rm,
 	}
+	if pu.SV.PgPort > 0 {
+		prm := &pgRoutineManager{RoutineManager: rm}
+		mo.pgAddr = fmt.Sprintf("%s:%d", pu.SV.Host, pu.SV.PgPort)
+		mo.pgApp, err = goetty.NewApplication(
+			mo.pgAddr,
+			prm.Handler,
+			goetty.WithAppLogger(logutil.GetGlobalLogger()),
+			goetty.WithAppSessionOptions(
+				goetty.WithSessionCodec(NewPgCodec()),
+				goetty.WithSessionLogger(logutil.GetGlobalLogger()),
+				goetty.WithSessionRWBUfferSize(DefaultRpcBufferSize, DefaultRpcBufferSize),
+				goetty.WithSessionAllocator(NewSessionAllocator(pu))),
+			goetty.WithAppSessionAware(prm))
+		if err != nil {
+			logutil.Panicf("start postgresql server failed with %+v", err)
+		}
+	}
+	return mo
 }
 
 func initVarByConfig(ctx context.Context, pu *config.ParameterUnit) error {
@@ -762,3 +762,26 @@ func Test_doSelectGlobalSystemVariable(t *testing.T) {
 		convey.So(err, convey.ShouldBeNil)
 	})
 }
+
+func TestSession_getSnapshotTxnOption(t *testing.T) {
+	ses := &Session{
+		sysVars: map[string]interface{}{},
+	}
+	opt, err := ses.getSnapshotTxnOption()
+	assert.NoError(t, err)
+	assert.Nil(t, opt)
+
+	ses.sysVars["snapshot_ts"] = ""
+	opt, err = ses.getSnapshotTxnOption()
+	assert.NoError(t, err)
+	assert.Nil(t, opt)
+
+	ses.sysVars["snapshot_ts"] = "100-1"
+	opt, err = ses.getSnapshotTxnOption()
+	assert.NoError(t, err)
+	assert.NotNil(t, opt)
+
+	ses.sysVars["snapshot_ts"] = "invalid"
+	_, err = ses.getSnapshotTxnOption()
+	assert.Error(t, err)
+}
@@ -58,7 +58,9 @@ func IsDDL(stmt tree.Statement) bool {
 	case *tree.CreateTable, *tree.DropTable,
 		*tree.CreateView, *tree.DropView, *tree.AlterView, *tree.AlterTable,
 		*tree.CreateDatabase, *tree.DropDatabase, *tree.CreateSequence, *tree.DropSequence,
-		*tree.CreateIndex, *tree.DropIndex, *tree.TruncateTable:
+		*tree.CreateIndex, *tree.DropIndex, *tree.TruncateTable,
+		*tree.CreateTrigger, *tree.DropTrigger,
+		*tree.CreateMaterializedView, *tree.DropMaterializedView:
 		return true
 	}
 	return false
@@ -130,7 +132,9 @@ If it is Case4, Then
 func statementCanBeExecutedInUncommittedTransaction(ses *Session, stmt tree.Statement) (bool, error) {
 	switch st := stmt.(type) {
 	//ddl statement
-	case *tree.CreateTable, *tree.CreateIndex, *tree.CreateView, *tree.AlterView, *tree.AlterTable:
+	case *tree.CreateTable, *tree.CreateIndex, *tree.CreateView, *tree.AlterView, *tree.AlterTable,
+		*tree.CreateTrigger, *tree.DropTrigger,
+		*tree.CreateMaterializedView, *tree.DropMaterializedView, *tree.RefreshMaterializedView:
 		return true, nil
 	case *tree.CreateDatabase, *tree.CreateSequence: //Case1, Case3 above
 		return !ses.OptionBitsIsSet(OPTION_BEGIN), nil
@@ -210,3 +214,71 @@ func statementCanBeExecutedInUncommittedTransaction(ses *Session, stmt tree.Stat
 
 	return false, nil
 }
+
+// statementCanBeExecutedAtSnapshot checks the statement only reads the data, so that it can be
+// executed when the session variable snapshot_ts is set and the transactions read the data at it.
+func statementCanBeExecutedAtSnapshot(ses *Session, stmt tree.Statement) (bool, error) {
+	switch st := stmt.(type) {
+	case *tree.Select, *tree.ParenSelect, *tree.ValuesStatement, *tree.MoDump:
+		return true, nil
+	case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+		return true, nil
+	case *tree.SetVar, *tree.Use, *tree.Deallocate, *tree.Reset, *InternalCmdFieldList:
+		return true, nil
+	case *tree.ExplainAnalyze:
+		return statementCanBeExecutedAtSnapshot(ses, st.Statement)
+	case *tree.ExplainStmt, *tree.ExplainFor:
+		return true, nil
+	case *tree.ShowCreateTable,
+		*tree.ShowCreateView,
+		*tree.ShowCreateDatabase,
+		*tree.ShowColumns,
+		*tree.ShowDatabases,
+		*tree.ShowTarget,
+		*tree.ShowTableStatus,
+		*tree.ShowGrants,
+		*tree.ShowSequences,
+		*tree.ShowTables,
+		*tree.ShowProcessList,
+		*tree.ShowErrors,
+		*tree.ShowWarnings,
+		*tree.ShowCollation,
+		*tree.ShowVariables,
+		*tree.ShowStatus,
+		*tree.ShowIndex,
+		*tree.ShowFunctionOrProcedureStatus,
+		*tree.ShowNodeList,
+		*tree.ShowLocks,
+		*tree.ShowTableNumber,
+		*tree.ShowColumnNumber,
+		*tree.ShowTableValues,
+		*tree.ShowAccounts,
+		*tree.ShowPublications,
+		*tree.ShowSubscriptions,
+		*tree.ShowCreatePublications,
+		*tree.ShowTableSize,
+		*tree.ShowRolesStmt,
+		*tree.ShowBackendServers,
+		*tree.ShowStages:
+		return true, nil
+	case *tree.PrepareStmt:
+		return statementCanBeExecutedAtSnapshot(ses, st.Stmt)
+	case *tree.PrepareString:
+		v, err := ses.GetGlobalVar("lower_case_table_names")
+		if err != nil {
+			return false, err
+		}
+		preStmt, err := mysql.ParseOne(ses.requestCtx, st.Sql, v.(int64))
+		if err != nil {
+			return false, err
+		}
+		return statementCanBeExecutedAtSnapshot(ses, preStmt)
+	case *tree.Execute:
+		preStmt, err := ses.GetPrepareStmt(string(st.Name))
+		if err != nil {
+			return false, err
+		}
+		return statementCanBeExecutedAtSnapshot(ses, preStmt.PrepareStmt)
+	}
+	return false, nil
+}
@@ -0,0 +1,1241 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"go/constant"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	util2 "github.com/matrixorigin/matrixone/pkg/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	triggerNewRow = "new"
+	triggerOldRow = "old"
+
+	// triggerBatchSize is the max count of the rows, or of the values of the inserted
+	// rows, fetched by one query. The rows changed by the statement are fetched and
+	// changed batch by batch.
+	triggerBatchSize = 1000
+
+	getTriggerDefinerFormat = "select definer from mo_catalog.mo_triggers where trigger_db = '%s' and trigger_name = '%s';"
+)
+
+var (
+	triggerEventInsert = tree.TriggerEventInsert.String()
+	triggerEventUpdate = tree.TriggerEventUpdate.String()
+	triggerEventDelete = tree.TriggerEventDelete.String()
+)
+
+var _ ComputationRunner = &triggerRunner{}
+
+// triggerRunner changes the rows of the table with triggers one by one, and fires the
+// triggers of the table before and after each row is changed. The statements run in the
+// transaction of the session through the background executor, and the body of the trigger
+// is run by the stored procedure interpreter with the NEW and OLD rows bound as variables.
+// The statements in the body are checked and run with the privileges of the definer of
+// the trigger, not the user who changes the table.
+//
+// REPLACE deletes the rows conflicting with the inserted row and fires the DELETE triggers,
+// INSERT ... ON DUPLICATE KEY UPDATE updates the conflicting row and fires the UPDATE
+// triggers instead of inserting it. The row of the table without primary key is located by
+// all of its columns.
+//
+// The rows are not held in memory all together. The values of INSERT are evaluated batch
+// by batch, and the rows of UPDATE and DELETE are fetched in the batches ordered by the
+// primary key. The rows of INSERT ... SELECT, and of UPDATE and DELETE with ORDER BY or
+// LIMIT or on the table without primary key, are fetched by one query.
+type triggerRunner struct {
+	ctx      context.Context
+	ses      *Session
+	proc     *process.Process
+	stmt     tree.Statement
+	dbName   string
+	table    *tree.TableName
+	tableDef *plan.TableDef
+	// the columns of the row, and the columns to locate the row
+	cols []*plan.ColDef
+	keys []*plan.ColDef
+	// the columns of the primary key and the unique keys to find the rows conflicting
+	// with the inserted row
+	uniqueKeys [][]*plan.ColDef
+
+	// the triggers by the events
+	before map[string][]*triggerBody
+	after  map[string][]*triggerBody
+
+	// definers are the definers of the triggers by the names of the triggers, and
+	// definerExecs are the executors of the trigger bodies by the names of the definers
+	definers     map[string]*TenantInfo
+	definerExecs map[string]*BackgroundHandler
+
+	// movedKeys are the keys of the rows whose primary key is changed by UPDATE, the rows
+	// are skipped when they are fetched again in a later batch
+	movedKeys map[string]struct{}
+}
+
+type triggerBody struct {
+	def  *plan.TriggerDef
+	body tree.Statement
+}
+
+// getTriggerRunner returns the runner which fires the triggers of the table changed by the
+// DML, or nil if the DML doesn't fire any trigger. The parameters of the prepared statement
+// are bound to the statement as constants. LOAD DATA, DELETE with partitions and the
+// multiple-table DML on the table with triggers are not supported yet.
+func getTriggerRunner(ctx context.Context, ses *Session, proc *process.Process, stmt tree.Statement, pn *plan.Plan, prepared bool) (*triggerRunner, error) {
+	// the events of the triggers fired by the statement
+	var events []string
+	switch st := stmt.(type) {
+	case *tree.Insert:
+		events = []string{triggerEventInsert}
+		if len(st.OnDuplicateUpdate) > 0 {
+			events = append(events, triggerEventUpdate)
+		}
+	case *tree.Replace:
+		events = []string{triggerEventInsert, triggerEventDelete}
+	case *tree.Load:
+		events = []string{triggerEventInsert}
+	case *tree.Update:
+		events = []string{triggerEventUpdate}
+	case *tree.Delete:
+		events = []string{triggerEventDelete}
+	default:
+		return nil, nil
+	}
+	// the row is changed by the runner of the statement which fires the triggers
+	if skip, ok := ctx.Value(defines.SkipTriggerKey{}).(bool); ok && skip {
+		return nil, nil
+	}
+	chain, _ := ctx.Value(defines.TriggerTablesKey{}).([]string)
+	if len(chain) == 0 && !planHasTriggers(pn) {
+		return nil, nil
+	}
+
+	targets, single := getDMLTargetTables(stmt)
+	var runner *triggerRunner
+	for _, target := range targets {
+		dbName := string(target.SchemaName)
+		if dbName == "" {
+			dbName = ses.GetDatabaseName()
+		}
+		tblName := string(target.ObjectName)
+		for _, name := range chain {
+			if name == dbName+"."+tblName {
+				return nil, moerr.NewInternalError(ctx, "can't update table '%s' in trigger because it is already used by statement which invoked this trigger", tblName)
+			}
+		}
+		_, tableDef := ses.GetTxnCompileCtx().Resolve(dbName, tblName)
+		if tableDef == nil || len(tableDef.Triggers) == 0 {
+			continue
+		}
+		hasTrigger := false
+		for _, t := range tableDef.Triggers {
+			for _, event := range events {
+				if t.Event == event {
+					hasTrigger = true
+				}
+			}
+		}
+		if !hasTrigger {
+			continue
+		}
+		if !single {
+			return nil, moerr.NewNYI(ctx, "multiple-table %s on the table '%s' with triggers", strings.ToLower(events[0]), tblName)
+		}
+		runner = &triggerRunner{
+			ctx:      ctx,
+			ses:      ses,
+			proc:     proc,
+			stmt:     stmt,
+			dbName:   dbName,
+			table:    tree.NewTableName(tree.Identifier(tblName), tree.ObjectNamePrefix{SchemaName: tree.Identifier(dbName), ExplicitSchema: true}),
+			tableDef: tableDef,
+		}
+	}
+	if runner == nil {
+		return nil, nil
+	}
+
+	switch st := stmt.(type) {
+	case *tree.Load:
+		return nil, moerr.NewNYI(ctx, "LOAD DATA on the table '%s' with triggers", runner.tableDef.Name)
+	case *tree.Delete:
+		if len(st.PartitionNames) > 0 {
+			return nil, moerr.NewNYI(ctx, "DELETE with partitions on the table '%s' with triggers", runner.tableDef.Name)
+		}
+	}
+	if prepared {
+		var err error
+		if runner.stmt, err = bindPrepareParams(ctx, proc, stmt); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := runner.init(); err != nil {
+		return nil, err
+	}
+	return runner, nil
+}
+
+// bindPrepareParams returns the prepared statement with the parameters of the EXECUTE
+// bound as the string constants, the parameters are always passed as text.
+func bindPrepareParams(ctx context.Context, proc *process.Process, stmt tree.Statement) (tree.Statement, error) {
+	var params []string
+	if vec := proc.GetPrepareParams(); vec != nil {
+		params = make([]string, vec.Length())
+		for i := range params {
+			val, err := proc.GetPrepareParamsAt(i)
+			if err != nil {
+				return nil, err
+			}
+			if val == nil {
+				params[i] = "null"
+			} else {
+				params[i] = "'" + util.EscapeSqlString(string(val)) + "'"
+			}
+		}
+	}
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true), tree.WithParams(params))
+	stmt.Format(fmtCtx)
+	return parsers.ParseOne(ctx, dialect.MYSQL, fmtCtx.String(), 1)
+}
+
+// planHasTriggers checks whether any table in the plan has triggers, it avoids resolving
+// the tables again for the DML on the tables without triggers.
+func planHasTriggers(pn *plan.Plan) bool {
+	qry := pn.GetQuery()
+	if qry == nil {
+		return false
+	}
+	for _, node := range qry.Nodes {
+		if len(node.TableDef.GetTriggers()) > 0 ||
+			len(node.InsertCtx.GetTableDef().GetTriggers()) > 0 ||
+			len(node.PreInsertCtx.GetTableDef().GetTriggers()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// getDMLTargetTables returns the tables changed by the DML, and whether the DML changes
+// only one table.
+func getDMLTargetTables(stmt tree.Statement) ([]*tree.TableName, bool) {
+	var tables []*tree.TableName
+	switch st := stmt.(type) {
+	case *tree.Insert:
+		tables = getTableNamesOfTableExpr(st.Table, tables)
+	case *tree.Replace:
+		tables = getTableNamesOfTableExpr(st.Table, tables)
+	case *tree.Load:
+		if st.Table != nil {
+			tables = append(tables, st.Table)
+		}
+	case *tree.Update:
+		for _, t := range st.Tables {
+			tables = getTableNamesOfTableExpr(t, tables)
+		}
+	case *tree.Delete:
+		for _, t := range st.Tables {
+			tables = getTableNamesOfTableExpr(t, tables)
+		}
+		if st.TableRefs != nil {
+			return tables, false
+		}
+	}
+	return tables, len(tables) == 1
+}
+
+func getTableNamesOfTableExpr(expr tree.TableExpr, tables []*tree.TableName) []*tree.TableName {
+	switch t := expr.(type) {
+	case *tree.TableName:
+		tables = append(tables, t)
+	case *tree.AliasedTableExpr:
+		tables = getTableNamesOfTableExpr(t.Expr, tables)
+	case *tree.ParenTableExpr:
+		tables = getTableNamesOfTableExpr(t.Expr, tables)
+	case *tree.JoinTableExpr:
+		tables = getTableNamesOfTableExpr(t.Left, tables)
+		if t.Right != nil {
+			tables = getTableNamesOfTableExpr(t.Right, tables)
+		}
+	}
+	return tables
+}
+
+func (tr *triggerRunner) init() error {
+	tr.before = make(map[string][]*triggerBody)
+	tr.after = make(map[string][]*triggerBody)
+	for _, t := range tr.tableDef.Triggers {
+		stmts, err := parsers.Parse(tr.ctx, dialect.MYSQL, t.Body, 1)
+		if err != nil {
+			return err
+		}
+		body := &triggerBody{def: t, body: stmts[0]}
+		if t.Timing == tree.TriggerTimeBefore.String() {
+			tr.before[t.Event] = append(tr.before[t.Event], body)
+		} else {
+			tr.after[t.Event] = append(tr.after[t.Event], body)
+		}
+	}
+
+	for _, col := range tr.tableDef.Cols {
+		if !col.Hidden {
+			tr.cols = append(tr.cols, col)
+		}
+	}
+	// the row of the table without primary key is located by all of its columns
+	if tr.tableDef.Pkey != nil {
+		keyNames := tr.tableDef.Pkey.Names
+		if len(keyNames) == 0 {
+			keyNames = []string{tr.tableDef.Pkey.PkeyColName}
+		}
+		for _, name := range keyNames {
+			col := getColumnByName(tr.tableDef.Cols, name)
+			if col == nil {
+				return moerr.NewInternalError(tr.ctx, "can't find the primary key column '%s' of the table '%s'", name, tr.tableDef.Name)
+			}
+			tr.keys = append(tr.keys, col)
+		}
+	}
+
+	for _, uniqueCols := range plan2.GetUniqueColAndIdxFromTableDef(tr.tableDef) {
+		names := make([]string, 0, len(uniqueCols))
+		for name := range uniqueCols {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		key := make([]*plan.ColDef, len(names))
+		for i, name := range names {
+			if key[i] = getColumnByName(tr.tableDef.Cols, name); key[i] == nil {
+				return moerr.NewInternalError(tr.ctx, "can't find the unique key column '%s' of the table '%s'", name, tr.tableDef.Name)
+			}
+		}
+		tr.uniqueKeys = append(tr.uniqueKeys, key)
+	}
+	return nil
+}
+
+func (tr *triggerRunner) Run(_ uint64) (*util2.RunResult, error) {
+	bh := tr.ses.GetShareTxnBackgroundExec(tr.ctx, false)
+	defer bh.Close()
+	defer func() {
+		for _, dbh := range tr.definerExecs {
+			dbh.Close()
+		}
+		tr.definerExecs = nil
+	}()
+
+	var rows uint64
+	var err error
+	switch st := tr.stmt.(type) {
+	case *tree.Insert:
+		rows, err = tr.runInsert(bh, st, false)
+	case *tree.Replace:
+		rows, err = tr.runInsert(bh, &tree.Insert{
+			Table:          st.Table,
+			PartitionNames: st.PartitionNames,
+			Columns:        st.Columns,
+			Rows:           st.Rows,
+		}, true)
+	case *tree.Update:
+		rows, err = tr.runUpdate(bh, st)
+	case *tree.Delete:
+		rows, err = tr.runDelete(bh, st)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &util2.RunResult{AffectRows: rows}, nil
+}
+
+// fetchCtx returns the context of the statements run by the runner. The tables changed by
+// the statements which fire the triggers are passed down, so the trigger can't change them.
+func (tr *triggerRunner) fetchCtx() context.Context {
+	chain, _ := tr.ctx.Value(defines.TriggerTablesKey{}).([]string)
+	tables := make([]string, 0, len(chain)+1)
+	tables = append(tables, chain...)
+	tables = append(tables, tr.dbName+"."+tr.tableDef.Name)
+	return context.WithValue(tr.ctx, defines.TriggerTablesKey{}, tables)
+}
+
+// rowCtx returns the context of the statement which changes the row, the row is bound
+// as the variables of the stored procedure.
+func rowCtx(ctx context.Context, varScope *[]map[string]interface{}) context.Context {
+	ctx = context.WithValue(ctx, defines.SkipTriggerKey{}, true)
+	ctx = context.WithValue(ctx, defines.VarScopeKey{}, varScope)
+	return context.WithValue(ctx, defines.InSp{}, true)
+}
+
+func (tr *triggerRunner) runInsert(bh BackgroundExec, st *tree.Insert, replace bool) (uint64, error) {
+	ctx := tr.fetchCtx()
+
+	// the columns of the statement
+	insertCols := tr.cols
+	if len(st.Columns) > 0 {
+		insertCols = make([]*plan.ColDef, len(st.Columns))
+		for i, name := range st.Columns {
+			col := getColumnByName(tr.cols, string(name))
+			if col == nil {
+				return 0, moerr.NewBadFieldError(ctx, string(name), tr.tableDef.Name)
+			}
+			insertCols[i] = col
+		}
+	}
+	inserted := make(map[string]int, len(insertCols))
+	for i, col := range insertCols {
+		inserted[strings.ToLower(col.Name)] = i
+	}
+	// the columns updated on the duplicate key and their new values
+	setCols, setExprs, err := tr.updateSetExprs(ctx, st.OnDuplicateUpdate)
+	if err != nil {
+		return 0, err
+	}
+
+	// the values of the columns which are not in the statement
+	defaultExprs := make(tree.SelectExprs, len(tr.cols))
+	for i, col := range tr.cols {
+		expr, err := getColumnDefaultExpr(ctx, col)
+		if err != nil {
+			return 0, err
+		}
+		defaultExprs[i] = tree.SelectExpr{Expr: expr}
+	}
+	defaults, err := tr.fetchRows(ctx, bh, selectWithoutFrom(defaultExprs))
+	if err != nil {
+		return 0, err
+	}
+
+	var affected, lastInsertID uint64
+	insertRow := func(vals []*triggerValue) error {
+		if len(vals) != len(insertCols) {
+			return moerr.NewWrongValueCountOnRow(ctx, 1)
+		}
+		// the auto increment column gets its value after the row is inserted
+		var autoIncrCol *plan.ColDef
+		newRow := make(map[string]interface{}, len(tr.cols))
+		for i, col := range tr.cols {
+			v := defaults[0][i]
+			idx, ok := inserted[strings.ToLower(col.Name)]
+			if ok {
+				v = vals[idx]
+			}
+			if col.Typ.AutoIncr && (v.isNull || v.val == "0") {
+				autoIncrCol = col
+				v = &triggerValue{val: "0"}
+			}
+			if newRow[rowVarName(triggerNewRow, col.Name)], err = v.toExpr(ctx, col); err != nil {
+				return err
+			}
+		}
+		varScope := []map[string]interface{}{newRow}
+		initRow := copyTriggerRow(newRow)
+		if err = tr.fire(ctx, bh, tr.before[triggerEventInsert], &varScope); err != nil {
+			return err
+		}
+
+		if replace || len(st.OnDuplicateUpdate) > 0 {
+			conflicts, err := tr.fetchConflictRows(ctx, bh, &varScope, setExprs)
+			if err != nil {
+				return err
+			}
+			// the conflicting row is updated instead of inserting the row, it's counted
+			// as two rows like MySQL
+			if len(st.OnDuplicateUpdate) > 0 && len(conflicts) > 0 {
+				if err = tr.updateRow(ctx, bh, conflicts[0], setCols); err != nil {
+					return err
+				}
+				affected += 2
+				return nil
+			}
+			for _, conflict := range conflicts {
+				if err = tr.deleteRow(ctx, bh, conflict); err != nil {
+					return err
+				}
+				affected++
+			}
+		}
+
+		var names tree.IdentifierList
+		var exprs tree.Exprs
+		for _, col := range tr.cols {
+			name := rowVarName(triggerNewRow, col.Name)
+			_, ok := inserted[strings.ToLower(col.Name)]
+			changed := newRow[name] != initRow[name]
+			if (!ok && !changed) || (col == autoIncrCol && !changed) {
+				continue
+			}
+			if col == autoIncrCol {
+				autoIncrCol = nil
+			}
+			names = append(names, tree.Identifier(col.Name))
+			exprs = append(exprs, tree.SetUnresolvedName(triggerNewRow, col.Name))
+		}
+		insert := &tree.Insert{
+			Table:          tr.table,
+			PartitionNames: st.PartitionNames,
+			Columns:        names,
+			Rows:           &tree.Select{Select: &tree.ValuesClause{Rows: []tree.Exprs{exprs}}},
+		}
+		if err = bh.ExecStmt(rowCtx(ctx, &varScope), insert); err != nil {
+			return err
+		}
+		affected++
+
+		if autoIncrCol != nil {
+			id, err := tr.fetchRows(ctx, bh, selectWithoutFrom(tree.SelectExprs{
+				{Expr: &tree.FuncExpr{Func: tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("last_insert_id"))}},
+			}))
+			if err != nil {
+				return err
+			}
+			if newRow[rowVarName(triggerNewRow, autoIncrCol.Name)], err = id[0][0].toExpr(ctx, autoIncrCol); err != nil {
+				return err
+			}
+			if lastInsertID == 0 {
+				lastInsertID, _ = strconv.ParseUint(id[0][0].val, 10, 64)
+			}
+		}
+		return tr.fire(ctx, bh, tr.after[triggerEventInsert], &varScope)
+	}
+
+	// the rows to insert
+	if values, ok := st.Rows.Select.(*tree.ValuesClause); ok {
+		err = tr.scanValues(ctx, bh, values, insertCols, insertRow)
+	} else {
+		var rows [][]*triggerValue
+		if rows, err = tr.fetchRows(ctx, bh, st.Rows); err != nil {
+			return 0, err
+		}
+		for _, vals := range rows {
+			if err = insertRow(vals); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	if lastInsertID != 0 {
+		tr.proc.SetLastInsertID(lastInsertID)
+	}
+	return affected, nil
+}
+
+// scanValues evaluates the rows of the VALUES clause and calls fn on them. The values of
+// a batch of rows are selected together by one query.
+func (tr *triggerRunner) scanValues(ctx context.Context, bh BackgroundExec, values *tree.ValuesClause, insertCols []*plan.ColDef, fn func(vals []*triggerValue) error) error {
+	batch := triggerBatchSize / len(insertCols)
+	if batch == 0 {
+		batch = 1
+	}
+	for start := 0; start < len(values.Rows); start += batch {
+		end := start + batch
+		if end > len(values.Rows) {
+			end = len(values.Rows)
+		}
+		exprs := make(tree.SelectExprs, 0, (end-start)*len(insertCols))
+		for r, row := range values.Rows[start:end] {
+			if len(row) != len(insertCols) {
+				return moerr.NewWrongValueCountOnRow(ctx, start+r+1)
+			}
+			for i, expr := range row {
+				if _, ok := expr.(*tree.DefaultVal); ok {
+					var err error
+					if expr, err = getColumnDefaultExpr(ctx, insertCols[i]); err != nil {
+						return err
+					}
+				}
+				exprs = append(exprs, tree.SelectExpr{Expr: expr})
+			}
+		}
+		vals, err := tr.fetchRows(ctx, bh, selectWithoutFrom(exprs))
+		if err != nil {
+			return err
+		}
+		for r := 0; r < end-start; r++ {
+			if err = fn(vals[0][r*len(insertCols) : (r+1)*len(insertCols)]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fetchConflictRows returns the rows conflicting with the NEW row on the primary key or the
+// unique keys, the values of exprs on the rows follow the columns of the rows.
+func (tr *triggerRunner) fetchConflictRows(ctx context.Context, bh BackgroundExec, varScope *[]map[string]interface{}, exprs tree.SelectExprs) ([][]*triggerValue, error) {
+	var filter tree.Expr
+	for _, key := range tr.uniqueKeys {
+		var keyFilter tree.Expr
+		for _, col := range key {
+			keyFilter = andFilter(keyFilter, tree.NewComparisonExpr(tree.EQUAL, tree.SetUnresolvedName(col.Name), tree.SetUnresolvedName(triggerNewRow, col.Name)))
+		}
+		if filter == nil {
+			filter = keyFilter
+		} else {
+			filter = tree.NewOrExpr(filter, keyFilter)
+		}
+	}
+	if filter == nil {
+		return nil, nil
+	}
+	return tr.fetchRows(rowCtx(ctx, varScope), bh, &tree.Select{
+		Select: &tree.SelectClause{
+			Exprs: append(tr.rowSelectExprs(), exprs...),
+			From:  &tree.From{Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: tr.table}}},
+			Where: tree.NewWhere(filter),
+		},
+	})
+}
+
+// updateSetExprs returns the columns set by the update exprs and their new values.
+func (tr *triggerRunner) updateSetExprs(ctx context.Context, updateExprs tree.UpdateExprs) ([]*plan.ColDef, tree.SelectExprs, error) {
+	var setCols []*plan.ColDef
+	var exprs tree.SelectExprs
+	for _, setExpr := range updateExprs {
+		values := []tree.Expr{setExpr.Expr}
+		if setExpr.Tuple {
+			tuple, ok := setExpr.Expr.(*tree.Tuple)
+			if !ok || len(tuple.Exprs) != len(setExpr.Names) {
+				return nil, nil, moerr.NewNYI(ctx, "the update expression '%s' on the table with triggers", tree.String(setExpr, dialect.MYSQL))
+			}
+			values = tuple.Exprs
+		}
+		for i, name := range setExpr.Names {
+			col := getColumnByName(tr.cols, name.Parts[0])
+			if col == nil {
+				return nil, nil, moerr.NewBadFieldError(ctx, name.Parts[0], tr.tableDef.Name)
+			}
+			expr := values[i]
+			if _, ok := expr.(*tree.DefaultVal); ok {
+				var err error
+				if expr, err = getColumnDefaultExpr(ctx, col); err != nil {
+					return nil, nil, err
+				}
+			}
+			setCols = append(setCols, col)
+			exprs = append(exprs, tree.SelectExpr{Expr: expr})
+		}
+	}
+	return setCols, exprs, nil
+}
+
+func (tr *triggerRunner) runUpdate(bh BackgroundExec, st *tree.Update) (uint64, error) {
+	ctx := tr.fetchCtx()
+
+	// the old row, the columns to locate the row and the new values of the row
+	setCols, setExprs, err := tr.updateSetExprs(ctx, st.Exprs)
+	if err != nil {
+		return 0, err
+	}
+	tr.movedKeys = make(map[string]struct{})
+	defer func() {
+		tr.movedKeys = nil
+	}()
+	var affected uint64
+	err = tr.scanRows(ctx, bh, st.Tables, st.Where, st.With, st.OrderBy, st.Limit, setExprs, func(vals []*triggerValue) error {
+		if _, ok := tr.movedKeys[tr.rowKey(vals)]; ok {
+			return nil
+		}
+		affected++
+		return tr.updateRow(ctx, bh, vals, setCols)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return affected, nil
+}
+
+// updateRow updates the row selected by rowSelectExprs with the new values of setCols which
+// follow the row, and fires the UPDATE triggers.
+func (tr *triggerRunner) updateRow(ctx context.Context, bh BackgroundExec, vals []*triggerValue, setCols []*plan.ColDef) error {
+	oldRow, err := tr.bindOldRow(ctx, vals)
+	if err != nil {
+		return err
+	}
+	newRow := make(map[string]interface{}, len(tr.cols)+1)
+	for _, col := range tr.cols {
+		newRow[rowVarName(triggerNewRow, col.Name)] = plan2.DeepCopyExpr(oldRow[rowVarName(triggerOldRow, col.Name)].(*plan.Expr))
+	}
+	offset := len(tr.cols) + len(tr.keys)
+	for i, col := range setCols {
+		if newRow[rowVarName(triggerNewRow, col.Name)], err = vals[offset+i].toExpr(ctx, col); err != nil {
+			return err
+		}
+	}
+	varScope := []map[string]interface{}{newRow, oldRow}
+	initRow := copyTriggerRow(newRow)
+	if err = tr.fire(ctx, bh, tr.before[triggerEventUpdate], &varScope); err != nil {
+		return err
+	}
+
+	var updateExprs tree.UpdateExprs
+	for _, col := range tr.cols {
+		name := rowVarName(triggerNewRow, col.Name)
+		if newRow[name] == initRow[name] && !containsColumn(setCols, col) {
+			continue
+		}
+		updateExprs = append(updateExprs, &tree.UpdateExpr{
+			Names: []*tree.UnresolvedName{tree.SetUnresolvedName(col.Name)},
+			Expr:  tree.SetUnresolvedName(triggerNewRow, col.Name),
+		})
+	}
+	if len(updateExprs) > 0 {
+		update := &tree.Update{
+			Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: tr.table}},
+			Exprs:  updateExprs,
+			Where:  tr.rowWhere(),
+			Limit:  tr.rowLimit(),
+		}
+		if err = bh.ExecStmt(rowCtx(ctx, &varScope), update); err != nil {
+			return err
+		}
+		if err = tr.recordMovedKey(ctx, bh, &varScope, initRow, setCols); err != nil {
+			return err
+		}
+	}
+	return tr.fire(ctx, bh, tr.after[triggerEventUpdate], &varScope)
+}
+
+// recordMovedKey records the new primary key of the row changed by UPDATE if the key is
+// changed, the row may be fetched again by a later batch after its key is moved forward.
+func (tr *triggerRunner) recordMovedKey(ctx context.Context, bh BackgroundExec, varScope *[]map[string]interface{}, initRow map[string]interface{}, setCols []*plan.ColDef) error {
+	if tr.movedKeys == nil {
+		return nil
+	}
+	newRow := (*varScope)[0]
+	moved := false
+	exprs := make(tree.SelectExprs, len(tr.keys))
+	for i, col := range tr.keys {
+		name := rowVarName(triggerNewRow, col.Name)
+		if _, ok := newRow[name]; !ok {
+			// the hidden key is not changed
+			exprs[i] = tree.SelectExpr{Expr: tree.SetUnresolvedName(triggerOldRow, col.Name)}
+			continue
+		}
+		if newRow[name] != initRow[name] || containsColumn(setCols, col) {
+			moved = true
+		}
+		exprs[i] = tree.SelectExpr{Expr: tree.SetUnresolvedName(triggerNewRow, col.Name)}
+	}
+	if !moved {
+		return nil
+	}
+	key, err := tr.fetchRows(rowCtx(ctx, varScope), bh, selectWithoutFrom(exprs))
+	if err != nil {
+		return err
+	}
+	tr.movedKeys[triggerKey(key[0])] = struct{}{}
+	return nil
+}
+
+func (tr *triggerRunner) runDelete(bh BackgroundExec, st *tree.Delete) (uint64, error) {
+	ctx := tr.fetchCtx()
+
+	var affected uint64
+	err := tr.scanRows(ctx, bh, st.Tables, st.Where, st.With, st.OrderBy, st.Limit, nil, func(vals []*triggerValue) error {
+		affected++
+		return tr.deleteRow(ctx, bh, vals)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return affected, nil
+}
+
+// deleteRow deletes the row selected by rowSelectExprs, and fires the DELETE triggers.
+func (tr *triggerRunner) deleteRow(ctx context.Context, bh BackgroundExec, vals []*triggerValue) error {
+	oldRow, err := tr.bindOldRow(ctx, vals)
+	if err != nil {
+		return err
+	}
+	varScope := []map[string]interface{}{oldRow}
+	if err = tr.fire(ctx, bh, tr.before[triggerEventDelete], &varScope); err != nil {
+		return err
+	}
+	del := &tree.Delete{
+		Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: tr.table}},
+		Where:  tr.rowWhere(),
+		Limit:  tr.rowLimit(),
+	}
+	if err = bh.ExecStmt(rowCtx(ctx, &varScope), del); err != nil {
+		return err
+	}
+	return tr.fire(ctx, bh, tr.after[triggerEventDelete], &varScope)
+}
+
+// fire runs the triggers with the row bound in the variable scope.
+func (tr *triggerRunner) fire(ctx context.Context, bh BackgroundExec, triggers []*triggerBody, varScope *[]map[string]interface{}) error {
+	for _, t := range triggers {
+		dbh, definerCtx, err := tr.getDefinerExec(ctx, bh, t.def)
+		if err != nil {
+			return err
+		}
+		interpreter := Interpreter{
+			ctx:         definerCtx,
+			ses:         tr.ses,
+			bh:          dbh,
+			varScope:    varScope,
+			fmtctx:      tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true)),
+			outParamMap: make(map[string]interface{}),
+			trigger:     t.def,
+		}
+		if _, err := interpreter.interpret(t.body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDefinerExec returns the executor of the trigger body and its context. The executor
+// runs in the transaction of the session as the definer of the trigger, so every statement
+// in the body is checked against the privileges of the definer.
+func (tr *triggerRunner) getDefinerExec(ctx context.Context, bh BackgroundExec, def *plan.TriggerDef) (BackgroundExec, context.Context, error) {
+	definer, ok := tr.definers[def.Name]
+	if !ok {
+		var err error
+		if definer, err = tr.getTriggerDefiner(ctx, bh, def.Name); err != nil {
+			return nil, nil, err
+		}
+		if tr.definers == nil {
+			tr.definers = make(map[string]*TenantInfo)
+		}
+		tr.definers[def.Name] = definer
+	}
+	if dbh, ok := tr.definerExecs[definer.GetUser()]; ok {
+		return dbh, definerCtx(ctx, definer), nil
+	}
+	dbh, ctx := newDefinerExec(ctx, tr.ses, definer, tr.dbName)
+	if tr.definerExecs == nil {
+		tr.definerExecs = make(map[string]*BackgroundHandler)
+	}
+	tr.definerExecs[definer.GetUser()] = dbh
+	return dbh, ctx, nil
+}
+
+// definerCtx returns the context of the statements run as the definer.
+func definerCtx(ctx context.Context, definer *TenantInfo) context.Context {
+	ctx = context.WithValue(ctx, defines.UserIDKey{}, definer.GetUserID())
+	return context.WithValue(ctx, defines.RoleIDKey{}, definer.GetDefaultRoleID())
+}
+
+// newDefinerExec returns the executor running in the transaction of the session as the
+// definer, and its context. The statements run by it are checked against the privileges
+// of the definer.
+func newDefinerExec(ctx context.Context, ses *Session, definer *TenantInfo, dbName string) (*BackgroundHandler, context.Context) {
+	ctx = definerCtx(ctx, definer)
+	dbh := ses.GetShareTxnBackgroundExec(ctx, false).(*BackgroundHandler)
+	dbh.ses.SetTenantInfo(definer)
+	dbh.ses.SetDatabaseName(dbName)
+	return dbh, ctx
+}
+
+// getTriggerDefiner returns the tenant info of the definer recorded in mo_triggers.
+func (tr *triggerRunner) getTriggerDefiner(ctx context.Context, bh BackgroundExec, name string) (*TenantInfo, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, fmt.Sprintf(getTriggerDefinerFormat, util.EscapeSqlString(tr.dbName), util.EscapeSqlString(name))); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, moerr.NewInternalError(ctx, "can not find the definer of the trigger '%s'", name)
+	}
+	definer, err := erArray[0].GetString(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	userName, _, _ := strings.Cut(definer, "@")
+	return getDefinerTenantInfo(ctx, tr.ses, bh, userName)
+}
+
+// getDefinerTenantInfo returns the tenant info of the user in the account of the session.
+// The definer has all the roles granted to it, like the user who defines the object.
+func getDefinerTenantInfo(ctx context.Context, ses *Session, bh BackgroundExec, userName string) (*TenantInfo, error) {
+	sql, err := getSqlForPasswordOfUser(ctx, userName)
+	if err != nil {
+		return nil, err
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, sql); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, moerr.NewInternalError(ctx, "the definer '%s' does not exist", userName)
+	}
+	userID, err := erArray[0].GetInt64(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	roleID, err := erArray[0].GetInt64(ctx, 0, 2)
+	if err != nil {
+		return nil, err
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, getSqlForRoleNameOfRoleId(roleID)); err != nil {
+		return nil, err
+	}
+	if erArray, err = getResultSet(ctx, bh); err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, moerr.NewInternalError(ctx, "the default role of the definer '%s' does not exist", userName)
+	}
+	roleName, err := erArray[0].GetString(ctx, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &TenantInfo{
+		User:                userName,
+		UserID:              uint32(userID),
+		DefaultRole:         roleName,
+		DefaultRoleID:       uint32(roleID),
+		useAllSecondaryRole: true,
+		delimiter:           ':',
+	}
+	if tenant := ses.GetTenantInfo(); tenant != nil {
+		info.Tenant, info.TenantID = tenant.GetTenant(), tenant.GetTenantID()
+	} else {
+		info.TenantID = defines.GetAccountId(ctx)
+		if info.TenantID == sysAccountID {
+			info.Tenant = sysAccountName
+		}
+	}
+	return info, nil
+}
+
+// selectWithoutFrom returns the select of the exprs without a FROM clause, the FROM is an
+// unnamed table as the parser makes for such a select.
+func selectWithoutFrom(exprs tree.SelectExprs) *tree.Select {
+	tn := tree.NewTableName(tree.Identifier(""), tree.ObjectNamePrefix{})
+	return &tree.Select{Select: &tree.SelectClause{
+		Exprs: exprs,
+		From:  &tree.From{Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: tn}}},
+	}}
+}
+
+// rowSelectExprs returns the columns of the row and the columns to locate the row.
+func (tr *triggerRunner) rowSelectExprs() tree.SelectExprs {
+	exprs := make(tree.SelectExprs, 0, len(tr.cols)+len(tr.keys))
+	for _, col := range tr.cols {
+		exprs = append(exprs, tree.SelectExpr{Expr: tree.SetUnresolvedName(col.Name)})
+	}
+	for _, col := range tr.keys {
+		exprs = append(exprs, tree.SelectExpr{Expr: tree.SetUnresolvedName(col.Name)})
+	}
+	return exprs
+}
+
+// bindOldRow binds the values selected by rowSelectExprs as the OLD row.
+func (tr *triggerRunner) bindOldRow(ctx context.Context, vals []*triggerValue) (map[string]interface{}, error) {
+	var err error
+	oldRow := make(map[string]interface{}, len(tr.cols)+len(tr.keys))
+	for i, col := range tr.cols {
+		if oldRow[rowVarName(triggerOldRow, col.Name)], err = vals[i].toExpr(ctx, col); err != nil {
+			return nil, err
+		}
+	}
+	for i, col := range tr.keys {
+		if oldRow[rowVarName(triggerOldRow, col.Name)], err = vals[len(tr.cols)+i].toExpr(ctx, col); err != nil {
+			return nil, err
+		}
+	}
+	return oldRow, nil
+}
+
+// rowWhere returns the filter which locates the row by the primary key of the OLD row, or
+// by all the columns of the OLD row if the table has no primary key.
+func (tr *triggerRunner) rowWhere() *tree.Where {
+	var filter tree.Expr
+	for _, col := range tr.keys {
+		filter = andFilter(filter, tree.NewComparisonExpr(tree.EQUAL, tree.SetUnresolvedName(col.Name), tree.SetUnresolvedName(triggerOldRow, col.Name)))
+	}
+	if len(tr.keys) == 0 {
+		for _, col := range tr.cols {
+			name, old := tree.SetUnresolvedName(col.Name), tree.SetUnresolvedName(triggerOldRow, col.Name)
+			filter = andFilter(filter, tree.NewParenExpr(tree.NewOrExpr(
+				tree.NewComparisonExpr(tree.EQUAL, name, old),
+				tree.NewAndExpr(tree.NewIsNullExpr(name), tree.NewIsNullExpr(old)),
+			)))
+		}
+	}
+	return tree.NewWhere(filter)
+}
+
+// rowLimit returns the limit of the statement which changes the row. The table without
+// primary key may have the same rows, only one of them is changed.
+func (tr *triggerRunner) rowLimit() *tree.Limit {
+	if len(tr.keys) > 0 {
+		return nil
+	}
+	return &tree.Limit{Count: tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)}
+}
+
+func andFilter(filter, expr tree.Expr) tree.Expr {
+	if filter == nil {
+		return expr
+	}
+	return tree.NewAndExpr(filter, expr)
+}
+
+// scanRows fetches the rows of the table selected by UPDATE or DELETE, with the values of
+// exprs following the columns of the rows, and calls fn on them. The rows are fetched in
+// the batches ordered by the primary key, and each batch starts after the last row of the
+// previous one. The statement with ORDER BY or LIMIT, and the table without primary key,
+// fetch the rows by one query.
+func (tr *triggerRunner) scanRows(
+	ctx context.Context,
+	bh BackgroundExec,
+	tables tree.TableExprs,
+	where *tree.Where,
+	with *tree.With,
+	orderBy tree.OrderBy,
+	limit *tree.Limit,
+	exprs tree.SelectExprs,
+	fn func(vals []*triggerValue) error,
+) error {
+	clause := &tree.SelectClause{
+		Exprs: append(tr.rowSelectExprs(), exprs...),
+		From:  &tree.From{Tables: tables},
+		Where: where,
+	}
+	query := &tree.Select{
+		Select:  clause,
+		OrderBy: orderBy,
+		Limit:   limit,
+		With:    with,
+	}
+	if len(tr.keys) == 0 || len(orderBy) > 0 || limit != nil {
+		rows, err := tr.fetchRows(ctx, bh, query)
+		if err != nil {
+			return err
+		}
+		for _, vals := range rows {
+			if err = fn(vals); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	query.OrderBy = make(tree.OrderBy, len(tr.keys))
+	for i, col := range tr.keys {
+		query.OrderBy[i] = &tree.Order{Expr: tree.SetUnresolvedName(col.Name), Direction: tree.Ascending}
+	}
+	query.Limit = &tree.Limit{Count: tree.NewNumValWithType(constant.MakeInt64(triggerBatchSize), strconv.Itoa(triggerBatchSize), false, tree.P_int64)}
+	fetchCtx := ctx
+	for {
+		rows, err := tr.fetchRows(fetchCtx, bh, query)
+		if err != nil {
+			return err
+		}
+		for _, vals := range rows {
+			if err = fn(vals); err != nil {
+				return err
+			}
+		}
+		if len(rows) < triggerBatchSize {
+			return nil
+		}
+
+		// the next batch starts after the last row, which is bound as the OLD row
+		lastRow, err := tr.bindOldRow(ctx, rows[len(rows)-1])
+		if err != nil {
+			return err
+		}
+		varScope := []map[string]interface{}{lastRow}
+		fetchCtx = rowCtx(ctx, &varScope)
+		filter := tr.afterKeyFilter()
+		if where != nil {
+			filter = tree.NewAndExpr(tree.NewParenExpr(where.Expr), filter)
+		}
+		clause.Where = tree.NewWhere(filter)
+	}
+}
+
+// afterKeyFilter returns the filter of the rows whose primary key is greater than the
+// primary key of the OLD row.
+func (tr *triggerRunner) afterKeyFilter() tree.Expr {
+	var filter tree.Expr
+	for i, col := range tr.keys {
+		var keyFilter tree.Expr
+		for _, prev := range tr.keys[:i] {
+			keyFilter = andFilter(keyFilter, tree.NewComparisonExpr(tree.EQUAL, tree.SetUnresolvedName(prev.Name), tree.SetUnresolvedName(triggerOldRow, prev.Name)))
+		}
+		keyFilter = andFilter(keyFilter, tree.NewComparisonExpr(tree.GREAT_THAN, tree.SetUnresolvedName(col.Name), tree.SetUnresolvedName(triggerOldRow, col.Name)))
+		if filter == nil {
+			filter = keyFilter
+		} else {
+			filter = tree.NewOrExpr(filter, tree.NewParenExpr(keyFilter))
+		}
+	}
+	return tree.NewParenExpr(filter)
+}
+
+// rowKey returns the primary key of the row selected by rowSelectExprs.
+func (tr *triggerRunner) rowKey(vals []*triggerValue) string {
+	return triggerKey(vals[len(tr.cols) : len(tr.cols)+len(tr.keys)])
+}
+
+func triggerKey(vals []*triggerValue) string {
+	var b strings.Builder
+	for _, v := range vals {
+		if v.isNull {
+			b.WriteString("null")
+		} else {
+			b.WriteString(strconv.Quote(v.val))
+		}
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+// fetchRows runs the query and returns the values of the rows.
+func (tr *triggerRunner) fetchRows(ctx context.Context, bh BackgroundExec, query *tree.Select) ([][]*triggerValue, error) {
+	bh.ClearExecResultSet()
+	if err := bh.ExecStmt(ctx, query); err != nil {
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	mrs, ok := erArray[0].(*MysqlResultSet)
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "it is not the type of result set")
+	}
+	rows := make([][]*triggerValue, mrs.GetRowCount())
+	for i := range rows {
+		rows[i] = make([]*triggerValue, mrs.GetColumnCount())
+		for j := range rows[i] {
+			if rows[i][j], err = getTriggerValue(ctx, mrs, uint64(i), uint64(j)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return rows, nil
+}
+
+// triggerValue is the value of the column, it's bound to the row of the trigger as the
+// constant of the column type.
+type triggerValue struct {
+	val    string
+	isNull bool
+}
+
+func (v *triggerValue) toExpr(ctx context.Context, col *plan.ColDef) (*plan.Expr, error) {
+	return plan2.MakePlan2TypedConstExpr(ctx, v.val, v.isNull, col.Typ)
+}
+
+func getTriggerValue(ctx context.Context, mrs *MysqlResultSet, r, c uint64) (*triggerValue, error) {
+	value, err := mrs.GetValue(ctx, r, c)
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case nil:
+		return &triggerValue{isNull: true}, nil
+	case bool:
+		if v {
+			return &triggerValue{val: "1"}, nil
+		}
+		return &triggerValue{val: "0"}, nil
+	case float32:
+		return &triggerValue{val: strconv.FormatFloat(float64(v), 'g', -1, 32)}, nil
+	case float64:
+		return &triggerValue{val: strconv.FormatFloat(v, 'g', -1, 64)}, nil
+	case types.Date:
+		return &triggerValue{val: v.String()}, nil
+	}
+	s, err := mrs.GetString(ctx, r, c)
+	if err != nil {
+		return nil, err
+	}
+	return &triggerValue{val: s}, nil
+}
+
+// getColumnDefaultExpr returns the default value of the column as the expression.
+func getColumnDefaultExpr(ctx context.Context, col *plan.ColDef) (tree.Expr, error) {
+	origin := "null"
+	if col.Default != nil && col.Default.OriginString != "" {
+		origin = col.Default.OriginString
+	}
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, "select "+origin, 1)
+	if err != nil {
+		return nil, err
+	}
+	return stmt.(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr, nil
+}
+
+func rowVarName(row, col string) string {
+	return row + "." + strings.ToLower(col)
+}
+
+func copyTriggerRow(row map[string]interface{}) map[string]interface{} {
+	ret := make(map[string]interface{}, len(row))
+	for k, v := range row {
+		ret[k] = v
+	}
+	return ret
+}
+
+func getColumnByName(cols []*plan.ColDef, name string) *plan.ColDef {
+	for _, col := range cols {
+		if strings.EqualFold(col.Name, name) {
+			return col
+		}
+	}
+	return nil
+}
+
+func containsColumn(cols []*plan.ColDef, col *plan.ColDef) bool {
+	for _, c := range cols {
+		if c == col {
+			return true
+		}
+	}
+	return false
+}
+
+// isTriggerRowVar checks whether the variable is the column of the NEW or OLD row.
+func isTriggerRowVar(name string) bool {
+	name = strings.ToLower(name)
+	return strings.HasPrefix(name, triggerNewRow+".") || strings.HasPrefix(name, triggerOldRow+".")
+}
@@ -0,0 +1,242 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_getDMLTargetTables(t *testing.T) {
+	ctx := context.TODO()
+	cases := []struct {
+		sql    string
+		tables []string
+		single bool
+	}{
+		{sql: "insert into db1.t1 values (1)", tables: []string{"t1"}, single: true},
+		{sql: "insert into t1 select * from t2", tables: []string{"t1"}, single: true},
+		{sql: "replace into t1 values (1)", tables: []string{"t1"}, single: true},
+		{sql: "update t1 set a = 1 where b = 2", tables: []string{"t1"}, single: true},
+		{sql: "update t1 join t2 on t1.a = t2.a set t1.b = t2.b", tables: []string{"t1", "t2"}, single: false},
+		{sql: "delete from t1 where a = 1", tables: []string{"t1"}, single: true},
+		{sql: "delete t1 from t1 join t2 on t1.a = t2.a", tables: []string{"t1"}, single: false},
+		{sql: "select * from t1", tables: nil, single: false},
+	}
+	for _, c := range cases {
+		stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, c.sql, 1)
+		require.NoError(t, err)
+		tables, single := getDMLTargetTables(stmt)
+		var names []string
+		for _, table := range tables {
+			names = append(names, string(table.ObjectName))
+		}
+		require.Equal(t, c.tables, names, c.sql)
+		require.Equal(t, c.single, single, c.sql)
+	}
+}
+
+func Test_getTriggerRunnerSkipped(t *testing.T) {
+	ctx := context.TODO()
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, "insert into t1 values (1)", 1)
+	require.NoError(t, err)
+	withTriggers := &plan.Plan{Plan: &plan.Plan_Query{Query: &plan.Query{
+		Nodes: []*plan.Node{{TableDef: &plan.TableDef{Triggers: []*plan.TriggerDef{{Name: "t1_bi"}}}}},
+	}}}
+	withoutTriggers := &plan.Plan{Plan: &plan.Plan_Query{Query: &plan.Query{
+		Nodes: []*plan.Node{{TableDef: &plan.TableDef{}}},
+	}}}
+	require.True(t, planHasTriggers(withTriggers))
+	require.False(t, planHasTriggers(withoutTriggers))
+
+	// the table has no trigger
+	runner, err := getTriggerRunner(ctx, nil, nil, stmt, withoutTriggers, false)
+	require.NoError(t, err)
+	require.Nil(t, runner)
+
+	// the row of the statement is changed by the runner
+	runner, err = getTriggerRunner(context.WithValue(ctx, defines.SkipTriggerKey{}, true), nil, nil, stmt, withTriggers, false)
+	require.NoError(t, err)
+	require.Nil(t, runner)
+
+	// not a DML
+	stmt, err = parsers.ParseOne(ctx, dialect.MYSQL, "select * from t1", 1)
+	require.NoError(t, err)
+	runner, err = getTriggerRunner(ctx, nil, nil, stmt, withTriggers, false)
+	require.NoError(t, err)
+	require.Nil(t, runner)
+}
+
+func Test_getColumnDefaultExpr(t *testing.T) {
+	ctx := context.TODO()
+	expr, err := getColumnDefaultExpr(ctx, &plan.ColDef{Name: "a"})
+	require.NoError(t, err)
+	require.Equal(t, "null", tree.String(expr, dialect.MYSQL))
+
+	expr, err = getColumnDefaultExpr(ctx, &plan.ColDef{Name: "a", Default: &plan.Default{OriginString: "1 + 2"}})
+	require.NoError(t, err)
+	require.Equal(t, "1 + 2", tree.String(expr, dialect.MYSQL))
+}
+
+func Test_isTriggerRowVar(t *testing.T) {
+	require.True(t, isTriggerRowVar("new.a"))
+	require.True(t, isTriggerRowVar("OLD.a"))
+	require.False(t, isTriggerRowVar("newa"))
+	require.False(t, isTriggerRowVar("a"))
+	require.Equal(t, "new.col", rowVarName(triggerNewRow, "Col"))
+}
+
+func newMrsForTriggerDefiner(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	col := &MysqlColumn{}
+	col.SetName("definer")
+	col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	mrs.AddColumn(col)
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func Test_getTriggerDefiner(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ses := newTestSession(t, ctrl)
+	defer ses.Close()
+	ses.SetTenantInfo(&TenantInfo{
+		Tenant:        "acc1",
+		User:          "u1",
+		DefaultRole:   "r1",
+		TenantID:      10,
+		UserID:        11,
+		DefaultRoleID: 12,
+	})
+	tr := &triggerRunner{ses: ses, dbName: "db1"}
+
+	bh := &backgroundExecTest{}
+	bh.init()
+
+	// the trigger is not recorded
+	bh.sql2result[fmt.Sprintf(getTriggerDefinerFormat, "db1", "t1_bi")] = newMrsForTriggerDefiner(nil)
+	_, err := tr.getTriggerDefiner(ctx, bh, "t1_bi")
+	require.ErrorContains(t, err, "can not find the definer")
+
+	bh.sql2result[fmt.Sprintf(getTriggerDefinerFormat, "db1", "t1_bi")] = newMrsForTriggerDefiner([][]interface{}{{"u2@localhost"}})
+	sql, err := getSqlForPasswordOfUser(ctx, "u2")
+	require.NoError(t, err)
+	// the definer is dropped
+	bh.sql2result[sql] = newMrsForPasswordOfUser(nil)
+	_, err = tr.getTriggerDefiner(ctx, bh, "t1_bi")
+	require.ErrorContains(t, err, "does not exist")
+
+	bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{{21, "", 22}})
+	bh.sql2result[getSqlForRoleNameOfRoleId(22)] = newMrsForTriggerDefiner([][]interface{}{{"r2"}})
+	definer, err := tr.getTriggerDefiner(ctx, bh, "t1_bi")
+	require.NoError(t, err)
+	require.Equal(t, "acc1", definer.GetTenant())
+	require.Equal(t, uint32(10), definer.GetTenantID())
+	require.Equal(t, "u2", definer.GetUser())
+	require.Equal(t, uint32(21), definer.GetUserID())
+	require.Equal(t, "r2", definer.GetDefaultRole())
+	require.Equal(t, uint32(22), definer.GetDefaultRoleID())
+	require.True(t, definer.GetUseSecondaryRole())
+}
+
+func Test_bindPrepareParams(t *testing.T) {
+	ctx := context.TODO()
+	proc := testutil.NewProcess()
+	params := proc.GetVector(types.T_text.ToType())
+	require.NoError(t, vector.AppendBytes(params, []byte("it's"), false, proc.Mp()))
+	require.NoError(t, vector.AppendBytes(params, nil, true, proc.Mp()))
+	require.NoError(t, vector.AppendBytes(params, []byte("3"), false, proc.Mp()))
+	proc.SetPrepareParams(params)
+
+	stmt, err := parsers.ParseOne(ctx, dialect.MYSQL, "insert into t1 (a, b) values (?, ?) on duplicate key update c = ?", 1)
+	require.NoError(t, err)
+	stmt, err = bindPrepareParams(ctx, proc, stmt)
+	require.NoError(t, err)
+	require.Equal(t, "insert into t1 (a, b) values (it's, null) on duplicate key update c = 3", tree.String(stmt, dialect.MYSQL))
+}
+
+func Test_triggerRunnerInit(t *testing.T) {
+	ctx := context.TODO()
+	cols := []*plan.ColDef{
+		{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "b", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "c", Typ: &plan.Type{Id: int32(types.T_int64)}},
+	}
+	tr := &triggerRunner{
+		ctx: ctx,
+		tableDef: &plan.TableDef{
+			Name:          "t1",
+			Cols:          cols,
+			Name2ColIndex: map[string]int32{"a": 0, "b": 1, "c": 2},
+			Pkey:          &plan.PrimaryKeyDef{Names: []string{"a"}, PkeyColName: "a"},
+			Indexes:       []*plan.IndexDef{{Unique: true, Parts: []string{"c", "b"}}},
+			Triggers: []*plan.TriggerDef{
+				{Name: "t1_bi", Timing: tree.TriggerTimeBefore.String(), Event: triggerEventInsert, Body: "set new.b = 1"},
+				{Name: "t1_ad", Timing: tree.TriggerTimeAfter.String(), Event: triggerEventDelete, Body: "set @x = old.a"},
+			},
+		},
+	}
+	require.NoError(t, tr.init())
+	require.Len(t, tr.before[triggerEventInsert], 1)
+	require.Len(t, tr.after[triggerEventDelete], 1)
+	require.Equal(t, []*plan.ColDef{cols[0]}, tr.keys)
+	require.Equal(t, [][]*plan.ColDef{{cols[0]}, {cols[1], cols[2]}}, tr.uniqueKeys)
+	require.Equal(t, "a = old.a", tree.String(tr.rowWhere().Expr, dialect.MYSQL))
+	require.Nil(t, tr.rowLimit())
+
+	// the row of the table without primary key is located by all the columns
+	tr = &triggerRunner{ctx: ctx, tableDef: &plan.TableDef{Name: "t2", Cols: cols[:2]}}
+	require.NoError(t, tr.init())
+	require.Empty(t, tr.keys)
+	require.Empty(t, tr.uniqueKeys)
+	require.Equal(t, "(a = old.a or a is null and old.a is null) and (b = old.b or b is null and old.b is null)",
+		tree.String(tr.rowWhere().Expr, dialect.MYSQL))
+	require.Equal(t, "limit 1", tree.String(tr.rowLimit(), dialect.MYSQL))
+}
+
+func Test_triggerRunnerBatch(t *testing.T) {
+	cols := []*plan.ColDef{
+		{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "b", Typ: &plan.Type{Id: int32(types.T_varchar)}},
+		{Name: "c", Typ: &plan.Type{Id: int32(types.T_int64)}},
+	}
+	tr := &triggerRunner{cols: cols, keys: cols[:1]}
+	require.Equal(t, "(a > old.a)", tree.String(tr.afterKeyFilter(), dialect.MYSQL))
+	tr.keys = cols[:2]
+	require.Equal(t, "(a > old.a or (a = old.a and b > old.b))", tree.String(tr.afterKeyFilter(), dialect.MYSQL))
+
+	// the key follows the columns of the row
+	row := []*triggerValue{{val: "1"}, {val: "x,y"}, {isNull: true}, {val: "1"}, {val: "x,y"}}
+	require.Equal(t, triggerKey(row[3:]), tr.rowKey(row))
+	require.NotEqual(t, triggerKey([]*triggerValue{{val: "1,\"x"}, {val: "y"}}), tr.rowKey(row))
+	require.NotEqual(t, triggerKey([]*triggerValue{{val: "null"}}), triggerKey([]*triggerValue{{isNull: true}}))
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	moruntime "github.com/matrixorigin/matrixone/pkg/common/runtime"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/txn/storage/memorystorage"
@@ -149,6 +150,17 @@ func (th *TxnHandler) NewTxnOperator() (context.Context, TxnOperator, error) {
 		opts = append(opts,
 			client.WithUserTxn())
 	}
+
+	//the background sessions may write the data, they do not read at snapshot_ts
+	if th.ses != nil && !th.ses.IsBackgroundSession() {
+		snapshotOpt, err := th.ses.getSnapshotTxnOption()
+		if err != nil {
+			return nil, nil, err
+		}
+		if snapshotOpt != nil {
+			opts = append(opts, snapshotOpt)
+		}
+	}
 	th.txnOperator, err = th.txnClient.New(
 		txnCtx,
 		th.ses.getLastCommitTS(),
@@ -162,6 +174,20 @@ func (th *TxnHandler) NewTxnOperator() (context.Context, TxnOperator, error) {
 	return txnCtx, th.txnOperator, err
 }
 
+// getSnapshotTxnOption returns the option of the snapshot timestamp in the session variable snapshot_ts,
+// or nil if it is not set
+func (ses *Session) getSnapshotTxnOption() (client.TxnOption, error) {
+	str, ok := ses.GetSysVar("snapshot_ts").(string)
+	if !ok || str == "" {
+		return nil, nil
+	}
+	ts, err := timestamp.ParseTimestamp(str)
+	if err != nil {
+		return nil, err
+	}
+	return client.WithSnapshotTS(ts), nil
+}
+
 func (th *TxnHandler) enableStartStmt(txnId []byte) {
 	th.mu.Lock()
 	defer th.mu.Unlock()
@@ -436,6 +436,10 @@ func getValueFromVector(vec *vector.Vector, ses *Session, expr *plan2.Expr) (int
 		val := vec.GetBytesAt(0)
 		byteJson := types.DecodeJson(val)
 		return byteJson.String(), nil
+	case types.T_array_float32:
+		return types.BytesToArrayToString[float32](vec.GetBytesAt(0)), nil
+	case types.T_array_float64:
+		return types.BytesToArrayToString[float64](vec.GetBytesAt(0)), nil
 	case types.T_uuid:
 		val := vector.MustFixedCol[types.Uuid](vec)[0]
 		return val.ToString(), nil
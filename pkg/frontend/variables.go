@@ -1362,6 +1362,26 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemVariableBoolType("mo_pk_check_by_dn"),
 		Default:           int8(0),
 	},
+	//the snapshot timestamp of the new transactions in the session, like the result of
+	//mo_ctl('cn','GetSnapshot',''). the transactions read the data at the timestamp.
+	//only the read-only statements can be executed when it is set. empty means using the latest timestamp.
+	"snapshot_ts": {
+		Name:              "snapshot_ts",
+		Scope:             ScopeSession,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableStringType("snapshot_ts"),
+		Default:           "",
+	},
+	//the number of the nearest lists of an ivfflat index searched by a query.
+	"probe_limit": {
+		Name:              "probe_limit",
+		Scope:             ScopeBoth,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("probe_limit", 1, 65536, false),
+		Default:           int64(5),
+	},
 	"syspublications": {
 		Name:              "syspublications",
 		Scope:             ScopeBoth,
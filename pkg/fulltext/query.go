@@ -0,0 +1,140 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+)
+
+type Operator int
+
+const (
+	// OpShould terms add to the score of the documents containing them.
+	OpShould Operator = iota
+	// OpMust terms must be present in every matched document.
+	OpMust
+	// OpMustNot terms must not be present in any matched document.
+	OpMustNot
+)
+
+// Term is a word, or a phrase of the words at consecutive positions, of
+// the search string.
+type Term struct {
+	Words []string
+	Op    Operator
+	// Prefix is set if the single word of the term is a prefix, e.g. 'data*'
+	Prefix bool
+}
+
+// ParseQuery parses the search string of MATCH ... AGAINST into terms. In
+// natural language mode, every token of the search string is an optional
+// word. In boolean mode, the words may be prefixed by '+' or '-' and
+// suffixed by '*', and the double quoted words and the words split into
+// more than one token, such as CJK text, are searched as phrases.
+func ParseQuery(parser string, query string, boolean bool) []Term {
+	if !boolean {
+		var terms []Term
+		seen := make(map[string]struct{})
+		for _, token := range Tokenize(parser, query, nil, 0) {
+			if _, ok := seen[token.Word]; ok {
+				continue
+			}
+			seen[token.Word] = struct{}{}
+			terms = append(terms, Term{Words: []string{token.Word}})
+		}
+		return terms
+	}
+
+	var terms []Term
+	seen := make(map[string]struct{})
+	addTerm := func(text string, op Operator, prefix bool) {
+		tokens := Tokenize(parser, text, nil, 0)
+		if len(tokens) == 0 {
+			return
+		}
+		term := Term{Op: op, Prefix: prefix && len(tokens) == 1}
+		for _, token := range tokens {
+			term.Words = append(term.Words, token.Word)
+		}
+		key := term.key()
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		terms = append(terms, term)
+	}
+
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		op := OpShould
+		for i < len(runes) && (runes[i] == '+' || runes[i] == '-') {
+			if runes[i] == '+' {
+				op = OpMust
+			} else {
+				op = OpMustNot
+			}
+			i++
+		}
+		if i >= len(runes) {
+			break
+		}
+
+		switch r := runes[i]; {
+		case r == '"':
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				j++
+			}
+			addTerm(string(runes[i+1:j]), op, false)
+			i = j + 1
+		case unicode.IsSpace(r) || isBooleanOperator(r):
+			// the operators changing the relevance or grouping the words
+			// are ignored
+			i++
+		default:
+			j := i
+			for j < len(runes) && !unicode.IsSpace(runes[j]) && !isBooleanOperator(runes[j]) && runes[j] != '"' {
+				j++
+			}
+			word := string(runes[i:j])
+			prefix := strings.HasSuffix(word, "*")
+			addTerm(strings.TrimRight(word, "*"), op, prefix)
+			i = j
+		}
+	}
+	return terms
+}
+
+func isBooleanOperator(r rune) bool {
+	switch r {
+	case '(', ')', '<', '>', '~', '+':
+		return true
+	}
+	return false
+}
+
+func (t *Term) key() string {
+	var sb strings.Builder
+	sb.WriteByte(byte('0' + t.Op))
+	if t.Prefix {
+		sb.WriteByte('*')
+	}
+	for _, w := range t.Words {
+		sb.WriteByte(' ')
+		sb.WriteString(w)
+	}
+	return sb.String()
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// ParserDefault splits the text into words on whitespace and punctuation,
+	// and the runs of CJK characters, which have no word delimiters, into ngrams.
+	ParserDefault = "default"
+	// ParserNgram splits every word into ngrams.
+	ParserNgram = "ngram"
+
+	// NgramTokenSize is the number of characters of an ngram token.
+	NgramTokenSize = 2
+	// MaxWordLen is the max number of characters of a token, longer words
+	// are not indexed.
+	MaxWordLen = 84
+)
+
+// Token is a word of the text and its position, which is the number of
+// tokens before it.
+type Token struct {
+	Word string
+	Pos  int32
+}
+
+// IsValidParser returns whether name is a supported parser, the empty
+// name is the default parser.
+func IsValidParser(name string) bool {
+	switch strings.ToLower(name) {
+	case "", ParserDefault, ParserNgram:
+		return true
+	}
+	return false
+}
+
+// Tokenize appends the tokens of text to tokens, the positions of the new
+// tokens start from pos.
+func Tokenize(parser string, text string, tokens []Token, pos int32) []Token {
+	ngramAll := strings.EqualFold(parser, ParserNgram)
+
+	var word []rune
+	cjk := false
+	flush := func() {
+		if len(word) == 0 {
+			return
+		}
+		if cjk || ngramAll {
+			tokens, pos = appendNgrams(tokens, pos, word)
+		} else if len(word) <= MaxWordLen {
+			tokens = append(tokens, Token{Word: string(word), Pos: pos})
+			pos++
+		}
+		word = word[:0]
+	}
+
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			if !cjk {
+				flush()
+			}
+			cjk = true
+			word = append(word, r)
+		case isWordChar(r):
+			if cjk {
+				flush()
+			}
+			cjk = false
+			word = append(word, unicode.ToLower(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+func appendNgrams(tokens []Token, pos int32, word []rune) ([]Token, int32) {
+	if len(word) < NgramTokenSize {
+		return append(tokens, Token{Word: string(word), Pos: pos}), pos + 1
+	}
+	for i := 0; i+NgramTokenSize <= len(word); i++ {
+		tokens = append(tokens, Token{Word: string(word[i : i+NgramTokenSize]), Pos: pos})
+		pos++
+	}
+	return tokens, pos
+}
+
+func isWordChar(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
@@ -0,0 +1,83 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fulltext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func words(tokens []Token) []string {
+	ws := make([]string, len(tokens))
+	for i, t := range tokens {
+		ws[i] = t.Word
+	}
+	return ws
+}
+
+func TestTokenize(t *testing.T) {
+	tokens := Tokenize(ParserDefault, "Hello, MatrixOne's world_1!", nil, 0)
+	require.Equal(t, []string{"hello", "matrixone", "s", "world_1"}, words(tokens))
+	for i, token := range tokens {
+		require.Equal(t, int32(i), token.Pos)
+	}
+
+	tokens = Tokenize("", "数据库MatrixOne中文分词 测", nil, 0)
+	require.Equal(t, []string{"数据", "据库", "matrixone", "中文", "文分", "分词", "测"}, words(tokens))
+
+	tokens = Tokenize(ParserNgram, "MySQL 中文", nil, 0)
+	require.Equal(t, []string{"my", "ys", "sq", "ql", "中文"}, words(tokens))
+
+	// the positions continue from pos
+	tokens = Tokenize(ParserDefault, "a b", tokens, 5)
+	require.Equal(t, int32(5), tokens[5].Pos)
+	require.Equal(t, int32(6), tokens[6].Pos)
+
+	require.Empty(t, Tokenize(ParserDefault, strings.Repeat("x", MaxWordLen+1), nil, 0))
+	require.Empty(t, Tokenize(ParserDefault, " ,.;", nil, 0))
+
+	require.True(t, IsValidParser("NGRAM"))
+	require.True(t, IsValidParser(""))
+	require.False(t, IsValidParser("mecab"))
+}
+
+func TestParseQuery(t *testing.T) {
+	terms := ParseQuery(ParserDefault, "Database, database systems", false)
+	require.Equal(t, []Term{
+		{Words: []string{"database"}},
+		{Words: []string{"systems"}},
+	}, terms)
+
+	terms = ParseQuery(ParserDefault, `+mysql -oracle data* "full text" ~tuning (index)`, true)
+	require.Equal(t, []Term{
+		{Words: []string{"mysql"}, Op: OpMust},
+		{Words: []string{"oracle"}, Op: OpMustNot},
+		{Words: []string{"data"}, Prefix: true},
+		{Words: []string{"full", "text"}},
+		{Words: []string{"tuning"}},
+		{Words: []string{"index"}},
+	}, terms)
+
+	// the CJK words are searched as phrases of ngrams
+	terms = ParseQuery(ParserDefault, "+中文分词 数据", true)
+	require.Equal(t, []Term{
+		{Words: []string{"中文", "文分", "分词"}, Op: OpMust},
+		{Words: []string{"数据"}},
+	}, terms)
+
+	require.Empty(t, ParseQuery(ParserDefault, `+ - "" *`, true))
+}
@@ -0,0 +1,79 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ivfflat
+
+import (
+	"context"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams(t *testing.T) {
+	ctx := context.TODO()
+	p, err := NewParams(ctx, 0, "")
+	require.NoError(t, err)
+	require.Equal(t, Params{Lists: DefaultLists, OpType: OpTypeL2}, p)
+	require.Equal(t, "l2_distance", p.DistanceFunc())
+
+	p, err = NewParams(ctx, 4, "VECTOR_COSINE_OPS")
+	require.NoError(t, err)
+	require.Equal(t, "cosine_distance", p.DistanceFunc())
+
+	parsed, err := ParseParams(ctx, p.String())
+	require.NoError(t, err)
+	require.Equal(t, p, parsed)
+
+	_, err = NewParams(ctx, -1, "")
+	require.Error(t, err)
+	_, err = NewParams(ctx, 4, "vector_hamming_ops")
+	require.Error(t, err)
+	_, err = ParseParams(ctx, "lists=4")
+	require.Error(t, err)
+}
+
+func TestKMeans(t *testing.T) {
+	var vecs [][]float64
+	for _, center := range [][]float64{{0, 0}, {10, 10}, {-10, 10}} {
+		for _, delta := range [][]float64{{0, 0}, {0.5, 0}, {0, 0.5}, {-0.5, 0}} {
+			vecs = append(vecs, []float64{center[0] + delta[0], center[1] + delta[1]})
+		}
+	}
+	centroids := KMeans(vecs, 3, false)
+	require.Equal(t, 3, len(centroids))
+	sort.Slice(centroids, func(i, j int) bool {
+		return centroids[i][0] < centroids[j][0]
+	})
+	expected := [][]float64{{-10, 10.125}, {0, 0.125}, {10, 10.125}}
+	for i := range expected {
+		for j := range expected[i] {
+			require.InDelta(t, expected[i][j], centroids[i][j], 1e-9)
+		}
+	}
+
+	// deterministic
+	require.Equal(t, KMeans(vecs, 3, false), KMeans(vecs, 3, false))
+
+	// no more centroids than distinct vectors
+	require.Equal(t, 1, len(KMeans([][]float64{{1, 1}, {1, 1}}, 4, false)))
+	require.Nil(t, KMeans(nil, 4, false))
+
+	// the centroids are normalized for the cosine distance
+	for _, c := range KMeans(vecs[4:], 2, true) {
+		require.InDelta(t, 1, math.Hypot(c[0], c[1]), 1e-9)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ivfflat
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/matrixorigin/matrixone/pkg/vectorize/moarray"
+)
+
+const (
+	kmeansMaxIterations = 16
+	kmeansSeed          = 1
+)
+
+// KMeans clusters the vectors into at most k centroids, which are seeded by
+// k-means++ and refined by Lloyd's iterations. If spherical is set, the vectors
+// and the centroids are normalized, so the vectors are clustered by the cosine
+// distance. The result is deterministic for the same input.
+func KMeans(vecs [][]float64, k int, spherical bool) [][]float64 {
+	if len(vecs) == 0 || k <= 0 {
+		return nil
+	}
+	if spherical {
+		normalized := make([][]float64, len(vecs))
+		for i, v := range vecs {
+			normalized[i] = moarray.NormalizeL2[float64](v)
+		}
+		vecs = normalized
+	}
+
+	centroids := seedCentroids(vecs, k)
+	assignments := make([]int, len(vecs))
+	for i := range assignments {
+		assignments[i] = -1
+	}
+	dim := len(vecs[0])
+	for iter := 0; iter < kmeansMaxIterations; iter++ {
+		changed := false
+		for i, v := range vecs {
+			c, _ := nearestCentroid(v, centroids)
+			if c != assignments[i] {
+				assignments[i] = c
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		sums := make([][]float64, len(centroids))
+		counts := make([]int, len(centroids))
+		for i := range sums {
+			sums[i] = make([]float64, dim)
+		}
+		for i, v := range vecs {
+			c := assignments[i]
+			counts[c]++
+			for j, f := range v {
+				sums[c][j] += f
+			}
+		}
+		for i := range centroids {
+			// an empty cluster keeps its centroid
+			if counts[i] == 0 {
+				continue
+			}
+			for j := range sums[i] {
+				sums[i][j] /= float64(counts[i])
+			}
+			if spherical {
+				sums[i] = moarray.NormalizeL2[float64](sums[i])
+			}
+			centroids[i] = sums[i]
+		}
+	}
+	return centroids
+}
+
+// seedCentroids picks k distinct vectors by k-means++, the probability of a vector
+// to be picked is proportional to the squared distance to its nearest centroid.
+func seedCentroids(vecs [][]float64, k int) [][]float64 {
+	r := rand.New(rand.NewSource(kmeansSeed))
+	centroids := make([][]float64, 0, k)
+	centroids = append(centroids, vecs[r.Intn(len(vecs))])
+
+	dists := make([]float64, len(vecs))
+	for i := range dists {
+		dists[i] = math.MaxFloat64
+	}
+	for len(centroids) < k {
+		last := centroids[len(centroids)-1]
+		var sum float64
+		for i, v := range vecs {
+			if d := squaredL2(v, last); d < dists[i] {
+				dists[i] = d
+			}
+			sum += dists[i]
+		}
+		// the rest of the vectors are the same as the centroids
+		if sum == 0 {
+			break
+		}
+		target := r.Float64() * sum
+		picked := len(vecs) - 1
+		for i, d := range dists {
+			target -= d
+			if target < 0 && d > 0 {
+				picked = i
+				break
+			}
+		}
+		centroids = append(centroids, vecs[picked])
+	}
+	return centroids
+}
+
+func nearestCentroid(v []float64, centroids [][]float64) (int, float64) {
+	nearest, minDist := 0, math.MaxFloat64
+	for i, c := range centroids {
+		if d := squaredL2(v, c); d < minDist {
+			nearest, minDist = i, d
+		}
+	}
+	return nearest, minDist
+}
+
+func squaredL2(v1, v2 []float64) float64 {
+	var sum float64
+	for i := range v1 {
+		diff := v1[i] - v2[i]
+		sum += diff * diff
+	}
+	return sum
+}
@@ -0,0 +1,91 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ivfflat
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// DefaultLists is the number of lists of an ivfflat index if it's not given.
+	DefaultLists = 16
+	// MaxLists is the max number of lists of an ivfflat index.
+	MaxLists = 65536
+
+	// DefaultProbeLimit is the number of the nearest lists searched by a query
+	// if the probe_limit variable is not set.
+	DefaultProbeLimit = 5
+
+	OpTypeL2     = "vector_l2_ops"
+	OpTypeCosine = "vector_cosine_ops"
+
+	// UnassignedCentroidId is the centroid id of the rows inserted before the
+	// index has any centroid, these rows are always searched.
+	UnassignedCentroidId = 0
+)
+
+// Params are the parameters of an ivfflat index, which are saved as the
+// IndexAlgoParams of the index in json.
+type Params struct {
+	Lists  int64  `json:"lists"`
+	OpType string `json:"op_type"`
+}
+
+// NewParams checks the options of CREATE INDEX ... USING IVFFLAT, a zero
+// lists or an empty op type means the default one.
+func NewParams(ctx context.Context, lists int64, opType string) (Params, error) {
+	if lists == 0 {
+		lists = DefaultLists
+	}
+	if lists < 0 || lists > MaxLists {
+		return Params{}, moerr.NewInvalidInput(ctx, "lists of ivfflat index should be in [1, %d], got %d", MaxLists, lists)
+	}
+	opType = strings.ToLower(opType)
+	switch opType {
+	case "":
+		opType = OpTypeL2
+	case OpTypeL2, OpTypeCosine:
+	default:
+		return Params{}, moerr.NewNotSupported(ctx, "op_type '%s' of ivfflat index", opType)
+	}
+	return Params{Lists: lists, OpType: opType}, nil
+}
+
+// ParseParams parses the IndexAlgoParams of an ivfflat index.
+func ParseParams(ctx context.Context, s string) (Params, error) {
+	var p Params
+	if err := json.Unmarshal([]byte(s), &p); err != nil {
+		return Params{}, moerr.NewInternalError(ctx, "invalid parameters of ivfflat index: %s", s)
+	}
+	return p, nil
+}
+
+func (p Params) String() string {
+	b, _ := json.Marshal(p)
+	return string(b)
+}
+
+// DistanceFunc returns the name of the distance function which the index
+// is built on.
+func (p Params) DistanceFunc() string {
+	if p.OpType == OpTypeCosine {
+		return "cosine_distance"
+	}
+	return "l2_distance"
+}
@@ -41,9 +41,8 @@ func constructorFactory(size int64, algo uint8) CacheConstructor {
 			return cacheData, nil
 		}
 
-		// lz4 compress
 		decompressed := allocator.Alloc(int(size))
-		bs, err := compress.Decompress(data, decompressed.Bytes(), compress.Lz4)
+		bs, err := compress.Decompress(data, decompressed.Bytes(), int(algo))
 		if err != nil {
 			return
 		}
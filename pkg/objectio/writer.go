@@ -23,7 +23,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/pierrec/lz4/v4"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -45,6 +44,10 @@ type objectWriterV1 struct {
 	name              ObjectName
 	compressBuf       []byte
 	bloomFilter       []byte
+
+	// compression of the column data, the metadata is always lz4 compressed
+	compressAlg   compress.T
+	compressLevel int
 }
 
 type blockData struct {
@@ -87,6 +90,8 @@ func newObjectWriterSpecialV1(wt WriterType, fileName string, fs fileservice.Fil
 		buffer:   NewObjectBuffer(fileName),
 		blocks:   make([][]blockData, 2),
 		lastId:   0,
+
+		compressAlg: compress.Lz4,
 	}
 	writer.blocks[SchemaData] = make([]blockData, 0)
 	writer.blocks[SchemaTombstone] = make([]blockData, 0)
@@ -105,6 +110,8 @@ func newObjectWriterV1(name ObjectName, fs fileservice.FileService, schemaVersio
 		buffer:    NewObjectBuffer(fileName),
 		blocks:    make([][]blockData, 2),
 		lastId:    0,
+
+		compressAlg: compress.Lz4,
 	}
 	writer.blocks[SchemaData] = make([]blockData, 0)
 	writer.blocks[SchemaTombstone] = make([]blockData, 0)
@@ -490,20 +497,38 @@ func (w *objectWriterV1) Sync(ctx context.Context, items ...WriteOptions) error
 	return err
 }
 
+// SetCompression sets the algorithm and the level used to compress the
+// column data. The algorithm is recorded in the extent of every column, so
+// readers decode it transparently.
+func (w *objectWriterV1) SetCompression(alg compress.T, level int) {
+	w.compressAlg = alg
+	w.compressLevel = level
+}
+
 func (w *objectWriterV1) WriteWithCompress(offset uint32, buf []byte) (data []byte, extent Extent, err error) {
+	return w.writeWithCompress(offset, buf, compress.Lz4, 0)
+}
+
+func (w *objectWriterV1) writeWithCompress(offset uint32, buf []byte, alg compress.T, level int) (data []byte, extent Extent, err error) {
 	var tmpData []byte
 	dataLen := len(buf)
-	compressBlockBound := lz4.CompressBlockBound(dataLen)
+	if alg == compress.None {
+		data = make([]byte, dataLen)
+		copy(data, buf)
+		extent = NewExtent(compress.None, offset, uint32(dataLen), uint32(dataLen))
+		return
+	}
+	compressBlockBound := compress.CompressBound(int(alg), dataLen)
 	if len(w.compressBuf) < compressBlockBound {
 		w.compressBuf = make([]byte, compressBlockBound)
 	}
-	if tmpData, err = compress.Compress(buf, w.compressBuf[:compressBlockBound], compress.Lz4); err != nil {
+	if tmpData, err = compress.CompressLevel(buf, w.compressBuf[:compressBlockBound], int(alg), level); err != nil {
 		return
 	}
 	length := uint32(len(tmpData))
 	data = make([]byte, length)
 	copy(data, tmpData[:length])
-	extent = NewExtent(compress.Lz4, offset, length, uint32(dataLen))
+	extent = NewExtent(uint8(alg), offset, length, uint32(dataLen))
 	return
 }
 
@@ -534,7 +559,7 @@ func (w *objectWriterV1) addBlock(blocks *[]blockData, blockMeta BlockObject, ba
 			return err
 		}
 		var ext Extent
-		if data, ext, err = w.WriteWithCompress(0, buf.Bytes()); err != nil {
+		if data, ext, err = w.writeWithCompress(0, buf.Bytes(), w.compressAlg, w.compressLevel); err != nil {
 			return err
 		}
 		block.data = append(block.data, data)
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -320,6 +321,52 @@ func TestNewObjectReader(t *testing.T) {
 	assert.Equal(t, uint32(1), meta.BlockCount())
 }
 
+func TestObjectWriterCompression(t *testing.T) {
+	ctx := context.Background()
+
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	assert.Nil(t, err)
+
+	for _, alg := range []compress.T{compress.None, compress.Lz4, compress.Zstd, compress.Snappy} {
+		name := fmt.Sprintf("%s.blk", alg)
+		objectWriter, err := NewObjectWriterSpecial(WriterNormal, name, service)
+		assert.Nil(t, err)
+		objectWriter.SetCompression(alg, 0)
+		_, err = objectWriter.Write(bat)
+		assert.Nil(t, err)
+		blocks, err := objectWriter.WriteEnd(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(blocks))
+
+		objectReader, _ := NewObjectReaderWithStr(name, service)
+		ext := blocks[0].BlockHeader().MetaLocation()
+		objectReader.CacheMetaExtent(&ext)
+		idxs := []uint16{0, 3}
+		typs := []types.Type{types.T_int8.ToType(), types.T_int64.ToType()}
+		vec, err := objectReader.ReadOneBlock(ctx, idxs, typs, 0, mp)
+		assert.Nil(t, err)
+		for i, idx := range idxs {
+			assert.Equal(t, uint8(alg), blocks[0].MustGetColumn(idx).Location().Alg())
+			obj, err := Decode(vec.Entries[i].CachedData.Bytes())
+			assert.Nil(t, err)
+			v := obj.(*vector.Vector)
+			assert.Equal(t, bat.Vecs[idx].Length(), v.Length())
+			assert.Equal(t, bat.Vecs[idx].String(), v.String())
+		}
+		vec.Release()
+	}
+}
+
 func newBatch(mp *mpool.MPool) *batch.Batch {
 	types := []types.Type{
 		types.T_int8.ToType(),
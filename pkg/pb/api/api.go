@@ -39,6 +39,17 @@ func NewUpdateCommentReq(did, tid uint64, comment string) *AlterTableReq {
 	}
 }
 
+func NewUpdatePartitionReq(did, tid uint64, partition string) *AlterTableReq {
+	return &AlterTableReq{
+		DbId:    did,
+		TableId: tid,
+		Kind:    AlterKind_UpdatePartition,
+		Operation: &AlterTableReq_UpdatePartition{
+			&AlterTablePartition{Partition: partition},
+		},
+	}
+}
+
 func NewRenameTableReq(did, tid uint64, old, new string) *AlterTableReq {
 	return &AlterTableReq{
 		DbId:    did,
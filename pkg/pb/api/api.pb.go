@@ -63,6 +63,7 @@ const (
 	AlterKind_RenameTable      AlterKind = 3
 	AlterKind_UpdateComment    AlterKind = 4
 	AlterKind_UpdateConstraint AlterKind = 5
+	AlterKind_UpdatePartition  AlterKind = 6
 )
 
 var AlterKind_name = map[int32]string{
@@ -72,6 +73,7 @@ var AlterKind_name = map[int32]string{
 	3: "RenameTable",
 	4: "UpdateComment",
 	5: "UpdateConstraint",
+	6: "UpdatePartition",
 }
 
 var AlterKind_value = map[string]int32{
@@ -81,6 +83,7 @@ var AlterKind_value = map[string]int32{
 	"RenameTable":      3,
 	"UpdateComment":    4,
 	"UpdateConstraint": 5,
+	"UpdatePartition":  6,
 }
 
 func (x AlterKind) String() string {
@@ -912,6 +915,53 @@ func (m *AlterTableComment) GetComment() string {
 	return ""
 }
 
+type AlterTablePartition struct {
+	Partition            string   `protobuf:"bytes,1,opt,name=partition,proto3" json:"partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterTablePartition) Reset()         { *m = AlterTablePartition{} }
+func (m *AlterTablePartition) String() string { return proto.CompactTextString(m) }
+func (*AlterTablePartition) ProtoMessage()    {}
+func (*AlterTablePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+}
+func (m *AlterTablePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterTablePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterTablePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterTablePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterTablePartition.Merge(m, src)
+}
+func (m *AlterTablePartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterTablePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterTablePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterTablePartition proto.InternalMessageInfo
+
+func (m *AlterTablePartition) GetPartition() string {
+	if m != nil {
+		return m.Partition
+	}
+	return ""
+}
+
 type AlterTableRenameTable struct {
 	OldName              string   `protobuf:"bytes,1,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
 	NewName              string   `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
@@ -924,7 +974,7 @@ func (m *AlterTableRenameTable) Reset()         { *m = AlterTableRenameTable{} }
 func (m *AlterTableRenameTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTableRenameTable) ProtoMessage()    {}
 func (*AlterTableRenameTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{12}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
 }
 func (m *AlterTableRenameTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -979,7 +1029,7 @@ func (m *AlterTableAddColumn) Reset()         { *m = AlterTableAddColumn{} }
 func (m *AlterTableAddColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddColumn) ProtoMessage()    {}
 func (*AlterTableAddColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{13}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
 }
 func (m *AlterTableAddColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1034,7 +1084,7 @@ func (m *AlterTableDropColumn) Reset()         { *m = AlterTableDropColumn{} }
 func (m *AlterTableDropColumn) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropColumn) ProtoMessage()    {}
 func (*AlterTableDropColumn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{14}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
 }
 func (m *AlterTableDropColumn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,6 +1137,7 @@ type AlterTableReq struct {
 	//	*AlterTableReq_RenameTable
 	//	*AlterTableReq_UpdateComment
 	//	*AlterTableReq_UpdateCstr
+	//	*AlterTableReq_UpdatePartition
 	Operation            isAlterTableReq_Operation `protobuf_oneof:"operation"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -1097,7 +1148,7 @@ func (m *AlterTableReq) Reset()         { *m = AlterTableReq{} }
 func (m *AlterTableReq) String() string { return proto.CompactTextString(m) }
 func (*AlterTableReq) ProtoMessage()    {}
 func (*AlterTableReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{15}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
 }
 func (m *AlterTableReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1147,12 +1198,16 @@ type AlterTableReq_UpdateComment struct {
 type AlterTableReq_UpdateCstr struct {
 	UpdateCstr *AlterTableConstraint `protobuf:"bytes,8,opt,name=update_cstr,json=updateCstr,proto3,oneof" json:"update_cstr,omitempty"`
 }
+type AlterTableReq_UpdatePartition struct {
+	UpdatePartition *AlterTablePartition `protobuf:"bytes,9,opt,name=update_partition,json=updatePartition,proto3,oneof" json:"update_partition,omitempty"`
+}
 
-func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()     {}
-func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()    {}
-func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()   {}
-func (*AlterTableReq_UpdateComment) isAlterTableReq_Operation() {}
-func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()    {}
+func (*AlterTableReq_AddColumn) isAlterTableReq_Operation()       {}
+func (*AlterTableReq_DropColumn) isAlterTableReq_Operation()      {}
+func (*AlterTableReq_RenameTable) isAlterTableReq_Operation()     {}
+func (*AlterTableReq_UpdateComment) isAlterTableReq_Operation()   {}
+func (*AlterTableReq_UpdateCstr) isAlterTableReq_Operation()      {}
+func (*AlterTableReq_UpdatePartition) isAlterTableReq_Operation() {}
 
 func (m *AlterTableReq) GetOperation() isAlterTableReq_Operation {
 	if m != nil {
@@ -1217,6 +1272,13 @@ func (m *AlterTableReq) GetUpdateCstr() *AlterTableConstraint {
 	return nil
 }
 
+func (m *AlterTableReq) GetUpdatePartition() *AlterTablePartition {
+	if x, ok := m.GetOperation().(*AlterTableReq_UpdatePartition); ok {
+		return x.UpdatePartition
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1225,6 +1287,7 @@ func (*AlterTableReq) XXX_OneofWrappers() []interface{} {
 		(*AlterTableReq_RenameTable)(nil),
 		(*AlterTableReq_UpdateComment)(nil),
 		(*AlterTableReq_UpdateCstr)(nil),
+		(*AlterTableReq_UpdatePartition)(nil),
 	}
 }
 
@@ -1244,7 +1307,7 @@ func (m *SchemaExtra) Reset()         { *m = SchemaExtra{} }
 func (m *SchemaExtra) String() string { return proto.CompactTextString(m) }
 func (*SchemaExtra) ProtoMessage()    {}
 func (*SchemaExtra) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{16}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
 }
 func (m *SchemaExtra) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1313,7 +1376,7 @@ func (m *Int64Map) Reset()         { *m = Int64Map{} }
 func (m *Int64Map) String() string { return proto.CompactTextString(m) }
 func (*Int64Map) ProtoMessage()    {}
 func (*Int64Map) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
 }
 func (m *Int64Map) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1365,6 +1428,7 @@ func init() {
 	proto.RegisterType((*MetadataCkp)(nil), "api.MetadataCkp")
 	proto.RegisterType((*AlterTableConstraint)(nil), "api.AlterTableConstraint")
 	proto.RegisterType((*AlterTableComment)(nil), "api.AlterTableComment")
+	proto.RegisterType((*AlterTablePartition)(nil), "api.AlterTablePartition")
 	proto.RegisterType((*AlterTableRenameTable)(nil), "api.AlterTableRenameTable")
 	proto.RegisterType((*AlterTableAddColumn)(nil), "api.AlterTableAddColumn")
 	proto.RegisterType((*AlterTableDropColumn)(nil), "api.AlterTableDropColumn")
@@ -1377,91 +1441,93 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 1332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x12, 0x17, 0x45, 0xfd, 0x1d, 0x4a, 0x32, 0xbd, 0xf1, 0x7b, 0x50, 0xfc, 0x5e, 0x1d, 0x95, 0x69,
-	0x53, 0x37, 0x6d, 0x6c, 0xc0, 0x09, 0x82, 0x34, 0x08, 0x12, 0xc4, 0x72, 0x50, 0x0b, 0x8d, 0x93,
-	0x80, 0x71, 0x12, 0x20, 0x28, 0x40, 0xac, 0xc8, 0x8d, 0xbc, 0x10, 0xb9, 0x5c, 0x93, 0x2b, 0xc7,
-	0xbe, 0xb7, 0x5f, 0xa0, 0xe7, 0x1e, 0x7a, 0x2d, 0xfa, 0x45, 0x7a, 0xec, 0xb1, 0xc7, 0x22, 0xbd,
-	0xb4, 0xdf, 0xa2, 0xd8, 0x59, 0x52, 0x92, 0xd3, 0x36, 0xd7, 0x5c, 0x84, 0x99, 0xdf, 0xfc, 0xd1,
-	0xec, 0xcc, 0x8f, 0xb3, 0x0b, 0x6d, 0x2a, 0xf9, 0x96, 0xcc, 0x52, 0x95, 0x12, 0x9b, 0x4a, 0xbe,
-	0x7e, 0x6d, 0xc2, 0xd5, 0xd1, 0x6c, 0xbc, 0x15, 0xa6, 0xc9, 0xf6, 0x24, 0x9d, 0xa4, 0xdb, 0x68,
-	0x1b, 0xcf, 0x5e, 0xa1, 0x86, 0x0a, 0x4a, 0x26, 0x66, 0x7d, 0x45, 0xf1, 0x84, 0xe5, 0x8a, 0x26,
-	0xb2, 0x00, 0x40, 0xc6, 0x54, 0x18, 0xd9, 0xfb, 0xc9, 0x82, 0xc6, 0x73, 0x16, 0xaa, 0x34, 0x23,
-	0x04, 0x6a, 0x11, 0x55, 0xb4, 0x6f, 0x0d, 0xac, 0xcd, 0x8e, 0x8f, 0x32, 0xd9, 0x80, 0x9a, 0x3a,
-	0x93, 0xac, 0x5f, 0x1d, 0x58, 0x9b, 0xce, 0x0e, 0x6c, 0x61, 0xe4, 0xe1, 0x99, 0x64, 0x3e, 0xe2,
-	0x64, 0x1d, 0x5a, 0x62, 0x16, 0xc7, 0x74, 0x1c, 0xb3, 0xbe, 0x3d, 0xb0, 0x36, 0x5b, 0xfe, 0x5c,
-	0x27, 0x2e, 0xd8, 0x22, 0x97, 0xfd, 0x1a, 0xa6, 0xd3, 0x22, 0xb9, 0x08, 0x2d, 0x9e, 0x07, 0x61,
-	0x2a, 0x72, 0xd5, 0xaf, 0xa3, 0x77, 0x93, 0xe7, 0x43, 0xad, 0x6a, 0xe7, 0x98, 0x89, 0x7e, 0x63,
-	0x60, 0x6d, 0x76, 0x7d, 0x2d, 0xea, 0x72, 0x68, 0xc6, 0x68, 0xbf, 0x69, 0xca, 0xd1, 0xb2, 0x77,
-	0x17, 0xea, 0xbb, 0x54, 0x85, 0x47, 0x64, 0x0d, 0xea, 0x54, 0xa9, 0x2c, 0xef, 0x5b, 0x03, 0x7b,
-	0xb3, 0xed, 0x1b, 0x85, 0x5c, 0x82, 0xda, 0x09, 0x0b, 0xf3, 0x7e, 0x75, 0x60, 0x6f, 0x3a, 0x3b,
-	0xce, 0x96, 0xee, 0x9b, 0x39, 0x9c, 0x8f, 0x06, 0xef, 0x39, 0x34, 0x0f, 0x75, 0x6d, 0xa3, 0x3d,
-	0x72, 0x01, 0xea, 0xd1, 0x38, 0xe0, 0x11, 0x1e, 0xb7, 0xe6, 0xd7, 0xa2, 0xf1, 0x28, 0xd2, 0xa0,
-	0x42, 0xb0, 0x6a, 0x40, 0xa5, 0xc1, 0x0f, 0xa1, 0x23, 0x69, 0xa6, 0xb8, 0xe2, 0xa9, 0xd0, 0x36,
-	0x1b, 0x6d, 0xce, 0x1c, 0x1b, 0x45, 0xde, 0x77, 0x16, 0xf4, 0x9e, 0x9e, 0x89, 0xf0, 0x61, 0x3a,
-	0x39, 0xa4, 0x3c, 0xf6, 0xd9, 0x31, 0xb9, 0x06, 0xcd, 0x50, 0x04, 0x47, 0xf4, 0x84, 0xe1, 0x3f,
-	0x38, 0x3b, 0x6b, 0x5b, 0x8b, 0x39, 0x1c, 0x96, 0x92, 0xdf, 0x08, 0xc5, 0x3e, 0x3d, 0x61, 0x85,
-	0xfb, 0x6b, 0x2a, 0x54, 0xd1, 0xeb, 0x7f, 0x75, 0x7f, 0x41, 0x85, 0x22, 0x1e, 0xd4, 0xd5, 0xbc,
-	0xe9, 0xce, 0x4e, 0x07, 0x8f, 0x5a, 0x1c, 0xcd, 0x37, 0x26, 0xef, 0x6b, 0x58, 0x39, 0x57, 0x53,
-	0x2e, 0xf5, 0x51, 0xc2, 0xa9, 0x0c, 0xe2, 0x34, 0xa4, 0xba, 0x72, 0xac, 0xac, 0xed, 0x3b, 0xe1,
-	0x54, 0x3e, 0x2c, 0x20, 0x72, 0x05, 0x5a, 0x61, 0x9a, 0x24, 0x54, 0x44, 0x65, 0x1f, 0x01, 0x93,
-	0x3f, 0x10, 0x2a, 0x3b, 0xf3, 0xe7, 0x36, 0xef, 0x2e, 0xac, 0x3e, 0xc9, 0x98, 0x56, 0xb9, 0x7a,
-	0x91, 0x71, 0xc5, 0x86, 0x49, 0x44, 0x3e, 0x05, 0x60, 0xda, 0x2f, 0x88, 0x79, 0xae, 0x70, 0x36,
-	0xe7, 0xc3, 0xdb, 0x68, 0x7d, 0xc8, 0x73, 0xe5, 0xfd, 0x5a, 0x85, 0x3a, 0x82, 0xe4, 0x7a, 0x19,
-	0x84, 0x4c, 0xd3, 0x25, 0xf5, 0x76, 0xd6, 0x16, 0x41, 0xe6, 0x17, 0x39, 0x67, 0xc2, 0xb5, 0xa8,
-	0xa9, 0x84, 0xa7, 0x5c, 0x0c, 0xab, 0x89, 0xfa, 0x28, 0x22, 0x97, 0xc0, 0xd1, 0xdc, 0x1d, 0xd3,
-	0x9c, 0x2d, 0xc6, 0x05, 0x25, 0x34, 0x8a, 0xc8, 0x07, 0x00, 0x26, 0x56, 0xd0, 0x84, 0x21, 0x3f,
-	0xdb, 0x7e, 0x1b, 0x91, 0x47, 0x34, 0x61, 0xe4, 0x32, 0x74, 0xe7, 0xf1, 0xe8, 0x51, 0x47, 0x8f,
-	0x4e, 0x09, 0xa2, 0xd3, 0xff, 0xa0, 0xfd, 0x8a, 0x97, 0x29, 0x1a, 0xe8, 0xd0, 0xd2, 0x00, 0x1a,
-	0xff, 0x0f, 0xf6, 0x98, 0x2a, 0x64, 0x6e, 0x79, 0x7e, 0xa4, 0xad, 0xaf, 0x61, 0x72, 0x19, 0x7a,
-	0x72, 0x1a, 0x84, 0x47, 0x2c, 0x9c, 0x06, 0xe3, 0xb3, 0x20, 0x12, 0xfd, 0xd6, 0xc0, 0xda, 0xac,
-	0xfb, 0x8e, 0x9c, 0x0e, 0x35, 0xb8, 0x7b, 0xb6, 0x27, 0xbc, 0xdb, 0xd0, 0x9e, 0x9f, 0x9b, 0x00,
-	0x34, 0x46, 0x22, 0x67, 0x99, 0x72, 0x2b, 0x5a, 0xde, 0x63, 0x31, 0x53, 0xcc, 0xb5, 0xb4, 0xfc,
-	0x4c, 0x46, 0x54, 0x31, 0xb7, 0x4a, 0xda, 0x50, 0xbf, 0x1f, 0x2b, 0x96, 0xb9, 0xb6, 0xf7, 0x8d,
-	0x05, 0x80, 0x99, 0x64, 0xca, 0x85, 0x22, 0x9f, 0x41, 0x23, 0xe1, 0x22, 0x50, 0xf9, 0x3b, 0x89,
-	0x58, 0x4f, 0xb8, 0x38, 0xcc, 0xd1, 0x99, 0x9e, 0x6a, 0xe7, 0xea, 0x3b, 0x9d, 0xe9, 0xe9, 0x61,
-	0x5e, 0x9e, 0xd3, 0xfe, 0xc7, 0x73, 0x9a, 0x32, 0xa8, 0xa2, 0x71, 0x3a, 0x19, 0x4e, 0xe5, 0x7b,
-	0x2b, 0xe3, 0x5b, 0x0b, 0x9c, 0x03, 0xa6, 0xa8, 0x1e, 0xdf, 0xfb, 0xac, 0xe3, 0x16, 0xac, 0xe1,
-	0x80, 0xf0, 0x2b, 0xc5, 0xa5, 0x97, 0x51, 0x3d, 0x9e, 0x01, 0x38, 0xe1, 0x5c, 0xcb, 0x8b, 0xed,
-	0xbb, 0x0c, 0x79, 0xd7, 0x60, 0x75, 0x39, 0x32, 0x49, 0x98, 0x50, 0xa4, 0x0f, 0xcd, 0xd0, 0x88,
-	0xc5, 0x57, 0x5c, 0xaa, 0xde, 0x01, 0xfc, 0x67, 0xe1, 0xee, 0x33, 0xcd, 0x50, 0x14, 0xf5, 0x37,
-	0x93, 0xc6, 0x91, 0xa1, 0x6c, 0x11, 0x93, 0xc6, 0x11, 0x32, 0xf6, 0x22, 0xb4, 0x04, 0x7b, 0x6d,
-	0x4c, 0x55, 0x63, 0x12, 0xec, 0xb5, 0x36, 0x79, 0x11, 0x5c, 0x58, 0xa4, 0xbb, 0x1f, 0x45, 0xc3,
-	0x34, 0x9e, 0x25, 0x82, 0x7c, 0x04, 0x8d, 0x10, 0xa5, 0xa2, 0x8d, 0x1d, 0x73, 0x37, 0x0c, 0xd3,
-	0x78, 0x8f, 0xbd, 0xf2, 0x0b, 0x1b, 0xf9, 0x04, 0x56, 0x38, 0x32, 0x37, 0x90, 0x69, 0x8e, 0xdb,
-	0x12, 0xd3, 0xd7, 0xfd, 0x9e, 0x81, 0x9f, 0x14, 0xa8, 0xf7, 0x72, 0xb9, 0x3b, 0x7b, 0x59, 0x2a,
-	0x8b, 0xbf, 0xb9, 0x04, 0x4e, 0x9c, 0x4e, 0x78, 0x48, 0xe3, 0x80, 0x47, 0xa7, 0xf8, 0x5f, 0x5d,
-	0x1f, 0x0a, 0x68, 0x14, 0x9d, 0xea, 0x95, 0x96, 0xb3, 0xe3, 0x19, 0x13, 0x21, 0x0b, 0xc4, 0x2c,
-	0xc1, 0xf4, 0x5d, 0xdf, 0x29, 0xb1, 0x47, 0xb3, 0xc4, 0xfb, 0xd1, 0x86, 0xee, 0x72, 0x47, 0x8e,
-	0xcf, 0x6d, 0x0f, 0xeb, 0xfc, 0xf6, 0x98, 0xdf, 0x0b, 0xd5, 0xa5, 0x7b, 0xc1, 0x83, 0xda, 0x94,
-	0x0b, 0xb3, 0x4b, 0x7a, 0x3b, 0x3d, 0x1c, 0x2d, 0x66, 0xfc, 0x8a, 0x8b, 0xc8, 0x47, 0x1b, 0xf9,
-	0x02, 0x80, 0x46, 0x51, 0x50, 0x34, 0xa5, 0x86, 0x4d, 0xe9, 0x2f, 0x3c, 0xcf, 0xb7, 0x6f, 0xbf,
-	0xe2, 0xb7, 0xe9, 0xbc, 0x97, 0x77, 0xc0, 0x89, 0xb2, 0x54, 0x96, 0xb1, 0x75, 0x8c, 0xbd, 0xf8,
-	0x56, 0xec, 0xa2, 0x29, 0xfb, 0x15, 0x1f, 0xa2, 0x45, 0x8b, 0xee, 0x41, 0x27, 0xc3, 0x29, 0x07,
-	0xe6, 0x4a, 0x68, 0x60, 0xf8, 0xfa, 0x5b, 0xe1, 0x4b, 0x44, 0xd8, 0xaf, 0xf8, 0x4e, 0xb6, 0xc4,
-	0x8b, 0x7b, 0xd0, 0x9b, 0xe1, 0x1a, 0x09, 0x4a, 0x46, 0x99, 0xcd, 0xf5, 0xdf, 0xb7, 0x52, 0x14,
-	0xd4, 0xdb, 0xaf, 0xf8, 0x5d, 0xe3, 0x5f, 0x72, 0xf1, 0x0e, 0x38, 0x65, 0x82, 0x5c, 0x65, 0xb8,
-	0xce, 0xfe, 0x5e, 0xff, 0x82, 0xf2, 0xba, 0xfe, 0x22, 0x41, 0xae, 0xb2, 0x5d, 0x07, 0xda, 0xa9,
-	0x64, 0x19, 0x5e, 0x3f, 0xde, 0xf7, 0x16, 0x38, 0x4f, 0xc3, 0x23, 0x96, 0xd0, 0x07, 0xa7, 0x2a,
-	0xa3, 0xe4, 0x0a, 0xac, 0x08, 0x76, 0xaa, 0x74, 0x6b, 0x82, 0x9c, 0x1d, 0xeb, 0x09, 0x1b, 0x0e,
-	0x74, 0x35, 0x3c, 0x4c, 0xe3, 0xa7, 0x08, 0xe2, 0xd2, 0xce, 0x52, 0x29, 0x59, 0x14, 0x98, 0x87,
-	0x41, 0x15, 0x1f, 0x06, 0x9d, 0x02, 0xbc, 0x8f, 0xef, 0x83, 0x8f, 0xa1, 0x67, 0x5a, 0x1c, 0x84,
-	0x47, 0x54, 0x4c, 0x58, 0x54, 0xbc, 0x59, 0xba, 0x06, 0x1d, 0x1a, 0xf0, 0xdc, 0x77, 0x52, 0x3b,
-	0xf7, 0x9d, 0x78, 0x11, 0xb4, 0x46, 0x42, 0xdd, 0xbc, 0x71, 0x40, 0x25, 0xf1, 0xc0, 0x4a, 0x8a,
-	0x3b, 0xce, 0x5c, 0x57, 0xa5, 0x65, 0xeb, 0xc0, 0xdc, 0x76, 0x56, 0xb2, 0x7e, 0x03, 0x1a, 0x46,
-	0xd1, 0x0f, 0x9c, 0x29, 0x3b, 0xc3, 0xe2, 0x6d, 0x5f, 0x8b, 0xfa, 0x0d, 0x73, 0x42, 0xe3, 0x99,
-	0xf9, 0xe0, 0x6c, 0xdf, 0x28, 0xb7, 0xab, 0xb7, 0xac, 0xab, 0x37, 0xa1, 0xf1, 0x58, 0x0e, 0xd3,
-	0x88, 0x91, 0x26, 0xd8, 0x8f, 0x52, 0xe9, 0x56, 0xc8, 0x2a, 0x74, 0x1e, 0xcb, 0x2f, 0x99, 0x2a,
-	0x6e, 0x73, 0xf7, 0x8f, 0x26, 0x71, 0xc1, 0x79, 0x2c, 0x9f, 0x64, 0x38, 0x05, 0xae, 0xdc, 0x3f,
-	0x9b, 0x57, 0x8f, 0xa1, 0x3d, 0x67, 0x25, 0x71, 0xa0, 0x39, 0x12, 0x27, 0x34, 0xe6, 0x91, 0x5b,
-	0x21, 0x5d, 0x68, 0xcf, 0xb9, 0xe7, 0x5a, 0xa4, 0x07, 0xb0, 0xa0, 0x93, 0x5b, 0x25, 0x2b, 0xe0,
-	0x2c, 0xf1, 0xc3, 0xb5, 0xc9, 0x2a, 0x74, 0x9f, 0x2d, 0x8f, 0xd8, 0xad, 0x91, 0x35, 0x70, 0x4b,
-	0xa8, 0x1c, 0xa4, 0x5b, 0xdf, 0xbd, 0xfb, 0xf3, 0x9b, 0x0d, 0xeb, 0x97, 0x37, 0x1b, 0xd6, 0x6f,
-	0x6f, 0x36, 0x2a, 0x3f, 0xfc, 0xbe, 0x61, 0xbd, 0xfc, 0x7c, 0xe9, 0x75, 0x9a, 0x50, 0x95, 0xf1,
-	0xd3, 0x34, 0xe3, 0x13, 0x2e, 0x4a, 0x45, 0xb0, 0x6d, 0x39, 0x9d, 0x6c, 0xcb, 0xf1, 0x36, 0x95,
-	0x7c, 0xdc, 0xc0, 0x67, 0xe8, 0xf5, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x21, 0x83, 0xaa,
-	0xe4, 0x0a, 0x00, 0x00,
+	// 1372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x16, 0xf5, 0xcd, 0xa1, 0x24, 0xd3, 0x1b, 0xbf, 0x2f, 0x18, 0x37, 0x75, 0x54, 0xa6, 0x4d,
+	0xdd, 0xb4, 0xb1, 0x01, 0x27, 0x08, 0xd2, 0xa0, 0x48, 0x10, 0xcb, 0x41, 0x2d, 0x34, 0x4e, 0x0c,
+	0xc6, 0x49, 0x80, 0xa0, 0x00, 0xb1, 0x22, 0x37, 0x32, 0x21, 0x72, 0xb9, 0x26, 0x57, 0x8e, 0x7d,
+	0x6f, 0xfb, 0x03, 0x7a, 0xee, 0xa1, 0xf7, 0xfe, 0x91, 0x1e, 0x7b, 0xec, 0xb1, 0x48, 0x2f, 0xed,
+	0xad, 0x3f, 0xa1, 0xd8, 0x59, 0x52, 0x1f, 0x69, 0x90, 0x6b, 0x2e, 0xc4, 0xcc, 0x33, 0x33, 0xcb,
+	0xd9, 0x99, 0x67, 0x67, 0x17, 0x4c, 0x2a, 0xa2, 0x2d, 0x91, 0xa5, 0x32, 0x25, 0x35, 0x2a, 0xa2,
+	0xf5, 0xeb, 0xe3, 0x48, 0x1e, 0x4f, 0x47, 0x5b, 0x41, 0x9a, 0x6c, 0x8f, 0xd3, 0x71, 0xba, 0x8d,
+	0xb6, 0xd1, 0xf4, 0x25, 0x6a, 0xa8, 0xa0, 0xa4, 0x63, 0xd6, 0x57, 0x64, 0x94, 0xb0, 0x5c, 0xd2,
+	0x44, 0x14, 0x00, 0x88, 0x98, 0x72, 0x2d, 0xbb, 0xbf, 0x18, 0xd0, 0x7c, 0xc6, 0x02, 0x99, 0x66,
+	0x84, 0x40, 0x3d, 0xa4, 0x92, 0x3a, 0x46, 0xdf, 0xd8, 0xec, 0x78, 0x28, 0x93, 0x0d, 0xa8, 0xcb,
+	0x73, 0xc1, 0x9c, 0x6a, 0xdf, 0xd8, 0xb4, 0x76, 0x60, 0x0b, 0x23, 0x8f, 0xce, 0x05, 0xf3, 0x10,
+	0x27, 0xeb, 0xd0, 0xe6, 0xd3, 0x38, 0xa6, 0xa3, 0x98, 0x39, 0xb5, 0xbe, 0xb1, 0xd9, 0xf6, 0x66,
+	0x3a, 0xb1, 0xa1, 0xc6, 0x73, 0xe1, 0xd4, 0x71, 0x39, 0x25, 0x92, 0x8b, 0xd0, 0x8e, 0x72, 0x3f,
+	0x48, 0x79, 0x2e, 0x9d, 0x06, 0x7a, 0xb7, 0xa2, 0x7c, 0xa0, 0x54, 0xe5, 0x1c, 0x33, 0xee, 0x34,
+	0xfb, 0xc6, 0x66, 0xd7, 0x53, 0xa2, 0x4a, 0x87, 0x66, 0x8c, 0x3a, 0x2d, 0x9d, 0x8e, 0x92, 0xdd,
+	0xbb, 0xd0, 0xd8, 0xa5, 0x32, 0x38, 0x26, 0x6b, 0xd0, 0xa0, 0x52, 0x66, 0xb9, 0x63, 0xf4, 0x6b,
+	0x9b, 0xa6, 0xa7, 0x15, 0x72, 0x19, 0xea, 0xa7, 0x2c, 0xc8, 0x9d, 0x6a, 0xbf, 0xb6, 0x69, 0xed,
+	0x58, 0x5b, 0xaa, 0x6e, 0x7a, 0x73, 0x1e, 0x1a, 0xdc, 0x67, 0xd0, 0x3a, 0x52, 0xb9, 0x0d, 0xf7,
+	0xc8, 0x05, 0x68, 0x84, 0x23, 0x3f, 0x0a, 0x71, 0xbb, 0x75, 0xaf, 0x1e, 0x8e, 0x86, 0xa1, 0x02,
+	0x25, 0x82, 0x55, 0x0d, 0x4a, 0x05, 0x7e, 0x04, 0x1d, 0x41, 0x33, 0x19, 0xc9, 0x28, 0xe5, 0xca,
+	0x56, 0x43, 0x9b, 0x35, 0xc3, 0x86, 0xa1, 0xfb, 0xa3, 0x01, 0xbd, 0x27, 0xe7, 0x3c, 0x78, 0x98,
+	0x8e, 0x8f, 0x68, 0x14, 0x7b, 0xec, 0x84, 0x5c, 0x87, 0x56, 0xc0, 0xfd, 0x63, 0x7a, 0xca, 0xf0,
+	0x0f, 0xd6, 0xce, 0xda, 0xd6, 0xbc, 0x0f, 0x47, 0xa5, 0xe4, 0x35, 0x03, 0xbe, 0x4f, 0x4f, 0x59,
+	0xe1, 0xfe, 0x8a, 0x72, 0xe9, 0x54, 0xdf, 0xed, 0xfe, 0x9c, 0x72, 0x49, 0x5c, 0x68, 0xc8, 0x59,
+	0xd1, 0xad, 0x9d, 0x0e, 0x6e, 0xb5, 0xd8, 0x9a, 0xa7, 0x4d, 0xee, 0xb7, 0xb0, 0xb2, 0x94, 0x53,
+	0x2e, 0xd4, 0x56, 0x82, 0x89, 0xf0, 0xe3, 0x34, 0xa0, 0x2a, 0x73, 0xcc, 0xcc, 0xf4, 0xac, 0x60,
+	0x22, 0x1e, 0x16, 0x10, 0xb9, 0x0a, 0xed, 0x20, 0x4d, 0x12, 0xca, 0xc3, 0xb2, 0x8e, 0x80, 0x8b,
+	0x3f, 0xe0, 0x32, 0x3b, 0xf7, 0x66, 0x36, 0xf7, 0x2e, 0xac, 0x1e, 0x66, 0x4c, 0xa9, 0x91, 0x7c,
+	0x9e, 0x45, 0x92, 0x0d, 0x92, 0x90, 0x7c, 0x06, 0xc0, 0x94, 0x9f, 0x1f, 0x47, 0xb9, 0x74, 0x8c,
+	0xff, 0x84, 0x9b, 0x68, 0x7d, 0x18, 0xe5, 0xd2, 0xfd, 0xbd, 0x0a, 0x0d, 0x04, 0xc9, 0x8d, 0x32,
+	0x08, 0x99, 0xa6, 0x52, 0xea, 0xed, 0xac, 0xcd, 0x83, 0xf4, 0x17, 0x39, 0x67, 0xb2, 0x52, 0x54,
+	0x54, 0xc2, 0x5d, 0xce, 0x9b, 0xd5, 0x42, 0x7d, 0x18, 0x92, 0xcb, 0x60, 0x29, 0xee, 0x8e, 0x68,
+	0xce, 0xe6, 0xed, 0x82, 0x12, 0x1a, 0x86, 0xe4, 0x43, 0x00, 0x1d, 0xcb, 0x69, 0xc2, 0x90, 0x9f,
+	0xa6, 0x67, 0x22, 0xf2, 0x88, 0x26, 0x8c, 0x5c, 0x81, 0xee, 0x2c, 0x1e, 0x3d, 0x1a, 0xe8, 0xd1,
+	0x29, 0x41, 0x74, 0xfa, 0x00, 0xcc, 0x97, 0x51, 0xb9, 0x44, 0x13, 0x1d, 0xda, 0x0a, 0x40, 0xe3,
+	0x25, 0xa8, 0x8d, 0xa8, 0x44, 0xe6, 0x96, 0xfb, 0x47, 0xda, 0x7a, 0x0a, 0x26, 0x57, 0xa0, 0x27,
+	0x26, 0x7e, 0x70, 0xcc, 0x82, 0x89, 0x3f, 0x3a, 0xf7, 0x43, 0xee, 0xb4, 0xfb, 0xc6, 0x66, 0xc3,
+	0xb3, 0xc4, 0x64, 0xa0, 0xc0, 0xdd, 0xf3, 0x3d, 0xee, 0xde, 0x01, 0x73, 0xb6, 0x6f, 0x02, 0xd0,
+	0x1c, 0xf2, 0x9c, 0x65, 0xd2, 0xae, 0x28, 0x79, 0x8f, 0xc5, 0x4c, 0x32, 0xdb, 0x50, 0xf2, 0x53,
+	0x11, 0x52, 0xc9, 0xec, 0x2a, 0x31, 0xa1, 0x71, 0x3f, 0x96, 0x2c, 0xb3, 0x6b, 0xee, 0x77, 0x06,
+	0x00, 0xae, 0x24, 0xd2, 0x88, 0x4b, 0xf2, 0x39, 0x34, 0x93, 0x88, 0xfb, 0x32, 0x7f, 0x27, 0x11,
+	0x1b, 0x49, 0xc4, 0x8f, 0x72, 0x74, 0xa6, 0x67, 0xca, 0xb9, 0xfa, 0x4e, 0x67, 0x7a, 0x76, 0x94,
+	0x97, 0xfb, 0xac, 0xbd, 0x75, 0x9f, 0x3a, 0x0d, 0x2a, 0x69, 0x9c, 0x8e, 0x07, 0x13, 0xf1, 0xde,
+	0xd2, 0xf8, 0xde, 0x00, 0xeb, 0x80, 0x49, 0xaa, 0xda, 0xf7, 0x3e, 0xf3, 0xb8, 0x0d, 0x6b, 0xd8,
+	0x20, 0x3c, 0xa5, 0x38, 0xf4, 0x32, 0xaa, 0xda, 0xd3, 0x07, 0x2b, 0x98, 0x69, 0x79, 0x31, 0x7d,
+	0x17, 0x21, 0xf7, 0x3a, 0xac, 0x2e, 0x46, 0x26, 0x09, 0xe3, 0x92, 0x38, 0xd0, 0x0a, 0xb4, 0x58,
+	0x9c, 0xe2, 0x52, 0x75, 0x6f, 0xc0, 0x85, 0xb9, 0xfb, 0x61, 0x39, 0xa5, 0xc8, 0x25, 0x30, 0x67,
+	0x23, 0xab, 0x08, 0x99, 0x03, 0xee, 0x01, 0xfc, 0x6f, 0x1e, 0xe4, 0x31, 0x45, 0x6b, 0x14, 0xd5,
+	0x41, 0x4b, 0xe3, 0x50, 0xf3, 0xbc, 0xf8, 0x51, 0x1a, 0x87, 0x48, 0xf3, 0x8b, 0xd0, 0xe6, 0xec,
+	0x95, 0x36, 0x55, 0xb5, 0x89, 0xb3, 0x57, 0xca, 0xe4, 0x86, 0x8b, 0x39, 0xdc, 0x0f, 0xc3, 0x41,
+	0x1a, 0x4f, 0x13, 0x4e, 0x3e, 0x86, 0x66, 0x80, 0x52, 0x51, 0xfb, 0x8e, 0xbe, 0x50, 0x06, 0x69,
+	0xbc, 0xc7, 0x5e, 0x7a, 0x85, 0x8d, 0x7c, 0x0a, 0x2b, 0x11, 0xd2, 0xdd, 0x17, 0x69, 0xae, 0xf3,
+	0xad, 0xe2, 0x09, 0xe9, 0x69, 0xf8, 0xb0, 0x40, 0xdd, 0x17, 0x8b, 0x25, 0xdd, 0xcb, 0x52, 0x51,
+	0xfc, 0xe6, 0x32, 0x58, 0x71, 0x3a, 0x8e, 0x02, 0x1a, 0xfb, 0x51, 0x78, 0x86, 0xff, 0xea, 0x7a,
+	0x50, 0x40, 0xc3, 0xf0, 0x4c, 0xcd, 0xc1, 0x9c, 0x9d, 0x4c, 0x19, 0x0f, 0x98, 0xcf, 0xa7, 0x09,
+	0x2e, 0xdf, 0xf5, 0xac, 0x12, 0x7b, 0x34, 0x4d, 0xdc, 0x7f, 0x6a, 0xd0, 0x5d, 0xac, 0xc8, 0xc9,
+	0xd2, 0xc8, 0x31, 0x96, 0x47, 0xce, 0xec, 0x32, 0xa9, 0x2e, 0x5c, 0x26, 0x2e, 0xd4, 0x27, 0x11,
+	0xd7, 0x03, 0xa8, 0xb7, 0xd3, 0x43, 0x3e, 0xe0, 0x8a, 0xdf, 0x44, 0x3c, 0xf4, 0xd0, 0x46, 0xbe,
+	0x04, 0xa0, 0x61, 0xe8, 0x17, 0x45, 0xa9, 0x63, 0x51, 0x9c, 0xb9, 0xe7, 0x72, 0xf9, 0xf6, 0x2b,
+	0x9e, 0x49, 0x4b, 0x85, 0x7c, 0x05, 0x56, 0x98, 0xa5, 0xa2, 0x8c, 0x6d, 0x60, 0xec, 0xc5, 0x37,
+	0x62, 0xe7, 0x45, 0xd9, 0xaf, 0x78, 0x10, 0xce, 0x34, 0x72, 0x0f, 0x3a, 0x19, 0x76, 0xd9, 0xd7,
+	0xf7, 0x48, 0x13, 0xc3, 0xd7, 0xdf, 0x08, 0x5f, 0x20, 0xc2, 0x7e, 0xc5, 0xb3, 0xb2, 0xb9, 0x4a,
+	0xee, 0x41, 0x6f, 0x8a, 0xb3, 0xc7, 0x2f, 0x69, 0xa8, 0xc7, 0xdd, 0xff, 0xdf, 0x58, 0xa2, 0xe0,
+	0xeb, 0x7e, 0xc5, 0xeb, 0x6a, 0xff, 0x02, 0x50, 0xf9, 0x97, 0x0b, 0xe4, 0x32, 0x73, 0xda, 0x6f,
+	0xcd, 0x7f, 0x7e, 0x4e, 0x54, 0xfe, 0xc5, 0x02, 0xb9, 0xcc, 0xc8, 0x03, 0xb0, 0x8b, 0xe8, 0x39,
+	0xa9, 0xcd, 0xb7, 0x96, 0x6f, 0x76, 0x02, 0xf6, 0x2b, 0xde, 0x8a, 0x8e, 0x99, 0x41, 0xbb, 0x16,
+	0x98, 0xa9, 0x60, 0x19, 0x5e, 0x7d, 0xee, 0x4f, 0x06, 0x58, 0x4f, 0x82, 0x63, 0x96, 0xd0, 0x07,
+	0x67, 0x32, 0xa3, 0xe4, 0x2a, 0xac, 0x70, 0x76, 0x26, 0x55, 0x85, 0xfd, 0x9c, 0x9d, 0x28, 0xa2,
+	0x68, 0x2a, 0x75, 0x15, 0x3c, 0x48, 0xe3, 0x27, 0x08, 0xe2, 0x85, 0x91, 0xa5, 0x42, 0xb0, 0xd0,
+	0xd7, 0x8f, 0x92, 0x2a, 0x3e, 0x4a, 0x3a, 0x05, 0x78, 0x5f, 0x61, 0xe4, 0x13, 0xe8, 0xe9, 0x4e,
+	0xf9, 0xc1, 0x31, 0xe5, 0x63, 0x16, 0x16, 0xef, 0xa5, 0xae, 0x46, 0x07, 0x1a, 0x5c, 0x3a, 0x6e,
+	0xf5, 0xa5, 0xe3, 0xe6, 0x86, 0xd0, 0x1e, 0x72, 0x79, 0xeb, 0xe6, 0x01, 0x15, 0xc4, 0x05, 0x23,
+	0x29, 0xee, 0x57, 0x7d, 0x55, 0x96, 0x96, 0xad, 0x03, 0x7d, 0xd3, 0x1a, 0xc9, 0xfa, 0x4d, 0x68,
+	0x6a, 0x45, 0x3d, 0xae, 0x26, 0xec, 0x1c, 0x93, 0xaf, 0x79, 0x4a, 0x54, 0xef, 0xa7, 0x53, 0x1a,
+	0x4f, 0xf5, 0xb9, 0xad, 0x79, 0x5a, 0xb9, 0x53, 0xbd, 0x6d, 0x5c, 0xbb, 0x05, 0xcd, 0xc7, 0x62,
+	0x90, 0x86, 0x8c, 0xb4, 0xa0, 0xf6, 0x28, 0x15, 0x76, 0x85, 0xac, 0x42, 0xe7, 0xb1, 0xf8, 0x9a,
+	0xc9, 0xe2, 0x25, 0x61, 0xff, 0xd5, 0x22, 0x36, 0x58, 0x8f, 0xc5, 0x61, 0x86, 0xcd, 0x8c, 0xa4,
+	0xfd, 0x77, 0xeb, 0xda, 0x0f, 0x06, 0x98, 0x33, 0x76, 0x13, 0x0b, 0x5a, 0x43, 0x7e, 0x4a, 0xe3,
+	0x28, 0xb4, 0x2b, 0xa4, 0x0b, 0xe6, 0x8c, 0xc3, 0xb6, 0x41, 0x7a, 0x00, 0x73, 0x5a, 0xda, 0x55,
+	0xb2, 0x02, 0xd6, 0x02, 0xcf, 0xec, 0x1a, 0x59, 0x85, 0xee, 0xd3, 0x45, 0xaa, 0xd8, 0x75, 0xb2,
+	0x06, 0x76, 0x09, 0x95, 0x84, 0xb0, 0x1b, 0xe4, 0x02, 0xac, 0x3c, 0x5d, 0x6e, 0xa8, 0xdd, 0xdc,
+	0xbd, 0xfb, 0xeb, 0xeb, 0x0d, 0xe3, 0xb7, 0xd7, 0x1b, 0xc6, 0x1f, 0xaf, 0x37, 0x2a, 0x3f, 0xff,
+	0xb9, 0x61, 0xbc, 0xf8, 0x62, 0xe1, 0xbd, 0x9c, 0x50, 0x99, 0x45, 0x67, 0x69, 0x16, 0x8d, 0x23,
+	0x5e, 0x2a, 0x9c, 0x6d, 0x8b, 0xc9, 0x78, 0x5b, 0x8c, 0xb6, 0xa9, 0x88, 0x46, 0x4d, 0x7c, 0x18,
+	0xdf, 0xf8, 0x77, 0x00, 0xb1, 0x38, 0x4e, 0x15, 0x76, 0x0b, 0x00, 0x00,
 }
 
 func (m *Vector) Marshal() (dAtA []byte, err error) {
@@ -2130,6 +2196,40 @@ func (m *AlterTableComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AlterTablePartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterTablePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTablePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Partition) > 0 {
+		i -= len(m.Partition)
+		copy(dAtA[i:], m.Partition)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Partition)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterTableRenameTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2408,6 +2508,27 @@ func (m *AlterTableReq_UpdateCstr) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTableReq_UpdatePartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTableReq_UpdatePartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UpdatePartition != nil {
+		{
+			size, err := m.UpdatePartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SchemaExtra) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2809,6 +2930,22 @@ func (m *AlterTableComment) ProtoSize() (n int) {
 	return n
 }
 
+func (m *AlterTablePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Partition)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterTableRenameTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2950,6 +3087,18 @@ func (m *AlterTableReq_UpdateCstr) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTableReq_UpdatePartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdatePartition != nil {
+		l = m.UpdatePartition.ProtoSize()
+		n += 1 + l + sovApi(uint64(l))
+	}
+	return n
+}
 func (m *SchemaExtra) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -4742,6 +4891,89 @@ func (m *AlterTableComment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AlterTablePartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterTablePartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterTablePartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlterTableRenameTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5313,6 +5545,41 @@ func (m *AlterTableReq) Unmarshal(dAtA []byte) error {
 			}
 			m.Operation = &AlterTableReq_UpdateCstr{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatePartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AlterTablePartition{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Operation = &AlterTableReq_UpdatePartition{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
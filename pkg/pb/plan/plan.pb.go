@@ -370,6 +370,40 @@ func (ForeignKeyDef_RefAction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{26, 0}
 }
 
+type AlterPartition_Typ int32
+
+const (
+	AlterPartition_ADD        AlterPartition_Typ = 0
+	AlterPartition_DROP       AlterPartition_Typ = 1
+	AlterPartition_TRUNCATE   AlterPartition_Typ = 2
+	AlterPartition_REORGANIZE AlterPartition_Typ = 3
+	AlterPartition_EXCHANGE   AlterPartition_Typ = 4
+)
+
+var AlterPartition_Typ_name = map[int32]string{
+	0: "ADD",
+	1: "DROP",
+	2: "TRUNCATE",
+	3: "REORGANIZE",
+	4: "EXCHANGE",
+}
+
+var AlterPartition_Typ_value = map[string]int32{
+	"ADD":        0,
+	"DROP":       1,
+	"TRUNCATE":   2,
+	"REORGANIZE": 3,
+	"EXCHANGE":   4,
+}
+
+func (x AlterPartition_Typ) String() string {
+	return proto.EnumName(AlterPartition_Typ_name, int32(x))
+}
+
+func (AlterPartition_Typ) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{38, 0}
+}
+
 type OrderBySpec_OrderByFlag int32
 
 const (
@@ -404,7 +438,7 @@ func (x OrderBySpec_OrderByFlag) String() string {
 }
 
 func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{47, 0}
 }
 
 type FrameClause_FrameType int32
@@ -429,7 +463,7 @@ func (x FrameClause_FrameType) String() string {
 }
 
 func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{49, 0}
 }
 
 type FrameBound_BoundType int32
@@ -457,7 +491,7 @@ func (x FrameBound_BoundType) String() string {
 }
 
 func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{50, 0}
 }
 
 type Node_NodeType int32
@@ -608,21 +642,22 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{55, 0}
 }
 
 type Node_JoinType int32
 
 const (
-	Node_INNER  Node_JoinType = 0
-	Node_LEFT   Node_JoinType = 1
-	Node_RIGHT  Node_JoinType = 2
-	Node_OUTER  Node_JoinType = 3
-	Node_SEMI   Node_JoinType = 4
-	Node_ANTI   Node_JoinType = 5
-	Node_SINGLE Node_JoinType = 6
-	Node_MARK   Node_JoinType = 7
-	Node_APPLY  Node_JoinType = 8
+	Node_INNER       Node_JoinType = 0
+	Node_LEFT        Node_JoinType = 1
+	Node_RIGHT       Node_JoinType = 2
+	Node_OUTER       Node_JoinType = 3
+	Node_SEMI        Node_JoinType = 4
+	Node_ANTI        Node_JoinType = 5
+	Node_SINGLE      Node_JoinType = 6
+	Node_MARK        Node_JoinType = 7
+	Node_APPLY       Node_JoinType = 8
+	Node_OUTER_APPLY Node_JoinType = 9
 )
 
 var Node_JoinType_name = map[int32]string{
@@ -635,18 +670,20 @@ var Node_JoinType_name = map[int32]string{
 	6: "SINGLE",
 	7: "MARK",
 	8: "APPLY",
+	9: "OUTER_APPLY",
 }
 
 var Node_JoinType_value = map[string]int32{
-	"INNER":  0,
-	"LEFT":   1,
-	"RIGHT":  2,
-	"OUTER":  3,
-	"SEMI":   4,
-	"ANTI":   5,
-	"SINGLE": 6,
-	"MARK":   7,
-	"APPLY":  8,
+	"INNER":       0,
+	"LEFT":        1,
+	"RIGHT":       2,
+	"OUTER":       3,
+	"SEMI":        4,
+	"ANTI":        5,
+	"SINGLE":      6,
+	"MARK":        7,
+	"APPLY":       8,
+	"OUTER_APPLY": 9,
 }
 
 func (x Node_JoinType) String() string {
@@ -654,7 +691,7 @@ func (x Node_JoinType) String() string {
 }
 
 func (Node_JoinType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52, 1}
+	return fileDescriptor_2d655ab2f7683c23, []int{55, 1}
 }
 
 type Node_AggMode int32
@@ -682,7 +719,7 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52, 2}
+	return fileDescriptor_2d655ab2f7683c23, []int{55, 2}
 }
 
 type Query_StatementType int32
@@ -722,7 +759,7 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{64, 0}
 }
 
 type TransationControl_TclType int32
@@ -750,7 +787,7 @@ func (x TransationControl_TclType) String() string {
 }
 
 func (TransationControl_TclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{65, 0}
 }
 
 type TransationBegin_TransationMode int32
@@ -778,7 +815,7 @@ func (x TransationBegin_TransationMode) String() string {
 }
 
 func (TransationBegin_TransationMode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{66, 0}
 }
 
 type DataControl_DclType int32
@@ -818,7 +855,7 @@ func (x DataControl_DclType) String() string {
 }
 
 func (DataControl_DclType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{71, 0}
 }
 
 type DataDefinition_DdlType int32
@@ -857,6 +894,8 @@ const (
 	DataDefinition_ALTER_SEQUENCE      DataDefinition_DdlType = 30
 	DataDefinition_DROP_SEQUENCE       DataDefinition_DdlType = 31
 	DataDefinition_SHOW_SEQUENCES      DataDefinition_DdlType = 32
+	DataDefinition_CREATE_TRIGGER      DataDefinition_DdlType = 33
+	DataDefinition_DROP_TRIGGER        DataDefinition_DdlType = 34
 )
 
 var DataDefinition_DdlType_name = map[int32]string{
@@ -893,6 +932,8 @@ var DataDefinition_DdlType_name = map[int32]string{
 	30: "ALTER_SEQUENCE",
 	31: "DROP_SEQUENCE",
 	32: "SHOW_SEQUENCES",
+	33: "CREATE_TRIGGER",
+	34: "DROP_TRIGGER",
 }
 
 var DataDefinition_DdlType_value = map[string]int32{
@@ -929,6 +970,8 @@ var DataDefinition_DdlType_value = map[string]int32{
 	"ALTER_SEQUENCE":      30,
 	"DROP_SEQUENCE":       31,
 	"SHOW_SEQUENCES":      32,
+	"CREATE_TRIGGER":      33,
+	"DROP_TRIGGER":        34,
 }
 
 func (x DataDefinition_DdlType) String() string {
@@ -936,7 +979,7 @@ func (x DataDefinition_DdlType) String() string {
 }
 
 func (DataDefinition_DdlType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{72, 0}
 }
 
 type AlterTableDrop_Typ int32
@@ -947,6 +990,7 @@ const (
 	AlterTableDrop_KEY         AlterTableDrop_Typ = 2
 	AlterTableDrop_PRIMARY_KEY AlterTableDrop_Typ = 3
 	AlterTableDrop_FOREIGN_KEY AlterTableDrop_Typ = 4
+	AlterTableDrop_CHECK       AlterTableDrop_Typ = 5
 )
 
 var AlterTableDrop_Typ_name = map[int32]string{
@@ -955,6 +999,7 @@ var AlterTableDrop_Typ_name = map[int32]string{
 	2: "KEY",
 	3: "PRIMARY_KEY",
 	4: "FOREIGN_KEY",
+	5: "CHECK",
 }
 
 var AlterTableDrop_Typ_value = map[string]int32{
@@ -963,6 +1008,7 @@ var AlterTableDrop_Typ_value = map[string]int32{
 	"KEY":         2,
 	"PRIMARY_KEY": 3,
 	"FOREIGN_KEY": 4,
+	"CHECK":       5,
 }
 
 func (x AlterTableDrop_Typ) String() string {
@@ -970,7 +1016,7 @@ func (x AlterTableDrop_Typ) String() string {
 }
 
 func (AlterTableDrop_Typ) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{79, 0}
 }
 
 type AlterTable_AlgorithmType int32
@@ -1001,7 +1047,7 @@ func (x AlterTable_AlgorithmType) String() string {
 }
 
 func (AlterTable_AlgorithmType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{88, 0}
 }
 
 type MetadataScanInfo_MetadataScanInfoType int32
@@ -1077,7 +1123,7 @@ func (x MetadataScanInfo_MetadataScanInfoType) String() string {
 }
 
 func (MetadataScanInfo_MetadataScanInfoType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{105, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{110, 0}
 }
 
 type Type struct {
@@ -3269,10 +3315,18 @@ type IndexDef struct {
 	Comment        string   `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
 	Visible        bool     `protobuf:"varint,8,opt,name=visible,proto3" json:"visible,omitempty"`
 	// currently not used
-	Option               *IndexOption `protobuf:"bytes,9,opt,name=option,proto3" json:"option,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Option *IndexOption `protobuf:"bytes,9,opt,name=option,proto3" json:"option,omitempty"`
+	// The algorithm of the index, empty for the btree like unique and
+	// secondary indexes
+	IndexAlgo string `protobuf:"bytes,10,opt,name=index_algo,json=indexAlgo,proto3" json:"index_algo,omitempty"`
+	// The parameters of the algorithm, e.g. the parser of a fulltext index
+	IndexAlgoParams string `protobuf:"bytes,11,opt,name=index_algo_params,json=indexAlgoParams,proto3" json:"index_algo_params,omitempty"`
+	// The kind of the hidden table of the index, for the algorithm which
+	// needs more than one hidden table, e.g. the centroids of an ivfflat index
+	IndexAlgoTableType   string   `protobuf:"bytes,12,opt,name=index_algo_table_type,json=indexAlgoTableType,proto3" json:"index_algo_table_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *IndexDef) Reset()         { *m = IndexDef{} }
@@ -3371,6 +3425,27 @@ func (m *IndexDef) GetOption() *IndexOption {
 	return nil
 }
 
+func (m *IndexDef) GetIndexAlgo() string {
+	if m != nil {
+		return m.IndexAlgo
+	}
+	return ""
+}
+
+func (m *IndexDef) GetIndexAlgoParams() string {
+	if m != nil {
+		return m.IndexAlgoParams
+	}
+	return ""
+}
+
+func (m *IndexDef) GetIndexAlgoTableType() string {
+	if m != nil {
+		return m.IndexAlgoTableType
+	}
+	return ""
+}
+
 type ForeignKeyDef struct {
 	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Cols []uint64 `protobuf:"varint,2,rep,packed,name=cols,proto3" json:"cols,omitempty"`
@@ -3463,7 +3538,10 @@ func (m *ForeignKeyDef) GetOnUpdate() ForeignKeyDef_RefAction {
 type CheckDef struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Name for anonymous constraints, __mo_chk_[INDEX_ID]
-	Check                *Expr    `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	Check *Expr `protobuf:"bytes,2,opt,name=check,proto3" json:"check,omitempty"`
+	// the text of the check expression, it's bound again by the DML
+	ExprStr              string   `protobuf:"bytes,3,opt,name=expr_str,json=exprStr,proto3" json:"expr_str,omitempty"`
+	NotEnforced          bool     `protobuf:"varint,4,opt,name=not_enforced,json=notEnforced,proto3" json:"not_enforced,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3516,6 +3594,94 @@ func (m *CheckDef) GetCheck() *Expr {
 	return nil
 }
 
+func (m *CheckDef) GetExprStr() string {
+	if m != nil {
+		return m.ExprStr
+	}
+	return ""
+}
+
+func (m *CheckDef) GetNotEnforced() bool {
+	if m != nil {
+		return m.NotEnforced
+	}
+	return false
+}
+
+type TriggerDef struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// BEFORE or AFTER
+	Timing string `protobuf:"bytes,2,opt,name=timing,proto3" json:"timing,omitempty"`
+	// INSERT, UPDATE or DELETE
+	Event string `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
+	// the text of the trigger body, it's interpreted when the trigger fires
+	Body                 string   `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TriggerDef) Reset()         { *m = TriggerDef{} }
+func (m *TriggerDef) String() string { return proto.CompactTextString(m) }
+func (*TriggerDef) ProtoMessage()    {}
+func (*TriggerDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{28}
+}
+func (m *TriggerDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerDef.Merge(m, src)
+}
+func (m *TriggerDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TriggerDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerDef proto.InternalMessageInfo
+
+func (m *TriggerDef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetTiming() string {
+	if m != nil {
+		return m.Timing
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *TriggerDef) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
 type ClusterByDef struct {
 	// XXX: Deprecated and to be removed soon.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -3530,7 +3696,7 @@ func (m *ClusterByDef) Reset()         { *m = ClusterByDef{} }
 func (m *ClusterByDef) String() string { return proto.CompactTextString(m) }
 func (*ClusterByDef) ProtoMessage()    {}
 func (*ClusterByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{28}
+	return fileDescriptor_2d655ab2f7683c23, []int{29}
 }
 func (m *ClusterByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3585,7 +3751,7 @@ func (m *PropertyDef) Reset()         { *m = PropertyDef{} }
 func (m *PropertyDef) String() string { return proto.CompactTextString(m) }
 func (*PropertyDef) ProtoMessage()    {}
 func (*PropertyDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{29}
+	return fileDescriptor_2d655ab2f7683c23, []int{30}
 }
 func (m *PropertyDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3640,7 +3806,7 @@ func (m *Property) Reset()         { *m = Property{} }
 func (m *Property) String() string { return proto.CompactTextString(m) }
 func (*Property) ProtoMessage()    {}
 func (*Property) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{30}
+	return fileDescriptor_2d655ab2f7683c23, []int{31}
 }
 func (m *Property) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3694,7 +3860,7 @@ func (m *PropertiesDef) Reset()         { *m = PropertiesDef{} }
 func (m *PropertiesDef) String() string { return proto.CompactTextString(m) }
 func (*PropertiesDef) ProtoMessage()    {}
 func (*PropertiesDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{31}
+	return fileDescriptor_2d655ab2f7683c23, []int{32}
 }
 func (m *PropertiesDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3750,7 +3916,7 @@ func (m *PartitionByDef) Reset()         { *m = PartitionByDef{} }
 func (m *PartitionByDef) String() string { return proto.CompactTextString(m) }
 func (*PartitionByDef) ProtoMessage()    {}
 func (*PartitionByDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{32}
+	return fileDescriptor_2d655ab2f7683c23, []int{33}
 }
 func (m *PartitionByDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3861,7 +4027,7 @@ func (m *PartitionExpr) Reset()         { *m = PartitionExpr{} }
 func (m *PartitionExpr) String() string { return proto.CompactTextString(m) }
 func (*PartitionExpr) ProtoMessage()    {}
 func (*PartitionExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{33}
+	return fileDescriptor_2d655ab2f7683c23, []int{34}
 }
 func (m *PartitionExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3916,7 +4082,7 @@ func (m *PartitionColumns) Reset()         { *m = PartitionColumns{} }
 func (m *PartitionColumns) String() string { return proto.CompactTextString(m) }
 func (*PartitionColumns) ProtoMessage()    {}
 func (*PartitionColumns) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{34}
+	return fileDescriptor_2d655ab2f7683c23, []int{35}
 }
 func (m *PartitionColumns) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3976,7 +4142,7 @@ func (m *PartitionItem) Reset()         { *m = PartitionItem{} }
 func (m *PartitionItem) String() string { return proto.CompactTextString(m) }
 func (*PartitionItem) ProtoMessage()    {}
 func (*PartitionItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{35}
+	return fileDescriptor_2d655ab2f7683c23, []int{36}
 }
 func (m *PartitionItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4054,6 +4220,179 @@ func (m *PartitionItem) GetPartitionTableName() string {
 	return ""
 }
 
+type PartitionPrune struct {
+	IsPruned             bool             `protobuf:"varint,1,opt,name=is_pruned,json=isPruned,proto3" json:"is_pruned,omitempty"`
+	SelectedPartitions   []*PartitionItem `protobuf:"bytes,2,rep,name=selected_partitions,json=selectedPartitions,proto3" json:"selected_partitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *PartitionPrune) Reset()         { *m = PartitionPrune{} }
+func (m *PartitionPrune) String() string { return proto.CompactTextString(m) }
+func (*PartitionPrune) ProtoMessage()    {}
+func (*PartitionPrune) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{37}
+}
+func (m *PartitionPrune) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionPrune) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionPrune.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionPrune) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionPrune.Merge(m, src)
+}
+func (m *PartitionPrune) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *PartitionPrune) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionPrune.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionPrune proto.InternalMessageInfo
+
+func (m *PartitionPrune) GetIsPruned() bool {
+	if m != nil {
+		return m.IsPruned
+	}
+	return false
+}
+
+func (m *PartitionPrune) GetSelectedPartitions() []*PartitionItem {
+	if m != nil {
+		return m.SelectedPartitions
+	}
+	return nil
+}
+
+type AlterPartition struct {
+	Typ AlterPartition_Typ `protobuf:"varint,1,opt,name=typ,proto3,enum=plan.AlterPartition_Typ" json:"typ,omitempty"`
+	// the partition info after the alter, it's nil if the partitions are unchanged
+	PartitionDef *PartitionByDef `protobuf:"bytes,2,opt,name=partition_def,json=partitionDef,proto3" json:"partition_def,omitempty"`
+	// the hidden tables of the new partitions
+	CreateTables []*TableDef `protobuf:"bytes,3,rep,name=create_tables,json=createTables,proto3" json:"create_tables,omitempty"`
+	// the hidden tables of the removed partitions
+	DropTableNames []string `protobuf:"bytes,4,rep,name=drop_table_names,json=dropTableNames,proto3" json:"drop_table_names,omitempty"`
+	// the hidden tables of the truncated partitions
+	TruncateTableNames []string `protobuf:"bytes,5,rep,name=truncate_table_names,json=truncateTableNames,proto3" json:"truncate_table_names,omitempty"`
+	// the sql to find a row which doesn't match its partition, the alter fails if it returns any row
+	ValidateSql string `protobuf:"bytes,6,opt,name=validate_sql,json=validateSql,proto3" json:"validate_sql,omitempty"`
+	// the sqls to move the rows between the tables
+	MoveDataSqls []string `protobuf:"bytes,7,rep,name=move_data_sqls,json=moveDataSqls,proto3" json:"move_data_sqls,omitempty"`
+	// the table exchanged with the partition
+	ExchangeDatabase     string   `protobuf:"bytes,8,opt,name=exchange_database,json=exchangeDatabase,proto3" json:"exchange_database,omitempty"`
+	ExchangeTable        string   `protobuf:"bytes,9,opt,name=exchange_table,json=exchangeTable,proto3" json:"exchange_table,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterPartition) Reset()         { *m = AlterPartition{} }
+func (m *AlterPartition) String() string { return proto.CompactTextString(m) }
+func (*AlterPartition) ProtoMessage()    {}
+func (*AlterPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{38}
+}
+func (m *AlterPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AlterPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AlterPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AlterPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterPartition.Merge(m, src)
+}
+func (m *AlterPartition) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *AlterPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterPartition proto.InternalMessageInfo
+
+func (m *AlterPartition) GetTyp() AlterPartition_Typ {
+	if m != nil {
+		return m.Typ
+	}
+	return AlterPartition_ADD
+}
+
+func (m *AlterPartition) GetPartitionDef() *PartitionByDef {
+	if m != nil {
+		return m.PartitionDef
+	}
+	return nil
+}
+
+func (m *AlterPartition) GetCreateTables() []*TableDef {
+	if m != nil {
+		return m.CreateTables
+	}
+	return nil
+}
+
+func (m *AlterPartition) GetDropTableNames() []string {
+	if m != nil {
+		return m.DropTableNames
+	}
+	return nil
+}
+
+func (m *AlterPartition) GetTruncateTableNames() []string {
+	if m != nil {
+		return m.TruncateTableNames
+	}
+	return nil
+}
+
+func (m *AlterPartition) GetValidateSql() string {
+	if m != nil {
+		return m.ValidateSql
+	}
+	return ""
+}
+
+func (m *AlterPartition) GetMoveDataSqls() []string {
+	if m != nil {
+		return m.MoveDataSqls
+	}
+	return nil
+}
+
+func (m *AlterPartition) GetExchangeDatabase() string {
+	if m != nil {
+		return m.ExchangeDatabase
+	}
+	return ""
+}
+
+func (m *AlterPartition) GetExchangeTable() string {
+	if m != nil {
+		return m.ExchangeTable
+	}
+	return ""
+}
+
 type ViewDef struct {
 	View                 string   `protobuf:"bytes,1,opt,name=view,proto3" json:"view,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -4065,7 +4404,7 @@ func (m *ViewDef) Reset()         { *m = ViewDef{} }
 func (m *ViewDef) String() string { return proto.CompactTextString(m) }
 func (*ViewDef) ProtoMessage()    {}
 func (*ViewDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{36}
+	return fileDescriptor_2d655ab2f7683c23, []int{39}
 }
 func (m *ViewDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4115,6 +4454,7 @@ type TableDef struct {
 	Fkeys        []*ForeignKeyDef `protobuf:"bytes,13,rep,name=fkeys,proto3" json:"fkeys,omitempty"`
 	RefChildTbls []uint64         `protobuf:"varint,14,rep,packed,name=ref_child_tbls,json=refChildTbls,proto3" json:"ref_child_tbls,omitempty"`
 	Checks       []*CheckDef      `protobuf:"bytes,15,rep,name=checks,proto3" json:"checks,omitempty"`
+	Triggers     []*TriggerDef    `protobuf:"bytes,16,rep,name=triggers,proto3" json:"triggers,omitempty"`
 	Partition    *PartitionByDef  `protobuf:"bytes,21,opt,name=partition,proto3" json:"partition,omitempty"`
 	ClusterBy    *ClusterByDef    `protobuf:"bytes,22,opt,name=cluster_by,json=clusterBy,proto3" json:"cluster_by,omitempty"`
 	Props        []*PropertyDef   `protobuf:"bytes,23,rep,name=props,proto3" json:"props,omitempty"`
@@ -4135,7 +4475,7 @@ func (m *TableDef) Reset()         { *m = TableDef{} }
 func (m *TableDef) String() string { return proto.CompactTextString(m) }
 func (*TableDef) ProtoMessage()    {}
 func (*TableDef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37}
+	return fileDescriptor_2d655ab2f7683c23, []int{40}
 }
 func (m *TableDef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4255,6 +4595,13 @@ func (m *TableDef) GetChecks() []*CheckDef {
 	return nil
 }
 
+func (m *TableDef) GetTriggers() []*TriggerDef {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
 func (m *TableDef) GetPartition() *PartitionByDef {
 	if m != nil {
 		return m.Partition
@@ -4340,7 +4687,7 @@ func (m *TableDef_DefType) Reset()         { *m = TableDef_DefType{} }
 func (m *TableDef_DefType) String() string { return proto.CompactTextString(m) }
 func (*TableDef_DefType) ProtoMessage()    {}
 func (*TableDef_DefType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{37, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{40, 0}
 }
 func (m *TableDef_DefType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4414,7 +4761,7 @@ func (m *TableFunction) Reset()         { *m = TableFunction{} }
 func (m *TableFunction) String() string { return proto.CompactTextString(m) }
 func (*TableFunction) ProtoMessage()    {}
 func (*TableFunction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{38}
+	return fileDescriptor_2d655ab2f7683c23, []int{41}
 }
 func (m *TableFunction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4481,7 +4828,7 @@ func (m *HashMapStats) Reset()         { *m = HashMapStats{} }
 func (m *HashMapStats) String() string { return proto.CompactTextString(m) }
 func (*HashMapStats) ProtoMessage()    {}
 func (*HashMapStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{39}
+	return fileDescriptor_2d655ab2f7683c23, []int{42}
 }
 func (m *HashMapStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4597,7 +4944,7 @@ func (m *Stats) Reset()         { *m = Stats{} }
 func (m *Stats) String() string { return proto.CompactTextString(m) }
 func (*Stats) ProtoMessage()    {}
 func (*Stats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{40}
+	return fileDescriptor_2d655ab2f7683c23, []int{43}
 }
 func (m *Stats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4688,7 +5035,7 @@ func (m *RowsetExpr) Reset()         { *m = RowsetExpr{} }
 func (m *RowsetExpr) String() string { return proto.CompactTextString(m) }
 func (*RowsetExpr) ProtoMessage()    {}
 func (*RowsetExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{41}
+	return fileDescriptor_2d655ab2f7683c23, []int{44}
 }
 func (m *RowsetExpr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4749,7 +5096,7 @@ func (m *ColData) Reset()         { *m = ColData{} }
 func (m *ColData) String() string { return proto.CompactTextString(m) }
 func (*ColData) ProtoMessage()    {}
 func (*ColData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{42}
+	return fileDescriptor_2d655ab2f7683c23, []int{45}
 }
 func (m *ColData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4796,7 +5143,7 @@ func (m *RowsetData) Reset()         { *m = RowsetData{} }
 func (m *RowsetData) String() string { return proto.CompactTextString(m) }
 func (*RowsetData) ProtoMessage()    {}
 func (*RowsetData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{43}
+	return fileDescriptor_2d655ab2f7683c23, []int{46}
 }
 func (m *RowsetData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4845,7 +5192,7 @@ func (m *OrderBySpec) Reset()         { *m = OrderBySpec{} }
 func (m *OrderBySpec) String() string { return proto.CompactTextString(m) }
 func (*OrderBySpec) ProtoMessage()    {}
 func (*OrderBySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{44}
+	return fileDescriptor_2d655ab2f7683c23, []int{47}
 }
 func (m *OrderBySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4910,7 +5257,7 @@ func (m *WindowSpec) Reset()         { *m = WindowSpec{} }
 func (m *WindowSpec) String() string { return proto.CompactTextString(m) }
 func (*WindowSpec) ProtoMessage()    {}
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{45}
+	return fileDescriptor_2d655ab2f7683c23, []int{48}
 }
 func (m *WindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4987,7 +5334,7 @@ func (m *FrameClause) Reset()         { *m = FrameClause{} }
 func (m *FrameClause) String() string { return proto.CompactTextString(m) }
 func (*FrameClause) ProtoMessage()    {}
 func (*FrameClause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{46}
+	return fileDescriptor_2d655ab2f7683c23, []int{49}
 }
 func (m *FrameClause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5050,7 +5397,7 @@ func (m *FrameBound) Reset()         { *m = FrameBound{} }
 func (m *FrameBound) String() string { return proto.CompactTextString(m) }
 func (*FrameBound) ProtoMessage()    {}
 func (*FrameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{47}
+	return fileDescriptor_2d655ab2f7683c23, []int{50}
 }
 func (m *FrameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5113,7 +5460,7 @@ func (m *OnDuplicateKeyCtx) Reset()         { *m = OnDuplicateKeyCtx{} }
 func (m *OnDuplicateKeyCtx) String() string { return proto.CompactTextString(m) }
 func (*OnDuplicateKeyCtx) ProtoMessage()    {}
 func (*OnDuplicateKeyCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{48}
+	return fileDescriptor_2d655ab2f7683c23, []int{51}
 }
 func (m *OnDuplicateKeyCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5181,7 +5528,7 @@ func (m *InsertCtx) Reset()         { *m = InsertCtx{} }
 func (m *InsertCtx) String() string { return proto.CompactTextString(m) }
 func (*InsertCtx) ProtoMessage()    {}
 func (*InsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{49}
+	return fileDescriptor_2d655ab2f7683c23, []int{52}
 }
 func (m *InsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5278,7 +5625,7 @@ func (m *ReplaceCtx) Reset()         { *m = ReplaceCtx{} }
 func (m *ReplaceCtx) String() string { return proto.CompactTextString(m) }
 func (*ReplaceCtx) ProtoMessage()    {}
 func (*ReplaceCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{50}
+	return fileDescriptor_2d655ab2f7683c23, []int{53}
 }
 func (m *ReplaceCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5378,6 +5725,8 @@ type AnalyzeInfo struct {
 	NetworkIO            int64    `protobuf:"varint,12,opt,name=networkIO,proto3" json:"networkIO,omitempty"`
 	ScanTime             int64    `protobuf:"varint,13,opt,name=scanTime,proto3" json:"scanTime,omitempty"`
 	InsertTime           int64    `protobuf:"varint,14,opt,name=insertTime,proto3" json:"insertTime,omitempty"`
+	SpillCount           int64    `protobuf:"varint,15,opt,name=spill_count,json=spillCount,proto3" json:"spill_count,omitempty"`
+	SpillSize            int64    `protobuf:"varint,16,opt,name=spill_size,json=spillSize,proto3" json:"spill_size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -5387,7 +5736,7 @@ func (m *AnalyzeInfo) Reset()         { *m = AnalyzeInfo{} }
 func (m *AnalyzeInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalyzeInfo) ProtoMessage()    {}
 func (*AnalyzeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{51}
+	return fileDescriptor_2d655ab2f7683c23, []int{54}
 }
 func (m *AnalyzeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5514,6 +5863,20 @@ func (m *AnalyzeInfo) GetInsertTime() int64 {
 	return 0
 }
 
+func (m *AnalyzeInfo) GetSpillCount() int64 {
+	if m != nil {
+		return m.SpillCount
+	}
+	return 0
+}
+
+func (m *AnalyzeInfo) GetSpillSize() int64 {
+	if m != nil {
+		return m.SpillSize
+	}
+	return 0
+}
+
 type Node struct {
 	NodeType Node_NodeType `protobuf:"varint,1,opt,name=node_type,json=nodeType,proto3,enum=plan.Node_NodeType" json:"node_type,omitempty"`
 	NodeId   int32         `protobuf:"varint,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
@@ -5565,16 +5928,18 @@ type Node struct {
 	RuntimeFilterProbeList []*RuntimeFilterSpec `protobuf:"bytes,40,rep,name=runtime_filter_probe_list,json=runtimeFilterProbeList,proto3" json:"runtime_filter_probe_list,omitempty"`
 	RuntimeFilterBuildList []*RuntimeFilterSpec `protobuf:"bytes,41,rep,name=runtime_filter_build_list,json=runtimeFilterBuildList,proto3" json:"runtime_filter_build_list,omitempty"`
 	Uuid                   []byte               `protobuf:"bytes,42,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}             `json:"-"`
-	XXX_unrecognized       []byte               `json:"-"`
-	XXX_sizecache          int32                `json:"-"`
+	// the partitions to scan after partition pruning
+	PartitionPrune       *PartitionPrune `protobuf:"bytes,43,opt,name=partition_prune,json=partitionPrune,proto3" json:"partition_prune,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{52}
+	return fileDescriptor_2d655ab2f7683c23, []int{55}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5883,6 +6248,13 @@ func (m *Node) GetUuid() []byte {
 	return nil
 }
 
+func (m *Node) GetPartitionPrune() *PartitionPrune {
+	if m != nil {
+		return m.PartitionPrune
+	}
+	return nil
+}
+
 type LockTarget struct {
 	TableId              uint64   `protobuf:"varint,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
 	PrimaryColIdxInBat   int32    `protobuf:"varint,2,opt,name=primary_col_idx_in_bat,json=primaryColIdxInBat,proto3" json:"primary_col_idx_in_bat,omitempty"`
@@ -5902,7 +6274,7 @@ func (m *LockTarget) Reset()         { *m = LockTarget{} }
 func (m *LockTarget) String() string { return proto.CompactTextString(m) }
 func (*LockTarget) ProtoMessage()    {}
 func (*LockTarget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{53}
+	return fileDescriptor_2d655ab2f7683c23, []int{56}
 }
 func (m *LockTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6010,7 +6382,7 @@ func (m *PreInsertUkCtx) Reset()         { *m = PreInsertUkCtx{} }
 func (m *PreInsertUkCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertUkCtx) ProtoMessage()    {}
 func (*PreInsertUkCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{54}
+	return fileDescriptor_2d655ab2f7683c23, []int{57}
 }
 func (m *PreInsertUkCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6086,7 +6458,7 @@ func (m *PreDeleteCtx) Reset()         { *m = PreDeleteCtx{} }
 func (m *PreDeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*PreDeleteCtx) ProtoMessage()    {}
 func (*PreDeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{55}
+	return fileDescriptor_2d655ab2f7683c23, []int{58}
 }
 func (m *PreDeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6136,7 +6508,7 @@ func (m *PreInsertCtx) Reset()         { *m = PreInsertCtx{} }
 func (m *PreInsertCtx) String() string { return proto.CompactTextString(m) }
 func (*PreInsertCtx) ProtoMessage()    {}
 func (*PreInsertCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{56}
+	return fileDescriptor_2d655ab2f7683c23, []int{59}
 }
 func (m *PreInsertCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6205,7 +6577,7 @@ func (m *RuntimeFilterSpec) Reset()         { *m = RuntimeFilterSpec{} }
 func (m *RuntimeFilterSpec) String() string { return proto.CompactTextString(m) }
 func (*RuntimeFilterSpec) ProtoMessage()    {}
 func (*RuntimeFilterSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{57}
+	return fileDescriptor_2d655ab2f7683c23, []int{60}
 }
 func (m *RuntimeFilterSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6259,7 +6631,7 @@ func (m *IdList) Reset()         { *m = IdList{} }
 func (m *IdList) String() string { return proto.CompactTextString(m) }
 func (*IdList) ProtoMessage()    {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{58}
+	return fileDescriptor_2d655ab2f7683c23, []int{61}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6306,7 +6678,7 @@ func (m *ColPosMap) Reset()         { *m = ColPosMap{} }
 func (m *ColPosMap) String() string { return proto.CompactTextString(m) }
 func (*ColPosMap) ProtoMessage()    {}
 func (*ColPosMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{59}
+	return fileDescriptor_2d655ab2f7683c23, []int{62}
 }
 func (m *ColPosMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6362,7 +6734,7 @@ func (m *DeleteCtx) Reset()         { *m = DeleteCtx{} }
 func (m *DeleteCtx) String() string { return proto.CompactTextString(m) }
 func (*DeleteCtx) ProtoMessage()    {}
 func (*DeleteCtx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{60}
+	return fileDescriptor_2d655ab2f7683c23, []int{63}
 }
 func (m *DeleteCtx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6477,7 +6849,7 @@ func (m *Query) Reset()         { *m = Query{} }
 func (m *Query) String() string { return proto.CompactTextString(m) }
 func (*Query) ProtoMessage()    {}
 func (*Query) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{61}
+	return fileDescriptor_2d655ab2f7683c23, []int{64}
 }
 func (m *Query) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6566,7 +6938,7 @@ func (m *TransationControl) Reset()         { *m = TransationControl{} }
 func (m *TransationControl) String() string { return proto.CompactTextString(m) }
 func (*TransationControl) ProtoMessage()    {}
 func (*TransationControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{62}
+	return fileDescriptor_2d655ab2f7683c23, []int{65}
 }
 func (m *TransationControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6670,7 +7042,7 @@ func (m *TransationBegin) Reset()         { *m = TransationBegin{} }
 func (m *TransationBegin) String() string { return proto.CompactTextString(m) }
 func (*TransationBegin) ProtoMessage()    {}
 func (*TransationBegin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{63}
+	return fileDescriptor_2d655ab2f7683c23, []int{66}
 }
 func (m *TransationBegin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6717,7 +7089,7 @@ func (m *TransationCommit) Reset()         { *m = TransationCommit{} }
 func (m *TransationCommit) String() string { return proto.CompactTextString(m) }
 func (*TransationCommit) ProtoMessage()    {}
 func (*TransationCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{64}
+	return fileDescriptor_2d655ab2f7683c23, []int{67}
 }
 func (m *TransationCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6764,7 +7136,7 @@ func (m *TransationRollback) Reset()         { *m = TransationRollback{} }
 func (m *TransationRollback) String() string { return proto.CompactTextString(m) }
 func (*TransationRollback) ProtoMessage()    {}
 func (*TransationRollback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{65}
+	return fileDescriptor_2d655ab2f7683c23, []int{68}
 }
 func (m *TransationRollback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6819,7 +7191,7 @@ func (m *Plan) Reset()         { *m = Plan{} }
 func (m *Plan) String() string { return proto.CompactTextString(m) }
 func (*Plan) ProtoMessage()    {}
 func (*Plan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{66}
+	return fileDescriptor_2d655ab2f7683c23, []int{69}
 }
 func (m *Plan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6942,7 +7314,7 @@ func (m *Column) Reset()         { *m = Column{} }
 func (m *Column) String() string { return proto.CompactTextString(m) }
 func (*Column) ProtoMessage()    {}
 func (*Column) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{67}
+	return fileDescriptor_2d655ab2f7683c23, []int{70}
 }
 func (m *Column) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6997,7 +7369,7 @@ func (m *DataControl) Reset()         { *m = DataControl{} }
 func (m *DataControl) String() string { return proto.CompactTextString(m) }
 func (*DataControl) ProtoMessage()    {}
 func (*DataControl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{68}
+	return fileDescriptor_2d655ab2f7683c23, []int{71}
 }
 func (m *DataControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7128,6 +7500,8 @@ type DataDefinition struct {
 	//	*DataDefinition_CreateSequence
 	//	*DataDefinition_DropSequence
 	//	*DataDefinition_AlterSequence
+	//	*DataDefinition_CreateTrigger
+	//	*DataDefinition_DropTrigger
 	Definition           isDataDefinition_Definition `protobuf_oneof:"definition"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
 	XXX_unrecognized     []byte                      `json:"-"`
@@ -7138,7 +7512,7 @@ func (m *DataDefinition) Reset()         { *m = DataDefinition{} }
 func (m *DataDefinition) String() string { return proto.CompactTextString(m) }
 func (*DataDefinition) ProtoMessage()    {}
 func (*DataDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{69}
+	return fileDescriptor_2d655ab2f7683c23, []int{72}
 }
 func (m *DataDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7224,6 +7598,12 @@ type DataDefinition_DropSequence struct {
 type DataDefinition_AlterSequence struct {
 	AlterSequence *AlterSequence `protobuf:"bytes,19,opt,name=alter_sequence,json=alterSequence,proto3,oneof" json:"alter_sequence,omitempty"`
 }
+type DataDefinition_CreateTrigger struct {
+	CreateTrigger *CreateTrigger `protobuf:"bytes,20,opt,name=create_trigger,json=createTrigger,proto3,oneof" json:"create_trigger,omitempty"`
+}
+type DataDefinition_DropTrigger struct {
+	DropTrigger *DropTrigger `protobuf:"bytes,21,opt,name=drop_trigger,json=dropTrigger,proto3,oneof" json:"drop_trigger,omitempty"`
+}
 
 func (*DataDefinition_CreateDatabase) isDataDefinition_Definition() {}
 func (*DataDefinition_AlterDatabase) isDataDefinition_Definition()  {}
@@ -7242,6 +7622,8 @@ func (*DataDefinition_UnlockTables) isDataDefinition_Definition()   {}
 func (*DataDefinition_CreateSequence) isDataDefinition_Definition() {}
 func (*DataDefinition_DropSequence) isDataDefinition_Definition()   {}
 func (*DataDefinition_AlterSequence) isDataDefinition_Definition()  {}
+func (*DataDefinition_CreateTrigger) isDataDefinition_Definition()  {}
+func (*DataDefinition_DropTrigger) isDataDefinition_Definition()    {}
 
 func (m *DataDefinition) GetDefinition() isDataDefinition_Definition {
 	if m != nil {
@@ -7383,6 +7765,20 @@ func (m *DataDefinition) GetAlterSequence() *AlterSequence {
 	return nil
 }
 
+func (m *DataDefinition) GetCreateTrigger() *CreateTrigger {
+	if x, ok := m.GetDefinition().(*DataDefinition_CreateTrigger); ok {
+		return x.CreateTrigger
+	}
+	return nil
+}
+
+func (m *DataDefinition) GetDropTrigger() *DropTrigger {
+	if x, ok := m.GetDefinition().(*DataDefinition_DropTrigger); ok {
+		return x.DropTrigger
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*DataDefinition) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -7403,6 +7799,8 @@ func (*DataDefinition) XXX_OneofWrappers() []interface{} {
 		(*DataDefinition_CreateSequence)(nil),
 		(*DataDefinition_DropSequence)(nil),
 		(*DataDefinition_AlterSequence)(nil),
+		(*DataDefinition_CreateTrigger)(nil),
+		(*DataDefinition_DropTrigger)(nil),
 	}
 }
 
@@ -7418,7 +7816,7 @@ func (m *SubscriptionOption) Reset()         { *m = SubscriptionOption{} }
 func (m *SubscriptionOption) String() string { return proto.CompactTextString(m) }
 func (*SubscriptionOption) ProtoMessage()    {}
 func (*SubscriptionOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{70}
+	return fileDescriptor_2d655ab2f7683c23, []int{73}
 }
 func (m *SubscriptionOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7474,7 +7872,7 @@ func (m *CreateDatabase) Reset()         { *m = CreateDatabase{} }
 func (m *CreateDatabase) String() string { return proto.CompactTextString(m) }
 func (*CreateDatabase) ProtoMessage()    {}
 func (*CreateDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{71}
+	return fileDescriptor_2d655ab2f7683c23, []int{74}
 }
 func (m *CreateDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7536,7 +7934,7 @@ func (m *AlterDatabase) Reset()         { *m = AlterDatabase{} }
 func (m *AlterDatabase) String() string { return proto.CompactTextString(m) }
 func (*AlterDatabase) ProtoMessage()    {}
 func (*AlterDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{72}
+	return fileDescriptor_2d655ab2f7683c23, []int{75}
 }
 func (m *AlterDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7592,7 +7990,7 @@ func (m *DropDatabase) Reset()         { *m = DropDatabase{} }
 func (m *DropDatabase) String() string { return proto.CompactTextString(m) }
 func (*DropDatabase) ProtoMessage()    {}
 func (*DropDatabase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{73}
+	return fileDescriptor_2d655ab2f7683c23, []int{76}
 }
 func (m *DropDatabase) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7653,7 +8051,7 @@ func (m *FkColName) Reset()         { *m = FkColName{} }
 func (m *FkColName) String() string { return proto.CompactTextString(m) }
 func (*FkColName) ProtoMessage()    {}
 func (*FkColName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{74}
+	return fileDescriptor_2d655ab2f7683c23, []int{77}
 }
 func (m *FkColName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7710,7 +8108,7 @@ func (m *CreateTable) Reset()         { *m = CreateTable{} }
 func (m *CreateTable) String() string { return proto.CompactTextString(m) }
 func (*CreateTable) ProtoMessage()    {}
 func (*CreateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{75}
+	return fileDescriptor_2d655ab2f7683c23, []int{78}
 }
 func (m *CreateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7815,7 +8213,7 @@ func (m *AlterTableDrop) Reset()         { *m = AlterTableDrop{} }
 func (m *AlterTableDrop) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDrop) ProtoMessage()    {}
 func (*AlterTableDrop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{76}
+	return fileDescriptor_2d655ab2f7683c23, []int{79}
 }
 func (m *AlterTableDrop) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7879,7 +8277,7 @@ func (m *AlterTableAddFk) Reset()         { *m = AlterTableAddFk{} }
 func (m *AlterTableAddFk) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddFk) ProtoMessage()    {}
 func (*AlterTableAddFk) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{77}
+	return fileDescriptor_2d655ab2f7683c23, []int{80}
 }
 func (m *AlterTableAddFk) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7951,7 +8349,7 @@ func (m *AlterTableAddIndex) Reset()         { *m = AlterTableAddIndex{} }
 func (m *AlterTableAddIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAddIndex) ProtoMessage()    {}
 func (*AlterTableAddIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{78}
+	return fileDescriptor_2d655ab2f7683c23, []int{81}
 }
 func (m *AlterTableAddIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8029,7 +8427,7 @@ func (m *AlterTableDropIndex) Reset()         { *m = AlterTableDropIndex{} }
 func (m *AlterTableDropIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableDropIndex) ProtoMessage()    {}
 func (*AlterTableDropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{79}
+	return fileDescriptor_2d655ab2f7683c23, []int{82}
 }
 func (m *AlterTableDropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8100,7 +8498,7 @@ func (m *AlterTableAlterIndex) Reset()         { *m = AlterTableAlterIndex{} }
 func (m *AlterTableAlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterTableAlterIndex) ProtoMessage()    {}
 func (*AlterTableAlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{80}
+	return fileDescriptor_2d655ab2f7683c23, []int{83}
 }
 func (m *AlterTableAlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8168,7 +8566,7 @@ func (m *AlterTableComment) Reset()         { *m = AlterTableComment{} }
 func (m *AlterTableComment) String() string { return proto.CompactTextString(m) }
 func (*AlterTableComment) ProtoMessage()    {}
 func (*AlterTableComment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{81}
+	return fileDescriptor_2d655ab2f7683c23, []int{84}
 }
 func (m *AlterTableComment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8216,7 +8614,7 @@ func (m *AlterTableName) Reset()         { *m = AlterTableName{} }
 func (m *AlterTableName) String() string { return proto.CompactTextString(m) }
 func (*AlterTableName) ProtoMessage()    {}
 func (*AlterTableName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{82}
+	return fileDescriptor_2d655ab2f7683c23, []int{85}
 }
 func (m *AlterTableName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8273,7 +8671,7 @@ func (m *AlterAddCol) Reset()         { *m = AlterAddCol{} }
 func (m *AlterAddCol) String() string { return proto.CompactTextString(m) }
 func (*AlterAddCol) ProtoMessage()    {}
 func (*AlterAddCol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{83}
+	return fileDescriptor_2d655ab2f7683c23, []int{86}
 }
 func (m *AlterAddCol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8342,7 +8740,7 @@ func (m *AlterDropCol) Reset()         { *m = AlterDropCol{} }
 func (m *AlterDropCol) String() string { return proto.CompactTextString(m) }
 func (*AlterDropCol) ProtoMessage()    {}
 func (*AlterDropCol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{84}
+	return fileDescriptor_2d655ab2f7683c23, []int{87}
 }
 func (m *AlterDropCol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8386,27 +8784,29 @@ func (m *AlterDropCol) GetSeq() uint32 {
 }
 
 type AlterTable struct {
-	Database             string                   `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	TableDef             *TableDef                `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
-	CopyTableDef         *TableDef                `protobuf:"bytes,3,opt,name=copy_table_def,json=copyTableDef,proto3" json:"copy_table_def,omitempty"`
-	IsClusterTable       bool                     `protobuf:"varint,4,opt,name=is_cluster_table,json=isClusterTable,proto3" json:"is_cluster_table,omitempty"`
-	Actions              []*AlterTable_Action     `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
-	AlgorithmType        AlterTable_AlgorithmType `protobuf:"varint,6,opt,name=algorithm_type,json=algorithmType,proto3,enum=plan.AlterTable_AlgorithmType" json:"algorithm_type,omitempty"`
-	CreateTmpTableSql    string                   `protobuf:"bytes,7,opt,name=create_tmp_table_sql,json=createTmpTableSql,proto3" json:"create_tmp_table_sql,omitempty"`
-	InsertTmpDataSql     string                   `protobuf:"bytes,8,opt,name=insert_tmp_data_sql,json=insertTmpDataSql,proto3" json:"insert_tmp_data_sql,omitempty"`
-	CreateTableSql       string                   `protobuf:"bytes,9,opt,name=create_table_sql,json=createTableSql,proto3" json:"create_table_sql,omitempty"`
-	InsertDataSql        string                   `protobuf:"bytes,10,opt,name=insert_data_sql,json=insertDataSql,proto3" json:"insert_data_sql,omitempty"`
-	ChangeTblColIdMap    map[uint64]*ColDef       `protobuf:"bytes,11,rep,name=change_tbl_colId_map,json=changeTblColIdMap,proto3" json:"change_tbl_colId_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	Database          string                   `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	TableDef          *TableDef                `protobuf:"bytes,2,opt,name=table_def,json=tableDef,proto3" json:"table_def,omitempty"`
+	CopyTableDef      *TableDef                `protobuf:"bytes,3,opt,name=copy_table_def,json=copyTableDef,proto3" json:"copy_table_def,omitempty"`
+	IsClusterTable    bool                     `protobuf:"varint,4,opt,name=is_cluster_table,json=isClusterTable,proto3" json:"is_cluster_table,omitempty"`
+	Actions           []*AlterTable_Action     `protobuf:"bytes,5,rep,name=actions,proto3" json:"actions,omitempty"`
+	AlgorithmType     AlterTable_AlgorithmType `protobuf:"varint,6,opt,name=algorithm_type,json=algorithmType,proto3,enum=plan.AlterTable_AlgorithmType" json:"algorithm_type,omitempty"`
+	CreateTmpTableSql string                   `protobuf:"bytes,7,opt,name=create_tmp_table_sql,json=createTmpTableSql,proto3" json:"create_tmp_table_sql,omitempty"`
+	InsertTmpDataSql  string                   `protobuf:"bytes,8,opt,name=insert_tmp_data_sql,json=insertTmpDataSql,proto3" json:"insert_tmp_data_sql,omitempty"`
+	CreateTableSql    string                   `protobuf:"bytes,9,opt,name=create_table_sql,json=createTableSql,proto3" json:"create_table_sql,omitempty"`
+	InsertDataSql     string                   `protobuf:"bytes,10,opt,name=insert_data_sql,json=insertDataSql,proto3" json:"insert_data_sql,omitempty"`
+	ChangeTblColIdMap map[uint64]*ColDef       `protobuf:"bytes,11,rep,name=change_tbl_colId_map,json=changeTblColIdMap,proto3" json:"change_tbl_colId_map,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// the partition management of the table, it's nil for the other alters
+	AlterPartition       *AlterPartition `protobuf:"bytes,12,opt,name=alter_partition,json=alterPartition,proto3" json:"alter_partition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *AlterTable) Reset()         { *m = AlterTable{} }
 func (m *AlterTable) String() string { return proto.CompactTextString(m) }
 func (*AlterTable) ProtoMessage()    {}
 func (*AlterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85}
+	return fileDescriptor_2d655ab2f7683c23, []int{88}
 }
 func (m *AlterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8512,6 +8912,13 @@ func (m *AlterTable) GetChangeTblColIdMap() map[uint64]*ColDef {
 	return nil
 }
 
+func (m *AlterTable) GetAlterPartition() *AlterPartition {
+	if m != nil {
+		return m.AlterPartition
+	}
+	return nil
+}
+
 type AlterTable_Action struct {
 	// Types that are valid to be assigned to Action:
 	//
@@ -8523,6 +8930,7 @@ type AlterTable_Action struct {
 	//	*AlterTable_Action_AlterName
 	//	*AlterTable_Action_AddCol
 	//	*AlterTable_Action_DropCol
+	//	*AlterTable_Action_AddCheck
 	Action               isAlterTable_Action_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -8533,7 +8941,7 @@ func (m *AlterTable_Action) Reset()         { *m = AlterTable_Action{} }
 func (m *AlterTable_Action) String() string { return proto.CompactTextString(m) }
 func (*AlterTable_Action) ProtoMessage()    {}
 func (*AlterTable_Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{85, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{88, 0}
 }
 func (m *AlterTable_Action) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8592,6 +9000,9 @@ type AlterTable_Action_AddCol struct {
 type AlterTable_Action_DropCol struct {
 	DropCol *AlterDropCol `protobuf:"bytes,8,opt,name=drop_col,json=dropCol,proto3,oneof" json:"drop_col,omitempty"`
 }
+type AlterTable_Action_AddCheck struct {
+	AddCheck *CheckDef `protobuf:"bytes,9,opt,name=add_check,json=addCheck,proto3,oneof" json:"add_check,omitempty"`
+}
 
 func (*AlterTable_Action_Drop) isAlterTable_Action_Action()         {}
 func (*AlterTable_Action_AddFk) isAlterTable_Action_Action()        {}
@@ -8601,6 +9012,7 @@ func (*AlterTable_Action_AlterComment) isAlterTable_Action_Action() {}
 func (*AlterTable_Action_AlterName) isAlterTable_Action_Action()    {}
 func (*AlterTable_Action_AddCol) isAlterTable_Action_Action()       {}
 func (*AlterTable_Action_DropCol) isAlterTable_Action_Action()      {}
+func (*AlterTable_Action_AddCheck) isAlterTable_Action_Action()     {}
 
 func (m *AlterTable_Action) GetAction() isAlterTable_Action_Action {
 	if m != nil {
@@ -8665,6 +9077,13 @@ func (m *AlterTable_Action) GetDropCol() *AlterDropCol {
 	return nil
 }
 
+func (m *AlterTable_Action) GetAddCheck() *CheckDef {
+	if x, ok := m.GetAction().(*AlterTable_Action_AddCheck); ok {
+		return x.AddCheck
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -8676,6 +9095,7 @@ func (*AlterTable_Action) XXX_OneofWrappers() []interface{} {
 		(*AlterTable_Action_AlterName)(nil),
 		(*AlterTable_Action_AddCol)(nil),
 		(*AlterTable_Action_DropCol)(nil),
+		(*AlterTable_Action_AddCheck)(nil),
 	}
 }
 
@@ -8699,7 +9119,7 @@ func (m *DropTable) Reset()         { *m = DropTable{} }
 func (m *DropTable) String() string { return proto.CompactTextString(m) }
 func (*DropTable) ProtoMessage()    {}
 func (*DropTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{86}
+	return fileDescriptor_2d655ab2f7683c23, []int{89}
 }
 func (m *DropTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8811,7 +9231,7 @@ func (m *AlterView) Reset()         { *m = AlterView{} }
 func (m *AlterView) String() string { return proto.CompactTextString(m) }
 func (*AlterView) ProtoMessage()    {}
 func (*AlterView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{87}
+	return fileDescriptor_2d655ab2f7683c23, []int{90}
 }
 func (m *AlterView) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8874,7 +9294,7 @@ func (m *CreateSequence) Reset()         { *m = CreateSequence{} }
 func (m *CreateSequence) String() string { return proto.CompactTextString(m) }
 func (*CreateSequence) ProtoMessage()    {}
 func (*CreateSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{88}
+	return fileDescriptor_2d655ab2f7683c23, []int{91}
 }
 func (m *CreateSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8938,7 +9358,7 @@ func (m *DropSequence) Reset()         { *m = DropSequence{} }
 func (m *DropSequence) String() string { return proto.CompactTextString(m) }
 func (*DropSequence) ProtoMessage()    {}
 func (*DropSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{89}
+	return fileDescriptor_2d655ab2f7683c23, []int{92}
 }
 func (m *DropSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9005,7 +9425,7 @@ func (m *AlterSequence) Reset()         { *m = AlterSequence{} }
 func (m *AlterSequence) String() string { return proto.CompactTextString(m) }
 func (*AlterSequence) ProtoMessage()    {}
 func (*AlterSequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{90}
+	return fileDescriptor_2d655ab2f7683c23, []int{93}
 }
 func (m *AlterSequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9050,7 +9470,7 @@ func (m *CreateIndex) Reset()         { *m = CreateIndex{} }
 func (m *CreateIndex) String() string { return proto.CompactTextString(m) }
 func (*CreateIndex) ProtoMessage()    {}
 func (*CreateIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{91}
+	return fileDescriptor_2d655ab2f7683c23, []int{94}
 }
 func (m *CreateIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9121,6 +9541,94 @@ func (m *CreateIndex) GetTableExist() bool {
 	return false
 }
 
+type CreateTrigger struct {
+	IfNotExists bool        `protobuf:"varint,1,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	Database    string      `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Table       string      `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	Trigger     *TriggerDef `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// the existing trigger the new trigger FOLLOWS or PRECEDES
+	OrderTrigger         string   `protobuf:"bytes,5,opt,name=order_trigger,json=orderTrigger,proto3" json:"order_trigger,omitempty"`
+	Precedes             bool     `protobuf:"varint,6,opt,name=precedes,proto3" json:"precedes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTrigger) Reset()         { *m = CreateTrigger{} }
+func (m *CreateTrigger) String() string { return proto.CompactTextString(m) }
+func (*CreateTrigger) ProtoMessage()    {}
+func (*CreateTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{95}
+}
+func (m *CreateTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTrigger.Merge(m, src)
+}
+func (m *CreateTrigger) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CreateTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTrigger proto.InternalMessageInfo
+
+func (m *CreateTrigger) GetIfNotExists() bool {
+	if m != nil {
+		return m.IfNotExists
+	}
+	return false
+}
+
+func (m *CreateTrigger) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *CreateTrigger) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *CreateTrigger) GetTrigger() *TriggerDef {
+	if m != nil {
+		return m.Trigger
+	}
+	return nil
+}
+
+func (m *CreateTrigger) GetOrderTrigger() string {
+	if m != nil {
+		return m.OrderTrigger
+	}
+	return ""
+}
+
+func (m *CreateTrigger) GetPrecedes() bool {
+	if m != nil {
+		return m.Precedes
+	}
+	return false
+}
+
 type AlterIndex struct {
 	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -9132,7 +9640,7 @@ func (m *AlterIndex) Reset()         { *m = AlterIndex{} }
 func (m *AlterIndex) String() string { return proto.CompactTextString(m) }
 func (*AlterIndex) ProtoMessage()    {}
 func (*AlterIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{92}
+	return fileDescriptor_2d655ab2f7683c23, []int{96}
 }
 func (m *AlterIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9182,7 +9690,7 @@ func (m *DropIndex) Reset()         { *m = DropIndex{} }
 func (m *DropIndex) String() string { return proto.CompactTextString(m) }
 func (*DropIndex) ProtoMessage()    {}
 func (*DropIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{93}
+	return fileDescriptor_2d655ab2f7683c23, []int{97}
 }
 func (m *DropIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9239,6 +9747,69 @@ func (m *DropIndex) GetIndexTableName() string {
 	return ""
 }
 
+type DropTrigger struct {
+	IfExists             bool     `protobuf:"varint,1,opt,name=if_exists,json=ifExists,proto3" json:"if_exists,omitempty"`
+	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropTrigger) Reset()         { *m = DropTrigger{} }
+func (m *DropTrigger) String() string { return proto.CompactTextString(m) }
+func (*DropTrigger) ProtoMessage()    {}
+func (*DropTrigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{98}
+}
+func (m *DropTrigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DropTrigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DropTrigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DropTrigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropTrigger.Merge(m, src)
+}
+func (m *DropTrigger) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DropTrigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropTrigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropTrigger proto.InternalMessageInfo
+
+func (m *DropTrigger) GetIfExists() bool {
+	if m != nil {
+		return m.IfExists
+	}
+	return false
+}
+
+func (m *DropTrigger) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *DropTrigger) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
 type TruncateTable struct {
 	Database             string        `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
 	Table                string        `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
@@ -9256,7 +9827,7 @@ func (m *TruncateTable) Reset()         { *m = TruncateTable{} }
 func (m *TruncateTable) String() string { return proto.CompactTextString(m) }
 func (*TruncateTable) ProtoMessage()    {}
 func (*TruncateTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{94}
+	return fileDescriptor_2d655ab2f7683c23, []int{99}
 }
 func (m *TruncateTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9347,7 +9918,7 @@ func (m *ClusterTable) Reset()         { *m = ClusterTable{} }
 func (m *ClusterTable) String() string { return proto.CompactTextString(m) }
 func (*ClusterTable) ProtoMessage()    {}
 func (*ClusterTable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{95}
+	return fileDescriptor_2d655ab2f7683c23, []int{100}
 }
 func (m *ClusterTable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9409,7 +9980,7 @@ func (m *ShowVariables) Reset()         { *m = ShowVariables{} }
 func (m *ShowVariables) String() string { return proto.CompactTextString(m) }
 func (*ShowVariables) ProtoMessage()    {}
 func (*ShowVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{96}
+	return fileDescriptor_2d655ab2f7683c23, []int{101}
 }
 func (m *ShowVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9463,7 +10034,7 @@ func (m *SetVariables) Reset()         { *m = SetVariables{} }
 func (m *SetVariables) String() string { return proto.CompactTextString(m) }
 func (*SetVariables) ProtoMessage()    {}
 func (*SetVariables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{97}
+	return fileDescriptor_2d655ab2f7683c23, []int{102}
 }
 func (m *SetVariables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9514,7 +10085,7 @@ func (m *SetVariablesItem) Reset()         { *m = SetVariablesItem{} }
 func (m *SetVariablesItem) String() string { return proto.CompactTextString(m) }
 func (*SetVariablesItem) ProtoMessage()    {}
 func (*SetVariablesItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{98}
+	return fileDescriptor_2d655ab2f7683c23, []int{103}
 }
 func (m *SetVariablesItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9592,7 +10163,7 @@ func (m *Prepare) Reset()         { *m = Prepare{} }
 func (m *Prepare) String() string { return proto.CompactTextString(m) }
 func (*Prepare) ProtoMessage()    {}
 func (*Prepare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{99}
+	return fileDescriptor_2d655ab2f7683c23, []int{104}
 }
 func (m *Prepare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9661,7 +10232,7 @@ func (m *Execute) Reset()         { *m = Execute{} }
 func (m *Execute) String() string { return proto.CompactTextString(m) }
 func (*Execute) ProtoMessage()    {}
 func (*Execute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{100}
+	return fileDescriptor_2d655ab2f7683c23, []int{105}
 }
 func (m *Execute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9715,7 +10286,7 @@ func (m *Deallocate) Reset()         { *m = Deallocate{} }
 func (m *Deallocate) String() string { return proto.CompactTextString(m) }
 func (*Deallocate) ProtoMessage()    {}
 func (*Deallocate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{101}
+	return fileDescriptor_2d655ab2f7683c23, []int{106}
 }
 func (m *Deallocate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9763,7 +10334,7 @@ func (m *TableLockInfo) Reset()         { *m = TableLockInfo{} }
 func (m *TableLockInfo) String() string { return proto.CompactTextString(m) }
 func (*TableLockInfo) ProtoMessage()    {}
 func (*TableLockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{102}
+	return fileDescriptor_2d655ab2f7683c23, []int{107}
 }
 func (m *TableLockInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9817,7 +10388,7 @@ func (m *LockTables) Reset()         { *m = LockTables{} }
 func (m *LockTables) String() string { return proto.CompactTextString(m) }
 func (*LockTables) ProtoMessage()    {}
 func (*LockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{103}
+	return fileDescriptor_2d655ab2f7683c23, []int{108}
 }
 func (m *LockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9863,7 +10434,7 @@ func (m *UnLockTables) Reset()         { *m = UnLockTables{} }
 func (m *UnLockTables) String() string { return proto.CompactTextString(m) }
 func (*UnLockTables) ProtoMessage()    {}
 func (*UnLockTables) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{104}
+	return fileDescriptor_2d655ab2f7683c23, []int{109}
 }
 func (m *UnLockTables) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9921,7 +10492,7 @@ func (m *MetadataScanInfo) Reset()         { *m = MetadataScanInfo{} }
 func (m *MetadataScanInfo) String() string { return proto.CompactTextString(m) }
 func (*MetadataScanInfo) ProtoMessage()    {}
 func (*MetadataScanInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{105}
+	return fileDescriptor_2d655ab2f7683c23, []int{110}
 }
 func (m *MetadataScanInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10094,6 +10665,7 @@ func init() {
 	proto.RegisterEnum("plan.SubqueryRef_Type", SubqueryRef_Type_name, SubqueryRef_Type_value)
 	proto.RegisterEnum("plan.Function_FuncFlag", Function_FuncFlag_name, Function_FuncFlag_value)
 	proto.RegisterEnum("plan.ForeignKeyDef_RefAction", ForeignKeyDef_RefAction_name, ForeignKeyDef_RefAction_value)
+	proto.RegisterEnum("plan.AlterPartition_Typ", AlterPartition_Typ_name, AlterPartition_Typ_value)
 	proto.RegisterEnum("plan.OrderBySpec_OrderByFlag", OrderBySpec_OrderByFlag_name, OrderBySpec_OrderByFlag_value)
 	proto.RegisterEnum("plan.FrameClause_FrameType", FrameClause_FrameType_name, FrameClause_FrameType_value)
 	proto.RegisterEnum("plan.FrameBound_BoundType", FrameBound_BoundType_name, FrameBound_BoundType_value)
@@ -10136,6 +10708,7 @@ func init() {
 	proto.RegisterType((*IndexDef)(nil), "plan.IndexDef")
 	proto.RegisterType((*ForeignKeyDef)(nil), "plan.ForeignKeyDef")
 	proto.RegisterType((*CheckDef)(nil), "plan.CheckDef")
+	proto.RegisterType((*TriggerDef)(nil), "plan.TriggerDef")
 	proto.RegisterType((*ClusterByDef)(nil), "plan.ClusterByDef")
 	proto.RegisterType((*PropertyDef)(nil), "plan.PropertyDef")
 	proto.RegisterType((*Property)(nil), "plan.Property")
@@ -10144,6 +10717,8 @@ func init() {
 	proto.RegisterType((*PartitionExpr)(nil), "plan.PartitionExpr")
 	proto.RegisterType((*PartitionColumns)(nil), "plan.PartitionColumns")
 	proto.RegisterType((*PartitionItem)(nil), "plan.PartitionItem")
+	proto.RegisterType((*PartitionPrune)(nil), "plan.PartitionPrune")
+	proto.RegisterType((*AlterPartition)(nil), "plan.AlterPartition")
 	proto.RegisterType((*ViewDef)(nil), "plan.ViewDef")
 	proto.RegisterType((*TableDef)(nil), "plan.TableDef")
 	proto.RegisterMapType((map[string]int32)(nil), "plan.TableDef.Name2colIndexEntry")
@@ -10206,8 +10781,10 @@ func init() {
 	proto.RegisterType((*DropSequence)(nil), "plan.DropSequence")
 	proto.RegisterType((*AlterSequence)(nil), "plan.AlterSequence")
 	proto.RegisterType((*CreateIndex)(nil), "plan.CreateIndex")
+	proto.RegisterType((*CreateTrigger)(nil), "plan.CreateTrigger")
 	proto.RegisterType((*AlterIndex)(nil), "plan.AlterIndex")
 	proto.RegisterType((*DropIndex)(nil), "plan.DropIndex")
+	proto.RegisterType((*DropTrigger)(nil), "plan.DropTrigger")
 	proto.RegisterType((*TruncateTable)(nil), "plan.TruncateTable")
 	proto.RegisterType((*ClusterTable)(nil), "plan.ClusterTable")
 	proto.RegisterType((*ShowVariables)(nil), "plan.ShowVariables")
@@ -10225,585 +10802,613 @@ func init() {
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 9233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x4b, 0x8c, 0x23, 0x47,
-	0x96, 0x58, 0xf1, 0x4f, 0x3e, 0x92, 0x55, 0x59, 0xd1, 0xd5, 0x2d, 0x76, 0xab, 0xd5, 0x2a, 0xa5,
-	0x7e, 0xad, 0x1e, 0x4d, 0xb7, 0xd4, 0xfa, 0x6b, 0x67, 0x76, 0x86, 0xc5, 0x62, 0x77, 0x51, 0xcd,
-	0x22, 0x6b, 0x82, 0xac, 0x6e, 0x69, 0x17, 0x46, 0x22, 0xc9, 0x4c, 0x56, 0xa5, 0x2a, 0x99, 0x49,
-	0x65, 0x26, 0xbb, 0xaa, 0x04, 0x2c, 0x30, 0xa7, 0x5d, 0xf8, 0x66, 0xc0, 0xc6, 0xc2, 0x80, 0xd7,
-	0xc0, 0xae, 0x01, 0x5f, 0x0c, 0x1f, 0x7c, 0xb0, 0xb1, 0x80, 0xb1, 0x30, 0x60, 0xf8, 0x62, 0x1f,
-	0x0c, 0xd8, 0xf0, 0xcd, 0xf6, 0xc1, 0x1e, 0x1b, 0xbe, 0x19, 0x3e, 0xec, 0xc0, 0x27, 0x1f, 0x8c,
-	0xf7, 0x22, 0x32, 0x33, 0xb2, 0x58, 0xa5, 0x1e, 0x69, 0xc7, 0xb0, 0x7d, 0x21, 0xe3, 0x7d, 0xe2,
-	0x1f, 0xf1, 0x7e, 0x11, 0x91, 0x00, 0x0b, 0xd7, 0xf4, 0xee, 0x2f, 0x02, 0x3f, 0xf2, 0x59, 0x11,
-	0xd3, 0xb7, 0x7e, 0x7c, 0xe4, 0x44, 0xc7, 0xcb, 0xc9, 0xfd, 0xa9, 0x3f, 0x7f, 0x70, 0xe4, 0x1f,
-	0xf9, 0x0f, 0x88, 0x38, 0x59, 0xce, 0x08, 0x22, 0x80, 0x52, 0x22, 0x93, 0xfe, 0xe7, 0x39, 0x28,
-	0x8e, 0xcf, 0x17, 0x36, 0x5b, 0x87, 0xbc, 0x63, 0xb5, 0x72, 0xdb, 0xb9, 0xbb, 0x25, 0x9e, 0x77,
-	0x2c, 0xb6, 0x0d, 0x75, 0xcf, 0x8f, 0x06, 0x4b, 0xd7, 0x35, 0x27, 0xae, 0xdd, 0xca, 0x6f, 0xe7,
-	0xee, 0x56, 0xb9, 0x8a, 0x62, 0x2f, 0x43, 0xcd, 0x5c, 0x46, 0xbe, 0xe1, 0x78, 0xd3, 0xa0, 0x55,
-	0x20, 0x7a, 0x15, 0x11, 0x3d, 0x6f, 0x1a, 0xb0, 0x2d, 0x28, 0x9d, 0x3a, 0x56, 0x74, 0xdc, 0x2a,
-	0x52, 0x89, 0x02, 0x40, 0x6c, 0x38, 0x35, 0x5d, 0xbb, 0x55, 0x12, 0x58, 0x02, 0x10, 0x1b, 0x51,
-	0x25, 0xe5, 0xed, 0xdc, 0xdd, 0x1a, 0x17, 0x00, 0xbb, 0x03, 0x60, 0x7b, 0xcb, 0xf9, 0x73, 0xd3,
-	0x5d, 0xda, 0x61, 0xab, 0x42, 0x24, 0x05, 0xa3, 0xff, 0xf7, 0x12, 0x94, 0x3a, 0xbe, 0x17, 0x46,
-	0xec, 0x06, 0x94, 0x9d, 0xd0, 0x5b, 0xba, 0x2e, 0x35, 0xbf, 0xca, 0x25, 0xc4, 0x6e, 0x40, 0xc9,
-	0xf9, 0xf4, 0xb9, 0xe9, 0x52, 0xe3, 0x4b, 0x7b, 0x6b, 0x5c, 0x80, 0xac, 0x05, 0x65, 0xe7, 0xfd,
-	0x8f, 0x91, 0x50, 0x90, 0x04, 0x09, 0x13, 0xe5, 0x83, 0x87, 0x48, 0x29, 0x26, 0x14, 0x82, 0x89,
-	0xf2, 0xf1, 0x87, 0x48, 0xc1, 0xa6, 0x17, 0x88, 0x42, 0x30, 0xd6, 0xb2, 0xa4, 0x5a, 0xb0, 0xf5,
-	0x4d, 0xac, 0x65, 0x19, 0xd7, 0xb2, 0x14, 0xb5, 0x54, 0x24, 0x41, 0xc2, 0x44, 0x11, 0xb5, 0x54,
-	0x13, 0x4a, 0x52, 0xcb, 0x52, 0xd4, 0x52, 0xdb, 0xce, 0xdd, 0x2d, 0x12, 0x45, 0xd4, 0xb2, 0x05,
-	0x45, 0x0b, 0xf1, 0xb0, 0x9d, 0xbb, 0x9b, 0xdb, 0x5b, 0xe3, 0x04, 0x21, 0x36, 0x44, 0x6c, 0x1d,
-	0x47, 0x07, 0xb1, 0xa1, 0xc4, 0x4e, 0x10, 0xdb, 0xc0, 0xd1, 0x40, 0xec, 0x44, 0x62, 0x67, 0x88,
-	0x6d, 0x6e, 0xe7, 0xee, 0xe6, 0x11, 0x8b, 0x10, 0xbb, 0x05, 0x15, 0xcb, 0x8c, 0x6c, 0x24, 0xac,
-	0xcb, 0x2e, 0xc7, 0x08, 0xa4, 0x45, 0xce, 0x9c, 0x68, 0x1b, 0xb2, 0xd3, 0x31, 0x82, 0xe9, 0x50,
-	0x47, 0xb6, 0x98, 0xae, 0x49, 0xba, 0x8a, 0x64, 0x1f, 0x41, 0xc3, 0xb2, 0xa7, 0xce, 0xdc, 0x74,
-	0x45, 0x9f, 0x36, 0xb7, 0x73, 0x77, 0xeb, 0x0f, 0x37, 0xee, 0xd3, 0x9a, 0x4d, 0x28, 0x7b, 0x6b,
-	0x3c, 0xc3, 0xc6, 0x3e, 0x85, 0xa6, 0x84, 0xdf, 0x7f, 0x48, 0x03, 0xcb, 0x28, 0x9f, 0x96, 0xc9,
-	0xf7, 0xfe, 0xc3, 0x4f, 0xf7, 0xd6, 0x78, 0x96, 0x91, 0xbd, 0x01, 0x0d, 0xac, 0x3b, 0x8c, 0xcc,
-	0xf9, 0x02, 0x33, 0x5e, 0x93, 0xad, 0xca, 0x60, 0xb1, 0x5b, 0x5f, 0x87, 0xbe, 0x87, 0x0c, 0x5b,
-	0x72, 0xdc, 0x62, 0x04, 0xdb, 0x06, 0xb0, 0xec, 0x99, 0xb9, 0x74, 0x23, 0x24, 0x5f, 0x97, 0x03,
-	0xa8, 0xe0, 0xd8, 0x1d, 0xa8, 0x2d, 0x17, 0xd8, 0xcb, 0xa7, 0xa6, 0xdb, 0xba, 0x21, 0x19, 0x52,
-	0x14, 0x96, 0x8e, 0x8b, 0x14, 0xa9, 0x2f, 0xc9, 0xd9, 0x8d, 0x11, 0xb8, 0xd0, 0x9d, 0x70, 0xc7,
-	0xf1, 0x5a, 0x2d, 0x5a, 0xa7, 0x02, 0x60, 0xb7, 0xa1, 0x10, 0x06, 0xd3, 0xd6, 0x4d, 0xea, 0x25,
-	0x88, 0x5e, 0x76, 0xcf, 0x16, 0x01, 0x47, 0xf4, 0x4e, 0x05, 0x4a, 0xb4, 0xe0, 0xf5, 0xdb, 0x50,
-	0x3d, 0x30, 0x03, 0x73, 0xce, 0xed, 0x19, 0xd3, 0xa0, 0xb0, 0xf0, 0x43, 0xb9, 0x5b, 0x31, 0xa9,
-	0xf7, 0xa1, 0xfc, 0xd4, 0x0c, 0x90, 0xc6, 0xa0, 0xe8, 0x99, 0x73, 0x9b, 0x88, 0x35, 0x4e, 0x69,
-	0xdc, 0x21, 0xe1, 0x79, 0x18, 0xd9, 0x73, 0xb9, 0x8f, 0x25, 0x84, 0xf8, 0x23, 0xd7, 0x9f, 0xc8,
-	0x9d, 0x50, 0xe5, 0x12, 0xd2, 0x07, 0x50, 0xee, 0xf8, 0x2e, 0x96, 0xf6, 0x12, 0x54, 0x02, 0xdb,
-	0x35, 0xd2, 0xda, 0xca, 0x81, 0xed, 0x1e, 0xf8, 0x21, 0x12, 0xa6, 0xbe, 0x20, 0xe4, 0x05, 0x61,
-	0xea, 0x13, 0x21, 0xae, 0xbf, 0x90, 0xd6, 0xaf, 0x7f, 0x06, 0x35, 0x6e, 0x9e, 0xca, 0x22, 0xaf,
-	0x43, 0x39, 0x9a, 0xb8, 0x86, 0x94, 0x36, 0x45, 0x5e, 0x8a, 0x26, 0x6e, 0xcf, 0x42, 0x34, 0x16,
-	0xe8, 0x58, 0x54, 0x5e, 0x91, 0x97, 0xa6, 0xbe, 0xdb, 0xb3, 0xf4, 0x31, 0x40, 0xc7, 0x0f, 0x82,
-	0x1f, 0xdc, 0x9c, 0x2d, 0x28, 0x59, 0xf6, 0x22, 0x3a, 0x16, 0x7b, 0x9d, 0x0b, 0x40, 0xbf, 0x07,
-	0x55, 0x1c, 0xe2, 0xbe, 0x13, 0x46, 0xec, 0x0e, 0x14, 0x5d, 0x27, 0x8c, 0x5a, 0xb9, 0xed, 0xc2,
-	0x85, 0x09, 0x20, 0xbc, 0xbe, 0x0d, 0xd5, 0x7d, 0xf3, 0xec, 0x29, 0x4e, 0x02, 0x96, 0x46, 0xb3,
-	0x21, 0x47, 0x57, 0x4e, 0xcd, 0x3d, 0x80, 0xb1, 0x19, 0x1c, 0xd9, 0x11, 0x49, 0xd2, 0xdb, 0x50,
-	0x88, 0xce, 0x17, 0xc4, 0x91, 0x14, 0x87, 0x04, 0x8e, 0x68, 0xfd, 0x2f, 0x73, 0x50, 0x1f, 0x2d,
-	0x27, 0xdf, 0x2c, 0xed, 0xe0, 0x1c, 0x7b, 0x74, 0x37, 0xe5, 0x5e, 0x7f, 0x78, 0x43, 0x70, 0x2b,
-	0xf4, 0x34, 0x27, 0x76, 0xd1, 0xf3, 0x2d, 0x3b, 0x1e, 0xa1, 0x12, 0x2f, 0x23, 0xd8, 0xb3, 0x50,
-	0x74, 0xfb, 0x0b, 0x39, 0xde, 0x79, 0x7f, 0xc1, 0xb6, 0xa1, 0x34, 0x3d, 0x76, 0x5c, 0x8b, 0x84,
-	0x58, 0xb6, 0x47, 0x82, 0xc0, 0x6e, 0x42, 0x35, 0xf0, 0x4f, 0x8d, 0xd0, 0xf9, 0x36, 0x16, 0xc5,
-	0x95, 0xc0, 0x3f, 0x1d, 0x39, 0xdf, 0xda, 0xfa, 0x58, 0xea, 0x03, 0x80, 0xf2, 0xa8, 0xd3, 0xee,
-	0xb7, 0xb9, 0xb6, 0x86, 0xe9, 0xee, 0x97, 0xbd, 0xd1, 0x78, 0xa4, 0xe5, 0xd8, 0x3a, 0xc0, 0x60,
-	0x38, 0x36, 0x24, 0x9c, 0x67, 0x65, 0xc8, 0xf7, 0x06, 0x5a, 0x01, 0x79, 0x10, 0xdf, 0x1b, 0x68,
-	0x45, 0x56, 0x81, 0x42, 0x7b, 0xf0, 0x95, 0x56, 0xa2, 0x44, 0xbf, 0xaf, 0x95, 0xf5, 0x7f, 0x90,
-	0x87, 0xda, 0x70, 0xf2, 0xb5, 0x3d, 0x8d, 0xb0, 0xcf, 0xb8, 0x1c, 0xed, 0xe0, 0xb9, 0x1d, 0x50,
-	0xb7, 0x0b, 0x5c, 0x42, 0xd8, 0x11, 0x6b, 0x42, 0x9d, 0x2b, 0xf0, 0xbc, 0x35, 0x21, 0xbe, 0xe9,
-	0xb1, 0x3d, 0x37, 0xa9, 0x73, 0xc8, 0x47, 0x10, 0x2e, 0x7f, 0x7f, 0xf2, 0x35, 0x75, 0xaf, 0xc0,
-	0x31, 0xc9, 0x5e, 0x85, 0xba, 0x28, 0xc3, 0xa0, 0xb5, 0x57, 0x12, 0xda, 0x42, 0xa0, 0x06, 0xb8,
-	0x03, 0x5e, 0x82, 0x8a, 0x35, 0x11, 0x44, 0xa1, 0x65, 0xca, 0xd6, 0x84, 0x08, 0x98, 0x93, 0x4a,
-	0x15, 0x44, 0xa9, 0x67, 0x04, 0x8a, 0x18, 0x6e, 0x42, 0xd5, 0x9f, 0x7c, 0x2d, 0xa8, 0x55, 0xa2,
-	0x56, 0xfc, 0xc9, 0xd7, 0x44, 0xfa, 0x11, 0x6c, 0x86, 0xcb, 0x49, 0x38, 0x0d, 0x9c, 0x45, 0xe4,
-	0xf8, 0x9e, 0xe0, 0xa9, 0x11, 0x8f, 0xa6, 0x12, 0x88, 0xf9, 0x2e, 0x54, 0x17, 0xcb, 0x89, 0xe1,
-	0x78, 0x33, 0x9f, 0xa4, 0x78, 0xfd, 0x61, 0x53, 0x4c, 0xcc, 0xc1, 0x72, 0xd2, 0xf3, 0x66, 0x3e,
-	0xaf, 0x2c, 0x44, 0x42, 0x7f, 0x0b, 0x2a, 0x12, 0x87, 0x3a, 0x36, 0xb2, 0x3d, 0xd3, 0x8b, 0x8c,
-	0x44, 0x39, 0x57, 0x05, 0xa2, 0x67, 0xe9, 0x7f, 0x92, 0x03, 0x6d, 0xa4, 0x54, 0xb3, 0x6f, 0x47,
-	0xe6, 0xa5, 0xdb, 0xff, 0x15, 0x00, 0x73, 0x3a, 0xf5, 0x97, 0xa2, 0x18, 0xb1, 0x78, 0x6a, 0x12,
-	0xd3, 0xb3, 0xd4, 0xb1, 0x29, 0x64, 0xc6, 0xe6, 0x35, 0x68, 0xc4, 0xf9, 0x88, 0x5a, 0x24, 0x6a,
-	0x5d, 0xe2, 0xe2, 0xd1, 0x09, 0x97, 0x13, 0x75, 0xd4, 0x2b, 0xe1, 0x92, 0x72, 0xeb, 0x7f, 0x94,
-	0x87, 0xea, 0xa3, 0xa5, 0x37, 0xc5, 0xa6, 0xb1, 0xd7, 0xa1, 0x38, 0x5b, 0x7a, 0x53, 0xb9, 0x2b,
-	0xa4, 0x0e, 0x48, 0x56, 0x04, 0x27, 0x22, 0xee, 0x44, 0x33, 0x38, 0xc2, 0x1d, 0xbc, 0xb2, 0x13,
-	0x11, 0xaf, 0xff, 0x93, 0x9c, 0x28, 0xf1, 0x91, 0x6b, 0x1e, 0xb1, 0x2a, 0x14, 0x07, 0xc3, 0x41,
-	0x57, 0x5b, 0x63, 0x0d, 0xa8, 0xf6, 0x06, 0xe3, 0x2e, 0x1f, 0xb4, 0xfb, 0x5a, 0x8e, 0x16, 0xee,
-	0xb8, 0xbd, 0xd3, 0xef, 0x6a, 0x79, 0xa4, 0x3c, 0x1d, 0xf6, 0xdb, 0xe3, 0x5e, 0xbf, 0xab, 0x15,
-	0x05, 0x85, 0xf7, 0x3a, 0x63, 0xad, 0xca, 0x34, 0x68, 0x1c, 0xf0, 0xe1, 0xee, 0x61, 0xa7, 0x6b,
-	0x0c, 0x0e, 0xfb, 0x7d, 0x4d, 0x63, 0xd7, 0x60, 0x23, 0xc1, 0x0c, 0x05, 0x72, 0x1b, 0xb3, 0x3c,
-	0x6d, 0xf3, 0x36, 0x7f, 0xac, 0xfd, 0x9c, 0x55, 0xa1, 0xd0, 0x7e, 0xfc, 0x58, 0xfb, 0x25, 0xee,
-	0x81, 0xda, 0xb3, 0xde, 0xc0, 0x78, 0xda, 0xee, 0x1f, 0x76, 0xb5, 0x5f, 0xe6, 0x63, 0x78, 0xc8,
-	0x77, 0xbb, 0x5c, 0xfb, 0x65, 0x11, 0xe1, 0xfd, 0xe1, 0x60, 0x38, 0x1e, 0x0e, 0x7a, 0x1d, 0xed,
-	0x97, 0x55, 0xfd, 0x2f, 0x8a, 0x50, 0xc4, 0x6e, 0x7c, 0xb7, 0x68, 0x60, 0x2f, 0x43, 0x6e, 0x4a,
-	0xb3, 0x53, 0x7f, 0x58, 0x17, 0x34, 0xb2, 0x6f, 0xf6, 0xd6, 0x78, 0x0e, 0xc7, 0x26, 0x27, 0xf6,
-	0x78, 0xfd, 0xe1, 0xba, 0x5c, 0x37, 0x52, 0x1b, 0x20, 0x7d, 0xc1, 0x6e, 0x43, 0xee, 0xb9, 0xdc,
-	0xf0, 0x0d, 0x41, 0x17, 0xfa, 0x00, 0xa9, 0xcf, 0xd9, 0x36, 0x14, 0xa6, 0xbe, 0xb0, 0x5d, 0x12,
-	0xba, 0x10, 0xa9, 0x7b, 0x6b, 0x1c, 0x49, 0xec, 0x75, 0x28, 0x04, 0xe6, 0x29, 0x6d, 0x8e, 0x64,
-	0x7e, 0x12, 0x99, 0x8d, 0x4c, 0x81, 0x79, 0x8a, 0x8d, 0x98, 0xd1, 0x16, 0x49, 0x1a, 0x11, 0x4f,
-	0x30, 0x56, 0x33, 0x63, 0xdb, 0x90, 0x3b, 0xa5, 0x4d, 0x92, 0xa8, 0xeb, 0x67, 0x8e, 0x67, 0xf9,
-	0xa7, 0xa3, 0x85, 0x3d, 0x45, 0x8e, 0x53, 0xf6, 0x26, 0x14, 0xc2, 0xe5, 0x84, 0x36, 0x49, 0xfd,
-	0xe1, 0xe6, 0x8a, 0xb8, 0xc3, 0x8a, 0xc2, 0xe5, 0x84, 0xbd, 0x05, 0xc5, 0xa9, 0x1f, 0x04, 0x72,
-	0xa3, 0x68, 0x71, 0x83, 0x63, 0x3d, 0x80, 0xe6, 0x0b, 0xd2, 0xb1, 0xc2, 0x88, 0xac, 0x9f, 0x84,
-	0x29, 0x15, 0xc4, 0x58, 0x61, 0xc4, 0xde, 0x90, 0xd2, 0xbd, 0xa1, 0xb6, 0x3a, 0x96, 0xfd, 0x58,
-	0x0e, 0x52, 0x99, 0x0e, 0x85, 0xb9, 0x79, 0x46, 0xb6, 0x51, 0xc2, 0x14, 0x0b, 0x7d, 0x6c, 0xd3,
-	0xdc, 0x3c, 0x63, 0x6f, 0x40, 0x61, 0xe2, 0x78, 0x64, 0x26, 0x25, 0xb5, 0xed, 0x38, 0x9e, 0x19,
-	0x9c, 0xef, 0x9a, 0x91, 0x89, 0x5c, 0x13, 0xc7, 0x43, 0x35, 0x66, 0x2e, 0xcf, 0x70, 0x9f, 0x6d,
-	0x08, 0x85, 0x63, 0x2e, 0xcf, 0x7a, 0x16, 0x8a, 0x2c, 0xcf, 0x7a, 0x4e, 0x76, 0x52, 0x8e, 0x63,
-	0x12, 0x0d, 0xec, 0xd0, 0x76, 0xed, 0x69, 0xe4, 0x3c, 0x77, 0xa2, 0x73, 0x32, 0x8e, 0x72, 0x5c,
-	0x45, 0xed, 0x94, 0xa1, 0x68, 0x9f, 0x2d, 0x02, 0x7d, 0x1b, 0x20, 0xad, 0x07, 0x37, 0xb8, 0x65,
-	0x46, 0x26, 0x2d, 0xa2, 0x06, 0xa7, 0xb4, 0x7e, 0x13, 0x6a, 0x89, 0x09, 0xc5, 0x1a, 0x90, 0x33,
-	0xa5, 0x60, 0xcd, 0x99, 0xfa, 0x5d, 0xb4, 0x68, 0x62, 0x23, 0x29, 0x4b, 0x43, 0x28, 0x16, 0xb7,
-	0xb9, 0x89, 0xfe, 0x13, 0x68, 0x70, 0x3b, 0x5c, 0xba, 0x51, 0xc7, 0x77, 0x77, 0xed, 0x19, 0x7b,
-	0x17, 0x20, 0x81, 0x43, 0xa9, 0x1d, 0xd3, 0xa5, 0xb3, 0x6b, 0xcf, 0xb8, 0x42, 0xd7, 0xff, 0x75,
-	0x81, 0x6c, 0x86, 0x5d, 0xa1, 0xe0, 0xa5, 0x26, 0xcf, 0x29, 0x9a, 0x3c, 0x91, 0x4c, 0xf9, 0xac,
-	0x61, 0x72, 0xec, 0x58, 0x96, 0xed, 0xc5, 0x06, 0x88, 0x80, 0x70, 0xac, 0x4d, 0xf7, 0x88, 0xd6,
-	0xf3, 0xfa, 0x43, 0x16, 0x57, 0x3a, 0x5f, 0x04, 0x76, 0x18, 0x8a, 0x0d, 0x63, 0xba, 0x47, 0xf1,
-	0x76, 0x2a, 0x5d, 0xbe, 0x9d, 0x6e, 0x42, 0xd5, 0xf3, 0x23, 0x83, 0x1c, 0x83, 0x32, 0x95, 0x5e,
-	0x91, 0xee, 0x0b, 0x7b, 0x1b, 0x2a, 0xd2, 0xa4, 0x93, 0xab, 0x59, 0x8a, 0xe2, 0x5d, 0x81, 0xe4,
-	0x31, 0x95, 0xb5, 0xd0, 0xac, 0x98, 0xcf, 0x6d, 0x2f, 0x8a, 0x65, 0xbf, 0x04, 0xd9, 0x8f, 0xa0,
-	0xe6, 0x7b, 0x86, 0xb0, 0xfb, 0xe4, 0x72, 0x96, 0xeb, 0x66, 0xe8, 0x1d, 0x12, 0x96, 0x57, 0x7d,
-	0x99, 0xc2, 0xa6, 0xb8, 0xfe, 0xa9, 0x31, 0x35, 0x03, 0x8b, 0x96, 0x74, 0x95, 0x57, 0x5c, 0xff,
-	0xb4, 0x63, 0x06, 0x96, 0xd0, 0x85, 0xdf, 0x78, 0xcb, 0x39, 0x2d, 0xe3, 0x26, 0x97, 0x10, 0xbb,
-	0x0d, 0xb5, 0xa9, 0xbb, 0x0c, 0x23, 0x3b, 0xd8, 0x39, 0x17, 0x96, 0x3c, 0x4f, 0x11, 0xd8, 0xae,
-	0x45, 0xe0, 0xcc, 0xcd, 0xe0, 0x9c, 0xd6, 0x6c, 0x95, 0xc7, 0x20, 0x5a, 0x28, 0x8b, 0x13, 0xc7,
-	0x3a, 0x13, 0xe6, 0x3c, 0x17, 0x00, 0xf2, 0x1f, 0xdb, 0xa6, 0x65, 0x07, 0x21, 0x2d, 0xcb, 0x2a,
-	0x8f, 0x41, 0x9a, 0x01, 0x4a, 0xd2, 0xda, 0xac, 0x71, 0x09, 0xe9, 0xdf, 0x40, 0x45, 0x8e, 0x06,
-	0x8a, 0x65, 0x5c, 0x87, 0x59, 0xb1, 0x25, 0xc4, 0x32, 0xe2, 0xd9, 0xeb, 0xd0, 0xf4, 0x03, 0xe7,
-	0xc8, 0xf1, 0x8c, 0x30, 0x0a, 0x1c, 0xef, 0x48, 0xce, 0x70, 0x43, 0x20, 0x47, 0x84, 0x43, 0x5d,
-	0x82, 0x33, 0x61, 0x98, 0x13, 0xc7, 0xc5, 0xf5, 0x5e, 0x90, 0x0e, 0xe5, 0xd2, 0x75, 0xdb, 0x02,
-	0xa5, 0x0f, 0xa1, 0x1a, 0x8f, 0xdd, 0x6f, 0xa5, 0x4e, 0xfd, 0x77, 0xa0, 0xde, 0xf3, 0x2c, 0xfb,
-	0x6c, 0x48, 0xea, 0x91, 0xbd, 0x0b, 0x6c, 0x1a, 0xd8, 0x66, 0x64, 0x1b, 0xf6, 0x59, 0x14, 0x98,
-	0x86, 0x70, 0x3a, 0x85, 0xcf, 0xa8, 0x09, 0x4a, 0x17, 0x09, 0x63, 0xc4, 0xeb, 0xff, 0x3e, 0x07,
-	0xcd, 0x03, 0x31, 0xa8, 0x4f, 0xec, 0xf3, 0x5d, 0x61, 0x59, 0x4f, 0xe3, 0xad, 0x50, 0xe4, 0x94,
-	0x66, 0x77, 0xa0, 0xbe, 0x38, 0xb1, 0xcf, 0x8d, 0x8c, 0xe9, 0x5a, 0x43, 0x54, 0x87, 0x16, 0xfd,
-	0x3b, 0x50, 0xf6, 0xa9, 0x76, 0x29, 0xbb, 0xa5, 0xc8, 0x53, 0x9a, 0xc5, 0x25, 0x03, 0xd3, 0xa1,
-	0x99, 0x14, 0xa5, 0xaa, 0x5b, 0x59, 0x18, 0xa9, 0xdb, 0x2d, 0x28, 0x21, 0x29, 0x6c, 0x95, 0xb6,
-	0x0b, 0x68, 0x7f, 0x12, 0xc0, 0xde, 0x83, 0xe6, 0xd4, 0x9f, 0x2f, 0x8c, 0x38, 0xbb, 0x94, 0xe2,
-	0xd9, 0xcd, 0x5a, 0x47, 0x96, 0x03, 0x51, 0x96, 0xfe, 0xb7, 0xf3, 0x50, 0xa5, 0x36, 0xc8, 0xfd,
-	0xea, 0x58, 0x67, 0xf1, 0x7e, 0xad, 0xf1, 0x92, 0x63, 0xa1, 0xc8, 0x7a, 0x05, 0xc0, 0x41, 0x16,
-	0x43, 0xd9, 0xb5, 0x35, 0xc2, 0xc4, 0x4d, 0x59, 0x98, 0x41, 0x14, 0xb6, 0x0a, 0xa2, 0x29, 0x04,
-	0xe0, 0x72, 0x5a, 0x7a, 0xce, 0x37, 0x4b, 0xd1, 0xfa, 0x2a, 0x97, 0x10, 0xbb, 0x0b, 0x9a, 0x28,
-	0x8c, 0x06, 0x5d, 0xb5, 0x17, 0xd6, 0x09, 0x4f, 0x63, 0x1e, 0x1b, 0x64, 0x82, 0xc7, 0x3e, 0x43,
-	0xb9, 0x2d, 0x76, 0x2e, 0x10, 0xaa, 0x8b, 0x18, 0x75, 0x4f, 0x56, 0xb2, 0x7b, 0xb2, 0x05, 0x95,
-	0xe7, 0x4e, 0xe8, 0xe0, 0xac, 0x56, 0xc5, 0x2a, 0x97, 0xa0, 0x32, 0x0d, 0xb5, 0x17, 0x4c, 0x83,
-	0xfe, 0xaf, 0xf2, 0xd0, 0x7c, 0xe4, 0x07, 0xb6, 0x73, 0xe4, 0xa5, 0xf3, 0xbe, 0x62, 0x52, 0xc5,
-	0x6b, 0x21, 0xaf, 0xac, 0x85, 0x57, 0xa1, 0x3e, 0x13, 0x19, 0x8d, 0x68, 0x22, 0x5c, 0xaa, 0x22,
-	0x07, 0x89, 0x1a, 0x4f, 0x5c, 0xdc, 0x03, 0x31, 0x03, 0x65, 0x2e, 0x52, 0xe6, 0x38, 0x13, 0x8a,
-	0x51, 0xf6, 0x39, 0x89, 0x15, 0xcb, 0x76, 0xed, 0x48, 0x0c, 0xd0, 0xfa, 0xc3, 0x57, 0xa4, 0xa6,
-	0x55, 0xdb, 0x74, 0x9f, 0xdb, 0xb3, 0x36, 0x29, 0x5e, 0x94, 0x32, 0xbb, 0xc4, 0x2e, 0xf3, 0x4a,
-	0x91, 0x54, 0xfe, 0x0d, 0xf3, 0x8a, 0xfd, 0xa6, 0x8f, 0xa1, 0x96, 0xa0, 0xd1, 0x6c, 0xe2, 0x5d,
-	0x69, 0x2a, 0xad, 0xb1, 0x3a, 0x54, 0x3a, 0xed, 0x51, 0xa7, 0xbd, 0xdb, 0xd5, 0x72, 0x48, 0x1a,
-	0x75, 0xc7, 0xc2, 0x3c, 0xca, 0xb3, 0x0d, 0xa8, 0x23, 0xb4, 0xdb, 0x7d, 0xd4, 0x3e, 0xec, 0x8f,
-	0xb5, 0x02, 0x6b, 0x42, 0x6d, 0x30, 0x34, 0xda, 0x9d, 0x71, 0x6f, 0x38, 0xd0, 0x8a, 0xfa, 0xcf,
-	0xa1, 0xda, 0x39, 0xb6, 0xa7, 0x27, 0x57, 0x8d, 0x22, 0x79, 0x2a, 0xf6, 0xf4, 0x44, 0x5a, 0x3d,
-	0x17, 0x3c, 0x15, 0x7b, 0x7a, 0xa2, 0x3f, 0x85, 0x46, 0x27, 0x96, 0x7a, 0x57, 0x95, 0xf2, 0x10,
-	0xd6, 0x69, 0xf9, 0x4f, 0x27, 0xf1, 0xfa, 0xcf, 0x5f, 0xb2, 0xfe, 0x1b, 0xc8, 0xd3, 0x99, 0xc8,
-	0x0d, 0xf0, 0x11, 0xd4, 0x0f, 0x02, 0x7f, 0x61, 0x07, 0x11, 0x15, 0xab, 0x41, 0xe1, 0xc4, 0x3e,
-	0x97, 0xa5, 0x62, 0x32, 0xf5, 0xf4, 0xf2, 0xaa, 0xa7, 0xf7, 0x10, 0xaa, 0x71, 0xb6, 0xdf, 0x38,
-	0xcf, 0xcf, 0x50, 0x8e, 0x50, 0x1e, 0xc7, 0x0e, 0xb1, 0xb2, 0xfb, 0x00, 0x8b, 0x04, 0x21, 0x15,
-	0x6b, 0x6c, 0xd3, 0xc9, 0xc2, 0xb9, 0xc2, 0xa1, 0xff, 0x65, 0x01, 0xd6, 0x0f, 0xcc, 0x20, 0x72,
-	0x70, 0x72, 0xc4, 0x30, 0xbc, 0x0d, 0xc5, 0xe8, 0x7c, 0x61, 0x4b, 0xb7, 0xf1, 0x5a, 0x62, 0x10,
-	0x0a, 0x1e, 0xd2, 0x81, 0xc4, 0xc0, 0x3e, 0x87, 0xf5, 0x45, 0x8c, 0x36, 0x48, 0xa2, 0x8a, 0xb1,
-	0xb9, 0x98, 0x85, 0xc6, 0xbc, 0xb9, 0x50, 0x41, 0xf6, 0x53, 0xd8, 0xca, 0xe6, 0xb5, 0xc3, 0x30,
-	0x95, 0x64, 0xea, 0x64, 0x5d, 0xcb, 0x64, 0x14, 0x6c, 0xac, 0x03, 0x9b, 0x69, 0xf6, 0xa9, 0xef,
-	0x2e, 0xe7, 0x5e, 0x28, 0x2d, 0xd4, 0x1b, 0x17, 0x6a, 0xef, 0x08, 0x2a, 0xd7, 0x16, 0x17, 0x30,
-	0x4c, 0x87, 0x46, 0x82, 0x1b, 0x2c, 0xe7, 0xb4, 0x25, 0x8a, 0x3c, 0x83, 0x63, 0x1f, 0x00, 0x24,
-	0x70, 0xd8, 0x2a, 0xd3, 0x78, 0x5e, 0xec, 0x5f, 0x2f, 0xb2, 0xe7, 0x5c, 0x61, 0x43, 0xfd, 0x6a,
-	0xba, 0x47, 0x7e, 0xe0, 0x44, 0xc7, 0x73, 0x92, 0x23, 0x05, 0x9e, 0x22, 0x48, 0x5c, 0x85, 0x06,
-	0x7a, 0x36, 0x49, 0x16, 0x29, 0x52, 0xd6, 0x9d, 0x70, 0xb4, 0x9c, 0x24, 0xe5, 0xa2, 0x22, 0x4a,
-	0x7b, 0x39, 0x0f, 0x8f, 0xa4, 0xff, 0x97, 0xb6, 0x70, 0x3f, 0x3c, 0x62, 0x0f, 0xe1, 0x7a, 0xca,
-	0x94, 0x4a, 0xc0, 0xb0, 0x05, 0x24, 0x3b, 0xd3, 0xe1, 0x4b, 0xc4, 0x60, 0xa8, 0x7f, 0x01, 0xcd,
-	0xcc, 0xec, 0xbc, 0x50, 0x25, 0xde, 0x84, 0x2a, 0xfe, 0xa3, 0x42, 0x94, 0x0b, 0xb0, 0x82, 0xf0,
-	0x28, 0x0a, 0x74, 0x1b, 0xb4, 0x8b, 0x63, 0xcd, 0xde, 0xa0, 0x88, 0x09, 0x4d, 0xca, 0x6a, 0xe4,
-	0x23, 0x26, 0xa1, 0x8b, 0xbb, 0x3a, 0x89, 0x79, 0x6a, 0xf5, 0xca, 0x64, 0xe9, 0x7f, 0x96, 0x57,
-	0xda, 0x8c, 0x23, 0xce, 0xde, 0x54, 0x97, 0x9f, 0xb2, 0x71, 0xd3, 0x31, 0x23, 0x99, 0xff, 0x0e,
-	0x68, 0x7e, 0x60, 0x39, 0x9e, 0x49, 0x11, 0x1c, 0x31, 0xdc, 0x79, 0x32, 0x87, 0x36, 0x24, 0xfe,
-	0x40, 0xa2, 0xd1, 0x6c, 0xb6, 0xec, 0xc4, 0xe5, 0x95, 0x0e, 0xab, 0x8a, 0x52, 0xf5, 0x43, 0x31,
-	0xab, 0x1f, 0xde, 0x86, 0x9a, 0x6b, 0x87, 0xa1, 0x11, 0x1d, 0x9b, 0x1e, 0x69, 0xd0, 0x6c, 0xa7,
-	0xab, 0x48, 0x1c, 0x1f, 0x9b, 0x1e, 0x32, 0x3a, 0x9e, 0x21, 0x43, 0xcf, 0xe5, 0x55, 0x46, 0xc7,
-	0x23, 0xcf, 0x00, 0x35, 0xef, 0xd6, 0x65, 0x13, 0x2b, 0x15, 0x13, 0x5b, 0x9d, 0x57, 0xfd, 0x15,
-	0xa8, 0x3c, 0x75, 0xec, 0x53, 0x29, 0xcb, 0x9e, 0x3b, 0xf6, 0x69, 0x2c, 0xcb, 0x30, 0xad, 0xff,
-	0x59, 0x15, 0xaa, 0xc4, 0xbc, 0x7b, 0x75, 0xa4, 0xec, 0xfb, 0x18, 0xd2, 0xdb, 0x52, 0x4f, 0x15,
-	0x2f, 0x31, 0xdf, 0x85, 0xd6, 0x7a, 0x05, 0x84, 0x72, 0x35, 0x48, 0xa0, 0x08, 0x9d, 0x5c, 0x23,
-	0x8c, 0x8c, 0x66, 0xd5, 0x84, 0x69, 0x14, 0x7e, 0xe3, 0xca, 0xd0, 0x49, 0x8a, 0x60, 0xf7, 0xa1,
-	0x8a, 0x2d, 0x24, 0xd7, 0xbe, 0xa2, 0x0a, 0x16, 0xea, 0x43, 0xec, 0x1c, 0xf2, 0x4a, 0x34, 0x71,
-	0x11, 0x20, 0x0d, 0x6d, 0x07, 0x61, 0xbc, 0x9d, 0x9a, 0x3c, 0x06, 0x51, 0xa2, 0xa1, 0xf9, 0x22,
-	0x9d, 0xb9, 0x78, 0xfb, 0xaa, 0xf6, 0x17, 0x27, 0x06, 0x76, 0x17, 0x2a, 0x64, 0x31, 0xd8, 0x61,
-	0xab, 0xa1, 0x8a, 0xce, 0xd8, 0x9c, 0xe1, 0x31, 0x99, 0xbd, 0x03, 0xa5, 0xd9, 0x89, 0x7d, 0x1e,
-	0xb6, 0x9a, 0xaa, 0x48, 0xc8, 0xe8, 0x42, 0x2e, 0x38, 0xd8, 0x1b, 0xb0, 0x1e, 0xd8, 0x33, 0x83,
-	0xa2, 0x63, 0xa8, 0xbc, 0xc3, 0xd6, 0x3a, 0xe9, 0xe6, 0x46, 0x60, 0xcf, 0x3a, 0x88, 0x1c, 0x4f,
-	0xdc, 0x90, 0xbd, 0x05, 0x65, 0xd2, 0x4a, 0x68, 0x44, 0x2b, 0x35, 0xc7, 0x2a, 0x8e, 0x4b, 0x2a,
-	0x7b, 0x08, 0xb5, 0x54, 0x6c, 0x5c, 0xa7, 0x0e, 0x6d, 0x5d, 0x90, 0x47, 0x24, 0xc6, 0x79, 0xca,
-	0xc6, 0xde, 0x07, 0x90, 0xe6, 0xbd, 0x31, 0x39, 0xa7, 0xc0, 0x72, 0x3d, 0x71, 0x7c, 0x14, 0x05,
-	0xa8, 0x3a, 0x01, 0x6f, 0x43, 0x09, 0xb5, 0x44, 0xd8, 0x7a, 0x89, 0x5a, 0xb3, 0x99, 0x55, 0x21,
-	0xd4, 0x3b, 0xa2, 0xb3, 0xbb, 0x50, 0xc5, 0xc5, 0x65, 0xe0, 0x14, 0xb6, 0x54, 0x7f, 0x47, 0xae,
-	0x44, 0xb4, 0x93, 0xec, 0xd3, 0xd1, 0x37, 0x2e, 0xbb, 0x07, 0x45, 0xcb, 0x9e, 0x85, 0xad, 0x9b,
-	0x54, 0xe2, 0x0d, 0x65, 0x2e, 0xd1, 0x70, 0xd8, 0xb5, 0x67, 0x42, 0xb5, 0x20, 0x0f, 0xdb, 0x83,
-	0x75, 0x5c, 0x7a, 0x0f, 0xc9, 0xf4, 0xc5, 0x21, 0x6f, 0xdd, 0xa2, 0x5c, 0xaf, 0x5d, 0xc8, 0x35,
-	0x90, 0x4c, 0x34, 0x41, 0x5d, 0x2f, 0x0a, 0xce, 0x79, 0xd3, 0x53, 0x71, 0xec, 0x16, 0x54, 0x9d,
-	0xb0, 0xef, 0x4f, 0x4f, 0x6c, 0xab, 0xf5, 0xb2, 0x38, 0x48, 0x8a, 0x61, 0xf6, 0x19, 0x34, 0x69,
-	0x31, 0x22, 0x88, 0x95, 0xb7, 0x6e, 0xab, 0x2a, 0x6f, 0xac, 0x92, 0x78, 0x96, 0x13, 0xcd, 0x2d,
-	0x27, 0x34, 0x22, 0x7b, 0xbe, 0xf0, 0x03, 0xf4, 0x94, 0x5e, 0x11, 0x2e, 0x87, 0x13, 0x8e, 0x63,
-	0x14, 0xca, 0xf9, 0xe4, 0x0c, 0xcb, 0xf0, 0x67, 0xb3, 0xd0, 0x8e, 0x5a, 0x77, 0x68, 0xaf, 0xad,
-	0xc7, 0x47, 0x59, 0x43, 0xc2, 0xde, 0x7a, 0x4c, 0xfe, 0x10, 0x95, 0xfb, 0xd1, 0x05, 0xfd, 0x9d,
-	0x59, 0xb0, 0x8a, 0xa2, 0xdf, 0x5b, 0x53, 0xd5, 0xf8, 0x4e, 0x09, 0x0a, 0x96, 0x3d, 0xbb, 0xf5,
-	0x73, 0x60, 0xab, 0x23, 0xf2, 0x22, 0x63, 0xa2, 0x24, 0x8d, 0x89, 0xcf, 0xf3, 0x9f, 0xe6, 0xf4,
-	0xcf, 0xa0, 0x99, 0xd9, 0x5e, 0x97, 0x1a, 0x45, 0xc2, 0x3c, 0x37, 0x45, 0xc4, 0xbf, 0xc1, 0x05,
-	0xa0, 0xff, 0x49, 0x01, 0x1a, 0x7b, 0x66, 0x78, 0xbc, 0x6f, 0x2e, 0x46, 0x91, 0x19, 0x85, 0x38,
-	0x46, 0xc7, 0x66, 0x78, 0x3c, 0x37, 0x17, 0x22, 0x1a, 0x9c, 0x13, 0x61, 0x08, 0x89, 0x1b, 0x39,
-	0xdf, 0xda, 0x38, 0x3b, 0x08, 0x0e, 0xbd, 0x83, 0x27, 0xf2, 0xf8, 0x20, 0x81, 0x71, 0x3f, 0x87,
-	0xc7, 0xcb, 0xd9, 0xcc, 0xb5, 0xa5, 0xdc, 0x89, 0x41, 0xf6, 0x06, 0x34, 0x65, 0x92, 0x1c, 0xa1,
-	0x33, 0x79, 0x10, 0x98, 0x45, 0xb2, 0x0f, 0xa0, 0x2e, 0x11, 0xe3, 0x58, 0xfa, 0xac, 0x27, 0x61,
-	0xa1, 0x94, 0xc0, 0x55, 0x2e, 0xf6, 0x0b, 0xb8, 0xae, 0x80, 0x8f, 0xfc, 0x60, 0x7f, 0xe9, 0x46,
-	0x4e, 0x67, 0x20, 0x6d, 0xde, 0x97, 0x57, 0xb2, 0xa7, 0x2c, 0xfc, 0xf2, 0x9c, 0xd9, 0xd6, 0xee,
-	0x3b, 0x9e, 0xb4, 0x08, 0xb2, 0xc8, 0x0b, 0x5c, 0xe6, 0x19, 0xc9, 0xb0, 0x2c, 0x97, 0x79, 0x86,
-	0x2b, 0x56, 0x22, 0xf6, 0xed, 0xe8, 0xd8, 0xb7, 0xc8, 0x22, 0x48, 0x56, 0xec, 0x48, 0x25, 0xf1,
-	0x2c, 0xa7, 0xfe, 0x9f, 0x73, 0x50, 0x12, 0xf3, 0xf2, 0x32, 0xd4, 0x26, 0xae, 0x3f, 0x3d, 0x31,
-	0xbc, 0xe5, 0x3c, 0x0e, 0xfc, 0x12, 0x02, 0x0d, 0x1e, 0x72, 0x3e, 0xc2, 0x88, 0x66, 0x23, 0xc7,
-	0x29, 0x8d, 0x0a, 0xc0, 0x5f, 0x46, 0x53, 0x2f, 0xa2, 0x89, 0xc8, 0x71, 0x09, 0xe1, 0x0c, 0x05,
-	0xfe, 0x29, 0xcd, 0x6d, 0x91, 0x08, 0x31, 0x48, 0xb1, 0x65, 0x12, 0xfc, 0x98, 0xa9, 0x44, 0xb4,
-	0x2a, 0x21, 0x3a, 0x5e, 0x74, 0x31, 0x3a, 0x55, 0x5e, 0x89, 0x4e, 0xb1, 0x8f, 0x93, 0x95, 0x43,
-	0x2d, 0x96, 0xe2, 0x5f, 0x8a, 0x2c, 0x75, 0x8d, 0xf1, 0x0c, 0x9f, 0xfe, 0x0c, 0x80, 0xfb, 0xa7,
-	0xa1, 0x1d, 0x91, 0x51, 0xf3, 0x12, 0x35, 0x2f, 0x73, 0xa0, 0xe3, 0x9f, 0x1e, 0xf8, 0x61, 0x7c,
-	0xc4, 0x95, 0x4f, 0x8e, 0xb8, 0x12, 0xfb, 0xa7, 0x70, 0xb9, 0xfd, 0xa3, 0x3f, 0x80, 0x0a, 0x2a,
-	0x36, 0x33, 0x32, 0xd9, 0x1b, 0x49, 0x8c, 0xac, 0x90, 0xc6, 0xea, 0xd2, 0x5a, 0x65, 0xd4, 0xec,
-	0x41, 0xdc, 0x12, 0xca, 0xf3, 0x9a, 0xe2, 0xdd, 0x27, 0x02, 0x52, 0x16, 0x28, 0x54, 0xa5, 0xfe,
-	0x1f, 0x72, 0x50, 0x1f, 0x06, 0x16, 0x0a, 0xdf, 0xd1, 0xc2, 0x9e, 0xbe, 0xd0, 0x22, 0x43, 0xdd,
-	0xe9, 0xbb, 0xae, 0x99, 0xd8, 0x33, 0xa8, 0x3b, 0x63, 0x04, 0x7b, 0x1f, 0x8a, 0x33, 0xd7, 0x3c,
-	0xa2, 0xfe, 0x24, 0x9e, 0x9a, 0x52, 0x7c, 0x9c, 0x7e, 0xe4, 0x9a, 0x47, 0x9c, 0x58, 0xf5, 0xdf,
-	0x4f, 0xea, 0xa7, 0x10, 0xb8, 0x1a, 0xf8, 0x5e, 0xa3, 0xc3, 0x96, 0x51, 0x47, 0xcb, 0xb1, 0x2a,
-	0x14, 0x77, 0xbb, 0xa3, 0x8e, 0xf0, 0xcf, 0xd0, 0x53, 0x1b, 0x19, 0x8f, 0x7a, 0x7c, 0x34, 0xd6,
-	0x8a, 0x74, 0x7a, 0x43, 0x88, 0x7e, 0x7b, 0x34, 0xd6, 0xaa, 0x0c, 0xa0, 0x7c, 0x38, 0xe8, 0xfd,
-	0xe2, 0xb0, 0xab, 0x69, 0xfa, 0xbf, 0xcb, 0x01, 0xa4, 0xe1, 0x5a, 0xf6, 0x23, 0xa8, 0x9f, 0x12,
-	0x64, 0x28, 0x81, 0x7b, 0xb5, 0x8f, 0x20, 0xc8, 0xa4, 0xd7, 0x7f, 0xac, 0x98, 0xe9, 0xa8, 0xbf,
-	0x56, 0x23, 0xf8, 0xf5, 0x45, 0xaa, 0xfa, 0xd8, 0xbb, 0x50, 0xf5, 0xb1, 0x1f, 0xc8, 0x5a, 0x50,
-	0x95, 0x97, 0xd2, 0x7d, 0x5e, 0xf1, 0x05, 0x80, 0x7a, 0x6e, 0x16, 0xc4, 0x01, 0x91, 0x84, 0xf5,
-	0x11, 0xa2, 0x3a, 0xae, 0xb9, 0x0c, 0x6d, 0x2e, 0xe8, 0x89, 0x1c, 0x2c, 0x29, 0x47, 0x8f, 0xff,
-	0x30, 0x07, 0x75, 0x85, 0x95, 0x3d, 0xc8, 0x78, 0x4e, 0x2f, 0xaf, 0x94, 0x25, 0xd2, 0x8a, 0x07,
-	0xf5, 0x16, 0x94, 0xc2, 0xc8, 0x0c, 0x22, 0xe9, 0x38, 0x69, 0x4a, 0x8e, 0x1d, 0x7f, 0xe9, 0x59,
-	0x5c, 0x90, 0x99, 0x0e, 0x05, 0xdb, 0xb3, 0xe4, 0xea, 0x5c, 0xe5, 0x42, 0xa2, 0xbe, 0x0d, 0xb5,
-	0xa4, 0x78, 0x9c, 0x26, 0x3e, 0x7c, 0x36, 0xd2, 0xd6, 0x58, 0x0d, 0x4a, 0xbc, 0x3d, 0x78, 0xdc,
-	0xd5, 0x72, 0xfa, 0x3f, 0xce, 0x01, 0xa4, 0xb9, 0xd8, 0xfd, 0x4c, 0x6b, 0x6f, 0x5d, 0x2c, 0xf5,
-	0x3e, 0xfd, 0x2a, 0x8d, 0xbd, 0x0d, 0xb5, 0xa5, 0x47, 0x48, 0xdb, 0x92, 0xc2, 0x3a, 0x45, 0xb0,
-	0xdb, 0x50, 0x88, 0x6f, 0x3d, 0x5c, 0x38, 0x69, 0x7e, 0x6e, 0xba, 0xfa, 0xe7, 0x50, 0x4b, 0x8a,
-	0x43, 0x47, 0xfe, 0xd1, 0xb0, 0xdf, 0x1f, 0x3e, 0xeb, 0x0d, 0x1e, 0x6b, 0x6b, 0x08, 0x1e, 0xf0,
-	0x6e, 0xa7, 0xbb, 0x8b, 0x60, 0x0e, 0xd7, 0x55, 0xe7, 0x90, 0xf3, 0xee, 0x60, 0x6c, 0xf0, 0xe1,
-	0x33, 0x2d, 0xaf, 0xff, 0xad, 0x3c, 0x6c, 0x0e, 0xbd, 0xdd, 0xe5, 0xc2, 0x75, 0xa6, 0x66, 0x64,
-	0x3f, 0xb1, 0xcf, 0x3b, 0xd1, 0x19, 0xfb, 0x51, 0x2c, 0x61, 0x2c, 0x7b, 0x26, 0x17, 0xd0, 0x7a,
-	0xd6, 0x38, 0x90, 0x12, 0x67, 0x97, 0x0e, 0x42, 0x35, 0xdf, 0x33, 0xac, 0xb8, 0x08, 0xc3, 0xb1,
-	0xce, 0x68, 0x19, 0x95, 0xf8, 0xba, 0x9f, 0x96, 0x8c, 0x4a, 0xe3, 0x4b, 0xd8, 0xcc, 0x70, 0x4a,
-	0xa9, 0x80, 0xcb, 0xe8, 0xdd, 0x38, 0x04, 0x7b, 0xa1, 0x29, 0x2a, 0x06, 0x7b, 0x2c, 0xcc, 0x90,
-	0x0d, 0x3f, 0x8b, 0xbd, 0x35, 0x80, 0xad, 0xcb, 0x18, 0x2f, 0xd1, 0xce, 0xdb, 0xaa, 0x76, 0xbe,
-	0x10, 0xb9, 0x48, 0x35, 0xf5, 0x3f, 0xcd, 0x43, 0xad, 0xe7, 0x85, 0x76, 0x10, 0xe1, 0x70, 0xbc,
-	0x06, 0x85, 0x20, 0x19, 0x88, 0x95, 0x23, 0x30, 0xa4, 0xb1, 0x7b, 0xb0, 0x69, 0x5a, 0x96, 0x61,
-	0xce, 0x66, 0xf6, 0x34, 0xb2, 0x2d, 0x03, 0x65, 0xb5, 0x9c, 0xc7, 0x0d, 0xd3, 0xb2, 0xda, 0x12,
-	0x8f, 0x62, 0x4b, 0xfa, 0xa8, 0xb1, 0xd1, 0x28, 0x82, 0x99, 0x85, 0xd8, 0x47, 0x95, 0x36, 0x23,
-	0x8d, 0x73, 0x76, 0x1e, 0x8a, 0x2f, 0x98, 0x87, 0xfb, 0x70, 0xed, 0xa2, 0x4b, 0xe3, 0x58, 0x22,
-	0xe0, 0x58, 0xe4, 0x9b, 0x59, 0x8f, 0xa6, 0x67, 0x85, 0x57, 0xfb, 0xb6, 0xe5, 0x2b, 0x7d, 0xdb,
-	0xac, 0xd3, 0x8c, 0x13, 0x5d, 0x21, 0x31, 0x9f, 0xca, 0x90, 0x9e, 0x75, 0xa6, 0xff, 0xc7, 0x3c,
-	0x00, 0xb7, 0x17, 0xae, 0x39, 0xb5, 0xff, 0xff, 0x19, 0xbd, 0x57, 0xd1, 0x3d, 0x75, 0xed, 0xc8,
-	0x36, 0xa6, 0xbe, 0x67, 0xc5, 0x07, 0xd1, 0x02, 0xd5, 0xf1, 0x69, 0x47, 0x5f, 0x3a, 0xbc, 0xe5,
-	0xef, 0x3d, 0xbc, 0x95, 0xef, 0x31, 0xbc, 0xd5, 0x4b, 0x86, 0xf7, 0xbf, 0x15, 0xa0, 0xde, 0xf6,
-	0x4c, 0xf7, 0xfc, 0x5b, 0x9b, 0x8e, 0x9a, 0x29, 0xdc, 0xbb, 0x58, 0x46, 0x62, 0xd4, 0xc4, 0x19,
-	0x51, 0x8d, 0x30, 0x34, 0x5e, 0xaf, 0x42, 0xdd, 0x5f, 0x46, 0x09, 0x5d, 0x9c, 0x1a, 0x81, 0x40,
-	0x11, 0x43, 0x92, 0x9f, 0x6c, 0x8d, 0x82, 0x92, 0x9f, 0xac, 0xc8, 0x34, 0x7f, 0x62, 0x8b, 0x24,
-	0xf9, 0x89, 0xe1, 0x75, 0x68, 0x46, 0xce, 0x9c, 0xc6, 0x2d, 0x5c, 0xce, 0x6d, 0x31, 0x76, 0x05,
-	0x71, 0x77, 0xa7, 0x23, 0x71, 0x58, 0xca, 0xdc, 0x9e, 0xfb, 0xc1, 0xb9, 0x28, 0xa5, 0x2c, 0x4a,
-	0x11, 0x28, 0x2a, 0xe5, 0x5d, 0x60, 0xa7, 0xa6, 0x13, 0x19, 0xd9, 0xa2, 0x84, 0x35, 0xa7, 0x21,
-	0x65, 0xac, 0x16, 0x77, 0x03, 0xca, 0x96, 0x13, 0x9e, 0xf4, 0x86, 0xd2, 0x92, 0x93, 0x10, 0x9a,
-	0x46, 0xe1, 0x07, 0xbd, 0xa1, 0x31, 0x39, 0x97, 0x87, 0x3b, 0x05, 0x5e, 0x45, 0xc4, 0xce, 0x79,
-	0x44, 0xa1, 0x6c, 0x22, 0x8a, 0xde, 0xd2, 0x51, 0x38, 0x1d, 0xea, 0x14, 0xf8, 0x3a, 0xe2, 0x7b,
-	0x88, 0xee, 0x20, 0x16, 0xd7, 0x23, 0x71, 0xca, 0x8e, 0x0b, 0xd6, 0x3a, 0xb1, 0x6e, 0x20, 0x61,
-	0x48, 0x78, 0xc1, 0x7b, 0x1b, 0x6a, 0x9e, 0x1d, 0x9d, 0xfa, 0x01, 0xb6, 0xa6, 0x21, 0x46, 0x2f,
-	0x41, 0xa0, 0x0d, 0x1e, 0x4e, 0x4d, 0x0f, 0x1b, 0x4f, 0x07, 0x3e, 0xd8, 0x1e, 0x09, 0xb3, 0x3b,
-	0x38, 0xf0, 0x28, 0x63, 0x88, 0xba, 0x2e, 0x86, 0x24, 0xc5, 0xe8, 0xff, 0x68, 0x0b, 0x8a, 0x03,
-	0xdf, 0xb2, 0xd9, 0x7b, 0x50, 0xa3, 0x0b, 0x24, 0xab, 0x91, 0x43, 0x24, 0xd3, 0x0f, 0xa9, 0x92,
-	0xaa, 0x27, 0x53, 0x57, 0x5f, 0x39, 0x79, 0x8d, 0x94, 0x22, 0x05, 0xff, 0x95, 0xe3, 0x6a, 0x61,
-	0xee, 0x09, 0x0a, 0x36, 0x99, 0xdc, 0xe9, 0xc0, 0xf6, 0x28, 0xfa, 0x50, 0xe2, 0x09, 0x4c, 0xe6,
-	0x42, 0xe0, 0xe3, 0xde, 0x35, 0xe8, 0x70, 0xb6, 0x74, 0x89, 0xb9, 0x20, 0xe8, 0x74, 0x43, 0xe7,
-	0x3d, 0xa8, 0x7d, 0xed, 0x3b, 0x9e, 0x68, 0x78, 0x79, 0xa5, 0xe1, 0x5f, 0xf8, 0x8e, 0x08, 0x79,
-	0x56, 0xbf, 0x96, 0x29, 0xf6, 0x3a, 0x54, 0x7c, 0x4f, 0x94, 0x5d, 0x59, 0x29, 0xbb, 0xec, 0x7b,
-	0x7d, 0x71, 0xe8, 0xdb, 0x9c, 0x2c, 0xd1, 0xe1, 0x47, 0x56, 0x7b, 0x16, 0xc9, 0x08, 0x5f, 0x9d,
-	0x90, 0x43, 0xaf, 0x6f, 0xcf, 0x22, 0xb4, 0x82, 0x66, 0x8e, 0x8b, 0x22, 0x82, 0x0a, 0xab, 0xad,
-	0x14, 0x06, 0x82, 0x4c, 0x05, 0xbe, 0x09, 0xd5, 0xa3, 0xc0, 0x5f, 0x2e, 0xd0, 0xac, 0x81, 0xd5,
-	0x98, 0x1a, 0xd1, 0x76, 0xce, 0xb1, 0xf7, 0x94, 0x74, 0xbc, 0x23, 0x03, 0x1d, 0xce, 0xfa, 0x6a,
-	0xef, 0x63, 0xfa, 0xc8, 0xa6, 0x52, 0xcd, 0xa3, 0x23, 0x43, 0x9e, 0x62, 0xaf, 0x94, 0x6a, 0x1e,
-	0x1d, 0x51, 0xe5, 0xf7, 0xa1, 0x79, 0xea, 0x78, 0x46, 0xb8, 0xb0, 0xa7, 0x82, 0xb7, 0xb9, 0x5a,
-	0xec, 0xa9, 0xe3, 0xa1, 0x69, 0x45, 0xfc, 0xaa, 0x0d, 0xb6, 0xfe, 0x42, 0x1b, 0x6c, 0x1b, 0x4a,
-	0xae, 0x33, 0x77, 0x22, 0x3a, 0x3e, 0xbc, 0xa0, 0xef, 0x88, 0xc0, 0x74, 0x28, 0x4b, 0x07, 0x5a,
-	0x5b, 0x61, 0x91, 0x94, 0xac, 0x28, 0x65, 0x2f, 0x10, 0xa5, 0x0f, 0xa5, 0xe7, 0x8f, 0xcc, 0xc6,
-	0x73, 0x7b, 0xda, 0xba, 0xa6, 0x06, 0x5d, 0x92, 0x0c, 0xf5, 0x38, 0xc3, 0x53, 0x7b, 0xca, 0xee,
-	0x42, 0xc5, 0x9f, 0x7c, 0x6d, 0xa0, 0xa2, 0xd8, 0xba, 0x5c, 0x51, 0x94, 0xfd, 0xc9, 0xd7, 0xdc,
-	0x9e, 0xb1, 0xf7, 0xa1, 0x1e, 0x90, 0xf1, 0x6f, 0x90, 0xa7, 0x70, 0x5d, 0x35, 0xdb, 0x52, 0xaf,
-	0x80, 0x43, 0x90, 0x7a, 0x08, 0xaf, 0x43, 0x53, 0x1c, 0x1c, 0x8a, 0x93, 0xa2, 0x90, 0xa2, 0x34,
-	0x35, 0xde, 0x20, 0xa4, 0x38, 0x45, 0x0a, 0xd9, 0x7d, 0x80, 0x58, 0x01, 0x44, 0x67, 0x74, 0x05,
-	0x30, 0x69, 0x84, 0x38, 0xa6, 0xe9, 0x44, 0x67, 0xbc, 0x66, 0xc5, 0x49, 0x74, 0xc0, 0x27, 0x8e,
-	0x67, 0xe1, 0x5a, 0x88, 0xcc, 0xa3, 0xb0, 0xd5, 0xa2, 0xad, 0x52, 0x97, 0xb8, 0xb1, 0x79, 0x14,
-	0xb2, 0x0f, 0xa1, 0x61, 0x0a, 0x41, 0x2d, 0x6e, 0x0f, 0xdd, 0x54, 0xcd, 0x60, 0x45, 0x84, 0xf3,
-	0xba, 0xa9, 0xc8, 0xf3, 0x4f, 0x80, 0xc5, 0xa1, 0x39, 0xb2, 0x90, 0xc4, 0xa2, 0xb8, 0xb5, 0xb2,
-	0x28, 0x36, 0x64, 0x6c, 0x2e, 0xb9, 0x0f, 0xf7, 0x09, 0x34, 0xb3, 0x6a, 0xf1, 0xf6, 0x25, 0xc1,
-	0x28, 0x1a, 0x7e, 0xde, 0x98, 0xaa, 0x8a, 0xf2, 0x75, 0x68, 0x7a, 0x7e, 0x64, 0x4c, 0xcd, 0xe9,
-	0xb1, 0x4d, 0x19, 0x45, 0xc0, 0xa5, 0xe1, 0xf9, 0x51, 0x27, 0xc6, 0xe1, 0xf8, 0x08, 0xd9, 0x44,
-	0xe3, 0x73, 0x47, 0x1d, 0x9f, 0xc4, 0x52, 0x42, 0xbd, 0x11, 0x1b, 0x4d, 0x38, 0x4f, 0xc2, 0x08,
-	0xa0, 0x0c, 0xaf, 0x66, 0xe6, 0x29, 0xb1, 0x0e, 0x38, 0x04, 0xa9, 0xa5, 0xf0, 0x2a, 0xd4, 0x43,
-	0x7f, 0x19, 0x4c, 0x6d, 0x23, 0x8c, 0xec, 0x45, 0x6b, 0x9b, 0x46, 0x14, 0x04, 0x6a, 0x14, 0xd9,
-	0x0b, 0xf6, 0x29, 0xac, 0x2f, 0x02, 0xdb, 0x50, 0xe6, 0xe9, 0x35, 0xb5, 0x8b, 0x07, 0x81, 0x9d,
-	0x4e, 0x55, 0x63, 0xa1, 0x40, 0x71, 0x4e, 0xa5, 0x07, 0xfa, 0x85, 0x9c, 0x69, 0x27, 0x30, 0x67,
-	0x6a, 0xfc, 0xfd, 0x0c, 0x36, 0x95, 0x9c, 0xcb, 0x13, 0xca, 0xfc, 0x7a, 0x26, 0x36, 0x18, 0xb3,
-	0x1f, 0x9e, 0x60, 0xf6, 0xf5, 0x45, 0x06, 0x66, 0xed, 0x0b, 0xf6, 0x31, 0x1a, 0xa4, 0x6f, 0x50,
-	0xfe, 0x97, 0xae, 0x30, 0x7a, 0x33, 0x86, 0xf3, 0x13, 0x11, 0x52, 0xea, 0x85, 0x5d, 0xcf, 0x6a,
-	0xbd, 0x29, 0xee, 0x9f, 0x12, 0xc0, 0x3e, 0x80, 0x06, 0x45, 0x1a, 0x22, 0xba, 0x39, 0x13, 0xb6,
-	0xde, 0x52, 0x9d, 0x66, 0x0a, 0xa6, 0x11, 0x81, 0xd7, 0xdd, 0x24, 0x1d, 0xb2, 0x8f, 0x61, 0x53,
-	0xc4, 0x27, 0x54, 0xe9, 0xf8, 0xf6, 0xea, 0xe2, 0x22, 0xa6, 0x47, 0xa9, 0x88, 0xe4, 0x70, 0x33,
-	0x58, 0x7a, 0xa4, 0x9d, 0x65, 0xce, 0x45, 0xe0, 0x4f, 0x6c, 0x91, 0xff, 0x2e, 0xe5, 0x97, 0xdd,
-	0xe1, 0x82, 0x4d, 0xe4, 0x25, 0x61, 0x74, 0x23, 0x50, 0x51, 0x07, 0x98, 0xef, 0x8a, 0x32, 0x85,
-	0x58, 0xa7, 0x32, 0xdf, 0xf9, 0x3e, 0x65, 0xee, 0x60, 0x3e, 0x2a, 0x93, 0x41, 0x71, 0xb9, 0x74,
-	0xac, 0xd6, 0x3d, 0x71, 0xcb, 0x06, 0xd3, 0xfa, 0xbf, 0x2d, 0x42, 0x35, 0x56, 0x92, 0xac, 0x0e,
-	0x95, 0xc3, 0xc1, 0x93, 0xc1, 0xf0, 0xd9, 0x40, 0x5b, 0x43, 0xb7, 0x9a, 0x2e, 0x83, 0x19, 0xa3,
-	0x4e, 0x7b, 0x20, 0x2e, 0x49, 0xd2, 0x15, 0x34, 0x01, 0xe7, 0xd9, 0x26, 0x34, 0x1f, 0x1d, 0x0e,
-	0xe8, 0x54, 0x54, 0xa0, 0x0a, 0x88, 0xea, 0x7e, 0x29, 0x7c, 0x77, 0x81, 0x2a, 0x22, 0x6a, 0xbf,
-	0x3d, 0xee, 0xf2, 0x5e, 0x8c, 0x2a, 0xd1, 0x01, 0xeb, 0x98, 0x77, 0xdb, 0xfb, 0x02, 0x51, 0xc6,
-	0x6a, 0x0f, 0xf8, 0xf0, 0x8b, 0x6e, 0x67, 0xac, 0x01, 0xbb, 0x0e, 0x9b, 0x49, 0x19, 0x71, 0xf9,
-	0x5a, 0x9d, 0x35, 0xa0, 0x1a, 0x97, 0xa3, 0x6d, 0x61, 0xa9, 0xbc, 0xdb, 0x39, 0xe4, 0xa3, 0xde,
-	0xd3, 0xae, 0xd1, 0x19, 0x77, 0xb5, 0xeb, 0xe8, 0x79, 0x8e, 0x7a, 0x83, 0x27, 0xda, 0x0d, 0xf4,
-	0xeb, 0x30, 0x25, 0x4a, 0x7f, 0x89, 0x31, 0x58, 0x4f, 0x79, 0x09, 0xd7, 0xa2, 0xb0, 0xc2, 0xe3,
-	0xc7, 0xda, 0x1d, 0x2c, 0x76, 0xb7, 0x37, 0x1a, 0xf7, 0x06, 0x9d, 0xb1, 0xf6, 0x2a, 0x03, 0x28,
-	0x3f, 0xea, 0xf5, 0xc7, 0x5d, 0xae, 0x6d, 0x63, 0x79, 0x5f, 0x0c, 0x7b, 0x03, 0xed, 0x35, 0xba,
-	0x62, 0xd7, 0xde, 0x3f, 0xe8, 0x77, 0x35, 0x9d, 0x6a, 0x19, 0xf2, 0xb1, 0xf6, 0x3a, 0xfa, 0xb7,
-	0x87, 0x03, 0x6c, 0xdb, 0x1b, 0x58, 0x21, 0x25, 0x8d, 0x76, 0xbf, 0xaf, 0xbd, 0xa9, 0xc4, 0x1f,
-	0xde, 0xc2, 0xf4, 0xb3, 0xde, 0x60, 0x77, 0xf8, 0x4c, 0x7b, 0x1b, 0xd9, 0x76, 0xf8, 0xb0, 0xbd,
-	0xdb, 0x69, 0x8f, 0xc6, 0xda, 0x5d, 0x2c, 0x60, 0x74, 0xd0, 0xef, 0x8d, 0xb5, 0x77, 0x90, 0xeb,
-	0x71, 0x7b, 0xbc, 0xd7, 0xe5, 0xda, 0x3d, 0x4c, 0xb7, 0x47, 0xa3, 0x2e, 0x1f, 0x6b, 0x0f, 0x31,
-	0xdd, 0x1b, 0x50, 0xfa, 0x03, 0x4c, 0xef, 0x76, 0xfb, 0xdd, 0x71, 0x57, 0xfb, 0x10, 0x07, 0x8c,
-	0x77, 0x0f, 0xfa, 0xed, 0x4e, 0x57, 0xfb, 0x08, 0x81, 0xfe, 0xb0, 0xf3, 0xc4, 0x18, 0x1e, 0x68,
-	0x1f, 0x63, 0x1d, 0x14, 0x3d, 0x19, 0xe1, 0x60, 0x7e, 0x82, 0xe3, 0x94, 0x80, 0xd4, 0xba, 0x4f,
-	0xb1, 0xda, 0xfd, 0xde, 0xe0, 0x70, 0xa4, 0x7d, 0x86, 0xcc, 0x94, 0x24, 0xca, 0xe7, 0x6c, 0x0b,
-	0xb4, 0xe1, 0xc0, 0xd8, 0x3d, 0x3c, 0xe8, 0xf7, 0x3a, 0xed, 0x71, 0xd7, 0x78, 0xd2, 0xfd, 0x4a,
-	0xfb, 0x1d, 0x9c, 0xf6, 0x03, 0xde, 0x35, 0x64, 0x3b, 0x7e, 0x12, 0xc3, 0xb2, 0x2d, 0x3f, 0xc5,
-	0x2a, 0x52, 0xba, 0x71, 0xf8, 0x44, 0xfb, 0x5d, 0xfd, 0x6b, 0xa8, 0xc6, 0xe6, 0x0b, 0x56, 0xd7,
-	0x1b, 0x0c, 0xba, 0x5c, 0x5b, 0xc3, 0xb1, 0xeb, 0x77, 0x1f, 0x8d, 0xb5, 0x1c, 0xc5, 0x06, 0x7a,
-	0x8f, 0xf7, 0xc6, 0x5a, 0x1e, 0x93, 0xc3, 0x43, 0x1c, 0xf1, 0x02, 0x8d, 0x6d, 0x77, 0xbf, 0xa7,
-	0x15, 0x31, 0xd5, 0x1e, 0x8c, 0x7b, 0x5a, 0x89, 0xc6, 0xbe, 0x37, 0x78, 0xdc, 0xef, 0x6a, 0x65,
-	0xc4, 0xee, 0xb7, 0xf9, 0x13, 0xad, 0x82, 0x99, 0xda, 0x07, 0x07, 0xfd, 0xaf, 0xb4, 0xaa, 0x7e,
-	0x17, 0x2a, 0xed, 0xa3, 0xa3, 0x7d, 0x34, 0x05, 0xab, 0x50, 0x7c, 0x74, 0xd8, 0xef, 0x8b, 0xbb,
-	0xbd, 0x3b, 0xc3, 0xf1, 0x78, 0xb8, 0xaf, 0xe5, 0x70, 0xaa, 0xc7, 0xc3, 0x03, 0x2d, 0xaf, 0xff,
-	0x61, 0x01, 0x20, 0xdd, 0xf9, 0xec, 0x26, 0x54, 0x63, 0x4f, 0x45, 0x9e, 0x44, 0x55, 0x22, 0xe1,
-	0x9f, 0xb0, 0x87, 0x70, 0x43, 0xde, 0x3c, 0x92, 0x57, 0x60, 0xce, 0x0c, 0xc7, 0x33, 0x26, 0x66,
-	0x24, 0x0d, 0x46, 0x26, 0xa9, 0x22, 0xde, 0xdb, 0xf3, 0x76, 0xcc, 0x88, 0x3d, 0x84, 0x0d, 0x35,
-	0x4f, 0x74, 0xbe, 0xc8, 0x86, 0x24, 0x44, 0x2c, 0x3f, 0xcd, 0x38, 0x3e, 0x5f, 0xb0, 0xf7, 0xe0,
-	0x7a, 0x60, 0xcf, 0x02, 0x3b, 0x3c, 0x36, 0xa2, 0x50, 0xad, 0x46, 0x84, 0x95, 0x37, 0x25, 0x71,
-	0x1c, 0x26, 0xb5, 0xbc, 0x07, 0xd7, 0xa5, 0x34, 0xb8, 0xd0, 0x30, 0x71, 0xe1, 0x79, 0x53, 0x10,
-	0xd5, 0x76, 0xbd, 0x02, 0x20, 0x05, 0x61, 0xfc, 0x18, 0xa5, 0xca, 0x6b, 0x42, 0xe8, 0xa1, 0xe6,
-	0x7a, 0x17, 0x98, 0x13, 0x1a, 0x17, 0x9c, 0x31, 0x72, 0x2d, 0xaa, 0x5c, 0x73, 0xc2, 0x83, 0x8c,
-	0x23, 0x76, 0x95, 0x9f, 0x57, 0xbd, 0xca, 0xcf, 0xdb, 0x82, 0x12, 0xc9, 0x4a, 0x72, 0x37, 0xaa,
-	0x5c, 0x00, 0xfa, 0x3f, 0xcb, 0xc1, 0x7a, 0x56, 0x2f, 0x88, 0xe3, 0xcd, 0xf4, 0xdc, 0xb6, 0x94,
-	0x9e, 0xd5, 0xbe, 0x0c, 0xb5, 0xc5, 0x89, 0x3c, 0xa4, 0x95, 0xc3, 0x5f, 0x5d, 0x9c, 0x88, 0xc3,
-	0x59, 0xb4, 0x88, 0x17, 0x27, 0xc2, 0x82, 0x5e, 0x1d, 0xec, 0xf2, 0xe2, 0x24, 0x36, 0x9b, 0x97,
-	0x92, 0xa9, 0xb8, 0xca, 0xb4, 0x14, 0x4c, 0x19, 0x23, 0xae, 0xf4, 0xdd, 0x46, 0x9c, 0xbe, 0x0d,
-	0x0d, 0x55, 0x9d, 0x32, 0x0d, 0x0a, 0xe8, 0x90, 0x8a, 0x96, 0x63, 0x52, 0xff, 0xbb, 0x39, 0x62,
-	0xf9, 0x5e, 0x61, 0x92, 0x4c, 0x13, 0xf2, 0x2f, 0xb0, 0x23, 0xb7, 0x29, 0x50, 0x6d, 0xd0, 0x39,
-	0xcf, 0xd4, 0x8f, 0x9f, 0x3a, 0xc0, 0xb1, 0x19, 0xb6, 0x97, 0x91, 0xdf, 0xf1, 0x5d, 0x1c, 0x38,
-	0x27, 0x8c, 0x2f, 0xce, 0x14, 0xe3, 0x03, 0x28, 0x79, 0x33, 0xa6, 0x0b, 0x9b, 0x2b, 0x6a, 0x03,
-	0xbb, 0x11, 0x99, 0x47, 0xf1, 0x03, 0x8c, 0xc8, 0x3c, 0x4a, 0x62, 0xc1, 0xf9, 0x2b, 0xa2, 0xd3,
-	0xb7, 0xa1, 0xdc, 0x4b, 0x54, 0x4b, 0xf2, 0xde, 0xa0, 0x20, 0xdf, 0x18, 0xf8, 0x50, 0xeb, 0xd0,
-	0x7b, 0x85, 0x7d, 0x73, 0xc1, 0xee, 0x41, 0x61, 0x6e, 0x2e, 0x64, 0x20, 0xba, 0x95, 0x04, 0xa2,
-	0x05, 0xf5, 0xfe, 0xbe, 0xb9, 0x10, 0xd1, 0x2b, 0x64, 0xba, 0xf5, 0x31, 0x54, 0x63, 0xc4, 0xf7,
-	0x3a, 0x43, 0xfa, 0x9f, 0x79, 0xa8, 0xed, 0xaa, 0x46, 0xe8, 0xd4, 0xf4, 0x8c, 0x28, 0x58, 0x7a,
-	0x68, 0x2b, 0xc8, 0x3b, 0x71, 0x75, 0xf4, 0x30, 0x25, 0x2a, 0x9e, 0x95, 0xfc, 0x77, 0xcc, 0xca,
-	0x6d, 0x40, 0x6b, 0xd9, 0x70, 0x2c, 0x8a, 0x39, 0x88, 0xf7, 0x16, 0xd5, 0xc0, 0x3f, 0xed, 0x59,
-	0x3d, 0xeb, 0xec, 0xf2, 0xe0, 0x4c, 0xf1, 0x37, 0x0f, 0xce, 0x94, 0x2e, 0x0d, 0xce, 0xfc, 0xbf,
-	0x12, 0x4e, 0x61, 0x6f, 0xa5, 0x42, 0xed, 0xc4, 0x3e, 0x27, 0xb6, 0x9a, 0x38, 0xf1, 0x5a, 0x24,
-	0x87, 0xd8, 0x3d, 0xeb, 0x4c, 0xff, 0xf3, 0x3c, 0x94, 0x7e, 0xb1, 0xb4, 0x83, 0x73, 0xf6, 0x31,
-	0xd4, 0xc2, 0x68, 0x1e, 0xa9, 0xee, 0xf8, 0x4d, 0x31, 0xae, 0x44, 0x27, 0x6f, 0xda, 0x9e, 0xdb,
-	0x5e, 0x24, 0x7c, 0x5b, 0xe4, 0xa5, 0xfd, 0xb7, 0x85, 0xbe, 0xb7, 0xbd, 0x08, 0x65, 0x74, 0x54,
-	0x00, 0xe8, 0xa0, 0xa1, 0x6f, 0x1e, 0xca, 0x40, 0x28, 0xa4, 0xfe, 0x31, 0x17, 0x04, 0x74, 0xd0,
-	0xe8, 0x10, 0x30, 0xbe, 0x0c, 0x90, 0x71, 0xd0, 0x04, 0x85, 0xce, 0xfa, 0x6c, 0x13, 0x3d, 0x8f,
-	0xf8, 0x8a, 0x61, 0x02, 0xa3, 0xe0, 0x71, 0x7d, 0xd3, 0x1a, 0x9b, 0x47, 0xf1, 0x75, 0x5a, 0x09,
-	0xea, 0x16, 0x34, 0x33, 0x8d, 0xcd, 0x1a, 0x47, 0xa8, 0x97, 0xba, 0x7d, 0x54, 0xb2, 0x39, 0x45,
-	0x4b, 0xe7, 0x55, 0xcd, 0x5c, 0x50, 0x54, 0x36, 0xdd, 0xd3, 0x3f, 0x3c, 0xd8, 0x6d, 0x8f, 0xbb,
-	0x5a, 0x89, 0x54, 0x70, 0x97, 0x3f, 0xee, 0x6a, 0x65, 0xfd, 0xef, 0xe5, 0x61, 0x73, 0x1c, 0x98,
-	0x5e, 0x68, 0x8a, 0x3b, 0x27, 0x5e, 0x14, 0xf8, 0x2e, 0xfb, 0x1c, 0xaa, 0xd1, 0xd4, 0x55, 0x07,
-	0xf1, 0x55, 0x29, 0x09, 0x2e, 0xb2, 0xde, 0x1f, 0x4f, 0x5d, 0x1a, 0xca, 0x4a, 0x24, 0x12, 0xec,
-	0xc7, 0x50, 0x9a, 0xd8, 0x47, 0x8e, 0x27, 0x57, 0xf5, 0xf5, 0x8b, 0x19, 0x77, 0x90, 0xb8, 0xb7,
-	0xc6, 0x05, 0x17, 0x7b, 0x0f, 0xca, 0x53, 0x7f, 0x8e, 0x4e, 0x70, 0x41, 0xbd, 0xc5, 0xa4, 0x56,
-	0x84, 0xd4, 0xbd, 0x35, 0x2e, 0xf9, 0xd8, 0xc7, 0x50, 0x0d, 0x7c, 0xd7, 0x9d, 0x98, 0xd3, 0x13,
-	0x29, 0x50, 0x5b, 0x17, 0xf3, 0x70, 0x49, 0xdf, 0x5b, 0xe3, 0x09, 0xaf, 0x7e, 0x1f, 0x2a, 0xb2,
-	0xb1, 0x38, 0x00, 0x3b, 0xdd, 0xc7, 0x3d, 0x39, 0x90, 0x9d, 0xe1, 0xfe, 0x7e, 0x6f, 0x2c, 0xee,
-	0xe1, 0xf1, 0x61, 0xbf, 0xbf, 0xd3, 0xee, 0x3c, 0xd1, 0xf2, 0x3b, 0x55, 0x28, 0x9b, 0x74, 0x14,
-	0xac, 0xff, 0x61, 0x0e, 0x36, 0x2e, 0x74, 0x80, 0x7d, 0x0a, 0xc5, 0xb9, 0x6f, 0xc5, 0xc3, 0xf3,
-	0xc6, 0xa5, 0xbd, 0x54, 0x60, 0x34, 0x10, 0x38, 0xe5, 0xd0, 0x3f, 0x83, 0xf5, 0x2c, 0x5e, 0x79,
-	0x75, 0xd1, 0x84, 0x1a, 0xef, 0xb6, 0x77, 0x8d, 0xe1, 0xa0, 0xff, 0x95, 0x30, 0x79, 0x09, 0x7c,
-	0xc6, 0x7b, 0xe3, 0xae, 0x96, 0xd7, 0x7f, 0x1f, 0xb4, 0x8b, 0x03, 0xc3, 0x1e, 0xc3, 0xc6, 0xd4,
-	0x9f, 0x2f, 0x5c, 0x5b, 0xec, 0xbe, 0x74, 0xca, 0xee, 0x5c, 0x32, 0x92, 0x92, 0x8d, 0x66, 0x6c,
-	0x7d, 0x9a, 0x81, 0xf5, 0xbf, 0x06, 0x6c, 0x75, 0x04, 0x7f, 0x7b, 0xc5, 0xff, 0x3a, 0x07, 0xc5,
-	0x03, 0xd7, 0x44, 0xa5, 0x59, 0xa2, 0x97, 0x09, 0x52, 0x19, 0xd5, 0x95, 0xed, 0x89, 0xcb, 0x82,
-	0x68, 0xec, 0x47, 0x50, 0x88, 0xa6, 0xf1, 0x9d, 0xc3, 0x97, 0xae, 0x58, 0x7c, 0x7b, 0x6b, 0x1c,
-	0xb9, 0xd8, 0x5d, 0x28, 0x58, 0x56, 0x7c, 0x04, 0x23, 0x1d, 0xbf, 0x5d, 0x33, 0x32, 0x77, 0xed,
-	0x99, 0xe3, 0x39, 0xf2, 0x25, 0x05, 0xb2, 0xb0, 0x37, 0xa1, 0x60, 0x4d, 0xdd, 0xec, 0x99, 0x17,
-	0x72, 0x2a, 0x05, 0x5a, 0x53, 0x97, 0xe9, 0xd0, 0x8c, 0x82, 0x73, 0x23, 0x58, 0x7a, 0x14, 0xf3,
-	0x0c, 0xa5, 0x79, 0x53, 0x47, 0x0d, 0xb1, 0xa4, 0x00, 0xa1, 0x08, 0xcd, 0x86, 0xc6, 0x22, 0xb0,
-	0x17, 0x66, 0x90, 0x18, 0x36, 0x4e, 0x78, 0x20, 0x10, 0x3b, 0x65, 0xa0, 0xa7, 0xc3, 0xfa, 0xbb,
-	0x74, 0x6f, 0x1f, 0x8d, 0x05, 0x3d, 0x4e, 0x5d, 0x72, 0x35, 0x4c, 0x52, 0xf4, 0xff, 0x95, 0x87,
-	0xba, 0xd2, 0x1e, 0xf6, 0x21, 0x54, 0xad, 0xec, 0x46, 0xbc, 0xb9, 0xd2, 0xe8, 0xfb, 0xbb, 0xf1,
-	0x16, 0xb4, 0xe4, 0xf2, 0xfe, 0x0c, 0x9a, 0xa1, 0x1d, 0x19, 0xcf, 0xcd, 0xc0, 0x41, 0x81, 0x1b,
-	0xca, 0x61, 0x94, 0xfe, 0xf4, 0xc8, 0x8e, 0x9e, 0xc6, 0x94, 0xbd, 0x35, 0xde, 0x08, 0x15, 0x98,
-	0xbd, 0x03, 0x95, 0xb8, 0x4b, 0x85, 0xcc, 0x7b, 0x2a, 0x81, 0xdc, 0x5b, 0xe3, 0x31, 0x1d, 0x59,
-	0xed, 0x33, 0x7b, 0xba, 0x8c, 0x62, 0xbb, 0xa6, 0x19, 0x77, 0x88, 0x90, 0xf4, 0x46, 0x53, 0x24,
-	0xd9, 0x43, 0x00, 0xcb, 0x36, 0x5d, 0xd7, 0x27, 0x45, 0x58, 0x52, 0xc3, 0x0d, 0xbb, 0x09, 0x5e,
-	0xbc, 0x09, 0x8d, 0x21, 0xfd, 0x08, 0x2a, 0xb2, 0x63, 0x68, 0xe2, 0x8f, 0xba, 0x63, 0xe3, 0x69,
-	0x9b, 0xf7, 0xd0, 0x01, 0x94, 0xa7, 0x7b, 0x8f, 0x79, 0x7b, 0x20, 0xc5, 0x1f, 0xef, 0x3e, 0x1d,
-	0x3e, 0xe9, 0x6a, 0x79, 0x71, 0x4a, 0x3b, 0xf8, 0x4a, 0x2b, 0x08, 0x9f, 0xae, 0x7b, 0xd0, 0xe6,
-	0x28, 0xfc, 0xea, 0x50, 0xe9, 0x7e, 0xd9, 0xed, 0x1c, 0x92, 0xf4, 0x5b, 0x07, 0xd8, 0xed, 0xb6,
-	0xfb, 0xfd, 0x21, 0x3a, 0x19, 0x5a, 0x79, 0xa7, 0x86, 0xb6, 0x1f, 0x8d, 0xa4, 0xfe, 0xcf, 0x9b,
-	0xb0, 0x9e, 0x5d, 0x38, 0xec, 0x13, 0xa8, 0x5a, 0x56, 0x66, 0x06, 0x6e, 0x5f, 0xb6, 0xc0, 0xee,
-	0xef, 0x5a, 0xf1, 0x24, 0x88, 0x04, 0x7b, 0x2d, 0x5e, 0xe6, 0xf9, 0x95, 0x65, 0x1e, 0x2f, 0xf2,
-	0x9f, 0xc1, 0x86, 0xbc, 0x3b, 0x6f, 0x99, 0x91, 0x39, 0x31, 0x43, 0x3b, 0xbb, 0x86, 0x3b, 0x44,
-	0xdc, 0x95, 0xb4, 0xbd, 0x35, 0xbe, 0x3e, 0xcd, 0x60, 0xd8, 0x4f, 0x60, 0xdd, 0x24, 0x6b, 0x3c,
-	0xc9, 0x5f, 0x54, 0x2f, 0xce, 0xb4, 0x91, 0xa6, 0x64, 0x6f, 0x9a, 0x2a, 0x02, 0x97, 0x89, 0x15,
-	0xf8, 0x8b, 0x34, 0x73, 0x49, 0x5d, 0x26, 0xbb, 0x81, 0xbf, 0x50, 0xf2, 0x36, 0x2c, 0x05, 0x66,
-	0x1f, 0x43, 0x43, 0xb6, 0x3c, 0xb5, 0xeb, 0x93, 0x0d, 0x25, 0x9a, 0x4d, 0xba, 0x7e, 0x6f, 0x8d,
-	0xd7, 0xa7, 0x29, 0xc8, 0x3e, 0x80, 0xba, 0x68, 0x70, 0x6a, 0xe7, 0x27, 0x2b, 0x81, 0x5a, 0x1b,
-	0xe7, 0x02, 0x33, 0x81, 0xd8, 0x7b, 0x00, 0xd4, 0x4e, 0x91, 0xa7, 0x9a, 0x89, 0xfe, 0x05, 0xfe,
-	0x22, 0xce, 0x52, 0xb3, 0x62, 0x40, 0x69, 0x9e, 0xb8, 0x43, 0x55, 0x5b, 0x6d, 0x1e, 0x5d, 0x13,
-	0x4a, 0x9b, 0x27, 0xee, 0x4c, 0x25, 0xcd, 0x13, 0xd9, 0x60, 0xa5, 0x79, 0x71, 0x2e, 0xd1, 0x3c,
-	0x91, 0x29, 0x6e, 0x9e, 0xc8, 0x53, 0xbf, 0xd8, 0xbc, 0x38, 0x0b, 0x35, 0x4f, 0xe4, 0xf8, 0x09,
-	0xac, 0xc7, 0x56, 0xa1, 0xec, 0x54, 0x23, 0x73, 0xcd, 0x4f, 0xd2, 0xe2, 0x8e, 0x35, 0x23, 0x15,
-	0x81, 0xb9, 0xc3, 0x63, 0xff, 0x54, 0xd9, 0xde, 0x4d, 0x35, 0xf7, 0xe8, 0xd8, 0x3f, 0x55, 0xf7,
-	0x77, 0x33, 0x54, 0x11, 0xd8, 0x5a, 0xd1, 0x45, 0xba, 0x25, 0xb9, 0xae, 0xb6, 0x96, 0x7a, 0xf8,
-	0xd4, 0xb1, 0x4f, 0xb1, 0xb5, 0x66, 0x0c, 0xe0, 0xa0, 0xa4, 0x1e, 0x5c, 0x28, 0x63, 0xd5, 0x99,
-	0x48, 0x96, 0xac, 0x09, 0x12, 0xb7, 0x2e, 0xc4, 0xb5, 0xb5, 0xf4, 0xd4, 0x6c, 0x9a, 0xba, 0xb6,
-	0x0e, 0xbd, 0x4c, 0xc6, 0x86, 0x60, 0x95, 0x59, 0xd3, 0x5d, 0x11, 0xda, 0xdf, 0x2c, 0x6d, 0x6f,
-	0x6a, 0xcb, 0x47, 0xee, 0x99, 0x5d, 0x31, 0x92, 0xb4, 0x74, 0x57, 0xc4, 0x98, 0x64, 0x5d, 0x27,
-	0xd9, 0xd9, 0xc5, 0x75, 0xad, 0x64, 0xa6, 0x75, 0x9d, 0x64, 0x4d, 0x36, 0x54, 0x92, 0xf7, 0xda,
-	0xca, 0x86, 0x52, 0x32, 0x8b, 0x0d, 0x15, 0x23, 0xf4, 0x5f, 0x17, 0xa1, 0x22, 0xe5, 0x00, 0xbb,
-	0x06, 0x1b, 0x1d, 0xde, 0x6d, 0x8f, 0xbb, 0xc6, 0x6e, 0x7b, 0xdc, 0xde, 0x69, 0x8f, 0x50, 0xbd,
-	0x33, 0x58, 0x6f, 0xf7, 0xc7, 0x5d, 0x9e, 0xe2, 0x72, 0x28, 0xdc, 0x76, 0xf9, 0xf0, 0x20, 0x45,
-	0xe5, 0x99, 0x06, 0x0d, 0x99, 0x57, 0xbc, 0xb9, 0x2c, 0xb0, 0x0d, 0xa8, 0x8b, 0x8c, 0x02, 0x41,
-	0x77, 0x4e, 0x28, 0x97, 0x80, 0x4b, 0x4a, 0x96, 0xde, 0x60, 0xb7, 0xfb, 0xa5, 0x56, 0x4e, 0xb3,
-	0x08, 0x44, 0x25, 0xc9, 0x22, 0xe0, 0x2a, 0x36, 0x66, 0xcc, 0x0f, 0x07, 0x9d, 0xb4, 0x9e, 0x1a,
-	0xdd, 0x41, 0x10, 0xc5, 0x3c, 0xed, 0x75, 0x9f, 0x69, 0x80, 0x99, 0x44, 0x29, 0x04, 0xd7, 0xd1,
-	0x40, 0xa1, 0x42, 0x08, 0x6c, 0xb0, 0x97, 0xe0, 0xda, 0x68, 0x6f, 0xf8, 0xcc, 0x10, 0x99, 0x92,
-	0x2e, 0x34, 0xd9, 0x16, 0x68, 0x0a, 0x41, 0x14, 0xbf, 0x8e, 0x55, 0x12, 0x36, 0x66, 0x1c, 0x69,
-	0x1b, 0x14, 0x8d, 0x43, 0xdc, 0x58, 0x88, 0x76, 0x0d, 0xbb, 0x22, 0xb2, 0x0e, 0xfb, 0x87, 0xfb,
-	0x83, 0x91, 0xb6, 0x89, 0x8d, 0x20, 0x8c, 0x68, 0x39, 0x4b, 0x8a, 0x49, 0x15, 0xc2, 0x35, 0xd2,
-	0x11, 0x88, 0x7b, 0xd6, 0xe6, 0x83, 0xde, 0xe0, 0xf1, 0x48, 0xdb, 0x4a, 0x4a, 0xee, 0x72, 0x3e,
-	0xe4, 0x23, 0xed, 0x7a, 0x82, 0x18, 0x8d, 0xdb, 0xe3, 0xc3, 0x91, 0x76, 0x23, 0x69, 0xe5, 0x01,
-	0x1f, 0x76, 0xba, 0xa3, 0x51, 0xbf, 0x37, 0x1a, 0x6b, 0x2f, 0xb1, 0xeb, 0xb0, 0x99, 0xb6, 0x28,
-	0x66, 0x6e, 0x29, 0x0d, 0xe5, 0x8f, 0xbb, 0x63, 0xed, 0x66, 0xd2, 0x8c, 0xce, 0xb0, 0xdf, 0x6f,
-	0x53, 0x98, 0xf0, 0x16, 0x32, 0x51, 0x30, 0x4c, 0xf6, 0xe6, 0x65, 0x6c, 0xd7, 0xe1, 0x40, 0x45,
-	0xdd, 0x56, 0x96, 0xc6, 0xa8, 0xfb, 0x8b, 0xc3, 0xee, 0xa0, 0xd3, 0xd5, 0x5e, 0x49, 0x97, 0x46,
-	0x82, 0xbb, 0x93, 0x2c, 0x8d, 0x04, 0xf5, 0x6a, 0x52, 0x67, 0x8c, 0x1a, 0x69, 0xdb, 0x3b, 0x0d,
-	0xfa, 0xbe, 0x82, 0x54, 0x44, 0xfa, 0x17, 0xc0, 0xd4, 0xf7, 0xcb, 0xf2, 0x99, 0x16, 0x83, 0xe2,
-	0x2c, 0xf0, 0xe7, 0xf1, 0x6d, 0x46, 0x4c, 0xb3, 0x6d, 0xa8, 0x2f, 0x96, 0x13, 0x8a, 0x64, 0xa7,
-	0x77, 0xa9, 0x54, 0x94, 0xfe, 0x77, 0x72, 0xb0, 0x9e, 0x55, 0x42, 0x68, 0x1a, 0x39, 0x33, 0xc3,
-	0xf3, 0x23, 0xf1, 0x94, 0x28, 0x8c, 0xdd, 0x5a, 0x67, 0x36, 0xf0, 0x23, 0x7a, 0x4b, 0x44, 0x0e,
-	0x4f, 0xa2, 0x53, 0x44, 0xa9, 0x09, 0xcc, 0x7a, 0x70, 0x2d, 0xf3, 0xbc, 0x3b, 0xf3, 0x90, 0xab,
-	0x95, 0xbc, 0x5d, 0xbd, 0xd0, 0x7e, 0xce, 0xc2, 0x15, 0x9c, 0xbe, 0x07, 0xcd, 0x8c, 0x86, 0xa3,
-	0x90, 0xc3, 0x2c, 0xdb, 0xae, 0xaa, 0x33, 0x7b, 0x71, 0xa3, 0xf4, 0x63, 0x68, 0xa8, 0xea, 0xee,
-	0x07, 0x17, 0x44, 0x37, 0x15, 0x64, 0xda, 0x70, 0xac, 0xf8, 0xb5, 0x52, 0x8c, 0xea, 0x59, 0xfa,
-	0xab, 0x50, 0x7b, 0x74, 0x12, 0x3f, 0x3c, 0x53, 0xdf, 0xbe, 0xd5, 0xe4, 0x75, 0xb8, 0xff, 0x9a,
-	0x87, 0xba, 0xa2, 0x40, 0x7f, 0xa3, 0xf1, 0xbe, 0x0d, 0xb5, 0xf4, 0x42, 0xae, 0xbc, 0xa0, 0x94,
-	0x20, 0x32, 0xed, 0x2d, 0x5c, 0x68, 0xef, 0xf7, 0xba, 0x86, 0xf1, 0x3e, 0x34, 0x94, 0xe7, 0x66,
-	0xa1, 0x3c, 0x60, 0x5e, 0x39, 0x3a, 0x4c, 0x9f, 0x9e, 0x85, 0xec, 0x3a, 0x94, 0x67, 0x27, 0x86,
-	0x35, 0x89, 0x2f, 0xae, 0x94, 0x66, 0x27, 0xbb, 0x13, 0x0a, 0xaa, 0xcd, 0x12, 0xcd, 0x20, 0x82,
-	0x04, 0xd5, 0x59, 0x2c, 0xff, 0xef, 0x42, 0x65, 0x76, 0x22, 0xde, 0x72, 0x55, 0xa9, 0x06, 0xa9,
-	0x9e, 0x92, 0x71, 0xe3, 0xe5, 0xd9, 0x09, 0xbd, 0xeb, 0xfa, 0x0c, 0xb4, 0x0b, 0x71, 0x87, 0x50,
-	0x1e, 0x26, 0x5f, 0x6c, 0xd4, 0x46, 0x36, 0x04, 0x11, 0xea, 0xff, 0x22, 0x07, 0xeb, 0xa9, 0xc1,
-	0x81, 0x93, 0xcf, 0xee, 0xa9, 0x1f, 0x8d, 0x68, 0x5d, 0xb4, 0x49, 0x90, 0xe5, 0xfe, 0xf8, 0x7c,
-	0x21, 0x9e, 0xc1, 0x5e, 0xf6, 0x5a, 0xe0, 0xb2, 0xd7, 0x78, 0x85, 0xcb, 0x5e, 0xe3, 0xe9, 0x8f,
-	0xa1, 0x30, 0x3e, 0x5f, 0x08, 0xd7, 0x13, 0x65, 0x9c, 0xb0, 0x67, 0x85, 0x74, 0xa3, 0x80, 0xf1,
-	0x93, 0xee, 0x57, 0xe2, 0xa2, 0xe1, 0x01, 0xef, 0xed, 0xb7, 0xf9, 0x57, 0x14, 0x0a, 0x27, 0x2d,
-	0xf0, 0x68, 0xc8, 0xbb, 0xbd, 0xc7, 0x03, 0x42, 0x14, 0xc9, 0x31, 0x4d, 0x9b, 0xd8, 0xb6, 0xac,
-	0x47, 0x27, 0xea, 0x87, 0x07, 0x72, 0x99, 0x0f, 0x0f, 0x24, 0x6f, 0x12, 0xd4, 0xa7, 0x87, 0x51,
-	0xf2, 0x44, 0x30, 0x5e, 0x8c, 0x85, 0x74, 0x31, 0xb2, 0xb7, 0xa1, 0x38, 0x3b, 0xb1, 0xcf, 0xb3,
-	0x56, 0x65, 0xf6, 0xae, 0x3f, 0x31, 0xe8, 0xbf, 0xca, 0x01, 0xcb, 0x34, 0x44, 0x18, 0x3a, 0x3f,
-	0xb4, 0x2d, 0x9f, 0x40, 0x4b, 0x3e, 0x44, 0x15, 0x5c, 0x4a, 0x10, 0x48, 0x0e, 0xe9, 0x75, 0x41,
-	0xa7, 0xea, 0xd2, 0x07, 0x0d, 0xec, 0x01, 0x88, 0xc7, 0x94, 0x3d, 0x6f, 0xe6, 0x67, 0xbd, 0x3c,
-	0x65, 0x4f, 0xf1, 0x94, 0x87, 0xdd, 0x83, 0x4d, 0x75, 0xd2, 0xc4, 0xf3, 0x48, 0x11, 0x15, 0xdb,
-	0x48, 0x67, 0x8d, 0xf6, 0x99, 0xfe, 0xc7, 0x39, 0xb8, 0x96, 0x5d, 0x10, 0x7f, 0xb5, 0x5e, 0x66,
-	0xdf, 0x82, 0x16, 0x2e, 0xbe, 0x05, 0xbd, 0x6c, 0x3d, 0x15, 0x2f, 0x5d, 0x4f, 0x7f, 0x94, 0x83,
-	0x2d, 0x65, 0xf4, 0x53, 0xd3, 0xf4, 0xff, 0x50, 0xcb, 0x94, 0x27, 0xa1, 0xc5, 0xcc, 0x93, 0x50,
-	0xfd, 0x43, 0xd8, 0x4c, 0x1b, 0xd2, 0x91, 0x2f, 0x84, 0x5e, 0x85, 0xba, 0x67, 0x9f, 0x1a, 0xf1,
-	0xfb, 0x21, 0xd1, 0x12, 0xf0, 0xec, 0x53, 0xc9, 0xa0, 0x3f, 0x52, 0xf7, 0x62, 0xf2, 0x7d, 0x10,
-	0xd7, 0x52, 0x5b, 0x5e, 0xf1, 0x5d, 0x2b, 0x26, 0x61, 0x69, 0x4a, 0xc3, 0x2b, 0x9e, 0x7d, 0x4a,
-	0xe3, 0xe0, 0x41, 0x9d, 0xca, 0x69, 0x5b, 0x56, 0xc7, 0x77, 0x2f, 0xbd, 0xc1, 0x7f, 0x13, 0xaa,
-	0x8b, 0x20, 0xd3, 0x6d, 0xf4, 0x67, 0xa9, 0xe0, 0x3b, 0xf2, 0x5a, 0xe8, 0x6a, 0x24, 0x5f, 0x5c,
-	0x03, 0x95, 0x97, 0xa7, 0x8b, 0xe9, 0xf7, 0x81, 0x1e, 0x42, 0x43, 0x28, 0xa0, 0xc0, 0x5f, 0x60,
-	0x85, 0x49, 0x1c, 0x3e, 0x77, 0xb7, 0x49, 0x71, 0x78, 0xc4, 0x84, 0xf6, 0x37, 0xf2, 0xd9, 0x15,
-	0x26, 0xf5, 0xbf, 0x51, 0x03, 0x48, 0x3b, 0x9b, 0x11, 0xce, 0xb9, 0xef, 0x12, 0xce, 0x2f, 0x0a,
-	0xc8, 0x7f, 0x08, 0xeb, 0x53, 0x7f, 0x71, 0x6e, 0xa4, 0x39, 0x0a, 0x97, 0xe6, 0x68, 0x20, 0xd7,
-	0x58, 0xb9, 0x1f, 0xba, 0x12, 0x13, 0x2e, 0x5e, 0x1a, 0x13, 0x7e, 0x1f, 0x2a, 0x22, 0x1a, 0x16,
-	0xcb, 0xfd, 0x97, 0x2e, 0x4a, 0xc8, 0xfb, 0xf2, 0xfd, 0x6b, 0xcc, 0xc7, 0xba, 0x68, 0x4c, 0xcb,
-	0xc7, 0x7f, 0xea, 0x35, 0xa3, 0x3b, 0xab, 0x39, 0x63, 0x36, 0x71, 0x4a, 0x65, 0xaa, 0x20, 0x7b,
-	0x00, 0x5b, 0xb1, 0xaf, 0x39, 0x97, 0x4e, 0x20, 0x3d, 0xba, 0x11, 0xcf, 0xc1, 0x36, 0xa5, 0x7b,
-	0x39, 0x17, 0xae, 0xdf, 0xe8, 0x1b, 0x97, 0xfd, 0x18, 0xae, 0xc9, 0x1b, 0x01, 0x98, 0x01, 0x87,
-	0x93, 0xf8, 0xc5, 0xb7, 0x06, 0x34, 0x79, 0x89, 0x6b, 0x4e, 0xda, 0x1e, 0xd9, 0xef, 0x82, 0xa6,
-	0xfa, 0xb2, 0xc4, 0x2b, 0xde, 0x1b, 0xae, 0x2b, 0xae, 0x2b, 0x72, 0xbe, 0x05, 0x1b, 0xb2, 0xe0,
-	0xa4, 0x50, 0x10, 0x2f, 0xef, 0x04, 0x3a, 0x2e, 0xf1, 0x4b, 0xd8, 0x9a, 0x1e, 0x9b, 0xde, 0x91,
-	0x6d, 0x44, 0x13, 0xd7, 0xa0, 0x0f, 0x35, 0x18, 0x73, 0x73, 0x21, 0xef, 0x24, 0xbd, 0xbd, 0xd2,
-	0xfd, 0x0e, 0x31, 0x8f, 0x27, 0x2e, 0x1d, 0x9c, 0x25, 0x67, 0x11, 0x9b, 0xd3, 0x8b, 0xf8, 0x5b,
-	0x7f, 0x51, 0x80, 0xb2, 0x7c, 0x4f, 0x7c, 0x0f, 0x8a, 0xe8, 0xba, 0xc8, 0x28, 0xda, 0xd6, 0x65,
-	0xfa, 0x8a, 0x3e, 0x69, 0x86, 0xaa, 0xed, 0x3e, 0x94, 0x4d, 0xcb, 0x32, 0x66, 0x27, 0xd9, 0xa0,
-	0xec, 0x05, 0xd5, 0xb1, 0xb7, 0xc6, 0x4b, 0x26, 0xe9, 0x90, 0x4f, 0xa0, 0x86, 0xfc, 0xc2, 0xa3,
-	0xcd, 0x98, 0x66, 0xab, 0x42, 0x7e, 0x6f, 0x8d, 0x57, 0xcd, 0x58, 0xe0, 0xff, 0x34, 0xeb, 0x40,
-	0x0b, 0x09, 0x7c, 0x6b, 0x25, 0xeb, 0x55, 0xae, 0xf4, 0xef, 0x82, 0xf0, 0xa8, 0x12, 0x59, 0x51,
-	0x52, 0xe3, 0x7f, 0x2b, 0x92, 0x05, 0xdd, 0x37, 0x53, 0x1c, 0x38, 0x0a, 0x49, 0xf3, 0x51, 0xec,
-	0xdc, 0x26, 0xdf, 0x24, 0xba, 0x64, 0x64, 0x70, 0xb3, 0x27, 0x1e, 0x2e, 0xed, 0xfc, 0x77, 0xa1,
-	0x82, 0xdd, 0x9d, 0xfa, 0xae, 0x8c, 0x48, 0x6c, 0x2a, 0x79, 0x84, 0x30, 0xd9, 0x5b, 0xe3, 0x38,
-	0x84, 0xb8, 0xcb, 0x1f, 0x40, 0x95, 0xdc, 0x4b, 0x64, 0xaf, 0xaa, 0x9e, 0xa5, 0x2a, 0x0b, 0xe8,
-	0x93, 0x6f, 0x22, 0x99, 0x06, 0x92, 0x6f, 0x71, 0xb8, 0x71, 0xf9, 0x5c, 0xab, 0xc7, 0x4c, 0x45,
-	0x71, 0xcc, 0xa4, 0x67, 0x2f, 0x43, 0x67, 0x5f, 0x19, 0x2a, 0x87, 0x4e, 0x3f, 0x47, 0x2b, 0x58,
-	0xdd, 0x2f, 0x75, 0xa8, 0xc4, 0x6f, 0xc7, 0xe9, 0xcc, 0xbb, 0x33, 0x3c, 0xf8, 0x4a, 0xcb, 0x21,
-	0xba, 0x37, 0x18, 0x8d, 0xdb, 0x03, 0x79, 0x4c, 0xd0, 0x1b, 0xc8, 0x63, 0x02, 0xfd, 0xd7, 0x79,
-	0xa8, 0x25, 0x81, 0x94, 0x1f, 0x6e, 0xfb, 0x26, 0x5f, 0x1c, 0x2c, 0xa8, 0x5f, 0x1c, 0xbc, 0xa0,
-	0x60, 0xc5, 0xb9, 0x50, 0x91, 0x6c, 0x8c, 0x8d, 0xac, 0x1a, 0x0b, 0x57, 0x2f, 0x49, 0x95, 0x7e,
-	0xc3, 0x4b, 0x52, 0xea, 0x59, 0x7a, 0x39, 0x7b, 0x96, 0x7e, 0xe1, 0xfb, 0x01, 0x15, 0x3a, 0xc3,
-	0x52, 0xbf, 0x1f, 0x70, 0xe5, 0xe1, 0x55, 0xf5, 0xea, 0xc3, 0x2b, 0xfa, 0x38, 0xe2, 0x53, 0xc7,
-	0x3e, 0x95, 0x07, 0xcb, 0x12, 0xca, 0x4a, 0x6c, 0x78, 0xc1, 0x29, 0xee, 0x37, 0x50, 0x4b, 0x22,
-	0x2e, 0x3f, 0x7c, 0xd4, 0xbf, 0x8f, 0x05, 0xaf, 0xff, 0x41, 0xec, 0xce, 0x25, 0x01, 0x8f, 0xbf,
-	0xaa, 0x3b, 0x97, 0xa9, 0xbe, 0xf0, 0x82, 0xea, 0xcf, 0x84, 0x9b, 0x95, 0x54, 0xfe, 0x5b, 0x5e,
-	0x6a, 0xea, 0x2a, 0x28, 0x66, 0x56, 0x81, 0xbe, 0x21, 0x5d, 0xc5, 0x24, 0x54, 0xf3, 0x3f, 0x72,
-	0xb1, 0x9b, 0x95, 0xbc, 0x96, 0xbc, 0x52, 0x0f, 0x27, 0xb5, 0xe5, 0xd5, 0xda, 0xbe, 0x4f, 0xcf,
-	0xbf, 0xd3, 0xa0, 0x2d, 0x7e, 0x97, 0x41, 0xfb, 0x36, 0x94, 0x84, 0x28, 0x2d, 0x5d, 0x65, 0xcc,
-	0x0a, 0xfa, 0x0b, 0xbf, 0xf0, 0xa1, 0xeb, 0xd2, 0xee, 0x10, 0xfd, 0xdd, 0x8a, 0xcb, 0x8d, 0xbf,
-	0x4e, 0x82, 0x00, 0xfa, 0x13, 0xb5, 0xd4, 0xae, 0xfd, 0xfe, 0x63, 0xf2, 0x5b, 0xb3, 0x68, 0xff,
-	0x38, 0x0f, 0xcd, 0x4c, 0x18, 0xf4, 0x07, 0x34, 0xe6, 0x52, 0xc9, 0x53, 0xb8, 0x5c, 0xf2, 0x5c,
-	0x29, 0x04, 0x8a, 0x57, 0x0b, 0x81, 0xff, 0x1b, 0xd2, 0x4a, 0xff, 0x9b, 0xb9, 0xe4, 0xdb, 0x1d,
-	0xa2, 0xb0, 0xcb, 0x2c, 0xb8, 0xdc, 0xa5, 0x16, 0xdc, 0x9d, 0xe4, 0x83, 0x75, 0xbd, 0x5d, 0x71,
-	0xce, 0xdd, 0xe4, 0x0a, 0x86, 0x7d, 0x06, 0x37, 0xc5, 0x29, 0x94, 0x50, 0xde, 0x86, 0x3f, 0x33,
-	0x92, 0xcf, 0xd9, 0xc9, 0x8b, 0x07, 0x37, 0x04, 0x83, 0xf8, 0xc2, 0xcb, 0xac, 0x1d, 0x53, 0xf5,
-	0x1e, 0x34, 0x33, 0x61, 0x67, 0xe5, 0x1b, 0x98, 0x39, 0xf5, 0x1b, 0x98, 0x6c, 0x1b, 0x4a, 0xa7,
-	0xc7, 0x76, 0x60, 0x5f, 0xf2, 0x96, 0x4d, 0x10, 0xf4, 0x9f, 0x40, 0x43, 0x3d, 0xa0, 0x62, 0xef,
-	0x42, 0xc9, 0x89, 0xec, 0x79, 0xfc, 0x84, 0xf0, 0xc6, 0xea, 0x19, 0x16, 0x7d, 0x85, 0x42, 0x30,
-	0xe9, 0x7f, 0x9a, 0x03, 0xed, 0x22, 0x4d, 0xf9, 0x50, 0x67, 0xee, 0x8a, 0x0f, 0x75, 0xe6, 0x33,
-	0x8d, 0xbc, 0xe4, 0x63, 0x9b, 0xe9, 0xd3, 0xa4, 0xe2, 0x15, 0x4f, 0x93, 0xd8, 0x5b, 0x50, 0x0d,
-	0x6c, 0xfa, 0x38, 0xa2, 0x95, 0xfd, 0x78, 0x96, 0xf8, 0xba, 0x41, 0x4c, 0xd3, 0xff, 0x7a, 0x0e,
-	0x2a, 0xf2, 0x34, 0xed, 0x52, 0x0f, 0xe5, 0x1d, 0xa8, 0x88, 0x0f, 0x25, 0xc6, 0x9f, 0xec, 0x5b,
-	0xb9, 0x17, 0x12, 0xd3, 0xd1, 0x63, 0x41, 0x52, 0xd6, 0x63, 0x39, 0x70, 0x4d, 0x8f, 0x13, 0x1e,
-	0x57, 0x13, 0x5d, 0x41, 0x20, 0xe3, 0x3b, 0x94, 0x0f, 0x06, 0x80, 0x50, 0x68, 0x29, 0x84, 0xfa,
-	0x4f, 0xa1, 0x22, 0x4f, 0xeb, 0x2e, 0x6d, 0xca, 0x8b, 0x3e, 0x1d, 0xb8, 0x0d, 0x90, 0x1e, 0xdf,
-	0x5d, 0x56, 0x82, 0xee, 0xca, 0x57, 0xd5, 0x7d, 0x7f, 0x7a, 0x42, 0xfe, 0xf6, 0x03, 0xa8, 0xba,
-	0xf1, 0xa3, 0xf3, 0xdc, 0xd5, 0x8f, 0xce, 0x13, 0x26, 0x76, 0x0f, 0x12, 0x29, 0xfa, 0x22, 0x1f,
-	0x48, 0x6f, 0xc7, 0x17, 0xec, 0x68, 0xe5, 0x7c, 0x20, 0x7d, 0x5c, 0x44, 0xc5, 0xcb, 0xe7, 0x62,
-	0x65, 0x74, 0xc3, 0x5b, 0x61, 0xd3, 0xd7, 0xa1, 0xa1, 0x1e, 0x4e, 0xe8, 0x7f, 0xbf, 0x0c, 0xda,
-	0xbe, 0x1d, 0x99, 0x28, 0x6b, 0x46, 0x53, 0xd3, 0xa3, 0x4e, 0xb4, 0xe8, 0x51, 0xec, 0x40, 0x71,
-	0x4e, 0xe3, 0x88, 0x5e, 0x0b, 0x2a, 0x3b, 0xd8, 0xf4, 0x9e, 0x25, 0x9f, 0x88, 0xc7, 0x20, 0xee,
-	0x3e, 0x31, 0x83, 0x83, 0x74, 0x69, 0x29, 0x18, 0xa4, 0x93, 0x25, 0x48, 0x77, 0x3e, 0xa4, 0x0f,
-	0xa6, 0x60, 0x70, 0xb1, 0x8e, 0xfc, 0x20, 0x92, 0x8b, 0xab, 0xca, 0x25, 0x84, 0x72, 0xb1, 0x17,
-	0xee, 0x89, 0xaf, 0x54, 0x08, 0xa1, 0x9f, 0xc0, 0xd8, 0x1a, 0x6c, 0x7b, 0xdf, 0x17, 0xdf, 0x91,
-	0x68, 0xf0, 0x18, 0xc4, 0xd2, 0x76, 0x6d, 0x17, 0x09, 0x55, 0x22, 0x48, 0x08, 0x4b, 0x13, 0xd7,
-	0x0a, 0xc6, 0x21, 0x99, 0x36, 0x0d, 0x9e, 0xc0, 0x44, 0x13, 0x7a, 0x27, 0x24, 0xdb, 0x06, 0x69,
-	0x12, 0x46, 0x9a, 0xb8, 0xf8, 0x34, 0x0e, 0xe9, 0x04, 0xac, 0xc1, 0x13, 0x18, 0xa5, 0xf3, 0xc8,
-	0x3e, 0xea, 0x59, 0x74, 0xc8, 0xd5, 0xe0, 0x02, 0xc0, 0x16, 0x70, 0xff, 0xb4, 0xe3, 0x45, 0xf2,
-	0xe9, 0x8d, 0x84, 0xb0, 0xcd, 0x83, 0xa5, 0xeb, 0x22, 0x41, 0xbc, 0xba, 0x89, 0x41, 0xa6, 0x43,
-	0x23, 0xfe, 0x5a, 0xdd, 0xc8, 0xf9, 0xd6, 0x16, 0x9f, 0x4f, 0xe6, 0x19, 0x1c, 0x8d, 0xb2, 0xf8,
-	0x58, 0x19, 0x72, 0x68, 0xe2, 0xd9, 0x4e, 0x8a, 0x41, 0x33, 0x7b, 0xdf, 0xf1, 0xe8, 0x3c, 0xa9,
-	0xc1, 0x31, 0x49, 0x18, 0xf3, 0x8c, 0x8e, 0x88, 0x10, 0x63, 0x92, 0xcf, 0x3e, 0x5a, 0xce, 0xe9,
-	0xe0, 0xa7, 0xc1, 0x31, 0xa9, 0xff, 0x69, 0x1e, 0xb6, 0x2e, 0x2e, 0x02, 0x5a, 0x9c, 0x0d, 0xa8,
-	0x76, 0x86, 0x7d, 0x63, 0xd0, 0xde, 0x97, 0x9f, 0xcc, 0xdc, 0xa1, 0x48, 0x7f, 0x6f, 0x57, 0x3c,
-	0xe7, 0x1c, 0xee, 0x7c, 0xd1, 0xed, 0x8c, 0x05, 0x99, 0xc2, 0x79, 0xdd, 0xc1, 0x98, 0x7f, 0x45,
-	0x27, 0x0a, 0xf2, 0x7a, 0xce, 0x68, 0xc8, 0xc7, 0xdd, 0x5d, 0xad, 0x48, 0xf7, 0x66, 0x47, 0xc6,
-	0x5e, 0x6f, 0x77, 0xb7, 0x3b, 0xd0, 0x4a, 0x74, 0xdb, 0xb8, 0x3b, 0x6e, 0x1b, 0xfd, 0x61, 0x47,
-	0x2b, 0xd3, 0xa1, 0x4b, 0xb7, 0x2f, 0xc1, 0x0a, 0x82, 0xe2, 0xca, 0x8a, 0x31, 0x1e, 0x69, 0x55,
-	0x02, 0xe5, 0x69, 0xd1, 0x48, 0xab, 0x49, 0xe6, 0xae, 0x00, 0x41, 0x5c, 0x14, 0x7a, 0x8c, 0x4d,
-	0xaa, 0x8b, 0xfb, 0x2d, 0xcf, 0x46, 0x46, 0x67, 0x30, 0xd6, 0x1a, 0x08, 0x0d, 0x0e, 0xfb, 0x7d,
-	0x82, 0x9a, 0x6c, 0x13, 0x9a, 0x9d, 0xe1, 0xfe, 0x01, 0xef, 0x8e, 0x46, 0xc6, 0xa8, 0xf7, 0x7b,
-	0x5d, 0x6d, 0x9d, 0x7a, 0xc0, 0x7b, 0x8f, 0x7b, 0x03, 0x81, 0xd8, 0x60, 0x15, 0x28, 0xec, 0xf7,
-	0x06, 0x9a, 0x46, 0x89, 0xf6, 0x97, 0xda, 0x26, 0x26, 0x46, 0x87, 0xfb, 0x1a, 0xbb, 0xf7, 0x5a,
-	0x3a, 0x39, 0xf1, 0x3b, 0xdc, 0x81, 0xef, 0xd9, 0xe2, 0x05, 0x75, 0xff, 0xdb, 0x0f, 0xb5, 0xdc,
-	0xbd, 0x3f, 0x50, 0xbe, 0x63, 0x43, 0x3c, 0x32, 0xd0, 0x49, 0x37, 0xbd, 0xfb, 0xbd, 0x41, 0xb7,
-	0xcd, 0x29, 0xac, 0x49, 0x6f, 0xad, 0xf7, 0xda, 0xa3, 0x3d, 0x31, 0x66, 0x92, 0x42, 0x88, 0x42,
-	0xfa, 0xaa, 0x97, 0x6e, 0x76, 0x53, 0x32, 0x39, 0x28, 0x2a, 0xd1, 0x0d, 0xdf, 0xde, 0x68, 0xac,
-	0x95, 0x99, 0x06, 0x0d, 0x4c, 0x25, 0xb4, 0xca, 0x3d, 0x1d, 0xea, 0xca, 0xd7, 0x0b, 0xa8, 0x0e,
-	0x33, 0x3c, 0x96, 0x0f, 0x85, 0xd1, 0x27, 0xd3, 0x72, 0xf7, 0x3e, 0x42, 0x3d, 0xa6, 0x7c, 0x3b,
-	0x80, 0x3e, 0xc0, 0xeb, 0x07, 0x73, 0xd3, 0x95, 0x7c, 0xf6, 0x32, 0xb4, 0xb5, 0x1c, 0x5d, 0xd3,
-	0xb1, 0xe5, 0x57, 0x06, 0xb4, 0xfc, 0xbd, 0xf7, 0xe0, 0xfa, 0xa5, 0x1f, 0x46, 0xa0, 0xc1, 0x77,
-	0xe6, 0x0b, 0xd7, 0x96, 0x5f, 0xfc, 0xa2, 0x1b, 0x31, 0x67, 0x5a, 0xee, 0xde, 0xcf, 0xa1, 0x75,
-	0xd5, 0xc5, 0x19, 0xac, 0xa7, 0xb3, 0xd7, 0xa6, 0xcb, 0x49, 0x38, 0x45, 0x43, 0x43, 0x40, 0x39,
-	0x71, 0xb7, 0xab, 0xdf, 0xa5, 0x33, 0xc2, 0x7b, 0xbf, 0xcc, 0x29, 0xa2, 0x35, 0xbe, 0x25, 0x91,
-	0x20, 0xe4, 0xd8, 0xab, 0x28, 0x6e, 0x9b, 0x96, 0x96, 0x63, 0x37, 0x80, 0x65, 0x50, 0x7d, 0x7f,
-	0x6a, 0xba, 0x5a, 0x9e, 0x4e, 0x03, 0x63, 0xfc, 0xb3, 0xc0, 0x89, 0x6c, 0xad, 0xc0, 0x5e, 0x81,
-	0x9b, 0x09, 0xae, 0xef, 0x9f, 0x1e, 0x04, 0x0e, 0xba, 0x99, 0xe7, 0x82, 0x5c, 0xdc, 0xf9, 0xd9,
-	0xbf, 0xfc, 0xd5, 0x9d, 0xdc, 0xbf, 0xf9, 0xd5, 0x9d, 0xdc, 0x7f, 0xfa, 0xd5, 0x9d, 0xb5, 0x3f,
-	0xfd, 0x2f, 0x77, 0x72, 0xbf, 0xa7, 0x7e, 0x27, 0x7f, 0x6e, 0x46, 0x81, 0x73, 0x26, 0xac, 0xda,
-	0x18, 0xf0, 0xec, 0x07, 0x8b, 0x93, 0xa3, 0x07, 0x8b, 0xc9, 0x03, 0x14, 0xc3, 0x93, 0x32, 0x7d,
-	0x2e, 0xff, 0x83, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x64, 0xfd, 0x1b, 0xb7, 0x71, 0x5f, 0x00,
-	0x00,
+	// 9682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0xbd, 0x4d, 0x8c, 0x23, 0x47,
+	0x96, 0x18, 0xdc, 0xfc, 0x27, 0x1f, 0x7f, 0x2a, 0x2b, 0xbb, 0xba, 0x9b, 0xdd, 0x6a, 0xb5, 0x4a,
+	0x29, 0x8d, 0xd4, 0xea, 0xd1, 0x74, 0x4b, 0x25, 0x8d, 0xfe, 0x76, 0x67, 0x67, 0x58, 0x24, 0xbb,
+	0x9a, 0xd3, 0x2c, 0xb2, 0x26, 0xc8, 0xea, 0x96, 0x76, 0xf1, 0x21, 0x91, 0x64, 0x26, 0xab, 0x52,
+	0xc5, 0xca, 0xa4, 0x32, 0x93, 0x5d, 0x55, 0xf3, 0x61, 0x0d, 0x9d, 0x76, 0xe1, 0xb3, 0x8d, 0x3d,
+	0xd8, 0x6b, 0x60, 0x6d, 0xc0, 0x3e, 0x18, 0x3e, 0xda, 0x58, 0xc0, 0x30, 0x7c, 0xb6, 0x0f, 0xfe,
+	0xbf, 0xf9, 0x07, 0xb0, 0xc7, 0xbe, 0x2d, 0x0c, 0x1f, 0x76, 0xe1, 0x93, 0x61, 0x18, 0xef, 0x45,
+	0x44, 0x66, 0x64, 0x91, 0xa5, 0x96, 0x34, 0x63, 0xd8, 0xbe, 0x10, 0xf1, 0x7e, 0xe2, 0x27, 0xe3,
+	0xe7, 0xc5, 0x7b, 0x2f, 0x5e, 0x04, 0x01, 0x16, 0x73, 0xcb, 0x7b, 0xb8, 0x08, 0xfc, 0xc8, 0xd7,
+	0xf3, 0x98, 0xbe, 0xf3, 0xa3, 0x23, 0x37, 0x3a, 0x5e, 0x4e, 0x1e, 0x4e, 0xfd, 0xd3, 0x47, 0x47,
+	0xfe, 0x91, 0xff, 0x88, 0x88, 0x93, 0xe5, 0x8c, 0x20, 0x02, 0x28, 0xc5, 0x33, 0x19, 0x7f, 0x9a,
+	0x81, 0xfc, 0xf8, 0x62, 0xe1, 0xe8, 0x0d, 0xc8, 0xba, 0x76, 0x33, 0xb3, 0x9d, 0xb9, 0x5f, 0x60,
+	0x59, 0xd7, 0xd6, 0xb7, 0xa1, 0xea, 0xf9, 0xd1, 0x60, 0x39, 0x9f, 0x5b, 0x93, 0xb9, 0xd3, 0xcc,
+	0x6e, 0x67, 0xee, 0x97, 0x99, 0x8a, 0xd2, 0x5f, 0x81, 0x8a, 0xb5, 0x8c, 0x7c, 0xd3, 0xf5, 0xa6,
+	0x41, 0x33, 0x47, 0xf4, 0x32, 0x22, 0x7a, 0xde, 0x34, 0xd0, 0xb7, 0xa0, 0x70, 0xe6, 0xda, 0xd1,
+	0x71, 0x33, 0x4f, 0x25, 0x72, 0x00, 0xb1, 0xe1, 0xd4, 0x9a, 0x3b, 0xcd, 0x02, 0xc7, 0x12, 0x80,
+	0xd8, 0x88, 0x2a, 0x29, 0x6e, 0x67, 0xee, 0x57, 0x18, 0x07, 0xf4, 0x7b, 0x00, 0x8e, 0xb7, 0x3c,
+	0x7d, 0x61, 0xcd, 0x97, 0x4e, 0xd8, 0x2c, 0x11, 0x49, 0xc1, 0x18, 0xff, 0xb5, 0x00, 0x85, 0xb6,
+	0xef, 0x85, 0x91, 0x7e, 0x13, 0x8a, 0x6e, 0xe8, 0x2d, 0xe7, 0x73, 0x6a, 0x7e, 0x99, 0x09, 0x48,
+	0xbf, 0x09, 0x05, 0xf7, 0x93, 0x17, 0xd6, 0x9c, 0x1a, 0x5f, 0x78, 0x72, 0x8d, 0x71, 0x50, 0x6f,
+	0x42, 0xd1, 0x7d, 0xff, 0x23, 0x24, 0xe4, 0x04, 0x41, 0xc0, 0x44, 0xf9, 0x60, 0x07, 0x29, 0xf9,
+	0x98, 0xf2, 0xc1, 0x8e, 0xa4, 0x7c, 0xf4, 0x21, 0x52, 0xb0, 0xe9, 0x39, 0xa2, 0x10, 0x8c, 0xb5,
+	0x2c, 0xa9, 0x16, 0x6c, 0x7d, 0x1d, 0x6b, 0x59, 0xca, 0x5a, 0x96, 0xbc, 0x96, 0x92, 0x20, 0x08,
+	0x98, 0x28, 0xbc, 0x96, 0x72, 0x4c, 0x89, 0x6b, 0x59, 0xf2, 0x5a, 0x2a, 0xdb, 0x99, 0xfb, 0x79,
+	0xa2, 0xf0, 0x5a, 0xb6, 0x20, 0x6f, 0x23, 0x1e, 0xb6, 0x33, 0xf7, 0x33, 0x4f, 0xae, 0xb1, 0xbc,
+	0x2d, 0xb0, 0x21, 0x62, 0xab, 0xd8, 0x3b, 0x88, 0x0d, 0x05, 0x76, 0x82, 0xd8, 0x1a, 0xf6, 0x06,
+	0x62, 0x27, 0x02, 0x3b, 0x43, 0x6c, 0x7d, 0x3b, 0x73, 0x3f, 0x8b, 0x58, 0x84, 0xf4, 0x3b, 0x50,
+	0xb2, 0xad, 0xc8, 0x41, 0x42, 0x43, 0x7c, 0xb2, 0x44, 0x20, 0x2d, 0x72, 0x4f, 0x89, 0xb6, 0x21,
+	0x3e, 0x5a, 0x22, 0x74, 0x03, 0xaa, 0xc8, 0x26, 0xe9, 0x9a, 0xa0, 0xab, 0x48, 0xfd, 0xc7, 0x50,
+	0xb3, 0x9d, 0xa9, 0x7b, 0x6a, 0xcd, 0xf9, 0x37, 0x6d, 0x6e, 0x67, 0xee, 0x57, 0x77, 0x36, 0x1e,
+	0xd2, 0x9c, 0x8d, 0x29, 0x4f, 0xae, 0xb1, 0x14, 0x9b, 0xfe, 0x09, 0xd4, 0x05, 0xfc, 0xfe, 0x0e,
+	0x75, 0xac, 0x4e, 0xf9, 0xb4, 0x54, 0xbe, 0xf7, 0x77, 0x3e, 0x79, 0x72, 0x8d, 0xa5, 0x19, 0xf5,
+	0x37, 0xa1, 0x86, 0x75, 0x87, 0x91, 0x75, 0xba, 0xc0, 0x8c, 0xd7, 0x45, 0xab, 0x52, 0x58, 0xfc,
+	0xac, 0x2f, 0x43, 0xdf, 0x43, 0x86, 0x2d, 0xd1, 0x6f, 0x12, 0xa1, 0x6f, 0x03, 0xd8, 0xce, 0xcc,
+	0x5a, 0xce, 0x23, 0x24, 0xdf, 0x10, 0x1d, 0xa8, 0xe0, 0xf4, 0x7b, 0x50, 0x59, 0x2e, 0xf0, 0x2b,
+	0x9f, 0x59, 0xf3, 0xe6, 0x4d, 0xc1, 0x90, 0xa0, 0xb0, 0x74, 0x9c, 0xa4, 0x48, 0xbd, 0x25, 0x46,
+	0x57, 0x22, 0x70, 0xa2, 0xbb, 0xe1, 0xae, 0xeb, 0x35, 0x9b, 0x34, 0x4f, 0x39, 0xa0, 0xdf, 0x85,
+	0x5c, 0x18, 0x4c, 0x9b, 0xb7, 0xe9, 0x2b, 0x81, 0x7f, 0x65, 0xf7, 0x7c, 0x11, 0x30, 0x44, 0xef,
+	0x96, 0xa0, 0x40, 0x13, 0xde, 0xb8, 0x0b, 0xe5, 0x03, 0x2b, 0xb0, 0x4e, 0x99, 0x33, 0xd3, 0x35,
+	0xc8, 0x2d, 0xfc, 0x50, 0xac, 0x56, 0x4c, 0x1a, 0x7d, 0x28, 0x3e, 0xb3, 0x02, 0xa4, 0xe9, 0x90,
+	0xf7, 0xac, 0x53, 0x87, 0x88, 0x15, 0x46, 0x69, 0x5c, 0x21, 0xe1, 0x45, 0x18, 0x39, 0xa7, 0x62,
+	0x1d, 0x0b, 0x08, 0xf1, 0x47, 0x73, 0x7f, 0x22, 0x56, 0x42, 0x99, 0x09, 0xc8, 0x18, 0x40, 0xb1,
+	0xed, 0xcf, 0xb1, 0xb4, 0x5b, 0x50, 0x0a, 0x9c, 0xb9, 0x99, 0xd4, 0x56, 0x0c, 0x9c, 0xf9, 0x81,
+	0x1f, 0x22, 0x61, 0xea, 0x73, 0x42, 0x96, 0x13, 0xa6, 0x3e, 0x11, 0x64, 0xfd, 0xb9, 0xa4, 0x7e,
+	0xe3, 0x53, 0xa8, 0x30, 0xeb, 0x4c, 0x14, 0x79, 0x03, 0x8a, 0xd1, 0x64, 0x6e, 0x0a, 0x69, 0x93,
+	0x67, 0x85, 0x68, 0x32, 0xef, 0xd9, 0x88, 0xc6, 0x02, 0x5d, 0x9b, 0xca, 0xcb, 0xb3, 0xc2, 0xd4,
+	0x9f, 0xf7, 0x6c, 0x63, 0x0c, 0xd0, 0xf6, 0x83, 0xe0, 0x7b, 0x37, 0x67, 0x0b, 0x0a, 0xb6, 0xb3,
+	0x88, 0x8e, 0xf9, 0x5a, 0x67, 0x1c, 0x30, 0x1e, 0x40, 0x19, 0xbb, 0xb8, 0xef, 0x86, 0x91, 0x7e,
+	0x0f, 0xf2, 0x73, 0x37, 0x8c, 0x9a, 0x99, 0xed, 0xdc, 0xa5, 0x01, 0x20, 0xbc, 0xb1, 0x0d, 0xe5,
+	0x7d, 0xeb, 0xfc, 0x19, 0x0e, 0x82, 0xbe, 0x25, 0x46, 0x43, 0xf4, 0xae, 0x18, 0x9a, 0x07, 0x00,
+	0x63, 0x2b, 0x38, 0x72, 0x22, 0x92, 0xa4, 0x77, 0x21, 0x17, 0x5d, 0x2c, 0x88, 0x23, 0x2e, 0x0e,
+	0x09, 0x0c, 0xd1, 0xc6, 0x9f, 0x67, 0xa0, 0x3a, 0x5a, 0x4e, 0xbe, 0x5a, 0x3a, 0xc1, 0x05, 0x7e,
+	0xd1, 0xfd, 0x84, 0xbb, 0xb1, 0x73, 0x93, 0x73, 0x2b, 0xf4, 0x24, 0x27, 0x7e, 0xa2, 0xe7, 0xdb,
+	0x8e, 0xec, 0xa1, 0x02, 0x2b, 0x22, 0xd8, 0xb3, 0x51, 0x74, 0xfb, 0x0b, 0xd1, 0xdf, 0x59, 0x7f,
+	0xa1, 0x6f, 0x43, 0x61, 0x7a, 0xec, 0xce, 0xed, 0x66, 0x5e, 0x6d, 0x02, 0x7d, 0x11, 0x27, 0xe8,
+	0xb7, 0xa1, 0x1c, 0xf8, 0x67, 0x66, 0xe8, 0xfe, 0x52, 0x8a, 0xe2, 0x52, 0xe0, 0x9f, 0x8d, 0xdc,
+	0x5f, 0x3a, 0xc6, 0x58, 0xec, 0x07, 0x00, 0xc5, 0x51, 0xbb, 0xd5, 0x6f, 0x31, 0xed, 0x1a, 0xa6,
+	0xbb, 0x9f, 0xf7, 0x46, 0xe3, 0x91, 0x96, 0xd1, 0x1b, 0x00, 0x83, 0xe1, 0xd8, 0x14, 0x70, 0x56,
+	0x2f, 0x42, 0xb6, 0x37, 0xd0, 0x72, 0xc8, 0x83, 0xf8, 0xde, 0x40, 0xcb, 0xeb, 0x25, 0xc8, 0xb5,
+	0x06, 0x5f, 0x68, 0x05, 0x4a, 0xf4, 0xfb, 0x5a, 0xd1, 0xf8, 0xbb, 0x59, 0xa8, 0x0c, 0x27, 0x5f,
+	0x3a, 0xd3, 0x08, 0xbf, 0x19, 0xa7, 0xa3, 0x13, 0xbc, 0x70, 0x02, 0xfa, 0xec, 0x1c, 0x13, 0x10,
+	0x7e, 0x88, 0x3d, 0xa1, 0x8f, 0xcb, 0xb1, 0xac, 0x3d, 0x21, 0xbe, 0xe9, 0xb1, 0x73, 0x6a, 0x35,
+	0x73, 0x82, 0x8f, 0x20, 0x9c, 0xfe, 0xfe, 0xe4, 0x4b, 0xfa, 0xbc, 0x1c, 0xc3, 0xa4, 0xfe, 0x1a,
+	0x54, 0x79, 0x19, 0x26, 0xcd, 0xbd, 0x02, 0xdf, 0x2d, 0x38, 0x6a, 0x80, 0x2b, 0xe0, 0x16, 0x94,
+	0xec, 0x09, 0x27, 0xf2, 0x5d, 0xa6, 0x68, 0x4f, 0x88, 0x80, 0x39, 0xa9, 0x54, 0x4e, 0x14, 0xfb,
+	0x0c, 0x47, 0x11, 0xc3, 0x6d, 0x28, 0xfb, 0x93, 0x2f, 0x39, 0xb5, 0x4c, 0xd4, 0x92, 0x3f, 0xf9,
+	0x92, 0x48, 0x3f, 0x84, 0xcd, 0x70, 0x39, 0x09, 0xa7, 0x81, 0xbb, 0x88, 0x5c, 0xdf, 0xe3, 0x3c,
+	0x15, 0xe2, 0xd1, 0x54, 0x02, 0x31, 0xdf, 0x87, 0xf2, 0x62, 0x39, 0x31, 0x5d, 0x6f, 0xe6, 0x93,
+	0x14, 0xaf, 0xee, 0xd4, 0xf9, 0xc0, 0x1c, 0x2c, 0x27, 0x3d, 0x6f, 0xe6, 0xb3, 0xd2, 0x82, 0x27,
+	0x8c, 0xb7, 0xa0, 0x24, 0x70, 0xb8, 0xc7, 0x46, 0x8e, 0x67, 0x79, 0x91, 0x19, 0x6f, 0xce, 0x65,
+	0x8e, 0xe8, 0xd9, 0xc6, 0x1f, 0x67, 0x40, 0x1b, 0x29, 0xd5, 0xec, 0x3b, 0x91, 0xb5, 0x76, 0xf9,
+	0xbf, 0x0a, 0x60, 0x4d, 0xa7, 0xfe, 0x92, 0x17, 0xc3, 0x27, 0x4f, 0x45, 0x60, 0x7a, 0xb6, 0xda,
+	0x37, 0xb9, 0x54, 0xdf, 0xbc, 0x0e, 0x35, 0x99, 0x8f, 0xa8, 0x79, 0xa2, 0x56, 0x05, 0x4e, 0xf6,
+	0x4e, 0xb8, 0x9c, 0xa8, 0xbd, 0x5e, 0x0a, 0x97, 0x94, 0xdb, 0xf8, 0xc3, 0x2c, 0x94, 0x1f, 0x2f,
+	0xbd, 0x29, 0x36, 0x4d, 0x7f, 0x03, 0xf2, 0xb3, 0xa5, 0x37, 0x6d, 0x66, 0xd4, 0x3d, 0x20, 0x9e,
+	0x11, 0x8c, 0x88, 0xb8, 0x12, 0xad, 0xe0, 0x08, 0x57, 0xf0, 0xca, 0x4a, 0x44, 0xbc, 0xf1, 0x0f,
+	0x32, 0xbc, 0xc4, 0xc7, 0x73, 0xeb, 0x48, 0x2f, 0x43, 0x7e, 0x30, 0x1c, 0x74, 0xb5, 0x6b, 0x7a,
+	0x0d, 0xca, 0xbd, 0xc1, 0xb8, 0xcb, 0x06, 0xad, 0xbe, 0x96, 0xa1, 0x89, 0x3b, 0x6e, 0xed, 0xf6,
+	0xbb, 0x5a, 0x16, 0x29, 0xcf, 0x86, 0xfd, 0xd6, 0xb8, 0xd7, 0xef, 0x6a, 0x79, 0x4e, 0x61, 0xbd,
+	0xf6, 0x58, 0x2b, 0xeb, 0x1a, 0xd4, 0x0e, 0xd8, 0xb0, 0x73, 0xd8, 0xee, 0x9a, 0x83, 0xc3, 0x7e,
+	0x5f, 0xd3, 0xf4, 0xeb, 0xb0, 0x11, 0x63, 0x86, 0x1c, 0xb9, 0x8d, 0x59, 0x9e, 0xb5, 0x58, 0x8b,
+	0xed, 0x69, 0x3f, 0xd3, 0xcb, 0x90, 0x6b, 0xed, 0xed, 0x69, 0x5f, 0xe3, 0x1a, 0xa8, 0x3c, 0xef,
+	0x0d, 0xcc, 0x67, 0xad, 0xfe, 0x61, 0x57, 0xfb, 0x3a, 0x2b, 0xe1, 0x21, 0xeb, 0x74, 0x99, 0xf6,
+	0x75, 0x1e, 0xe1, 0xfd, 0xe1, 0x60, 0x38, 0x1e, 0x0e, 0x7a, 0x6d, 0xed, 0xeb, 0xb2, 0xf1, 0x8f,
+	0xf2, 0x90, 0xc7, 0xcf, 0xf8, 0x66, 0xd1, 0xa0, 0xbf, 0x02, 0x99, 0x29, 0x8d, 0x4e, 0x75, 0xa7,
+	0xca, 0x69, 0xa4, 0xdf, 0x3c, 0xb9, 0xc6, 0x32, 0xd8, 0x37, 0x19, 0xbe, 0xc6, 0xab, 0x3b, 0x0d,
+	0x31, 0x6f, 0xc4, 0x6e, 0x80, 0xf4, 0x85, 0x7e, 0x17, 0x32, 0x2f, 0xc4, 0x82, 0xaf, 0x71, 0x3a,
+	0xdf, 0x0f, 0x90, 0xfa, 0x42, 0xdf, 0x86, 0xdc, 0xd4, 0xe7, 0xba, 0x4b, 0x4c, 0xe7, 0x22, 0xf5,
+	0xc9, 0x35, 0x86, 0x24, 0xfd, 0x0d, 0xc8, 0x05, 0xd6, 0x59, 0xb3, 0xa8, 0x8e, 0x4f, 0x2c, 0xb3,
+	0x91, 0x29, 0xb0, 0xce, 0xb0, 0x11, 0xb3, 0x66, 0x49, 0x6d, 0x84, 0x1c, 0x60, 0xac, 0x66, 0xa6,
+	0x6f, 0x43, 0xe6, 0xac, 0x59, 0x56, 0xb7, 0xeb, 0xe7, 0xae, 0x67, 0xfb, 0x67, 0xa3, 0x85, 0x33,
+	0x45, 0x8e, 0x33, 0xfd, 0x07, 0x90, 0x0b, 0x97, 0x13, 0x5a, 0x24, 0xd5, 0x9d, 0xcd, 0x15, 0x71,
+	0x87, 0x15, 0x85, 0xcb, 0x89, 0xfe, 0x16, 0xe4, 0xa7, 0x7e, 0x10, 0x34, 0x41, 0x2d, 0x2b, 0xd9,
+	0x07, 0x50, 0x7d, 0x41, 0x3a, 0x56, 0x18, 0x35, 0xab, 0x2a, 0x53, 0x22, 0x88, 0xb1, 0xc2, 0x48,
+	0x7f, 0x53, 0x48, 0xf7, 0x9a, 0xda, 0x6a, 0x29, 0xfb, 0xb1, 0x1c, 0xa4, 0xea, 0x06, 0xe4, 0x4e,
+	0xad, 0xf3, 0x66, 0x5d, 0x65, 0x92, 0x42, 0x1f, 0xdb, 0x74, 0x6a, 0x9d, 0xeb, 0x6f, 0x42, 0x6e,
+	0xe2, 0x7a, 0xcd, 0x86, 0x5a, 0xdb, 0xae, 0xeb, 0x59, 0xc1, 0x45, 0xc7, 0x8a, 0x2c, 0xe4, 0x9a,
+	0xb8, 0x1e, 0x6e, 0x63, 0xd6, 0xf2, 0x1c, 0xd7, 0xd9, 0x06, 0xdf, 0x70, 0xac, 0xe5, 0x79, 0xcf,
+	0x46, 0x91, 0xe5, 0xd9, 0x2f, 0x48, 0x4f, 0xca, 0x30, 0x4c, 0xa2, 0x82, 0x1d, 0x3a, 0x73, 0x67,
+	0x1a, 0xb9, 0x2f, 0xdc, 0xe8, 0x82, 0x94, 0xa3, 0x0c, 0x53, 0x51, 0xbb, 0x45, 0xc8, 0x3b, 0xe7,
+	0x8b, 0xc0, 0xd8, 0x06, 0x48, 0xea, 0xc1, 0x05, 0x6e, 0x5b, 0x91, 0x45, 0x93, 0xa8, 0xc6, 0x28,
+	0x6d, 0xdc, 0x86, 0x4a, 0xac, 0x42, 0xe9, 0x35, 0xc8, 0x58, 0x42, 0xb0, 0x66, 0x2c, 0xe3, 0x3e,
+	0x80, 0x20, 0xbd, 0xbf, 0xf3, 0x49, 0x9a, 0x86, 0x90, 0x14, 0xb7, 0x99, 0x89, 0xf1, 0xdb, 0x50,
+	0x63, 0x4e, 0xb8, 0x9c, 0x47, 0x6d, 0x7f, 0xde, 0x71, 0x66, 0xfa, 0xbb, 0x00, 0x31, 0x1c, 0x8a,
+	0xdd, 0x31, 0x99, 0x3a, 0x1d, 0x67, 0xc6, 0x14, 0xba, 0xf1, 0xcf, 0x72, 0x50, 0x14, 0x19, 0x93,
+	0x9d, 0x3c, 0xa3, 0xec, 0xe4, 0xb1, 0x64, 0xca, 0xa6, 0x15, 0x93, 0x63, 0xd7, 0xb6, 0x1d, 0x4f,
+	0x2a, 0x20, 0x1c, 0xc2, 0xbe, 0xb6, 0xe6, 0x47, 0x34, 0x9f, 0x1b, 0x3b, 0xba, 0xac, 0xf4, 0x74,
+	0x11, 0x38, 0x61, 0xc8, 0x17, 0x8c, 0x35, 0x3f, 0x92, 0xcb, 0xa9, 0xb0, 0x7e, 0x39, 0xdd, 0x86,
+	0xb2, 0xe7, 0x47, 0x26, 0x19, 0x06, 0x45, 0x2a, 0xbd, 0x24, 0xcc, 0x17, 0xfd, 0x6d, 0x28, 0x09,
+	0x95, 0xae, 0x59, 0x52, 0x45, 0x71, 0x87, 0x23, 0x99, 0xa4, 0xea, 0x4d, 0x54, 0x2b, 0x4e, 0x4f,
+	0x1d, 0x2f, 0x92, 0xb2, 0x5f, 0x80, 0xfa, 0x0f, 0xa1, 0xe2, 0x7b, 0x26, 0xd7, 0xfb, 0x9a, 0x15,
+	0x75, 0xde, 0x0c, 0xbd, 0x43, 0xc2, 0xb2, 0xb2, 0x2f, 0x52, 0xd8, 0x94, 0xb9, 0x7f, 0x66, 0x4e,
+	0xad, 0xc0, 0xa6, 0x29, 0x5d, 0x66, 0xa5, 0xb9, 0x7f, 0xd6, 0xb6, 0x02, 0x9b, 0xef, 0x85, 0x5f,
+	0x79, 0xcb, 0x53, 0x9a, 0xc6, 0x75, 0x26, 0x20, 0xfd, 0x2e, 0x54, 0xa6, 0xf3, 0x65, 0x18, 0x39,
+	0xc1, 0xee, 0x05, 0xd7, 0xe4, 0x59, 0x82, 0xc0, 0x76, 0x2d, 0x02, 0xf7, 0xd4, 0x0a, 0x2e, 0x68,
+	0xce, 0x96, 0x99, 0x04, 0x51, 0x43, 0x59, 0x9c, 0xb8, 0xf6, 0x39, 0x57, 0xe7, 0x19, 0x07, 0x90,
+	0xff, 0xd8, 0xb1, 0x6c, 0x27, 0x08, 0x69, 0x5a, 0x96, 0x99, 0x04, 0x69, 0x04, 0x28, 0x49, 0x73,
+	0xb3, 0xc2, 0x04, 0x64, 0x7c, 0x05, 0x25, 0xd1, 0x1b, 0xfa, 0x3d, 0x3e, 0x0f, 0xd3, 0x62, 0x8b,
+	0x8b, 0x65, 0xc4, 0xeb, 0x6f, 0x40, 0xdd, 0x0f, 0xdc, 0x23, 0xd7, 0x33, 0xc3, 0x28, 0x70, 0xbd,
+	0x23, 0x31, 0xc2, 0x35, 0x8e, 0x1c, 0x11, 0x0e, 0xf7, 0x12, 0x1c, 0x09, 0xd3, 0x9a, 0xb8, 0x73,
+	0x9c, 0xef, 0x39, 0x61, 0x50, 0x2e, 0xe7, 0xf3, 0x16, 0x47, 0x19, 0x43, 0x28, 0xcb, 0xbe, 0xfb,
+	0x8d, 0xd4, 0x69, 0xfc, 0x16, 0x54, 0x7b, 0x9e, 0xed, 0x9c, 0x0f, 0x69, 0x7b, 0xd4, 0xdf, 0x05,
+	0x7d, 0x1a, 0x38, 0x56, 0xe4, 0x98, 0xce, 0x79, 0x14, 0x58, 0x26, 0x37, 0x3a, 0xb9, 0xcd, 0xa8,
+	0x71, 0x4a, 0x17, 0x09, 0x63, 0xc4, 0x1b, 0xff, 0x36, 0x03, 0xf5, 0x03, 0xde, 0xa9, 0x4f, 0x9d,
+	0x8b, 0x0e, 0xd7, 0xac, 0xa7, 0x72, 0x29, 0xe4, 0x19, 0xa5, 0xf5, 0x7b, 0x50, 0x5d, 0x9c, 0x38,
+	0x17, 0x66, 0x4a, 0x75, 0xad, 0x20, 0xaa, 0x4d, 0x93, 0xfe, 0x1d, 0x28, 0xfa, 0x54, 0x7b, 0x33,
+	0xa7, 0x8a, 0x3c, 0xa5, 0x59, 0x4c, 0x30, 0xe8, 0x06, 0xd4, 0xe3, 0xa2, 0xd4, 0xed, 0x56, 0x14,
+	0x46, 0xdb, 0xed, 0x16, 0x14, 0x90, 0x14, 0x36, 0x0b, 0xdb, 0x39, 0xd4, 0x3f, 0x09, 0xd0, 0xdf,
+	0x83, 0xfa, 0xd4, 0x3f, 0x5d, 0x98, 0x32, 0xbb, 0x90, 0xe2, 0xe9, 0xc5, 0x5a, 0x45, 0x96, 0x03,
+	0x5e, 0x96, 0xf1, 0x47, 0x39, 0x28, 0x53, 0x1b, 0xc4, 0x7a, 0x75, 0xed, 0x73, 0xb9, 0x5e, 0x2b,
+	0xac, 0xe0, 0xda, 0x28, 0xb2, 0x5e, 0x05, 0x70, 0x91, 0xc5, 0x54, 0x56, 0x6d, 0x85, 0x30, 0xb2,
+	0x29, 0x0b, 0x2b, 0x88, 0xc2, 0x66, 0x8e, 0x37, 0x85, 0x00, 0x9c, 0x4e, 0x4b, 0xcf, 0xfd, 0x6a,
+	0xc9, 0x5b, 0x5f, 0x66, 0x02, 0xd2, 0xef, 0x83, 0xc6, 0x0b, 0xa3, 0x4e, 0x57, 0xf5, 0x85, 0x06,
+	0xe1, 0xa9, 0xcf, 0xa5, 0x42, 0xc6, 0x79, 0x9c, 0x73, 0x94, 0xdb, 0x7c, 0xe5, 0x02, 0xa1, 0xba,
+	0x88, 0x51, 0xd7, 0x64, 0x29, 0xbd, 0x26, 0x9b, 0x50, 0x7a, 0xe1, 0x86, 0x2e, 0x8e, 0x6a, 0x99,
+	0xcf, 0x72, 0x01, 0x2a, 0xc3, 0x50, 0x79, 0xd9, 0x30, 0xc4, 0x9f, 0x6d, 0xcd, 0x8f, 0xb8, 0xa6,
+	0x26, 0x3f, 0xbb, 0x35, 0x3f, 0xf2, 0xf5, 0x07, 0xb0, 0x99, 0x90, 0xcd, 0x05, 0xee, 0xc1, 0x21,
+	0xb7, 0xbf, 0xd9, 0x46, 0xcc, 0x45, 0x5b, 0x73, 0xa8, 0xbf, 0x0f, 0x37, 0x14, 0x5e, 0xfe, 0x55,
+	0xd1, 0xc5, 0xc2, 0xa1, 0xf5, 0x5c, 0x61, 0x7a, 0xcc, 0x4f, 0x5f, 0x8f, 0x92, 0xcb, 0xf8, 0xa7,
+	0x59, 0xa8, 0x3f, 0xf6, 0x03, 0xc7, 0x3d, 0xf2, 0x92, 0x59, 0xb7, 0xa2, 0xd0, 0xc9, 0x99, 0x98,
+	0x55, 0x66, 0xe2, 0x6b, 0x50, 0x9d, 0xf1, 0x8c, 0x66, 0x34, 0xe1, 0x06, 0x5d, 0x9e, 0x81, 0x40,
+	0x8d, 0x27, 0x73, 0x5c, 0x81, 0x92, 0x81, 0x32, 0xe7, 0x29, 0xb3, 0xcc, 0x84, 0x42, 0x5c, 0xff,
+	0x8c, 0x84, 0x9a, 0xed, 0xcc, 0x9d, 0x88, 0x0f, 0x4f, 0x63, 0xe7, 0x55, 0xb1, 0xcf, 0xab, 0x6d,
+	0x7a, 0xc8, 0x9c, 0x59, 0x8b, 0xb6, 0x7d, 0x94, 0x71, 0x1d, 0x62, 0xd7, 0x3f, 0x53, 0x05, 0x62,
+	0xf1, 0x5b, 0xe6, 0xe5, 0xab, 0xdd, 0x18, 0x43, 0x25, 0x46, 0xa3, 0xd2, 0xc6, 0xba, 0x42, 0x51,
+	0xbb, 0xa6, 0x57, 0xa1, 0xd4, 0x6e, 0x8d, 0xda, 0xad, 0x4e, 0x57, 0xcb, 0x20, 0x69, 0xd4, 0x1d,
+	0x73, 0xe5, 0x2c, 0xab, 0x6f, 0x40, 0x15, 0xa1, 0x4e, 0xf7, 0x71, 0xeb, 0xb0, 0x3f, 0xd6, 0x72,
+	0x7a, 0x1d, 0x2a, 0x83, 0xa1, 0xd9, 0x6a, 0x8f, 0x7b, 0xc3, 0x81, 0x96, 0x37, 0xfe, 0x12, 0x94,
+	0xdb, 0xc7, 0xce, 0xf4, 0xe4, 0xaa, 0x5e, 0x24, 0x3b, 0xc9, 0x99, 0x9e, 0x34, 0xb3, 0x2b, 0x42,
+	0x86, 0x13, 0x50, 0x6e, 0xa3, 0xb4, 0x41, 0x19, 0x23, 0x54, 0xe3, 0x12, 0xc2, 0xa3, 0x28, 0x20,
+	0x79, 0xe6, 0x47, 0xa6, 0xe3, 0xcd, 0xfc, 0x60, 0xea, 0xd8, 0xcd, 0x7c, 0xec, 0x20, 0xeb, 0x0a,
+	0x94, 0x31, 0x01, 0x18, 0x07, 0xee, 0xd1, 0x91, 0x13, 0x74, 0xae, 0xb6, 0xcb, 0x23, 0xf7, 0x34,
+	0x11, 0x5f, 0x02, 0xc2, 0xb5, 0xe5, 0xbc, 0xc0, 0x09, 0xce, 0x2b, 0xe5, 0x00, 0x96, 0x30, 0xf1,
+	0xed, 0x0b, 0x21, 0x17, 0x28, 0x6d, 0x3c, 0x83, 0x5a, 0x5b, 0xee, 0x0a, 0x57, 0xd5, 0xb2, 0x03,
+	0x0d, 0x12, 0x0f, 0xd3, 0x89, 0x94, 0x0f, 0xd9, 0x35, 0xf2, 0xa1, 0x86, 0x3c, 0xed, 0x89, 0x10,
+	0x10, 0x3f, 0x86, 0xea, 0x41, 0xe0, 0x2f, 0x9c, 0x20, 0xa2, 0x62, 0x35, 0xc8, 0x9d, 0x38, 0x17,
+	0xa2, 0x54, 0x4c, 0x26, 0x96, 0x70, 0x56, 0xb5, 0x84, 0x77, 0xa0, 0x2c, 0xb3, 0x7d, 0xeb, 0x3c,
+	0x3f, 0x85, 0xba, 0xc8, 0xe3, 0x3a, 0x21, 0x56, 0xf6, 0x10, 0x60, 0x11, 0x23, 0x84, 0xe2, 0x21,
+	0x75, 0x5e, 0x51, 0x38, 0x53, 0x38, 0x8c, 0x3f, 0xcf, 0x41, 0xe3, 0xc0, 0x0a, 0x22, 0x17, 0xa7,
+	0x0f, 0xef, 0x86, 0xb7, 0x21, 0x4f, 0x0b, 0x8d, 0x9b, 0xd5, 0xd7, 0x63, 0x85, 0x99, 0xf3, 0x90,
+	0x8e, 0x40, 0x0c, 0xfa, 0x67, 0xd0, 0x58, 0x48, 0xb4, 0x49, 0x3b, 0x0e, 0xef, 0x9b, 0xcb, 0x59,
+	0x68, 0x56, 0xd4, 0x17, 0x2a, 0xa8, 0xff, 0x04, 0xb6, 0xd2, 0x79, 0x9d, 0x30, 0x4c, 0x24, 0xbd,
+	0x3a, 0x9d, 0xae, 0xa7, 0x32, 0x72, 0x36, 0xbd, 0x0d, 0x9b, 0x49, 0xf6, 0xa9, 0x3f, 0x5f, 0x9e,
+	0x7a, 0xa1, 0xd0, 0xe0, 0x6f, 0x5e, 0xaa, 0xbd, 0xcd, 0xa9, 0x4c, 0x5b, 0x5c, 0xc2, 0xe8, 0x06,
+	0xd4, 0x62, 0xdc, 0x60, 0x79, 0x4a, 0x8b, 0x36, 0xcf, 0x52, 0x38, 0xfd, 0x03, 0x80, 0x18, 0x0e,
+	0x9b, 0xc5, 0xed, 0xdc, 0x9a, 0xef, 0xeb, 0x45, 0xce, 0x29, 0x53, 0xd8, 0x50, 0xff, 0x40, 0xa9,
+	0x15, 0xb8, 0xd1, 0xf1, 0x29, 0xc9, 0xd9, 0x1c, 0x4b, 0x10, 0x24, 0xce, 0x43, 0x13, 0x2d, 0xbf,
+	0x38, 0x8b, 0x10, 0xb9, 0x0d, 0x37, 0x1c, 0x2d, 0x27, 0x71, 0xb9, 0xb8, 0x51, 0x27, 0x5f, 0x79,
+	0x1a, 0x1e, 0x09, 0xfb, 0x38, 0x69, 0xe1, 0x7e, 0x78, 0xa4, 0xef, 0xc0, 0x8d, 0x84, 0x29, 0xd9,
+	0x21, 0xc2, 0x26, 0xd0, 0xde, 0x92, 0x74, 0x5f, 0xbc, 0x4d, 0x84, 0xc6, 0xcf, 0xa1, 0x9e, 0x1a,
+	0x9d, 0x97, 0xaa, 0x0c, 0xea, 0x62, 0xce, 0xa6, 0x16, 0xb3, 0xe1, 0x80, 0x76, 0xb9, 0xaf, 0xf5,
+	0x37, 0xc9, 0xa3, 0x84, 0xc9, 0x35, 0x9e, 0x21, 0x49, 0x42, 0x17, 0xc0, 0xea, 0x20, 0x66, 0xa9,
+	0xd5, 0x2b, 0x83, 0x65, 0xfc, 0xcd, 0x2c, 0xd4, 0x53, 0x3d, 0xae, 0xff, 0x40, 0x9d, 0x7e, 0xca,
+	0xc2, 0x4d, 0xfa, 0x8c, 0xf6, 0xc4, 0x77, 0x40, 0xf3, 0x03, 0xdb, 0xf5, 0x2c, 0xf2, 0x70, 0xf1,
+	0xee, 0xce, 0x92, 0xba, 0xb8, 0x21, 0xf0, 0x07, 0x02, 0x8d, 0x66, 0x85, 0xed, 0xc4, 0x2e, 0x01,
+	0x21, 0x40, 0x54, 0x94, 0xba, 0x7f, 0xe6, 0xd3, 0xfb, 0xe7, 0xdb, 0x50, 0x99, 0x3b, 0x61, 0x68,
+	0x46, 0xc7, 0x96, 0xd7, 0x2c, 0xac, 0x7c, 0x74, 0x19, 0x89, 0xe3, 0x63, 0xcb, 0x43, 0x46, 0xd7,
+	0x33, 0x85, 0x6b, 0xbe, 0xb8, 0xca, 0xe8, 0x7a, 0x64, 0x39, 0xa1, 0x66, 0xb2, 0xb5, 0x6e, 0x60,
+	0xc5, 0xc6, 0xad, 0xaf, 0x8e, 0xab, 0x11, 0x2a, 0x6b, 0xf9, 0x20, 0x58, 0x7a, 0x74, 0xce, 0xe0,
+	0x86, 0xe6, 0x02, 0xd3, 0xb6, 0xd0, 0xd6, 0xca, 0x6e, 0x48, 0x34, 0x5b, 0xef, 0xc0, 0x75, 0x6e,
+	0x32, 0x39, 0xb6, 0xa9, 0x4c, 0xf2, 0xec, 0xd5, 0x93, 0x5c, 0x97, 0xfc, 0x31, 0x3a, 0x34, 0xfe,
+	0x2c, 0x07, 0x8d, 0xd6, 0x3c, 0x72, 0x82, 0x18, 0xa7, 0x3f, 0x50, 0xfd, 0x72, 0x4d, 0x5e, 0x50,
+	0x9a, 0x05, 0x4d, 0x0d, 0x6e, 0x69, 0x7c, 0xaa, 0xce, 0x71, 0xdb, 0x99, 0x09, 0x19, 0xb2, 0x75,
+	0xa9, 0x7a, 0x12, 0x4d, 0xca, 0xcc, 0x47, 0x41, 0xf5, 0x01, 0xd4, 0x85, 0x4e, 0x4a, 0xbd, 0xc3,
+	0xb5, 0xa9, 0x58, 0xdc, 0x51, 0xb7, 0x70, 0xe1, 0x4c, 0x4c, 0x04, 0x87, 0xb8, 0xfa, 0xec, 0xc0,
+	0x5f, 0xa4, 0x56, 0x4a, 0x9e, 0xe6, 0x5c, 0x03, 0xf1, 0xc9, 0x22, 0xc1, 0xfe, 0x8f, 0x82, 0xa5,
+	0x37, 0x8d, 0x2b, 0x30, 0x55, 0xf5, 0x51, 0x97, 0x34, 0x25, 0xc7, 0xeb, 0x50, 0x7b, 0x61, 0xcd,
+	0x5d, 0xdc, 0x96, 0xcd, 0xf0, 0xab, 0xb9, 0xf0, 0x96, 0x55, 0x25, 0x6e, 0xf4, 0x15, 0xba, 0xd9,
+	0x1b, 0xa7, 0xfe, 0x0b, 0xc7, 0x44, 0xd3, 0x13, 0x79, 0xf0, 0x74, 0x06, 0x8b, 0xab, 0x21, 0x16,
+	0x6d, 0xd4, 0xd1, 0x57, 0x73, 0x5a, 0x19, 0xce, 0xf9, 0xf4, 0xd8, 0xf2, 0x8e, 0x38, 0xe7, 0xc4,
+	0x0a, 0xa5, 0x03, 0x4d, 0x93, 0x84, 0x8e, 0xc0, 0xe3, 0x3a, 0x88, 0x99, 0xb9, 0x5a, 0xce, 0xc5,
+	0x44, 0x5d, 0x62, 0xb9, 0x4e, 0xde, 0x81, 0xdc, 0xf8, 0x62, 0x41, 0x6e, 0xc5, 0x4e, 0x47, 0xbb,
+	0x86, 0x3e, 0xa0, 0x0e, 0x1b, 0x1e, 0x70, 0xcd, 0x60, 0xcc, 0x0e, 0x07, 0xed, 0xd6, 0x18, 0xfd,
+	0x3e, 0x0d, 0x00, 0xd6, 0x1d, 0xb2, 0xbd, 0xd6, 0xa0, 0xf7, 0xbb, 0x5d, 0x2d, 0x87, 0xd4, 0xee,
+	0xe7, 0xed, 0x27, 0xad, 0xc1, 0x5e, 0x57, 0xcb, 0x1b, 0xaf, 0x42, 0xe9, 0x99, 0xeb, 0x9c, 0x89,
+	0xed, 0xf2, 0x85, 0xeb, 0x9c, 0xc9, 0xed, 0x12, 0xd3, 0xc6, 0xbf, 0x28, 0x43, 0x59, 0x76, 0xfc,
+	0x55, 0xce, 0xea, 0xef, 0x62, 0xcb, 0x6e, 0x0b, 0x65, 0x2d, 0xbf, 0xc6, 0x82, 0x26, 0x0a, 0xaa,
+	0x9c, 0x8a, 0x72, 0xc8, 0xd5, 0xe2, 0x4a, 0x24, 0x75, 0x42, 0x32, 0x05, 0x69, 0xf8, 0x93, 0xf1,
+	0x48, 0x10, 0xfa, 0x43, 0x28, 0x63, 0x0b, 0xc9, 0xbb, 0x56, 0x52, 0xf7, 0x2e, 0xfa, 0x06, 0xe9,
+	0x9f, 0x61, 0xa5, 0x68, 0x32, 0x47, 0x80, 0x94, 0x64, 0x27, 0x08, 0xa5, 0xc4, 0xae, 0x33, 0x09,
+	0xe2, 0xa6, 0x89, 0x16, 0x44, 0xb3, 0xaa, 0x96, 0x92, 0x32, 0x81, 0x18, 0x31, 0xe8, 0xf7, 0xa1,
+	0x44, 0xaa, 0xab, 0x13, 0x36, 0x6b, 0xea, 0x74, 0x95, 0x16, 0x05, 0x93, 0x64, 0xfd, 0x1d, 0x28,
+	0xcc, 0x4e, 0x9c, 0x8b, 0xb0, 0x59, 0x57, 0x17, 0x64, 0x4a, 0x21, 0x64, 0x9c, 0x03, 0x67, 0x55,
+	0xe0, 0xcc, 0x4c, 0x72, 0x50, 0xa3, 0x06, 0x1b, 0x36, 0x1b, 0xa4, 0xa0, 0xd6, 0x02, 0x67, 0xd6,
+	0x46, 0xe4, 0x78, 0x32, 0x0f, 0xf5, 0xb7, 0xa0, 0x48, 0xaa, 0x19, 0xda, 0xb1, 0x4a, 0xcd, 0x52,
+	0xcf, 0x63, 0x82, 0xaa, 0xbf, 0x0b, 0xe5, 0x88, 0xeb, 0x5e, 0x61, 0x53, 0xdb, 0xce, 0x25, 0x1e,
+	0x9b, 0x44, 0x23, 0x63, 0x31, 0x87, 0xbe, 0x03, 0x95, 0x64, 0x1f, 0xbb, 0xf1, 0x0d, 0x8b, 0x37,
+	0x61, 0xd3, 0xdf, 0x07, 0x10, 0xf6, 0xb8, 0x39, 0xb9, 0xa0, 0x93, 0xa0, 0x6a, 0xec, 0xa9, 0x50,
+	0x34, 0x32, 0xd5, 0x6a, 0x7f, 0x1b, 0x0a, 0xa8, 0xb6, 0x84, 0xcd, 0x5b, 0xdb, 0xb9, 0xc4, 0x08,
+	0x51, 0xf4, 0x2c, 0xc6, 0xe9, 0xe8, 0x2b, 0xc6, 0xa9, 0x48, 0x0b, 0xb0, 0xa9, 0x3a, 0x28, 0xc4,
+	0xbc, 0x45, 0xc3, 0xc6, 0x39, 0xc3, 0xb5, 0xf8, 0x00, 0xf2, 0xb6, 0x33, 0x0b, 0x9b, 0xb7, 0xb7,
+	0x73, 0x89, 0xde, 0x20, 0x67, 0x2f, 0xfa, 0x33, 0xb8, 0xae, 0x83, 0x3c, 0xfa, 0x13, 0x68, 0xe0,
+	0x44, 0xdd, 0x21, 0x5b, 0x15, 0x07, 0xa8, 0x79, 0x87, 0x72, 0xbd, 0x7e, 0x29, 0xd7, 0x40, 0x30,
+	0xd1, 0x70, 0x76, 0xbd, 0x28, 0xb8, 0x60, 0x75, 0x4f, 0xc5, 0xe9, 0x77, 0xa0, 0xec, 0x86, 0x7d,
+	0x7f, 0x7a, 0xe2, 0xd8, 0xcd, 0x57, 0xa4, 0x44, 0xe6, 0x30, 0x0a, 0x43, 0x9a, 0xba, 0x08, 0x62,
+	0xe5, 0xcd, 0xbb, 0xaa, 0x0e, 0x36, 0x56, 0x49, 0x2c, 0xcd, 0x89, 0xb2, 0xc7, 0x0d, 0xcd, 0xc8,
+	0x39, 0x5d, 0xf8, 0x01, 0xba, 0x36, 0x5e, 0xe5, 0x3a, 0xb5, 0x1b, 0x8e, 0x25, 0x0a, 0x45, 0x5f,
+	0x7c, 0xe8, 0x6c, 0xfa, 0xb3, 0x59, 0xe8, 0x44, 0xcd, 0x7b, 0xb4, 0x32, 0x1b, 0xf2, 0xec, 0x79,
+	0x48, 0xd8, 0x3b, 0x7b, 0xe4, 0xc0, 0xa0, 0x72, 0x7f, 0x7c, 0x49, 0xa1, 0x4c, 0x4d, 0x6f, 0x45,
+	0xf3, 0xc4, 0xa3, 0xbe, 0x84, 0x71, 0xb7, 0x00, 0x39, 0xdb, 0x99, 0xdd, 0xf9, 0x19, 0xe8, 0xab,
+	0x3d, 0xf2, 0x32, 0xed, 0xb6, 0x20, 0xb4, 0xdb, 0xcf, 0xb2, 0x9f, 0x64, 0x8c, 0x4f, 0xa1, 0x9e,
+	0x5a, 0x8c, 0x6b, 0xb5, 0x74, 0x6e, 0x4f, 0x5b, 0xfc, 0x88, 0xae, 0xc6, 0x38, 0x60, 0xfc, 0x71,
+	0x0e, 0x6a, 0x4f, 0xac, 0xf0, 0x78, 0xdf, 0x5a, 0x8c, 0x22, 0x2b, 0x22, 0xf9, 0x7c, 0x6c, 0x85,
+	0xc7, 0xa7, 0xd6, 0x82, 0x1f, 0xdf, 0x64, 0xb8, 0xdf, 0x50, 0xe0, 0xf0, 0x08, 0x07, 0x47, 0x07,
+	0xc1, 0xa1, 0x77, 0xf0, 0x54, 0x9c, 0xf7, 0xc5, 0x30, 0xae, 0xfe, 0xf0, 0x78, 0x39, 0x9b, 0xcd,
+	0x1d, 0x21, 0xa5, 0x24, 0xa8, 0xbf, 0x09, 0x75, 0x91, 0x24, 0xcf, 0xc5, 0xb9, 0x38, 0xb9, 0x4f,
+	0x23, 0xf5, 0x0f, 0xa0, 0x2a, 0x10, 0x63, 0x29, 0xab, 0x1a, 0xb1, 0x1f, 0x37, 0x21, 0x30, 0x95,
+	0x4b, 0xff, 0x05, 0xdc, 0x50, 0xc0, 0xc7, 0x7e, 0xb0, 0xbf, 0x9c, 0x47, 0x6e, 0x7b, 0x20, 0xcc,
+	0xc4, 0x57, 0x56, 0xb2, 0x27, 0x2c, 0x6c, 0x7d, 0xce, 0x74, 0x6b, 0xf7, 0x5d, 0x4f, 0xa8, 0xa8,
+	0x69, 0xe4, 0x25, 0x2e, 0xeb, 0xbc, 0x59, 0x5e, 0xe1, 0xb2, 0xce, 0x71, 0xc6, 0x0a, 0xc4, 0xbe,
+	0x13, 0x1d, 0xfb, 0x76, 0xb3, 0xa2, 0xce, 0xd8, 0x91, 0x4a, 0x62, 0x69, 0x4e, 0xe3, 0x3f, 0x65,
+	0xa0, 0xc0, 0xc7, 0xe5, 0x15, 0xa8, 0x4c, 0xe6, 0xfe, 0xf4, 0xc4, 0x44, 0x57, 0x9e, 0x38, 0xa9,
+	0x21, 0x04, 0x6a, 0xe0, 0x64, 0xaf, 0x87, 0x11, 0x8d, 0x46, 0x86, 0x51, 0x1a, 0xb7, 0x0b, 0x7f,
+	0x19, 0x4d, 0x85, 0x91, 0x97, 0x61, 0x02, 0xc2, 0x11, 0x0a, 0xfc, 0x33, 0x1a, 0xdb, 0x3c, 0x11,
+	0x24, 0x88, 0x55, 0xf0, 0x6d, 0x02, 0x33, 0x15, 0x88, 0x56, 0x26, 0x44, 0xdb, 0x8b, 0x2e, 0xbb,
+	0x93, 0x8b, 0x2b, 0xee, 0x64, 0xfd, 0xa3, 0x78, 0xe6, 0x50, 0x8b, 0x9b, 0x25, 0x55, 0x64, 0xa9,
+	0x73, 0x8c, 0xa5, 0xf8, 0x8c, 0xe7, 0x00, 0xcc, 0x3f, 0x0b, 0x9d, 0x88, 0xb4, 0xec, 0x5b, 0xd4,
+	0xbc, 0xd4, 0x09, 0xac, 0x7f, 0x86, 0x07, 0xad, 0xe2, 0x4c, 0x3a, 0x1b, 0x9f, 0x49, 0xc7, 0x0a,
+	0x79, 0x6e, 0xbd, 0x42, 0x6e, 0x3c, 0x82, 0x12, 0x6e, 0x83, 0x56, 0x64, 0xa1, 0x97, 0x5e, 0x38,
+	0xb5, 0x15, 0x51, 0x9d, 0xd4, 0x2a, 0xdc, 0xdc, 0x8f, 0x64, 0x4b, 0x28, 0xcf, 0xeb, 0x8a, 0x3b,
+	0x2e, 0x16, 0x90, 0xa2, 0x40, 0xbe, 0xb1, 0x1a, 0xff, 0x2e, 0x03, 0xd5, 0x61, 0x60, 0xa3, 0xf0,
+	0xc5, 0x23, 0x88, 0x97, 0x9a, 0x08, 0xb8, 0xd3, 0xfa, 0xf3, 0xb9, 0x15, 0x2b, 0xd8, 0x15, 0x96,
+	0x20, 0xf4, 0xf7, 0x21, 0x3f, 0x9b, 0x5b, 0x47, 0xcd, 0x9c, 0xea, 0xdc, 0x50, 0x8a, 0x97, 0x69,
+	0x3c, 0x9e, 0x62, 0xc4, 0x6a, 0xfc, 0x1e, 0x54, 0x15, 0x64, 0xea, 0xa4, 0xea, 0x1a, 0xa9, 0x31,
+	0xa3, 0xb6, 0x96, 0x21, 0x35, 0xa6, 0x3b, 0x6a, 0x73, 0x97, 0x06, 0x3a, 0x37, 0x46, 0xe6, 0xe3,
+	0x1e, 0x1b, 0x8d, 0xb5, 0x3c, 0x1d, 0xb7, 0x12, 0xa2, 0xdf, 0x1a, 0xe1, 0xb9, 0x15, 0x40, 0xf1,
+	0x70, 0xd0, 0xfb, 0xc5, 0x61, 0x57, 0xd3, 0x8c, 0x7f, 0x93, 0x01, 0x48, 0xce, 0x57, 0xf4, 0x1f,
+	0x42, 0xf5, 0x8c, 0x20, 0x53, 0x39, 0x69, 0x53, 0xbf, 0x11, 0x38, 0x99, 0xb4, 0x80, 0x1f, 0x29,
+	0x76, 0x23, 0xee, 0x5f, 0xab, 0x47, 0x6e, 0xd5, 0x45, 0xb2, 0xf5, 0xe1, 0x76, 0xea, 0xe3, 0x77,
+	0x20, 0x6b, 0x4e, 0xdd, 0xbc, 0x94, 0xcf, 0x67, 0x25, 0x3f, 0xb0, 0xe5, 0x3e, 0x37, 0x0b, 0xa4,
+	0x07, 0x33, 0x66, 0x7d, 0x8c, 0xa8, 0xf6, 0xdc, 0x5a, 0x86, 0x0e, 0xe3, 0xf4, 0x58, 0x0e, 0x16,
+	0x94, 0x58, 0x81, 0xbf, 0x97, 0x81, 0xaa, 0xc2, 0xaa, 0x3f, 0x4a, 0x99, 0xf2, 0xaf, 0xac, 0x94,
+	0xc5, 0xd3, 0x8a, 0x49, 0xff, 0x16, 0x14, 0xc2, 0xc8, 0x0a, 0x22, 0xa1, 0x85, 0x6b, 0x4a, 0x8e,
+	0x5d, 0x7f, 0xe9, 0xd9, 0x8c, 0x93, 0xf1, 0xcc, 0xc7, 0xf1, 0xec, 0x66, 0xee, 0x0a, 0x2e, 0x24,
+	0x1a, 0xdb, 0x50, 0x89, 0x8b, 0xc7, 0x61, 0x62, 0xc3, 0xe7, 0x23, 0xed, 0x9a, 0x5e, 0x81, 0x02,
+	0x23, 0x65, 0x32, 0x63, 0xfc, 0xfd, 0x0c, 0x40, 0x92, 0x4b, 0x7f, 0x98, 0x6a, 0xed, 0x9d, 0xcb,
+	0xa5, 0x3e, 0xa4, 0x5f, 0xa5, 0xb1, 0x77, 0xa1, 0xb2, 0xf4, 0x08, 0xe9, 0xd8, 0x42, 0x58, 0x27,
+	0x08, 0x3c, 0xe0, 0x90, 0x61, 0x4a, 0x97, 0x42, 0x43, 0x5e, 0x58, 0x73, 0xe3, 0x33, 0xa8, 0xc4,
+	0xc5, 0xa1, 0xef, 0xeb, 0xf1, 0xb0, 0xdf, 0x1f, 0x3e, 0xef, 0x0d, 0xf6, 0xb4, 0x6b, 0x08, 0x1e,
+	0xb0, 0x6e, 0xbb, 0xdb, 0x41, 0x30, 0x83, 0xf3, 0xaa, 0x7d, 0xc8, 0x58, 0x77, 0x30, 0x36, 0xd9,
+	0xf0, 0xb9, 0x96, 0x35, 0xfe, 0x6a, 0x16, 0x36, 0x87, 0x5e, 0x67, 0xb9, 0x98, 0xbb, 0x68, 0x00,
+	0x3c, 0x75, 0x2e, 0xda, 0xd1, 0x39, 0x1e, 0x6a, 0x70, 0x09, 0x83, 0x46, 0x4c, 0x46, 0x3d, 0xd4,
+	0x88, 0x2d, 0x91, 0x72, 0x24, 0x55, 0xe3, 0xfb, 0xa0, 0xa1, 0xb9, 0x23, 0x8b, 0x30, 0xf1, 0xd0,
+	0x01, 0xa7, 0x51, 0x81, 0x35, 0xfc, 0xa4, 0x64, 0xdc, 0x34, 0x3e, 0x87, 0xcd, 0x14, 0xa7, 0x90,
+	0x0a, 0x38, 0x8d, 0xde, 0x95, 0x67, 0x26, 0x97, 0x9a, 0xa2, 0x62, 0xf0, 0x8b, 0xb9, 0x1a, 0xb2,
+	0xe1, 0xa7, 0xb1, 0x77, 0x06, 0xb0, 0xb5, 0x8e, 0x71, 0xcd, 0xee, 0xbc, 0xad, 0xee, 0xce, 0x97,
+	0x9c, 0x7d, 0xc9, 0x4e, 0xfd, 0x0f, 0xb3, 0x50, 0xe9, 0x79, 0xa1, 0x13, 0x44, 0xd8, 0x1d, 0xaf,
+	0x43, 0x2e, 0x88, 0x3b, 0x62, 0xe5, 0xcc, 0x1a, 0x69, 0xe8, 0x0e, 0xb6, 0x6c, 0xdb, 0xb4, 0x66,
+	0x33, 0x6e, 0x83, 0xa2, 0xac, 0x16, 0xe3, 0xb8, 0x61, 0xd9, 0x76, 0x4b, 0xe0, 0x51, 0x6c, 0x09,
+	0xa7, 0x89, 0x54, 0x1a, 0xb9, 0x99, 0x93, 0x93, 0x4e, 0x13, 0xa1, 0x33, 0x52, 0x3f, 0xa7, 0xc7,
+	0x21, 0xff, 0x92, 0x71, 0x78, 0x08, 0xd7, 0x2f, 0xdb, 0xd8, 0xae, 0xcd, 0x4d, 0xbc, 0x3c, 0xdb,
+	0x4c, 0x9b, 0xd8, 0x3d, 0x3b, 0xbc, 0xda, 0xd9, 0x52, 0xbc, 0xd2, 0xd9, 0x92, 0xf6, 0xe2, 0xe0,
+	0x40, 0x97, 0x48, 0xcc, 0x27, 0x32, 0xa4, 0x67, 0x9f, 0x1b, 0xff, 0x3e, 0x8b, 0x27, 0x86, 0x8b,
+	0xb9, 0x35, 0x75, 0xfe, 0xdf, 0xe9, 0xbd, 0xd7, 0xd0, 0x5f, 0x32, 0x77, 0x22, 0xc7, 0x9c, 0xfa,
+	0x9e, 0x2d, 0x23, 0x47, 0x38, 0xaa, 0xed, 0xd3, 0x8a, 0x5e, 0xdb, 0xbd, 0xc5, 0xef, 0xdc, 0xbd,
+	0xa5, 0xef, 0xd0, 0xbd, 0xe5, 0x35, 0xdd, 0xfb, 0x77, 0xf2, 0x50, 0x6d, 0x79, 0xd6, 0xfc, 0xe2,
+	0x97, 0x0e, 0xc5, 0x86, 0xd0, 0x41, 0xc5, 0x62, 0x19, 0xf1, 0x5e, 0xe3, 0x87, 0xba, 0x15, 0xc2,
+	0x50, 0x7f, 0xbd, 0x06, 0x55, 0x7f, 0x19, 0xc5, 0x74, 0x7e, 0xcc, 0x0b, 0x1c, 0x45, 0x0c, 0x71,
+	0x7e, 0xd2, 0x35, 0x72, 0x4a, 0x7e, 0xd2, 0x22, 0x93, 0xfc, 0xb1, 0x2e, 0x12, 0xe7, 0x27, 0x86,
+	0x37, 0xa0, 0x8e, 0x71, 0x75, 0xd8, 0x6f, 0xe1, 0xf2, 0xd4, 0xe1, 0x7d, 0x97, 0xe3, 0xc1, 0x76,
+	0x6d, 0x81, 0xc3, 0x52, 0x4e, 0x9d, 0x53, 0x3f, 0xb8, 0xe0, 0xa5, 0x14, 0x79, 0x29, 0x1c, 0x45,
+	0xa5, 0xbc, 0x0b, 0xfa, 0x99, 0xe5, 0x46, 0x66, 0xba, 0x28, 0xae, 0xcd, 0x69, 0x48, 0x19, 0xab,
+	0xc5, 0xdd, 0x84, 0xa2, 0xed, 0x86, 0x27, 0xbd, 0xa1, 0xd0, 0xe4, 0x04, 0x84, 0xaa, 0x51, 0xf8,
+	0x41, 0x6f, 0x68, 0x4e, 0x2e, 0xc4, 0x69, 0x6c, 0x8e, 0x95, 0x11, 0xb1, 0x7b, 0x11, 0xd1, 0xd9,
+	0x13, 0x11, 0xf9, 0xd7, 0x52, 0xec, 0x0a, 0x9d, 0xeb, 0xe4, 0x58, 0x03, 0xf1, 0x3d, 0x44, 0xb7,
+	0x11, 0x8b, 0xf3, 0x91, 0x38, 0xc5, 0x87, 0x73, 0xd6, 0x2a, 0xb1, 0x6e, 0x20, 0x61, 0xb8, 0x8c,
+	0x62, 0xde, 0xbb, 0x50, 0xf1, 0x9c, 0xe8, 0xcc, 0x0f, 0xb0, 0x35, 0x35, 0xde, 0x7b, 0x31, 0x02,
+	0x75, 0xf0, 0x70, 0x6a, 0x79, 0xd8, 0xf8, 0x66, 0x5d, 0xb4, 0x47, 0xc0, 0x18, 0xd9, 0xea, 0x92,
+	0x8c, 0x21, 0x6a, 0x83, 0x77, 0x49, 0x82, 0xc1, 0x3e, 0x0b, 0x17, 0xee, 0x7c, 0x2e, 0xea, 0xdf,
+	0xe0, 0x0c, 0x84, 0xe2, 0x55, 0xbf, 0x0a, 0x1c, 0xe2, 0x7d, 0xaa, 0xf1, 0xba, 0x09, 0x43, 0x21,
+	0x5c, 0x5f, 0xdf, 0x80, 0xfc, 0xc0, 0xb7, 0x1d, 0xfd, 0x3d, 0xa8, 0x50, 0xc4, 0xd8, 0xaa, 0x2b,
+	0x1c, 0xc9, 0xf4, 0x43, 0x5b, 0x51, 0xd9, 0x13, 0xa9, 0xab, 0x63, 0xcc, 0x5e, 0xa7, 0x4d, 0x95,
+	0x4e, 0xfb, 0x94, 0xf8, 0x14, 0xae, 0x2e, 0x72, 0x0a, 0x7e, 0x32, 0x19, 0xef, 0x81, 0xe3, 0x91,
+	0xaf, 0xa3, 0xc0, 0x62, 0x98, 0xd4, 0x8d, 0xc0, 0xc7, 0xb5, 0x6f, 0x52, 0x34, 0x46, 0x61, 0x8d,
+	0xba, 0xc1, 0xe9, 0x14, 0x92, 0xf7, 0x1e, 0x54, 0xbe, 0xf4, 0x5d, 0x8f, 0x37, 0xbc, 0xb8, 0xd2,
+	0xf0, 0x9f, 0xfb, 0x2e, 0xf7, 0xe1, 0x97, 0xbf, 0x14, 0x29, 0xfd, 0x0d, 0x28, 0xf9, 0x1e, 0x2f,
+	0xbb, 0xb4, 0x52, 0x76, 0xd1, 0xf7, 0xfa, 0x3c, 0xca, 0xa3, 0x3e, 0x59, 0xa2, 0x7b, 0x01, 0x59,
+	0x9d, 0x59, 0x24, 0x5c, 0xd6, 0x55, 0x42, 0x0e, 0xbd, 0xbe, 0x33, 0xc3, 0x73, 0xfd, 0xea, 0xcc,
+	0x45, 0x3f, 0x1f, 0x2f, 0xac, 0xb2, 0x52, 0x18, 0x70, 0x32, 0x15, 0xf8, 0x03, 0x28, 0x1f, 0x05,
+	0xfe, 0x72, 0x81, 0x6a, 0x11, 0xac, 0x70, 0x96, 0x88, 0xb6, 0x7b, 0x81, 0x5f, 0x4f, 0x49, 0xd7,
+	0x3b, 0x32, 0xd1, 0x60, 0xad, 0xae, 0x7e, 0xbd, 0xa4, 0x8f, 0x1c, 0x2a, 0xd5, 0x3a, 0x3a, 0x32,
+	0x45, 0xd8, 0xca, 0x4a, 0xa9, 0xd6, 0xd1, 0x11, 0x55, 0xfe, 0x10, 0xea, 0x67, 0x78, 0xfe, 0xbd,
+	0x70, 0xa6, 0x9c, 0xb7, 0xbe, 0x5a, 0xec, 0x99, 0xeb, 0xa1, 0x6a, 0x46, 0xfc, 0xaa, 0x0e, 0xd7,
+	0x78, 0xa9, 0x0e, 0xb7, 0x0d, 0x85, 0xb9, 0x7b, 0xea, 0xf2, 0xe9, 0x77, 0x69, 0xbf, 0x24, 0x82,
+	0x6e, 0x40, 0x51, 0x18, 0xe0, 0xda, 0x0a, 0x8b, 0xa0, 0xa4, 0x45, 0xb1, 0xfe, 0x12, 0x51, 0xbc,
+	0x03, 0xf5, 0x98, 0xd9, 0x7c, 0xe1, 0x4c, 0x9b, 0xd7, 0xd7, 0xfa, 0x42, 0xab, 0x32, 0xc3, 0x33,
+	0x67, 0x8a, 0xae, 0x28, 0x8c, 0xce, 0xc3, 0x8d, 0x66, 0x6b, 0xfd, 0x46, 0x53, 0xf4, 0x27, 0x5f,
+	0x62, 0xd0, 0xe1, 0xfb, 0x50, 0x0d, 0xc8, 0x78, 0x20, 0x6f, 0x64, 0xf3, 0x86, 0xaa, 0xf6, 0x25,
+	0x56, 0x05, 0x83, 0x20, 0x4e, 0xa3, 0x84, 0xe3, 0x91, 0x02, 0xfc, 0x68, 0x38, 0x24, 0x2f, 0x4f,
+	0x85, 0xd5, 0x08, 0xc9, 0x8f, 0x8d, 0x43, 0x3c, 0xad, 0x92, 0x1b, 0x48, 0x74, 0xde, 0xbc, 0xa5,
+	0x36, 0x82, 0x9f, 0x8c, 0xb6, 0xa3, 0x73, 0x56, 0xb1, 0x65, 0x12, 0x0d, 0xf8, 0x89, 0xeb, 0xd9,
+	0x38, 0x17, 0x22, 0xeb, 0x28, 0x6c, 0x36, 0x69, 0xa9, 0x54, 0x05, 0x6e, 0x6c, 0x1d, 0x85, 0xfa,
+	0x87, 0x50, 0xb3, 0xb8, 0xa0, 0xe7, 0xe1, 0x82, 0xb7, 0x55, 0x35, 0x5a, 0xd9, 0x02, 0x58, 0xd5,
+	0x4a, 0x00, 0xfd, 0x63, 0xd0, 0xa5, 0x23, 0x90, 0x34, 0x2c, 0x3e, 0x29, 0xee, 0xac, 0x4c, 0x8a,
+	0x0d, 0xe1, 0x09, 0x8c, 0x03, 0x60, 0x3f, 0x86, 0x7a, 0x7a, 0x5b, 0xbd, 0xbb, 0xc6, 0x99, 0x45,
+	0xdd, 0xcf, 0x6a, 0x53, 0x05, 0xc2, 0xfe, 0xc1, 0x33, 0xd0, 0xa9, 0x35, 0x3d, 0x76, 0x28, 0x23,
+	0x77, 0xd8, 0xe0, 0xc1, 0x68, 0x5b, 0xe2, 0xb0, 0x7f, 0xb8, 0x6c, 0xa3, 0xfe, 0xb9, 0xa7, 0xf6,
+	0x4f, 0xac, 0x69, 0xe1, 0xbe, 0x23, 0x92, 0x34, 0x4e, 0x5c, 0x89, 0xa0, 0x0c, 0xaf, 0xa5, 0xc6,
+	0x29, 0xd6, 0x2e, 0x18, 0x04, 0x71, 0x9a, 0x04, 0xa6, 0xbf, 0x0c, 0xa6, 0x8e, 0x19, 0x46, 0xce,
+	0xa2, 0xb9, 0x4d, 0x3d, 0x0a, 0x1c, 0x35, 0x8a, 0x9c, 0x85, 0xfe, 0x09, 0x34, 0x16, 0x81, 0x63,
+	0x2a, 0xe3, 0xf4, 0xba, 0xfa, 0x89, 0x07, 0x81, 0x93, 0x0c, 0x55, 0x6d, 0xa1, 0x40, 0x32, 0xa7,
+	0xf2, 0x05, 0xc6, 0xa5, 0x9c, 0xc9, 0x47, 0xd4, 0x16, 0x0a, 0xa4, 0xff, 0x14, 0x36, 0x95, 0x9c,
+	0xcb, 0x13, 0xca, 0xfc, 0x46, 0xca, 0xb7, 0x28, 0xd9, 0x0f, 0x4f, 0x30, 0x7b, 0x63, 0x91, 0x82,
+	0xf5, 0xd6, 0x25, 0xfd, 0x1a, 0x15, 0xda, 0x37, 0x29, 0xff, 0xad, 0x2b, 0x94, 0xe6, 0x94, 0xe2,
+	0xfd, 0x94, 0xbb, 0xa4, 0x7a, 0x61, 0xd7, 0xb3, 0x9b, 0x3f, 0xe0, 0x01, 0xe7, 0x04, 0xe8, 0x1f,
+	0x40, 0x8d, 0x3c, 0x15, 0x11, 0x85, 0xca, 0x85, 0xcd, 0xb7, 0x54, 0xa3, 0x9b, 0x9c, 0x71, 0x44,
+	0x60, 0xd5, 0x79, 0x9c, 0x0e, 0xf5, 0x8f, 0x60, 0x93, 0xfb, 0x37, 0x54, 0xe9, 0xf8, 0xf6, 0xea,
+	0xe4, 0x22, 0xa6, 0xc7, 0x89, 0x88, 0x64, 0x70, 0x3b, 0x58, 0x7a, 0xb4, 0xbb, 0x8b, 0x9c, 0x8b,
+	0xc0, 0x9f, 0x38, 0x3c, 0xff, 0xfd, 0xed, 0x5c, 0xf2, 0x39, 0x8c, 0xb3, 0xf1, 0xbc, 0x24, 0x8c,
+	0x6e, 0x06, 0x2a, 0xea, 0x00, 0xf3, 0x5d, 0x51, 0x26, 0x17, 0xeb, 0x54, 0xe6, 0x3b, 0xdf, 0xa5,
+	0xcc, 0x5d, 0xcc, 0x47, 0x65, 0xea, 0x90, 0x5f, 0x2e, 0x5d, 0xbb, 0xf9, 0x80, 0x87, 0xd5, 0x61,
+	0x5a, 0xff, 0x09, 0x6c, 0x24, 0x6a, 0x19, 0x1d, 0x40, 0x35, 0x7f, 0xb8, 0xd6, 0x39, 0x4c, 0x87,
+	0x51, 0xac, 0xb1, 0x48, 0xc1, 0xc6, 0xbf, 0xca, 0x43, 0x59, 0xee, 0xb1, 0x18, 0xc7, 0x70, 0x38,
+	0x78, 0x3a, 0x18, 0x3e, 0x1f, 0x68, 0xd7, 0xd0, 0xaa, 0xa7, 0xe0, 0x51, 0x73, 0xd4, 0x6e, 0x0d,
+	0x78, 0x50, 0x35, 0x85, 0xac, 0x72, 0x38, 0xab, 0x6f, 0x42, 0xfd, 0xf1, 0xe1, 0x80, 0xe2, 0x18,
+	0x38, 0x2a, 0x87, 0xa8, 0xee, 0xe7, 0xdc, 0x75, 0xc0, 0x51, 0x79, 0x44, 0xed, 0xb7, 0xc6, 0x5d,
+	0xd6, 0x93, 0xa8, 0x02, 0x85, 0x44, 0x8c, 0x59, 0xb7, 0xb5, 0xcf, 0x11, 0x45, 0xac, 0xf6, 0x80,
+	0x0d, 0x7f, 0xde, 0x6d, 0x8f, 0x35, 0xd0, 0x6f, 0xc0, 0x66, 0x5c, 0x86, 0x2c, 0x5f, 0xab, 0xa2,
+	0x57, 0x42, 0x96, 0xa3, 0x6d, 0x61, 0xa9, 0xac, 0xdb, 0x3e, 0x64, 0xa3, 0xde, 0xb3, 0xae, 0xd9,
+	0x1e, 0x77, 0xb5, 0x1b, 0x68, 0xf8, 0x8e, 0x7a, 0x83, 0xa7, 0xda, 0x4d, 0x34, 0x2b, 0x31, 0xc5,
+	0x4b, 0xbf, 0xa5, 0xeb, 0xd0, 0x48, 0x78, 0x09, 0xd7, 0x24, 0xaf, 0xc6, 0xde, 0x9e, 0x76, 0x0f,
+	0x8b, 0xed, 0xf4, 0x46, 0xe3, 0xde, 0xa0, 0x3d, 0xd6, 0x5e, 0x43, 0xc7, 0xc5, 0xe3, 0x5e, 0x7f,
+	0xdc, 0x65, 0xda, 0x36, 0x96, 0xf7, 0xf3, 0x61, 0x6f, 0xa0, 0xbd, 0x8e, 0xd8, 0x51, 0x6b, 0xff,
+	0xa0, 0xdf, 0xd5, 0x0c, 0xaa, 0x65, 0xc8, 0xc6, 0xda, 0x1b, 0x68, 0x5e, 0x1f, 0x0e, 0xb0, 0x6d,
+	0x6f, 0x62, 0x85, 0x94, 0x34, 0x31, 0x8e, 0xfc, 0x07, 0x8a, 0xfb, 0xe3, 0x2d, 0x4c, 0x3f, 0xef,
+	0x0d, 0x3a, 0xc3, 0xe7, 0xda, 0xdb, 0xc8, 0xb6, 0xcb, 0x86, 0xad, 0x4e, 0x1b, 0xbd, 0x24, 0xf7,
+	0xb1, 0x80, 0xd1, 0x41, 0xbf, 0x37, 0xd6, 0xde, 0x41, 0xae, 0xbd, 0xd6, 0xf8, 0x49, 0x97, 0x69,
+	0x0f, 0x30, 0xdd, 0x1a, 0x8d, 0xba, 0x6c, 0xac, 0xed, 0x60, 0xba, 0x37, 0xa0, 0xf4, 0x07, 0x98,
+	0xee, 0x74, 0xfb, 0xdd, 0x71, 0x57, 0xfb, 0x10, 0x3b, 0x8c, 0x75, 0x0f, 0xfa, 0xad, 0x76, 0x57,
+	0xfb, 0x31, 0x02, 0xfd, 0x61, 0xfb, 0xa9, 0x39, 0x3c, 0xd0, 0x3e, 0xc2, 0x3a, 0xc8, 0x79, 0x33,
+	0xc2, 0xce, 0xfc, 0x18, 0xfb, 0x29, 0x06, 0xa9, 0x75, 0x9f, 0x60, 0xb5, 0xfb, 0xbd, 0xc1, 0xe1,
+	0x48, 0xfb, 0x14, 0x99, 0x29, 0x49, 0x94, 0xcf, 0xf4, 0x2d, 0xd0, 0x86, 0x03, 0xb3, 0x73, 0x78,
+	0xd0, 0xef, 0xe1, 0x11, 0x95, 0xf9, 0xb4, 0xfb, 0x85, 0xf6, 0x5b, 0x38, 0xec, 0x07, 0xac, 0x6b,
+	0x8a, 0x76, 0xfc, 0xb6, 0x84, 0x45, 0x5b, 0x7e, 0x82, 0x55, 0x24, 0x74, 0xf3, 0xf0, 0xa9, 0xf6,
+	0x3b, 0xc6, 0xff, 0x0f, 0x65, 0xa9, 0xfd, 0x60, 0x75, 0xbd, 0xc1, 0xa0, 0xcb, 0xf8, 0x41, 0x58,
+	0xbf, 0xfb, 0x78, 0xac, 0x65, 0x10, 0xc9, 0x7a, 0x7b, 0x4f, 0xc6, 0x5a, 0x16, 0x93, 0xc3, 0x43,
+	0xec, 0xf1, 0x1c, 0xf5, 0x6d, 0x77, 0xbf, 0xa7, 0xe5, 0x31, 0xd5, 0x1a, 0x8c, 0x7b, 0x5a, 0x81,
+	0xfa, 0xbe, 0x37, 0xd8, 0xeb, 0x77, 0xb5, 0x22, 0x62, 0xf7, 0x5b, 0xec, 0xa9, 0x56, 0xc2, 0x4c,
+	0xad, 0x83, 0x83, 0xfe, 0x17, 0x5a, 0x19, 0x27, 0x13, 0xe5, 0x37, 0x39, 0xa2, 0x62, 0xdc, 0x87,
+	0x52, 0xeb, 0xe8, 0x68, 0x1f, 0x55, 0xcb, 0x32, 0xe4, 0x1f, 0x63, 0x14, 0x0e, 0x5d, 0x0e, 0xd8,
+	0x1d, 0x8e, 0xc7, 0xc3, 0x7d, 0x2d, 0x83, 0x63, 0x3f, 0x1e, 0x1e, 0x68, 0x59, 0xe3, 0x0f, 0x72,
+	0x00, 0x89, 0x24, 0xc1, 0xa3, 0x77, 0x69, 0x39, 0x89, 0x73, 0xb4, 0x52, 0xc4, 0xed, 0x25, 0x7d,
+	0x07, 0x6e, 0x8a, 0xd0, 0x45, 0x11, 0x43, 0x77, 0x6e, 0xba, 0x9e, 0x39, 0xb1, 0x22, 0xa1, 0x80,
+	0xea, 0x82, 0xca, 0xfd, 0xcf, 0x3d, 0x6f, 0xd7, 0x8a, 0xf4, 0x1d, 0xd8, 0x50, 0xf3, 0xe0, 0x39,
+	0x6d, 0x6e, 0x25, 0x06, 0xb4, 0x9e, 0x64, 0xc4, 0x33, 0xc3, 0xf7, 0xe0, 0x46, 0xe0, 0xcc, 0x02,
+	0x27, 0x3c, 0x36, 0xa3, 0x50, 0xad, 0x86, 0xbb, 0xb9, 0x37, 0x05, 0x71, 0x1c, 0xc6, 0xb5, 0xbc,
+	0x07, 0x37, 0x84, 0x74, 0xb9, 0xd4, 0x30, 0x7e, 0x63, 0x62, 0x93, 0x13, 0xd5, 0x76, 0xbd, 0x0a,
+	0x20, 0x04, 0xab, 0xbc, 0xcd, 0x56, 0x66, 0x15, 0x2e, 0x44, 0x71, 0x27, 0x7c, 0x17, 0x74, 0x3c,
+	0xc8, 0x4e, 0x1b, 0x87, 0x64, 0xea, 0x94, 0x99, 0xe6, 0x86, 0x07, 0x29, 0xc3, 0xf0, 0x2a, 0xbb,
+	0xb3, 0x7c, 0x95, 0xdd, 0xb9, 0x05, 0x05, 0x92, 0xbd, 0x64, 0xfe, 0x94, 0x19, 0x07, 0x8c, 0x7f,
+	0x9c, 0x81, 0x46, 0x7a, 0x9f, 0xe1, 0xe7, 0xff, 0x49, 0x60, 0x43, 0x21, 0x09, 0x66, 0x78, 0x05,
+	0x2a, 0x8b, 0x13, 0x11, 0xc5, 0x20, 0xba, 0xbf, 0xbc, 0x38, 0xe1, 0xd1, 0x0b, 0xa8, 0x61, 0x2f,
+	0x4e, 0xb8, 0x46, 0xbe, 0xda, 0xd9, 0xc5, 0xc5, 0x89, 0x54, 0xc3, 0x97, 0x82, 0x29, 0xbf, 0xca,
+	0xb4, 0xe4, 0x4c, 0x29, 0xa5, 0xb0, 0xf0, 0xcd, 0x4a, 0xa1, 0xb1, 0x0d, 0x35, 0x75, 0x7b, 0x46,
+	0xcf, 0x0e, 0x1a, 0xc8, 0xbc, 0xe5, 0x98, 0x34, 0xfe, 0x46, 0x06, 0x6a, 0xf1, 0x27, 0x7e, 0x4b,
+	0xc7, 0x43, 0xaa, 0x09, 0xd9, 0x97, 0xe8, 0xa5, 0xdb, 0xe4, 0x38, 0x37, 0xe9, 0xdc, 0x09, 0xa3,
+	0xa7, 0xb8, 0xd7, 0x01, 0x8e, 0xad, 0xb0, 0xb5, 0x8c, 0xfc, 0xb6, 0x3f, 0x17, 0x21, 0x0a, 0x22,
+	0xf6, 0x2d, 0x2f, 0x0f, 0xc4, 0x44, 0x70, 0x5b, 0x17, 0x36, 0x57, 0xb6, 0x21, 0xfc, 0x8c, 0xc8,
+	0x3a, 0x92, 0x37, 0xb8, 0x22, 0xeb, 0x28, 0xf6, 0x4d, 0x67, 0xaf, 0xf0, 0x96, 0xdf, 0x85, 0x62,
+	0x2f, 0xde, 0xaa, 0xe2, 0x0b, 0x4b, 0x39, 0x71, 0x49, 0xc9, 0x87, 0x4a, 0x9b, 0x2e, 0x3c, 0xed,
+	0x5b, 0x0b, 0x8c, 0x5d, 0x38, 0xb5, 0x16, 0xc2, 0x31, 0xde, 0x8c, 0x1d, 0xe3, 0x9c, 0xfa, 0x70,
+	0xdf, 0x5a, 0x70, 0x6f, 0x1a, 0x32, 0xdd, 0xf9, 0x08, 0xca, 0x12, 0xf1, 0x9d, 0xce, 0xb4, 0xfe,
+	0x7b, 0x16, 0x2a, 0x1d, 0x55, 0xa9, 0x9d, 0x5a, 0x9e, 0x29, 0xe3, 0x09, 0x44, 0x98, 0x46, 0x15,
+	0x2d, 0x5e, 0x81, 0x92, 0xa3, 0x92, 0xfd, 0x86, 0x51, 0xb9, 0x0b, 0xa8, 0x7d, 0x9b, 0xae, 0x4d,
+	0x3e, 0x10, 0x7e, 0x61, 0x0b, 0x2f, 0x2a, 0xf5, 0x6c, 0xf4, 0x22, 0xae, 0x75, 0x16, 0xe5, 0xbf,
+	0xbd, 0xb3, 0xa8, 0xb0, 0xd6, 0x59, 0xf4, 0x7f, 0x8b, 0x7b, 0x47, 0x7f, 0x2b, 0x11, 0x6a, 0x18,
+	0xa6, 0x87, 0x6c, 0x15, 0x7e, 0x02, 0xb7, 0x88, 0x8f, 0xe0, 0xd1, 0x0d, 0xf4, 0xa7, 0x59, 0x28,
+	0xfc, 0x02, 0xaf, 0x4b, 0xe8, 0x1f, 0x41, 0x25, 0x8c, 0x4e, 0x23, 0xd5, 0xbc, 0xbf, 0xcd, 0xfb,
+	0x95, 0xe8, 0x64, 0x9d, 0x3b, 0x18, 0xd8, 0xc3, 0x6d, 0x65, 0xe4, 0xc5, 0x14, 0x0e, 0x2a, 0xea,
+	0xc9, 0xa1, 0xf0, 0xd6, 0x72, 0x00, 0x0d, 0x3e, 0xb4, 0xf5, 0x65, 0x04, 0x0a, 0x24, 0xf6, 0x36,
+	0xe3, 0x04, 0x34, 0xf8, 0x44, 0xbc, 0x6b, 0x7e, 0xd5, 0xc4, 0xe6, 0x14, 0x3a, 0x7b, 0x74, 0x2c,
+	0xb4, 0x64, 0x64, 0x90, 0x49, 0x0c, 0xa3, 0xe0, 0x99, 0xfb, 0x96, 0x3d, 0xb6, 0x8e, 0x64, 0x3c,
+	0xbe, 0x00, 0x0d, 0x1b, 0xea, 0xa9, 0xc6, 0xa6, 0xb5, 0x25, 0xdc, 0xa8, 0xba, 0x7d, 0xdc, 0x75,
+	0x33, 0xca, 0xb6, 0x9d, 0x55, 0xb7, 0xea, 0x9c, 0xb2, 0x87, 0xd3, 0x45, 0x9f, 0xc3, 0x83, 0x0e,
+	0x86, 0x82, 0x14, 0x68, 0x4f, 0xee, 0xb2, 0xbd, 0xae, 0x56, 0x34, 0xfe, 0x56, 0x16, 0x36, 0xc7,
+	0x81, 0xe5, 0x85, 0x16, 0x0f, 0xca, 0xf2, 0xa2, 0xc0, 0x9f, 0xeb, 0x9f, 0x41, 0x39, 0x9a, 0xce,
+	0xd5, 0x4e, 0x7c, 0x4d, 0x46, 0x0a, 0x5c, 0x62, 0x7d, 0x38, 0x9e, 0xce, 0xa9, 0x2b, 0x4b, 0x11,
+	0x4f, 0xe8, 0x3f, 0x82, 0xc2, 0xc4, 0x39, 0x72, 0x3d, 0x31, 0xab, 0x6f, 0x5c, 0xce, 0xb8, 0x8b,
+	0x44, 0xbc, 0x12, 0x4c, 0x5c, 0xfa, 0x7b, 0x78, 0x31, 0xe2, 0x14, 0x8d, 0xea, 0x9c, 0x1a, 0xe6,
+	0xa7, 0x56, 0x84, 0x54, 0xbc, 0xf6, 0xcb, 0xf9, 0xf4, 0x8f, 0xf0, 0xa2, 0xde, 0x7c, 0x3e, 0xb1,
+	0xa6, 0x27, 0x42, 0xa0, 0x36, 0x2f, 0xe7, 0x61, 0x82, 0xfe, 0xe4, 0x1a, 0x8b, 0x79, 0x8d, 0x87,
+	0x50, 0x12, 0x8d, 0xc5, 0x0e, 0xd8, 0xed, 0xee, 0xf5, 0x44, 0x47, 0xb6, 0x87, 0xfb, 0xfb, 0xbd,
+	0x31, 0x0f, 0x98, 0x61, 0xc3, 0x7e, 0x7f, 0xb7, 0xd5, 0x7e, 0xaa, 0x65, 0x77, 0xcb, 0x50, 0xb4,
+	0xe8, 0x68, 0xda, 0xf8, 0x83, 0x0c, 0x6c, 0x5c, 0xfa, 0x00, 0xfd, 0x13, 0xc8, 0x9f, 0xfa, 0xb6,
+	0xec, 0x9e, 0x37, 0xd7, 0x7e, 0xa5, 0x02, 0xa3, 0x82, 0xc0, 0x28, 0x87, 0xf1, 0x29, 0x34, 0xd2,
+	0x78, 0xe5, 0xda, 0x56, 0x1d, 0x2a, 0xac, 0xdb, 0xea, 0x98, 0xc3, 0x41, 0xff, 0x0b, 0xae, 0x03,
+	0x13, 0xf8, 0x9c, 0xf5, 0x30, 0x86, 0xc7, 0xf8, 0x3d, 0xd0, 0x2e, 0x77, 0x8c, 0xbe, 0x07, 0x1b,
+	0x18, 0xa5, 0x3a, 0x77, 0xf8, 0xea, 0x4b, 0x86, 0xec, 0xde, 0x9a, 0x9e, 0x14, 0x6c, 0x34, 0x62,
+	0x8d, 0x69, 0x0a, 0x36, 0xfe, 0x3f, 0xd0, 0x57, 0x7b, 0xf0, 0x37, 0x57, 0xfc, 0x5f, 0x64, 0x20,
+	0x7f, 0x30, 0xb7, 0x70, 0xd3, 0x2c, 0xd0, 0xd5, 0xa6, 0x66, 0x46, 0x75, 0x9b, 0xd1, 0xf2, 0xc4,
+	0x69, 0x41, 0x34, 0xfd, 0x87, 0x90, 0x8b, 0xa6, 0x32, 0x28, 0xf7, 0xd6, 0x15, 0x93, 0x0f, 0xef,
+	0x17, 0x45, 0xd3, 0x39, 0xde, 0x17, 0xb5, 0x6d, 0x79, 0x24, 0x24, 0xec, 0x10, 0x74, 0x56, 0x74,
+	0x9c, 0x99, 0xeb, 0xb9, 0xe2, 0x2a, 0x16, 0xb2, 0xe0, 0x55, 0x2b, 0x7b, 0x3a, 0x4f, 0x9f, 0xc1,
+	0x21, 0xa7, 0x52, 0xa0, 0x3d, 0xc5, 0x9b, 0xdc, 0xf5, 0x28, 0xb8, 0x30, 0x83, 0xa5, 0x47, 0x3e,
+	0xd8, 0x50, 0xa8, 0x37, 0x55, 0xdc, 0x21, 0x96, 0xe4, 0xb0, 0xe4, 0xae, 0x62, 0x0c, 0xc1, 0x73,
+	0x16, 0x56, 0x10, 0x2b, 0x36, 0x18, 0x83, 0x47, 0x08, 0xbc, 0xa8, 0x84, 0xa5, 0x1b, 0xef, 0xd2,
+	0xc5, 0x1f, 0x54, 0x16, 0x0c, 0x99, 0x5a, 0x13, 0x3b, 0x29, 0x28, 0xc6, 0xff, 0xc8, 0x42, 0x55,
+	0x69, 0x8f, 0xfe, 0x21, 0x94, 0xed, 0xe9, 0x7c, 0x8d, 0x34, 0x53, 0x98, 0x1e, 0x76, 0xe4, 0x12,
+	0xb4, 0x79, 0x82, 0x0e, 0xef, 0x9d, 0xc8, 0x7c, 0x61, 0x05, 0x2e, 0x0f, 0xa0, 0xcb, 0xaa, 0xf6,
+	0xf9, 0xc8, 0x89, 0x9e, 0x49, 0x0a, 0x5e, 0x04, 0x0f, 0x15, 0x58, 0x7f, 0x07, 0x2f, 0xd1, 0xf0,
+	0x4f, 0xca, 0xa5, 0x2e, 0x64, 0x72, 0x24, 0xde, 0xdc, 0x16, 0x74, 0x64, 0x75, 0xce, 0x9d, 0xe9,
+	0x32, 0x92, 0x7a, 0x4d, 0x5d, 0x7e, 0x10, 0x21, 0x91, 0x55, 0xd0, 0xf5, 0x1d, 0xf4, 0x07, 0x59,
+	0xf3, 0xb9, 0x4f, 0x1b, 0x61, 0x41, 0x75, 0x5f, 0x74, 0x62, 0x3c, 0xbf, 0x54, 0x2e, 0x21, 0xe3,
+	0x08, 0x4a, 0xe2, 0xc3, 0x50, 0xe7, 0xc7, 0xa0, 0xf6, 0x67, 0x2d, 0xd6, 0x43, 0x8b, 0x50, 0x9c,
+	0x36, 0xee, 0xb1, 0xd6, 0x40, 0x88, 0x3f, 0xd6, 0x7d, 0x36, 0x7c, 0x8a, 0x41, 0x6e, 0x74, 0x6a,
+	0x3c, 0xf8, 0x42, 0xcb, 0x71, 0x23, 0xaf, 0x7b, 0xd0, 0x62, 0x28, 0xfc, 0xaa, 0x50, 0xea, 0x7e,
+	0xde, 0x6d, 0x1f, 0x92, 0xf4, 0x6b, 0x00, 0x74, 0xba, 0xad, 0x7e, 0x7f, 0x48, 0x81, 0x71, 0xc5,
+	0xdd, 0x0a, 0xea, 0x7e, 0xd4, 0x93, 0xc6, 0x7f, 0x68, 0x40, 0x23, 0x3d, 0x71, 0xf4, 0x8f, 0xa1,
+	0x6c, 0xdb, 0xa9, 0x11, 0xb8, 0xbb, 0x6e, 0x82, 0x3d, 0xec, 0xd8, 0x72, 0x10, 0x78, 0x02, 0xbd,
+	0xc3, 0x7c, 0x9a, 0x67, 0x57, 0xa6, 0xb9, 0x9c, 0xe4, 0x3f, 0x85, 0x0d, 0x11, 0xe8, 0x18, 0x07,
+	0x03, 0xa6, 0xe6, 0x70, 0x9b, 0x88, 0x32, 0x20, 0xf0, 0xc9, 0x35, 0xd6, 0x98, 0xa6, 0x30, 0xfa,
+	0x6f, 0x43, 0xc3, 0x22, 0x6d, 0x3c, 0xce, 0x9f, 0x57, 0x03, 0x79, 0x28, 0x36, 0x53, 0xc9, 0x5e,
+	0xb7, 0x54, 0x04, 0x4e, 0x13, 0x0a, 0x99, 0x8c, 0x33, 0x17, 0xd4, 0x69, 0xd2, 0x09, 0xfc, 0x85,
+	0x92, 0xb7, 0x66, 0x2b, 0x30, 0xc6, 0x4d, 0xa8, 0x21, 0x9a, 0xcd, 0xa2, 0xba, 0xa0, 0xda, 0x49,
+	0x5c, 0x26, 0x3e, 0x7f, 0xa0, 0x84, 0x69, 0x62, 0xa8, 0x0c, 0x6f, 0x70, 0xa2, 0xe7, 0xc7, 0x33,
+	0x81, 0x5a, 0x2b, 0x73, 0x81, 0x15, 0x43, 0xfa, 0x7b, 0x00, 0x49, 0x68, 0x67, 0xb3, 0xac, 0x2a,
+	0x4b, 0x1d, 0x19, 0xda, 0x89, 0x0f, 0x0e, 0xc4, 0x71, 0x9e, 0x4a, 0xf3, 0x78, 0x4c, 0x57, 0x65,
+	0xb5, 0x79, 0x14, 0xb6, 0x94, 0x34, 0x8f, 0xc0, 0xa4, 0x79, 0x3c, 0x1b, 0xac, 0x34, 0x4f, 0xe6,
+	0x02, 0x2b, 0x86, 0xe2, 0xe6, 0xf1, 0x3c, 0xd5, 0xcb, 0xcd, 0x93, 0x59, 0x2a, 0xb6, 0x04, 0x70,
+	0xd8, 0xd2, 0x11, 0xa8, 0xcd, 0x9a, 0x3a, 0x6c, 0x63, 0x35, 0x02, 0x15, 0x87, 0x2d, 0x15, 0x92,
+	0x8a, 0xb9, 0xc3, 0x63, 0xff, 0x4c, 0x59, 0xde, 0x75, 0x35, 0xf7, 0xe8, 0xd8, 0x3f, 0x53, 0xd7,
+	0x77, 0x3d, 0x54, 0x11, 0xd8, 0x5a, 0xfe, 0x89, 0x14, 0xe3, 0xd9, 0x50, 0x5b, 0x4b, 0x5f, 0x88,
+	0xd1, 0x74, 0xd8, 0x5a, 0x4b, 0x02, 0xd8, 0x29, 0x89, 0x05, 0x17, 0x36, 0x37, 0xd4, 0x4e, 0xe9,
+	0x4b, 0x43, 0x0e, 0x6b, 0x82, 0xd8, 0xac, 0x0b, 0x71, 0x6e, 0x2d, 0x3d, 0x35, 0x9b, 0xa6, 0xce,
+	0xad, 0x43, 0x2f, 0x95, 0xb1, 0xc6, 0x59, 0x45, 0xd6, 0x64, 0x55, 0x84, 0xce, 0x57, 0x4b, 0xc7,
+	0x9b, 0x3a, 0xcd, 0xcd, 0xd5, 0x55, 0x31, 0x12, 0xb4, 0x64, 0x55, 0x48, 0x4c, 0x3c, 0xaf, 0xe3,
+	0xec, 0xfa, 0xe5, 0x79, 0xad, 0x64, 0xae, 0xd9, 0x0a, 0x9c, 0x2c, 0xa8, 0x38, 0xef, 0xf5, 0x95,
+	0x05, 0xa5, 0x64, 0xae, 0x5b, 0x2a, 0x02, 0x73, 0xcb, 0x55, 0xc1, 0xa3, 0x28, 0x9b, 0x5b, 0x6a,
+	0x6e, 0xb1, 0x2e, 0x38, 0x09, 0x73, 0x4f, 0x55, 0x04, 0x4e, 0x5a, 0x3e, 0xcd, 0x45, 0xde, 0x1b,
+	0xa9, 0x4d, 0x0a, 0xe7, 0x76, 0x9c, 0xb3, 0x6a, 0x27, 0xa0, 0xf1, 0xd7, 0x0a, 0x50, 0x12, 0xd2,
+	0x07, 0x2f, 0x6c, 0xb7, 0x59, 0x17, 0xbd, 0x29, 0x9d, 0xd6, 0xb8, 0xb5, 0xdb, 0x1a, 0xa1, 0x52,
+	0xa1, 0x43, 0xa3, 0x85, 0x5e, 0xa6, 0x04, 0x97, 0x41, 0x91, 0x8a, 0x51, 0xc2, 0x09, 0x2a, 0x8b,
+	0xd7, 0xbf, 0x45, 0x5e, 0x7e, 0x55, 0x3c, 0x87, 0xce, 0x0e, 0x9e, 0x91, 0x23, 0x28, 0xf2, 0x86,
+	0x72, 0x71, 0xb8, 0xa0, 0x64, 0xe9, 0x0d, 0x3a, 0xdd, 0xcf, 0xb5, 0x62, 0x92, 0x85, 0x23, 0x4a,
+	0x71, 0x16, 0x0e, 0x97, 0xb1, 0x31, 0x32, 0x28, 0x59, 0x14, 0x53, 0xc1, 0x4c, 0xa2, 0x98, 0x67,
+	0xbd, 0xee, 0x73, 0x0d, 0x30, 0x13, 0x2f, 0x85, 0xe0, 0x2a, 0xaa, 0x45, 0x54, 0x08, 0x81, 0x35,
+	0xfd, 0x16, 0x5c, 0x1f, 0x3d, 0x19, 0x3e, 0x37, 0x79, 0xa6, 0xf8, 0x13, 0xea, 0xe8, 0x52, 0x52,
+	0x08, 0xbc, 0xf8, 0x06, 0x56, 0x49, 0x58, 0xc9, 0x38, 0xd2, 0x36, 0xc8, 0x29, 0x88, 0xb8, 0x31,
+	0xdf, 0x50, 0x34, 0xfc, 0x14, 0x9e, 0x75, 0xd8, 0x3f, 0xdc, 0x1f, 0x8c, 0xb4, 0x4d, 0x6c, 0x04,
+	0x61, 0x78, 0xcb, 0xf5, 0xb8, 0x98, 0x64, 0x1b, 0xba, 0x4e, 0x3b, 0x13, 0xe2, 0x9e, 0xb7, 0xd8,
+	0xa0, 0x37, 0xd8, 0x1b, 0x69, 0x5b, 0x71, 0xc9, 0x5d, 0xc6, 0x86, 0x6c, 0xa4, 0xdd, 0x88, 0x11,
+	0xa3, 0x71, 0x6b, 0x7c, 0x38, 0xd2, 0x6e, 0xc6, 0xad, 0x3c, 0x60, 0xc3, 0x76, 0x77, 0x34, 0xea,
+	0xf7, 0x46, 0x63, 0xed, 0x16, 0x3a, 0x22, 0x93, 0x16, 0x49, 0xe6, 0xa6, 0xd2, 0x50, 0xb6, 0xd7,
+	0x1d, 0x6b, 0xb7, 0xe3, 0x66, 0xb4, 0x87, 0x7d, 0xbc, 0xc5, 0x3f, 0x1c, 0x68, 0x77, 0x90, 0x89,
+	0x7c, 0x72, 0xe2, 0x6b, 0x5e, 0xc1, 0x76, 0x1d, 0x0e, 0x54, 0xd4, 0x5d, 0x65, 0x6a, 0x8c, 0xba,
+	0xbf, 0x38, 0xec, 0x0e, 0xda, 0x5d, 0xed, 0xd5, 0x64, 0x6a, 0xc4, 0xb8, 0x7b, 0xf1, 0xd4, 0x88,
+	0x51, 0xaf, 0xc5, 0x75, 0x4a, 0xd4, 0x48, 0xdb, 0x46, 0x9c, 0x9c, 0x2e, 0xac, 0xb7, 0xb7, 0xd7,
+	0x65, 0xda, 0xeb, 0xd8, 0x89, 0x7c, 0x7e, 0x08, 0x8c, 0xb1, 0x5b, 0xa3, 0xc7, 0x63, 0xc4, 0x26,
+	0x69, 0xfc, 0x1c, 0x74, 0xf5, 0x71, 0x06, 0x71, 0x07, 0x55, 0x87, 0xfc, 0x2c, 0xf0, 0x4f, 0x65,
+	0xe4, 0x27, 0xa6, 0x31, 0x74, 0x6f, 0xb1, 0x9c, 0x90, 0xd7, 0x3e, 0x89, 0x3b, 0x53, 0x51, 0xc6,
+	0x5f, 0xcf, 0x40, 0x23, 0xbd, 0x41, 0xa2, 0xda, 0xe6, 0xce, 0x4c, 0xba, 0x82, 0x86, 0xb7, 0x22,
+	0x43, 0x69, 0x72, 0xbb, 0xb3, 0x81, 0x1f, 0xd1, 0x45, 0x49, 0x32, 0xc6, 0xe2, 0xfd, 0x8e, 0x97,
+	0x1a, 0xc3, 0x7a, 0x0f, 0xae, 0xa7, 0xde, 0xae, 0x48, 0xdd, 0x52, 0x6d, 0xc6, 0x17, 0xf3, 0x2f,
+	0xb5, 0x9f, 0xe9, 0xe1, 0x0a, 0xce, 0x78, 0x02, 0xf5, 0xd4, 0xee, 0x4b, 0xee, 0x90, 0x59, 0xba,
+	0x5d, 0x65, 0x77, 0xf6, 0xf2, 0x46, 0x19, 0xc7, 0x50, 0x53, 0xb7, 0xe2, 0xef, 0x5d, 0x10, 0x45,
+	0x75, 0x88, 0x34, 0xfa, 0x1c, 0xc5, 0x65, 0x48, 0x89, 0xea, 0xd9, 0xc6, 0x6b, 0x50, 0x79, 0x7c,
+	0x22, 0x6f, 0xd5, 0xaa, 0x17, 0x7b, 0x2b, 0x22, 0x74, 0xf0, 0xbf, 0x64, 0xa1, 0xaa, 0x6c, 0xee,
+	0xdf, 0xaa, 0xbf, 0xef, 0xe2, 0x6b, 0x1d, 0x32, 0x78, 0x59, 0x04, 0x73, 0xc5, 0x88, 0x54, 0x7b,
+	0x73, 0x97, 0xda, 0xfb, 0x9d, 0x42, 0x56, 0xde, 0x87, 0x9a, 0x72, 0x97, 0x36, 0x14, 0x87, 0xe9,
+	0x97, 0xf9, 0xab, 0xc9, 0xbd, 0xda, 0x10, 0xaf, 0x31, 0xcc, 0x4e, 0x4c, 0x7b, 0x22, 0x83, 0x7c,
+	0x0a, 0xb3, 0x93, 0xce, 0x84, 0x1c, 0x7e, 0xb3, 0x78, 0xd7, 0xe2, 0x0e, 0x8c, 0xf2, 0xec, 0x24,
+	0xbe, 0x65, 0x52, 0x9a, 0x9d, 0xf0, 0xab, 0xa2, 0xe5, 0xed, 0x5c, 0xb2, 0x75, 0xc6, 0xfd, 0xc6,
+	0x8a, 0xb3, 0x13, 0xba, 0x36, 0xfa, 0x29, 0x68, 0x97, 0x7c, 0x22, 0x61, 0xb3, 0xb2, 0xb6, 0x51,
+	0x1b, 0x69, 0xf7, 0x48, 0x68, 0xfc, 0xf3, 0x8c, 0xb8, 0x79, 0xc3, 0x59, 0x02, 0x7f, 0x71, 0xf5,
+	0xcd, 0x9b, 0x98, 0x25, 0xb9, 0x79, 0xb3, 0xee, 0x1e, 0xc6, 0xba, 0xab, 0xc6, 0xb9, 0x75, 0x57,
+	0x8d, 0x0d, 0xc6, 0xaf, 0x93, 0x90, 0x59, 0x8c, 0x92, 0x90, 0xeb, 0xda, 0x5c, 0x06, 0x92, 0x33,
+	0x1b, 0xdd, 0xf4, 0x14, 0x94, 0x79, 0xc0, 0x7a, 0xfb, 0x2d, 0xf6, 0x05, 0xf9, 0xed, 0x69, 0xaf,
+	0x78, 0x3c, 0x64, 0xdd, 0xde, 0xde, 0x80, 0x10, 0x79, 0xcc, 0xd5, 0x7e, 0xd2, 0x6d, 0x3f, 0xd5,
+	0x0a, 0x64, 0x3f, 0x27, 0xad, 0x6d, 0xd9, 0xf6, 0xe3, 0x13, 0xf5, 0x81, 0x95, 0x4c, 0xea, 0x81,
+	0x95, 0xf8, 0xe2, 0x87, 0x7a, 0xc5, 0x3a, 0x92, 0xed, 0x8b, 0xe7, 0x65, 0x2e, 0x99, 0x97, 0x78,
+	0x49, 0x03, 0xef, 0x4b, 0xa4, 0x95, 0xdf, 0xf4, 0x85, 0x0a, 0x62, 0x30, 0x7e, 0x95, 0x01, 0x3d,
+	0xd5, 0x10, 0xae, 0x8f, 0x7d, 0xdf, 0xb6, 0x7c, 0x0c, 0x4d, 0x71, 0xe1, 0x9e, 0x73, 0x29, 0xbe,
+	0x2a, 0xd1, 0xbb, 0x37, 0x38, 0x9d, 0xaa, 0x4b, 0x6e, 0x8d, 0xe8, 0x8f, 0x80, 0xdf, 0x9e, 0xc6,
+	0x33, 0xea, 0xb4, 0x31, 0xaa, 0x2c, 0x2f, 0x96, 0xf0, 0x24, 0x37, 0xac, 0xd5, 0x6b, 0xe0, 0xdc,
+	0x79, 0xb7, 0x91, 0x0c, 0x20, 0x2d, 0x39, 0xe3, 0x8f, 0x32, 0x70, 0x3d, 0x3d, 0x37, 0x7e, 0xbd,
+	0xaf, 0x4c, 0xdf, 0x79, 0xcf, 0x5d, 0xbe, 0xf3, 0xbe, 0x6e, 0x6a, 0xe5, 0xd7, 0x4e, 0xad, 0x3f,
+	0xcc, 0xc0, 0x96, 0xd2, 0xfb, 0x89, 0x06, 0xfd, 0xbf, 0xa9, 0x65, 0xca, 0xd5, 0xf7, 0x7c, 0xea,
+	0xea, 0xbb, 0xf1, 0x21, 0x6c, 0x26, 0x0d, 0x69, 0x8b, 0x9b, 0x7e, 0xaf, 0x41, 0xd5, 0x73, 0xce,
+	0x4c, 0x79, 0x0f, 0x90, 0xb7, 0x04, 0x3c, 0xe7, 0x4c, 0x30, 0x18, 0x8f, 0xd5, 0x65, 0x19, 0xbf,
+	0x83, 0x34, 0xb7, 0xd5, 0x96, 0x97, 0xfc, 0xb9, 0x2d, 0x49, 0x58, 0x9a, 0xd2, 0xf0, 0x92, 0xe7,
+	0x9c, 0x51, 0x3f, 0x78, 0x50, 0xa5, 0x72, 0x5a, 0xb6, 0x8d, 0x8e, 0xf2, 0x75, 0x17, 0x1f, 0x6e,
+	0x43, 0x19, 0x0f, 0xca, 0xd5, 0xdc, 0x8b, 0x80, 0xd7, 0x79, 0x4f, 0x44, 0xd3, 0xae, 0x1e, 0x38,
+	0x10, 0x5e, 0xc6, 0x9c, 0xe7, 0x93, 0x77, 0xd0, 0x76, 0xa0, 0xc6, 0xf7, 0xa2, 0xc0, 0x5f, 0x60,
+	0x85, 0xf1, 0x71, 0x01, 0xde, 0x74, 0xc2, 0x24, 0x62, 0x42, 0xe7, 0x2b, 0x71, 0x7d, 0x12, 0x93,
+	0xc6, 0xff, 0xac, 0x00, 0x24, 0x1f, 0x9b, 0x92, 0xd3, 0x99, 0x6f, 0x92, 0xd3, 0x2f, 0x3b, 0x37,
+	0xf8, 0x10, 0xef, 0x5d, 0x2f, 0x2e, 0xcc, 0x24, 0x47, 0x6e, 0x6d, 0x8e, 0x1a, 0x72, 0x8d, 0x95,
+	0xb0, 0xda, 0x15, 0xd7, 0x75, 0x7e, 0xad, 0xeb, 0xfa, 0x7d, 0x28, 0x71, 0xa7, 0x9d, 0xdc, 0x02,
+	0x6e, 0x5d, 0x16, 0x96, 0x0f, 0xc5, 0x4d, 0x7b, 0xc9, 0xa7, 0x77, 0xa1, 0x11, 0x5f, 0xe2, 0x55,
+	0xa3, 0xab, 0xee, 0xad, 0xe6, 0x94, 0x6c, 0xfc, 0x30, 0xcd, 0x52, 0x41, 0xfd, 0x11, 0x6c, 0x49,
+	0xe5, 0xff, 0x54, 0x5e, 0x43, 0xc4, 0xbb, 0x4a, 0xfc, 0x5a, 0xe7, 0x26, 0xa7, 0x8d, 0x4f, 0xb9,
+	0x85, 0x8a, 0xd7, 0x94, 0x7e, 0x04, 0xd7, 0x45, 0x20, 0x04, 0x66, 0x90, 0x17, 0x07, 0xe5, 0x75,
+	0x40, 0x4e, 0x1a, 0x9f, 0x2e, 0xc4, 0xe5, 0x41, 0xec, 0x03, 0xd5, 0xe4, 0x26, 0x5e, 0x7e, 0x21,
+	0xb0, 0xa1, 0x58, 0xd8, 0xc8, 0xf9, 0x16, 0x6c, 0x88, 0x82, 0xe3, 0x42, 0xf9, 0x93, 0x0d, 0x75,
+	0x8e, 0x96, 0x25, 0x7e, 0x0e, 0x5b, 0xf2, 0x7a, 0xe1, 0x04, 0x03, 0xeb, 0xe6, 0x3d, 0xdb, 0xc4,
+	0x33, 0x12, 0x1e, 0x8a, 0xf5, 0xf6, 0xca, 0xe7, 0xb7, 0x89, 0x79, 0x3c, 0x99, 0xd3, 0xf9, 0x5e,
+	0x7c, 0x64, 0xb2, 0x39, 0xbd, 0x8c, 0xc7, 0x20, 0x01, 0x6e, 0x46, 0x25, 0x37, 0xc8, 0x6a, 0xaa,
+	0x09, 0x97, 0xbe, 0x34, 0xca, 0x1a, 0x56, 0x0a, 0xbe, 0xf3, 0x67, 0x39, 0x28, 0xf2, 0x51, 0xa2,
+	0xbb, 0x5c, 0x81, 0x2f, 0x9f, 0x87, 0xda, 0x5a, 0xb7, 0xf3, 0xd1, 0xcb, 0x8f, 0xb8, 0x49, 0x3e,
+	0x84, 0x22, 0x1e, 0x86, 0xcc, 0x4e, 0xd2, 0xae, 0xe7, 0x4b, 0x3b, 0x0f, 0xfa, 0x18, 0x2d, 0x4c,
+	0xe8, 0x1f, 0x43, 0x05, 0xf9, 0xb9, 0xdd, 0x9e, 0x52, 0xf2, 0x56, 0xf7, 0x08, 0xf4, 0x24, 0x5b,
+	0x22, 0xad, 0xff, 0x24, 0xed, 0x26, 0xe0, 0x02, 0xfc, 0xce, 0x4a, 0xd6, 0xab, 0x1c, 0x06, 0xbf,
+	0x03, 0xdc, 0x6e, 0x8c, 0x45, 0x4d, 0x41, 0xf5, 0x72, 0xae, 0x08, 0x26, 0x34, 0x52, 0x2d, 0x7e,
+	0xac, 0x4a, 0x30, 0x5e, 0xdd, 0xe2, 0xf9, 0xe3, 0xa7, 0xdb, 0xd6, 0xf4, 0x0c, 0xca, 0x8a, 0xd8,
+	0x8e, 0x47, 0x40, 0x7f, 0x17, 0x4a, 0xf8, 0xb9, 0x53, 0x9f, 0xcf, 0xc9, 0x24, 0x78, 0x2a, 0x91,
+	0x45, 0xe8, 0x65, 0xb7, 0x28, 0xa5, 0x3f, 0x82, 0x32, 0x59, 0xa3, 0x53, 0x9f, 0x4f, 0xc9, 0xd8,
+	0x7e, 0x56, 0x45, 0x09, 0xbd, 0x8c, 0xc9, 0x93, 0xfa, 0x8f, 0x78, 0x6f, 0xf2, 0xd7, 0x23, 0x52,
+	0x8f, 0xff, 0xc8, 0x8b, 0x88, 0xa2, 0x0f, 0x09, 0x4c, 0xbc, 0xeb, 0x77, 0x18, 0xdc, 0x5c, 0x3f,
+	0xb3, 0xd4, 0xb3, 0xb7, 0x3c, 0x3f, 0x7b, 0x33, 0xd2, 0x11, 0xeb, 0xe9, 0x8b, 0xa3, 0xca, 0x49,
+	0xdc, 0xcf, 0x50, 0xfd, 0x56, 0x57, 0x67, 0x15, 0x4a, 0xf2, 0x4d, 0x0c, 0x8a, 0x0c, 0x68, 0x0f,
+	0x0f, 0xd0, 0xc1, 0x5e, 0x85, 0x52, 0x6f, 0x30, 0x1a, 0xb7, 0x06, 0xe2, 0xec, 0xa4, 0x37, 0x10,
+	0x67, 0x27, 0xc6, 0x5f, 0xe0, 0x59, 0x5e, 0xec, 0x50, 0xfa, 0xde, 0x4a, 0x77, 0xfc, 0x8e, 0x6b,
+	0x4e, 0x7d, 0xc7, 0xf5, 0xd2, 0x76, 0xae, 0xde, 0x56, 0xde, 0x48, 0x6f, 0x9a, 0xe1, 0x6a, 0x24,
+	0x5a, 0xe1, 0x5b, 0x46, 0xa2, 0xa9, 0x01, 0x06, 0xc5, 0x74, 0x80, 0xc1, 0xa5, 0x77, 0x51, 0x4a,
+	0xdb, 0xb9, 0x4b, 0xef, 0xa2, 0x5c, 0x79, 0xa2, 0x57, 0xbe, 0xfa, 0x44, 0x8f, 0x9e, 0x9c, 0x45,
+	0x8f, 0x91, 0x38, 0x6d, 0x17, 0x50, 0x7a, 0x7f, 0x80, 0x97, 0x1c, 0x6d, 0x7f, 0x05, 0x95, 0xd8,
+	0x0d, 0xf5, 0xfd, 0x7b, 0xfd, 0xbb, 0x98, 0x0e, 0xc6, 0xef, 0x4b, 0x3b, 0x32, 0xf6, 0xe3, 0xfc,
+	0xba, 0x76, 0x64, 0xaa, 0xfa, 0xdc, 0x4b, 0xaa, 0x3f, 0xe7, 0xf6, 0x5d, 0x5c, 0xf9, 0x6f, 0x78,
+	0xaa, 0xa9, 0xb3, 0x20, 0x9f, 0x9a, 0x05, 0xc6, 0x86, 0xb0, 0x51, 0x65, 0xd5, 0xc6, 0x7f, 0xcb,
+	0x48, 0xfb, 0x2e, 0xbe, 0xd2, 0x7a, 0xe5, 0xae, 0x1f, 0xd7, 0x96, 0x55, 0x6b, 0xfb, 0x2e, 0x5f,
+	0xfe, 0x8d, 0xea, 0x73, 0xfe, 0x9b, 0xd4, 0xe7, 0xb7, 0xa1, 0xc0, 0x25, 0x6f, 0xe1, 0x2a, 0xd5,
+	0x99, 0xd3, 0x5f, 0xfa, 0x6e, 0x92, 0xf1, 0xaf, 0x33, 0x50, 0x4f, 0xb9, 0xe5, 0x7e, 0xed, 0xb1,
+	0xbf, 0x6a, 0xc1, 0x97, 0xa4, 0x5b, 0x2f, 0x9f, 0x7a, 0x99, 0x2f, 0xb9, 0x79, 0x2d, 0x19, 0xf8,
+	0x33, 0x5e, 0x18, 0x93, 0x2c, 0x73, 0x14, 0xe4, 0x33, 0x5e, 0xb6, 0x13, 0xc8, 0x66, 0xde, 0x21,
+	0x05, 0x71, 0xea, 0xd8, 0x74, 0x47, 0x85, 0x26, 0x89, 0x84, 0x0d, 0x43, 0xa8, 0x6e, 0x7c, 0x10,
+	0xb7, 0x64, 0x67, 0xc9, 0x87, 0xac, 0x10, 0x40, 0x93, 0xac, 0x92, 0x98, 0x06, 0xdf, 0x7d, 0xa0,
+	0x7f, 0x63, 0x46, 0xc1, 0xef, 0x42, 0x55, 0xf1, 0x6d, 0x7e, 0xff, 0xd9, 0xbf, 0xee, 0x89, 0xdd,
+	0x3f, 0xca, 0x42, 0x3d, 0xe5, 0x4c, 0xff, 0x1e, 0x1f, 0xba, 0x56, 0x54, 0xe7, 0xd6, 0x8b, 0xea,
+	0x2b, 0xa5, 0x66, 0xfe, 0x6a, 0xa9, 0xf9, 0x7f, 0x42, 0xbc, 0x1b, 0x7f, 0x25, 0x13, 0x3f, 0x91,
+	0xc4, 0x0b, 0x5b, 0xa7, 0x60, 0x67, 0xd6, 0x2a, 0xd8, 0xf7, 0xe2, 0x77, 0x53, 0x7b, 0x1d, 0x1e,
+	0x2d, 0x51, 0x67, 0x0a, 0x46, 0xff, 0x14, 0x6e, 0xf3, 0xb3, 0x4c, 0xae, 0x1c, 0x99, 0xfe, 0xcc,
+	0x94, 0x54, 0x5b, 0x84, 0xaf, 0xdc, 0xe4, 0x0c, 0xfc, 0xa1, 0xb1, 0x59, 0x4b, 0x52, 0x8d, 0x1e,
+	0xd4, 0x53, 0x87, 0x17, 0xca, 0x53, 0xcc, 0x19, 0xf5, 0x29, 0x66, 0x0c, 0xcb, 0x38, 0x3b, 0x76,
+	0x02, 0x67, 0xcd, 0x0d, 0x4d, 0x4e, 0xc0, 0x77, 0x1b, 0xd5, 0x63, 0x4e, 0xfd, 0x5d, 0x28, 0xb8,
+	0x91, 0x73, 0x2a, 0x2f, 0xc6, 0xde, 0x5c, 0x3d, 0x09, 0xa5, 0x77, 0x50, 0x38, 0x93, 0xf1, 0x27,
+	0xf8, 0x88, 0xec, 0x25, 0x9a, 0xf2, 0x5e, 0x74, 0xe6, 0x8a, 0xf7, 0xa2, 0xb3, 0xa9, 0x46, 0xae,
+	0x99, 0x90, 0xc9, 0x85, 0xbb, 0xfc, 0x15, 0x17, 0xee, 0xf4, 0xb7, 0xa0, 0x1c, 0x38, 0xf4, 0x46,
+	0xaf, 0xdd, 0x2c, 0xac, 0x30, 0xc5, 0x34, 0xe3, 0x2f, 0x67, 0xa0, 0x24, 0xce, 0x64, 0xd7, 0x1a,
+	0x90, 0xef, 0x40, 0x89, 0xbf, 0xd7, 0x2b, 0xdf, 0x7d, 0x59, 0x89, 0x2e, 0x92, 0x74, 0x34, 0x28,
+	0x91, 0x94, 0x36, 0x28, 0xf1, 0xa4, 0x9e, 0x11, 0x1e, 0x67, 0x13, 0x05, 0xb2, 0x90, 0x6d, 0x14,
+	0x8a, 0x6b, 0x2c, 0x40, 0x28, 0x54, 0xad, 0x42, 0xe3, 0x27, 0x50, 0x12, 0x67, 0xbe, 0x6b, 0x9b,
+	0xf2, 0xb2, 0x17, 0x6c, 0xb7, 0x01, 0x92, 0x43, 0xe0, 0x75, 0x25, 0x18, 0x73, 0xf1, 0x56, 0x00,
+	0x1e, 0x1a, 0x91, 0x3b, 0xe4, 0x11, 0xbe, 0x1d, 0x29, 0x9e, 0x52, 0xc8, 0x5c, 0xfd, 0x94, 0x42,
+	0xcc, 0xa4, 0x3f, 0x80, 0x78, 0xdb, 0x79, 0x99, 0x89, 0x6a, 0xb4, 0x64, 0x98, 0x26, 0xcd, 0x9c,
+	0x0f, 0x84, 0x0b, 0x02, 0x51, 0x72, 0xfa, 0x5c, 0xae, 0x0c, 0xdb, 0xc4, 0x14, 0x36, 0xa3, 0x01,
+	0x35, 0xf5, 0x88, 0xcb, 0xf8, 0xdb, 0x45, 0xd0, 0xf0, 0x25, 0x62, 0x94, 0x35, 0xa3, 0xa9, 0xe5,
+	0xd1, 0x47, 0x34, 0xe9, 0xaa, 0xf7, 0x40, 0xf1, 0x1d, 0x08, 0x10, 0x29, 0xbb, 0xd8, 0xf4, 0x9e,
+	0x2d, 0x1e, 0x3e, 0x90, 0x20, 0xae, 0x3e, 0x3e, 0x82, 0x83, 0x64, 0x6a, 0x29, 0x18, 0xa4, 0x93,
+	0xea, 0x4c, 0x91, 0x43, 0xc2, 0x44, 0x56, 0x30, 0x38, 0x59, 0x47, 0x7e, 0x10, 0x89, 0xc9, 0x55,
+	0x66, 0x02, 0x42, 0xb9, 0xd8, 0x0b, 0x9f, 0xf0, 0x97, 0x5a, 0xc4, 0x76, 0x22, 0x61, 0x6c, 0x0d,
+	0xb6, 0xbd, 0xef, 0xf3, 0xb7, 0x54, 0x6a, 0x4c, 0x82, 0x58, 0x5a, 0xc7, 0x99, 0x23, 0xa1, 0x4c,
+	0x04, 0x01, 0x61, 0x69, 0x3c, 0x38, 0x65, 0x1c, 0x92, 0x2e, 0x58, 0x63, 0x31, 0x4c, 0x34, 0xbe,
+	0xe1, 0x86, 0x4d, 0x10, 0x34, 0x01, 0x23, 0x8d, 0x87, 0xcf, 0x8d, 0xf9, 0xf3, 0x81, 0x35, 0x16,
+	0xc3, 0x28, 0x9d, 0x47, 0xce, 0x51, 0xcf, 0x26, 0x43, 0xb2, 0xc6, 0x38, 0x80, 0x2d, 0x60, 0xfe,
+	0x59, 0xdb, 0x8b, 0xc4, 0x85, 0x32, 0x01, 0x61, 0x9b, 0xf1, 0x51, 0x53, 0x24, 0xf0, 0xbb, 0x64,
+	0x12, 0xc4, 0xc7, 0xc1, 0xe4, 0xa3, 0xa9, 0x78, 0x31, 0x4c, 0xdc, 0x24, 0x4b, 0xe1, 0xa8, 0x97,
+	0xf9, 0x9b, 0x99, 0xc9, 0x5d, 0x32, 0x05, 0x83, 0x76, 0x09, 0x3e, 0xaf, 0xb0, 0x49, 0x2d, 0xc1,
+	0x24, 0x61, 0xac, 0xf3, 0xa6, 0x2e, 0x30, 0x16, 0xb9, 0x54, 0x46, 0xcb, 0x53, 0x3a, 0x3e, 0xac,
+	0x31, 0x4c, 0x1a, 0x7f, 0x92, 0x85, 0xad, 0xcb, 0x93, 0x80, 0x26, 0x67, 0x0d, 0xca, 0xed, 0x61,
+	0xdf, 0x1c, 0xb4, 0xf6, 0xc5, 0xcb, 0xcd, 0xbb, 0x74, 0x72, 0xd3, 0xeb, 0xf0, 0x4b, 0xca, 0xc3,
+	0x5d, 0x0c, 0x55, 0xe7, 0x64, 0x72, 0xbc, 0x76, 0x07, 0x63, 0xf6, 0x05, 0x9d, 0x10, 0x89, 0x20,
+	0x2f, 0x0c, 0x11, 0xef, 0x76, 0xb4, 0x3c, 0x85, 0x63, 0x8f, 0xcc, 0x27, 0xbd, 0x4e, 0xa7, 0x8b,
+	0x91, 0xef, 0x18, 0xc4, 0xde, 0x1d, 0xb7, 0xcc, 0xfe, 0xb0, 0xad, 0x15, 0x91, 0xd8, 0xe9, 0xf6,
+	0x05, 0x58, 0x42, 0x90, 0x07, 0x3e, 0x99, 0xe3, 0x91, 0x56, 0x26, 0x50, 0x1c, 0xe7, 0x8c, 0xb4,
+	0x8a, 0x60, 0xee, 0x72, 0x10, 0xa8, 0x92, 0xee, 0x1e, 0x36, 0xa9, 0xca, 0xa3, 0xa4, 0x9e, 0x8f,
+	0xcc, 0xf6, 0x60, 0xac, 0xd5, 0x10, 0xc2, 0xcb, 0xf8, 0x04, 0xd5, 0xf1, 0xec, 0xa8, 0x3d, 0xdc,
+	0x3f, 0x60, 0xdd, 0xd1, 0xc8, 0x1c, 0xe1, 0x3b, 0x43, 0x0d, 0xfa, 0x02, 0xd6, 0xdb, 0xeb, 0x0d,
+	0x38, 0x62, 0x03, 0x7d, 0xc8, 0xfb, 0xbd, 0x81, 0xa6, 0x51, 0xa2, 0xf5, 0xb9, 0xb6, 0x89, 0x89,
+	0xd1, 0xe1, 0xbe, 0xa6, 0x3f, 0x78, 0x3d, 0x19, 0x1c, 0x79, 0xbb, 0x7c, 0xe0, 0x7b, 0x0e, 0x7f,
+	0x17, 0xa0, 0xff, 0xcb, 0x0f, 0xb5, 0xcc, 0x83, 0xdf, 0x57, 0x9e, 0x0b, 0x23, 0x1e, 0xe1, 0x92,
+	0xa6, 0x0b, 0x04, 0xfd, 0xde, 0xa0, 0xdb, 0x62, 0xe4, 0x80, 0xa6, 0x17, 0x04, 0x9e, 0xb4, 0x46,
+	0x4f, 0x78, 0x9f, 0x09, 0x0a, 0x21, 0x72, 0xc9, 0x5d, 0x75, 0xba, 0x30, 0x40, 0xc9, 0xf8, 0xe0,
+	0xaf, 0x80, 0x19, 0xe9, 0x4c, 0xae, 0x88, 0xe7, 0x59, 0x98, 0x8a, 0x69, 0xa5, 0x07, 0x06, 0x54,
+	0x95, 0x37, 0x39, 0xa8, 0x0e, 0x2b, 0x3c, 0x16, 0xd7, 0xdf, 0xd1, 0x88, 0xd5, 0x32, 0x0f, 0x7e,
+	0x0c, 0x75, 0xc1, 0xc3, 0x5f, 0xc4, 0xa0, 0x77, 0xe0, 0xfd, 0xe0, 0xd4, 0x9a, 0x0b, 0x3e, 0x67,
+	0x19, 0x3a, 0x5a, 0x06, 0xfb, 0x98, 0x39, 0xe2, 0xed, 0x0c, 0x2d, 0xfb, 0xe0, 0x3d, 0xb8, 0xb1,
+	0xf6, 0xb9, 0x0f, 0xea, 0x7c, 0x17, 0x63, 0xa9, 0xc4, 0xd3, 0x8f, 0x14, 0x57, 0x75, 0xae, 0x65,
+	0x1e, 0xfc, 0x0c, 0x9a, 0x57, 0x85, 0x5f, 0x71, 0xf7, 0x7b, 0x8b, 0x42, 0xdc, 0x70, 0x88, 0x86,
+	0x26, 0x87, 0x32, 0x3c, 0x42, 0xb0, 0xdf, 0xa5, 0x33, 0xdf, 0x07, 0x5f, 0x67, 0x14, 0xd1, 0x2a,
+	0x63, 0x6d, 0x62, 0x84, 0xe8, 0x7b, 0x15, 0xc5, 0x1c, 0xcb, 0xd6, 0x32, 0xfa, 0x4d, 0xd0, 0x53,
+	0xa8, 0xbe, 0x3f, 0xb5, 0xe6, 0x5a, 0x96, 0x4e, 0x77, 0x25, 0xfe, 0x79, 0xe0, 0x46, 0x8e, 0x96,
+	0xd3, 0x5f, 0x85, 0xdb, 0x31, 0xae, 0xef, 0x9f, 0x1d, 0x04, 0x2e, 0xda, 0xe5, 0x17, 0x9c, 0x9c,
+	0xdf, 0xfd, 0xe9, 0x3f, 0xf9, 0xd5, 0xbd, 0xcc, 0xbf, 0xfc, 0xd5, 0xbd, 0xcc, 0x7f, 0xfc, 0xd5,
+	0xbd, 0x6b, 0x7f, 0xf2, 0x9f, 0xef, 0x65, 0x7e, 0x57, 0xfd, 0xbb, 0x96, 0x53, 0x2b, 0x0a, 0xdc,
+	0x73, 0x6e, 0x06, 0x48, 0xc0, 0x73, 0x1e, 0x2d, 0x4e, 0x8e, 0x1e, 0x2d, 0x26, 0x8f, 0x50, 0x0c,
+	0x4f, 0x8a, 0xf4, 0xaf, 0x2d, 0x1f, 0xfc, 0xaf, 0x01, 0x00, 0x31, 0xb1, 0xaa, 0x92, 0xf8, 0x65,
+	0x00, 0x00,
 }
 
 func (m *Type) Marshal() (dAtA []byte, err error) {
@@ -12801,6 +13406,27 @@ func (m *IndexDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.IndexAlgoTableType) > 0 {
+		i -= len(m.IndexAlgoTableType)
+		copy(dAtA[i:], m.IndexAlgoTableType)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexAlgoTableType)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.IndexAlgoParams) > 0 {
+		i -= len(m.IndexAlgoParams)
+		copy(dAtA[i:], m.IndexAlgoParams)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexAlgoParams)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.IndexAlgo) > 0 {
+		i -= len(m.IndexAlgo)
+		copy(dAtA[i:], m.IndexAlgo)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.IndexAlgo)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Option != nil {
 		{
 			size, err := m.Option.MarshalToSizedBuffer(dAtA[:i])
@@ -12992,6 +13618,23 @@ func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.NotEnforced {
+		i--
+		if m.NotEnforced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExprStr) > 0 {
+		i -= len(m.ExprStr)
+		copy(dAtA[i:], m.ExprStr)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExprStr)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Check != nil {
 		{
 			size, err := m.Check.MarshalToSizedBuffer(dAtA[:i])
@@ -13014,6 +13657,61 @@ func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TriggerDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Timing) > 0 {
+		i -= len(m.Timing)
+		copy(dAtA[i:], m.Timing)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Timing)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterByDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -13485,6 +14183,163 @@ func (m *PartitionItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PartitionPrune) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionPrune) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionPrune) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SelectedPartitions) > 0 {
+		for iNdEx := len(m.SelectedPartitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SelectedPartitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.IsPruned {
+		i--
+		if m.IsPruned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AlterPartition) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AlterPartition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterPartition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExchangeTable) > 0 {
+		i -= len(m.ExchangeTable)
+		copy(dAtA[i:], m.ExchangeTable)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExchangeTable)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ExchangeDatabase) > 0 {
+		i -= len(m.ExchangeDatabase)
+		copy(dAtA[i:], m.ExchangeDatabase)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ExchangeDatabase)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MoveDataSqls) > 0 {
+		for iNdEx := len(m.MoveDataSqls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MoveDataSqls[iNdEx])
+			copy(dAtA[i:], m.MoveDataSqls[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.MoveDataSqls[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ValidateSql) > 0 {
+		i -= len(m.ValidateSql)
+		copy(dAtA[i:], m.ValidateSql)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.ValidateSql)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TruncateTableNames) > 0 {
+		for iNdEx := len(m.TruncateTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TruncateTableNames[iNdEx])
+			copy(dAtA[i:], m.TruncateTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.TruncateTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DropTableNames) > 0 {
+		for iNdEx := len(m.DropTableNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DropTableNames[iNdEx])
+			copy(dAtA[i:], m.DropTableNames[iNdEx])
+			i = encodeVarintPlan(dAtA, i, uint64(len(m.DropTableNames[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CreateTables) > 0 {
+		for iNdEx := len(m.CreateTables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateTables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PartitionDef != nil {
+		{
+			size, err := m.PartitionDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Typ != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.Typ))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ViewDef) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -13674,6 +14529,22 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xaa
 	}
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPlan(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.Checks) > 0 {
 		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -13689,20 +14560,20 @@ func (m *TableDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.RefChildTbls) > 0 {
-		dAtA46 := make([]byte, len(m.RefChildTbls)*10)
-		var j45 int
+		dAtA47 := make([]byte, len(m.RefChildTbls)*10)
+		var j46 int
 		for _, num := range m.RefChildTbls {
 			for num >= 1<<7 {
-				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j45++
+				j46++
 			}
-			dAtA46[j45] = uint8(num)
-			j45++
+			dAtA47[j46] = uint8(num)
+			j46++
 		}
-		i -= j45
-		copy(dAtA[i:], dAtA46[:j45])
-		i = encodeVarintPlan(dAtA, i, uint64(j45))
+		i -= j46
+		copy(dAtA[i:], dAtA47[:j46])
+		i = encodeVarintPlan(dAtA, i, uint64(j46))
 		i--
 		dAtA[i] = 0x72
 	}
@@ -14500,21 +15371,21 @@ func (m *OnDuplicateKeyCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.OnDuplicateIdx) > 0 {
-		dAtA60 := make([]byte, len(m.OnDuplicateIdx)*10)
-		var j59 int
+		dAtA61 := make([]byte, len(m.OnDuplicateIdx)*10)
+		var j60 int
 		for _, num1 := range m.OnDuplicateIdx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA60[j59] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA61[j60] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j59++
+				j60++
 			}
-			dAtA60[j59] = uint8(num)
-			j59++
+			dAtA61[j60] = uint8(num)
+			j60++
 		}
-		i -= j59
-		copy(dAtA[i:], dAtA60[:j59])
-		i = encodeVarintPlan(dAtA, i, uint64(j59))
+		i -= j60
+		copy(dAtA[i:], dAtA61[:j60])
+		i = encodeVarintPlan(dAtA, i, uint64(j60))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -14572,20 +15443,20 @@ func (m *InsertCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA63 := make([]byte, len(m.PartitionTableIds)*10)
-		var j62 int
+		dAtA64 := make([]byte, len(m.PartitionTableIds)*10)
+		var j63 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA63[j62] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA64[j63] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j62++
+				j63++
 			}
-			dAtA63[j62] = uint8(num)
-			j62++
+			dAtA64[j63] = uint8(num)
+			j63++
 		}
-		i -= j62
-		copy(dAtA[i:], dAtA63[:j62])
-		i = encodeVarintPlan(dAtA, i, uint64(j62))
+		i -= j63
+		copy(dAtA[i:], dAtA64[:j63])
+		i = encodeVarintPlan(dAtA, i, uint64(j63))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -14675,20 +15546,20 @@ func (m *ReplaceCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA67 := make([]byte, len(m.PartitionTableIds)*10)
-		var j66 int
+		dAtA68 := make([]byte, len(m.PartitionTableIds)*10)
+		var j67 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA67[j66] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA68[j67] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j66++
+				j67++
 			}
-			dAtA67[j66] = uint8(num)
-			j66++
+			dAtA68[j67] = uint8(num)
+			j67++
 		}
-		i -= j66
-		copy(dAtA[i:], dAtA67[:j66])
-		i = encodeVarintPlan(dAtA, i, uint64(j66))
+		i -= j67
+		copy(dAtA[i:], dAtA68[:j67])
+		i = encodeVarintPlan(dAtA, i, uint64(j67))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -14770,6 +15641,18 @@ func (m *AnalyzeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.SpillSize != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.SpillSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.SpillCount != 0 {
+		i = encodeVarintPlan(dAtA, i, uint64(m.SpillCount))
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.InsertTime != 0 {
 		i = encodeVarintPlan(dAtA, i, uint64(m.InsertTime))
 		i--
@@ -14867,6 +15750,20 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.PartitionPrune != nil {
+		{
+			size, err := m.PartitionPrune.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xda
+	}
 	if len(m.Uuid) > 0 {
 		i -= len(m.Uuid)
 		copy(dAtA[i:], m.Uuid)
@@ -15009,21 +15906,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x8a
 	}
 	if len(m.SourceStep) > 0 {
-		dAtA75 := make([]byte, len(m.SourceStep)*10)
-		var j74 int
+		dAtA77 := make([]byte, len(m.SourceStep)*10)
+		var j76 int
 		for _, num1 := range m.SourceStep {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA75[j74] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA77[j76] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j74++
+				j76++
 			}
-			dAtA75[j74] = uint8(num)
-			j74++
+			dAtA77[j76] = uint8(num)
+			j76++
 		}
-		i -= j74
-		copy(dAtA[i:], dAtA75[:j74])
-		i = encodeVarintPlan(dAtA, i, uint64(j74))
+		i -= j76
+		copy(dAtA[i:], dAtA77[:j76])
+		i = encodeVarintPlan(dAtA, i, uint64(j76))
 		i--
 		dAtA[i] = 0x2
 		i--
@@ -15114,21 +16011,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0xca
 	}
 	if len(m.BindingTags) > 0 {
-		dAtA81 := make([]byte, len(m.BindingTags)*10)
-		var j80 int
+		dAtA83 := make([]byte, len(m.BindingTags)*10)
+		var j82 int
 		for _, num1 := range m.BindingTags {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA81[j80] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA83[j82] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j80++
+				j82++
 			}
-			dAtA81[j80] = uint8(num)
-			j80++
+			dAtA83[j82] = uint8(num)
+			j82++
 		}
-		i -= j80
-		copy(dAtA[i:], dAtA81[:j80])
-		i = encodeVarintPlan(dAtA, i, uint64(j80))
+		i -= j82
+		copy(dAtA[i:], dAtA83[:j82])
+		i = encodeVarintPlan(dAtA, i, uint64(j82))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -15369,21 +16266,21 @@ func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Children) > 0 {
-		dAtA89 := make([]byte, len(m.Children)*10)
-		var j88 int
+		dAtA91 := make([]byte, len(m.Children)*10)
+		var j90 int
 		for _, num1 := range m.Children {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA89[j88] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA91[j90] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j88++
+				j90++
 			}
-			dAtA89[j88] = uint8(num)
-			j88++
+			dAtA91[j90] = uint8(num)
+			j90++
 		}
-		i -= j88
-		copy(dAtA[i:], dAtA89[:j88])
-		i = encodeVarintPlan(dAtA, i, uint64(j88))
+		i -= j90
+		copy(dAtA[i:], dAtA91[:j90])
+		i = encodeVarintPlan(dAtA, i, uint64(j90))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -15447,20 +16344,20 @@ func (m *LockTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA92 := make([]byte, len(m.PartitionTableIds)*10)
-		var j91 int
+		dAtA94 := make([]byte, len(m.PartitionTableIds)*10)
+		var j93 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA94[j93] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j91++
+				j93++
 			}
-			dAtA92[j91] = uint8(num)
-			j91++
+			dAtA94[j93] = uint8(num)
+			j93++
 		}
-		i -= j91
-		copy(dAtA[i:], dAtA92[:j91])
-		i = encodeVarintPlan(dAtA, i, uint64(j91))
+		i -= j93
+		copy(dAtA[i:], dAtA94[:j93])
+		i = encodeVarintPlan(dAtA, i, uint64(j93))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -15585,21 +16482,21 @@ func (m *PreInsertUkCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x10
 	}
 	if len(m.Columns) > 0 {
-		dAtA98 := make([]byte, len(m.Columns)*10)
-		var j97 int
+		dAtA100 := make([]byte, len(m.Columns)*10)
+		var j99 int
 		for _, num1 := range m.Columns {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA98[j97] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA100[j99] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j97++
+				j99++
 			}
-			dAtA98[j97] = uint8(num)
-			j97++
+			dAtA100[j99] = uint8(num)
+			j99++
 		}
-		i -= j97
-		copy(dAtA[i:], dAtA98[:j97])
-		i = encodeVarintPlan(dAtA, i, uint64(j97))
+		i -= j99
+		copy(dAtA[i:], dAtA100[:j99])
+		i = encodeVarintPlan(dAtA, i, uint64(j99))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -15631,21 +16528,21 @@ func (m *PreDeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Idx) > 0 {
-		dAtA100 := make([]byte, len(m.Idx)*10)
-		var j99 int
+		dAtA102 := make([]byte, len(m.Idx)*10)
+		var j101 int
 		for _, num1 := range m.Idx {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA100[j99] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA102[j101] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j99++
+				j101++
 			}
-			dAtA100[j99] = uint8(num)
-			j99++
+			dAtA102[j101] = uint8(num)
+			j101++
 		}
-		i -= j99
-		copy(dAtA[i:], dAtA100[:j99])
-		i = encodeVarintPlan(dAtA, i, uint64(j99))
+		i -= j101
+		copy(dAtA[i:], dAtA102[:j101])
+		i = encodeVarintPlan(dAtA, i, uint64(j101))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -15792,21 +16689,21 @@ func (m *IdList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.List) > 0 {
-		dAtA105 := make([]byte, len(m.List)*10)
-		var j104 int
+		dAtA107 := make([]byte, len(m.List)*10)
+		var j106 int
 		for _, num1 := range m.List {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA105[j104] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA107[j106] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j104++
+				j106++
 			}
-			dAtA105[j104] = uint8(num)
-			j104++
+			dAtA107[j106] = uint8(num)
+			j106++
 		}
-		i -= j104
-		copy(dAtA[i:], dAtA105[:j104])
-		i = encodeVarintPlan(dAtA, i, uint64(j104))
+		i -= j106
+		copy(dAtA[i:], dAtA107[:j106])
+		i = encodeVarintPlan(dAtA, i, uint64(j106))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -15901,20 +16798,20 @@ func (m *DeleteCtx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.PartitionTableIds) > 0 {
-		dAtA107 := make([]byte, len(m.PartitionTableIds)*10)
-		var j106 int
+		dAtA109 := make([]byte, len(m.PartitionTableIds)*10)
+		var j108 int
 		for _, num := range m.PartitionTableIds {
 			for num >= 1<<7 {
-				dAtA107[j106] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA109[j108] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j106++
+				j108++
 			}
-			dAtA107[j106] = uint8(num)
-			j106++
+			dAtA109[j108] = uint8(num)
+			j108++
 		}
-		i -= j106
-		copy(dAtA[i:], dAtA107[:j106])
-		i = encodeVarintPlan(dAtA, i, uint64(j106))
+		i -= j108
+		copy(dAtA[i:], dAtA109[:j108])
+		i = encodeVarintPlan(dAtA, i, uint64(j108))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -16040,21 +16937,21 @@ func (m *Query) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Steps) > 0 {
-		dAtA110 := make([]byte, len(m.Steps)*10)
-		var j109 int
+		dAtA112 := make([]byte, len(m.Steps)*10)
+		var j111 int
 		for _, num1 := range m.Steps {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA110[j109] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA112[j111] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j109++
+				j111++
 			}
-			dAtA110[j109] = uint8(num)
-			j109++
+			dAtA112[j111] = uint8(num)
+			j111++
 		}
-		i -= j109
-		copy(dAtA[i:], dAtA110[:j109])
-		i = encodeVarintPlan(dAtA, i, uint64(j109))
+		i -= j111
+		copy(dAtA[i:], dAtA112[:j111])
+		i = encodeVarintPlan(dAtA, i, uint64(j111))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -16985,6 +17882,52 @@ func (m *DataDefinition_AlterSequence) MarshalToSizedBuffer(dAtA []byte) (int, e
 	}
 	return len(dAtA) - i, nil
 }
+func (m *DataDefinition_CreateTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataDefinition_CreateTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CreateTrigger != nil {
+		{
+			size, err := m.CreateTrigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DataDefinition_DropTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataDefinition_DropTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DropTrigger != nil {
+		{
+			size, err := m.DropTrigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
 func (m *SubscriptionOption) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -17822,6 +18765,18 @@ func (m *AlterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AlterPartition != nil {
+		{
+			size, err := m.AlterPartition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.ChangeTblColIdMap) > 0 {
 		for k := range m.ChangeTblColIdMap {
 			v := m.ChangeTblColIdMap[k]
@@ -18141,6 +19096,27 @@ func (m *AlterTable_Action_DropCol) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	}
 	return len(dAtA) - i, nil
 }
+func (m *AlterTable_Action_AddCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AlterTable_Action_AddCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.AddCheck != nil {
+		{
+			size, err := m.AddCheck.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *DropTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -18197,20 +19173,20 @@ func (m *DropTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA158 := make([]byte, len(m.ForeignTbl)*10)
-		var j157 int
+		dAtA164 := make([]byte, len(m.ForeignTbl)*10)
+		var j163 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA158[j157] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA164[j163] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j157++
+				j163++
 			}
-			dAtA158[j157] = uint8(num)
-			j157++
+			dAtA164[j163] = uint8(num)
+			j163++
 		}
-		i -= j157
-		copy(dAtA[i:], dAtA158[:j157])
-		i = encodeVarintPlan(dAtA, i, uint64(j157))
+		i -= j163
+		copy(dAtA[i:], dAtA164[:j163])
+		i = encodeVarintPlan(dAtA, i, uint64(j163))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -18544,6 +19520,86 @@ func (m *CreateIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CreateTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Precedes {
+		i--
+		if m.Precedes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OrderTrigger) > 0 {
+		i -= len(m.OrderTrigger)
+		copy(dAtA[i:], m.OrderTrigger)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.OrderTrigger)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPlan(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IfNotExists {
+		i--
+		if m.IfNotExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AlterIndex) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -18633,6 +19689,57 @@ func (m *DropIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DropTrigger) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DropTrigger) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DropTrigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Database) > 0 {
+		i -= len(m.Database)
+		copy(dAtA[i:], m.Database)
+		i = encodeVarintPlan(dAtA, i, uint64(len(m.Database)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IfExists {
+		i--
+		if m.IfExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TruncateTable) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -18658,20 +19765,20 @@ func (m *TruncateTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ForeignTbl) > 0 {
-		dAtA165 := make([]byte, len(m.ForeignTbl)*10)
-		var j164 int
+		dAtA172 := make([]byte, len(m.ForeignTbl)*10)
+		var j171 int
 		for _, num := range m.ForeignTbl {
 			for num >= 1<<7 {
-				dAtA165[j164] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA172[j171] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j164++
+				j171++
 			}
-			dAtA165[j164] = uint8(num)
-			j164++
+			dAtA172[j171] = uint8(num)
+			j171++
 		}
-		i -= j164
-		copy(dAtA[i:], dAtA165[:j164])
-		i = encodeVarintPlan(dAtA, i, uint64(j164))
+		i -= j171
+		copy(dAtA[i:], dAtA172[:j171])
+		i = encodeVarintPlan(dAtA, i, uint64(j171))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -18757,20 +19864,20 @@ func (m *ClusterTable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x18
 	}
 	if len(m.AccountIDs) > 0 {
-		dAtA168 := make([]byte, len(m.AccountIDs)*10)
-		var j167 int
+		dAtA175 := make([]byte, len(m.AccountIDs)*10)
+		var j174 int
 		for _, num := range m.AccountIDs {
 			for num >= 1<<7 {
-				dAtA168[j167] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA175[j174] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j167++
+				j174++
 			}
-			dAtA168[j167] = uint8(num)
-			j167++
+			dAtA175[j174] = uint8(num)
+			j174++
 		}
-		i -= j167
-		copy(dAtA[i:], dAtA168[:j167])
-		i = encodeVarintPlan(dAtA, i, uint64(j167))
+		i -= j174
+		copy(dAtA[i:], dAtA175[:j174])
+		i = encodeVarintPlan(dAtA, i, uint64(j174))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -18982,21 +20089,21 @@ func (m *Prepare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.ParamTypes) > 0 {
-		dAtA172 := make([]byte, len(m.ParamTypes)*10)
-		var j171 int
+		dAtA179 := make([]byte, len(m.ParamTypes)*10)
+		var j178 int
 		for _, num1 := range m.ParamTypes {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA172[j171] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA179[j178] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j171++
+				j178++
 			}
-			dAtA172[j171] = uint8(num)
-			j171++
+			dAtA179[j178] = uint8(num)
+			j178++
 		}
-		i -= j171
-		copy(dAtA[i:], dAtA172[:j171])
-		i = encodeVarintPlan(dAtA, i, uint64(j171))
+		i -= j178
+		copy(dAtA[i:], dAtA179[:j178])
+		i = encodeVarintPlan(dAtA, i, uint64(j178))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -20425,6 +21532,18 @@ func (m *IndexDef) ProtoSize() (n int) {
 		l = m.Option.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.IndexAlgo)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.IndexAlgoParams)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.IndexAlgoTableType)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -20484,6 +21603,41 @@ func (m *CheckDef) ProtoSize() (n int) {
 		l = m.Check.ProtoSize()
 		n += 1 + l + sovPlan(uint64(l))
 	}
+	l = len(m.ExprStr)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.NotEnforced {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TriggerDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Timing)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -20707,6 +21861,82 @@ func (m *PartitionItem) ProtoSize() (n int) {
 	return n
 }
 
+func (m *PartitionPrune) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsPruned {
+		n += 2
+	}
+	if len(m.SelectedPartitions) > 0 {
+		for _, e := range m.SelectedPartitions {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AlterPartition) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Typ != 0 {
+		n += 1 + sovPlan(uint64(m.Typ))
+	}
+	if m.PartitionDef != nil {
+		l = m.PartitionDef.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.CreateTables) > 0 {
+		for _, e := range m.CreateTables {
+			l = e.ProtoSize()
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.DropTableNames) > 0 {
+		for _, s := range m.DropTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	if len(m.TruncateTableNames) > 0 {
+		for _, s := range m.TruncateTableNames {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	l = len(m.ValidateSql)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if len(m.MoveDataSqls) > 0 {
+		for _, s := range m.MoveDataSqls {
+			l = len(s)
+			n += 1 + l + sovPlan(uint64(l))
+		}
+	}
+	l = len(m.ExchangeDatabase)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.ExchangeTable)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ViewDef) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -20789,6 +22019,12 @@ func (m *TableDef) ProtoSize() (n int) {
 			n += 1 + l + sovPlan(uint64(l))
 		}
 	}
+	if len(m.Triggers) > 0 {
+		for _, e := range m.Triggers {
+			l = e.ProtoSize()
+			n += 2 + l + sovPlan(uint64(l))
+		}
+	}
 	if m.Partition != nil {
 		l = m.Partition.ProtoSize()
 		n += 2 + l + sovPlan(uint64(l))
@@ -21293,6 +22529,12 @@ func (m *AnalyzeInfo) ProtoSize() (n int) {
 	if m.InsertTime != 0 {
 		n += 1 + sovPlan(uint64(m.InsertTime))
 	}
+	if m.SpillCount != 0 {
+		n += 1 + sovPlan(uint64(m.SpillCount))
+	}
+	if m.SpillSize != 0 {
+		n += 2 + sovPlan(uint64(m.SpillSize))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -21496,6 +22738,10 @@ func (m *Node) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPlan(uint64(l))
 	}
+	if m.PartitionPrune != nil {
+		l = m.PartitionPrune.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -22251,6 +23497,30 @@ func (m *DataDefinition_AlterSequence) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *DataDefinition_CreateTrigger) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateTrigger != nil {
+		l = m.CreateTrigger.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	return n
+}
+func (m *DataDefinition_DropTrigger) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DropTrigger != nil {
+		l = m.DropTrigger.ProtoSize()
+		n += 2 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *SubscriptionOption) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -22688,6 +23958,10 @@ func (m *AlterTable) ProtoSize() (n int) {
 			n += mapEntrySize + 1 + sovPlan(uint64(mapEntrySize))
 		}
 	}
+	if m.AlterPartition != nil {
+		l = m.AlterPartition.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -22805,6 +24079,18 @@ func (m *AlterTable_Action_DropCol) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *AlterTable_Action_AddCheck) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AddCheck != nil {
+		l = m.AddCheck.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	return n
+}
 func (m *DropTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -22980,6 +24266,40 @@ func (m *CreateIndex) ProtoSize() (n int) {
 	return n
 }
 
+func (m *CreateTrigger) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IfNotExists {
+		n += 2
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Trigger != nil {
+		l = m.Trigger.ProtoSize()
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.OrderTrigger)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.Precedes {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AlterIndex) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -23024,6 +24344,29 @@ func (m *DropIndex) ProtoSize() (n int) {
 	return n
 }
 
+func (m *DropTrigger) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IfExists {
+		n += 2
+	}
+	l = len(m.Database)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPlan(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TruncateTable) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -28102,6 +29445,102 @@ func (m *IndexDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexAlgo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgoParams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexAlgoParams = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAlgoTableType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexAlgoTableType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -28513,6 +29952,58 @@ func (m *CheckDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExprStr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExprStr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotEnforced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NotEnforced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -28535,7 +30026,7 @@ func (m *CheckDef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
+func (m *TriggerDef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28558,10 +30049,10 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterByDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: TriggerDef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterByDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TriggerDef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -28598,9 +30089,9 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompCbkeyCol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timing", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -28610,82 +30101,261 @@ func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompCbkeyCol == nil {
-				m.CompCbkeyCol = &ColDef{}
-			}
-			if err := m.CompCbkeyCol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Timing = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PropertyDef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PropertyDef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PropertyDef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterByDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterByDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterByDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompCbkeyCol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompCbkeyCol == nil {
+				m.CompCbkeyCol = &ColDef{}
+			}
+			if err := m.CompCbkeyCol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PropertyDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PropertyDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PropertyDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29805,7 +31475,7 @@ func (m *PartitionItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ViewDef) Unmarshal(dAtA []byte) error {
+func (m *PartitionPrune) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29828,17 +31498,37 @@ func (m *ViewDef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ViewDef: wiretype end group for non-group")
+			return fmt.Errorf("proto: PartitionPrune: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ViewDef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PartitionPrune: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPruned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPruned = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectedPartitions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -29848,23 +31538,440 @@ func (m *ViewDef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.View = string(dAtA[iNdEx:postIndex])
+			m.SelectedPartitions = append(m.SelectedPartitions, &PartitionItem{})
+			if err := m.SelectedPartitions[len(m.SelectedPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterPartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterPartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterPartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Typ", wireType)
+			}
+			m.Typ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Typ |= AlterPartition_Typ(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionDef == nil {
+				m.PartitionDef = &PartitionByDef{}
+			}
+			if err := m.PartitionDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateTables = append(m.CreateTables, &TableDef{})
+			if err := m.CreateTables[len(m.CreateTables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTableNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DropTableNames = append(m.DropTableNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncateTableNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TruncateTableNames = append(m.TruncateTableNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidateSql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidateSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveDataSqls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MoveDataSqls = append(m.MoveDataSqls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeDatabase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeDatabase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeTable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeTable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ViewDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ViewDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ViewDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field View", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.View = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30355,6 +32462,40 @@ func (m *TableDef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triggers = append(m.Triggers, &TriggerDef{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 21:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Partition", wireType)
@@ -33447,6 +35588,44 @@ func (m *AnalyzeInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpillCount", wireType)
+			}
+			m.SpillCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpillCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpillSize", wireType)
+			}
+			m.SpillSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpillSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -34692,16 +36871,140 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreInsertUkCtx == nil {
-				m.PreInsertUkCtx = &PreInsertUkCtx{}
-			}
-			if err := m.PreInsertUkCtx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PreInsertUkCtx == nil {
+				m.PreInsertUkCtx = &PreInsertUkCtx{}
+			}
+			if err := m.PreInsertUkCtx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDuplicateKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OnDuplicateKey == nil {
+				m.OnDuplicateKey = &OnDuplicateKeyCtx{}
+			}
+			if err := m.OnDuplicateKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEnd", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEnd = bool(v != 0)
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockTargets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockTargets = append(m.LockTargets, &LockTarget{})
+			if err := m.LockTargets[len(m.LockTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockFilterList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockFilterList = append(m.BlockFilterList, &Expr{})
+			if err := m.BlockFilterList[len(m.BlockFilterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnDuplicateKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterProbeList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34728,36 +37031,14 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OnDuplicateKey == nil {
-				m.OnDuplicateKey = &OnDuplicateKeyCtx{}
-			}
-			if err := m.OnDuplicateKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RuntimeFilterProbeList = append(m.RuntimeFilterProbeList, &RuntimeFilterSpec{})
+			if err := m.RuntimeFilterProbeList[len(m.RuntimeFilterProbeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEnd", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsEnd = bool(v != 0)
-		case 38:
+		case 41:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockTargets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34784,16 +37065,16 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockTargets = append(m.LockTargets, &LockTarget{})
-			if err := m.LockTargets[len(m.LockTargets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &RuntimeFilterSpec{})
+			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 39:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockFilterList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -34803,29 +37084,29 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockFilterList = append(m.BlockFilterList, &Expr{})
-			if err := m.BlockFilterList[len(m.BlockFilterList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Uuid = append(m.Uuid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Uuid == nil {
+				m.Uuid = []byte{}
 			}
 			iNdEx = postIndex
-		case 40:
+		case 43:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterProbeList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionPrune", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34852,79 +37133,13 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuntimeFilterProbeList = append(m.RuntimeFilterProbeList, &RuntimeFilterSpec{})
-			if err := m.RuntimeFilterProbeList[len(m.RuntimeFilterProbeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.PartitionPrune == nil {
+				m.PartitionPrune = &PartitionPrune{}
 			}
-			iNdEx = postIndex
-		case 41:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeFilterBuildList", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RuntimeFilterBuildList = append(m.RuntimeFilterBuildList, &RuntimeFilterSpec{})
-			if err := m.RuntimeFilterBuildList[len(m.RuntimeFilterBuildList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PartitionPrune.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 42:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uuid = append(m.Uuid[:0], dAtA[iNdEx:postIndex]...)
-			if m.Uuid == nil {
-				m.Uuid = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -38354,6 +40569,76 @@ func (m *DataDefinition) Unmarshal(dAtA []byte) error {
 			}
 			m.Definition = &DataDefinition_AlterSequence{v}
 			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTrigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CreateTrigger{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Definition = &DataDefinition_CreateTrigger{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTrigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DropTrigger{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Definition = &DataDefinition_DropTrigger{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -41035,6 +43320,42 @@ func (m *AlterTable) Unmarshal(dAtA []byte) error {
 			}
 			m.ChangeTblColIdMap[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlterPartition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AlterPartition == nil {
+				m.AlterPartition = &AlterPartition{}
+			}
+			if err := m.AlterPartition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -41366,6 +43687,41 @@ func (m *AlterTable_Action) Unmarshal(dAtA []byte) error {
 			}
 			m.Action = &AlterTable_Action_DropCol{v}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddCheck", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CheckDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &AlterTable_Action_AddCheck{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -42131,13 +44487,298 @@ func (m *DropSequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Database = string(dAtA[iNdEx:postIndex])
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			m.TableId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TableId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AlterSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AlterSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AlterSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateIndex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateIndex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TableDef == nil {
+				m.TableDef = &TableDef{}
+			}
+			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -42147,29 +44788,33 @@ func (m *DropSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPlan
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPlan
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Table = string(dAtA[iNdEx:postIndex])
+			if m.Index == nil {
+				m.Index = &CreateTable{}
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableExist", wireType)
 			}
-			m.TableId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPlan
@@ -42179,11 +44824,12 @@ func (m *DropSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TableId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.TableExist = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -42206,7 +44852,7 @@ func (m *DropSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AlterSequence) Unmarshal(dAtA []byte) error {
+func (m *CreateTrigger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -42229,64 +44875,33 @@ func (m *AlterSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AlterSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateTrigger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AlterSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPlan(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CreateIndex) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPlan
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateIndex: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateIndex: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.IfNotExists = bool(v != 0)
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
@@ -42318,7 +44933,7 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 			}
 			m.Database = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
@@ -42350,9 +44965,9 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 			}
 			m.Table = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableDef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -42379,16 +44994,16 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TableDef == nil {
-				m.TableDef = &TableDef{}
+			if m.Trigger == nil {
+				m.Trigger = &TriggerDef{}
 			}
-			if err := m.TableDef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginTablePrimaryKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderTrigger", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -42416,47 +45031,11 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginTablePrimaryKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPlan
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPlan
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPlan
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Index == nil {
-				m.Index = &CreateTable{}
-			}
-			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrderTrigger = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TableExist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Precedes", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -42473,7 +45052,7 @@ func (m *CreateIndex) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.TableExist = bool(v != 0)
+			m.Precedes = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPlan(dAtA[iNdEx:])
@@ -42758,6 +45337,141 @@ func (m *DropIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DropTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPlan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DropTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DropTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfExists = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Database = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPlan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPlan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPlan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPlan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TruncateTable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -68,6 +68,10 @@ const (
 	TaskCode_MetricLogMerge TaskCode = 2
 	// MetricStorageUsage handle metric server_storage_usage collection
 	TaskCode_MetricStorageUsage TaskCode = 3
+	// UserDefinedEvent handle the event created by CREATE EVENT
+	TaskCode_UserDefinedEvent TaskCode = 4
+	// MViewRefresh refresh the materialized view created by CREATE MATERIALIZED VIEW
+	TaskCode_MViewRefresh TaskCode = 5
 )
 
 var TaskCode_name = map[int32]string{
@@ -75,6 +79,8 @@ var TaskCode_name = map[int32]string{
 	1: "SystemInit",
 	2: "MetricLogMerge",
 	3: "MetricStorageUsage",
+	4: "UserDefinedEvent",
+	5: "MViewRefresh",
 }
 
 var TaskCode_value = map[string]int32{
@@ -82,6 +88,8 @@ var TaskCode_value = map[string]int32{
 	"SystemInit":         1,
 	"MetricLogMerge":     2,
 	"MetricStorageUsage": 3,
+	"UserDefinedEvent":   4,
+	"MViewRefresh":       5,
 }
 
 func (x TaskCode) String() string {
@@ -577,50 +585,52 @@ func init() {
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 680 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xda, 0x4c,
-	0x14, 0x65, 0x80, 0xf0, 0x73, 0xf9, 0x91, 0xbf, 0xf9, 0x3e, 0x7d, 0xb2, 0x58, 0x50, 0x84, 0x52,
-	0x09, 0x21, 0x35, 0xa8, 0xb4, 0x5d, 0x74, 0x55, 0x25, 0x90, 0xaa, 0xa8, 0xa1, 0xa9, 0x06, 0xb2,
-	0xe9, 0x6e, 0x30, 0xb7, 0x8e, 0x15, 0xf0, 0x58, 0xe3, 0x71, 0x05, 0x4f, 0xd2, 0x75, 0xdf, 0x26,
-	0xcb, 0x3c, 0x41, 0xd5, 0x46, 0xdd, 0xf7, 0x15, 0xaa, 0x99, 0x01, 0x07, 0x67, 0xdd, 0x9d, 0xcf,
-	0x39, 0x77, 0xee, 0xdc, 0x7b, 0x8e, 0x6d, 0x00, 0xc5, 0xe3, 0x9b, 0x93, 0x48, 0x0a, 0x25, 0x68,
-	0x51, 0x3f, 0xb7, 0x9e, 0xf9, 0x81, 0xba, 0x4e, 0x16, 0x27, 0x9e, 0x58, 0x0f, 0x7c, 0xe1, 0x8b,
-	0x81, 0x11, 0x17, 0xc9, 0x67, 0x83, 0x0c, 0x30, 0x4f, 0xf6, 0x50, 0xf7, 0x2b, 0x81, 0xfa, 0x9c,
-	0xc7, 0x37, 0x53, 0x54, 0x7c, 0xc9, 0x15, 0xa7, 0x4d, 0xc8, 0x4f, 0xc6, 0x2e, 0xe9, 0x90, 0x5e,
-	0x95, 0xe5, 0x27, 0x63, 0xda, 0x87, 0xca, 0xf9, 0x06, 0xbd, 0x44, 0x09, 0xe9, 0xe6, 0x3b, 0xa4,
-	0xd7, 0x1c, 0x36, 0x4f, 0xcc, 0xa5, 0xfa, 0xd4, 0x48, 0x2c, 0x91, 0xa5, 0x3a, 0x75, 0xa1, 0x3c,
-	0x12, 0xa1, 0xc2, 0x8d, 0x72, 0x0b, 0x1d, 0xd2, 0xab, 0xb3, 0x3d, 0xa4, 0xcf, 0xa1, 0x7c, 0x19,
-	0xa9, 0x40, 0x84, 0xb1, 0x5b, 0xec, 0x90, 0x5e, 0x6d, 0xf8, 0xcf, 0x43, 0x93, 0x9d, 0x70, 0x56,
-	0xbc, 0xfd, 0xfe, 0x24, 0xc7, 0xf6, 0x75, 0xdd, 0x6f, 0x04, 0x6a, 0x07, 0x32, 0x3d, 0x86, 0xc6,
-	0x94, 0x6f, 0x18, 0x2a, 0xb9, 0x9d, 0x07, 0x6b, 0x8c, 0xcd, 0x8c, 0x0d, 0x96, 0x25, 0x75, 0x95,
-	0x41, 0x93, 0x50, 0xa1, 0xfc, 0xc2, 0x57, 0x66, 0xe6, 0x02, 0xcb, 0x92, 0xba, 0x6a, 0x8c, 0x2b,
-	0xbe, 0x1d, 0x27, 0x92, 0xeb, 0xee, 0x66, 0xdc, 0x02, 0xcb, 0x92, 0xb4, 0x03, 0xb5, 0x91, 0x08,
-	0xbd, 0x44, 0x4a, 0x0c, 0xbd, 0xad, 0x19, 0xbc, 0xc1, 0x0e, 0xa9, 0xee, 0x7b, 0x68, 0xd8, 0xe5,
-	0x91, 0x61, 0x9c, 0xac, 0x14, 0x3d, 0x86, 0xa2, 0xf6, 0xc4, 0xcc, 0xd6, 0x1c, 0x3a, 0x76, 0x49,
-	0xab, 0x19, 0xaf, 0x8c, 0x4a, 0xff, 0x83, 0xa3, 0x73, 0x29, 0x77, 0x86, 0x56, 0x99, 0x05, 0xdd,
-	0xdf, 0x79, 0x28, 0xea, 0x85, 0x0f, 0x22, 0x28, 0x9a, 0x08, 0x5e, 0x42, 0x65, 0x1f, 0x8f, 0x39,
-	0x51, 0x1b, 0xd2, 0x07, 0xf7, 0xf6, 0xca, 0xce, 0xbe, 0xb4, 0x92, 0x76, 0xa1, 0xfe, 0x91, 0x4b,
-	0x0c, 0x95, 0xae, 0x9a, 0x8c, 0xcd, 0x8a, 0x55, 0x96, 0xe1, 0x68, 0x0f, 0x4a, 0x33, 0xc5, 0x55,
-	0x62, 0x53, 0x49, 0x07, 0xd6, 0xaa, 0xe5, 0xd9, 0x4e, 0xa7, 0x6d, 0x00, 0xcd, 0xb2, 0x24, 0x0c,
-	0x51, 0xba, 0x47, 0xa6, 0xd7, 0x01, 0x63, 0x56, 0x8a, 0x84, 0x77, 0xed, 0x96, 0x8c, 0x4b, 0x16,
-	0x68, 0x9f, 0x2f, 0x78, 0xac, 0xde, 0x21, 0x97, 0x6a, 0x81, 0x5c, 0xb9, 0x65, 0xeb, 0x73, 0x86,
-	0xa4, 0x2d, 0xa8, 0x8c, 0x24, 0x72, 0x85, 0xa7, 0xca, 0xad, 0x98, 0x82, 0x14, 0xdb, 0x0c, 0xd6,
-	0xd1, 0x0a, 0x15, 0x2e, 0x4f, 0x95, 0x5b, 0x35, 0xf2, 0x21, 0x45, 0x5f, 0x3f, 0xca, 0xc0, 0x05,
-	0x63, 0xd1, 0xbf, 0x76, 0x95, 0x8c, 0xc4, 0xb2, 0x95, 0xdd, 0x5f, 0x44, 0xdf, 0x2c, 0xc2, 0xbf,
-	0xe8, 0x7a, 0xcb, 0x76, 0x3c, 0xdf, 0x44, 0x72, 0xe7, 0x78, 0x8a, 0xb5, 0xf6, 0x01, 0x37, 0x4a,
-	0xbf, 0xa8, 0xc6, 0xef, 0x02, 0x4b, 0xb1, 0x4e, 0x6b, 0x2e, 0x03, 0xdf, 0x47, 0x69, 0x5f, 0xee,
-	0x23, 0x33, 0x47, 0x86, 0xcb, 0xf8, 0x54, 0x7a, 0xe4, 0x53, 0x0b, 0x2a, 0x57, 0xd1, 0xd2, 0x6a,
-	0xd6, 0xe4, 0x14, 0xf7, 0x5f, 0xd9, 0xec, 0x76, 0x49, 0xd6, 0xa0, 0x6c, 0x4f, 0x2d, 0x9d, 0x9c,
-	0x06, 0x3a, 0xc0, 0x20, 0xf4, 0x1d, 0x42, 0x1b, 0x50, 0x4d, 0x8d, 0x75, 0xf2, 0xfd, 0x39, 0x54,
-	0xf6, 0xdf, 0x38, 0xad, 0x43, 0x65, 0x8e, 0xb1, 0xba, 0x0c, 0x57, 0x5b, 0x27, 0x47, 0x9b, 0x00,
-	0xb3, 0x6d, 0xac, 0x70, 0x3d, 0x09, 0x03, 0xe5, 0x10, 0x4a, 0xa1, 0x39, 0x45, 0x25, 0x03, 0xef,
-	0x42, 0xf8, 0x53, 0x94, 0x3e, 0x3a, 0x79, 0xfa, 0x3f, 0x50, 0xcb, 0xcd, 0x94, 0x90, 0xdc, 0xc7,
-	0xab, 0x98, 0xfb, 0xe8, 0x14, 0xfa, 0x4f, 0x01, 0x1e, 0xbe, 0x07, 0x7d, 0xff, 0x2c, 0xf1, 0x3c,
-	0x8c, 0x63, 0x27, 0x47, 0x01, 0x4a, 0x6f, 0x79, 0xb0, 0xc2, 0xa5, 0x43, 0xce, 0xde, 0xdc, 0xfd,
-	0x6c, 0x93, 0xdb, 0xfb, 0x36, 0xb9, 0xbb, 0x6f, 0x93, 0x1f, 0xf7, 0x6d, 0xf2, 0xe9, 0xf0, 0xc7,
-	0xb6, 0xe6, 0x4a, 0x06, 0x1b, 0x21, 0x03, 0x3f, 0x08, 0xf7, 0x20, 0xc4, 0x41, 0x74, 0xe3, 0x0f,
-	0xa2, 0xc5, 0x40, 0xa7, 0xb4, 0x28, 0x99, 0xff, 0xdb, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xbd, 0x41, 0x97, 0x03, 0x22, 0x05, 0x00, 0x00,
+	// 710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xda, 0x4a,
+	0x14, 0xc5, 0x7c, 0x73, 0xf9, 0x90, 0xdf, 0xbc, 0xe8, 0xc9, 0x62, 0xc1, 0x43, 0x28, 0x4f, 0x42,
+	0x48, 0x2f, 0xe8, 0xf1, 0xda, 0x45, 0x57, 0x55, 0x02, 0x54, 0x45, 0x0d, 0x4d, 0x35, 0x90, 0x2e,
+	0xba, 0x1b, 0xcc, 0x8d, 0x63, 0x05, 0xc6, 0xd6, 0x78, 0x9c, 0x82, 0xd4, 0xff, 0xd1, 0x75, 0xff,
+	0x4d, 0x96, 0xf9, 0x05, 0x55, 0x1b, 0x75, 0xdf, 0xbf, 0x50, 0xcd, 0x0c, 0x38, 0x38, 0xeb, 0xee,
+	0x7c, 0xce, 0xb9, 0x73, 0x7d, 0xef, 0x39, 0xf6, 0x00, 0x48, 0x16, 0xdd, 0x9c, 0x84, 0x22, 0x90,
+	0x01, 0xc9, 0xab, 0xe7, 0xe6, 0xbf, 0x9e, 0x2f, 0xaf, 0xe3, 0xc5, 0x89, 0x1b, 0xac, 0xfb, 0x5e,
+	0xe0, 0x05, 0x7d, 0x2d, 0x2e, 0xe2, 0x2b, 0x8d, 0x34, 0xd0, 0x4f, 0xe6, 0x50, 0xe7, 0xb3, 0x05,
+	0xb5, 0x39, 0x8b, 0x6e, 0xa6, 0x28, 0xd9, 0x92, 0x49, 0x46, 0x1a, 0x90, 0x9d, 0x8c, 0x1c, 0xab,
+	0x6d, 0x75, 0x2b, 0x34, 0x3b, 0x19, 0x91, 0x1e, 0x94, 0xc7, 0x1b, 0x74, 0x63, 0x19, 0x08, 0x27,
+	0xdb, 0xb6, 0xba, 0x8d, 0x41, 0xe3, 0x44, 0xbf, 0x54, 0x9d, 0x1a, 0x06, 0x4b, 0xa4, 0x89, 0x4e,
+	0x1c, 0x28, 0x0d, 0x03, 0x2e, 0x71, 0x23, 0x9d, 0x5c, 0xdb, 0xea, 0xd6, 0xe8, 0x1e, 0x92, 0xff,
+	0xa0, 0x74, 0x11, 0x4a, 0x3f, 0xe0, 0x91, 0x93, 0x6f, 0x5b, 0xdd, 0xea, 0xe0, 0x8f, 0xc7, 0x26,
+	0x3b, 0xe1, 0x2c, 0x7f, 0xf7, 0xf5, 0xef, 0x0c, 0xdd, 0xd7, 0x75, 0xbe, 0x58, 0x50, 0x3d, 0x90,
+	0xc9, 0x31, 0xd4, 0xa7, 0x6c, 0x43, 0x51, 0x8a, 0xed, 0xdc, 0x5f, 0x63, 0xa4, 0x67, 0xac, 0xd3,
+	0x34, 0xa9, 0xaa, 0x34, 0x9a, 0x70, 0x89, 0xe2, 0x96, 0xad, 0xf4, 0xcc, 0x39, 0x9a, 0x26, 0x55,
+	0xd5, 0x08, 0x57, 0x6c, 0x3b, 0x8a, 0x05, 0x53, 0xdd, 0xf5, 0xb8, 0x39, 0x9a, 0x26, 0x49, 0x1b,
+	0xaa, 0xc3, 0x80, 0xbb, 0xb1, 0x10, 0xc8, 0xdd, 0xad, 0x1e, 0xbc, 0x4e, 0x0f, 0xa9, 0xce, 0x1b,
+	0xa8, 0x9b, 0xe5, 0x91, 0x62, 0x14, 0xaf, 0x24, 0x39, 0x86, 0xbc, 0xf2, 0x44, 0xcf, 0xd6, 0x18,
+	0xd8, 0x66, 0x49, 0xa3, 0x69, 0xaf, 0xb4, 0x4a, 0x8e, 0xa0, 0x30, 0x16, 0x62, 0x67, 0x68, 0x85,
+	0x1a, 0xd0, 0xf9, 0x99, 0x85, 0xbc, 0x5a, 0xf8, 0x20, 0x82, 0xbc, 0x8e, 0xe0, 0x19, 0x94, 0xf7,
+	0xf1, 0xe8, 0x13, 0xd5, 0x01, 0x79, 0x74, 0x6f, 0xaf, 0xec, 0xec, 0x4b, 0x2a, 0x49, 0x07, 0x6a,
+	0xef, 0x98, 0x40, 0x2e, 0x55, 0xd5, 0x64, 0xa4, 0x57, 0xac, 0xd0, 0x14, 0x47, 0xba, 0x50, 0x9c,
+	0x49, 0x26, 0x63, 0x93, 0x4a, 0x32, 0xb0, 0x52, 0x0d, 0x4f, 0x77, 0x3a, 0x69, 0x01, 0x28, 0x96,
+	0xc6, 0x9c, 0xa3, 0x70, 0x0a, 0xba, 0xd7, 0x01, 0xa3, 0x57, 0x0a, 0x03, 0xf7, 0xda, 0x29, 0x6a,
+	0x97, 0x0c, 0x50, 0x3e, 0x9f, 0xb3, 0x48, 0xbe, 0x46, 0x26, 0xe4, 0x02, 0x99, 0x74, 0x4a, 0xc6,
+	0xe7, 0x14, 0x49, 0x9a, 0x50, 0x1e, 0x0a, 0x64, 0x12, 0x4f, 0xa5, 0x53, 0xd6, 0x05, 0x09, 0x36,
+	0x19, 0xac, 0xc3, 0x15, 0x4a, 0x5c, 0x9e, 0x4a, 0xa7, 0xa2, 0xe5, 0x43, 0x8a, 0xbc, 0x78, 0x92,
+	0x81, 0x03, 0xda, 0xa2, 0x3f, 0xcd, 0x2a, 0x29, 0x89, 0xa6, 0x2b, 0x3b, 0x3f, 0x2c, 0xf5, 0xe6,
+	0x80, 0xff, 0x46, 0xd7, 0x9b, 0xa6, 0xe3, 0x78, 0x13, 0x8a, 0x9d, 0xe3, 0x09, 0x56, 0xda, 0x5b,
+	0xdc, 0x48, 0xf5, 0xa1, 0x6a, 0xbf, 0x73, 0x34, 0xc1, 0x2a, 0xad, 0xb9, 0xf0, 0x3d, 0x0f, 0x85,
+	0xf9, 0xb8, 0x0b, 0x7a, 0x8e, 0x14, 0x97, 0xf2, 0xa9, 0xf8, 0xc4, 0xa7, 0x26, 0x94, 0x2f, 0xc3,
+	0xa5, 0xd1, 0x8c, 0xc9, 0x09, 0xee, 0x3d, 0x37, 0xd9, 0xed, 0x92, 0xac, 0x42, 0xc9, 0x9c, 0x5a,
+	0xda, 0x19, 0x05, 0x54, 0x80, 0x3e, 0xf7, 0x6c, 0x8b, 0xd4, 0xa1, 0x92, 0x18, 0x6b, 0x67, 0x7b,
+	0x9f, 0xa0, 0xbc, 0xff, 0xc7, 0x49, 0x0d, 0xca, 0x73, 0x8c, 0xe4, 0x05, 0x5f, 0x6d, 0xed, 0x0c,
+	0x69, 0x00, 0xcc, 0xb6, 0x91, 0xc4, 0xf5, 0x84, 0xfb, 0xd2, 0xb6, 0x08, 0x81, 0xc6, 0x14, 0xa5,
+	0xf0, 0xdd, 0xf3, 0xc0, 0x9b, 0xa2, 0xf0, 0xd0, 0xce, 0x92, 0xbf, 0x80, 0x18, 0x6e, 0x26, 0x03,
+	0xc1, 0x3c, 0xbc, 0x8c, 0x98, 0x87, 0x76, 0x8e, 0x1c, 0x81, 0x7d, 0x19, 0xa1, 0x18, 0xe1, 0x95,
+	0xcf, 0x71, 0x39, 0xbe, 0x45, 0x2e, 0xed, 0x3c, 0xb1, 0xa1, 0x36, 0x7d, 0xef, 0xe3, 0x47, 0x8a,
+	0x57, 0x02, 0xa3, 0x6b, 0xbb, 0xd0, 0xfb, 0x07, 0xe0, 0xf1, 0xbf, 0x51, 0x73, 0xce, 0x62, 0xd7,
+	0xc5, 0x28, 0xb2, 0x33, 0x04, 0xa0, 0xf8, 0x8a, 0xf9, 0x2b, 0x5c, 0xda, 0xd6, 0xd9, 0xcb, 0xfb,
+	0xef, 0x2d, 0xeb, 0xee, 0xa1, 0x65, 0xdd, 0x3f, 0xb4, 0xac, 0x6f, 0x0f, 0x2d, 0xeb, 0xc3, 0xe1,
+	0x05, 0xb8, 0x66, 0x52, 0xf8, 0x9b, 0x40, 0xf8, 0x9e, 0xcf, 0xf7, 0x80, 0x63, 0x3f, 0xbc, 0xf1,
+	0xfa, 0xe1, 0xa2, 0xaf, 0xd2, 0x5c, 0x14, 0xf5, 0x3d, 0xf8, 0xff, 0xaf, 0x01, 0x00, 0x22, 0x88,
+	0xc8, 0xa2, 0x4a, 0x05, 0x00, 0x00,
 }
 
 func (m *TaskMetadata) Marshal() (dAtA []byte, err error) {
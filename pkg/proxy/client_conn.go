@@ -170,7 +170,9 @@ func newClientConn(
 		return nil, err
 	}
 	fp := config.FrontendParameters{
-		EnableTls: cfg.TLSEnabled,
+		EnableTls:         cfg.TLSEnabled,
+		DefaultAuthPlugin: cfg.DefaultAuthPlugin,
+		RsaPrivateKeyFile: cfg.RSAPrivateKeyFile,
 	}
 	fp.SetDefaultValues()
 	c.mysqlProto = frontend.NewMysqlClientProtocol(c.connID, c.conn, 0, &fp)
@@ -420,6 +422,13 @@ func (c *clientConn) connectToBackend(sendToClient bool) (ServerConn, error) {
 		return nil, withCode(moerr.NewInternalErrorNoCtx("access error"),
 			codeAuthFailed)
 	}
+	if sendToClient {
+		// The packets after the handshake are compressed between the client and
+		// proxy if the client asks for it. The connection to CN server is never
+		// compressed, so the packets in the tunnel are the same as before, and the
+		// connection can be transferred to another CN server.
+		c.mysqlProto.EnableCompression()
+	}
 
 	// Set the use defined variables, including session variables and user variables.
 	for _, stmt := range c.setVarStmts {
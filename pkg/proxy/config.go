@@ -62,6 +62,14 @@ type Config struct {
 	// TLSKeyFile is the file path of file that contains X509 key in PEM
 	// format for client.
 	TLSKeyFile string `toml:"tls-key-file"`
+	// DefaultAuthPlugin is the authentication method announced in the
+	// handshake. This value should be the same with all CN servers, and
+	// the name of this parameter is defaultAuthPlugin.
+	DefaultAuthPlugin string `toml:"default-auth-plugin"`
+	// RSAPrivateKeyFile is the file path of file that contains RSA private
+	// key in PEM format, which is used to decrypt the password of
+	// caching_sha2_password on the insecure connection.
+	RSAPrivateKeyFile string `toml:"rsa-private-key-file"`
 
 	// HAKeeper is the configuration of HAKeeper.
 	HAKeeper struct {
@@ -67,6 +67,11 @@ add configuration in CN config file:
 [cn.frontend]
 proxy-enabled = true
 
+The salt and the labels are only received from the proxies whose addresses or CIDRs are
+configured in proxy-allowed-addresses, and only the loopback addresses are allowed if it
+is empty. The proxies running on other hosts must be configured explicitly:
+proxy-allowed-addresses = ["10.0.0.0/8"]
+
 The default port is of proxy service 6009.
 
 After startup, connect to port 6001 to log in normally, and then use the internal command
@@ -40,9 +40,6 @@ func (c *clientConn) handleHandshakeResp() error {
 		return err
 	}
 	c.mysqlProto.AddSequenceId(1)
-	// Save the login packet in client connection, it will be used
-	// in the future.
-	c.handshakePack = pack
 
 	// Parse the login information and returns whether ssl is needed.
 	// Also, we can get connection attributes from client if it sets
@@ -58,6 +55,18 @@ func (c *clientConn) handleHandshakeResp() error {
 		return c.handleHandshakeResp()
 	}
 
+	// Negotiate the authentication method with client, the login packet
+	// is rewritten with the auth data which can be replayed to CN servers.
+	payload, err := c.mysqlProto.AuthenticateForProxy(c.ctx, pack.Payload)
+	if err != nil {
+		return err
+	}
+	pack.Payload = payload
+	pack.Length = int32(len(payload))
+	// Save the login packet in client connection, it will be used
+	// in the future.
+	c.handshakePack = pack
+
 	// parse tenant information from client login request.
 	if err := c.clientInfo.parse(c.mysqlProto.GetUserName()); err != nil {
 		return err
@@ -83,6 +92,7 @@ func (c *clientConn) upgradeToTLS() error {
 		return moerr.NewInternalError(ctx, "TSL handshake error: %v", err)
 	}
 	c.conn.UseConn(tlsConn)
+	c.mysqlProto.SetTlsEstablished()
 	return nil
 }
 
@@ -46,7 +46,9 @@ type MySQLCmd byte
 const cmdQuery MySQLCmd = 0x03
 
 // MySQLConn contains a buffer to save data which may be only part
-// of a packet.
+// of a packet. If the client uses the compressed protocol, the client
+// connection is wrapped by frontend.NewCompressedConn, so the buffer
+// always holds the uncompressed packets.
 type MySQLConn struct {
 	net.Conn
 	*msgBuf
@@ -17,9 +17,11 @@ package proxy
 import (
 	"math"
 	"net"
+	"strings"
 	"testing"
 
 	"github.com/lni/goutils/leaktest"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/stretchr/testify/require"
 )
 
@@ -212,6 +214,38 @@ func TestMySQLConnSend(t *testing.T) {
 	})
 }
 
+func TestMySQLConnSendCompressed(t *testing.T) {
+	defer leaktest.AfterTest(t)()
+
+	for _, algorithm := range []frontend.CompressionAlgorithm{frontend.CompressionZlib, frontend.CompressionZstd} {
+		q := "select " + strings.Repeat("1,", 100) + "1"
+		data := makeSimplePacket(q)
+		// data is compressed by src1, dst1 decompresses it and sendTo src2,
+		// dst2 reads the uncompressed packet.
+		src1, dst1 := net.Pipe()
+		src2, dst2 := net.Pipe()
+		client := frontend.NewCompressedConn(src1, algorithm, 0)
+
+		go func() {
+			n, err := client.Write(data)
+			require.NoError(t, err)
+			require.Equal(t, len(data), n)
+		}()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			s := newMySQLConn("server", dst2, 0, nil, nil)
+			res, err := s.receive()
+			require.NoError(t, err)
+			require.Equal(t, data, res)
+		}()
+		d1 := newMySQLConn("source", frontend.NewCompressedConn(dst1, algorithm, 0), 0, nil, nil)
+		_, err := d1.sendTo(src2)
+		require.NoError(t, err)
+		<-done
+	}
+}
+
 func TestMySQLConnSize(t *testing.T) {
 	defer leaktest.AfterTest(t)()
 
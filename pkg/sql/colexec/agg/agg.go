@@ -381,7 +381,7 @@ func (a *UnaryAgg[T1, T2]) MarshalBinary() ([]byte, error) {
 		IsCount:    a.isCount,
 	}
 	switch {
-	case a.otyp.Oid.IsMySQLString():
+	case a.otyp.Oid.IsMySQLString() || a.otyp.Oid == types.T_json:
 		source.Da = types.EncodeStringSlice(getUnaryAggStrVs(a))
 	default:
 		source.Da = a.da
@@ -424,7 +424,7 @@ func (a *UnaryAgg[T1, T2]) UnmarshalBinary(data []byte) error {
 
 func setAggValues[T1, T2 any](agg any, typ types.Type) {
 	switch {
-	case typ.Oid.IsMySQLString():
+	case typ.Oid.IsMySQLString() || typ.Oid == types.T_json:
 		a := agg.(*UnaryAgg[[]byte, []byte])
 		values := types.DecodeStringSlice(a.da)
 		a.vs = make([][]byte, len(values))
@@ -0,0 +1,81 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// CumeDist returns the number of rows preceding or peer with the current row
+// divided by the rows of partition.
+type CumeDist struct {
+	Ps [][]int64
+}
+
+func CumeDistReturnType() types.Type {
+	return types.New(types.T_float64, 0, 0)
+}
+
+func NewCumeDist() *CumeDist {
+	return &CumeDist{}
+}
+
+func (r *CumeDist) Grows(_ int) {}
+
+func (r *CumeDist) Eval(vs []float64, err error) ([]float64, error) {
+	idx := 0
+	for _, p := range r.Ps {
+		if len(p) == 0 {
+			continue
+		}
+		size := p[len(p)-1] - p[0]
+		for i := 1; i < len(p); i++ {
+			v := float64(p[i]-p[0]) / float64(size)
+			for t := p[i-1]; t < p[i]; t++ {
+				vs[idx] = v
+				idx++
+			}
+		}
+	}
+	return vs, nil
+}
+
+func (r *CumeDist) Fill(i int64, value int64, ov float64, z int64, isEmpty bool, isNull bool) (float64, bool, error) {
+	for int(i) >= len(r.Ps) {
+		r.Ps = append(r.Ps, []int64{})
+	}
+	r.Ps[i] = append(r.Ps[i], value)
+	return 0, false, nil
+}
+
+func (r *CumeDist) Merge(xIndex int64, yIndex int64, x float64, y float64, xEmpty bool, yEmpty bool, yCumeDist any) (float64, bool, error) {
+	return 0, false, nil
+}
+
+func (r *CumeDist) BatchFill(rs, vs any, start, count int64, vps []uint64, zs []int64, nsp *nulls.Nulls) error {
+	return nil
+}
+
+func (r *CumeDist) MarshalBinary() ([]byte, error) {
+	return types.EncodeSlice(r.Ps), nil
+}
+
+func (r *CumeDist) UnmarshalBinary(data []byte) error {
+	copyData := make([]byte, len(data))
+	copy(copyData, data)
+	r.Ps = types.DecodeSlice[[]int64](copyData)
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// JsonArrayAgg collects the values of each group into a json array.
+// The binder rewrites json_arrayagg(expr) to json_arrayagg(json_array(expr)),
+// so the input is always an array of one element and NULL is kept as json null.
+type JsonArrayAgg struct {
+	elems [][]bytejson.ByteJson
+}
+
+// JsonObjectAgg collects the key-value pairs of each group into a json object.
+// The binder rewrites json_objectagg(key, val) to json_objectagg(json_object(key, val)),
+// the last value wins if a key is duplicated.
+type JsonObjectAgg struct {
+	members []map[string]bytejson.ByteJson
+}
+
+func JsonArrayAggReturnType(_ []types.Type) types.Type {
+	return types.T_json.ToType()
+}
+
+func JsonObjectAggReturnType(_ []types.Type) types.Type {
+	return types.T_json.ToType()
+}
+
+func newJsonArrayAgg(typ types.Type, dist bool) Agg[any] {
+	if typ.Oid != types.T_json {
+		panic(moerr.NewInternalErrorNoCtx("unsupported type '%s' for json_arrayagg", typ))
+	}
+	if dist {
+		panic(moerr.NewNotSupportedNoCtx("json_arrayagg in distinct mode"))
+	}
+	aggPriv := &JsonArrayAgg{}
+	return NewUnaryAgg(AggregateJsonArrayAgg, aggPriv, false, typ, JsonArrayAggReturnType(nil), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+}
+
+func newJsonObjectAgg(typ types.Type, dist bool) Agg[any] {
+	if typ.Oid != types.T_json {
+		panic(moerr.NewInternalErrorNoCtx("unsupported type '%s' for json_objectagg", typ))
+	}
+	if dist {
+		panic(moerr.NewNotSupportedNoCtx("json_objectagg in distinct mode"))
+	}
+	aggPriv := &JsonObjectAgg{}
+	return NewUnaryAgg(AggregateJsonObjectAgg, aggPriv, false, typ, JsonObjectAggReturnType(nil), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+}
+
+// decodeJsonInput copies the input because the memory of the batch will be reused.
+func decodeJsonInput(input []byte) bytejson.ByteJson {
+	return types.DecodeJson(append([]byte(nil), input...))
+}
+
+func (a *JsonArrayAgg) Grows(cnt int) {
+	for i := 0; i < cnt; i++ {
+		a.elems = append(a.elems, nil)
+	}
+}
+
+func (a *JsonArrayAgg) Fill(groupIndex int64, input []byte, _ []byte, z int64, isEmpty bool, isNull bool) ([]byte, bool, error) {
+	if isNull {
+		return nil, isEmpty, nil
+	}
+	elems := decodeJsonInput(input).ArrayElems()
+	for ; z > 0; z-- {
+		a.elems[groupIndex] = append(a.elems[groupIndex], elems...)
+	}
+	return []byte{}, false, nil
+}
+
+func (a *JsonArrayAgg) Merge(xIndex int64, yIndex int64, x []byte, _ []byte, xIsEmpty bool, yIsEmpty bool, yJsonArrayAgg any) ([]byte, bool, error) {
+	if yIsEmpty {
+		return x, xIsEmpty, nil
+	}
+	a.elems[xIndex] = append(a.elems[xIndex], yJsonArrayAgg.(*JsonArrayAgg).elems[yIndex]...)
+	return []byte{}, false, nil
+}
+
+func (a *JsonArrayAgg) Eval(vs [][]byte, err error) ([][]byte, error) {
+	for i := range vs {
+		if vs[i], err = bytejson.CreateArray(a.elems[i]).Marshal(); err != nil {
+			return nil, err
+		}
+	}
+	return vs, nil
+}
+
+func (a *JsonArrayAgg) MarshalBinary() ([]byte, error) {
+	strs := make([]string, 0, len(a.elems))
+	for _, elems := range a.elems {
+		data, err := bytejson.CreateArray(elems).Marshal()
+		if err != nil {
+			return nil, err
+		}
+		strs = append(strs, string(data))
+	}
+	return types.EncodeStringSlice(strs), nil
+}
+
+func (a *JsonArrayAgg) UnmarshalBinary(data []byte) error {
+	strs := types.DecodeStringSlice(data)
+	a.elems = make([][]bytejson.ByteJson, len(strs))
+	for i, str := range strs {
+		a.elems[i] = types.DecodeJson([]byte(str)).ArrayElems()
+	}
+	return nil
+}
+
+func (a *JsonObjectAgg) Grows(cnt int) {
+	for i := 0; i < cnt; i++ {
+		a.members = append(a.members, make(map[string]bytejson.ByteJson))
+	}
+}
+
+func (a *JsonObjectAgg) Fill(groupIndex int64, input []byte, _ []byte, _ int64, isEmpty bool, isNull bool) ([]byte, bool, error) {
+	if isNull {
+		return nil, isEmpty, nil
+	}
+	keys, vals := decodeJsonInput(input).ObjectMembers()
+	for i, key := range keys {
+		a.members[groupIndex][key] = vals[i]
+	}
+	return []byte{}, false, nil
+}
+
+func (a *JsonObjectAgg) Merge(xIndex int64, yIndex int64, x []byte, _ []byte, xIsEmpty bool, yIsEmpty bool, yJsonObjectAgg any) ([]byte, bool, error) {
+	if yIsEmpty {
+		return x, xIsEmpty, nil
+	}
+	for key, val := range yJsonObjectAgg.(*JsonObjectAgg).members[yIndex] {
+		a.members[xIndex][key] = val
+	}
+	return []byte{}, false, nil
+}
+
+func (a *JsonObjectAgg) object(i int) (bytejson.ByteJson, error) {
+	keys := make([]string, 0, len(a.members[i]))
+	vals := make([]bytejson.ByteJson, 0, len(a.members[i]))
+	for key, val := range a.members[i] {
+		keys = append(keys, key)
+		vals = append(vals, val)
+	}
+	return bytejson.CreateObject(keys, vals)
+}
+
+func (a *JsonObjectAgg) Eval(vs [][]byte, err error) ([][]byte, error) {
+	for i := range vs {
+		obj, err := a.object(i)
+		if err != nil {
+			return nil, err
+		}
+		if vs[i], err = obj.Marshal(); err != nil {
+			return nil, err
+		}
+	}
+	return vs, nil
+}
+
+func (a *JsonObjectAgg) MarshalBinary() ([]byte, error) {
+	strs := make([]string, 0, len(a.members))
+	for i := range a.members {
+		obj, err := a.object(i)
+		if err != nil {
+			return nil, err
+		}
+		data, err := obj.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		strs = append(strs, string(data))
+	}
+	return types.EncodeStringSlice(strs), nil
+}
+
+func (a *JsonObjectAgg) UnmarshalBinary(data []byte) error {
+	strs := types.DecodeStringSlice(data)
+	a.members = make([]map[string]bytejson.ByteJson, len(strs))
+	for i, str := range strs {
+		keys, vals := types.DecodeJson([]byte(str)).ObjectMembers()
+		a.members[i] = make(map[string]bytejson.ByteJson, len(keys))
+		for j, key := range keys {
+			a.members[i][key] = vals[j]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func makeJsonVector(t *testing.T, m *mpool.MPool, docs []string) *vector.Vector {
+	vec := vector.NewVec(types.T_json.ToType())
+	for _, doc := range docs {
+		bj, err := types.ParseStringToByteJson(doc)
+		require.NoError(t, err)
+		data, err := bj.Marshal()
+		require.NoError(t, err)
+		require.NoError(t, vector.AppendBytes(vec, data, false, m))
+	}
+	return vec
+}
+
+func fillJsonAgg(t *testing.T, m *mpool.MPool, op int, docs []string) Agg[any] {
+	a, err := New(op, false, types.T_json.ToType())
+	require.NoError(t, err)
+	require.NoError(t, a.Grows(2, m))
+	vec := makeJsonVector(t, m, docs)
+	for i := range docs {
+		require.NoError(t, a.Fill(0, int64(i), []*vector.Vector{vec}))
+	}
+	return a
+}
+
+func evalJsonAgg(t *testing.T, m *mpool.MPool, a Agg[any]) []string {
+	vec, err := a.Eval(m)
+	require.NoError(t, err)
+	ret := make([]string, vec.Length())
+	for i := range ret {
+		if vec.GetNulls().Contains(uint64(i)) {
+			ret[i] = "NULL"
+			continue
+		}
+		ret[i] = types.DecodeJson(vec.GetBytesAt(i)).String()
+	}
+	return ret
+}
+
+func TestJsonArrayAgg(t *testing.T) {
+	m := mpool.MustNewZeroNoFixed()
+
+	a := fillJsonAgg(t, m, AggregateJsonArrayAgg, []string{`[1]`, `[null]`, `["a"]`})
+	b := fillJsonAgg(t, m, AggregateJsonArrayAgg, []string{`[{"x": 2}]`})
+	require.NoError(t, a.Merge(b, 0, 0))
+	require.NoError(t, a.Merge(b, 1, 1))
+	require.Equal(t, []string{`[1, null, "a", {"x": 2}]`, "NULL"}, evalJsonAgg(t, m, a))
+
+	a = fillJsonAgg(t, m, AggregateJsonArrayAgg, []string{`[1]`, `[2]`})
+	data, err := a.MarshalBinary()
+	require.NoError(t, err)
+	c, err := New(AggregateJsonArrayAgg, false, types.T_json.ToType())
+	require.NoError(t, err)
+	require.NoError(t, c.UnmarshalBinary(data))
+	require.NoError(t, c.Merge(b, 0, 0))
+	require.Equal(t, []string{`[1, 2, {"x": 2}]`, "NULL"}, evalJsonAgg(t, m, c))
+}
+
+func TestJsonObjectAgg(t *testing.T) {
+	m := mpool.MustNewZeroNoFixed()
+
+	a := fillJsonAgg(t, m, AggregateJsonObjectAgg, []string{`{"a": 1}`, `{"b": null}`, `{"a": 3}`})
+	b := fillJsonAgg(t, m, AggregateJsonObjectAgg, []string{`{"c": [1]}`})
+	require.NoError(t, a.Merge(b, 0, 0))
+	require.Equal(t, []string{`{"a": 3, "b": null, "c": [1]}`, "NULL"}, evalJsonAgg(t, m, a))
+
+	a = fillJsonAgg(t, m, AggregateJsonObjectAgg, []string{`{"a": 1}`})
+	data, err := a.MarshalBinary()
+	require.NoError(t, err)
+	c, err := New(AggregateJsonObjectAgg, false, types.T_json.ToType())
+	require.NoError(t, err)
+	require.NoError(t, c.UnmarshalBinary(data))
+	require.NoError(t, c.Merge(b, 0, 0))
+	require.Equal(t, []string{`{"a": 1, "c": [1]}`, "NULL"}, evalJsonAgg(t, m, c))
+}
@@ -54,6 +54,14 @@ func ReturnType(op int, typ types.Type) (types.Type, error) {
 		otyp = RowNumberReturnType()
 	case WinDenseRank:
 		otyp = DenseRankReturnType()
+	case WinLag, WinLead, WinFirstValue, WinLastValue, WinNthValue:
+		otyp = typ
+	case WinNtile:
+		otyp = NtileReturnType()
+	case WinPercentRank:
+		otyp = PercentRankReturnType()
+	case WinCumeDist:
+		otyp = CumeDistReturnType()
 	}
 	if otyp.Oid == types.T_any {
 		return typ, moerr.NewInternalErrorNoCtx("'%v' not support %s", typ, Names[op])
@@ -97,6 +105,10 @@ func NewWithConfig(op int, dist bool, typ types.Type, config any) (Agg[any], err
 		return newMedian(typ, dist), nil
 	case AggregateGroupConcat:
 		return NewGroupConcat(typ, dist, config), nil
+	case AggregateJsonArrayAgg:
+		return newJsonArrayAgg(typ, dist), nil
+	case AggregateJsonObjectAgg:
+		return newJsonObjectAgg(typ, dist), nil
 	case WinRank:
 		r := NewRank()
 		return NewUnaryAgg(WinRank, r, false, typ, RankReturnType(), r.Grows, r.Eval, r.Merge, r.Fill, nil), nil
@@ -106,6 +118,17 @@ func NewWithConfig(op int, dist bool, typ types.Type, config any) (Agg[any], err
 	case WinDenseRank:
 		r := NewDenseRank()
 		return NewUnaryAgg(WinDenseRank, r, false, typ, DenseRankReturnType(), r.Grows, r.Eval, r.Merge, r.Fill, nil), nil
+	case WinLag, WinLead, WinFirstValue, WinLastValue, WinNthValue:
+		return newWinValue(op, typ), nil
+	case WinNtile:
+		r := NewNtile(config)
+		return NewUnaryAgg(WinNtile, r, false, typ, NtileReturnType(), r.Grows, r.Eval, r.Merge, r.Fill, nil), nil
+	case WinPercentRank:
+		r := NewPercentRank()
+		return NewUnaryAgg(WinPercentRank, r, false, typ, PercentRankReturnType(), r.Grows, r.Eval, r.Merge, r.Fill, nil), nil
+	case WinCumeDist:
+		r := NewCumeDist()
+		return NewUnaryAgg(WinCumeDist, r, false, typ, CumeDistReturnType(), r.Grows, r.Eval, r.Merge, r.Fill, nil), nil
 	}
 	panic(moerr.NewInternalErrorNoCtx("unsupported type '%s' for aggregate %s", typ, Names[op]))
 }
@@ -0,0 +1,90 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// Ntile divides every partition into N buckets as equal as possible, and the
+// first size % N buckets get one more row.
+type Ntile struct {
+	N  int64
+	Ps [][]int64
+}
+
+func NtileReturnType() types.Type {
+	return types.New(types.T_uint64, 0, 0)
+}
+
+// NewNtile returns the ntile agg, the number of buckets is encoded in config.
+func NewNtile(config any) *Ntile {
+	r := &Ntile{N: 1}
+	if data, ok := config.([]byte); ok && len(data) == 8 {
+		r.N = types.DecodeInt64(data)
+	}
+	return r
+}
+
+func (r *Ntile) Grows(_ int) {}
+
+func (r *Ntile) Eval(vs []uint64, err error) ([]uint64, error) {
+	idx := 0
+	for _, p := range r.Ps {
+		if len(p) == 0 {
+			continue
+		}
+		size := p[len(p)-1] - p[0]
+		q, m := size/r.N, size%r.N
+		for i := int64(0); i < size; i++ {
+			// the first m buckets hold q+1 rows
+			if i < m*(q+1) {
+				vs[idx] = uint64(i/(q+1) + 1)
+			} else {
+				vs[idx] = uint64(m + (i-m*(q+1))/q + 1)
+			}
+			idx++
+		}
+	}
+	return vs, nil
+}
+
+func (r *Ntile) Fill(i int64, value int64, ov uint64, z int64, isEmpty bool, isNull bool) (uint64, bool, error) {
+	for int(i) >= len(r.Ps) {
+		r.Ps = append(r.Ps, []int64{})
+	}
+	r.Ps[i] = append(r.Ps[i], value)
+	return 0, false, nil
+}
+
+func (r *Ntile) Merge(xIndex int64, yIndex int64, x uint64, y uint64, xEmpty bool, yEmpty bool, yNtile any) (uint64, bool, error) {
+	return 0, false, nil
+}
+
+func (r *Ntile) BatchFill(rs, vs any, start, count int64, vps []uint64, zs []int64, nsp *nulls.Nulls) error {
+	return nil
+}
+
+func (r *Ntile) MarshalBinary() ([]byte, error) {
+	return types.EncodeSlice(r.Ps), nil
+}
+
+func (r *Ntile) UnmarshalBinary(data []byte) error {
+	copyData := make([]byte, len(data))
+	copy(copyData, data)
+	r.Ps = types.DecodeSlice[[]int64](copyData)
+	return nil
+}
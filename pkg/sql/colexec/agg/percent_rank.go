@@ -0,0 +1,84 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// PercentRank returns (rank - 1) / (rows of partition - 1) of every row,
+// and 0 for the partition with only one row.
+type PercentRank struct {
+	Ps [][]int64
+}
+
+func PercentRankReturnType() types.Type {
+	return types.New(types.T_float64, 0, 0)
+}
+
+func NewPercentRank() *PercentRank {
+	return &PercentRank{}
+}
+
+func (r *PercentRank) Grows(_ int) {}
+
+func (r *PercentRank) Eval(vs []float64, err error) ([]float64, error) {
+	idx := 0
+	for _, p := range r.Ps {
+		if len(p) == 0 {
+			continue
+		}
+		size := p[len(p)-1] - p[0]
+		for i := 1; i < len(p); i++ {
+			var v float64
+			if size > 1 {
+				v = float64(p[i-1]-p[0]) / float64(size-1)
+			}
+			for t := p[i-1]; t < p[i]; t++ {
+				vs[idx] = v
+				idx++
+			}
+		}
+	}
+	return vs, nil
+}
+
+func (r *PercentRank) Fill(i int64, value int64, ov float64, z int64, isEmpty bool, isNull bool) (float64, bool, error) {
+	for int(i) >= len(r.Ps) {
+		r.Ps = append(r.Ps, []int64{})
+	}
+	r.Ps[i] = append(r.Ps[i], value)
+	return 0, false, nil
+}
+
+func (r *PercentRank) Merge(xIndex int64, yIndex int64, x float64, y float64, xEmpty bool, yEmpty bool, yPercentRank any) (float64, bool, error) {
+	return 0, false, nil
+}
+
+func (r *PercentRank) BatchFill(rs, vs any, start, count int64, vps []uint64, zs []int64, nsp *nulls.Nulls) error {
+	return nil
+}
+
+func (r *PercentRank) MarshalBinary() ([]byte, error) {
+	return types.EncodeSlice(r.Ps), nil
+}
+
+func (r *PercentRank) UnmarshalBinary(data []byte) error {
+	copyData := make([]byte, len(data))
+	copy(copyData, data)
+	r.Ps = types.DecodeSlice[[]int64](copyData)
+	return nil
+}
@@ -44,17 +44,28 @@ const (
 	AggregateAnyValue
 	AggregateMedian
 	AggregateGroupConcat
+	AggregateJsonArrayAgg
+	AggregateJsonObjectAgg
 
 	WinRank
 	WinRowNumber
 	WinDenseRank
+	WinLag
+	WinLead
+	WinFirstValue
+	WinLastValue
+	WinNthValue
+	WinNtile
+	WinPercentRank
+	WinCumeDist
 )
 
 // TODO: It's a bad hack here, I will fix it later.
 // remove the special id from agg framwork is a better way.
 // just put these code here for now because I have no enough time to solve the import cycle problem.
 func GetFunctionIsWinOrderFunBySpecialId(id int) bool {
-	return id == WinRank || id == WinRowNumber || id == WinDenseRank
+	return id == WinRank || id == WinRowNumber || id == WinDenseRank ||
+		id == WinNtile || id == WinPercentRank || id == WinCumeDist
 }
 
 const (
@@ -77,10 +88,20 @@ var Names = [...]string{
 	AggregateAnyValue:            "any",
 	AggregateMedian:              "median",
 	AggregateGroupConcat:         "group_concat",
-
-	WinRank:      "rank",
-	WinRowNumber: "row_number",
-	WinDenseRank: "dense_rank",
+	AggregateJsonArrayAgg:        "json_arrayagg",
+	AggregateJsonObjectAgg:       "json_objectagg",
+
+	WinRank:        "rank",
+	WinRowNumber:   "row_number",
+	WinDenseRank:   "dense_rank",
+	WinLag:         "lag",
+	WinLead:        "lead",
+	WinFirstValue:  "first_value",
+	WinLastValue:   "last_value",
+	WinNthValue:    "nth_value",
+	WinNtile:       "ntile",
+	WinPercentRank: "percent_rank",
+	WinCumeDist:    "cume_dist",
 }
 
 type Aggregate struct {
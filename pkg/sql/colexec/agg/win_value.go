@@ -0,0 +1,93 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agg
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var WinValueSupported = []types.T{
+	types.T_bool,
+	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	types.T_float32, types.T_float64,
+	types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
+	types.T_blob, types.T_text, types.T_json,
+	types.T_date, types.T_datetime, types.T_time, types.T_timestamp,
+	types.T_enum,
+	types.T_decimal64, types.T_decimal128,
+	types.T_uuid,
+}
+
+// newWinValue returns the agg of the value functions of window, which are lag,
+// lead, first_value, last_value and nth_value. The window operator fills at
+// most one row into every group, so the any value agg is enough to keep it.
+func newWinValue(op int, typ types.Type) Agg[any] {
+	switch typ.Oid {
+	case types.T_bool:
+		return newGenericWinValue[bool](op, typ)
+	case types.T_int8:
+		return newGenericWinValue[int8](op, typ)
+	case types.T_int16:
+		return newGenericWinValue[int16](op, typ)
+	case types.T_int32:
+		return newGenericWinValue[int32](op, typ)
+	case types.T_int64:
+		return newGenericWinValue[int64](op, typ)
+	case types.T_uint8:
+		return newGenericWinValue[uint8](op, typ)
+	case types.T_uint16:
+		return newGenericWinValue[uint16](op, typ)
+	case types.T_uint32:
+		return newGenericWinValue[uint32](op, typ)
+	case types.T_uint64:
+		return newGenericWinValue[uint64](op, typ)
+	case types.T_float32:
+		return newGenericWinValue[float32](op, typ)
+	case types.T_float64:
+		return newGenericWinValue[float64](op, typ)
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary,
+		types.T_blob, types.T_text, types.T_json:
+		return newStrWinValue(op, typ)
+	case types.T_date:
+		return newGenericWinValue[types.Date](op, typ)
+	case types.T_datetime:
+		return newGenericWinValue[types.Datetime](op, typ)
+	case types.T_time:
+		return newGenericWinValue[types.Time](op, typ)
+	case types.T_timestamp:
+		return newGenericWinValue[types.Timestamp](op, typ)
+	case types.T_enum:
+		return newGenericWinValue[types.Enum](op, typ)
+	case types.T_decimal64:
+		return newGenericWinValue[types.Decimal64](op, typ)
+	case types.T_decimal128:
+		return newGenericWinValue[types.Decimal128](op, typ)
+	case types.T_uuid:
+		return newGenericWinValue[types.Uuid](op, typ)
+	}
+	panic(moerr.NewInternalErrorNoCtx("unsupported type '%s' for %s", typ, Names[op]))
+}
+
+func newGenericWinValue[T any](op int, typ types.Type) Agg[any] {
+	aggPriv := NewAnyValue[T]()
+	return NewUnaryAgg(op, aggPriv, false, typ, AnyValueReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+}
+
+func newStrWinValue(op int, typ types.Type) Agg[any] {
+	aggPriv := NewStrAnyValue()
+	return NewUnaryAgg(op, aggPriv, false, typ, AnyValueReturnType([]types.Type{typ}), aggPriv.Grows, aggPriv.Eval, aggPriv.Merge, aggPriv.Fill, nil)
+}
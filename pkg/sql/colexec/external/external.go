@@ -32,6 +32,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/morpc"
@@ -139,7 +140,7 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (p
 		proc.SetInputBatch(nil)
 		return process.ExecStop, nil
 	}
-	if param.plh == nil {
+	if param.plh == nil && param.parqh == nil {
 		if param.Fileparam.FileIndex >= len(param.FileList) {
 			proc.SetInputBatch(nil)
 			return process.ExecStop, nil
@@ -379,6 +380,8 @@ func GetCompressType(param *tree.ExternParam, filepath string) string {
 		return tree.BZIP2
 	case "lz4":
 		return tree.LZ4
+	case "zst", "zstd":
+		return tree.ZSTD
 	default:
 		return tree.NOCOMPRESS
 	}
@@ -398,6 +401,12 @@ func getUnCompressReader(param *tree.ExternParam, filepath string, r io.ReadClos
 		return zlib.NewReader(r)
 	case tree.LZ4:
 		return io.NopCloser(lz4.NewReader(r)), nil
+	case tree.ZSTD, tree.ZST:
+		dec, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
 	case tree.LZW:
 		return nil, moerr.NewInternalError(param.Ctx, "the compress type '%s' is not support now", param.CompressType)
 	default:
@@ -734,6 +743,8 @@ func scanZonemapFile(ctx context.Context, param *ExternalParam, proc *process.Pr
 func scanFileData(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
 	if param.Extern.QueryResult {
 		return scanZonemapFile(ctx, param, proc)
+	} else if param.Extern.Format == tree.PARQUET {
+		return scanParquetFile(ctx, param, proc)
 	} else {
 		return scanCsvFile(ctx, param, proc)
 	}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -232,6 +233,10 @@ func Test_getCompressType(t *testing.T) {
 		compress = GetCompressType(param, param.Filepath)
 		convey.So(compress, convey.ShouldEqual, tree.LZ4)
 
+		param.Filepath = "a.zst"
+		compress = GetCompressType(param, param.Filepath)
+		convey.So(compress, convey.ShouldEqual, tree.ZSTD)
+
 		param.Filepath = "a.csv"
 		compress = GetCompressType(param, param.Filepath)
 		convey.So(compress, convey.ShouldEqual, tree.NOCOMPRESS)
@@ -271,6 +276,11 @@ func Test_getUnCompressReader(t *testing.T) {
 		convey.So(read, convey.ShouldNotBeNil)
 		convey.So(err, convey.ShouldBeNil)
 
+		param.CompressType = tree.ZSTD
+		read, err = getUnCompressReader(param, param.Filepath, io.NopCloser(bytes.NewReader(nil)))
+		convey.So(read, convey.ShouldNotBeNil)
+		convey.So(err, convey.ShouldBeNil)
+
 		param.CompressType = tree.LZW
 		read, err = getUnCompressReader(param, param.Filepath, &os.File{})
 		convey.So(read, convey.ShouldBeNil)
@@ -0,0 +1,770 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"io"
+	"math"
+	"math/big"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/external/parquet"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/util/errutil"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// julian day number of 1970-01-01, used by the legacy INT96 timestamps
+	julianDayOfUnixEpoch = 2440588
+	// loadLocalDir is the directory of the local file service holding the
+	// parquet files sent by LOAD DATA LOCAL
+	loadLocalDir = "load_local"
+	// parquetReadValues is the number of values read from a column at once
+	parquetReadValues = 512
+)
+
+// ParquetHandler keeps the state of the parquet file being scanned. The
+// column chunks of the projected columns are read in whole when a row group
+// starts, and the row group is returned in several batches.
+type ParquetHandler struct {
+	file *parquet.File
+	// fs and path locate the file being scanned
+	fs   fileservice.FileService
+	path string
+	// spilled is true if the file is a copy of a LOAD DATA LOCAL stream in
+	// the local file service, it is deleted once scanned
+	spilled bool
+	// leaf column of every attr, nil for the columns that are not in the file
+	cols []*parquet.Column
+	// convs converts the values of the leaf column to the attr type
+	convs []parquetConverter
+	// readers of the column chunks of the current row group
+	readers []*parquet.ColumnReader
+	values  []parquet.Value
+	// next row group to read
+	rowGroup int
+	// rows left in the current row group
+	rows int64
+}
+
+// parquetConverter converts a non-null parquet value to the go value
+// accepted by vector.AppendAny for the target type.
+type parquetConverter func(v parquet.Value) (any, error)
+
+// parquetRowGroupMeta exposes the statistics of one row group as zone maps
+// indexed by the column position in ExternalParam.Cols.
+type parquetRowGroupMeta []objectio.ColumnMeta
+
+func (m parquetRowGroupMeta) MustGetColumn(seqnum uint16) objectio.ColumnMeta {
+	return m[seqnum]
+}
+
+// parquetReaderAt reads the footer of the file from the file service.
+type parquetReaderAt struct {
+	ctx  context.Context
+	fs   fileservice.FileService
+	path string
+}
+
+func (r *parquetReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	vec := fileservice.IOVector{
+		FilePath: r.path,
+		Entries: []fileservice.IOEntry{
+			0: {
+				Offset: off,
+				Size:   int64(len(p)),
+				Data:   p,
+			},
+		},
+		CachePolicy: fileservice.SkipAll,
+	}
+	if err := r.fs.Read(r.ctx, &vec); err != nil {
+		return 0, err
+	}
+	return copy(p, vec.Entries[0].Data), nil
+}
+
+// spillLoadLocalFile copies the file sent by LOAD DATA LOCAL to the local
+// file service, as a parquet file is read from its footer.
+func spillLoadLocalFile(proc *process.Process) (fileservice.FileService, string, int64, error) {
+	fs, err := fileservice.Get[fileservice.FileService](proc.FileService, defines.LocalFileServiceName)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	name := path.Join(loadLocalDir, proc.Id, uuid.NewString())
+	err = fs.Write(proc.Ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset:         0,
+				Size:           -1,
+				ReaderForWrite: proc.LoadLocalReader,
+			},
+		},
+		CachePolicy: fileservice.SkipAll,
+	})
+	if err != nil {
+		return nil, "", 0, err
+	}
+	entry, err := fs.StatFile(proc.Ctx, name)
+	if err != nil {
+		colexec.DeleteSpillFiles(proc, []string{name})
+		return nil, "", 0, err
+	}
+	return fs, name, entry.Size, nil
+}
+
+func newParquetHandler(param *ExternalParam, proc *process.Process) (h *ParquetHandler, err error) {
+	h = &ParquetHandler{
+		cols:   make([]*parquet.Column, len(param.Attrs)),
+		convs:  make([]parquetConverter, len(param.Attrs)),
+		values: make([]parquet.Value, parquetReadValues),
+	}
+	var size int64
+	if param.Extern.Local {
+		h.fs, h.path, size, err = spillLoadLocalFile(proc)
+		if err != nil {
+			return nil, err
+		}
+		h.spilled = true
+		defer func() {
+			if err != nil {
+				h.close(proc)
+			}
+		}()
+	} else {
+		var fs fileservice.ETLFileService
+		fs, h.path, err = plan2.GetForETLWithType(param.Extern, param.Fileparam.Filepath)
+		if err != nil {
+			return nil, err
+		}
+		h.fs = fs
+		if param.Fileparam.FileIndex-1 < len(param.FileSize) {
+			size = param.FileSize[param.Fileparam.FileIndex-1]
+		} else {
+			entry, err := fs.StatFile(param.Ctx, h.path)
+			if err != nil {
+				return nil, err
+			}
+			size = entry.Size
+		}
+	}
+
+	h.file, err = parquet.OpenFile(&parquetReaderAt{ctx: param.Ctx, fs: h.fs, path: h.path}, size)
+	if err != nil {
+		return nil, moerr.NewInvalidInput(proc.Ctx, "'%s' is not a valid parquet file: %s", param.Fileparam.Filepath, err.Error())
+	}
+	for i, attr := range param.Attrs {
+		if param.Cols[i].Hidden || catalog.ContainExternalHidenCol(attr) {
+			continue
+		}
+		col := lookupParquetColumn(h.file, attr)
+		if col == nil {
+			if isAccountIdCol(param, i) {
+				continue
+			}
+			return nil, moerr.NewInvalidInput(proc.Ctx, "column '%s' is not found in parquet file '%s'", attr, param.Fileparam.Filepath)
+		}
+		if col.Nested {
+			return nil, moerr.NewNotSupported(proc.Ctx, "parquet nested column '%s'", col.Name)
+		}
+		h.convs[i], err = getParquetConverter(proc.Ctx, col, makeType(param.Cols[i].Typ, false))
+		if err != nil {
+			return nil, err
+		}
+		h.cols[i] = col
+	}
+	return h, nil
+}
+
+func lookupParquetColumn(f *parquet.File, attr string) *parquet.Column {
+	for _, col := range f.Columns() {
+		if col.Name == attr {
+			return col
+		}
+	}
+	for _, col := range f.Columns() {
+		if strings.EqualFold(col.Name, attr) {
+			return col
+		}
+	}
+	return nil
+}
+
+func isAccountIdCol(param *ExternalParam, colIdx int) bool {
+	return param.ClusterTable != nil && param.ClusterTable.GetIsClusterTable() &&
+		int(param.ClusterTable.GetColumnIndexOfAccountId()) == colIdx
+}
+
+// scanParquetFile returns at most OneBatchMaxRow rows of the current row
+// group. The row groups whose statistics do not match the filter are skipped.
+func scanParquetFile(ctx context.Context, param *ExternalParam, proc *process.Process) (*batch.Batch, error) {
+	_, span := trace.Start(ctx, "scanParquetFile")
+	defer span.End()
+	var err error
+	if param.parqh == nil {
+		param.parqh, err = newParquetHandler(param, proc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	h := param.parqh
+	if h.rows == 0 {
+		h.readers = nil
+		if err = h.nextRowGroup(ctx, param, proc); err != nil {
+			return nil, err
+		}
+	}
+
+	bat := makeBatch(param, 0, proc)
+	n := 0
+	if h.rows > 0 {
+		n = OneBatchMaxRow
+		if int64(n) > h.rows {
+			n = int(h.rows)
+		}
+		if err = h.fillBatch(proc, param, bat, n); err != nil {
+			bat.Clean(proc.Mp())
+			return nil, err
+		}
+		h.rows -= int64(n)
+	}
+	bat.SetRowCount(n)
+
+	if h.rows == 0 && h.rowGroup >= len(h.file.RowGroups()) {
+		h.close(proc)
+		param.parqh = nil
+		param.Fileparam.FileFin++
+		if param.Fileparam.FileFin >= param.Fileparam.FileCnt {
+			param.Fileparam.End = true
+		}
+	}
+	return bat, nil
+}
+
+// nextRowGroup reads the column chunks of the projected columns in the next
+// row group that may satisfy the filter.
+func (h *ParquetHandler) nextRowGroup(ctx context.Context, param *ExternalParam, proc *process.Process) error {
+	rowGroups := h.file.RowGroups()
+	for ; h.rowGroup < len(rowGroups); h.rowGroup++ {
+		if !h.needRead(ctx, param, proc, h.rowGroup) {
+			continue
+		}
+		rg := &rowGroups[h.rowGroup]
+		data, err := h.readColumnChunks(proc.Ctx, rg)
+		if err != nil {
+			return err
+		}
+		h.readers = make([]*parquet.ColumnReader, len(h.cols))
+		for i, col := range h.cols {
+			if col == nil {
+				continue
+			}
+			h.readers[i], err = parquet.NewColumnReader(col, &rg.Columns[col.Index], data[col.Index])
+			if err != nil {
+				return err
+			}
+		}
+		h.rows = rg.NumRows
+		h.rowGroup++
+		return nil
+	}
+	return nil
+}
+
+// readColumnChunks reads the column chunks of the projected columns with one
+// request, and returns them indexed by the column index.
+func (h *ParquetHandler) readColumnChunks(ctx context.Context, rg *parquet.RowGroup) ([][]byte, error) {
+	data := make([][]byte, len(rg.Columns))
+	vec := fileservice.IOVector{
+		FilePath:    h.path,
+		CachePolicy: fileservice.SkipAll,
+	}
+	var indexes []int
+	for _, col := range h.cols {
+		if col == nil || data[col.Index] != nil {
+			continue
+		}
+		off, size := rg.Columns[col.Index].Range()
+		data[col.Index] = []byte{}
+		if size > 0 {
+			vec.Entries = append(vec.Entries, fileservice.IOEntry{Offset: off, Size: size})
+			indexes = append(indexes, col.Index)
+		}
+	}
+	if len(vec.Entries) == 0 {
+		return data, nil
+	}
+	sort.Sort(&chunkEntries{entries: vec.Entries, indexes: indexes})
+	if err := h.fs.Read(ctx, &vec); err != nil {
+		return nil, err
+	}
+	for i, entry := range vec.Entries {
+		data[indexes[i]] = entry.Data
+	}
+	return data, nil
+}
+
+// chunkEntries sorts the entries of the column chunks by offset, keeping
+// the column index of each.
+type chunkEntries struct {
+	entries []fileservice.IOEntry
+	indexes []int
+}
+
+func (c *chunkEntries) Len() int {
+	return len(c.entries)
+}
+
+func (c *chunkEntries) Less(i, j int) bool {
+	return c.entries[i].Offset < c.entries[j].Offset
+}
+
+func (c *chunkEntries) Swap(i, j int) {
+	c.entries[i], c.entries[j] = c.entries[j], c.entries[i]
+	c.indexes[i], c.indexes[j] = c.indexes[j], c.indexes[i]
+}
+
+func (h *ParquetHandler) fillBatch(proc *process.Process, param *ExternalParam, bat *batch.Batch, n int) error {
+	mp := proc.GetMPool()
+	filepath := []byte(param.Fileparam.Filepath)
+	for i, vec := range bat.Vecs {
+		if h.readers == nil || h.readers[i] == nil {
+			isFilepath := catalog.ContainExternalHidenCol(param.Attrs[i])
+			for j := 0; j < n; j++ {
+				if err := vector.AppendBytes(vec, filepath, !isFilepath, mp); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		conv := h.convs[i]
+		for left := n; left > 0; {
+			values := h.values
+			if left < len(values) {
+				values = values[:left]
+			}
+			cnt, err := h.readers[i].Read(values)
+			if err == io.EOF {
+				return moerr.NewInvalidInput(proc.Ctx, "parquet column chunk has less values than rows")
+			}
+			if err != nil {
+				return err
+			}
+			for _, v := range values[:cnt] {
+				if v.IsNull() {
+					if err = vector.AppendAny(vec, nil, true, mp); err != nil {
+						return err
+					}
+					continue
+				}
+				val, err := conv(v)
+				if err != nil {
+					return moerr.NewInvalidInput(proc.Ctx, "parquet column '%s': %s", param.Attrs[i], err.Error())
+				}
+				if err = vector.AppendAny(vec, val, false, mp); err != nil {
+					return err
+				}
+			}
+			left -= cnt
+		}
+	}
+	return nil
+}
+
+// close releases the column chunks and deletes the copy of a LOAD DATA
+// LOCAL file.
+func (h *ParquetHandler) close(proc *process.Process) {
+	h.readers = nil
+	if h.spilled {
+		colexec.DeleteSpillFiles(proc, []string{h.path})
+		h.spilled = false
+	}
+}
+
+// needRead checks the filter against the statistics of the row group. It
+// only prunes when every column of the filter has statistics, as a missing
+// zone map would make the filter evaluate to false.
+func (h *ParquetHandler) needRead(ctx context.Context, param *ExternalParam, proc *process.Process, rowGroup int) bool {
+	_, span := trace.Start(ctx, "parquetNeedRead")
+	defer span.End()
+
+	expr := param.Filter.FilterExpr
+	if expr == nil || !param.Filter.exprMono || len(param.Filter.columnMap) == 0 {
+		return true
+	}
+	chunks := h.file.RowGroups()[rowGroup].Columns
+	meta := make(parquetRowGroupMeta, len(param.Cols))
+	for _, colIdx := range param.Filter.columnMap {
+		if colIdx >= len(h.cols) || h.cols[colIdx] == nil {
+			return true
+		}
+		col := h.cols[colIdx]
+		zm, ok := parquetZoneMap(col, h.convs[colIdx], &chunks[col.Index].MetaData.Statistics,
+			makeType(param.Cols[colIdx].Typ, false))
+		if !ok {
+			return true
+		}
+		meta[colIdx] = objectio.BuildColumnMeta()
+		meta[colIdx].SetZoneMap(zm)
+	}
+
+	notReportErrCtx := errutil.ContextWithNoReport(proc.Ctx, true)
+	cnt := plan2.AssignAuxIdForExpr(expr, 0)
+	zms := make([]objectio.ZoneMap, cnt)
+	vecs := make([]*vector.Vector, cnt)
+	return colexec.EvaluateFilterByZoneMap(notReportErrCtx, proc, expr, meta, param.Filter.columnMap, zms, vecs)
+}
+
+func parquetZoneMap(col *parquet.Column, conv parquetConverter, stats *parquet.Statistics, typ types.Type) (objectio.ZoneMap, bool) {
+	// the sort order of INT96 is undefined and json is not comparable
+	if col.Kind == parquet.Int96 || typ.Oid == types.T_json {
+		return nil, false
+	}
+	if stats.MinValue == nil || stats.MaxValue == nil {
+		return nil, false
+	}
+	zm := objectio.NewZM(typ.Oid, typ.Scale)
+	for _, b := range [][]byte{stats.MinValue, stats.MaxValue} {
+		v, ok := parquet.StatValue(col, b)
+		if !ok {
+			return nil, false
+		}
+		val, err := conv(v)
+		if err != nil {
+			return nil, false
+		}
+		index.UpdateZM(zm, types.EncodeValue(val, typ.Oid))
+	}
+	return zm, true
+}
+
+// getParquetConverter maps the physical and logical type of the parquet
+// column to the type of the table column.
+func getParquetConverter(ctx context.Context, col *parquet.Column, typ types.Type) (parquetConverter, error) {
+	kind := col.Kind
+	lt := col.LogicalType
+	if lt == nil {
+		lt = &parquet.LogicalType{}
+	}
+	isInteger := (kind == parquet.Int32 || kind == parquet.Int64) && lt.Decimal == nil &&
+		!lt.Date && lt.Time == nil && lt.Timestamp == nil
+	isUnsigned := lt.Integer != nil && !lt.Integer.IsSigned
+	isBytes := kind == parquet.ByteArray || kind == parquet.FixedLenByteArray
+
+	notSupported := func() (parquetConverter, error) {
+		return nil, moerr.NewNotSupported(ctx, "load parquet column '%s' of type %s into %s", col.Name, col.String(), typ.String())
+	}
+
+	switch typ.Oid {
+	case types.T_bool:
+		if kind != parquet.Boolean {
+			return notSupported()
+		}
+		return func(v parquet.Value) (any, error) { return v.Boolean(), nil }, nil
+
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		if !isInteger {
+			return notSupported()
+		}
+		bits := typ.Oid.FixedLength() * 8
+		return func(v parquet.Value) (any, error) {
+			var x int64
+			if isUnsigned {
+				u := parquetUint(v)
+				if u > math.MaxInt64 {
+					return nil, moerr.NewOutOfRangeNoCtx(typ.String(), "value '%d'", u)
+				}
+				x = int64(u)
+			} else {
+				x = parquetInt(v)
+			}
+			if bits < 64 && (x < -(1<<(bits-1)) || x >= 1<<(bits-1)) {
+				return nil, moerr.NewOutOfRangeNoCtx(typ.String(), "value '%d'", x)
+			}
+			switch typ.Oid {
+			case types.T_int8:
+				return int8(x), nil
+			case types.T_int16:
+				return int16(x), nil
+			case types.T_int32:
+				return int32(x), nil
+			default:
+				return x, nil
+			}
+		}, nil
+
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		if !isInteger {
+			return notSupported()
+		}
+		bits := typ.Oid.FixedLength() * 8
+		return func(v parquet.Value) (any, error) {
+			var x uint64
+			if isUnsigned {
+				x = parquetUint(v)
+			} else {
+				i := parquetInt(v)
+				if i < 0 {
+					return nil, moerr.NewOutOfRangeNoCtx(typ.String(), "value '%d'", i)
+				}
+				x = uint64(i)
+			}
+			if bits < 64 && x >= 1<<bits {
+				return nil, moerr.NewOutOfRangeNoCtx(typ.String(), "value '%d'", x)
+			}
+			switch typ.Oid {
+			case types.T_uint8:
+				return uint8(x), nil
+			case types.T_uint16:
+				return uint16(x), nil
+			case types.T_uint32:
+				return uint32(x), nil
+			default:
+				return x, nil
+			}
+		}, nil
+
+	case types.T_float32, types.T_float64:
+		var f func(v parquet.Value) float64
+		switch {
+		case kind == parquet.Float:
+			f = func(v parquet.Value) float64 { return float64(v.Float()) }
+		case kind == parquet.Double:
+			f = func(v parquet.Value) float64 { return v.Double() }
+		case isInteger && isUnsigned:
+			f = func(v parquet.Value) float64 { return float64(parquetUint(v)) }
+		case isInteger:
+			f = func(v parquet.Value) float64 { return float64(parquetInt(v)) }
+		case lt.Decimal != nil:
+			scale := lt.Decimal.Scale
+			f = func(v parquet.Value) float64 {
+				x, _ := strconv.ParseFloat(parquetDecimalString(v, scale), 64)
+				return x
+			}
+		default:
+			return notSupported()
+		}
+		if typ.Oid == types.T_float32 {
+			return func(v parquet.Value) (any, error) { return float32(f(v)), nil }, nil
+		}
+		return func(v parquet.Value) (any, error) { return f(v), nil }, nil
+
+	case types.T_decimal64, types.T_decimal128:
+		var s func(v parquet.Value) string
+		switch {
+		case lt.Decimal != nil:
+			scale := lt.Decimal.Scale
+			s = func(v parquet.Value) string { return parquetDecimalString(v, scale) }
+		case isInteger && isUnsigned:
+			s = func(v parquet.Value) string { return strconv.FormatUint(parquetUint(v), 10) }
+		case isInteger:
+			s = func(v parquet.Value) string { return strconv.FormatInt(parquetInt(v), 10) }
+		case kind == parquet.Float:
+			s = func(v parquet.Value) string { return strconv.FormatFloat(float64(v.Float()), 'f', -1, 32) }
+		case kind == parquet.Double:
+			s = func(v parquet.Value) string { return strconv.FormatFloat(v.Double(), 'f', -1, 64) }
+		default:
+			return notSupported()
+		}
+		if typ.Oid == types.T_decimal64 {
+			return func(v parquet.Value) (any, error) { return types.ParseDecimal64(s(v), typ.Width, typ.Scale) }, nil
+		}
+		return func(v parquet.Value) (any, error) { return types.ParseDecimal128(s(v), typ.Width, typ.Scale) }, nil
+
+	case types.T_date:
+		switch {
+		case lt.Date:
+			epoch := types.DateFromCalendar(1970, 1, 1)
+			return func(v parquet.Value) (any, error) { return epoch + types.Date(v.Int32()), nil }, nil
+		case lt.Timestamp != nil || kind == parquet.Int96:
+			micros := parquetTimestampMicros(kind, lt)
+			return func(v parquet.Value) (any, error) {
+				return types.Datetime(types.UnixMicroToTimestamp(micros(v))).ToDate(), nil
+			}, nil
+		}
+		return notSupported()
+
+	case types.T_time:
+		if lt.Time == nil {
+			return notSupported()
+		}
+		div, mul := parquetTimeUnit(lt.Time.Unit)
+		return func(v parquet.Value) (any, error) {
+			return types.Time(parquetInt(v) / div * mul), nil
+		}, nil
+
+	case types.T_datetime, types.T_timestamp:
+		var micros func(v parquet.Value) int64
+		switch {
+		case lt.Timestamp != nil || kind == parquet.Int96:
+			micros = parquetTimestampMicros(kind, lt)
+		case lt.Date:
+			micros = func(v parquet.Value) int64 { return int64(v.Int32()) * 86400 * 1000000 }
+		default:
+			return notSupported()
+		}
+		if typ.Oid == types.T_datetime {
+			return func(v parquet.Value) (any, error) {
+				return types.Datetime(types.UnixMicroToTimestamp(micros(v))), nil
+			}, nil
+		}
+		return func(v parquet.Value) (any, error) { return types.UnixMicroToTimestamp(micros(v)), nil }, nil
+
+	case types.T_uuid:
+		if !lt.UUID && !(kind == parquet.FixedLenByteArray && col.Length == 16) {
+			if kind != parquet.ByteArray {
+				return notSupported()
+			}
+			return func(v parquet.Value) (any, error) { return types.ParseUuid(string(v.ByteArray())) }, nil
+		}
+		return func(v parquet.Value) (any, error) {
+			var u types.Uuid
+			copy(u[:], v.ByteArray())
+			return u, nil
+		}, nil
+
+	case types.T_json:
+		if !isBytes {
+			return notSupported()
+		}
+		return func(v parquet.Value) (any, error) {
+			bj, err := types.ParseStringToByteJson(string(v.ByteArray()))
+			if err != nil {
+				return nil, err
+			}
+			return types.EncodeJson(bj)
+		}, nil
+
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		switch {
+		case isBytes && lt.Decimal == nil:
+			return func(v parquet.Value) (any, error) { return v.ByteArray(), nil }, nil
+		case lt.Decimal != nil:
+			scale := lt.Decimal.Scale
+			return func(v parquet.Value) (any, error) { return []byte(parquetDecimalString(v, scale)), nil }, nil
+		case kind == parquet.Boolean:
+			return func(v parquet.Value) (any, error) { return []byte(strconv.FormatBool(v.Boolean())), nil }, nil
+		case isInteger && isUnsigned:
+			return func(v parquet.Value) (any, error) { return []byte(strconv.FormatUint(parquetUint(v), 10)), nil }, nil
+		case isInteger:
+			return func(v parquet.Value) (any, error) { return []byte(strconv.FormatInt(parquetInt(v), 10)), nil }, nil
+		case kind == parquet.Float:
+			return func(v parquet.Value) (any, error) {
+				return []byte(strconv.FormatFloat(float64(v.Float()), 'g', -1, 32)), nil
+			}, nil
+		case kind == parquet.Double:
+			return func(v parquet.Value) (any, error) { return []byte(strconv.FormatFloat(v.Double(), 'g', -1, 64)), nil }, nil
+		}
+		return notSupported()
+	}
+	return notSupported()
+}
+
+func parquetInt(v parquet.Value) int64 {
+	if v.Kind() == parquet.Int32 {
+		return int64(v.Int32())
+	}
+	return v.Int64()
+}
+
+func parquetUint(v parquet.Value) uint64 {
+	if v.Kind() == parquet.Int32 {
+		return uint64(uint32(v.Int32()))
+	}
+	return uint64(v.Int64())
+}
+
+// parquetDecimalString formats the unscaled value of a DECIMAL, which is
+// either an integer or a big-endian two's complement byte array.
+func parquetDecimalString(v parquet.Value, scale int32) string {
+	var s string
+	switch v.Kind() {
+	case parquet.Int32, parquet.Int64:
+		s = strconv.FormatInt(parquetInt(v), 10)
+	default:
+		b := v.ByteArray()
+		x := new(big.Int).SetBytes(b)
+		if len(b) > 0 && b[0]&0x80 != 0 {
+			x.Sub(x, new(big.Int).Lsh(big.NewInt(1), uint(len(b)*8)))
+		}
+		s = x.String()
+	}
+	if scale <= 0 {
+		return s
+	}
+	neg := strings.HasPrefix(s, "-")
+	if neg {
+		s = s[1:]
+	}
+	if len(s) <= int(scale) {
+		s = strings.Repeat("0", int(scale)-len(s)+1) + s
+	}
+	s = s[:len(s)-int(scale)] + "." + s[len(s)-int(scale):]
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+// parquetTimeUnit returns the divisor and multiplier that convert the unit
+// to microseconds.
+func parquetTimeUnit(unit parquet.TimeUnit) (int64, int64) {
+	switch unit {
+	case parquet.Millis:
+		return 1, 1000
+	case parquet.Nanos:
+		return 1000, 1
+	default:
+		return 1, 1
+	}
+}
+
+// parquetTimestampMicros returns the microseconds since the unix epoch of a
+// TIMESTAMP or of a legacy INT96 timestamp.
+func parquetTimestampMicros(kind parquet.Kind, lt *parquet.LogicalType) func(v parquet.Value) int64 {
+	if kind == parquet.Int96 {
+		return func(v parquet.Value) int64 {
+			x := v.Int96()
+			nanos := int64(uint64(x[1])<<32 | uint64(x[0]))
+			days := int64(x[2]) - julianDayOfUnixEpoch
+			return days*86400*1000000 + nanos/1000
+		}
+	}
+	div, mul := parquetTimeUnit(lt.Timestamp.Unit)
+	return func(v parquet.Value) int64 {
+		return v.Int64() / div * mul
+	}
+}
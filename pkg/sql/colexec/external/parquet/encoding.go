@@ -0,0 +1,406 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+func errCorrupted(what string) error {
+	return moerr.NewInvalidInputNoCtx("corrupted parquet %s", what)
+}
+
+// decodeHybrid decodes n values of the RLE/bit-packed hybrid encoding of the
+// given bit width into out, and returns the number of bytes consumed.
+func decodeHybrid(b []byte, bitWidth int, out []uint32) (int, error) {
+	if bitWidth < 0 || bitWidth > 32 {
+		return 0, errCorrupted("bit width")
+	}
+	byteWidth := (bitWidth + 7) / 8
+	pos, i := 0, 0
+	for i < len(out) {
+		header, n := binary.Uvarint(b[pos:])
+		if n <= 0 {
+			return 0, errCorrupted("rle run")
+		}
+		pos += n
+		if header&1 == 0 {
+			count := header >> 1
+			if pos+byteWidth > len(b) {
+				return 0, errCorrupted("rle run")
+			}
+			var v uint32
+			for j := 0; j < byteWidth; j++ {
+				v |= uint32(b[pos+j]) << (8 * j)
+			}
+			pos += byteWidth
+			for ; count > 0 && i < len(out); count-- {
+				out[i] = v
+				i++
+			}
+			continue
+		}
+		groups := header >> 1
+		size := groups * uint64(bitWidth)
+		if size > uint64(len(b)-pos) {
+			return 0, errCorrupted("bit-packed run")
+		}
+		count := int(groups * 8)
+		if count > len(out)-i {
+			count = len(out) - i
+		}
+		unpackBits(b[pos:pos+int(size)], bitWidth, out[i:i+count])
+		i += count
+		pos += int(size)
+	}
+	return pos, nil
+}
+
+// unpackBits unpacks the values packed from the least significant bit.
+func unpackBits(b []byte, bitWidth int, out []uint32) {
+	if bitWidth == 0 {
+		for i := range out {
+			out[i] = 0
+		}
+		return
+	}
+	mask := uint64(1)<<bitWidth - 1
+	bit := 0
+	for i := range out {
+		var v uint64
+		// a value spans at most 5 bytes
+		for j, shift := bit/8, -(bit % 8); shift < bitWidth; j, shift = j+1, shift+8 {
+			if shift < 0 {
+				v |= uint64(b[j]) >> -shift
+			} else {
+				v |= uint64(b[j]) << shift
+			}
+		}
+		out[i] = uint32(v & mask)
+		bit += bitWidth
+	}
+}
+
+// unpackBits64 is unpackBits for the values of DELTA_BINARY_PACKED, which
+// are up to 64 bits wide.
+func unpackBits64(b []byte, bitWidth int, out []uint64) {
+	if bitWidth == 0 {
+		for i := range out {
+			out[i] = 0
+		}
+		return
+	}
+	bit := 0
+	for i := range out {
+		var v uint64
+		for j, shift := bit/8, -(bit % 8); shift < bitWidth; j, shift = j+1, shift+8 {
+			if shift < 0 {
+				v |= uint64(b[j]) >> -shift
+			} else {
+				v |= uint64(b[j]) << shift
+			}
+		}
+		if bitWidth < 64 {
+			v &= uint64(1)<<bitWidth - 1
+		}
+		out[i] = v
+		bit += bitWidth
+	}
+}
+
+// decodeDeltaBinaryPacked decodes n values of the DELTA_BINARY_PACKED
+// encoding, and returns the number of bytes consumed.
+func decodeDeltaBinaryPacked(b []byte, out []int64) (int, error) {
+	pos := 0
+	uvarint := func() (uint64, bool) {
+		v, n := binary.Uvarint(b[pos:])
+		if n <= 0 {
+			return 0, false
+		}
+		pos += n
+		return v, true
+	}
+	blockSize, ok1 := uvarint()
+	miniBlocks, ok2 := uvarint()
+	total, ok3 := uvarint()
+	first, ok4 := uvarint()
+	if !ok1 || !ok2 || !ok3 || !ok4 || miniBlocks == 0 || blockSize == 0 ||
+		blockSize%128 != 0 || blockSize%miniBlocks != 0 || (blockSize/miniBlocks)%32 != 0 ||
+		total < uint64(len(out)) {
+		return 0, errCorrupted("delta binary packed header")
+	}
+	if len(out) == 0 {
+		return pos, nil
+	}
+	miniBlockSize := int(blockSize / miniBlocks)
+	last := int64(first>>1) ^ -int64(first&1)
+	out[0] = last
+	buf := make([]uint64, miniBlockSize)
+	i := 1
+	for i < len(out) {
+		zz, ok := uvarint()
+		if !ok || uint64(len(b)-pos) < miniBlocks {
+			return 0, errCorrupted("delta binary packed block")
+		}
+		minDelta := int64(zz>>1) ^ -int64(zz&1)
+		widths := b[pos : pos+int(miniBlocks)]
+		pos += int(miniBlocks)
+		for _, w := range widths {
+			if i >= len(out) {
+				break
+			}
+			if w > 64 {
+				return 0, errCorrupted("delta binary packed bit width")
+			}
+			size := miniBlockSize * int(w) / 8
+			if size > len(b)-pos {
+				return 0, errCorrupted("delta binary packed miniblock")
+			}
+			unpackBits64(b[pos:pos+size], int(w), buf)
+			pos += size
+			for j := 0; j < miniBlockSize && i < len(out); j++ {
+				last += minDelta + int64(buf[j])
+				out[i] = last
+				i++
+			}
+		}
+	}
+	return pos, nil
+}
+
+// decodePlain decodes n PLAIN values of the column, and returns the number
+// of bytes consumed.
+func decodePlain(col *Column, b []byte, out []Value) (int, error) {
+	n := len(out)
+	kind := col.Kind
+	size := 0
+	switch kind {
+	case Boolean:
+		if (n+7)/8 > len(b) {
+			return 0, errCorrupted("boolean values")
+		}
+		for i := range out {
+			out[i] = Value{kind: kind, u64: uint64(b[i/8]>>(i%8)) & 1}
+		}
+		return (n + 7) / 8, nil
+	case Int32, Float:
+		size = 4
+	case Int64, Double:
+		size = 8
+	case Int96:
+		size = 12
+	case FixedLenByteArray:
+		size = col.Length
+	case ByteArray:
+		pos := 0
+		for i := range out {
+			if len(b)-pos < 4 {
+				return 0, errCorrupted("byte array values")
+			}
+			l := int(binary.LittleEndian.Uint32(b[pos:]))
+			pos += 4
+			if l < 0 || l > len(b)-pos {
+				return 0, errCorrupted("byte array values")
+			}
+			out[i] = Value{kind: kind, b: b[pos : pos+l : pos+l]}
+			pos += l
+		}
+		return pos, nil
+	}
+	if n*size > len(b) {
+		return 0, errCorrupted(kind.String() + " values")
+	}
+	for i := range out {
+		v := b[i*size : (i+1)*size : (i+1)*size]
+		switch kind {
+		case Int32, Float:
+			out[i] = Value{kind: kind, u64: uint64(binary.LittleEndian.Uint32(v))}
+		case Int64, Double:
+			out[i] = Value{kind: kind, u64: binary.LittleEndian.Uint64(v)}
+		default:
+			out[i] = Value{kind: kind, b: v}
+		}
+	}
+	return n * size, nil
+}
+
+// decodeDeltaLengthByteArray decodes n values of DELTA_LENGTH_BYTE_ARRAY,
+// and returns the number of bytes consumed.
+func decodeDeltaLengthByteArray(b []byte, out []Value) (int, error) {
+	lengths := make([]int64, len(out))
+	pos, err := decodeDeltaBinaryPacked(b, lengths)
+	if err != nil {
+		return 0, err
+	}
+	for i, l := range lengths {
+		if l < 0 || l > int64(len(b)-pos) {
+			return 0, errCorrupted("delta length byte array")
+		}
+		out[i] = Value{kind: ByteArray, b: b[pos : pos+int(l) : pos+int(l)]}
+		pos += int(l)
+	}
+	return pos, nil
+}
+
+// decodeDeltaByteArray decodes n values of DELTA_BYTE_ARRAY, each value is
+// a prefix of the previous one followed by a suffix.
+func decodeDeltaByteArray(col *Column, b []byte, out []Value) error {
+	prefixes := make([]int64, len(out))
+	pos, err := decodeDeltaBinaryPacked(b, prefixes)
+	if err != nil {
+		return err
+	}
+	if _, err = decodeDeltaLengthByteArray(b[pos:], out); err != nil {
+		return err
+	}
+	var last []byte
+	for i := range out {
+		p := prefixes[i]
+		if p < 0 || p > int64(len(last)) {
+			return errCorrupted("delta byte array")
+		}
+		suffix := out[i].b
+		v := make([]byte, 0, int(p)+len(suffix))
+		v = append(append(v, last[:p]...), suffix...)
+		if col.Kind == FixedLenByteArray && len(v) != col.Length {
+			return errCorrupted("delta byte array")
+		}
+		out[i] = Value{kind: col.Kind, b: v}
+		last = v
+	}
+	return nil
+}
+
+// decodeByteStreamSplit decodes n values whose k-th bytes are stored in the
+// k-th stream.
+func decodeByteStreamSplit(col *Column, b []byte, out []Value) error {
+	n := len(out)
+	var size int
+	switch col.Kind {
+	case Int32, Float:
+		size = 4
+	case Int64, Double:
+		size = 8
+	case FixedLenByteArray:
+		size = col.Length
+	default:
+		return moerr.NewNotSupportedNoCtx("BYTE_STREAM_SPLIT encoding of %s", col.Kind.String())
+	}
+	if n*size > len(b) {
+		return errCorrupted("byte stream split values")
+	}
+	v := make([]byte, n*size)
+	for i := 0; i < n; i++ {
+		for k := 0; k < size; k++ {
+			v[i*size+k] = b[k*n+i]
+		}
+	}
+	_, err := decodePlain(col, v, out)
+	return err
+}
+
+// decodeDictIndexes decodes the indexes of RLE_DICTIONARY values, which are
+// prefixed by their bit width.
+func decodeDictIndexes(b []byte, dict []Value, out []Value) error {
+	if len(b) == 0 {
+		if len(out) == 0 {
+			return nil
+		}
+		return errCorrupted("dictionary indexes")
+	}
+	idx := make([]uint32, len(out))
+	if _, err := decodeHybrid(b[1:], int(b[0]), idx); err != nil {
+		return err
+	}
+	for i, j := range idx {
+		if int(j) >= len(dict) {
+			return errCorrupted("dictionary indexes")
+		}
+		out[i] = dict[j]
+	}
+	return nil
+}
+
+// decodeRLEBooleans decodes the booleans of the RLE encoding, which are
+// prefixed by the size of the runs.
+func decodeRLEBooleans(b []byte, out []Value) error {
+	if len(b) < 4 {
+		return errCorrupted("boolean values")
+	}
+	size := binary.LittleEndian.Uint32(b)
+	if uint64(size) > uint64(len(b)-4) {
+		return errCorrupted("boolean values")
+	}
+	bits := make([]uint32, len(out))
+	if _, err := decodeHybrid(b[4:4+size], 1, bits); err != nil {
+		return err
+	}
+	for i, x := range bits {
+		out[i] = Value{kind: Boolean, u64: uint64(x)}
+	}
+	return nil
+}
+
+// decodeValues decodes the non-null values of a data page.
+func decodeValues(col *Column, encoding int32, b []byte, dict []Value, out []Value) error {
+	switch encoding {
+	case encodingPlain:
+		_, err := decodePlain(col, b, out)
+		return err
+	case encodingPlainDictionary, encodingRLEDictionary:
+		if dict == nil {
+			return errCorrupted("data page without dictionary")
+		}
+		return decodeDictIndexes(b, dict, out)
+	case encodingRLE:
+		if col.Kind != Boolean {
+			break
+		}
+		return decodeRLEBooleans(b, out)
+	case encodingDeltaBinaryPacked:
+		if col.Kind != Int32 && col.Kind != Int64 {
+			break
+		}
+		vals := make([]int64, len(out))
+		if _, err := decodeDeltaBinaryPacked(b, vals); err != nil {
+			return err
+		}
+		for i, v := range vals {
+			if col.Kind == Int32 {
+				// the deltas of INT32 wrap around in 32 bits
+				out[i] = Value{kind: Int32, u64: uint64(uint32(v))}
+			} else {
+				out[i] = Value{kind: Int64, u64: uint64(v)}
+			}
+		}
+		return nil
+	case encodingDeltaLengthByteArray:
+		if col.Kind != ByteArray {
+			break
+		}
+		_, err := decodeDeltaLengthByteArray(b, out)
+		return err
+	case encodingDeltaByteArray:
+		if col.Kind != ByteArray && col.Kind != FixedLenByteArray {
+			break
+		}
+		return decodeDeltaByteArray(col, b, out)
+	case encodingByteStreamSplit:
+		return decodeByteStreamSplit(col, b, out)
+	}
+	return moerr.NewNotSupportedNoCtx("parquet encoding %d of %s column '%s'", encoding, col.Kind.String(), col.Name)
+}
@@ -0,0 +1,285 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package parquet reads the columns of parquet files. It supports the flat
+// schemas loaded by LOAD DATA and external tables, the nested columns are
+// described by the schema but can not be read.
+package parquet
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	magic = "PAR1"
+	// footerSize is the size of the metadata length and the magic number
+	// at the end of the file
+	footerSize = 8
+	// tailReadSize is the size read from the end of the file to get the
+	// metadata in one read most of the time
+	tailReadSize = 64 << 10
+	// maxMetadataSize bounds the metadata read from the footer
+	maxMetadataSize = 256 << 20
+)
+
+// Column is a top level field of the schema.
+type Column struct {
+	Name string
+	// Kind is the physical type of a leaf column
+	Kind Kind
+	// Length is the size of the values of a FIXED_LEN_BYTE_ARRAY column
+	Length int
+	// LogicalType is nil if the column is not annotated, the legacy
+	// converted types are mapped to logical types.
+	LogicalType *LogicalType
+	// Index is the position of the chunk of the column in the row groups
+	Index int
+	// Nested is true for groups and repeated fields
+	Nested bool
+	// Optional is true if the values may be null
+	Optional bool
+}
+
+// String returns the physical and logical type of the column.
+func (c *Column) String() string {
+	if c.Nested {
+		return "GROUP"
+	}
+	s := c.Kind.String()
+	lt := c.LogicalType
+	if lt == nil {
+		return s
+	}
+	switch {
+	case lt.String:
+		s += "(STRING)"
+	case lt.Decimal != nil:
+		s += "(DECIMAL)"
+	case lt.Date:
+		s += "(DATE)"
+	case lt.Time != nil:
+		s += "(TIME)"
+	case lt.Timestamp != nil:
+		s += "(TIMESTAMP)"
+	case lt.Integer != nil:
+		s += "(INTEGER)"
+	case lt.JSON:
+		s += "(JSON)"
+	case lt.UUID:
+		s += "(UUID)"
+	}
+	return s
+}
+
+// File is the metadata of a parquet file.
+type File struct {
+	meta    *FileMetaData
+	columns []*Column
+}
+
+// OpenFile reads the metadata of the parquet file of the given size from
+// its footer.
+func OpenFile(r io.ReaderAt, size int64) (*File, error) {
+	if size < int64(len(magic)+footerSize) {
+		return nil, moerr.NewInvalidInputNoCtx("file is too small to be a parquet file")
+	}
+	n := int64(tailReadSize)
+	if n > size {
+		n = size
+	}
+	tail := make([]byte, n)
+	if _, err := r.ReadAt(tail, size-n); err != nil {
+		return nil, err
+	}
+	if string(tail[n-int64(len(magic)):]) != magic {
+		return nil, moerr.NewInvalidInputNoCtx("missing parquet magic number in the footer")
+	}
+	metaSize := int64(binary.LittleEndian.Uint32(tail[n-footerSize:]))
+	if metaSize > maxMetadataSize || metaSize > size-int64(len(magic)+footerSize) {
+		return nil, moerr.NewInvalidInputNoCtx("invalid parquet metadata size %d", metaSize)
+	}
+	var buf []byte
+	if metaSize <= n-footerSize {
+		buf = tail[n-footerSize-metaSize : n-footerSize]
+	} else {
+		buf = make([]byte, metaSize)
+		if _, err := r.ReadAt(buf, size-footerSize-metaSize); err != nil {
+			return nil, err
+		}
+	}
+
+	d := &thriftDecoder{b: buf}
+	meta := decodeFileMetaData(d)
+	if d.err != nil {
+		return nil, d.err
+	}
+	f := &File{meta: meta}
+	if err := f.buildColumns(); err != nil {
+		return nil, err
+	}
+	for i := range meta.RowGroups {
+		rg := &meta.RowGroups[i]
+		if len(rg.Columns) != f.numLeaves() {
+			return nil, moerr.NewInvalidInputNoCtx("row group %d has %d columns, expected %d",
+				i, len(rg.Columns), f.numLeaves())
+		}
+		for j := range rg.Columns {
+			cc := &rg.Columns[j]
+			if cc.FilePath != "" {
+				return nil, moerr.NewNotSupportedNoCtx("parquet column chunks in external files")
+			}
+			off, chunkSize := cc.Range()
+			if off < 0 || chunkSize < 0 || off+chunkSize > size {
+				return nil, moerr.NewInvalidInputNoCtx("column chunk out of the parquet file")
+			}
+		}
+	}
+	return f, nil
+}
+
+// buildColumns flattens the schema into the top level fields, the leaves
+// of the nested fields only take their positions in the row groups.
+func (f *File) buildColumns() error {
+	schema := f.meta.schema
+	if len(schema) == 0 {
+		return moerr.NewInvalidInputNoCtx("empty parquet schema")
+	}
+	pos, leaves := 1, 0
+	// walk returns the number of leaves under the element at pos
+	var walk func(depth int) (int, error)
+	walk = func(depth int) (int, error) {
+		if pos >= len(schema) || depth > maxThriftDepth {
+			return 0, moerr.NewInvalidInputNoCtx("malformed parquet schema")
+		}
+		e := &schema[pos]
+		pos++
+		if e.numChildren == 0 {
+			if e.typ == nil {
+				return 0, moerr.NewInvalidInputNoCtx("parquet leaf column '%s' has no type", e.name)
+			}
+			return 1, nil
+		}
+		n := 0
+		for i := int32(0); i < e.numChildren; i++ {
+			m, err := walk(depth + 1)
+			if err != nil {
+				return 0, err
+			}
+			n += m
+		}
+		return n, nil
+	}
+	for i := int32(0); i < schema[0].numChildren; i++ {
+		if pos >= len(schema) {
+			return moerr.NewInvalidInputNoCtx("malformed parquet schema")
+		}
+		e := &schema[pos]
+		col := &Column{
+			Name:     e.name,
+			Index:    leaves,
+			Optional: e.repetitionType == repetitionOptional,
+			Nested:   e.numChildren > 0 || e.repetitionType == repetitionRepeated,
+		}
+		n, err := walk(0)
+		if err != nil {
+			return err
+		}
+		leaves += n
+		if e.numChildren == 0 {
+			col.Kind = *e.typ
+			col.Length = int(e.typeLength)
+			col.LogicalType = logicalTypeOf(e)
+			if col.Kind == FixedLenByteArray && col.Length <= 0 {
+				return moerr.NewInvalidInputNoCtx("parquet column '%s' has an invalid length", e.name)
+			}
+		}
+		f.columns = append(f.columns, col)
+	}
+	if pos != len(schema) {
+		return moerr.NewInvalidInputNoCtx("malformed parquet schema")
+	}
+	return nil
+}
+
+func (f *File) numLeaves() int {
+	n := 0
+	for _, e := range f.meta.schema[1:] {
+		if e.numChildren == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+func logicalTypeOf(e *schemaElement) *LogicalType {
+	if e.logicalType != nil {
+		return e.logicalType
+	}
+	if e.convertedType == nil {
+		return nil
+	}
+	switch *e.convertedType {
+	case convertedUTF8:
+		return &LogicalType{String: true}
+	case convertedDecimal:
+		return &LogicalType{Decimal: &DecimalType{Scale: e.scale, Precision: e.precision}}
+	case convertedDate:
+		return &LogicalType{Date: true}
+	case convertedTimeMillis:
+		return &LogicalType{Time: &TimeType{IsAdjustedToUTC: true, Unit: Millis}}
+	case convertedTimeMicros:
+		return &LogicalType{Time: &TimeType{IsAdjustedToUTC: true, Unit: Micros}}
+	case convertedTimestampMillis:
+		return &LogicalType{Timestamp: &TimeType{IsAdjustedToUTC: true, Unit: Millis}}
+	case convertedTimestampMicros:
+		return &LogicalType{Timestamp: &TimeType{IsAdjustedToUTC: true, Unit: Micros}}
+	case convertedUint8:
+		return &LogicalType{Integer: &IntType{BitWidth: 8}}
+	case convertedUint16:
+		return &LogicalType{Integer: &IntType{BitWidth: 16}}
+	case convertedUint32:
+		return &LogicalType{Integer: &IntType{BitWidth: 32}}
+	case convertedUint64:
+		return &LogicalType{Integer: &IntType{BitWidth: 64}}
+	case convertedInt8:
+		return &LogicalType{Integer: &IntType{BitWidth: 8, IsSigned: true}}
+	case convertedInt16:
+		return &LogicalType{Integer: &IntType{BitWidth: 16, IsSigned: true}}
+	case convertedInt32:
+		return &LogicalType{Integer: &IntType{BitWidth: 32, IsSigned: true}}
+	case convertedInt64:
+		return &LogicalType{Integer: &IntType{BitWidth: 64, IsSigned: true}}
+	case convertedJSON:
+		return &LogicalType{JSON: true}
+	}
+	return nil
+}
+
+// Columns returns the top level fields of the schema.
+func (f *File) Columns() []*Column {
+	return f.columns
+}
+
+// RowGroups returns the metadata of the row groups.
+func (f *File) RowGroups() []RowGroup {
+	return f.meta.RowGroups
+}
+
+// NumRows returns the number of rows in the file.
+func (f *File) NumRows() int64 {
+	return f.meta.NumRows
+}
@@ -0,0 +1,482 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+// The structs below are the subset of parquet.thrift used by the reader,
+// the fields keep the ids and the names of the thrift definitions.
+
+// Kind is the physical type of a column.
+type Kind int32
+
+const (
+	Boolean           Kind = 0
+	Int32             Kind = 1
+	Int64             Kind = 2
+	Int96             Kind = 3
+	Float             Kind = 4
+	Double            Kind = 5
+	ByteArray         Kind = 6
+	FixedLenByteArray Kind = 7
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Boolean:
+		return "BOOLEAN"
+	case Int32:
+		return "INT32"
+	case Int64:
+		return "INT64"
+	case Int96:
+		return "INT96"
+	case Float:
+		return "FLOAT"
+	case Double:
+		return "DOUBLE"
+	case ByteArray:
+		return "BYTE_ARRAY"
+	case FixedLenByteArray:
+		return "FIXED_LEN_BYTE_ARRAY"
+	}
+	return "UNKNOWN"
+}
+
+// repetition types
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+)
+
+// legacy converted types, which are mapped to logical types
+const (
+	convertedUTF8            = 0
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimeMillis      = 7
+	convertedTimeMicros      = 8
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+	convertedUint8           = 11
+	convertedUint16          = 12
+	convertedUint32          = 13
+	convertedUint64          = 14
+	convertedInt8            = 15
+	convertedInt16           = 16
+	convertedInt32           = 17
+	convertedInt64           = 18
+	convertedJSON            = 19
+)
+
+// encodings
+const (
+	encodingPlain                = 0
+	encodingPlainDictionary      = 2
+	encodingRLE                  = 3
+	encodingBitPacked            = 4
+	encodingDeltaBinaryPacked    = 5
+	encodingDeltaLengthByteArray = 6
+	encodingDeltaByteArray       = 7
+	encodingRLEDictionary        = 8
+	encodingByteStreamSplit      = 9
+)
+
+// compression codecs
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+	codecLz4Raw       = 7
+)
+
+// page types
+const (
+	pageData       = 0
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// TimeUnit is the unit of TIME and TIMESTAMP values.
+type TimeUnit int
+
+const (
+	Millis TimeUnit = iota
+	Micros
+	Nanos
+)
+
+type DecimalType struct {
+	Scale     int32
+	Precision int32
+}
+
+type TimeType struct {
+	IsAdjustedToUTC bool
+	Unit            TimeUnit
+}
+
+type IntType struct {
+	BitWidth int8
+	IsSigned bool
+}
+
+// LogicalType annotates the physical type, at most one field is set.
+type LogicalType struct {
+	String    bool
+	Decimal   *DecimalType
+	Date      bool
+	Time      *TimeType
+	Timestamp *TimeType
+	Integer   *IntType
+	JSON      bool
+	UUID      bool
+}
+
+type schemaElement struct {
+	typ            *Kind
+	typeLength     int32
+	repetitionType int32
+	name           string
+	numChildren    int32
+	convertedType  *int32
+	scale          int32
+	precision      int32
+	logicalType    *LogicalType
+}
+
+// Statistics keeps the min and max values of a column chunk encoded as
+// PLAIN values. Only the fields written with the column order are kept, the
+// deprecated min and max have an undefined order for unsigned and byte array
+// columns.
+type Statistics struct {
+	MinValue []byte
+	MaxValue []byte
+}
+
+type ColumnMetaData struct {
+	Type                  Kind
+	Codec                 int32
+	NumValues             int64
+	TotalCompressedSize   int64
+	DataPageOffset        int64
+	DictionaryPageOffset  int64
+	HasDictionaryPage     bool
+	Statistics            Statistics
+	TotalUncompressedSize int64
+}
+
+type ColumnChunk struct {
+	FilePath string
+	MetaData ColumnMetaData
+}
+
+// Range returns the offset and the size of the column chunk in the file.
+func (c *ColumnChunk) Range() (int64, int64) {
+	off := c.MetaData.DataPageOffset
+	if c.MetaData.HasDictionaryPage && c.MetaData.DictionaryPageOffset > 0 &&
+		c.MetaData.DictionaryPageOffset < off {
+		off = c.MetaData.DictionaryPageOffset
+	}
+	return off, c.MetaData.TotalCompressedSize
+}
+
+type RowGroup struct {
+	Columns []ColumnChunk
+	NumRows int64
+}
+
+type FileMetaData struct {
+	schema    []schemaElement
+	NumRows   int64
+	RowGroups []RowGroup
+}
+
+type pageHeader struct {
+	typ                  int32
+	uncompressedPageSize int32
+	compressedPageSize   int32
+	// data page and data page v2
+	numValues int32
+	numNulls  int32
+	encoding  int32
+	// data page
+	definitionLevelEncoding int32
+	// data page v2
+	definitionLevelsByteLength int32
+	repetitionLevelsByteLength int32
+	isCompressed               bool
+}
+
+func decodeFileMetaData(d *thriftDecoder) *FileMetaData {
+	m := &FileMetaData{}
+	d.readStruct(func(typ byte, id int16) {
+		switch {
+		case id == 2 && typ == thriftList:
+			_, n := d.readList()
+			m.schema = make([]schemaElement, n)
+			for i := range m.schema {
+				decodeSchemaElement(d, &m.schema[i])
+			}
+		case id == 3 && typ == thriftI64:
+			m.NumRows = d.readVarint()
+		case id == 4 && typ == thriftList:
+			_, n := d.readList()
+			m.RowGroups = make([]RowGroup, n)
+			for i := range m.RowGroups {
+				decodeRowGroup(d, &m.RowGroups[i])
+			}
+		default:
+			d.skip(typ)
+		}
+	})
+	return m
+}
+
+func decodeSchemaElement(d *thriftDecoder, e *schemaElement) {
+	d.readStruct(func(typ byte, id int16) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			k := Kind(d.readI32())
+			e.typ = &k
+		case id == 2 && typ == thriftI32:
+			e.typeLength = d.readI32()
+		case id == 3 && typ == thriftI32:
+			e.repetitionType = d.readI32()
+		case id == 4 && typ == thriftBinary:
+			e.name = d.readString()
+		case id == 5 && typ == thriftI32:
+			e.numChildren = d.readI32()
+		case id == 6 && typ == thriftI32:
+			t := d.readI32()
+			e.convertedType = &t
+		case id == 7 && typ == thriftI32:
+			e.scale = d.readI32()
+		case id == 8 && typ == thriftI32:
+			e.precision = d.readI32()
+		case id == 10 && typ == thriftStruct:
+			e.logicalType = decodeLogicalType(d)
+		default:
+			d.skip(typ)
+		}
+	})
+}
+
+func decodeLogicalType(d *thriftDecoder) *LogicalType {
+	lt := &LogicalType{}
+	d.readStruct(func(typ byte, id int16) {
+		if typ != thriftStruct {
+			d.skip(typ)
+			return
+		}
+		switch id {
+		case 1:
+			lt.String = true
+			d.skip(typ)
+		case 5:
+			dec := &DecimalType{}
+			d.readStruct(func(typ byte, id int16) {
+				switch {
+				case id == 1 && typ == thriftI32:
+					dec.Scale = d.readI32()
+				case id == 2 && typ == thriftI32:
+					dec.Precision = d.readI32()
+				default:
+					d.skip(typ)
+				}
+			})
+			lt.Decimal = dec
+		case 6:
+			lt.Date = true
+			d.skip(typ)
+		case 7:
+			lt.Time = decodeTimeType(d)
+		case 8:
+			lt.Timestamp = decodeTimeType(d)
+		case 10:
+			it := &IntType{}
+			d.readStruct(func(typ byte, id int16) {
+				switch {
+				case id == 1 && typ == thriftByte:
+					it.BitWidth = int8(d.readByte())
+				case id == 2 && (typ == thriftTrue || typ == thriftFalse):
+					it.IsSigned = d.readBool(typ)
+				default:
+					d.skip(typ)
+				}
+			})
+			lt.Integer = it
+		case 12:
+			lt.JSON = true
+			d.skip(typ)
+		case 14:
+			lt.UUID = true
+			d.skip(typ)
+		default:
+			d.skip(typ)
+		}
+	})
+	return lt
+}
+
+func decodeTimeType(d *thriftDecoder) *TimeType {
+	t := &TimeType{}
+	d.readStruct(func(typ byte, id int16) {
+		switch {
+		case id == 1 && (typ == thriftTrue || typ == thriftFalse):
+			t.IsAdjustedToUTC = d.readBool(typ)
+		case id == 2 && typ == thriftStruct:
+			d.readStruct(func(typ byte, id int16) {
+				switch id {
+				case 1:
+					t.Unit = Millis
+				case 2:
+					t.Unit = Micros
+				case 3:
+					t.Unit = Nanos
+				}
+				d.skip(typ)
+			})
+		default:
+			d.skip(typ)
+		}
+	})
+	return t
+}
+
+func decodeRowGroup(d *thriftDecoder, rg *RowGroup) {
+	d.readStruct(func(typ byte, id int16) {
+		switch {
+		case id == 1 && typ == thriftList:
+			_, n := d.readList()
+			rg.Columns = make([]ColumnChunk, n)
+			for i := range rg.Columns {
+				decodeColumnChunk(d, &rg.Columns[i])
+			}
+		case id == 3 && typ == thriftI64:
+			rg.NumRows = d.readVarint()
+		default:
+			d.skip(typ)
+		}
+	})
+}
+
+func decodeColumnChunk(d *thriftDecoder, c *ColumnChunk) {
+	d.readStruct(func(typ byte, id int16) {
+		switch {
+		case id == 1 && typ == thriftBinary:
+			c.FilePath = d.readString()
+		case id == 3 && typ == thriftStruct:
+			decodeColumnMetaData(d, &c.MetaData)
+		default:
+			d.skip(typ)
+		}
+	})
+}
+
+func decodeColumnMetaData(d *thriftDecoder, m *ColumnMetaData) {
+	d.readStruct(func(typ byte, id int16) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			m.Type = Kind(d.readI32())
+		case id == 4 && typ == thriftI32:
+			m.Codec = d.readI32()
+		case id == 5 && typ == thriftI64:
+			m.NumValues = d.readVarint()
+		case id == 6 && typ == thriftI64:
+			m.TotalUncompressedSize = d.readVarint()
+		case id == 7 && typ == thriftI64:
+			m.TotalCompressedSize = d.readVarint()
+		case id == 9 && typ == thriftI64:
+			m.DataPageOffset = d.readVarint()
+		case id == 11 && typ == thriftI64:
+			m.DictionaryPageOffset = d.readVarint()
+			m.HasDictionaryPage = true
+		case id == 12 && typ == thriftStruct:
+			d.readStruct(func(typ byte, id int16) {
+				switch {
+				case id == 5 && typ == thriftBinary:
+					m.Statistics.MaxValue = d.readBinary()
+				case id == 6 && typ == thriftBinary:
+					m.Statistics.MinValue = d.readBinary()
+				default:
+					d.skip(typ)
+				}
+			})
+		default:
+			d.skip(typ)
+		}
+	})
+}
+
+func decodePageHeader(d *thriftDecoder) *pageHeader {
+	h := &pageHeader{isCompressed: true}
+	d.readStruct(func(typ byte, id int16) {
+		switch {
+		case id == 1 && typ == thriftI32:
+			h.typ = d.readI32()
+		case id == 2 && typ == thriftI32:
+			h.uncompressedPageSize = d.readI32()
+		case id == 3 && typ == thriftI32:
+			h.compressedPageSize = d.readI32()
+		case id == 5 && typ == thriftStruct:
+			d.readStruct(func(typ byte, id int16) {
+				switch {
+				case id == 1 && typ == thriftI32:
+					h.numValues = d.readI32()
+				case id == 2 && typ == thriftI32:
+					h.encoding = d.readI32()
+				case id == 3 && typ == thriftI32:
+					h.definitionLevelEncoding = d.readI32()
+				default:
+					d.skip(typ)
+				}
+			})
+		case id == 7 && typ == thriftStruct:
+			d.readStruct(func(typ byte, id int16) {
+				switch {
+				case id == 1 && typ == thriftI32:
+					h.numValues = d.readI32()
+				case id == 2 && typ == thriftI32:
+					h.encoding = d.readI32()
+				default:
+					d.skip(typ)
+				}
+			})
+		case id == 8 && typ == thriftStruct:
+			d.readStruct(func(typ byte, id int16) {
+				switch {
+				case id == 1 && typ == thriftI32:
+					h.numValues = d.readI32()
+				case id == 2 && typ == thriftI32:
+					h.numNulls = d.readI32()
+				case id == 4 && typ == thriftI32:
+					h.encoding = d.readI32()
+				case id == 5 && typ == thriftI32:
+					h.definitionLevelsByteLength = d.readI32()
+				case id == 6 && typ == thriftI32:
+					h.repetitionLevelsByteLength = d.readI32()
+				case id == 7 && (typ == thriftTrue || typ == thriftFalse):
+					h.isCompressed = d.readBool(typ)
+				default:
+					d.skip(typ)
+				}
+			})
+		default:
+			d.skip(typ)
+		}
+	})
+	return h
+}
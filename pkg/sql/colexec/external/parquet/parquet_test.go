@@ -0,0 +1,194 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The files in testdata hold 100 rows in 2 row groups and pages of a few
+// rows, they are written with data pages v1 and v2 by parquet-go with the
+// encodings and codecs of the columns below:
+//
+//	bool  BOOLEAN               PLAIN (RLE in v2)
+//	i32   INT32                 DELTA_BINARY_PACKED
+//	i64   INT64                 PLAIN, ZSTD
+//	u32   INT32 UINT(32)        PLAIN
+//	i96   INT96                 PLAIN
+//	f32   FLOAT                 BYTE_STREAM_SPLIT
+//	f64   DOUBLE                PLAIN, SNAPPY
+//	str   BYTE_ARRAY STRING     RLE_DICTIONARY
+//	opt   BYTE_ARRAY STRING     DELTA_LENGTH_BYTE_ARRAY, optional
+//	pre   BYTE_ARRAY STRING     DELTA_BYTE_ARRAY
+//	fixed FIXED_LEN_BYTE_ARRAY  PLAIN
+//	lz    BYTE_ARRAY STRING     DELTA_LENGTH_BYTE_ARRAY, LZ4_RAW
+//	gz    INT64                 RLE_DICTIONARY, GZIP
+func expectedTypesRow(i int) map[string]any {
+	var opt any
+	if i%4 != 1 {
+		opt = fmt.Sprintf("prefix-%03d", i)
+	}
+	return map[string]any{
+		"bool":  i%3 == 0,
+		"i32":   int32(i*7 - 300),
+		"i64":   int64(i) * 1000000007,
+		"u32":   uint32(4000000000 + i),
+		"i96":   [3]uint32{uint32(i), uint32(i * 2), uint32(2460000 + i)},
+		"f32":   float32(i) / 4,
+		"f64":   float64(i) * -1.5,
+		"str":   fmt.Sprintf("s%d", i%5),
+		"opt":   opt,
+		"pre":   fmt.Sprintf("prefix-%03d", i),
+		"fixed": string([]byte{byte(i), byte(i + 1), byte(i + 2), byte(i + 3)}),
+		"lz":    fmt.Sprintf("lz4-%03d", i),
+		"gz":    int64(i % 4),
+	}
+}
+
+func valueOf(col *Column, v Value) any {
+	if v.IsNull() {
+		return nil
+	}
+	switch col.Kind {
+	case Boolean:
+		return v.Boolean()
+	case Int32:
+		if lt := col.LogicalType; lt != nil && lt.Integer != nil && !lt.Integer.IsSigned {
+			return uint32(v.Int32())
+		}
+		return v.Int32()
+	case Int64:
+		return v.Int64()
+	case Int96:
+		return v.Int96()
+	case Float:
+		return v.Float()
+	case Double:
+		return v.Double()
+	}
+	return string(v.ByteArray())
+}
+
+func openTestFile(t *testing.T, name string) (*File, []byte) {
+	data, err := os.ReadFile(name)
+	require.NoError(t, err)
+	f, err := OpenFile(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	return f, data
+}
+
+func TestReadFile(t *testing.T) {
+	for _, name := range []string{"testdata/types_v1.parquet", "testdata/types_v2.parquet"} {
+		t.Run(name, func(t *testing.T) {
+			f, data := openTestFile(t, name)
+			require.Equal(t, int64(100), f.NumRows())
+			require.Len(t, f.RowGroups(), 2)
+			require.Len(t, f.Columns(), 13)
+
+			cols := f.Columns()
+			require.Equal(t, "opt", cols[8].Name)
+			require.True(t, cols[8].Optional)
+			require.True(t, cols[8].LogicalType.String)
+			require.Equal(t, 4, cols[10].Length)
+
+			row := 0
+			for _, rg := range f.RowGroups() {
+				for _, col := range cols {
+					chunk := &rg.Columns[col.Index]
+					off, size := chunk.Range()
+					r, err := NewColumnReader(col, chunk, data[off:off+size])
+					require.NoError(t, err)
+					vals := make([]Value, 7)
+					i := row
+					for {
+						n, err := r.Read(vals)
+						if err == io.EOF {
+							break
+						}
+						require.NoError(t, err, col.Name)
+						for _, v := range vals[:n] {
+							require.Equal(t, expectedTypesRow(i)[col.Name], valueOf(col, v), "column %s row %d", col.Name, i)
+							i++
+						}
+					}
+					require.Equal(t, row+int(rg.NumRows), i, col.Name)
+				}
+				row += int(rg.NumRows)
+			}
+		})
+	}
+}
+
+func TestStatValue(t *testing.T) {
+	f, _ := openTestFile(t, "testdata/types_v1.parquet")
+	cols := f.Columns()
+	stats := f.RowGroups()[1].Columns[cols[1].Index].MetaData.Statistics
+	min, ok := StatValue(cols[1], stats.MinValue)
+	require.True(t, ok)
+	max, ok := StatValue(cols[1], stats.MaxValue)
+	require.True(t, ok)
+	require.Equal(t, int32(50*7-300), min.Int32())
+	require.Equal(t, int32(99*7-300), max.Int32())
+
+	stats = f.RowGroups()[0].Columns[cols[7].Index].MetaData.Statistics
+	min, ok = StatValue(cols[7], stats.MinValue)
+	require.True(t, ok)
+	require.Equal(t, "s0", string(min.ByteArray()))
+
+	_, ok = StatValue(cols[1], []byte{1, 2})
+	require.False(t, ok)
+}
+
+func TestOpenFileError(t *testing.T) {
+	_, data := openTestFile(t, "testdata/types_v1.parquet")
+
+	open := func(b []byte) error {
+		_, err := OpenFile(bytes.NewReader(b), int64(len(b)))
+		return err
+	}
+	require.Error(t, open([]byte("a,b\n1,2\n")))
+	require.Error(t, open(data[:len(data)-1]))
+
+	// a metadata size larger than the file
+	bad := append([]byte(nil), data...)
+	bad[len(bad)-8] = 0xff
+	bad[len(bad)-7] = 0xff
+	require.Error(t, open(bad))
+
+	// truncated metadata
+	bad = append([]byte(nil), data...)
+	size := int(bad[len(bad)-8]) | int(bad[len(bad)-7])<<8
+	copy(bad[len(bad)-8-size/2:], make([]byte, size/2))
+	require.Error(t, open(bad))
+}
+
+func TestReadTruncatedChunk(t *testing.T) {
+	f, data := openTestFile(t, "testdata/types_v1.parquet")
+	col := f.Columns()[2]
+	chunk := &f.RowGroups()[0].Columns[col.Index]
+	off, size := chunk.Range()
+	r, err := NewColumnReader(col, chunk, data[off:off+size/2])
+	require.NoError(t, err)
+	vals := make([]Value, 100)
+	_, err = r.Read(vals)
+	require.Error(t, err)
+	require.NotEqual(t, io.EOF, err)
+}
@@ -0,0 +1,261 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+)
+
+// maxPageSize bounds the memory allocated for a page
+const maxPageSize = 1 << 30
+
+// ColumnReader reads the values of a column chunk, which is read in whole
+// by the caller.
+type ColumnReader struct {
+	col   *Column
+	codec int32
+	data  []byte
+	pos   int
+	// values left in the column chunk
+	left int64
+	dict []Value
+	// values of the current page, the nulls included
+	page []Value
+	idx  int
+}
+
+// NewColumnReader returns a reader of the column chunk, data holds the bytes
+// in the range returned by chunk.Range.
+func NewColumnReader(col *Column, chunk *ColumnChunk, data []byte) (*ColumnReader, error) {
+	if col.Nested {
+		return nil, moerr.NewNotSupportedNoCtx("parquet nested column '%s'", col.Name)
+	}
+	if chunk.MetaData.Type != col.Kind {
+		return nil, moerr.NewInvalidInputNoCtx("parquet column '%s' has chunks of type %s, expected %s",
+			col.Name, chunk.MetaData.Type.String(), col.Kind.String())
+	}
+	switch chunk.MetaData.Codec {
+	case codecUncompressed, codecSnappy, codecGzip, codecZstd, codecLz4Raw:
+	default:
+		return nil, moerr.NewNotSupportedNoCtx("parquet compression codec %d of column '%s'",
+			chunk.MetaData.Codec, col.Name)
+	}
+	return &ColumnReader{
+		col:   col,
+		codec: chunk.MetaData.Codec,
+		data:  data,
+		left:  chunk.MetaData.NumValues,
+	}, nil
+}
+
+// Read reads the next values into vals, and returns io.EOF after the last
+// value of the column chunk.
+func (r *ColumnReader) Read(vals []Value) (int, error) {
+	n := 0
+	for n < len(vals) {
+		if r.idx == len(r.page) {
+			if r.left <= 0 {
+				break
+			}
+			if err := r.readPage(); err != nil {
+				return n, err
+			}
+			continue
+		}
+		m := copy(vals[n:], r.page[r.idx:])
+		r.idx += m
+		n += m
+	}
+	if n == 0 && len(vals) > 0 {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// readPage decodes the next data page, the dictionary page is decoded on
+// the way.
+func (r *ColumnReader) readPage() error {
+	for {
+		if r.pos >= len(r.data) {
+			return moerr.NewInvalidInputNoCtx("parquet column '%s' has less values than its metadata", r.col.Name)
+		}
+		d := &thriftDecoder{b: r.data[r.pos:]}
+		h := decodePageHeader(d)
+		if d.err != nil {
+			return d.err
+		}
+		r.pos += d.pos
+		if h.compressedPageSize < 0 || int(h.compressedPageSize) > len(r.data)-r.pos ||
+			h.uncompressedPageSize < 0 || h.uncompressedPageSize > maxPageSize {
+			return errCorrupted("page header")
+		}
+		body := r.data[r.pos : r.pos+int(h.compressedPageSize)]
+		r.pos += int(h.compressedPageSize)
+
+		switch h.typ {
+		case pageDictionary:
+			if err := r.readDictionaryPage(h, body); err != nil {
+				return err
+			}
+		case pageData:
+			return r.readDataPage(h, body)
+		case pageDataV2:
+			return r.readDataPageV2(h, body)
+		}
+		// the index pages are skipped
+	}
+}
+
+func (r *ColumnReader) readDictionaryPage(h *pageHeader, body []byte) error {
+	if h.encoding != encodingPlain && h.encoding != encodingPlainDictionary {
+		return moerr.NewNotSupportedNoCtx("parquet dictionary encoding %d", h.encoding)
+	}
+	data, err := r.decompress(body, int(h.uncompressedPageSize))
+	if err != nil {
+		return err
+	}
+	if h.numValues < 0 || int(h.numValues) > len(data)*8 {
+		return errCorrupted("dictionary page")
+	}
+	dict := make([]Value, h.numValues)
+	if _, err = decodePlain(r.col, data, dict); err != nil {
+		return err
+	}
+	r.dict = dict
+	return nil
+}
+
+func (r *ColumnReader) readDataPage(h *pageHeader, body []byte) error {
+	data, err := r.decompress(body, int(h.uncompressedPageSize))
+	if err != nil {
+		return err
+	}
+	n := int(h.numValues)
+	if n < 0 || int64(n) > r.left {
+		return errCorrupted("data page")
+	}
+	var levels []uint32
+	if r.col.Optional {
+		if h.definitionLevelEncoding != encodingRLE {
+			return moerr.NewNotSupportedNoCtx("parquet definition level encoding %d", h.definitionLevelEncoding)
+		}
+		if len(data) < 4 {
+			return errCorrupted("definition levels")
+		}
+		size := binary.LittleEndian.Uint32(data)
+		if uint64(size) > uint64(len(data)-4) {
+			return errCorrupted("definition levels")
+		}
+		levels = make([]uint32, n)
+		if _, err = decodeHybrid(data[4:4+size], 1, levels); err != nil {
+			return err
+		}
+		data = data[4+size:]
+	}
+	return r.decodePage(h.encoding, levels, n, data)
+}
+
+func (r *ColumnReader) readDataPageV2(h *pageHeader, body []byte) error {
+	n := int(h.numValues)
+	defLen, repLen := int(h.definitionLevelsByteLength), int(h.repetitionLevelsByteLength)
+	if n < 0 || int64(n) > r.left || defLen < 0 || repLen < 0 || defLen+repLen > len(body) ||
+		defLen+repLen > int(h.uncompressedPageSize) {
+		return errCorrupted("data page")
+	}
+	var levels []uint32
+	if r.col.Optional {
+		levels = make([]uint32, n)
+		if _, err := decodeHybrid(body[repLen:repLen+defLen], 1, levels); err != nil {
+			return err
+		}
+	}
+	data := body[repLen+defLen:]
+	if h.isCompressed {
+		var err error
+		if data, err = r.decompress(data, int(h.uncompressedPageSize)-defLen-repLen); err != nil {
+			return err
+		}
+	}
+	return r.decodePage(h.encoding, levels, n, data)
+}
+
+// decodePage decodes n values, the values are null where the definition
+// level is 0.
+func (r *ColumnReader) decodePage(encoding int32, levels []uint32, n int, data []byte) error {
+	nonNull := n
+	for _, l := range levels {
+		if l == 0 {
+			nonNull--
+		}
+	}
+	vals := make([]Value, nonNull)
+	if err := decodeValues(r.col, encoding, data, r.dict, vals); err != nil {
+		return err
+	}
+	if levels == nil {
+		r.page = vals
+	} else {
+		r.page = make([]Value, n)
+		j := 0
+		for i, l := range levels {
+			if l == 0 {
+				r.page[i] = Value{kind: r.col.Kind, null: true}
+			} else {
+				r.page[i] = vals[j]
+				j++
+			}
+		}
+	}
+	r.idx = 0
+	r.left -= int64(n)
+	return nil
+}
+
+func (r *ColumnReader) decompress(src []byte, size int) ([]byte, error) {
+	if size < 0 {
+		return nil, errCorrupted("page size")
+	}
+	var data []byte
+	var err error
+	switch r.codec {
+	case codecUncompressed:
+		data = src
+	case codecSnappy:
+		data, err = compress.Decompress(src, make([]byte, size), compress.Snappy)
+	case codecZstd:
+		data, err = compress.Decompress(src, make([]byte, size), compress.Zstd)
+	case codecLz4Raw:
+		data, err = compress.Decompress(src, make([]byte, size), compress.Lz4)
+	case codecGzip:
+		var zr *gzip.Reader
+		if zr, err = gzip.NewReader(bytes.NewReader(src)); err == nil {
+			data = make([]byte, size)
+			_, err = io.ReadFull(zr, data)
+		}
+	}
+	if err != nil {
+		return nil, moerr.NewInvalidInputNoCtx("decompress parquet page: %s", err.Error())
+	}
+	if len(data) != size {
+		return nil, errCorrupted("page size")
+	}
+	return data, nil
+}
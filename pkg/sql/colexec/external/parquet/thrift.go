@@ -0,0 +1,222 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// types of the thrift compact protocol
+const (
+	thriftStop   = 0
+	thriftTrue   = 1
+	thriftFalse  = 2
+	thriftByte   = 3
+	thriftI16    = 4
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftDouble = 7
+	thriftBinary = 8
+	thriftList   = 9
+	thriftSet    = 10
+	thriftMap    = 11
+	thriftStruct = 12
+)
+
+// maxThriftDepth bounds the nesting of the structs skipped by the decoder
+const maxThriftDepth = 64
+
+// thriftDecoder decodes the thrift compact protocol, in which the metadata
+// of parquet files is serialized. Decoding stops at the first error, which
+// is kept in err.
+type thriftDecoder struct {
+	b   []byte
+	pos int
+	err error
+}
+
+func (d *thriftDecoder) fail() {
+	if d.err == nil {
+		d.err = moerr.NewInvalidInputNoCtx("malformed parquet metadata")
+	}
+	d.pos = len(d.b)
+}
+
+func (d *thriftDecoder) readByte() byte {
+	if d.pos >= len(d.b) {
+		d.fail()
+		return 0
+	}
+	c := d.b[d.pos]
+	d.pos++
+	return c
+}
+
+func (d *thriftDecoder) readUvarint() uint64 {
+	if d.pos >= len(d.b) {
+		d.fail()
+		return 0
+	}
+	v, n := binary.Uvarint(d.b[d.pos:])
+	if n <= 0 {
+		d.fail()
+		return 0
+	}
+	d.pos += n
+	return v
+}
+
+func (d *thriftDecoder) readVarint() int64 {
+	v := d.readUvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (d *thriftDecoder) readI32() int32 {
+	return int32(d.readVarint())
+}
+
+func (d *thriftDecoder) readBinary() []byte {
+	n := d.readUvarint()
+	if n > uint64(len(d.b)-d.pos) {
+		d.fail()
+		return nil
+	}
+	b := d.b[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return b
+}
+
+func (d *thriftDecoder) readString() string {
+	return string(d.readBinary())
+}
+
+func (d *thriftDecoder) readDouble() float64 {
+	if len(d.b)-d.pos < 8 {
+		d.fail()
+		return 0
+	}
+	v := math.Float64frombits(binary.LittleEndian.Uint64(d.b[d.pos:]))
+	d.pos += 8
+	return v
+}
+
+// readField returns the type and id of the next field of a struct, the type
+// is thriftStop after the last field. The value of a bool field is carried
+// by its type.
+func (d *thriftDecoder) readField(lastID int16) (byte, int16) {
+	c := d.readByte()
+	typ := c & 0x0f
+	if typ == thriftStop {
+		return thriftStop, 0
+	}
+	if delta := int16(c >> 4); delta != 0 {
+		return typ, lastID + delta
+	}
+	return typ, int16(d.readVarint())
+}
+
+// readList returns the element type and the size of a list or a set.
+func (d *thriftDecoder) readList() (byte, int) {
+	c := d.readByte()
+	size := uint64(c >> 4)
+	if size == 15 {
+		size = d.readUvarint()
+	}
+	// every element takes at least one byte
+	if size > uint64(len(d.b)-d.pos) {
+		d.fail()
+		return thriftStop, 0
+	}
+	return c & 0x0f, int(size)
+}
+
+func (d *thriftDecoder) readBool(typ byte) bool {
+	return typ == thriftTrue
+}
+
+// readStruct calls fn for every field of a struct, fn must consume the value
+// of the field or skip it.
+func (d *thriftDecoder) readStruct(fn func(typ byte, id int16)) {
+	var id int16
+	for d.err == nil {
+		var typ byte
+		typ, id = d.readField(id)
+		if typ == thriftStop {
+			return
+		}
+		fn(typ, id)
+	}
+}
+
+func (d *thriftDecoder) skip(typ byte) {
+	d.skipDepth(typ, 0)
+}
+
+func (d *thriftDecoder) skipDepth(typ byte, depth int) {
+	if depth > maxThriftDepth {
+		d.fail()
+		return
+	}
+	switch typ {
+	case thriftTrue, thriftFalse:
+	case thriftByte:
+		d.readByte()
+	case thriftI16, thriftI32, thriftI64:
+		d.readUvarint()
+	case thriftDouble:
+		d.readDouble()
+	case thriftBinary:
+		d.readBinary()
+	case thriftList, thriftSet:
+		elem, n := d.readList()
+		for i := 0; i < n && d.err == nil; i++ {
+			if elem == thriftTrue || elem == thriftFalse {
+				// the elements of a bool list take one byte each
+				d.readByte()
+			} else {
+				d.skipDepth(elem, depth+1)
+			}
+		}
+	case thriftMap:
+		n := d.readUvarint()
+		if n == 0 {
+			return
+		}
+		kv := d.readByte()
+		if n > uint64(len(d.b)-d.pos) {
+			d.fail()
+			return
+		}
+		for i := uint64(0); i < n && d.err == nil; i++ {
+			d.skipDepth(kv>>4, depth+1)
+			d.skipDepth(kv&0x0f, depth+1)
+		}
+	case thriftStruct:
+		var id int16
+		for d.err == nil {
+			var t byte
+			t, id = d.readField(id)
+			if t == thriftStop {
+				return
+			}
+			d.skipDepth(t, depth+1)
+		}
+	default:
+		d.fail()
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Value is a value of a column. The fixed size values are kept in the bits
+// of u64, the byte arrays and INT96 values point into the page they are read
+// from.
+type Value struct {
+	kind Kind
+	null bool
+	u64  uint64
+	b    []byte
+}
+
+func (v Value) Kind() Kind {
+	return v.kind
+}
+
+func (v Value) IsNull() bool {
+	return v.null
+}
+
+func (v Value) Boolean() bool {
+	return v.u64 != 0
+}
+
+func (v Value) Int32() int32 {
+	return int32(v.u64)
+}
+
+func (v Value) Int64() int64 {
+	return int64(v.u64)
+}
+
+// Int96 returns the three little endian words of an INT96 value.
+func (v Value) Int96() [3]uint32 {
+	return [3]uint32{
+		binary.LittleEndian.Uint32(v.b),
+		binary.LittleEndian.Uint32(v.b[4:]),
+		binary.LittleEndian.Uint32(v.b[8:]),
+	}
+}
+
+func (v Value) Float() float32 {
+	return math.Float32frombits(uint32(v.u64))
+}
+
+func (v Value) Double() float64 {
+	return math.Float64frombits(v.u64)
+}
+
+// ByteArray returns the bytes of a BYTE_ARRAY, FIXED_LEN_BYTE_ARRAY or
+// INT96 value.
+func (v Value) ByteArray() []byte {
+	return v.b
+}
+
+// StatValue decodes a min or max value of the statistics of a column chunk,
+// which is PLAIN encoded without the length of byte arrays.
+func StatValue(col *Column, b []byte) (Value, bool) {
+	switch col.Kind {
+	case Boolean:
+		if len(b) != 1 {
+			return Value{}, false
+		}
+		return Value{kind: Boolean, u64: uint64(b[0] & 1)}, true
+	case Int32, Float:
+		if len(b) != 4 {
+			return Value{}, false
+		}
+		return Value{kind: col.Kind, u64: uint64(binary.LittleEndian.Uint32(b))}, true
+	case Int64, Double:
+		if len(b) != 8 {
+			return Value{}, false
+		}
+		return Value{kind: col.Kind, u64: binary.LittleEndian.Uint64(b)}, true
+	case Int96:
+		if len(b) != 12 {
+			return Value{}, false
+		}
+		return Value{kind: Int96, b: b}, true
+	case FixedLenByteArray:
+		if len(b) != col.Length {
+			return Value{}, false
+		}
+		return Value{kind: FixedLenByteArray, b: b}, true
+	case ByteArray:
+		return Value{kind: ByteArray, b: b}, true
+	}
+	return Value{}, false
+}
@@ -0,0 +1,264 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// testdata/rows.parquet holds 30 rows in row groups of 10 rows, it is
+// written by parquet-go from the rows of
+//
+//	type row struct {
+//		A int64     `parquet:"A"`
+//		B *string   `parquet:"b,optional,snappy"`
+//		C int64     `parquet:"c,decimal(2:10),zstd"`
+//		D time.Time `parquet:"d,timestamp(microsecond),gzip"`
+//		E int32     `parquet:"e,date"`
+//		F uint32    `parquet:"f"`
+//	}
+//
+// where A is the row number i, B is "v<i>" or null if i%3 == 0, C is i*100+5,
+// D is 2023-07-01 12:30:00 plus i seconds, E is 2023-07-01 plus i days and F
+// is i.
+const parquetTestFile = "testdata/rows.parquet"
+
+func parquetTestFileSize(t *testing.T) int64 {
+	info, err := os.Stat(parquetTestFile)
+	require.NoError(t, err)
+	return info.Size()
+}
+
+func newParquetArgument(proc *process.Process, path string, size int64, cols []*plan.ColDef, filter *plan.Expr) *Argument {
+	attrs := make([]string, len(cols))
+	name2ColIndex := make(map[string]int32, len(cols))
+	for i, col := range cols {
+		attrs[i] = col.Name
+		name2ColIndex[col.Name] = int32(i)
+	}
+	return &Argument{
+		Es: &ExternalParam{
+			ExParamConst: ExParamConst{
+				Attrs:         attrs,
+				Cols:          cols,
+				FileList:      []string{path},
+				FileSize:      []int64{size},
+				Name2ColIndex: name2ColIndex,
+				Extern: &tree.ExternParam{
+					ExParamConst: tree.ExParamConst{
+						Filepath: path,
+						Format:   tree.PARQUET,
+						Tail:     &tree.TailParameter{},
+					},
+					ExParam: tree.ExParam{
+						FileService: proc.FileService,
+						Ctx:         context.Background(),
+					},
+				},
+			},
+			ExParam: ExParam{
+				Fileparam: &ExFileparam{},
+				Filter:    &FilterParam{FilterExpr: filter},
+			},
+		},
+	}
+}
+
+func runParquetScan(t *testing.T, proc *process.Process, arg *Argument, fn func(bat []*vector.Vector, rows int)) int {
+	require.NoError(t, Prepare(proc, arg))
+	defer arg.Free(proc, false)
+	total := 0
+	for {
+		status, err := Call(0, proc, arg, false, false)
+		require.NoError(t, err)
+		if status == process.ExecStop {
+			break
+		}
+		bat := proc.InputBatch()
+		if bat != nil {
+			fn(bat.Vecs, bat.RowCount())
+			total += bat.RowCount()
+			bat.Clean(proc.Mp())
+		}
+	}
+	return total
+}
+
+func TestScanParquetFile(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	proc.FileService = testutil.NewFS()
+	path, size := parquetTestFile, parquetTestFileSize(t)
+
+	cols := []*plan.ColDef{
+		{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "b", Typ: &plan.Type{Id: int32(types.T_varchar), Width: 64}},
+		{Name: "c", Typ: &plan.Type{Id: int32(types.T_decimal64), Width: 10, Scale: 2}},
+		{Name: "d", Typ: &plan.Type{Id: int32(types.T_datetime)}},
+		{Name: "e", Typ: &plan.Type{Id: int32(types.T_date)}},
+		{Name: "f", Typ: &plan.Type{Id: int32(types.T_int16)}},
+		{Name: catalog.ExternalFilePath, Typ: &plan.Type{Id: int32(types.T_varchar), Width: types.MaxVarcharLen}},
+	}
+	var as []int64
+	total := runParquetScan(t, proc, newParquetArgument(proc, path, size, cols, nil), func(vecs []*vector.Vector, rows int) {
+		as = append(as, vector.MustFixedCol[int64](vecs[0])...)
+		for i := 0; i < rows; i++ {
+			a := vector.GetFixedAt[int64](vecs[0], i)
+			require.Equal(t, a%3 == 0, vecs[1].GetNulls().Contains(uint64(i)))
+			if a%3 != 0 {
+				require.Equal(t, fmt.Sprintf("v%d", a), vecs[1].GetStringAt(i))
+			}
+			require.Equal(t, fmt.Sprintf("%d.05", a), vector.GetFixedAt[types.Decimal64](vecs[2], i).Format(2))
+			require.Equal(t, fmt.Sprintf("2023-07-01 12:30:%02d", a), vector.GetFixedAt[types.Datetime](vecs[3], i).String())
+			require.Equal(t, types.DateFromCalendar(2023, 7, 1)+types.Date(a), vector.GetFixedAt[types.Date](vecs[4], i))
+			require.Equal(t, int16(a), vector.GetFixedAt[int16](vecs[5], i))
+			require.Equal(t, path, vecs[6].GetStringAt(i))
+		}
+	})
+	require.Equal(t, 30, total)
+	require.Len(t, as, 30)
+	require.Equal(t, int64(29), as[29])
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestScanParquetFilePrune(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	proc.FileService = testutil.NewFS()
+	path, size := parquetTestFile, parquetTestFileSize(t)
+
+	e, err := function.GetFunctionByName(context.Background(), ">", []types.Type{types.T_int64.ToType(), types.T_int64.ToType()})
+	require.NoError(t, err)
+	filter := &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_bool)},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: e.GetEncodedOverloadID(), ObjName: ">"},
+				Args: []*plan.Expr{
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: 0, Name: "t.a"}},
+					},
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_I64Val{I64Val: 15}}},
+					},
+				},
+			},
+		},
+	}
+	cols := []*plan.ColDef{
+		{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}},
+	}
+	var as []int64
+	total := runParquetScan(t, proc, newParquetArgument(proc, path, size, cols, filter), func(vecs []*vector.Vector, rows int) {
+		as = append(as, vector.MustFixedCol[int64](vecs[0])...)
+	})
+	// the first row group is pruned by its statistics
+	require.Equal(t, 20, total)
+	require.Equal(t, int64(10), as[0])
+}
+
+func TestScanParquetFileError(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	proc.FileService = testutil.NewFS()
+	path, size := parquetTestFile, parquetTestFileSize(t)
+
+	run := func(cols []*plan.ColDef) error {
+		arg := newParquetArgument(proc, path, size, cols, nil)
+		require.NoError(t, Prepare(proc, arg))
+		defer arg.Free(proc, false)
+		_, err := Call(0, proc, arg, false, false)
+		return err
+	}
+	// missing column
+	err := run([]*plan.ColDef{{Name: "x", Typ: &plan.Type{Id: int32(types.T_int64)}}})
+	require.Error(t, err)
+	require.True(t, strings.Contains(err.Error(), "not found"))
+	// incompatible type
+	require.Error(t, run([]*plan.ColDef{{Name: "b", Typ: &plan.Type{Id: int32(types.T_int64)}}}))
+	// narrower integer type holding every value
+	require.NoError(t, run([]*plan.ColDef{{Name: "a", Typ: &plan.Type{Id: int32(types.T_int8)}}}))
+
+	// not a parquet file
+	bad := filepath.Join(t.TempDir(), "bad.parquet")
+	require.NoError(t, os.WriteFile(bad, []byte("a,b\n1,2\n"), 0644))
+	arg := newParquetArgument(proc, bad, 8, []*plan.ColDef{{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}}}, nil)
+	require.NoError(t, Prepare(proc, arg))
+	_, err = Call(0, proc, arg, false, false)
+	require.Error(t, err)
+}
+
+func TestScanParquetLoadLocal(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	proc.FileService = testutil.NewFS()
+	data, err := os.ReadFile(parquetTestFile)
+	require.NoError(t, err)
+
+	var w *io.PipeWriter
+	proc.LoadLocalReader, w = io.Pipe()
+	go func() {
+		// the client sends the file in several packets
+		for len(data) > 0 {
+			n := 1000
+			if n > len(data) {
+				n = len(data)
+			}
+			_, _ = w.Write(data[:n])
+			data = data[n:]
+		}
+		_ = w.Close()
+	}()
+
+	cols := []*plan.ColDef{
+		{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "b", Typ: &plan.Type{Id: int32(types.T_varchar), Width: 64}},
+	}
+	arg := newParquetArgument(proc, parquetTestFile, 0, cols, nil)
+	arg.Es.Extern.Local = true
+	arg.Es.FileSize = nil
+	var as []int64
+	total := runParquetScan(t, proc, arg, func(vecs []*vector.Vector, rows int) {
+		as = append(as, vector.MustFixedCol[int64](vecs[0])...)
+	})
+	require.Equal(t, 30, total)
+	require.Equal(t, int64(29), as[29])
+
+	// the copy of the file is deleted once scanned
+	fs, err := fileservice.Get[fileservice.FileService](proc.FileService, defines.LocalFileServiceName)
+	require.NoError(t, err)
+	var entries []fileservice.DirEntry
+	entries, err = fs.List(context.Background(), path.Join(loadLocalDir, proc.Id))
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
@@ -64,6 +64,7 @@ type ExParam struct {
 	prevStr        string
 	reader         io.ReadCloser
 	plh            *ParseLineHandler
+	parqh          *ParquetHandler
 	Fileparam      *ExFileparam
 	Zoneparam      *ZonemapFileparam
 	Filter         *FilterParam
@@ -94,7 +95,12 @@ type Argument struct {
 	Es *ExternalParam
 }
 
-func (arg *Argument) Free(*process.Process, bool) {}
+func (arg *Argument) Free(proc *process.Process, _ bool) {
+	if arg.Es != nil && arg.Es.parqh != nil {
+		arg.Es.parqh.close(proc)
+		arg.Es.parqh = nil
+	}
+}
 
 type ParseLineHandler struct {
 	csvReader *csv.Reader
@@ -65,6 +65,7 @@ func Prepare(proc *process.Process, arg any) (err error) {
 	ap.ctr = new(container)
 	ap.ctr.inserted = make([]uint8, hashmap.UnitLimit)
 	ap.ctr.zInserted = make([]uint8, hashmap.UnitLimit)
+	ap.ctr.canSpill = ap.canSpill()
 
 	ctr := ap.ctr
 
@@ -231,8 +232,18 @@ func initCtrBatchForProcessWithoutGroup(ap *Argument, proc *process.Process, ctr
 
 func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool, isLast bool) (process.ExecStatus, error) {
 	var err error
+	if ctr.merging {
+		return ctr.outputSpilled(ap, proc, anal, isLast)
+	}
 	bat := proc.InputBatch()
 	if bat == nil {
+		// the groups are spilled to disk, merge them partition by partition.
+		if ctr.spilled != nil {
+			if err = ctr.startMerge(ap, proc, anal); err != nil {
+				return process.ExecNext, err
+			}
+			return ctr.outputSpilled(ap, proc, anal, isLast)
+		}
 		if ctr.bat != nil {
 			if ap.NeedEval {
 				for i, ag := range ctr.bat.Aggs {
@@ -332,6 +343,9 @@ func (ctr *container) processWithGroup(ap *Argument, proc *process.Process, anal
 	if err != nil {
 		return process.ExecNext, err
 	}
+	if ctr.canSpill {
+		err = ctr.spillIfExceeded(ap, proc, anal)
+	}
 	return process.ExecNext, err
 }
 
@@ -23,6 +23,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -121,6 +122,54 @@ func TestGroup(t *testing.T) {
 	}
 }
 
+func TestGroupSpill(t *testing.T) {
+	tc := newTestCase([]bool{false, false}, []types.Type{
+		types.T_int64.ToType(),
+		types.T_int64.ToType(),
+	}, []*plan.Expr{newExpression(0)}, []agg.Aggregate{{Op: agg.AggregateSum, E: newExpression(1)}})
+	tc.arg.NeedEval = true
+	tc.arg.Aggs[0].E.Typ = &plan.Type{Id: int32(types.T_int64)}
+	tc.proc.Lim.Size = 1
+	tc.proc.AnalInfos = []*process.AnalyzeInfo{process.NewAnalyzeInfo(0)}
+
+	err := Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		tc.proc.Reg.InputBatch = newBatch(t, tc.flgs, tc.arg.Types, tc.proc, 1000)
+		_, err = Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+	}
+	require.Less(t, int64(0), tc.proc.AnalInfos[0].SpillCount)
+	require.Less(t, int64(0), tc.proc.AnalInfos[0].SpillSize)
+
+	sums := make(map[int64]int64)
+	tc.proc.Reg.InputBatch = nil
+	for {
+		result, err := Call(0, tc.proc, tc.arg, false, false)
+		require.NoError(t, err)
+		if bat := tc.proc.Reg.InputBatch; bat != nil {
+			keys := vector.MustFixedCol[int64](bat.Vecs[0])
+			vals := vector.MustFixedCol[int64](bat.Vecs[1])
+			for i, key := range keys {
+				_, ok := sums[key]
+				require.False(t, ok)
+				sums[key] = vals[i]
+			}
+			bat.Clean(tc.proc.Mp())
+		}
+		if result == process.ExecStop {
+			break
+		}
+	}
+	require.Equal(t, 1000, len(sums))
+	for key, sum := range sums {
+		require.Equal(t, key*3, sum)
+	}
+	tc.arg.Free(tc.proc, false)
+	tc.proc.FreeVectors()
+	require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+}
+
 func BenchmarkGroup(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		tcs = []groupTestCase{
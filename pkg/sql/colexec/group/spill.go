@@ -0,0 +1,421 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/agg"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// spilledPartition is a partition of the groups spilled to disk, the partial results
+// of the same group are all in one partition, and they are merged when the partition
+// is read back.
+type spilledPartition struct {
+	colexec.SpillPartition
+	level int
+}
+
+// canSpill returns whether the groups can be spilled to disk,
+// spilling is not supported for distinct aggregations and group_concat.
+func (arg *Argument) canSpill() bool {
+	if len(arg.Exprs) == 0 || len(arg.MultiAggs) != 0 {
+		return false
+	}
+	for _, ag := range arg.Aggs {
+		if ag.Dist {
+			return false
+		}
+	}
+	return true
+}
+
+func (ctr *container) hashMapSize() int64 {
+	if ctr.intHashMap != nil {
+		return ctr.intHashMap.Size()
+	}
+	if ctr.strHashMap != nil {
+		return ctr.strHashMap.Size()
+	}
+	return 0
+}
+
+// spillIfExceeded reserves the memory of the groups in memory,
+// and spills them to disk if the memory threshold of the query is exceeded.
+func (ctr *container) spillIfExceeded(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	size := int64(ctr.bat.Size()) + ctr.hashMapSize()
+	if size <= ctr.reserved {
+		return nil
+	}
+	if proc.ReserveMemory(size - ctr.reserved) {
+		ctr.reserved = size
+		return nil
+	}
+	return ctr.spill(ap, proc, anal)
+}
+
+// spill writes the groups in memory to the partitions on disk.
+func (ctr *container) spill(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	if ctr.spilled == nil {
+		ctr.spilled = make([]colexec.SpillPartition, colexec.SpillPartitionCount)
+	}
+	if ctr.bat != nil {
+		if err := spillGroups(ap, proc, anal, ctr.bat, ctr.spilled, 0); err != nil {
+			return err
+		}
+		ctr.cleanBatch(proc.Mp())
+		ctr.cleanHashMap()
+	}
+	proc.ReleaseMemory(ctr.reserved)
+	ctr.reserved = 0
+	return nil
+}
+
+// spillGroups writes the groups of the batch to the partitions by the hash of the group by columns.
+func spillGroups(ap *Argument, proc *process.Process, anal process.Analyze, bat *batch.Batch, parts []colexec.SpillPartition, level int) error {
+	sels := colexec.SpillPartitionSels(bat.Vecs, bat.RowCount(), level)
+	for i := range sels {
+		if len(sels[i]) == 0 {
+			continue
+		}
+		pbat, err := shrinkGroups(ap, proc, bat, sels[i])
+		if err != nil {
+			return err
+		}
+		name, size, err := colexec.WriteSpillFile(proc, []*batch.Batch{pbat}, anal)
+		pbat.Clean(proc.Mp())
+		if err != nil {
+			return err
+		}
+		parts[i].Files = append(parts[i].Files, name)
+		parts[i].Rows += int64(len(sels[i]))
+		parts[i].Size += size
+	}
+	return nil
+}
+
+// shrinkGroups returns a new batch of the selected groups and their partial results.
+func shrinkGroups(ap *Argument, proc *process.Process, bat *batch.Batch, sels []int32) (*batch.Batch, error) {
+	rbat := batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		rbat.Vecs[i] = vector.NewVec(*vec.GetType())
+		if err := rbat.Vecs[i].Union(vec, sels, proc.Mp()); err != nil {
+			rbat.Clean(proc.Mp())
+			return nil, err
+		}
+	}
+	rbat.SetRowCount(len(sels))
+
+	rbat.Aggs = make([]agg.Agg[any], len(bat.Aggs))
+	for i, ag := range bat.Aggs {
+		rag, err := agg.NewWithConfig(ap.Aggs[i].Op, ap.Aggs[i].Dist, ag.GetInputTypes()[0], ap.Aggs[i].Config)
+		if err != nil {
+			rbat.Clean(proc.Mp())
+			return nil, err
+		}
+		rbat.Aggs[i] = rag
+		if err = rag.Grows(len(sels), proc.Mp()); err != nil {
+			rbat.Clean(proc.Mp())
+			return nil, err
+		}
+		for j, sel := range sels {
+			if err = rag.Merge(ag, int64(j), int64(sel)); err != nil {
+				rbat.Clean(proc.Mp())
+				return nil, err
+			}
+		}
+	}
+	return rbat, nil
+}
+
+// startMerge spills the groups left in memory, and starts to merge the spilled partitions.
+func (ctr *container) startMerge(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	if err := ctr.spill(ap, proc, anal); err != nil {
+		return err
+	}
+	for i := range ctr.spilled {
+		if !ctr.spilled[i].IsEmpty() {
+			ctr.pending = append(ctr.pending, spilledPartition{SpillPartition: ctr.spilled[i]})
+		}
+	}
+	ctr.spilled = nil
+	ctr.merging = true
+	return nil
+}
+
+// outputSpilled returns the groups of the next spilled partition.
+func (ctr *container) outputSpilled(ap *Argument, proc *process.Process, anal process.Analyze, isLast bool) (process.ExecStatus, error) {
+	for len(ctr.pending) > 0 {
+		bat, err := ctr.mergePartition(ap, proc, anal)
+		if err != nil {
+			return process.ExecNext, err
+		}
+		if bat == nil {
+			continue
+		}
+		if ap.NeedEval {
+			for i, ag := range bat.Aggs {
+				vec, err := ag.Eval(proc.Mp())
+				if err != nil {
+					bat.Clean(proc.Mp())
+					return process.ExecNext, err
+				}
+				bat.Aggs[i] = nil
+				bat.Vecs = append(bat.Vecs, vec)
+				anal.Alloc(int64(vec.Size()))
+
+				ag.Free(proc.Mp())
+			}
+			bat.Aggs = nil
+		}
+		anal.Output(bat, isLast)
+		proc.SetInputBatch(bat)
+		if len(ctr.pending) > 0 {
+			return process.ExecHasMore, nil
+		}
+		return process.ExecStop, nil
+	}
+	proc.SetInputBatch(nil)
+	return process.ExecStop, nil
+}
+
+// mergePartition merges the partial results of the last pending partition, it returns nil
+// if the partition exceeds the memory threshold and is partitioned again.
+func (ctr *container) mergePartition(ap *Argument, proc *process.Process, anal process.Analyze) (*batch.Batch, error) {
+	part := ctr.pending[len(ctr.pending)-1]
+	m := &partitionMerger{
+		inserted:  ctr.inserted,
+		zInserted: ctr.zInserted,
+	}
+	defer m.free(proc)
+
+	for i, file := range part.Files {
+		bats, err := colexec.ReadSpillFile(proc, file)
+		if err != nil {
+			return nil, err
+		}
+		for j, bat := range bats {
+			if err = m.merge(ap, ctr, bat, proc); err != nil {
+				for _, b := range bats[j+1:] {
+					b.Clean(proc.Mp())
+				}
+				return nil, err
+			}
+			size := int64(m.bat.Size()) + m.hashMapSize()
+			if size <= m.reserved || part.level >= colexec.MaxSpillLevel {
+				continue
+			}
+			if proc.ReserveMemory(size - m.reserved) {
+				m.reserved = size
+				continue
+			}
+
+			// the partition is too large, it's partitioned again at the next level
+			err = ctr.respill(ap, proc, anal, part, m.bat, bats[j+1:], part.Files[i+1:])
+			m.bat = nil
+			return nil, err
+		}
+	}
+	ctr.pending = ctr.pending[:len(ctr.pending)-1]
+	colexec.DeleteSpillFiles(proc, part.Files)
+
+	bat := m.bat
+	m.bat = nil
+	return bat, nil
+}
+
+// respill partitions the merged groups and the unread data of the partition at the next level.
+func (ctr *container) respill(ap *Argument, proc *process.Process, anal process.Analyze, part spilledPartition,
+	merged *batch.Batch, bats []*batch.Batch, files []string) (err error) {
+	parts := make([]colexec.SpillPartition, colexec.SpillPartitionCount)
+	defer func() {
+		merged.Clean(proc.Mp())
+		for _, bat := range bats {
+			bat.Clean(proc.Mp())
+		}
+		if err != nil {
+			for i := range parts {
+				colexec.DeleteSpillFiles(proc, parts[i].Files)
+			}
+		}
+	}()
+
+	if err = spillGroups(ap, proc, anal, merged, parts, part.level+1); err != nil {
+		return err
+	}
+	for _, bat := range bats {
+		if err = spillGroups(ap, proc, anal, bat, parts, part.level+1); err != nil {
+			return err
+		}
+	}
+	for _, bat := range bats {
+		bat.Clean(proc.Mp())
+	}
+	bats = nil
+	for _, file := range files {
+		bats, err = colexec.ReadSpillFile(proc, file)
+		if err != nil {
+			return err
+		}
+		for _, bat := range bats {
+			if err = spillGroups(ap, proc, anal, bat, parts, part.level+1); err != nil {
+				return err
+			}
+		}
+		for _, bat := range bats {
+			bat.Clean(proc.Mp())
+		}
+		bats = nil
+	}
+
+	ctr.pending = ctr.pending[:len(ctr.pending)-1]
+	colexec.DeleteSpillFiles(proc, part.Files)
+	for i := range parts {
+		if !parts[i].IsEmpty() {
+			ctr.pending = append(ctr.pending, spilledPartition{SpillPartition: parts[i], level: part.level + 1})
+		}
+	}
+	return nil
+}
+
+// partitionMerger merges the partial results of the groups like the merge group operator.
+type partitionMerger struct {
+	bat        *batch.Batch
+	intHashMap *hashmap.IntHashMap
+	strHashMap *hashmap.StrHashMap
+	reserved   int64
+
+	inserted  []uint8
+	zInserted []uint8
+}
+
+func (m *partitionMerger) hashMapSize() int64 {
+	if m.intHashMap != nil {
+		return m.intHashMap.Size()
+	}
+	return m.strHashMap.Size()
+}
+
+func (m *partitionMerger) merge(ap *Argument, ctr *container, bat *batch.Batch, proc *process.Process) error {
+	defer bat.Clean(proc.Mp())
+	if m.bat == nil {
+		if err := m.init(ap, ctr, bat, proc); err != nil {
+			return err
+		}
+	}
+
+	var itr hashmap.Iterator
+	if m.intHashMap != nil {
+		itr = m.intHashMap.NewIterator()
+	} else {
+		itr = m.strHashMap.NewIterator()
+	}
+	count := bat.RowCount()
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		var rows uint64
+		if m.intHashMap != nil {
+			rows = m.intHashMap.GroupCount()
+		} else {
+			rows = m.strHashMap.GroupCount()
+		}
+		vals, _, err := itr.Insert(i, n, bat.Vecs)
+		if err != nil {
+			return err
+		}
+		if err = m.batchMerge(i, n, bat, vals, rows, proc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *partitionMerger) init(ap *Argument, ctr *container, bat *batch.Batch, proc *process.Process) (err error) {
+	if ctr.keyWidth <= 8 {
+		if m.intHashMap, err = hashmap.NewIntHashMap(ctr.groupVecsNullable, 0, 0, proc.Mp()); err != nil {
+			return err
+		}
+	} else {
+		if m.strHashMap, err = hashmap.NewStrMap(ctr.groupVecsNullable, 0, 0, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	m.bat = batch.NewWithSize(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		m.bat.Vecs[i] = vector.NewVec(*vec.GetType())
+	}
+	m.bat.Aggs = make([]agg.Agg[any], len(bat.Aggs))
+	for i, ag := range bat.Aggs {
+		if m.bat.Aggs[i], err = agg.NewWithConfig(ap.Aggs[i].Op, ap.Aggs[i].Dist, ag.GetInputTypes()[0], ap.Aggs[i].Config); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *partitionMerger) batchMerge(i int, n int, bat *batch.Batch, vals []uint64, hashRows uint64, proc *process.Process) error {
+	cnt := 0
+	copy(m.inserted[:n], m.zInserted[:n])
+	for k, v := range vals[:n] {
+		if v > hashRows {
+			m.inserted[k] = 1
+			hashRows++
+			cnt++
+		}
+	}
+	m.bat.AddRowCount(cnt)
+
+	if cnt > 0 {
+		for j, vec := range m.bat.Vecs {
+			if err := vec.UnionBatch(bat.Vecs[j], int64(i), cnt, m.inserted[:n], proc.Mp()); err != nil {
+				return err
+			}
+		}
+		for _, ag := range m.bat.Aggs {
+			if err := ag.Grows(cnt, proc.Mp()); err != nil {
+				return err
+			}
+		}
+	}
+	for j, ag := range m.bat.Aggs {
+		if err := ag.BatchMerge(bat.Aggs[j], int64(i), m.inserted[:n], vals); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *partitionMerger) free(proc *process.Process) {
+	if m.bat != nil {
+		m.bat.Clean(proc.Mp())
+		m.bat = nil
+	}
+	if m.intHashMap != nil {
+		m.intHashMap.Free()
+		m.intHashMap = nil
+	}
+	if m.strHashMap != nil {
+		m.strHashMap.Free()
+		m.strHashMap = nil
+	}
+	proc.ReleaseMemory(m.reserved)
+	m.reserved = 0
+}
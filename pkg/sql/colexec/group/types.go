@@ -67,6 +67,16 @@ type container struct {
 	bat *batch.Batch
 
 	hasAggResult bool
+
+	// canSpill is whether the groups are spilled to disk once the memory threshold is exceeded.
+	canSpill bool
+	// reserved is the memory of the groups reserved from the query.
+	reserved int64
+	// spilled are the partitions of the groups spilled to disk.
+	spilled []colexec.SpillPartition
+	// pending are the spilled partitions to be merged after all the input is processed.
+	pending []spilledPartition
+	merging bool
 }
 
 type Argument struct {
@@ -91,6 +101,7 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		ctr.cleanAggVectors()
 		ctr.cleanGroupVectors()
 		ctr.cleanMultiAggVecs()
+		ctr.cleanSpilled(proc)
 	}
 }
 
@@ -154,3 +165,16 @@ func (ctr *container) cleanHashMap() {
 		ctr.strHashMap = nil
 	}
 }
+
+func (ctr *container) cleanSpilled(proc *process.Process) {
+	for i := range ctr.spilled {
+		colexec.DeleteSpillFiles(proc, ctr.spilled[i].Files)
+	}
+	ctr.spilled = nil
+	for i := range ctr.pending {
+		colexec.DeleteSpillFiles(proc, ctr.pending[i].Files)
+	}
+	ctr.pending = nil
+	proc.ReleaseMemory(ctr.reserved)
+	ctr.reserved = 0
+}
@@ -109,13 +109,34 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, _ bool) (proces
 			}
 
 		case Eval:
+			if ctr.spiller != nil {
+				// the join builds the hash table of the spilled partitions one by one.
+				// the batch has no data but the row count of the spilled build side,
+				// otherwise it is dropped as an empty batch before reaching the join.
+				ctr.cleanHashMap()
+				jm, rows := ctr.newSpilledJoinMap(ap, proc)
+				ctr.bat.AuxData = jm
+				ctr.bat.SetRowCount(int(rows))
+				proc.SetInputBatch(ctr.bat)
+				ctr.bat = nil
+				ctr.state = End
+				return process.ExecNext, nil
+			}
 			if ctr.bat != nil && ctr.bat.RowCount() != 0 {
 				if ap.NeedHashMap {
+					var jm *hashmap.JoinMap
 					if ctr.keyWidth <= 8 {
-						ctr.bat.AuxData = hashmap.NewJoinMap(ctr.multiSels, nil, ctr.intHashMap, nil, ctr.hasNull, ap.IsDup)
+						jm = hashmap.NewJoinMap(ctr.multiSels, nil, ctr.intHashMap, nil, ctr.hasNull, ap.IsDup)
 					} else {
-						ctr.bat.AuxData = hashmap.NewJoinMap(ctr.multiSels, nil, nil, ctr.strHashMap, ctr.hasNull, ap.IsDup)
+						jm = hashmap.NewJoinMap(ctr.multiSels, nil, nil, ctr.strHashMap, ctr.hasNull, ap.IsDup)
 					}
+					if reserved := ctr.reserved; reserved > 0 {
+						jm.SetRelease(func() {
+							proc.ReleaseMemory(reserved)
+						})
+						ctr.reserved = 0
+					}
+					ctr.bat.AuxData = jm
 				}
 
 				proc.SetInputBatch(ctr.bat)
@@ -166,6 +187,27 @@ func (ctr *container) mergeBuildBatches(ap *Argument, proc *process.Process, ana
 		anal.Input(bat, isFirst)
 		anal.Alloc(int64(bat.Size()))
 
+		if ap.CanSpill && ctr.spiller == nil && !ctr.reserve(proc, bat) {
+			// the build side exceeds the memory threshold, spill it to disk.
+			if tmpBatch != nil {
+				batches = append(batches, tmpBatch)
+				tmpBatch = nil
+			}
+			if err = ctr.startSpill(proc, anal, batches); err != nil {
+				return err
+			}
+			batches = batches[:0]
+			rowCount = 0
+		}
+		if ctr.spiller != nil {
+			err = ctr.spill(proc, bat)
+			proc.PutBatch(bat)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
 		rowCount += bat.RowCount()
 		if bat.RowCount() >= batchSize/2 {
 			batches = append(batches, bat)
@@ -189,6 +231,9 @@ func (ctr *container) mergeBuildBatches(ap *Argument, proc *process.Process, ana
 		}
 	}
 
+	if ctr.spiller != nil {
+		return ctr.spiller.Flush()
+	}
 	if tmpBatch != nil {
 		batches = append(batches, tmpBatch)
 	}
@@ -212,7 +257,7 @@ func (ctr *container) build(ap *Argument, proc *process.Process, anal process.An
 		return err
 	}
 
-	if ctr.bat == nil || ctr.bat.RowCount() == 0 || !ap.NeedHashMap {
+	if ctr.spiller != nil || ctr.bat == nil || ctr.bat.RowCount() == 0 || !ap.NeedHashMap {
 		return nil
 	}
 
@@ -302,6 +347,19 @@ func (ctr *container) handleRuntimeFilter(ap *Argument, proc *process.Process) e
 		return nil
 	}
 
+	// the rows of the spilled build side are unknown until the join reads them.
+	if ctr.spiller != nil {
+		select {
+		case <-proc.Ctx.Done():
+			ctr.state = End
+
+		case ap.RuntimeFilterSenders[0].Chan <- &pipeline.RuntimeFilter{Typ: pipeline.RuntimeFilter_NO_FILTER}:
+			ctr.state = Eval
+		}
+
+		return nil
+	}
+
 	vec := ctr.vecs[0]
 	if ctr.bat.RowCount() == 0 || vec == nil || vec.Length() == 0 {
 		select {
@@ -426,3 +484,60 @@ func (ctr *container) evalJoinCondition(bat *batch.Batch, proc *process.Process)
 	}
 	return nil
 }
+
+// reserve reserves the memory of the build batch from the query,
+// it returns false if the memory threshold is exceeded.
+func (ctr *container) reserve(proc *process.Process, bat *batch.Batch) bool {
+	size := int64(bat.Size())
+	if !proc.ReserveMemory(size) {
+		return false
+	}
+	ctr.reserved += size
+	return true
+}
+
+// startSpill spills the build batches received so far, and releases their memory.
+func (ctr *container) startSpill(proc *process.Process, anal process.Analyze, batches []*batch.Batch) error {
+	ctr.spiller = colexec.NewSpiller(proc, anal, 0)
+	for i, bat := range batches {
+		err := ctr.spill(proc, bat)
+		proc.PutBatch(bat)
+		if err != nil {
+			for _, b := range batches[i+1:] {
+				proc.PutBatch(b)
+			}
+			return err
+		}
+	}
+	proc.ReleaseMemory(ctr.reserved)
+	ctr.reserved = 0
+	return nil
+}
+
+func (ctr *container) spill(proc *process.Process, bat *batch.Batch) error {
+	if err := ctr.evalJoinCondition(bat, proc); err != nil {
+		return err
+	}
+	return ctr.spiller.Append(bat, ctr.vecs)
+}
+
+// newSpilledJoinMap returns the join map of the spilled partitions and the number of the
+// spilled rows, the files are deleted once all the joins have processed them.
+func (ctr *container) newSpilledJoinMap(ap *Argument, proc *process.Process) (*hashmap.JoinMap, int64) {
+	parts := ctr.spiller.Partitions
+	ctr.spiller.Free(false)
+	ctr.spiller = nil
+
+	var rows int64
+	for i := range parts {
+		rows += parts[i].Rows
+	}
+
+	jm := hashmap.NewSpilledJoinMap(parts, ap.IsDup)
+	jm.SetRelease(func() {
+		for i := range parts {
+			colexec.DeleteSpillFiles(proc, parts[i].Files)
+		}
+	})
+	return jm, rows
+}
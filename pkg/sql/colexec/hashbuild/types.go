@@ -53,6 +53,11 @@ type container struct {
 	intHashMap *hashmap.IntHashMap
 	strHashMap *hashmap.StrHashMap
 	keyWidth   int // keyWidth is the width of hash columns, it determines which hash map to use.
+
+	// reserved is the memory of the build batches reserved from the query.
+	reserved int64
+	// spiller partitions the build batches to disk once the memory threshold is exceeded.
+	spiller *colexec.Spiller
 }
 
 type Argument struct {
@@ -68,6 +73,12 @@ type Argument struct {
 
 	HashOnPK             bool
 	RuntimeFilterSenders []*colexec.RuntimeFilterChan
+	// CanSpill is whether the build side can be spilled to disk, the join
+	// must be able to process the spilled partitions.
+	// Only the inner join (package join) does it now. The left, semi, anti,
+	// mark, single and right joins always build the whole hash table in
+	// memory, whatever the memory threshold of the query is.
+	CanSpill bool
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
@@ -79,6 +90,9 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		if !arg.NeedHashMap {
 			ctr.cleanHashMap()
 		}
+		ctr.cleanSpiller()
+		proc.ReleaseMemory(ctr.reserved)
+		ctr.reserved = 0
 		ctr.FreeMergeTypeOperator(pipelineFailed)
 		if ctr.isMerge {
 			ctr.FreeMergeTypeOperator(pipelineFailed)
@@ -88,6 +102,13 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 	}
 }
 
+func (ctr *container) cleanSpiller() {
+	if ctr.spiller != nil {
+		ctr.spiller.Free(true)
+		ctr.spiller = nil
+	}
+}
+
 func (ctr *container) cleanBatch(mp *mpool.MPool) {
 	if ctr.bat != nil {
 		ctr.bat.Clean(mp)
@@ -59,9 +59,11 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (p
 			if err := ctr.build(proc, anal); err != nil {
 				return process.ExecNext, err
 			}
-			if ctr.mp == nil {
+			if ctr.mp == nil && ctr.spill == nil {
 				// for inner ,right and semi join, if hashmap is empty, we can finish this pipeline
 				ctr.state = End
+			} else if ctr.spill != nil {
+				ctr.state = SpillProbe
 			} else {
 				ctr.state = Probe
 			}
@@ -92,6 +94,43 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (p
 			}
 			return process.ExecNext, nil
 
+		case SpillProbe:
+			bat, _, err := ctr.ReceiveFromSingleReg(0, anal)
+			if err != nil {
+				return process.ExecNext, err
+			}
+
+			if bat == nil {
+				if err = ctr.startSpillJoin(proc); err != nil {
+					return process.ExecNext, err
+				}
+				ctr.state = SpillJoin
+				continue
+			}
+			if bat.Last() {
+				proc.SetInputBatch(bat)
+				return process.ExecNext, nil
+			}
+			if bat.IsEmpty() {
+				proc.PutBatch(bat)
+				continue
+			}
+			anal.Input(bat, isFirst)
+			if err = ctr.spillProbe(bat, proc); err != nil {
+				return process.ExecNext, err
+			}
+
+		case SpillJoin:
+			ok, err := ctr.spillJoin(ap, proc, anal, false, isLast)
+			if err != nil {
+				return process.ExecNext, err
+			}
+			if !ok {
+				ctr.state = End
+				continue
+			}
+			return process.ExecNext, nil
+
 		default:
 			proc.SetInputBatch(nil)
 			return process.ExecStop, nil
@@ -108,6 +147,17 @@ func (ctr *container) build(proc *process.Process, anal process.Analyze) error {
 	if bat != nil {
 		ctr.bat = bat
 		ctr.mp = bat.DupJmAuxData()
+		if ctr.mp.Spilled() != nil {
+			// the build side is spilled to disk, the probe side is spilled the same way,
+			// and each pair of the partitions is joined in memory.
+			ctr.spill = &spillState{
+				jm:      ctr.mp,
+				spiller: colexec.NewSpiller(proc, anal, 0),
+			}
+			ctr.mp = nil
+			ctr.cleanBatch(proc.Mp())
+			return nil
+		}
 		anal.Alloc(ctr.mp.Size())
 	}
 	return nil
@@ -23,6 +23,9 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/hashbuild"
@@ -112,6 +115,68 @@ func TestJoin(t *testing.T) {
 	}
 }
 
+func TestJoinSpill(t *testing.T) {
+	tc := newTestCase([]bool{false}, []types.Type{types.T_int64.ToType()}, []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0)},
+		[][]*plan.Expr{
+			{
+				newExpr(0, types.T_int64.ToType()),
+			},
+			{
+				newExpr(0, types.T_int64.ToType()),
+			},
+		})
+	tc.barg.CanSpill = true
+	tc.proc.Lim.Size = 1
+	tc.proc.AnalInfos = []*process.AnalyzeInfo{process.NewAnalyzeInfo(0)}
+	nb0 := tc.proc.Mp().CurrNB()
+
+	err := hashbuild.Prepare(tc.proc, tc.barg)
+	require.NoError(t, err)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, 1000)
+	tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, 1000)
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- nil
+	_, err = hashbuild.Call(0, tc.proc, tc.barg, false, false)
+	require.NoError(t, err)
+	bat := tc.proc.Reg.InputBatch
+	jm, ok := bat.AuxData.(*hashmap.JoinMap)
+	require.True(t, ok)
+	require.NotNil(t, jm.Spilled())
+	require.False(t, bat.IsEmpty())
+	jm.SetDupCount(int64(1))
+	tc.barg.Free(tc.proc, false)
+
+	err = Prepare(tc.proc, tc.arg)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.flgs, tc.types, tc.proc, 1000)
+	}
+	tc.proc.Reg.MergeReceivers[0].Ch <- nil
+	tc.proc.Reg.MergeReceivers[1].Ch <- bat
+	rows := 0
+	for {
+		if ok, err := Call(0, tc.proc, tc.arg, false, false); ok == process.ExecStop || err != nil {
+			require.NoError(t, err)
+			break
+		}
+		rbat := tc.proc.Reg.InputBatch
+		require.Equal(t, vector.MustFixedCol[int64](rbat.Vecs[0]), vector.MustFixedCol[int64](rbat.Vecs[1]))
+		rows += rbat.RowCount()
+		rbat.Clean(tc.proc.Mp())
+	}
+	require.Equal(t, 6000, rows)
+	require.Less(t, int64(0), tc.proc.AnalInfos[0].SpillCount)
+
+	tc.arg.Free(tc.proc, false)
+	tc.proc.FreeVectors()
+	require.Equal(t, nb0, tc.proc.Mp().CurrNB())
+	fs, err := fileservice.Get[fileservice.FileService](tc.proc.FileService, defines.LocalFileServiceName)
+	require.NoError(t, err)
+	entries, err := fs.List(context.Background(), "spill/"+tc.proc.Id)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+}
+
 /*
 func TestLowCardinalityJoin(t *testing.T) {
 	tc := newTestCase([]bool{false}, []types.Type{types.T_varchar.ToType()}, []colexec.ResultPos{colexec.NewResultPos(1, 0)},
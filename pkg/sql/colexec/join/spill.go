@@ -0,0 +1,366 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/hashmap"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// spilledPair is a partition of the build side and the probe side spilled to disk,
+// the rows of both sides with the same join keys are in the same pair.
+type spilledPair struct {
+	build colexec.SpillPartition
+	probe colexec.SpillPartition
+	level int
+}
+
+// spillState is the state of a join whose build side is spilled to disk.
+type spillState struct {
+	// jm is the join map of the spilled build side, it's shared by all the joins of a broadcast.
+	jm *hashmap.JoinMap
+
+	spiller *colexec.Spiller
+	pending []spilledPair
+
+	// the pair being joined, and the probe batches not joined yet.
+	pair       *spilledPair
+	probeFiles []string
+	probeBats  []*batch.Batch
+	reserved   int64
+
+	buildEvecs []evalVector
+	buildVecs  []*vector.Vector
+}
+
+// spillProbe partitions the probe batch the same way as the build side.
+func (ctr *container) spillProbe(bat *batch.Batch, proc *process.Process) error {
+	defer proc.PutBatch(bat)
+	if err := ctr.evalJoinCondition(bat, proc); err != nil {
+		return err
+	}
+	return ctr.spill.spiller.Append(bat, ctr.vecs)
+}
+
+// startSpillJoin pairs the spilled partitions of both sides once all the probe batches are spilled.
+func (ctr *container) startSpillJoin(proc *process.Process) error {
+	s := ctr.spill
+	if err := s.spiller.Flush(); err != nil {
+		return err
+	}
+	builds := s.jm.Spilled().([]colexec.SpillPartition)
+	probes := s.spiller.Partitions
+	s.spiller.Free(false)
+	s.spiller = nil
+	for i := range probes {
+		if builds[i].IsEmpty() || probes[i].IsEmpty() {
+			colexec.DeleteSpillFiles(proc, probes[i].Files)
+			continue
+		}
+		s.pending = append(s.pending, spilledPair{build: builds[i], probe: probes[i]})
+	}
+	return nil
+}
+
+// spillJoin joins the next probe batch of the spilled pairs, it returns false if all the pairs are joined.
+func (ctr *container) spillJoin(ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool, isLast bool) (bool, error) {
+	s := ctr.spill
+	for {
+		if s.pair == nil {
+			if len(s.pending) == 0 {
+				return false, nil
+			}
+			if err := ctr.loadPair(ap, proc, anal); err != nil {
+				return false, err
+			}
+			continue
+		}
+
+		if len(s.probeBats) == 0 {
+			if len(s.probeFiles) == 0 {
+				ctr.finishPair(proc)
+				continue
+			}
+			bats, err := colexec.ReadSpillFile(proc, s.probeFiles[0])
+			if err != nil {
+				return false, err
+			}
+			s.probeFiles = s.probeFiles[1:]
+			s.probeBats = bats
+			continue
+		}
+
+		bat := s.probeBats[0]
+		s.probeBats = s.probeBats[1:]
+		if err := ctr.probe(bat, ap, proc, anal, isFirst, isLast); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+}
+
+// loadPair builds the hash table of the next pair, the pair is partitioned again
+// if its build side exceeds the memory threshold.
+func (ctr *container) loadPair(ap *Argument, proc *process.Process, anal process.Analyze) error {
+	s := ctr.spill
+	pair := s.pending[len(s.pending)-1]
+	s.pending = s.pending[:len(s.pending)-1]
+	if proc.ReserveMemory(pair.build.Size) {
+		s.reserved = pair.build.Size
+	} else if pair.level < colexec.MaxSpillLevel {
+		return ctr.respill(ap, proc, anal, pair)
+	}
+
+	s.pair = &pair
+	s.probeFiles = pair.probe.Files
+
+	bat := batch.NewWithSize(len(ap.Typs))
+	for i, typ := range ap.Typs {
+		bat.Vecs[i] = vector.NewVec(typ)
+	}
+	ctr.bat = bat
+	for _, file := range pair.build.Files {
+		bats, err := colexec.ReadSpillFile(proc, file)
+		if err != nil {
+			return err
+		}
+		for i, b := range bats {
+			ctr.bat, err = ctr.bat.Append(proc.Ctx, proc.Mp(), b)
+			b.Clean(proc.Mp())
+			if err != nil {
+				for _, b := range bats[i+1:] {
+					b.Clean(proc.Mp())
+				}
+				return err
+			}
+		}
+	}
+	if pair.level > 0 {
+		colexec.DeleteSpillFiles(proc, pair.build.Files)
+	}
+	s.pair.build.Files = nil
+	return ctr.buildHashMap(ap, proc)
+}
+
+// respill partitions both sides of the pair at the next level.
+func (ctr *container) respill(ap *Argument, proc *process.Process, anal process.Analyze, pair spilledPair) error {
+	s := ctr.spill
+	builds := colexec.NewSpiller(proc, anal, pair.level+1)
+	probes := colexec.NewSpiller(proc, anal, pair.level+1)
+	defer builds.Free(true)
+	defer probes.Free(true)
+
+	if err := ctr.newBuildExecutors(ap, proc); err != nil {
+		return err
+	}
+	if err := respillFiles(proc, builds, pair.build.Files, func(bat *batch.Batch) ([]*vector.Vector, error) {
+		for i := range s.buildEvecs {
+			vec, err := s.buildEvecs[i].executor.Eval(proc, []*batch.Batch{bat})
+			if err != nil {
+				return nil, err
+			}
+			s.buildVecs[i] = vec
+		}
+		return s.buildVecs, nil
+	}); err != nil {
+		return err
+	}
+	if err := respillFiles(proc, probes, pair.probe.Files, func(bat *batch.Batch) ([]*vector.Vector, error) {
+		if err := ctr.evalJoinCondition(bat, proc); err != nil {
+			return nil, err
+		}
+		return ctr.vecs, nil
+	}); err != nil {
+		return err
+	}
+
+	if pair.level > 0 {
+		colexec.DeleteSpillFiles(proc, pair.build.Files)
+	}
+	colexec.DeleteSpillFiles(proc, pair.probe.Files)
+	for i := range builds.Partitions {
+		if builds.Partitions[i].IsEmpty() || probes.Partitions[i].IsEmpty() {
+			continue
+		}
+		s.pending = append(s.pending, spilledPair{
+			build: builds.Partitions[i],
+			probe: probes.Partitions[i],
+			level: pair.level + 1,
+		})
+		// the files are taken by the pending pair
+		builds.Partitions[i].Files = nil
+		probes.Partitions[i].Files = nil
+	}
+	return nil
+}
+
+func respillFiles(proc *process.Process, spiller *colexec.Spiller, files []string, keys func(*batch.Batch) ([]*vector.Vector, error)) error {
+	for _, file := range files {
+		bats, err := colexec.ReadSpillFile(proc, file)
+		if err != nil {
+			return err
+		}
+		for i, bat := range bats {
+			vecs, err := keys(bat)
+			if err == nil {
+				err = spiller.Append(bat, vecs)
+			}
+			bat.Clean(proc.Mp())
+			if err != nil {
+				for _, b := range bats[i+1:] {
+					b.Clean(proc.Mp())
+				}
+				return err
+			}
+		}
+	}
+	return spiller.Flush()
+}
+
+// newBuildExecutors creates the executors of the build side join conditions.
+func (ctr *container) newBuildExecutors(ap *Argument, proc *process.Process) (err error) {
+	s := ctr.spill
+	if s.buildEvecs != nil {
+		return nil
+	}
+	s.buildEvecs = make([]evalVector, len(ap.Conditions[1]))
+	s.buildVecs = make([]*vector.Vector, len(ap.Conditions[1]))
+	for i := range s.buildEvecs {
+		if s.buildEvecs[i].executor, err = colexec.NewExpressionExecutor(proc, ap.Conditions[1][i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildHashMap builds the hash table of the build batch like the hash build operator.
+func (ctr *container) buildHashMap(ap *Argument, proc *process.Process) (err error) {
+	s := ctr.spill
+	if err = ctr.newBuildExecutors(ap, proc); err != nil {
+		return err
+	}
+	for i := range s.buildEvecs {
+		if s.buildVecs[i], err = s.buildEvecs[i].executor.Eval(proc, []*batch.Batch{ctr.bat}); err != nil {
+			return err
+		}
+	}
+
+	keyWidth := 0
+	for _, expr := range ap.Conditions[1] {
+		width := types.T(expr.Typ.Id).TypeLen()
+		if types.T(expr.Typ.Id).FixedLength() < 0 {
+			width = 128
+		}
+		keyWidth += width
+	}
+	var ihm *hashmap.IntHashMap
+	var shm *hashmap.StrHashMap
+	var itr hashmap.Iterator
+	if keyWidth <= 8 {
+		if ihm, err = hashmap.NewIntHashMap(false, ap.Ibucket, ap.Nbucket, proc.Mp()); err != nil {
+			return err
+		}
+		itr = ihm.NewIterator()
+	} else {
+		if shm, err = hashmap.NewStrMap(false, ap.Ibucket, ap.Nbucket, proc.Mp()); err != nil {
+			return err
+		}
+		itr = shm.NewIterator()
+	}
+
+	count := ctr.bat.RowCount()
+	var multiSels [][]int32
+	if !ap.HashOnPK {
+		multiSels = make([][]int32, count)
+	}
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		vals, zvals, err := itr.Insert(i, n, s.buildVecs)
+		if err != nil {
+			if ihm != nil {
+				ihm.Free()
+			} else {
+				shm.Free()
+			}
+			return err
+		}
+		if ap.HashOnPK {
+			continue
+		}
+		for k, v := range vals[:n] {
+			if zvals[k] == 0 || v == 0 {
+				continue
+			}
+			multiSels[v-1] = append(multiSels[v-1], int32(i+k))
+		}
+	}
+	ctr.mp = hashmap.NewJoinMap(multiSels, nil, ihm, shm, false, false)
+	return nil
+}
+
+// finishPair frees the hash table of the joined pair.
+func (ctr *container) finishPair(proc *process.Process) {
+	s := ctr.spill
+	colexec.DeleteSpillFiles(proc, s.pair.probe.Files)
+	s.pair = nil
+	ctr.cleanHashMap()
+	ctr.cleanBatch(proc.Mp())
+	proc.ReleaseMemory(s.reserved)
+	s.reserved = 0
+}
+
+func (s *spillState) free(proc *process.Process) {
+	for _, bat := range s.probeBats {
+		bat.Clean(proc.Mp())
+	}
+	s.probeBats = nil
+	if s.pair != nil {
+		if s.pair.level > 0 {
+			colexec.DeleteSpillFiles(proc, s.pair.build.Files)
+		}
+		colexec.DeleteSpillFiles(proc, s.pair.probe.Files)
+		s.pair = nil
+	}
+	for _, pair := range s.pending {
+		if pair.level > 0 {
+			colexec.DeleteSpillFiles(proc, pair.build.Files)
+		}
+		colexec.DeleteSpillFiles(proc, pair.probe.Files)
+	}
+	s.pending = nil
+	if s.spiller != nil {
+		s.spiller.Free(true)
+		s.spiller = nil
+	}
+	for i := range s.buildEvecs {
+		if s.buildEvecs[i].executor != nil {
+			s.buildEvecs[i].executor.Free()
+		}
+	}
+	s.buildEvecs = nil
+	proc.ReleaseMemory(s.reserved)
+	s.reserved = 0
+	if s.jm != nil {
+		s.jm.Free()
+		s.jm = nil
+	}
+}
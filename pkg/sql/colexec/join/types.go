@@ -28,6 +28,8 @@ import (
 const (
 	Build = iota
 	Probe
+	SpillProbe
+	SpillJoin
 	End
 )
 
@@ -57,6 +59,9 @@ type container struct {
 	vecs  []*vector.Vector
 
 	mp *hashmap.JoinMap
+
+	// spill is not nil if the build side is spilled to disk.
+	spill *spillState
 }
 
 type Argument struct {
@@ -80,6 +85,10 @@ func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 		ctr.cleanEvalVectors()
 		ctr.cleanHashMap()
 		ctr.cleanExprExecutor()
+		if ctr.spill != nil {
+			ctr.spill.free(proc)
+			ctr.spill = nil
+		}
 		ctr.FreeAllReg()
 	}
 }
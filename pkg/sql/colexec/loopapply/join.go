@@ -0,0 +1,191 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loopapply
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func String(arg any, buf *bytes.Buffer) {
+	ap := arg.(*Argument)
+	if ap.IsOuter {
+		buf.WriteString(" loop outer apply ")
+	} else {
+		buf.WriteString(" loop apply ")
+	}
+}
+
+func Prepare(proc *process.Process, arg any) error {
+	var err error
+
+	ap := arg.(*Argument)
+	ap.ctr = new(container)
+	ap.ctr.InitReceiver(proc, false)
+	ap.ctr.bat = batch.NewWithSize(len(ap.Typs))
+	for i, typ := range ap.Typs {
+		ap.ctr.bat.Vecs[i] = vector.NewVec(typ)
+	}
+
+	if ap.Cond != nil {
+		ap.ctr.expr, err = colexec.NewExpressionExecutor(proc, ap.Cond)
+	}
+	return err
+}
+
+func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (process.ExecStatus, error) {
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	ap := arg.(*Argument)
+	ctr := ap.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(proc, anal); err != nil {
+				return process.ExecNext, err
+			}
+			if ctr.bat.RowCount() == 0 && !ap.IsOuter {
+				ctr.state = End
+			} else {
+				ctr.state = Probe
+			}
+
+		case Probe:
+			bat, _, err := ctr.ReceiveFromSingleReg(0, anal)
+			if err != nil {
+				return process.ExecNext, err
+			}
+
+			if bat == nil {
+				ctr.state = End
+				continue
+			}
+			if bat.IsEmpty() {
+				proc.PutBatch(bat)
+				continue
+			}
+			err = ctr.probe(bat, ap, proc, anal, isFirst, isLast)
+			proc.PutBatch(bat)
+			return process.ExecNext, err
+
+		default:
+			proc.SetInputBatch(nil)
+			return process.ExecStop, nil
+		}
+	}
+}
+
+func (ctr *container) build(proc *process.Process, anal process.Analyze) error {
+	bat, _, err := ctr.ReceiveFromSingleReg(1, anal)
+	if err != nil {
+		return err
+	}
+
+	if bat != nil {
+		ctr.bat.Clean(proc.Mp())
+		ctr.bat = bat
+	}
+	return nil
+}
+
+func (ctr *container) probe(bat *batch.Batch, ap *Argument, proc *process.Process, anal process.Analyze, isFirst bool, isLast bool) error {
+	anal.Input(bat, isFirst)
+	rbat := batch.NewWithSize(len(ap.Result))
+	for i, rp := range ap.Result {
+		if rp.Rel == 0 {
+			rbat.Vecs[i] = proc.GetVector(*bat.Vecs[rp.Pos].GetType())
+		} else {
+			rbat.Vecs[i] = proc.GetVector(ap.Typs[rp.Pos])
+		}
+	}
+	if ctr.expr != nil && ctr.joinBat == nil {
+		ctr.joinBat, ctr.cfs = colexec.NewJoinBatch(bat, proc.Mp())
+	}
+
+	count := bat.RowCount()
+	rows := ctr.bat.RowCount()
+	rowCount := 0
+	for i := 0; i < count; i++ {
+		var rs vector.FunctionParameterWrapper[bool]
+		if ctr.expr != nil && rows > 0 {
+			if err := colexec.SetJoinBatchValues(ctr.joinBat, bat, int64(i), rows, ctr.cfs); err != nil {
+				rbat.Clean(proc.Mp())
+				return err
+			}
+			vec, err := ctr.expr.Eval(proc, []*batch.Batch{ctr.joinBat, ctr.bat})
+			if err != nil {
+				rbat.Clean(proc.Mp())
+				return err
+			}
+			rs = vector.GenerateFunctionFixedTypeParameter[bool](vec)
+		}
+
+		// the right side is read in order, so the first matches are the
+		// rows kept by the LIMIT clause of the derived table.
+		matched, emitted := uint64(0), uint64(0)
+		for j := 0; j < rows && emitted < ap.Limit; j++ {
+			if rs != nil {
+				if b, null := rs.GetValue(uint64(j)); null || !b {
+					continue
+				}
+			}
+			if matched++; matched <= ap.Offset {
+				continue
+			}
+			if err := ctr.appendRow(rbat, bat, ap, i, j, proc); err != nil {
+				rbat.Clean(proc.Mp())
+				return err
+			}
+			emitted++
+		}
+		if emitted == 0 && ap.IsOuter {
+			if err := ctr.appendRow(rbat, bat, ap, i, -1, proc); err != nil {
+				rbat.Clean(proc.Mp())
+				return err
+			}
+			emitted++
+		}
+		rowCount += int(emitted)
+	}
+	rbat.SetRowCount(rowCount)
+
+	anal.Output(rbat, isLast)
+	proc.SetInputBatch(rbat)
+	return nil
+}
+
+// appendRow appends the i-th row of the left side joined with the j-th row of
+// the right side to rbat, the right side is null if j is negative.
+func (ctr *container) appendRow(rbat, bat *batch.Batch, ap *Argument, i, j int, proc *process.Process) error {
+	for k, rp := range ap.Result {
+		var err error
+		if rp.Rel == 0 {
+			err = rbat.Vecs[k].UnionOne(bat.Vecs[rp.Pos], int64(i), proc.Mp())
+		} else if j < 0 {
+			err = rbat.Vecs[k].UnionNull(proc.Mp())
+		} else {
+			err = rbat.Vecs[k].UnionOne(ctr.bat.Vecs[rp.Pos], int64(j), proc.Mp())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
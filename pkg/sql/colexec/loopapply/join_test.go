@@ -0,0 +1,192 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loopapply
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+type applyTestCase struct {
+	arg    *Argument
+	proc   *process.Process
+	cancel context.CancelFunc
+	expect []string
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{}, buf)
+	String(&Argument{IsOuter: true}, buf)
+	require.Equal(t, " loop apply  loop outer apply ", buf.String())
+}
+
+func TestApply(t *testing.T) {
+	tcs := []applyTestCase{
+		newTestCase(false, NoLimit, 0, []string{"1 1 10", "1 1 11", "1 1 12", "2 2 20", "3 3 30", "3 3 31"}),
+		newTestCase(false, 2, 0, []string{"1 1 10", "1 1 11", "2 2 20", "3 3 30", "3 3 31"}),
+		newTestCase(false, 1, 1, []string{"1 1 11", "3 3 31"}),
+		newTestCase(true, 1, 1, []string{"1 1 11", "2 NULL NULL", "3 3 31", "4 NULL NULL"}),
+		newTestCase(true, 0, 0, []string{"1 NULL NULL", "2 NULL NULL", "3 NULL NULL", "4 NULL NULL"}),
+	}
+	for _, tc := range tcs {
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		tc.proc.Reg.MergeReceivers[0].Ch <- newLeftBatch(tc.proc)
+		tc.proc.Reg.MergeReceivers[0].Ch <- batch.EmptyBatch
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- newRightBatch(tc.proc)
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+
+		var rows []string
+		for {
+			ok, err := Call(0, tc.proc, tc.arg, false, false)
+			require.NoError(t, err)
+			if ok == process.ExecStop {
+				break
+			}
+			rows = append(rows, batchRows(tc.proc.InputBatch())...)
+			tc.proc.InputBatch().Clean(tc.proc.Mp())
+		}
+		require.Equal(t, tc.expect, rows)
+		tc.arg.Free(tc.proc, false)
+		tc.proc.FreeVectors()
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
+func TestApplyWithEmptyRight(t *testing.T) {
+	for _, isOuter := range []bool{false, true} {
+		tc := newTestCase(isOuter, NoLimit, 0, nil)
+		require.NoError(t, Prepare(tc.proc, tc.arg))
+		tc.proc.Reg.MergeReceivers[0].Ch <- newLeftBatch(tc.proc)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+
+		var rows []string
+		for {
+			ok, err := Call(0, tc.proc, tc.arg, false, false)
+			require.NoError(t, err)
+			if ok == process.ExecStop {
+				break
+			}
+			rows = append(rows, batchRows(tc.proc.InputBatch())...)
+			tc.proc.InputBatch().Clean(tc.proc.Mp())
+		}
+		if isOuter {
+			require.Equal(t, []string{"1 NULL NULL", "2 NULL NULL", "3 NULL NULL", "4 NULL NULL"}, rows)
+		} else {
+			require.Empty(t, rows)
+			// the left side is not read, drain it for the memory check
+			bat := <-tc.proc.Reg.MergeReceivers[0].Ch
+			bat.Clean(tc.proc.Mp())
+		}
+		tc.arg.Free(tc.proc, false)
+		tc.proc.FreeVectors()
+		require.Equal(t, int64(0), tc.proc.Mp().CurrNB())
+	}
+}
+
+func newTestCase(isOuter bool, limit, offset uint64, expect []string) applyTestCase {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	proc.Reg.MergeReceivers = make([]*process.WaitRegister, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	proc.Reg.MergeReceivers[0] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 4),
+	}
+	proc.Reg.MergeReceivers[1] = &process.WaitRegister{
+		Ctx: ctx,
+		Ch:  make(chan *batch.Batch, 4),
+	}
+	typ := types.T_int64.ToType()
+	fr, _ := function.GetFunctionByName(ctx, "=", []types.Type{typ, typ})
+	cond := &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_bool)},
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: fr.GetEncodedOverloadID(), ObjName: "="},
+				Args: []*plan.Expr{
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: 0, ColPos: 0}},
+					},
+					{
+						Typ:  &plan.Type{Id: int32(types.T_int64)},
+						Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: 1, ColPos: 0}},
+					},
+				},
+			},
+		},
+	}
+	return applyTestCase{
+		proc:   proc,
+		cancel: cancel,
+		expect: expect,
+		arg: &Argument{
+			Typs:    []types.Type{typ, typ},
+			Cond:    cond,
+			Result:  []colexec.ResultPos{colexec.NewResultPos(0, 0), colexec.NewResultPos(1, 0), colexec.NewResultPos(1, 1)},
+			IsOuter: isOuter,
+			Limit:   limit,
+			Offset:  offset,
+		},
+	}
+}
+
+func newLeftBatch(proc *process.Process) *batch.Batch {
+	typ := types.T_int64.ToType()
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(4, typ, proc.Mp(), false, []int64{1, 2, 3, 4}),
+	}, nil)
+}
+
+// newRightBatch returns the sorted right side, the key 2 has only one row.
+func newRightBatch(proc *process.Process) *batch.Batch {
+	typ := types.T_int64.ToType()
+	return testutil.NewBatchWithVectors([]*vector.Vector{
+		testutil.NewInt64Vector(6, typ, proc.Mp(), false, []int64{1, 1, 1, 2, 3, 3}),
+		testutil.NewInt64Vector(6, typ, proc.Mp(), false, []int64{10, 11, 12, 20, 30, 31}),
+	}, nil)
+}
+
+func batchRows(bat *batch.Batch) []string {
+	rows := make([]string, bat.RowCount())
+	for i := range rows {
+		for j, vec := range bat.Vecs {
+			if j > 0 {
+				rows[i] += " "
+			}
+			if vec.GetNulls().Contains(uint64(i)) {
+				rows[i] += "NULL"
+			} else {
+				rows[i] += fmt.Sprint(vector.GetFixedAt[int64](vec, i))
+			}
+		}
+	}
+	return rows
+}
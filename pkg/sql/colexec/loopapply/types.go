@@ -0,0 +1,89 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loopapply
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+// NoLimit is the Limit of an apply without LIMIT clause.
+const NoLimit = math.MaxUint64
+
+type container struct {
+	colexec.ReceiverOperator
+
+	state   int
+	bat     *batch.Batch
+	joinBat *batch.Batch
+	expr    colexec.ExpressionExecutor
+	cfs     []func(*vector.Vector, *vector.Vector, int64, int) error
+}
+
+// Argument of the nested loop apply. The right side is the correlated derived
+// table with its correlated predicates pulled up into Cond, and it is read in
+// order. For each row of the left side, the matched rows of the right side are
+// cut by Offset and Limit, which are the LIMIT clause of the derived table.
+type Argument struct {
+	ctr    *container
+	Typs   []types.Type
+	Cond   *plan.Expr
+	Result []colexec.ResultPos
+	// IsOuter is set for LEFT JOIN LATERAL, a row of the left side without
+	// any match is joined with nulls.
+	IsOuter bool
+	Limit   uint64
+	Offset  uint64
+}
+
+func (ap *Argument) Free(proc *process.Process, pipelineFailed bool) {
+	if ctr := ap.ctr; ctr != nil {
+		ctr.cleanBatch(proc.Mp())
+		ctr.cleanExprExecutor()
+		ctr.FreeAllReg()
+		ap.ctr = nil
+	}
+}
+
+func (ctr *container) cleanBatch(mp *mpool.MPool) {
+	if ctr.bat != nil {
+		ctr.bat.Clean(mp)
+		ctr.bat = nil
+	}
+	if ctr.joinBat != nil {
+		ctr.joinBat.Clean(mp)
+		ctr.joinBat = nil
+	}
+}
+
+func (ctr *container) cleanExprExecutor() {
+	if ctr.expr != nil {
+		ctr.expr.Free()
+		ctr.expr = nil
+	}
+}
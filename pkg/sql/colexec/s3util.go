@@ -18,6 +18,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -51,6 +52,12 @@ type S3Writer struct {
 	tablename     string
 	attrs         []string
 
+	// compression of the column data, from the table's COMPRESSION option.
+	// The block writer's default is used if not set.
+	compressSet   bool
+	compressAlg   compress.T
+	compressLevel int
+
 	writer  *blockio.BlockWriter
 	lengths []uint64
 
@@ -119,6 +126,12 @@ func (w *S3Writer) SetTableName(name string) {
 	w.tablename = name
 }
 
+func (w *S3Writer) SetCompression(alg compress.T, level int) {
+	w.compressSet = true
+	w.compressAlg = alg
+	w.compressLevel = level
+}
+
 func (w *S3Writer) SetSeqnums(seqnums []uint16) {
 	w.seqnums = seqnums
 	logutil.Debugf("s3 table set directly %q seqnums: %+v", w.tablename, w.seqnums)
@@ -133,6 +146,7 @@ func AllocS3Writer(proc *process.Process, tableDef *plan.TableDef) (*S3Writer, e
 		pk:             -1,
 		partitionIndex: 0,
 	}
+	writer.SetCompression(catalog.GetTableDefCompression(tableDef))
 
 	writer.ResetBlockInfoBat(proc)
 	for i, colDef := range tableDef.Cols {
@@ -195,6 +209,7 @@ func AllocPartitionS3Writer(proc *process.Process, tableDef *plan.TableDef) ([]*
 			pk:             -1,
 			partitionIndex: int16(i), // This value is aligned with the partition number
 		}
+		writers[i].SetCompression(catalog.GetTableDefCompression(tableDef))
 
 		writers[i].ResetBlockInfoBat(proc)
 		for j, colDef := range tableDef.Cols {
@@ -567,6 +582,9 @@ func (w *S3Writer) generateWriter(proc *process.Process) (objectio.ObjectName, e
 	if err != nil {
 		return nil, err
 	}
+	if w.compressSet {
+		w.writer.SetCompression(w.compressAlg, w.compressLevel)
+	}
 	w.lengths = w.lengths[:0]
 	return obj, err
 }
@@ -0,0 +1,315 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/google/uuid"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	// SpillPartitionCount is the number of partitions the data of a hash operator is spilled to.
+	SpillPartitionCount = 16
+	// MaxSpillLevel is the max times a spilled partition is partitioned again, a partition
+	// still exceeding the memory threshold at the last level is processed in memory anyway.
+	MaxSpillLevel = 3
+	// SpillBatchRows is the number of rows buffered for a partition before it is written.
+	SpillBatchRows = 8192
+
+	// spillDir is the directory of the spilled files in the local file service.
+	spillDir = "spill"
+	// spillDeleteTimeout is the timeout to delete the spilled files.
+	spillDeleteTimeout = time.Minute
+)
+
+// SpillPartition is a partition of the data spilled to disk,
+// every file of it holds the batches written at once.
+type SpillPartition struct {
+	Files []string
+	Rows  int64
+	Size  int64
+}
+
+func (p *SpillPartition) IsEmpty() bool {
+	return len(p.Files) == 0
+}
+
+// SpillPartitionSels returns the rows of every partition by the hash of the keys,
+// the keys are hashed with a different seed at each level, so the rows of a partition
+// are partitioned again at the next level.
+func SpillPartitionSels(keys []*vector.Vector, rowCount int, level int) [][]int32 {
+	sels := make([][]int32, SpillPartitionCount)
+	seed := []byte{byte(level)}
+	notNull := []byte{1}
+	null := []byte{0}
+	h := xxhash.New()
+	for i := 0; i < rowCount; i++ {
+		h.Reset()
+		_, _ = h.Write(seed)
+		for _, vec := range keys {
+			row := i
+			if vec.IsConst() {
+				row = 0
+			}
+			if vec.IsConstNull() || vec.GetNulls().Contains(uint64(row)) {
+				_, _ = h.Write(null)
+				continue
+			}
+			_, _ = h.Write(notNull)
+			_, _ = h.Write(vec.GetRawBytesAt(row))
+		}
+		p := h.Sum64() % SpillPartitionCount
+		sels[p] = append(sels[p], int32(i))
+	}
+	return sels
+}
+
+// WriteSpillFile writes the batches to a new file of the local file service,
+// it returns the name and the size of the file.
+func WriteSpillFile(proc *process.Process, bats []*batch.Batch, anal process.Analyze) (string, int64, error) {
+	fs, err := fileservice.Get[fileservice.FileService](proc.FileService, defines.LocalFileServiceName)
+	if err != nil {
+		return "", 0, err
+	}
+
+	var buf bytes.Buffer
+	for _, bat := range bats {
+		data, err := bat.MarshalBinary()
+		if err != nil {
+			return "", 0, err
+		}
+		size := uint32(len(data))
+		buf.Write(types.EncodeUint32(&size))
+		buf.Write(data)
+	}
+
+	name := path.Join(spillDir, proc.Id, uuid.NewString())
+	vec := fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   int64(buf.Len()),
+				Data:   buf.Bytes(),
+			},
+		},
+		CachePolicy: fileservice.SkipAll,
+	}
+	if err = fs.Write(proc.Ctx, vec); err != nil {
+		return "", 0, err
+	}
+	anal.Spill(int64(buf.Len()))
+	return name, int64(buf.Len()), nil
+}
+
+// ReadSpillFile reads the batches of a spilled file.
+func ReadSpillFile(proc *process.Process, name string) ([]*batch.Batch, error) {
+	fs, err := fileservice.Get[fileservice.FileService](proc.FileService, defines.LocalFileServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	vec := &fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   -1,
+			},
+		},
+		CachePolicy: fileservice.SkipAll,
+	}
+	if err = fs.Read(proc.Ctx, vec); err != nil {
+		return nil, err
+	}
+
+	var bats []*batch.Batch
+	data := vec.Entries[0].Data
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, moerr.NewInternalError(proc.Ctx, "invalid spilled file %s", name)
+		}
+		size := types.DecodeUint32(data[:4])
+		data = data[4:]
+		if len(data) < int(size) {
+			return nil, moerr.NewInternalError(proc.Ctx, "invalid spilled file %s", name)
+		}
+		bat, err := unmarshalSpilledBatch(data[:size], proc.Mp())
+		if err != nil {
+			for _, b := range bats {
+				b.Clean(proc.Mp())
+			}
+			return nil, err
+		}
+		bats = append(bats, bat)
+		data = data[size:]
+	}
+	return bats, nil
+}
+
+// unmarshalSpilledBatch decodes a batch, and copies its data to the memory pool.
+func unmarshalSpilledBatch(data []byte, mp *mpool.MPool) (*batch.Batch, error) {
+	bat := batch.NewWithSize(0)
+	if err := bat.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	for i, vec := range bat.Vecs {
+		rvec := vector.NewVec(*vec.GetType())
+		if err := vector.GetUnionAllFunction(*vec.GetType(), mp)(rvec, vec); err != nil {
+			rvec.Free(mp)
+			for j := 0; j < i; j++ {
+				bat.Vecs[j].Free(mp)
+			}
+			return nil, err
+		}
+		bat.Vecs[i] = rvec
+	}
+	for i, ag := range bat.Aggs {
+		if err := ag.WildAggReAlloc(mp); err != nil {
+			for j := 0; j < i; j++ {
+				bat.Aggs[j].Free(mp)
+			}
+			bat.Aggs = nil
+			bat.Clean(mp)
+			return nil, err
+		}
+	}
+	return bat, nil
+}
+
+// DeleteSpillFiles deletes the spilled files, it is called when the operator is freed,
+// and the context of the query may be canceled already.
+func DeleteSpillFiles(proc *process.Process, files []string) {
+	if len(files) == 0 {
+		return
+	}
+	fs, err := fileservice.Get[fileservice.FileService](proc.FileService, defines.LocalFileServiceName)
+	if err != nil {
+		logutil.Errorf("failed to delete spilled files: %v", err)
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), spillDeleteTimeout)
+	defer cancel()
+	if err = fs.Delete(ctx, files...); err != nil {
+		logutil.Errorf("failed to delete spilled files: %v", err)
+	}
+}
+
+// Spiller partitions the rows of batches by the hash of their keys,
+// and writes the partitions to disk.
+type Spiller struct {
+	proc  *process.Process
+	anal  process.Analyze
+	level int
+
+	Partitions []SpillPartition
+	bufs       []*batch.Batch
+}
+
+func NewSpiller(proc *process.Process, anal process.Analyze, level int) *Spiller {
+	return &Spiller{
+		proc:       proc,
+		anal:       anal,
+		level:      level,
+		Partitions: make([]SpillPartition, SpillPartitionCount),
+		bufs:       make([]*batch.Batch, SpillPartitionCount),
+	}
+}
+
+// Append appends the rows of the batch to their partitions, a partition is written
+// to disk once it has SpillBatchRows rows.
+func (s *Spiller) Append(bat *batch.Batch, keys []*vector.Vector) error {
+	sels := SpillPartitionSels(keys, bat.RowCount(), s.level)
+	for i := range sels {
+		if len(sels[i]) == 0 {
+			continue
+		}
+		if s.bufs[i] == nil {
+			s.bufs[i] = batch.NewWithSize(len(bat.Vecs))
+			for j, vec := range bat.Vecs {
+				s.bufs[i].Vecs[j] = vector.NewVec(*vec.GetType())
+			}
+		}
+		for j, vec := range bat.Vecs {
+			if err := s.bufs[i].Vecs[j].Union(vec, sels[i], s.proc.Mp()); err != nil {
+				return err
+			}
+		}
+		s.bufs[i].AddRowCount(len(sels[i]))
+		if s.bufs[i].RowCount() >= SpillBatchRows {
+			if err := s.flushPartition(i); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Flush writes the buffered rows of all the partitions to disk.
+func (s *Spiller) Flush() error {
+	for i := range s.bufs {
+		if err := s.flushPartition(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Spiller) flushPartition(i int) error {
+	bat := s.bufs[i]
+	if bat == nil || bat.RowCount() == 0 {
+		return nil
+	}
+	name, size, err := WriteSpillFile(s.proc, []*batch.Batch{bat}, s.anal)
+	if err != nil {
+		return err
+	}
+	s.Partitions[i].Files = append(s.Partitions[i].Files, name)
+	s.Partitions[i].Rows += int64(bat.RowCount())
+	s.Partitions[i].Size += size
+	bat.CleanOnlyData()
+	return nil
+}
+
+// Free frees the buffered rows, and deletes the written files if the partitions
+// are not taken by the operator.
+func (s *Spiller) Free(deleteFiles bool) {
+	for i, bat := range s.bufs {
+		if bat != nil {
+			bat.Clean(s.proc.Mp())
+			s.bufs[i] = nil
+		}
+	}
+	if deleteFiles {
+		for i := range s.Partitions {
+			DeleteSpillFiles(s.proc, s.Partitions[i].Files)
+			s.Partitions[i].Files = nil
+		}
+	}
+}
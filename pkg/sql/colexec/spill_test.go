@@ -0,0 +1,100 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpiller(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	proc.AnalInfos = []*process.AnalyzeInfo{process.NewAnalyzeInfo(0)}
+	anal := proc.GetAnalyze(0)
+
+	bat := testutil.NewBatch([]types.Type{types.T_int64.ToType(), types.T_varchar.ToType()}, true, 10000, proc.Mp())
+	spiller := NewSpiller(proc, anal, 0)
+	require.NoError(t, spiller.Append(bat, bat.Vecs[:1]))
+	require.NoError(t, spiller.Flush())
+	spiller.Free(false)
+	require.Less(t, int64(0), proc.AnalInfos[0].SpillCount)
+
+	// the rows with the same key are in the same partition
+	rows := 0
+	keys := make(map[int64]int)
+	for i, part := range spiller.Partitions {
+		for _, file := range part.Files {
+			bats, err := ReadSpillFile(proc, file)
+			require.NoError(t, err)
+			for _, b := range bats {
+				require.Equal(t, 2, len(b.Vecs))
+				for _, key := range vector.MustFixedCol[int64](b.Vecs[0]) {
+					if p, ok := keys[key]; ok {
+						require.Equal(t, i, p)
+					}
+					keys[key] = i
+				}
+				rows += b.RowCount()
+				b.Clean(proc.Mp())
+			}
+		}
+		require.Equal(t, int64(rows), sumRows(spiller.Partitions[:i+1]))
+	}
+	require.Equal(t, bat.RowCount(), rows)
+	bat.Clean(proc.Mp())
+
+	spiller.Free(true)
+	fs, err := fileservice.Get[fileservice.FileService](proc.FileService, defines.LocalFileServiceName)
+	require.NoError(t, err)
+	entries, err := fs.List(context.Background(), spillDir)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(entries))
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestSpillPartitionSels(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = testutil.MakeInt64Vector([]int64{1, 2, 1, 3}, []uint64{3})
+	bat.SetRowCount(4)
+
+	sels := SpillPartitionSels(bat.Vecs, bat.RowCount(), 0)
+	require.Equal(t, SpillPartitionCount, len(sels))
+	for _, sel := range sels {
+		for _, row := range sel {
+			if row == 0 {
+				require.Contains(t, sel, int32(2))
+			}
+		}
+	}
+	bat.Clean(proc.Mp())
+}
+
+func sumRows(parts []SpillPartition) (rows int64) {
+	for _, part := range parts {
+		rows += part.Rows
+	}
+	return
+}
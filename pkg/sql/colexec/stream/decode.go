@@ -0,0 +1,261 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+const nullFlag = "\\N"
+
+// recordDecoder splits a raw record into one field per column. A nil field
+// means NULL.
+type recordDecoder func(ctx context.Context, record []byte, attrs []string) ([]*string, error)
+
+func getRecordDecoder(ctx context.Context, configs map[string]interface{}) (recordDecoder, error) {
+	switch format := strings.ToLower(getConfig(configs, ValueKey)); format {
+	case JsonFormat, "":
+		return decodeJsonRecord, nil
+	case CsvFormat:
+		delimiter := getConfig(configs, DelimiterKey)
+		comma := ','
+		if delimiter != "" {
+			comma = []rune(delimiter)[0]
+		}
+		return func(ctx context.Context, record []byte, attrs []string) ([]*string, error) {
+			return decodeCsvRecord(ctx, record, attrs, comma)
+		}, nil
+	default:
+		return nil, moerr.NewNotSupported(ctx, "stream value format '%s'", format)
+	}
+}
+
+// decodeJsonRecord maps the members of a json object to columns by name.
+func decodeJsonRecord(ctx context.Context, record []byte, attrs []string) ([]*string, error) {
+	var obj map[string]json.RawMessage
+	d := json.NewDecoder(bytes.NewReader(record))
+	d.UseNumber()
+	if err := d.Decode(&obj); err != nil {
+		return nil, moerr.NewInvalidInput(ctx, "stream record '%s' is not a json object", string(record))
+	}
+	lower := make(map[string]json.RawMessage, len(obj))
+	for k, v := range obj {
+		lower[strings.ToLower(k)] = v
+	}
+	fields := make([]*string, len(attrs))
+	for i, attr := range attrs {
+		raw, ok := obj[attr]
+		if !ok {
+			if raw, ok = lower[strings.ToLower(attr)]; !ok {
+				continue
+			}
+		}
+		raw = bytes.TrimSpace(raw)
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+		var field string
+		if raw[0] == '"' {
+			if err := json.Unmarshal(raw, &field); err != nil {
+				return nil, moerr.NewInvalidInput(ctx, "invalid json string %s", string(raw))
+			}
+		} else {
+			// numbers and booleans keep their text, objects and arrays stay json
+			field = string(raw)
+		}
+		fields[i] = &field
+	}
+	return fields, nil
+}
+
+// decodeCsvRecord maps the fields of a csv line to columns by position.
+func decodeCsvRecord(ctx context.Context, record []byte, attrs []string, comma rune) ([]*string, error) {
+	r := csv.NewReader(bytes.NewReader(record))
+	r.Comma = comma
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	line, err := r.Read()
+	if err != nil {
+		return nil, moerr.NewInvalidInput(ctx, "stream record '%s' is not a csv line", string(record))
+	}
+	if len(line) < len(attrs) {
+		return nil, moerr.NewInvalidInput(ctx, "stream record '%s' has %d fields, expect %d", string(record), len(line), len(attrs))
+	}
+	fields := make([]*string, len(attrs))
+	for i := range attrs {
+		if line[i] == nullFlag {
+			continue
+		}
+		fields[i] = &line[i]
+	}
+	return fields, nil
+}
+
+// decodeRecords decodes the records into a batch with one vector per column.
+func decodeRecords(ctx context.Context, records [][]byte, attrs []string, typs []types.Type,
+	decode recordDecoder, mp *mpool.MPool) (*batch.Batch, error) {
+	bat := batch.New(false, attrs)
+	for i := range attrs {
+		bat.Vecs[i] = vector.NewVec(typs[i])
+	}
+	for _, record := range records {
+		fields, err := decode(ctx, record, attrs)
+		if err != nil {
+			bat.Clean(mp)
+			return nil, err
+		}
+		for i, field := range fields {
+			if err := appendField(ctx, bat.Vecs[i], field, mp); err != nil {
+				bat.Clean(mp)
+				return nil, moerr.NewInvalidInput(ctx, "stream column '%s': %s", attrs[i], err.Error())
+			}
+		}
+	}
+	bat.SetRowCount(len(records))
+	return bat, nil
+}
+
+func appendField(ctx context.Context, vec *vector.Vector, field *string, mp *mpool.MPool) error {
+	typ := vec.GetType()
+	if field == nil {
+		return vector.AppendAny(vec, nil, true, mp)
+	}
+	s := *field
+	if !typ.Oid.IsMySQLString() && typ.Oid != types.T_json {
+		s = strings.TrimSpace(s)
+		if len(s) == 0 {
+			return vector.AppendAny(vec, nil, true, mp)
+		}
+	}
+	switch typ.Oid {
+	case types.T_bool:
+		v, err := types.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, v, false, mp)
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64:
+		v, err := strconv.ParseInt(s, 10, typ.Oid.FixedLength()*8)
+		if err != nil {
+			return err
+		}
+		switch typ.Oid {
+		case types.T_int8:
+			return vector.AppendFixed(vec, int8(v), false, mp)
+		case types.T_int16:
+			return vector.AppendFixed(vec, int16(v), false, mp)
+		case types.T_int32:
+			return vector.AppendFixed(vec, int32(v), false, mp)
+		default:
+			return vector.AppendFixed(vec, v, false, mp)
+		}
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		v, err := strconv.ParseUint(s, 10, typ.Oid.FixedLength()*8)
+		if err != nil {
+			return err
+		}
+		switch typ.Oid {
+		case types.T_uint8:
+			return vector.AppendFixed(vec, uint8(v), false, mp)
+		case types.T_uint16:
+			return vector.AppendFixed(vec, uint16(v), false, mp)
+		case types.T_uint32:
+			return vector.AppendFixed(vec, uint32(v), false, mp)
+		default:
+			return vector.AppendFixed(vec, v, false, mp)
+		}
+	case types.T_float32:
+		v, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, float32(v), false, mp)
+	case types.T_float64:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, v, false, mp)
+	case types.T_decimal64:
+		v, err := types.ParseDecimal64(s, typ.Width, typ.Scale)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, v, false, mp)
+	case types.T_decimal128:
+		v, err := types.ParseDecimal128(s, typ.Width, typ.Scale)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, v, false, mp)
+	case types.T_date:
+		v, err := types.ParseDateCast(s)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, v, false, mp)
+	case types.T_time:
+		v, err := types.ParseTime(s, typ.Scale)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, v, false, mp)
+	case types.T_datetime:
+		v, err := types.ParseDatetime(s, typ.Scale)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, v, false, mp)
+	case types.T_timestamp:
+		v, err := types.ParseTimestamp(time.Local, s, typ.Scale)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, v, false, mp)
+	case types.T_uuid:
+		v, err := types.ParseUuid(s)
+		if err != nil {
+			return err
+		}
+		return vector.AppendFixed(vec, v, false, mp)
+	case types.T_json:
+		bj, err := types.ParseStringToByteJson(s)
+		if err != nil {
+			return err
+		}
+		v, err := types.EncodeJson(bj)
+		if err != nil {
+			return err
+		}
+		return vector.AppendBytes(vec, v, false, mp)
+	case types.T_char, types.T_varchar, types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		return vector.AppendBytes(vec, []byte(s), false, mp)
+	default:
+		return moerr.NewNotSupported(ctx, fmt.Sprintf("stream column type %s", typ.String()))
+	}
+}
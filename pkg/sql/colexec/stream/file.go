@@ -0,0 +1,155 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// fileSource reads an append-only local file, one record per line. The
+// offset of a record is its line number, so the file must only be appended.
+// The file is kept open between reads, and a read continuing from the
+// previous one does not rescan the lines before it.
+type fileSource struct {
+	path string
+
+	f *os.File
+	r *bufio.Reader
+	// line is the offset of the next record of r, and pos is its byte
+	// position in the file.
+	line int64
+	pos  int64
+}
+
+func newFileSource(ctx context.Context, configs map[string]interface{}) (Source, error) {
+	path := getConfig(configs, FilepathKey)
+	if path == "" {
+		return nil, moerr.NewInvalidInput(ctx, "stream source '%s' requires property '%s'", FileSource, FilepathKey)
+	}
+	return &fileSource{path: path}, nil
+}
+
+func (s *fileSource) Start(_ context.Context) (int64, error) {
+	return 0, nil
+}
+
+func (s *fileSource) End(ctx context.Context) (int64, error) {
+	if err := s.seek(ctx, 0); err != nil {
+		return 0, err
+	}
+	for {
+		line, err := s.next()
+		if err != nil || line == nil {
+			return s.line, err
+		}
+	}
+}
+
+func (s *fileSource) Read(ctx context.Context, offset, end int64, limit int) ([][]byte, int64, error) {
+	if err := s.seek(ctx, offset); err != nil {
+		return nil, 0, err
+	}
+	records := make([][]byte, 0, limit)
+	for len(records) < limit && s.line < end {
+		line, err := s.next()
+		if err != nil {
+			return nil, 0, err
+		}
+		if line == nil {
+			break
+		}
+		records = append(records, bytes.Clone(line))
+	}
+	if s.line < offset {
+		// the file is shorter than offset
+		return records, offset, nil
+	}
+	return records, s.line, nil
+}
+
+func (s *fileSource) Close() error {
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f, s.r = nil, nil
+	return err
+}
+
+// seek moves the reader to the record at offset, or to the end of the file if
+// it has fewer records. The file is only reopened to move backwards.
+func (s *fileSource) seek(ctx context.Context, offset int64) error {
+	if s.f == nil || offset < s.line {
+		if err := s.Close(); err != nil {
+			return err
+		}
+		f, err := os.Open(s.path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return moerr.NewFileNotFound(ctx, s.path)
+			}
+			return err
+		}
+		s.f, s.r = f, bufio.NewReader(f)
+		s.line, s.pos = 0, 0
+	}
+	for s.line < offset {
+		line, err := s.next()
+		if err != nil || line == nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// next returns the next complete non-empty line of the file, or nil at the
+// end of it. A trailing line without newline is still being appended, so the
+// reader is moved back to its start to read it again once it is complete.
+func (s *fileSource) next() ([]byte, error) {
+	for {
+		line, err := s.r.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			buf := bytes.Clone(line)
+			for err == bufio.ErrBufferFull {
+				line, err = s.r.ReadSlice('\n')
+				buf = append(buf, line...)
+			}
+			line = buf
+		}
+		if err != nil {
+			if err != io.EOF {
+				return nil, err
+			}
+			if _, err = s.f.Seek(s.pos, io.SeekStart); err != nil {
+				return nil, err
+			}
+			s.r.Reset(s.f)
+			return nil, nil
+		}
+		s.pos += int64(len(line))
+		line = bytes.TrimRight(line, "\r\n")
+		if len(line) == 0 {
+			continue
+		}
+		s.line++
+		return line, nil
+	}
+}
@@ -0,0 +1,457 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+// kafka api keys and versions used by kafkaSource
+const (
+	kafkaApiFetch       int16 = 1
+	kafkaApiListOffsets int16 = 2
+
+	kafkaFetchVersion       int16 = 4
+	kafkaListOffsetsVersion int16 = 1
+
+	kafkaLatestOffset   int64 = -1
+	kafkaEarliestOffset int64 = -2
+
+	kafkaDefaultClientID = "matrixone"
+	kafkaDialTimeout     = 10 * time.Second
+	kafkaMaxWaitMs       = 500
+	kafkaMaxBytes        = 32 << 20
+)
+
+// kafkaSource reads one partition of a topic with the kafka wire protocol.
+// Only uncompressed record batches (magic v2) are supported, and the first
+// address in bootstrap.servers must be the leader of the partition.
+type kafkaSource struct {
+	topic     string
+	partition int32
+	clientID  string
+	conn      net.Conn
+	r         *bufio.Reader
+	corrID    int32
+}
+
+func newKafkaSource(ctx context.Context, configs map[string]interface{}) (Source, error) {
+	s := &kafkaSource{
+		topic:    getConfig(configs, TopicKey),
+		clientID: getConfig(configs, ClientIDKey),
+	}
+	if s.topic == "" {
+		return nil, moerr.NewInvalidInput(ctx, "stream source '%s' requires property '%s'", KafkaSource, TopicKey)
+	}
+	if s.clientID == "" {
+		s.clientID = kafkaDefaultClientID
+	}
+	partition := getConfig(configs, PartitionKey)
+	if partition == "" {
+		partition = getConfig(configs, partitionKeyLegacy)
+	}
+	if partition != "" {
+		p, err := strconv.ParseInt(partition, 10, 32)
+		if err != nil {
+			return nil, moerr.NewInvalidInput(ctx, "invalid kafka partition '%s'", partition)
+		}
+		s.partition = int32(p)
+	}
+	servers := getConfig(configs, ServersKey)
+	if servers == "" {
+		return nil, moerr.NewInvalidInput(ctx, "stream source '%s' requires property '%s'", KafkaSource, ServersKey)
+	}
+	addr := strings.TrimSpace(strings.Split(servers, ",")[0])
+	dialer := net.Dialer{Timeout: kafkaDialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	s.conn = conn
+	s.r = bufio.NewReader(conn)
+	return s, nil
+}
+
+func (s *kafkaSource) Start(ctx context.Context) (int64, error) {
+	return s.listOffset(ctx, kafkaEarliestOffset)
+}
+
+func (s *kafkaSource) End(ctx context.Context) (int64, error) {
+	return s.listOffset(ctx, kafkaLatestOffset)
+}
+
+func (s *kafkaSource) Read(ctx context.Context, offset, end int64, limit int) ([][]byte, int64, error) {
+	values := make([][]byte, 0, limit)
+	for offset < end {
+		records, next, err := s.fetch(ctx, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		if next <= offset {
+			break
+		}
+		for _, r := range records {
+			if r.offset >= end {
+				return values, end, nil
+			}
+			if len(values) == limit {
+				return values, r.offset, nil
+			}
+			values = append(values, r.value)
+		}
+		// the offsets of control and compacted records are skipped as well
+		offset = next
+		if len(values) == limit {
+			break
+		}
+	}
+	if offset > end {
+		offset = end
+	}
+	return values, offset, nil
+}
+
+func (s *kafkaSource) Close() error {
+	return s.conn.Close()
+}
+
+func (s *kafkaSource) listOffset(ctx context.Context, timestamp int64) (int64, error) {
+	var e kafkaEncoder
+	e.putInt32(-1) // replica id
+	e.putInt32(1)
+	e.putString(s.topic)
+	e.putInt32(1)
+	e.putInt32(s.partition)
+	e.putInt64(timestamp)
+	d, err := s.roundTrip(ctx, kafkaApiListOffsets, kafkaListOffsetsVersion, e.buf)
+	if err != nil {
+		return 0, err
+	}
+	for nt := d.int32(); nt > 0; nt-- {
+		d.string()
+		for np := d.int32(); np > 0; np-- {
+			partition := d.int32()
+			code := d.int16()
+			d.int64() // timestamp
+			offset := d.int64()
+			if d.err != nil {
+				break
+			}
+			if partition != s.partition {
+				continue
+			}
+			if code != 0 {
+				return 0, moerr.NewInternalError(ctx, "kafka list offsets of %s[%d] failed with error code %d", s.topic, s.partition, code)
+			}
+			return offset, nil
+		}
+	}
+	if d.err != nil {
+		return 0, d.err
+	}
+	return 0, moerr.NewInternalError(ctx, "kafka partition %s[%d] not found", s.topic, s.partition)
+}
+
+// fetch returns the data records at or after offset, and the offset that
+// follows the last record batch in the response.
+func (s *kafkaSource) fetch(ctx context.Context, offset int64) ([]kafkaRecord, int64, error) {
+	var e kafkaEncoder
+	e.putInt32(-1) // replica id
+	e.putInt32(kafkaMaxWaitMs)
+	e.putInt32(1) // min bytes
+	e.putInt32(kafkaMaxBytes)
+	e.putInt8(0) // read uncommitted
+	e.putInt32(1)
+	e.putString(s.topic)
+	e.putInt32(1)
+	e.putInt32(s.partition)
+	e.putInt64(offset)
+	e.putInt32(kafkaMaxBytes)
+	d, err := s.roundTrip(ctx, kafkaApiFetch, kafkaFetchVersion, e.buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	d.int32() // throttle time
+	var records []kafkaRecord
+	next := offset
+	for nt := d.int32(); nt > 0; nt-- {
+		d.string()
+		for np := d.int32(); np > 0; np-- {
+			partition := d.int32()
+			code := d.int16()
+			d.int64() // high watermark
+			d.int64() // last stable offset
+			for na := d.int32(); na > 0; na-- {
+				d.int64() // producer id
+				d.int64() // first offset
+			}
+			data := d.bytes()
+			if d.err != nil {
+				return nil, 0, d.err
+			}
+			if partition != s.partition {
+				continue
+			}
+			if code != 0 {
+				return nil, 0, moerr.NewInternalError(ctx, "kafka fetch of %s[%d] failed with error code %d", s.topic, s.partition, code)
+			}
+			records, next, err = decodeRecordBatches(ctx, data, offset)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+	return records, next, d.err
+}
+
+func (s *kafkaSource) roundTrip(ctx context.Context, apiKey, version int16, body []byte) (*kafkaDecoder, error) {
+	s.corrID++
+	var e kafkaEncoder
+	e.putInt32(0) // size, filled below
+	e.putInt16(apiKey)
+	e.putInt16(version)
+	e.putInt32(s.corrID)
+	e.putString(s.clientID)
+	e.buf = append(e.buf, body...)
+	binary.BigEndian.PutUint32(e.buf, uint32(len(e.buf)-4))
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.conn.SetDeadline(deadline)
+	} else {
+		_ = s.conn.SetDeadline(time.Now().Add(kafkaDialTimeout + kafkaMaxWaitMs*time.Millisecond))
+	}
+	if _, err := s.conn.Write(e.buf); err != nil {
+		return nil, err
+	}
+	var head [8]byte
+	if _, err := io.ReadFull(s.r, head[:]); err != nil {
+		return nil, err
+	}
+	size := int32(binary.BigEndian.Uint32(head[:4]))
+	if corrID := int32(binary.BigEndian.Uint32(head[4:])); corrID != s.corrID {
+		return nil, moerr.NewInternalError(ctx, "kafka correlation id mismatch, expect %d, got %d", s.corrID, corrID)
+	}
+	if size < 4 {
+		return nil, moerr.NewInternalError(ctx, "bad kafka response size %d", size)
+	}
+	buf := make([]byte, size-4)
+	if _, err := io.ReadFull(s.r, buf); err != nil {
+		return nil, err
+	}
+	return &kafkaDecoder{ctx: ctx, buf: buf}, nil
+}
+
+// kafkaRecord is the value of a data record and its offset in the partition.
+type kafkaRecord struct {
+	offset int64
+	value  []byte
+}
+
+// decodeRecordBatches decodes the data records of a sequence of v2 record
+// batches, skipping records before offset, and returns the offset following
+// the last complete batch. A partial batch at the end is ignored.
+func decodeRecordBatches(ctx context.Context, data []byte, offset int64) ([]kafkaRecord, int64, error) {
+	var records []kafkaRecord
+	next := offset
+	for len(data) >= 12 {
+		baseOffset := int64(binary.BigEndian.Uint64(data))
+		length := int(int32(binary.BigEndian.Uint32(data[8:])))
+		if len(data) < 12+length {
+			break
+		}
+		d := &kafkaDecoder{ctx: ctx, buf: data[12 : 12+length]}
+		data = data[12+length:]
+
+		d.int32() // partition leader epoch
+		if magic := d.int8(); magic != 2 {
+			return nil, 0, moerr.NewNotSupported(ctx, "kafka record batch magic %d", magic)
+		}
+		d.int32() // crc
+		attributes := d.int16()
+		if attributes&0x7 != 0 {
+			return nil, 0, moerr.NewNotSupported(ctx, "compressed kafka record batch")
+		}
+		lastOffsetDelta := d.int32()
+		d.int64() // first timestamp
+		d.int64() // max timestamp
+		d.int64() // producer id
+		d.int16() // producer epoch
+		d.int32() // base sequence
+		count := d.int32()
+		// control batches carry transaction markers instead of data
+		isControl := attributes&0x20 != 0
+		for i := int32(0); i < count; i++ {
+			d.varint() // length
+			d.int8()   // attributes
+			d.varint() // timestamp delta
+			recordOffset := baseOffset + d.varint()
+			d.varbytes() // key
+			value := d.varbytes()
+			for nh := d.varint(); nh > 0; nh-- {
+				d.varbytes()
+				d.varbytes()
+			}
+			if d.err != nil {
+				return nil, 0, d.err
+			}
+			if isControl || recordOffset < offset {
+				continue
+			}
+			records = append(records, kafkaRecord{offset: recordOffset, value: value})
+		}
+		if n := baseOffset + int64(lastOffsetDelta) + 1; n > next {
+			next = n
+		}
+	}
+	return records, next, nil
+}
+
+type kafkaEncoder struct {
+	buf []byte
+}
+
+func (e *kafkaEncoder) putInt8(v int8) {
+	e.buf = append(e.buf, byte(v))
+}
+
+func (e *kafkaEncoder) putInt16(v int16) {
+	e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(v))
+}
+
+func (e *kafkaEncoder) putInt32(v int32) {
+	e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(v))
+}
+
+func (e *kafkaEncoder) putInt64(v int64) {
+	e.buf = binary.BigEndian.AppendUint64(e.buf, uint64(v))
+}
+
+func (e *kafkaEncoder) putString(v string) {
+	e.putInt16(int16(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *kafkaEncoder) putBytes(v []byte) {
+	e.putInt32(int32(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *kafkaEncoder) putVarint(v int64) {
+	e.buf = binary.AppendVarint(e.buf, v)
+}
+
+func (e *kafkaEncoder) putVarbytes(v []byte) {
+	if v == nil {
+		e.putVarint(-1)
+		return
+	}
+	e.putVarint(int64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+// kafkaDecoder reads big-endian kafka primitives. The first error is kept in
+// err and all later reads return zero values.
+type kafkaDecoder struct {
+	ctx context.Context
+	buf []byte
+	err error
+}
+
+func (d *kafkaDecoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.buf) < n {
+		d.err = moerr.NewInternalError(d.ctx, "malformed kafka message")
+		return nil
+	}
+	v := d.buf[:n]
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *kafkaDecoder) int8() int8 {
+	if b := d.next(1); b != nil {
+		return int8(b[0])
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int16() int16 {
+	if b := d.next(2); b != nil {
+		return int16(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int32() int32 {
+	if b := d.next(4); b != nil {
+		return int32(binary.BigEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) int64() int64 {
+	if b := d.next(8); b != nil {
+		return int64(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (d *kafkaDecoder) string() string {
+	n := d.int16()
+	if n < 0 {
+		return ""
+	}
+	return string(d.next(int(n)))
+}
+
+func (d *kafkaDecoder) bytes() []byte {
+	n := d.int32()
+	if n < 0 {
+		return nil
+	}
+	return d.next(int(n))
+}
+
+func (d *kafkaDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.buf)
+	if n <= 0 {
+		d.err = moerr.NewInternalError(d.ctx, "malformed kafka varint")
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *kafkaDecoder) varbytes() []byte {
+	n := d.varint()
+	if n < 0 {
+		return nil
+	}
+	return d.next(int(n))
+}
@@ -0,0 +1,299 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// testBroker is an in-process kafka broker that serves one partition of one
+// topic. Every record batch holds batchRecords records, and fetch returns at
+// most two batches so that readers have to fetch repeatedly. A batch of nil
+// values is sent as a control batch of transaction markers.
+type testBroker struct {
+	t            *testing.T
+	l            net.Listener
+	topic        string
+	partition    int32
+	start        int64
+	values       [][]byte
+	batchRecords int
+}
+
+func newTestBroker(t *testing.T, topic string, partition int32, start int64, values [][]byte) *testBroker {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	b := &testBroker{
+		t:            t,
+		l:            l,
+		topic:        topic,
+		partition:    partition,
+		start:        start,
+		values:       values,
+		batchRecords: 3,
+	}
+	go b.serve()
+	t.Cleanup(func() { _ = l.Close() })
+	return b
+}
+
+func (b *testBroker) serve() {
+	for {
+		conn, err := b.l.Accept()
+		if err != nil {
+			return
+		}
+		go b.handle(conn)
+	}
+}
+
+func (b *testBroker) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(conn, size[:]); err != nil {
+			return
+		}
+		buf := make([]byte, binary.BigEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			return
+		}
+		d := &kafkaDecoder{ctx: context.Background(), buf: buf}
+		apiKey := d.int16()
+		d.int16() // version
+		corrID := d.int32()
+		d.string() // client id
+
+		var e kafkaEncoder
+		e.putInt32(0)
+		e.putInt32(corrID)
+		switch apiKey {
+		case kafkaApiListOffsets:
+			b.listOffsets(d, &e)
+		case kafkaApiFetch:
+			b.fetch(d, &e)
+		default:
+			return
+		}
+		binary.BigEndian.PutUint32(e.buf, uint32(len(e.buf)-4))
+		if _, err := conn.Write(e.buf); err != nil {
+			return
+		}
+	}
+}
+
+func (b *testBroker) listOffsets(d *kafkaDecoder, e *kafkaEncoder) {
+	d.int32() // replica id
+	d.int32() // topics
+	topic := d.string()
+	d.int32() // partitions
+	partition := d.int32()
+	timestamp := d.int64()
+
+	var code int16
+	if topic != b.topic || partition != b.partition {
+		code = 3 // unknown topic or partition
+	}
+	offset := b.start
+	if timestamp == kafkaLatestOffset {
+		offset = b.start + int64(len(b.values))
+	}
+	e.putInt32(1)
+	e.putString(topic)
+	e.putInt32(1)
+	e.putInt32(partition)
+	e.putInt16(code)
+	e.putInt64(-1)
+	e.putInt64(offset)
+}
+
+func (b *testBroker) fetch(d *kafkaDecoder, e *kafkaEncoder) {
+	d.int32() // replica id
+	d.int32() // max wait
+	d.int32() // min bytes
+	d.int32() // max bytes
+	d.int8()  // isolation level
+	d.int32() // topics
+	topic := d.string()
+	d.int32() // partitions
+	partition := d.int32()
+	offset := d.int64()
+
+	// batches are aligned to batchRecords, so the first one may start
+	// before the requested offset like a real broker does
+	var records kafkaEncoder
+	first := (offset - b.start) / int64(b.batchRecords) * int64(b.batchRecords)
+	for n := 0; n < 2 && first < int64(len(b.values)); n++ {
+		last := first + int64(b.batchRecords)
+		if last > int64(len(b.values)) {
+			last = int64(len(b.values))
+		}
+		records.buf = append(records.buf, encodeRecordBatch(b.start+first, b.values[first:last])...)
+		first = last
+	}
+
+	e.putInt32(0) // throttle time
+	e.putInt32(1)
+	e.putString(topic)
+	e.putInt32(1)
+	e.putInt32(partition)
+	e.putInt16(0)
+	e.putInt64(b.start + int64(len(b.values)))
+	e.putInt64(b.start + int64(len(b.values)))
+	e.putInt32(-1) // aborted transactions
+	e.putBytes(records.buf)
+}
+
+func encodeRecordBatch(baseOffset int64, values [][]byte) []byte {
+	var attributes int16 = 0x20
+	for _, value := range values {
+		if value != nil {
+			attributes = 0
+		}
+	}
+	var body kafkaEncoder
+	body.putInt32(0) // partition leader epoch
+	body.putInt8(2)  // magic
+	body.putInt32(0) // crc, not verified by the reader
+	body.putInt16(attributes)
+	body.putInt32(int32(len(values) - 1))
+	body.putInt64(0)
+	body.putInt64(0)
+	body.putInt64(-1)
+	body.putInt16(-1)
+	body.putInt32(-1)
+	body.putInt32(int32(len(values)))
+	for i, value := range values {
+		var r kafkaEncoder
+		r.putInt8(0)
+		r.putVarint(0)
+		r.putVarint(int64(i))
+		r.putVarbytes(nil)
+		r.putVarbytes(value)
+		r.putVarint(0)
+		body.putVarint(int64(len(r.buf)))
+		body.buf = append(body.buf, r.buf...)
+	}
+	var e kafkaEncoder
+	e.putInt64(baseOffset)
+	e.putBytes(body.buf)
+	return e.buf
+}
+
+func TestKafkaSource(t *testing.T) {
+	values := make([][]byte, 10)
+	for i := range values {
+		values[i] = []byte(fmt.Sprintf(`{"a": %d, "b": "v%d", "c": %d.5}`, i, i, i))
+	}
+	b := newTestBroker(t, "user", 1, 100, values)
+
+	tblDef := newStreamTableDef(map[string]string{
+		TypeKey:            KafkaSource,
+		ValueKey:           JsonFormat,
+		TopicKey:           "user",
+		partitionKeyLegacy: "1",
+		ServersKey:         b.l.Addr().String(),
+	})
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	as, bs, _ := runStream(t, proc, tblDef)
+	require.Equal(t, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, as)
+	require.Equal(t, "v9", bs[9])
+
+	// read from the middle of a record batch
+	ctx := context.Background()
+	src, err := NewSource(ctx, GetConfigs(tblDef))
+	require.NoError(t, err)
+	defer src.Close()
+	records, next, err := src.Read(ctx, 104, 110, 4)
+	require.NoError(t, err)
+	require.Equal(t, values[4:8], records)
+	require.Equal(t, int64(108), next)
+	records, next, err = src.Read(ctx, 104, 106, 4)
+	require.NoError(t, err)
+	require.Equal(t, values[4:6], records)
+	require.Equal(t, int64(106), next)
+	records, next, err = src.Read(ctx, 110, 120, 4)
+	require.NoError(t, err)
+	require.Empty(t, records)
+	require.Equal(t, int64(110), next)
+
+	// unknown partition
+	configs := GetConfigs(tblDef)
+	configs[PartitionKey] = "2"
+	_, _, err = GetStreamCurrentSize(ctx, configs)
+	require.Error(t, err)
+}
+
+func TestKafkaSourceControlBatch(t *testing.T) {
+	values := make([][]byte, 9)
+	for i := range values {
+		// offsets 103 to 105 are a control batch
+		if i < 3 || i > 5 {
+			values[i] = []byte(fmt.Sprintf(`{"a": %d}`, i))
+		}
+	}
+	b := newTestBroker(t, "user", 0, 100, values)
+
+	tblDef := newStreamTableDef(map[string]string{
+		TypeKey:    KafkaSource,
+		ValueKey:   JsonFormat,
+		TopicKey:   "user",
+		ServersKey: b.l.Addr().String(),
+	})
+	ctx := context.Background()
+	src, err := NewSource(ctx, GetConfigs(tblDef))
+	require.NoError(t, err)
+	defer src.Close()
+	records, next, err := src.Read(ctx, 100, 109, 4)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{values[0], values[1], values[2], values[6]}, records)
+	require.Equal(t, int64(107), next)
+	records, next, err = src.Read(ctx, next, 109, 4)
+	require.NoError(t, err)
+	require.Equal(t, values[7:9], records)
+	require.Equal(t, int64(109), next)
+
+	// the scans of two adjacent ranges read every record exactly once
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	var as []int64
+	for _, r := range [][2]int64{{100, 104}, {104, 109}} {
+		arg := &Argument{TblDef: tblDef, Offset: r[0], Limit: r[1] - r[0]}
+		require.NoError(t, Prepare(proc, arg))
+		for {
+			status, err := Call(0, proc, arg, false, false)
+			require.NoError(t, err)
+			if bat := proc.InputBatch(); bat != nil {
+				as = append(as, vector.MustFixedCol[int64](bat.Vecs[0])...)
+				bat.Clean(proc.Mp())
+			}
+			if status == process.ExecStop {
+				break
+			}
+		}
+		arg.Free(proc, false)
+	}
+	require.Equal(t, []int64{0, 1, 2, 6, 7, 8}, as)
+}
@@ -0,0 +1,147 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// property keys of CREATE SOURCE ... WITH (...)
+const (
+	TypeKey      = "type"
+	ValueKey     = "value"
+	FilepathKey  = "filepath"
+	TopicKey     = "topic"
+	PartitionKey = "partition"
+	ServersKey   = "bootstrap.servers"
+	ClientIDKey  = "client.id"
+	DelimiterKey = "delimiter"
+
+	// legacy spelling accepted by early CREATE SOURCE statements
+	partitionKeyLegacy = "partion"
+)
+
+// source connector types
+const (
+	FileSource  = "file"
+	KafkaSource = "kafka"
+)
+
+// record formats
+const (
+	JsonFormat = "json"
+	CsvFormat  = "csv"
+)
+
+// Source is a connector to an external stream. The records of a stream are
+// addressed by an increasing offset, which starts at the value returned by
+// Start and ends before the value returned by End. Offsets are not dense, a
+// kafka partition for example has gaps at transaction markers and compacted
+// records, so readers must advance by the offset returned from Read.
+type Source interface {
+	// Start returns the offset of the first record still available.
+	Start(ctx context.Context) (int64, error)
+	// End returns the offset one past the last record currently available.
+	End(ctx context.Context) (int64, error)
+	// Read returns at most limit raw records in the offset range [offset, end),
+	// and the offset to continue reading from. The returned offset is equal to
+	// offset only if nothing at or after offset is available.
+	Read(ctx context.Context, offset, end int64, limit int) ([][]byte, int64, error)
+	Close() error
+}
+
+// SourceFactory creates a Source from the properties of a stream table.
+type SourceFactory func(ctx context.Context, configs map[string]interface{}) (Source, error)
+
+var sourceFactories = struct {
+	sync.RWMutex
+	m map[string]SourceFactory
+}{
+	m: map[string]SourceFactory{
+		FileSource:  newFileSource,
+		KafkaSource: newKafkaSource,
+	},
+}
+
+// RegisterSource registers a connector for the given type property.
+func RegisterSource(typ string, factory SourceFactory) {
+	sourceFactories.Lock()
+	defer sourceFactories.Unlock()
+	sourceFactories.m[strings.ToLower(typ)] = factory
+}
+
+// NewSource creates the connector selected by the type property in configs.
+func NewSource(ctx context.Context, configs map[string]interface{}) (Source, error) {
+	typ := strings.ToLower(getConfig(configs, TypeKey))
+	if typ == "" {
+		return nil, moerr.NewInvalidInput(ctx, "stream source type is not specified")
+	}
+	sourceFactories.RLock()
+	factory, ok := sourceFactories.m[typ]
+	sourceFactories.RUnlock()
+	if !ok {
+		return nil, moerr.NewNotSupported(ctx, "stream source type '%s'", typ)
+	}
+	return factory(ctx, configs)
+}
+
+// GetConfigs collects the properties of a stream table.
+func GetConfigs(tblDef *plan.TableDef) map[string]interface{} {
+	configs := make(map[string]interface{})
+	for _, def := range tblDef.Defs {
+		if v, ok := def.Def.(*plan.TableDef_DefType_Properties); ok {
+			for _, x := range v.Properties.Properties {
+				configs[strings.ToLower(x.Key)] = x.Value
+			}
+		}
+	}
+	return configs
+}
+
+// GetStreamCurrentSize returns the offset range [start, end) currently
+// available in the stream described by configs.
+func GetStreamCurrentSize(ctx context.Context, configs map[string]interface{}) (int64, int64, error) {
+	src, err := NewSource(ctx, configs)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer src.Close()
+	start, err := src.Start(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := src.End(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	return start, end, nil
+}
+
+func getConfig(configs map[string]interface{}, key string) string {
+	v, ok := configs[key]
+	if !ok || v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	return fmt.Sprint(v)
+}
@@ -16,13 +16,15 @@ package stream
 
 import (
 	"bytes"
-	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
+// batchSize is the max number of records decoded into one batch
+const batchSize = 8192
+
 func String(_ any, buf *bytes.Buffer) {
 	buf.WriteString("stream scan")
 }
@@ -34,45 +36,74 @@ func Prepare(proc *process.Process, arg any) error {
 	p := arg.(*Argument)
 	p.attrs = make([]string, len(p.TblDef.Cols))
 	p.types = make([]types.Type, len(p.TblDef.Cols))
-	p.configs = make(map[string]interface{})
 	for i, col := range p.TblDef.Cols {
 		p.attrs[i] = col.Name
-		p.types[i] = types.Type{
-			Oid:   types.T(col.Typ.Id),
-			Scale: col.Typ.Scale,
-			Width: col.Typ.Width,
-		}
+		p.types[i] = types.New(types.T(col.Typ.Id), col.Typ.Width, col.Typ.Scale)
 	}
-	for _, def := range p.TblDef.Defs {
-		switch v := def.Def.(type) {
-		case *plan.TableDef_DefType_Properties:
-			for _, x := range v.Properties.Properties {
-				p.configs[x.Key] = x.Value
-			}
-		}
+	p.configs = GetConfigs(p.TblDef)
+	decode, err := getRecordDecoder(proc.Ctx, p.configs)
+	if err != nil {
+		return err
 	}
-
+	p.decode = decode
 	return nil
 }
 
 func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (process.ExecStatus, error) {
-	return process.ExecStop, moerr.NewNYI(proc.Ctx, "stream scan operator")
-	//_, span := trace.Start(proc.Ctx, "StreamCall")
-	//defer span.End()
+	ctx, span := trace.Start(proc.Ctx, "StreamCall")
+	defer span.End()
+
+	p := arg.(*Argument)
+	if p.end || p.Limit <= 0 {
+		proc.SetInputBatch(nil)
+		return process.ExecStop, nil
+	}
+	anal := proc.GetAnalyze(idx)
+	anal.Start()
+	defer anal.Stop()
+	anal.Input(nil, isFirst)
 
-	//p := arg.(*Argument)
-	//if p.end {
-	//	proc.SetInputBatch(nil)
-	//	return process.ExecStop, nil
-	//}
-	//
-	////TODO:
-	////b, err := RetrieveData(ctx, p.configs, p.attrs, p.types, p.Offset, p.Limit, proc.Mp(), NewKafkaAdapter)
-	////if err != nil {
-	////	return process.ExecStop, err
-	////}
-	//p.end = true
-	//proc.SetInputBatch(nil)
-	//
-	//return process.ExecStop, nil
+	if p.src == nil {
+		src, err := NewSource(ctx, p.configs)
+		if err != nil {
+			return process.ExecStop, err
+		}
+		p.src = src
+	}
+	limit := int(p.Limit)
+	if limit > batchSize {
+		limit = batchSize
+	}
+	var records [][]byte
+	for len(records) == 0 {
+		if p.end {
+			proc.SetInputBatch(nil)
+			return process.ExecStop, nil
+		}
+		var next int64
+		var err error
+		records, next, err = p.src.Read(ctx, p.Offset, p.Offset+p.Limit, limit)
+		if err != nil {
+			return process.ExecStop, err
+		}
+		if next <= p.Offset {
+			// the stream was truncated behind the planned range
+			p.end = true
+			continue
+		}
+		// a read may only skip records, such as kafka transaction markers
+		p.Limit -= next - p.Offset
+		p.Offset = next
+		if p.Limit <= 0 {
+			p.end = true
+		}
+	}
+	bat, err := decodeRecords(ctx, records, p.attrs, p.types, p.decode, proc.Mp())
+	if err != nil {
+		return process.ExecStop, err
+	}
+	proc.SetInputBatch(bat)
+	anal.Output(bat, isLast)
+	anal.Alloc(int64(bat.Size()))
+	return process.ExecNext, nil
 }
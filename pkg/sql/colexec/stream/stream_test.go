@@ -0,0 +1,204 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stream
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+func newStreamTableDef(props map[string]string) *plan.TableDef {
+	properties := make([]*plan.Property, 0, len(props))
+	for k, v := range props {
+		properties = append(properties, &plan.Property{Key: k, Value: v})
+	}
+	return &plan.TableDef{
+		Name: "s",
+		Cols: []*plan.ColDef{
+			{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}},
+			{Name: "b", Typ: &plan.Type{Id: int32(types.T_varchar), Width: 64}},
+			{Name: "c", Typ: &plan.Type{Id: int32(types.T_float64)}},
+		},
+		Defs: []*plan.TableDef_DefType{
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{Properties: properties},
+				},
+			},
+		},
+	}
+}
+
+// runStream reads [start, end) of the stream and returns the columns of all
+// the batches.
+func runStream(t *testing.T, proc *process.Process, tblDef *plan.TableDef) ([]int64, []string, []bool) {
+	ctx := context.Background()
+	start, end, err := GetStreamCurrentSize(ctx, GetConfigs(tblDef))
+	require.NoError(t, err)
+
+	arg := &Argument{TblDef: tblDef, Offset: start, Limit: end - start}
+	require.NoError(t, Prepare(proc, arg))
+	defer arg.Free(proc, false)
+
+	var as []int64
+	var bs []string
+	var cNulls []bool
+	for {
+		status, err := Call(0, proc, arg, false, false)
+		require.NoError(t, err)
+		bat := proc.InputBatch()
+		if bat != nil {
+			as = append(as, vector.MustFixedCol[int64](bat.Vecs[0])...)
+			for _, b := range vector.MustStrCol(bat.Vecs[1]) {
+				bs = append(bs, strings.Clone(b))
+			}
+			for i := 0; i < bat.RowCount(); i++ {
+				cNulls = append(cNulls, bat.Vecs[2].GetNulls().Contains(uint64(i)))
+			}
+			bat.Clean(proc.Mp())
+		}
+		if status == process.ExecStop {
+			break
+		}
+	}
+	return as, bs, cNulls
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{}, buf)
+	require.Equal(t, "stream scan", buf.String())
+}
+
+func TestFileSourceJson(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	path := filepath.Join(t.TempDir(), "s.log")
+	data := `{"a": 1, "b": "x", "c": 1.5}
+{"A": 2, "b": "y", "c": null}
+
+{"a": 3, "b": "z"}
+{"a": 4, "b": "partial"`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0644))
+
+	tblDef := newStreamTableDef(map[string]string{
+		TypeKey:     FileSource,
+		ValueKey:    JsonFormat,
+		FilepathKey: path,
+	})
+	as, bs, cNulls := runStream(t, proc, tblDef)
+	require.Equal(t, []int64{1, 2, 3}, as)
+	require.Equal(t, []string{"x", "y", "z"}, bs)
+	require.Equal(t, []bool{false, true, true}, cNulls)
+	require.Equal(t, int64(0), proc.Mp().CurrNB())
+}
+
+func TestFileSourceCsvRange(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	path := filepath.Join(t.TempDir(), "s.csv")
+	require.NoError(t, os.WriteFile(path, []byte("1|a|0.5\n2|\"b|c\"|\\N\n3|d|2\n"), 0644))
+
+	tblDef := newStreamTableDef(map[string]string{
+		TypeKey:      FileSource,
+		ValueKey:     CsvFormat,
+		DelimiterKey: "|",
+		FilepathKey:  path,
+	})
+	arg := &Argument{TblDef: tblDef, Offset: 1, Limit: 1}
+	require.NoError(t, Prepare(proc, arg))
+	_, err := Call(0, proc, arg, false, false)
+	require.NoError(t, err)
+	bat := proc.InputBatch()
+	require.Equal(t, 1, bat.RowCount())
+	require.Equal(t, []int64{2}, vector.MustFixedCol[int64](bat.Vecs[0]))
+	require.Equal(t, "b|c", bat.Vecs[1].GetStringAt(0))
+	require.True(t, bat.Vecs[2].GetNulls().Contains(0))
+	bat.Clean(proc.Mp())
+	arg.Free(proc, false)
+
+	as, _, _ := runStream(t, proc, tblDef)
+	require.Equal(t, []int64{1, 2, 3}, as)
+}
+
+func TestFileSourceAppend(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "s.csv")
+	require.NoError(t, os.WriteFile(path, []byte("1|a|0.5\n2|b|1\n3|c"), 0644))
+
+	src, err := NewSource(ctx, map[string]interface{}{TypeKey: FileSource, FilepathKey: path})
+	require.NoError(t, err)
+	defer src.Close()
+	records, next, err := src.Read(ctx, 0, 10, 1)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("1|a|0.5")}, records)
+	require.Equal(t, int64(1), next)
+	records, next, err = src.Read(ctx, next, 10, 4)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("2|b|1")}, records)
+	require.Equal(t, int64(2), next)
+
+	// the partial line is read once it is complete
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0644)
+	require.NoError(t, err)
+	_, err = f.WriteString("|2\n4|d|3\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	records, next, err = src.Read(ctx, next, 10, 4)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("3|c|2"), []byte("4|d|3")}, records)
+	require.Equal(t, int64(4), next)
+
+	// reading backwards reopens the file
+	records, next, err = src.Read(ctx, 1, 2, 4)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("2|b|1")}, records)
+	require.Equal(t, int64(2), next)
+}
+
+func TestBadSource(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewSource(ctx, map[string]interface{}{})
+	require.Error(t, err)
+	_, err = NewSource(ctx, map[string]interface{}{TypeKey: "unknown"})
+	require.Error(t, err)
+	_, err = NewSource(ctx, map[string]interface{}{TypeKey: FileSource})
+	require.Error(t, err)
+	_, _, err = GetStreamCurrentSize(ctx, map[string]interface{}{TypeKey: FileSource, FilepathKey: "/not/exist"})
+	require.Error(t, err)
+
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	path := filepath.Join(t.TempDir(), "s.log")
+	require.NoError(t, os.WriteFile(path, []byte("{\"a\": \"x\"}\n"), 0644))
+	tblDef := newStreamTableDef(map[string]string{TypeKey: FileSource, FilepathKey: path})
+	arg := &Argument{TblDef: tblDef, Offset: 0, Limit: 1}
+	require.NoError(t, Prepare(proc, arg))
+	_, err = Call(0, proc, arg, false, false)
+	require.Error(t, err)
+	arg.Free(proc, false)
+
+	tblDef = newStreamTableDef(map[string]string{TypeKey: FileSource, FilepathKey: path, ValueKey: "avro"})
+	require.Error(t, Prepare(proc, &Argument{TblDef: tblDef}))
+}
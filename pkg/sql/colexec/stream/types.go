@@ -22,13 +22,22 @@ import (
 
 type Argument struct {
 	TblDef *plan.TableDef
+	// Offset is the first offset to read, Limit is the length of the offset
+	// range [Offset, Offset+Limit) assigned to this scan.
 	Offset int64
 	Limit  int64
 
-	//end     bool
+	end     bool
 	attrs   []string
 	types   []types.Type
 	configs map[string]interface{}
+	src     Source
+	decode  recordDecoder
 }
 
-func (arg *Argument) Free(*process.Process, bool) {}
+func (arg *Argument) Free(*process.Process, bool) {
+	if arg.src != nil {
+		_ = arg.src.Close()
+		arg.src = nil
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// fulltextIndexTokenizeState is the compiled param of fulltext_index_tokenize.
+type fulltextIndexTokenizeState struct {
+	parser string
+	// outputs are the positions in arg.Attrs of the word, doc id, position
+	// and doc length columns, -1 if the column is not needed
+	outputs [4]int
+	tokens  []fulltext.Token
+}
+
+func fulltextIndexTokenizePrepare(proc *process.Process, arg *Argument) error {
+	var err error
+	param := plan2.FullTextIndexTokenizeParam{}
+	if err = json.Unmarshal(arg.Params, &param); err != nil {
+		return err
+	}
+	st := &fulltextIndexTokenizeState{
+		parser:  param.Parser,
+		outputs: [4]int{-1, -1, -1, -1},
+	}
+	for i, attr := range arg.Attrs {
+		switch attr {
+		case catalog.FullTextIndexWordColName:
+			st.outputs[0] = i
+		case catalog.FullTextIndexDocIdColName:
+			st.outputs[1] = i
+		case catalog.FullTextIndexPosColName:
+			st.outputs[2] = i
+		case catalog.FullTextIndexDocLenColName:
+			st.outputs[3] = i
+		}
+	}
+	arg.ctr.fulltextIndexTokenize = st
+	arg.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, arg.Args)
+	return err
+}
+
+func fulltextIndexTokenizeCall(_ int, proc *process.Process, arg *Argument) (bool, error) {
+	var (
+		err  error
+		rbat *batch.Batch
+	)
+	defer func() {
+		if err != nil && rbat != nil {
+			rbat.Clean(proc.Mp())
+		}
+	}()
+	bat := proc.InputBatch()
+	if bat == nil {
+		return true, nil
+	}
+	if bat.IsEmpty() {
+		proc.PutBatch(bat)
+		proc.SetInputBatch(batch.EmptyBatch)
+		return false, nil
+	}
+	vecs := make([]*vector.Vector, len(arg.ctr.executorsForArgs))
+	for i, executor := range arg.ctr.executorsForArgs {
+		if vecs[i], err = executor.Eval(proc, []*batch.Batch{bat}); err != nil {
+			return false, err
+		}
+	}
+
+	st := arg.ctr.fulltextIndexTokenize
+	rbat = batch.NewWithSize(len(arg.Attrs))
+	rbat.Attrs = arg.Attrs
+	rbat.Cnt = 1
+	for i := range arg.retSchema {
+		rbat.Vecs[i] = vector.NewVec(arg.retSchema[i])
+	}
+
+	rows := 0
+	docIdVec := vecs[0]
+	for i := 0; i < bat.RowCount(); i++ {
+		if docIdVec.IsConstNull() || docIdVec.GetNulls().Contains(uint64(i)) {
+			continue
+		}
+		// the columns are tokenized as one text, so the positions go on
+		// from one column to the next
+		st.tokens = st.tokens[:0]
+		for _, vec := range vecs[1:] {
+			idx := i
+			if vec.IsConst() {
+				idx = 0
+			}
+			if vec.IsConstNull() || vec.GetNulls().Contains(uint64(idx)) {
+				continue
+			}
+			st.tokens = fulltext.Tokenize(st.parser, vec.GetStringAt(idx), st.tokens, int32(len(st.tokens)))
+		}
+		if err = st.emit(proc, rbat, docIdVec, i); err != nil {
+			return false, err
+		}
+		rows += len(st.tokens)
+	}
+	rbat.SetRowCount(rows)
+	proc.SetInputBatch(rbat)
+	return false, nil
+}
+
+func (st *fulltextIndexTokenizeState) emit(proc *process.Process, rbat *batch.Batch, docIdVec *vector.Vector, row int) error {
+	docLen := int32(len(st.tokens))
+	for _, token := range st.tokens {
+		if pos := st.outputs[0]; pos >= 0 {
+			if err := vector.AppendBytes(rbat.Vecs[pos], []byte(token.Word), false, proc.Mp()); err != nil {
+				return err
+			}
+		}
+		if pos := st.outputs[1]; pos >= 0 {
+			if err := rbat.Vecs[pos].UnionOne(docIdVec, int64(row), proc.Mp()); err != nil {
+				return err
+			}
+		}
+		if pos := st.outputs[2]; pos >= 0 {
+			if err := vector.AppendFixed(rbat.Vecs[pos], token.Pos, false, proc.Mp()); err != nil {
+				return err
+			}
+		}
+		if pos := st.outputs[3]; pos >= 0 {
+			if err := vector.AppendFixed(rbat.Vecs[pos], docLen, false, proc.Mp()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
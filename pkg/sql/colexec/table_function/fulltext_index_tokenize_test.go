@@ -0,0 +1,118 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+var fulltextIndexTokenizeTestColDefs = []*plan.ColDef{
+	{Name: catalog.FullTextIndexWordColName, Typ: &plan.Type{Id: int32(types.T_varchar)}},
+	{Name: catalog.FullTextIndexDocIdColName, Typ: &plan.Type{Id: int32(types.T_int64)}},
+	{Name: catalog.FullTextIndexPosColName, Typ: &plan.Type{Id: int32(types.T_int32)}},
+	{Name: catalog.FullTextIndexDocLenColName, Typ: &plan.Type{Id: int32(types.T_int32)}},
+}
+
+func newFulltextIndexTokenizeArg(t *testing.T, parser string, colDefs []*plan.ColDef) *Argument {
+	data, err := json.Marshal(&plan2.FullTextIndexTokenizeParam{Parser: parser})
+	require.NoError(t, err)
+	attrs := make([]string, len(colDefs))
+	for i, col := range colDefs {
+		attrs[i] = col.Name
+	}
+	return &Argument{
+		Name:   "fulltext_index_tokenize",
+		Attrs:  attrs,
+		Rets:   colDefs,
+		Params: data,
+		Args: []*plan.Expr{
+			{
+				Typ:  &plan.Type{Id: int32(types.T_int64)},
+				Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: 0, ColPos: 0}},
+			},
+			{
+				Typ:  &plan.Type{Id: int32(types.T_varchar)},
+				Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: 0, ColPos: 1}},
+			},
+			{
+				Typ:  &plan.Type{Id: int32(types.T_text)},
+				Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: 0, ColPos: 2}},
+			},
+		},
+	}
+}
+
+func TestFulltextIndexTokenize(t *testing.T) {
+	proc := testutil.NewProcess()
+	arg := newFulltextIndexTokenizeArg(t, "", fulltextIndexTokenizeTestColDefs)
+	require.NoError(t, Prepare(proc, arg))
+
+	bat := batch.NewWithSize(3)
+	bat.Vecs[0] = testutil.MakeInt64Vector([]int64{1, 2, 3}, nil)
+	bat.Vecs[1] = testutil.MakeVarcharVector([]string{"Hello World", "", "全文"}, []uint64{1})
+	bat.Vecs[2] = vector.NewVec(types.T_text.ToType())
+	for _, s := range []string{"hello", "", "索引"} {
+		require.NoError(t, vector.AppendBytes(bat.Vecs[2], []byte(s), false, proc.Mp()))
+	}
+	bat.SetRowCount(3)
+	proc.SetInputBatch(bat)
+
+	end, err := fulltextIndexTokenizeCall(0, proc, arg)
+	require.NoError(t, err)
+	require.False(t, end)
+	require.Equal(t, [][]string{
+		{"hello", "1", "0", "3"},
+		{"world", "1", "1", "3"},
+		{"hello", "1", "2", "3"},
+		{"全文", "3", "0", "2"},
+		{"索引", "3", "1", "2"},
+	}, jsonTableRows(proc.InputBatch()))
+	proc.InputBatch().Clean(proc.Mp())
+	bat.Clean(proc.Mp())
+	arg.Free(proc, false)
+}
+
+func TestFulltextIndexTokenizePrunedColumns(t *testing.T) {
+	proc := testutil.NewProcess()
+	arg := newFulltextIndexTokenizeArg(t, "ngram", []*plan.ColDef{fulltextIndexTokenizeTestColDefs[2], fulltextIndexTokenizeTestColDefs[0]})
+	require.NoError(t, Prepare(proc, arg))
+
+	bat := batch.NewWithSize(3)
+	bat.Vecs[0] = testutil.MakeInt64Vector([]int64{1}, nil)
+	bat.Vecs[1] = testutil.MakeVarcharVector([]string{"abc"}, nil)
+	bat.Vecs[2] = testutil.MakeTextVector([]string{""}, []uint64{0})
+	bat.SetRowCount(1)
+	proc.SetInputBatch(bat)
+
+	_, err := fulltextIndexTokenizeCall(0, proc, arg)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"0", "ab"},
+		{"1", "bc"},
+	}, jsonTableRows(proc.InputBatch()))
+	proc.InputBatch().Clean(proc.Mp())
+	bat.Clean(proc.Mp())
+	arg.Free(proc, false)
+}
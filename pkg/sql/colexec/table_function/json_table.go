@@ -0,0 +1,498 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// jsonTableState is the compiled param of json_table.
+type jsonTableState struct {
+	root *jsonTableNode
+	// cols are the output columns in the order of arg.Attrs
+	cols []*jsonTableColumn
+	// raws keep the text of the values which have to be cast to the column type
+	raws []*vector.Vector
+	// row is the values of the row being produced
+	row []jsonTableCell
+}
+
+// jsonTableNode is a row path, which is the path of JSON_TABLE or a NESTED PATH.
+type jsonTableNode struct {
+	path    bytejson.Path
+	columns []*jsonTableColumn
+	nested  []*jsonTableNode
+	// outputs are the positions of all the output columns under this node,
+	// including the nested ones
+	outputs []int
+}
+
+type jsonTableColumn struct {
+	*plan2.JsonTableColumn
+	path bytejson.Path
+	typ  types.Type
+	// pos is the position in arg.Attrs, the columns which are not needed are skipped
+	pos        int
+	onEmptyVal bytejson.ByteJson
+	onErrorVal bytejson.ByteJson
+	cast       colexec.ExpressionExecutor
+}
+
+type jsonTableCell struct {
+	null    bool
+	ordinal uint32
+	val     bytejson.ByteJson
+}
+
+var jsonTableExistsVals [2]bytejson.ByteJson
+
+func init() {
+	jsonTableExistsVals[0], _ = bytejson.ParseFromString("0")
+	jsonTableExistsVals[1], _ = bytejson.ParseFromString("1")
+}
+
+func jsonTablePrepare(proc *process.Process, arg *Argument) error {
+	var err error
+	param := plan2.JsonTableParam{}
+	if err = json.Unmarshal(arg.Params, &param); err != nil {
+		return err
+	}
+	positions := make(map[string]int, len(arg.Attrs))
+	for i, attr := range arg.Attrs {
+		positions[attr] = i
+	}
+	st := &jsonTableState{
+		cols: make([]*jsonTableColumn, len(arg.Attrs)),
+		raws: make([]*vector.Vector, len(arg.Attrs)),
+		row:  make([]jsonTableCell, len(arg.Attrs)),
+	}
+	for i := range st.row {
+		st.row[i].null = true
+	}
+	if st.root, err = newJsonTableNode(proc, &param, positions, arg.retSchema, st); err != nil {
+		st.free(proc)
+		return err
+	}
+	arg.ctr.jsonTable = st
+	arg.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, arg.Args)
+	return err
+}
+
+func newJsonTableNode(proc *process.Process, param *plan2.JsonTableParam, positions map[string]int, retSchema []types.Type, st *jsonTableState) (*jsonTableNode, error) {
+	var err error
+	node := &jsonTableNode{}
+	if node.path, err = types.ParseStringToPath(param.Path); err != nil {
+		return nil, err
+	}
+	for _, c := range param.Columns {
+		if c.Kind == tree.JsonTableColumnNested {
+			nested, err := newJsonTableNode(proc, c.Nested, positions, retSchema, st)
+			if err != nil {
+				return nil, err
+			}
+			node.nested = append(node.nested, nested)
+			node.outputs = append(node.outputs, nested.outputs...)
+			continue
+		}
+		pos, ok := positions[c.Name]
+		if !ok {
+			continue
+		}
+		col := &jsonTableColumn{JsonTableColumn: c, pos: pos, typ: retSchema[pos]}
+		if c.Kind != tree.JsonTableColumnOrdinality {
+			if col.path, err = types.ParseStringToPath(c.Path); err != nil {
+				return nil, err
+			}
+		}
+		if col.onEmptyVal, err = parseJsonTableDefault(c.OnEmpty); err != nil {
+			return nil, err
+		}
+		if col.onErrorVal, err = parseJsonTableDefault(c.OnError); err != nil {
+			return nil, err
+		}
+		if c.Kind != tree.JsonTableColumnOrdinality && jsonTableNeedCast(col.typ) {
+			if col.cast, err = newJsonTableCast(proc, col.typ); err != nil {
+				return nil, err
+			}
+			st.raws[pos] = vector.NewVec(types.T_varchar.ToType())
+		}
+		st.cols[pos] = col
+		node.columns = append(node.columns, col)
+		node.outputs = append(node.outputs, pos)
+	}
+	return node, nil
+}
+
+// parseJsonTableDefault parses the json string of DEFAULT, a string which is
+// not a valid json document is used as a json string.
+func parseJsonTableDefault(resp *tree.JsonTableOnResponse) (bytejson.ByteJson, error) {
+	if resp == nil || resp.Kind != tree.JsonTableOnResponseDefault {
+		return bytejson.Null, nil
+	}
+	if bj, err := bytejson.ParseFromString(resp.Value); err == nil {
+		return bj, nil
+	}
+	var bj bytejson.ByteJson
+	err := bj.UnmarshalObject(resp.Value)
+	return bj, err
+}
+
+func jsonTableNeedCast(typ types.Type) bool {
+	return typ.Oid != types.T_json && !typ.Oid.IsMySQLString()
+}
+
+// newJsonTableCast returns the executor casting the first column of a varchar batch to the type.
+func newJsonTableCast(proc *process.Process, typ types.Type) (colexec.ExpressionExecutor, error) {
+	from := types.T_varchar.ToType()
+	fGet, err := function.GetFunctionByName(proc.Ctx, "cast", []types.Type{from, typ})
+	if err != nil {
+		return nil, err
+	}
+	toType := plan2.MakePlan2Type(&typ)
+	expr := &plan.Expr{
+		Typ: toType,
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{Obj: fGet.GetEncodedOverloadID(), ObjName: "cast"},
+				Args: []*plan.Expr{
+					{
+						Typ:  plan2.MakePlan2Type(&from),
+						Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: 0, ColPos: 0}},
+					},
+					{
+						Typ:  toType,
+						Expr: &plan.Expr_T{T: &plan.TargetType{Typ: toType}},
+					},
+				},
+			},
+		},
+	}
+	return colexec.NewExpressionExecutor(proc, expr)
+}
+
+func (st *jsonTableState) free(proc *process.Process) {
+	if st == nil {
+		return
+	}
+	for _, col := range st.cols {
+		if col != nil && col.cast != nil {
+			col.cast.Free()
+		}
+	}
+	for _, raw := range st.raws {
+		if raw != nil {
+			raw.Free(proc.Mp())
+		}
+	}
+}
+
+func (st *jsonTableState) cleanRaws() {
+	for _, raw := range st.raws {
+		if raw != nil {
+			raw.CleanOnlyData()
+		}
+	}
+}
+
+func jsonTableCall(_ int, proc *process.Process, arg *Argument) (bool, error) {
+	var (
+		err  error
+		rbat *batch.Batch
+	)
+	defer func() {
+		if err != nil && rbat != nil {
+			rbat.Clean(proc.Mp())
+		}
+	}()
+	bat := proc.InputBatch()
+	if bat == nil {
+		return true, nil
+	}
+	if bat.IsEmpty() {
+		proc.PutBatch(bat)
+		proc.SetInputBatch(batch.EmptyBatch)
+		return false, nil
+	}
+	docVec, err := arg.ctr.executorsForArgs[0].Eval(proc, []*batch.Batch{bat})
+	if err != nil {
+		return false, err
+	}
+
+	st := arg.ctr.jsonTable
+	st.cleanRaws()
+	rbat = batch.NewWithSize(len(arg.Attrs))
+	rbat.Attrs = arg.Attrs
+	rbat.Cnt = 1
+	for i := range arg.retSchema {
+		rbat.Vecs[i] = vector.NewVec(arg.retSchema[i])
+	}
+
+	rows := 0
+	for i := 0; i < bat.RowCount(); i++ {
+		idx := i
+		if docVec.IsConst() {
+			idx = 0
+		}
+		if docVec.IsConstNull() || docVec.GetNulls().Contains(uint64(idx)) {
+			continue
+		}
+		var doc bytejson.ByteJson
+		if docVec.GetType().Oid == types.T_json {
+			doc = types.DecodeJson(docVec.GetBytesAt(idx))
+		} else if doc, err = types.ParseSliceToByteJson(docVec.GetBytesAt(idx)); err != nil {
+			return false, err
+		}
+		var n int
+		if n, err = st.produce(proc, st.root, doc, rbat); err != nil {
+			return false, err
+		}
+		rows += n
+	}
+	if err = st.castRaws(proc, rbat, rows); err != nil {
+		return false, err
+	}
+	rbat.SetRowCount(rows)
+	proc.SetInputBatch(rbat)
+	return false, nil
+}
+
+// produce generates the rows of the node for the value. The sibling nested paths
+// are joined by union and each of them is joined to the parent by left outer join.
+func (st *jsonTableState) produce(proc *process.Process, node *jsonTableNode, val bytejson.ByteJson, rbat *batch.Batch) (int, error) {
+	rows := 0
+	for i, match := range val.LookupAll(&node.path) {
+		for _, col := range node.columns {
+			if err := st.fill(col, match, uint32(i+1)); err != nil {
+				return 0, err
+			}
+		}
+		produced := 0
+		for _, nested := range node.nested {
+			n, err := st.produce(proc, nested, match, rbat)
+			if err != nil {
+				return 0, err
+			}
+			produced += n
+			st.setNull(nested.outputs)
+		}
+		if produced == 0 {
+			if err := st.emit(proc, rbat); err != nil {
+				return 0, err
+			}
+			produced = 1
+		}
+		rows += produced
+	}
+	st.setNull(node.outputs)
+	return rows, nil
+}
+
+func (st *jsonTableState) setNull(outputs []int) {
+	for _, pos := range outputs {
+		st.row[pos] = jsonTableCell{null: true}
+	}
+}
+
+func (st *jsonTableState) fill(col *jsonTableColumn, match bytejson.ByteJson, ordinal uint32) error {
+	cell := &st.row[col.pos]
+	*cell = jsonTableCell{}
+	switch col.Kind {
+	case tree.JsonTableColumnOrdinality:
+		cell.ordinal = ordinal
+		return nil
+	case tree.JsonTableColumnExists:
+		if len(match.LookupAll(&col.path)) > 0 {
+			cell.val = jsonTableExistsVals[1]
+		} else {
+			cell.val = jsonTableExistsVals[0]
+		}
+		return nil
+	}
+
+	vals := match.LookupAll(&col.path)
+	if len(vals) == 0 {
+		return col.respond(cell, col.OnEmpty, col.onEmptyVal, "missing value for json_table column '%s'")
+	}
+	if len(vals) > 1 {
+		return col.respond(cell, col.OnError, col.onErrorVal, "more than one value for json_table column '%s'")
+	}
+	cell.val = vals[0]
+	if col.typ.Oid == types.T_json {
+		return nil
+	}
+	switch cell.val.Type {
+	case bytejson.TpCodeObject, bytejson.TpCodeArray:
+		return col.respond(cell, col.OnError, col.onErrorVal, "can't store an array or an object in the scalar json_table column '%s'")
+	case bytejson.TpCodeLiteral:
+		if cell.val.IsNull() {
+			cell.null = true
+			return nil
+		}
+	}
+	if col.typ.Oid.IsMySQLString() && col.typ.Width > 0 &&
+		utf8.RuneCountInString(jsonTableText(cell.val, col.typ)) > int(col.typ.Width) {
+		return col.respond(cell, col.OnError, col.onErrorVal, "data too long for json_table column '%s'")
+	}
+	return nil
+}
+
+// respond sets the cell by the ON EMPTY or ON ERROR clause, which is NULL by default.
+func (col *jsonTableColumn) respond(cell *jsonTableCell, resp *tree.JsonTableOnResponse, val bytejson.ByteJson, msg string) error {
+	if resp == nil || resp.Kind == tree.JsonTableOnResponseNull {
+		cell.null = true
+		return nil
+	}
+	if resp.Kind == tree.JsonTableOnResponseError {
+		return moerr.NewInvalidInputNoCtx(msg, col.Name)
+	}
+	cell.val = val
+	return nil
+}
+
+// jsonTableText returns the text of the json value which is stored in a non-json column.
+func jsonTableText(val bytejson.ByteJson, typ types.Type) string {
+	switch val.Type {
+	case bytejson.TpCodeString:
+		return string(val.GetString())
+	case bytejson.TpCodeLiteral:
+		if !typ.Oid.IsMySQLString() {
+			if val.Data[0] == bytejson.LiteralTrue {
+				return "1"
+			}
+			return "0"
+		}
+	}
+	return val.String()
+}
+
+func (st *jsonTableState) emit(proc *process.Process, rbat *batch.Batch) error {
+	mp := proc.Mp()
+	for pos, col := range st.cols {
+		cell := &st.row[pos]
+		vec := rbat.Vecs[pos]
+		var err error
+		switch {
+		case col.Kind == tree.JsonTableColumnOrdinality:
+			err = vector.AppendFixed(vec, cell.ordinal, cell.null, mp)
+		case cell.null:
+			if col.cast != nil {
+				err = vector.AppendBytes(st.raws[pos], nil, true, mp)
+			} else {
+				err = vector.AppendBytes(vec, nil, true, mp)
+			}
+		case col.typ.Oid == types.T_json:
+			var data []byte
+			if data, err = cell.val.Marshal(); err == nil {
+				err = vector.AppendBytes(vec, data, false, mp)
+			}
+		case col.cast != nil:
+			err = vector.AppendBytes(st.raws[pos], []byte(jsonTableText(cell.val, col.typ)), false, mp)
+		default:
+			err = vector.AppendBytes(vec, []byte(jsonTableText(cell.val, col.typ)), false, mp)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// castRaws casts the text of the values to the column types. If the cast of the
+// whole column fails, the values are cast one by one to apply the ON ERROR clause.
+func (st *jsonTableState) castRaws(proc *process.Process, rbat *batch.Batch, rows int) error {
+	if rows == 0 {
+		return nil
+	}
+	for pos, col := range st.cols {
+		if col.cast == nil {
+			continue
+		}
+		input := batch.NewWithSize(1)
+		input.Vecs[0] = st.raws[pos]
+		input.SetRowCount(rows)
+		res, err := col.cast.Eval(proc, []*batch.Batch{input})
+		if err == nil {
+			var vec *vector.Vector
+			if vec, err = res.Dup(proc.Mp()); err != nil {
+				return err
+			}
+			rbat.Vecs[pos].Free(proc.Mp())
+			rbat.Vecs[pos] = vec
+			continue
+		}
+		if err = st.castOneByOne(proc, col, rbat.Vecs[pos], rows); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (st *jsonTableState) castOneByOne(proc *process.Process, col *jsonTableColumn, vec *vector.Vector, rows int) error {
+	raw := st.raws[col.pos]
+	one := vector.NewVec(types.T_varchar.ToType())
+	defer one.Free(proc.Mp())
+	input := batch.NewWithSize(1)
+	input.Vecs[0] = one
+	input.SetRowCount(1)
+
+	cast := func(text []byte) (*vector.Vector, error) {
+		one.CleanOnlyData()
+		if err := vector.AppendBytes(one, text, false, proc.Mp()); err != nil {
+			return nil, err
+		}
+		return col.cast.Eval(proc, []*batch.Batch{input})
+	}
+	for i := 0; i < rows; i++ {
+		if raw.GetNulls().Contains(uint64(i)) {
+			if err := vec.UnionNull(proc.Mp()); err != nil {
+				return err
+			}
+			continue
+		}
+		res, err := cast(raw.GetBytesAt(i))
+		if err != nil {
+			resp := col.OnError
+			if resp != nil && resp.Kind == tree.JsonTableOnResponseError {
+				return err
+			}
+			if resp == nil || resp.Kind == tree.JsonTableOnResponseNull {
+				if err = vec.UnionNull(proc.Mp()); err != nil {
+					return err
+				}
+				continue
+			}
+			if res, err = cast([]byte(jsonTableText(col.onErrorVal, col.typ))); err != nil {
+				return err
+			}
+		}
+		if err = vec.UnionOne(res, 0, proc.Mp()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
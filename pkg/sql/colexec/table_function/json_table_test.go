@@ -0,0 +1,209 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	jsonTableTestParam = &plan2.JsonTableParam{
+		Path: "$.items[*]",
+		Columns: []*plan2.JsonTableColumn{
+			{Kind: tree.JsonTableColumnOrdinality, Name: "ord"},
+			{
+				Kind:    tree.JsonTableColumnPath,
+				Name:    "id",
+				Path:    "$.id",
+				OnEmpty: &tree.JsonTableOnResponse{Kind: tree.JsonTableOnResponseDefault, Value: "-1"},
+			},
+			{
+				Kind:    tree.JsonTableColumnPath,
+				Name:    "name",
+				Path:    "$.name",
+				OnError: &tree.JsonTableOnResponse{Kind: tree.JsonTableOnResponseDefault, Value: `"long"`},
+			},
+			{Kind: tree.JsonTableColumnExists, Name: "has_tags", Path: "$.tags"},
+			{
+				Kind: tree.JsonTableColumnNested,
+				Nested: &plan2.JsonTableParam{
+					Path: "$.tags[*]",
+					Columns: []*plan2.JsonTableColumn{
+						{Kind: tree.JsonTableColumnPath, Name: "tag", Path: "$"},
+						{Kind: tree.JsonTableColumnOrdinality, Name: "tag_ord"},
+					},
+				},
+			},
+		},
+	}
+	jsonTableTestColDefs = []*plan.ColDef{
+		{Name: "ord", Typ: &plan.Type{Id: int32(types.T_uint32)}},
+		{Name: "id", Typ: &plan.Type{Id: int32(types.T_int64)}},
+		{Name: "name", Typ: &plan.Type{Id: int32(types.T_varchar), Width: 5}},
+		{Name: "has_tags", Typ: &plan.Type{Id: int32(types.T_int32)}},
+		{Name: "tag", Typ: &plan.Type{Id: int32(types.T_json)}},
+		{Name: "tag_ord", Typ: &plan.Type{Id: int32(types.T_uint32)}},
+	}
+)
+
+func newJsonTableArg(t *testing.T, param *plan2.JsonTableParam, colDefs []*plan.ColDef) *Argument {
+	data, err := json.Marshal(param)
+	require.NoError(t, err)
+	attrs := make([]string, len(colDefs))
+	for i, col := range colDefs {
+		attrs[i] = col.Name
+	}
+	typ := types.T_varchar.ToType()
+	return &Argument{
+		Name:   "json_table",
+		Attrs:  attrs,
+		Rets:   colDefs,
+		Params: data,
+		Args: []*plan.Expr{
+			{
+				Typ:  plan2.MakePlan2Type(&typ),
+				Expr: &plan.Expr_Col{Col: &plan.ColRef{RelPos: 0, ColPos: 0}},
+			},
+		},
+	}
+}
+
+func runJsonTable(t *testing.T, proc *process.Process, arg *Argument, docs []string) (*batch.Batch, error) {
+	require.NoError(t, Prepare(proc, arg))
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vector.NewVec(types.T_varchar.ToType())
+	for _, doc := range docs {
+		require.NoError(t, vector.AppendBytes(bat.Vecs[0], []byte(doc), doc == "", proc.Mp()))
+	}
+	bat.SetRowCount(len(docs))
+	proc.SetInputBatch(bat)
+	_, err := jsonTableCall(0, proc, arg)
+	bat.Clean(proc.Mp())
+	if err != nil {
+		return nil, err
+	}
+	return proc.InputBatch(), nil
+}
+
+func jsonTableRows(bat *batch.Batch) [][]string {
+	rows := make([][]string, bat.RowCount())
+	for i := range rows {
+		for _, vec := range bat.Vecs {
+			if vec.GetNulls().Contains(uint64(i)) {
+				rows[i] = append(rows[i], "NULL")
+				continue
+			}
+			switch vec.GetType().Oid {
+			case types.T_json:
+				rows[i] = append(rows[i], types.DecodeJson(vec.GetBytesAt(i)).String())
+			case types.T_varchar:
+				rows[i] = append(rows[i], vec.GetStringAt(i))
+			case types.T_uint32:
+				rows[i] = append(rows[i], fmt.Sprint(vector.GetFixedAt[uint32](vec, i)))
+			case types.T_int32:
+				rows[i] = append(rows[i], fmt.Sprint(vector.GetFixedAt[int32](vec, i)))
+			case types.T_int64:
+				rows[i] = append(rows[i], fmt.Sprint(vector.GetFixedAt[int64](vec, i)))
+			}
+		}
+	}
+	return rows
+}
+
+func TestJsonTable(t *testing.T) {
+	proc := testutil.NewProcess()
+	arg := newJsonTableArg(t, jsonTableTestParam, jsonTableTestColDefs)
+	bat, err := runJsonTable(t, proc, arg, []string{
+		`{"items": [{"id": 1, "name": "a", "tags": ["x", "y"]}, {"id": "bad", "name": "too long"}, {"name": null}]}`,
+		"",
+		`{"items": [{"id": 7, "tags": []}]}`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"1", "1", "a", "1", `"x"`, "1"},
+		{"1", "1", "a", "1", `"y"`, "2"},
+		{"2", "NULL", "long", "0", "NULL", "NULL"},
+		{"3", "-1", "NULL", "0", "NULL", "NULL"},
+		{"1", "7", "NULL", "1", "NULL", "NULL"},
+	}, jsonTableRows(bat))
+	bat.Clean(proc.Mp())
+	arg.Free(proc, false)
+
+	arg = newJsonTableArg(t, jsonTableTestParam, jsonTableTestColDefs)
+	bat, err = runJsonTable(t, proc, arg, []string{"", `{"items": 1}`})
+	require.NoError(t, err)
+	require.Equal(t, 0, bat.RowCount())
+	bat.Clean(proc.Mp())
+	arg.Free(proc, false)
+}
+
+func TestJsonTablePrunedColumns(t *testing.T) {
+	proc := testutil.NewProcess()
+	arg := newJsonTableArg(t, jsonTableTestParam, []*plan.ColDef{jsonTableTestColDefs[5], jsonTableTestColDefs[1]})
+	bat, err := runJsonTable(t, proc, arg, []string{`{"items": [{"id": 1, "tags": ["x", "y"]}, {"id": 2}]}`})
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"1", "1"},
+		{"2", "1"},
+		{"NULL", "2"},
+	}, jsonTableRows(bat))
+	bat.Clean(proc.Mp())
+	arg.Free(proc, false)
+}
+
+func TestJsonTableOnError(t *testing.T) {
+	proc := testutil.NewProcess()
+	param := &plan2.JsonTableParam{
+		Path: "$[*]",
+		Columns: []*plan2.JsonTableColumn{
+			{
+				Kind:    tree.JsonTableColumnPath,
+				Name:    "a",
+				Path:    "$.a",
+				OnEmpty: &tree.JsonTableOnResponse{Kind: tree.JsonTableOnResponseError},
+			},
+		},
+	}
+	colDefs := []*plan.ColDef{{Name: "a", Typ: &plan.Type{Id: int32(types.T_int64)}}}
+
+	arg := newJsonTableArg(t, param, colDefs)
+	_, err := runJsonTable(t, proc, arg, []string{`[{"a": 1}, {"b": 2}]`})
+	require.Error(t, err)
+	arg.Free(proc, false)
+
+	param.Columns[0].OnEmpty = nil
+	param.Columns[0].OnError = &tree.JsonTableOnResponse{Kind: tree.JsonTableOnResponseError}
+	arg = newJsonTableArg(t, param, colDefs)
+	_, err = runJsonTable(t, proc, arg, []string{`[{"a": 1}, {"a": "x"}]`})
+	require.Error(t, err)
+	arg.Free(proc, false)
+
+	arg = newJsonTableArg(t, param, colDefs)
+	_, err = runJsonTable(t, proc, arg, []string{`[{"a": 1}, {"a": [1]}]`})
+	require.Error(t, err)
+	arg.Free(proc, false)
+}
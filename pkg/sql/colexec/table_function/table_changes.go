@@ -0,0 +1,170 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package table_function
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// tableChangesState is the compiled param of mo_table_changes.
+type tableChangesState struct {
+	param plan2.TableChangesParam
+	// attrs are the columns of the table in arg.Attrs, and sign is the position
+	// of the sign column in arg.Attrs, -1 if the column is not needed
+	attrs []string
+	sign  int
+}
+
+func tableChangesPrepare(proc *process.Process, arg *Argument) error {
+	var err error
+	st := &tableChangesState{sign: -1}
+	if err = json.Unmarshal(arg.Params, &st.param); err != nil {
+		return err
+	}
+	for i, attr := range arg.Attrs {
+		if attr == catalog.TableChangesSignColName {
+			st.sign = i
+			continue
+		}
+		st.attrs = append(st.attrs, attr)
+	}
+	arg.ctr.tableChanges = st
+	arg.ctr.executorsForArgs, err = colexec.NewExpressionExecutorsFromPlanExpressions(proc, arg.Args)
+	return err
+}
+
+func tableChangesCall(_ int, proc *process.Process, arg *Argument) (bool, error) {
+	var (
+		err              error
+		rbat             *batch.Batch
+		inserts, deletes *batch.Batch
+	)
+	defer func() {
+		if err != nil && rbat != nil {
+			rbat.Clean(proc.Mp())
+		}
+		if inserts != nil {
+			inserts.Clean(proc.Mp())
+		}
+		if deletes != nil {
+			deletes.Clean(proc.Mp())
+		}
+	}()
+	bat := proc.InputBatch()
+	if bat == nil {
+		return true, nil
+	}
+	if bat.IsEmpty() {
+		proc.PutBatch(bat)
+		proc.SetInputBatch(batch.EmptyBatch)
+		return false, nil
+	}
+
+	var ts [2]types.TS
+	for i, executor := range arg.ctr.executorsForArgs {
+		var vec *vector.Vector
+		if vec, err = executor.Eval(proc, []*batch.Batch{bat}); err != nil {
+			return false, err
+		}
+		if vec.IsConstNull() || vec.GetNulls().Contains(0) {
+			err = moerr.NewInvalidInput(proc.Ctx, "%s: the timestamp can not be null", "mo_table_changes")
+			return false, err
+		}
+		if ts[i], err = parseTableChangesTS(proc, vec.GetStringAt(0)); err != nil {
+			return false, err
+		}
+	}
+	from, to := ts[0], ts[1]
+	// the changes after the snapshot are not visible to the transaction
+	if to.Greater(types.TimestampToTS(proc.TxnOperator.Txn().SnapshotTS)) {
+		err = moerr.NewInvalidInput(proc.Ctx, "%s: the timestamp %s is after the snapshot of the transaction", "mo_table_changes", to.ToString())
+		return false, err
+	}
+
+	st := arg.ctr.tableChanges
+	e := proc.Ctx.Value(defines.EngineKey{}).(engine.Engine)
+	db, err := e.Database(proc.Ctx, st.param.Database, proc.TxnOperator)
+	if err != nil {
+		return false, err
+	}
+	rel, err := db.Relation(proc.Ctx, st.param.Table, nil)
+	if err != nil {
+		return false, err
+	}
+	if inserts, deletes, err = rel.CollectChanges(proc.Ctx, from, to, st.attrs, proc.Mp()); err != nil {
+		return false, err
+	}
+
+	rbat = batch.NewWithSize(len(arg.Attrs))
+	rbat.Attrs = arg.Attrs
+	rbat.Cnt = 1
+	for i := range arg.retSchema {
+		rbat.Vecs[i] = vector.NewVec(arg.retSchema[i])
+	}
+	j := 0
+	for i := range arg.Attrs {
+		if i == st.sign {
+			continue
+		}
+		if err = rbat.Vecs[i].UnionBatch(inserts.Vecs[j], 0, inserts.RowCount(), nil, proc.Mp()); err != nil {
+			return false, err
+		}
+		if err = rbat.Vecs[i].UnionBatch(deletes.Vecs[j], 0, deletes.RowCount(), nil, proc.Mp()); err != nil {
+			return false, err
+		}
+		j++
+	}
+	if st.sign >= 0 {
+		for i := 0; i < inserts.RowCount(); i++ {
+			if err = vector.AppendFixed(rbat.Vecs[st.sign], int8(1), false, proc.Mp()); err != nil {
+				return false, err
+			}
+		}
+		for i := 0; i < deletes.RowCount(); i++ {
+			if err = vector.AppendFixed(rbat.Vecs[st.sign], int8(-1), false, proc.Mp()); err != nil {
+				return false, err
+			}
+		}
+	}
+	rbat.SetRowCount(inserts.RowCount() + deletes.RowCount())
+	proc.SetInputBatch(rbat)
+	return false, nil
+}
+
+// parseTableChangesTS parses the timestamp in the format of types.TS.ToString.
+func parseTableChangesTS(proc *process.Process, s string) (types.TS, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) == 2 {
+		physical, err1 := strconv.ParseInt(parts[0], 10, 64)
+		logical, err2 := strconv.ParseUint(parts[1], 10, 32)
+		if err1 == nil && err2 == nil {
+			return types.BuildTS(physical, uint32(logical)), nil
+		}
+	}
+	return types.TS{}, moerr.NewInvalidInput(proc.Ctx, "%s: invalid timestamp '%s'", "mo_table_changes", s)
+}
@@ -45,6 +45,12 @@ func Call(idx int, proc *process.Process, arg any, isFirst bool, isLast bool) (p
 		f, e = metadataScan(idx, proc, tblArg)
 	case "processlist":
 		f, e = processlist(idx, proc, tblArg)
+	case "json_table":
+		f, e = jsonTableCall(idx, proc, tblArg)
+	case "fulltext_index_tokenize":
+		f, e = fulltextIndexTokenizeCall(idx, proc, tblArg)
+	case "mo_table_changes":
+		f, e = tableChangesCall(idx, proc, tblArg)
 	default:
 		return process.ExecStop, moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.Name))
 	}
@@ -107,6 +113,12 @@ func Prepare(proc *process.Process, arg any) error {
 		return metadataScanPrepare(proc, tblArg)
 	case "processlist":
 		return processlistPrepare(proc, tblArg)
+	case "json_table":
+		return jsonTablePrepare(proc, tblArg)
+	case "fulltext_index_tokenize":
+		return fulltextIndexTokenizePrepare(proc, tblArg)
+	case "mo_table_changes":
+		return tableChangesPrepare(proc, tblArg)
 	default:
 		return moerr.NewNotSupported(proc.Ctx, fmt.Sprintf("table function %s is not supported", tblArg.Name))
 	}
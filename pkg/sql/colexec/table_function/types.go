@@ -41,11 +41,16 @@ type container struct {
 	state int
 
 	executorsForArgs []colexec.ExpressionExecutor
+
+	jsonTable             *jsonTableState
+	fulltextIndexTokenize *fulltextIndexTokenizeState
+	tableChanges          *tableChangesState
 }
 
 func (arg *Argument) Free(proc *process.Process, pipelineFailed bool) {
 	if arg.ctr != nil {
 		arg.ctr.cleanExecutors()
+		arg.ctr.jsonTable.free(proc)
 	}
 }
 
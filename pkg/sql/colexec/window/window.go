@@ -145,7 +145,8 @@ func Call(idx int, proc *process.Process, arg any, isFirst, isLast bool) (proces
 func (ctr *container) processFunc(idx int, ap *Argument, proc *process.Process, anal process.Analyze) error {
 	var err error
 	n := ctr.bat.Vecs[0].Length()
-	isWinOrder := function.GetFunctionIsWinOrderFunByName(ap.WinSpecList[idx].Expr.(*plan.Expr_W).W.Name)
+	w := ap.WinSpecList[idx].Expr.(*plan.Expr_W).W
+	isWinOrder := function.GetFunctionIsWinOrderFunByName(w.Name)
 	if isWinOrder {
 		if ctr.ps == nil {
 			ctr.ps = append(ctr.ps, 0)
@@ -182,10 +183,20 @@ func (ctr *container) processFunc(idx int, ap *Argument, proc *process.Process,
 
 			}
 		}
+	} else if w.Name == "lag" || w.Name == "lead" {
+		if err = ctr.processLagLead(idx, w, proc); err != nil {
+			return err
+		}
 	} else {
 		nullVec := vector.NewConstNull(*ctr.aggVecs[idx].vec.GetType(), 1, proc.Mp())
 		defer nullVec.Free(proc.Mp())
 
+		// the n of nth_value was folded into a constant by plan
+		nth := 0
+		if w.Name == "nth_value" {
+			nth = int(w.WindowFunc.Expr.(*plan.Expr_F).F.Args[1].GetC().GetI64Val())
+		}
+
 		// plan.Function_AGG, plan.Function_WIN_VALUE
 		for j := 0; j < n; j++ {
 
@@ -195,23 +206,42 @@ func (ctr *container) processFunc(idx int, ap *Argument, proc *process.Process,
 				start, end = buildPartitionInterval(ctr.ps, j, n)
 			}
 
-			left, right, err := ctr.buildInterval(j, start, end, ap.WinSpecList[idx].Expr.(*plan.Expr_W).W.Frame, proc)
+			left, right, err := ctr.buildInterval(j, start, end, w.Frame, proc)
 			if err != nil {
 				return err
 			}
 
-			if right < start || left > end || left >= right {
+			if left < start {
+				left = start
+			}
+			if right > end {
+				right = end
+			}
+
+			// the frame may lie entirely outside the partition, check it after
+			// clamping so that no row of a neighbouring partition is taken
+			if left >= right {
 				if err = ctr.bat.Aggs[idx].Fill(int64(j), int64(0), []*vector.Vector{nullVec}); err != nil {
 					return err
 				}
 				continue
 			}
 
-			if left < start {
-				left = start
-			}
-			if right > end {
-				right = end
+			// the value functions only take one row of the frame
+			switch w.Name {
+			case "first_value":
+				right = left + 1
+			case "last_value":
+				left = right - 1
+			case "nth_value":
+				if left+nth > right {
+					if err = ctr.bat.Aggs[idx].Fill(int64(j), int64(0), []*vector.Vector{nullVec}); err != nil {
+						return err
+					}
+					continue
+				}
+				left += nth - 1
+				right = left + 1
 			}
 
 			for k := left; k < right; k++ {
@@ -237,6 +267,57 @@ func (ctr *container) processFunc(idx int, ap *Argument, proc *process.Process,
 	return nil
 }
 
+// processLagLead fills the row which is offset rows before (lag) or after
+// (lead) the current row within its partition, and the default value if there
+// is no such row. The frame clause is ignored, as in MySQL.
+func (ctr *container) processLagLead(idx int, w *plan.WindowSpec, proc *process.Process) error {
+	n := ctr.bat.Vecs[0].Length()
+	args := w.WindowFunc.Expr.(*plan.Expr_F).F.Args
+
+	// the offset was folded into a constant by plan
+	offset := 1
+	if len(args) > 1 {
+		offset = int(args[1].GetC().GetI64Val())
+	}
+	if w.Name == "lag" {
+		offset = -offset
+	}
+
+	var defVec *vector.Vector
+	if len(args) > 2 {
+		executor, err := colexec.NewExpressionExecutor(proc, args[2])
+		if err != nil {
+			return err
+		}
+		defer executor.Free()
+		if defVec, err = executor.Eval(proc, []*batch.Batch{ctr.bat}); err != nil {
+			return err
+		}
+	} else {
+		defVec = vector.NewConstNull(*ctr.aggVecs[idx].vec.GetType(), 1, proc.Mp())
+		defer defVec.Free(proc.Mp())
+	}
+
+	for j := 0; j < n; j++ {
+		start, end := 0, n
+		if ctr.ps != nil {
+			start, end = buildPartitionInterval(ctr.ps, j, n)
+		}
+
+		k := j + offset
+		if k >= start && k < end {
+			if err := ctr.bat.Aggs[idx].Fill(int64(j), int64(k), []*vector.Vector{ctr.aggVecs[idx].vec}); err != nil {
+				return err
+			}
+		} else {
+			if err := ctr.bat.Aggs[idx].Fill(int64(j), int64(j), []*vector.Vector{defVec}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (ctr *container) buildInterval(rowIdx, start, end int, frame *plan.FrameClause, proc *process.Process) (int, int, error) {
 	// FrameClause_ROWS
 	if frame.Type == plan.FrameClause_ROWS {
@@ -16,6 +16,7 @@ package window
 
 import (
 	"bytes"
+	"context"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -122,6 +124,196 @@ func TestWindow(t *testing.T) {
 	}
 }
 
+func TestValueAndDistributionFunctions(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	proc.Reg.MergeReceivers = []*process.WaitRegister{{Ctx: ctx, Ch: make(chan *batch.Batch, 2)}}
+
+	// partition by p order by a
+	ps := []int64{1, 1, 1, 1, 2, 2}
+	as := []int64{10, 20, 20, 30, 40, 50}
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = testutil.MakeInt64Vector(ps, nil)
+	bat.Vecs[1] = testutil.MakeInt64Vector(as, nil)
+	bat.SetRowCount(len(ps))
+
+	three := int64(3)
+	arg := &Argument{}
+	add := func(name string, op int, args []*plan.Expr, cfg []byte) {
+		var e *plan.Expr
+		typ := types.Type{}
+		if len(args) > 0 {
+			e = args[0]
+			typ = types.T_int64.ToType()
+		}
+		arg.WinSpecList = append(arg.WinSpecList, newWinSpec(name, args))
+		arg.Aggs = append(arg.Aggs, agg.Aggregate{Op: op, E: e, Config: cfg})
+		arg.Types = append(arg.Types, typ)
+	}
+	add("lag", agg.WinLag, []*plan.Expr{newInt64Col(1)}, nil)
+	add("lead", agg.WinLead, []*plan.Expr{newInt64Col(1), newInt64Const(2), newInt64Const(0)}, nil)
+	add("first_value", agg.WinFirstValue, []*plan.Expr{newInt64Col(1)}, nil)
+	add("last_value", agg.WinLastValue, []*plan.Expr{newInt64Col(1)}, nil)
+	add("nth_value", agg.WinNthValue, []*plan.Expr{newInt64Col(1), newInt64Const(2)}, nil)
+	add("ntile", agg.WinNtile, []*plan.Expr{newInt64Const(3)}, types.EncodeInt64(&three))
+	add("percent_rank", agg.WinPercentRank, nil, nil)
+	add("cume_dist", agg.WinCumeDist, nil, nil)
+
+	require.NoError(t, Prepare(proc, arg))
+	proc.Reg.MergeReceivers[0].Ch <- bat
+	proc.Reg.MergeReceivers[0].Ch <- nil
+	_, err := Call(0, proc, arg, false, false)
+	require.NoError(t, err)
+
+	res := proc.InputBatch()
+	require.Equal(t, 2+len(arg.WinSpecList), len(res.Vecs))
+	int64s := func(vec *vector.Vector) []any {
+		rs := make([]any, vec.Length())
+		for i := range rs {
+			if !vec.GetNulls().Contains(uint64(i)) {
+				rs[i] = vector.GetFixedAt[int64](vec, i)
+			}
+		}
+		return rs
+	}
+	require.Equal(t, []any{nil, int64(10), int64(20), int64(20), nil, int64(40)}, int64s(res.Vecs[2]))
+	require.Equal(t, []any{int64(20), int64(30), int64(0), int64(0), int64(0), int64(0)}, int64s(res.Vecs[3]))
+	require.Equal(t, []any{int64(10), int64(10), int64(10), int64(10), int64(40), int64(40)}, int64s(res.Vecs[4]))
+	require.Equal(t, []any{int64(10), int64(20), int64(20), int64(30), int64(40), int64(50)}, int64s(res.Vecs[5]))
+	require.Equal(t, []any{nil, int64(20), int64(20), int64(20), nil, int64(50)}, int64s(res.Vecs[6]))
+	require.Equal(t, []uint64{1, 1, 2, 3, 1, 2}, vector.MustFixedCol[uint64](res.Vecs[7]))
+	require.Equal(t, []float64{0, 1.0 / 3, 1.0 / 3, 1, 0, 1}, vector.MustFixedCol[float64](res.Vecs[8]))
+	require.Equal(t, []float64{0.25, 0.75, 0.75, 1, 0.5, 1}, vector.MustFixedCol[float64](res.Vecs[9]))
+
+	res.Clean(proc.Mp())
+	proc.SetInputBatch(nil)
+	arg.Free(proc, false)
+}
+
+func TestValueFunctionsEmptyFrame(t *testing.T) {
+	proc := testutil.NewProcessWithMPool(mpool.MustNewZero())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	proc.Reg.MergeReceivers = []*process.WaitRegister{{Ctx: ctx, Ch: make(chan *batch.Batch, 2)}}
+
+	// partition by p order by a
+	ps := []int64{1, 1, 2, 2}
+	as := []int64{10, 20, 30, 40}
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = testutil.MakeInt64Vector(ps, nil)
+	bat.Vecs[1] = testutil.MakeInt64Vector(as, nil)
+	bat.SetRowCount(len(ps))
+
+	// rows between 2 preceding and 1 preceding
+	preceding := &plan.FrameClause{
+		Type:  plan.FrameClause_ROWS,
+		Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newUint64Const(2)},
+		End:   &plan.FrameBound{Type: plan.FrameBound_PRECEDING, Val: newUint64Const(1)},
+	}
+	// rows between 1 following and 2 following
+	following := &plan.FrameClause{
+		Type:  plan.FrameClause_ROWS,
+		Start: &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Val: newUint64Const(1)},
+		End:   &plan.FrameBound{Type: plan.FrameBound_FOLLOWING, Val: newUint64Const(2)},
+	}
+
+	arg := &Argument{}
+	add := func(name string, op int, frame *plan.FrameClause) {
+		spec := newWinSpec(name, []*plan.Expr{newInt64Col(1)})
+		spec.Expr.(*plan.Expr_W).W.Frame = frame
+		arg.WinSpecList = append(arg.WinSpecList, spec)
+		arg.Aggs = append(arg.Aggs, agg.Aggregate{Op: op, E: newInt64Col(1)})
+		arg.Types = append(arg.Types, types.T_int64.ToType())
+	}
+	add("first_value", agg.WinFirstValue, preceding)
+	add("last_value", agg.WinLastValue, preceding)
+	add("first_value", agg.WinFirstValue, following)
+	add("last_value", agg.WinLastValue, following)
+
+	require.NoError(t, Prepare(proc, arg))
+	proc.Reg.MergeReceivers[0].Ch <- bat
+	proc.Reg.MergeReceivers[0].Ch <- nil
+	_, err := Call(0, proc, arg, false, false)
+	require.NoError(t, err)
+
+	res := proc.InputBatch()
+	require.Equal(t, 2+len(arg.WinSpecList), len(res.Vecs))
+	int64s := func(vec *vector.Vector) []any {
+		rs := make([]any, vec.Length())
+		for i := range rs {
+			if !vec.GetNulls().Contains(uint64(i)) {
+				rs[i] = vector.GetFixedAt[int64](vec, i)
+			}
+		}
+		return rs
+	}
+	require.Equal(t, []any{nil, int64(10), nil, int64(30)}, int64s(res.Vecs[2]))
+	require.Equal(t, []any{nil, int64(10), nil, int64(30)}, int64s(res.Vecs[3]))
+	require.Equal(t, []any{int64(20), nil, int64(40), nil}, int64s(res.Vecs[4]))
+	require.Equal(t, []any{int64(20), nil, int64(40), nil}, int64s(res.Vecs[5]))
+
+	res.Clean(proc.Mp())
+	proc.SetInputBatch(nil)
+	arg.Free(proc, false)
+}
+
+func newWinSpec(name string, args []*plan.Expr) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_W{
+			W: &plan.WindowSpec{
+				WindowFunc: &plan.Expr{
+					Expr: &plan.Expr_F{
+						F: &plan.Function{
+							Func: &plan.ObjectRef{ObjName: name},
+							Args: args,
+						},
+					},
+				},
+				PartitionBy: []*plan.Expr{newInt64Col(0)},
+				OrderBy: []*plan.OrderBySpec{
+					{Expr: newInt64Col(1), Flag: plan.OrderBySpec_INTERNAL},
+				},
+				Frame: &plan.FrameClause{
+					Type:  plan.FrameClause_RANGE,
+					Start: &plan.FrameBound{Type: plan.FrameBound_PRECEDING, UnBounded: true},
+					End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+				},
+				Name: name,
+			},
+		},
+	}
+}
+
+func newInt64Col(pos int32) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				ColPos: pos,
+			},
+		},
+	}
+}
+
+func newInt64Const(v int64) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_int64)},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{Value: &plan.Const_I64Val{I64Val: v}},
+		},
+	}
+}
+
+func newUint64Const(v uint64) *plan.Expr {
+	return &plan.Expr{
+		Typ: &plan.Type{Id: int32(types.T_uint64)},
+		Expr: &plan.Expr_C{
+			C: &plan.Const{Value: &plan.Const_U64Val{U64Val: v}},
+		},
+	}
+}
+
 func newTestCase(flgs []bool, ts []types.Type, exprs []*plan.Expr, aggs []agg.Aggregate) winTestCase {
 	for _, expr := range exprs {
 		if col, ok := expr.Expr.(*plan.Expr_Col); ok {
@@ -16,6 +16,7 @@ package compile
 
 import (
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 )
@@ -107,6 +108,13 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 		return err
 	}
 
+	if len(qry.TableDef.Triggers) > 0 {
+		// Restore the original table's triggers to the new table
+		if err = restoreNewTableTriggers(c, newRel, qry.TableDef.Triggers); err != nil {
+			return err
+		}
+	}
+
 	if len(qry.CopyTableDef.RefChildTbls) > 0 {
 		// Restore the original table's foreign key child table ids to the copy table definition
 		if err = restoreNewTableRefChildTbls(c, newRel, qry.CopyTableDef.RefChildTbls); err != nil {
@@ -133,6 +141,9 @@ func (s *Scope) AlterTableCopy(c *Compile) error {
 
 func (s *Scope) AlterTable(c *Compile) error {
 	qry := s.Plan.GetDdl().GetAlterTable()
+	if qry.AlterPartition != nil {
+		return s.AlterTablePartition(c)
+	}
 	if qry.AlgorithmType == plan.AlterTable_COPY {
 		return s.AlterTableCopy(c)
 	} else {
@@ -218,6 +229,19 @@ func restoreNewTableRefChildTbls(c *Compile, copyRel engine.Relation, refChildTb
 	return copyRel.UpdateConstraint(c.ctx, oldCt)
 }
 
+// restoreNewTableTriggers keeps the triggers of the table after the table is recreated
+func restoreNewTableTriggers(c *Compile, newRel engine.Relation, triggers []*plan.TriggerDef) error {
+	oldCt, err := getRelationConstraint(c, newRel)
+	if err != nil {
+		return err
+	}
+	newCt, err := makeNewCreateConstraint(oldCt, &engine.TriggerDef{Triggers: triggers})
+	if err != nil {
+		return err
+	}
+	return newRel.UpdateConstraint(c.ctx, newCt)
+}
+
 // notifyParentTableFkTableIdChange Notify the parent table of changes in the tableid of the foreign key table
 func notifyParentTableFkTableIdChange(c *Compile, fkey *plan.ForeignKeyDef, oldTableId uint64, newTableId uint64) error {
 	foreignTblId := fkey.ForeignTbl
@@ -250,3 +274,131 @@ func notifyParentTableFkTableIdChange(c *Compile, fkey *plan.ForeignKeyDef, oldT
 	}
 	return fatherRelation.UpdateConstraint(c.ctx, oldCt)
 }
+
+// AlterTablePartition executes the partition management of a partitioned table.
+// The rows of a partition are stored in a hidden table, so it creates, drops and truncates
+// the hidden tables, moves the rows between them and updates the partition info of the table.
+func (s *Scope) AlterTablePartition(c *Compile) error {
+	qry := s.Plan.GetDdl().GetAlterTable()
+	alter := qry.AlterPartition
+	dbName := qry.Database
+	if dbName == "" {
+		dbName = c.db
+	}
+	tblName := qry.GetTableDef().GetName()
+
+	dbSource, err := c.e.Database(c.ctx, dbName, c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	rel, err := dbSource.Relation(c.ctx, tblName, nil)
+	if err != nil {
+		return err
+	}
+
+	if c.proc.TxnOperator.Txn().IsPessimistic() {
+		var retryErr error
+		// 1. lock origin table metadata in catalog
+		if err = lockMoTable(c, dbName, tblName); err != nil {
+			if !moerr.IsMoErrCode(err, moerr.ErrTxnNeedRetry) &&
+				!moerr.IsMoErrCode(err, moerr.ErrTxnNeedRetryWithDefChanged) {
+				return err
+			}
+			retryErr = err
+		}
+
+		// 2. lock origin table, and the table exchanged with the partition
+		if err = lockTable(c.e, c.proc, rel, true); err != nil {
+			if !moerr.IsMoErrCode(err, moerr.ErrTxnNeedRetry) &&
+				!moerr.IsMoErrCode(err, moerr.ErrTxnNeedRetryWithDefChanged) {
+				return err
+			}
+			retryErr = err
+		}
+		if alter.ExchangeTable != "" {
+			exchangeDb, err := c.e.Database(c.ctx, alter.ExchangeDatabase, c.proc.TxnOperator)
+			if err != nil {
+				return err
+			}
+			exchangeRel, err := exchangeDb.Relation(c.ctx, alter.ExchangeTable, nil)
+			if err != nil {
+				return err
+			}
+			if err = lockTable(c.e, c.proc, exchangeRel, true); err != nil {
+				if !moerr.IsMoErrCode(err, moerr.ErrTxnNeedRetry) &&
+					!moerr.IsMoErrCode(err, moerr.ErrTxnNeedRetryWithDefChanged) {
+					return err
+				}
+				retryErr = err
+			}
+		}
+		if retryErr != nil {
+			return retryErr
+		}
+	}
+
+	// 3. check the rows are in the right partitions
+	if alter.ValidateSql != "" {
+		if err = validatePartitionRows(c, alter.ValidateSql); err != nil {
+			return err
+		}
+	}
+
+	// 4. create the hidden tables of the new partitions
+	for _, table := range alter.CreateTables {
+		storageCols := planColsToExeCols(table.GetCols())
+		storageDefs, err := planDefsToExeDefs(table)
+		if err != nil {
+			return err
+		}
+		if err = dbSource.Create(c.ctx, table.GetName(), append(storageCols, storageDefs...)); err != nil {
+			return err
+		}
+	}
+
+	// 5. move the rows between the hidden tables
+	for _, sql := range alter.MoveDataSqls {
+		if err = c.runSql(sql); err != nil {
+			return err
+		}
+	}
+
+	// 6. truncate and drop the hidden tables
+	for _, name := range alter.TruncateTableNames {
+		if _, err = dbSource.Truncate(c.ctx, name); err != nil {
+			return err
+		}
+	}
+	for _, name := range alter.DropTableNames {
+		if err = dbSource.Delete(c.ctx, name); err != nil {
+			return err
+		}
+	}
+
+	// 7. update the partition info of the table
+	if alter.PartitionDef == nil {
+		return nil
+	}
+	bytes, err := alter.PartitionDef.MarshalPartitionInfo()
+	if err != nil {
+		return err
+	}
+	defs, err := rel.TableDefs(c.ctx)
+	if err != nil {
+		return err
+	}
+	ct := &engine.ConstraintDef{
+		Cts: []engine.Constraint{},
+	}
+	for _, def := range defs {
+		if oldCt, ok := def.(*engine.ConstraintDef); ok {
+			ct = oldCt
+			break
+		}
+	}
+	req, err := api.NewUpdatePartitionReq(rel.GetDBID(c.ctx), rel.GetTableID(c.ctx), string(bytes)).Marshal()
+	if err != nil {
+		return err
+	}
+	return rel.AlterTable(c.ctx, ct, [][]byte{req})
+}
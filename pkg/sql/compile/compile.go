@@ -59,6 +59,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergedelete"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/mergerecursive"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/stream"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
@@ -318,6 +319,10 @@ func (c *Compile) run(s *Scope) error {
 		return s.CreateIndex(c)
 	case DropIndex:
 		return s.DropIndex(c)
+	case CreateTrigger:
+		return s.CreateTrigger(c)
+	case DropTrigger:
+		return s.DropTrigger(c)
 	case TruncateTable:
 		return s.TruncateTable(c)
 	case Deletion:
@@ -522,6 +527,16 @@ func (c *Compile) compileScope(ctx context.Context, pn *plan.Plan) ([]*Scope, er
 				Magic: DropIndex,
 				Plan:  pn,
 			}}, nil
+		case plan.DataDefinition_CREATE_TRIGGER:
+			return []*Scope{{
+				Magic: CreateTrigger,
+				Plan:  pn,
+			}}, nil
+		case plan.DataDefinition_DROP_TRIGGER:
+			return []*Scope{{
+				Magic: DropTrigger,
+				Plan:  pn,
+			}}, nil
 		case plan.DataDefinition_SHOW_DATABASES,
 			plan.DataDefinition_SHOW_TABLES,
 			plan.DataDefinition_SHOW_COLUMNS,
@@ -889,7 +904,12 @@ func (c *Compile) compilePlanScope(ctx context.Context, step int32, curNodeIdx i
 			return nil, err
 		}
 		c.setAnalyzeCurrent(right, curr)
-		return c.compileSort(n, c.compileJoin(ctx, n, ns[n.Children[0]], ns[n.Children[1]], left, right)), nil
+		ss := c.compileJoin(ctx, n, ns[n.Children[0]], ns[n.Children[1]], left, right)
+		if n.JoinType == plan.Node_APPLY || n.JoinType == plan.Node_OUTER_APPLY {
+			// the limit of an apply is evaluated for each row of the left side
+			return ss, nil
+		}
+		return c.compileSort(n, ss), nil
 	case plan.Node_SORT:
 		curr := c.anal.curr
 		c.setAnalyzeCurrent(nil, int(n.Children[0]))
@@ -1325,10 +1345,11 @@ func (c *Compile) compileStreamScan(ctx context.Context, n *plan.Node) ([]*Scope
 	_, span := trace.Start(ctx, "compileStreamScan")
 	defer span.End()
 
-	// TODO:
-	// end, err := GetStreamCurrentSize(ctx context.Context, configs map[string]interface{}, factory KafkaAdapterFactory) (int64, error)
-	end := int64(0)
-	ps := calculatePartitions(0, end, int64(ncpu))
+	start, end, err := stream.GetStreamCurrentSize(ctx, stream.GetConfigs(n.TableDef))
+	if err != nil {
+		return nil, err
+	}
+	ps := calculatePartitions(start, end, int64(ncpu))
 
 	ss := make([]*Scope, len(ps))
 	for i := range ss {
@@ -1672,7 +1693,7 @@ func (c *Compile) compileTableScanWithNode(n *plan.Node, node engine.Node, filte
 	// prcoess partitioned table
 	var partitionRelNames []string
 	if n.TableDef.Partition != nil {
-		partitionRelNames = append(partitionRelNames, n.TableDef.Partition.PartitionTableNames...)
+		partitionRelNames = append(partitionRelNames, getPartitionTableNames(n)...)
 	}
 
 	s = &Scope{
@@ -2079,6 +2100,15 @@ func (c *Compile) compileBroadcastJoin(ctx context.Context, node, left, right *p
 			})
 			//}
 		}
+	case plan.Node_APPLY, plan.Node_OUTER_APPLY:
+		rs = c.newBroadcastJoinScopeList(ss, children, node)
+		for i := range rs {
+			rs[i].appendInstruction(vm.Instruction{
+				Op:  vm.LoopApply,
+				Idx: c.anal.curr,
+				Arg: constructLoopApply(node, rightTyps, c.proc),
+			})
+		}
 	default:
 		panic(moerr.NewNYI(ctx, fmt.Sprintf("join typ '%v'", node.JoinType)))
 	}
@@ -2919,6 +2949,8 @@ func (c *Compile) fillAnalyzeInfo() {
 		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.NetworkIO, atomic.LoadInt64(&anal.NetworkIO))
 		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.ScanTime, atomic.LoadInt64(&anal.ScanTime))
 		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.InsertTime, atomic.LoadInt64(&anal.InsertTime))
+		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.SpillCount, atomic.LoadInt64(&anal.SpillCount))
+		atomic.StoreInt64(&c.anal.qry.Nodes[i].AnalyzeInfo.SpillSize, atomic.LoadInt64(&anal.SpillSize))
 	}
 }
 
@@ -2973,11 +3005,7 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 
 	if n.TableDef.Partition != nil {
 		isPartitionTable = true
-		partitionInfo := n.TableDef.Partition
-		partitionNum := int(partitionInfo.PartitionNum)
-		partitionTableNames := partitionInfo.PartitionTableNames
-		for i := 0; i < partitionNum; i++ {
-			partTableName := partitionTableNames[i]
+		for i, partTableName := range getPartitionTableNames(n) {
 			subrelation, err := db.Relation(ctx, partTableName, c.proc)
 			if err != nil {
 				return nil, err
@@ -3040,6 +3068,19 @@ func (c *Compile) generateNodes(n *plan.Node) (engine.Nodes, error) {
 	return putBlocksInAverage(c, ranges, rel, n), nil
 }
 
+// getPartitionTableNames returns the tables of the partitions to scan, which are the ones
+// left after partition pruning if the partitions are pruned by the planner.
+func getPartitionTableNames(n *plan.Node) []string {
+	if n.PartitionPrune != nil && n.PartitionPrune.IsPruned {
+		names := make([]string, len(n.PartitionPrune.SelectedPartitions))
+		for i, partition := range n.PartitionPrune.SelectedPartitions {
+			names[i] = partition.PartitionTableName
+		}
+		return names
+	}
+	return n.TableDef.Partition.PartitionTableNames
+}
+
 func putBlocksInAverage(c *Compile, ranges [][]byte, rel engine.Relation, n *plan.Node) engine.Nodes {
 	var nodes engine.Nodes
 	step := (len(ranges) + len(c.cnList) - 1) / len(c.cnList)
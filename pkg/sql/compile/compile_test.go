@@ -66,6 +66,8 @@ func init() {
 		newTestCase("select * from R limit 10", new(testing.T)),
 		newTestCase("select count(*) from R group by uid", new(testing.T)),
 		newTestCase("select count(distinct uid) from R", new(testing.T)),
+		newTestCase("select * from R, lateral (select * from S where S.uid = R.uid order by S.uid limit 1) as s", new(testing.T)),
+		newTestCase("select * from R left join lateral (select * from S where S.uid = R.uid limit 1 offset 1) as s on true", new(testing.T)),
 		// xxx because memEngine can not handle Halloween Problem
 		// newTestCase("insert into R values('991', '992', '993')", new(testing.T)),
 		// newTestCase("insert into R select * from S", new(testing.T)),
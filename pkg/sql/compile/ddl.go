@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -34,6 +35,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"github.com/matrixorigin/matrixone/pkg/txn/client"
 	"github.com/matrixorigin/matrixone/pkg/util/trace"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -91,6 +93,19 @@ func (s *Scope) DropDatabase(c *Compile) error {
 	if err != nil {
 		return err
 	}
+	// delete all triggers under the database from mo_catalog.mo_triggers
+	deleteSql = fmt.Sprintf(deleteMoTriggersWithDatabaseFormat, util.EscapeSqlString(dbName))
+	err = c.runSql(deleteSql)
+	if err != nil {
+		return err
+	}
+	// delete all materialized views under the database from mo_catalog.mo_mviews,
+	// and their refresh tasks are canceled by themselves
+	deleteSql = fmt.Sprintf(deleteMoMViewsWithDatabaseFormat, util.EscapeSqlString(dbName))
+	err = c.runSql(deleteSql)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -234,6 +249,9 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 	var dropIndex []*plan.IndexDef
 	var alterIndex *plan.IndexDef
 
+	var addChecks []*plan.CheckDef
+	dropChecks := make(map[string]bool)
+
 	var alterKind []api.AlterKind
 	var comment string
 	var oldName, newName string
@@ -257,28 +275,34 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 				}
 			} else if alterTableDrop.Typ == plan.AlterTableDrop_INDEX {
 				alterKind = addAlterKind(alterKind, api.AlterKind_UpdateConstraint)
-				for i, indexdef := range tableDef.Indexes {
-					if indexdef.IndexName == constraintName {
-						dropIndex = append(dropIndex, indexdef)
-						tableDef.Indexes = append(tableDef.Indexes[:i], tableDef.Indexes[i+1:]...)
-						//1. drop index table
-						if indexdef.TableExist {
-							if _, err = dbSource.Relation(c.ctx, indexdef.IndexTableName, nil); err != nil {
-								return err
-							}
-							if err = dbSource.Delete(c.ctx, indexdef.IndexTableName); err != nil {
-								return err
-							}
+				// an index may have more than one index table, e.g. ivfflat index
+				indexes := make([]*plan.IndexDef, 0, len(tableDef.Indexes))
+				for _, indexdef := range tableDef.Indexes {
+					if indexdef.IndexName != constraintName {
+						indexes = append(indexes, indexdef)
+						continue
+					}
+					dropIndex = append(dropIndex, indexdef)
+					//1. drop index table
+					if indexdef.TableExist {
+						if _, err = dbSource.Relation(c.ctx, indexdef.IndexTableName, nil); err != nil {
+							return err
 						}
-						//2. delete index object from mo_catalog.mo_indexes
-						deleteSql := fmt.Sprintf(deleteMoIndexesWithTableIdAndIndexNameFormat, tableDef.TblId, indexdef.IndexName)
-						err = c.runSql(deleteSql)
-						if err != nil {
+						if err = dbSource.Delete(c.ctx, indexdef.IndexTableName); err != nil {
 							return err
 						}
-						break
 					}
 				}
+				tableDef.Indexes = indexes
+				//2. delete index object from mo_catalog.mo_indexes
+				deleteSql := fmt.Sprintf(deleteMoIndexesWithTableIdAndIndexNameFormat, tableDef.TblId, constraintName)
+				err = c.runSql(deleteSql)
+				if err != nil {
+					return err
+				}
+			} else if alterTableDrop.Typ == plan.AlterTableDrop_CHECK {
+				alterKind = addAlterKind(alterKind, api.AlterKind_UpdateConstraint)
+				dropChecks[strings.ToLower(constraintName)] = true
 			} else if alterTableDrop.Typ == plan.AlterTableDrop_COLUMN {
 				alterKind = append(alterKind, api.AlterKind_DropColumn)
 				var idx int
@@ -299,6 +323,15 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			alterKind = addAlterKind(alterKind, api.AlterKind_UpdateConstraint)
 			addRefChildTbls = append(addRefChildTbls, act.AddFk.Fkey.ForeignTbl)
 			newFkeys = append(newFkeys, act.AddFk.Fkey)
+		case *plan.AlterTable_Action_AddCheck:
+			alterKind = addAlterKind(alterKind, api.AlterKind_UpdateConstraint)
+			// the existing rows must satisfy the enforced check constraint
+			if !act.AddCheck.NotEnforced {
+				if err = validateCheckDef(c, dbName, tblName, act.AddCheck); err != nil {
+					return err
+				}
+			}
+			addChecks = append(addChecks, act.AddCheck)
 		case *plan.AlterTable_Action_AddIndex:
 			alterKind = addAlterKind(alterKind, api.AlterKind_UpdateConstraint)
 			indexDef := act.AddIndex.IndexInfo.TableDef.Indexes[0]
@@ -307,7 +340,7 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 					return moerr.NewDuplicateKey(c.ctx, indexDef.IndexName)
 				}
 			}
-			addIndex = append(addIndex, indexDef)
+			addIndex = append(addIndex, act.AddIndex.IndexInfo.TableDef.Indexes...)
 			if indexDef.Unique {
 				// 0. check original data is not duplicated
 				err = genNewUniqueIndexDuplicateCheck(c, qry.Database, tblName, partsToColsStr(indexDef.Parts))
@@ -326,7 +359,13 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 				return err
 			}
 			//---------------------------------------------------------
-			if act.AddIndex.IndexTableExist {
+			if indexDef.IndexAlgo == catalog.MoIndexIvfFlatAlgo {
+				// 2. create index tables and cluster the data into them
+				err = genIvfFlatIndexTables(c, act.AddIndex.IndexInfo, tableDef, qry.Database)
+				if err != nil {
+					return err
+				}
+			} else if act.AddIndex.IndexTableExist {
 				def := act.AddIndex.IndexInfo.GetIndexTables()[0]
 				// 2. create index table from unique index object
 				createSQL := genCreateIndexTableSql(def, indexDef, qry.Database)
@@ -409,8 +448,19 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 	}
 	originHasFkDef := false
 	originHasIndexDef := false
+	originHasCheckDef := false
 	for _, ct := range oldCt.Cts {
 		switch t := ct.(type) {
+		case *engine.CheckDef:
+			originHasCheckDef = true
+			checks := make([]*plan.CheckDef, 0, len(t.Checks)+len(addChecks))
+			for _, check := range t.Checks {
+				if !dropChecks[strings.ToLower(check.Name)] {
+					checks = append(checks, check)
+				}
+			}
+			t.Checks = append(checks, addChecks...)
+			newCt.Cts = append(newCt.Cts, t)
 		case *engine.ForeignKeyDef:
 			for _, fkey := range t.Fkeys {
 				if _, ok := removeRefChildTbls[fkey.Name]; !ok {
@@ -443,6 +493,8 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			newCt.Cts = append(newCt.Cts, t)
 		case *engine.PrimaryKeyDef:
 			newCt.Cts = append(newCt.Cts, t)
+		case *engine.TriggerDef:
+			newCt.Cts = append(newCt.Cts, t)
 		}
 	}
 	if !originHasFkDef {
@@ -455,6 +507,11 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 			Indexes: []*plan.IndexDef(addIndex),
 		})
 	}
+	if !originHasCheckDef && addChecks != nil {
+		newCt.Cts = append(newCt.Cts, &engine.CheckDef{
+			Checks: addChecks,
+		})
+	}
 
 	var addColIdx int
 	var dropColIdx int
@@ -495,6 +552,14 @@ func (s *Scope) AlterTableInplace(c *Compile) error {
 		return err
 	}
 
+	// the triggers follow the renamed table
+	if newName != "" && len(qry.GetTableDef().GetTriggers()) > 0 {
+		updateSql := fmt.Sprintf(updateMoTriggersTableNameFormat, util.EscapeSqlString(newName), util.EscapeSqlString(dbName), util.EscapeSqlString(oldName))
+		if err = c.runSql(updateSql); err != nil {
+			return err
+		}
+	}
+
 	// remove refChildTbls for drop foreign key clause
 	for _, fkTblId := range removeRefChildTbls {
 		err := s.removeRefChildTbl(c, fkTblId, tblId)
@@ -809,7 +874,12 @@ func (s *Scope) CreateIndex(c *Compile) error {
 	}
 
 	// build and create index table for unique index
-	if qry.TableExist {
+	if indexDef.IndexAlgo == catalog.MoIndexIvfFlatAlgo {
+		err = genIvfFlatIndexTables(c, qry.GetIndex(), tableDef, qry.Database)
+		if err != nil {
+			return err
+		}
+	} else if qry.TableExist {
 		def := qry.GetIndex().GetIndexTables()[0]
 		createSQL := genCreateIndexTableSql(def, indexDef, qry.Database)
 		err = c.runSql(createSQL)
@@ -885,6 +955,8 @@ func (s *Scope) DropIndex(c *Compile) error {
 			break
 		}
 	}
+	// an index may have more than one index table, e.g. ivfflat index
+	indexTableNames := getIndexTableNames(oldCt, qry.GetIndexName())
 	newCt, err := makeNewDropConstraint(oldCt, qry.GetIndexName())
 	if err != nil {
 		return err
@@ -895,11 +967,11 @@ func (s *Scope) DropIndex(c *Compile) error {
 	}
 
 	//2. drop index table
-	if qry.IndexTableName != "" {
-		if _, err = d.Relation(c.ctx, qry.IndexTableName, nil); err != nil {
+	for _, indexTableName := range indexTableNames {
+		if _, err = d.Relation(c.ctx, indexTableName, nil); err != nil {
 			return err
 		}
-		if err = d.Delete(c.ctx, qry.IndexTableName); err != nil {
+		if err = d.Delete(c.ctx, indexTableName); err != nil {
 			return err
 		}
 	}
@@ -927,19 +999,40 @@ func makeNewDropConstraint(oldCt *engine.ConstraintDef, dropName string) (*engin
 				}
 			}
 		case *engine.IndexDef:
-			for idx, index := range def.Indexes {
-				if index.IndexName == dropName {
-					def.Indexes = append(def.Indexes[:idx], def.Indexes[idx+1:]...)
-					oldCt.Cts = append(oldCt.Cts[:i], oldCt.Cts[i+1:]...)
-					oldCt.Cts = append(oldCt.Cts, def)
-					break
+			indexes := make([]*plan.IndexDef, 0, len(def.Indexes))
+			for _, index := range def.Indexes {
+				if index.IndexName != dropName {
+					indexes = append(indexes, index)
 				}
 			}
+			if len(indexes) != len(def.Indexes) {
+				def.Indexes = indexes
+				oldCt.Cts = append(oldCt.Cts[:i], oldCt.Cts[i+1:]...)
+				oldCt.Cts = append(oldCt.Cts, def)
+			}
 		}
 	}
 	return oldCt, nil
 }
 
+// getIndexTableNames returns the names of the index tables of the index.
+func getIndexTableNames(ct *engine.ConstraintDef, indexName string) []string {
+	var names []string
+	if ct == nil {
+		return names
+	}
+	for _, c := range ct.Cts {
+		if def, ok := c.(*engine.IndexDef); ok {
+			for _, index := range def.Indexes {
+				if index.IndexName == indexName && index.TableExist {
+					names = append(names, index.IndexTableName)
+				}
+			}
+		}
+	}
+	return names
+}
+
 func makeNewCreateConstraint(oldCt *engine.ConstraintDef, c engine.Constraint) (*engine.ConstraintDef, error) {
 	// duplication has checked in plan
 	if oldCt == nil {
@@ -974,12 +1067,25 @@ func makeNewCreateConstraint(oldCt *engine.ConstraintDef, c engine.Constraint) (
 			oldCt.Cts = append(oldCt.Cts, c)
 		}
 
+	case *engine.TriggerDef:
+		ok := false
+		for i, ct := range oldCt.Cts {
+			if _, ok = ct.(*engine.TriggerDef); ok {
+				oldCt.Cts = append(oldCt.Cts[:i], oldCt.Cts[i+1:]...)
+				oldCt.Cts = append(oldCt.Cts, t)
+				break
+			}
+		}
+		if !ok {
+			oldCt.Cts = append(oldCt.Cts, c)
+		}
+
 	case *engine.IndexDef:
 		ok := false
 		var indexdef *engine.IndexDef
 		for i, ct := range oldCt.Cts {
 			if indexdef, ok = ct.(*engine.IndexDef); ok {
-				indexdef.Indexes = append(indexdef.Indexes, t.Indexes[0])
+				indexdef.Indexes = append(indexdef.Indexes, t.Indexes...)
 				oldCt.Cts = append(oldCt.Cts[:i], oldCt.Cts[i+1:]...)
 				oldCt.Cts = append(oldCt.Cts, indexdef)
 				break
@@ -1319,6 +1425,24 @@ func (s *Scope) DropTable(c *Compile) error {
 		}
 	}
 
+	// delete all triggers of the table in mo_catalog.mo_triggers
+	if len(qry.GetTableDef().GetTriggers()) > 0 {
+		deleteSql := fmt.Sprintf(deleteMoTriggersWithTableFormat, util.EscapeSqlString(dbName), util.EscapeSqlString(tblName))
+		err = c.runSql(deleteSql)
+		if err != nil {
+			return err
+		}
+	}
+
+	// delete the materialized view in mo_catalog.mo_mviews
+	if qry.GetTableDef().GetTableType() == catalog.SystemMaterializedRel {
+		deleteSql := fmt.Sprintf(deleteMoMViewsWithNameFormat, util.EscapeSqlString(dbName), util.EscapeSqlString(tblName))
+		err = c.runSql(deleteSql)
+		if err != nil {
+			return err
+		}
+	}
+
 	if isTemp {
 		if err := dbSource.Delete(c.ctx, engine.GetTempTableName(dbName, tblName)); err != nil {
 			return err
@@ -1429,6 +1553,12 @@ func planDefsToExeDefs(tableDef *plan.TableDef) ([]engine.TableDef, error) {
 		})
 	}
 
+	if len(tableDef.Checks) > 0 {
+		c.Cts = append(c.Cts, &engine.CheckDef{
+			Checks: tableDef.Checks,
+		})
+	}
+
 	if tableDef.Pkey != nil {
 		c.Cts = append(c.Cts, &engine.PrimaryKeyDef{
 			Pkey: tableDef.Pkey,
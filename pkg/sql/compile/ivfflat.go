@@ -0,0 +1,129 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/ivfflat"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// ivfFlatSamplesPerList is the number of rows sampled for each list when the
+// centroids of an ivfflat index are computed.
+const ivfFlatSamplesPerList = 256
+
+var (
+	selectIvfFlatSamplesFormat            = "select %s from %s.%s where %s is not null limit %d;"
+	insertIntoIvfFlatCentroidsTableFormat = "insert into %s.`%s` values %s;"
+	insertIntoIvfFlatEntriesTableFormat   = "insert into %s.`%s` select serial_extract(min(serial(%s(c.%s, t.%s), c.%s)), 1, cast(null as bigint)), %s from %s.%s as t, %s.`%s` as c where t.%s is not null group by %s;"
+)
+
+// genIvfFlatIndexTables creates the hidden tables of an ivfflat index on an existing
+// table, the centroids are computed by k-means on a sample of the rows, and then every
+// row is assigned to the list of its nearest centroid.
+func genIvfFlatIndexTables(c *Compile, indexInfo *plan.CreateTable, originTableDef *plan.TableDef, dbName string) error {
+	var centroidsDef, entriesDef *plan.IndexDef
+	for i, indexDef := range indexInfo.TableDef.Indexes {
+		createSQL := genCreateIndexTableSql(indexInfo.IndexTables[i], indexDef, dbName)
+		if err := c.runSql(createSQL); err != nil {
+			return err
+		}
+		if indexDef.IndexAlgoTableType == catalog.IvfFlatIndexCentroidsType {
+			centroidsDef = indexDef
+		} else {
+			entriesDef = indexDef
+		}
+	}
+
+	params, err := ivfflat.ParseParams(c.ctx, centroidsDef.IndexAlgoParams)
+	if err != nil {
+		return err
+	}
+	col := centroidsDef.Parts[0]
+	vecs, err := selectIvfFlatSamples(c, originTableDef, col, dbName, int(params.Lists)*ivfFlatSamplesPerList)
+	if err != nil {
+		return err
+	}
+	// an empty table has no centroid, the rows inserted later are in the unassigned list
+	if len(vecs) == 0 {
+		return nil
+	}
+
+	centroids := ivfflat.KMeans(vecs, int(params.Lists), params.OpType == ivfflat.OpTypeCosine)
+	values := make([]string, len(centroids))
+	for i, centroid := range centroids {
+		values[i] = fmt.Sprintf("(%d, '%s')", i+1, types.ArrayToString[float64](centroid))
+	}
+	insertSQL := fmt.Sprintf(insertIntoIvfFlatCentroidsTableFormat, dbName, centroidsDef.IndexTableName, strings.Join(values, ", "))
+	if err = c.runSql(insertSQL); err != nil {
+		return err
+	}
+
+	var pkey string
+	if originTableDef.Pkey.PkeyColName == catalog.CPrimaryKeyColName {
+		parts := make([]string, len(originTableDef.Pkey.Names))
+		for i, name := range originTableDef.Pkey.Names {
+			parts[i] = "t." + name
+		}
+		pkey = "serial(" + partsToColsStr(parts) + ")"
+	} else {
+		pkey = "t." + originTableDef.Pkey.PkeyColName
+	}
+	insertSQL = fmt.Sprintf(insertIntoIvfFlatEntriesTableFormat, dbName, entriesDef.IndexTableName,
+		params.DistanceFunc(), catalog.IvfFlatIndexCentroidColName, col, catalog.IvfFlatIndexCentroidIdColName, pkey,
+		dbName, originTableDef.Name, dbName, centroidsDef.IndexTableName, col, pkey)
+	return c.runSql(insertSQL)
+}
+
+// selectIvfFlatSamples returns at most limit not null vectors of the column.
+func selectIvfFlatSamples(c *Compile, originTableDef *plan.TableDef, col string, dbName string, limit int) ([][]float64, error) {
+	sql := fmt.Sprintf(selectIvfFlatSamplesFormat, col, dbName, originTableDef.Name, col, limit)
+	res, err := c.runSqlWithResult(sql)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	var vecs [][]float64
+	res.ReadRows(func(colVecs []*vector.Vector) bool {
+		vec := colVecs[0]
+		for i := 0; i < vec.Length(); i++ {
+			if vec.IsNull(uint64(i)) {
+				continue
+			}
+			vecs = append(vecs, arrayBytesToFloat64(vec.GetType().Oid, vec.GetBytesAt(i)))
+		}
+		return true
+	})
+	return vecs, nil
+}
+
+// arrayBytesToFloat64 copies the vector into a new []float64.
+func arrayBytesToFloat64(oid types.T, b []byte) []float64 {
+	if oid == types.T_array_float64 {
+		return append([]float64(nil), types.BytesToArray[float64](b)...)
+	}
+	arr := types.BytesToArray[float32](b)
+	res := make([]float64, len(arr))
+	for i, v := range arr {
+		res[i] = float64(v)
+	}
+	return res
+}
@@ -44,6 +44,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/lockop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopapply"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopjoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopleft"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopmark"
@@ -222,6 +223,16 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 			Cond:   t.Cond,
 			Typs:   t.Typs,
 		}
+	case vm.LoopApply:
+		t := sourceIns.Arg.(*loopapply.Argument)
+		res.Arg = &loopapply.Argument{
+			Result:  t.Result,
+			Cond:    t.Cond,
+			Typs:    t.Typs,
+			IsOuter: t.IsOuter,
+			Limit:   t.Limit,
+			Offset:  t.Offset,
+		}
 	case vm.Offset:
 		t := sourceIns.Arg.(*offset.Argument)
 		res.Arg = &offset.Argument{
@@ -357,6 +368,7 @@ func dupInstruction(sourceIns *vm.Instruction, regMap map[*process.WaitRegister]
 			Typs:        t.Typs,
 			Conditions:  t.Conditions,
 			HashOnPK:    t.HashOnPK,
+			CanSpill:    t.CanSpill,
 		}
 	case vm.External:
 		t := sourceIns.Arg.(*external.Argument)
@@ -679,7 +691,7 @@ func constructStream(n *plan.Node, p [2]int64) *stream.Argument {
 	return &stream.Argument{
 		TblDef: n.TableDef,
 		Offset: p[0],
-		Limit:  p[1],
+		Limit:  p[1] - p[0],
 	}
 }
 
@@ -908,6 +920,12 @@ func constructWindow(ctx context.Context, n *plan.Node, proc *process.Process) *
 				cfg = []byte(vec.GetStringAt(0))
 			}
 
+			// for ntile, the only arg is the number of buckets, which was folded by plan
+			if f.F.Func.ObjName == "ntile" {
+				buckets := f.F.Args[0].GetC().GetI64Val()
+				cfg = types.EncodeInt64(&buckets)
+			}
+
 			e = f.F.Args[0]
 		}
 		aggs[i] = agg.Aggregate{
@@ -1369,6 +1387,36 @@ func constructLoopMark(n *plan.Node, typs []types.Type, proc *process.Process) *
 	}
 }
 
+func constructLoopApply(n *plan.Node, typs []types.Type, proc *process.Process) *loopapply.Argument {
+	result := make([]colexec.ResultPos, len(n.ProjectList))
+	for i, expr := range n.ProjectList {
+		result[i].Rel, result[i].Pos = constructJoinResult(expr, proc)
+	}
+	arg := &loopapply.Argument{
+		Typs:    typs,
+		Result:  result,
+		Cond:    colexec.RewriteFilterExprList(n.OnList),
+		IsOuter: n.JoinType == plan.Node_OUTER_APPLY,
+		Limit:   loopapply.NoLimit,
+	}
+	if n.Limit != nil {
+		arg.Limit = evalApplyLimit(n.Limit, proc)
+	}
+	if n.Offset != nil {
+		arg.Offset = evalApplyLimit(n.Offset, proc)
+	}
+	return arg
+}
+
+func evalApplyLimit(expr *plan.Expr, proc *process.Process) uint64 {
+	vec, err := colexec.EvalExpressionOnce(proc, expr, []*batch.Batch{constBat})
+	if err != nil {
+		panic(err)
+	}
+	defer vec.Free(proc.Mp())
+	return uint64(vector.MustFixedCol[int64](vec)[0])
+}
+
 func constructHashBuild(c *Compile, in vm.Instruction, proc *process.Process, isDup bool) *hashbuild.Argument {
 	// XXX BUG
 	// relation index of arg.Conditions should be rewritten to 0 here.
@@ -1400,6 +1448,7 @@ func constructHashBuild(c *Compile, in vm.Instruction, proc *process.Process, is
 			Conditions:  arg.Conditions[1],
 			IsDup:       isDup,
 			HashOnPK:    arg.HashOnPK,
+			CanSpill:    true,
 		}
 
 		if arg.RuntimeFilterSpecs != nil {
@@ -1630,6 +1679,14 @@ func constructHashBuild(c *Compile, in vm.Instruction, proc *process.Process, is
 			IsDup:       isDup,
 		}
 
+	case vm.LoopApply:
+		arg := in.Arg.(*loopapply.Argument)
+		return &hashbuild.Argument{
+			NeedHashMap: false,
+			Typs:        arg.Typs,
+			IsDup:       isDup,
+		}
+
 	default:
 		panic(moerr.NewInternalError(proc.Ctx, "unsupport join type '%v'", in.Op))
 	}
@@ -53,6 +53,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/lockop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopapply"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopjoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopleft"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopmark"
@@ -895,6 +896,26 @@ func convertToPipelineInstruction(opr *vm.Instruction, ctx *scopeContext, ctxId
 			Types:  convertToPlanTypes(t.Typs),
 			Result: t.Result,
 		}
+	case *loopapply.Argument:
+		// the outer apply is sent as a left join, and the inner one as a join
+		relList, colList := getRelColList(t.Result)
+		if t.IsOuter {
+			in.LeftJoin = &pipeline.LeftJoin{
+				RelList: relList,
+				ColList: colList,
+				Expr:    t.Cond,
+				Types:   convertToPlanTypes(t.Typs),
+			}
+		} else {
+			in.Join = &pipeline.Join{
+				RelList: relList,
+				ColList: colList,
+				Expr:    t.Cond,
+				Types:   convertToPlanTypes(t.Typs),
+			}
+		}
+		in.Limit = t.Limit
+		in.Offset = t.Offset
 	case *offset.Argument:
 		in.Offset = t.Offset
 	case *order.Argument:
@@ -1287,6 +1308,24 @@ func convertToVmInstruction(opr *pipeline.Instruction, ctx *scopeContext, eng en
 			Cond:   t.Expr,
 			Typs:   convertToTypes(t.Types),
 		}
+	case vm.LoopApply:
+		arg := &loopapply.Argument{
+			IsOuter: opr.LeftJoin != nil,
+			Limit:   opr.Limit,
+			Offset:  opr.Offset,
+		}
+		if arg.IsOuter {
+			t := opr.GetLeftJoin()
+			arg.Result = convertToResultPos(t.RelList, t.ColList)
+			arg.Cond = t.Expr
+			arg.Typs = convertToTypes(t.Types)
+		} else {
+			t := opr.GetJoin()
+			arg.Result = convertToResultPos(t.RelList, t.ColList)
+			arg.Cond = t.Expr
+			arg.Typs = convertToTypes(t.Types)
+		}
+		v.Arg = arg
 	case vm.Offset:
 		v.Arg = &offset.Argument{Offset: opr.Offset}
 	case vm.Order:
@@ -1466,6 +1505,8 @@ func mergeAnalyseInfo(target *anaylze, ana *pipeline.AnalysisList) {
 		atomic.AddInt64(&target.analInfos[i].NetworkIO, n.NetworkIO)
 		atomic.AddInt64(&target.analInfos[i].ScanTime, n.ScanTime)
 		atomic.AddInt64(&target.analInfos[i].InsertTime, n.InsertTime)
+		atomic.AddInt64(&target.analInfos[i].SpillCount, n.SpillCount)
+		atomic.AddInt64(&target.analInfos[i].SpillSize, n.SpillSize)
 	}
 }
 
@@ -1626,6 +1667,8 @@ func convertToPlanAnalyzeInfo(info *process.AnalyzeInfo) *plan.AnalyzeInfo {
 		NetworkIO:        info.NetworkIO,
 		ScanTime:         info.ScanTime,
 		InsertTime:       info.InsertTime,
+		SpillCount:       info.SpillCount,
+		SpillSize:        info.SpillSize,
 	}
 }
 
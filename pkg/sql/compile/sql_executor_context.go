@@ -252,6 +252,8 @@ func (c *compilerContext) getTableDef(
 	var partitionInfo *plan.PartitionByDef
 	var viewSql *plan.ViewDef
 	var foreignKeys []*plan.ForeignKeyDef
+	var checks []*plan.CheckDef
+	var triggers []*plan.TriggerDef
 	var primarykey *plan.PrimaryKeyDef
 	var indexes []*plan.IndexDef
 	var refChildTbls []uint64
@@ -317,6 +319,10 @@ func (c *compilerContext) getTableDef(
 					indexes = k.Indexes
 				case *engine.ForeignKeyDef:
 					foreignKeys = k.Fkeys
+				case *engine.CheckDef:
+					checks = k.Checks
+				case *engine.TriggerDef:
+					triggers = k.Triggers
 				case *engine.RefChildTableDef:
 					refChildTbls = k.Tables
 				case *engine.PrimaryKeyDef:
@@ -380,6 +386,8 @@ func (c *compilerContext) getTableDef(
 		ViewSql:      viewSql,
 		Partition:    partitionInfo,
 		Fkeys:        foreignKeys,
+		Checks:       checks,
+		Triggers:     triggers,
 		RefChildTbls: refChildTbls,
 		ClusterBy:    clusterByDef,
 		Indexes:      indexes,
@@ -0,0 +1,233 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+var (
+	selectMoTriggersTableNameFormat    = "select table_name from mo_catalog.mo_triggers where trigger_db = '%s' and trigger_name = '%s';"
+	insertIntoMoTriggersFormat         = "insert into mo_catalog.mo_triggers(trigger_name, trigger_db, table_name, action_timing, event_manipulation, action_order, action_statement, definer, created_time) values ('%s', '%s', '%s', '%s', '%s', %d, '%s', '%s', current_timestamp());"
+	updateMoTriggersActionOrderFormat  = "update mo_catalog.mo_triggers set action_order = %d where trigger_db = '%s' and trigger_name = '%s';"
+	updateMoTriggersTableNameFormat    = "update mo_catalog.mo_triggers set table_name = '%s' where trigger_db = '%s' and table_name = '%s';"
+	deleteMoTriggersWithNameFormat     = "delete from mo_catalog.mo_triggers where trigger_db = '%s' and trigger_name = '%s';"
+	deleteMoTriggersWithTableFormat    = "delete from mo_catalog.mo_triggers where trigger_db = '%s' and table_name = '%s';"
+	deleteMoTriggersWithDatabaseFormat = "delete from mo_catalog.mo_triggers where trigger_db = '%s';"
+)
+
+func (s *Scope) CreateTrigger(c *Compile) error {
+	qry := s.Plan.GetDdl().GetCreateTrigger()
+	trigger := qry.Trigger
+
+	// the trigger name is unique in the schema
+	tblName, err := getTriggerTableName(c, qry.Database, trigger.Name)
+	if err != nil {
+		return err
+	}
+	if tblName != "" {
+		if qry.IfNotExists {
+			return nil
+		}
+		return moerr.NewInternalError(c.ctx, "trigger '%s' already exists", trigger.Name)
+	}
+
+	d, err := c.e.Database(c.ctx, qry.Database, c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	r, err := d.Relation(c.ctx, qry.Table, nil)
+	if err != nil {
+		return err
+	}
+	oldCt, err := getRelationConstraint(c, r)
+	if err != nil {
+		return err
+	}
+	triggers := addTrigger(getTriggers(oldCt), trigger, qry.OrderTrigger, qry.Precedes)
+	newCt, err := makeNewCreateConstraint(oldCt, &engine.TriggerDef{Triggers: triggers})
+	if err != nil {
+		return err
+	}
+	if err = r.UpdateConstraint(c.ctx, newCt); err != nil {
+		return err
+	}
+
+	definer := c.proc.SessionInfo.User
+	if c.proc.SessionInfo.Host != "" {
+		definer += "@" + c.proc.SessionInfo.Host
+	}
+	// the action order of the triggers after the new one is changed
+	order := 0
+	for _, t := range triggers {
+		if t.Timing != trigger.Timing || t.Event != trigger.Event {
+			continue
+		}
+		order++
+		var sql string
+		if t == trigger {
+			sql = fmt.Sprintf(insertIntoMoTriggersFormat,
+				util.EscapeSqlString(trigger.Name), util.EscapeSqlString(qry.Database), util.EscapeSqlString(qry.Table),
+				trigger.Timing, trigger.Event, order, util.EscapeSqlString(trigger.Body), util.EscapeSqlString(definer))
+		} else {
+			sql = fmt.Sprintf(updateMoTriggersActionOrderFormat, order, util.EscapeSqlString(qry.Database), util.EscapeSqlString(t.Name))
+		}
+		if err = c.runSql(sql); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Scope) DropTrigger(c *Compile) error {
+	qry := s.Plan.GetDdl().GetDropTrigger()
+
+	tblName, err := getTriggerTableName(c, qry.Database, qry.Name)
+	if err != nil {
+		return err
+	}
+	if tblName == "" {
+		if qry.IfExists {
+			return nil
+		}
+		return moerr.NewInternalError(c.ctx, "trigger '%s' does not exist", qry.Name)
+	}
+
+	d, err := c.e.Database(c.ctx, qry.Database, c.proc.TxnOperator)
+	if err != nil {
+		return err
+	}
+	r, err := d.Relation(c.ctx, tblName, nil)
+	if err != nil {
+		return err
+	}
+	oldCt, err := getRelationConstraint(c, r)
+	if err != nil {
+		return err
+	}
+	oldTriggers := getTriggers(oldCt)
+	triggers := make([]*plan.TriggerDef, 0, len(oldTriggers))
+	var dropped *plan.TriggerDef
+	for _, t := range oldTriggers {
+		if strings.EqualFold(t.Name, qry.Name) {
+			dropped = t
+			continue
+		}
+		triggers = append(triggers, t)
+	}
+	if dropped != nil {
+		newCt, err := makeNewCreateConstraint(oldCt, &engine.TriggerDef{Triggers: triggers})
+		if err != nil {
+			return err
+		}
+		if err = r.UpdateConstraint(c.ctx, newCt); err != nil {
+			return err
+		}
+	}
+
+	err = c.runSql(fmt.Sprintf(deleteMoTriggersWithNameFormat, util.EscapeSqlString(qry.Database), util.EscapeSqlString(qry.Name)))
+	if err != nil {
+		return err
+	}
+	if dropped == nil {
+		return nil
+	}
+	// the triggers after the dropped one move forward
+	order := 0
+	for _, t := range triggers {
+		if t.Timing != dropped.Timing || t.Event != dropped.Event {
+			continue
+		}
+		order++
+		err = c.runSql(fmt.Sprintf(updateMoTriggersActionOrderFormat, order, util.EscapeSqlString(qry.Database), util.EscapeSqlString(t.Name)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTriggerTableName returns the table name of the trigger, or empty string if the trigger doesn't exist.
+func getTriggerTableName(c *Compile, dbName, triggerName string) (string, error) {
+	res, err := c.runSqlWithResult(fmt.Sprintf(selectMoTriggersTableNameFormat, util.EscapeSqlString(dbName), util.EscapeSqlString(triggerName)))
+	if err != nil {
+		return "", err
+	}
+	defer res.Close()
+
+	var tblName string
+	res.ReadRows(func(cols []*vector.Vector) bool {
+		if cols[0].Length() > 0 {
+			tblName = cols[0].GetStringAt(0)
+			return false
+		}
+		return true
+	})
+	return tblName, nil
+}
+
+func getRelationConstraint(c *Compile, r engine.Relation) (*engine.ConstraintDef, error) {
+	defs, err := r.TableDefs(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, def := range defs {
+		if ct, ok := def.(*engine.ConstraintDef); ok {
+			return ct, nil
+		}
+	}
+	return nil, nil
+}
+
+func getTriggers(ct *engine.ConstraintDef) []*plan.TriggerDef {
+	if ct == nil {
+		return nil
+	}
+	for _, c := range ct.Cts {
+		if def, ok := c.(*engine.TriggerDef); ok {
+			return def.Triggers
+		}
+	}
+	return nil
+}
+
+// addTrigger returns the triggers with the new trigger, which is placed after (FOLLOWS)
+// or before (PRECEDES) the order trigger, or after all the triggers if there is no order trigger.
+// The triggers of the table fire in the order of the list.
+func addTrigger(triggers []*plan.TriggerDef, trigger *plan.TriggerDef, orderTrigger string, precedes bool) []*plan.TriggerDef {
+	pos := len(triggers)
+	if orderTrigger != "" {
+		for i, t := range triggers {
+			if strings.EqualFold(t.Name, orderTrigger) {
+				pos = i
+				if !precedes {
+					pos++
+				}
+				break
+			}
+		}
+	}
+	newTriggers := make([]*plan.TriggerDef, 0, len(triggers)+1)
+	newTriggers = append(newTriggers, triggers[:pos]...)
+	newTriggers = append(newTriggers, trigger)
+	return append(newTriggers, triggers[pos:]...)
+}
@@ -66,6 +66,8 @@ const (
 	AlterSequence
 	MagicDelete
 	Replace
+	CreateTrigger
+	DropTrigger
 )
 
 // Source contains information of a relation which will be used in execution,
@@ -34,6 +34,8 @@ const (
 	INDEX_TYPE_PRIMARY  = "PRIMARY"
 	INDEX_TYPE_UNIQUE   = "UNIQUE"
 	INDEX_TYPE_MULTIPLE = "MULTIPLE"
+	INDEX_TYPE_FULLTEXT = "FULLTEXT"
+	INDEX_TYPE_IVFFLAT  = "IVFFLAT"
 )
 
 const (
@@ -57,6 +59,7 @@ const (
 
 var (
 	selectOriginTableConstraintFormat = "select serial(%s) from %s.%s group by serial(%s) having count(*) > 1 and serial(%s) is not null;"
+	selectCheckViolatedRowFormat      = "select 1 from `%s`.`%s` where not (%s) limit 1;"
 )
 
 var (
@@ -65,6 +68,7 @@ var (
 	insertIntoSingleIndexTableWithoutPKeyFormat = "insert into  %s.`%s` select (%s) from %s.%s where (%s) is not null;"
 	insertIntoIndexTableWithoutPKeyFormat       = "insert into  %s.`%s` select serial(%s) from %s.%s where serial(%s) is not null;"
 	createIndexTableForamt                      = "create table %s.`%s` (%s);"
+	insertIntoFullTextIndexTableFormat          = "insert into  %s.`%s` select f.* from %s.%s as t, fulltext_index_tokenize('%s', t.%s, %s) as f where f.%s = t.%s;"
 )
 
 var (
@@ -73,14 +77,59 @@ var (
 	deleteMoIndexesWithTableIdAndIndexNameFormat = `delete from mo_catalog.mo_indexes where table_id = %v and name = '%s';`
 	updateMoIndexesVisibleFormat                 = `update mo_catalog.mo_indexes set is_visible = %v where table_id = %v and name = '%s';`
 	updateMoIndexesTruncateTableFormat           = `update mo_catalog.mo_indexes set table_id = %v where table_id = %v`
+
+	deleteMoMViewsWithNameFormat     = `delete from mo_catalog.mo_mviews where database_name = '%s' and mview_name = '%s';`
+	deleteMoMViewsWithDatabaseFormat = `delete from mo_catalog.mo_mviews where database_name = '%s';`
 )
 
+// validateCheckDef returns an error if any row of the table violates the check constraint,
+// a row whose check expression is null doesn't violate it.
+func validateCheckDef(c *Compile, dbName string, tblName string, check *plan.CheckDef) error {
+	res, err := c.runSqlWithResult(fmt.Sprintf(selectCheckViolatedRowFormat, dbName, tblName, check.ExprStr))
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+
+	violated := false
+	res.ReadRows(func(cols []*vector.Vector) bool {
+		violated = violated || cols[0].Length() > 0
+		return !violated
+	})
+	if violated {
+		return moerr.NewCheckViolated(c.ctx, check.Name)
+	}
+	return nil
+}
+
+// validatePartitionRows returns an error if the sql finds a row which doesn't match its partition
+func validatePartitionRows(c *Compile, sql string) error {
+	res, err := c.runSqlWithResult(sql)
+	if err != nil {
+		return err
+	}
+	defer res.Close()
+
+	mismatched := false
+	res.ReadRows(func(cols []*vector.Vector) bool {
+		mismatched = mismatched || cols[0].Length() > 0
+		return !mismatched
+	})
+	if mismatched {
+		return moerr.NewRowDoesNotMatchPartition(c.ctx)
+	}
+	return nil
+}
+
 // genCreateIndexTableSql: Generate ddl statements for creating index table
 func genCreateIndexTableSql(indexTableDef *plan.TableDef, indexDef *plan.IndexDef, DBName string) string {
 	var sql string
 	planCols := indexTableDef.GetCols()
 	for i, planCol := range planCols {
-		if i == 1 {
+		if planCol.Hidden {
+			continue
+		}
+		if i > 0 {
 			sql += ","
 		}
 		sql += planCol.Name + " "
@@ -98,18 +147,28 @@ func genCreateIndexTableSql(indexTableDef *plan.TableDef, indexDef *plan.IndexDe
 			sql += fmt.Sprintf("DECIMAL(%d,%d)", planCol.Typ.Width, planCol.Typ.Scale)
 		case types.T_decimal128:
 			sql += fmt.Sprintf("DECIAML(%d,%d)", planCol.Typ.Width, planCol.Typ.Scale)
+		case types.T_array_float32:
+			sql += fmt.Sprintf("VECF32(%d)", planCol.Typ.Width)
+		case types.T_array_float64:
+			sql += fmt.Sprintf("VECF64(%d)", planCol.Typ.Width)
 		default:
 			sql += typeId.String()
 		}
-		if i == 0 {
+		if i == 0 && (indexTableDef.Pkey == nil || len(indexTableDef.Pkey.Names) <= 1) {
 			sql += " primary key"
 		}
 	}
+	if indexTableDef.Pkey != nil && len(indexTableDef.Pkey.Names) > 1 {
+		sql += fmt.Sprintf(",primary key (%s)", partsToColsStr(indexTableDef.Pkey.Names))
+	}
 	return fmt.Sprintf(createIndexTableForamt, DBName, indexDef.IndexTableName, sql)
 }
 
 // genInsertIndexTableSql: Generate an insert statement for inserting data into the index table
 func genInsertIndexTableSql(originTableDef *plan.TableDef, indexDef *plan.IndexDef, DBName string) string {
+	if indexDef.IndexAlgo == catalog.MoIndexFullTextAlgo {
+		return genInsertFullTextIndexTableSql(originTableDef, indexDef, DBName)
+	}
 	// insert data into index table
 	var insertSQL string
 	temp := partsToColsStr(indexDef.Parts)
@@ -144,6 +203,18 @@ func genInsertIndexTableSql(originTableDef *plan.TableDef, indexDef *plan.IndexD
 	return insertSQL
 }
 
+// genInsertFullTextIndexTableSql: Generate an insert statement for inserting the tokens of the
+// existing rows into the hidden table of a fulltext index
+func genInsertFullTextIndexTableSql(originTableDef *plan.TableDef, indexDef *plan.IndexDef, DBName string) string {
+	pkeyName := originTableDef.Pkey.PkeyColName
+	parts := make([]string, len(indexDef.Parts))
+	for i, part := range indexDef.Parts {
+		parts[i] = "t." + part
+	}
+	return fmt.Sprintf(insertIntoFullTextIndexTableFormat, DBName, indexDef.IndexTableName, DBName, originTableDef.Name,
+		indexDef.IndexAlgoParams, pkeyName, partsToColsStr(parts), catalog.FullTextIndexDocIdColName, pkeyName)
+}
+
 // genInsertMOIndexesSql: Generate an insert statement for insert index metadata into `mo_catalog.mo_indexes`
 func genInsertMOIndexesSql(eg engine.Engine, proc *process.Process, databaseId string, tableId uint64, ct *engine.ConstraintDef) (string, error) {
 	buffer := bytes.NewBuffer(make([]byte, 0, 1024))
@@ -154,6 +225,11 @@ func genInsertMOIndexesSql(eg engine.Engine, proc *process.Process, databaseId s
 		switch def := constraint.(type) {
 		case *engine.IndexDef:
 			for _, indexdef := range def.Indexes {
+				// only the centroids table of an ivfflat index is recorded
+				if indexdef.IndexAlgo == catalog.MoIndexIvfFlatAlgo &&
+					indexdef.IndexAlgoTableType == catalog.IvfFlatIndexEntriesType {
+					continue
+				}
 				ctx, cancelFunc := context.WithTimeout(proc.Ctx, time.Second*30)
 				defer cancelFunc()
 				index_id, err := eg.AllocateIDByKey(ctx, ALLOCID_INDEX_KEY)
@@ -183,6 +259,10 @@ func genInsertMOIndexesSql(eg engine.Engine, proc *process.Process, databaseId s
 					var index_type string
 					if indexdef.Unique {
 						index_type = INDEX_TYPE_UNIQUE
+					} else if indexdef.IndexAlgo == catalog.MoIndexFullTextAlgo {
+						index_type = INDEX_TYPE_FULLTEXT
+					} else if indexdef.IndexAlgo == catalog.MoIndexIvfFlatAlgo {
+						index_type = INDEX_TYPE_IVFFLAT
 					} else {
 						index_type = INDEX_TYPE_MULTIPLE
 					}
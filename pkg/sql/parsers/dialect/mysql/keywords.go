@@ -41,7 +41,10 @@ func init() {
 		"avg_row_length":             AVG_ROW_LENGTH,
 		"avg":                        AVG,
 		"bsi":                        BSI,
-		"before":                     UNUSED,
+		"ivfflat":                    IVFFLAT,
+		"lists":                      LISTS,
+		"op_type":                    OP_TYPE,
+		"before":                     BEFORE,
 		"begin":                      BEGIN,
 		"between":                    BETWEEN,
 		"bigint":                     BIGINT,
@@ -144,6 +147,7 @@ func init() {
 		"disk":                       DISK,
 		"div":                        DIV,
 		"directory":                  DIRECTORY,
+		"dot":                        DOT,
 		"double":                     DOUBLE,
 		"do":                         DO,
 		"drop":                       DROP,
@@ -151,7 +155,7 @@ func init() {
 		"duplicate":                  DUPLICATE,
 		"delay_key_write":            DELAY_KEY_WRITE,
 		"drainer":                    DRAINER,
-		"each":                       UNUSED,
+		"each":                       EACH,
 		"else":                       ELSE,
 		"elseif":                     ELSEIF,
 		"enclosed":                   ENCLOSED,
@@ -163,6 +167,7 @@ func init() {
 		"escape":                     ESCAPE,
 		"escaped":                    ESCAPED,
 		"exists":                     EXISTS,
+		"exchange":                   EXCHANGE,
 		"exit":                       UNUSED,
 		"explain":                    EXPLAIN,
 		"expansion":                  EXPANSION,
@@ -183,6 +188,9 @@ func init() {
 		"float8":                     UNUSED,
 		"for":                        FOR,
 		"force":                      FORCE,
+		"follows":                    FOLLOWS,
+		"materialized":               MATERIALIZED,
+		"refresh":                    REFRESH,
 		"foreign":                    FOREIGN,
 		"format":                     FORMAT,
 		"from":                       FROM,
@@ -235,12 +243,15 @@ func init() {
 		"join":                       JOIN,
 		"json":                       JSON,
 		"uuid":                       UUID,
+		"vecf32":                     VECF32,
+		"vecf64":                     VECF64,
 		"key":                        KEY,
 		"keys":                       KEYS,
 		"key_block_size":             KEY_BLOCK_SIZE,
 		"kill":                       KILL,
 		"language":                   LANGUAGE,
 		"last":                       LAST,
+		"lateral":                    LATERAL,
 		"leading":                    LEADING,
 		"leave":                      LEAVE,
 		"left":                       LEFT,
@@ -537,8 +548,17 @@ func init() {
 		"min":                        MIN,
 		"second_microsecond":         SECOND_MICROSECOND,
 		"prepare":                    PREPARE,
+		"precedes":                   PRECEDES,
 		"deallocate":                 DEALLOCATE,
 		"dense_rank":                 DENSE_RANK,
+		"lag":                        LAG,
+		"lead":                       LEAD,
+		"first_value":                FIRST_VALUE,
+		"last_value":                 LAST_VALUE,
+		"nth_value":                  NTH_VALUE,
+		"ntile":                      NTILE,
+		"percent_rank":               PERCENT_RANK,
+		"cume_dist":                  CUME_DIST,
 		"reset":                      RESET,
 		"intersect":                  INTERSECT,
 		"minus":                      MINUS,
@@ -572,6 +592,7 @@ func init() {
 		"sequences":                  SEQUENCES,
 		"sequence":                   SEQUENCE,
 		"increment":                  INCREMENT,
+		"incremental":                INCREMENTAL,
 		"cycle":                      CYCLE,
 		"minvalue":                   MINVALUE,
 		"nextval":                    NEXTVAL,
@@ -590,5 +611,18 @@ func init() {
 		"credentials":                CREDENTIALS,
 		"backup":                     BACKUP,
 		"filesystem":                 FILESYSTEM,
+		"schedule":                   SCHEDULE,
+		"every":                      EVERY,
+		"starts":                     STARTS,
+		"ends":                       ENDS,
+		"at":                         AT,
+		"completion":                 COMPLETION,
+		"preserve":                   PRESERVE,
+		"json_table":                 JSON_TABLE,
+		"nested":                     NESTED,
+		"path":                       PATH,
+		"ordinality":                 ORDINALITY,
+		"error":                      ERROR,
+		"empty":                      EMPTY,
 	}
 }
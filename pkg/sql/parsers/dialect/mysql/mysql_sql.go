@@ -204,396 +204,431 @@ const LONGBLOB = 57526
 const JSON = 57527
 const ENUM = 57528
 const UUID = 57529
-const GEOMETRY = 57530
-const POINT = 57531
-const LINESTRING = 57532
-const POLYGON = 57533
-const GEOMETRYCOLLECTION = 57534
-const MULTIPOINT = 57535
-const MULTILINESTRING = 57536
-const MULTIPOLYGON = 57537
-const INT1 = 57538
-const INT2 = 57539
-const INT3 = 57540
-const INT4 = 57541
-const INT8 = 57542
-const S3OPTION = 57543
-const SQL_SMALL_RESULT = 57544
-const SQL_BIG_RESULT = 57545
-const SQL_BUFFER_RESULT = 57546
-const LOW_PRIORITY = 57547
-const HIGH_PRIORITY = 57548
-const DELAYED = 57549
-const CREATE = 57550
-const ALTER = 57551
-const DROP = 57552
-const RENAME = 57553
-const ANALYZE = 57554
-const ADD = 57555
-const RETURNS = 57556
-const SCHEMA = 57557
-const TABLE = 57558
-const SEQUENCE = 57559
-const INDEX = 57560
-const VIEW = 57561
-const TO = 57562
-const IGNORE = 57563
-const IF = 57564
-const PRIMARY = 57565
-const COLUMN = 57566
-const CONSTRAINT = 57567
-const SPATIAL = 57568
-const FULLTEXT = 57569
-const FOREIGN = 57570
-const KEY_BLOCK_SIZE = 57571
-const SHOW = 57572
-const DESCRIBE = 57573
-const EXPLAIN = 57574
-const DATE = 57575
-const ESCAPE = 57576
-const REPAIR = 57577
-const OPTIMIZE = 57578
-const TRUNCATE = 57579
-const MAXVALUE = 57580
-const PARTITION = 57581
-const REORGANIZE = 57582
-const LESS = 57583
-const THAN = 57584
-const PROCEDURE = 57585
-const TRIGGER = 57586
-const STATUS = 57587
-const VARIABLES = 57588
-const ROLE = 57589
-const PROXY = 57590
-const AVG_ROW_LENGTH = 57591
-const STORAGE = 57592
-const DISK = 57593
-const MEMORY = 57594
-const CHECKSUM = 57595
-const COMPRESSION = 57596
-const DATA = 57597
-const DIRECTORY = 57598
-const DELAY_KEY_WRITE = 57599
-const ENCRYPTION = 57600
-const ENGINE = 57601
-const MAX_ROWS = 57602
-const MIN_ROWS = 57603
-const PACK_KEYS = 57604
-const ROW_FORMAT = 57605
-const STATS_AUTO_RECALC = 57606
-const STATS_PERSISTENT = 57607
-const STATS_SAMPLE_PAGES = 57608
-const DYNAMIC = 57609
-const COMPRESSED = 57610
-const REDUNDANT = 57611
-const COMPACT = 57612
-const FIXED = 57613
-const COLUMN_FORMAT = 57614
-const AUTO_RANDOM = 57615
-const ENGINE_ATTRIBUTE = 57616
-const SECONDARY_ENGINE_ATTRIBUTE = 57617
-const INSERT_METHOD = 57618
-const RESTRICT = 57619
-const CASCADE = 57620
-const ACTION = 57621
-const PARTIAL = 57622
-const SIMPLE = 57623
-const CHECK = 57624
-const ENFORCED = 57625
-const RANGE = 57626
-const LIST = 57627
-const ALGORITHM = 57628
-const LINEAR = 57629
-const PARTITIONS = 57630
-const SUBPARTITION = 57631
-const SUBPARTITIONS = 57632
-const CLUSTER = 57633
-const TYPE = 57634
-const ANY = 57635
-const SOME = 57636
-const EXTERNAL = 57637
-const LOCALFILE = 57638
-const URL = 57639
-const PREPARE = 57640
-const DEALLOCATE = 57641
-const RESET = 57642
-const EXTENSION = 57643
-const INCREMENT = 57644
-const CYCLE = 57645
-const MINVALUE = 57646
-const PUBLICATION = 57647
-const SUBSCRIPTIONS = 57648
-const PUBLICATIONS = 57649
-const PROPERTIES = 57650
-const PARSER = 57651
-const VISIBLE = 57652
-const INVISIBLE = 57653
-const BTREE = 57654
-const HASH = 57655
-const RTREE = 57656
-const BSI = 57657
-const ZONEMAP = 57658
-const LEADING = 57659
-const BOTH = 57660
-const TRAILING = 57661
-const UNKNOWN = 57662
-const EXPIRE = 57663
-const ACCOUNT = 57664
-const ACCOUNTS = 57665
-const UNLOCK = 57666
-const DAY = 57667
-const NEVER = 57668
-const PUMP = 57669
-const MYSQL_COMPATIBILITY_MODE = 57670
-const MODIFY = 57671
-const CHANGE = 57672
-const SECOND = 57673
-const ASCII = 57674
-const COALESCE = 57675
-const COLLATION = 57676
-const HOUR = 57677
-const MICROSECOND = 57678
-const MINUTE = 57679
-const MONTH = 57680
-const QUARTER = 57681
-const REPEAT = 57682
-const REVERSE = 57683
-const ROW_COUNT = 57684
-const WEEK = 57685
-const REVOKE = 57686
-const FUNCTION = 57687
-const PRIVILEGES = 57688
-const TABLESPACE = 57689
-const EXECUTE = 57690
-const SUPER = 57691
-const GRANT = 57692
-const OPTION = 57693
-const REFERENCES = 57694
-const REPLICATION = 57695
-const SLAVE = 57696
-const CLIENT = 57697
-const USAGE = 57698
-const RELOAD = 57699
-const FILE = 57700
-const TEMPORARY = 57701
-const ROUTINE = 57702
-const EVENT = 57703
-const SHUTDOWN = 57704
-const NULLX = 57705
-const AUTO_INCREMENT = 57706
-const APPROXNUM = 57707
-const SIGNED = 57708
-const UNSIGNED = 57709
-const ZEROFILL = 57710
-const ENGINES = 57711
-const LOW_CARDINALITY = 57712
-const AUTOEXTEND_SIZE = 57713
-const ADMIN_NAME = 57714
-const RANDOM = 57715
-const SUSPEND = 57716
-const ATTRIBUTE = 57717
-const HISTORY = 57718
-const REUSE = 57719
-const CURRENT = 57720
-const OPTIONAL = 57721
-const FAILED_LOGIN_ATTEMPTS = 57722
-const PASSWORD_LOCK_TIME = 57723
-const UNBOUNDED = 57724
-const SECONDARY = 57725
-const RESTRICTED = 57726
-const USER = 57727
-const IDENTIFIED = 57728
-const CIPHER = 57729
-const ISSUER = 57730
-const X509 = 57731
-const SUBJECT = 57732
-const SAN = 57733
-const REQUIRE = 57734
-const SSL = 57735
-const NONE = 57736
-const PASSWORD = 57737
-const SHARED = 57738
-const EXCLUSIVE = 57739
-const MAX_QUERIES_PER_HOUR = 57740
-const MAX_UPDATES_PER_HOUR = 57741
-const MAX_CONNECTIONS_PER_HOUR = 57742
-const MAX_USER_CONNECTIONS = 57743
-const FORMAT = 57744
-const VERBOSE = 57745
-const CONNECTION = 57746
-const TRIGGERS = 57747
-const PROFILES = 57748
-const LOAD = 57749
-const INFILE = 57750
-const TERMINATED = 57751
-const OPTIONALLY = 57752
-const ENCLOSED = 57753
-const ESCAPED = 57754
-const STARTING = 57755
-const LINES = 57756
-const ROWS = 57757
-const IMPORT = 57758
-const DISCARD = 57759
-const MODUMP = 57760
-const OVER = 57761
-const PRECEDING = 57762
-const FOLLOWING = 57763
-const GROUPS = 57764
-const DATABASES = 57765
-const TABLES = 57766
-const SEQUENCES = 57767
-const EXTENDED = 57768
-const FULL = 57769
-const PROCESSLIST = 57770
-const FIELDS = 57771
-const COLUMNS = 57772
-const OPEN = 57773
-const ERRORS = 57774
-const WARNINGS = 57775
-const INDEXES = 57776
-const SCHEMAS = 57777
-const NODE = 57778
-const LOCKS = 57779
-const ROLES = 57780
-const TABLE_NUMBER = 57781
-const COLUMN_NUMBER = 57782
-const TABLE_VALUES = 57783
-const TABLE_SIZE = 57784
-const NAMES = 57785
-const GLOBAL = 57786
-const PERSIST = 57787
-const SESSION = 57788
-const ISOLATION = 57789
-const LEVEL = 57790
-const READ = 57791
-const WRITE = 57792
-const ONLY = 57793
-const REPEATABLE = 57794
-const COMMITTED = 57795
-const UNCOMMITTED = 57796
-const SERIALIZABLE = 57797
-const LOCAL = 57798
-const EVENTS = 57799
-const PLUGINS = 57800
-const CURRENT_TIMESTAMP = 57801
-const DATABASE = 57802
-const CURRENT_TIME = 57803
-const LOCALTIME = 57804
-const LOCALTIMESTAMP = 57805
-const UTC_DATE = 57806
-const UTC_TIME = 57807
-const UTC_TIMESTAMP = 57808
-const REPLACE = 57809
-const CONVERT = 57810
-const SEPARATOR = 57811
-const TIMESTAMPDIFF = 57812
-const CURRENT_DATE = 57813
-const CURRENT_USER = 57814
-const CURRENT_ROLE = 57815
-const SECOND_MICROSECOND = 57816
-const MINUTE_MICROSECOND = 57817
-const MINUTE_SECOND = 57818
-const HOUR_MICROSECOND = 57819
-const HOUR_SECOND = 57820
-const HOUR_MINUTE = 57821
-const DAY_MICROSECOND = 57822
-const DAY_SECOND = 57823
-const DAY_MINUTE = 57824
-const DAY_HOUR = 57825
-const YEAR_MONTH = 57826
-const SQL_TSI_HOUR = 57827
-const SQL_TSI_DAY = 57828
-const SQL_TSI_WEEK = 57829
-const SQL_TSI_MONTH = 57830
-const SQL_TSI_QUARTER = 57831
-const SQL_TSI_YEAR = 57832
-const SQL_TSI_SECOND = 57833
-const SQL_TSI_MINUTE = 57834
-const RECURSIVE = 57835
-const CONFIG = 57836
-const DRAINER = 57837
-const SOURCE = 57838
-const STREAM = 57839
-const HEADERS = 57840
-const CONNECTOR = 57841
-const MATCH = 57842
-const AGAINST = 57843
-const BOOLEAN = 57844
-const LANGUAGE = 57845
-const WITH = 57846
-const QUERY = 57847
-const EXPANSION = 57848
-const WITHOUT = 57849
-const VALIDATION = 57850
-const ADDDATE = 57851
-const BIT_AND = 57852
-const BIT_OR = 57853
-const BIT_XOR = 57854
-const CAST = 57855
-const COUNT = 57856
-const APPROX_COUNT = 57857
-const APPROX_COUNT_DISTINCT = 57858
-const APPROX_PERCENTILE = 57859
-const CURDATE = 57860
-const CURTIME = 57861
-const DATE_ADD = 57862
-const DATE_SUB = 57863
-const EXTRACT = 57864
-const GROUP_CONCAT = 57865
-const MAX = 57866
-const MID = 57867
-const MIN = 57868
-const NOW = 57869
-const POSITION = 57870
-const SESSION_USER = 57871
-const STD = 57872
-const STDDEV = 57873
-const MEDIAN = 57874
-const STDDEV_POP = 57875
-const STDDEV_SAMP = 57876
-const SUBDATE = 57877
-const SUBSTR = 57878
-const SUBSTRING = 57879
-const SUM = 57880
-const SYSDATE = 57881
-const SYSTEM_USER = 57882
-const TRANSLATE = 57883
-const TRIM = 57884
-const VARIANCE = 57885
-const VAR_POP = 57886
-const VAR_SAMP = 57887
-const AVG = 57888
-const RANK = 57889
-const ROW_NUMBER = 57890
-const DENSE_RANK = 57891
-const NEXTVAL = 57892
-const SETVAL = 57893
-const CURRVAL = 57894
-const LASTVAL = 57895
-const ARROW = 57896
-const ROW = 57897
-const OUTFILE = 57898
-const HEADER = 57899
-const MAX_FILE_SIZE = 57900
-const FORCE_QUOTE = 57901
-const PARALLEL = 57902
-const UNUSED = 57903
-const BINDINGS = 57904
-const DO = 57905
-const DECLARE = 57906
-const LOOP = 57907
-const WHILE = 57908
-const LEAVE = 57909
-const ITERATE = 57910
-const UNTIL = 57911
-const CALL = 57912
-const SPBEGIN = 57913
-const BACKEND = 57914
-const SERVERS = 57915
-const KILL = 57916
-const BACKUP = 57917
-const FILESYSTEM = 57918
-const QUERY_RESULT = 57919
+const VECF32 = 57530
+const VECF64 = 57531
+const GEOMETRY = 57532
+const POINT = 57533
+const LINESTRING = 57534
+const POLYGON = 57535
+const GEOMETRYCOLLECTION = 57536
+const MULTIPOINT = 57537
+const MULTILINESTRING = 57538
+const MULTIPOLYGON = 57539
+const INT1 = 57540
+const INT2 = 57541
+const INT3 = 57542
+const INT4 = 57543
+const INT8 = 57544
+const S3OPTION = 57545
+const SQL_SMALL_RESULT = 57546
+const SQL_BIG_RESULT = 57547
+const SQL_BUFFER_RESULT = 57548
+const LOW_PRIORITY = 57549
+const HIGH_PRIORITY = 57550
+const DELAYED = 57551
+const CREATE = 57552
+const ALTER = 57553
+const DROP = 57554
+const RENAME = 57555
+const ANALYZE = 57556
+const ADD = 57557
+const RETURNS = 57558
+const SCHEMA = 57559
+const TABLE = 57560
+const SEQUENCE = 57561
+const INDEX = 57562
+const VIEW = 57563
+const TO = 57564
+const IGNORE = 57565
+const IF = 57566
+const PRIMARY = 57567
+const COLUMN = 57568
+const CONSTRAINT = 57569
+const SPATIAL = 57570
+const FULLTEXT = 57571
+const FOREIGN = 57572
+const KEY_BLOCK_SIZE = 57573
+const SHOW = 57574
+const DESCRIBE = 57575
+const EXPLAIN = 57576
+const DATE = 57577
+const ESCAPE = 57578
+const REPAIR = 57579
+const OPTIMIZE = 57580
+const TRUNCATE = 57581
+const MAXVALUE = 57582
+const PARTITION = 57583
+const REORGANIZE = 57584
+const EXCHANGE = 57585
+const LESS = 57586
+const THAN = 57587
+const PROCEDURE = 57588
+const TRIGGER = 57589
+const STATUS = 57590
+const VARIABLES = 57591
+const ROLE = 57592
+const PROXY = 57593
+const AVG_ROW_LENGTH = 57594
+const STORAGE = 57595
+const DISK = 57596
+const MEMORY = 57597
+const CHECKSUM = 57598
+const COMPRESSION = 57599
+const DATA = 57600
+const DIRECTORY = 57601
+const DELAY_KEY_WRITE = 57602
+const ENCRYPTION = 57603
+const ENGINE = 57604
+const MAX_ROWS = 57605
+const MIN_ROWS = 57606
+const PACK_KEYS = 57607
+const ROW_FORMAT = 57608
+const STATS_AUTO_RECALC = 57609
+const STATS_PERSISTENT = 57610
+const STATS_SAMPLE_PAGES = 57611
+const DYNAMIC = 57612
+const COMPRESSED = 57613
+const REDUNDANT = 57614
+const COMPACT = 57615
+const FIXED = 57616
+const COLUMN_FORMAT = 57617
+const AUTO_RANDOM = 57618
+const ENGINE_ATTRIBUTE = 57619
+const SECONDARY_ENGINE_ATTRIBUTE = 57620
+const INSERT_METHOD = 57621
+const RESTRICT = 57622
+const CASCADE = 57623
+const ACTION = 57624
+const PARTIAL = 57625
+const SIMPLE = 57626
+const CHECK = 57627
+const ENFORCED = 57628
+const RANGE = 57629
+const LIST = 57630
+const ALGORITHM = 57631
+const LINEAR = 57632
+const PARTITIONS = 57633
+const SUBPARTITION = 57634
+const SUBPARTITIONS = 57635
+const CLUSTER = 57636
+const TYPE = 57637
+const ANY = 57638
+const SOME = 57639
+const EXTERNAL = 57640
+const LOCALFILE = 57641
+const URL = 57642
+const PREPARE = 57643
+const DEALLOCATE = 57644
+const RESET = 57645
+const EXTENSION = 57646
+const INCREMENT = 57647
+const CYCLE = 57648
+const MINVALUE = 57649
+const PUBLICATION = 57650
+const SUBSCRIPTIONS = 57651
+const PUBLICATIONS = 57652
+const PROPERTIES = 57653
+const PARSER = 57654
+const VISIBLE = 57655
+const INVISIBLE = 57656
+const BTREE = 57657
+const HASH = 57658
+const RTREE = 57659
+const BSI = 57660
+const IVFFLAT = 57661
+const LISTS = 57662
+const OP_TYPE = 57663
+const ZONEMAP = 57664
+const LEADING = 57665
+const BOTH = 57666
+const TRAILING = 57667
+const UNKNOWN = 57668
+const LATERAL = 57669
+const EXPIRE = 57670
+const ACCOUNT = 57671
+const ACCOUNTS = 57672
+const UNLOCK = 57673
+const DAY = 57674
+const NEVER = 57675
+const PUMP = 57676
+const MYSQL_COMPATIBILITY_MODE = 57677
+const MODIFY = 57678
+const CHANGE = 57679
+const SECOND = 57680
+const ASCII = 57681
+const COALESCE = 57682
+const COLLATION = 57683
+const HOUR = 57684
+const MICROSECOND = 57685
+const MINUTE = 57686
+const MONTH = 57687
+const QUARTER = 57688
+const REPEAT = 57689
+const REVERSE = 57690
+const ROW_COUNT = 57691
+const WEEK = 57692
+const REVOKE = 57693
+const FUNCTION = 57694
+const PRIVILEGES = 57695
+const TABLESPACE = 57696
+const EXECUTE = 57697
+const SUPER = 57698
+const GRANT = 57699
+const OPTION = 57700
+const REFERENCES = 57701
+const REPLICATION = 57702
+const SLAVE = 57703
+const CLIENT = 57704
+const USAGE = 57705
+const RELOAD = 57706
+const FILE = 57707
+const TEMPORARY = 57708
+const ROUTINE = 57709
+const EVENT = 57710
+const SHUTDOWN = 57711
+const NULLX = 57712
+const AUTO_INCREMENT = 57713
+const APPROXNUM = 57714
+const SIGNED = 57715
+const UNSIGNED = 57716
+const ZEROFILL = 57717
+const ENGINES = 57718
+const LOW_CARDINALITY = 57719
+const AUTOEXTEND_SIZE = 57720
+const ADMIN_NAME = 57721
+const RANDOM = 57722
+const SUSPEND = 57723
+const ATTRIBUTE = 57724
+const HISTORY = 57725
+const REUSE = 57726
+const CURRENT = 57727
+const OPTIONAL = 57728
+const FAILED_LOGIN_ATTEMPTS = 57729
+const PASSWORD_LOCK_TIME = 57730
+const UNBOUNDED = 57731
+const SECONDARY = 57732
+const RESTRICTED = 57733
+const USER = 57734
+const IDENTIFIED = 57735
+const CIPHER = 57736
+const ISSUER = 57737
+const X509 = 57738
+const SUBJECT = 57739
+const SAN = 57740
+const REQUIRE = 57741
+const SSL = 57742
+const NONE = 57743
+const PASSWORD = 57744
+const SHARED = 57745
+const EXCLUSIVE = 57746
+const MAX_QUERIES_PER_HOUR = 57747
+const MAX_UPDATES_PER_HOUR = 57748
+const MAX_CONNECTIONS_PER_HOUR = 57749
+const MAX_USER_CONNECTIONS = 57750
+const FORMAT = 57751
+const VERBOSE = 57752
+const CONNECTION = 57753
+const TRIGGERS = 57754
+const PROFILES = 57755
+const LOAD = 57756
+const INFILE = 57757
+const TERMINATED = 57758
+const OPTIONALLY = 57759
+const ENCLOSED = 57760
+const ESCAPED = 57761
+const STARTING = 57762
+const LINES = 57763
+const ROWS = 57764
+const IMPORT = 57765
+const DISCARD = 57766
+const MODUMP = 57767
+const OVER = 57768
+const PRECEDING = 57769
+const FOLLOWING = 57770
+const GROUPS = 57771
+const DATABASES = 57772
+const TABLES = 57773
+const SEQUENCES = 57774
+const EXTENDED = 57775
+const FULL = 57776
+const PROCESSLIST = 57777
+const FIELDS = 57778
+const COLUMNS = 57779
+const OPEN = 57780
+const ERRORS = 57781
+const WARNINGS = 57782
+const INDEXES = 57783
+const SCHEMAS = 57784
+const NODE = 57785
+const LOCKS = 57786
+const ROLES = 57787
+const TABLE_NUMBER = 57788
+const COLUMN_NUMBER = 57789
+const TABLE_VALUES = 57790
+const TABLE_SIZE = 57791
+const NAMES = 57792
+const GLOBAL = 57793
+const PERSIST = 57794
+const SESSION = 57795
+const ISOLATION = 57796
+const LEVEL = 57797
+const READ = 57798
+const WRITE = 57799
+const ONLY = 57800
+const REPEATABLE = 57801
+const COMMITTED = 57802
+const UNCOMMITTED = 57803
+const SERIALIZABLE = 57804
+const LOCAL = 57805
+const EVENTS = 57806
+const PLUGINS = 57807
+const CURRENT_TIMESTAMP = 57808
+const DATABASE = 57809
+const CURRENT_TIME = 57810
+const LOCALTIME = 57811
+const LOCALTIMESTAMP = 57812
+const UTC_DATE = 57813
+const UTC_TIME = 57814
+const UTC_TIMESTAMP = 57815
+const REPLACE = 57816
+const CONVERT = 57817
+const SEPARATOR = 57818
+const TIMESTAMPDIFF = 57819
+const CURRENT_DATE = 57820
+const CURRENT_USER = 57821
+const CURRENT_ROLE = 57822
+const SECOND_MICROSECOND = 57823
+const MINUTE_MICROSECOND = 57824
+const MINUTE_SECOND = 57825
+const HOUR_MICROSECOND = 57826
+const HOUR_SECOND = 57827
+const HOUR_MINUTE = 57828
+const DAY_MICROSECOND = 57829
+const DAY_SECOND = 57830
+const DAY_MINUTE = 57831
+const DAY_HOUR = 57832
+const YEAR_MONTH = 57833
+const SQL_TSI_HOUR = 57834
+const SQL_TSI_DAY = 57835
+const SQL_TSI_WEEK = 57836
+const SQL_TSI_MONTH = 57837
+const SQL_TSI_QUARTER = 57838
+const SQL_TSI_YEAR = 57839
+const SQL_TSI_SECOND = 57840
+const SQL_TSI_MINUTE = 57841
+const RECURSIVE = 57842
+const CONFIG = 57843
+const DRAINER = 57844
+const SOURCE = 57845
+const STREAM = 57846
+const HEADERS = 57847
+const CONNECTOR = 57848
+const DOT = 57849
+const MATCH = 57850
+const AGAINST = 57851
+const BOOLEAN = 57852
+const LANGUAGE = 57853
+const WITH = 57854
+const QUERY = 57855
+const EXPANSION = 57856
+const WITHOUT = 57857
+const VALIDATION = 57858
+const ADDDATE = 57859
+const BIT_AND = 57860
+const BIT_OR = 57861
+const BIT_XOR = 57862
+const CAST = 57863
+const COUNT = 57864
+const APPROX_COUNT = 57865
+const APPROX_COUNT_DISTINCT = 57866
+const APPROX_PERCENTILE = 57867
+const CURDATE = 57868
+const CURTIME = 57869
+const DATE_ADD = 57870
+const DATE_SUB = 57871
+const EXTRACT = 57872
+const GROUP_CONCAT = 57873
+const MAX = 57874
+const MID = 57875
+const MIN = 57876
+const NOW = 57877
+const POSITION = 57878
+const SESSION_USER = 57879
+const STD = 57880
+const STDDEV = 57881
+const MEDIAN = 57882
+const STDDEV_POP = 57883
+const STDDEV_SAMP = 57884
+const SUBDATE = 57885
+const SUBSTR = 57886
+const SUBSTRING = 57887
+const SUM = 57888
+const SYSDATE = 57889
+const SYSTEM_USER = 57890
+const TRANSLATE = 57891
+const TRIM = 57892
+const VARIANCE = 57893
+const VAR_POP = 57894
+const VAR_SAMP = 57895
+const AVG = 57896
+const RANK = 57897
+const ROW_NUMBER = 57898
+const DENSE_RANK = 57899
+const LAG = 57900
+const LEAD = 57901
+const FIRST_VALUE = 57902
+const LAST_VALUE = 57903
+const NTH_VALUE = 57904
+const NTILE = 57905
+const PERCENT_RANK = 57906
+const CUME_DIST = 57907
+const NEXTVAL = 57908
+const SETVAL = 57909
+const CURRVAL = 57910
+const LASTVAL = 57911
+const ARROW = 57912
+const ROW = 57913
+const OUTFILE = 57914
+const HEADER = 57915
+const MAX_FILE_SIZE = 57916
+const FORCE_QUOTE = 57917
+const PARALLEL = 57918
+const UNUSED = 57919
+const BINDINGS = 57920
+const DO = 57921
+const DECLARE = 57922
+const LOOP = 57923
+const WHILE = 57924
+const LEAVE = 57925
+const ITERATE = 57926
+const UNTIL = 57927
+const CALL = 57928
+const SPBEGIN = 57929
+const BACKEND = 57930
+const SERVERS = 57931
+const KILL = 57932
+const BACKUP = 57933
+const FILESYSTEM = 57934
+const INCREMENTAL = 57935
+const SCHEDULE = 57936
+const EVERY = 57937
+const STARTS = 57938
+const ENDS = 57939
+const AT = 57940
+const COMPLETION = 57941
+const PRESERVE = 57942
+const BEFORE = 57943
+const EACH = 57944
+const FOLLOWS = 57945
+const PRECEDES = 57946
+const MATERIALIZED = 57947
+const REFRESH = 57948
+const JSON_TABLE = 57949
+const NESTED = 57950
+const PATH = 57951
+const ORDINALITY = 57952
+const ERROR = 57953
+const QUERY_RESULT = 57954
 
 var yyToknames = [...]string{
 	"$end",
@@ -800,6 +835,8 @@ var yyToknames = [...]string{
 	"JSON",
 	"ENUM",
 	"UUID",
+	"VECF32",
+	"VECF64",
 	"GEOMETRY",
 	"POINT",
 	"LINESTRING",
@@ -853,6 +890,7 @@ var yyToknames = [...]string{
 	"MAXVALUE",
 	"PARTITION",
 	"REORGANIZE",
+	"EXCHANGE",
 	"LESS",
 	"THAN",
 	"PROCEDURE",
@@ -928,11 +966,15 @@ var yyToknames = [...]string{
 	"HASH",
 	"RTREE",
 	"BSI",
+	"IVFFLAT",
+	"LISTS",
+	"OP_TYPE",
 	"ZONEMAP",
 	"LEADING",
 	"BOTH",
 	"TRAILING",
 	"UNKNOWN",
+	"LATERAL",
 	"EXPIRE",
 	"ACCOUNT",
 	"ACCOUNTS",
@@ -1112,6 +1154,7 @@ var yyToknames = [...]string{
 	"STREAM",
 	"HEADERS",
 	"CONNECTOR",
+	"DOT",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
@@ -1162,6 +1205,14 @@ var yyToknames = [...]string{
 	"RANK",
 	"ROW_NUMBER",
 	"DENSE_RANK",
+	"LAG",
+	"LEAD",
+	"FIRST_VALUE",
+	"LAST_VALUE",
+	"NTH_VALUE",
+	"NTILE",
+	"PERCENT_RANK",
+	"CUME_DIST",
 	"NEXTVAL",
 	"SETVAL",
 	"CURRVAL",
@@ -1189,6 +1240,25 @@ var yyToknames = [...]string{
 	"KILL",
 	"BACKUP",
 	"FILESYSTEM",
+	"INCREMENTAL",
+	"SCHEDULE",
+	"EVERY",
+	"STARTS",
+	"ENDS",
+	"AT",
+	"COMPLETION",
+	"PRESERVE",
+	"BEFORE",
+	"EACH",
+	"FOLLOWS",
+	"PRECEDES",
+	"MATERIALIZED",
+	"REFRESH",
+	"JSON_TABLE",
+	"NESTED",
+	"PATH",
+	"ORDINALITY",
+	"ERROR",
 	"QUERY_RESULT",
 	"';'",
 	"'{'",
@@ -1203,4255 +1273,4960 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:10356
+//line mysql_sql.y:11125
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 115,
-	21, 698,
-	-2, 679,
-	-1, 132,
-	233, 1032,
-	235, 955,
-	-2, 996,
-	-1, 155,
-	42, 517,
-	235, 517,
-	262, 524,
-	263, 524,
-	451, 517,
-	-2, 550,
-	-1, 191,
-	598, 1743,
-	-2, 433,
-	-1, 525,
-	314, 133,
-	425, 133,
-	-2, 1655,
-	-1, 588,
-	81, 1452,
-	-2, 1797,
-	-1, 589,
-	81, 1470,
-	-2, 1768,
-	-1, 593,
-	81, 1471,
-	-2, 1796,
-	-1, 619,
-	81, 1382,
-	-2, 1865,
-	-1, 620,
-	81, 1383,
-	-2, 1864,
-	-1, 621,
-	81, 1384,
-	-2, 1854,
-	-1, 622,
-	81, 1828,
-	-2, 1849,
-	-1, 623,
-	81, 1829,
-	-2, 1850,
-	-1, 624,
-	81, 1830,
-	-2, 1856,
-	-1, 625,
-	81, 1831,
-	-2, 1838,
-	-1, 626,
-	81, 1832,
-	-2, 1847,
-	-1, 627,
-	81, 1833,
-	-2, 1857,
-	-1, 628,
-	81, 1834,
-	-2, 1858,
-	-1, 629,
-	81, 1835,
-	-2, 1863,
-	-1, 630,
-	81, 1836,
-	-2, 1868,
-	-1, 631,
-	81, 1837,
-	-2, 1869,
-	-1, 633,
-	81, 1449,
-	-2, 1643,
-	-1, 637,
-	81, 1454,
-	-2, 1656,
-	-1, 640,
-	81, 1458,
-	-2, 1674,
-	-1, 644,
-	81, 1462,
-	-2, 1714,
-	-1, 645,
-	81, 1463,
-	-2, 1792,
-	-1, 653,
-	81, 1473,
-	-2, 1777,
-	-1, 654,
-	81, 1474,
-	-2, 1821,
-	-1, 655,
-	81, 1475,
-	-2, 1787,
-	-1, 656,
-	81, 1476,
-	-2, 1811,
-	-1, 667,
-	81, 1360,
-	-2, 1859,
-	-1, 668,
-	81, 1361,
-	-2, 1860,
-	-1, 669,
-	81, 1362,
-	-2, 1861,
-	-1, 673,
-	21, 699,
-	-2, 662,
-	-1, 754,
-	446, 550,
-	447, 550,
-	-2, 518,
-	-1, 798,
-	122, 1643,
-	133, 1643,
-	153, 1643,
-	-2, 1618,
-	-1, 902,
-	21, 699,
-	-2, 662,
-	-1, 1002,
-	21, 698,
-	-2, 1258,
-	-1, 1120,
-	513, 997,
-	514, 997,
-	-2, 873,
-	-1, 1374,
-	81, 1520,
-	-2, 1794,
-	-1, 1375,
-	81, 1521,
-	-2, 1795,
-	-1, 1513,
-	82, 845,
-	-2, 851,
-	-1, 1896,
-	82, 1604,
-	154, 1604,
-	-2, 1779,
-	-1, 1897,
-	82, 1604,
-	154, 1604,
-	-2, 1778,
-	-1, 1898,
-	82, 1582,
-	154, 1582,
+	-1, 120,
+	21, 717,
+	-2, 698,
+	-1, 138,
+	235, 1125,
+	237, 1044,
+	-2, 1088,
+	-1, 164,
+	42, 533,
+	237, 533,
+	265, 540,
+	266, 540,
+	458, 533,
+	-2, 566,
+	-1, 200,
+	633, 1855,
+	-2, 449,
+	-1, 570,
+	317, 136,
+	432, 136,
 	-2, 1765,
-	-1, 1899,
-	82, 1583,
-	154, 1583,
-	-2, 1770,
-	-1, 1900,
-	82, 1584,
-	154, 1584,
-	-2, 1702,
-	-1, 1901,
-	82, 1585,
-	154, 1585,
-	-2, 1696,
-	-1, 1902,
-	82, 1586,
-	154, 1586,
-	-2, 1634,
-	-1, 1903,
-	82, 1587,
-	154, 1587,
+	-1, 634,
+	81, 1560,
+	-2, 1915,
+	-1, 635,
+	81, 1578,
+	-2, 1880,
+	-1, 639,
+	81, 1579,
+	-2, 1914,
+	-1, 673,
+	81, 1490,
+	-2, 2001,
+	-1, 674,
+	81, 1491,
+	-2, 2000,
+	-1, 675,
+	81, 1492,
+	-2, 1990,
+	-1, 676,
+	81, 1964,
+	-2, 1985,
+	-1, 677,
+	81, 1965,
+	-2, 1986,
+	-1, 678,
+	81, 1966,
+	-2, 1992,
+	-1, 679,
+	81, 1967,
+	-2, 1974,
+	-1, 680,
+	81, 1968,
+	-2, 1983,
+	-1, 681,
+	81, 1969,
+	-2, 1993,
+	-1, 682,
+	81, 1970,
+	-2, 1994,
+	-1, 683,
+	81, 1971,
+	-2, 1999,
+	-1, 684,
+	81, 1972,
+	-2, 2004,
+	-1, 685,
+	81, 1973,
+	-2, 2005,
+	-1, 687,
+	81, 1557,
+	-2, 1753,
+	-1, 691,
+	81, 1562,
+	-2, 1766,
+	-1, 694,
+	81, 1566,
+	-2, 1786,
+	-1, 698,
+	81, 1570,
+	-2, 1826,
+	-1, 699,
+	81, 1571,
+	-2, 1910,
+	-1, 707,
+	81, 1581,
+	-2, 1895,
+	-1, 708,
+	81, 1582,
+	-2, 1944,
+	-1, 709,
+	81, 1583,
+	-2, 1905,
+	-1, 710,
+	81, 1584,
+	-2, 1929,
+	-1, 721,
+	81, 1468,
+	-2, 1995,
+	-1, 722,
+	81, 1469,
+	-2, 1996,
+	-1, 723,
+	81, 1470,
+	-2, 1997,
+	-1, 727,
+	21, 718,
+	-2, 681,
+	-1, 812,
+	453, 566,
+	454, 566,
+	-2, 534,
+	-1, 857,
+	122, 1753,
+	133, 1753,
+	153, 1753,
+	-2, 1728,
+	-1, 966,
+	21, 718,
+	-2, 681,
+	-1, 1066,
+	21, 717,
+	-2, 1352,
+	-1, 1197,
+	520, 1089,
+	521, 1089,
+	-2, 912,
+	-1, 1459,
+	81, 1628,
+	-2, 1912,
+	-1, 1460,
+	81, 1629,
+	-2, 1913,
+	-1, 1608,
+	82, 884,
+	-2, 890,
+	-1, 2023,
+	82, 1714,
+	154, 1714,
+	-2, 1897,
+	-1, 2024,
+	82, 1714,
+	154, 1714,
+	-2, 1896,
+	-1, 2025,
+	82, 1690,
+	154, 1690,
+	-2, 1877,
+	-1, 2026,
+	82, 1691,
+	154, 1691,
+	-2, 1882,
+	-1, 2027,
+	82, 1692,
+	154, 1692,
+	-2, 1814,
+	-1, 2028,
+	82, 1693,
+	154, 1693,
+	-2, 1808,
+	-1, 2029,
+	82, 1694,
+	154, 1694,
+	-2, 1744,
+	-1, 2030,
+	82, 1695,
+	154, 1695,
+	-2, 1879,
+	-1, 2031,
+	82, 1696,
+	154, 1696,
+	-2, 1812,
+	-1, 2032,
+	82, 1697,
+	154, 1697,
+	-2, 1807,
+	-1, 2033,
+	82, 1698,
+	154, 1698,
+	-2, 1800,
+	-1, 2035,
+	82, 1701,
+	154, 1701,
+	-2, 1929,
+	-1, 2036,
+	82, 1714,
+	154, 1714,
+	-2, 1930,
+	-1, 2037,
+	82, 1714,
+	154, 1714,
+	-2, 1931,
+	-1, 2038,
+	82, 1681,
+	154, 1681,
+	-2, 1915,
+	-1, 2039,
+	82, 1712,
+	154, 1712,
+	-2, 1880,
+	-1, 2040,
+	82, 1712,
+	154, 1712,
+	-2, 1914,
+	-1, 2041,
+	82, 1712,
+	154, 1712,
 	-2, 1767,
-	-1, 1904,
-	82, 1588,
-	154, 1588,
-	-2, 1700,
-	-1, 1905,
-	82, 1589,
-	154, 1589,
-	-2, 1695,
-	-1, 1906,
-	82, 1590,
-	154, 1590,
-	-2, 1688,
-	-1, 1908,
-	82, 1593,
-	154, 1593,
-	-2, 1811,
-	-1, 1909,
-	82, 1573,
-	154, 1573,
-	-2, 1797,
-	-1, 1910,
-	82, 1602,
-	154, 1602,
-	-2, 1768,
-	-1, 1911,
-	82, 1602,
-	154, 1602,
+	-1, 2042,
+	82, 1710,
+	154, 1710,
+	-2, 1905,
+	-1, 2043,
+	82, 1707,
+	154, 1707,
+	-2, 1791,
+	-1, 2044,
+	81, 1662,
+	82, 1662,
+	154, 1662,
+	390, 1662,
+	391, 1662,
+	392, 1662,
+	-2, 1743,
+	-1, 2045,
+	81, 1663,
+	82, 1663,
+	154, 1663,
+	390, 1663,
+	391, 1663,
+	392, 1663,
+	-2, 1745,
+	-1, 2046,
+	81, 1666,
+	82, 1666,
+	154, 1666,
+	390, 1666,
+	391, 1666,
+	392, 1666,
+	-2, 1881,
+	-1, 2047,
+	81, 1668,
+	82, 1668,
+	154, 1668,
+	390, 1668,
+	391, 1668,
+	392, 1668,
+	-2, 1864,
+	-1, 2048,
+	81, 1670,
+	82, 1670,
+	154, 1670,
+	390, 1670,
+	391, 1670,
+	392, 1670,
+	-2, 1813,
+	-1, 2049,
+	81, 1672,
+	82, 1672,
+	154, 1672,
+	390, 1672,
+	391, 1672,
+	392, 1672,
 	-2, 1796,
-	-1, 1912,
-	82, 1602,
-	154, 1602,
-	-2, 1657,
-	-1, 1913,
-	82, 1600,
-	154, 1600,
+	-1, 2050,
+	81, 1673,
+	82, 1673,
+	154, 1673,
+	390, 1673,
+	391, 1673,
+	392, 1673,
+	-2, 1797,
+	-1, 2051,
+	81, 1675,
+	82, 1675,
+	154, 1675,
+	390, 1675,
+	391, 1675,
+	392, 1675,
+	-2, 1742,
+	-1, 2052,
+	82, 1717,
+	154, 1717,
+	390, 1717,
+	391, 1717,
+	392, 1717,
+	-2, 1773,
+	-1, 2053,
+	82, 1717,
+	154, 1717,
+	390, 1717,
+	391, 1717,
+	392, 1717,
 	-2, 1787,
-	-1, 1914,
-	82, 1597,
-	154, 1597,
-	-2, 1679,
-	-1, 1915,
-	81, 1554,
-	82, 1554,
-	154, 1554,
-	383, 1554,
-	384, 1554,
-	385, 1554,
-	-2, 1633,
-	-1, 1916,
-	81, 1555,
-	82, 1555,
-	154, 1555,
-	383, 1555,
-	384, 1555,
-	385, 1555,
-	-2, 1635,
-	-1, 1917,
-	81, 1558,
-	82, 1558,
-	154, 1558,
-	383, 1558,
-	384, 1558,
-	385, 1558,
-	-2, 1769,
-	-1, 1918,
-	81, 1560,
-	82, 1560,
-	154, 1560,
-	383, 1560,
-	384, 1560,
-	385, 1560,
-	-2, 1752,
-	-1, 1919,
-	81, 1562,
-	82, 1562,
-	154, 1562,
-	383, 1562,
-	384, 1562,
-	385, 1562,
-	-2, 1701,
-	-1, 1920,
-	81, 1564,
-	82, 1564,
-	154, 1564,
-	383, 1564,
-	384, 1564,
-	385, 1564,
-	-2, 1684,
-	-1, 1921,
-	81, 1565,
-	82, 1565,
-	154, 1565,
-	383, 1565,
-	384, 1565,
-	385, 1565,
-	-2, 1685,
-	-1, 1922,
-	81, 1567,
-	82, 1567,
-	154, 1567,
-	383, 1567,
-	384, 1567,
-	385, 1567,
-	-2, 1632,
-	-1, 1923,
-	82, 1607,
-	154, 1607,
-	383, 1607,
-	384, 1607,
-	385, 1607,
-	-2, 1662,
-	-1, 1924,
-	82, 1607,
-	154, 1607,
-	383, 1607,
-	384, 1607,
-	385, 1607,
-	-2, 1675,
-	-1, 1925,
-	82, 1610,
-	154, 1610,
-	383, 1610,
-	384, 1610,
-	385, 1610,
-	-2, 1658,
-	-1, 1926,
-	82, 1610,
-	154, 1610,
-	383, 1610,
-	384, 1610,
-	385, 1610,
-	-2, 1717,
-	-1, 1927,
-	82, 1607,
-	154, 1607,
-	383, 1607,
-	384, 1607,
-	385, 1607,
-	-2, 1737,
-	-1, 1943,
-	105, 990,
-	149, 990,
-	188, 990,
-	191, 990,
-	275, 990,
-	-2, 983,
-	-1, 2082,
-	21, 698,
-	-2, 792,
-	-1, 2278,
-	105, 990,
-	149, 990,
-	188, 990,
-	191, 990,
-	275, 990,
-	-2, 984,
-	-1, 2298,
-	79, 608,
-	154, 608,
-	-2, 1145,
-	-1, 2634,
-	191, 990,
-	299, 1226,
-	-2, 1198,
-	-1, 2775,
-	105, 990,
-	149, 990,
-	188, 990,
-	191, 990,
-	-2, 1088,
-	-1, 2777,
-	105, 990,
-	149, 990,
-	188, 990,
-	191, 990,
-	-2, 1088,
-	-1, 2787,
-	79, 608,
-	154, 608,
-	-2, 1146,
-	-1, 2795,
-	191, 990,
-	299, 1226,
-	-2, 1199,
-	-1, 2922,
-	105, 990,
-	149, 990,
-	188, 990,
-	191, 990,
-	-2, 1089,
-	-1, 3284,
-	82, 1050,
-	154, 1050,
-	-2, 990,
-	-1, 3288,
-	82, 1050,
-	154, 1050,
-	-2, 990,
-	-1, 3302,
-	82, 1054,
-	154, 1054,
-	-2, 990,
-	-1, 3307,
-	82, 1055,
-	154, 1055,
-	-2, 990,
+	-1, 2054,
+	82, 1720,
+	154, 1720,
+	390, 1720,
+	391, 1720,
+	392, 1720,
+	-2, 1768,
+	-1, 2055,
+	82, 1720,
+	154, 1720,
+	390, 1720,
+	391, 1720,
+	392, 1720,
+	-2, 1829,
+	-1, 2056,
+	82, 1717,
+	154, 1717,
+	390, 1717,
+	391, 1717,
+	392, 1717,
+	-2, 1849,
+	-1, 2078,
+	105, 1082,
+	149, 1082,
+	188, 1082,
+	191, 1082,
+	278, 1082,
+	-2, 1075,
+	-1, 2233,
+	21, 717,
+	-2, 812,
+	-1, 2449,
+	105, 1082,
+	149, 1082,
+	188, 1082,
+	191, 1082,
+	278, 1082,
+	-2, 1076,
+	-1, 2469,
+	79, 627,
+	154, 627,
+	-2, 1238,
+	-1, 2837,
+	191, 1082,
+	302, 1320,
+	-2, 1292,
+	-1, 2997,
+	105, 1082,
+	149, 1082,
+	188, 1082,
+	191, 1082,
+	-2, 1181,
+	-1, 2999,
+	105, 1082,
+	149, 1082,
+	188, 1082,
+	191, 1082,
+	-2, 1181,
+	-1, 3009,
+	79, 627,
+	154, 627,
+	-2, 1239,
+	-1, 3020,
+	191, 1082,
+	302, 1320,
+	-2, 1293,
+	-1, 3161,
+	105, 1082,
+	149, 1082,
+	188, 1082,
+	191, 1082,
+	-2, 1182,
+	-1, 3176,
+	82, 1143,
+	154, 1143,
+	-2, 1082,
+	-1, 3304,
+	82, 1143,
+	154, 1143,
+	-2, 1082,
+	-1, 3462,
+	82, 1147,
+	154, 1147,
+	-2, 1082,
+	-1, 3527,
+	82, 1148,
+	154, 1148,
+	-2, 1082,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 38378
+const yyLast = 45328
 
 var yyAct = [...]int{
-	555, 1594, 3288, 3287, 3296, 3267, 182, 1293, 1355, 534,
-	3159, 536, 557, 3220, 529, 3185, 3238, 3167, 2652, 3168,
-	544, 2870, 3079, 1871, 2962, 3093, 2809, 1034, 3071, 1351,
-	2875, 1214, 2715, 3097, 2905, 2906, 2903, 2997, 585, 2716,
-	441, 3032, 797, 674, 2873, 2287, 2771, 1283, 2987, 3080,
-	448, 3082, 453, 453, 538, 1548, 2755, 2910, 453, 469,
-	478, 1155, 2301, 478, 1984, 1358, 2921, 2602, 2865, 2741,
-	167, 2796, 2414, 2415, 2924, 1683, 1680, 2586, 2397, 2744,
-	2337, 2649, 2631, 1648, 2407, 2283, 2713, 2076, 2413, 489,
-	2701, 2638, 1777, 1746, 1987, 2436, 2684, 2410, 2269, 2567,
-	1695, 2570, 483, 2565, 2637, 2603, 2279, 2060, 1892, 2473,
-	1884, 1773, 527, 1875, 2510, 1755, 2317, 1955, 1207, 1894,
-	528, 1493, 533, 2600, 36, 896, 1754, 1747, 2124, 1772,
-	2456, 1720, 1874, 1676, 1651, 730, 2077, 803, 1279, 1128,
-	2065, 1649, 2258, 2319, 2253, 791, 1577, 1985, 6, 1586,
-	1954, 1163, 178, 8, 177, 7, 1523, 1501, 2141, 441,
-	1287, 850, 1248, 1349, 1805, 1774, 1890, 1223, 1104, 447,
-	537, 1284, 2104, 1292, 1193, 1980, 114, 2215, 2605, 1934,
-	2604, 35, 182, 475, 182, 440, 841, 842, 1784, 1560,
-	526, 1559, 1404, 1388, 835, 836, 2216, 1144, 761, 840,
-	1340, 528, 26, 913, 545, 801, 15, 1750, 1736, 13,
-	1255, 1753, 1130, 14, 1348, 1710, 790, 32, 1164, 1073,
-	2084, 465, 1522, 1190, 535, 462, 1192, 1274, 1140, 1410,
-	1409, 671, 729, 492, 491, 23, 16, 1354, 10, 168,
-	1156, 1247, 707, 727, 1099, 1550, 477, 161, 1781, 164,
-	711, 838, 837, 673, 839, 3022, 2241, 2241, 2241, 1035,
-	749, 474, 1791, 2758, 2175, 470, 2127, 1506, 472, 2708,
-	2130, 2128, 473, 1262, 834, 834, 471, 166, 1090, 449,
-	1176, 1260, 834, 2863, 2469, 2125, 2467, 1725, 1258, 2993,
-	2988, 2866, 2714, 833, 1497, 1029, 3084, 1749, 672, 1430,
-	165, 2014, 3150, 458, 2022, 971, 972, 973, 970, 481,
-	2288, 682, 3043, 55, 2799, 832, 2892, 2170, 2742, 8,
-	934, 7, 165, 51, 157, 133, 733, 165, 1091, 971,
-	972, 973, 970, 2286, 1530, 2162, 2887, 165, 1778, 804,
-	165, 165, 165, 3115, 806, 1115, 1114, 1532, 113, 2533,
-	165, 1517, 2811, 487, 488, 1307, 3044, 662, 1300, 661,
-	663, 664, 1120, 665, 666, 2802, 1938, 2890, 2102, 162,
-	968, 165, 51, 157, 133, 2797, 1789, 2443, 2444, 2103,
-	2819, 2820, 1693, 1092, 2442, 1304, 2798, 1660, 1297, 2488,
-	2481, 162, 1661, 1662, 770, 732, 162, 1325, 113, 675,
-	165, 51, 157, 133, 949, 771, 1306, 950, 3087, 1299,
-	162, 162, 683, 2142, 2090, 1341, 1573, 2089, 1345, 162,
-	2091, 1357, 1172, 2803, 942, 1173, 1194, 944, 1196, 779,
-	1507, 1508, 961, 2255, 966, 952, 1152, 3203, 800, 3201,
-	162, 1159, 1344, 2256, 2883, 1158, 1161, 1162, 1161, 1162,
-	799, 3086, 453, 3085, 1426, 945, 1862, 3149, 3087, 3146,
-	1423, 2995, 453, 906, 1425, 1422, 1424, 1428, 1429, 162,
-	3086, 3145, 1427, 3085, 3144, 2717, 807, 3189, 3190, 3073,
-	478, 478, 2474, 453, 3171, 3172, 3073, 2475, 773, 2476,
-	2254, 772, 2998, 2999, 3000, 3001, 3076, 2991, 2717, 2157,
-	916, 907, 901, 903, 2353, 1677, 1261, 1259, 3090, 2726,
-	947, 1175, 1667, 2745, 2818, 2579, 1988, 916, 1785, 1360,
-	2581, 1346, 1336, 2752, 2054, 758, 2897, 1733, 2261, 1933,
-	938, 2571, 718, 734, 3017, 132, 2244, 163, 3152, 3153,
-	3089, 2807, 1268, 1267, 1343, 974, 2401, 1004, 2821, 964,
-	965, 2499, 2167, 2501, 1003, 940, 963, 155, 2020, 844,
-	736, 802, 1012, 2804, 2808, 2806, 2805, 943, 946, 775,
-	2576, 2577, 774, 948, 2864, 2882, 929, 2468, 937, 905,
-	900, 2057, 2884, 2056, 1018, 2575, 2578, 906, 3020, 2894,
-	2061, 475, 475, 939, 2650, 2651, 1671, 902, 1794, 1796,
-	1797, 2813, 2814, 1411, 1412, 1413, 1414, 1415, 1416, 1417,
-	1418, 1419, 1420, 1421, 1433, 1434, 1435, 1436, 1437, 1438,
-	1431, 1432, 1790, 757, 756, 1184, 1691, 1692, 3196, 1150,
-	1359, 3170, 2273, 2274, 2275, 2276, 959, 960, 3205, 2833,
-	755, 1038, 2595, 2821, 2613, 804, 3103, 480, 951, 522,
-	806, 731, 524, 1089, 3040, 2800, 2294, 523, 780, 1342,
-	479, 2812, 735, 766, 2406, 1940, 1174, 3281, 941, 474,
-	474, 2826, 3098, 470, 470, 776, 472, 472, 3297, 3229,
-	473, 473, 3200, 3161, 471, 471, 762, 1779, 909, 910,
-	918, 917, 3236, 1139, 1097, 448, 1100, 2573, 3021, 2728,
-	2505, 2240, 1779, 1039, 2836, 1779, 453, 918, 917, 2963,
-	2964, 2965, 2967, 2966, 1070, 2654, 804, 2031, 926, 3016,
-	2953, 806, 763, 767, 3261, 2106, 922, 923, 2003, 730,
-	3157, 3158, 3151, 3161, 1983, 2005, 2030, 3042, 778, 920,
-	752, 2267, 750, 754, 770, 834, 834, 834, 751, 748,
-	747, 1010, 753, 738, 739, 737, 740, 741, 742, 743,
-	834, 768, 1780, 769, 834, 1006, 1007, 1008, 1009, 834,
-	2942, 1792, 1203, 2288, 764, 765, 453, 2126, 1186, 911,
-	1160, 2891, 807, 476, 441, 441, 672, 2051, 2052, 2817,
-	1202, 927, 2004, 441, 441, 1154, 1153, 1218, 1218, 486,
-	453, 1263, 1161, 1162, 1161, 1162, 3241, 3298, 3041, 134,
-	1157, 760, 476, 777, 1137, 1136, 1135, 2893, 2171, 478,
-	1100, 448, 3292, 2582, 1251, 1251, 1216, 1216, 2260, 1220,
-	1678, 134, 2572, 3268, 1795, 182, 134, 3018, 1250, 1250,
-	3033, 928, 897, 52, 441, 1151, 134, 2550, 2816, 134,
-	134, 134, 1656, 807, 1047, 1048, 2502, 2948, 802, 134,
-	2779, 3206, 1806, 1225, 1098, 2453, 2454, 1106, 1107, 1108,
-	1109, 1110, 52, 1112, 1983, 2354, 3304, 2355, 2356, 1119,
-	134, 2000, 2898, 1990, 2264, 2265, 2438, 2440, 2574, 759,
-	2861, 1105, 1668, 1291, 487, 1294, 3070, 1269, 1191, 2263,
-	1302, 2646, 1337, 2653, 452, 452, 1366, 1369, 1370, 134,
-	460, 2163, 2094, 1212, 1213, 1075, 2018, 1367, 1782, 1111,
-	1323, 720, 2504, 721, 1118, 1077, 2650, 2651, 1117, 1198,
-	1200, 1993, 1116, 482, 1218, 2560, 1218, 906, 1210, 1211,
-	1146, 1147, 3242, 673, 1793, 1133, 2243, 1102, 3291, 2955,
-	1138, 3009, 2647, 2351, 3010, 1125, 934, 1148, 1878, 1141,
-	1145, 1145, 1145, 1308, 1095, 1166, 1167, 1185, 1169, 1170,
-	1171, 1509, 1127, 1510, 1103, 1511, 1670, 724, 725, 726,
-	2512, 2511, 1141, 1141, 1361, 1362, 1363, 1364, 1365, 1264,
-	1243, 1877, 1177, 1178, 1376, 1377, 1378, 1379, 1380, 1381,
-	1382, 1383, 1384, 1385, 1386, 1387, 685, 3012, 1281, 1282,
-	1399, 1400, 1165, 2043, 2944, 1168, 1408, 1989, 2943, 1201,
-	686, 1298, 1991, 2929, 3009, 1305, 3310, 3010, 1406, 1407,
-	1457, 3309, 1447, 1448, 1449, 1441, 475, 954, 3011, 933,
-	955, 689, 3004, 1451, 722, 1463, 1332, 1353, 1464, 2439,
-	3303, 1289, 1226, 1356, 1466, 458, 1236, 1880, 1879, 1994,
-	1286, 1473, 1474, 1290, 1242, 1241, 1252, 3300, 957, 3244,
-	3239, 3240, 1318, 1319, 1253, 3282, 1992, 1093, 1094, 1272,
-	3012, 1275, 1276, 3277, 1999, 2949, 2950, 1495, 1997, 2372,
-	2373, 1499, 688, 1887, 1502, 1334, 691, 690, 969, 1371,
-	969, 2681, 1101, 969, 1936, 453, 2074, 1521, 1218, 1525,
-	1526, 3011, 1528, 1529, 474, 3271, 1888, 1889, 470, 1551,
-	453, 472, 1309, 1218, 1331, 473, 771, 730, 1328, 471,
-	1549, 1327, 1491, 1314, 934, 1218, 3270, 2144, 2618, 3301,
-	673, 1186, 1368, 953, 1310, 1456, 469, 1787, 1494, 2648,
-	2677, 971, 972, 973, 970, 3278, 2381, 1330, 1329, 3248,
-	1326, 2768, 676, 3222, 1322, 1572, 1865, 1347, 1352, 719,
-	2017, 1350, 1321, 1578, 1578, 3265, 1186, 771, 1186, 1186,
-	1837, 958, 453, 1836, 1521, 1521, 2162, 1787, 1218, 1645,
-	1646, 1658, 1338, 1439, 1440, 1520, 1443, 2531, 2656, 1576,
-	1527, 1390, 781, 3179, 1458, 441, 956, 1218, 1787, 773,
-	807, 2371, 772, 2300, 807, 1551, 3173, 1465, 1935, 1467,
-	3125, 2593, 1495, 1397, 1398, 3064, 3224, 1495, 1495, 2075,
-	2075, 1787, 453, 1521, 1218, 3223, 1700, 3063, 453, 453,
-	1704, 1705, 825, 830, 831, 2249, 1708, 1709, 1990, 1993,
-	3005, 1715, 1442, 3059, 3081, 969, 2075, 1596, 182, 2299,
-	773, 182, 182, 772, 182, 3058, 2246, 2149, 932, 2106,
-	1516, 1723, 1640, 1641, 1726, 3180, 676, 1729, 1778, 1468,
-	1731, 3057, 1659, 1531, 1978, 1533, 1534, 1535, 3025, 1686,
-	1687, 3056, 3025, 1672, 1870, 1498, 1561, 3065, 1563, 1564,
-	1457, 1457, 1757, 1492, 898, 969, 3024, 1457, 1457, 1959,
-	1841, 1569, 1764, 2916, 904, 1769, 2840, 1664, 1679, 1666,
-	1579, 1697, 1713, 3005, 1699, 3025, 1689, 3006, 1724, 1684,
-	1685, 1727, 1728, 1142, 1730, 925, 1549, 3025, 1702, 1703,
-	1218, 1776, 2665, 1580, 2596, 1524, 2433, 2594, 1545, 1557,
-	1558, 2222, 2681, 3025, 1141, 2214, 1552, 1553, 1570, 1546,
-	1541, 2176, 1126, 3025, 1556, 1581, 1567, 1568, 1566, 1582,
-	1583, 1562, 1554, 1402, 2160, 1869, 1204, 1994, 3025, 1145,
-	2153, 2790, 1989, 1983, 1988, 2917, 1986, 1991, 2106, 1444,
-	931, 1770, 2619, 1339, 1565, 2458, 2751, 2300, 1758, 1799,
-	2382, 2384, 2385, 2386, 2383, 1803, 1804, 1816, 2151, 1571,
-	1644, 1647, 1574, 1575, 2666, 1752, 2597, 1809, 2075, 1071,
-	1813, 1673, 1752, 969, 1663, 1524, 1665, 969, 2302, 2165,
-	827, 828, 829, 969, 1430, 2164, 971, 972, 973, 970,
-	475, 1992, 558, 568, 2156, 1694, 1959, 1975, 1698, 1143,
-	1832, 559, 2147, 567, 560, 564, 563, 561, 562, 1823,
-	1711, 971, 972, 973, 970, 1721, 932, 1830, 1719, 2146,
-	899, 1350, 2139, 2137, 1817, 2135, 804, 1842, 2133, 1844,
-	2152, 806, 1815, 804, 1768, 1843, 1851, 1738, 806, 1958,
-	1848, 1849, 1850, 1866, 1718, 1853, 1854, 1855, 1856, 1857,
-	1858, 1859, 1860, 1847, 1515, 1311, 565, 1868, 2976, 1015,
-	1846, 1835, 1826, 527, 1759, 906, 1928, 453, 474, 1761,
-	1767, 1825, 470, 919, 899, 472, 1824, 894, 1762, 473,
-	1763, 934, 453, 471, 453, 453, 453, 1766, 566, 1771,
-	2125, 2147, 892, 2838, 2140, 2138, 1956, 2134, 1688, 986,
-	2134, 1786, 1315, 2086, 2614, 2623, 1963, 1186, 1113, 2496,
-	3104, 1959, 3257, 1807, 3245, 1865, 1960, 1968, 804, 2015,
-	1446, 1445, 1142, 806, 1181, 969, 1183, 1798, 1187, 1188,
-	1189, 1186, 969, 969, 969, 2930, 1800, 1131, 2782, 1426,
-	3023, 1132, 2706, 969, 2010, 1423, 1811, 1390, 969, 1425,
-	1422, 1424, 1428, 1429, 3105, 1206, 2192, 1427, 1231, 1232,
-	1233, 1234, 1235, 807, 1237, 1238, 1239, 1240, 1801, 1802,
-	807, 1245, 1246, 1787, 1316, 899, 2183, 2780, 1182, 2931,
-	2615, 1895, 2783, 2946, 891, 887, 888, 889, 890, 2016,
-	2197, 2945, 2196, 2195, 2193, 989, 990, 991, 992, 993,
-	986, 2757, 1224, 687, 2079, 2079, 1658, 2079, 1930, 985,
-	984, 994, 995, 987, 988, 989, 990, 991, 992, 993,
-	986, 2781, 2682, 1208, 2616, 441, 441, 2675, 1839, 1495,
-	1479, 1495, 2670, 906, 1209, 1861, 1863, 1864, 1143, 1218,
-	453, 987, 988, 989, 990, 991, 992, 993, 986, 1495,
-	1495, 1947, 453, 1881, 1205, 807, 2194, 906, 448, 1446,
-	1445, 2667, 1251, 1937, 1658, 2588, 2403, 2114, 2100, 2116,
-	2271, 2242, 1972, 182, 1977, 1973, 1250, 994, 995, 987,
-	988, 989, 990, 991, 992, 993, 986, 1038, 2150, 2083,
-	2096, 2092, 2081, 2093, 2085, 1122, 1121, 908, 1964, 1433,
-	1434, 1435, 1436, 1437, 1438, 1431, 1432, 2119, 1722, 1405,
-	1396, 2097, 2098, 2460, 1995, 1996, 1982, 2001, 1981, 2158,
-	1976, 1405, 1776, 1812, 1974, 692, 1393, 1395, 1392, 1218,
-	1394, 1218, 1971, 1218, 1256, 1519, 1722, 3143, 906, 971,
-	972, 973, 970, 2120, 970, 1145, 2958, 2113, 2957, 1039,
-	2709, 2477, 2108, 1965, 1966, 971, 972, 973, 970, 2343,
-	2168, 973, 970, 1969, 1970, 2342, 2129, 1218, 2201, 1472,
-	1198, 1200, 2325, 1895, 2058, 2323, 2937, 2184, 971, 972,
-	973, 970, 804, 2208, 2087, 2202, 2203, 806, 1218, 2198,
-	2199, 2900, 2901, 2205, 2206, 1017, 1216, 3286, 2200, 2210,
-	971, 972, 973, 970, 2270, 3274, 2211, 2101, 1016, 2707,
-	971, 972, 973, 970, 2179, 1461, 3230, 1216, 1256, 2209,
-	3225, 3163, 3260, 2109, 2112, 3134, 3106, 2110, 3049, 1462,
-	1495, 3045, 2895, 2235, 2236, 1502, 2212, 906, 985, 984,
-	994, 995, 987, 988, 989, 990, 991, 992, 993, 986,
-	2989, 2933, 2932, 2021, 2172, 2023, 2024, 2025, 2026, 2027,
-	2028, 2029, 2169, 2784, 2032, 2033, 2034, 2035, 2036, 2037,
-	2038, 2039, 2040, 2041, 2042, 2174, 2044, 2045, 2046, 2047,
-	2048, 2181, 2049, 3259, 2187, 2155, 1218, 2161, 2896, 2268,
-	2159, 2233, 2166, 2748, 2749, 1521, 2284, 2580, 453, 971,
-	972, 973, 970, 2492, 2298, 2393, 2111, 1518, 2185, 2472,
-	2304, 971, 972, 973, 970, 2118, 2177, 2178, 2471, 807,
-	2121, 2391, 1536, 2524, 2376, 2375, 2313, 2191, 2374, 2366,
-	2360, 906, 2359, 2358, 3275, 971, 972, 973, 970, 2322,
-	2357, 1741, 1740, 3164, 1257, 1739, 906, 906, 906, 1578,
-	2750, 1735, 906, 2247, 2333, 2334, 2335, 906, 1734, 2339,
-	2340, 2392, 2341, 1312, 2389, 2250, 1350, 2378, 2280, 971,
-	972, 973, 970, 1088, 2217, 2218, 2234, 2390, 2237, 2756,
-	2223, 2180, 2523, 2281, 1584, 2079, 1701, 985, 984, 994,
-	995, 987, 988, 989, 990, 991, 992, 993, 986, 2394,
-	1281, 1282, 2305, 1596, 2408, 2566, 3195, 441, 971, 972,
-	973, 970, 1521, 906, 1658, 1658, 1658, 1658, 2871, 2251,
-	2388, 3191, 2295, 2377, 522, 906, 1658, 524, 3147, 2079,
-	3092, 2320, 523, 2904, 1696, 2320, 2316, 1289, 1828, 3068,
-	1696, 1696, 2266, 3053, 3048, 1218, 1286, 3047, 3019, 1290,
-	2990, 2327, 2939, 2913, 2297, 2899, 2303, 453, 453, 3096,
-	8, 2869, 7, 2328, 2329, 2867, 2847, 2844, 2332, 1814,
-	2842, 2398, 182, 2338, 2747, 1276, 2312, 182, 2315, 2746,
-	2743, 2733, 2318, 2676, 2324, 971, 972, 973, 970, 2207,
-	2464, 2877, 2466, 2672, 2663, 2331, 3124, 2429, 1457, 2662,
-	1457, 2589, 2557, 2487, 1827, 2556, 2321, 2491, 2555, 3299,
-	1495, 1876, 2503, 1218, 2470, 1495, 2498, 971, 972, 973,
-	970, 2368, 3112, 1524, 2447, 2387, 2379, 2306, 2369, 2416,
-	971, 972, 973, 970, 2367, 2310, 2311, 2308, 2363, 2362,
-	2361, 2416, 2461, 971, 972, 973, 970, 2465, 2399, 1867,
-	2508, 2404, 2402, 618, 617, 2417, 2418, 2419, 2420, 1743,
-	2430, 2876, 1737, 2307, 2428, 2432, 2431, 2309, 1505, 1504,
-	673, 1494, 2528, 1313, 2445, 1046, 2486, 1042, 1041, 895,
-	2448, 971, 972, 973, 970, 2257, 684, 971, 972, 973,
-	970, 3108, 2484, 2517, 3002, 2519, 2920, 2777, 2490, 2459,
-	906, 2776, 2463, 2495, 2462, 2775, 2767, 2732, 2569, 2721,
-	2441, 2712, 2500, 2483, 1872, 1873, 2711, 2480, 2584, 2830,
-	2700, 2482, 2478, 453, 2494, 2699, 2485, 2624, 2489, 977,
-	978, 979, 980, 981, 982, 983, 975, 906, 2529, 2522,
-	2514, 906, 906, 906, 2509, 971, 972, 973, 970, 2455,
-	1658, 1956, 2507, 2622, 2513, 2248, 2245, 2136, 2506, 2626,
-	3122, 2132, 2131, 2520, 2521, 570, 115, 1852, 2349, 2350,
-	2636, 115, 2639, 2592, 2639, 2639, 1845, 1840, 1838, 906,
-	1834, 1820, 2364, 2365, 1833, 2518, 971, 972, 973, 970,
-	1831, 2643, 1822, 2658, 2551, 1819, 1818, 1742, 1490, 1489,
-	1218, 1218, 1488, 2280, 2655, 2554, 2400, 2558, 2561, 1460,
-	1459, 165, 2657, 157, 133, 1450, 1895, 2559, 1230, 1929,
-	1228, 459, 3256, 3250, 115, 165, 2585, 2564, 3237, 1216,
-	1216, 2659, 2660, 2620, 1946, 2591, 1948, 1949, 1950, 3234,
-	3232, 2534, 2535, 3133, 1036, 3066, 453, 2536, 2537, 2538,
-	2539, 2569, 2540, 2541, 2542, 2543, 2544, 2545, 2546, 2547,
-	2644, 2635, 1521, 1521, 2609, 2610, 2611, 3055, 2621, 1967,
-	2515, 2516, 2617, 971, 972, 973, 970, 3050, 2640, 2641,
-	162, 1271, 2971, 2956, 2645, 2730, 2952, 2634, 2855, 2630,
-	1469, 1470, 1471, 2527, 162, 1475, 1476, 1477, 1478, 1480,
-	1481, 1482, 1483, 1484, 1485, 1486, 1487, 2853, 2828, 2201,
-	807, 971, 972, 973, 970, 2827, 2824, 807, 2710, 971,
-	972, 973, 970, 2823, 2759, 2608, 2607, 1280, 805, 1273,
-	1129, 2395, 115, 984, 994, 995, 987, 988, 989, 990,
-	991, 992, 993, 986, 2642, 2326, 2664, 115, 2292, 115,
-	2669, 2526, 2291, 2668, 2674, 453, 2673, 2290, 1285, 2678,
-	2679, 1288, 1277, 2232, 2145, 2095, 2689, 677, 678, 679,
-	680, 2671, 676, 2050, 1957, 2629, 1945, 971, 972, 973,
-	970, 2693, 2525, 2696, 2697, 2698, 2231, 1391, 162, 2729,
-	3120, 2230, 1224, 1706, 3118, 2229, 2731, 1514, 1513, 1335,
-	2705, 2228, 2625, 1301, 1696, 1278, 2627, 2628, 971, 972,
-	973, 970, 971, 972, 973, 970, 807, 971, 972, 973,
-	970, 971, 972, 973, 970, 2722, 2227, 971, 972, 973,
-	970, 1072, 2723, 2724, 2226, 1069, 2598, 2599, 2725, 1068,
-	1067, 1066, 2734, 1229, 2825, 2225, 2763, 1065, 2284, 1064,
-	1063, 1062, 971, 972, 973, 970, 1061, 1060, 2772, 906,
-	971, 972, 973, 970, 1059, 1058, 1057, 2739, 2079, 1658,
-	2787, 971, 972, 973, 970, 1056, 1055, 1054, 807, 1053,
-	1052, 1051, 1050, 1049, 906, 1045, 1962, 2224, 2736, 1044,
-	1043, 1040, 1033, 2636, 3211, 2221, 1032, 906, 1030, 1029,
-	1028, 1027, 1026, 2765, 2766, 1025, 1024, 906, 1023, 1022,
-	2680, 1021, 1218, 971, 972, 973, 970, 1020, 1019, 1014,
-	2754, 971, 972, 973, 970, 2692, 1013, 936, 1521, 893,
-	2738, 1942, 906, 2764, 3209, 2789, 1495, 2220, 2740, 2685,
-	2686, 1216, 924, 2839, 3169, 2688, 2272, 1495, 2107, 2822,
-	2852, 1745, 2786, 2854, 935, 2785, 2691, 2690, 182, 2815,
-	2422, 2860, 2219, 971, 972, 973, 970, 2859, 2425, 2857,
-	2423, 906, 2421, 2426, 2849, 2424, 2858, 3285, 2831, 2834,
-	2154, 2769, 2774, 2427, 2829, 2071, 2072, 2837, 971, 972,
-	973, 970, 2148, 2885, 2587, 2841, 1123, 100, 2845, 2843,
-	2793, 54, 2846, 1543, 1544, 2850, 2552, 2553, 2851, 2213,
-	906, 1218, 1218, 2832, 53, 2632, 2848, 2633, 2204, 906,
-	1538, 1539, 1540, 2338, 450, 2856, 2239, 2923, 2862, 2923,
-	2019, 2835, 115, 115, 805, 971, 972, 973, 970, 2182,
-	1216, 2911, 2909, 2872, 971, 972, 973, 970, 2416, 1632,
-	2289, 2562, 1265, 455, 1218, 2886, 2296, 456, 822, 2938,
-	2888, 2143, 1401, 2914, 2173, 971, 972, 973, 970, 1074,
-	457, 1931, 453, 2345, 906, 906, 1295, 454, 906, 906,
-	2346, 2347, 2348, 2911, 1872, 1873, 2915, 2416, 971, 972,
-	973, 970, 2926, 2974, 2927, 1707, 2062, 930, 3088, 2973,
-	2789, 2968, 2606, 2563, 1549, 1002, 2981, 2314, 2960, 2961,
-	2822, 2940, 2969, 2970, 2985, 2986, 2788, 2936, 2252, 1952,
-	2815, 1547, 2791, 1512, 3182, 2792, 2907, 807, 1446, 1445,
-	1086, 1087, 3052, 2067, 2070, 2071, 2072, 2068, 3015, 2069,
-	2073, 1084, 1085, 2661, 2760, 2761, 2762, 1082, 1083, 1080,
-	1081, 2059, 3008, 2977, 2055, 1643, 1180, 1179, 962, 2979,
-	2878, 2978, 2695, 2105, 1765, 1134, 1076, 3251, 2772, 3155,
-	807, 3140, 3138, 3027, 3035, 3099, 3078, 3077, 3075, 3067,
-	2984, 3003, 2983, 3007, 2868, 3013, 2735, 2719, 2718, 2703,
-	2907, 2907, 2006, 1079, 2907, 2907, 2702, 817, 813, 808,
-	812, 815, 2457, 1551, 2493, 3026, 3213, 3212, 3212, 2450,
-	2451, 1944, 1821, 921, 3028, 3213, 3031, 3036, 2954, 3038,
-	2720, 3037, 906, 3029, 3030, 820, 1218, 2449, 3046, 811,
-	2067, 2070, 2071, 2072, 2068, 3254, 2069, 2073, 676, 1149,
-	997, 1078, 1001, 3051, 677, 678, 679, 680, 62, 676,
-	169, 3, 2, 1195, 2088, 1216, 3060, 3061, 998, 1000,
-	996, 1690, 999, 985, 984, 994, 995, 987, 988, 989,
-	990, 991, 992, 993, 986, 3083, 906, 1018, 1222, 1,
-	818, 2934, 2935, 1503, 3074, 3072, 3100, 821, 985, 984,
-	994, 995, 987, 988, 989, 990, 991, 992, 993, 986,
-	3095, 681, 2434, 2435, 809, 2694, 906, 2437, 1783, 3091,
-	2405, 3094, 2053, 1218, 1932, 3109, 3127, 3130, 2583, 1124,
-	723, 1452, 3102, 1320, 2918, 2919, 824, 819, 915, 3110,
-	1317, 914, 3117, 3119, 3121, 3123, 912, 1403, 2907, 3131,
-	3116, 572, 1216, 1748, 3126, 2396, 2370, 3139, 2980, 3141,
-	3142, 3137, 1218, 3181, 3135, 3219, 3132, 3184, 3107, 1333,
-	556, 3069, 3165, 2994, 3136, 2996, 2874, 810, 1227, 1788,
-	3154, 967, 2479, 459, 745, 2590, 609, 583, 1031, 1303,
-	1296, 1216, 2532, 3162, 3188, 826, 582, 2753, 2262, 2452,
-	2975, 3039, 2907, 3174, 823, 3175, 3187, 3176, 746, 3177,
-	115, 1732, 3178, 2992, 1266, 1270, 906, 2928, 2778, 2612,
-	2293, 3295, 3192, 3284, 3083, 3266, 3193, 3249, 3160, 3280,
-	3199, 3235, 2907, 2881, 2879, 2880, 3228, 3156, 493, 1669,
-	439, 3218, 788, 3207, 2972, 1744, 3210, 3208, 3202, 3204,
-	494, 1961, 816, 3148, 3221, 3054, 703, 3226, 1941, 906,
-	3214, 3215, 3216, 3217, 704, 706, 2278, 3227, 2277, 1372,
-	976, 3231, 115, 3233, 1389, 2548, 115, 2549, 1011, 532,
-	1810, 2259, 2810, 2446, 3188, 3247, 61, 115, 60, 814,
-	3243, 59, 58, 906, 1714, 906, 3187, 115, 865, 3246,
-	190, 3253, 574, 3255, 189, 2902, 3129, 3258, 1696, 3186,
-	554, 553, 552, 551, 550, 2066, 3221, 906, 3262, 2064,
-	3264, 2063, 3269, 1653, 1652, 3273, 3276, 1712, 2336, 3279,
-	2330, 2002, 3197, 3252, 2007, 1585, 3166, 3113, 3062, 3114,
-	2951, 2380, 1537, 1998, 3283, 1602, 3290, 2352, 1599, 1598,
-	3294, 2344, 3293, 2947, 2941, 1629, 2282, 2770, 3302, 2922,
-	2794, 2795, 2801, 1951, 3290, 3307, 3306, 3305, 2530, 3294,
-	849, 845, 3308, 847, 848, 1356, 846, 2190, 2186, 1979,
-	2601, 1886, 1885, 1883, 865, 1882, 985, 984, 994, 995,
-	987, 988, 989, 990, 991, 992, 993, 986, 3101, 1096,
-	3014, 2737, 1893, 1891, 2687, 2683, 1756, 1500, 2238, 1356,
-	1654, 1356, 1650, 1943, 3111, 2889, 1542, 2727, 853, 696,
-	1939, 985, 984, 994, 995, 987, 988, 989, 990, 991,
-	992, 993, 986, 1356, 98, 147, 48, 89, 873, 877,
-	879, 881, 883, 884, 886, 88, 891, 887, 888, 889,
-	890, 97, 868, 869, 870, 871, 851, 852, 874, 145,
-	854, 47, 855, 856, 857, 858, 859, 860, 861, 862,
-	863, 864, 866, 872, 174, 173, 176, 175, 172, 2122,
-	2123, 876, 878, 880, 882, 885, 985, 984, 994, 995,
-	987, 988, 989, 990, 991, 992, 993, 986, 171, 1254,
-	170, 2925, 670, 38, 853, 37, 33, 12, 843, 11,
-	34, 3194, 21, 22, 20, 1324, 19, 25, 867, 31,
-	30, 108, 107, 29, 873, 877, 879, 881, 883, 884,
-	886, 106, 891, 887, 888, 889, 890, 105, 868, 869,
-	870, 871, 851, 852, 874, 104, 854, 103, 855, 856,
-	857, 858, 859, 860, 861, 862, 863, 864, 866, 872,
-	1808, 102, 28, 18, 42, 41, 1657, 876, 878, 880,
-	882, 885, 40, 9, 96, 94, 27, 95, 92, 93,
-	90, 73, 72, 71, 985, 984, 994, 995, 987, 988,
-	989, 990, 991, 992, 993, 986, 86, 85, 84, 83,
-	82, 81, 79, 80, 867, 744, 70, 69, 68, 67,
-	66, 91, 77, 87, 78, 76, 75, 74, 65, 64,
-	63, 131, 130, 128, 129, 127, 126, 125, 124, 123,
-	122, 43, 44, 115, 45, 46, 115, 115, 141, 115,
-	140, 142, 144, 146, 143, 138, 136, 139, 137, 135,
-	56, 2188, 2189, 17, 24, 4, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 590, 0, 0, 0, 805, 0, 0, 0, 0,
-	333, 0, 805, 0, 0, 0, 0, 0, 0, 0,
-	0, 115, 0, 546, 0, 0, 0, 278, 0, 0,
-	303, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 434, 0, 435, 0, 0, 581, 0,
-	0, 362, 317, 0, 0, 0, 0, 641, 649, 0,
-	0, 0, 0, 0, 2959, 0, 0, 0, 0, 539,
-	0, 0, 571, 618, 617, 558, 568, 0, 0, 255,
-	188, 436, 0, 437, 559, 0, 567, 560, 564, 563,
-	561, 562, 0, 633, 0, 0, 0, 1002, 0, 0,
-	530, 543, 0, 547, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 875, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 540, 541, 0,
-	0, 0, 0, 591, 0, 542, 0, 0, 586, 565,
-	569, 0, 0, 0, 0, 246, 367, 383, 256, 358,
-	396, 261, 365, 251, 332, 355, 0, 0, 248, 381,
-	364, 314, 297, 298, 247, 0, 350, 276, 289, 273,
-	330, 566, 589, 593, 272, 655, 587, 391, 250, 0,
-	390, 329, 377, 382, 315, 309, 249, 379, 313, 308,
-	301, 280, 656, 293, 341, 307, 342, 294, 319, 318,
-	320, 0, 0, 875, 0, 0, 419, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	584, 0, 0, 0, 393, 0, 0, 639, 0, 0,
-	0, 366, 0, 0, 302, 0, 0, 0, 588, 0,
-	353, 335, 652, 531, 0, 351, 305, 378, 343, 384,
-	368, 392, 347, 344, 241, 369, 275, 316, 252, 254,
-	270, 277, 279, 281, 282, 325, 326, 338, 357, 370,
-	371, 372, 274, 262, 352, 263, 291, 264, 242, 267,
-	266, 268, 359, 269, 244, 339, 376, 0, 287, 348,
-	312, 245, 311, 340, 375, 374, 253, 400, 406, 407,
-	0, 0, 412, 0, 0, 0, 420, 425, 426, 427,
-	429, 430, 431, 432, 0, 0, 0, 0, 414, 0,
-	0, 0, 1454, 1453, 1455, 405, 285, 238, 239, 445,
-	637, 331, 0, 0, 0, 0, 651, 632, 634, 635,
-	638, 642, 643, 644, 645, 646, 648, 650, 654, 444,
-	0, 0, 0, 0, 0, 443, 337, 0, 356, 0,
-	0, 2082, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 363, 386, 398, 415, 418, 0, 0, 0, 0,
-	243, 417, 0, 0, 0, 0, 0, 0, 0, 0,
-	653, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	592, 0, 0, 321, 322, 323, 324, 640, 0, 260,
-	416, 346, 0, 0, 0, 0, 0, 0, 0, 1657,
-	0, 0, 0, 0, 0, 0, 0, 0, 115, 410,
-	411, 284, 290, 428, 292, 259, 336, 286, 395, 299,
-	0, 421, 0, 422, 0, 0, 0, 0, 328, 295,
-	296, 360, 300, 306, 349, 394, 334, 354, 257, 385,
-	361, 310, 0, 0, 662, 636, 661, 663, 664, 660,
-	665, 666, 647, 549, 0, 596, 658, 657, 659, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 373, 0, 237, 265, 0, 240, 0, 304, 0,
-	345, 283, 0, 0, 625, 602, 603, 604, 548, 605,
-	599, 600, 601, 626, 594, 622, 623, 573, 597, 606,
-	621, 607, 624, 627, 628, 667, 668, 613, 669, 610,
-	629, 620, 619, 608, 595, 630, 631, 580, 575, 611,
-	612, 598, 614, 615, 616, 576, 577, 578, 579, 0,
-	0, 0, 401, 402, 403, 424, 387, 0, 442, 165,
-	51, 157, 133, 0, 0, 0, 0, 0, 0, 0,
-	446, 438, 0, 165, 51, 157, 133, 158, 0, 0,
-	0, 0, 0, 865, 150, 0, 0, 0, 159, 0,
-	0, 158, 0, 0, 0, 0, 0, 0, 150, 0,
-	0, 0, 159, 0, 0, 0, 0, 113, 0, 0,
-	0, 504, 503, 510, 500, 0, 0, 0, 0, 0,
-	0, 113, 101, 507, 508, 0, 509, 513, 162, 0,
-	495, 0, 0, 0, 0, 0, 101, 0, 0, 0,
-	518, 0, 162, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 115, 0, 0, 0, 0, 504, 503,
-	510, 500, 0, 0, 0, 0, 0, 0, 0, 0,
-	507, 508, 0, 509, 513, 0, 0, 495, 0, 0,
-	0, 0, 0, 522, 0, 0, 524, 518, 0, 0,
-	0, 523, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 853, 118, 119, 0, 120, 121, 0,
-	971, 972, 973, 970, 0, 0, 0, 0, 118, 119,
-	0, 120, 121, 873, 877, 879, 881, 883, 884, 886,
-	0, 891, 887, 888, 889, 890, 0, 868, 869, 870,
-	871, 851, 852, 874, 0, 854, 0, 855, 856, 857,
-	858, 859, 860, 861, 862, 863, 864, 866, 872, 1657,
-	1657, 1657, 1657, 0, 0, 0, 876, 878, 880, 882,
-	885, 1657, 132, 156, 163, 0, 99, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 132, 156, 163, 1430,
-	99, 0, 0, 0, 155, 149, 148, 0, 0, 0,
-	0, 57, 0, 867, 0, 0, 0, 0, 155, 149,
-	148, 0, 0, 0, 0, 57, 0, 115, 0, 0,
-	0, 0, 115, 0, 496, 498, 497, 0, 0, 0,
-	0, 0, 0, 0, 502, 0, 0, 0, 0, 0,
-	0, 0, 115, 0, 0, 0, 506, 0, 0, 115,
-	0, 0, 0, 521, 0, 0, 0, 0, 0, 0,
-	499, 0, 151, 152, 153, 0, 0, 0, 0, 0,
-	0, 496, 498, 497, 0, 0, 151, 152, 153, 0,
-	0, 502, 0, 0, 0, 0, 0, 0, 160, 0,
-	0, 0, 0, 506, 0, 0, 0, 0, 0, 0,
-	521, 0, 160, 0, 0, 0, 0, 499, 109, 0,
-	0, 0, 154, 0, 110, 0, 0, 0, 0, 0,
-	0, 0, 109, 0, 0, 0, 154, 0, 110, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1426, 0, 0, 0, 115, 0,
-	1423, 0, 0, 0, 1425, 1422, 1424, 1428, 1429, 0,
-	0, 0, 1427, 0, 501, 505, 511, 0, 512, 514,
-	0, 111, 515, 516, 517, 0, 0, 519, 520, 0,
-	0, 0, 50, 0, 0, 111, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1657, 50, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	115, 501, 505, 511, 0, 512, 514, 0, 0, 515,
-	516, 517, 0, 0, 519, 520, 0, 0, 0, 0,
-	0, 52, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 52, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 134, 0,
-	0, 0, 875, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 134, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1411, 1412, 1413, 1414, 1415, 1416, 1417,
-	1418, 1419, 1420, 1421, 1433, 1434, 1435, 1436, 1437, 1438,
-	1431, 1432, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 112, 39, 0,
-	0, 0, 0, 0, 49, 5, 0, 0, 116, 117,
-	0, 112, 39, 0, 0, 0, 0, 0, 49, 0,
-	0, 0, 116, 117, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 590, 0,
-	0, 0, 0, 0, 0, 0, 0, 333, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	546, 0, 0, 0, 278, 1496, 0, 303, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	434, 0, 435, 0, 0, 581, 0, 0, 362, 317,
-	0, 0, 0, 0, 641, 649, 0, 0, 0, 0,
-	0, 0, 0, 1681, 0, 0, 539, 0, 0, 571,
-	618, 617, 558, 568, 0, 0, 255, 188, 436, 115,
-	437, 559, 0, 567, 560, 564, 563, 561, 562, 0,
-	633, 0, 0, 0, 0, 0, 0, 530, 543, 0,
-	547, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 115, 0, 540, 541, 0, 0, 0, 0,
-	591, 0, 542, 0, 1657, 1682, 565, 569, 0, 0,
-	0, 0, 246, 367, 383, 256, 358, 396, 261, 365,
-	251, 332, 355, 0, 0, 248, 381, 364, 314, 297,
-	298, 247, 0, 350, 276, 289, 273, 330, 566, 589,
-	593, 272, 655, 587, 391, 250, 0, 390, 329, 377,
-	382, 315, 309, 249, 379, 313, 308, 301, 280, 656,
-	293, 341, 307, 342, 294, 319, 318, 320, 0, 0,
-	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 584, 0, 0,
-	0, 393, 0, 115, 639, 0, 0, 0, 366, 0,
-	0, 302, 0, 0, 0, 588, 0, 353, 335, 652,
-	531, 0, 351, 305, 378, 343, 384, 368, 392, 347,
-	344, 241, 369, 275, 316, 252, 254, 270, 277, 279,
-	281, 282, 325, 326, 338, 357, 370, 371, 372, 274,
-	262, 352, 263, 291, 264, 242, 267, 266, 268, 359,
-	269, 244, 339, 376, 0, 287, 348, 312, 245, 311,
-	340, 375, 374, 253, 400, 406, 407, 0, 0, 412,
-	0, 0, 0, 420, 425, 426, 427, 429, 430, 431,
-	432, 0, 0, 0, 0, 414, 0, 0, 0, 0,
-	0, 0, 405, 285, 238, 239, 445, 637, 331, 0,
-	0, 0, 0, 651, 632, 634, 635, 638, 642, 643,
-	644, 645, 646, 648, 650, 654, 444, 0, 0, 0,
-	0, 0, 443, 337, 0, 356, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 363, 386,
-	398, 415, 418, 0, 0, 0, 0, 243, 417, 0,
-	0, 0, 0, 0, 0, 0, 0, 653, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 592, 0, 0,
-	321, 322, 323, 324, 640, 0, 260, 416, 346, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 410, 411, 284, 290,
-	428, 292, 259, 336, 286, 395, 299, 0, 421, 0,
-	422, 0, 0, 0, 0, 328, 295, 296, 360, 300,
-	306, 349, 394, 334, 354, 257, 385, 361, 310, 0,
-	0, 662, 636, 661, 663, 664, 660, 665, 666, 647,
-	549, 0, 596, 658, 657, 659, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 373, 0,
-	237, 265, 0, 240, 0, 304, 0, 345, 283, 0,
-	0, 625, 602, 603, 604, 548, 605, 599, 600, 601,
-	626, 594, 622, 623, 573, 597, 606, 621, 607, 624,
-	627, 628, 667, 668, 613, 669, 610, 629, 620, 619,
-	608, 595, 630, 631, 580, 575, 611, 612, 598, 614,
-	615, 616, 576, 577, 578, 579, 165, 590, 0, 401,
-	402, 403, 424, 387, 0, 442, 333, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 446, 438, 546,
-	0, 0, 0, 278, 0, 0, 303, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 434,
-	0, 435, 0, 0, 1005, 0, 0, 362, 317, 0,
-	0, 0, 0, 641, 649, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 539, 0, 0, 571, 618,
-	617, 558, 568, 0, 0, 255, 188, 436, 0, 437,
-	559, 0, 567, 560, 564, 563, 561, 562, 0, 633,
-	0, 0, 0, 0, 0, 0, 530, 543, 0, 547,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 540, 541, 0, 0, 0, 0, 591,
-	0, 542, 0, 0, 586, 565, 569, 0, 0, 0,
-	0, 246, 367, 383, 256, 358, 396, 261, 365, 251,
-	332, 355, 0, 0, 248, 381, 364, 314, 297, 298,
-	247, 0, 350, 276, 289, 273, 330, 566, 589, 593,
-	272, 655, 587, 391, 250, 0, 390, 329, 377, 382,
-	315, 309, 249, 379, 313, 308, 301, 280, 656, 293,
-	341, 307, 342, 294, 319, 318, 320, 0, 0, 0,
-	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 584, 0, 0, 0,
-	393, 0, 0, 639, 0, 0, 0, 366, 0, 0,
-	302, 0, 0, 0, 588, 0, 353, 335, 652, 531,
-	0, 351, 305, 378, 343, 384, 368, 392, 347, 344,
-	241, 369, 275, 316, 252, 254, 270, 277, 279, 281,
-	282, 325, 326, 338, 357, 370, 371, 372, 274, 262,
-	352, 263, 291, 264, 242, 267, 266, 268, 359, 269,
-	244, 339, 376, 0, 287, 348, 312, 245, 311, 340,
-	375, 374, 253, 400, 406, 407, 0, 0, 412, 0,
-	0, 0, 420, 425, 426, 427, 429, 430, 431, 432,
-	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
-	0, 405, 285, 238, 239, 445, 637, 331, 0, 0,
-	0, 0, 651, 632, 634, 635, 638, 642, 643, 644,
-	645, 646, 648, 650, 654, 444, 0, 0, 0, 0,
-	0, 443, 337, 0, 356, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 363, 386, 398,
-	415, 418, 0, 0, 0, 0, 243, 417, 0, 0,
-	0, 0, 0, 0, 0, 0, 653, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 592, 0, 0, 321,
-	322, 323, 324, 640, 0, 260, 416, 346, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 410, 411, 284, 290, 428,
-	292, 259, 336, 286, 395, 299, 0, 421, 0, 422,
-	0, 0, 0, 0, 328, 295, 296, 360, 300, 306,
-	349, 394, 334, 354, 257, 385, 361, 310, 0, 0,
-	662, 636, 661, 663, 664, 660, 665, 666, 647, 549,
-	0, 596, 658, 657, 659, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 373, 0, 237,
-	265, 0, 240, 0, 304, 134, 345, 283, 0, 0,
-	625, 602, 603, 604, 548, 605, 599, 600, 601, 626,
-	594, 622, 623, 573, 597, 606, 621, 607, 624, 627,
-	628, 667, 668, 613, 669, 610, 629, 620, 619, 608,
-	595, 630, 631, 580, 575, 611, 612, 598, 614, 615,
-	616, 576, 577, 578, 579, 0, 590, 0, 401, 402,
-	403, 424, 387, 0, 442, 333, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 446, 438, 546, 0,
-	0, 0, 278, 3263, 0, 303, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 434, 0,
-	435, 0, 0, 581, 0, 0, 362, 317, 0, 0,
-	0, 0, 641, 649, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 539, 0, 0, 571, 618, 617,
-	558, 568, 0, 0, 255, 188, 436, 0, 437, 559,
-	0, 567, 560, 564, 563, 561, 562, 0, 633, 0,
-	0, 0, 0, 0, 0, 530, 543, 0, 547, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 540, 541, 0, 0, 0, 0, 591, 0,
-	542, 0, 0, 586, 565, 569, 0, 0, 0, 0,
-	246, 367, 383, 256, 358, 396, 261, 365, 251, 332,
-	355, 0, 0, 248, 381, 364, 314, 297, 298, 247,
-	0, 350, 276, 289, 273, 330, 566, 589, 593, 272,
-	655, 587, 391, 250, 0, 390, 329, 377, 382, 315,
-	309, 249, 379, 313, 308, 301, 280, 656, 293, 341,
-	307, 342, 294, 319, 318, 320, 0, 0, 0, 0,
-	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 584, 0, 0, 0, 393,
-	0, 0, 639, 0, 0, 0, 366, 0, 0, 302,
-	0, 0, 0, 588, 0, 353, 335, 652, 531, 0,
-	351, 305, 378, 343, 384, 368, 392, 347, 344, 241,
-	369, 275, 316, 252, 254, 270, 277, 279, 281, 282,
-	325, 326, 338, 357, 370, 371, 372, 274, 262, 352,
-	263, 291, 264, 242, 267, 266, 268, 359, 269, 244,
-	339, 376, 0, 287, 348, 312, 245, 311, 340, 375,
-	374, 253, 400, 406, 407, 0, 0, 412, 0, 0,
-	0, 420, 425, 426, 427, 429, 430, 431, 432, 0,
-	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
-	405, 285, 238, 239, 445, 637, 331, 0, 0, 0,
-	0, 651, 632, 634, 635, 638, 642, 643, 644, 645,
-	646, 648, 650, 654, 444, 0, 0, 0, 0, 0,
-	443, 337, 0, 356, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 363, 386, 398, 415,
-	418, 0, 0, 0, 0, 243, 417, 0, 0, 0,
-	0, 0, 0, 0, 0, 653, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 592, 0, 0, 321, 322,
-	323, 324, 640, 0, 260, 416, 346, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 410, 411, 284, 290, 428, 292,
-	259, 336, 286, 395, 299, 0, 421, 0, 422, 0,
-	0, 0, 0, 328, 295, 296, 360, 300, 306, 349,
-	394, 334, 354, 257, 385, 361, 310, 0, 0, 662,
-	636, 661, 663, 664, 660, 665, 666, 647, 549, 0,
-	596, 658, 657, 659, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 373, 0, 237, 265,
-	0, 240, 0, 304, 0, 345, 283, 0, 0, 625,
-	602, 603, 604, 548, 605, 599, 600, 601, 626, 594,
-	622, 623, 573, 597, 606, 621, 607, 624, 627, 628,
-	667, 668, 613, 669, 610, 629, 620, 619, 608, 595,
-	630, 631, 580, 575, 611, 612, 598, 614, 615, 616,
-	576, 577, 578, 579, 0, 590, 0, 401, 402, 403,
-	424, 387, 0, 442, 333, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 446, 438, 546, 0, 0,
-	0, 278, 1496, 0, 303, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 434, 0, 435,
-	0, 0, 581, 0, 0, 362, 317, 0, 0, 0,
-	0, 641, 649, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 539, 0, 0, 571, 618, 617, 558,
-	568, 0, 0, 255, 188, 436, 0, 437, 559, 0,
-	567, 560, 564, 563, 561, 562, 0, 633, 0, 0,
-	0, 0, 0, 0, 530, 543, 0, 547, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 540, 541, 0, 0, 0, 0, 591, 0, 542,
-	0, 0, 586, 565, 569, 0, 0, 0, 0, 246,
-	367, 383, 256, 358, 396, 261, 365, 251, 332, 355,
-	0, 0, 248, 381, 364, 314, 297, 298, 247, 0,
-	350, 276, 289, 273, 330, 566, 589, 593, 272, 655,
-	587, 391, 250, 0, 390, 329, 377, 382, 315, 309,
-	249, 379, 313, 308, 301, 280, 656, 293, 341, 307,
-	342, 294, 319, 318, 320, 0, 0, 0, 0, 0,
-	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 584, 0, 0, 0, 393, 0,
-	0, 639, 0, 0, 0, 366, 0, 0, 302, 0,
-	0, 0, 588, 0, 353, 335, 652, 531, 0, 351,
-	305, 378, 343, 384, 368, 392, 347, 344, 241, 369,
-	275, 316, 252, 254, 270, 277, 279, 281, 282, 325,
-	326, 338, 357, 370, 371, 372, 274, 262, 352, 263,
-	291, 264, 242, 267, 266, 268, 359, 269, 244, 339,
-	376, 0, 287, 348, 312, 245, 311, 340, 375, 374,
-	253, 400, 406, 407, 0, 0, 412, 0, 0, 0,
-	420, 425, 426, 427, 429, 430, 431, 432, 0, 0,
-	0, 0, 414, 0, 0, 0, 0, 0, 0, 405,
-	285, 238, 239, 445, 637, 331, 0, 0, 0, 0,
-	651, 632, 634, 635, 638, 642, 643, 644, 645, 646,
-	648, 650, 654, 444, 0, 0, 0, 0, 0, 443,
-	337, 0, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 363, 386, 398, 415, 418,
-	0, 0, 0, 0, 243, 417, 0, 0, 0, 0,
-	0, 0, 0, 0, 653, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 592, 0, 0, 321, 322, 323,
-	324, 640, 0, 260, 416, 346, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 410, 411, 284, 290, 428, 292, 259,
-	336, 286, 395, 299, 0, 421, 0, 422, 0, 0,
-	0, 0, 328, 295, 296, 360, 300, 306, 349, 394,
-	334, 354, 257, 385, 361, 310, 0, 0, 662, 636,
-	661, 663, 664, 660, 665, 666, 647, 549, 0, 596,
-	658, 657, 659, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 373, 0, 237, 265, 0,
-	240, 0, 304, 0, 345, 283, 0, 0, 625, 602,
-	603, 604, 548, 605, 599, 600, 601, 626, 594, 622,
-	623, 573, 597, 606, 621, 607, 624, 627, 628, 667,
-	668, 613, 669, 610, 629, 620, 619, 608, 595, 630,
-	631, 580, 575, 611, 612, 598, 614, 615, 616, 576,
-	577, 578, 579, 0, 590, 0, 401, 402, 403, 424,
-	387, 0, 442, 333, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 446, 438, 546, 0, 0, 0,
-	278, 0, 0, 303, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 434, 0, 435, 0,
-	0, 581, 0, 0, 362, 317, 0, 0, 0, 0,
-	641, 649, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 539, 0, 0, 571, 618, 617, 558, 568,
-	0, 0, 255, 188, 436, 0, 437, 559, 0, 567,
-	560, 564, 563, 561, 562, 0, 633, 0, 0, 0,
-	0, 0, 0, 530, 543, 0, 547, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	540, 541, 1249, 0, 0, 0, 591, 0, 542, 0,
-	0, 586, 565, 569, 0, 0, 0, 0, 246, 367,
-	383, 256, 358, 396, 261, 365, 251, 332, 355, 0,
-	0, 248, 381, 364, 314, 297, 298, 247, 0, 350,
-	276, 289, 273, 330, 566, 589, 593, 272, 655, 587,
-	391, 250, 0, 390, 329, 377, 382, 315, 309, 249,
-	379, 313, 308, 301, 280, 656, 293, 341, 307, 342,
-	294, 319, 318, 320, 0, 0, 0, 0, 0, 419,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 584, 0, 0, 0, 393, 0, 0,
-	639, 0, 0, 0, 366, 0, 0, 302, 0, 0,
-	0, 588, 0, 353, 335, 652, 531, 0, 351, 305,
-	378, 343, 384, 368, 392, 347, 344, 241, 369, 275,
-	316, 252, 254, 270, 277, 279, 281, 282, 325, 326,
-	338, 357, 370, 371, 372, 274, 262, 352, 263, 291,
-	264, 242, 267, 266, 268, 359, 269, 244, 339, 376,
-	0, 287, 348, 312, 245, 311, 340, 375, 374, 253,
-	400, 406, 407, 0, 0, 412, 0, 0, 0, 420,
-	425, 426, 427, 429, 430, 431, 432, 0, 0, 0,
-	0, 414, 0, 0, 0, 0, 0, 0, 405, 285,
-	238, 239, 445, 637, 331, 0, 0, 0, 0, 651,
-	632, 634, 635, 638, 642, 643, 644, 645, 646, 648,
-	650, 654, 444, 0, 0, 0, 0, 0, 443, 337,
-	0, 356, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 363, 386, 398, 415, 418, 0,
-	0, 0, 0, 243, 417, 0, 0, 0, 0, 0,
-	0, 0, 0, 653, 0, 0, 0, 397, 0, 0,
-	0, 0, 0, 592, 0, 0, 321, 322, 323, 324,
-	640, 0, 260, 416, 346, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 410, 411, 284, 290, 428, 292, 259, 336,
-	286, 395, 299, 0, 421, 0, 422, 0, 0, 0,
-	0, 328, 295, 296, 360, 300, 306, 349, 394, 334,
-	354, 257, 385, 361, 310, 0, 0, 662, 636, 661,
-	663, 664, 660, 665, 666, 647, 549, 0, 596, 658,
-	657, 659, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 373, 0, 237, 265, 0, 240,
-	0, 304, 0, 345, 283, 0, 0, 625, 602, 603,
-	604, 548, 605, 599, 600, 601, 626, 594, 622, 623,
-	573, 597, 606, 621, 607, 624, 627, 628, 667, 668,
-	613, 669, 610, 629, 620, 619, 608, 595, 630, 631,
-	580, 575, 611, 612, 598, 614, 615, 616, 576, 577,
-	578, 579, 0, 0, 0, 401, 402, 403, 424, 387,
-	590, 442, 0, 1829, 0, 0, 0, 0, 0, 333,
-	0, 0, 0, 446, 438, 0, 0, 0, 0, 0,
-	0, 0, 546, 0, 0, 0, 278, 0, 0, 303,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 434, 0, 435, 0, 0, 581, 0, 0,
-	362, 317, 0, 0, 0, 0, 641, 649, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 539, 0,
-	0, 571, 618, 617, 558, 568, 0, 0, 255, 188,
-	436, 0, 437, 559, 0, 567, 560, 564, 563, 561,
-	562, 0, 633, 0, 0, 0, 0, 0, 0, 530,
-	543, 0, 547, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 540, 541, 0, 0,
-	0, 0, 591, 0, 542, 0, 0, 586, 565, 569,
-	0, 0, 0, 0, 246, 367, 383, 256, 358, 396,
-	261, 365, 251, 332, 355, 0, 0, 248, 381, 364,
-	314, 297, 298, 247, 0, 350, 276, 289, 273, 330,
-	566, 589, 593, 272, 655, 587, 391, 250, 0, 390,
-	329, 377, 382, 315, 309, 249, 379, 313, 308, 301,
-	280, 656, 293, 341, 307, 342, 294, 319, 318, 320,
-	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 584,
-	0, 0, 0, 393, 0, 0, 639, 0, 0, 0,
-	366, 0, 0, 302, 0, 0, 0, 588, 0, 353,
-	335, 652, 531, 0, 351, 305, 378, 343, 384, 368,
-	392, 347, 344, 241, 369, 275, 316, 252, 254, 270,
-	277, 279, 281, 282, 325, 326, 338, 357, 370, 371,
-	372, 274, 262, 352, 263, 291, 264, 242, 267, 266,
-	268, 359, 269, 244, 339, 376, 0, 287, 348, 312,
-	245, 311, 340, 375, 374, 253, 400, 406, 407, 0,
-	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
-	430, 431, 432, 0, 0, 0, 0, 414, 0, 0,
-	0, 0, 0, 0, 405, 285, 238, 239, 445, 637,
-	331, 0, 0, 0, 0, 651, 632, 634, 635, 638,
-	642, 643, 644, 645, 646, 648, 650, 654, 444, 0,
-	0, 0, 0, 0, 443, 337, 0, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	363, 386, 398, 415, 418, 0, 0, 0, 0, 243,
-	417, 0, 0, 0, 0, 0, 0, 0, 0, 653,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 592,
-	0, 0, 321, 322, 323, 324, 640, 0, 260, 416,
-	346, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 410, 411,
-	284, 290, 428, 292, 259, 336, 286, 395, 299, 0,
-	421, 0, 422, 0, 0, 0, 0, 328, 295, 296,
-	360, 300, 306, 349, 394, 334, 354, 257, 385, 361,
-	310, 0, 0, 662, 636, 661, 663, 664, 660, 665,
-	666, 647, 549, 0, 596, 658, 657, 659, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	373, 0, 237, 265, 0, 240, 0, 304, 0, 345,
-	283, 0, 0, 625, 602, 603, 604, 548, 605, 599,
-	600, 601, 626, 594, 622, 623, 573, 597, 606, 621,
-	607, 624, 627, 628, 667, 668, 613, 669, 610, 629,
-	620, 619, 608, 595, 630, 631, 580, 575, 611, 612,
-	598, 614, 615, 616, 576, 577, 578, 579, 0, 590,
-	0, 401, 402, 403, 424, 387, 0, 442, 333, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 446,
-	438, 546, 0, 0, 0, 278, 0, 0, 303, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 434, 0, 435, 0, 0, 581, 0, 0, 362,
-	317, 0, 0, 0, 0, 641, 649, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 539, 0, 0,
-	571, 618, 617, 558, 568, 0, 0, 255, 188, 436,
-	0, 437, 559, 0, 567, 560, 564, 563, 561, 562,
-	0, 633, 0, 0, 0, 0, 0, 0, 530, 543,
-	0, 547, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 540, 541, 0, 0, 0,
-	0, 591, 0, 542, 0, 0, 586, 565, 569, 0,
-	0, 0, 0, 246, 367, 383, 256, 358, 396, 261,
-	365, 251, 332, 355, 0, 0, 248, 381, 364, 314,
-	297, 298, 247, 0, 350, 276, 289, 273, 330, 566,
-	589, 593, 272, 655, 587, 391, 250, 0, 390, 329,
-	377, 382, 315, 309, 249, 379, 313, 308, 301, 280,
-	656, 293, 341, 307, 342, 294, 319, 318, 320, 0,
-	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 584, 0,
-	0, 0, 393, 0, 0, 639, 0, 0, 0, 366,
-	0, 0, 302, 0, 0, 0, 588, 0, 353, 335,
-	652, 531, 0, 351, 305, 378, 343, 384, 368, 392,
-	347, 344, 241, 369, 275, 316, 252, 254, 270, 277,
-	279, 281, 282, 325, 326, 338, 357, 370, 371, 372,
-	274, 262, 352, 263, 291, 264, 242, 267, 266, 268,
-	359, 269, 244, 339, 376, 0, 287, 348, 312, 245,
-	311, 340, 375, 374, 253, 400, 406, 407, 0, 0,
-	412, 0, 0, 0, 420, 425, 426, 427, 429, 430,
-	431, 432, 0, 0, 0, 0, 414, 0, 0, 0,
-	0, 0, 0, 405, 285, 238, 239, 445, 637, 331,
-	0, 0, 0, 0, 651, 632, 634, 635, 638, 642,
-	643, 644, 645, 646, 648, 650, 654, 444, 0, 0,
-	0, 0, 0, 443, 337, 0, 356, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
-	386, 398, 415, 418, 0, 0, 0, 0, 243, 417,
-	0, 0, 0, 0, 0, 0, 0, 0, 653, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 592, 0,
-	0, 321, 322, 323, 324, 640, 0, 260, 416, 346,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 410, 411, 284,
-	290, 428, 292, 259, 336, 286, 395, 299, 0, 421,
-	0, 422, 0, 0, 0, 0, 328, 295, 296, 360,
-	300, 306, 349, 394, 334, 354, 257, 385, 361, 310,
-	0, 0, 662, 636, 661, 663, 664, 660, 665, 666,
-	647, 549, 0, 596, 658, 657, 659, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 373,
-	0, 237, 265, 0, 240, 0, 304, 0, 345, 283,
-	0, 0, 625, 602, 603, 604, 548, 605, 599, 600,
-	601, 626, 594, 622, 623, 573, 597, 606, 621, 607,
-	624, 627, 628, 667, 668, 613, 669, 610, 629, 620,
-	619, 608, 595, 630, 631, 580, 575, 611, 612, 598,
-	614, 615, 616, 576, 577, 578, 579, 0, 590, 0,
-	401, 402, 403, 424, 387, 0, 442, 333, 0, 0,
-	0, 0, 0, 0, 0, 0, 1373, 0, 446, 438,
-	546, 0, 0, 0, 278, 0, 0, 303, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	434, 0, 435, 0, 0, 581, 0, 0, 362, 317,
-	0, 0, 0, 0, 641, 649, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 539, 0, 0, 571,
-	618, 617, 558, 568, 0, 0, 255, 188, 436, 0,
-	437, 559, 0, 567, 560, 564, 563, 561, 562, 0,
-	633, 0, 0, 0, 0, 0, 0, 0, 543, 0,
-	547, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 540, 541, 0, 0, 0, 0,
-	591, 0, 542, 0, 0, 586, 565, 569, 0, 0,
-	0, 0, 246, 367, 383, 256, 358, 396, 261, 365,
-	251, 332, 355, 0, 0, 248, 381, 364, 314, 297,
-	298, 247, 0, 350, 276, 289, 273, 330, 566, 589,
-	593, 272, 655, 587, 391, 250, 0, 390, 329, 377,
-	382, 315, 309, 249, 379, 313, 308, 301, 280, 656,
-	293, 341, 307, 342, 294, 319, 318, 320, 0, 0,
-	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 584, 0, 0,
-	0, 393, 0, 0, 639, 0, 0, 0, 366, 0,
-	0, 302, 0, 0, 0, 588, 0, 353, 335, 652,
-	0, 0, 351, 305, 378, 343, 384, 368, 392, 347,
-	344, 241, 369, 275, 316, 252, 254, 270, 277, 279,
-	281, 282, 325, 326, 338, 357, 370, 371, 372, 274,
-	262, 352, 263, 291, 264, 242, 267, 266, 268, 359,
-	269, 244, 339, 376, 0, 287, 348, 312, 245, 311,
-	340, 375, 374, 253, 400, 1374, 1375, 0, 0, 412,
-	0, 0, 0, 420, 425, 426, 427, 429, 430, 431,
-	432, 0, 0, 0, 0, 414, 0, 0, 0, 0,
-	0, 0, 405, 285, 238, 239, 445, 637, 331, 0,
-	0, 0, 0, 651, 632, 634, 635, 638, 642, 643,
-	644, 645, 646, 648, 650, 654, 444, 0, 0, 0,
-	0, 0, 443, 337, 0, 356, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 363, 386,
-	398, 415, 418, 0, 0, 0, 0, 243, 417, 0,
-	0, 0, 0, 0, 0, 0, 0, 653, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 592, 0, 0,
-	321, 322, 323, 324, 640, 0, 260, 416, 346, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 410, 411, 284, 290,
-	428, 292, 259, 336, 286, 395, 299, 0, 421, 0,
-	422, 0, 0, 0, 0, 328, 295, 296, 360, 300,
-	306, 349, 394, 334, 354, 257, 385, 361, 310, 0,
-	0, 662, 636, 661, 663, 664, 660, 665, 666, 647,
-	549, 0, 596, 658, 657, 659, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 373, 0,
-	237, 265, 0, 240, 0, 304, 0, 345, 283, 0,
-	0, 625, 602, 603, 604, 548, 605, 599, 600, 601,
-	626, 594, 622, 623, 573, 597, 606, 621, 607, 624,
-	627, 628, 667, 668, 613, 669, 610, 629, 620, 619,
-	608, 595, 630, 631, 580, 575, 611, 612, 598, 614,
-	615, 616, 576, 577, 578, 579, 0, 590, 0, 401,
-	402, 403, 424, 387, 0, 442, 333, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 446, 438, 546,
-	0, 0, 0, 278, 0, 0, 303, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 434,
-	0, 435, 0, 0, 581, 0, 0, 362, 317, 0,
-	0, 0, 0, 641, 649, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 571, 618,
-	617, 558, 568, 0, 0, 255, 188, 436, 0, 437,
-	559, 0, 567, 560, 564, 563, 561, 562, 0, 633,
-	0, 0, 0, 0, 0, 0, 530, 543, 0, 547,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 540, 541, 0, 0, 0, 0, 591,
-	0, 542, 0, 0, 586, 565, 569, 0, 0, 0,
-	0, 246, 367, 383, 256, 358, 396, 261, 365, 251,
-	332, 355, 0, 0, 248, 381, 364, 314, 297, 298,
-	247, 0, 350, 276, 289, 273, 330, 566, 589, 593,
-	272, 655, 587, 391, 250, 0, 390, 329, 377, 382,
-	315, 309, 249, 379, 313, 308, 301, 280, 656, 293,
-	341, 307, 342, 294, 319, 318, 320, 0, 0, 0,
-	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 584, 0, 0, 0,
-	393, 0, 0, 639, 0, 0, 0, 366, 0, 0,
-	302, 0, 0, 0, 588, 0, 353, 335, 652, 531,
-	0, 351, 305, 378, 343, 384, 368, 392, 347, 344,
-	241, 369, 275, 316, 252, 254, 270, 277, 279, 281,
-	282, 325, 326, 338, 357, 370, 371, 372, 274, 262,
-	352, 263, 291, 264, 242, 267, 266, 268, 359, 269,
-	244, 339, 376, 0, 287, 348, 312, 245, 311, 340,
-	375, 374, 253, 400, 406, 407, 0, 0, 412, 0,
-	0, 0, 420, 425, 426, 427, 429, 430, 431, 432,
-	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
-	0, 405, 285, 238, 239, 445, 637, 331, 0, 0,
-	0, 0, 651, 632, 634, 635, 638, 642, 643, 644,
-	645, 646, 648, 650, 654, 444, 0, 0, 0, 0,
-	0, 443, 337, 0, 356, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 363, 386, 398,
-	415, 418, 0, 0, 0, 0, 243, 417, 0, 0,
-	0, 0, 0, 0, 0, 0, 653, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 592, 0, 0, 321,
-	322, 323, 324, 640, 0, 260, 416, 346, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 410, 411, 284, 290, 428,
-	292, 259, 336, 286, 395, 299, 0, 421, 0, 422,
-	0, 0, 0, 0, 328, 295, 296, 360, 300, 306,
-	349, 394, 334, 354, 257, 385, 361, 310, 0, 0,
-	662, 636, 661, 663, 664, 660, 665, 666, 647, 549,
-	0, 596, 658, 657, 659, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 373, 0, 237,
-	265, 0, 240, 0, 304, 0, 345, 283, 0, 0,
-	625, 602, 603, 604, 548, 605, 599, 600, 601, 626,
-	594, 622, 623, 573, 597, 606, 621, 607, 624, 627,
-	628, 667, 668, 613, 669, 610, 629, 620, 619, 608,
-	595, 630, 631, 580, 575, 611, 612, 598, 614, 615,
-	616, 576, 577, 578, 579, 0, 590, 0, 401, 402,
-	403, 424, 387, 0, 442, 333, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 446, 438, 546, 0,
-	0, 0, 278, 0, 0, 303, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 434, 0,
-	435, 0, 0, 581, 0, 0, 362, 317, 0, 0,
-	0, 0, 641, 649, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 539, 0, 0, 571, 618, 617,
-	558, 568, 0, 0, 255, 188, 436, 0, 437, 559,
-	0, 567, 560, 564, 563, 561, 562, 0, 633, 0,
-	0, 0, 0, 0, 0, 0, 543, 0, 547, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 540, 541, 0, 0, 0, 0, 591, 0,
-	542, 0, 0, 586, 565, 569, 0, 0, 0, 0,
-	246, 367, 383, 256, 358, 396, 261, 365, 251, 332,
-	355, 0, 0, 248, 381, 364, 314, 297, 298, 247,
-	0, 350, 276, 289, 273, 330, 566, 589, 593, 272,
-	655, 587, 391, 250, 0, 390, 329, 377, 382, 315,
-	309, 249, 379, 313, 308, 301, 280, 656, 293, 341,
-	307, 342, 294, 319, 318, 320, 0, 0, 0, 0,
-	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 584, 0, 0, 0, 393,
-	0, 0, 639, 0, 0, 0, 366, 0, 0, 302,
-	0, 0, 0, 588, 0, 353, 335, 652, 0, 0,
-	351, 305, 378, 343, 384, 368, 392, 347, 344, 241,
-	369, 275, 316, 252, 254, 270, 277, 279, 281, 282,
-	325, 326, 338, 357, 370, 371, 372, 274, 262, 352,
-	263, 291, 264, 242, 267, 266, 268, 359, 269, 244,
-	339, 376, 0, 287, 348, 312, 245, 311, 340, 375,
-	374, 253, 400, 406, 407, 0, 0, 412, 0, 0,
-	0, 420, 425, 426, 427, 429, 430, 431, 432, 0,
-	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
-	405, 285, 238, 239, 445, 637, 331, 0, 0, 0,
-	0, 651, 632, 634, 635, 638, 642, 643, 644, 645,
-	646, 648, 650, 654, 444, 0, 0, 0, 0, 0,
-	443, 337, 0, 356, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 363, 386, 398, 415,
-	418, 0, 0, 0, 0, 243, 417, 0, 0, 0,
-	0, 0, 0, 0, 0, 653, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 592, 0, 0, 321, 322,
-	323, 324, 640, 0, 260, 416, 346, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 410, 411, 284, 290, 428, 292,
-	259, 336, 286, 395, 299, 0, 421, 0, 422, 0,
-	0, 0, 0, 328, 295, 296, 360, 300, 306, 349,
-	394, 334, 354, 257, 385, 361, 310, 0, 0, 662,
-	636, 661, 663, 664, 660, 665, 666, 647, 549, 0,
-	596, 658, 657, 659, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 373, 0, 237, 265,
-	0, 240, 0, 304, 0, 345, 283, 0, 0, 625,
-	602, 603, 604, 548, 605, 599, 600, 601, 626, 594,
-	622, 623, 573, 597, 606, 621, 607, 624, 627, 628,
-	667, 668, 613, 669, 610, 629, 620, 619, 608, 595,
-	630, 631, 580, 575, 611, 612, 598, 614, 615, 616,
-	576, 577, 578, 579, 0, 0, 0, 401, 402, 403,
-	424, 387, 0, 442, 165, 51, 157, 133, 0, 0,
-	0, 0, 0, 0, 333, 446, 438, 0, 0, 0,
-	0, 0, 158, 0, 0, 0, 0, 0, 0, 150,
-	0, 278, 0, 159, 303, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 434, 0, 435,
-	0, 0, 113, 0, 0, 362, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 162, 0, 0, 187, 0, 0, 0,
-	0, 0, 0, 255, 188, 436, 0, 437, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 179, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
-	367, 383, 256, 358, 396, 261, 365, 251, 332, 355,
-	0, 0, 248, 381, 364, 314, 297, 298, 247, 0,
-	350, 276, 289, 273, 330, 0, 380, 408, 272, 399,
-	0, 391, 250, 0, 390, 329, 377, 382, 315, 309,
-	249, 379, 313, 308, 301, 280, 423, 293, 341, 307,
-	342, 294, 319, 318, 320, 0, 0, 0, 0, 0,
-	419, 0, 0, 0, 0, 0, 0, 132, 156, 163,
-	0, 99, 0, 0, 0, 0, 0, 0, 393, 0,
-	0, 180, 0, 0, 0, 366, 0, 0, 302, 155,
-	149, 148, 409, 0, 353, 335, 57, 0, 0, 351,
-	305, 378, 343, 384, 368, 392, 347, 344, 241, 369,
-	275, 316, 252, 254, 270, 277, 279, 281, 282, 325,
-	326, 338, 357, 370, 371, 372, 274, 262, 352, 263,
-	291, 264, 242, 267, 266, 268, 359, 269, 244, 339,
-	376, 0, 287, 348, 312, 245, 311, 340, 375, 374,
-	253, 400, 406, 407, 0, 0, 412, 151, 152, 153,
-	420, 425, 426, 427, 429, 430, 431, 432, 0, 0,
-	0, 0, 414, 0, 0, 0, 0, 0, 0, 405,
-	285, 238, 239, 388, 271, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 327, 404, 183,
-	0, 0, 433, 191, 0, 0, 0, 154, 0, 192,
-	337, 0, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 363, 386, 398, 415, 418,
-	0, 0, 0, 0, 243, 417, 0, 0, 0, 0,
-	0, 0, 0, 0, 389, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 413, 0, 0, 321, 322, 323,
-	324, 288, 0, 260, 416, 346, 111, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 50, 0, 0,
-	0, 0, 0, 410, 411, 284, 290, 428, 292, 259,
-	336, 286, 395, 299, 0, 421, 0, 422, 0, 0,
-	0, 0, 328, 295, 296, 360, 300, 306, 349, 394,
-	334, 354, 257, 385, 361, 310, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 52, 0, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 373, 0, 237, 265, 0,
-	240, 0, 304, 134, 345, 283, 0, 0, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 0, 218, 219, 220, 221, 222, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 0, 0, 0, 233,
-	234, 235, 236, 0, 0, 0, 401, 402, 403, 424,
-	387, 333, 193, 39, 181, 184, 186, 185, 0, 49,
-	5, 0, 0, 116, 194, 438, 0, 0, 278, 0,
-	0, 303, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 434, 0, 435, 0, 0, 0,
-	0, 0, 362, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1037, 0, 0, 187, 0, 0, 558, 568, 0, 0,
-	255, 188, 436, 0, 437, 559, 0, 567, 560, 564,
-	563, 561, 562, 0, 258, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	565, 0, 0, 0, 0, 0, 246, 367, 383, 256,
-	358, 396, 261, 365, 251, 332, 355, 0, 0, 248,
-	381, 364, 314, 297, 298, 247, 0, 350, 276, 289,
-	273, 330, 566, 380, 408, 272, 399, 0, 391, 250,
-	0, 390, 329, 377, 382, 315, 309, 249, 379, 313,
-	308, 301, 280, 423, 293, 341, 307, 342, 294, 319,
-	318, 320, 0, 0, 0, 0, 0, 419, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 393, 0, 0, 0, 0,
-	0, 0, 366, 0, 0, 302, 0, 0, 0, 409,
-	0, 353, 335, 0, 0, 0, 351, 305, 378, 343,
-	384, 368, 392, 347, 344, 241, 369, 275, 316, 252,
-	254, 270, 277, 279, 281, 282, 325, 326, 338, 357,
-	370, 371, 372, 274, 262, 352, 263, 291, 264, 242,
-	267, 266, 268, 359, 269, 244, 339, 376, 0, 287,
-	348, 312, 245, 311, 340, 375, 374, 253, 400, 406,
-	407, 0, 0, 412, 0, 0, 0, 420, 425, 426,
-	427, 429, 430, 431, 432, 0, 0, 0, 0, 414,
-	0, 0, 0, 0, 0, 0, 405, 285, 238, 239,
-	445, 271, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 327, 404, 0, 0, 0, 433,
-	444, 0, 0, 0, 0, 0, 443, 337, 0, 356,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 363, 386, 398, 415, 418, 0, 0, 0,
-	0, 243, 417, 0, 0, 0, 0, 0, 0, 0,
-	0, 389, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 413, 0, 0, 321, 322, 323, 324, 288, 0,
-	260, 416, 346, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	410, 411, 284, 290, 428, 292, 259, 336, 286, 395,
-	299, 0, 421, 0, 422, 0, 0, 0, 0, 328,
-	295, 296, 360, 300, 306, 349, 394, 334, 354, 257,
-	385, 361, 310, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 232, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 373, 0, 237, 265, 0, 240, 0, 304,
-	0, 345, 283, 0, 0, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 0, 218,
-	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 0, 0, 0, 233, 234, 235, 236,
-	0, 0, 0, 401, 402, 403, 424, 387, 0, 442,
-	0, 0, 0, 165, 51, 157, 133, 0, 0, 0,
-	0, 446, 438, 333, 463, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	278, 0, 0, 303, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 434, 0, 435, 0,
-	0, 0, 0, 0, 362, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 468, 0, 0, 187, 0, 0, 0, 0,
-	0, 0, 255, 188, 436, 0, 437, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 258, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 367,
-	383, 256, 358, 396, 261, 365, 251, 332, 355, 0,
-	0, 248, 381, 364, 314, 297, 298, 247, 0, 350,
-	276, 289, 273, 330, 0, 380, 408, 272, 399, 0,
-	391, 250, 0, 390, 329, 377, 382, 315, 309, 249,
-	379, 313, 308, 301, 280, 423, 293, 341, 307, 342,
-	294, 319, 318, 320, 0, 0, 0, 0, 0, 419,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	467, 0, 0, 0, 0, 0, 0, 393, 0, 0,
-	0, 0, 0, 0, 366, 0, 0, 302, 0, 0,
-	0, 409, 0, 353, 335, 0, 0, 0, 351, 305,
-	378, 343, 384, 368, 392, 347, 344, 241, 369, 275,
-	316, 252, 254, 270, 277, 279, 281, 282, 325, 326,
-	338, 357, 370, 371, 372, 274, 262, 352, 263, 291,
-	264, 242, 267, 266, 268, 359, 269, 244, 339, 376,
-	0, 287, 348, 312, 245, 311, 340, 375, 374, 253,
-	400, 406, 407, 0, 0, 412, 0, 0, 0, 420,
-	425, 426, 427, 429, 430, 431, 432, 0, 0, 0,
-	0, 414, 0, 0, 0, 0, 0, 0, 405, 285,
-	238, 239, 445, 271, 331, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 327, 404, 0, 0,
-	0, 433, 444, 0, 0, 0, 0, 0, 443, 337,
-	0, 356, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 363, 386, 398, 415, 418, 0,
-	0, 0, 0, 243, 417, 0, 0, 0, 0, 0,
-	0, 0, 0, 389, 0, 0, 0, 397, 0, 0,
-	0, 0, 0, 413, 0, 0, 321, 322, 323, 324,
-	464, 466, 260, 416, 346, 476, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 410, 411, 284, 290, 428, 292, 259, 336,
-	286, 395, 299, 0, 421, 0, 422, 0, 0, 0,
-	0, 328, 295, 296, 360, 300, 306, 349, 394, 334,
-	354, 257, 385, 361, 310, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 52, 0, 0, 232, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 373, 0, 237, 265, 0, 240,
-	0, 304, 134, 345, 283, 0, 0, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	0, 218, 219, 220, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 333, 0, 0, 233, 234,
-	235, 236, 0, 865, 0, 401, 402, 403, 424, 387,
-	0, 442, 278, 0, 0, 303, 0, 0, 0, 0,
-	0, 0, 0, 446, 438, 0, 0, 0, 434, 0,
-	435, 0, 0, 0, 0, 0, 362, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
-	0, 0, 0, 0, 255, 188, 436, 0, 437, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 258, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 853, 0, 0, 0, 0, 0, 0,
-	246, 367, 383, 256, 358, 396, 261, 365, 251, 332,
-	355, 0, 0, 1915, 1917, 1918, 1919, 1920, 1921, 1922,
-	0, 1927, 1923, 1924, 1925, 1926, 0, 1910, 1911, 1912,
-	1913, 851, 1896, 1916, 0, 1897, 329, 1898, 1899, 1900,
-	1901, 1902, 1903, 1904, 1905, 1906, 1907, 1908, 1914, 341,
-	307, 342, 294, 319, 318, 320, 876, 878, 880, 882,
-	885, 419, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	0, 0, 0, 0, 0, 0, 366, 0, 0, 302,
-	0, 0, 0, 1909, 0, 353, 335, 0, 0, 0,
-	351, 305, 378, 343, 384, 368, 392, 347, 344, 241,
-	369, 275, 316, 252, 254, 270, 277, 279, 281, 282,
-	325, 326, 338, 357, 370, 371, 372, 274, 262, 352,
-	263, 291, 264, 242, 267, 266, 268, 359, 269, 244,
-	339, 376, 0, 287, 348, 312, 245, 311, 340, 375,
-	374, 253, 400, 406, 407, 0, 0, 412, 0, 0,
-	0, 420, 425, 426, 427, 429, 430, 431, 432, 0,
-	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
-	405, 285, 238, 239, 445, 271, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 327, 404,
-	0, 0, 0, 433, 444, 0, 0, 0, 0, 0,
-	443, 337, 0, 356, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 363, 386, 398, 415,
-	418, 0, 0, 0, 0, 243, 417, 0, 0, 0,
-	0, 0, 0, 0, 0, 389, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 413, 0, 0, 321, 322,
-	323, 324, 288, 0, 260, 416, 346, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 410, 411, 284, 290, 428, 292,
-	259, 336, 286, 395, 299, 0, 421, 0, 422, 0,
-	0, 0, 0, 328, 295, 296, 360, 300, 306, 349,
-	394, 334, 354, 257, 385, 361, 310, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	232, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 373, 0, 237, 265,
-	0, 240, 875, 304, 0, 345, 283, 0, 0, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
+	601, 3597, 2831, 1440, 3455, 579, 191, 581, 3548, 603,
+	3494, 3569, 1697, 574, 3469, 3090, 3415, 3470, 3304, 2858,
+	3385, 180, 2156, 3108, 178, 3, 3367, 179, 186, 7,
+	3103, 589, 2021, 1377, 3204, 3351, 3034, 1293, 1993, 3340,
+	2885, 484, 2491, 2932, 1099, 3371, 3303, 1232, 3145, 3247,
+	3144, 2933, 3139, 631, 492, 2595, 497, 497, 3288, 728,
+	856, 3106, 497, 513, 522, 2993, 2458, 522, 3237, 1367,
+	583, 1647, 3352, 2204, 3354, 1443, 2973, 3149, 3160, 1436,
+	2120, 3021, 2802, 2472, 2959, 3098, 2782, 1750, 2832, 3163,
+	2604, 1495, 2605, 2962, 1793, 2594, 2523, 176, 2578, 2855,
+	1756, 2841, 1790, 2226, 2834, 2454, 2588, 527, 2930, 2889,
+	2918, 2800, 1205, 1890, 533, 2123, 1859, 572, 2899, 2591,
+	1286, 2627, 2431, 2763, 2766, 2761, 2803, 2090, 2805, 2840,
+	2450, 2598, 960, 2804, 1805, 198, 2202, 1649, 2019, 1999,
+	2011, 788, 1996, 1998, 2665, 1886, 1868, 2703, 1867, 1587,
+	2277, 573, 578, 1833, 2648, 1860, 2227, 1885, 862, 1786,
+	1759, 2420, 1267, 2415, 2215, 1757, 1676, 1363, 484, 2493,
+	2121, 1686, 1376, 187, 8, 6, 2089, 1240, 1371, 1869,
+	1618, 912, 1368, 1595, 1434, 1887, 1918, 2256, 2294, 491,
+	1330, 191, 1302, 191, 2017, 903, 904, 2063, 1272, 1761,
+	1659, 1489, 897, 898, 7, 582, 2116, 902, 1439, 119,
+	35, 1897, 1658, 590, 571, 1473, 1425, 977, 1863, 26,
+	1146, 1337, 15, 1623, 1866, 819, 1823, 1221, 1433, 1849,
+	860, 2890, 849, 2235, 850, 1617, 573, 506, 787, 1496,
+	1271, 13, 1269, 14, 725, 536, 32, 23, 59, 535,
+	1217, 567, 16, 10, 509, 765, 1233, 177, 1173, 1329,
+	170, 1100, 769, 1178, 173, 521, 807, 881, 1894, 3276,
+	2403, 900, 1904, 2976, 2925, 483, 2329, 2283, 2403, 727,
+	785, 2403, 518, 2280, 1600, 514, 2281, 1344, 899, 2370,
+	901, 2278, 1340, 896, 3600, 895, 175, 1207, 3621, 493,
+	3626, 3544, 3542, 3091, 516, 3576, 517, 2441, 739, 515,
+	3279, 896, 3440, 3441, 778, 896, 779, 2073, 2602, 1241,
+	502, 1035, 1036, 1037, 1034, 2894, 2208, 2209, 2789, 2002,
+	2600, 2209, 525, 2599, 3224, 3225, 2434, 567, 1997, 1163,
+	1253, 2371, 1342, 3096, 1035, 1036, 1037, 1034, 2661, 8,
+	2659, 894, 756, 1530, 1838, 3598, 2886, 1035, 1036, 1037,
+	1034, 3365, 3243, 3238, 3099, 772, 2931, 775, 1591, 1094,
+	3366, 3356, 1862, 726, 3408, 3407, 3444, 2164, 1356, 174,
+	3110, 863, 865, 1049, 1048, 1058, 1059, 1051, 1052, 1053,
+	1054, 1055, 1056, 1057, 1050, 174, 174, 174, 55, 166,
+	139, 3324, 3581, 532, 3325, 174, 174, 36, 876, 872,
+	867, 871, 874, 1391, 1384, 174, 174, 55, 166, 139,
+	866, 3350, 3553, 3255, 998, 3299, 736, 2680, 3128, 174,
+	1165, 174, 55, 166, 139, 2315, 2457, 879, 883, 3502,
+	2323, 870, 2459, 1388, 1381, 2960, 1891, 2849, 171, 1629,
+	3348, 3253, 3123, 118, 2673, 1402, 1403, 2355, 3396, 1192,
+	1191, 1631, 531, 118, 1390, 1383, 171, 729, 2727, 3300,
+	519, 1612, 1410, 1902, 171, 171, 716, 1197, 715, 717,
+	718, 2067, 719, 720, 171, 171, 1166, 2254, 2003, 1032,
+	3126, 1426, 877, 774, 1430, 768, 497, 752, 171, 880,
+	171, 2635, 2636, 2255, 771, 770, 497, 970, 1771, 1772,
+	2242, 1249, 2634, 2241, 1250, 906, 2243, 1602, 1603, 1429,
+	868, 1803, 749, 758, 522, 522, 760, 497, 737, 1273,
+	2417, 1275, 1770, 3443, 1229, 1238, 1239, 3234, 1516, 2295,
+	2418, 3473, 3474, 878, 3522, 828, 1236, 1406, 3119, 969,
+	1235, 1238, 1239, 3520, 1672, 1405, 1442, 1030, 859, 882,
+	858, 580, 965, 967, 3359, 777, 1025, 3359, 3436, 3358,
+	767, 3245, 3358, 3435, 766, 3357, 1343, 1341, 3357, 3434,
+	748, 1976, 2666, 869, 755, 3498, 3499, 2416, 3342, 1038,
+	3248, 3249, 3250, 3251, 2667, 2934, 2668, 2934, 1067, 3342,
+	3345, 1252, 1431, 3241, 1445, 753, 1076, 2310, 971, 2534,
+	2327, 1787, 138, 980, 172, 3446, 3447, 3362, 1068, 2943,
+	1777, 1421, 3448, 3449, 980, 1428, 2970, 2963, 750, 1083,
+	1898, 964, 2777, 970, 164, 2196, 3133, 2767, 861, 2062,
+	2406, 3271, 776, 2693, 757, 993, 2775, 2423, 776, 1846,
+	1350, 1349, 1028, 1029, 3046, 3361, 1013, 2691, 875, 1014,
+	1001, 1027, 966, 2320, 567, 2162, 567, 3097, 754, 2660,
+	2582, 2199, 2198, 3274, 3130, 1104, 2203, 3513, 1451, 1454,
+	1455, 2771, 3263, 3118, 2795, 3264, 3060, 3377, 1016, 1452,
+	3120, 3296, 3472, 2856, 2857, 873, 1907, 1909, 1910, 2813,
+	1512, 2772, 2773, 1103, 3524, 524, 1509, 523, 2465, 2587,
+	1511, 1508, 1510, 1514, 1515, 2069, 3372, 2774, 1513, 1444,
+	3619, 3051, 863, 865, 1903, 3559, 3063, 3386, 1162, 1227,
+	518, 518, 3519, 514, 514, 3457, 3566, 1216, 3266, 1261,
+	1427, 3453, 3454, 773, 3457, 3270, 3195, 3275, 2945, 1171,
+	492, 1174, 516, 516, 517, 517, 2698, 515, 515, 2402,
+	1251, 866, 2173, 3595, 497, 973, 974, 1011, 984, 3265,
+	1801, 1802, 2152, 3572, 990, 1023, 1024, 3111, 2119, 2154,
+	2860, 2172, 763, 2429, 1143, 896, 1282, 788, 896, 986,
+	987, 2193, 2194, 2769, 863, 865, 884, 896, 1281, 1892,
+	991, 896, 3387, 896, 1214, 1074, 896, 3190, 982, 981,
+	1892, 3308, 2258, 1213, 3445, 1212, 1905, 1893, 2833, 982,
+	981, 1892, 3289, 2601, 1231, 1230, 3464, 3411, 961, 2744,
+	1012, 1237, 2279, 866, 497, 520, 1263, 2153, 3184, 2143,
+	1268, 2142, 3486, 484, 484, 1345, 975, 2119, 3297, 2141,
+	520, 3001, 484, 484, 1234, 1919, 1297, 1297, 3298, 497,
+	1519, 1520, 1521, 1522, 1523, 1524, 1517, 1518, 3094, 2443,
+	2444, 2445, 2446, 2447, 2852, 1179, 726, 1407, 522, 1174,
+	492, 759, 3599, 531, 2459, 3339, 1333, 1333, 1270, 2072,
+	2316, 2246, 3543, 998, 1299, 56, 140, 191, 2210, 1332,
+	1332, 2126, 1112, 1113, 3254, 1015, 484, 1328, 1304, 3573,
+	56, 3127, 140, 140, 140, 2160, 1238, 1239, 519, 519,
+	1453, 3622, 140, 140, 1895, 1238, 1239, 1186, 1164, 1188,
+	3525, 3057, 140, 140, 992, 1295, 1295, 3129, 1908, 1172,
+	3307, 2696, 2778, 1788, 2129, 2768, 140, 1195, 140, 2324,
+	1228, 3272, 1194, 2694, 2422, 2645, 2646, 1375, 1193, 1378,
+	526, 2756, 1752, 1753, 1386, 3205, 3206, 3207, 3209, 3210,
+	861, 2859, 3208, 1906, 1291, 1292, 2856, 2857, 997, 3263,
+	567, 1351, 3264, 1018, 1408, 1148, 1019, 2535, 3259, 2536,
+	2537, 2770, 3353, 3197, 1150, 2629, 2631, 3258, 1297, 3134,
+	1297, 970, 3463, 2853, 2532, 1202, 2149, 1778, 1422, 2405,
+	2426, 2427, 2005, 1392, 1176, 1021, 1169, 1180, 1181, 1182,
+	1183, 1184, 1185, 727, 1187, 2425, 1189, 1605, 1223, 1224,
+	1606, 778, 1196, 779, 780, 3266, 1262, 2125, 3191, 3192,
+	3570, 3571, 2127, 1441, 782, 783, 784, 1446, 1447, 1448,
+	1449, 1450, 2705, 2704, 1461, 1462, 1463, 1464, 1465, 1466,
+	1467, 1468, 1469, 1470, 1471, 1472, 3265, 1204, 2004, 1322,
+	1484, 1485, 1254, 1255, 2130, 1604, 1242, 1277, 1279, 1245,
+	970, 1494, 2185, 1493, 1177, 3186, 1289, 1290, 1210, 3185,
+	1280, 1491, 1492, 1215, 1017, 1543, 740, 2128, 741, 1527,
+	1225, 1365, 1366, 3168, 2553, 2554, 1650, 1537, 1243, 1244,
+	3577, 1246, 1247, 1248, 1753, 1552, 2007, 2006, 1218, 1222,
+	1222, 1222, 1441, 1533, 1534, 1535, 1305, 502, 1315, 1167,
+	1168, 3620, 1022, 1373, 567, 1370, 1549, 1321, 1374, 1550,
+	1346, 1218, 1218, 1320, 2014, 1438, 1334, 1070, 1071, 1072,
+	1073, 1033, 1559, 1560, 1335, 1423, 3617, 1020, 2065, 1589,
+	2630, 2848, 3609, 1593, 2896, 497, 1596, 2015, 2016, 998,
+	2818, 1579, 1580, 1581, 1582, 1583, 1584, 1951, 1528, 497,
+	1950, 1616, 1297, 1620, 1621, 1268, 1624, 1393, 995, 1627,
+	1628, 1585, 829, 1456, 1419, 518, 730, 497, 514, 3608,
+	1297, 840, 2854, 1900, 788, 1416, 1435, 1648, 1413, 866,
+	829, 2148, 1297, 866, 1175, 2146, 3582, 516, 1263, 517,
+	2297, 2470, 515, 513, 1394, 727, 2552, 1412, 3016, 2224,
+	1398, 996, 1826, 1415, 1900, 3568, 2793, 2882, 1414, 1411,
+	1588, 1542, 1671, 2225, 1437, 2471, 1432, 2562, 744, 3550,
+	1677, 1677, 1033, 1263, 2159, 1263, 1263, 1525, 1526, 497,
+	1529, 1616, 1616, 730, 996, 1297, 1748, 1749, 1544, 1675,
+	1767, 1900, 2064, 3529, 1475, 831, 1615, 2305, 830, 3488,
+	2990, 1551, 777, 1553, 484, 3259, 1297, 1991, 1900, 3260,
+	3482, 1424, 1354, 831, 1357, 1358, 830, 1987, 1006, 743,
+	1589, 1008, 1626, 746, 745, 1589, 1589, 1033, 1361, 1362,
+	2315, 497, 1616, 1297, 2862, 1810, 3475, 497, 497, 1814,
+	1815, 3551, 2305, 567, 2225, 1818, 1819, 1268, 1624, 1650,
+	1009, 3460, 3406, 1828, 1035, 1036, 1037, 1034, 2411, 1033,
+	191, 3332, 2408, 191, 191, 1033, 191, 1622, 2302, 1699,
+	3331, 3489, 1836, 2225, 1554, 1839, 1743, 1744, 1842, 2471,
+	3323, 1844, 3483, 1664, 3321, 2794, 3320, 2258, 1382, 1592,
+	1824, 1891, 1389, 1035, 1036, 1037, 1034, 1219, 1670, 1769,
+	2916, 1673, 1674, 519, 1543, 1543, 1870, 2113, 3281, 1586,
+	1619, 1543, 1543, 1417, 1796, 1797, 1877, 1782, 1774, 1660,
+	1776, 1662, 1663, 3461, 3281, 1992, 1144, 1807, 1640, 1002,
+	1794, 1795, 1955, 3333, 1668, 1882, 3319, 1678, 567, 1990,
+	1653, 1648, 2094, 1799, 1203, 1297, 1889, 1487, 1809, 998,
+	3318, 1789, 3016, 1283, 1004, 2969, 3281, 1837, 3281, 1611,
+	1840, 1841, 1645, 1843, 1644, 3605, 1007, 1010, 3280, 567,
+	1625, 1812, 1813, 1680, 3155, 1630, 3552, 1632, 1633, 1634,
+	1661, 3067, 3015, 1655, 2870, 1669, 2896, 1681, 1682, 1555,
+	1556, 1557, 1003, 1619, 1561, 1562, 1563, 1564, 1566, 1567,
+	1568, 1569, 1570, 1571, 1572, 1573, 1916, 1917, 3281, 1820,
+	2796, 3012, 1883, 1220, 2563, 2565, 2566, 2567, 2564, 1751,
+	1747, 1922, 3281, 1755, 1926, 1871, 887, 892, 893, 2819,
+	1912, 1656, 1657, 2650, 963, 1679, 1783, 1773, 2473, 1775,
+	3281, 1435, 2318, 2624, 1929, 1804, 3156, 2317, 1666, 1667,
+	1865, 2309, 1218, 2258, 3016, 2346, 2871, 1865, 3221, 2110,
+	2074, 1808, 2377, 1946, 1937, 2369, 1665, 1005, 2330, 2313,
+	837, 1821, 1944, 1035, 1036, 1037, 1034, 1222, 1834, 1931,
+	1881, 1832, 2797, 955, 951, 952, 953, 954, 1831, 2351,
+	1957, 2350, 2349, 2347, 1754, 1962, 1963, 1964, 2126, 2129,
+	1967, 1968, 1969, 1970, 1971, 1972, 1973, 1974, 2306, 1956,
+	1851, 1958, 1610, 863, 865, 2225, 2304, 2299, 1965, 1928,
+	863, 865, 1035, 1036, 1037, 1034, 2292, 2290, 572, 1872,
+	970, 2057, 497, 1875, 1033, 1876, 1874, 1033, 1880, 518,
+	1033, 2094, 514, 1395, 1482, 1483, 497, 2288, 2286, 2093,
+	1988, 497, 866, 497, 497, 497, 1986, 2348, 1985, 866,
+	1884, 516, 1080, 517, 983, 2091, 515, 3065, 2725, 963,
+	1984, 1983, 2022, 958, 1879, 2098, 1263, 956, 1798, 1050,
+	2300, 616, 120, 2823, 2095, 1982, 2103, 120, 2305, 2300,
+	1920, 1911, 2688, 3591, 1285, 3578, 2923, 1981, 2293, 2291,
+	1263, 1532, 1531, 1287, 3378, 1961, 863, 865, 2157, 2135,
+	1924, 1475, 833, 1913, 1288, 832, 889, 890, 891, 2287,
+	2287, 2094, 1987, 1532, 1531, 1960, 1949, 1940, 1033, 2130,
+	1033, 1939, 1938, 1219, 2125, 2119, 2124, 1930, 2122, 2127,
+	1899, 503, 1033, 1033, 120, 866, 2059, 2163, 3379, 2165,
+	2166, 2167, 2168, 2169, 2170, 2171, 3169, 1033, 2174, 2175,
+	2176, 2177, 2178, 2179, 2180, 2181, 2182, 2183, 2184, 1033,
+	2186, 2187, 2188, 2189, 2190, 2211, 2191, 1033, 3004, 3277,
+	2158, 742, 2229, 3188, 2229, 1767, 2229, 2814, 1399, 2237,
+	1651, 1652, 2278, 1284, 2128, 2352, 2353, 1033, 1033, 1033,
+	3170, 3187, 838, 1033, 1033, 484, 484, 2975, 1589, 1900,
+	1589, 1565, 1900, 970, 2100, 2101, 2112, 2897, 2337, 1297,
+	497, 3002, 3005, 834, 2104, 2105, 1208, 519, 1589, 1589,
+	1209, 530, 497, 1558, 2880, 2875, 2118, 970, 492, 2272,
+	2872, 2117, 2008, 497, 2784, 1333, 2584, 1767, 2433, 1220,
+	2267, 2066, 2269, 864, 2404, 1104, 191, 120, 1332, 2207,
+	1400, 963, 839, 2815, 1481, 3003, 2303, 2107, 2248, 1199,
+	2108, 1490, 120, 1198, 120, 972, 1490, 1835, 1925, 2022,
+	1478, 1480, 1477, 1103, 1479, 2234, 836, 2232, 2099, 2236,
+	1053, 1054, 1055, 1056, 1057, 1050, 2652, 2244, 2252, 2245,
+	2111, 1338, 2311, 1835, 1614, 1889, 3433, 2816, 1034, 747,
+	3200, 2718, 1297, 3199, 1297, 2082, 1297, 2249, 2250, 2144,
+	2145, 970, 2150, 2109, 2362, 1037, 1034, 2126, 2129, 3131,
+	1035, 1036, 1037, 1034, 2669, 2506, 1975, 1977, 1978, 1979,
+	1980, 2926, 3623, 1035, 1036, 1037, 1034, 2266, 2505, 2499,
+	1297, 2497, 2356, 2273, 2282, 1035, 1036, 1037, 1034, 2338,
+	3179, 835, 3594, 2325, 2924, 2200, 2260, 2363, 2357, 2358,
+	2717, 1082, 1297, 3136, 3137, 3612, 2360, 2361, 3560, 2365,
+	2341, 2264, 2231, 3556, 1081, 3132, 2106, 2354, 2239, 2366,
+	2271, 604, 614, 2321, 863, 865, 1035, 1036, 1037, 1034,
+	605, 2967, 613, 606, 610, 609, 607, 608, 3554, 2364,
+	2574, 3459, 2253, 3423, 2572, 1222, 1035, 1036, 1037, 1034,
+	3380, 1589, 2570, 3593, 2396, 2397, 1596, 2262, 2559, 1295,
+	3311, 2261, 3301, 866, 970, 2265, 2367, 3239, 1547, 1277,
+	1279, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1050, 3172,
+	3171, 1295, 1548, 3006, 2966, 611, 2776, 2968, 2130, 2684,
+	1914, 1915, 2664, 2125, 2119, 2124, 2573, 2122, 2127, 2328,
+	2571, 2663, 2557, 2322, 2556, 2412, 2409, 2555, 2569, 2114,
+	2547, 2541, 2540, 2308, 2558, 1297, 2539, 612, 2430, 2538,
+	2314, 1854, 1853, 1852, 2335, 2439, 2319, 1848, 2432, 1847,
+	1616, 2455, 2394, 497, 1396, 2419, 1161, 3615, 2312, 2469,
+	1035, 1036, 1037, 1034, 2596, 2475, 2974, 2589, 2762, 2339,
+	1435, 2331, 2332, 2128, 1035, 1036, 1037, 1034, 3393, 3601,
+	2345, 2484, 3575, 2274, 3091, 3515, 3512, 970, 3104, 3500,
+	2466, 7, 3437, 3364, 3140, 2496, 3337, 1035, 1036, 1037,
+	1034, 3315, 1870, 3310, 2502, 2503, 1339, 2504, 3309, 3273,
+	2507, 2508, 1870, 1870, 2512, 970, 970, 970, 1677, 1994,
+	1995, 970, 3240, 2519, 2520, 2521, 970, 1048, 1058, 1059,
+	1051, 1052, 1053, 1054, 1055, 1056, 1057, 1050, 2451, 1942,
+	3181, 2413, 120, 120, 864, 2452, 2398, 2229, 2395, 3152,
+	3135, 2530, 2531, 3102, 1035, 1036, 1037, 1034, 2514, 2515,
+	3100, 2575, 1338, 2518, 3074, 2545, 2546, 3071, 2524, 484,
+	3389, 3069, 1927, 1699, 1616, 1934, 2579, 2965, 1365, 1366,
+	2964, 1268, 2476, 970, 1767, 1767, 1767, 1767, 2961, 2581,
+	2951, 1035, 1036, 1037, 1034, 970, 1767, 2887, 2881, 2229,
+	2877, 2868, 2229, 567, 1373, 1941, 1370, 2867, 2494, 1374,
+	2478, 2785, 2494, 2490, 2480, 2428, 1066, 1297, 2753, 2752,
+	2372, 2373, 2633, 2751, 2000, 2606, 2378, 2748, 2697, 497,
+	497, 1035, 1036, 1037, 1034, 2468, 8, 2606, 2474, 2695,
+	2662, 2513, 2639, 1619, 2568, 191, 1035, 1036, 1037, 1034,
+	191, 2597, 2560, 2550, 2548, 2486, 2544, 2483, 2543, 2542,
+	1989, 2492, 2656, 1856, 2658, 1850, 2498, 1035, 1036, 1037,
+	1034, 1543, 1599, 1543, 672, 671, 2679, 1598, 1397, 1111,
+	2683, 2501, 1589, 1107, 2517, 2619, 1297, 1589, 1106, 2690,
+	3466, 2509, 2511, 2388, 2389, 2390, 2391, 2392, 2393, 3370,
+	959, 738, 3256, 2549, 3252, 2623, 3233, 3159, 2999, 2621,
+	1035, 1036, 1037, 1034, 2998, 1765, 1035, 1036, 1037, 1034,
+	2997, 2989, 2950, 2701, 2938, 1035, 1036, 1037, 1034, 2580,
+	2929, 2928, 2585, 1041, 1042, 1043, 1044, 1045, 1046, 1047,
+	1039, 2917, 2653, 2603, 2915, 2824, 2722, 2657, 2723, 2607,
+	2608, 2609, 2610, 2715, 2707, 2622, 2620, 2495, 2618, 2702,
+	2647, 1151, 727, 1588, 2410, 2407, 2289, 2477, 2678, 2285,
+	2284, 496, 496, 1358, 2637, 2481, 2482, 504, 2640, 1966,
+	2632, 3113, 970, 1959, 1954, 1361, 1362, 1952, 2676, 2710,
+	2765, 2712, 1948, 2479, 2682, 1947, 2651, 2655, 1945, 2654,
+	2780, 2687, 1936, 2583, 1933, 1932, 2692, 1035, 1036, 1037,
+	1034, 3112, 2755, 497, 1855, 1578, 1577, 2675, 2672, 1576,
+	1575, 2677, 567, 2670, 2022, 1574, 3055, 174, 970, 166,
+	139, 2686, 970, 970, 970, 1546, 1545, 1035, 1036, 1037,
+	1034, 1767, 2091, 2699, 2822, 1536, 2948, 174, 2798, 2799,
+	2826, 2334, 1035, 1036, 1037, 1034, 2700, 1309, 1307, 2135,
+	3590, 2706, 3405, 2721, 2839, 3584, 2842, 3567, 2842, 2842,
+	2713, 2714, 1035, 1036, 1037, 1034, 2847, 2720, 3564, 3562,
+	3422, 1101, 2792, 3388, 2846, 970, 3335, 3334, 2711, 1035,
+	1036, 1037, 1034, 1306, 2837, 2719, 171, 2906, 503, 3317,
+	3312, 1297, 1297, 1035, 1036, 1037, 1034, 1353, 3214, 2451,
+	2745, 2861, 3198, 2750, 3194, 2749, 171, 3086, 866, 2754,
+	2757, 1035, 1036, 1037, 1034, 866, 3084, 3056, 120, 3053,
+	3052, 3049, 3048, 2863, 2981, 1206, 2786, 2781, 2864, 2865,
+	2808, 2820, 2807, 2809, 2810, 2811, 2386, 497, 2716, 1364,
+	2791, 1355, 2765, 1058, 1059, 1051, 1052, 1053, 1054, 1055,
+	1056, 1057, 1050, 1616, 1616, 2838, 2576, 2500, 2489, 2821,
+	2817, 2463, 1035, 1036, 1037, 1034, 2385, 2462, 2461, 1369,
+	1295, 1295, 1372, 2892, 2893, 2825, 2118, 1359, 2850, 2827,
+	2828, 2117, 120, 2387, 2298, 2247, 120, 2843, 2844, 2238,
+	171, 2192, 1035, 1036, 1037, 1034, 2092, 120, 2080, 1476,
+	1816, 2851, 1609, 1608, 1258, 1420, 1260, 120, 1264, 1265,
+	1266, 2356, 1385, 2888, 1360, 1145, 1142, 1141, 2728, 2729,
+	2927, 1140, 1139, 866, 2730, 2731, 2732, 2733, 1138, 2734,
+	2735, 2736, 2737, 2738, 2739, 2740, 2741, 1137, 1136, 1310,
+	1311, 1312, 1313, 1314, 1135, 1316, 1317, 1318, 1319, 2384,
+	1134, 1133, 1324, 1325, 1326, 1327, 1132, 497, 1131, 1130,
+	1129, 1128, 1127, 1126, 2876, 2874, 2873, 2674, 1125, 2879,
+	2878, 2869, 2883, 2884, 2681, 1035, 1036, 1037, 1034, 1124,
+	1123, 1122, 2946, 2383, 1121, 2708, 2709, 2382, 2904, 1120,
+	1119, 1118, 2947, 1117, 866, 1116, 2908, 1115, 1114, 2949,
+	1110, 1109, 2895, 1108, 1105, 2912, 2913, 2914, 2909, 1035,
+	1036, 1037, 1034, 1035, 1036, 1037, 1034, 2907, 2381, 1098,
+	1097, 1095, 2922, 2845, 1049, 1048, 1058, 1059, 1051, 1052,
+	1053, 1054, 1055, 1056, 1057, 1050, 1094, 1200, 2380, 1093,
+	1092, 2829, 1091, 1953, 1035, 1036, 1037, 1034, 497, 2956,
+	2939, 1090, 1089, 1088, 1087, 1086, 2985, 2940, 2455, 2379,
+	2941, 2942, 2952, 2980, 1035, 1036, 1037, 1034, 2994, 970,
+	1085, 1084, 1079, 1078, 1077, 2982, 2983, 2984, 2229, 1767,
+	3009, 567, 2760, 1000, 957, 1035, 1036, 1037, 1034, 2957,
+	2376, 2900, 2901, 3403, 3017, 3401, 3399, 970, 3050, 2097,
+	2077, 962, 988, 2905, 3625, 3614, 2839, 2375, 3534, 3532,
+	3471, 968, 2891, 970, 2954, 2903, 1035, 1036, 1037, 1034,
+	2787, 2442, 2259, 970, 2070, 1858, 999, 1297, 2612, 2987,
+	2988, 2611, 989, 1035, 1036, 1037, 1034, 2615, 3432, 3018,
+	2613, 2374, 2616, 1616, 2617, 2614, 2221, 2222, 2972, 3349,
+	1589, 3177, 2307, 2830, 2783, 3059, 2977, 970, 2301, 1642,
+	1643, 2206, 2205, 2986, 3066, 2524, 3011, 1035, 1036, 1037,
+	1034, 1589, 105, 58, 3083, 3076, 2368, 3085, 3047, 3008,
+	2996, 3007, 2359, 2400, 494, 191, 2161, 3040, 57, 3077,
+	3088, 1766, 3062, 3092, 2336, 2746, 2747, 3089, 970, 2606,
+	1735, 2758, 1035, 1036, 1037, 1034, 1295, 3054, 1035, 1036,
+	1037, 1034, 1347, 3061, 3093, 3080, 3058, 2296, 3010, 3064,
+	1035, 1036, 1037, 1034, 3013, 2326, 3073, 3014, 3070, 1486,
+	3068, 1147, 499, 500, 1637, 1638, 1639, 3078, 3075, 3072,
+	2606, 498, 970, 1297, 1297, 3079, 3087, 3121, 501, 3081,
+	1379, 970, 3082, 1994, 1995, 1035, 1036, 1037, 1034, 2060,
+	2835, 120, 2836, 1817, 120, 120, 2526, 120, 866, 994,
+	3162, 3360, 3162, 2527, 2528, 2529, 2806, 3157, 3158, 2788,
+	3148, 2759, 3095, 2485, 3146, 2414, 2087, 1646, 3105, 1297,
+	3180, 731, 732, 733, 734, 1607, 730, 866, 1532, 1531,
+	3122, 1159, 1160, 3124, 3491, 864, 3314, 497, 2866, 970,
+	970, 3141, 864, 970, 970, 866, 3153, 2201, 497, 3217,
+	2197, 120, 1295, 3150, 1157, 1158, 3219, 1155, 1156, 1153,
+	1154, 1746, 3143, 3154, 3165, 3216, 1257, 1256, 3166, 1026,
+	3142, 2911, 2257, 1878, 1211, 1648, 3211, 3229, 3220, 1149,
+	3585, 3146, 3146, 3011, 3451, 3146, 3146, 3235, 3236, 3202,
+	3203, 3047, 3178, 3212, 3213, 3175, 3182, 1308, 3150, 1190,
+	3040, 1049, 1048, 1058, 1059, 1051, 1052, 1053, 1054, 1055,
+	1056, 1057, 1050, 3429, 3269, 2212, 3427, 1811, 3226, 2217,
+	2220, 2221, 2222, 2218, 3373, 2219, 2223, 3347, 1066, 3346,
+	1822, 3344, 3336, 3232, 3231, 3173, 3174, 3101, 2953, 3262,
+	3222, 2919, 3223, 2936, 2935, 2920, 3227, 2994, 2155, 1152,
+	2649, 3283, 2217, 2220, 2221, 2222, 2218, 2958, 2219, 2223,
+	1650, 2436, 2437, 2438, 3291, 3536, 3535, 3536, 2685, 1259,
+	2401, 2079, 1935, 985, 3535, 3196, 2937, 2641, 730, 3306,
+	3257, 3267, 3261, 1226, 66, 2, 2979, 3278, 731, 732,
+	733, 734, 1274, 730, 1303, 2240, 1800, 1301, 1, 1597,
+	735, 2625, 2626, 3613, 2991, 970, 3282, 2910, 2628, 1896,
+	2586, 1297, 3284, 2195, 3292, 3294, 3287, 3293, 3285, 3286,
+	2061, 2779, 1201, 3302, 781, 1538, 1404, 886, 3329, 3330,
+	3114, 979, 1401, 978, 3327, 976, 1488, 618, 1861, 2577,
+	3313, 2551, 3228, 3490, 3547, 3421, 3493, 3146, 3326, 1418,
+	602, 3338, 3244, 3425, 3246, 3322, 1049, 1048, 1058, 1059,
+	1051, 1052, 1053, 1054, 1055, 1056, 1057, 1050, 3107, 3328,
+	1083, 1901, 970, 1031, 2671, 803, 655, 629, 1096, 1387,
+	3355, 1380, 2726, 888, 628, 2971, 3343, 3341, 2424, 2644,
+	1295, 3295, 885, 3374, 804, 1845, 3242, 1348, 1352, 3167,
+	3000, 2812, 2464, 3384, 3176, 3375, 3583, 3383, 970, 3456,
+	3618, 3369, 3518, 3565, 3146, 3117, 3390, 3115, 1297, 3363,
+	3368, 3116, 3558, 3452, 3412, 3416, 3419, 3392, 537, 1779,
+	3376, 482, 3588, 847, 3215, 3398, 3400, 3402, 3404, 1857,
+	3381, 3382, 538, 2096, 3442, 3316, 3109, 761, 3391, 2076,
+	3146, 762, 764, 2449, 2448, 3409, 1457, 3420, 3397, 3410,
+	1040, 1474, 2742, 2743, 1075, 3428, 1297, 3430, 3431, 791,
+	577, 3426, 1923, 2421, 3424, 3035, 2638, 3462, 65, 64,
+	63, 62, 1827, 3467, 3438, 1049, 1048, 1058, 1059, 1051,
+	1052, 1053, 1054, 1055, 1056, 1057, 1050, 1295, 199, 3450,
+	620, 3138, 3418, 3458, 3495, 599, 598, 597, 596, 595,
+	2216, 2214, 3465, 3497, 1766, 3481, 2233, 2213, 1760, 1825,
+	2522, 1685, 3496, 3487, 3476, 2516, 3477, 2151, 3478, 2132,
+	3479, 3509, 3510, 3480, 1684, 3468, 3394, 3395, 790, 3485,
+	3193, 2561, 970, 1636, 2147, 1295, 1705, 2533, 829, 1702,
+	3501, 3506, 1701, 2525, 3505, 3355, 3511, 3507, 3508, 3503,
+	3189, 3514, 3183, 1732, 2453, 3306, 2992, 3521, 3523, 3161,
+	3019, 3527, 3526, 3020, 3416, 3533, 3530, 3531, 1766, 3026,
+	3546, 2086, 911, 907, 3516, 909, 910, 120, 3528, 3549,
+	3541, 908, 3545, 3537, 3538, 3539, 3540, 2344, 2340, 2115,
+	1601, 2801, 3596, 3414, 3555, 2013, 3439, 2435, 970, 2071,
+	2012, 3557, 2010, 2009, 1613, 1170, 3561, 3268, 3563, 1516,
+	2955, 2020, 2018, 2902, 2898, 1594, 2399, 1763, 1758, 2078,
+	3383, 831, 1635, 3125, 830, 1641, 751, 2068, 3574, 2001,
+	2440, 156, 3497, 3580, 39, 52, 3504, 155, 51, 103,
+	1441, 3496, 3579, 970, 154, 970, 3587, 50, 3589, 102,
+	153, 49, 93, 3592, 92, 101, 151, 48, 183, 182,
+	816, 185, 184, 181, 2275, 3549, 3602, 3606, 792, 2276,
+	3607, 970, 3604, 1336, 3611, 3164, 724, 38, 37, 33,
+	12, 11, 34, 21, 1683, 1441, 3616, 1441, 22, 20,
+	1409, 19, 1061, 25, 1065, 31, 794, 30, 113, 112,
+	29, 111, 110, 3624, 109, 108, 107, 28, 18, 43,
+	1062, 1064, 1060, 1441, 1063, 1049, 1048, 1058, 1059, 1051,
+	1052, 1053, 1054, 1055, 1056, 1057, 1050, 42, 41, 9,
+	100, 98, 27, 99, 96, 97, 1806, 548, 547, 554,
+	544, 94, 1806, 1806, 77, 76, 75, 90, 89, 551,
+	552, 88, 553, 557, 87, 86, 539, 85, 83, 815,
+	814, 84, 802, 74, 73, 72, 562, 71, 70, 95,
+	81, 1512, 91, 82, 80, 79, 78, 1509, 69, 68,
+	813, 1511, 1508, 1510, 1514, 1515, 67, 137, 136, 1513,
+	134, 789, 135, 133, 132, 131, 130, 129, 128, 44,
+	45, 46, 793, 824, 47, 147, 146, 148, 150, 566,
+	152, 3586, 569, 149, 144, 142, 145, 568, 143, 141,
+	60, 17, 24, 4, 0, 0, 820, 0, 0, 0,
+	548, 547, 554, 544, 120, 0, 0, 0, 0, 0,
+	0, 0, 551, 552, 0, 553, 557, 0, 0, 539,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 562,
+	0, 0, 821, 825, 1049, 1048, 1058, 1059, 1051, 1052,
+	1053, 1054, 1055, 1056, 1057, 1050, 0, 0, 0, 0,
+	810, 0, 808, 812, 828, 0, 2724, 0, 809, 806,
+	805, 0, 811, 796, 797, 795, 798, 799, 800, 801,
+	0, 826, 566, 827, 0, 569, 0, 0, 0, 0,
+	568, 0, 0, 0, 822, 823, 0, 0, 0, 0,
+	1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506,
+	1507, 1519, 1520, 1521, 1522, 1523, 1524, 1517, 1518, 1049,
+	1048, 1058, 1059, 1051, 1052, 1053, 1054, 1055, 1056, 1057,
+	1050, 818, 540, 542, 541, 1766, 1766, 1766, 1766, 0,
+	0, 0, 546, 0, 0, 0, 0, 1766, 0, 0,
+	0, 0, 2333, 0, 550, 0, 0, 0, 0, 0,
+	0, 565, 0, 0, 0, 0, 0, 0, 3484, 543,
+	0, 0, 0, 534, 0, 927, 1049, 1048, 1058, 1059,
+	1051, 1052, 1053, 1054, 1055, 1056, 1057, 1050, 0, 0,
+	0, 0, 0, 0, 0, 0, 120, 0, 0, 0,
+	0, 120, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2058, 817, 0,
+	0, 120, 0, 0, 0, 540, 542, 541, 120, 0,
+	0, 2075, 0, 0, 0, 546, 2081, 0, 2083, 2084,
+	2085, 1781, 0, 0, 0, 0, 0, 550, 0, 0,
+	0, 0, 0, 0, 565, 0, 0, 0, 0, 0,
+	0, 0, 543, 0, 0, 0, 0, 0, 0, 0,
+	0, 2102, 0, 0, 0, 0, 0, 545, 549, 555,
+	0, 556, 558, 0, 0, 559, 560, 561, 0, 0,
+	563, 564, 0, 0, 566, 915, 0, 569, 0, 0,
+	0, 0, 568, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 937, 941, 943, 945, 947,
+	948, 950, 0, 955, 951, 952, 953, 954, 0, 932,
+	933, 934, 935, 913, 914, 938, 120, 916, 0, 917,
+	918, 919, 920, 921, 922, 923, 924, 925, 926, 928,
+	929, 930, 936, 0, 0, 0, 0, 0, 0, 0,
+	940, 942, 944, 946, 949, 0, 0, 0, 0, 0,
+	545, 549, 555, 0, 556, 558, 0, 0, 559, 560,
+	561, 0, 0, 563, 564, 0, 0, 0, 0, 0,
+	0, 0, 1766, 0, 0, 0, 0, 931, 0, 242,
+	0, 0, 0, 0, 0, 1303, 0, 120, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1806, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 247, 2263, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 205,
 	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 0, 218, 219, 220, 221, 222, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 333, 0, 0,
-	233, 234, 235, 236, 0, 0, 0, 401, 402, 403,
-	424, 387, 0, 442, 278, 0, 0, 303, 0, 0,
-	0, 0, 0, 0, 0, 446, 438, 0, 0, 0,
-	434, 0, 435, 0, 0, 0, 0, 0, 362, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	0, 0, 0, 0, 0, 0, 255, 188, 436, 0,
-	437, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	258, 1990, 1993, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 367, 383, 256, 358, 396, 261, 365,
-	251, 332, 355, 0, 0, 248, 381, 364, 314, 297,
-	298, 247, 0, 350, 276, 289, 273, 330, 0, 380,
-	408, 272, 399, 0, 391, 250, 0, 390, 329, 377,
-	382, 315, 309, 249, 379, 313, 308, 301, 280, 423,
-	293, 341, 307, 342, 294, 319, 318, 320, 0, 0,
-	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1994, 393, 0, 0, 0, 1989, 0, 1988, 366, 1986,
-	1991, 302, 0, 0, 0, 409, 0, 353, 335, 0,
-	0, 0, 351, 305, 378, 343, 384, 368, 392, 347,
-	344, 241, 369, 275, 316, 252, 254, 270, 277, 279,
-	281, 282, 325, 326, 338, 357, 370, 371, 372, 274,
-	262, 352, 263, 291, 264, 242, 267, 266, 268, 359,
-	269, 244, 339, 376, 1992, 287, 348, 312, 245, 311,
-	340, 375, 374, 253, 400, 406, 407, 0, 0, 412,
-	0, 0, 0, 420, 425, 426, 427, 429, 430, 431,
-	432, 0, 0, 0, 0, 414, 0, 0, 0, 0,
-	0, 0, 405, 285, 238, 239, 445, 271, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	327, 404, 0, 0, 0, 433, 444, 0, 0, 0,
-	0, 0, 443, 337, 0, 356, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 363, 386,
-	398, 415, 418, 0, 0, 0, 0, 243, 417, 0,
-	0, 0, 0, 0, 0, 0, 0, 389, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 413, 0, 0,
-	321, 322, 323, 324, 288, 0, 260, 416, 346, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 410, 411, 284, 290,
-	428, 292, 259, 336, 286, 395, 299, 0, 421, 0,
-	422, 0, 0, 0, 0, 328, 295, 296, 360, 300,
-	306, 349, 394, 334, 354, 257, 385, 361, 310, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 373, 0,
-	237, 265, 0, 240, 0, 304, 0, 345, 283, 0,
-	0, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 0, 218, 219, 220, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 0,
-	0, 0, 233, 234, 235, 236, 333, 0, 0, 401,
-	402, 403, 424, 387, 0, 442, 0, 0, 1716, 0,
-	0, 0, 0, 278, 0, 0, 303, 446, 438, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 434,
-	0, 435, 0, 0, 0, 0, 0, 362, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
-	0, 1717, 0, 0, 0, 255, 188, 436, 0, 437,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 971, 972, 973, 970, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 367, 383, 256, 358, 396, 261, 365, 251,
-	332, 355, 0, 0, 248, 381, 364, 314, 297, 298,
-	247, 0, 350, 276, 289, 273, 330, 0, 380, 408,
-	272, 399, 0, 391, 250, 0, 390, 329, 377, 382,
-	315, 309, 249, 379, 313, 308, 301, 280, 423, 293,
-	341, 307, 342, 294, 319, 318, 320, 0, 0, 0,
-	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 0, 0, 0, 0, 0, 0, 366, 0, 0,
-	302, 0, 0, 0, 409, 0, 353, 335, 0, 0,
-	0, 351, 305, 378, 343, 384, 368, 392, 347, 344,
-	241, 369, 275, 316, 252, 254, 270, 277, 279, 281,
-	282, 325, 326, 338, 357, 370, 371, 372, 274, 262,
-	352, 263, 291, 264, 242, 267, 266, 268, 359, 269,
-	244, 339, 376, 0, 287, 348, 312, 245, 311, 340,
-	375, 374, 253, 400, 406, 407, 0, 0, 412, 0,
-	0, 0, 420, 425, 426, 427, 429, 430, 431, 432,
-	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
-	0, 405, 285, 238, 239, 445, 271, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	404, 0, 0, 0, 433, 444, 0, 0, 0, 0,
-	0, 443, 337, 0, 356, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 363, 386, 398,
-	415, 418, 0, 0, 0, 0, 243, 417, 0, 0,
-	0, 0, 0, 0, 0, 0, 389, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 413, 0, 0, 321,
-	322, 323, 324, 288, 0, 260, 416, 346, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 410, 411, 284, 290, 428,
-	292, 259, 336, 286, 395, 299, 0, 421, 0, 422,
-	0, 0, 0, 0, 328, 295, 296, 360, 300, 306,
-	349, 394, 334, 354, 257, 385, 361, 310, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 373, 0, 237,
-	265, 0, 240, 0, 304, 0, 345, 283, 0, 0,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 0, 218, 219, 220, 221, 222, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 333, 0,
-	0, 233, 234, 235, 236, 0, 0, 0, 401, 402,
-	403, 424, 387, 0, 442, 278, 787, 0, 303, 0,
-	0, 0, 0, 0, 0, 0, 446, 438, 0, 0,
-	0, 434, 0, 435, 0, 0, 0, 0, 0, 362,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 794, 795, 0, 0, 0, 0, 255, 188, 436,
-	0, 437, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 798, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 367, 782, 256, 358, 396, 261,
-	365, 251, 332, 355, 0, 0, 248, 381, 364, 314,
-	297, 298, 247, 0, 350, 276, 289, 273, 330, 0,
-	380, 408, 272, 399, 773, 391, 250, 772, 390, 329,
-	377, 382, 315, 309, 249, 379, 313, 308, 301, 280,
-	423, 293, 341, 307, 342, 294, 319, 318, 320, 0,
-	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 0, 0, 0, 0, 0, 0, 366,
-	0, 0, 302, 0, 0, 0, 409, 0, 353, 335,
-	0, 0, 0, 351, 305, 378, 343, 384, 368, 392,
-	785, 344, 241, 369, 275, 316, 252, 254, 270, 277,
-	279, 281, 282, 325, 326, 338, 357, 370, 371, 372,
-	274, 262, 352, 263, 291, 264, 242, 267, 266, 268,
-	359, 269, 244, 339, 376, 0, 287, 348, 312, 245,
-	311, 340, 375, 374, 253, 400, 406, 407, 0, 0,
-	412, 0, 0, 0, 420, 425, 426, 427, 429, 430,
-	431, 432, 0, 0, 0, 0, 414, 0, 0, 0,
-	0, 0, 0, 405, 285, 238, 239, 445, 271, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 404, 0, 0, 0, 433, 444, 0, 0,
-	0, 0, 0, 443, 337, 0, 356, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
-	386, 398, 415, 418, 0, 0, 0, 0, 243, 417,
-	0, 0, 0, 0, 0, 0, 786, 0, 389, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 789, 0,
-	0, 321, 322, 323, 324, 288, 0, 260, 416, 346,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 410, 411, 284,
-	290, 428, 292, 259, 336, 286, 395, 299, 0, 421,
-	0, 422, 0, 0, 0, 0, 796, 783, 792, 784,
-	300, 306, 349, 394, 334, 354, 257, 385, 361, 793,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 373,
-	0, 237, 265, 0, 240, 0, 304, 0, 345, 283,
-	0, 0, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 0, 218, 219, 220, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	333, 0, 0, 233, 234, 235, 236, 0, 0, 0,
-	401, 402, 403, 424, 387, 0, 442, 278, 0, 0,
-	303, 0, 0, 0, 0, 0, 0, 0, 446, 438,
-	0, 0, 0, 434, 0, 435, 0, 0, 0, 0,
-	0, 362, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 0, 0, 0, 0, 0, 0, 255,
-	188, 436, 0, 437, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 258, 0, 2009, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 246, 367, 383, 256, 358,
-	396, 261, 365, 251, 332, 355, 0, 0, 248, 381,
-	364, 314, 297, 298, 247, 0, 350, 276, 289, 273,
-	330, 0, 380, 408, 272, 399, 0, 391, 250, 0,
-	390, 329, 377, 382, 315, 309, 249, 379, 313, 308,
-	301, 280, 423, 293, 341, 307, 342, 294, 319, 318,
-	320, 0, 0, 0, 0, 0, 419, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2008, 393, 0, 0, 0, 2013, 2011,
-	0, 366, 0, 2012, 302, 0, 0, 0, 409, 0,
-	353, 335, 0, 0, 0, 351, 305, 378, 343, 384,
-	368, 392, 347, 344, 241, 369, 275, 316, 252, 254,
-	270, 277, 279, 281, 282, 325, 326, 338, 357, 370,
-	371, 372, 274, 262, 352, 263, 291, 264, 242, 267,
-	266, 268, 359, 269, 244, 339, 376, 0, 287, 348,
-	312, 245, 311, 340, 375, 374, 253, 400, 406, 407,
-	0, 0, 412, 0, 0, 0, 420, 425, 426, 427,
-	429, 430, 431, 432, 0, 0, 0, 0, 414, 0,
-	0, 0, 0, 0, 0, 405, 285, 238, 239, 445,
-	271, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 327, 404, 0, 0, 0, 433, 444,
-	0, 0, 0, 0, 0, 443, 337, 0, 356, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 363, 386, 398, 415, 418, 0, 0, 0, 0,
-	243, 417, 0, 0, 0, 0, 0, 0, 0, 0,
-	389, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	413, 0, 0, 321, 322, 323, 324, 288, 0, 260,
-	416, 346, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 410,
-	411, 284, 290, 428, 292, 259, 336, 286, 395, 299,
-	0, 421, 0, 422, 0, 0, 0, 0, 328, 295,
-	296, 360, 300, 306, 349, 394, 334, 354, 257, 385,
-	361, 310, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 373, 0, 237, 265, 0, 240, 0, 304, 0,
-	345, 283, 0, 0, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 0, 218, 219,
-	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 0, 0, 0, 233, 234, 235, 236, 165,
-	0, 0, 401, 402, 403, 424, 387, 0, 442, 333,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	446, 438, 0, 0, 0, 0, 278, 0, 0, 303,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 434, 0, 435, 0, 0, 113, 0, 0,
-	362, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 162, 1760,
-	0, 187, 0, 0, 0, 0, 0, 0, 255, 188,
-	436, 0, 437, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 258, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 367, 383, 256, 358, 396,
-	261, 365, 251, 332, 355, 0, 0, 248, 381, 364,
-	314, 297, 298, 247, 0, 350, 276, 289, 273, 330,
-	0, 380, 408, 272, 399, 0, 391, 250, 0, 390,
-	329, 377, 382, 315, 309, 249, 379, 313, 308, 301,
-	280, 423, 293, 341, 307, 342, 294, 319, 318, 320,
-	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 393, 0, 0, 0, 0, 0, 0,
-	366, 0, 0, 302, 0, 0, 0, 409, 0, 353,
-	335, 0, 0, 0, 351, 305, 378, 343, 384, 368,
-	392, 347, 344, 241, 369, 275, 316, 252, 254, 270,
-	277, 279, 281, 282, 325, 326, 338, 357, 370, 371,
-	372, 274, 262, 352, 263, 291, 264, 242, 267, 266,
-	268, 359, 269, 244, 339, 376, 0, 287, 348, 312,
-	245, 311, 340, 375, 374, 253, 400, 406, 407, 0,
-	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
-	430, 431, 432, 0, 0, 0, 0, 414, 0, 0,
-	0, 0, 0, 0, 405, 285, 238, 239, 445, 271,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 404, 0, 0, 0, 433, 444, 0,
-	0, 0, 0, 0, 443, 337, 0, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	363, 386, 398, 415, 418, 0, 0, 0, 0, 243,
-	417, 0, 0, 0, 0, 0, 0, 0, 0, 389,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 413,
-	0, 0, 321, 322, 323, 324, 288, 0, 260, 416,
-	346, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 410, 411,
-	284, 290, 428, 292, 259, 336, 286, 395, 299, 0,
-	421, 0, 422, 0, 0, 0, 0, 328, 295, 296,
-	360, 300, 306, 349, 394, 334, 354, 257, 385, 361,
-	310, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 232, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	373, 0, 237, 265, 0, 240, 0, 304, 134, 345,
-	283, 0, 0, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 0, 218, 219, 220,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 0, 0, 0, 233, 234, 235, 236, 165, 0,
-	0, 401, 402, 403, 424, 387, 0, 442, 333, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 446,
-	438, 0, 0, 0, 0, 278, 0, 0, 303, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 434, 0, 435, 0, 0, 113, 0, 0, 362,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 162, 1751, 0,
-	187, 0, 0, 0, 0, 0, 0, 255, 188, 436,
-	0, 437, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 367, 383, 256, 358, 396, 261,
-	365, 251, 332, 355, 0, 0, 248, 381, 364, 314,
-	297, 298, 247, 0, 350, 276, 289, 273, 330, 0,
-	380, 408, 272, 399, 0, 391, 250, 0, 390, 329,
-	377, 382, 315, 309, 249, 379, 313, 308, 301, 280,
-	423, 293, 341, 307, 342, 294, 319, 318, 320, 0,
-	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 0, 0, 0, 0, 0, 0, 366,
-	0, 0, 302, 0, 0, 0, 409, 0, 353, 335,
-	0, 0, 0, 351, 305, 378, 343, 384, 368, 392,
-	347, 344, 241, 369, 275, 316, 252, 254, 270, 277,
-	279, 281, 282, 325, 326, 338, 357, 370, 371, 372,
-	274, 262, 352, 263, 291, 264, 242, 267, 266, 268,
-	359, 269, 244, 339, 376, 0, 287, 348, 312, 245,
-	311, 340, 375, 374, 253, 400, 406, 407, 0, 0,
-	412, 0, 0, 0, 420, 425, 426, 427, 429, 430,
-	431, 432, 0, 0, 0, 0, 414, 0, 0, 0,
-	0, 0, 0, 405, 285, 238, 239, 445, 271, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 404, 0, 0, 0, 433, 444, 0, 0,
-	0, 0, 0, 443, 337, 0, 356, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
-	386, 398, 415, 418, 0, 0, 0, 0, 243, 417,
-	0, 0, 0, 0, 0, 0, 0, 0, 389, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 413, 0,
-	0, 321, 322, 323, 324, 288, 0, 260, 416, 346,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 410, 411, 284,
-	290, 428, 292, 259, 336, 286, 395, 299, 0, 421,
-	0, 422, 0, 0, 0, 0, 328, 295, 296, 360,
-	300, 306, 349, 394, 334, 354, 257, 385, 361, 310,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 373,
-	0, 237, 265, 0, 240, 0, 304, 134, 345, 283,
-	0, 0, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 0, 218, 219, 220, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	0, 0, 0, 233, 234, 235, 236, 165, 0, 0,
-	401, 402, 403, 424, 387, 0, 442, 333, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 446, 438,
-	0, 0, 0, 0, 278, 0, 0, 303, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	434, 0, 435, 0, 0, 113, 0, 0, 362, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1655, 0, 0, 187,
-	0, 0, 0, 0, 0, 0, 255, 188, 436, 0,
-	437, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	258, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 367, 383, 256, 358, 396, 261, 365,
-	251, 332, 355, 0, 0, 248, 381, 364, 314, 297,
-	298, 247, 0, 350, 276, 289, 273, 330, 0, 380,
-	408, 272, 399, 0, 391, 250, 0, 390, 329, 377,
-	382, 315, 309, 249, 379, 313, 308, 301, 280, 423,
-	293, 341, 307, 342, 294, 319, 318, 320, 0, 0,
-	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 393, 0, 0, 0, 0, 0, 0, 366, 0,
-	0, 302, 0, 0, 0, 409, 0, 353, 335, 0,
-	0, 0, 351, 305, 378, 343, 384, 368, 392, 347,
-	344, 241, 369, 275, 316, 252, 254, 270, 277, 279,
-	281, 282, 325, 326, 338, 357, 370, 371, 372, 274,
-	262, 352, 263, 291, 264, 242, 267, 266, 268, 359,
-	269, 244, 339, 376, 0, 287, 348, 312, 245, 311,
-	340, 375, 374, 253, 400, 406, 407, 0, 0, 412,
-	0, 0, 0, 420, 425, 426, 427, 429, 430, 431,
-	432, 0, 0, 0, 0, 414, 0, 0, 0, 0,
-	0, 0, 405, 285, 238, 239, 445, 271, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	327, 404, 0, 0, 0, 433, 444, 0, 0, 0,
-	0, 0, 443, 337, 0, 356, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 363, 386,
-	398, 415, 418, 0, 0, 0, 0, 243, 417, 0,
-	0, 0, 0, 0, 0, 0, 0, 389, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 413, 0, 0,
-	321, 322, 323, 324, 288, 0, 260, 416, 346, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 410, 411, 284, 290,
-	428, 292, 259, 336, 286, 395, 299, 0, 421, 0,
-	422, 0, 0, 0, 0, 328, 295, 296, 360, 300,
-	306, 349, 394, 334, 354, 257, 385, 361, 310, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 373, 0,
-	237, 265, 0, 240, 0, 304, 134, 345, 283, 0,
-	0, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 0, 218, 219, 220, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 333,
-	0, 0, 233, 234, 235, 236, 0, 0, 0, 401,
-	402, 403, 424, 387, 0, 442, 278, 0, 0, 303,
-	0, 0, 0, 0, 0, 0, 0, 446, 438, 0,
-	0, 0, 434, 0, 435, 0, 0, 0, 0, 0,
-	362, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 794, 795, 0, 0, 0, 0, 255, 188,
-	436, 0, 437, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 798, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 367, 383, 256, 358, 396,
-	261, 365, 251, 332, 355, 0, 0, 248, 381, 364,
-	314, 297, 298, 247, 0, 350, 276, 289, 273, 330,
-	0, 380, 408, 272, 399, 773, 391, 250, 772, 390,
-	329, 377, 382, 315, 309, 249, 379, 313, 308, 301,
-	280, 423, 293, 341, 307, 342, 294, 319, 318, 320,
-	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 393, 0, 0, 0, 0, 0, 0,
-	366, 0, 0, 302, 0, 0, 0, 409, 0, 353,
-	335, 0, 0, 0, 351, 305, 378, 343, 384, 368,
-	392, 347, 344, 241, 369, 275, 316, 252, 254, 270,
-	277, 279, 281, 282, 325, 326, 338, 357, 370, 371,
-	372, 274, 262, 352, 263, 291, 264, 242, 267, 266,
-	268, 359, 269, 244, 339, 376, 0, 287, 348, 312,
-	245, 311, 340, 375, 374, 253, 400, 406, 407, 0,
-	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
-	430, 431, 432, 0, 0, 0, 0, 414, 0, 0,
-	0, 0, 0, 0, 405, 285, 238, 239, 445, 271,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 404, 0, 0, 0, 433, 444, 0,
-	0, 0, 0, 0, 443, 337, 0, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	363, 386, 398, 415, 418, 0, 0, 0, 0, 243,
-	417, 0, 0, 0, 0, 0, 0, 0, 0, 389,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 413,
-	0, 0, 321, 322, 323, 324, 288, 0, 260, 416,
-	346, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 410, 411,
-	284, 290, 428, 292, 259, 336, 286, 395, 299, 0,
-	421, 0, 422, 0, 0, 0, 0, 796, 1674, 792,
-	1675, 300, 306, 349, 394, 334, 354, 257, 385, 361,
-	793, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 232, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	373, 0, 237, 265, 0, 240, 0, 304, 0, 345,
-	283, 0, 0, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 0, 218, 219, 220,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 333, 0, 0, 233, 234, 235, 236, 0, 0,
-	2409, 401, 402, 403, 424, 387, 0, 442, 278, 0,
-	0, 303, 0, 0, 0, 0, 0, 0, 0, 446,
-	438, 0, 0, 0, 434, 0, 435, 0, 0, 0,
-	0, 0, 362, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 0, 0, 0, 0, 0, 0,
-	255, 188, 436, 0, 437, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 258, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 367, 383, 256,
-	358, 396, 261, 365, 251, 332, 355, 0, 0, 248,
-	381, 364, 314, 297, 298, 247, 0, 350, 276, 289,
-	273, 330, 0, 380, 408, 272, 399, 0, 391, 250,
-	0, 390, 329, 377, 382, 315, 309, 249, 379, 313,
-	308, 301, 280, 423, 293, 341, 307, 342, 294, 319,
-	318, 320, 0, 0, 0, 0, 0, 419, 0, 0,
-	0, 0, 0, 0, 0, 0, 2412, 0, 0, 2411,
-	0, 0, 0, 0, 0, 393, 0, 0, 0, 0,
-	0, 0, 366, 0, 0, 302, 0, 0, 0, 409,
-	0, 353, 335, 0, 0, 0, 351, 305, 378, 343,
-	384, 368, 392, 347, 344, 241, 369, 275, 316, 252,
-	254, 270, 277, 279, 281, 282, 325, 326, 338, 357,
-	370, 371, 372, 274, 262, 352, 263, 291, 264, 242,
-	267, 266, 268, 359, 269, 244, 339, 376, 0, 287,
-	348, 312, 245, 311, 340, 375, 374, 253, 400, 406,
-	407, 0, 0, 412, 0, 0, 0, 420, 425, 426,
-	427, 429, 430, 431, 432, 0, 0, 0, 0, 414,
-	0, 0, 0, 0, 0, 0, 405, 285, 238, 239,
-	445, 271, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 327, 404, 0, 0, 0, 433,
-	444, 0, 0, 0, 0, 0, 443, 337, 0, 356,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 363, 386, 398, 415, 418, 0, 0, 0,
-	0, 243, 417, 0, 0, 0, 0, 0, 0, 0,
-	0, 389, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 413, 0, 0, 321, 322, 323, 324, 288, 0,
-	260, 416, 346, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	410, 411, 284, 290, 428, 292, 259, 336, 286, 395,
-	299, 0, 421, 0, 422, 0, 0, 0, 0, 328,
-	295, 296, 360, 300, 306, 349, 394, 334, 354, 257,
-	385, 361, 310, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 232, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 373, 0, 237, 265, 0, 240, 0, 304,
-	0, 345, 283, 0, 0, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 0, 218,
-	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 333, 0, 0, 233, 234, 235, 236,
-	0, 0, 0, 401, 402, 403, 424, 387, 0, 442,
-	278, 1221, 0, 303, 0, 0, 0, 0, 0, 0,
-	0, 446, 438, 0, 0, 0, 434, 0, 435, 0,
-	0, 0, 0, 0, 362, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 0, 0, 1219, 0,
-	0, 0, 255, 188, 436, 0, 437, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 258, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1217, 0, 0, 0, 0, 0, 0, 246, 367,
-	383, 256, 358, 396, 261, 365, 251, 332, 355, 0,
-	0, 248, 381, 364, 314, 297, 298, 247, 0, 350,
-	276, 289, 273, 330, 0, 380, 408, 272, 399, 0,
-	391, 250, 0, 390, 329, 377, 382, 315, 309, 249,
-	379, 313, 308, 301, 280, 423, 293, 341, 307, 342,
-	294, 319, 318, 320, 0, 0, 0, 0, 0, 419,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 393, 0, 0,
-	0, 0, 0, 0, 366, 0, 0, 302, 0, 0,
-	0, 409, 0, 353, 335, 0, 0, 0, 351, 305,
-	378, 343, 384, 368, 392, 347, 344, 241, 369, 275,
-	316, 252, 254, 270, 277, 279, 281, 282, 325, 326,
-	338, 357, 370, 371, 372, 274, 262, 352, 263, 291,
-	264, 242, 267, 266, 268, 359, 269, 244, 339, 376,
-	0, 287, 348, 312, 245, 311, 340, 375, 374, 253,
-	400, 406, 407, 0, 0, 412, 0, 0, 0, 420,
-	425, 426, 427, 429, 430, 431, 432, 0, 0, 0,
-	0, 414, 0, 0, 0, 0, 0, 0, 405, 285,
-	238, 239, 445, 271, 331, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 327, 404, 0, 0,
-	0, 433, 444, 0, 0, 0, 0, 0, 443, 337,
-	0, 356, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 363, 386, 398, 415, 418, 0,
-	0, 0, 0, 243, 417, 0, 0, 0, 0, 0,
-	0, 0, 0, 389, 0, 0, 0, 397, 0, 0,
-	0, 0, 0, 413, 0, 0, 321, 322, 323, 324,
-	288, 0, 260, 416, 346, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 410, 411, 284, 290, 428, 292, 259, 336,
-	286, 395, 299, 0, 421, 0, 422, 0, 0, 0,
-	0, 328, 295, 296, 360, 300, 306, 349, 394, 334,
-	354, 257, 385, 361, 310, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 232, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 373, 0, 237, 265, 0, 240,
-	0, 304, 0, 345, 283, 0, 0, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
-	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	0, 218, 219, 220, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 333, 0, 0, 233, 234,
-	235, 236, 0, 0, 0, 401, 402, 403, 424, 387,
-	0, 442, 278, 1215, 0, 303, 0, 0, 0, 0,
-	0, 0, 0, 446, 438, 0, 0, 0, 434, 0,
-	435, 0, 0, 0, 0, 0, 362, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
-	1219, 0, 0, 0, 255, 188, 436, 0, 437, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 258, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 0, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 238, 239, 240, 241, 1921, 0, 0,
+	248, 249, 250, 251, 252, 253, 254, 255, 243, 244,
+	245, 246, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 1049, 1048, 1058, 1059, 1051, 1052, 1053, 1054, 1055,
+	1056, 1057, 1050, 0, 0, 0, 0, 0, 0, 0,
+	247, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 0, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
+	0, 0, 0, 248, 249, 250, 251, 252, 253, 254,
+	255, 243, 244, 245, 246, 0, 0, 0, 0, 0,
+	174, 55, 166, 139, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 167, 0,
+	0, 0, 0, 0, 0, 159, 0, 0, 0, 168,
+	0, 0, 0, 0, 0, 0, 0, 0, 1780, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 118, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 106, 0, 0, 0, 0, 0, 171,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 939, 0, 0, 0, 0, 0, 0, 0,
+	0, 120, 0, 0, 0, 0, 0, 0, 2460, 0,
+	0, 0, 0, 0, 2467, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	120, 0, 0, 0, 548, 547, 554, 544, 0, 0,
+	0, 0, 0, 0, 0, 0, 551, 552, 120, 553,
+	557, 0, 247, 539, 0, 124, 125, 0, 126, 127,
+	1766, 0, 0, 562, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 0, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 239,
+	240, 241, 0, 0, 0, 248, 249, 250, 251, 252,
+	253, 254, 255, 243, 244, 245, 246, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 165, 172, 120, 104,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 158, 157,
+	0, 0, 0, 0, 61, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 120, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2642, 2643, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 160, 161, 162, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 169, 0, 0, 540,
+	542, 541, 0, 0, 0, 0, 0, 0, 0, 546,
+	0, 0, 0, 0, 0, 0, 114, 0, 0, 0,
+	163, 550, 115, 0, 0, 0, 0, 0, 565, 0,
+	0, 0, 0, 481, 0, 0, 543, 0, 0, 0,
+	0, 636, 0, 0, 0, 0, 0, 0, 0, 0,
+	353, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 591, 0, 0, 0, 297, 0, 0,
+	323, 0, 0, 0, 0, 0, 0, 0, 0, 116,
+	0, 0, 0, 464, 0, 465, 0, 0, 627, 0,
+	54, 382, 337, 0, 0, 0, 0, 695, 703, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 584,
+	0, 0, 617, 672, 671, 604, 614, 0, 2790, 273,
+	197, 466, 0, 467, 605, 0, 613, 606, 610, 609,
+	607, 608, 0, 687, 0, 0, 0, 0, 0, 56,
+	575, 588, 0, 592, 545, 549, 555, 0, 556, 558,
+	0, 0, 559, 560, 561, 0, 0, 563, 564, 0,
+	0, 0, 0, 0, 0, 0, 0, 585, 586, 0,
+	0, 0, 0, 637, 0, 587, 0, 140, 632, 611,
+	615, 0, 0, 0, 0, 264, 387, 403, 274, 378,
+	421, 279, 385, 269, 352, 375, 0, 0, 266, 401,
+	384, 334, 317, 318, 265, 0, 370, 295, 309, 291,
+	350, 612, 635, 639, 290, 709, 633, 416, 268, 0,
+	415, 349, 397, 402, 335, 329, 267, 399, 333, 328,
+	321, 299, 710, 449, 450, 313, 361, 327, 362, 314,
+	339, 338, 340, 0, 117, 40, 0, 0, 444, 0,
+	0, 53, 1806, 0, 0, 121, 122, 0, 0, 0,
+	0, 0, 630, 0, 0, 0, 418, 0, 0, 693,
+	0, 123, 0, 386, 0, 0, 322, 0, 0, 0,
+	634, 0, 373, 355, 706, 576, 0, 371, 303, 325,
+	398, 363, 404, 388, 417, 367, 364, 259, 389, 293,
+	336, 270, 272, 288, 296, 298, 300, 301, 345, 346,
+	358, 377, 390, 391, 392, 292, 280, 372, 281, 311,
+	282, 260, 285, 284, 286, 379, 287, 262, 359, 396,
+	0, 307, 368, 332, 263, 331, 360, 395, 394, 271,
+	425, 431, 432, 0, 0, 437, 0, 0, 0, 445,
+	455, 456, 457, 459, 460, 461, 462, 120, 0, 0,
+	0, 439, 0, 0, 451, 452, 453, 0, 1540, 1539,
+	1541, 430, 2944, 305, 256, 257, 489, 691, 351, 0,
+	0, 0, 0, 705, 686, 688, 689, 692, 696, 697,
+	698, 699, 700, 702, 704, 708, 487, 0, 0, 0,
+	0, 0, 486, 357, 0, 376, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 383, 411,
+	423, 440, 443, 0, 0, 0, 0, 261, 442, 0,
+	0, 120, 0, 0, 0, 0, 0, 707, 0, 0,
+	0, 422, 0, 0, 0, 0, 0, 638, 0, 0,
+	341, 342, 343, 344, 694, 0, 278, 441, 366, 0,
+	0, 0, 0, 2978, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 436, 304, 310,
+	458, 312, 277, 356, 306, 420, 319, 0, 446, 0,
+	447, 0, 0, 0, 0, 348, 315, 316, 380, 320,
+	326, 369, 419, 354, 374, 275, 410, 381, 330, 0,
+	0, 716, 690, 715, 717, 718, 714, 719, 720, 701,
+	594, 0, 642, 712, 711, 713, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 393, 0,
+	247, 283, 294, 600, 258, 0, 324, 0, 365, 302,
+	0, 0, 679, 648, 649, 650, 593, 651, 645, 646,
+	647, 680, 640, 676, 677, 619, 643, 652, 675, 653,
+	678, 681, 682, 721, 722, 659, 723, 656, 683, 674,
+	673, 654, 641, 684, 685, 626, 621, 657, 658, 644,
+	660, 661, 662, 666, 667, 668, 669, 670, 665, 663,
+	664, 622, 623, 624, 625, 0, 0, 0, 426, 427,
+	428, 454, 412, 0, 485, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 490, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 405, 406, 407, 408,
+	409, 488, 0, 477, 478, 479, 480, 0, 0, 481,
+	0, 0, 0, 0, 0, 0, 0, 636, 0, 0,
+	0, 0, 0, 0, 0, 0, 353, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 591,
+	0, 0, 0, 297, 1590, 0, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
+	0, 465, 0, 0, 627, 0, 0, 382, 337, 0,
+	0, 0, 3201, 695, 703, 0, 0, 0, 0, 0,
+	0, 0, 1791, 3218, 0, 584, 0, 0, 617, 672,
+	671, 604, 614, 0, 0, 273, 197, 466, 0, 467,
+	605, 0, 613, 606, 610, 609, 607, 608, 0, 687,
+	0, 0, 0, 0, 0, 0, 575, 588, 0, 592,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 585, 586, 0, 0, 0, 0, 637,
+	0, 587, 0, 0, 1792, 611, 615, 0, 0, 0,
+	0, 264, 387, 403, 274, 378, 421, 279, 385, 269,
+	352, 375, 0, 0, 266, 401, 384, 334, 317, 318,
+	265, 0, 370, 295, 309, 291, 350, 612, 635, 639,
+	290, 709, 633, 416, 268, 0, 415, 349, 397, 402,
+	335, 329, 267, 399, 333, 328, 321, 299, 710, 449,
+	450, 313, 361, 327, 362, 314, 339, 338, 340, 0,
+	0, 0, 0, 0, 444, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 630, 0,
+	0, 0, 418, 0, 0, 693, 0, 0, 0, 386,
+	0, 0, 322, 0, 0, 0, 634, 0, 373, 355,
+	706, 576, 0, 371, 303, 325, 398, 363, 404, 388,
+	417, 367, 364, 259, 389, 293, 336, 270, 272, 288,
+	296, 298, 300, 301, 345, 346, 358, 377, 390, 391,
+	392, 292, 280, 372, 281, 311, 282, 260, 285, 284,
+	286, 379, 287, 262, 359, 396, 0, 307, 368, 332,
+	263, 331, 360, 395, 394, 271, 425, 431, 432, 0,
+	0, 437, 0, 0, 0, 445, 455, 456, 457, 459,
+	460, 461, 462, 0, 0, 0, 0, 439, 0, 0,
+	451, 452, 453, 0, 0, 0, 0, 430, 0, 305,
+	256, 257, 489, 691, 351, 0, 0, 0, 0, 705,
+	686, 688, 689, 692, 696, 697, 698, 699, 700, 702,
+	704, 708, 487, 0, 0, 0, 0, 0, 486, 357,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 383, 411, 423, 440, 443, 0,
+	0, 0, 0, 261, 442, 0, 0, 0, 0, 0,
+	0, 0, 0, 707, 0, 0, 0, 422, 0, 0,
+	0, 0, 0, 638, 0, 0, 341, 342, 343, 344,
+	694, 0, 278, 441, 366, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 436, 304, 310, 458, 312, 277, 356,
+	306, 420, 319, 0, 446, 0, 447, 0, 0, 0,
+	0, 348, 315, 316, 380, 320, 326, 369, 419, 354,
+	374, 275, 410, 381, 330, 0, 0, 716, 690, 715,
+	717, 718, 714, 719, 720, 701, 594, 0, 642, 712,
+	711, 713, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 393, 0, 247, 283, 294, 600,
+	258, 0, 324, 0, 365, 302, 0, 0, 679, 648,
+	649, 650, 593, 651, 645, 646, 647, 680, 640, 676,
+	677, 619, 643, 652, 675, 653, 678, 681, 682, 721,
+	722, 659, 723, 656, 683, 674, 673, 654, 641, 684,
+	685, 626, 621, 657, 658, 644, 660, 661, 662, 666,
+	667, 668, 669, 670, 665, 663, 664, 622, 623, 624,
+	625, 0, 0, 0, 426, 427, 428, 454, 412, 0,
+	485, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 490, 468, 469, 470, 471, 472, 473, 474,
+	475, 476, 405, 406, 407, 408, 409, 488, 481, 477,
+	478, 479, 480, 0, 0, 174, 636, 0, 0, 0,
+	0, 0, 0, 0, 0, 353, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 591, 0,
+	0, 0, 297, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 0,
+	465, 0, 0, 1069, 0, 0, 382, 337, 0, 0,
+	0, 0, 695, 703, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 584, 0, 0, 617, 672, 671,
+	604, 614, 0, 0, 273, 197, 466, 0, 467, 605,
+	0, 613, 606, 610, 609, 607, 608, 0, 687, 0,
+	0, 0, 0, 0, 0, 575, 588, 0, 592, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 585, 586, 0, 0, 0, 0, 637, 0,
+	587, 0, 0, 632, 611, 615, 0, 0, 0, 0,
+	264, 387, 403, 274, 378, 421, 279, 385, 269, 352,
+	375, 0, 0, 266, 401, 384, 334, 317, 318, 265,
+	0, 370, 295, 309, 291, 350, 612, 635, 639, 290,
+	709, 633, 416, 268, 0, 415, 349, 397, 402, 335,
+	329, 267, 399, 333, 328, 321, 299, 710, 449, 450,
+	313, 361, 327, 362, 314, 339, 338, 340, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 630, 0, 0,
+	0, 418, 0, 0, 693, 0, 0, 0, 386, 0,
+	0, 322, 0, 0, 0, 634, 0, 373, 355, 706,
+	576, 0, 371, 303, 325, 398, 363, 404, 388, 417,
+	367, 364, 259, 389, 293, 336, 270, 272, 288, 296,
+	298, 300, 301, 345, 346, 358, 377, 390, 391, 392,
+	292, 280, 372, 281, 311, 282, 260, 285, 284, 286,
+	379, 287, 262, 359, 396, 0, 307, 368, 332, 263,
+	331, 360, 395, 394, 271, 425, 431, 432, 0, 0,
+	437, 0, 0, 0, 445, 455, 456, 457, 459, 460,
+	461, 462, 0, 0, 0, 0, 439, 0, 0, 451,
+	452, 453, 0, 0, 0, 0, 430, 0, 305, 256,
+	257, 489, 691, 351, 0, 0, 0, 0, 705, 686,
+	688, 689, 692, 696, 697, 698, 699, 700, 702, 704,
+	708, 487, 0, 0, 0, 0, 0, 486, 357, 0,
+	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 411, 423, 440, 443, 0, 0,
+	0, 0, 261, 442, 0, 0, 0, 0, 0, 0,
+	0, 0, 707, 0, 0, 0, 422, 0, 0, 0,
+	0, 0, 638, 0, 0, 341, 342, 343, 344, 694,
+	0, 278, 441, 366, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 436, 304, 310, 458, 312, 277, 356, 306,
+	420, 319, 0, 446, 0, 447, 0, 0, 0, 0,
+	348, 315, 316, 380, 320, 326, 369, 419, 354, 374,
+	275, 410, 381, 330, 0, 0, 716, 690, 715, 717,
+	718, 714, 719, 720, 701, 594, 0, 642, 712, 711,
+	713, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 393, 0, 247, 283, 294, 600, 258,
+	0, 324, 140, 365, 302, 0, 0, 679, 648, 649,
+	650, 593, 651, 645, 646, 647, 680, 640, 676, 677,
+	619, 643, 652, 675, 653, 678, 681, 682, 721, 722,
+	659, 723, 656, 683, 674, 673, 654, 641, 684, 685,
+	626, 621, 657, 658, 644, 660, 661, 662, 666, 667,
+	668, 669, 670, 665, 663, 664, 622, 623, 624, 625,
+	0, 0, 0, 426, 427, 428, 454, 412, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 490, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 405, 406, 407, 408, 409, 488, 481, 477, 478,
+	479, 480, 0, 0, 0, 636, 0, 0, 0, 0,
+	0, 0, 0, 0, 353, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 591, 0, 0,
+	0, 297, 3603, 0, 323, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 464, 0, 465,
+	0, 0, 627, 0, 0, 382, 337, 0, 0, 0,
+	0, 695, 703, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 584, 0, 0, 617, 672, 671, 604,
+	614, 0, 0, 273, 197, 466, 0, 467, 605, 0,
+	613, 606, 610, 609, 607, 608, 0, 687, 0, 0,
+	0, 0, 0, 0, 575, 588, 0, 592, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 585, 586, 0, 0, 0, 0, 637, 0, 587,
+	0, 0, 632, 611, 615, 0, 0, 0, 0, 264,
+	387, 403, 274, 378, 421, 279, 385, 269, 352, 375,
+	0, 0, 266, 401, 384, 334, 317, 318, 265, 0,
+	370, 295, 309, 291, 350, 612, 635, 639, 290, 709,
+	633, 416, 268, 0, 415, 349, 397, 402, 335, 329,
+	267, 399, 333, 328, 321, 299, 710, 449, 450, 313,
+	361, 327, 362, 314, 339, 338, 340, 0, 0, 0,
+	0, 0, 444, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 630, 0, 0, 0,
+	418, 0, 0, 693, 0, 0, 0, 386, 0, 0,
+	322, 0, 0, 0, 634, 0, 373, 355, 706, 576,
+	0, 371, 303, 325, 398, 363, 404, 388, 417, 367,
+	364, 259, 389, 293, 336, 270, 272, 288, 296, 298,
+	300, 301, 345, 346, 358, 377, 390, 391, 392, 292,
+	280, 372, 281, 311, 282, 260, 285, 284, 286, 379,
+	287, 262, 359, 396, 0, 307, 368, 332, 263, 331,
+	360, 395, 394, 271, 425, 431, 432, 0, 0, 437,
+	0, 0, 0, 445, 455, 456, 457, 459, 460, 461,
+	462, 0, 0, 0, 0, 439, 0, 0, 451, 452,
+	453, 0, 0, 0, 0, 430, 0, 305, 256, 257,
+	489, 691, 351, 0, 0, 0, 0, 705, 686, 688,
+	689, 692, 696, 697, 698, 699, 700, 702, 704, 708,
+	487, 0, 0, 0, 0, 0, 486, 357, 0, 376,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 383, 411, 423, 440, 443, 0, 0, 0,
+	0, 261, 442, 0, 0, 0, 0, 0, 0, 0,
+	0, 707, 0, 0, 0, 422, 0, 0, 0, 0,
+	0, 638, 0, 0, 341, 342, 343, 344, 694, 0,
+	278, 441, 366, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 436, 304, 310, 458, 312, 277, 356, 306, 420,
+	319, 0, 446, 0, 447, 0, 0, 0, 0, 348,
+	315, 316, 380, 320, 326, 369, 419, 354, 374, 275,
+	410, 381, 330, 0, 0, 716, 690, 715, 717, 718,
+	714, 719, 720, 701, 594, 0, 642, 712, 711, 713,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 393, 0, 247, 283, 294, 600, 258, 0,
+	324, 0, 365, 302, 0, 0, 679, 648, 649, 650,
+	593, 651, 645, 646, 647, 680, 640, 676, 677, 619,
+	643, 652, 675, 653, 678, 681, 682, 721, 722, 659,
+	723, 656, 683, 674, 673, 654, 641, 684, 685, 626,
+	621, 657, 658, 644, 660, 661, 662, 666, 667, 668,
+	669, 670, 665, 663, 664, 622, 623, 624, 625, 0,
+	0, 0, 426, 427, 428, 454, 412, 0, 485, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	490, 468, 469, 470, 471, 472, 473, 474, 475, 476,
+	405, 406, 407, 408, 409, 488, 481, 477, 478, 479,
+	480, 0, 0, 0, 636, 0, 0, 0, 0, 0,
+	0, 0, 0, 353, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 591, 0, 0, 0,
+	297, 1590, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 0, 465, 0,
+	0, 627, 0, 0, 382, 337, 0, 0, 0, 0,
+	695, 703, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 584, 0, 0, 617, 672, 671, 604, 614,
+	0, 0, 273, 197, 466, 0, 467, 605, 0, 613,
+	606, 610, 609, 607, 608, 0, 687, 0, 0, 0,
+	0, 0, 0, 575, 588, 0, 592, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	585, 586, 0, 0, 0, 0, 637, 0, 587, 0,
+	0, 632, 611, 615, 0, 0, 0, 0, 264, 387,
+	403, 274, 378, 421, 279, 385, 269, 352, 375, 0,
+	0, 266, 401, 384, 334, 317, 318, 265, 0, 370,
+	295, 309, 291, 350, 612, 635, 639, 290, 709, 633,
+	416, 268, 0, 415, 349, 397, 402, 335, 329, 267,
+	399, 333, 328, 321, 299, 710, 449, 450, 313, 361,
+	327, 362, 314, 339, 338, 340, 0, 0, 0, 0,
+	0, 444, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 630, 0, 0, 0, 418,
+	0, 0, 693, 0, 0, 0, 386, 0, 0, 322,
+	0, 0, 0, 634, 0, 373, 355, 706, 576, 0,
+	371, 303, 325, 398, 363, 404, 388, 417, 367, 364,
+	259, 389, 293, 336, 270, 272, 288, 296, 298, 300,
+	301, 345, 346, 358, 377, 390, 391, 392, 292, 280,
+	372, 281, 311, 282, 260, 285, 284, 286, 379, 287,
+	262, 359, 396, 0, 307, 368, 332, 263, 331, 360,
+	395, 394, 271, 425, 431, 432, 0, 0, 437, 0,
+	0, 0, 445, 455, 456, 457, 459, 460, 461, 462,
+	0, 0, 0, 0, 439, 0, 0, 451, 452, 453,
+	0, 0, 0, 0, 430, 0, 305, 256, 257, 489,
+	691, 351, 0, 0, 0, 0, 705, 686, 688, 689,
+	692, 696, 697, 698, 699, 700, 702, 704, 708, 487,
+	0, 0, 0, 0, 0, 486, 357, 0, 376, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 411, 423, 440, 443, 0, 0, 0, 0,
+	261, 442, 0, 0, 0, 0, 0, 0, 0, 0,
+	707, 0, 0, 0, 422, 0, 0, 0, 0, 0,
+	638, 0, 0, 341, 342, 343, 344, 694, 0, 278,
+	441, 366, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	436, 304, 310, 458, 312, 277, 356, 306, 420, 319,
+	0, 446, 0, 447, 0, 0, 0, 0, 348, 315,
+	316, 380, 320, 326, 369, 419, 354, 374, 275, 410,
+	381, 330, 0, 0, 716, 690, 715, 717, 718, 714,
+	719, 720, 701, 594, 0, 642, 712, 711, 713, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 393, 0, 247, 283, 294, 600, 258, 0, 324,
+	0, 365, 302, 0, 0, 679, 648, 649, 650, 593,
+	651, 645, 646, 647, 680, 640, 676, 677, 619, 643,
+	652, 675, 653, 678, 681, 682, 721, 722, 659, 723,
+	656, 683, 674, 673, 654, 641, 684, 685, 626, 621,
+	657, 658, 644, 660, 661, 662, 666, 667, 668, 669,
+	670, 665, 663, 664, 622, 623, 624, 625, 0, 0,
+	0, 426, 427, 428, 454, 412, 0, 485, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 405,
+	406, 407, 408, 409, 488, 481, 477, 478, 479, 480,
+	0, 0, 0, 636, 0, 0, 0, 0, 0, 0,
+	0, 0, 353, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 591, 0, 0, 0, 297,
+	0, 0, 323, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 464, 0, 465, 0, 0,
+	627, 0, 0, 382, 337, 0, 0, 0, 0, 695,
+	703, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 584, 0, 0, 617, 672, 671, 604, 614, 0,
+	0, 273, 197, 466, 0, 467, 605, 0, 613, 606,
+	610, 609, 607, 608, 0, 687, 0, 0, 0, 0,
+	0, 0, 575, 588, 0, 592, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 585,
+	586, 1331, 0, 0, 0, 637, 0, 587, 0, 0,
+	632, 611, 615, 0, 0, 0, 0, 264, 387, 403,
+	274, 378, 421, 279, 385, 269, 352, 375, 0, 0,
+	266, 401, 384, 334, 317, 318, 265, 0, 370, 295,
+	309, 291, 350, 612, 635, 639, 290, 709, 633, 416,
+	268, 0, 415, 349, 397, 402, 335, 329, 267, 399,
+	333, 328, 321, 299, 710, 449, 450, 313, 361, 327,
+	362, 314, 339, 338, 340, 0, 0, 0, 0, 0,
+	444, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 630, 0, 0, 0, 418, 0,
+	0, 693, 0, 0, 0, 386, 0, 0, 322, 0,
+	0, 0, 634, 0, 373, 355, 706, 576, 0, 371,
+	303, 325, 398, 363, 404, 388, 417, 367, 364, 259,
+	389, 293, 336, 270, 272, 288, 296, 298, 300, 301,
+	345, 346, 358, 377, 390, 391, 392, 292, 280, 372,
+	281, 311, 282, 260, 285, 284, 286, 379, 287, 262,
+	359, 396, 0, 307, 368, 332, 263, 331, 360, 395,
+	394, 271, 425, 431, 432, 0, 0, 437, 0, 0,
+	0, 445, 455, 456, 457, 459, 460, 461, 462, 0,
+	0, 0, 0, 439, 0, 0, 451, 452, 453, 0,
+	0, 0, 0, 430, 0, 305, 256, 257, 489, 691,
+	351, 0, 0, 0, 0, 705, 686, 688, 689, 692,
+	696, 697, 698, 699, 700, 702, 704, 708, 487, 0,
+	0, 0, 0, 0, 486, 357, 0, 376, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	383, 411, 423, 440, 443, 0, 0, 0, 0, 261,
+	442, 0, 0, 0, 0, 0, 0, 0, 0, 707,
+	0, 0, 0, 422, 0, 0, 0, 0, 0, 638,
+	0, 0, 341, 342, 343, 344, 694, 0, 278, 441,
+	366, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 436,
+	304, 310, 458, 312, 277, 356, 306, 420, 319, 0,
+	446, 0, 447, 0, 0, 0, 0, 348, 315, 316,
+	380, 320, 326, 369, 419, 354, 374, 275, 410, 381,
+	330, 0, 0, 716, 690, 715, 717, 718, 714, 719,
+	720, 701, 594, 0, 642, 712, 711, 713, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	393, 0, 247, 283, 294, 600, 258, 0, 324, 0,
+	365, 302, 0, 0, 679, 648, 649, 650, 593, 651,
+	645, 646, 647, 680, 640, 676, 677, 619, 643, 652,
+	675, 653, 678, 681, 682, 721, 722, 659, 723, 656,
+	683, 674, 673, 654, 641, 684, 685, 626, 621, 657,
+	658, 644, 660, 661, 662, 666, 667, 668, 669, 670,
+	665, 663, 664, 622, 623, 624, 625, 0, 0, 0,
+	426, 427, 428, 454, 412, 0, 485, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 490, 468,
+	469, 470, 471, 472, 473, 474, 475, 476, 405, 406,
+	407, 408, 409, 488, 481, 477, 478, 479, 480, 0,
+	0, 0, 636, 0, 0, 1943, 0, 0, 0, 0,
+	0, 353, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 591, 0, 0, 0, 297, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 0, 465, 0, 0, 627,
+	0, 0, 382, 337, 0, 0, 0, 0, 695, 703,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	584, 0, 0, 617, 672, 671, 604, 614, 0, 0,
+	273, 197, 466, 0, 467, 605, 0, 613, 606, 610,
+	609, 607, 608, 0, 687, 0, 0, 0, 0, 0,
+	0, 575, 588, 0, 592, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 585, 586,
+	0, 0, 0, 0, 637, 0, 587, 0, 0, 632,
+	611, 615, 0, 0, 0, 0, 264, 387, 403, 274,
+	378, 421, 279, 385, 269, 352, 375, 0, 0, 266,
+	401, 384, 334, 317, 318, 265, 0, 370, 295, 309,
+	291, 350, 612, 635, 639, 290, 709, 633, 416, 268,
+	0, 415, 349, 397, 402, 335, 329, 267, 399, 333,
+	328, 321, 299, 710, 449, 450, 313, 361, 327, 362,
+	314, 339, 338, 340, 0, 0, 0, 0, 0, 444,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 630, 0, 0, 0, 418, 0, 0,
+	693, 0, 0, 0, 386, 0, 0, 322, 0, 0,
+	0, 634, 0, 373, 355, 706, 576, 0, 371, 303,
+	325, 398, 363, 404, 388, 417, 367, 364, 259, 389,
+	293, 336, 270, 272, 288, 296, 298, 300, 301, 345,
+	346, 358, 377, 390, 391, 392, 292, 280, 372, 281,
+	311, 282, 260, 285, 284, 286, 379, 287, 262, 359,
+	396, 0, 307, 368, 332, 263, 331, 360, 395, 394,
+	271, 425, 431, 432, 0, 0, 437, 0, 0, 0,
+	445, 455, 456, 457, 459, 460, 461, 462, 0, 0,
+	0, 0, 439, 0, 0, 451, 452, 453, 0, 0,
+	0, 0, 430, 0, 305, 256, 257, 489, 691, 351,
+	0, 0, 0, 0, 705, 686, 688, 689, 692, 696,
+	697, 698, 699, 700, 702, 704, 708, 487, 0, 0,
+	0, 0, 0, 486, 357, 0, 376, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	411, 423, 440, 443, 0, 0, 0, 0, 261, 442,
+	0, 0, 0, 0, 0, 0, 0, 0, 707, 0,
+	0, 0, 422, 0, 0, 0, 0, 0, 638, 0,
+	0, 341, 342, 343, 344, 694, 0, 278, 441, 366,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 435, 436, 304,
+	310, 458, 312, 277, 356, 306, 420, 319, 0, 446,
+	0, 447, 0, 0, 0, 0, 348, 315, 316, 380,
+	320, 326, 369, 419, 354, 374, 275, 410, 381, 330,
+	0, 0, 716, 690, 715, 717, 718, 714, 719, 720,
+	701, 594, 0, 642, 712, 711, 713, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1217, 0, 0, 0, 0, 0, 0,
-	246, 367, 383, 256, 358, 396, 261, 365, 251, 332,
-	355, 0, 0, 248, 381, 364, 314, 297, 298, 247,
-	0, 350, 276, 289, 273, 330, 0, 380, 408, 272,
-	399, 0, 391, 250, 0, 390, 329, 377, 382, 315,
-	309, 249, 379, 313, 308, 301, 280, 423, 293, 341,
-	307, 342, 294, 319, 318, 320, 0, 0, 0, 0,
-	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	0, 0, 0, 0, 0, 0, 366, 0, 0, 302,
-	0, 0, 0, 409, 0, 353, 335, 0, 0, 0,
-	351, 305, 378, 343, 384, 368, 392, 347, 344, 241,
-	369, 275, 316, 252, 254, 270, 277, 279, 281, 282,
-	325, 326, 338, 357, 370, 371, 372, 274, 262, 352,
-	263, 291, 264, 242, 267, 266, 268, 359, 269, 244,
-	339, 376, 0, 287, 348, 312, 245, 311, 340, 375,
-	374, 253, 400, 406, 407, 0, 0, 412, 0, 0,
-	0, 420, 425, 426, 427, 429, 430, 431, 432, 0,
-	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
-	405, 285, 238, 239, 445, 271, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 327, 404,
-	0, 0, 0, 433, 444, 0, 0, 0, 0, 0,
-	443, 337, 0, 356, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 363, 386, 398, 415,
-	418, 0, 0, 0, 0, 243, 417, 0, 0, 0,
-	0, 0, 0, 0, 0, 389, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 413, 0, 0, 321, 322,
-	323, 324, 288, 0, 260, 416, 346, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 410, 411, 284, 290, 428, 292,
-	259, 336, 286, 395, 299, 0, 421, 0, 422, 0,
-	0, 0, 0, 328, 295, 296, 360, 300, 306, 349,
-	394, 334, 354, 257, 385, 361, 310, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	232, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 373, 0, 237, 265,
-	0, 240, 0, 304, 0, 345, 283, 0, 0, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 0, 218, 219, 220, 221, 222, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 333, 0, 0,
-	233, 234, 235, 236, 0, 0, 0, 401, 402, 403,
-	424, 387, 0, 442, 278, 0, 0, 303, 0, 0,
-	0, 0, 0, 0, 0, 446, 438, 0, 0, 0,
-	434, 0, 435, 0, 0, 0, 0, 0, 362, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 3183, 0, 187,
-	618, 0, 0, 0, 0, 0, 255, 188, 436, 0,
-	437, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	258, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 367, 383, 256, 358, 396, 261, 365,
-	251, 332, 355, 0, 0, 248, 381, 364, 314, 297,
-	298, 247, 0, 350, 276, 289, 273, 330, 0, 380,
-	408, 272, 399, 0, 391, 250, 0, 390, 329, 377,
-	382, 315, 309, 249, 379, 313, 308, 301, 280, 423,
-	293, 341, 307, 342, 294, 319, 318, 320, 0, 0,
-	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 393, 0, 0, 0, 0, 0, 0, 366, 0,
-	0, 302, 0, 0, 0, 409, 0, 353, 335, 0,
-	0, 0, 351, 305, 378, 343, 384, 368, 392, 347,
-	344, 241, 369, 275, 316, 252, 254, 270, 277, 279,
-	281, 282, 325, 326, 338, 357, 370, 371, 372, 274,
-	262, 352, 263, 291, 264, 242, 267, 266, 268, 359,
-	269, 244, 339, 376, 0, 287, 348, 312, 245, 311,
-	340, 375, 374, 253, 400, 406, 407, 0, 0, 412,
-	0, 0, 0, 420, 425, 426, 427, 429, 430, 431,
-	432, 0, 0, 0, 0, 414, 0, 0, 0, 0,
-	0, 0, 405, 285, 238, 239, 445, 271, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	327, 404, 0, 0, 0, 433, 444, 0, 0, 0,
-	0, 0, 443, 337, 0, 356, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 363, 386,
-	398, 415, 418, 0, 0, 0, 0, 243, 417, 0,
-	0, 0, 0, 0, 0, 0, 0, 389, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 413, 0, 0,
-	321, 322, 323, 324, 288, 0, 260, 416, 346, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 410, 411, 284, 290,
-	428, 292, 259, 336, 286, 395, 299, 0, 421, 0,
-	422, 0, 0, 0, 0, 328, 295, 296, 360, 300,
-	306, 349, 394, 334, 354, 257, 385, 361, 310, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 373, 0,
-	237, 265, 0, 240, 0, 304, 0, 345, 283, 0,
-	0, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 0, 218, 219, 220, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 333,
-	0, 0, 233, 234, 235, 236, 0, 0, 0, 401,
-	402, 403, 424, 387, 0, 442, 278, 0, 0, 303,
-	0, 0, 0, 0, 0, 0, 0, 446, 438, 0,
-	0, 0, 434, 0, 435, 0, 0, 0, 0, 0,
-	362, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 0, 0, 1219, 0, 0, 0, 255, 188,
-	436, 0, 437, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 258, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1217, 0, 0,
-	0, 0, 0, 0, 246, 367, 383, 256, 358, 396,
-	261, 365, 251, 332, 355, 0, 0, 248, 381, 364,
-	314, 297, 298, 247, 0, 350, 276, 289, 273, 330,
-	0, 380, 408, 272, 399, 0, 391, 250, 0, 390,
-	329, 377, 382, 315, 309, 249, 379, 313, 308, 301,
-	280, 423, 293, 341, 307, 342, 294, 319, 318, 320,
-	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 393, 0, 0, 0, 0, 0, 0,
-	366, 0, 0, 302, 0, 0, 0, 409, 0, 353,
-	335, 0, 0, 0, 351, 305, 378, 343, 384, 368,
-	392, 347, 344, 241, 369, 275, 316, 252, 254, 270,
-	277, 279, 281, 282, 325, 326, 338, 357, 370, 371,
-	372, 274, 262, 352, 263, 291, 264, 242, 267, 266,
-	268, 359, 269, 244, 339, 376, 0, 287, 348, 312,
-	245, 311, 340, 375, 374, 253, 400, 406, 407, 0,
-	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
-	430, 431, 432, 0, 0, 0, 0, 414, 0, 0,
-	0, 0, 0, 0, 405, 285, 238, 239, 445, 271,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 404, 0, 0, 0, 433, 444, 0,
-	0, 0, 0, 0, 443, 337, 0, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	363, 386, 398, 415, 418, 0, 0, 0, 0, 243,
-	417, 0, 0, 0, 0, 0, 0, 0, 0, 389,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 413,
-	0, 0, 321, 322, 323, 324, 288, 0, 260, 416,
-	346, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 410, 411,
-	284, 290, 428, 292, 259, 336, 286, 395, 299, 0,
-	421, 0, 422, 0, 0, 0, 0, 328, 295, 296,
-	360, 300, 306, 349, 394, 334, 354, 257, 385, 361,
-	310, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 232, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	373, 0, 237, 265, 0, 240, 0, 304, 0, 345,
-	283, 0, 0, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 0, 218, 219, 220,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 333, 0, 0, 233, 234, 235, 236, 0, 0,
-	0, 401, 402, 403, 424, 387, 0, 442, 278, 0,
-	0, 303, 0, 0, 0, 0, 0, 0, 0, 446,
-	438, 0, 0, 0, 434, 0, 435, 0, 0, 0,
-	0, 0, 362, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 0, 0, 1219, 0, 0, 0,
-	255, 188, 436, 0, 437, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 258, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 2912,
-	0, 0, 0, 0, 0, 0, 246, 367, 383, 256,
-	358, 396, 261, 365, 251, 332, 355, 0, 0, 248,
-	381, 364, 314, 297, 298, 247, 0, 350, 276, 289,
-	273, 330, 0, 380, 408, 272, 399, 0, 391, 250,
-	0, 390, 329, 377, 382, 315, 309, 249, 379, 313,
-	308, 301, 280, 423, 293, 341, 307, 342, 294, 319,
-	318, 320, 0, 0, 0, 0, 0, 419, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 393, 0, 0, 0, 0,
-	0, 0, 366, 0, 0, 302, 0, 0, 0, 409,
-	0, 353, 335, 0, 0, 0, 351, 305, 378, 343,
-	384, 368, 392, 347, 344, 241, 369, 275, 316, 252,
-	254, 270, 277, 279, 281, 282, 325, 326, 338, 357,
-	370, 371, 372, 274, 262, 352, 263, 291, 264, 242,
-	267, 266, 268, 359, 269, 244, 339, 376, 0, 287,
-	348, 312, 245, 311, 340, 375, 374, 253, 400, 406,
-	407, 0, 0, 412, 0, 0, 0, 420, 425, 426,
-	427, 429, 430, 431, 432, 0, 0, 0, 0, 414,
-	0, 0, 0, 0, 0, 0, 405, 285, 238, 239,
-	445, 271, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 327, 404, 0, 0, 0, 433,
-	444, 0, 0, 0, 0, 0, 443, 337, 0, 356,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 363, 386, 398, 415, 418, 0, 0, 0,
-	0, 243, 417, 0, 0, 0, 0, 0, 0, 0,
-	0, 389, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 413, 0, 0, 321, 322, 323, 324, 288, 0,
-	260, 416, 346, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	410, 411, 284, 290, 428, 292, 259, 336, 286, 395,
-	299, 0, 421, 0, 422, 0, 0, 0, 0, 328,
-	295, 296, 360, 300, 306, 349, 394, 334, 354, 257,
-	385, 361, 310, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 232, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 373, 0, 237, 265, 0, 240, 0, 304,
-	0, 345, 283, 0, 0, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 0, 218,
-	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 0, 0, 0, 233, 234, 235, 236,
-	333, 0, 0, 401, 402, 403, 424, 387, 0, 442,
-	0, 0, 2078, 0, 0, 0, 0, 278, 0, 0,
-	303, 446, 438, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 434, 0, 435, 0, 0, 0, 0,
-	0, 362, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 0, 0, 2080, 0, 0, 0, 255,
-	188, 436, 0, 437, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 246, 367, 383, 256, 358,
-	396, 261, 365, 251, 332, 355, 0, 0, 248, 381,
-	364, 314, 297, 298, 247, 0, 350, 276, 289, 273,
-	330, 0, 380, 408, 272, 399, 0, 391, 250, 0,
-	390, 329, 377, 382, 315, 309, 249, 379, 313, 308,
-	301, 280, 423, 293, 341, 307, 342, 294, 319, 318,
-	320, 0, 0, 0, 0, 0, 419, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 0, 0, 0, 0, 0,
-	0, 366, 0, 0, 302, 0, 0, 0, 409, 0,
-	353, 335, 0, 0, 0, 351, 305, 378, 343, 384,
-	368, 392, 347, 344, 241, 369, 275, 316, 252, 254,
-	270, 277, 279, 281, 282, 325, 326, 338, 357, 370,
-	371, 372, 274, 262, 352, 263, 291, 264, 242, 267,
-	266, 268, 359, 269, 244, 339, 376, 0, 287, 348,
-	312, 245, 311, 340, 375, 374, 253, 400, 406, 407,
-	0, 0, 412, 0, 0, 0, 420, 425, 426, 427,
-	429, 430, 431, 432, 0, 0, 0, 0, 414, 0,
-	0, 0, 0, 0, 0, 405, 285, 238, 239, 445,
-	271, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 327, 404, 0, 0, 0, 433, 444,
-	0, 0, 0, 0, 0, 443, 337, 0, 356, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 363, 386, 398, 415, 418, 0, 0, 0, 0,
-	243, 417, 0, 0, 0, 0, 0, 0, 0, 0,
-	389, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	413, 0, 0, 321, 322, 323, 324, 288, 0, 260,
-	416, 346, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 410,
-	411, 284, 290, 428, 292, 259, 336, 286, 395, 299,
-	0, 421, 0, 422, 0, 0, 0, 0, 328, 295,
-	296, 360, 300, 306, 349, 394, 334, 354, 257, 385,
-	361, 310, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 373, 0, 237, 265, 0, 240, 0, 304, 0,
-	345, 283, 0, 0, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 0, 218, 219,
-	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 333, 0, 0, 233, 234, 235, 236, 0,
-	0, 0, 401, 402, 403, 424, 387, 0, 442, 278,
-	2099, 0, 303, 0, 0, 0, 0, 0, 0, 0,
-	446, 438, 0, 0, 0, 434, 0, 435, 0, 0,
-	0, 0, 0, 362, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 0, 1219, 0, 0,
-	0, 255, 188, 436, 0, 437, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 367, 383,
-	256, 358, 396, 261, 365, 251, 332, 355, 0, 0,
-	248, 381, 364, 314, 297, 298, 247, 0, 350, 276,
-	289, 273, 330, 0, 380, 408, 272, 399, 0, 391,
-	250, 0, 390, 329, 377, 382, 315, 309, 249, 379,
-	313, 308, 301, 280, 423, 293, 341, 307, 342, 294,
-	319, 318, 320, 0, 0, 0, 0, 0, 419, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 0, 0, 0,
-	0, 0, 0, 366, 0, 0, 302, 0, 0, 0,
-	409, 0, 353, 335, 0, 0, 0, 351, 305, 378,
-	343, 384, 368, 392, 347, 344, 241, 369, 275, 316,
-	252, 254, 270, 277, 279, 281, 282, 325, 326, 338,
-	357, 370, 371, 372, 274, 262, 352, 263, 291, 264,
-	242, 267, 266, 268, 359, 269, 244, 339, 376, 0,
-	287, 348, 312, 245, 311, 340, 375, 374, 253, 400,
-	406, 407, 0, 0, 412, 0, 0, 0, 420, 425,
-	426, 427, 429, 430, 431, 432, 0, 0, 0, 0,
-	414, 0, 0, 0, 0, 0, 0, 405, 285, 238,
-	239, 445, 271, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 404, 0, 0, 0,
-	433, 444, 0, 0, 0, 0, 0, 443, 337, 0,
-	356, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 363, 386, 398, 415, 418, 0, 0,
-	0, 0, 243, 417, 0, 0, 0, 0, 0, 0,
-	0, 0, 389, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 413, 0, 0, 321, 322, 323, 324, 288,
-	0, 260, 416, 346, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 410, 411, 284, 290, 428, 292, 259, 336, 286,
-	395, 299, 0, 421, 0, 422, 0, 0, 0, 0,
-	328, 295, 296, 360, 300, 306, 349, 394, 334, 354,
-	257, 385, 361, 310, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 373, 0, 237, 265, 0, 240, 0,
-	304, 0, 345, 283, 0, 0, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 0,
-	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 333, 0, 0, 233, 234, 235,
-	236, 0, 0, 0, 401, 402, 403, 424, 387, 0,
-	442, 278, 0, 0, 303, 0, 0, 0, 0, 0,
-	0, 0, 446, 438, 0, 0, 0, 434, 0, 435,
-	0, 0, 0, 0, 0, 362, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 3272, 0, 187, 0, 0, 0,
-	0, 0, 0, 255, 188, 436, 0, 437, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 247, 283, 294, 600, 258, 0, 324, 0, 365,
+	302, 0, 0, 679, 648, 649, 650, 593, 651, 645,
+	646, 647, 680, 640, 676, 677, 619, 643, 652, 675,
+	653, 678, 681, 682, 721, 722, 659, 723, 656, 683,
+	674, 673, 654, 641, 684, 685, 626, 621, 657, 658,
+	644, 660, 661, 662, 666, 667, 668, 669, 670, 665,
+	663, 664, 622, 623, 624, 625, 0, 0, 0, 426,
+	427, 428, 454, 412, 0, 485, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 490, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 405, 406, 407,
+	408, 409, 488, 481, 477, 478, 479, 480, 0, 0,
+	0, 636, 0, 0, 0, 0, 0, 0, 0, 0,
+	353, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 591, 0, 0, 0, 297, 0, 0,
+	323, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 464, 0, 465, 0, 0, 627, 0,
+	0, 382, 337, 0, 0, 0, 0, 695, 703, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 584,
+	0, 0, 617, 672, 671, 604, 614, 0, 0, 273,
+	197, 466, 0, 467, 605, 0, 613, 606, 610, 609,
+	607, 608, 0, 687, 0, 0, 0, 0, 0, 0,
+	575, 588, 0, 592, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 585, 586, 0,
+	0, 0, 0, 637, 0, 587, 0, 0, 632, 611,
+	615, 0, 0, 0, 0, 264, 387, 403, 274, 378,
+	421, 279, 385, 269, 352, 375, 0, 0, 266, 401,
+	384, 334, 317, 318, 265, 0, 370, 295, 309, 291,
+	350, 612, 635, 639, 290, 709, 633, 416, 268, 0,
+	415, 349, 397, 402, 335, 329, 267, 399, 333, 328,
+	321, 299, 710, 449, 450, 313, 361, 327, 362, 314,
+	339, 338, 340, 0, 0, 0, 0, 0, 444, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 630, 0, 0, 0, 418, 0, 0, 693,
+	0, 0, 0, 386, 0, 0, 322, 0, 0, 0,
+	634, 0, 373, 355, 706, 576, 0, 371, 303, 325,
+	398, 363, 404, 388, 417, 367, 364, 259, 389, 293,
+	336, 270, 272, 288, 296, 298, 300, 301, 345, 346,
+	358, 377, 390, 391, 392, 292, 280, 372, 281, 311,
+	282, 260, 285, 284, 286, 379, 287, 262, 359, 396,
+	0, 307, 368, 332, 263, 331, 360, 395, 394, 271,
+	425, 431, 432, 0, 0, 437, 0, 0, 0, 445,
+	455, 456, 457, 459, 460, 461, 462, 0, 0, 0,
+	0, 439, 0, 0, 451, 452, 453, 0, 0, 0,
+	0, 430, 0, 305, 256, 257, 489, 691, 351, 0,
+	0, 0, 0, 705, 686, 688, 689, 692, 696, 697,
+	698, 699, 700, 702, 704, 708, 487, 0, 0, 0,
+	0, 0, 486, 357, 0, 376, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 383, 411,
+	423, 440, 443, 0, 0, 0, 0, 261, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 707, 0, 0,
+	0, 422, 0, 0, 0, 0, 0, 638, 0, 0,
+	341, 342, 343, 344, 694, 0, 278, 441, 366, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 436, 304, 310,
+	458, 312, 277, 356, 306, 420, 319, 0, 446, 0,
+	447, 0, 0, 0, 0, 348, 315, 316, 380, 320,
+	326, 369, 419, 354, 374, 275, 410, 381, 330, 0,
+	0, 716, 690, 715, 717, 718, 714, 719, 720, 701,
+	594, 0, 642, 712, 711, 713, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
-	367, 383, 256, 358, 396, 261, 365, 251, 332, 355,
-	0, 0, 248, 381, 364, 314, 297, 298, 247, 0,
-	350, 276, 289, 273, 330, 0, 380, 408, 272, 399,
-	0, 391, 250, 0, 390, 329, 377, 382, 315, 309,
-	249, 379, 313, 308, 301, 280, 423, 293, 341, 307,
-	342, 294, 319, 318, 320, 0, 0, 0, 0, 0,
-	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 393, 0,
-	0, 0, 0, 0, 0, 366, 0, 0, 302, 0,
-	0, 0, 409, 0, 353, 335, 0, 0, 0, 351,
-	305, 378, 343, 384, 368, 392, 347, 344, 241, 369,
-	275, 316, 252, 254, 270, 277, 279, 281, 282, 325,
-	326, 338, 357, 370, 371, 372, 274, 262, 352, 263,
-	291, 264, 242, 267, 266, 268, 359, 269, 244, 339,
-	376, 0, 287, 348, 312, 245, 311, 340, 375, 374,
-	253, 400, 406, 407, 0, 0, 412, 0, 0, 0,
-	420, 425, 426, 427, 429, 430, 431, 432, 0, 0,
-	0, 0, 414, 0, 0, 0, 0, 0, 0, 405,
-	285, 238, 239, 445, 271, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 327, 404, 0,
-	0, 0, 433, 444, 0, 0, 0, 0, 0, 443,
-	337, 0, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 363, 386, 398, 415, 418,
-	0, 0, 0, 0, 243, 417, 0, 0, 0, 0,
-	0, 0, 0, 0, 389, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 413, 0, 0, 321, 322, 323,
-	324, 288, 0, 260, 416, 346, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 410, 411, 284, 290, 428, 292, 259,
-	336, 286, 395, 299, 0, 421, 0, 422, 0, 0,
-	0, 0, 328, 295, 296, 360, 300, 306, 349, 394,
-	334, 354, 257, 385, 361, 310, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 373, 0, 237, 265, 0,
-	240, 0, 304, 0, 345, 283, 0, 0, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	247, 283, 294, 600, 258, 0, 324, 0, 365, 302,
+	0, 0, 679, 648, 649, 650, 593, 651, 645, 646,
+	647, 680, 640, 676, 677, 619, 643, 652, 675, 653,
+	678, 681, 682, 721, 722, 659, 723, 656, 683, 674,
+	673, 654, 641, 684, 685, 626, 621, 657, 658, 644,
+	660, 661, 662, 666, 667, 668, 669, 670, 665, 663,
+	664, 622, 623, 624, 625, 0, 0, 0, 426, 427,
+	428, 454, 412, 0, 485, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 490, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 405, 406, 407, 408,
+	409, 488, 481, 477, 478, 479, 480, 0, 0, 0,
+	636, 0, 0, 0, 0, 0, 0, 0, 0, 353,
+	0, 0, 0, 0, 0, 0, 0, 0, 1458, 0,
+	0, 0, 591, 0, 0, 0, 297, 0, 0, 323,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 0, 465, 0, 0, 627, 0, 0,
+	382, 337, 0, 0, 0, 0, 695, 703, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 584, 0,
+	0, 617, 672, 671, 604, 614, 0, 0, 273, 197,
+	466, 0, 467, 605, 0, 613, 606, 610, 609, 607,
+	608, 0, 687, 0, 0, 0, 0, 0, 0, 0,
+	588, 0, 592, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 585, 586, 0, 0,
+	0, 0, 637, 0, 587, 0, 0, 632, 611, 615,
+	0, 0, 0, 0, 264, 387, 403, 274, 378, 421,
+	279, 385, 269, 352, 375, 0, 0, 266, 401, 384,
+	334, 317, 318, 265, 0, 370, 295, 309, 291, 350,
+	612, 635, 639, 290, 709, 633, 416, 268, 0, 415,
+	349, 397, 402, 335, 329, 267, 399, 333, 328, 321,
+	299, 710, 449, 450, 313, 361, 327, 362, 314, 339,
+	338, 340, 0, 0, 0, 0, 0, 444, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 630, 0, 0, 0, 418, 0, 0, 693, 0,
+	0, 0, 386, 0, 0, 322, 0, 0, 0, 634,
+	0, 373, 355, 706, 0, 0, 371, 303, 325, 398,
+	363, 404, 388, 417, 367, 364, 259, 389, 293, 336,
+	270, 272, 288, 296, 298, 300, 301, 345, 346, 358,
+	377, 390, 391, 392, 292, 280, 372, 281, 311, 282,
+	260, 285, 284, 286, 379, 287, 262, 359, 396, 0,
+	307, 368, 332, 263, 331, 360, 395, 394, 271, 425,
+	1459, 1460, 0, 0, 437, 0, 0, 0, 445, 455,
+	456, 457, 459, 460, 461, 462, 0, 0, 0, 0,
+	439, 0, 0, 451, 452, 453, 0, 0, 0, 0,
+	430, 0, 305, 256, 257, 489, 691, 351, 0, 0,
+	0, 0, 705, 686, 688, 689, 692, 696, 697, 698,
+	699, 700, 702, 704, 708, 487, 0, 0, 0, 0,
+	0, 486, 357, 0, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 411, 423,
+	440, 443, 0, 0, 0, 0, 261, 442, 0, 0,
+	0, 0, 0, 0, 0, 0, 707, 0, 0, 0,
+	422, 0, 0, 0, 0, 0, 638, 0, 0, 341,
+	342, 343, 344, 694, 0, 278, 441, 366, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 435, 436, 304, 310, 458,
+	312, 277, 356, 306, 420, 319, 0, 446, 0, 447,
+	0, 0, 0, 0, 348, 315, 316, 380, 320, 326,
+	369, 419, 354, 374, 275, 410, 381, 330, 0, 0,
+	716, 690, 715, 717, 718, 714, 719, 720, 701, 594,
+	0, 642, 712, 711, 713, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 393, 0, 247,
+	283, 294, 600, 258, 0, 324, 0, 365, 302, 0,
+	0, 679, 648, 649, 650, 593, 651, 645, 646, 647,
+	680, 640, 676, 677, 619, 643, 652, 675, 653, 678,
+	681, 682, 721, 722, 659, 723, 656, 683, 674, 673,
+	654, 641, 684, 685, 626, 621, 657, 658, 644, 660,
+	661, 662, 666, 667, 668, 669, 670, 665, 663, 664,
+	622, 623, 624, 625, 0, 0, 0, 426, 427, 428,
+	454, 412, 0, 485, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 490, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 405, 406, 407, 408, 409,
+	488, 481, 477, 478, 479, 480, 0, 0, 0, 636,
+	0, 0, 0, 0, 0, 0, 0, 0, 353, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 591, 0, 0, 0, 297, 0, 0, 323, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 464, 0, 465, 0, 0, 627, 0, 0, 382,
+	337, 0, 0, 0, 0, 695, 703, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	617, 672, 671, 604, 614, 0, 0, 273, 197, 466,
+	0, 467, 605, 0, 613, 606, 610, 609, 607, 608,
+	0, 687, 0, 0, 0, 0, 0, 0, 575, 588,
+	0, 592, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 585, 586, 0, 0, 0,
+	0, 637, 0, 587, 0, 0, 632, 611, 615, 0,
+	0, 0, 0, 264, 387, 403, 274, 378, 421, 279,
+	385, 269, 352, 375, 0, 0, 266, 401, 384, 334,
+	317, 318, 265, 0, 370, 295, 309, 291, 350, 612,
+	635, 639, 290, 709, 633, 416, 268, 0, 415, 349,
+	397, 402, 335, 329, 267, 399, 333, 328, 321, 299,
+	710, 449, 450, 313, 361, 327, 362, 314, 339, 338,
+	340, 0, 0, 0, 0, 0, 444, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	630, 0, 0, 0, 418, 0, 0, 693, 0, 0,
+	0, 386, 0, 0, 322, 0, 0, 0, 634, 0,
+	373, 355, 706, 576, 0, 371, 303, 325, 398, 363,
+	404, 388, 417, 367, 364, 259, 389, 293, 336, 270,
+	272, 288, 296, 298, 300, 301, 345, 346, 358, 377,
+	390, 391, 392, 292, 280, 372, 281, 311, 282, 260,
+	285, 284, 286, 379, 287, 262, 359, 396, 0, 307,
+	368, 332, 263, 331, 360, 395, 394, 271, 425, 431,
+	432, 0, 0, 437, 0, 0, 0, 445, 455, 456,
+	457, 459, 460, 461, 462, 0, 0, 0, 0, 439,
+	0, 0, 451, 452, 453, 0, 0, 0, 0, 430,
+	0, 305, 256, 257, 489, 691, 351, 0, 0, 0,
+	0, 705, 686, 688, 689, 692, 696, 697, 698, 699,
+	700, 702, 704, 708, 487, 0, 0, 0, 0, 0,
+	486, 357, 0, 376, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 383, 411, 423, 440,
+	443, 0, 0, 0, 0, 261, 442, 0, 0, 0,
+	0, 0, 0, 0, 0, 707, 0, 0, 0, 422,
+	0, 0, 0, 0, 0, 638, 0, 0, 341, 342,
+	343, 344, 694, 0, 278, 441, 366, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 436, 304, 310, 458, 312,
+	277, 356, 306, 420, 319, 0, 446, 0, 447, 0,
+	0, 0, 0, 348, 315, 316, 380, 320, 326, 369,
+	419, 354, 374, 275, 410, 381, 330, 0, 0, 716,
+	690, 715, 717, 718, 714, 719, 720, 701, 594, 0,
+	642, 712, 711, 713, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 393, 0, 247, 283,
+	294, 600, 258, 0, 324, 0, 365, 302, 0, 0,
+	679, 648, 649, 650, 593, 651, 645, 646, 647, 680,
+	640, 676, 677, 619, 643, 652, 675, 653, 678, 681,
+	682, 721, 722, 659, 723, 656, 683, 674, 673, 654,
+	641, 684, 685, 626, 621, 657, 658, 644, 660, 661,
+	662, 666, 667, 668, 669, 670, 665, 663, 664, 622,
+	623, 624, 625, 0, 0, 0, 426, 427, 428, 454,
+	412, 0, 485, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 490, 468, 469, 470, 471, 472,
+	473, 474, 475, 476, 405, 406, 407, 408, 409, 488,
+	481, 477, 478, 479, 480, 0, 0, 0, 636, 0,
+	0, 0, 0, 0, 0, 0, 0, 353, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	591, 0, 0, 0, 297, 0, 0, 323, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 0, 465, 0, 0, 627, 0, 0, 382, 337,
+	0, 0, 0, 0, 695, 703, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 584, 0, 0, 617,
+	672, 671, 604, 614, 0, 0, 273, 197, 466, 0,
+	467, 605, 0, 613, 606, 610, 609, 607, 608, 0,
+	687, 0, 0, 0, 0, 0, 0, 0, 588, 0,
+	592, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 585, 586, 0, 0, 0, 0,
+	637, 0, 587, 0, 0, 632, 611, 615, 0, 0,
+	0, 0, 264, 387, 403, 274, 378, 421, 279, 385,
+	269, 352, 375, 0, 0, 266, 401, 384, 334, 317,
+	318, 265, 0, 370, 295, 309, 291, 350, 612, 635,
+	639, 290, 709, 633, 416, 268, 0, 415, 349, 397,
+	402, 335, 329, 267, 399, 333, 328, 321, 299, 710,
+	449, 450, 313, 361, 327, 362, 314, 339, 338, 340,
+	0, 0, 0, 0, 0, 444, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 630,
+	0, 0, 0, 418, 0, 0, 693, 0, 0, 0,
+	386, 0, 0, 322, 0, 0, 0, 634, 0, 373,
+	355, 706, 0, 0, 371, 303, 325, 398, 363, 404,
+	388, 417, 367, 364, 259, 389, 293, 336, 270, 272,
+	288, 296, 298, 300, 301, 345, 346, 358, 377, 390,
+	391, 392, 292, 280, 372, 281, 311, 282, 260, 285,
+	284, 286, 379, 287, 262, 359, 396, 0, 307, 368,
+	332, 263, 331, 360, 395, 394, 271, 425, 431, 432,
+	0, 0, 437, 0, 0, 0, 445, 455, 456, 457,
+	459, 460, 461, 462, 0, 0, 0, 0, 439, 0,
+	0, 451, 452, 453, 0, 0, 0, 0, 430, 0,
+	305, 256, 257, 489, 691, 351, 0, 0, 0, 0,
+	705, 686, 688, 689, 692, 696, 697, 698, 699, 700,
+	702, 704, 708, 487, 0, 0, 0, 0, 0, 486,
+	357, 0, 376, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 411, 423, 440, 443,
+	0, 0, 0, 0, 261, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 707, 0, 0, 0, 422, 0,
+	0, 0, 0, 0, 638, 0, 0, 341, 342, 343,
+	344, 694, 0, 278, 441, 366, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 435, 436, 304, 310, 458, 312, 277,
+	356, 306, 420, 319, 0, 446, 0, 447, 0, 0,
+	0, 0, 348, 315, 316, 380, 320, 326, 369, 419,
+	354, 374, 275, 410, 381, 330, 0, 0, 716, 690,
+	715, 717, 718, 714, 719, 720, 701, 594, 0, 642,
+	712, 711, 713, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 393, 0, 247, 283, 294,
+	600, 258, 0, 324, 0, 365, 302, 0, 0, 679,
+	648, 649, 650, 593, 651, 645, 646, 647, 680, 640,
+	676, 677, 619, 643, 652, 675, 653, 678, 681, 682,
+	721, 722, 659, 723, 656, 683, 674, 673, 654, 641,
+	684, 685, 626, 621, 657, 658, 644, 660, 661, 662,
+	666, 667, 668, 669, 670, 665, 663, 664, 622, 623,
+	624, 625, 0, 0, 0, 426, 427, 428, 454, 412,
+	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 490, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 405, 406, 407, 408, 409, 488, 481,
+	477, 478, 479, 480, 0, 0, 174, 55, 166, 139,
+	0, 0, 0, 0, 0, 0, 353, 0, 0, 0,
+	0, 0, 0, 0, 167, 0, 0, 0, 0, 0,
+	0, 159, 0, 297, 0, 168, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
+	0, 465, 0, 0, 118, 0, 0, 382, 337, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 106,
+	0, 0, 0, 0, 0, 171, 0, 0, 196, 0,
+	0, 0, 0, 0, 0, 273, 197, 466, 0, 467,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 188,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 264, 387, 403, 274, 378, 421, 279, 385, 269,
+	352, 375, 0, 0, 266, 401, 384, 334, 317, 318,
+	265, 0, 370, 295, 309, 291, 350, 0, 400, 433,
+	290, 424, 0, 416, 268, 0, 415, 349, 397, 402,
+	335, 329, 267, 399, 333, 328, 321, 299, 448, 449,
+	450, 313, 361, 327, 362, 314, 339, 338, 340, 0,
+	0, 0, 0, 0, 444, 0, 0, 0, 0, 0,
+	0, 138, 165, 172, 0, 104, 0, 0, 0, 0,
+	0, 0, 418, 0, 0, 189, 0, 0, 0, 386,
+	0, 0, 322, 164, 158, 157, 434, 0, 373, 355,
+	61, 0, 0, 371, 303, 325, 398, 363, 404, 388,
+	417, 367, 364, 259, 389, 293, 336, 270, 272, 288,
+	296, 298, 300, 301, 345, 346, 358, 377, 390, 391,
+	392, 292, 280, 372, 281, 311, 282, 260, 285, 284,
+	286, 379, 287, 262, 359, 396, 0, 307, 368, 332,
+	263, 331, 360, 395, 394, 271, 425, 431, 432, 0,
+	0, 437, 160, 161, 162, 445, 455, 456, 457, 459,
+	460, 461, 462, 0, 0, 0, 0, 439, 0, 0,
+	451, 452, 453, 0, 0, 0, 0, 430, 0, 305,
+	256, 257, 413, 289, 351, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 429, 192, 0,
+	0, 463, 200, 0, 0, 0, 163, 0, 201, 357,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 383, 411, 423, 440, 443, 0,
+	0, 0, 0, 261, 442, 0, 0, 0, 0, 0,
+	0, 0, 0, 414, 0, 0, 0, 422, 0, 0,
+	0, 0, 0, 438, 0, 0, 341, 342, 343, 344,
+	308, 0, 278, 441, 366, 116, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 54, 0, 0, 0,
+	0, 0, 435, 436, 304, 310, 458, 312, 277, 356,
+	306, 420, 319, 0, 446, 0, 447, 0, 0, 0,
+	0, 348, 315, 316, 380, 320, 326, 369, 419, 354,
+	374, 275, 410, 381, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 56, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 393, 0, 247, 283, 294, 0,
+	258, 0, 324, 140, 365, 302, 0, 0, 205, 206,
 	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 0, 218, 219, 220, 221, 222, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 333, 0, 0, 233,
-	234, 235, 236, 0, 0, 0, 401, 402, 403, 424,
-	387, 0, 442, 278, 0, 0, 303, 0, 0, 0,
-	0, 0, 0, 0, 446, 438, 0, 0, 0, 434,
-	0, 435, 0, 0, 0, 0, 0, 362, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 618,
-	0, 0, 0, 0, 0, 255, 188, 436, 0, 437,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 367, 383, 256, 358, 396, 261, 365, 251,
-	332, 355, 0, 0, 248, 381, 364, 314, 297, 298,
-	247, 0, 350, 276, 289, 273, 330, 0, 380, 408,
-	272, 399, 0, 391, 250, 0, 390, 329, 377, 382,
-	315, 309, 249, 379, 313, 308, 301, 280, 423, 293,
-	341, 307, 342, 294, 319, 318, 320, 0, 0, 0,
-	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 0, 0, 0, 0, 0, 0, 366, 0, 0,
-	302, 0, 0, 0, 409, 0, 353, 335, 0, 0,
-	0, 351, 305, 378, 343, 384, 368, 392, 347, 344,
-	241, 369, 275, 316, 252, 254, 270, 277, 279, 281,
-	282, 325, 326, 338, 357, 370, 371, 372, 274, 262,
-	352, 263, 291, 264, 242, 267, 266, 268, 359, 269,
-	244, 339, 376, 0, 287, 348, 312, 245, 311, 340,
-	375, 374, 253, 400, 406, 407, 0, 0, 412, 0,
-	0, 0, 420, 425, 426, 427, 429, 430, 431, 432,
-	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
-	0, 405, 285, 238, 239, 445, 271, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	404, 0, 0, 0, 433, 444, 0, 0, 0, 0,
-	0, 443, 337, 0, 356, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 363, 386, 398,
-	415, 418, 0, 0, 0, 0, 243, 417, 0, 0,
-	0, 0, 0, 0, 0, 0, 389, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 413, 0, 0, 321,
-	322, 323, 324, 288, 0, 260, 416, 346, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 410, 411, 284, 290, 428,
-	292, 259, 336, 286, 395, 299, 0, 421, 0, 422,
-	0, 0, 0, 0, 328, 295, 296, 360, 300, 306,
-	349, 394, 334, 354, 257, 385, 361, 310, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 373, 0, 237,
-	265, 0, 240, 0, 304, 0, 345, 283, 0, 0,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 0, 218, 219, 220, 221, 222, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 333, 0,
-	0, 233, 234, 235, 236, 0, 0, 0, 401, 402,
-	403, 424, 387, 0, 442, 278, 0, 0, 303, 0,
-	0, 0, 0, 0, 0, 0, 446, 438, 0, 0,
-	0, 434, 0, 435, 0, 0, 0, 0, 0, 362,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 3198, 0, 0,
-	187, 0, 0, 0, 0, 0, 0, 255, 188, 436,
-	0, 437, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 367, 383, 256, 358, 396, 261,
-	365, 251, 332, 355, 0, 0, 248, 381, 364, 314,
-	297, 298, 247, 0, 350, 276, 289, 273, 330, 0,
-	380, 408, 272, 399, 0, 391, 250, 0, 390, 329,
-	377, 382, 315, 309, 249, 379, 313, 308, 301, 280,
-	423, 293, 341, 307, 342, 294, 319, 318, 320, 0,
-	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 0, 0, 0, 0, 0, 0, 366,
-	0, 0, 302, 0, 0, 0, 409, 0, 353, 335,
-	0, 0, 0, 351, 305, 378, 343, 384, 368, 392,
-	347, 344, 241, 369, 275, 316, 252, 254, 270, 277,
-	279, 281, 282, 325, 326, 338, 357, 370, 371, 372,
-	274, 262, 352, 263, 291, 264, 242, 267, 266, 268,
-	359, 269, 244, 339, 376, 0, 287, 348, 312, 245,
-	311, 340, 375, 374, 253, 400, 406, 407, 0, 0,
-	412, 0, 0, 0, 420, 425, 426, 427, 429, 430,
-	431, 432, 0, 0, 0, 0, 414, 0, 0, 0,
-	0, 0, 0, 405, 285, 238, 239, 445, 271, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 404, 0, 0, 0, 433, 444, 0, 0,
-	0, 0, 0, 443, 337, 0, 356, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
-	386, 398, 415, 418, 0, 0, 0, 0, 243, 417,
-	0, 0, 0, 0, 0, 0, 0, 0, 389, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 413, 0,
-	0, 321, 322, 323, 324, 288, 0, 260, 416, 346,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 410, 411, 284,
-	290, 428, 292, 259, 336, 286, 395, 299, 0, 421,
-	0, 422, 0, 0, 0, 0, 328, 295, 296, 360,
-	300, 306, 349, 394, 334, 354, 257, 385, 361, 310,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 373,
-	0, 237, 265, 0, 240, 0, 304, 0, 345, 283,
-	0, 0, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 0, 218, 219, 220, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	333, 0, 0, 233, 234, 235, 236, 0, 0, 0,
-	401, 402, 403, 424, 387, 0, 442, 278, 0, 0,
-	303, 0, 0, 0, 0, 0, 0, 0, 446, 438,
-	0, 0, 0, 434, 0, 435, 0, 0, 0, 0,
-	0, 362, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 0, 0, 0, 0, 0, 0, 255,
-	188, 436, 0, 437, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 246, 367, 383, 256, 358,
-	396, 261, 365, 251, 332, 355, 0, 0, 248, 381,
-	364, 314, 297, 298, 247, 0, 350, 276, 289, 273,
-	330, 0, 380, 408, 272, 399, 0, 391, 250, 0,
-	390, 329, 377, 382, 315, 309, 249, 379, 313, 308,
-	301, 280, 423, 293, 341, 307, 342, 294, 319, 318,
-	320, 0, 0, 0, 0, 0, 419, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 0, 0, 0, 3128, 0,
-	0, 366, 0, 0, 302, 0, 0, 0, 409, 0,
-	353, 335, 0, 0, 0, 351, 305, 378, 343, 384,
-	368, 392, 347, 344, 241, 369, 275, 316, 252, 254,
-	270, 277, 279, 281, 282, 325, 326, 338, 357, 370,
-	371, 372, 274, 262, 352, 263, 291, 264, 242, 267,
-	266, 268, 359, 269, 244, 339, 376, 0, 287, 348,
-	312, 245, 311, 340, 375, 374, 253, 400, 406, 407,
-	0, 0, 412, 0, 0, 0, 420, 425, 426, 427,
-	429, 430, 431, 432, 0, 0, 0, 0, 414, 0,
-	0, 0, 0, 0, 0, 405, 285, 238, 239, 445,
-	271, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 327, 404, 0, 0, 0, 433, 444,
-	0, 0, 0, 0, 0, 443, 337, 0, 356, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 363, 386, 398, 415, 418, 0, 0, 0, 0,
-	243, 417, 0, 0, 0, 0, 0, 0, 0, 0,
-	389, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	413, 0, 0, 321, 322, 323, 324, 288, 0, 260,
-	416, 346, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 410,
-	411, 284, 290, 428, 292, 259, 336, 286, 395, 299,
-	0, 421, 0, 422, 0, 0, 0, 0, 328, 295,
-	296, 360, 300, 306, 349, 394, 334, 354, 257, 385,
-	361, 310, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 373, 0, 237, 265, 0, 240, 0, 304, 0,
-	345, 283, 0, 0, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 0, 218, 219,
-	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 333, 0, 0, 233, 234, 235, 236, 0,
-	0, 0, 401, 402, 403, 424, 387, 0, 442, 278,
-	0, 0, 303, 0, 0, 0, 0, 0, 0, 0,
-	446, 438, 0, 0, 0, 434, 0, 435, 0, 0,
-	0, 0, 0, 362, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2908, 0, 0, 187, 0, 0, 0, 0, 0,
-	0, 255, 188, 436, 0, 437, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 367, 383,
-	256, 358, 396, 261, 365, 251, 332, 355, 0, 0,
-	248, 381, 364, 314, 297, 298, 247, 0, 350, 276,
-	289, 273, 330, 0, 380, 408, 272, 399, 0, 391,
-	250, 0, 390, 329, 377, 382, 315, 309, 249, 379,
-	313, 308, 301, 280, 423, 293, 341, 307, 342, 294,
-	319, 318, 320, 0, 0, 0, 0, 0, 419, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 0, 0, 0,
-	0, 0, 0, 366, 0, 0, 302, 0, 0, 0,
-	409, 0, 353, 335, 0, 0, 0, 351, 305, 378,
-	343, 384, 368, 392, 347, 344, 241, 369, 275, 316,
-	252, 254, 270, 277, 279, 281, 282, 325, 326, 338,
-	357, 370, 371, 372, 274, 262, 352, 263, 291, 264,
-	242, 267, 266, 268, 359, 269, 244, 339, 376, 0,
-	287, 348, 312, 245, 311, 340, 375, 374, 253, 400,
-	406, 407, 0, 0, 412, 0, 0, 0, 420, 425,
-	426, 427, 429, 430, 431, 432, 0, 0, 0, 0,
-	414, 0, 0, 0, 0, 0, 0, 405, 285, 238,
-	239, 445, 271, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 404, 0, 0, 0,
-	433, 444, 0, 0, 0, 0, 0, 443, 337, 0,
-	356, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 363, 386, 398, 415, 418, 0, 0,
-	0, 0, 243, 417, 0, 0, 0, 0, 0, 0,
-	0, 0, 389, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 413, 0, 0, 321, 322, 323, 324, 288,
-	0, 260, 416, 346, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 410, 411, 284, 290, 428, 292, 259, 336, 286,
-	395, 299, 0, 421, 0, 422, 0, 0, 0, 0,
-	328, 295, 296, 360, 300, 306, 349, 394, 334, 354,
-	257, 385, 361, 310, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 373, 0, 237, 265, 0, 240, 0,
-	304, 0, 345, 283, 0, 0, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 0,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 0, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 239, 240, 241, 0, 0, 0, 248,
+	249, 250, 251, 252, 253, 254, 255, 243, 244, 245,
+	246, 0, 0, 0, 426, 427, 428, 454, 412, 0,
+	202, 40, 190, 193, 195, 194, 0, 53, 5, 0,
+	0, 121, 203, 468, 469, 470, 471, 472, 473, 474,
+	475, 476, 405, 406, 407, 408, 409, 204, 481, 477,
+	478, 479, 480, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 353, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 297, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 0,
+	465, 0, 0, 0, 0, 0, 382, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1102, 0, 0, 196, 0, 0,
+	604, 614, 0, 0, 273, 197, 466, 0, 467, 605,
+	0, 613, 606, 610, 609, 607, 608, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 611, 0, 0, 0, 0, 0,
+	264, 387, 403, 274, 378, 421, 279, 385, 269, 352,
+	375, 0, 0, 266, 401, 384, 334, 317, 318, 265,
+	0, 370, 295, 309, 291, 350, 612, 400, 433, 290,
+	424, 0, 416, 268, 0, 415, 349, 397, 402, 335,
+	329, 267, 399, 333, 328, 321, 299, 448, 449, 450,
+	313, 361, 327, 362, 314, 339, 338, 340, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 418, 0, 0, 0, 0, 0, 0, 386, 0,
+	0, 322, 0, 0, 0, 434, 0, 373, 355, 0,
+	0, 0, 371, 303, 325, 398, 363, 404, 388, 417,
+	367, 364, 259, 389, 293, 336, 270, 272, 288, 296,
+	298, 300, 301, 345, 346, 358, 377, 390, 391, 392,
+	292, 280, 372, 281, 311, 282, 260, 285, 284, 286,
+	379, 287, 262, 359, 396, 0, 307, 368, 332, 263,
+	331, 360, 395, 394, 271, 425, 431, 432, 0, 0,
+	437, 0, 0, 0, 445, 455, 456, 457, 459, 460,
+	461, 462, 0, 0, 0, 0, 439, 0, 0, 451,
+	452, 453, 0, 0, 0, 0, 430, 0, 305, 256,
+	257, 489, 289, 351, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 347, 429, 0, 0, 0,
+	463, 487, 0, 0, 0, 0, 0, 486, 357, 0,
+	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 411, 423, 440, 443, 0, 0,
+	0, 0, 261, 442, 0, 0, 0, 0, 0, 0,
+	0, 0, 414, 0, 0, 0, 422, 0, 0, 0,
+	0, 0, 438, 0, 0, 341, 342, 343, 344, 308,
+	0, 278, 441, 366, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 436, 304, 310, 458, 312, 277, 356, 306,
+	420, 319, 0, 446, 0, 447, 0, 0, 0, 0,
+	348, 315, 316, 380, 320, 326, 369, 419, 354, 374,
+	275, 410, 381, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 393, 0, 247, 283, 294, 0, 258,
+	0, 324, 0, 365, 302, 0, 0, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
 	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 333, 0, 0, 233, 234, 235,
-	236, 0, 0, 0, 401, 402, 403, 424, 387, 0,
-	442, 278, 0, 0, 303, 0, 0, 0, 0, 0,
-	0, 0, 446, 438, 0, 0, 0, 434, 0, 435,
-	0, 0, 0, 0, 0, 362, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 0, 2773,
-	0, 0, 0, 255, 188, 436, 0, 437, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
-	367, 383, 256, 358, 396, 261, 365, 251, 332, 355,
-	0, 0, 248, 381, 364, 314, 297, 298, 247, 0,
-	350, 276, 289, 273, 330, 0, 380, 408, 272, 399,
-	0, 391, 250, 0, 390, 329, 377, 382, 315, 309,
-	249, 379, 313, 308, 301, 280, 423, 293, 341, 307,
-	342, 294, 319, 318, 320, 0, 0, 0, 0, 0,
-	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 0,
-	0, 0, 0, 0, 0, 366, 0, 0, 302, 0,
-	0, 0, 409, 0, 353, 335, 0, 0, 0, 351,
-	305, 378, 343, 384, 368, 392, 347, 344, 241, 369,
-	275, 316, 252, 254, 270, 277, 279, 281, 282, 325,
-	326, 338, 357, 370, 371, 372, 274, 262, 352, 263,
-	291, 264, 242, 267, 266, 268, 359, 269, 244, 339,
-	376, 0, 287, 348, 312, 245, 311, 340, 375, 374,
-	253, 400, 406, 407, 0, 0, 412, 0, 0, 0,
-	420, 425, 426, 427, 429, 430, 431, 432, 0, 0,
-	0, 0, 414, 0, 0, 0, 0, 0, 0, 405,
-	285, 238, 239, 445, 271, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 327, 404, 0,
-	0, 0, 433, 444, 0, 0, 0, 0, 0, 443,
-	337, 0, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 363, 386, 398, 415, 418,
-	0, 0, 0, 0, 243, 417, 0, 0, 0, 0,
-	0, 0, 0, 0, 389, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 413, 0, 0, 321, 322, 323,
-	324, 288, 0, 260, 416, 346, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 410, 411, 284, 290, 428, 292, 259,
-	336, 286, 395, 299, 0, 421, 0, 422, 0, 0,
-	0, 0, 328, 295, 296, 360, 300, 306, 349, 394,
-	334, 354, 257, 385, 361, 310, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 373, 0, 237, 265, 0,
-	240, 0, 304, 0, 345, 283, 0, 0, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	0, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 238, 239, 240, 241, 0, 0, 0, 248, 249,
+	250, 251, 252, 253, 254, 255, 243, 244, 245, 246,
+	0, 0, 0, 426, 427, 428, 454, 412, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 490, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 405, 406, 407, 408, 409, 488, 481, 477, 478,
+	479, 480, 0, 0, 174, 55, 166, 139, 0, 0,
+	0, 0, 0, 0, 353, 507, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 0, 323, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 464, 0, 465,
+	0, 0, 0, 0, 0, 382, 337, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 512, 0, 0, 196, 0, 0, 0,
+	0, 0, 0, 273, 197, 466, 0, 467, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 264,
+	387, 403, 274, 378, 421, 279, 385, 269, 352, 375,
+	0, 0, 266, 401, 384, 334, 317, 318, 265, 0,
+	370, 295, 309, 291, 350, 0, 400, 433, 290, 424,
+	0, 416, 268, 0, 415, 349, 397, 402, 335, 329,
+	267, 399, 333, 328, 321, 299, 448, 449, 450, 313,
+	361, 327, 362, 314, 339, 338, 340, 0, 0, 0,
+	0, 0, 444, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 511, 0, 0, 0, 0, 0, 0,
+	418, 0, 0, 0, 0, 0, 0, 386, 0, 0,
+	322, 0, 0, 0, 434, 0, 373, 355, 0, 0,
+	0, 371, 303, 325, 398, 363, 404, 388, 417, 367,
+	364, 259, 389, 293, 336, 270, 272, 288, 296, 298,
+	300, 301, 345, 346, 358, 377, 390, 391, 392, 292,
+	280, 372, 281, 311, 282, 260, 285, 284, 286, 379,
+	287, 262, 359, 396, 0, 307, 368, 332, 263, 331,
+	360, 395, 394, 271, 425, 431, 432, 0, 0, 437,
+	0, 0, 0, 445, 455, 456, 457, 459, 460, 461,
+	462, 0, 0, 0, 0, 439, 0, 0, 451, 452,
+	453, 0, 0, 0, 0, 430, 0, 305, 256, 257,
+	489, 289, 351, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 347, 429, 0, 0, 0, 463,
+	487, 0, 0, 0, 0, 0, 486, 357, 0, 376,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 383, 411, 423, 440, 443, 0, 0, 0,
+	0, 261, 442, 0, 0, 0, 0, 0, 0, 0,
+	0, 414, 0, 0, 0, 422, 0, 0, 0, 0,
+	0, 438, 0, 0, 341, 342, 343, 344, 508, 510,
+	278, 441, 366, 520, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 436, 304, 310, 458, 312, 277, 356, 306, 420,
+	319, 0, 446, 0, 447, 0, 0, 0, 0, 348,
+	315, 316, 380, 320, 326, 369, 419, 354, 374, 275,
+	410, 381, 330, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 56, 0, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 393, 0, 247, 283, 294, 0, 258, 0,
+	324, 140, 365, 302, 0, 0, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 0,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 239, 240, 241, 0, 0, 0, 248, 249, 250,
+	251, 252, 253, 254, 255, 243, 244, 245, 246, 0,
+	0, 0, 426, 427, 428, 454, 412, 0, 485, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 481,
+	490, 468, 469, 470, 471, 472, 473, 474, 475, 476,
+	405, 406, 407, 408, 409, 488, 353, 477, 478, 479,
+	480, 0, 0, 0, 927, 0, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 0, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
+	0, 465, 0, 0, 0, 0, 0, 382, 337, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 196, 0,
+	0, 0, 0, 0, 0, 273, 197, 466, 0, 467,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 915, 0, 0, 0, 0, 0,
+	0, 264, 387, 403, 274, 378, 421, 279, 385, 269,
+	352, 375, 0, 0, 2044, 2046, 2047, 2048, 2049, 2050,
+	2051, 0, 2056, 2052, 2053, 2054, 2055, 0, 2039, 2040,
+	2041, 2042, 913, 2023, 2045, 0, 2024, 349, 2025, 2026,
+	2027, 2028, 2029, 2030, 2031, 2032, 2033, 2034, 2035, 2036,
+	2037, 2043, 361, 327, 362, 314, 339, 338, 340, 940,
+	942, 944, 946, 949, 444, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 418, 0, 0, 0, 0, 0, 0, 386,
+	0, 0, 322, 0, 0, 0, 2038, 0, 373, 355,
+	0, 0, 0, 371, 303, 325, 398, 363, 404, 388,
+	417, 367, 364, 259, 389, 293, 336, 270, 272, 288,
+	296, 298, 300, 301, 345, 346, 358, 377, 390, 391,
+	392, 292, 280, 372, 281, 311, 282, 260, 285, 284,
+	286, 379, 287, 262, 359, 396, 0, 307, 368, 332,
+	263, 331, 360, 395, 394, 271, 425, 431, 432, 0,
+	0, 437, 0, 0, 0, 445, 455, 456, 457, 459,
+	460, 461, 462, 0, 0, 0, 0, 439, 0, 0,
+	451, 452, 453, 0, 0, 0, 0, 430, 0, 305,
+	256, 257, 489, 289, 351, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 429, 0, 0,
+	0, 463, 487, 0, 0, 0, 0, 0, 486, 357,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 383, 411, 423, 440, 443, 0,
+	0, 0, 0, 261, 442, 0, 0, 0, 0, 0,
+	0, 0, 0, 414, 0, 0, 0, 422, 0, 0,
+	0, 0, 0, 438, 0, 0, 341, 342, 343, 344,
+	308, 0, 278, 441, 366, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 436, 304, 310, 458, 312, 277, 356,
+	306, 420, 319, 0, 446, 0, 447, 0, 0, 0,
+	0, 348, 315, 316, 380, 320, 326, 369, 419, 354,
+	374, 275, 410, 381, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 393, 0, 247, 283, 294, 0,
+	258, 939, 324, 0, 365, 302, 0, 0, 205, 206,
 	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 0, 218, 219, 220, 221, 222, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 333, 0, 0, 233,
-	234, 235, 236, 0, 0, 0, 401, 402, 403, 424,
-	387, 0, 442, 278, 0, 0, 303, 0, 0, 0,
-	0, 0, 0, 0, 446, 438, 0, 0, 0, 434,
-	0, 435, 0, 0, 0, 0, 0, 362, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
-	0, 0, 0, 0, 0, 255, 188, 436, 0, 437,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 367, 383, 256, 358, 396, 261, 365, 251,
-	332, 355, 0, 0, 248, 381, 364, 314, 297, 298,
-	247, 0, 350, 276, 289, 273, 330, 0, 380, 408,
-	272, 399, 0, 391, 250, 0, 390, 329, 377, 382,
-	315, 309, 249, 379, 313, 308, 301, 280, 423, 293,
-	341, 307, 342, 294, 319, 318, 320, 0, 0, 0,
-	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 0, 0, 0, 2982, 0, 0, 366, 0, 0,
-	302, 0, 0, 0, 409, 0, 353, 335, 0, 0,
-	0, 351, 305, 378, 343, 384, 368, 392, 347, 344,
-	241, 369, 275, 316, 252, 254, 270, 277, 279, 281,
-	282, 325, 326, 338, 357, 370, 371, 372, 274, 262,
-	352, 263, 291, 264, 242, 267, 266, 268, 359, 269,
-	244, 339, 376, 0, 287, 348, 312, 245, 311, 340,
-	375, 374, 253, 400, 406, 407, 0, 0, 412, 0,
-	0, 0, 420, 425, 426, 427, 429, 430, 431, 432,
-	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
-	0, 405, 285, 238, 239, 445, 271, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	404, 0, 0, 0, 433, 444, 0, 0, 0, 0,
-	0, 443, 337, 0, 356, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 363, 386, 398,
-	415, 418, 0, 0, 0, 0, 243, 417, 0, 0,
-	0, 0, 0, 0, 0, 0, 389, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 413, 0, 0, 321,
-	322, 323, 324, 288, 0, 260, 416, 346, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 410, 411, 284, 290, 428,
-	292, 259, 336, 286, 395, 299, 0, 421, 0, 422,
-	0, 0, 0, 0, 328, 295, 296, 360, 300, 306,
-	349, 394, 334, 354, 257, 385, 361, 310, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 373, 0, 237,
-	265, 0, 240, 0, 304, 0, 345, 283, 0, 0,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 0, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 239, 240, 241, 0, 0, 0, 248,
+	249, 250, 251, 252, 253, 254, 255, 243, 244, 245,
+	246, 0, 0, 0, 426, 427, 428, 454, 412, 0,
+	485, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 481, 490, 468, 469, 470, 471, 472, 473, 474,
+	475, 476, 405, 406, 407, 408, 409, 488, 353, 477,
+	478, 479, 480, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 297, 0, 0, 323, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 464, 0, 465, 0, 0, 0, 0, 0, 382,
+	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	196, 0, 0, 0, 0, 0, 0, 273, 197, 466,
+	0, 467, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 2126, 2129, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 264, 387, 403, 274, 378, 421, 279,
+	385, 269, 352, 375, 0, 0, 266, 401, 384, 334,
+	317, 318, 265, 0, 370, 295, 309, 291, 350, 0,
+	400, 433, 290, 424, 0, 416, 268, 0, 415, 349,
+	397, 402, 335, 329, 267, 399, 333, 328, 321, 299,
+	448, 449, 450, 313, 361, 327, 362, 314, 339, 338,
+	340, 0, 0, 0, 0, 0, 444, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2130, 418, 0, 0, 0, 2125, 0,
+	2124, 386, 2122, 2127, 322, 0, 0, 0, 434, 0,
+	373, 355, 0, 0, 0, 371, 303, 325, 398, 363,
+	404, 388, 417, 367, 364, 259, 389, 293, 336, 270,
+	272, 288, 296, 298, 300, 301, 345, 346, 358, 377,
+	390, 391, 392, 292, 280, 372, 281, 311, 282, 260,
+	285, 284, 286, 379, 287, 262, 359, 396, 2128, 307,
+	368, 332, 263, 331, 360, 395, 394, 271, 425, 431,
+	432, 0, 0, 437, 0, 0, 0, 445, 455, 456,
+	457, 459, 460, 461, 462, 0, 0, 0, 0, 439,
+	0, 0, 451, 452, 453, 0, 0, 0, 0, 430,
+	0, 305, 256, 257, 489, 289, 351, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 347, 429,
+	0, 0, 0, 463, 487, 0, 0, 0, 0, 0,
+	486, 357, 0, 376, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 383, 411, 423, 440,
+	443, 0, 0, 0, 0, 261, 442, 0, 0, 0,
+	0, 0, 0, 0, 0, 414, 0, 0, 0, 422,
+	0, 0, 0, 0, 0, 438, 0, 0, 341, 342,
+	343, 344, 308, 0, 278, 441, 366, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 436, 304, 310, 458, 312,
+	277, 356, 306, 420, 319, 0, 446, 0, 447, 0,
+	0, 0, 0, 348, 315, 316, 380, 320, 326, 369,
+	419, 354, 374, 275, 410, 381, 330, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 393, 0, 247, 283,
+	294, 0, 258, 0, 324, 0, 365, 302, 0, 0,
 	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 0, 218, 219, 220, 221, 222, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 333, 0,
-	0, 233, 234, 235, 236, 0, 0, 0, 401, 402,
-	403, 424, 387, 0, 442, 278, 0, 0, 303, 0,
-	0, 0, 0, 0, 0, 0, 446, 438, 0, 0,
-	0, 434, 0, 435, 0, 0, 0, 0, 0, 362,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 0, 0, 0, 255, 188, 436,
-	0, 437, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2623, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 367, 383, 256, 358, 396, 261,
-	365, 251, 332, 355, 0, 0, 248, 381, 364, 314,
-	297, 298, 247, 0, 350, 276, 289, 273, 330, 0,
-	380, 408, 272, 399, 0, 391, 250, 0, 390, 329,
-	377, 382, 315, 309, 249, 379, 313, 308, 301, 280,
-	423, 293, 341, 307, 342, 294, 319, 318, 320, 0,
-	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 0, 0, 0, 0, 0, 0, 366,
-	0, 0, 302, 0, 0, 0, 409, 0, 353, 335,
-	0, 0, 0, 351, 305, 378, 343, 384, 368, 392,
-	347, 344, 241, 369, 275, 316, 252, 254, 270, 277,
-	279, 281, 282, 325, 326, 338, 357, 370, 371, 372,
-	274, 262, 352, 263, 291, 264, 242, 267, 266, 268,
-	359, 269, 244, 339, 376, 0, 287, 348, 312, 245,
-	311, 340, 375, 374, 253, 400, 406, 407, 0, 0,
-	412, 0, 0, 0, 420, 425, 426, 427, 429, 430,
-	431, 432, 0, 0, 0, 0, 414, 0, 0, 0,
-	0, 0, 0, 405, 285, 238, 239, 445, 271, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 404, 0, 0, 0, 433, 444, 0, 0,
-	0, 0, 0, 443, 337, 0, 356, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
-	386, 398, 415, 418, 0, 0, 0, 0, 243, 417,
-	0, 0, 0, 0, 0, 0, 0, 0, 389, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 413, 0,
-	0, 321, 322, 323, 324, 288, 0, 260, 416, 346,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 410, 411, 284,
-	290, 428, 292, 259, 336, 286, 395, 299, 0, 421,
-	0, 422, 0, 0, 0, 0, 328, 295, 296, 360,
-	300, 306, 349, 394, 334, 354, 257, 385, 361, 310,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 373,
-	0, 237, 265, 0, 240, 0, 304, 0, 345, 283,
-	0, 0, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 0, 218, 219, 220, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	333, 0, 0, 233, 234, 235, 236, 0, 0, 0,
-	401, 402, 403, 424, 387, 0, 442, 278, 0, 0,
-	303, 0, 0, 0, 0, 0, 0, 0, 446, 438,
-	0, 0, 0, 434, 0, 435, 0, 0, 0, 0,
-	0, 362, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1655,
-	0, 0, 187, 0, 0, 0, 0, 0, 0, 255,
-	188, 436, 0, 437, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 246, 367, 383, 256, 358,
-	396, 261, 365, 251, 332, 355, 0, 0, 248, 381,
-	364, 314, 297, 298, 247, 0, 350, 276, 289, 273,
-	330, 0, 380, 408, 272, 399, 0, 391, 250, 0,
-	390, 329, 377, 382, 315, 309, 249, 379, 313, 308,
-	301, 280, 423, 293, 341, 307, 342, 294, 319, 318,
-	320, 0, 0, 0, 0, 0, 419, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 0, 0, 0, 0, 0,
-	0, 366, 0, 0, 302, 0, 0, 0, 409, 0,
-	353, 335, 0, 0, 0, 351, 305, 378, 343, 384,
-	368, 392, 347, 344, 241, 369, 275, 316, 252, 254,
-	270, 277, 279, 281, 282, 325, 326, 338, 357, 370,
-	371, 372, 274, 262, 352, 263, 291, 264, 242, 267,
-	266, 268, 359, 269, 244, 339, 376, 0, 287, 348,
-	312, 245, 311, 340, 375, 374, 253, 400, 406, 407,
-	0, 0, 412, 0, 0, 0, 420, 425, 426, 427,
-	429, 430, 431, 432, 0, 0, 0, 0, 414, 0,
-	0, 0, 0, 0, 0, 405, 285, 238, 239, 445,
-	271, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 327, 404, 0, 0, 0, 433, 444,
-	0, 0, 0, 0, 0, 443, 337, 0, 356, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 363, 386, 398, 415, 418, 0, 0, 0, 0,
-	243, 417, 0, 0, 0, 0, 0, 0, 0, 0,
-	389, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	413, 0, 0, 321, 322, 323, 324, 288, 0, 260,
-	416, 346, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 410,
-	411, 284, 290, 428, 292, 259, 336, 286, 395, 299,
-	0, 421, 0, 422, 0, 0, 0, 0, 328, 295,
-	296, 360, 300, 306, 349, 394, 334, 354, 257, 385,
-	361, 310, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 373, 0, 237, 265, 0, 240, 0, 304, 0,
-	345, 283, 0, 0, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 0, 218, 219,
-	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 333, 0, 0, 233, 234, 235, 236, 0,
-	0, 0, 401, 402, 403, 424, 387, 0, 442, 278,
-	0, 0, 303, 0, 0, 0, 0, 0, 0, 0,
-	446, 438, 0, 0, 0, 434, 0, 435, 0, 0,
-	0, 0, 0, 362, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 0, 2285, 0, 0,
-	0, 255, 188, 436, 0, 437, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 367, 383,
-	256, 358, 396, 261, 365, 251, 332, 355, 0, 0,
-	248, 381, 364, 314, 297, 298, 247, 0, 350, 276,
-	289, 273, 330, 0, 380, 408, 272, 399, 0, 391,
-	250, 0, 390, 329, 377, 382, 315, 309, 249, 379,
-	313, 308, 301, 280, 423, 293, 341, 307, 342, 294,
-	319, 318, 320, 0, 0, 0, 0, 0, 419, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 0, 0, 0,
-	0, 0, 0, 366, 0, 0, 302, 0, 0, 0,
-	409, 0, 353, 335, 0, 0, 0, 351, 305, 378,
-	343, 384, 368, 392, 347, 344, 241, 369, 275, 316,
-	252, 254, 270, 277, 279, 281, 282, 325, 326, 338,
-	357, 370, 371, 372, 274, 262, 352, 263, 291, 264,
-	242, 267, 266, 268, 359, 269, 244, 339, 376, 0,
-	287, 348, 312, 245, 311, 340, 375, 374, 253, 400,
-	406, 407, 0, 0, 412, 0, 0, 0, 420, 425,
-	426, 427, 429, 430, 431, 432, 0, 0, 0, 0,
-	414, 0, 0, 0, 0, 0, 0, 405, 285, 238,
-	239, 445, 271, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 404, 0, 0, 0,
-	433, 444, 0, 0, 0, 0, 0, 443, 337, 0,
-	356, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 363, 386, 398, 415, 418, 0, 0,
-	0, 0, 243, 417, 0, 0, 0, 0, 0, 0,
-	0, 0, 389, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 413, 0, 0, 321, 322, 323, 324, 288,
-	0, 260, 416, 346, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 410, 411, 284, 290, 428, 292, 259, 336, 286,
-	395, 299, 0, 421, 0, 422, 0, 0, 0, 0,
-	328, 295, 296, 360, 300, 306, 349, 394, 334, 354,
-	257, 385, 361, 310, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 373, 0, 237, 265, 0, 240, 0,
-	304, 0, 345, 283, 0, 0, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 0,
-	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 333, 0, 0, 233, 234, 235,
-	236, 0, 0, 0, 401, 402, 403, 424, 387, 0,
-	442, 278, 0, 0, 303, 0, 0, 0, 0, 0,
-	0, 0, 446, 438, 0, 0, 0, 434, 0, 435,
-	0, 0, 0, 0, 0, 362, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
-	0, 0, 0, 255, 188, 436, 0, 437, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2704, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
-	367, 383, 256, 358, 396, 261, 365, 251, 332, 355,
-	0, 0, 248, 381, 364, 314, 297, 298, 247, 0,
-	350, 276, 289, 273, 330, 0, 380, 408, 272, 399,
-	0, 391, 250, 0, 390, 329, 377, 382, 315, 309,
-	249, 379, 313, 308, 301, 280, 423, 293, 341, 307,
-	342, 294, 319, 318, 320, 0, 0, 0, 0, 0,
-	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 0, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 239, 240, 241, 0, 0,
+	0, 248, 249, 250, 251, 252, 253, 254, 255, 243,
+	244, 245, 246, 0, 0, 0, 426, 427, 428, 454,
+	412, 0, 485, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 481, 490, 468, 469, 470, 471, 472,
+	473, 474, 475, 476, 405, 406, 407, 408, 409, 488,
+	353, 477, 478, 479, 480, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 297, 0, 0,
+	323, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 464, 0, 465, 0, 0, 0, 0,
+	0, 382, 337, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 196, 0, 0, 0, 0, 0, 0, 273,
+	197, 466, 0, 467, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 2134, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 264, 387, 403, 274, 378,
+	421, 279, 385, 269, 352, 375, 0, 0, 266, 401,
+	384, 334, 317, 318, 265, 0, 370, 295, 309, 291,
+	350, 0, 400, 433, 290, 424, 0, 416, 268, 0,
+	415, 349, 397, 402, 335, 329, 267, 399, 333, 328,
+	321, 299, 448, 449, 450, 313, 361, 327, 362, 314,
+	339, 338, 340, 0, 0, 0, 0, 0, 444, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 2133, 418, 0, 0, 0,
+	2138, 2136, 2140, 386, 0, 2137, 322, 0, 0, 0,
+	434, 0, 373, 355, 0, 0, 2131, 371, 303, 325,
+	398, 363, 404, 388, 417, 367, 364, 259, 389, 293,
+	336, 270, 272, 288, 296, 298, 300, 301, 345, 346,
+	358, 377, 390, 391, 392, 292, 280, 372, 281, 311,
+	282, 260, 285, 284, 286, 379, 287, 262, 359, 396,
+	2139, 307, 368, 332, 263, 331, 360, 395, 394, 271,
+	425, 431, 432, 0, 0, 437, 0, 0, 0, 445,
+	455, 456, 457, 459, 460, 461, 462, 0, 0, 0,
+	0, 439, 0, 0, 451, 452, 453, 0, 0, 0,
+	0, 430, 0, 305, 256, 257, 489, 289, 351, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	347, 429, 0, 0, 0, 463, 487, 0, 0, 0,
+	0, 0, 486, 357, 0, 376, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 383, 411,
+	423, 440, 443, 0, 0, 0, 0, 261, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 414, 0, 0,
+	0, 422, 0, 0, 0, 0, 0, 438, 0, 0,
+	341, 342, 343, 344, 308, 0, 278, 441, 366, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 436, 304, 310,
+	458, 312, 277, 356, 306, 420, 319, 0, 446, 0,
+	447, 0, 0, 0, 0, 348, 315, 316, 380, 320,
+	326, 369, 419, 354, 374, 275, 410, 381, 330, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 393, 0,
-	0, 0, 0, 0, 0, 366, 0, 0, 302, 0,
-	0, 0, 409, 0, 353, 335, 0, 0, 0, 351,
-	305, 378, 343, 384, 368, 392, 347, 344, 241, 369,
-	275, 316, 252, 254, 270, 277, 279, 281, 282, 325,
-	326, 338, 357, 370, 371, 372, 274, 262, 352, 263,
-	291, 264, 242, 267, 266, 268, 359, 269, 244, 339,
-	376, 0, 287, 348, 312, 245, 311, 340, 375, 374,
-	253, 400, 406, 407, 0, 0, 412, 0, 0, 0,
-	420, 425, 426, 427, 429, 430, 431, 432, 0, 0,
-	0, 0, 414, 0, 0, 0, 0, 0, 0, 405,
-	285, 238, 239, 445, 271, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 327, 404, 0,
-	0, 0, 433, 444, 0, 0, 0, 0, 0, 443,
-	337, 0, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 363, 386, 398, 415, 418,
-	0, 0, 0, 0, 243, 417, 0, 0, 0, 0,
-	0, 0, 0, 0, 389, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 413, 0, 0, 321, 322, 323,
-	324, 288, 0, 260, 416, 346, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 410, 411, 284, 290, 428, 292, 259,
-	336, 286, 395, 299, 0, 421, 0, 422, 0, 0,
-	0, 0, 328, 295, 296, 360, 300, 306, 349, 394,
-	334, 354, 257, 385, 361, 310, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 373, 0, 237, 265, 0,
-	240, 0, 304, 0, 345, 283, 0, 0, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
-	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 0, 218, 219, 220, 221, 222, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 333, 0, 0, 233,
-	234, 235, 236, 0, 0, 0, 401, 402, 403, 424,
-	387, 0, 442, 278, 0, 0, 303, 0, 0, 0,
-	0, 0, 0, 0, 446, 438, 0, 0, 0, 434,
-	0, 435, 0, 0, 0, 0, 0, 362, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
-	0, 2568, 0, 0, 0, 255, 188, 436, 0, 437,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 367, 383, 256, 358, 396, 261, 365, 251,
-	332, 355, 0, 0, 248, 381, 364, 314, 297, 298,
-	247, 0, 350, 276, 289, 273, 330, 0, 380, 408,
-	272, 399, 0, 391, 250, 0, 390, 329, 377, 382,
-	315, 309, 249, 379, 313, 308, 301, 280, 423, 293,
-	341, 307, 342, 294, 319, 318, 320, 0, 0, 0,
-	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 0, 0, 0, 0, 0, 0, 366, 0, 0,
-	302, 0, 0, 0, 409, 0, 353, 335, 0, 0,
-	0, 351, 305, 378, 343, 384, 368, 392, 347, 344,
-	241, 369, 275, 316, 252, 254, 270, 277, 279, 281,
-	282, 325, 326, 338, 357, 370, 371, 372, 274, 262,
-	352, 263, 291, 264, 242, 267, 266, 268, 359, 269,
-	244, 339, 376, 0, 287, 348, 312, 245, 311, 340,
-	375, 374, 253, 400, 406, 407, 0, 0, 412, 0,
-	0, 0, 420, 425, 426, 427, 429, 430, 431, 432,
-	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
-	0, 405, 285, 238, 239, 445, 271, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	404, 0, 0, 0, 433, 444, 0, 0, 0, 0,
-	0, 443, 337, 0, 356, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 363, 386, 398,
-	415, 418, 0, 0, 0, 0, 243, 417, 0, 0,
-	0, 0, 0, 0, 0, 0, 389, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 413, 0, 0, 321,
-	322, 323, 324, 288, 0, 260, 416, 346, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 410, 411, 284, 290, 428,
-	292, 259, 336, 286, 395, 299, 0, 421, 0, 422,
-	0, 0, 0, 0, 328, 295, 296, 360, 300, 306,
-	349, 394, 334, 354, 257, 385, 361, 310, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 373, 0, 237,
-	265, 0, 240, 0, 304, 0, 345, 283, 0, 0,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 0, 218, 219, 220, 221, 222, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 333, 0,
-	0, 233, 234, 235, 236, 0, 0, 0, 401, 402,
-	403, 424, 387, 0, 442, 278, 0, 0, 303, 0,
-	0, 0, 0, 0, 0, 0, 446, 438, 0, 0,
-	0, 434, 0, 435, 0, 0, 0, 0, 0, 362,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 0, 0, 0, 255, 188, 436,
-	0, 437, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 2497, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 367, 383, 256, 358, 396, 261,
-	365, 251, 332, 355, 0, 0, 248, 381, 364, 314,
-	297, 298, 247, 0, 350, 276, 289, 273, 330, 0,
-	380, 408, 272, 399, 0, 391, 250, 0, 390, 329,
-	377, 382, 315, 309, 249, 379, 313, 308, 301, 280,
-	423, 293, 341, 307, 342, 294, 319, 318, 320, 0,
-	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 0, 0, 0, 0, 0, 0, 366,
-	0, 0, 302, 0, 0, 0, 409, 0, 353, 335,
-	0, 0, 0, 351, 305, 378, 343, 384, 368, 392,
-	347, 344, 241, 369, 275, 316, 252, 254, 270, 277,
-	279, 281, 282, 325, 326, 338, 357, 370, 371, 372,
-	274, 262, 352, 263, 291, 264, 242, 267, 266, 268,
-	359, 269, 244, 339, 376, 0, 287, 348, 312, 245,
-	311, 340, 375, 374, 253, 400, 406, 407, 0, 0,
-	412, 0, 0, 0, 420, 425, 426, 427, 429, 430,
-	431, 432, 0, 0, 0, 0, 414, 0, 0, 0,
-	0, 0, 0, 405, 285, 238, 239, 445, 271, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 404, 0, 0, 0, 433, 444, 0, 0,
-	0, 0, 0, 443, 337, 0, 356, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
-	386, 398, 415, 418, 0, 0, 0, 0, 243, 417,
-	0, 0, 0, 0, 0, 0, 0, 0, 389, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 413, 0,
-	0, 321, 322, 323, 324, 288, 0, 260, 416, 346,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 410, 411, 284,
-	290, 428, 292, 259, 336, 286, 395, 299, 0, 421,
-	0, 422, 0, 0, 0, 0, 328, 295, 296, 360,
-	300, 306, 349, 394, 334, 354, 257, 385, 361, 310,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 373,
-	0, 237, 265, 0, 240, 0, 304, 0, 345, 283,
-	0, 0, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 0, 218, 219, 220, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	333, 0, 0, 233, 234, 235, 236, 0, 0, 0,
-	401, 402, 403, 424, 387, 0, 442, 278, 0, 0,
-	303, 0, 0, 0, 0, 0, 0, 0, 446, 438,
-	0, 0, 0, 434, 0, 435, 0, 0, 0, 0,
-	0, 362, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 0, 0, 1219, 0, 0, 0, 255,
-	188, 436, 0, 437, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 246, 367, 383, 256, 358,
-	396, 261, 365, 251, 332, 355, 0, 0, 248, 381,
-	364, 314, 297, 298, 247, 0, 350, 276, 289, 273,
-	330, 0, 380, 408, 272, 399, 0, 391, 250, 0,
-	390, 329, 377, 382, 315, 309, 249, 379, 313, 308,
-	301, 280, 423, 293, 341, 307, 342, 294, 319, 318,
-	320, 0, 0, 0, 0, 0, 419, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 0, 0, 0, 0, 0,
-	0, 366, 0, 0, 302, 0, 0, 0, 409, 0,
-	353, 335, 0, 0, 0, 351, 305, 378, 343, 384,
-	368, 392, 347, 344, 241, 369, 275, 316, 252, 254,
-	270, 277, 279, 281, 282, 325, 326, 338, 357, 370,
-	371, 372, 274, 262, 352, 263, 291, 264, 242, 267,
-	266, 268, 359, 269, 244, 339, 376, 0, 287, 348,
-	312, 245, 311, 340, 375, 374, 253, 400, 406, 407,
-	0, 0, 412, 0, 0, 0, 420, 425, 426, 427,
-	429, 430, 431, 432, 0, 0, 0, 0, 414, 0,
-	0, 0, 0, 0, 0, 405, 285, 238, 239, 445,
-	271, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 327, 404, 0, 0, 0, 433, 444,
-	0, 0, 0, 0, 0, 443, 337, 0, 356, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 363, 386, 398, 415, 418, 0, 0, 0, 0,
-	243, 417, 0, 0, 0, 0, 0, 0, 0, 0,
-	389, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	413, 0, 0, 321, 322, 323, 324, 288, 0, 260,
-	416, 346, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 410,
-	411, 284, 290, 428, 292, 259, 336, 286, 395, 299,
-	0, 421, 0, 422, 0, 0, 0, 0, 328, 295,
-	296, 360, 300, 306, 349, 394, 334, 354, 257, 385,
-	361, 310, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 373, 0, 237, 265, 0, 240, 0, 304, 0,
-	345, 283, 0, 0, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 0, 218, 219,
-	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 333, 0, 0, 233, 234, 235, 236, 0,
-	0, 0, 401, 402, 403, 424, 387, 0, 442, 278,
-	0, 0, 303, 0, 0, 0, 0, 0, 0, 0,
-	446, 438, 0, 0, 0, 434, 0, 435, 0, 0,
-	0, 0, 0, 362, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 0, 2080, 0, 0,
-	0, 255, 188, 436, 0, 437, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 367, 383,
-	256, 358, 396, 261, 365, 251, 332, 355, 0, 0,
-	248, 381, 364, 314, 297, 298, 247, 0, 350, 276,
-	289, 273, 330, 0, 380, 408, 272, 399, 0, 391,
-	250, 0, 390, 329, 377, 382, 315, 309, 249, 379,
-	313, 308, 301, 280, 423, 293, 341, 307, 342, 294,
-	319, 318, 320, 0, 0, 0, 0, 0, 419, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 0, 0, 0,
-	0, 0, 0, 366, 0, 0, 302, 0, 0, 0,
-	409, 0, 353, 335, 0, 0, 0, 351, 305, 378,
-	343, 384, 368, 392, 347, 344, 241, 369, 275, 316,
-	252, 254, 270, 277, 279, 281, 282, 325, 326, 338,
-	357, 370, 371, 372, 274, 262, 352, 263, 291, 264,
-	242, 267, 266, 268, 359, 269, 244, 339, 376, 0,
-	287, 348, 312, 245, 311, 340, 375, 374, 253, 400,
-	406, 407, 0, 0, 412, 0, 0, 0, 420, 425,
-	426, 427, 429, 430, 431, 432, 0, 0, 0, 0,
-	414, 0, 0, 0, 0, 0, 0, 405, 285, 238,
-	239, 445, 271, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 404, 0, 0, 0,
-	433, 444, 0, 0, 0, 0, 0, 443, 337, 0,
-	356, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 363, 386, 398, 415, 418, 0, 0,
-	0, 0, 243, 417, 0, 0, 0, 0, 0, 0,
-	0, 0, 389, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 413, 0, 0, 321, 322, 323, 324, 288,
-	0, 260, 416, 346, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 410, 411, 284, 290, 428, 292, 259, 336, 286,
-	395, 299, 0, 421, 0, 422, 0, 0, 0, 0,
-	328, 295, 296, 360, 300, 306, 349, 394, 334, 354,
-	257, 385, 361, 310, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 373, 0, 237, 265, 0, 240, 0,
-	304, 0, 345, 283, 0, 0, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 0,
+	247, 283, 294, 0, 258, 0, 324, 0, 365, 302,
+	0, 0, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 0, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
+	0, 0, 0, 248, 249, 250, 251, 252, 253, 254,
+	255, 243, 244, 245, 246, 0, 0, 0, 426, 427,
+	428, 454, 412, 0, 485, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 481, 490, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 405, 406, 407, 408,
+	409, 488, 353, 477, 478, 479, 480, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 297,
+	0, 0, 323, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 464, 0, 465, 0, 0,
+	0, 0, 0, 382, 337, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 196, 0, 0, 0, 0, 0,
+	0, 273, 197, 466, 0, 467, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 2134, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 264, 387, 403,
+	274, 378, 421, 279, 385, 269, 352, 375, 0, 0,
+	266, 401, 384, 334, 317, 318, 265, 0, 370, 295,
+	309, 291, 350, 0, 400, 433, 290, 424, 0, 416,
+	268, 0, 415, 349, 397, 402, 335, 329, 267, 399,
+	333, 328, 321, 299, 448, 449, 450, 313, 361, 327,
+	362, 314, 339, 338, 340, 0, 0, 0, 0, 0,
+	444, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2133, 418, 0,
+	0, 0, 2138, 2136, 2140, 386, 0, 2137, 322, 0,
+	0, 0, 434, 0, 373, 355, 0, 0, 0, 371,
+	303, 325, 398, 363, 404, 388, 417, 367, 364, 259,
+	389, 293, 336, 270, 272, 288, 296, 298, 300, 301,
+	345, 346, 358, 377, 390, 391, 392, 292, 280, 372,
+	281, 311, 282, 260, 285, 284, 286, 379, 287, 262,
+	359, 396, 2139, 307, 368, 332, 263, 331, 360, 395,
+	394, 271, 425, 431, 432, 0, 0, 437, 0, 0,
+	0, 445, 455, 456, 457, 459, 460, 461, 462, 0,
+	0, 0, 0, 439, 0, 0, 451, 452, 453, 0,
+	0, 0, 0, 430, 0, 305, 256, 257, 489, 289,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 347, 429, 0, 0, 0, 463, 487, 0,
+	0, 0, 0, 0, 486, 357, 0, 376, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	383, 411, 423, 440, 443, 0, 0, 0, 0, 261,
+	442, 0, 0, 0, 0, 0, 0, 0, 0, 414,
+	0, 0, 0, 422, 0, 0, 0, 0, 0, 438,
+	0, 0, 341, 342, 343, 344, 308, 0, 278, 441,
+	366, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 436,
+	304, 310, 458, 312, 277, 356, 306, 420, 319, 0,
+	446, 0, 447, 0, 0, 0, 0, 348, 315, 316,
+	380, 320, 326, 369, 419, 354, 374, 275, 410, 381,
+	330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	393, 0, 247, 283, 294, 0, 258, 0, 324, 0,
+	365, 302, 0, 0, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 0, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 239,
+	240, 241, 0, 0, 0, 248, 249, 250, 251, 252,
+	253, 254, 255, 243, 244, 245, 246, 0, 0, 0,
+	426, 427, 428, 454, 412, 0, 485, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 490, 468,
+	469, 470, 471, 472, 473, 474, 475, 476, 405, 406,
+	407, 408, 409, 488, 481, 477, 478, 479, 480, 0,
+	0, 174, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 353, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 0, 465, 0, 0, 118,
+	0, 0, 382, 337, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1764, 0, 0, 196, 0, 0, 0, 0, 0, 0,
+	273, 197, 466, 0, 467, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 264, 387, 403, 274,
+	378, 421, 279, 385, 269, 352, 375, 0, 0, 266,
+	401, 384, 334, 317, 318, 265, 0, 370, 295, 309,
+	291, 350, 0, 400, 433, 290, 424, 0, 416, 268,
+	0, 415, 349, 397, 402, 335, 329, 267, 399, 333,
+	328, 321, 299, 448, 449, 450, 313, 361, 327, 362,
+	314, 339, 338, 340, 0, 0, 0, 0, 0, 444,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 0,
+	0, 0, 0, 0, 386, 0, 0, 322, 0, 0,
+	0, 434, 0, 373, 355, 0, 0, 0, 371, 303,
+	325, 398, 363, 404, 388, 417, 367, 364, 259, 389,
+	293, 336, 270, 272, 288, 296, 298, 300, 301, 345,
+	346, 358, 377, 390, 391, 392, 292, 280, 372, 281,
+	311, 282, 260, 285, 284, 286, 379, 287, 262, 359,
+	396, 0, 307, 368, 332, 263, 331, 360, 395, 394,
+	271, 425, 431, 432, 0, 0, 437, 0, 0, 0,
+	445, 455, 456, 457, 459, 460, 461, 462, 0, 0,
+	0, 0, 439, 0, 0, 451, 452, 453, 0, 0,
+	0, 0, 430, 1762, 305, 256, 257, 489, 289, 351,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 347, 429, 0, 0, 0, 463, 487, 0, 0,
+	0, 0, 0, 486, 357, 0, 376, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	411, 423, 440, 443, 0, 0, 0, 0, 261, 442,
+	0, 0, 0, 0, 0, 0, 0, 0, 414, 0,
+	0, 0, 422, 0, 0, 0, 0, 0, 438, 0,
+	0, 341, 342, 343, 344, 308, 0, 278, 441, 366,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 435, 436, 304,
+	310, 458, 312, 277, 356, 306, 420, 319, 0, 446,
+	0, 447, 0, 0, 0, 0, 348, 315, 316, 380,
+	320, 326, 369, 419, 354, 374, 275, 410, 381, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
+	0, 247, 283, 294, 0, 258, 0, 324, 140, 365,
+	302, 0, 0, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 0, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 239, 240,
+	241, 0, 0, 0, 248, 249, 250, 251, 252, 253,
+	254, 255, 243, 244, 245, 246, 0, 0, 0, 426,
+	427, 428, 454, 412, 0, 485, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 490, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 405, 406, 407,
+	408, 409, 488, 1768, 477, 478, 479, 480, 481, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 353, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1829, 0, 0,
+	0, 0, 297, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 0,
+	465, 0, 0, 0, 0, 0, 382, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 0, 0,
+	1830, 0, 0, 0, 273, 197, 466, 0, 467, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 1035, 1036, 1037, 1034, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	264, 387, 403, 274, 378, 421, 279, 385, 269, 352,
+	375, 0, 0, 266, 401, 384, 334, 317, 318, 265,
+	0, 370, 295, 309, 291, 350, 0, 400, 433, 290,
+	424, 0, 416, 268, 0, 415, 349, 397, 402, 335,
+	329, 267, 399, 333, 328, 321, 299, 448, 449, 450,
+	313, 361, 327, 362, 314, 339, 338, 340, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 418, 0, 0, 0, 0, 0, 0, 386, 0,
+	0, 322, 0, 0, 0, 434, 0, 373, 355, 0,
+	0, 0, 371, 303, 325, 398, 363, 404, 388, 417,
+	367, 364, 259, 389, 293, 336, 270, 272, 288, 296,
+	298, 300, 301, 345, 346, 358, 377, 390, 391, 392,
+	292, 280, 372, 281, 311, 282, 260, 285, 284, 286,
+	379, 287, 262, 359, 396, 0, 307, 368, 332, 263,
+	331, 360, 395, 394, 271, 425, 431, 432, 0, 0,
+	437, 0, 0, 0, 445, 455, 456, 457, 459, 460,
+	461, 462, 0, 0, 0, 0, 439, 0, 0, 451,
+	452, 453, 0, 0, 0, 0, 430, 0, 305, 256,
+	257, 489, 289, 351, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 347, 429, 0, 0, 0,
+	463, 487, 0, 0, 0, 0, 0, 486, 357, 0,
+	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 411, 423, 440, 443, 0, 0,
+	0, 0, 261, 442, 0, 0, 0, 0, 0, 0,
+	0, 0, 414, 0, 0, 0, 422, 0, 0, 0,
+	0, 0, 438, 0, 0, 341, 342, 343, 344, 308,
+	0, 278, 441, 366, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 436, 304, 310, 458, 312, 277, 356, 306,
+	420, 319, 0, 446, 0, 447, 0, 0, 0, 0,
+	348, 315, 316, 380, 320, 326, 369, 419, 354, 374,
+	275, 410, 381, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 393, 0, 247, 283, 294, 0, 258,
+	0, 324, 0, 365, 302, 0, 0, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
 	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 333, 0, 0, 233, 234, 235,
-	236, 0, 0, 0, 401, 402, 403, 424, 387, 0,
-	442, 278, 0, 0, 303, 0, 0, 0, 0, 0,
-	0, 0, 446, 438, 0, 0, 0, 434, 0, 435,
-	0, 0, 0, 0, 0, 362, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
-	0, 0, 0, 255, 188, 436, 0, 437, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 258, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1775, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
-	367, 383, 256, 358, 396, 261, 365, 251, 332, 355,
-	0, 0, 248, 381, 364, 314, 297, 298, 247, 0,
-	350, 276, 289, 273, 330, 0, 380, 408, 272, 399,
-	0, 391, 250, 0, 390, 329, 377, 382, 315, 309,
-	249, 379, 313, 308, 301, 280, 423, 293, 341, 307,
-	342, 294, 319, 318, 320, 0, 0, 0, 0, 0,
-	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 393, 0,
-	0, 0, 0, 0, 0, 366, 0, 0, 302, 0,
-	0, 0, 409, 0, 353, 335, 0, 0, 0, 351,
-	305, 378, 343, 384, 368, 392, 347, 344, 241, 369,
-	275, 316, 252, 254, 270, 277, 279, 281, 282, 325,
-	326, 338, 357, 370, 371, 372, 274, 262, 352, 263,
-	291, 264, 242, 267, 266, 268, 359, 269, 244, 339,
-	376, 0, 287, 348, 312, 245, 311, 340, 375, 374,
-	253, 400, 406, 407, 0, 0, 412, 0, 0, 0,
-	420, 425, 426, 427, 429, 430, 431, 432, 0, 0,
-	0, 0, 414, 0, 0, 0, 0, 0, 0, 405,
-	285, 238, 239, 445, 271, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 327, 404, 0,
-	0, 0, 433, 444, 0, 0, 0, 0, 0, 443,
-	337, 0, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 363, 386, 398, 415, 418,
-	0, 0, 0, 0, 243, 417, 0, 0, 0, 0,
-	0, 0, 0, 0, 389, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 413, 0, 0, 321, 322, 323,
-	324, 288, 0, 260, 416, 346, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 410, 411, 284, 290, 428, 292, 259,
-	336, 286, 395, 299, 0, 421, 0, 422, 0, 0,
-	0, 0, 328, 295, 296, 360, 300, 306, 349, 394,
-	334, 354, 257, 385, 361, 310, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 373, 0, 237, 265, 0,
-	240, 0, 304, 0, 345, 283, 0, 0, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	0, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 238, 239, 240, 241, 0, 0, 0, 248, 249,
+	250, 251, 252, 253, 254, 255, 243, 244, 245, 246,
+	0, 0, 0, 426, 427, 428, 454, 412, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	481, 490, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 405, 406, 407, 408, 409, 488, 353, 477, 478,
+	479, 480, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 846, 0, 323, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 0, 465, 0, 0, 0, 0, 0, 382, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 196,
+	853, 854, 0, 0, 0, 0, 273, 197, 466, 0,
+	467, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	857, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 264, 387, 841, 274, 378, 421, 279, 385,
+	269, 352, 375, 0, 0, 266, 401, 384, 334, 317,
+	318, 265, 0, 370, 295, 309, 291, 350, 0, 400,
+	433, 290, 424, 831, 416, 268, 830, 415, 349, 397,
+	402, 335, 329, 267, 399, 333, 328, 321, 299, 448,
+	449, 450, 313, 361, 327, 362, 314, 339, 338, 340,
+	0, 0, 0, 0, 0, 444, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 418, 0, 0, 0, 0, 0, 0,
+	386, 0, 0, 322, 0, 0, 0, 434, 0, 373,
+	355, 0, 0, 0, 371, 303, 325, 398, 363, 404,
+	388, 417, 844, 364, 259, 389, 293, 336, 270, 272,
+	288, 296, 298, 300, 301, 345, 346, 358, 377, 390,
+	391, 392, 292, 280, 372, 281, 311, 282, 260, 285,
+	284, 286, 379, 287, 262, 359, 396, 0, 307, 368,
+	332, 263, 331, 360, 395, 394, 271, 425, 431, 432,
+	0, 0, 437, 0, 0, 0, 445, 455, 456, 457,
+	459, 460, 461, 462, 0, 0, 0, 0, 439, 0,
+	0, 451, 452, 453, 0, 0, 0, 0, 430, 0,
+	305, 256, 257, 489, 289, 351, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 347, 429, 0,
+	0, 0, 463, 487, 0, 0, 0, 0, 0, 486,
+	357, 0, 376, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 411, 423, 440, 443,
+	0, 0, 0, 0, 261, 442, 0, 0, 0, 0,
+	0, 0, 845, 0, 414, 0, 0, 0, 422, 0,
+	0, 0, 0, 0, 848, 0, 0, 341, 342, 343,
+	344, 308, 0, 278, 441, 366, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 435, 436, 304, 310, 458, 312, 277,
+	356, 306, 420, 319, 0, 446, 0, 447, 0, 0,
+	0, 0, 855, 842, 851, 843, 320, 326, 369, 419,
+	354, 374, 275, 410, 381, 852, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 393, 0, 247, 283, 294,
+	0, 258, 0, 324, 0, 365, 302, 0, 0, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 0, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 238, 239, 240, 241, 0, 0, 0,
+	248, 249, 250, 251, 252, 253, 254, 255, 243, 244,
+	245, 246, 0, 0, 0, 426, 427, 428, 454, 412,
+	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 490, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 405, 406, 407, 408, 409, 488, 481,
+	477, 478, 479, 480, 0, 0, 174, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 353, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 0, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
+	0, 465, 0, 0, 118, 0, 0, 382, 337, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 1873, 0, 196, 0,
+	0, 0, 0, 0, 0, 273, 197, 466, 0, 467,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 264, 387, 403, 274, 378, 421, 279, 385, 269,
+	352, 375, 0, 0, 266, 401, 384, 334, 317, 318,
+	265, 0, 370, 295, 309, 291, 350, 0, 400, 433,
+	290, 424, 0, 416, 268, 0, 415, 349, 397, 402,
+	335, 329, 267, 399, 333, 328, 321, 299, 448, 449,
+	450, 313, 361, 327, 362, 314, 339, 338, 340, 0,
+	0, 0, 0, 0, 444, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 418, 0, 0, 0, 0, 0, 0, 386,
+	0, 0, 322, 0, 0, 0, 434, 0, 373, 355,
+	0, 0, 0, 371, 303, 325, 398, 363, 404, 388,
+	417, 367, 364, 259, 389, 293, 336, 270, 272, 288,
+	296, 298, 300, 301, 345, 346, 358, 377, 390, 391,
+	392, 292, 280, 372, 281, 311, 282, 260, 285, 284,
+	286, 379, 287, 262, 359, 396, 0, 307, 368, 332,
+	263, 331, 360, 395, 394, 271, 425, 431, 432, 0,
+	0, 437, 0, 0, 0, 445, 455, 456, 457, 459,
+	460, 461, 462, 0, 0, 0, 0, 439, 0, 0,
+	451, 452, 453, 0, 0, 0, 0, 430, 0, 305,
+	256, 257, 489, 289, 351, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 429, 0, 0,
+	0, 463, 487, 0, 0, 0, 0, 0, 486, 357,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 383, 411, 423, 440, 443, 0,
+	0, 0, 0, 261, 442, 0, 0, 0, 0, 0,
+	0, 0, 0, 414, 0, 0, 0, 422, 0, 0,
+	0, 0, 0, 438, 0, 0, 341, 342, 343, 344,
+	308, 0, 278, 441, 366, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 436, 304, 310, 458, 312, 277, 356,
+	306, 420, 319, 0, 446, 0, 447, 0, 0, 0,
+	0, 348, 315, 316, 380, 320, 326, 369, 419, 354,
+	374, 275, 410, 381, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 393, 0, 247, 283, 294, 0,
+	258, 0, 324, 140, 365, 302, 0, 0, 205, 206,
 	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 0, 218, 219, 220, 221, 222, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 333, 0, 0, 233,
-	234, 235, 236, 0, 0, 0, 401, 402, 403, 424,
-	387, 0, 442, 278, 0, 0, 303, 0, 0, 0,
-	0, 0, 0, 0, 446, 438, 0, 0, 0, 434,
-	0, 435, 0, 0, 0, 0, 0, 362, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
-	0, 0, 0, 0, 0, 255, 188, 436, 0, 437,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2117, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 367, 383, 256, 358, 396, 261, 365, 251,
-	332, 355, 0, 0, 248, 381, 364, 314, 297, 298,
-	247, 0, 350, 276, 289, 273, 330, 0, 380, 408,
-	272, 399, 0, 391, 250, 0, 390, 329, 377, 382,
-	315, 309, 249, 379, 313, 308, 301, 280, 423, 293,
-	341, 307, 342, 294, 319, 318, 320, 0, 0, 0,
-	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 0, 0, 0, 0, 0, 0, 366, 0, 0,
-	302, 0, 0, 0, 409, 0, 353, 335, 0, 0,
-	0, 351, 305, 378, 343, 384, 368, 392, 347, 344,
-	241, 369, 275, 316, 252, 254, 270, 277, 279, 281,
-	282, 325, 326, 338, 357, 370, 371, 372, 274, 262,
-	352, 263, 291, 264, 242, 267, 266, 268, 359, 269,
-	244, 339, 376, 0, 287, 348, 312, 245, 311, 340,
-	375, 374, 253, 400, 406, 407, 0, 0, 412, 0,
-	0, 0, 420, 425, 426, 427, 429, 430, 431, 432,
-	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
-	0, 405, 285, 238, 239, 445, 271, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	404, 0, 0, 0, 433, 444, 0, 0, 0, 0,
-	0, 443, 337, 0, 356, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 363, 386, 398,
-	415, 418, 0, 0, 0, 0, 243, 417, 0, 0,
-	0, 0, 0, 0, 0, 0, 389, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 413, 0, 0, 321,
-	322, 323, 324, 288, 0, 260, 416, 346, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 410, 411, 284, 290, 428,
-	292, 259, 336, 286, 395, 299, 0, 421, 0, 422,
-	0, 0, 0, 0, 328, 295, 296, 360, 300, 306,
-	349, 394, 334, 354, 257, 385, 361, 310, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 373, 0, 237,
-	265, 0, 240, 0, 304, 0, 345, 283, 0, 0,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 0, 218, 219, 220, 221, 222, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 333, 0,
-	0, 233, 234, 235, 236, 0, 0, 0, 401, 402,
-	403, 424, 387, 0, 442, 278, 0, 0, 303, 0,
-	0, 0, 0, 0, 0, 0, 446, 438, 0, 0,
-	0, 434, 0, 435, 0, 0, 0, 0, 0, 362,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 2115, 0, 0, 0, 255, 188, 436,
-	0, 437, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 367, 383, 256, 358, 396, 261,
-	365, 251, 332, 355, 0, 0, 248, 381, 364, 314,
-	297, 298, 247, 0, 350, 276, 289, 273, 330, 0,
-	380, 408, 272, 399, 0, 391, 250, 0, 390, 329,
-	377, 382, 315, 309, 249, 379, 313, 308, 301, 280,
-	423, 293, 341, 307, 342, 294, 319, 318, 320, 0,
-	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 0, 0, 0, 0, 0, 0, 366,
-	0, 0, 302, 0, 0, 0, 409, 0, 353, 335,
-	0, 0, 0, 351, 305, 378, 343, 384, 368, 392,
-	347, 344, 241, 369, 275, 316, 252, 254, 270, 277,
-	279, 281, 282, 325, 326, 338, 357, 370, 371, 372,
-	274, 262, 352, 263, 291, 264, 242, 267, 266, 268,
-	359, 269, 244, 339, 376, 0, 287, 348, 312, 245,
-	311, 340, 375, 374, 253, 400, 406, 407, 0, 0,
-	412, 0, 0, 0, 420, 425, 426, 427, 429, 430,
-	431, 432, 0, 0, 0, 0, 414, 0, 0, 0,
-	0, 0, 0, 405, 285, 238, 239, 445, 271, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 404, 0, 0, 0, 433, 444, 0, 0,
-	0, 0, 0, 443, 337, 0, 356, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
-	386, 398, 415, 418, 0, 0, 0, 0, 243, 417,
-	0, 0, 0, 0, 0, 0, 0, 0, 389, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 413, 0,
-	0, 321, 322, 323, 324, 288, 0, 260, 416, 346,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 410, 411, 284,
-	290, 428, 292, 259, 336, 286, 395, 299, 0, 421,
-	0, 422, 0, 0, 0, 0, 328, 295, 296, 360,
-	300, 306, 349, 394, 334, 354, 257, 385, 361, 310,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 373,
-	0, 237, 265, 0, 240, 0, 304, 0, 345, 283,
-	0, 0, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 0, 218, 219, 220, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	0, 1953, 0, 233, 234, 235, 236, 333, 0, 0,
-	401, 402, 403, 424, 387, 0, 442, 0, 0, 0,
-	0, 0, 0, 0, 278, 0, 0, 303, 446, 438,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	434, 0, 435, 0, 0, 0, 0, 0, 362, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	0, 0, 0, 0, 0, 0, 255, 188, 436, 0,
-	437, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	258, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 367, 383, 256, 358, 396, 261, 365,
-	251, 332, 355, 0, 0, 248, 381, 364, 314, 297,
-	298, 247, 0, 350, 276, 289, 273, 330, 0, 380,
-	408, 272, 399, 0, 391, 250, 0, 390, 329, 377,
-	382, 315, 309, 249, 379, 313, 308, 301, 280, 423,
-	293, 341, 307, 342, 294, 319, 318, 320, 0, 0,
-	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 393, 0, 0, 0, 0, 0, 0, 366, 0,
-	0, 302, 0, 0, 0, 409, 0, 353, 335, 0,
-	0, 0, 351, 305, 378, 343, 384, 368, 392, 347,
-	344, 241, 369, 275, 316, 252, 254, 270, 277, 279,
-	281, 282, 325, 326, 338, 357, 370, 371, 372, 274,
-	262, 352, 263, 291, 264, 242, 267, 266, 268, 359,
-	269, 244, 339, 376, 0, 287, 348, 312, 245, 311,
-	340, 375, 374, 253, 400, 406, 407, 0, 0, 412,
-	0, 0, 0, 420, 425, 426, 427, 429, 430, 431,
-	432, 0, 0, 0, 0, 414, 0, 0, 0, 0,
-	0, 0, 405, 285, 238, 239, 445, 271, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	327, 404, 0, 0, 0, 433, 444, 0, 0, 0,
-	0, 0, 443, 337, 0, 356, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 363, 386,
-	398, 415, 418, 0, 0, 0, 0, 243, 417, 0,
-	0, 0, 0, 0, 0, 0, 0, 389, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 413, 0, 0,
-	321, 322, 323, 324, 288, 0, 260, 416, 346, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 410, 411, 284, 290,
-	428, 292, 259, 336, 286, 395, 299, 0, 421, 0,
-	422, 0, 0, 0, 0, 328, 295, 296, 360, 300,
-	306, 349, 394, 334, 354, 257, 385, 361, 310, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 373, 0,
-	237, 265, 0, 240, 0, 304, 0, 345, 283, 0,
-	0, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 0, 218, 219, 220, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 333,
-	0, 0, 233, 234, 235, 236, 0, 1642, 0, 401,
-	402, 403, 424, 387, 0, 442, 278, 0, 0, 303,
-	0, 0, 0, 0, 0, 0, 0, 446, 438, 0,
-	0, 0, 434, 0, 435, 0, 0, 0, 0, 0,
-	362, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 0, 0, 0, 0, 0, 0, 255, 188,
-	436, 0, 437, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 258, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 367, 383, 256, 358, 396,
-	261, 365, 251, 332, 355, 0, 0, 248, 381, 364,
-	314, 297, 298, 247, 0, 350, 276, 289, 273, 330,
-	0, 380, 408, 272, 399, 0, 391, 250, 0, 390,
-	329, 377, 382, 315, 309, 249, 379, 313, 308, 301,
-	280, 423, 293, 341, 307, 342, 294, 319, 318, 320,
-	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 393, 0, 0, 0, 0, 0, 0,
-	366, 0, 0, 302, 0, 0, 0, 409, 0, 353,
-	335, 0, 0, 0, 351, 305, 378, 343, 384, 368,
-	392, 347, 344, 241, 369, 275, 316, 252, 254, 270,
-	277, 279, 281, 282, 325, 326, 338, 357, 370, 371,
-	372, 274, 262, 352, 263, 291, 264, 242, 267, 266,
-	268, 359, 269, 244, 339, 376, 0, 287, 348, 312,
-	245, 311, 340, 375, 374, 253, 400, 406, 407, 0,
-	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
-	430, 431, 432, 0, 0, 0, 0, 414, 0, 0,
-	0, 0, 0, 0, 405, 285, 238, 239, 445, 271,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 404, 0, 0, 0, 433, 444, 0,
-	0, 0, 0, 0, 443, 337, 0, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	363, 386, 398, 415, 418, 0, 0, 0, 0, 243,
-	417, 0, 0, 0, 0, 0, 0, 0, 0, 389,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 413,
-	0, 0, 321, 322, 323, 324, 288, 0, 260, 416,
-	346, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 410, 411,
-	284, 290, 428, 292, 259, 336, 286, 395, 299, 0,
-	421, 0, 422, 0, 0, 0, 0, 328, 295, 296,
-	360, 300, 306, 349, 394, 334, 354, 257, 385, 361,
-	310, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 232, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	373, 0, 237, 265, 0, 240, 0, 304, 0, 345,
-	283, 0, 0, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 0, 218, 219, 220,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 333, 0, 0, 233, 234, 235, 236, 0, 0,
-	0, 401, 402, 403, 424, 387, 0, 442, 278, 0,
-	0, 303, 0, 0, 0, 0, 0, 0, 0, 446,
-	438, 0, 0, 0, 434, 0, 435, 0, 0, 0,
-	0, 0, 362, 317, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 0, 0, 1219, 0, 0, 0,
-	255, 188, 436, 0, 437, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 258, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 246, 367, 383, 256,
-	358, 396, 261, 365, 251, 332, 355, 0, 0, 248,
-	381, 364, 314, 297, 298, 247, 0, 350, 276, 289,
-	273, 330, 0, 380, 408, 272, 399, 0, 391, 250,
-	0, 390, 329, 377, 382, 315, 309, 249, 379, 313,
-	308, 301, 280, 423, 293, 341, 307, 342, 294, 319,
-	318, 320, 0, 0, 0, 0, 0, 419, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 393, 0, 0, 0, 0,
-	0, 0, 366, 0, 0, 302, 0, 0, 0, 409,
-	0, 353, 335, 0, 0, 0, 351, 305, 378, 343,
-	384, 368, 392, 1555, 344, 241, 369, 275, 316, 252,
-	254, 270, 277, 279, 281, 282, 325, 326, 338, 357,
-	370, 371, 372, 274, 262, 352, 263, 291, 264, 242,
-	267, 266, 268, 359, 269, 244, 339, 376, 0, 287,
-	348, 312, 245, 311, 340, 375, 374, 253, 400, 406,
-	407, 0, 0, 412, 0, 0, 0, 420, 425, 426,
-	427, 429, 430, 431, 432, 0, 0, 0, 0, 414,
-	0, 0, 0, 0, 0, 0, 405, 285, 238, 239,
-	445, 271, 331, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 327, 404, 0, 0, 0, 433,
-	444, 0, 0, 0, 0, 0, 443, 337, 0, 356,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 363, 386, 398, 415, 418, 0, 0, 0,
-	0, 243, 417, 0, 0, 0, 0, 0, 0, 0,
-	0, 389, 0, 0, 0, 397, 0, 0, 0, 0,
-	0, 413, 0, 0, 321, 322, 323, 324, 288, 0,
-	260, 416, 346, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	410, 411, 284, 290, 428, 292, 259, 336, 286, 395,
-	299, 0, 421, 0, 422, 0, 0, 0, 0, 328,
-	295, 296, 360, 300, 306, 349, 394, 334, 354, 257,
-	385, 361, 310, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 232, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 373, 0, 237, 265, 0, 240, 0, 304,
-	0, 345, 283, 0, 0, 195, 196, 197, 198, 199,
-	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
-	210, 211, 212, 213, 214, 215, 216, 217, 0, 218,
-	219, 220, 221, 222, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 333, 0, 0, 233, 234, 235, 236,
-	0, 0, 0, 401, 402, 403, 424, 387, 0, 442,
-	278, 0, 0, 303, 0, 0, 0, 0, 0, 0,
-	0, 446, 438, 0, 0, 0, 434, 0, 435, 0,
-	0, 0, 0, 0, 362, 317, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 0, 0, 0, 0,
-	0, 0, 255, 188, 436, 0, 437, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 258, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 246, 367,
-	383, 256, 358, 396, 261, 365, 251, 332, 355, 0,
-	0, 248, 381, 364, 314, 297, 298, 247, 0, 350,
-	276, 289, 273, 330, 0, 380, 408, 272, 399, 0,
-	391, 250, 0, 390, 329, 377, 382, 315, 309, 249,
-	379, 313, 308, 301, 280, 423, 293, 341, 307, 342,
-	294, 319, 318, 320, 0, 0, 0, 0, 0, 419,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 393, 0, 0,
-	1244, 0, 0, 0, 366, 0, 0, 302, 0, 0,
-	0, 409, 0, 353, 335, 0, 0, 0, 351, 305,
-	378, 343, 384, 368, 392, 347, 344, 241, 369, 275,
-	316, 252, 254, 270, 277, 279, 281, 282, 325, 326,
-	338, 357, 370, 371, 372, 274, 262, 352, 263, 291,
-	264, 242, 267, 266, 268, 359, 269, 244, 339, 376,
-	0, 287, 348, 312, 245, 311, 340, 375, 374, 253,
-	400, 406, 407, 0, 0, 412, 0, 0, 0, 420,
-	425, 426, 427, 429, 430, 431, 432, 0, 0, 0,
-	0, 414, 0, 0, 0, 0, 0, 0, 405, 285,
-	238, 239, 445, 271, 331, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 327, 404, 0, 0,
-	0, 433, 444, 0, 0, 0, 0, 0, 443, 337,
-	0, 356, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 363, 386, 398, 415, 418, 0,
-	0, 0, 0, 243, 417, 0, 0, 0, 0, 0,
-	0, 0, 0, 389, 0, 0, 0, 397, 0, 0,
-	0, 0, 0, 413, 0, 0, 321, 322, 323, 324,
-	288, 0, 260, 416, 346, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 410, 411, 284, 290, 428, 292, 259, 336,
-	286, 395, 299, 0, 421, 0, 422, 0, 0, 0,
-	0, 328, 295, 296, 360, 300, 306, 349, 394, 334,
-	354, 257, 385, 361, 310, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 232, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 373, 0, 237, 265, 0, 240,
-	0, 304, 0, 345, 283, 0, 0, 195, 196, 197,
-	198, 199, 200, 201, 202, 203, 204, 205, 206, 207,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 0, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 239, 240, 241, 0, 0, 0, 248,
+	249, 250, 251, 252, 253, 254, 255, 243, 244, 245,
+	246, 0, 0, 0, 426, 427, 428, 454, 412, 0,
+	485, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 490, 468, 469, 470, 471, 472, 473, 474,
+	475, 476, 405, 406, 407, 408, 409, 488, 481, 477,
+	478, 479, 480, 0, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 353, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 297, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 0,
+	465, 0, 0, 118, 0, 0, 382, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 1864, 0, 196, 0, 0,
+	0, 0, 0, 0, 273, 197, 466, 0, 467, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	264, 387, 403, 274, 378, 421, 279, 385, 269, 352,
+	375, 0, 0, 266, 401, 384, 334, 317, 318, 265,
+	0, 370, 295, 309, 291, 350, 0, 400, 433, 290,
+	424, 0, 416, 268, 0, 415, 349, 397, 402, 335,
+	329, 267, 399, 333, 328, 321, 299, 448, 449, 450,
+	313, 361, 327, 362, 314, 339, 338, 340, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 418, 0, 0, 0, 0, 0, 0, 386, 0,
+	0, 322, 0, 0, 0, 434, 0, 373, 355, 0,
+	0, 0, 371, 303, 325, 398, 363, 404, 388, 417,
+	367, 364, 259, 389, 293, 336, 270, 272, 288, 296,
+	298, 300, 301, 345, 346, 358, 377, 390, 391, 392,
+	292, 280, 372, 281, 311, 282, 260, 285, 284, 286,
+	379, 287, 262, 359, 396, 0, 307, 368, 332, 263,
+	331, 360, 395, 394, 271, 425, 431, 432, 0, 0,
+	437, 0, 0, 0, 445, 455, 456, 457, 459, 460,
+	461, 462, 0, 0, 0, 0, 439, 0, 0, 451,
+	452, 453, 0, 0, 0, 0, 430, 0, 305, 256,
+	257, 489, 289, 351, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 347, 429, 0, 0, 0,
+	463, 487, 0, 0, 0, 0, 0, 486, 357, 0,
+	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 411, 423, 440, 443, 0, 0,
+	0, 0, 261, 442, 0, 0, 0, 0, 0, 0,
+	0, 0, 414, 0, 0, 0, 422, 0, 0, 0,
+	0, 0, 438, 0, 0, 341, 342, 343, 344, 308,
+	0, 278, 441, 366, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 436, 304, 310, 458, 312, 277, 356, 306,
+	420, 319, 0, 446, 0, 447, 0, 0, 0, 0,
+	348, 315, 316, 380, 320, 326, 369, 419, 354, 374,
+	275, 410, 381, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 393, 0, 247, 283, 294, 0, 258,
+	0, 324, 140, 365, 302, 0, 0, 205, 206, 207,
 	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
-	0, 218, 219, 220, 221, 222, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 333, 0, 0, 233, 234,
-	235, 236, 0, 0, 0, 401, 402, 403, 424, 387,
-	0, 442, 278, 0, 0, 303, 0, 0, 0, 0,
-	0, 0, 0, 446, 438, 0, 0, 0, 434, 0,
-	435, 0, 0, 0, 0, 0, 362, 317, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 0, 0,
-	0, 0, 0, 0, 255, 188, 436, 0, 437, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 258, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	0, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 238, 239, 240, 241, 0, 0, 0, 248, 249,
+	250, 251, 252, 253, 254, 255, 243, 244, 245, 246,
+	0, 0, 0, 426, 427, 428, 454, 412, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	481, 490, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 405, 406, 407, 408, 409, 488, 353, 477, 478,
+	479, 480, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 0, 0, 323, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 0, 465, 0, 0, 0, 0, 0, 382, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 196,
+	853, 854, 0, 0, 0, 0, 273, 197, 466, 0,
+	467, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	857, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 264, 387, 403, 274, 378, 421, 279, 385,
+	269, 352, 375, 0, 0, 266, 401, 384, 334, 317,
+	318, 265, 0, 370, 295, 309, 291, 350, 0, 400,
+	433, 290, 424, 831, 416, 268, 830, 415, 349, 397,
+	402, 335, 329, 267, 399, 333, 328, 321, 299, 448,
+	449, 450, 313, 361, 327, 362, 314, 339, 338, 340,
+	0, 0, 0, 0, 0, 444, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 418, 0, 0, 0, 0, 0, 0,
+	386, 0, 0, 322, 0, 0, 0, 434, 0, 373,
+	355, 0, 0, 0, 371, 303, 325, 398, 363, 404,
+	388, 417, 367, 364, 259, 389, 293, 336, 270, 272,
+	288, 296, 298, 300, 301, 345, 346, 358, 377, 390,
+	391, 392, 292, 280, 372, 281, 311, 282, 260, 285,
+	284, 286, 379, 287, 262, 359, 396, 0, 307, 368,
+	332, 263, 331, 360, 395, 394, 271, 425, 431, 432,
+	0, 0, 437, 0, 0, 0, 445, 455, 456, 457,
+	459, 460, 461, 462, 0, 0, 0, 0, 439, 0,
+	0, 451, 452, 453, 0, 0, 0, 0, 430, 0,
+	305, 256, 257, 489, 289, 351, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 347, 429, 0,
+	0, 0, 463, 487, 0, 0, 0, 0, 0, 486,
+	357, 0, 376, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 411, 423, 440, 443,
+	0, 0, 0, 0, 261, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 414, 0, 0, 0, 422, 0,
+	0, 0, 0, 0, 438, 0, 0, 341, 342, 343,
+	344, 308, 0, 278, 441, 366, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 435, 436, 304, 310, 458, 312, 277,
+	356, 306, 420, 319, 0, 446, 0, 447, 0, 0,
+	0, 0, 855, 1784, 851, 1785, 320, 326, 369, 419,
+	354, 374, 275, 410, 381, 852, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 393, 0, 247, 283, 294,
+	0, 258, 0, 324, 0, 365, 302, 0, 0, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 0, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 238, 239, 240, 241, 0, 0, 0,
+	248, 249, 250, 251, 252, 253, 254, 255, 243, 244,
+	245, 246, 0, 0, 0, 426, 427, 428, 454, 412,
+	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 481, 490, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 405, 406, 407, 408, 409, 488, 353,
+	477, 478, 479, 480, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 0, 323,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 0, 465, 0, 0, 0, 0, 0,
+	382, 337, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1764, 0,
+	0, 196, 0, 0, 0, 0, 0, 0, 273, 197,
+	466, 0, 467, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 264, 387, 403, 274, 378, 421,
+	279, 385, 269, 352, 375, 0, 0, 266, 401, 384,
+	334, 317, 318, 265, 0, 370, 295, 309, 291, 350,
+	0, 400, 433, 290, 424, 0, 416, 268, 0, 415,
+	349, 397, 402, 335, 329, 267, 399, 333, 328, 321,
+	299, 448, 449, 450, 313, 361, 327, 362, 314, 339,
+	338, 340, 0, 0, 0, 0, 0, 444, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 418, 0, 0, 0, 0,
+	0, 0, 386, 0, 0, 322, 0, 0, 0, 434,
+	0, 373, 355, 0, 0, 0, 371, 303, 325, 398,
+	363, 404, 388, 417, 367, 364, 259, 389, 293, 336,
+	270, 272, 288, 296, 298, 300, 301, 345, 346, 358,
+	377, 390, 391, 392, 292, 280, 372, 281, 311, 282,
+	260, 285, 284, 286, 379, 287, 262, 359, 396, 0,
+	307, 368, 332, 263, 331, 360, 395, 394, 271, 425,
+	431, 432, 0, 0, 437, 0, 0, 0, 445, 455,
+	456, 457, 459, 460, 461, 462, 0, 0, 0, 0,
+	439, 0, 0, 451, 452, 453, 0, 0, 0, 0,
+	430, 1762, 305, 256, 257, 489, 289, 351, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
+	429, 0, 0, 0, 463, 487, 0, 0, 0, 0,
+	0, 486, 357, 0, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 411, 423,
+	440, 443, 0, 0, 0, 0, 261, 442, 0, 0,
+	0, 0, 0, 0, 0, 0, 414, 0, 0, 0,
+	422, 0, 0, 0, 0, 0, 438, 0, 0, 341,
+	342, 343, 344, 308, 0, 278, 441, 366, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 435, 436, 304, 310, 458,
+	312, 277, 356, 306, 420, 319, 0, 446, 0, 447,
+	0, 0, 0, 0, 348, 315, 316, 380, 320, 326,
+	369, 419, 354, 374, 275, 410, 381, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 393, 0, 247,
+	283, 294, 0, 258, 0, 324, 0, 365, 302, 0,
+	0, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 0, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 238, 239, 240, 241, 0,
+	0, 0, 248, 249, 250, 251, 252, 253, 254, 255,
+	243, 244, 245, 246, 0, 0, 0, 426, 427, 428,
+	454, 412, 0, 485, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 490, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 405, 406, 407, 408, 409,
+	488, 1768, 477, 478, 479, 480, 481, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 353, 0, 0, 0, 0, 0, 0,
+	0, 0, 2590, 0, 0, 0, 0, 0, 0, 0,
+	297, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 0, 465, 0,
+	0, 0, 0, 0, 382, 337, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 196, 0, 0, 0, 0,
+	0, 0, 273, 197, 466, 0, 467, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 264, 387,
+	403, 274, 378, 421, 279, 385, 269, 352, 375, 0,
+	0, 266, 401, 384, 334, 317, 318, 265, 0, 370,
+	295, 309, 291, 350, 0, 400, 433, 290, 424, 0,
+	416, 268, 0, 415, 349, 397, 402, 335, 329, 267,
+	399, 333, 328, 321, 299, 448, 449, 450, 313, 361,
+	327, 362, 314, 339, 338, 340, 0, 0, 0, 0,
+	0, 444, 0, 0, 0, 0, 0, 0, 0, 0,
+	2593, 0, 0, 2592, 0, 0, 0, 0, 0, 418,
+	0, 0, 0, 0, 0, 0, 386, 0, 0, 322,
+	0, 0, 0, 434, 0, 373, 355, 0, 0, 0,
+	371, 303, 325, 398, 363, 404, 388, 417, 367, 364,
+	259, 389, 293, 336, 270, 272, 288, 296, 298, 300,
+	301, 345, 346, 358, 377, 390, 391, 392, 292, 280,
+	372, 281, 311, 282, 260, 285, 284, 286, 379, 287,
+	262, 359, 396, 0, 307, 368, 332, 263, 331, 360,
+	395, 394, 271, 425, 431, 432, 0, 0, 437, 0,
+	0, 0, 445, 455, 456, 457, 459, 460, 461, 462,
+	0, 0, 0, 0, 439, 0, 0, 451, 452, 453,
+	0, 0, 0, 0, 430, 0, 305, 256, 257, 489,
+	289, 351, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 347, 429, 0, 0, 0, 463, 487,
+	0, 0, 0, 0, 0, 486, 357, 0, 376, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 411, 423, 440, 443, 0, 0, 0, 0,
+	261, 442, 0, 0, 0, 0, 0, 0, 0, 0,
+	414, 0, 0, 0, 422, 0, 0, 0, 0, 0,
+	438, 0, 0, 341, 342, 343, 344, 308, 0, 278,
+	441, 366, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	436, 304, 310, 458, 312, 277, 356, 306, 420, 319,
+	0, 446, 0, 447, 0, 0, 0, 0, 348, 315,
+	316, 380, 320, 326, 369, 419, 354, 374, 275, 410,
+	381, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 393, 0, 247, 283, 294, 0, 258, 0, 324,
+	0, 365, 302, 0, 0, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 0, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 0, 0, 0, 248, 249, 250, 251,
+	252, 253, 254, 255, 243, 244, 245, 246, 0, 0,
+	0, 426, 427, 428, 454, 412, 0, 485, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 481, 490,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 405,
+	406, 407, 408, 409, 488, 353, 477, 478, 479, 480,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 297, 1300, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 0,
+	465, 0, 0, 0, 0, 0, 382, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 0, 0,
+	1298, 0, 0, 0, 273, 197, 466, 0, 467, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1296, 0, 0, 0, 0, 0, 0,
+	264, 387, 403, 274, 378, 421, 279, 385, 269, 352,
+	375, 0, 0, 266, 401, 384, 334, 317, 318, 265,
+	0, 370, 295, 309, 291, 350, 0, 400, 433, 290,
+	424, 0, 416, 268, 0, 415, 349, 397, 402, 335,
+	329, 267, 399, 333, 328, 321, 299, 448, 449, 450,
+	313, 361, 327, 362, 314, 339, 338, 340, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 418, 0, 0, 0, 0, 0, 0, 386, 0,
+	0, 322, 0, 0, 0, 434, 0, 373, 355, 0,
+	0, 0, 371, 303, 325, 398, 363, 404, 388, 417,
+	367, 364, 259, 389, 293, 336, 270, 272, 288, 296,
+	298, 300, 301, 345, 346, 358, 377, 390, 391, 392,
+	292, 280, 372, 281, 311, 282, 260, 285, 284, 286,
+	379, 287, 262, 359, 396, 0, 307, 368, 332, 263,
+	331, 360, 395, 394, 271, 425, 431, 432, 0, 0,
+	437, 0, 0, 0, 445, 455, 456, 457, 459, 460,
+	461, 462, 0, 0, 0, 0, 439, 0, 0, 451,
+	452, 453, 0, 0, 0, 0, 430, 0, 305, 256,
+	257, 489, 289, 351, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 347, 429, 0, 0, 0,
+	463, 487, 0, 0, 0, 0, 0, 486, 357, 0,
+	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 411, 423, 440, 443, 0, 0,
+	0, 0, 261, 442, 0, 0, 0, 0, 0, 0,
+	0, 0, 414, 0, 0, 0, 422, 0, 0, 0,
+	0, 0, 438, 0, 0, 341, 342, 343, 344, 308,
+	0, 278, 441, 366, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 436, 304, 310, 458, 312, 277, 356, 306,
+	420, 319, 0, 446, 0, 447, 0, 0, 0, 0,
+	348, 315, 316, 380, 320, 326, 369, 419, 354, 374,
+	275, 410, 381, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 393, 0, 247, 283, 294, 0, 258,
+	0, 324, 0, 365, 302, 0, 0, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	0, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 238, 239, 240, 241, 0, 0, 0, 248, 249,
+	250, 251, 252, 253, 254, 255, 243, 244, 245, 246,
+	0, 0, 0, 426, 427, 428, 454, 412, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	481, 490, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 405, 406, 407, 408, 409, 488, 353, 477, 478,
+	479, 480, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 1294, 0, 323, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 0, 465, 0, 0, 0, 0, 0, 382, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 196,
+	0, 0, 1298, 0, 0, 0, 273, 197, 466, 0,
+	467, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1296, 0, 0, 0, 0,
+	0, 0, 264, 387, 403, 274, 378, 421, 279, 385,
+	269, 352, 375, 0, 0, 266, 401, 384, 334, 317,
+	318, 265, 0, 370, 295, 309, 291, 350, 0, 400,
+	433, 290, 424, 0, 416, 268, 0, 415, 349, 397,
+	402, 335, 329, 267, 399, 333, 328, 321, 299, 448,
+	449, 450, 313, 361, 327, 362, 314, 339, 338, 340,
+	0, 0, 0, 0, 0, 444, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 418, 0, 0, 0, 0, 0, 0,
+	386, 0, 0, 322, 0, 0, 0, 434, 0, 373,
+	355, 0, 0, 0, 371, 303, 325, 398, 363, 404,
+	388, 417, 367, 364, 259, 389, 293, 336, 270, 272,
+	288, 296, 298, 300, 301, 345, 346, 358, 377, 390,
+	391, 392, 292, 280, 372, 281, 311, 282, 260, 285,
+	284, 286, 379, 287, 262, 359, 396, 0, 307, 368,
+	332, 263, 331, 360, 395, 394, 271, 425, 431, 432,
+	0, 0, 437, 0, 0, 0, 445, 455, 456, 457,
+	459, 460, 461, 462, 0, 0, 0, 0, 439, 0,
+	0, 451, 452, 453, 0, 0, 0, 0, 430, 0,
+	305, 256, 257, 489, 289, 351, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 347, 429, 0,
+	0, 0, 463, 487, 0, 0, 0, 0, 0, 486,
+	357, 0, 376, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 411, 423, 440, 443,
+	0, 0, 0, 0, 261, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 414, 0, 0, 0, 422, 0,
+	0, 0, 0, 0, 438, 0, 0, 341, 342, 343,
+	344, 308, 0, 278, 441, 366, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 435, 436, 304, 310, 458, 312, 277,
+	356, 306, 420, 319, 0, 446, 0, 447, 0, 0,
+	0, 0, 348, 315, 316, 380, 320, 326, 369, 419,
+	354, 374, 275, 410, 381, 330, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 393, 0, 247, 283, 294,
+	0, 258, 0, 324, 0, 365, 302, 0, 0, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 0, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 238, 239, 240, 241, 0, 0, 0,
+	248, 249, 250, 251, 252, 253, 254, 255, 243, 244,
+	245, 246, 0, 0, 0, 426, 427, 428, 454, 412,
+	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 481, 490, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 405, 406, 407, 408, 409, 488, 353,
+	477, 478, 479, 480, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 0, 323,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 0, 465, 0, 0, 0, 0, 0,
+	382, 337, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 3492,
+	0, 196, 672, 0, 0, 0, 0, 0, 273, 197,
+	466, 0, 467, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 264, 387, 403, 274, 378, 421,
+	279, 385, 269, 352, 375, 0, 0, 266, 401, 384,
+	334, 317, 318, 265, 0, 370, 295, 309, 291, 350,
+	0, 400, 433, 290, 424, 0, 416, 268, 0, 415,
+	349, 397, 402, 335, 329, 267, 399, 333, 328, 321,
+	299, 448, 449, 450, 313, 361, 327, 362, 314, 339,
+	338, 340, 0, 0, 0, 0, 0, 444, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 418, 0, 0, 0, 0,
+	0, 0, 386, 0, 0, 322, 0, 0, 0, 434,
+	0, 373, 355, 0, 0, 0, 371, 303, 325, 398,
+	363, 404, 388, 417, 367, 364, 259, 389, 293, 336,
+	270, 272, 288, 296, 298, 300, 301, 345, 346, 358,
+	377, 390, 391, 392, 292, 280, 372, 281, 311, 282,
+	260, 285, 284, 286, 379, 287, 262, 359, 396, 0,
+	307, 368, 332, 263, 331, 360, 395, 394, 271, 425,
+	431, 432, 0, 0, 437, 0, 0, 0, 445, 455,
+	456, 457, 459, 460, 461, 462, 0, 0, 0, 0,
+	439, 0, 0, 451, 452, 453, 0, 0, 0, 0,
+	430, 0, 305, 256, 257, 489, 289, 351, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
+	429, 0, 0, 0, 463, 487, 0, 0, 0, 0,
+	0, 486, 357, 0, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 411, 423,
+	440, 443, 0, 0, 0, 0, 261, 442, 0, 0,
+	0, 0, 0, 0, 0, 0, 414, 0, 0, 0,
+	422, 0, 0, 0, 0, 0, 438, 0, 0, 341,
+	342, 343, 344, 308, 0, 278, 441, 366, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 435, 436, 304, 310, 458,
+	312, 277, 356, 306, 420, 319, 0, 446, 0, 447,
+	0, 0, 0, 0, 348, 315, 316, 380, 320, 326,
+	369, 419, 354, 374, 275, 410, 381, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 393, 0, 247,
+	283, 294, 0, 258, 0, 324, 0, 365, 302, 0,
+	0, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 0, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 238, 239, 240, 241, 0,
+	0, 0, 248, 249, 250, 251, 252, 253, 254, 255,
+	243, 244, 245, 246, 0, 0, 0, 426, 427, 428,
+	454, 412, 0, 485, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 481, 490, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 405, 406, 407, 408, 409,
+	488, 353, 477, 478, 479, 480, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 0, 465, 0, 0, 0,
+	0, 0, 382, 337, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 0, 0, 1298, 0, 0, 0,
+	273, 197, 466, 0, 467, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1296,
+	0, 0, 0, 0, 0, 0, 264, 387, 403, 274,
+	378, 421, 279, 385, 269, 352, 375, 0, 0, 266,
+	401, 384, 334, 317, 318, 265, 0, 370, 295, 309,
+	291, 350, 0, 400, 433, 290, 424, 0, 416, 268,
+	0, 415, 349, 397, 402, 335, 329, 267, 399, 333,
+	328, 321, 299, 448, 449, 450, 313, 361, 327, 362,
+	314, 339, 338, 340, 0, 0, 0, 0, 0, 444,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 0,
+	0, 0, 0, 0, 386, 0, 0, 322, 0, 0,
+	0, 434, 0, 373, 355, 0, 0, 0, 371, 303,
+	325, 398, 363, 404, 388, 417, 367, 364, 259, 389,
+	293, 336, 270, 272, 288, 296, 298, 300, 301, 345,
+	346, 358, 377, 390, 391, 392, 292, 280, 372, 281,
+	311, 282, 260, 285, 284, 286, 379, 287, 262, 359,
+	396, 0, 307, 368, 332, 263, 331, 360, 395, 394,
+	271, 425, 431, 432, 0, 0, 437, 0, 0, 0,
+	445, 455, 456, 457, 459, 460, 461, 462, 0, 0,
+	0, 0, 439, 0, 0, 451, 452, 453, 0, 0,
+	0, 0, 430, 0, 305, 256, 257, 489, 289, 351,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 347, 429, 0, 0, 0, 463, 487, 0, 0,
+	0, 0, 0, 486, 357, 0, 376, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	411, 423, 440, 443, 0, 0, 0, 0, 261, 442,
+	0, 0, 0, 0, 0, 0, 0, 0, 414, 0,
+	0, 0, 422, 0, 0, 0, 0, 0, 438, 0,
+	0, 341, 342, 343, 344, 308, 0, 278, 441, 366,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 435, 436, 304,
+	310, 458, 312, 277, 356, 306, 420, 319, 0, 446,
+	0, 447, 0, 0, 0, 0, 348, 315, 316, 380,
+	320, 326, 369, 419, 354, 374, 275, 410, 381, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	246, 367, 383, 256, 358, 396, 261, 365, 251, 332,
-	355, 0, 0, 248, 381, 364, 314, 297, 298, 247,
-	0, 350, 276, 289, 273, 330, 0, 380, 408, 272,
-	399, 0, 391, 250, 0, 390, 329, 377, 382, 315,
-	309, 249, 379, 313, 308, 301, 280, 423, 293, 341,
-	307, 342, 294, 319, 318, 320, 0, 0, 0, 0,
-	0, 419, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
-	0, 0, 0, 0, 0, 0, 366, 0, 0, 302,
-	0, 0, 0, 409, 0, 353, 335, 0, 0, 0,
-	351, 305, 378, 343, 384, 368, 392, 347, 344, 241,
-	369, 275, 316, 252, 254, 270, 277, 279, 281, 282,
-	325, 326, 338, 357, 370, 371, 372, 274, 262, 352,
-	263, 291, 264, 242, 267, 266, 268, 359, 269, 244,
-	339, 376, 0, 287, 348, 312, 245, 311, 340, 375,
-	374, 253, 400, 406, 407, 0, 0, 412, 0, 0,
-	0, 420, 425, 426, 427, 429, 430, 431, 432, 0,
-	0, 0, 0, 414, 0, 0, 0, 0, 0, 0,
-	405, 285, 238, 239, 445, 271, 331, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 327, 404,
-	0, 0, 0, 433, 444, 0, 0, 0, 0, 0,
-	443, 337, 0, 356, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 363, 386, 398, 415,
-	418, 0, 0, 0, 0, 243, 417, 0, 0, 0,
-	0, 0, 0, 0, 0, 389, 0, 0, 0, 397,
-	0, 0, 0, 0, 0, 413, 0, 0, 321, 322,
-	323, 324, 288, 0, 260, 416, 346, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 410, 411, 284, 290, 428, 292,
-	259, 336, 286, 395, 299, 0, 421, 0, 422, 0,
-	0, 0, 0, 328, 295, 296, 360, 300, 306, 349,
-	394, 334, 354, 257, 385, 361, 310, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	232, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 728, 0, 0, 373, 0, 237, 265,
-	0, 240, 0, 304, 0, 345, 283, 0, 0, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 205,
-	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
-	216, 217, 0, 218, 219, 220, 221, 222, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 333, 0, 0,
-	233, 234, 235, 236, 0, 0, 0, 401, 402, 403,
-	424, 387, 0, 442, 278, 0, 0, 303, 0, 0,
-	0, 0, 0, 0, 0, 446, 438, 0, 0, 0,
-	434, 0, 435, 0, 0, 0, 0, 0, 362, 317,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	0, 0, 0, 0, 0, 0, 255, 188, 436, 0,
-	437, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	258, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 246, 367, 383, 256, 358, 396, 261, 365,
-	251, 332, 355, 0, 0, 248, 381, 364, 314, 297,
-	298, 247, 0, 350, 276, 289, 273, 330, 0, 380,
-	408, 272, 399, 0, 391, 250, 0, 390, 329, 377,
-	382, 315, 309, 249, 379, 313, 308, 301, 280, 423,
-	293, 341, 307, 342, 294, 319, 318, 320, 0, 0,
-	0, 0, 0, 419, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 393, 0, 0, 0, 0, 0, 0, 366, 0,
-	0, 302, 0, 0, 0, 409, 0, 353, 335, 0,
-	0, 0, 351, 305, 378, 343, 384, 368, 392, 484,
-	344, 241, 369, 275, 316, 252, 254, 270, 277, 279,
-	281, 282, 325, 326, 338, 357, 370, 371, 372, 274,
-	262, 352, 263, 291, 264, 242, 267, 266, 268, 359,
-	269, 244, 339, 376, 0, 287, 348, 312, 245, 311,
-	340, 375, 374, 253, 400, 406, 407, 0, 0, 412,
-	0, 0, 0, 420, 425, 426, 427, 429, 430, 431,
-	432, 0, 0, 0, 0, 414, 0, 0, 0, 0,
-	0, 0, 405, 285, 238, 239, 445, 271, 331, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	327, 404, 0, 0, 0, 433, 444, 0, 0, 0,
-	0, 0, 443, 337, 0, 356, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 363, 386,
-	398, 415, 418, 0, 0, 0, 0, 243, 417, 0,
-	0, 0, 0, 0, 0, 485, 0, 389, 0, 0,
-	0, 397, 0, 0, 0, 0, 0, 413, 0, 0,
-	321, 322, 323, 324, 288, 0, 260, 416, 346, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 410, 411, 284, 290,
-	428, 292, 259, 336, 286, 395, 299, 0, 421, 0,
-	422, 0, 0, 0, 0, 328, 295, 296, 360, 300,
-	306, 349, 394, 334, 354, 257, 385, 361, 310, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 373, 0,
-	237, 265, 0, 240, 0, 304, 0, 345, 283, 0,
-	0, 195, 196, 197, 198, 199, 200, 201, 202, 203,
-	204, 205, 206, 207, 208, 209, 210, 211, 212, 213,
-	214, 215, 216, 217, 0, 218, 219, 220, 221, 222,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 333,
-	0, 0, 233, 234, 235, 236, 0, 0, 0, 401,
-	402, 403, 424, 387, 0, 442, 278, 0, 0, 303,
-	0, 0, 0, 0, 0, 0, 0, 446, 438, 0,
-	0, 0, 434, 0, 435, 0, 0, 0, 0, 0,
-	362, 317, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 0, 0, 0, 0, 0, 0, 255, 188,
-	436, 0, 437, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 258, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 246, 367, 383, 256, 358, 396,
-	261, 365, 251, 332, 355, 0, 0, 248, 381, 364,
-	314, 297, 298, 247, 0, 350, 276, 289, 273, 330,
-	0, 380, 408, 272, 399, 0, 391, 250, 0, 390,
-	329, 377, 382, 315, 309, 249, 379, 313, 308, 301,
-	280, 423, 293, 341, 307, 342, 294, 319, 318, 320,
-	0, 0, 0, 0, 0, 419, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	461, 0, 0, 393, 0, 0, 0, 0, 0, 0,
-	366, 0, 0, 302, 0, 0, 0, 409, 0, 353,
-	335, 0, 0, 0, 351, 305, 378, 343, 384, 368,
-	392, 347, 344, 241, 369, 275, 316, 252, 254, 270,
-	277, 279, 281, 282, 325, 326, 338, 357, 370, 371,
-	372, 274, 262, 352, 263, 291, 264, 242, 267, 266,
-	268, 359, 269, 244, 339, 376, 0, 287, 348, 312,
-	245, 311, 340, 375, 374, 253, 400, 406, 407, 0,
-	0, 412, 0, 0, 0, 420, 425, 426, 427, 429,
-	430, 431, 432, 0, 0, 0, 0, 414, 0, 0,
-	0, 0, 0, 0, 405, 285, 238, 239, 445, 271,
-	331, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 404, 0, 0, 0, 433, 444, 0,
-	0, 0, 0, 0, 443, 337, 0, 356, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	363, 386, 398, 415, 418, 0, 0, 0, 0, 243,
-	417, 0, 0, 0, 0, 0, 0, 0, 0, 389,
-	0, 0, 0, 397, 0, 0, 0, 0, 0, 413,
-	0, 0, 321, 322, 323, 324, 288, 0, 260, 416,
-	346, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 410, 411,
-	284, 290, 428, 292, 259, 336, 286, 395, 299, 0,
-	421, 0, 422, 0, 0, 0, 0, 328, 295, 296,
-	360, 300, 306, 349, 394, 334, 354, 257, 385, 361,
-	310, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 232, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	373, 0, 237, 265, 0, 240, 0, 304, 0, 345,
-	283, 0, 0, 195, 196, 197, 198, 199, 200, 201,
-	202, 203, 204, 205, 206, 207, 208, 209, 210, 211,
-	212, 213, 214, 215, 216, 217, 0, 218, 219, 220,
-	221, 222, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 0, 333, 0, 233, 234, 235, 236, 0, 0,
-	0, 401, 402, 403, 424, 387, 0, 442, 451, 278,
-	0, 0, 303, 0, 0, 0, 0, 0, 0, 446,
-	438, 0, 0, 0, 0, 434, 0, 435, 0, 0,
-	0, 0, 0, 362, 317, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 0, 0, 0, 0, 0,
-	0, 255, 188, 436, 0, 437, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 258, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 246, 367, 383,
-	256, 358, 396, 261, 365, 251, 332, 355, 0, 0,
-	248, 381, 364, 314, 297, 298, 247, 0, 350, 276,
-	289, 273, 330, 0, 380, 408, 272, 399, 0, 391,
-	250, 0, 390, 329, 377, 382, 315, 309, 249, 379,
-	313, 308, 301, 280, 423, 293, 341, 307, 342, 294,
-	319, 318, 320, 0, 0, 0, 0, 0, 419, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 393, 0, 0, 0,
-	0, 0, 0, 366, 0, 0, 302, 0, 0, 0,
-	409, 0, 353, 335, 0, 0, 0, 351, 305, 378,
-	343, 384, 368, 392, 347, 344, 241, 369, 275, 316,
-	252, 254, 270, 277, 279, 281, 282, 325, 326, 338,
-	357, 370, 371, 372, 274, 262, 352, 263, 291, 264,
-	242, 267, 266, 268, 359, 269, 244, 339, 376, 0,
-	287, 348, 312, 245, 311, 340, 375, 374, 253, 400,
-	406, 407, 0, 0, 412, 0, 0, 0, 420, 425,
-	426, 427, 429, 430, 431, 432, 0, 0, 0, 0,
-	414, 0, 0, 0, 0, 0, 0, 405, 285, 238,
-	239, 445, 271, 331, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 404, 0, 0, 0,
-	433, 444, 0, 0, 0, 0, 0, 443, 337, 0,
-	356, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 363, 386, 398, 415, 418, 0, 0,
-	0, 0, 243, 417, 0, 0, 0, 0, 0, 0,
-	0, 0, 389, 0, 0, 0, 397, 0, 0, 0,
-	0, 0, 413, 0, 0, 321, 322, 323, 324, 288,
-	0, 260, 416, 346, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 410, 411, 284, 290, 428, 292, 259, 336, 286,
-	395, 299, 0, 421, 0, 422, 0, 0, 0, 0,
-	328, 295, 296, 360, 300, 306, 349, 394, 334, 354,
-	257, 385, 361, 310, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 373, 0, 237, 265, 0, 240, 0,
-	304, 0, 345, 283, 0, 0, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
-	209, 210, 211, 212, 213, 214, 215, 216, 217, 0,
+	0, 247, 283, 294, 0, 258, 0, 324, 0, 365,
+	302, 0, 0, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 0, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 239, 240,
+	241, 0, 0, 0, 248, 249, 250, 251, 252, 253,
+	254, 255, 243, 244, 245, 246, 0, 0, 0, 426,
+	427, 428, 454, 412, 0, 485, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 481, 490, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 405, 406, 407,
+	408, 409, 488, 353, 477, 478, 479, 480, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	297, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 0, 465, 0,
+	0, 0, 0, 0, 382, 337, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 196, 0, 0, 1298, 0,
+	0, 0, 273, 197, 466, 0, 467, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3151, 0, 0, 0, 0, 0, 0, 264, 387,
+	403, 274, 378, 421, 279, 385, 269, 352, 375, 0,
+	0, 266, 401, 384, 334, 317, 318, 265, 0, 370,
+	295, 309, 291, 350, 0, 400, 433, 290, 424, 0,
+	416, 268, 0, 415, 349, 397, 402, 335, 329, 267,
+	399, 333, 328, 321, 299, 448, 449, 450, 313, 361,
+	327, 362, 314, 339, 338, 340, 0, 0, 0, 0,
+	0, 444, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 418,
+	0, 0, 0, 0, 0, 0, 386, 0, 0, 322,
+	0, 0, 0, 434, 0, 373, 355, 0, 0, 0,
+	371, 303, 325, 398, 363, 404, 388, 417, 367, 364,
+	259, 389, 293, 336, 270, 272, 288, 296, 298, 300,
+	301, 345, 346, 358, 377, 390, 391, 392, 292, 280,
+	372, 281, 311, 282, 260, 285, 284, 286, 379, 287,
+	262, 359, 396, 0, 307, 368, 332, 263, 331, 360,
+	395, 394, 271, 425, 431, 432, 0, 0, 437, 0,
+	0, 0, 445, 455, 456, 457, 459, 460, 461, 462,
+	0, 0, 0, 0, 439, 0, 0, 451, 452, 453,
+	0, 0, 0, 0, 430, 0, 305, 256, 257, 489,
+	289, 351, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 347, 429, 0, 0, 0, 463, 487,
+	0, 0, 0, 0, 0, 486, 357, 0, 376, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 411, 423, 440, 443, 0, 0, 0, 0,
+	261, 442, 0, 0, 0, 0, 0, 0, 0, 0,
+	414, 0, 0, 0, 422, 0, 0, 0, 0, 0,
+	438, 0, 0, 341, 342, 343, 344, 308, 0, 278,
+	441, 366, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	436, 304, 310, 458, 312, 277, 356, 306, 420, 319,
+	0, 446, 0, 447, 0, 0, 0, 0, 348, 315,
+	316, 380, 320, 326, 369, 419, 354, 374, 275, 410,
+	381, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 393, 0, 247, 283, 294, 0, 258, 0, 324,
+	0, 365, 302, 0, 0, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 0, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 0, 0, 0, 248, 249, 250, 251,
+	252, 253, 254, 255, 243, 244, 245, 246, 0, 0,
+	0, 426, 427, 428, 454, 412, 0, 485, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 481, 490,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 405,
+	406, 407, 408, 409, 488, 353, 477, 478, 479, 480,
+	0, 0, 0, 0, 0, 0, 0, 2228, 0, 0,
+	0, 0, 297, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 0,
+	465, 0, 0, 0, 0, 0, 382, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 0, 0,
+	2230, 0, 0, 0, 273, 197, 466, 0, 467, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	264, 387, 403, 274, 378, 421, 279, 385, 269, 352,
+	375, 0, 0, 266, 401, 384, 334, 317, 318, 265,
+	0, 370, 295, 309, 291, 350, 0, 400, 433, 290,
+	424, 0, 416, 268, 0, 415, 349, 397, 402, 335,
+	329, 267, 399, 333, 328, 321, 299, 448, 449, 450,
+	313, 361, 327, 362, 314, 339, 338, 340, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 418, 0, 0, 0, 0, 0, 0, 386, 0,
+	0, 322, 0, 0, 0, 434, 0, 373, 355, 0,
+	0, 0, 371, 303, 325, 398, 363, 404, 388, 417,
+	367, 364, 259, 389, 293, 336, 270, 272, 288, 296,
+	298, 300, 301, 345, 346, 358, 377, 390, 391, 392,
+	292, 280, 372, 281, 311, 282, 260, 285, 284, 286,
+	379, 287, 262, 359, 396, 0, 307, 368, 332, 263,
+	331, 360, 395, 394, 271, 425, 431, 432, 0, 0,
+	437, 0, 0, 0, 445, 455, 456, 457, 459, 460,
+	461, 462, 0, 0, 0, 0, 439, 0, 0, 451,
+	452, 453, 0, 0, 0, 0, 430, 0, 305, 256,
+	257, 489, 289, 351, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 347, 429, 0, 0, 0,
+	463, 487, 0, 0, 0, 0, 0, 486, 357, 0,
+	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 411, 423, 440, 443, 0, 0,
+	0, 0, 261, 442, 0, 0, 0, 0, 0, 0,
+	0, 0, 414, 0, 0, 0, 422, 0, 0, 0,
+	0, 0, 438, 0, 0, 341, 342, 343, 344, 308,
+	0, 278, 441, 366, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 436, 304, 310, 458, 312, 277, 356, 306,
+	420, 319, 0, 446, 0, 447, 0, 0, 0, 0,
+	348, 315, 316, 380, 320, 326, 369, 419, 354, 374,
+	275, 410, 381, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 393, 0, 247, 283, 294, 0, 258,
+	0, 324, 0, 365, 302, 0, 0, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
 	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 333, 0, 0, 233, 234, 235,
-	236, 0, 0, 0, 401, 402, 403, 424, 387, 0,
-	442, 278, 0, 0, 303, 0, 0, 0, 0, 0,
-	0, 0, 446, 438, 0, 0, 0, 434, 0, 435,
-	0, 0, 0, 0, 0, 362, 317, 0, 0, 0,
+	0, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 238, 239, 240, 241, 0, 0, 0, 248, 249,
+	250, 251, 252, 253, 254, 255, 243, 244, 245, 246,
+	0, 0, 0, 426, 427, 428, 454, 412, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	481, 490, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 405, 406, 407, 408, 409, 488, 353, 477, 478,
+	479, 480, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 2251, 0, 323, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 0, 465, 0, 0, 0, 0, 0, 382, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 196,
+	0, 0, 1298, 0, 0, 0, 273, 197, 466, 0,
+	467, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 264, 387, 403, 274, 378, 421, 279, 385,
+	269, 352, 375, 0, 0, 266, 401, 384, 334, 317,
+	318, 265, 0, 370, 295, 309, 291, 350, 0, 400,
+	433, 290, 424, 0, 416, 268, 0, 415, 349, 397,
+	402, 335, 329, 267, 399, 333, 328, 321, 299, 448,
+	449, 450, 313, 361, 327, 362, 314, 339, 338, 340,
+	0, 0, 0, 0, 0, 444, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 418, 0, 0, 0, 0, 0, 0,
+	386, 0, 0, 322, 0, 0, 0, 434, 0, 373,
+	355, 0, 0, 0, 371, 303, 325, 398, 363, 404,
+	388, 417, 367, 364, 259, 389, 293, 336, 270, 272,
+	288, 296, 298, 300, 301, 345, 346, 358, 377, 390,
+	391, 392, 292, 280, 372, 281, 311, 282, 260, 285,
+	284, 286, 379, 287, 262, 359, 396, 0, 307, 368,
+	332, 263, 331, 360, 395, 394, 271, 425, 431, 432,
+	0, 0, 437, 0, 0, 0, 445, 455, 456, 457,
+	459, 460, 461, 462, 0, 0, 0, 0, 439, 0,
+	0, 451, 452, 453, 0, 0, 0, 0, 430, 0,
+	305, 256, 257, 489, 289, 351, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 347, 429, 0,
+	0, 0, 463, 487, 0, 0, 0, 0, 0, 486,
+	357, 0, 376, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 411, 423, 440, 443,
+	0, 0, 0, 0, 261, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 414, 0, 0, 0, 422, 0,
+	0, 0, 0, 0, 438, 0, 0, 341, 342, 343,
+	344, 308, 0, 278, 441, 366, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 435, 436, 304, 310, 458, 312, 277,
+	356, 306, 420, 319, 0, 446, 0, 447, 0, 0,
+	0, 0, 348, 315, 316, 380, 320, 326, 369, 419,
+	354, 374, 275, 410, 381, 330, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 393, 0, 247, 283, 294,
+	0, 258, 0, 324, 0, 365, 302, 0, 0, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 0, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 238, 239, 240, 241, 0, 0, 0,
+	248, 249, 250, 251, 252, 253, 254, 255, 243, 244,
+	245, 246, 0, 0, 0, 426, 427, 428, 454, 412,
+	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 481, 490, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 405, 406, 407, 408, 409, 488, 353,
+	477, 478, 479, 480, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 0, 323,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 0, 465, 0, 0, 0, 0, 0,
+	382, 337, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 3610,
+	0, 196, 0, 0, 0, 0, 0, 0, 273, 197,
+	466, 0, 467, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 264, 387, 403, 274, 378, 421,
+	279, 385, 269, 352, 375, 0, 0, 266, 401, 384,
+	334, 317, 318, 265, 0, 370, 295, 309, 291, 350,
+	0, 400, 433, 290, 424, 0, 416, 268, 0, 415,
+	349, 397, 402, 335, 329, 267, 399, 333, 328, 321,
+	299, 448, 449, 450, 313, 361, 327, 362, 314, 339,
+	338, 340, 0, 0, 0, 0, 0, 444, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 418, 0, 0, 0, 0,
+	0, 0, 386, 0, 0, 322, 0, 0, 0, 434,
+	0, 373, 355, 0, 0, 0, 371, 303, 325, 398,
+	363, 404, 388, 417, 367, 364, 259, 389, 293, 336,
+	270, 272, 288, 296, 298, 300, 301, 345, 346, 358,
+	377, 390, 391, 392, 292, 280, 372, 281, 311, 282,
+	260, 285, 284, 286, 379, 287, 262, 359, 396, 0,
+	307, 368, 332, 263, 331, 360, 395, 394, 271, 425,
+	431, 432, 0, 0, 437, 0, 0, 0, 445, 455,
+	456, 457, 459, 460, 461, 462, 0, 0, 0, 0,
+	439, 0, 0, 451, 452, 453, 0, 0, 0, 0,
+	430, 0, 305, 256, 257, 489, 289, 351, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
+	429, 0, 0, 0, 463, 487, 0, 0, 0, 0,
+	0, 486, 357, 0, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 411, 423,
+	440, 443, 0, 0, 0, 0, 261, 442, 0, 0,
+	0, 0, 0, 0, 0, 0, 414, 0, 0, 0,
+	422, 0, 0, 0, 0, 0, 438, 0, 0, 341,
+	342, 343, 344, 308, 0, 278, 441, 366, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 435, 436, 304, 310, 458,
+	312, 277, 356, 306, 420, 319, 0, 446, 0, 447,
+	0, 0, 0, 0, 348, 315, 316, 380, 320, 326,
+	369, 419, 354, 374, 275, 410, 381, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 393, 0, 247,
+	283, 294, 0, 258, 0, 324, 0, 365, 302, 0,
+	0, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 0, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 238, 239, 240, 241, 0,
+	0, 0, 248, 249, 250, 251, 252, 253, 254, 255,
+	243, 244, 245, 246, 0, 0, 0, 426, 427, 428,
+	454, 412, 0, 485, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 481, 490, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 405, 406, 407, 408, 409,
+	488, 353, 477, 478, 479, 480, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 0, 465, 0, 0, 0,
+	0, 0, 382, 337, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 672, 0, 0, 0, 0, 0,
+	273, 197, 466, 0, 467, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 264, 387, 403, 274,
+	378, 421, 279, 385, 269, 352, 375, 0, 0, 266,
+	401, 384, 334, 317, 318, 265, 0, 370, 295, 309,
+	291, 350, 0, 400, 433, 290, 424, 0, 416, 268,
+	0, 415, 349, 397, 402, 335, 329, 267, 399, 333,
+	328, 321, 299, 448, 449, 450, 313, 361, 327, 362,
+	314, 339, 338, 340, 0, 0, 0, 0, 0, 444,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 0,
+	0, 0, 0, 0, 386, 0, 0, 322, 0, 0,
+	0, 434, 0, 373, 355, 0, 0, 0, 371, 303,
+	325, 398, 363, 404, 388, 417, 367, 364, 259, 389,
+	293, 336, 270, 272, 288, 296, 298, 300, 301, 345,
+	346, 358, 377, 390, 391, 392, 292, 280, 372, 281,
+	311, 282, 260, 285, 284, 286, 379, 287, 262, 359,
+	396, 0, 307, 368, 332, 263, 331, 360, 395, 394,
+	271, 425, 431, 432, 0, 0, 437, 0, 0, 0,
+	445, 455, 456, 457, 459, 460, 461, 462, 0, 0,
+	0, 0, 439, 0, 0, 451, 452, 453, 0, 0,
+	0, 0, 430, 0, 305, 256, 257, 489, 289, 351,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 347, 429, 0, 0, 0, 463, 487, 0, 0,
+	0, 0, 0, 486, 357, 0, 376, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	411, 423, 440, 443, 0, 0, 0, 0, 261, 442,
+	0, 0, 0, 0, 0, 0, 0, 0, 414, 0,
+	0, 0, 422, 0, 0, 0, 0, 0, 438, 0,
+	0, 341, 342, 343, 344, 308, 0, 278, 441, 366,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 435, 436, 304,
+	310, 458, 312, 277, 356, 306, 420, 319, 0, 446,
+	0, 447, 0, 0, 0, 0, 348, 315, 316, 380,
+	320, 326, 369, 419, 354, 374, 275, 410, 381, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 0, 0, 0,
-	0, 0, 0, 255, 188, 436, 0, 437, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 258, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
+	0, 247, 283, 294, 0, 258, 0, 324, 0, 365,
+	302, 0, 0, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 0, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 239, 240,
+	241, 0, 0, 0, 248, 249, 250, 251, 252, 253,
+	254, 255, 243, 244, 245, 246, 0, 0, 0, 426,
+	427, 428, 454, 412, 0, 485, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 481, 490, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 405, 406, 407,
+	408, 409, 488, 353, 477, 478, 479, 480, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	297, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 0, 465, 0,
+	0, 0, 0, 0, 382, 337, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 3517, 0, 0, 196, 0, 0, 0, 0,
+	0, 0, 273, 197, 466, 0, 467, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 264, 387,
+	403, 274, 378, 421, 279, 385, 269, 352, 375, 0,
+	0, 266, 401, 384, 334, 317, 318, 265, 0, 370,
+	295, 309, 291, 350, 0, 400, 433, 290, 424, 0,
+	416, 268, 0, 415, 349, 397, 402, 335, 329, 267,
+	399, 333, 328, 321, 299, 448, 449, 450, 313, 361,
+	327, 362, 314, 339, 338, 340, 0, 0, 0, 0,
+	0, 444, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 418,
+	0, 0, 0, 0, 0, 0, 386, 0, 0, 322,
+	0, 0, 0, 434, 0, 373, 355, 0, 0, 0,
+	371, 303, 325, 398, 363, 404, 388, 417, 367, 364,
+	259, 389, 293, 336, 270, 272, 288, 296, 298, 300,
+	301, 345, 346, 358, 377, 390, 391, 392, 292, 280,
+	372, 281, 311, 282, 260, 285, 284, 286, 379, 287,
+	262, 359, 396, 0, 307, 368, 332, 263, 331, 360,
+	395, 394, 271, 425, 431, 432, 0, 0, 437, 0,
+	0, 0, 445, 455, 456, 457, 459, 460, 461, 462,
+	0, 0, 0, 0, 439, 0, 0, 451, 452, 453,
+	0, 0, 0, 0, 430, 0, 305, 256, 257, 489,
+	289, 351, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 347, 429, 0, 0, 0, 463, 487,
+	0, 0, 0, 0, 0, 486, 357, 0, 376, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 411, 423, 440, 443, 0, 0, 0, 0,
+	261, 442, 0, 0, 0, 0, 0, 0, 0, 0,
+	414, 0, 0, 0, 422, 0, 0, 0, 0, 0,
+	438, 0, 0, 341, 342, 343, 344, 308, 0, 278,
+	441, 366, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	436, 304, 310, 458, 312, 277, 356, 306, 420, 319,
+	0, 446, 0, 447, 0, 0, 0, 0, 348, 315,
+	316, 380, 320, 326, 369, 419, 354, 374, 275, 410,
+	381, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 393, 0, 247, 283, 294, 0, 258, 0, 324,
+	0, 365, 302, 0, 0, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 0, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 0, 0, 0, 248, 249, 250, 251,
+	252, 253, 254, 255, 243, 244, 245, 246, 0, 0,
+	0, 426, 427, 428, 454, 412, 0, 485, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 481, 490,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 405,
+	406, 407, 408, 409, 488, 353, 477, 478, 479, 480,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 297, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 0,
+	465, 0, 0, 0, 0, 0, 382, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 0, 0,
+	0, 0, 0, 0, 273, 197, 466, 0, 467, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	264, 387, 403, 274, 378, 421, 279, 385, 269, 352,
+	375, 0, 0, 266, 401, 384, 334, 317, 318, 265,
+	0, 370, 295, 309, 291, 350, 0, 400, 433, 290,
+	424, 0, 416, 268, 0, 415, 349, 397, 402, 335,
+	329, 267, 399, 333, 328, 321, 299, 448, 449, 450,
+	313, 361, 327, 362, 314, 339, 338, 340, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 418, 0, 0, 0, 3413, 0, 0, 386, 0,
+	0, 322, 0, 0, 0, 434, 0, 373, 355, 0,
+	0, 0, 371, 303, 325, 398, 363, 404, 388, 417,
+	367, 364, 259, 389, 293, 336, 270, 272, 288, 296,
+	298, 300, 301, 345, 346, 358, 377, 390, 391, 392,
+	292, 280, 372, 281, 311, 282, 260, 285, 284, 286,
+	379, 287, 262, 359, 396, 0, 307, 368, 332, 263,
+	331, 360, 395, 394, 271, 425, 431, 432, 0, 0,
+	437, 0, 0, 0, 445, 455, 456, 457, 459, 460,
+	461, 462, 0, 0, 0, 0, 439, 0, 0, 451,
+	452, 453, 0, 0, 0, 0, 430, 0, 305, 256,
+	257, 489, 289, 351, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 347, 429, 0, 0, 0,
+	463, 487, 0, 0, 0, 0, 0, 486, 357, 0,
+	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 411, 423, 440, 443, 0, 0,
+	0, 0, 261, 442, 0, 0, 0, 0, 0, 0,
+	0, 0, 414, 0, 0, 0, 422, 0, 0, 0,
+	0, 0, 438, 0, 0, 341, 342, 343, 344, 308,
+	0, 278, 441, 366, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 436, 304, 310, 458, 312, 277, 356, 306,
+	420, 319, 0, 446, 0, 447, 0, 0, 0, 0,
+	348, 315, 316, 380, 320, 326, 369, 419, 354, 374,
+	275, 410, 381, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 393, 0, 247, 283, 294, 0, 258,
+	0, 324, 0, 365, 302, 0, 0, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	0, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 238, 239, 240, 241, 0, 0, 0, 248, 249,
+	250, 251, 252, 253, 254, 255, 243, 244, 245, 246,
+	0, 0, 0, 426, 427, 428, 454, 412, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	481, 490, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 405, 406, 407, 408, 409, 488, 353, 477, 478,
+	479, 480, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 0, 0, 323, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 0, 465, 0, 0, 0, 0, 0, 382, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 3147, 0, 0, 196,
+	0, 0, 0, 0, 0, 0, 273, 197, 466, 0,
+	467, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 264, 387, 403, 274, 378, 421, 279, 385,
+	269, 352, 375, 0, 0, 266, 401, 384, 334, 317,
+	318, 265, 0, 370, 295, 309, 291, 350, 0, 400,
+	433, 290, 424, 0, 416, 268, 0, 415, 349, 397,
+	402, 335, 329, 267, 399, 333, 328, 321, 299, 448,
+	449, 450, 313, 361, 327, 362, 314, 339, 338, 340,
+	0, 0, 0, 0, 0, 444, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 418, 0, 0, 0, 0, 0, 0,
+	386, 0, 0, 322, 0, 0, 0, 434, 0, 373,
+	355, 0, 0, 0, 371, 303, 325, 398, 363, 404,
+	388, 417, 367, 364, 259, 389, 293, 336, 270, 272,
+	288, 296, 298, 300, 301, 345, 346, 358, 377, 390,
+	391, 392, 292, 280, 372, 281, 311, 282, 260, 285,
+	284, 286, 379, 287, 262, 359, 396, 0, 307, 368,
+	332, 263, 331, 360, 395, 394, 271, 425, 431, 432,
+	0, 0, 437, 0, 0, 0, 445, 455, 456, 457,
+	459, 460, 461, 462, 0, 0, 0, 0, 439, 0,
+	0, 451, 452, 453, 0, 0, 0, 0, 430, 0,
+	305, 256, 257, 489, 289, 351, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 347, 429, 0,
+	0, 0, 463, 487, 0, 0, 0, 0, 0, 486,
+	357, 0, 376, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 411, 423, 440, 443,
+	0, 0, 0, 0, 261, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 414, 0, 0, 0, 422, 0,
+	0, 0, 0, 0, 438, 0, 0, 341, 342, 343,
+	344, 308, 0, 278, 441, 366, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 435, 436, 304, 310, 458, 312, 277,
+	356, 306, 420, 319, 0, 446, 0, 447, 0, 0,
+	0, 0, 348, 315, 316, 380, 320, 326, 369, 419,
+	354, 374, 275, 410, 381, 330, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 393, 0, 247, 283, 294,
+	0, 258, 0, 324, 0, 365, 302, 0, 0, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 0, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 238, 239, 240, 241, 0, 0, 0,
+	248, 249, 250, 251, 252, 253, 254, 255, 243, 244,
+	245, 246, 0, 0, 0, 426, 427, 428, 454, 412,
+	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 481, 490, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 405, 406, 407, 408, 409, 488, 353,
+	477, 478, 479, 480, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 0, 323,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 0, 465, 0, 0, 0, 0, 0,
+	382, 337, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 196, 0, 0, 2995, 0, 0, 0, 273, 197,
+	466, 0, 467, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 264, 387, 403, 274, 378, 421,
+	279, 385, 269, 352, 375, 0, 0, 266, 401, 384,
+	334, 317, 318, 265, 0, 370, 295, 309, 291, 350,
+	0, 400, 433, 290, 424, 0, 416, 268, 0, 415,
+	349, 397, 402, 335, 329, 267, 399, 333, 328, 321,
+	299, 448, 449, 450, 313, 361, 327, 362, 314, 339,
+	338, 340, 0, 0, 0, 0, 0, 444, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 418, 0, 0, 0, 0,
+	0, 0, 386, 0, 0, 322, 0, 0, 0, 434,
+	0, 373, 355, 0, 0, 0, 371, 303, 325, 398,
+	363, 404, 388, 417, 367, 364, 259, 389, 293, 336,
+	270, 272, 288, 296, 298, 300, 301, 345, 346, 358,
+	377, 390, 391, 392, 292, 280, 372, 281, 311, 282,
+	260, 285, 284, 286, 379, 287, 262, 359, 396, 0,
+	307, 368, 332, 263, 331, 360, 395, 394, 271, 425,
+	431, 432, 0, 0, 437, 0, 0, 0, 445, 455,
+	456, 457, 459, 460, 461, 462, 0, 0, 0, 0,
+	439, 0, 0, 451, 452, 453, 0, 0, 0, 0,
+	430, 0, 305, 256, 257, 489, 289, 351, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
+	429, 0, 0, 0, 463, 487, 0, 0, 0, 0,
+	0, 486, 357, 0, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 411, 423,
+	440, 443, 0, 0, 0, 0, 261, 442, 0, 0,
+	0, 0, 0, 0, 0, 0, 414, 0, 0, 0,
+	422, 0, 0, 0, 0, 0, 438, 0, 0, 341,
+	342, 343, 344, 308, 0, 278, 441, 366, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 435, 436, 304, 310, 458,
+	312, 277, 356, 306, 420, 319, 0, 446, 0, 447,
+	0, 0, 0, 0, 348, 315, 316, 380, 320, 326,
+	369, 419, 354, 374, 275, 410, 381, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 393, 0, 247,
+	283, 294, 0, 258, 0, 324, 0, 365, 302, 0,
+	0, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 0, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 238, 239, 240, 241, 0,
+	0, 0, 248, 249, 250, 251, 252, 253, 254, 255,
+	243, 244, 245, 246, 0, 0, 0, 426, 427, 428,
+	454, 412, 0, 485, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 481, 490, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 405, 406, 407, 408, 409,
+	488, 353, 477, 478, 479, 480, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 0, 465, 0, 0, 0,
+	0, 0, 382, 337, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 0, 0, 0, 0, 0, 0,
+	273, 197, 466, 0, 467, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 264, 387, 403, 274,
+	378, 421, 279, 385, 269, 352, 375, 0, 0, 266,
+	401, 384, 334, 317, 318, 265, 0, 370, 295, 309,
+	291, 350, 0, 400, 433, 290, 424, 0, 416, 268,
+	0, 415, 349, 397, 402, 335, 329, 267, 399, 333,
+	328, 321, 299, 448, 449, 450, 313, 361, 327, 362,
+	314, 339, 338, 340, 0, 0, 0, 0, 0, 444,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 0,
+	0, 3230, 0, 0, 386, 0, 0, 322, 0, 0,
+	0, 434, 0, 373, 355, 0, 0, 0, 371, 303,
+	325, 398, 363, 404, 388, 417, 367, 364, 259, 389,
+	293, 336, 270, 272, 288, 296, 298, 300, 301, 345,
+	346, 358, 377, 390, 391, 392, 292, 280, 372, 281,
+	311, 282, 260, 285, 284, 286, 379, 287, 262, 359,
+	396, 0, 307, 368, 332, 263, 331, 360, 395, 394,
+	271, 425, 431, 432, 0, 0, 437, 0, 0, 0,
+	445, 455, 456, 457, 459, 460, 461, 462, 0, 0,
+	0, 0, 439, 0, 0, 451, 452, 453, 0, 0,
+	0, 0, 430, 0, 305, 256, 257, 489, 289, 351,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 347, 429, 0, 0, 0, 463, 487, 0, 0,
+	0, 0, 0, 486, 357, 0, 376, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	411, 423, 440, 443, 0, 0, 0, 0, 261, 442,
+	0, 0, 0, 0, 0, 0, 0, 0, 414, 0,
+	0, 0, 422, 0, 0, 0, 0, 0, 438, 0,
+	0, 341, 342, 343, 344, 308, 0, 278, 441, 366,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 435, 436, 304,
+	310, 458, 312, 277, 356, 306, 420, 319, 0, 446,
+	0, 447, 0, 0, 0, 0, 348, 315, 316, 380,
+	320, 326, 369, 419, 354, 374, 275, 410, 381, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
+	0, 247, 283, 294, 0, 258, 0, 324, 0, 365,
+	302, 0, 0, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 0, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 239, 240,
+	241, 0, 0, 0, 248, 249, 250, 251, 252, 253,
+	254, 255, 243, 244, 245, 246, 0, 0, 0, 426,
+	427, 428, 454, 412, 0, 485, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 481, 490, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 405, 406, 407,
+	408, 409, 488, 353, 477, 478, 479, 480, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	297, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 0, 465, 0,
+	0, 0, 0, 0, 382, 337, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 196, 0, 0, 0, 0,
+	0, 0, 273, 197, 466, 0, 467, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2823, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 264, 387,
+	403, 274, 378, 421, 279, 385, 269, 352, 375, 0,
+	0, 266, 401, 384, 334, 317, 318, 265, 0, 370,
+	295, 309, 291, 350, 0, 400, 433, 290, 424, 0,
+	416, 268, 0, 415, 349, 397, 402, 335, 329, 267,
+	399, 333, 328, 321, 299, 448, 449, 450, 313, 361,
+	327, 362, 314, 339, 338, 340, 0, 0, 0, 0,
+	0, 444, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 418,
+	0, 0, 0, 0, 0, 0, 386, 0, 0, 322,
+	0, 0, 0, 434, 0, 373, 355, 0, 0, 0,
+	371, 303, 325, 398, 363, 404, 388, 417, 367, 364,
+	259, 389, 293, 336, 270, 272, 288, 296, 298, 300,
+	301, 345, 346, 358, 377, 390, 391, 392, 292, 280,
+	372, 281, 311, 282, 260, 285, 284, 286, 379, 287,
+	262, 359, 396, 0, 307, 368, 332, 263, 331, 360,
+	395, 394, 271, 425, 431, 432, 0, 0, 437, 0,
+	0, 0, 445, 455, 456, 457, 459, 460, 461, 462,
+	0, 0, 0, 0, 439, 0, 0, 451, 452, 453,
+	0, 0, 0, 0, 430, 0, 305, 256, 257, 489,
+	289, 351, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 347, 429, 0, 0, 0, 463, 487,
+	0, 0, 0, 0, 0, 486, 357, 0, 376, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 411, 423, 440, 443, 0, 0, 0, 0,
+	261, 442, 0, 0, 0, 0, 0, 0, 0, 0,
+	414, 0, 0, 0, 422, 0, 0, 0, 0, 0,
+	438, 0, 0, 341, 342, 343, 344, 308, 0, 278,
+	441, 366, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	436, 304, 310, 458, 312, 277, 356, 306, 420, 319,
+	0, 446, 0, 447, 0, 0, 0, 0, 348, 315,
+	316, 380, 320, 326, 369, 419, 354, 374, 275, 410,
+	381, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 393, 0, 247, 283, 294, 0, 258, 0, 324,
+	0, 365, 302, 0, 0, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 0, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 0, 0, 0, 248, 249, 250, 251,
+	252, 253, 254, 255, 243, 244, 245, 246, 0, 0,
+	0, 426, 427, 428, 454, 412, 0, 485, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 481, 490,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 405,
+	406, 407, 408, 409, 488, 353, 477, 478, 479, 480,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 297, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 0,
+	465, 0, 0, 0, 0, 0, 382, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 0, 0,
+	2456, 0, 0, 0, 273, 197, 466, 0, 467, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	264, 387, 403, 274, 378, 421, 279, 385, 269, 352,
+	375, 0, 0, 266, 401, 384, 334, 317, 318, 265,
+	0, 370, 295, 309, 291, 350, 0, 400, 433, 290,
+	424, 0, 416, 268, 0, 415, 349, 397, 402, 335,
+	329, 267, 399, 333, 328, 321, 299, 448, 449, 450,
+	313, 361, 327, 362, 314, 339, 338, 340, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 418, 0, 0, 0, 0, 0, 0, 386, 0,
+	0, 322, 0, 0, 0, 434, 0, 373, 355, 0,
+	0, 0, 371, 303, 325, 398, 363, 404, 388, 417,
+	367, 364, 259, 389, 293, 336, 270, 272, 288, 296,
+	298, 300, 301, 345, 346, 358, 377, 390, 391, 392,
+	292, 280, 372, 281, 311, 282, 260, 285, 284, 286,
+	379, 287, 262, 359, 396, 0, 307, 368, 332, 263,
+	331, 360, 395, 394, 271, 425, 431, 432, 0, 0,
+	437, 0, 0, 0, 445, 455, 456, 457, 459, 460,
+	461, 462, 0, 0, 0, 0, 439, 0, 0, 451,
+	452, 453, 0, 0, 0, 0, 430, 0, 305, 256,
+	257, 489, 289, 351, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 347, 429, 0, 0, 0,
+	463, 487, 0, 0, 0, 0, 0, 486, 357, 0,
+	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 411, 423, 440, 443, 0, 0,
+	0, 0, 261, 442, 0, 0, 0, 0, 0, 0,
+	0, 0, 414, 0, 0, 0, 422, 0, 0, 0,
+	0, 0, 438, 0, 0, 341, 342, 343, 344, 308,
+	0, 278, 441, 366, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 436, 304, 310, 458, 312, 277, 356, 306,
+	420, 319, 0, 446, 0, 447, 0, 0, 0, 0,
+	348, 315, 316, 380, 320, 326, 369, 419, 354, 374,
+	275, 410, 381, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 393, 0, 247, 283, 294, 0, 258,
+	0, 324, 0, 365, 302, 0, 0, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	0, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 238, 239, 240, 241, 0, 0, 0, 248, 249,
+	250, 251, 252, 253, 254, 255, 243, 244, 245, 246,
+	0, 0, 0, 426, 427, 428, 454, 412, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	481, 490, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 405, 406, 407, 408, 409, 488, 353, 477, 478,
+	479, 480, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 297, 0, 0, 323, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 0, 465, 0, 0, 0, 0, 0, 382, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 196,
+	0, 0, 0, 0, 0, 0, 273, 197, 466, 0,
+	467, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2921, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 264, 387, 403, 274, 378, 421, 279, 385,
+	269, 352, 375, 0, 0, 266, 401, 384, 334, 317,
+	318, 265, 0, 370, 295, 309, 291, 350, 0, 400,
+	433, 290, 424, 0, 416, 268, 0, 415, 349, 397,
+	402, 335, 329, 267, 399, 333, 328, 321, 299, 448,
+	449, 450, 313, 361, 327, 362, 314, 339, 338, 340,
+	0, 0, 0, 0, 0, 444, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 418, 0, 0, 0, 0, 0, 0,
+	386, 0, 0, 322, 0, 0, 0, 434, 0, 373,
+	355, 0, 0, 0, 371, 303, 325, 398, 363, 404,
+	388, 417, 367, 364, 259, 389, 293, 336, 270, 272,
+	288, 296, 298, 300, 301, 345, 346, 358, 377, 390,
+	391, 392, 292, 280, 372, 281, 311, 282, 260, 285,
+	284, 286, 379, 287, 262, 359, 396, 0, 307, 368,
+	332, 263, 331, 360, 395, 394, 271, 425, 431, 432,
+	0, 0, 437, 0, 0, 0, 445, 455, 456, 457,
+	459, 460, 461, 462, 0, 0, 0, 0, 439, 0,
+	0, 451, 452, 453, 0, 0, 0, 0, 430, 0,
+	305, 256, 257, 489, 289, 351, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 347, 429, 0,
+	0, 0, 463, 487, 0, 0, 0, 0, 0, 486,
+	357, 0, 376, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 411, 423, 440, 443,
+	0, 0, 0, 0, 261, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 414, 0, 0, 0, 422, 0,
+	0, 0, 0, 0, 438, 0, 0, 341, 342, 343,
+	344, 308, 0, 278, 441, 366, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 435, 436, 304, 310, 458, 312, 277,
+	356, 306, 420, 319, 0, 446, 0, 447, 0, 0,
+	0, 0, 348, 315, 316, 380, 320, 326, 369, 419,
+	354, 374, 275, 410, 381, 330, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 393, 0, 247, 283, 294,
+	0, 258, 0, 324, 0, 365, 302, 0, 0, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 0, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 238, 239, 240, 241, 0, 0, 0,
+	248, 249, 250, 251, 252, 253, 254, 255, 243, 244,
+	245, 246, 0, 0, 0, 426, 427, 428, 454, 412,
+	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 481, 490, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 405, 406, 407, 408, 409, 488, 353,
+	477, 478, 479, 480, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 0, 323,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 0, 465, 0, 0, 0, 0, 0,
+	382, 337, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 196, 0, 0, 2764, 0, 0, 0, 273, 197,
+	466, 0, 467, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 264, 387, 403, 274, 378, 421,
+	279, 385, 269, 352, 375, 0, 0, 266, 401, 384,
+	334, 317, 318, 265, 0, 370, 295, 309, 291, 350,
+	0, 400, 433, 290, 424, 0, 416, 268, 0, 415,
+	349, 397, 402, 335, 329, 267, 399, 333, 328, 321,
+	299, 448, 449, 450, 313, 361, 327, 362, 314, 339,
+	338, 340, 0, 0, 0, 0, 0, 444, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 418, 0, 0, 0, 0,
+	0, 0, 386, 0, 0, 322, 0, 0, 0, 434,
+	0, 373, 355, 0, 0, 0, 371, 303, 325, 398,
+	363, 404, 388, 417, 367, 364, 259, 389, 293, 336,
+	270, 272, 288, 296, 298, 300, 301, 345, 346, 358,
+	377, 390, 391, 392, 292, 280, 372, 281, 311, 282,
+	260, 285, 284, 286, 379, 287, 262, 359, 396, 0,
+	307, 368, 332, 263, 331, 360, 395, 394, 271, 425,
+	431, 432, 0, 0, 437, 0, 0, 0, 445, 455,
+	456, 457, 459, 460, 461, 462, 0, 0, 0, 0,
+	439, 0, 0, 451, 452, 453, 0, 0, 0, 0,
+	430, 0, 305, 256, 257, 489, 289, 351, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
+	429, 0, 0, 0, 463, 487, 0, 0, 0, 0,
+	0, 486, 357, 0, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 411, 423,
+	440, 443, 0, 0, 0, 0, 261, 442, 0, 0,
+	0, 0, 0, 0, 0, 0, 414, 0, 0, 0,
+	422, 0, 0, 0, 0, 0, 438, 0, 0, 341,
+	342, 343, 344, 308, 0, 278, 441, 366, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 435, 436, 304, 310, 458,
+	312, 277, 356, 306, 420, 319, 0, 446, 0, 447,
+	0, 0, 0, 0, 348, 315, 316, 380, 320, 326,
+	369, 419, 354, 374, 275, 410, 381, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 393, 0, 247,
+	283, 294, 0, 258, 0, 324, 0, 365, 302, 0,
+	0, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 0, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 238, 239, 240, 241, 0,
+	0, 0, 248, 249, 250, 251, 252, 253, 254, 255,
+	243, 244, 245, 246, 0, 0, 0, 426, 427, 428,
+	454, 412, 0, 485, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 481, 490, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 405, 406, 407, 408, 409,
+	488, 353, 477, 478, 479, 480, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 0, 465, 0, 0, 0,
+	0, 0, 382, 337, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 0, 0, 0, 0, 0, 0,
+	273, 197, 466, 0, 467, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2689, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 264, 387, 403, 274,
+	378, 421, 279, 385, 269, 352, 375, 0, 0, 266,
+	401, 384, 334, 317, 318, 265, 0, 370, 295, 309,
+	291, 350, 0, 400, 433, 290, 424, 0, 416, 268,
+	0, 415, 349, 397, 402, 335, 329, 267, 399, 333,
+	328, 321, 299, 448, 449, 450, 313, 361, 327, 362,
+	314, 339, 338, 340, 0, 0, 0, 0, 0, 444,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 0,
+	0, 0, 0, 0, 386, 0, 0, 322, 0, 0,
+	0, 434, 0, 373, 355, 0, 0, 0, 371, 303,
+	325, 398, 363, 404, 388, 417, 367, 364, 259, 389,
+	293, 336, 270, 272, 288, 296, 298, 300, 301, 345,
+	346, 358, 377, 390, 391, 392, 292, 280, 372, 281,
+	311, 282, 260, 285, 284, 286, 379, 287, 262, 359,
+	396, 0, 307, 368, 332, 263, 331, 360, 395, 394,
+	271, 425, 431, 432, 0, 0, 437, 0, 0, 0,
+	445, 455, 456, 457, 459, 460, 461, 462, 0, 0,
+	0, 0, 439, 0, 0, 451, 452, 453, 0, 0,
+	0, 0, 430, 0, 305, 256, 257, 489, 289, 351,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 347, 429, 0, 0, 0, 463, 487, 0, 0,
+	0, 0, 0, 486, 357, 0, 376, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	411, 423, 440, 443, 0, 0, 0, 0, 261, 442,
+	0, 0, 0, 0, 0, 0, 0, 0, 414, 0,
+	0, 0, 422, 0, 0, 0, 0, 0, 438, 0,
+	0, 341, 342, 343, 344, 308, 0, 278, 441, 366,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 435, 436, 304,
+	310, 458, 312, 277, 356, 306, 420, 319, 0, 446,
+	0, 447, 0, 0, 0, 0, 348, 315, 316, 380,
+	320, 326, 369, 419, 354, 374, 275, 410, 381, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
+	0, 247, 283, 294, 0, 258, 0, 324, 0, 365,
+	302, 0, 0, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 0, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 239, 240,
+	241, 0, 0, 0, 248, 249, 250, 251, 252, 253,
+	254, 255, 243, 244, 245, 246, 0, 0, 0, 426,
+	427, 428, 454, 412, 0, 485, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 481, 490, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 405, 406, 407,
+	408, 409, 488, 353, 477, 478, 479, 480, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	297, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 0, 465, 0,
+	0, 0, 0, 0, 382, 337, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 196, 0, 0, 1298, 0,
+	0, 0, 273, 197, 466, 0, 467, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 264, 387,
+	403, 274, 378, 421, 279, 385, 269, 352, 375, 0,
+	0, 266, 401, 384, 334, 317, 318, 265, 0, 370,
+	295, 309, 291, 350, 0, 400, 433, 290, 424, 0,
+	416, 268, 0, 415, 349, 397, 402, 335, 329, 267,
+	399, 333, 328, 321, 299, 448, 449, 450, 313, 361,
+	327, 362, 314, 339, 338, 340, 0, 0, 0, 0,
+	0, 444, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 418,
+	0, 0, 0, 0, 0, 0, 386, 0, 0, 322,
+	0, 0, 0, 434, 0, 373, 355, 0, 0, 0,
+	371, 303, 325, 398, 363, 404, 388, 417, 367, 364,
+	259, 389, 293, 336, 270, 272, 288, 296, 298, 300,
+	301, 345, 346, 358, 377, 390, 391, 392, 292, 280,
+	372, 281, 311, 282, 260, 285, 284, 286, 379, 287,
+	262, 359, 396, 0, 307, 368, 332, 263, 331, 360,
+	395, 394, 271, 425, 431, 432, 0, 0, 437, 0,
+	0, 0, 445, 455, 456, 457, 459, 460, 461, 462,
+	0, 0, 0, 0, 439, 0, 0, 451, 452, 453,
+	0, 0, 0, 0, 430, 0, 305, 256, 257, 489,
+	289, 351, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 347, 429, 0, 0, 0, 463, 487,
+	0, 0, 0, 0, 0, 486, 357, 0, 376, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 411, 423, 440, 443, 0, 0, 0, 0,
+	261, 442, 0, 0, 0, 0, 0, 0, 0, 0,
+	414, 0, 0, 0, 422, 0, 0, 0, 0, 0,
+	438, 0, 0, 341, 342, 343, 344, 308, 0, 278,
+	441, 366, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	436, 304, 310, 458, 312, 277, 356, 306, 420, 319,
+	0, 446, 0, 447, 0, 0, 0, 0, 348, 315,
+	316, 380, 320, 326, 369, 419, 354, 374, 275, 410,
+	381, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 393, 0, 247, 283, 294, 0, 258, 0, 324,
+	0, 365, 302, 0, 0, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 0, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 0, 0, 0, 248, 249, 250, 251,
+	252, 253, 254, 255, 243, 244, 245, 246, 0, 0,
+	0, 426, 427, 428, 454, 412, 0, 485, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 481, 490,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 405,
+	406, 407, 408, 409, 488, 353, 477, 478, 479, 480,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 297, 0, 0, 323, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 464, 0,
+	465, 0, 0, 0, 0, 0, 382, 337, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 0, 0,
+	2230, 0, 0, 0, 273, 197, 466, 0, 467, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	264, 387, 403, 274, 378, 421, 279, 385, 269, 352,
+	375, 0, 0, 266, 401, 384, 334, 317, 318, 265,
+	0, 370, 295, 309, 291, 350, 0, 400, 433, 290,
+	424, 0, 416, 268, 0, 415, 349, 397, 402, 335,
+	329, 267, 399, 333, 328, 321, 299, 448, 449, 450,
+	313, 361, 327, 362, 314, 339, 338, 340, 0, 0,
+	0, 0, 0, 444, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 418, 0, 0, 0, 0, 0, 0, 386, 0,
+	0, 322, 0, 0, 0, 434, 0, 373, 355, 0,
+	0, 0, 371, 303, 325, 398, 363, 404, 388, 417,
+	367, 364, 259, 389, 293, 336, 270, 272, 288, 296,
+	298, 300, 301, 345, 346, 358, 377, 390, 391, 392,
+	292, 280, 372, 281, 311, 282, 260, 285, 284, 286,
+	379, 287, 262, 359, 396, 0, 307, 368, 332, 263,
+	331, 360, 395, 394, 271, 425, 431, 432, 0, 0,
+	437, 0, 0, 0, 445, 455, 456, 457, 459, 460,
+	461, 462, 0, 0, 0, 0, 439, 0, 0, 451,
+	452, 453, 0, 0, 0, 0, 430, 0, 305, 256,
+	257, 489, 289, 351, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 347, 429, 0, 0, 0,
+	463, 487, 0, 0, 0, 0, 0, 486, 357, 0,
+	376, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 383, 411, 423, 440, 443, 0, 0,
+	0, 0, 261, 442, 0, 0, 0, 0, 0, 0,
+	0, 0, 414, 0, 0, 0, 422, 0, 0, 0,
+	0, 0, 438, 0, 0, 341, 342, 343, 344, 308,
+	0, 278, 441, 366, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 435, 436, 304, 310, 458, 312, 277, 356, 306,
+	420, 319, 0, 446, 0, 447, 0, 0, 0, 0,
+	348, 315, 316, 380, 320, 326, 369, 419, 354, 374,
+	275, 410, 381, 330, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 242, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 393, 0, 247, 283, 294, 0, 258,
+	0, 324, 0, 365, 302, 0, 0, 205, 206, 207,
+	208, 209, 210, 211, 212, 213, 214, 215, 216, 217,
+	218, 219, 220, 221, 222, 223, 224, 225, 226, 227,
+	0, 228, 229, 230, 231, 232, 233, 234, 235, 236,
+	237, 238, 239, 240, 241, 0, 0, 0, 248, 249,
+	250, 251, 252, 253, 254, 255, 243, 244, 245, 246,
+	0, 0, 0, 426, 427, 428, 454, 412, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	481, 490, 468, 469, 470, 471, 472, 473, 474, 475,
+	476, 405, 406, 407, 408, 409, 488, 353, 477, 478,
+	479, 480, 0, 0, 0, 0, 2510, 0, 0, 0,
+	0, 0, 0, 0, 297, 0, 0, 323, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	464, 0, 465, 0, 0, 0, 0, 0, 382, 337,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 196,
+	0, 0, 0, 0, 0, 0, 273, 197, 466, 0,
+	467, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 264, 387, 403, 274, 378, 421, 279, 385,
+	269, 352, 375, 0, 0, 266, 401, 384, 334, 317,
+	318, 265, 0, 370, 295, 309, 291, 350, 0, 400,
+	433, 290, 424, 0, 416, 268, 0, 415, 349, 397,
+	402, 335, 329, 267, 399, 333, 328, 321, 299, 448,
+	449, 450, 313, 361, 327, 362, 314, 339, 338, 340,
+	0, 0, 0, 0, 0, 444, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 418, 0, 0, 0, 0, 0, 0,
+	386, 0, 0, 322, 0, 0, 0, 434, 0, 373,
+	355, 0, 0, 0, 371, 303, 325, 398, 363, 404,
+	388, 417, 367, 364, 259, 389, 293, 336, 270, 272,
+	288, 296, 298, 300, 301, 345, 346, 358, 377, 390,
+	391, 392, 292, 280, 372, 281, 311, 282, 260, 285,
+	284, 286, 379, 287, 262, 359, 396, 0, 307, 368,
+	332, 263, 331, 360, 395, 394, 271, 425, 431, 432,
+	0, 0, 437, 0, 0, 0, 445, 455, 456, 457,
+	459, 460, 461, 462, 0, 0, 0, 0, 439, 0,
+	0, 451, 452, 453, 0, 0, 0, 0, 430, 0,
+	305, 256, 257, 489, 289, 351, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 347, 429, 0,
+	0, 0, 463, 487, 0, 0, 0, 0, 0, 486,
+	357, 0, 376, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 383, 411, 423, 440, 443,
+	0, 0, 0, 0, 261, 442, 0, 0, 0, 0,
+	0, 0, 0, 0, 414, 0, 0, 0, 422, 0,
+	0, 0, 0, 0, 438, 0, 0, 341, 342, 343,
+	344, 308, 0, 278, 441, 366, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 435, 436, 304, 310, 458, 312, 277,
+	356, 306, 420, 319, 0, 446, 0, 447, 0, 0,
+	0, 0, 348, 315, 316, 380, 320, 326, 369, 419,
+	354, 374, 275, 410, 381, 330, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 242,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 393, 0, 247, 283, 294,
+	0, 258, 0, 324, 0, 365, 302, 0, 0, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 0, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 238, 239, 240, 241, 0, 0, 0,
+	248, 249, 250, 251, 252, 253, 254, 255, 243, 244,
+	245, 246, 0, 0, 0, 426, 427, 428, 454, 412,
+	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 481, 490, 468, 469, 470, 471, 472, 473,
+	474, 475, 476, 405, 406, 407, 408, 409, 488, 353,
+	477, 478, 479, 480, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 297, 0, 0, 323,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 464, 0, 465, 0, 0, 0, 0, 0,
+	382, 337, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 196, 0, 0, 0, 0, 0, 0, 273, 197,
+	466, 0, 467, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1888, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 264, 387, 403, 274, 378, 421,
+	279, 385, 269, 352, 375, 0, 0, 266, 401, 384,
+	334, 317, 318, 265, 0, 370, 295, 309, 291, 350,
+	0, 400, 433, 290, 424, 0, 416, 268, 0, 415,
+	349, 397, 402, 335, 329, 267, 399, 333, 328, 321,
+	299, 448, 449, 450, 313, 361, 327, 362, 314, 339,
+	338, 340, 0, 0, 0, 0, 0, 444, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 418, 0, 0, 0, 0,
+	0, 0, 386, 0, 0, 322, 0, 0, 0, 434,
+	0, 373, 355, 0, 0, 0, 371, 303, 325, 398,
+	363, 404, 388, 417, 367, 364, 259, 389, 293, 336,
+	270, 272, 288, 296, 298, 300, 301, 345, 346, 358,
+	377, 390, 391, 392, 292, 280, 372, 281, 311, 282,
+	260, 285, 284, 286, 379, 287, 262, 359, 396, 0,
+	307, 368, 332, 263, 331, 360, 395, 394, 271, 425,
+	431, 432, 0, 0, 437, 0, 0, 0, 445, 455,
+	456, 457, 459, 460, 461, 462, 0, 0, 0, 0,
+	439, 0, 0, 451, 452, 453, 0, 0, 0, 0,
+	430, 0, 305, 256, 257, 489, 289, 351, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 347,
+	429, 0, 0, 0, 463, 487, 0, 0, 0, 0,
+	0, 486, 357, 0, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 383, 411, 423,
+	440, 443, 0, 0, 0, 0, 261, 442, 0, 0,
+	0, 0, 0, 0, 0, 0, 414, 0, 0, 0,
+	422, 0, 0, 0, 0, 0, 438, 0, 0, 341,
+	342, 343, 344, 308, 0, 278, 441, 366, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 435, 436, 304, 310, 458,
+	312, 277, 356, 306, 420, 319, 0, 446, 0, 447,
+	0, 0, 0, 0, 348, 315, 316, 380, 320, 326,
+	369, 419, 354, 374, 275, 410, 381, 330, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 242, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 393, 0, 247,
+	283, 294, 0, 258, 0, 324, 0, 365, 302, 0,
+	0, 205, 206, 207, 208, 209, 210, 211, 212, 213,
+	214, 215, 216, 217, 218, 219, 220, 221, 222, 223,
+	224, 225, 226, 227, 0, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 238, 239, 240, 241, 0,
+	0, 0, 248, 249, 250, 251, 252, 253, 254, 255,
+	243, 244, 245, 246, 0, 0, 0, 426, 427, 428,
+	454, 412, 0, 485, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 481, 490, 468, 469, 470, 471,
+	472, 473, 474, 475, 476, 405, 406, 407, 408, 409,
+	488, 353, 477, 478, 479, 480, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 297, 0,
+	0, 323, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 464, 0, 465, 0, 0, 0,
+	0, 0, 382, 337, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 0, 0, 0, 0, 0, 0,
+	273, 197, 466, 0, 467, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2270, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 264, 387, 403, 274,
+	378, 421, 279, 385, 269, 352, 375, 0, 0, 266,
+	401, 384, 334, 317, 318, 265, 0, 370, 295, 309,
+	291, 350, 0, 400, 433, 290, 424, 0, 416, 268,
+	0, 415, 349, 397, 402, 335, 329, 267, 399, 333,
+	328, 321, 299, 448, 449, 450, 313, 361, 327, 362,
+	314, 339, 338, 340, 0, 0, 0, 0, 0, 444,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 418, 0, 0,
+	0, 0, 0, 0, 386, 0, 0, 322, 0, 0,
+	0, 434, 0, 373, 355, 0, 0, 0, 371, 303,
+	325, 398, 363, 404, 388, 417, 367, 364, 259, 389,
+	293, 336, 270, 272, 288, 296, 298, 300, 301, 345,
+	346, 358, 377, 390, 391, 392, 292, 280, 372, 281,
+	311, 282, 260, 285, 284, 286, 379, 287, 262, 359,
+	396, 0, 307, 368, 332, 263, 331, 360, 395, 394,
+	271, 425, 431, 432, 0, 0, 437, 0, 0, 0,
+	445, 455, 456, 457, 459, 460, 461, 462, 0, 0,
+	0, 0, 439, 0, 0, 451, 452, 453, 0, 0,
+	0, 0, 430, 0, 305, 256, 257, 489, 289, 351,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 347, 429, 0, 0, 0, 463, 487, 0, 0,
+	0, 0, 0, 486, 357, 0, 376, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 383,
+	411, 423, 440, 443, 0, 0, 0, 0, 261, 442,
+	0, 0, 0, 0, 0, 0, 0, 0, 414, 0,
+	0, 0, 422, 0, 0, 0, 0, 0, 438, 0,
+	0, 341, 342, 343, 344, 308, 0, 278, 441, 366,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 435, 436, 304,
+	310, 458, 312, 277, 356, 306, 420, 319, 0, 446,
+	0, 447, 0, 0, 0, 0, 348, 315, 316, 380,
+	320, 326, 369, 419, 354, 374, 275, 410, 381, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 242, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 393,
+	0, 247, 283, 294, 0, 258, 0, 324, 0, 365,
+	302, 0, 0, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 216, 217, 218, 219, 220, 221,
+	222, 223, 224, 225, 226, 227, 0, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 239, 240,
+	241, 0, 0, 0, 248, 249, 250, 251, 252, 253,
+	254, 255, 243, 244, 245, 246, 0, 0, 0, 426,
+	427, 428, 454, 412, 0, 485, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 481, 490, 468, 469,
+	470, 471, 472, 473, 474, 475, 476, 405, 406, 407,
+	408, 409, 488, 353, 477, 478, 479, 480, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	297, 0, 0, 323, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 464, 0, 465, 0,
+	0, 0, 0, 0, 382, 337, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 196, 0, 0, 2268, 0,
+	0, 0, 273, 197, 466, 0, 467, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 264, 387,
+	403, 274, 378, 421, 279, 385, 269, 352, 375, 0,
+	0, 266, 401, 384, 334, 317, 318, 265, 0, 370,
+	295, 309, 291, 350, 0, 400, 433, 290, 424, 0,
+	416, 268, 0, 415, 349, 397, 402, 335, 329, 267,
+	399, 333, 328, 321, 299, 448, 449, 450, 313, 361,
+	327, 362, 314, 339, 338, 340, 0, 0, 0, 0,
+	0, 444, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 418,
+	0, 0, 0, 0, 0, 0, 386, 0, 0, 322,
+	0, 0, 0, 434, 0, 373, 355, 0, 0, 0,
+	371, 303, 325, 398, 363, 404, 388, 417, 367, 364,
+	259, 389, 293, 336, 270, 272, 288, 296, 298, 300,
+	301, 345, 346, 358, 377, 390, 391, 392, 292, 280,
+	372, 281, 311, 282, 260, 285, 284, 286, 379, 287,
+	262, 359, 396, 0, 307, 368, 332, 263, 331, 360,
+	395, 394, 271, 425, 431, 432, 0, 0, 437, 0,
+	0, 0, 445, 455, 456, 457, 459, 460, 461, 462,
+	0, 0, 0, 0, 439, 0, 0, 451, 452, 453,
+	0, 0, 0, 0, 430, 0, 305, 256, 257, 489,
+	289, 351, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 347, 429, 0, 0, 0, 463, 487,
+	0, 0, 0, 0, 0, 486, 357, 0, 376, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 383, 411, 423, 440, 443, 0, 0, 0, 0,
+	261, 442, 0, 0, 0, 0, 0, 0, 0, 0,
+	414, 0, 0, 0, 422, 0, 0, 0, 0, 0,
+	438, 0, 0, 341, 342, 343, 344, 308, 0, 278,
+	441, 366, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	436, 304, 310, 458, 312, 277, 356, 306, 420, 319,
+	0, 446, 0, 447, 0, 0, 0, 0, 348, 315,
+	316, 380, 320, 326, 369, 419, 354, 374, 275, 410,
+	381, 330, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 242, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 393, 0, 247, 283, 294, 0, 258, 0, 324,
+	0, 365, 302, 0, 0, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 0, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	239, 240, 241, 0, 0, 0, 248, 249, 250, 251,
+	252, 253, 254, 255, 243, 244, 245, 246, 0, 0,
+	0, 426, 427, 428, 454, 412, 0, 485, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 490,
+	468, 469, 470, 471, 472, 473, 474, 475, 476, 405,
+	406, 407, 408, 409, 488, 481, 477, 478, 479, 480,
+	0, 0, 0, 0, 0, 0, 2088, 0, 0, 0,
+	0, 0, 353, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 297,
+	0, 0, 323, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 464, 0, 465, 0, 0,
+	0, 0, 0, 382, 337, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 196, 0, 0, 0, 0, 0,
+	0, 273, 197, 466, 0, 467, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 264, 387, 403,
+	274, 378, 421, 279, 385, 269, 352, 375, 0, 0,
+	266, 401, 384, 334, 317, 318, 265, 0, 370, 295,
+	309, 291, 350, 0, 400, 433, 290, 424, 0, 416,
+	268, 0, 415, 349, 397, 402, 335, 329, 267, 399,
+	333, 328, 321, 299, 448, 449, 450, 313, 361, 327,
+	362, 314, 339, 338, 340, 0, 0, 0, 0, 0,
+	444, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 418, 0,
+	0, 0, 0, 0, 0, 386, 0, 0, 322, 0,
+	0, 0, 434, 0, 373, 355, 0, 0, 0, 371,
+	303, 325, 398, 363, 404, 388, 417, 367, 364, 259,
+	389, 293, 336, 270, 272, 288, 296, 298, 300, 301,
+	345, 346, 358, 377, 390, 391, 392, 292, 280, 372,
+	281, 311, 282, 260, 285, 284, 286, 379, 287, 262,
+	359, 396, 0, 307, 368, 332, 263, 331, 360, 395,
+	394, 271, 425, 431, 432, 0, 0, 437, 0, 0,
+	0, 445, 455, 456, 457, 459, 460, 461, 462, 0,
+	0, 0, 0, 439, 0, 0, 451, 452, 453, 0,
+	0, 0, 0, 430, 0, 305, 256, 257, 489, 289,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 347, 429, 0, 0, 0, 463, 487, 0,
+	0, 0, 0, 0, 486, 357, 0, 376, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	383, 411, 423, 440, 443, 0, 0, 0, 0, 261,
+	442, 0, 0, 0, 0, 0, 0, 0, 0, 414,
+	0, 0, 0, 422, 0, 0, 0, 0, 0, 438,
+	0, 0, 341, 342, 343, 344, 308, 0, 278, 441,
+	366, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 436,
+	304, 310, 458, 312, 277, 356, 306, 420, 319, 0,
+	446, 0, 447, 0, 0, 0, 0, 348, 315, 316,
+	380, 320, 326, 369, 419, 354, 374, 275, 410, 381,
+	330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	393, 0, 247, 283, 294, 0, 258, 0, 324, 0,
+	365, 302, 0, 0, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 0, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 239,
+	240, 241, 0, 0, 0, 248, 249, 250, 251, 252,
+	253, 254, 255, 243, 244, 245, 246, 0, 0, 0,
+	426, 427, 428, 454, 412, 0, 485, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 481, 490, 468,
+	469, 470, 471, 472, 473, 474, 475, 476, 405, 406,
+	407, 408, 409, 488, 353, 477, 478, 479, 480, 0,
+	0, 0, 1745, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 0, 323, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 464, 0, 465,
+	0, 0, 0, 0, 0, 382, 337, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 196, 0, 0, 0,
+	0, 0, 0, 273, 197, 466, 0, 467, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 264,
+	387, 403, 274, 378, 421, 279, 385, 269, 352, 375,
+	0, 0, 266, 401, 384, 334, 317, 318, 265, 0,
+	370, 295, 309, 291, 350, 0, 400, 433, 290, 424,
+	0, 416, 268, 0, 415, 349, 397, 402, 335, 329,
+	267, 399, 333, 328, 321, 299, 448, 449, 450, 313,
+	361, 327, 362, 314, 339, 338, 340, 0, 0, 0,
+	0, 0, 444, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	418, 0, 0, 0, 0, 0, 0, 386, 0, 0,
+	322, 0, 0, 0, 434, 0, 373, 355, 0, 0,
+	0, 371, 303, 325, 398, 363, 404, 388, 417, 367,
+	364, 259, 389, 293, 336, 270, 272, 288, 296, 298,
+	300, 301, 345, 346, 358, 377, 390, 391, 392, 292,
+	280, 372, 281, 311, 282, 260, 285, 284, 286, 379,
+	287, 262, 359, 396, 0, 307, 368, 332, 263, 331,
+	360, 395, 394, 271, 425, 431, 432, 0, 0, 437,
+	0, 0, 0, 445, 455, 456, 457, 459, 460, 461,
+	462, 0, 0, 0, 0, 439, 0, 0, 451, 452,
+	453, 0, 0, 0, 0, 430, 0, 305, 256, 257,
+	489, 289, 351, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 347, 429, 0, 0, 0, 463,
+	487, 0, 0, 0, 0, 0, 486, 357, 0, 376,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 383, 411, 423, 440, 443, 0, 0, 0,
+	0, 261, 442, 0, 0, 0, 0, 0, 0, 0,
+	0, 414, 0, 0, 0, 422, 0, 0, 0, 0,
+	0, 438, 0, 0, 341, 342, 343, 344, 308, 0,
+	278, 441, 366, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 436, 304, 310, 458, 312, 277, 356, 306, 420,
+	319, 0, 446, 0, 447, 0, 0, 0, 0, 348,
+	315, 316, 380, 320, 326, 369, 419, 354, 374, 275,
+	410, 381, 330, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 393, 0, 247, 283, 294, 0, 258, 0,
+	324, 0, 365, 302, 0, 0, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 0,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 239, 240, 241, 0, 0, 0, 248, 249, 250,
+	251, 252, 253, 254, 255, 243, 244, 245, 246, 0,
+	0, 0, 426, 427, 428, 454, 412, 0, 485, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 481,
+	490, 468, 469, 470, 471, 472, 473, 474, 475, 476,
+	405, 406, 407, 408, 409, 488, 353, 477, 478, 479,
+	480, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 0, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
+	0, 465, 0, 0, 0, 0, 0, 382, 337, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 196, 0,
+	0, 1298, 0, 0, 0, 273, 197, 466, 0, 467,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 264, 387, 403, 274, 378, 421, 279, 385, 269,
+	352, 375, 0, 0, 266, 401, 384, 334, 317, 318,
+	265, 0, 370, 295, 309, 291, 350, 0, 400, 433,
+	290, 424, 0, 416, 268, 0, 415, 349, 397, 402,
+	335, 329, 267, 399, 333, 328, 321, 299, 448, 449,
+	450, 313, 361, 327, 362, 314, 339, 338, 340, 0,
+	0, 0, 0, 0, 444, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 418, 0, 0, 0, 0, 0, 0, 386,
+	0, 0, 322, 0, 0, 0, 434, 0, 373, 355,
+	0, 0, 0, 371, 303, 325, 398, 363, 404, 388,
+	417, 1654, 364, 259, 389, 293, 336, 270, 272, 288,
+	296, 298, 300, 301, 345, 346, 358, 377, 390, 391,
+	392, 292, 280, 372, 281, 311, 282, 260, 285, 284,
+	286, 379, 287, 262, 359, 396, 0, 307, 368, 332,
+	263, 331, 360, 395, 394, 271, 425, 431, 432, 0,
+	0, 437, 0, 0, 0, 445, 455, 456, 457, 459,
+	460, 461, 462, 0, 0, 0, 0, 439, 0, 0,
+	451, 452, 453, 0, 0, 0, 0, 430, 0, 305,
+	256, 257, 489, 289, 351, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 429, 0, 0,
+	0, 463, 487, 0, 0, 0, 0, 0, 486, 357,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 383, 411, 423, 440, 443, 0,
+	0, 0, 0, 261, 442, 0, 0, 0, 0, 0,
+	0, 0, 0, 414, 0, 0, 0, 422, 0, 0,
+	0, 0, 0, 438, 0, 0, 341, 342, 343, 344,
+	308, 0, 278, 441, 366, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 436, 304, 310, 458, 312, 277, 356,
+	306, 420, 319, 0, 446, 0, 447, 0, 0, 0,
+	0, 348, 315, 316, 380, 320, 326, 369, 419, 354,
+	374, 275, 410, 381, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 393, 0, 247, 283, 294, 0,
+	258, 0, 324, 0, 365, 302, 0, 0, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 0, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 239, 240, 241, 0, 0, 0, 248,
+	249, 250, 251, 252, 253, 254, 255, 243, 244, 245,
+	246, 0, 0, 0, 426, 427, 428, 454, 412, 0,
+	485, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 481, 490, 468, 469, 470, 471, 472, 473, 474,
+	475, 476, 405, 406, 407, 408, 409, 488, 353, 477,
+	478, 479, 480, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 297, 0, 0, 323, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 464, 0, 465, 0, 0, 0, 0, 0, 382,
+	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	196, 0, 0, 0, 0, 0, 0, 273, 197, 466,
+	0, 467, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 264, 387, 403, 274, 378, 421, 279,
+	385, 269, 352, 375, 0, 0, 266, 401, 384, 334,
+	317, 318, 265, 0, 370, 295, 309, 291, 350, 0,
+	400, 433, 290, 424, 0, 416, 268, 0, 415, 349,
+	397, 402, 335, 329, 267, 399, 333, 328, 321, 299,
+	448, 449, 450, 313, 361, 327, 362, 314, 339, 338,
+	340, 0, 0, 0, 0, 0, 444, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 418, 0, 0, 1323, 0, 0,
+	0, 386, 0, 0, 322, 0, 0, 0, 434, 0,
+	373, 355, 0, 0, 0, 371, 303, 325, 398, 363,
+	404, 388, 417, 367, 364, 259, 389, 293, 336, 270,
+	272, 288, 296, 298, 300, 301, 345, 346, 358, 377,
+	390, 391, 392, 292, 280, 372, 281, 311, 282, 260,
+	285, 284, 286, 379, 287, 262, 359, 396, 0, 307,
+	368, 332, 263, 331, 360, 395, 394, 271, 425, 431,
+	432, 0, 0, 437, 0, 0, 0, 445, 455, 456,
+	457, 459, 460, 461, 462, 0, 0, 0, 0, 439,
+	0, 0, 451, 452, 453, 0, 0, 0, 0, 430,
+	0, 305, 256, 257, 489, 289, 351, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 347, 429,
+	0, 0, 0, 463, 487, 0, 0, 0, 0, 0,
+	486, 357, 0, 376, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 383, 411, 423, 440,
+	443, 0, 0, 0, 0, 261, 442, 0, 0, 0,
+	0, 0, 0, 0, 0, 414, 0, 0, 0, 422,
+	0, 0, 0, 0, 0, 438, 0, 0, 341, 342,
+	343, 344, 308, 0, 278, 441, 366, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 436, 304, 310, 458, 312,
+	277, 356, 306, 420, 319, 0, 446, 0, 447, 0,
+	0, 0, 0, 348, 315, 316, 380, 320, 326, 369,
+	419, 354, 374, 275, 410, 381, 330, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 393, 0, 247, 283,
+	294, 0, 258, 0, 324, 0, 365, 302, 0, 0,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 0, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 239, 240, 241, 0, 0,
+	0, 248, 249, 250, 251, 252, 253, 254, 255, 243,
+	244, 245, 246, 0, 0, 0, 426, 427, 428, 454,
+	412, 0, 485, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 481, 490, 468, 469, 470, 471, 472,
+	473, 474, 475, 476, 405, 406, 407, 408, 409, 488,
+	353, 477, 478, 479, 480, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 297, 0, 0,
+	323, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 464, 0, 465, 0, 0, 0, 0,
+	0, 382, 337, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 196, 0, 0, 0, 0, 0, 0, 273,
+	197, 466, 0, 467, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 264, 387, 403, 274, 378,
+	421, 279, 385, 269, 352, 375, 0, 0, 266, 401,
+	384, 334, 317, 318, 265, 0, 370, 295, 309, 291,
+	350, 0, 400, 433, 290, 424, 0, 416, 268, 0,
+	415, 349, 397, 402, 335, 329, 267, 399, 333, 328,
+	321, 299, 448, 449, 450, 313, 361, 327, 362, 314,
+	339, 338, 340, 0, 0, 0, 0, 0, 444, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 418, 0, 0, 0,
+	0, 0, 0, 386, 0, 0, 322, 0, 0, 0,
+	434, 0, 373, 355, 0, 0, 0, 371, 303, 325,
+	398, 363, 404, 388, 417, 367, 364, 259, 389, 293,
+	336, 270, 272, 288, 296, 298, 300, 301, 345, 346,
+	358, 377, 390, 391, 392, 292, 280, 372, 281, 311,
+	282, 260, 285, 284, 286, 379, 287, 262, 359, 396,
+	0, 307, 368, 332, 263, 331, 360, 395, 394, 271,
+	425, 431, 432, 0, 0, 437, 0, 0, 0, 445,
+	455, 456, 457, 459, 460, 461, 462, 0, 0, 0,
+	0, 439, 0, 0, 451, 452, 453, 0, 0, 0,
+	0, 430, 0, 305, 256, 257, 489, 289, 351, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	347, 429, 0, 0, 0, 463, 487, 0, 0, 0,
+	0, 0, 486, 357, 0, 376, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 383, 411,
+	423, 440, 443, 0, 0, 0, 0, 261, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 414, 0, 0,
+	0, 422, 0, 0, 0, 0, 0, 438, 0, 0,
+	341, 342, 343, 344, 308, 0, 278, 441, 366, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 436, 304, 310,
+	458, 312, 277, 356, 306, 420, 319, 0, 446, 0,
+	447, 0, 0, 0, 0, 348, 315, 316, 380, 320,
+	326, 369, 419, 354, 374, 275, 410, 381, 330, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 786, 0, 0, 393, 0,
+	247, 283, 294, 0, 258, 0, 324, 0, 365, 302,
+	0, 0, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 0, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
+	0, 0, 0, 248, 249, 250, 251, 252, 253, 254,
+	255, 243, 244, 245, 246, 0, 0, 0, 426, 427,
+	428, 454, 412, 0, 485, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 481, 490, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 405, 406, 407, 408,
+	409, 488, 353, 477, 478, 479, 480, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 297,
+	0, 0, 323, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 464, 0, 465, 0, 0,
+	0, 0, 0, 382, 337, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 196, 0, 0, 0, 0, 0,
+	0, 273, 197, 466, 0, 467, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 264, 387, 403,
+	274, 378, 421, 279, 385, 269, 352, 375, 0, 0,
+	266, 401, 384, 334, 317, 318, 265, 0, 370, 295,
+	309, 291, 350, 0, 400, 433, 290, 424, 0, 416,
+	268, 0, 415, 349, 397, 402, 335, 329, 267, 399,
+	333, 328, 321, 299, 448, 449, 450, 313, 361, 327,
+	362, 314, 339, 338, 340, 0, 0, 0, 0, 0,
+	444, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 418, 0,
+	0, 0, 0, 0, 0, 386, 0, 0, 322, 0,
+	0, 0, 434, 0, 373, 355, 0, 0, 0, 371,
+	303, 325, 398, 363, 404, 388, 417, 528, 364, 259,
+	389, 293, 336, 270, 272, 288, 296, 298, 300, 301,
+	345, 346, 358, 377, 390, 391, 392, 292, 280, 372,
+	281, 311, 282, 260, 285, 284, 286, 379, 287, 262,
+	359, 396, 0, 307, 368, 332, 263, 331, 360, 395,
+	394, 271, 425, 431, 432, 0, 0, 437, 0, 0,
+	0, 445, 455, 456, 457, 459, 460, 461, 462, 0,
+	0, 0, 0, 439, 0, 0, 451, 452, 453, 0,
+	0, 0, 0, 430, 0, 305, 256, 257, 489, 289,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 347, 429, 0, 0, 0, 463, 487, 0,
+	0, 0, 0, 0, 486, 357, 0, 376, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	383, 411, 423, 440, 443, 0, 0, 0, 0, 261,
+	442, 0, 0, 0, 0, 0, 0, 529, 0, 414,
+	0, 0, 0, 422, 0, 0, 0, 0, 0, 438,
+	0, 0, 341, 342, 343, 344, 308, 0, 278, 441,
+	366, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 436,
+	304, 310, 458, 312, 277, 356, 306, 420, 319, 0,
+	446, 0, 447, 0, 0, 0, 0, 348, 315, 316,
+	380, 320, 326, 369, 419, 354, 374, 275, 410, 381,
+	330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	393, 0, 247, 283, 294, 0, 258, 0, 324, 0,
+	365, 302, 0, 0, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 0, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 239,
+	240, 241, 0, 0, 0, 248, 249, 250, 251, 252,
+	253, 254, 255, 243, 244, 245, 246, 0, 0, 0,
+	426, 427, 428, 454, 412, 0, 485, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 481, 490, 468,
+	469, 470, 471, 472, 473, 474, 475, 476, 405, 406,
+	407, 408, 409, 488, 353, 477, 478, 479, 480, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 0, 323, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 464, 0, 465,
+	0, 0, 0, 0, 0, 382, 337, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 196, 0, 0, 0,
+	0, 0, 0, 273, 197, 466, 0, 467, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 264,
+	387, 403, 274, 378, 421, 279, 385, 269, 352, 375,
+	0, 0, 266, 401, 384, 334, 317, 318, 265, 0,
+	370, 295, 309, 291, 350, 0, 400, 433, 290, 424,
+	0, 416, 268, 0, 415, 349, 397, 402, 335, 329,
+	267, 399, 333, 328, 321, 299, 448, 449, 450, 313,
+	361, 327, 362, 314, 339, 338, 340, 0, 0, 0,
+	0, 0, 444, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 505, 0, 0,
+	418, 0, 0, 0, 0, 0, 0, 386, 0, 0,
+	322, 0, 0, 0, 434, 0, 373, 355, 0, 0,
+	0, 371, 303, 325, 398, 363, 404, 388, 417, 367,
+	364, 259, 389, 293, 336, 270, 272, 288, 296, 298,
+	300, 301, 345, 346, 358, 377, 390, 391, 392, 292,
+	280, 372, 281, 311, 282, 260, 285, 284, 286, 379,
+	287, 262, 359, 396, 0, 307, 368, 332, 263, 331,
+	360, 395, 394, 271, 425, 431, 432, 0, 0, 437,
+	0, 0, 0, 445, 455, 456, 457, 459, 460, 461,
+	462, 0, 0, 0, 0, 439, 0, 0, 451, 452,
+	453, 0, 0, 0, 0, 430, 0, 305, 256, 257,
+	489, 289, 351, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 347, 429, 0, 0, 0, 463,
+	487, 0, 0, 0, 0, 0, 486, 357, 0, 376,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 383, 411, 423, 440, 443, 0, 0, 0,
+	0, 261, 442, 0, 0, 0, 0, 0, 0, 0,
+	0, 414, 0, 0, 0, 422, 0, 0, 0, 0,
+	0, 438, 0, 0, 341, 342, 343, 344, 308, 0,
+	278, 441, 366, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 436, 304, 310, 458, 312, 277, 356, 306, 420,
+	319, 0, 446, 0, 447, 0, 0, 0, 0, 348,
+	315, 316, 380, 320, 326, 369, 419, 354, 374, 275,
+	410, 381, 330, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 393, 0, 247, 283, 294, 0, 258, 0,
+	324, 0, 365, 302, 0, 0, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 0,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 239, 240, 241, 0, 0, 0, 248, 249, 250,
+	251, 252, 253, 254, 255, 243, 244, 245, 246, 0,
+	0, 0, 426, 427, 428, 454, 412, 0, 485, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 481,
+	490, 468, 469, 470, 471, 472, 473, 474, 475, 476,
+	405, 406, 407, 408, 409, 488, 353, 477, 478, 479,
+	480, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 495, 297, 0, 0, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
+	0, 465, 0, 0, 0, 0, 0, 382, 337, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 196, 0,
+	0, 0, 0, 0, 0, 273, 197, 466, 0, 467,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 264, 387, 403, 274, 378, 421, 279, 385, 269,
+	352, 375, 0, 0, 266, 401, 384, 334, 317, 318,
+	265, 0, 370, 295, 309, 291, 350, 0, 400, 433,
+	290, 424, 0, 416, 268, 0, 415, 349, 397, 402,
+	335, 329, 267, 399, 333, 328, 321, 299, 448, 449,
+	450, 313, 361, 327, 362, 314, 339, 338, 340, 0,
+	0, 0, 0, 0, 444, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 418, 0, 0, 0, 0, 0, 0, 386,
+	0, 0, 322, 0, 0, 0, 434, 0, 373, 355,
+	0, 0, 0, 371, 303, 325, 398, 363, 404, 388,
+	417, 367, 364, 259, 389, 293, 336, 270, 272, 288,
+	296, 298, 300, 301, 345, 346, 358, 377, 390, 391,
+	392, 292, 280, 372, 281, 311, 282, 260, 285, 284,
+	286, 379, 287, 262, 359, 396, 0, 307, 368, 332,
+	263, 331, 360, 395, 394, 271, 425, 431, 432, 0,
+	0, 437, 0, 0, 0, 445, 455, 456, 457, 459,
+	460, 461, 462, 0, 0, 0, 0, 439, 0, 0,
+	451, 452, 453, 0, 0, 0, 0, 430, 0, 305,
+	256, 257, 489, 289, 351, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 429, 0, 0,
+	0, 463, 487, 0, 0, 0, 0, 0, 486, 357,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 383, 411, 423, 440, 443, 0,
+	0, 0, 0, 261, 442, 0, 0, 0, 0, 0,
+	0, 0, 0, 414, 0, 0, 0, 422, 0, 0,
+	0, 0, 0, 438, 0, 0, 341, 342, 343, 344,
+	308, 0, 278, 441, 366, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 436, 304, 310, 458, 312, 277, 356,
+	306, 420, 319, 0, 446, 0, 447, 0, 0, 0,
+	0, 348, 315, 316, 380, 320, 326, 369, 419, 354,
+	374, 275, 410, 381, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 393, 0, 247, 283, 294, 0,
+	258, 0, 324, 0, 365, 302, 0, 0, 205, 206,
+	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 0, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 239, 240, 241, 0, 0, 0, 248,
+	249, 250, 251, 252, 253, 254, 255, 243, 244, 245,
+	246, 0, 0, 0, 426, 427, 428, 454, 412, 0,
+	485, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 481, 490, 468, 469, 470, 471, 472, 473, 474,
+	475, 476, 405, 406, 407, 408, 409, 488, 353, 477,
+	478, 479, 480, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 297, 0, 0, 323, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 464, 0, 465, 0, 0, 0, 0, 0, 382,
+	337, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	196, 0, 0, 0, 0, 0, 0, 273, 197, 466,
+	0, 467, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 264, 387, 403, 274, 378, 421, 279,
+	385, 269, 352, 375, 0, 0, 266, 401, 384, 334,
+	317, 318, 265, 0, 370, 295, 309, 291, 350, 0,
+	400, 433, 290, 424, 0, 416, 268, 0, 415, 349,
+	397, 402, 335, 329, 267, 399, 333, 328, 321, 299,
+	448, 449, 450, 313, 361, 327, 362, 314, 339, 338,
+	340, 0, 0, 0, 0, 0, 444, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 418, 0, 0, 0, 0, 0,
+	0, 386, 0, 0, 322, 0, 0, 0, 434, 0,
+	373, 355, 0, 0, 0, 371, 303, 325, 398, 363,
+	404, 388, 417, 367, 364, 259, 389, 293, 336, 270,
+	272, 288, 296, 298, 300, 301, 345, 346, 358, 377,
+	390, 391, 392, 292, 280, 372, 281, 311, 282, 260,
+	285, 284, 286, 379, 287, 262, 359, 396, 0, 307,
+	368, 332, 263, 331, 360, 395, 394, 271, 425, 431,
+	432, 0, 0, 437, 0, 0, 0, 445, 455, 456,
+	457, 459, 460, 461, 462, 0, 0, 0, 0, 439,
+	0, 0, 451, 452, 453, 0, 0, 0, 0, 430,
+	0, 305, 256, 257, 489, 289, 351, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 347, 429,
+	0, 0, 0, 463, 487, 0, 0, 0, 0, 0,
+	486, 357, 0, 376, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 383, 411, 423, 440,
+	443, 0, 0, 0, 0, 261, 442, 0, 0, 0,
+	0, 0, 0, 0, 0, 414, 0, 0, 0, 422,
+	0, 0, 0, 0, 0, 438, 0, 0, 341, 342,
+	343, 344, 308, 0, 278, 441, 366, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 436, 304, 310, 458, 312,
+	277, 356, 306, 420, 319, 0, 446, 0, 447, 0,
+	0, 0, 0, 348, 315, 316, 380, 320, 326, 369,
+	419, 354, 374, 275, 410, 381, 330, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	242, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 393, 0, 247, 283,
+	294, 0, 258, 0, 324, 0, 365, 302, 0, 0,
+	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 219, 220, 221, 222, 223, 224,
+	225, 226, 227, 0, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 239, 240, 241, 0, 0,
+	0, 248, 249, 250, 251, 252, 253, 254, 255, 243,
+	244, 245, 246, 0, 0, 0, 426, 427, 428, 454,
+	412, 0, 485, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 481, 490, 468, 469, 470, 471, 472,
+	473, 474, 475, 476, 405, 406, 407, 408, 409, 488,
+	353, 477, 478, 479, 480, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 297, 0, 0,
+	323, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 464, 0, 465, 0, 0, 0, 0,
+	0, 382, 337, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 196, 0, 0, 0, 0, 0, 0, 273,
+	197, 466, 0, 467, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 264, 387, 403, 274, 378,
+	421, 279, 385, 269, 352, 375, 0, 0, 266, 401,
+	384, 334, 317, 318, 265, 0, 370, 295, 309, 291,
+	350, 0, 400, 433, 290, 424, 0, 416, 268, 0,
+	415, 349, 397, 402, 335, 329, 267, 399, 333, 328,
+	321, 299, 448, 449, 450, 313, 361, 327, 362, 314,
+	339, 338, 340, 0, 0, 0, 0, 0, 444, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 418, 0, 0, 0,
+	0, 0, 0, 386, 0, 0, 322, 0, 0, 0,
+	434, 0, 373, 355, 0, 0, 0, 371, 303, 325,
+	398, 363, 404, 388, 417, 367, 364, 259, 389, 293,
+	336, 270, 272, 288, 296, 298, 300, 301, 345, 346,
+	358, 377, 390, 391, 392, 292, 280, 372, 281, 311,
+	282, 260, 285, 284, 286, 379, 287, 262, 359, 396,
+	0, 307, 368, 332, 263, 331, 360, 395, 394, 271,
+	425, 431, 432, 0, 0, 437, 0, 0, 0, 445,
+	455, 456, 457, 459, 460, 461, 462, 0, 0, 0,
+	0, 439, 0, 0, 451, 452, 453, 0, 0, 0,
+	0, 430, 0, 305, 256, 257, 489, 289, 351, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	347, 429, 0, 0, 0, 463, 487, 0, 0, 0,
+	0, 0, 486, 357, 0, 376, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 383, 411,
+	423, 440, 443, 0, 0, 0, 0, 261, 442, 0,
+	0, 0, 0, 0, 0, 0, 0, 414, 0, 0,
+	0, 422, 0, 0, 0, 0, 0, 438, 0, 0,
+	341, 342, 343, 344, 308, 0, 278, 441, 366, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 435, 436, 304, 310,
+	458, 312, 277, 356, 306, 420, 319, 0, 446, 0,
+	447, 0, 0, 0, 0, 348, 315, 316, 380, 320,
+	326, 369, 419, 354, 374, 275, 410, 381, 330, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 242, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 246,
-	367, 383, 256, 358, 396, 261, 365, 251, 332, 355,
-	0, 0, 248, 381, 364, 314, 297, 298, 247, 0,
-	350, 276, 289, 273, 330, 0, 380, 408, 272, 399,
-	0, 391, 250, 0, 390, 329, 377, 382, 315, 309,
-	249, 379, 313, 308, 301, 280, 423, 293, 341, 307,
-	342, 294, 319, 318, 320, 0, 0, 0, 0, 0,
-	419, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 393, 0,
-	0, 0, 0, 0, 0, 366, 0, 0, 302, 0,
-	0, 0, 409, 0, 353, 335, 0, 0, 0, 351,
-	305, 378, 343, 384, 368, 392, 347, 344, 241, 369,
-	275, 316, 252, 254, 270, 277, 279, 281, 282, 325,
-	326, 338, 357, 370, 371, 372, 274, 262, 352, 263,
-	291, 264, 242, 267, 266, 268, 359, 269, 244, 339,
-	376, 0, 287, 348, 312, 245, 311, 340, 375, 374,
-	253, 400, 406, 407, 0, 0, 412, 0, 0, 0,
-	420, 425, 426, 427, 429, 430, 431, 432, 0, 0,
-	0, 0, 414, 0, 0, 0, 0, 0, 0, 405,
-	285, 238, 239, 445, 271, 331, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 327, 404, 0,
-	0, 0, 433, 444, 0, 0, 0, 0, 0, 443,
-	337, 0, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 363, 386, 398, 415, 418,
-	0, 0, 0, 0, 243, 417, 0, 0, 0, 0,
-	0, 0, 0, 0, 389, 0, 0, 0, 397, 0,
-	0, 0, 0, 0, 413, 0, 0, 321, 322, 323,
-	324, 288, 0, 260, 416, 346, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 410, 411, 284, 290, 428, 292, 259,
-	336, 286, 395, 299, 0, 421, 0, 422, 0, 0,
-	0, 0, 328, 295, 296, 360, 300, 306, 349, 394,
-	334, 354, 257, 385, 361, 310, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 373, 0, 237, 265, 0,
-	240, 0, 304, 0, 345, 283, 0, 0, 195, 196,
-	197, 198, 199, 200, 201, 202, 203, 204, 205, 206,
+	247, 283, 294, 0, 258, 0, 324, 0, 365, 302,
+	0, 0, 205, 206, 207, 208, 209, 210, 211, 212,
+	213, 214, 215, 216, 217, 218, 219, 220, 221, 222,
+	223, 224, 225, 226, 227, 0, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
+	0, 0, 0, 248, 249, 250, 251, 252, 253, 254,
+	255, 243, 244, 245, 246, 0, 0, 0, 426, 427,
+	428, 454, 412, 0, 485, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 481, 490, 468, 469, 470,
+	471, 472, 473, 474, 475, 476, 405, 406, 407, 408,
+	409, 488, 353, 3417, 478, 479, 480, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 297,
+	0, 0, 323, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 464, 0, 465, 0, 0,
+	0, 0, 0, 382, 337, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 196, 0, 0, 0, 0, 0,
+	0, 273, 197, 466, 0, 467, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 264, 387, 1278,
+	274, 378, 421, 279, 385, 269, 352, 375, 0, 0,
+	266, 401, 384, 334, 317, 318, 265, 0, 370, 295,
+	309, 291, 350, 0, 400, 433, 290, 424, 0, 416,
+	268, 0, 415, 349, 397, 402, 335, 329, 267, 399,
+	333, 328, 321, 299, 448, 449, 450, 313, 361, 327,
+	362, 314, 339, 338, 340, 0, 0, 0, 0, 0,
+	444, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 418, 0,
+	0, 0, 0, 0, 0, 386, 0, 0, 322, 0,
+	0, 0, 434, 0, 373, 355, 0, 0, 0, 371,
+	303, 325, 398, 363, 404, 388, 417, 367, 364, 259,
+	389, 293, 336, 270, 272, 288, 296, 298, 300, 301,
+	345, 346, 358, 377, 390, 391, 392, 292, 280, 372,
+	281, 311, 282, 260, 285, 284, 286, 379, 287, 262,
+	359, 396, 0, 307, 368, 332, 263, 331, 360, 395,
+	394, 271, 425, 431, 432, 0, 0, 437, 0, 0,
+	0, 445, 455, 456, 457, 459, 460, 461, 462, 0,
+	0, 0, 0, 439, 0, 0, 451, 452, 453, 0,
+	0, 0, 0, 430, 0, 305, 256, 257, 489, 289,
+	351, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 347, 429, 0, 0, 0, 463, 487, 0,
+	0, 0, 0, 0, 486, 357, 0, 376, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	383, 411, 423, 440, 443, 0, 0, 0, 0, 261,
+	442, 0, 0, 0, 0, 0, 0, 0, 0, 414,
+	0, 0, 0, 422, 0, 0, 0, 0, 0, 438,
+	0, 0, 341, 342, 343, 344, 308, 0, 278, 441,
+	366, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 436,
+	304, 310, 458, 312, 277, 356, 306, 420, 319, 0,
+	446, 0, 447, 0, 0, 0, 0, 348, 315, 316,
+	380, 320, 326, 369, 419, 354, 374, 275, 410, 381,
+	330, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 242, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	393, 0, 247, 283, 294, 0, 258, 0, 324, 0,
+	365, 302, 0, 0, 205, 206, 207, 208, 209, 210,
+	211, 212, 213, 214, 215, 216, 217, 218, 219, 220,
+	221, 222, 223, 224, 225, 226, 227, 0, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 239,
+	240, 241, 0, 0, 0, 248, 249, 250, 251, 252,
+	253, 254, 255, 243, 244, 245, 246, 0, 0, 0,
+	426, 427, 428, 454, 412, 0, 485, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 481, 490, 468,
+	469, 470, 471, 472, 473, 474, 475, 476, 405, 406,
+	407, 408, 409, 488, 353, 477, 478, 479, 480, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 297, 0, 0, 323, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 464, 0, 465,
+	0, 0, 0, 0, 0, 382, 337, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 196, 0, 0, 0,
+	0, 0, 0, 273, 197, 466, 0, 467, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 264,
+	387, 1276, 274, 378, 421, 279, 385, 269, 352, 375,
+	0, 0, 266, 401, 384, 334, 317, 318, 265, 0,
+	370, 295, 309, 291, 350, 0, 400, 433, 290, 424,
+	0, 416, 268, 0, 415, 349, 397, 402, 335, 329,
+	267, 399, 333, 328, 321, 299, 448, 449, 450, 313,
+	361, 327, 362, 314, 339, 338, 340, 0, 0, 0,
+	0, 0, 444, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	418, 0, 0, 0, 0, 0, 0, 386, 0, 0,
+	322, 0, 0, 0, 434, 0, 373, 355, 0, 0,
+	0, 371, 303, 325, 398, 363, 404, 388, 417, 367,
+	364, 259, 389, 293, 336, 270, 272, 288, 296, 298,
+	300, 301, 345, 346, 358, 377, 390, 391, 392, 292,
+	280, 372, 281, 311, 282, 260, 285, 284, 286, 379,
+	287, 262, 359, 396, 0, 307, 368, 332, 263, 331,
+	360, 395, 394, 271, 425, 431, 432, 0, 0, 437,
+	0, 0, 0, 445, 455, 456, 457, 459, 460, 461,
+	462, 0, 0, 0, 0, 439, 0, 0, 451, 452,
+	453, 0, 0, 0, 0, 430, 0, 305, 256, 257,
+	489, 289, 351, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 347, 429, 0, 0, 0, 463,
+	487, 0, 0, 0, 0, 0, 486, 357, 0, 376,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 383, 411, 423, 440, 443, 0, 0, 0,
+	0, 261, 442, 0, 0, 0, 0, 0, 0, 0,
+	0, 414, 0, 0, 0, 422, 0, 0, 0, 0,
+	0, 438, 0, 0, 341, 342, 343, 344, 308, 0,
+	278, 441, 366, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	435, 436, 304, 310, 458, 312, 277, 356, 306, 420,
+	319, 0, 446, 0, 447, 0, 0, 0, 0, 348,
+	315, 316, 380, 320, 326, 369, 419, 354, 374, 275,
+	410, 381, 330, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 242, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 393, 0, 247, 283, 294, 0, 258, 0,
+	324, 0, 365, 302, 0, 0, 205, 206, 207, 208,
+	209, 210, 211, 212, 213, 214, 215, 216, 217, 218,
+	219, 220, 221, 222, 223, 224, 225, 226, 227, 0,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 239, 240, 241, 0, 0, 0, 248, 249, 250,
+	251, 252, 253, 254, 255, 243, 244, 245, 246, 0,
+	0, 0, 426, 427, 428, 454, 412, 0, 485, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 481,
+	490, 468, 469, 470, 471, 472, 473, 474, 475, 476,
+	405, 406, 407, 408, 409, 488, 353, 477, 478, 479,
+	480, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 297, 0, 0, 323, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 464,
+	0, 465, 0, 0, 0, 0, 0, 382, 337, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 196, 0,
+	0, 0, 0, 0, 0, 273, 197, 466, 0, 467,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 264, 387, 403, 274, 378, 421, 279, 385, 269,
+	352, 375, 0, 0, 266, 401, 384, 334, 317, 318,
+	265, 0, 370, 295, 309, 291, 350, 0, 400, 433,
+	290, 424, 0, 416, 268, 0, 415, 349, 397, 402,
+	335, 329, 267, 399, 333, 328, 321, 299, 448, 449,
+	450, 313, 361, 327, 362, 314, 339, 338, 340, 0,
+	0, 0, 0, 0, 444, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 418, 0, 0, 0, 0, 0, 0, 386,
+	0, 0, 322, 0, 0, 0, 434, 0, 373, 355,
+	0, 0, 0, 371, 303, 325, 398, 363, 404, 388,
+	417, 367, 364, 259, 389, 293, 336, 270, 272, 570,
+	296, 298, 300, 301, 345, 346, 358, 377, 390, 391,
+	392, 292, 280, 372, 281, 311, 282, 260, 285, 284,
+	286, 379, 287, 262, 359, 396, 0, 307, 368, 332,
+	263, 331, 360, 395, 394, 271, 425, 431, 432, 0,
+	0, 437, 0, 0, 0, 445, 455, 456, 457, 459,
+	460, 461, 462, 0, 0, 0, 0, 439, 0, 0,
+	451, 452, 453, 0, 0, 0, 0, 430, 0, 305,
+	256, 257, 489, 289, 351, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 347, 429, 0, 0,
+	0, 463, 487, 0, 0, 0, 0, 0, 486, 357,
+	0, 376, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 383, 411, 423, 440, 443, 0,
+	0, 0, 0, 261, 442, 0, 0, 0, 0, 0,
+	0, 0, 0, 414, 0, 0, 0, 422, 0, 0,
+	0, 0, 0, 438, 0, 0, 341, 342, 343, 344,
+	308, 0, 278, 441, 366, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 435, 436, 304, 310, 458, 312, 277, 356,
+	306, 420, 319, 0, 446, 0, 447, 0, 0, 0,
+	0, 348, 315, 316, 380, 320, 326, 369, 419, 354,
+	374, 275, 410, 381, 330, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 242, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1035, 1036, 1037, 1034, 0, 0, 0,
+	0, 0, 0, 0, 393, 0, 247, 283, 294, 0,
+	258, 0, 324, 0, 365, 302, 0, 0, 205, 206,
 	207, 208, 209, 210, 211, 212, 213, 214, 215, 216,
-	217, 0, 218, 219, 220, 221, 222, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 333, 0, 0, 233,
-	234, 235, 236, 0, 0, 0, 401, 402, 403, 424,
-	387, 0, 442, 278, 0, 0, 303, 0, 0, 0,
-	0, 0, 0, 0, 446, 438, 0, 0, 0, 434,
-	0, 435, 0, 0, 0, 0, 0, 362, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 0,
-	0, 0, 0, 0, 0, 255, 188, 436, 0, 437,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 258,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 246, 367, 1199, 256, 358, 396, 261, 365, 251,
-	332, 355, 0, 0, 248, 381, 364, 314, 297, 298,
-	247, 0, 350, 276, 289, 273, 330, 0, 380, 408,
-	272, 399, 0, 391, 250, 0, 390, 329, 377, 382,
-	315, 309, 249, 379, 313, 308, 301, 280, 423, 293,
-	341, 307, 342, 294, 319, 318, 320, 0, 0, 0,
-	0, 0, 419, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	393, 0, 0, 0, 0, 0, 0, 366, 0, 0,
-	302, 0, 0, 0, 409, 0, 353, 335, 0, 0,
-	0, 351, 305, 378, 343, 384, 368, 392, 347, 344,
-	241, 369, 275, 316, 252, 254, 270, 277, 279, 281,
-	282, 325, 326, 338, 357, 370, 371, 372, 274, 262,
-	352, 263, 291, 264, 242, 267, 266, 268, 359, 269,
-	244, 339, 376, 0, 287, 348, 312, 245, 311, 340,
-	375, 374, 253, 400, 406, 407, 0, 0, 412, 0,
-	0, 0, 420, 425, 426, 427, 429, 430, 431, 432,
-	0, 0, 0, 0, 414, 0, 0, 0, 0, 0,
-	0, 405, 285, 238, 239, 445, 271, 331, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	404, 0, 0, 0, 433, 444, 0, 0, 0, 0,
-	0, 443, 337, 0, 356, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 363, 386, 398,
-	415, 418, 0, 0, 0, 0, 243, 417, 0, 0,
-	0, 0, 0, 0, 0, 0, 389, 0, 0, 0,
-	397, 0, 0, 0, 0, 0, 413, 0, 0, 321,
-	322, 323, 324, 288, 0, 260, 416, 346, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 410, 411, 284, 290, 428,
-	292, 259, 336, 286, 395, 299, 0, 421, 0, 422,
-	0, 0, 0, 0, 328, 295, 296, 360, 300, 306,
-	349, 394, 334, 354, 257, 385, 361, 310, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 373, 0, 237,
-	265, 0, 240, 0, 304, 0, 345, 283, 0, 0,
-	195, 196, 197, 198, 199, 200, 201, 202, 203, 204,
-	205, 206, 207, 208, 209, 210, 211, 212, 213, 214,
-	215, 216, 217, 0, 218, 219, 220, 221, 222, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 333, 0,
-	0, 233, 234, 235, 236, 0, 0, 0, 401, 402,
-	403, 424, 387, 0, 442, 278, 0, 0, 303, 0,
-	0, 0, 0, 0, 0, 0, 446, 438, 0, 0,
-	0, 434, 0, 435, 0, 0, 0, 0, 0, 362,
-	317, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 0, 0, 0, 0, 0, 0, 255, 188, 436,
-	0, 437, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 258, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 246, 367, 1197, 256, 358, 396, 261,
-	365, 251, 332, 355, 0, 0, 248, 381, 364, 314,
-	297, 298, 247, 0, 350, 276, 289, 273, 330, 0,
-	380, 408, 272, 399, 0, 391, 250, 0, 390, 329,
-	377, 382, 315, 309, 249, 379, 313, 308, 301, 280,
-	423, 293, 341, 307, 342, 294, 319, 318, 320, 0,
-	0, 0, 0, 0, 419, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 393, 0, 0, 0, 0, 0, 0, 366,
-	0, 0, 302, 0, 0, 0, 409, 0, 353, 335,
-	0, 0, 0, 351, 305, 378, 343, 384, 368, 392,
-	347, 344, 241, 369, 275, 316, 252, 254, 270, 277,
-	279, 281, 282, 325, 326, 338, 357, 370, 371, 372,
-	274, 262, 352, 263, 291, 264, 242, 267, 266, 268,
-	359, 269, 244, 339, 376, 0, 287, 348, 312, 245,
-	311, 340, 375, 374, 253, 400, 406, 407, 0, 0,
-	412, 0, 0, 0, 420, 425, 426, 427, 429, 430,
-	431, 432, 0, 0, 0, 0, 414, 0, 0, 0,
-	0, 0, 0, 405, 285, 238, 239, 445, 271, 331,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 327, 404, 0, 0, 0, 433, 444, 0, 0,
-	0, 0, 0, 443, 337, 0, 356, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 363,
-	386, 398, 415, 418, 0, 0, 0, 0, 243, 417,
-	0, 0, 0, 0, 0, 0, 0, 0, 389, 0,
-	0, 0, 397, 0, 0, 0, 0, 0, 413, 0,
-	0, 321, 322, 323, 324, 288, 0, 260, 416, 346,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 410, 411, 284,
-	290, 428, 292, 259, 336, 286, 395, 299, 0, 421,
-	0, 422, 0, 0, 0, 0, 328, 295, 296, 360,
-	300, 306, 349, 394, 334, 354, 257, 385, 361, 310,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 373,
-	0, 237, 265, 0, 240, 0, 304, 0, 345, 283,
-	0, 0, 195, 196, 197, 198, 199, 200, 201, 202,
-	203, 204, 205, 206, 207, 208, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 0, 218, 219, 220, 221,
-	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	333, 0, 0, 233, 234, 235, 236, 0, 0, 0,
-	401, 402, 403, 424, 387, 0, 442, 278, 0, 0,
-	303, 0, 0, 0, 0, 0, 0, 0, 446, 438,
-	0, 0, 0, 434, 0, 435, 0, 0, 0, 0,
-	0, 362, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 0, 0, 0, 0, 0, 0, 255,
-	188, 436, 0, 437, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 258, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 246, 367, 383, 256, 358,
-	396, 261, 365, 251, 332, 355, 0, 0, 248, 381,
-	364, 314, 297, 298, 247, 0, 350, 276, 289, 273,
-	330, 0, 380, 408, 272, 399, 0, 391, 250, 0,
-	390, 329, 377, 382, 315, 309, 249, 379, 313, 308,
-	301, 280, 423, 293, 341, 307, 342, 294, 319, 318,
-	320, 0, 0, 0, 0, 0, 419, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 393, 0, 0, 0, 0, 0,
-	0, 366, 0, 0, 302, 0, 0, 0, 409, 0,
-	353, 335, 0, 0, 0, 351, 305, 378, 343, 384,
-	368, 392, 347, 344, 241, 369, 275, 316, 252, 254,
-	525, 277, 279, 281, 282, 325, 326, 338, 357, 370,
-	371, 372, 274, 262, 352, 263, 291, 264, 242, 267,
-	266, 268, 359, 269, 244, 339, 376, 0, 287, 348,
-	312, 245, 311, 340, 375, 374, 253, 400, 406, 407,
-	0, 0, 412, 0, 0, 0, 420, 425, 426, 427,
-	429, 430, 431, 432, 0, 0, 0, 0, 414, 0,
-	0, 0, 0, 0, 0, 405, 285, 238, 239, 445,
-	271, 331, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 327, 404, 0, 0, 0, 433, 444,
-	0, 0, 0, 0, 0, 443, 337, 0, 356, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 363, 386, 398, 415, 418, 0, 0, 0, 0,
-	243, 417, 0, 0, 0, 0, 0, 0, 0, 0,
-	389, 0, 0, 0, 397, 0, 0, 0, 0, 0,
-	413, 0, 0, 321, 322, 323, 324, 288, 0, 260,
-	416, 346, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 720, 0, 721, 0, 410,
-	411, 284, 290, 428, 292, 259, 336, 286, 395, 299,
-	0, 421, 0, 422, 0, 0, 0, 0, 328, 295,
-	296, 360, 300, 306, 349, 394, 334, 354, 257, 385,
-	361, 310, 0, 701, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 232, 714, 0, 717, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 373, 0, 237, 265, 0, 240, 0, 304, 0,
-	345, 283, 0, 0, 195, 196, 197, 198, 199, 200,
-	201, 202, 203, 204, 205, 206, 207, 208, 209, 210,
-	211, 212, 213, 214, 215, 216, 217, 0, 218, 219,
-	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 0, 0, 1630, 233, 234, 235, 236, 1592,
-	0, 0, 401, 402, 403, 424, 387, 0, 442, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	446, 438, 0, 0, 0, 0, 0, 0, 1632, 1601,
-	0, 0, 1630, 0, 0, 0, 0, 0, 1633, 1634,
-	0, 0, 716, 0, 710, 0, 697, 0, 0, 0,
-	0, 0, 0, 713, 712, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1600, 0, 1632, 0, 0, 0,
-	694, 0, 0, 0, 702, 0, 0, 0, 0, 1607,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 3289, 0, 0,
-	0, 0, 0, 719, 0, 0, 0, 1607, 709, 0,
-	0, 0, 708, 0, 0, 0, 0, 0, 693, 0,
-	0, 0, 700, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1623, 0, 0, 698,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 695, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1623, 0, 718, 0, 0, 0,
-	0, 1630, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 699, 0, 1591, 1593, 1590, 0, 1587, 0,
-	0, 0, 0, 1611, 0, 1632, 0, 0, 0, 0,
-	0, 0, 0, 0, 1617, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1605, 1639, 1630, 0, 1606, 1608,
-	1610, 1611, 1612, 1613, 1614, 1618, 1619, 1620, 1622, 1625,
-	1626, 1627, 1617, 0, 0, 0, 1607, 0, 0, 1615,
-	1624, 1616, 0, 0, 0, 0, 0, 715, 0, 0,
-	1632, 1595, 1605, 1639, 0, 0, 1606, 1608, 1610, 0,
-	1612, 1613, 1614, 1618, 1619, 1620, 1622, 1625, 1626, 1627,
-	0, 0, 0, 1631, 0, 0, 0, 1615, 1624, 1616,
-	0, 0, 0, 0, 0, 0, 705, 0, 0, 0,
-	0, 0, 0, 0, 1588, 1589, 0, 0, 0, 3034,
-	0, 1607, 0, 1623, 0, 0, 0, 0, 0, 0,
-	0, 1631, 1628, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1604,
-	504, 503, 510, 500, 0, 0, 1603, 0, 0, 0,
-	0, 0, 507, 508, 0, 509, 513, 0, 0, 495,
-	1628, 0, 0, 0, 0, 0, 0, 0, 0, 518,
-	1621, 0, 0, 0, 0, 0, 0, 1604, 1623, 1609,
-	0, 0, 0, 0, 1603, 0, 0, 0, 0, 0,
-	1611, 1636, 1635, 0, 0, 0, 0, 0, 0, 0,
-	0, 1617, 0, 0, 0, 0, 0, 0, 1621, 0,
-	0, 0, 522, 0, 0, 524, 0, 1609, 0, 0,
-	523, 1605, 1639, 0, 0, 1606, 1608, 1610, 0, 1612,
-	1613, 1614, 1618, 1619, 1620, 1622, 1625, 1626, 1627, 0,
-	0, 0, 0, 1597, 0, 0, 1615, 1624, 1616, 0,
-	0, 0, 0, 0, 0, 1611, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1617, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1638,
-	1631, 0, 1637, 0, 0, 0, 1605, 1639, 0, 0,
-	1606, 1608, 1610, 0, 1612, 1613, 1614, 1618, 1619, 1620,
-	1622, 1625, 1626, 1627, 0, 0, 0, 0, 0, 0,
-	0, 1615, 1624, 1616, 0, 0, 0, 0, 0, 1628,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1604, 0, 0, 0,
-	0, 0, 0, 1603, 0, 1631, 0, 0, 0, 0,
-	0, 0, 0, 496, 498, 497, 0, 0, 0, 0,
-	0, 0, 0, 502, 0, 0, 0, 1621, 0, 0,
-	0, 0, 0, 0, 0, 506, 1609, 0, 0, 0,
-	0, 0, 521, 0, 1628, 0, 0, 0, 0, 499,
-	0, 0, 0, 490, 0, 0, 0, 0, 0, 0,
-	0, 1604, 0, 0, 0, 0, 0, 0, 1603, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1621, 0, 0, 0, 0, 0, 0, 0,
-	0, 1609, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 501, 505, 511, 0, 512, 514, 0,
-	0, 515, 516, 517, 0, 0, 519, 520,
+	217, 218, 219, 220, 221, 222, 223, 224, 225, 226,
+	227, 0, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 239, 240, 241, 0, 0, 0, 248,
+	249, 250, 251, 252, 253, 254, 255, 243, 244, 245,
+	246, 0, 1516, 0, 426, 427, 428, 454, 412, 0,
+	485, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 490, 468, 469, 470, 471, 472, 473, 474,
+	475, 476, 405, 406, 407, 408, 409, 488, 0, 477,
+	478, 479, 480, 174, 55, 166, 139, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 174, 55, 166, 139,
+	0, 167, 0, 0, 0, 0, 0, 0, 159, 0,
+	0, 0, 168, 0, 167, 0, 0, 0, 0, 0,
+	0, 159, 0, 0, 0, 168, 0, 0, 0, 0,
+	0, 118, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 118, 0, 106, 0, 0, 0,
+	0, 0, 171, 0, 0, 0, 0, 0, 0, 106,
+	0, 0, 0, 0, 0, 171, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 174, 55,
+	166, 139, 3024, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 167, 0, 0, 188,
+	0, 0, 0, 159, 1512, 0, 0, 168, 0, 0,
+	1509, 0, 0, 0, 1511, 1508, 1510, 1514, 1515, 0,
+	3036, 0, 1513, 0, 0, 0, 118, 0, 124, 125,
+	0, 126, 127, 3027, 0, 0, 0, 0, 0, 0,
+	0, 106, 0, 3022, 0, 0, 0, 171, 3044, 3045,
+	0, 0, 0, 0, 3023, 0, 0, 0, 0, 566,
+	0, 0, 569, 0, 0, 0, 0, 568, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 3028, 0, 0, 0, 0, 0, 0, 138, 165,
+	172, 0, 104, 0, 0, 0, 0, 0, 0, 0,
+	0, 138, 165, 172, 0, 104, 0, 0, 0, 0,
+	164, 158, 157, 0, 0, 189, 0, 61, 0, 0,
+	0, 0, 0, 164, 158, 157, 0, 0, 0, 0,
+	61, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1497, 1498, 1499, 1500, 1501, 1502, 1503,
+	1504, 1505, 1506, 1507, 1519, 1520, 1521, 1522, 1523, 1524,
+	1517, 1518, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 3043, 0, 2124, 0, 0, 160,
+	161, 162, 0, 138, 165, 172, 0, 104, 0, 0,
+	0, 0, 160, 161, 162, 0, 0, 0, 0, 0,
+	0, 0, 3032, 0, 0, 164, 158, 157, 0, 169,
+	0, 0, 61, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 169, 0, 3029, 3033, 3031, 3030, 0, 114,
+	0, 0, 0, 163, 0, 115, 0, 0, 0, 0,
+	0, 0, 114, 0, 0, 0, 163, 0, 115, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 3038, 3039, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 160, 161, 162, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 116, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 54, 169, 116, 0, 0, 3046, 0,
+	0, 0, 0, 0, 0, 0, 54, 0, 0, 0,
+	3025, 0, 0, 0, 114, 0, 3037, 0, 163, 0,
+	115, 0, 0, 0, 1733, 0, 0, 0, 0, 1696,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 56, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 56, 0, 0, 1735, 1704,
+	0, 0, 0, 0, 0, 0, 0, 0, 1736, 1737,
+	0, 0, 0, 0, 0, 0, 0, 116, 0, 0,
+	140, 0, 0, 0, 0, 0, 0, 0, 54, 0,
+	0, 0, 0, 140, 1703, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1710,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 56, 0, 0,
+	0, 0, 0, 0, 3042, 0, 0, 117, 40, 242,
+	0, 0, 0, 0, 53, 5, 0, 0, 121, 122,
+	117, 0, 0, 0, 0, 0, 0, 53, 5, 0,
+	0, 121, 122, 0, 123, 140, 1726, 247, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 123, 0, 205,
+	206, 207, 208, 209, 210, 211, 212, 213, 214, 215,
+	216, 217, 218, 219, 220, 221, 222, 223, 224, 225,
+	226, 227, 3041, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 238, 239, 240, 241, 0, 0, 0,
+	248, 249, 250, 251, 252, 253, 254, 255, 243, 244,
+	245, 246, 117, 0, 0, 0, 1695, 1688, 1694, 53,
+	1687, 0, 0, 121, 122, 1714, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1720, 0, 0, 123,
+	0, 0, 0, 0, 1689, 0, 0, 1690, 1691, 0,
+	0, 0, 0, 0, 0, 0, 0, 1708, 1742, 0,
+	0, 1709, 1711, 1713, 0, 1715, 1716, 1717, 1721, 1722,
+	1723, 1725, 1728, 1729, 1730, 0, 0, 0, 0, 0,
+	1733, 0, 1718, 1727, 1719, 1696, 0, 0, 0, 0,
+	0, 0, 0, 0, 1698, 0, 0, 927, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1735, 1704, 1734, 0, 0, 0,
+	0, 0, 0, 0, 1736, 1737, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1692, 1693, 0, 0, 0, 0, 0, 0, 0,
+	1703, 0, 0, 0, 0, 0, 0, 0, 0, 1731,
+	0, 0, 0, 0, 0, 1710, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1707, 0, 0, 0,
+	0, 0, 0, 1706, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1724, 0, 0,
+	0, 0, 0, 0, 0, 0, 1712, 915, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1739, 1738,
+	0, 0, 1726, 0, 0, 0, 0, 937, 941, 943,
+	945, 947, 948, 950, 0, 955, 951, 952, 953, 954,
+	0, 932, 933, 934, 935, 913, 914, 938, 0, 916,
+	0, 917, 918, 919, 920, 921, 922, 923, 924, 925,
+	926, 928, 929, 930, 936, 0, 0, 0, 0, 0,
+	1700, 0, 940, 942, 944, 946, 949, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1695, 2488, 1694, 0, 2487, 0, 0, 0,
+	0, 1714, 0, 0, 0, 0, 0, 1741, 0, 931,
+	1740, 0, 1720, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1708, 1742, 0, 0, 1709, 1711, 1713,
+	0, 1715, 1716, 1717, 1721, 1722, 1723, 1725, 1728, 1729,
+	1730, 0, 0, 0, 0, 0, 0, 0, 1718, 1727,
+	1719, 0, 0, 0, 0, 0, 927, 0, 0, 0,
+	1698, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1734, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1733,
+	0, 0, 0, 0, 0, 0, 0, 1692, 1693, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1731, 0, 0, 0, 0,
+	0, 0, 0, 1735, 0, 0, 0, 2342, 2343, 0,
+	0, 0, 1707, 0, 0, 0, 0, 927, 0, 1706,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1724, 3305, 0, 915, 0, 0, 0,
+	905, 0, 1712, 0, 1710, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1739, 1738, 937, 941, 943, 945,
+	947, 948, 950, 0, 955, 951, 952, 953, 954, 0,
+	932, 933, 934, 935, 913, 914, 938, 0, 916, 0,
+	917, 918, 919, 920, 921, 922, 923, 924, 925, 926,
+	928, 929, 930, 936, 0, 0, 0, 0, 0, 0,
+	0, 940, 942, 944, 946, 949, 1700, 0, 0, 0,
+	0, 1726, 0, 0, 0, 0, 0, 0, 0, 0,
+	1733, 0, 0, 0, 0, 0, 0, 915, 0, 0,
+	0, 0, 0, 0, 939, 0, 0, 0, 931, 0,
+	0, 0, 0, 1741, 0, 0, 1740, 937, 941, 943,
+	945, 947, 948, 950, 1735, 955, 951, 952, 953, 954,
+	0, 932, 933, 934, 935, 913, 914, 938, 0, 916,
+	0, 917, 918, 919, 920, 921, 922, 923, 924, 925,
+	926, 928, 929, 930, 936, 1733, 0, 0, 0, 0,
+	1714, 0, 940, 942, 944, 946, 949, 0, 0, 0,
+	0, 1720, 0, 0, 0, 1710, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1735,
+	0, 0, 1708, 1742, 0, 0, 1709, 1711, 1713, 931,
+	1715, 1716, 1717, 1721, 1722, 1723, 1725, 1728, 1729, 1730,
+	0, 0, 0, 0, 0, 0, 0, 1718, 1727, 1719,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 3290, 0,
+	1710, 0, 1726, 0, 0, 0, 0, 0, 0, 0,
+	0, 1734, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1731, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1726, 0, 0,
+	0, 1707, 0, 0, 0, 0, 0, 0, 1706, 0,
+	0, 1714, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1720, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1724, 0, 0, 0, 0, 0, 0, 0,
+	0, 1712, 0, 1708, 1742, 0, 0, 1709, 1711, 1713,
+	0, 1715, 1716, 1717, 1721, 1722, 1723, 1725, 1728, 1729,
+	1730, 0, 0, 0, 0, 0, 0, 0, 1718, 1727,
+	1719, 0, 0, 939, 0, 0, 1714, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1720, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1734, 0, 0, 0, 0, 0, 1708, 1742,
+	0, 0, 1709, 1711, 1713, 0, 1715, 1716, 1717, 1721,
+	1722, 1723, 1725, 1728, 1729, 1730, 0, 0, 0, 0,
+	0, 0, 0, 1718, 1727, 1719, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1731, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1707, 0, 939, 0, 0, 1734, 0, 1706,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1724, 0, 0, 0, 0, 0, 0,
+	0, 0, 1712, 0, 0, 0, 0, 0, 0, 0,
+	1731, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1707, 0, 0,
+	0, 0, 0, 0, 1706, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1724, 0,
+	0, 0, 0, 0, 0, 0, 0, 1712,
 }
 
 var yyPact = [...]int{
-	4167, -1000, -1000, -1000, -318, 10392, -1000, -1000, -1000, -1000,
+	43581, -1000, -1000, -1000, -334, 11514, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	40556, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 40556, -330, 39954, 39954, -1000, -1000, 2445,
+	-1000, 39352, 12752, 40556, 389, 387, 40556, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 35442,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 35442,
-	-315, 34900, 34900, -1000, -1000, 2349, -1000, 34357, 11521, 35442,
-	345, 332, 35442, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 725, -1000, 38750, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 642, 3645, 42964, 9038, -215, -1000,
+	3192, -2, 2244, -314, 934, 939, 1137, 1137, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 259, 820,
+	38148, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 700,
-	-1000, 33815, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 655,
-	37998, 37068, 8146, -274, -1000, 2988, -110, 2149, 844, 861,
-	930, 930, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 37450, 755, 33273, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 3353, 1457, 820, 17005, 112, 110,
+	3192, 403, 174, -1000, 1475, 4318, 176, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 9038, 9038,
+	11514, -362, 11514, 9038, 40556, 40556, -1000, -1000, -1000, -1000,
+	642, 3645, 9038, 2244, -314, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 300, 336, 755, 13696, 9,
-	-3, 2988, 338, 2715, -1000, 1211, 4181, 174, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 8146,
-	8146, 10392, -347, 10392, 8146, 35442, 35442, -1000, -1000, -1000,
-	-1000, 655, 37998, 8146, 2149, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -5470,361 +6245,393 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -3, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 110, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 3294,
-	-1000, 1389, -1000, -1000, -1000, -1000, -1000, 2598, 1374, 2142,
-	586, 35442, -1000, 1371, 586, -1000, -1000, -1000, 2988, 2988,
-	-1000, 35442, 35442, 80, 1625, -1000, 388, 359, 288, 1370,
-	-1000, -1000, -1000, -1000, -1000, -1000, 467, 2947, -1000, 35442,
-	35442, 2613, 35442, -1000, 1990, 527, 4219, 2812, 1312, 802,
-	2626, -1000, -1000, 2596, -1000, 215, 191, 171, 804, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 196, -1000, 2882, -1000,
-	-1000, 188, -1000, -1000, 178, -1000, -1000, -1000, -7, -1000,
-	-1000, -1000, -1000, -1000, -1000, -103, -1000, -1000, 946, 1720,
-	8146, -1000, 2157, -1000, 2878, -1000, -1000, -1000, -1000, 5344,
-	9823, 9823, 9823, 9823, -1000, -1000, 2457, 8146, 2595, 2588,
-	-1000, -1000, -1000, -1000, -1000, 1356, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1758, -1000, -1000, -1000, 9264,
-	-1000, 2587, 2586, 2580, 2578, 2577, 2575, 2574, 2571, 2570,
-	2569, 2568, 2567, 2565, 2561, 2313, 10949, 2560, 2141, 2140,
-	2559, 2558, 2554, 2138, 2313, 2313, 2552, 2551, 2550, 2549,
-	2548, 2546, 2545, 2544, 2535, 2534, 2533, 2526, 2525, 2520,
-	2519, 2518, 2516, 2510, 2509, 2508, 2504, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 44606, -1000, 1504, -1000, -1000, -1000, -1000, -1000,
+	-1000, 2743, 1500, 2243, 570, 40556, -1000, 1496, 570, -1000,
+	-1000, -1000, 3192, 3192, -1000, 40556, 40556, 180, 1733, -1000,
+	404, 419, 393, 1491, -1000, -1000, -1000, -1000, -1000, -1000,
+	493, 3167, -1000, 40556, 40556, 2763, 40556, -1000, 43685, 533,
+	3738, 2974, 1110, 739, 2788, -1000, -1000, 2742, -1000, 290,
+	1063, 421, 748, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	328, -1000, 3053, -1000, -1000, 286, -1000, -1000, 274, -1000,
+	-1000, -1000, 109, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	9, -1000, -1000, 997, 2232, 9038, -1000, 2241, -1000, 3500,
+	-1000, -1000, -1000, -1000, 5943, 10895, 10895, 10895, 10895, -1000,
+	-1000, 2549, 9038, 2733, 2732, -1000, -1000, -1000, -1000, -1000,
+	2731, 1489, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1864, -1000, -1000, -1000, 10276, -1000, 2730, 2729, 2714,
+	2713, 2712, 2711, 2710, 2701, 2699, 2698, 2695, 2680, 2679,
+	2678, 2430, 12133, 2663, 2231, 2226, 2662, 2660, 2659, 2222,
+	2430, 2430, 2657, 2656, 2654, 2652, 2650, 2649, 2648, 2643,
+	2640, 2639, 2638, 2627, 2622, 2621, 2620, 2619, 2618, 2617,
+	2615, 2610, 2609, 2603, 2597, 2596, 2587, 2581, 2580, 2576,
+	2575, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1265, -1000, 2500, 2781, 2895, 2363, 2923, 2868, 2866, 2860,
-	2849, 1927, -1000, -1000, 60, -1000, -138, -1000, -1000, 912,
-	-1000, 797, -1000, 35442, 35442, 35442, 866, 652, 652, 652,
-	652, 652, 652, 684, 652, 35442, -168, 699, 695, 691,
-	652, -1000, -1000, -1000, -1000, -1000, -1000, -122, -1000, 1624,
-	1623, 2688, 786, -1000, -1000, -1000, -1000, 1208, 35442, -1000,
-	2399, 1457, 1457, 2892, 554, 553, 552, 1457, 391, -1000,
-	1556, 1556, 1556, 1556, 1457, 394, 533, 2895, 2895, 0,
-	1556, -50, 1457, 1457, -50, 1457, 1457, 1457, 189, -310,
-	-1000, -1000, -1000, 1556, 1556, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 2877, 2876, 655, 35442, 114, 35442, 655, 655,
-	655, 660, -38, 36526, 35984, 1990, 526, 508, 1222, 1582,
-	-1000, 1551, 35442, 35442, 1551, 1551, 18083, 17541, -1000, 35442,
-	-1000, 2895, 2363, -1000, 2288, 2511, 2286, 2363, 655, 655,
-	655, 655, 655, 156, 655, 655, 655, 655, 35442, 35442,
-	32731, 655, 655, 7021, 7021, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 10392, 1752, 1877, 169, -76, -313,
-	221, -1000, -1000, 35442, 2762, 159, -1000, -1000, -1000, 2350,
-	-1000, 2398, 2398, 2398, 2398, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2431, 2474, -1000, -1000, 2396, 2396,
-	2396, 2350, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 2427, 2427, 2430,
-	2430, 2427, 35442, 8146, 35442, 2788, 328, 2472, -1000, 35442,
-	325, 2895, 2781, 2895, -1000, -1000, 1352, 1917, 2136, -1000,
-	-1000, 388, 1470, -1000, 970, -1000, -1000, -1000, -1000, 35442,
-	-103, 310, -1000, -1000, 2118, 2468, -1000, 491, 1114, 1377,
-	-1000, 182, 4266, 28388, 1990, 28388, 35442, -1000, -1000, -1000,
-	-1000, -1000, -1000, -20, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 205, -1000, 8146,
-	8146, 8146, 8146, 8146, -1000, 805, 8705, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 9823, 9823, 9823, 9823, 9823, 9823,
-	9823, 9823, 9823, 9823, 9823, 9823, 2456, 1646, 9823, 9823,
-	9823, 9823, 2511, 2730, 1219, 214, -1000, -1000, -1000, -1000,
-	-1000, 1643, 1720, 8146, 8146, 35442, -1000, -1000, 4222, 8146,
-	8146, 112, 8146, 2847, 8146, 8146, 8146, 2283, 3598, 35442,
-	8146, -1000, 2278, 2277, -1000, -1000, 1783, 8146, -1000, -1000,
-	8146, -1000, -1000, 8146, 9823, 8146, -1000, -1000, -1000, 1247,
-	2847, 2847, 1678, 8146, 8146, 2847, 2847, 2847, 1539, 2847,
-	2847, 2847, 2847, 2847, 2847, 2847, 2847, 2270, 2267, 2266,
-	2895, -274, 6462, -1000, -279, 2781, 8146, -1000, -1000, 8146,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2132, 2131,
-	-329, -37, 808, 807, 810, -1000, 2839, -1000, 2467, 2466,
-	1351, 652, -1000, -133, 35442, 1673, 35442, 28388, 35442, 35442,
-	1990, 35442, 35442, -187, 652, -167, 652, 652, 652, 35442,
-	-1000, 2718, 28388, 2698, 660, -1000, 35442, 1208, 2837, 35442,
-	2936, 9823, 9823, -1000, 32189, 1556, 1457, 1457, -1000, -1000,
-	35442, -1000, -1000, -1000, 1556, 35442, 1556, 1556, 2936, 1556,
-	-1000, -1000, -1000, 1457, 1457, -1000, -1000, -1000, -1000, 1556,
-	1556, -1000, -1000, 2936, 35442, -25, 2936, 2936, 2, -1000,
-	-1000, -1000, 35442, 35442, 652, 35442, -1000, 35442, 35442, -1000,
-	-1000, 35442, 37628, 35442, 31647, 2875, -1000, 28388, 35442, 35442,
-	25678, -1000, 1128, -1000, -78, -1000, -75, -38, 1551, -38,
-	1551, -1000, 481, 565, 16457, 418, 28388, 4785, -1000, -1000,
-	1551, 1551, 4785, 4785, 1399, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1172, -1000, 160, 2781, -1000, -1000, -1000, -1000,
-	-1000, 35442, 35442, 28388, 1990, 35442, 655, 35442, 35442, 35442,
-	35442, -1000, 2462, -1000, 2810, 35442, 35442, 1306, -1000, -1000,
-	13154, 1341, 1306, -1000, 1668, -1000, 8146, 10392, -295, 8146,
-	10392, 10392, 8146, 10392, -1000, 8146, 142, -1000, -1000, -1000,
-	-1000, 1912, -1000, 1905, -1000, -1000, -1000, 2125, 2125, -1000,
-	1899, -1000, -1000, -1000, -1000, 1896, -1000, -1000, 1895, -1000,
-	-1000, -1000, 2265, 946, -1000, 2122, 2623, -275, -1000, 15356,
-	35442, 35442, -1000, -1000, -275, -1000, 14797, 35442, 2781, -1000,
-	2781, 35442, -1000, 2891, -1000, 388, 271, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1331, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1161, -1000, 35442, -1000, -1000, 182, 28388,
-	29472, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 184, -1000,
-	-1000, 163, -1000, 681, 115, 1469, -1000, -1000, 138, 175,
-	726, 1720, -1000, 1701, 1701, 1683, -1000, 497, -1000, -1000,
-	-1000, -1000, 2457, -1000, -1000, -1000, 1590, 2347, -1000, 1504,
-	1504, 1403, 1403, 1403, 1403, 1403, 1552, 1552, -1000, -1000,
-	-1000, 5344, 2456, 9823, 9823, 9823, 9823, 611, 611, 3291,
-	3389, -1000, 8146, 1655, -1000, 8146, 2085, 1328, 1321, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1252, -1000, 2574, 2933, 3068, 2465,
+	3139, 3038, 3036, 3033, 3010, 1990, -1000, -1000, 119, 690,
+	-1000, -43, -1000, -1000, 964, -1000, 849, -1000, 40556, 40556,
+	40556, 976, 634, 634, 634, 634, 634, 634, 634, 689,
+	634, 692, 634, 40556, -61, 723, 717, 712, 634, -1000,
+	-1000, -1000, -1000, -1000, -1000, -14, -1000, 1731, 1727, 2719,
+	836, -1000, -1000, -1000, -1000, 1270, 40556, -1000, 2484, 1676,
+	1676, 3061, 550, 548, 539, 1676, 432, -1000, 1697, 1697,
+	1697, 1697, 1676, 492, 559, 3068, 3068, 98, 1697, 94,
+	1676, 1676, 94, 1676, 1676, 1676, 276, -266, -1000, -1000,
+	-1000, 1697, 1697, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	3047, 3046, 642, 40556, 221, 40556, 642, 642, 642, 40556,
+	648, 58, 42362, 41760, 43685, 531, 519, 1279, 1651, -1000,
+	1561, 40556, 40556, 1561, 1561, 21275, 20673, -1000, 40556, -1000,
+	3068, 2465, -1000, 2406, 3025, 2405, 2465, 642, 642, 642,
+	642, 642, 265, 642, 642, 642, 642, 40556, 40556, 37546,
+	642, 642, 642, 642, 669, 7800, 7800, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 11514, 2076, 2019, 173,
+	-22, -315, 249, -1000, -1000, 40556, 2912, 260, -1000, -1000,
+	-1000, 2456, -1000, 2500, 2500, 2500, 2500, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 2536, 2573, -1000, 2500,
+	2500, -1000, 2498, 2498, 2498, 2456, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2264,
-	2263, 2315, 2946, 2260, 8146, -1000, -1000, 1444, 1439, 1430,
-	-1000, 2072, 7587, -1000, -1000, -1000, 2258, 1297, 2252, -1000,
-	-1000, -1000, 2248, 1429, 1101, 2246, 1524, 2245, 1156, 8146,
-	8146, 8146, 2244, 1428, 1421, 8146, 8146, 8146, 8146, 2235,
-	8146, 8146, 8146, 8146, 8146, 8146, 8146, 8146, 20, 20,
-	20, -1000, -1000, 1411, -1000, 1720, -1000, 2112, -1000, 1353,
-	1140, -1000, 2228, -1000, -1000, -1000, 2074, -1000, -1000, 827,
-	791, 891, 35442, 961, 12063, 35442, 35442, -1000, 2399, 2786,
-	140, -1000, 1064, -1000, 115, -111, 351, 980, 2602, 2945,
-	2445, 35442, 652, 35442, 35442, 35442, 2835, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 31105, -1000, 2443, 1407, -1000,
-	-1000, 8146, -1000, -1000, 2557, 35442, 35442, 2936, 2936, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 35442, 2936, 2936, 1457,
-	1556, -1000, -1000, 1556, -1000, -1000, 1556, -1000, 1294, -1000,
-	35442, -1000, -1000, -1000, 2399, 1130, -1000, 1142, 633, 633,
-	847, 493, 2922, 14238, -1000, 1447, 1021, 679, 2738, 194,
-	-1000, 1447, -221, 1447, 1447, 1447, 1447, 1447, 1447, 1447,
-	463, 444, 1447, 1447, 1447, 1447, 1447, 1447, 1447, 1447,
-	1447, 1447, 1447, 854, 1447, 1447, 1447, 1447, 1447, -1000,
-	1447, 2442, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 519,
-	135, 2874, 238, 236, 1064, 2871, 251, 2816, 1076, -1000,
-	-1000, -1000, -1000, 20258, 20258, 15915, 20258, -1000, 1472, -38,
-	-52, -1000, -1000, 1128, 4785, 1128, 4785, -1000, -1000, 675,
-	-1000, -1000, 980, -1000, 35442, 35442, -1000, -1000, 2434, 1618,
-	-1000, -1000, 10949, -1000, 4785, 4785, -1000, -1000, 20800, 35442,
-	-1000, -105, -1000, -88, 2890, 1115, -1000, -1000, 1124, 980,
-	2620, 35442, 1115, 1115, -1000, -1000, 12063, 35442, -1000, -1000,
-	2936, 7021, -1000, 25678, -1000, -1000, 30556, -1000, 30014, 2936,
-	1638, -1000, 10392, 1853, 165, -1000, 197, -320, 152, 1697,
-	151, 1720, -1000, -1000, 2230, 2229, 1396, -1000, 1393, 2225,
-	1391, 1390, -1000, -33, -1000, 2772, 983, -1000, 2433, -1000,
-	1387, 2682, -1000, 1113, -1000, 1616, 1326, -1000, 983, 1298,
-	2670, 1113, 2890, -1000, 1291, 78, -1000, -1000, 35442, 2118,
-	1292, 29472, 1032, -1000, 674, 1282, 1276, -1000, 28388, 185,
-	28388, -1000, 28388, -1000, -1000, 297, -1000, 35442, 2776, -1000,
-	-1000, -1000, 2074, -332, -1000, -1000, -1000, -1000, -1000, 1279,
-	-1000, 611, 611, 3291, 1753, -1000, 9823, -1000, 9823, 2707,
-	1507, -1000, 8146, 1841, 3208, 1456, 19167, 35442, -1000, -1000,
-	8146, 8146, -1000, 2686, -1000, -1000, -1000, -1000, 8146, 8146,
-	2123, -1000, 35442, -1000, -1000, -1000, -1000, 19167, -1000, 9823,
-	-1000, 8146, 1151, 2677, 1273, 20, 20, 20, 2630, 2605,
-	2563, 1269, 20, 2555, 2513, 2502, 2494, 2469, 2463, 2459,
-	2454, -1000, 2432, -1000, -1000, 6462, -1000, -33, 8146, 8146,
-	8146, 2733, -1000, -1000, 104, -1000, 1599, -1000, -1000, -1000,
-	779, 161, 2224, 1112, -1000, -1000, 35442, -1000, -1000, -1000,
-	2223, 1091, -1000, -1000, -1000, 4173, 2398, 2398, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 2431, -1000, -1000,
-	2396, 2396, 2396, 2350, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 2427, 2427, 2430, 2430, 2427, -1000, 2399,
-	2834, -1000, 42, 1447, 487, 28388, 477, -1000, 35442, 1760,
-	1598, 2618, 303, 2759, 35442, 26220, 252, 35442, 2426, 2421,
-	2417, 337, 4173, 35442, 1243, -1000, 1275, 4181, -1000, 35442,
-	1720, -1000, 1990, -1000, 1457, -1000, -1000, 2936, 1317, -1000,
-	-1000, 2936, 1457, 1457, 1556, 35442, -1000, 2823, 37628, -1000,
-	35442, -1000, -1000, -1000, 777, -1000, 824, 652, 35442, 1718,
-	824, 1715, 2414, -1000, -1000, 35442, 35442, 35442, 35442, -1000,
-	-1000, 35442, -1000, 35442, 35442, 35442, 35442, -1000, 35442, 35442,
-	-1000, 35442, 1708, 1702, 2783, -1000, 1447, 1447, 762, -1000,
-	-1000, 464, -1000, 1894, 1887, 1886, 1884, 2103, 2102, 2101,
-	1447, 1447, 1883, 2097, 28930, 2091, 1045, 1882, 1879, 1878,
-	1977, 2089, 1116, -1000, 2088, 1974, 1931, 1915, 35442, 2400,
-	2034, -1000, -1000, 42, 1447, 201, 35442, 1594, 487, 350,
-	1963, 16999, 35442, 25678, 25678, 25678, 25678, -1000, 2655, 2643,
-	-1000, 2653, 2651, 2666, 35442, 25678, 2399, -1000, 28930, -1000,
-	-1000, -1000, 2511, 1264, 2913, 811, 8146, -1000, -1000, -82,
-	-93, -1000, -1000, -1000, 28388, 2087, 418, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 2963, 35442, 35442, 571, 2217,
-	-1000, 2934, -1000, 1075, -1000, -1000, 1242, -1000, 2934, 1648,
-	-321, 10392, 1640, 1420, -1000, 8146, 10392, 8146, -296, 220,
-	-299, -1000, -1000, -1000, 2077, -1000, -1000, -1000, 1872, -1000,
-	1863, 51, 61, 1694, -275, 6462, 330, 35442, -275, 35442,
-	6462, -1000, 35442, 329, -275, -1000, 35442, 1857, -1000, -1000,
-	-1000, 2938, 28388, 1990, 1418, 27846, -1000, 183, -1000, 181,
-	466, 2075, -1000, 689, 103, 2074, -1000, -1000, -1000, 9823,
-	-1000, -1000, -1000, -1000, 1720, 8146, 2212, -1000, 806, 806,
-	2208, -1000, 2398, 2398, -1000, 2350, 2396, 2350, 806, 806,
-	2207, -1000, 1950, 2450, -1000, 2419, 2361, 8146, -1000, 2206,
-	3226, 1043, -137, 20, 20, -1000, -1000, -1000, -1000, 20,
-	20, 20, 20, -1000, 20, 20, 20, 20, 20, 20,
-	20, 20, 591, -1000, 51, 1720, 1720, -1000, -1000, 2712,
-	-1000, 2074, 2071, -1000, 2068, 2065, 961, 4173, 704, 12063,
-	2761, 2819, 315, 1964, -1000, -1000, -1000, 27304, 440, -1000,
-	-1000, -1000, 545, 177, 1851, 424, -1000, 35442, 251, 2693,
-	1593, 2064, 35442, -1000, -1000, -1000, -1000, -1000, 2759, -1000,
-	1072, 320, 1262, -1000, 1447, 1447, 12605, 2818, 2395, 2394,
-	12605, 12605, 12605, 323, 1534, -1000, 586, 1059, 1239, 25678,
-	35442, -1000, 25136, 2195, -1000, 980, 2936, -1000, 35442, -1000,
-	2936, 2936, 1457, -1000, 315, -1000, 2721, 4173, -1000, 35442,
-	-1000, 35442, -1000, 35442, 35442, 652, 8146, 2721, 35442, 664,
-	-1000, -1000, 922, 267, 603, 603, 1044, -1000, 2798, -1000,
-	-1000, -1000, 35442, -1000, -1000, -1000, -1000, -1000, -1000, 19167,
-	19167, 2863, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 2062, 2057, -1000, -1000, -1000, -1000,
+	-1000, 2528, 2528, 2531, 2531, 2528, 40556, 9038, 40556, 2952,
+	384, 2571, -1000, 40556, 383, 3068, 2933, 3068, -1000, -1000,
+	1470, 1988, 2221, -1000, -1000, 404, 1686, -1000, 353, -1000,
+	-1000, -1000, -1000, 40556, 9, 385, -1000, -1000, 2219, 2564,
+	-1000, 590, 1077, 1275, -1000, 256, 4442, 32111, 43685, 32111,
+	40556, -1000, -1000, -1000, -1000, -1000, -1000, 108, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 35442, 1260, -1000, 1589, 1964,
-	27304, 1560, 1551, 2056, 440, 1760, 1555, -1000, 2046, -1000,
-	996, 35442, 35442, -1000, 1198, -1000, 1550, 2611, 2617, 2611,
-	-1000, -1000, -1000, -1000, 2640, -1000, 2639, -1000, -1000, 1198,
-	-1000, -1000, -1000, -1000, -1000, 811, -1000, 2889, 824, 824,
-	824, 2193, -1000, -1000, -1000, 1032, 2188, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 2927, 2919, 26762, 2927,
-	-1000, -321, 1473, -1000, 1742, 150, 1681, 35442, -1000, -1000,
-	-1000, 2184, 2179, -282, 72, 2918, 2917, 2956, -1000, 2177,
-	1012, -275, -1000, -1000, 983, -1000, -1000, -1000, -275, -1000,
-	983, -1000, -1000, 1990, -1000, 166, -1000, -1000, -1000, -1000,
-	-1000, -1000, 97, -1000, 35442, -1000, 102, -1000, 1720, -1000,
+	-1000, 287, -1000, 9038, 9038, 9038, 9038, 9038, -1000, 577,
+	9657, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 10895, 10895,
+	10895, 10895, 10895, 10895, 10895, 10895, 10895, 10895, 10895, 10895,
+	2558, 1730, 10895, 10895, 10895, 10895, 3025, 2887, 1273, 288,
+	-1000, -1000, -1000, -1000, -1000, 1735, 2232, 9038, 9038, 40556,
+	40556, -1000, -1000, 43365, 9038, 9038, 3342, 9038, 3007, 9038,
+	9038, 9038, 2393, 4698, 40556, 9038, -1000, 2384, 2383, -1000,
+	-1000, 1936, 9038, -1000, -1000, 9038, -1000, -1000, 9038, 10895,
+	9038, -1000, -1000, -1000, 351, 3007, 3007, 1672, 9038, 9038,
+	3007, 3007, 3007, 1650, 3007, 3007, 3007, 3007, 3007, 3007,
+	3007, 3007, 2373, 2368, 2367, 2364, 2363, 9038, 9038, 9038,
+	9038, 9038, 9038, 3068, -215, 7181, -1000, -221, 2933, 9038,
+	-1000, -1000, 9038, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 2220, 2215, -347, 40556, 43, 912, 861, 865, -1000,
+	3001, -1000, 2562, 2561, 1439, 634, -1000, -20, 40556, 1772,
+	40556, 32111, 40556, 40556, 40556, 40556, 634, 43685, 40556, 40556,
+	-80, 634, -60, 634, 634, 634, 40556, -1000, 2922, 32111,
+	2844, 648, -1000, 40556, 1270, 2993, 40556, 3153, 10895, 10895,
+	-1000, 36944, 1697, 1676, 1676, -1000, -1000, 40556, -1000, -1000,
+	-1000, 1697, 40556, 1697, 1697, 3153, 1697, -1000, -1000, -1000,
+	1676, 1676, -1000, -1000, -1000, -1000, 1697, 1697, -1000, -1000,
+	3153, 40556, 106, 3153, 3153, 82, -1000, -1000, -1000, 40556,
+	40556, 634, 40556, -1000, 40556, 40556, -1000, -1000, 40556, 44048,
+	40556, 36342, 3041, -1000, 32111, 40556, 40556, 884, 1421, 19447,
+	-1000, 1225, -1000, 60, -1000, 34, 58, 1561, 58, 1561,
+	-1000, 589, 3950, 18845, 524, 32111, 5324, -1000, -1000, 1561,
+	1561, 5324, 5324, 1509, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1269, -1000, 297, 2933, -1000, -1000, -1000, -1000, -1000,
+	40556, 40556, 32111, 43685, 40556, 642, 40556, 40556, 40556, 40556,
+	-1000, 2559, -1000, 2968, 40556, 40556, 40556, 40556, 642, 1216,
+	-1000, -1000, 16403, 1415, 1216, -1000, 1765, -1000, 9038, 11514,
+	-244, 9038, 11514, 11514, 9038, 11514, -1000, 9038, 257, -1000,
+	-1000, -1000, -1000, 1983, -1000, 1981, -1000, -1000, -1000, 2208,
+	2208, -1000, -1000, -1000, 1977, -1000, -1000, -1000, -1000, 1976,
+	-1000, -1000, 1975, -1000, -1000, -1000, 2362, 997, -1000, 2206,
+	2787, -216, -1000, 18243, 40556, 40556, -1000, -1000, -216, -1000,
+	17624, 40556, 2933, -1000, 2933, 40556, -1000, 3060, -1000, 404,
+	382, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1407, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1261, -1000,
+	40556, -1000, -1000, 256, 32111, 33917, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 292, -1000, -1000, 183, -1000, 685, 220,
+	1638, -1000, -1000, 233, 185, 753, 2232, -1000, 1795, 1795,
+	1777, -1000, 595, -1000, -1000, -1000, -1000, 2549, -1000, -1000,
+	-1000, 2446, 2021, -1000, 1729, 1729, 1513, 1513, 1513, 1513,
+	1513, 1882, 1882, -1000, -1000, -1000, 5943, 2558, 10895, 10895,
+	10895, 10895, 602, 602, 2976, 4106, -1000, 9038, 1740, -1000,
+	9038, 2168, 1445, 1635, 1406, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 8146, -1000, -1000, -1000, 2353, -1000,
-	-1000, 8146, 2175, 2044, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2977, -1000,
-	2916, -282, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	4173, -1000, 1355, 315, -203, -1000, 2043, 110, -1000, -1000,
-	-1000, 2042, 2037, 1847, -1000, -1000, 1904, 1244, 128, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1963, 1938, 1529, -333, -1000,
-	2393, -1000, 1447, 1447, 1447, 35442, -1000, 26220, 1355, 1355,
-	2174, 1007, -1000, -1000, -1000, -1000, 315, 24052, 12605, 2173,
-	2169, 2165, 605, 1531, 1492, -1000, 1817, 20258, 25678, 25136,
-	1102, -1000, 1228, -1000, -1000, -1000, 2936, -1000, -1000, 2936,
-	-1000, -1000, -1000, 35442, 274, 2392, -1000, 2385, 2515, 362,
-	2384, 2377, 35442, 2187, -1000, 2721, 35442, 599, 2741, -1000,
-	-1000, -1000, -1000, -1000, 404, -1000, 35442, -1000, -1000, 1394,
-	-1000, 19167, -1000, -1000, 1234, -1000, 2034, 2033, -1000, 110,
-	2030, 4785, -1000, -1000, 2693, 2029, -1000, 35442, 996, 996,
-	2977, 35442, 6462, -1000, -1000, 8146, 2376, -1000, 8146, -1000,
-	-1000, -1000, -1000, -1000, 2357, 2708, -1000, -1000, -1000, -1000,
-	-1000, -1000, 8146, 8146, -1000, -1000, 651, 10392, -300, 217,
-	-1000, -1000, -1000, -284, 2028, -1000, -1000, 2914, 2024, 1981,
-	35442, -1000, 983, 983, 980, -1000, -1000, 2, -1000, 2129,
-	-1000, 2059, 20, -1000, 143, 8146, -284, -184, -1000, -1000,
-	-203, -1000, 308, -1000, -1000, 296, -1000, -1000, 247, -1000,
-	-1000, 1842, 486, -1000, -1000, -1000, 2018, 1739, 1996, 23510,
-	19167, 19709, 2016, -1000, -1000, -1000, -1000, -211, 12605, -1000,
-	1231, -1000, 1447, 1447, 2164, 37890, 205, 37890, 865, 1489,
-	-1000, 1806, -1000, 1805, -1000, 2936, 1102, 1228, -1000, -1000,
-	1414, -1000, -1000, -1000, -1000, 274, -1000, -1000, 1725, 9823,
-	-1000, -1000, 2015, 19709, 730, 1519, 1511, 817, 2355, -1000,
-	421, 2954, -1000, -1000, -1000, 758, 2352, -1000, 1691, 1689,
-	-1000, 35442, -1000, 23510, 23510, 380, 380, 23510, 23510, 2351,
-	603, -1000, -1000, 9823, -1000, -1000, -1000, -1000, 1447, 1359,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1938, -1000, -1000, 2895,
-	-1000, -1000, 1720, 35442, 1720, 24594, -1000, 2912, 2910, 1720,
-	946, -1000, -321, 35442, 35442, -286, 1804, -1000, 2013, 69,
-	-1000, -1000, 947, -288, 30, 66, -1000, -1000, -1000, 2162,
-	-1000, 928, -1000, -1000, -1000, 946, -286, 35442, -1000, 420,
-	-1000, -1000, 447, 2011, -1000, -1000, 246, -1000, -1000, -1000,
-	-1000, -1000, 101, -1000, 1468, 1224, -1000, 2350, 8146, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 24052, 1355, 1355,
-	-211, 584, 37825, -1000, 1021, -1000, 2, 584, 488, 216,
-	-1000, 1785, -1000, -1000, 2977, -1000, -1000, -1000, 3291, -1000,
-	-1000, -1000, -1000, -1000, -1000, 2010, 2007, -1000, -1000, -1000,
-	-1000, -1000, 1782, 2346, -119, 2852, 2006, -1000, -1000, 2336,
-	1209, 1199, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1183,
-	1171, 23510, -1000, -1000, 3291, 19167, 1447, -1000, -1000, 1155,
-	1143, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 2314, -1000,
-	-1000, 2909, -1000, 2002, 658, 49, 66, -1000, 2908, 68,
-	2907, 2906, -1000, -1000, 855, -276, 16, 14, -29, -1000,
-	-1000, -1000, 8146, -1000, 2814, -1000, 172, -1000, 96, -1000,
-	-1000, -1000, 1996, 1993, -1000, 23510, 2798, 2027, -1000, -1000,
-	-1000, -1000, 364, 2905, 37890, -1000, 1447, -1000, 364, -1000,
-	326, -1000, 1464, -1000, 1780, -1000, 2895, -1000, -1000, 2159,
-	8146, 2313, 1447, 2090, -174, 23510, 2465, 2461, 2231, 2067,
-	1138, -1000, 19167, -1000, -1000, 22968, 35442, 1981, -1000, 2312,
-	1779, 72, 56, 2902, -1000, 1981, 2901, 1981, 1981, 1676,
-	-1000, 36, 33, 21, -1000, -1000, -1000, -1000, 1991, -1000,
-	-1000, -1000, -1000, 211, -1000, -1000, 2798, -1000, 2899, 429,
-	-1000, 19167, -1000, -1000, -1000, 1775, -1000, -1000, -1000, 1911,
-	-1000, 35442, -1000, 2616, -1000, 187, 1134, 211, 380, 211,
-	380, 211, 380, 211, 380, 179, -1000, -1000, -1000, 1121,
-	-1000, -1000, 2844, 18625, 46, -1000, -1000, -1000, 1984, -1000,
-	1981, -1000, -1000, 855, -1000, -1000, -1000, -1000, -1000, 1447,
-	1969, 302, -1000, -1000, -1000, 22426, 377, 358, 356, -1000,
-	531, -1000, -1000, -1000, 603, -1000, -1000, 2606, 2566, 2942,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	35442, -1000, 35442, -1000, 1081, -1000, -1000, -1000, 1073, -1000,
-	-1000, -1000, -1000, -1000, 1774, -1000, 35442, -1000, 35442, 373,
-	1770, 9823, 2309, 9823, 2308, 389, 2297, -1000, -1000, 2951,
-	-1000, 2943, 776, 776, 211, 211, 211, 211, -1000, 915,
-	-1000, 1442, -1000, 21884, 35442, -1000, -1000, 1077, 2292, 2897,
-	-1000, 3191, 35442, 2903, 35442, 2291, 1440, 9823, -1000, -1000,
-	-1000, 1832, 428, -1000, 35442, 5903, -1000, 1022, -1000, -1000,
-	577, 379, -1000, 1054, -1000, 1033, 21342, 1759, 1902, -1000,
-	-1000, -1000, -1000, -1000, 1720, 35442, 1001, -1000, 35442, 360,
-	-1000, -1000, -1000, 993, -1000, -1000, -1000, -1000, 577, 2667,
-	-1000, 1751, -1000, -1000, 37666, 690, -1000, -1000, 37666, 372,
-	-1000, 548, 2078, -1000, -1000, 985, -1000, 35442, 795, 8146,
-	-1000, 372, 37890, -1000, 8146, 949, -1000, 37890, 944, -1000,
-	-1000,
+	-1000, -1000, -1000, -1000, -1000, 2353, 2352, 2189, 3166, 2350,
+	9038, -1000, -1000, 1630, 1629, 1625, -1000, 2153, 8419, -1000,
+	-1000, -1000, 2346, 1390, 2343, -1000, -1000, -1000, 2340, 1624,
+	1098, 2335, 2629, 2332, 1258, 9038, 9038, 9038, 2331, 1623,
+	1603, 9038, 9038, 9038, 9038, 2327, 9038, 9038, 9038, 9038,
+	9038, 9038, 9038, 9038, 138, 138, 138, 138, 138, 1595,
+	1583, 1569, 1568, 1556, 1554, -1000, -1000, 1548, -1000, 2232,
+	-1000, 2203, -1000, 1265, 1251, -1000, 2113, -1000, -1000, -272,
+	2167, -122, -1000, -1000, 904, 845, 950, 40556, 1012, 13354,
+	40556, 40556, -1000, 2484, 2964, 243, -1000, 1118, -1000, 220,
+	-3, 398, 2786, 271, 1387, 40556, 1015, 2761, 3165, 2557,
+	40556, 634, 40556, 40556, 40556, 2992, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 35740, -1000, 2555, 1547, -1000, -1000,
+	9038, -1000, -1000, 2760, 40556, 40556, 3153, 3153, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 40556, 3153, 3153, 1676, 1697,
+	-1000, -1000, 1697, -1000, -1000, 1697, -1000, 1386, -1000, 40556,
+	-1000, -1000, -1000, 2484, 1233, -1000, -1000, 1801, 14558, 591,
+	583, 581, 604, 604, 972, 535, 3138, -1000, 1566, 1105,
+	676, 2884, 294, -1000, 1566, -156, 1566, 1566, 1566, 1566,
+	1566, 1566, 1566, 505, 486, 1566, 1566, 1566, 1566, 1566,
+	1566, 1566, 1566, 1566, 1566, 1566, 923, 1566, 1566, 1566,
+	1566, 1566, -1000, 1566, 2550, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 520, 239, 3030, 320, 319, 1118, 3027, 330,
+	2851, 733, -285, 659, 40556, 3095, 1199, -1000, -1000, -1000,
+	-1000, 23683, 2549, 23683, 15779, 23683, -1000, 1688, 2548, 58,
+	37, -1000, -1000, 1225, 5324, 1225, 5324, -1000, -1000, 652,
+	-1000, -1000, 1015, -1000, 40556, 40556, -1000, -1000, 2544, 1726,
+	-1000, -1000, 12133, -1000, 5324, 5324, -1000, -1000, 24285, 40556,
+	-1000, 7, -1000, 29, 3059, 1213, -1000, -1000, 1217, 1015,
+	2784, 40556, 1213, 1213, -1000, -1000, 13354, 40556, -1000, -1000,
+	-1000, -1000, 40556, 3153, 7800, -1000, 19447, -1000, -1000, 35121,
+	-1000, 34519, 3153, 1700, -1000, 11514, 1996, 171, -1000, 236,
+	-319, 167, 1815, 158, 2232, -1000, -1000, 2318, 2317, 1546,
+	-1000, 1545, 2314, 1525, 1524, -1000, 86, -1000, 2918, 1066,
+	-1000, 2543, -1000, 1515, 2838, -1000, 1194, -1000, 1724, 1514,
+	-1000, 1066, 1506, 2832, 1194, 3059, -1000, 1378, 179, -1000,
+	-1000, 40556, 2219, 1467, 33917, 1156, -1000, 651, 1374, 1369,
+	-1000, 32111, 289, 32111, -1000, 32111, -1000, -1000, 420, -1000,
+	40556, 2927, -1000, -1000, 184, 2167, -355, -1000, -1000, -1000,
+	-1000, -1000, 1466, -1000, 602, 602, 2976, 3781, -1000, 10895,
+	-1000, 10895, 2852, 1679, -1000, 9038, 1982, 44327, 1385, 22479,
+	-69, 40556, -1000, -1000, 9038, 9038, -1000, 2840, -1000, -1000,
+	-1000, -1000, 9038, 9038, 1888, -1000, 40556, -1000, -1000, -1000,
+	-1000, 22479, -1000, 10895, -1000, 9038, 1185, 2834, 1463, 138,
+	138, 138, 2799, 2765, 2748, 1460, 138, 2717, 2696, 2676,
+	2645, 2641, 2607, 2524, 2494, -1000, 2542, -1000, -1000, -1000,
+	-1000, 138, 138, 138, 138, 138, 138, 7181, -1000, 86,
+	9038, 9038, 9038, 2880, -1000, -1000, -1000, 3164, 127, -1000,
+	1712, -1000, -1000, -1000, -1000, -1000, -1000, 842, 258, 2313,
+	1188, -1000, -1000, 40556, -1000, -1000, -1000, 2312, 1184, -1000,
+	-1000, -1000, 44697, 2500, 2500, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2536, -1000, 2500, 2500, -1000, 2498,
+	2498, 2498, 2456, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 2528, 2528, 2531, 2531, 2528, -1000, 2484, 2991,
+	-1000, 132, 1566, 606, 32111, 516, -1000, 40556, 1984, 1706,
+	-275, 3158, -1000, -1000, 40556, -316, 2783, 537, 2900, 40556,
+	29703, 355, 40556, 2527, 2526, 2520, 386, 44697, 40556, 1205,
+	-1000, 1365, 4318, -1000, 40556, 2232, -1000, 43685, -1000, 1676,
+	-1000, -1000, 3153, 1361, -1000, -1000, 3153, 1676, 1676, 1697,
+	40556, -1000, 2989, 44334, 2517, -1000, 40556, -1000, -1000, -1000,
+	795, -1000, 837, 634, 40556, 1824, 837, 1822, 2516, -1000,
+	-1000, 40556, -1000, 40556, 40556, -1000, 40556, 1821, 1808, 40556,
+	40556, 33315, 40556, 40556, 40556, 40556, 40556, 40556, -1000, -1000,
+	40556, -1000, 40556, 40556, 40556, 40556, 2966, -1000, 1566, 1566,
+	813, -1000, -1000, 569, -1000, 1973, 1970, 1966, 1965, 2202,
+	2201, 2199, 1566, 1566, 1964, 2197, 32713, 2196, 1060, 1961,
+	1958, 1956, 1968, 2195, 1207, -1000, 2187, 1962, 1954, 1950,
+	40556, 2515, 2119, -1000, -1000, 132, 1566, 318, 40556, 1704,
+	606, 392, 2006, 20071, 2003, -1000, -1000, 2851, -282, 206,
+	40556, -1000, 40556, 19447, 19447, 19447, 19447, -1000, 2804, 2801,
+	-1000, 2813, 2810, 2817, 40556, 19447, 2484, -1000, 32713, -1000,
+	-1000, 23683, -1000, 3025, 1441, 3062, 920, 9038, 9038, -1000,
+	-1000, 39, 24, -1000, -1000, -1000, 32111, 2185, 524, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 3173, 40556, 40556,
+	658, 2308, -1000, -1000, 3142, -1000, 1170, -1000, -1000, 1360,
+	-1000, 3142, 1761, -337, 11514, 1739, 1652, -1000, 9038, 11514,
+	9038, -248, 305, -251, -1000, -1000, -1000, 2183, -1000, -1000,
+	-1000, 1955, -1000, 1946, 144, 161, 1807, -216, 7181, 394,
+	40556, -216, 40556, 7181, -1000, 40556, 367, -216, -1000, 40556,
+	1943, -1000, -1000, -1000, 3162, 32111, 43685, 1531, 31509, -1000,
+	282, -1000, 281, 556, 2182, -1000, 706, 2171, 124, 2167,
+	-1000, -1000, -1000, 10895, -1000, -1000, -1000, -1000, 2232, 9038,
+	2307, -1000, 878, 878, 2302, -1000, 2500, 2500, -1000, 2456,
+	2498, 2456, 878, 878, 2301, 2497, -1000, 1868, 2443, -1000,
+	2425, 2411, 9038, -1000, 2296, 3724, 1494, -25, 138, 138,
+	-1000, -1000, -1000, -1000, 138, 138, 138, 138, -1000, 138,
+	138, 138, 138, 138, 138, 138, 138, 571, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 144, 2232, 2232, -1000, -1000,
+	2891, 2170, -272, 2167, 2166, -1000, 2162, 2161, 1012, 44697,
+	728, 13354, 2901, 2987, 417, 2007, -1000, -1000, -1000, 30907,
+	546, -1000, -1000, -1000, 641, 301, 1940, 536, -1000, 40556,
+	330, 2843, 1702, 2154, -282, 2782, -1000, -1000, -1000, -1000,
+	2985, -284, 40556, -1000, -1000, -1000, -1000, -1000, -1000, 2900,
+	-1000, 1087, 359, 1408, -1000, 1566, 1566, 13956, 2982, 2491,
+	2489, 13956, 13956, 13956, 375, 1747, -1000, 570, 1091, 1356,
+	19447, 40556, -1000, 29101, 2293, -1000, 1015, 3153, -1000, 40556,
+	-1000, 3153, 3153, 1676, -1000, 417, -1000, 1472, 15160, 560,
+	2956, 44697, -1000, 40556, -1000, 40556, -1000, 40556, 40556, 634,
+	9038, 1168, -1000, -1000, -1000, 40556, -1000, -1000, -1000, 1168,
+	-1000, 1123, -82, 2956, 40556, 635, -1000, -1000, 973, 363,
+	668, 668, 1160, -1000, 2957, -1000, -1000, -1000, -1000, -1000,
+	22479, 22479, 3018, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 2150, 2144, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 40556, 1382, -1000, 1698,
+	2007, 30907, 1693, 1561, 2143, 546, 1984, 1692, -1000, 2141,
+	-1000, 1083, 40556, 40556, -1000, -240, 2140, 2003, 2774, 9038,
+	9038, -1000, -292, -1000, 1312, -1000, 1675, 2753, 2777, 2753,
+	-1000, -1000, -1000, -1000, 2776, -1000, 2460, -1000, -1000, 1312,
+	-1000, -1000, -1000, 2484, -1000, -1000, 920, -1000, 3058, 837,
+	837, 837, 2292, 1226, -1000, -1000, -1000, 1156, 2289, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 3132, 3135,
+	30305, 3132, -1000, -337, 1557, -1000, 1827, 155, 1802, 40556,
+	-1000, -1000, -1000, 2279, 2278, -224, 164, 3134, 3133, 3172,
+	-1000, 2272, 1143, -216, -1000, -1000, 1066, -1000, -1000, -1000,
+	-216, -1000, 1066, -1000, -1000, 43685, -1000, 270, -1000, -1000,
+	-1000, -1000, -1000, -1000, 200, -1000, 40556, -1000, -1000, 116,
+	-1000, 2232, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 10895, -1000, 9038, -1000,
+	-1000, -1000, 2394, -1000, -1000, 9038, 2270, 2133, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 3177, -1000, 3128, -224, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 44697, -1000, 1884, 417,
+	-84, -1000, 2131, 217, -1000, -1000, -1000, 2123, 2120, 1938,
+	-1000, -1000, 1941, 1283, 224, -1000, -1000, -1000, -1000, -1000,
+	-1000, 2006, 2005, 1665, -358, -1000, 2774, 40556, 417, 9038,
+	2483, -1000, 1566, 1566, 1566, 40556, -1000, 29703, 1884, 1884,
+	2269, 1126, -1000, -1000, -1000, -1000, 417, 27897, 13956, 2268,
+	2262, 2256, 594, 1705, 1662, -1000, 1937, 23683, 19447, 29101,
+	1089, -1000, 1338, -1000, -1000, -1000, 3153, -1000, -1000, 3153,
+	-1000, 1380, -1000, 40556, -1000, -1000, 40556, 43652, 2481, -1000,
+	2480, 2759, 409, 2479, 2478, 40556, 2374, -1000, 2476, 696,
+	-1000, 2956, 40556, 646, 2892, -1000, -1000, -1000, -1000, -1000,
+	423, -1000, 40556, -1000, 1498, -1000, 22479, -1000, -1000, 1379,
+	-1000, 2119, 2114, -1000, 217, 2110, 5324, -1000, -1000, 2843,
+	2107, -1000, 40556, 1083, 1083, -1000, 43676, -1000, -240, 733,
+	-1000, -289, 2232, 43365, -1000, 3177, 40556, 7181, -1000, -1000,
+	9038, 2475, -1000, 9038, -1000, -1000, -1000, -1000, -1000, -1000,
+	2466, 2919, -1000, -1000, -1000, -1000, 2027, -1000, -1000, 9038,
+	9038, -1000, -1000, 627, 11514, -256, 303, -1000, -1000, -1000,
+	-227, 2103, -1000, -1000, 3127, 2096, 2031, 40556, -1000, 1066,
+	1066, 1015, -1000, -1000, 82, -1000, 248, 2359, -1000, 2329,
+	138, -1000, 244, 9038, -227, -76, -1000, -1000, -84, -1000,
+	431, -1000, -1000, 408, -1000, -1000, 325, -1000, -1000, 1869,
+	596, -1000, -1000, -1000, 2093, 1851, 2037, 2851, 3057, -1000,
+	43365, 27295, 22479, 23081, 2092, -1000, -1000, -1000, -1000, -87,
+	13956, -1000, 1372, -1000, 1566, 1566, 2255, 44899, 287, 44899,
+	945, 1640, -1000, 1934, -1000, 1933, -1000, 3153, 1089, 1338,
+	-1000, -1000, 1522, -1000, -1000, -1000, 560, 2831, -1000, -1000,
+	43652, -1000, -1000, 1839, 10895, -1000, -1000, 2083, 23081, 798,
+	1659, 1641, 767, 2463, -1000, 444, 3171, -1000, -1000, -1000,
+	802, 2461, -1000, 1786, 1783, -1000, 40556, -1000, 27295, 27295,
+	633, 633, 27295, 27295, 2457, 668, 560, 40556, -1000, -1000,
+	10895, -1000, -1000, -1000, -1000, 1566, 1389, -1000, -1000, -1000,
+	-1000, -1000, -1000, 2005, -1000, -1000, -1000, -1000, 2851, -279,
+	3068, -1000, -1000, 2232, 40556, 2232, 28499, -1000, 3124, 3123,
+	2254, 83, 2232, 997, -1000, -337, 40556, 40556, -229, 1921,
+	-1000, 2065, 168, -1000, -1000, 1010, -231, 133, 157, 2252,
+	377, -107, -1000, -1000, -1000, 2250, -1000, 883, -1000, -1000,
+	-1000, 997, -229, 40556, -1000, 443, -1000, -1000, 554, 2052,
+	-1000, -1000, 324, -1000, -1000, -1000, -1000, -1000, 115, -1000,
+	1637, 2003, -309, -1000, 1366, -1000, 2456, 9038, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 27897, 1884, 1884, -87,
+	564, 44834, -1000, 1105, -1000, 82, 564, 525, 329, -1000,
+	1916, -1000, -1000, 3177, -1000, -1000, 44673, 679, -1000, -1000,
+	2976, -1000, -1000, -1000, -1000, -1000, -1000, 2051, 2046, -1000,
+	-1000, -1000, -1000, -1000, 1914, 2449, -7, 3016, 2044, -1000,
+	-1000, 2448, 1348, 1334, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1284, 1282, 27295, -1000, -1000, 1278, -128, 2976,
+	22479, 1566, -1000, 2003, 9038, 9038, -1000, 1268, 1259, -1000,
+	-1000, -1000, -1000, -1000, 2436, -1000, -1000, -1000, 2435, -1000,
+	-1000, 3122, -1000, 2039, 645, 162, 157, -1000, 3121, 165,
+	3119, 3117, -1000, -78, 2825, -110, -1000, -1000, 586, -217,
+	131, 125, 120, -1000, -1000, -1000, 9038, -1000, 2977, -1000,
+	280, -1000, 198, -1000, -1000, -1000, 2037, 2036, -235, -218,
+	-1000, 27295, 2957, 2247, -1000, -1000, -1000, -1000, 405, 3114,
+	44899, -1000, 1566, -1000, 405, -1000, 364, -1000, 1588, -1000,
+	1904, -1000, 3068, -1000, 44673, 418, -1000, 540, 2432, -1000,
+	-1000, 2118, 9038, 2430, 1566, 2026, -67, 27295, 2757, 2756,
+	2754, 2413, 1250, -1000, -158, -159, -1000, 22479, -240, 213,
+	2232, -1000, -1000, 26693, 41158, 40556, 2031, -1000, 2429, 1897,
+	164, 151, 3106, -1000, 2031, 3103, 2031, 2031, 2814, -1000,
+	-1000, 1775, -1000, 134, 128, 123, -1000, -1000, -1000, -1000,
+	2035, -1000, -1000, -1000, -1000, 43676, -308, 285, -1000, -1000,
+	2957, -1000, 3074, 437, -1000, 22479, -1000, -1000, -1000, 1895,
+	-1000, -1000, -1000, -1000, 1249, -1000, 40556, 745, 9038, -1000,
+	2238, -1000, 40556, -1000, 2772, -1000, 241, 1234, 285, 633,
+	285, 633, 285, 633, 285, 633, 278, -1000, -1000, -1000,
+	-1000, 9038, -1000, -1000, 1208, -1000, 3885, 216, 1197, -1000,
+	-1000, 3014, 21877, 147, -1000, -1000, -1000, 2032, -1000, 2031,
+	-1000, -1000, -90, 586, -1000, -1000, -1000, -1000, -1000, 43594,
+	40556, 40556, -1000, 1566, 2029, 348, -1000, -1000, 1566, 2028,
+	-1000, 26091, 424, 472, 463, -1000, 597, -1000, -1000, -1000,
+	-1000, 418, 44899, -1000, 9038, 1191, 668, -1000, -1000, 2771,
+	2770, 3161, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 2232, -1000, 41158, -325, 266, 2027, -1000, -1000, 40556,
+	-1000, 40556, -1000, 1167, -1000, -1000, -1000, 1303, -1000, -1000,
+	-1000, -1000, -108, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1892, -1000, 40556, 1867, -1000, -1000, 40556, 416, 1862,
+	10895, 2428, 10895, 2427, 430, 2416, -1000, 44899, 1153, -1000,
+	-1000, -1000, 3163, -1000, 3169, 743, 743, 285, 285, 285,
+	285, -1000, -1000, 2025, -321, -1000, -1000, 956, -1000, 1553,
+	-1000, 25489, 40556, -129, -1000, -1000, -1000, 1134, 2414, 3070,
+	-1000, 3649, 40556, 3260, 40556, 2409, 1551, 10895, -1000, -1000,
+	-1000, -1000, 1902, 464, -1000, 254, 2022, 40556, 6562, -1000,
+	1292, -1000, -1000, -1000, 560, 428, -1000, 1117, -1000, 1080,
+	24887, 1859, 3131, -1000, -1000, -1000, -1000, 2767, -1000, -1000,
+	2000, -1000, -1000, -1000, 2232, 40556, 1074, 410, -1000, -1000,
+	-1000, 1049, -1000, -1000, 293, -1000, -1000, -1000, -1000, 1826,
+	-1000, 254, -1000, -1000, 2766, -328, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 148, 3000, 239, 154, 3595, 70, 238, 209, 206,
-	236, 3594, 3593, 2764, 2751, 3590, 3589, 3588, 3587, 3586,
-	3585, 3585, 3584, 3583, 3582, 3581, 3580, 3578, 3575, 3574,
-	3572, 3571, 3570, 3569, 3568, 3567, 3566, 3565, 3564, 3563,
-	3562, 3561, 235, 3560, 3559, 3558, 3557, 3556, 3555, 3554,
-	3553, 3552, 3551, 3550, 3549, 3548, 3547, 3546, 3545, 3543,
-	3542, 3541, 3540, 3539, 3538, 3537, 3536, 3523, 3522, 3521,
-	3520, 3519, 3518, 3517, 202, 3516, 2747, 3515, 3514, 3513,
-	3512, 3505, 3504, 3503, 221, 3502, 3501, 3487, 3485, 3477,
-	3471, 3463, 3462, 3461, 3460, 3459, 217, 3457, 3456, 3455,
-	3454, 3453, 3452, 213, 3450, 152, 181, 3449, 3447, 3446,
-	3445, 3443, 3442, 231, 219, 65, 3441, 44, 3440, 210,
-	3439, 131, 3438, 128, 3420, 3419, 3418, 3417, 3416, 3415,
-	3414, 3401, 3399, 3391, 3385, 3377, 3376, 3375, 3374, 3360,
-	84, 3359, 250, 3356, 69, 3355, 3353, 107, 124, 176,
-	247, 2315, 249, 241, 162, 150, 83, 3352, 2774, 3350,
-	141, 220, 134, 23, 3348, 157, 3347, 137, 43, 61,
-	240, 115, 55, 139, 125, 3346, 207, 97, 96, 3345,
-	3344, 172, 244, 166, 3343, 108, 3342, 3341, 3340, 3339,
-	190, 169, 3325, 3323, 110, 3322, 3321, 123, 3320, 67,
-	180, 147, 178, 3319, 852, 117, 100, 105, 3318, 3317,
-	119, 3316, 3314, 3313, 3311, 161, 3310, 3303, 114, 71,
-	3302, 3301, 3300, 66, 3299, 45, 3297, 3296, 31, 3295,
-	57, 3294, 3293, 3291, 3289, 3288, 3287, 3285, 3283, 3282,
-	3281, 3280, 68, 3279, 3277, 16, 17, 19, 3276, 26,
-	3275, 149, 3274, 3271, 3270, 82, 80, 3268, 81, 1,
-	46, 85, 3267, 215, 245, 90, 130, 3264, 399, 3263,
-	3261, 3259, 140, 3255, 1389, 3254, 3253, 3252, 3251, 3250,
-	116, 3249, 208, 48, 3246, 113, 132, 3245, 36, 42,
-	189, 191, 3244, 3242, 3240, 301, 175, 87, 29, 0,
-	3234, 136, 3232, 3231, 3228, 246, 3226, 225, 197, 146,
-	212, 260, 151, 3223, 3222, 64, 3221, 142, 54, 51,
-	122, 9, 20, 224, 3220, 14, 164, 3219, 193, 3218,
-	173, 7, 127, 3217, 3215, 27, 259, 3214, 3210, 3209,
-	106, 3208, 3206, 168, 74, 3205, 3204, 3198, 3196, 34,
-	3195, 35, 25, 3193, 89, 3191, 233, 3190, 237, 111,
-	163, 156, 129, 214, 222, 73, 72, 3185, 1653, 133,
-	92, 18, 3184, 216, 3182, 145, 118, 3180, 76, 3179,
-	234, 354, 200, 3178, 165, 8, 41, 33, 3177, 10,
-	3176, 177, 196, 3175, 3174, 22, 49, 3173, 30, 3171,
-	3170, 3169, 3168, 5, 3167, 3165, 3163, 2, 4, 3161,
-	3, 204, 3160, 3159, 3158, 3157, 47, 227, 3155, 138,
-	171, 160, 3154, 86, 3153, 3151, 3148, 799, 3144, 242,
-	3141, 3139, 3138, 3137, 3136, 3135, 3132, 3130, 3129, 93,
-	38, 3128, 3127, 3126, 3124, 75, 121, 3122, 3121, 3119,
-	3116, 37, 158, 3115, 21, 3114, 32, 28, 39, 3113,
-	109, 3111, 12, 170, 3110, 3109, 15, 3107, 3106, 11,
-	13, 3105, 3103, 104, 3098, 91, 24, 143, 94, 3096,
-	3095, 78, 211, 126, 3093, 3091, 230, 229, 192, 3087,
-	313, 232, 243, 3086, 203, 3081, 3080, 3078, 3076, 3073,
-	3071, 1044, 3070, 3069, 223, 62, 88, 99, 3068, 3064,
-	3062, 79, 144, 103, 101, 56, 77, 3060, 98, 188,
-	3058, 179, 3057, 3055, 95, 3053, 3052, 3051, 3033, 3029,
-	167, 3028, 3011, 174, 226, 3004, 3003, 3002, 2998, 228,
-	218, 2989,
+	0, 175, 24, 257, 28, 3743, 97, 253, 241, 222,
+	252, 3742, 3741, 2928, 2913, 3740, 3739, 3738, 3736, 3735,
+	3734, 3734, 3733, 3730, 3728, 3727, 3726, 3725, 3724, 3721,
+	3720, 3719, 3718, 3717, 3716, 3715, 3714, 3713, 3712, 3710,
+	3708, 3707, 247, 3706, 3699, 3698, 3696, 3695, 3694, 3693,
+	3692, 3690, 3689, 3688, 3687, 3685, 3684, 3683, 3682, 3681,
+	3678, 3677, 3675, 3674, 3671, 3668, 3667, 3666, 3665, 3664,
+	3661, 3655, 3654, 3653, 219, 3652, 2912, 3651, 3650, 3649,
+	3648, 3647, 3629, 3628, 254, 3627, 3626, 3625, 3624, 3622,
+	3621, 3620, 3619, 3618, 3617, 3615, 246, 3613, 3611, 3610,
+	3609, 3608, 3603, 243, 3602, 173, 210, 3601, 3600, 3599,
+	3598, 3597, 142, 3596, 244, 220, 75, 3595, 61, 27,
+	221, 3593, 153, 21, 150, 3589, 3584, 3583, 3582, 3581,
+	3579, 3578, 3577, 3576, 3575, 3574, 3572, 3571, 3570, 3569,
+	3567, 3564, 3559, 3558, 3557, 3556, 3555, 3554, 3551, 3550,
+	3549, 3547, 106, 3546, 262, 3545, 84, 3543, 3539, 136,
+	407, 209, 260, 1661, 264, 259, 190, 176, 100, 3538,
+	2924, 3537, 165, 233, 160, 38, 3536, 183, 3535, 158,
+	59, 47, 256, 146, 71, 112, 132, 179, 218, 119,
+	118, 3534, 3533, 187, 258, 194, 3532, 138, 3531, 3530,
+	3527, 3525, 214, 189, 3523, 3522, 140, 3520, 162, 87,
+	131, 109, 231, 73, 55, 223, 3519, 3517, 3516, 40,
+	3515, 15, 16, 3513, 1, 3512, 111, 3511, 82, 133,
+	170, 128, 3509, 2345, 127, 134, 126, 3508, 3507, 32,
+	3501, 3496, 3495, 3493, 181, 3492, 3491, 147, 81, 3489,
+	3483, 3480, 78, 3479, 66, 3476, 3474, 37, 3473, 77,
+	3472, 3470, 3463, 3462, 3459, 3457, 3456, 3454, 3453, 3451,
+	3450, 85, 3447, 3446, 11, 14, 17, 3445, 36, 3444,
+	171, 3439, 3437, 3435, 3431, 104, 96, 3430, 99, 12,
+	65, 105, 3429, 226, 137, 110, 154, 3428, 467, 199,
+	3427, 3421, 164, 3420, 353, 3419, 3418, 3417, 3416, 3415,
+	42, 3414, 229, 68, 3412, 139, 143, 3411, 52, 60,
+	200, 212, 135, 3410, 3408, 22, 206, 103, 79, 0,
+	3392, 156, 3391, 3390, 3389, 265, 3388, 237, 227, 166,
+	297, 266, 177, 3386, 3385, 80, 3383, 161, 70, 74,
+	152, 5, 31, 561, 3382, 13, 186, 3380, 215, 3374,
+	172, 33, 155, 3373, 3372, 44, 261, 3371, 3370, 3366,
+	130, 3364, 3363, 263, 89, 3362, 3361, 3359, 3357, 3356,
+	50, 3355, 48, 26, 3354, 114, 3353, 245, 3352, 208,
+	145, 184, 180, 157, 228, 235, 92, 90, 3349, 1761,
+	159, 113, 19, 3344, 232, 3343, 234, 120, 3341, 102,
+	3339, 249, 403, 216, 3338, 185, 3, 58, 45, 3333,
+	4, 3332, 289, 341, 3331, 3327, 35, 72, 3325, 23,
+	3323, 3322, 3320, 3319, 88, 3316, 2, 3314, 18, 20,
+	3313, 46, 213, 3312, 3311, 3310, 3309, 69, 378, 3308,
+	167, 182, 178, 3307, 108, 3306, 3305, 3304, 1811, 3302,
+	255, 3301, 3299, 3298, 3295, 3294, 3293, 3292, 3291, 3289,
+	116, 53, 3288, 3287, 3286, 3285, 94, 149, 3284, 3283,
+	3281, 3278, 49, 188, 3264, 30, 3263, 43, 39, 51,
+	3262, 144, 3261, 9, 205, 3260, 3259, 10, 3256, 3255,
+	7, 8, 3254, 3253, 129, 3252, 101, 34, 169, 115,
+	3251, 3249, 98, 224, 148, 3248, 3247, 91, 239, 201,
+	3246, 248, 238, 280, 3245, 217, 3243, 3242, 3241, 3237,
+	3236, 3235, 1034, 3234, 3232, 242, 83, 95, 123, 3231,
+	3230, 3223, 93, 163, 125, 124, 76, 86, 3220, 122,
+	211, 3219, 197, 3218, 3217, 121, 3212, 3211, 3210, 3209,
+	3208, 192, 3207, 3206, 198, 240, 3205, 3202, 3195, 3194,
+	250, 319, 3193,
 }
 
-//line mysql_sql.y:10356
+//line mysql_sql.y:11125
 type yySymType struct {
 	union interface{}
 	id    int
@@ -6048,6 +6855,31 @@ func (st *yySymType) epxlainOptionsUnion() []tree.OptionElem {
 	return v
 }
 
+func (st *yySymType) eventCommentUnion() tree.EventComment {
+	v, _ := st.union.(tree.EventComment)
+	return v
+}
+
+func (st *yySymType) eventCompletionUnion() tree.EventCompletion {
+	v, _ := st.union.(tree.EventCompletion)
+	return v
+}
+
+func (st *yySymType) eventNameUnion() *tree.EventName {
+	v, _ := st.union.(*tree.EventName)
+	return v
+}
+
+func (st *yySymType) eventScheduleUnion() *tree.EventSchedule {
+	v, _ := st.union.(*tree.EventSchedule)
+	return v
+}
+
+func (st *yySymType) eventStatusUnion() tree.EventStatus {
+	v, _ := st.union.(tree.EventStatus)
+	return v
+}
+
 func (st *yySymType) exportParmUnion() *tree.ExportParam {
 	v, _ := st.union.(*tree.ExportParam)
 	return v
@@ -6098,6 +6930,11 @@ func (st *yySymType) fullOptUnion() bool {
 	return v
 }
 
+func (st *yySymType) fullTextSearchTypeUnion() tree.FullTextSearchType {
+	v, _ := st.union.(tree.FullTextSearchType)
+	return v
+}
+
 func (st *yySymType) funcArgUnion() tree.FunctionArg {
 	v, _ := st.union.(tree.FunctionArg)
 	return v
@@ -6218,6 +7055,31 @@ func (st *yySymType) joinTableExprUnion() *tree.JoinTableExpr {
 	return v
 }
 
+func (st *yySymType) jsonTableUnion() *tree.JsonTable {
+	v, _ := st.union.(*tree.JsonTable)
+	return v
+}
+
+func (st *yySymType) jsonTableColumnUnion() *tree.JsonTableColumn {
+	v, _ := st.union.(*tree.JsonTableColumn)
+	return v
+}
+
+func (st *yySymType) jsonTableColumnsUnion() []*tree.JsonTableColumn {
+	v, _ := st.union.([]*tree.JsonTableColumn)
+	return v
+}
+
+func (st *yySymType) jsonTableOnResponseUnion() *tree.JsonTableOnResponse {
+	v, _ := st.union.(*tree.JsonTableOnResponse)
+	return v
+}
+
+func (st *yySymType) jsonTableOnResponsesUnion() [2]*tree.JsonTableOnResponse {
+	v, _ := st.union.([2]*tree.JsonTableOnResponse)
+	return v
+}
+
 func (st *yySymType) keyPartUnion() *tree.KeyPart {
 	v, _ := st.union.(*tree.KeyPart)
 	return v
@@ -6623,6 +7485,26 @@ func (st *yySymType) transactionCharacteristicListUnion() []*tree.TransactionCha
 	return v
 }
 
+func (st *yySymType) triggerEventUnion() tree.TriggerEvent {
+	v, _ := st.union.(tree.TriggerEvent)
+	return v
+}
+
+func (st *yySymType) triggerNameUnion() *tree.TriggerName {
+	v, _ := st.union.(*tree.TriggerName)
+	return v
+}
+
+func (st *yySymType) triggerOrderUnion() *tree.TriggerOrder {
+	v, _ := st.union.(*tree.TriggerOrder)
+	return v
+}
+
+func (st *yySymType) triggerTimeUnion() tree.TriggerTime {
+	v, _ := st.union.(tree.TriggerTime)
+	return v
+}
+
 func (st *yySymType) tupleUnion() *tree.Tuple {
 	v, _ := st.union.(*tree.Tuple)
 	return v
@@ -6759,195 +7641,209 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 529, 537, 537, 5, 5, 2, 6, 6, 3,
+	0, 560, 568, 568, 5, 5, 2, 6, 6, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	1, 1, 1, 1, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
 	4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 111, 111, 110, 527, 527, 527, 528,
-	528, 107, 128, 127, 130, 130, 129, 129, 126, 126,
-	122, 125, 125, 124, 124, 123, 118, 120, 120, 119,
-	121, 121, 108, 96, 109, 472, 472, 471, 471, 470,
-	470, 424, 424, 469, 469, 469, 468, 468, 468, 467,
-	467, 466, 466, 465, 465, 463, 463, 464, 462, 461,
-	461, 461, 459, 459, 459, 455, 455, 457, 456, 456,
-	458, 450, 450, 453, 453, 451, 451, 451, 451, 454,
-	449, 449, 449, 448, 448, 95, 95, 95, 370, 370,
-	94, 94, 384, 384, 384, 384, 384, 382, 382, 382,
-	382, 382, 382, 381, 381, 380, 380, 385, 385, 383,
-	383, 383, 383, 383, 383, 383, 383, 383, 383, 383,
-	383, 383, 383, 383, 383, 383, 383, 383, 383, 383,
-	383, 383, 383, 383, 383, 383, 383, 383, 383, 383,
-	383, 383, 383, 383, 383, 383, 383, 383, 383, 383,
-	383, 383, 383, 383, 383, 383, 383, 383, 383, 85,
-	85, 85, 85, 85, 90, 90, 90, 534, 534, 533,
-	533, 535, 535, 535, 535, 536, 536, 88, 88, 88,
-	89, 379, 379, 379, 86, 87, 87, 369, 369, 374,
-	374, 373, 373, 373, 373, 373, 373, 373, 373, 373,
-	373, 373, 373, 373, 378, 378, 378, 376, 376, 375,
-	375, 377, 377, 79, 79, 79, 82, 81, 368, 368,
-	368, 368, 368, 368, 368, 368, 368, 80, 80, 80,
-	80, 80, 80, 75, 75, 75, 75, 75, 74, 74,
-	76, 76, 366, 366, 365, 91, 91, 92, 531, 531,
-	530, 532, 532, 532, 532, 93, 99, 99, 99, 99,
-	99, 99, 99, 98, 98, 101, 101, 100, 102, 84,
-	84, 84, 84, 84, 84, 83, 83, 83, 83, 83,
-	83, 83, 83, 83, 497, 497, 497, 499, 499, 303,
-	304, 538, 306, 302, 302, 302, 493, 493, 494, 495,
-	496, 496, 496, 97, 11, 11, 11, 11, 11, 11,
-	11, 73, 78, 250, 250, 251, 251, 251, 251, 251,
-	251, 251, 251, 251, 251, 251, 251, 251, 251, 251,
-	251, 251, 251, 251, 251, 251, 251, 238, 238, 238,
-	233, 233, 233, 233, 234, 234, 235, 235, 236, 236,
-	236, 236, 237, 237, 296, 296, 255, 255, 255, 257,
-	257, 256, 254, 252, 252, 252, 252, 252, 252, 253,
-	253, 253, 258, 258, 71, 77, 77, 77, 510, 510,
-	72, 521, 521, 427, 427, 317, 317, 316, 316, 316,
-	316, 316, 316, 316, 316, 316, 316, 316, 316, 316,
-	316, 316, 316, 432, 433, 313, 42, 42, 42, 42,
+	4, 4, 4, 4, 111, 111, 112, 112, 110, 558,
+	558, 558, 559, 559, 107, 129, 128, 131, 131, 130,
+	130, 127, 127, 123, 126, 126, 125, 125, 124, 119,
+	121, 121, 120, 122, 122, 108, 96, 109, 503, 503,
+	502, 502, 501, 501, 455, 455, 500, 500, 500, 499,
+	499, 499, 498, 498, 497, 497, 496, 496, 494, 494,
+	495, 493, 492, 492, 492, 490, 490, 490, 486, 486,
+	488, 487, 487, 489, 481, 481, 484, 484, 482, 482,
+	482, 482, 485, 480, 480, 480, 479, 479, 95, 95,
+	95, 401, 401, 94, 94, 415, 415, 415, 415, 415,
+	413, 413, 413, 413, 413, 413, 412, 412, 411, 411,
+	416, 416, 414, 414, 414, 414, 414, 414, 414, 414,
+	414, 414, 414, 414, 414, 414, 414, 414, 414, 414,
+	414, 414, 414, 414, 414, 414, 414, 414, 414, 414,
+	414, 414, 414, 414, 414, 414, 414, 414, 414, 414,
+	414, 414, 414, 414, 414, 414, 414, 414, 414, 414,
+	414, 414, 85, 85, 85, 85, 85, 90, 90, 90,
+	565, 565, 564, 564, 566, 566, 566, 566, 567, 567,
+	88, 88, 88, 89, 410, 410, 410, 86, 87, 87,
+	400, 400, 405, 405, 404, 404, 404, 404, 404, 404,
+	404, 404, 404, 404, 404, 404, 404, 409, 409, 409,
+	407, 407, 406, 406, 408, 408, 79, 79, 79, 82,
+	81, 399, 399, 399, 399, 399, 399, 399, 399, 399,
+	80, 80, 80, 80, 80, 80, 75, 75, 75, 75,
+	75, 74, 74, 76, 76, 397, 397, 396, 91, 91,
+	92, 562, 562, 561, 563, 563, 563, 563, 93, 99,
+	99, 99, 99, 99, 99, 99, 98, 98, 101, 101,
+	100, 102, 84, 84, 84, 84, 84, 84, 83, 83,
+	83, 83, 83, 83, 83, 83, 83, 528, 528, 528,
+	530, 530, 530, 333, 334, 569, 336, 332, 332, 332,
+	524, 524, 525, 526, 527, 527, 527, 97, 11, 11,
+	11, 11, 11, 11, 11, 11, 73, 78, 78, 284,
+	284, 284, 284, 284, 284, 284, 284, 279, 279, 280,
+	280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+	280, 280, 280, 280, 280, 280, 280, 280, 280, 280,
+	280, 267, 267, 267, 262, 262, 262, 262, 263, 263,
+	264, 264, 265, 265, 265, 265, 266, 266, 326, 326,
+	285, 285, 285, 287, 287, 286, 283, 281, 281, 281,
+	281, 281, 281, 281, 281, 282, 282, 282, 288, 288,
+	71, 77, 77, 77, 541, 541, 72, 552, 552, 458,
+	458, 347, 347, 346, 346, 346, 346, 346, 346, 346,
+	346, 346, 346, 346, 346, 346, 346, 346, 346, 463,
+	464, 343, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	42, 42, 42, 42, 42, 49, 52, 48, 48, 48,
-	355, 355, 47, 539, 539, 291, 291, 60, 59, 51,
-	61, 62, 63, 64, 65, 66, 46, 58, 58, 58,
-	58, 58, 58, 58, 58, 69, 69, 444, 444, 541,
-	541, 541, 67, 68, 426, 426, 426, 57, 56, 55,
-	54, 53, 53, 45, 45, 44, 44, 50, 134, 135,
-	310, 310, 310, 312, 312, 308, 540, 540, 398, 398,
-	311, 311, 43, 43, 43, 43, 70, 309, 309, 290,
-	307, 307, 307, 12, 12, 10, 15, 15, 15, 15,
-	15, 15, 15, 15, 15, 15, 15, 15, 15, 24,
-	25, 27, 363, 363, 360, 26, 18, 17, 17, 21,
-	20, 16, 16, 19, 22, 23, 23, 9, 9, 9,
-	9, 13, 13, 14, 155, 155, 205, 205, 505, 505,
-	501, 501, 502, 502, 502, 503, 503, 504, 504, 103,
-	438, 438, 438, 438, 438, 438, 8, 177, 177, 437,
-	437, 437, 437, 437, 437, 367, 367, 482, 482, 482,
-	483, 176, 176, 171, 171, 439, 439, 332, 484, 484,
-	447, 447, 446, 446, 445, 445, 174, 174, 175, 175,
-	158, 158, 114, 114, 452, 452, 452, 452, 460, 460,
-	423, 423, 242, 242, 283, 283, 284, 284, 148, 148,
-	149, 149, 149, 149, 149, 149, 490, 490, 492, 492,
-	491, 173, 173, 169, 169, 170, 170, 170, 168, 168,
-	167, 166, 166, 165, 163, 163, 163, 164, 164, 164,
-	181, 181, 151, 151, 151, 150, 150, 150, 150, 150,
-	268, 268, 268, 268, 268, 268, 268, 268, 268, 268,
-	268, 268, 152, 152, 498, 498, 498, 428, 428, 428,
-	435, 435, 265, 265, 266, 266, 264, 264, 153, 153,
-	154, 154, 154, 154, 263, 263, 262, 156, 156, 162,
-	161, 161, 157, 157, 157, 157, 273, 273, 272, 272,
-	272, 272, 106, 112, 112, 113, 180, 180, 271, 270,
-	270, 270, 179, 179, 178, 178, 172, 172, 160, 160,
-	160, 160, 269, 159, 267, 526, 526, 525, 525, 524,
-	522, 522, 522, 523, 523, 523, 523, 474, 474, 474,
-	474, 474, 297, 297, 297, 301, 301, 300, 300, 300,
-	300, 300, 305, 7, 7, 7, 7, 7, 7, 31,
-	31, 31, 31, 31, 31, 31, 31, 31, 31, 37,
-	189, 190, 38, 191, 191, 192, 192, 193, 193, 194,
-	195, 196, 196, 196, 196, 36, 182, 182, 183, 183,
-	184, 184, 185, 186, 186, 186, 188, 187, 35, 35,
-	28, 141, 141, 142, 142, 142, 142, 144, 144, 239,
-	239, 239, 143, 143, 145, 145, 145, 506, 509, 507,
-	507, 511, 511, 511, 512, 512, 512, 512, 513, 513,
-	29, 131, 136, 516, 516, 516, 515, 515, 517, 517,
-	518, 518, 287, 287, 288, 288, 139, 140, 140, 138,
-	133, 147, 147, 147, 147, 147, 132, 137, 508, 514,
-	514, 514, 364, 364, 361, 362, 362, 359, 358, 358,
-	358, 520, 520, 519, 519, 519, 298, 298, 30, 354,
-	354, 356, 357, 357, 357, 348, 348, 348, 348, 34,
-	352, 352, 353, 353, 353, 353, 353, 349, 349, 351,
-	351, 347, 347, 347, 347, 347, 33, 146, 146, 346,
-	346, 343, 343, 341, 341, 342, 342, 340, 340, 340,
-	344, 344, 41, 40, 40, 40, 345, 345, 32, 32,
-	32, 116, 115, 115, 115, 286, 286, 285, 285, 117,
-	39, 217, 217, 412, 412, 412, 412, 412, 430, 430,
-	430, 413, 413, 413, 414, 414, 414, 415, 415, 415,
-	415, 415, 429, 429, 431, 431, 431, 386, 386, 387,
-	387, 387, 390, 390, 404, 404, 405, 405, 403, 403,
-	410, 410, 409, 409, 408, 408, 407, 407, 406, 406,
-	406, 406, 401, 401, 400, 400, 388, 388, 388, 388,
-	388, 389, 389, 389, 399, 399, 402, 402, 227, 227,
-	261, 261, 225, 225, 226, 226, 260, 260, 223, 223,
-	224, 224, 224, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 259, 259,
-	259, 259, 259, 259, 259, 259, 259, 259, 480, 480,
-	481, 229, 229, 240, 240, 240, 240, 240, 240, 228,
-	228, 230, 230, 206, 206, 204, 204, 197, 197, 198,
-	198, 199, 199, 199, 203, 203, 202, 202, 202, 202,
-	200, 200, 201, 201, 201, 201, 201, 201, 372, 372,
-	477, 477, 478, 478, 473, 473, 473, 476, 476, 476,
-	476, 476, 479, 479, 479, 475, 475, 207, 281, 281,
-	281, 299, 299, 299, 299, 280, 280, 280, 222, 222,
-	221, 221, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 219, 219, 219, 219, 219, 219,
-	219, 219, 219, 219, 371, 371, 314, 314, 315, 315,
-	249, 248, 248, 248, 248, 248, 246, 247, 245, 245,
-	245, 245, 245, 244, 244, 243, 243, 243, 350, 350,
-	241, 241, 232, 232, 232, 231, 231, 231, 411, 321,
-	321, 321, 321, 321, 321, 321, 321, 321, 321, 321,
-	321, 321, 323, 323, 323, 323, 323, 323, 323, 323,
-	323, 323, 323, 323, 323, 323, 323, 323, 323, 323,
-	323, 323, 323, 279, 279, 279, 324, 324, 329, 329,
-	489, 489, 488, 208, 208, 208, 209, 209, 209, 209,
-	209, 209, 209, 209, 209, 218, 218, 218, 395, 395,
-	395, 395, 396, 396, 396, 396, 397, 397, 397, 393,
-	393, 394, 394, 333, 334, 334, 436, 436, 391, 391,
-	392, 278, 278, 278, 278, 278, 278, 278, 278, 278,
-	278, 278, 278, 278, 278, 278, 278, 278, 278, 278,
-	443, 443, 443, 275, 275, 275, 275, 275, 275, 275,
-	275, 275, 275, 275, 275, 275, 275, 275, 275, 500,
-	500, 500, 485, 485, 485, 486, 486, 486, 486, 486,
-	486, 486, 486, 486, 486, 486, 486, 487, 487, 487,
-	487, 487, 487, 487, 487, 487, 487, 487, 487, 487,
-	487, 487, 487, 487, 277, 277, 277, 276, 276, 276,
-	276, 276, 276, 276, 276, 276, 276, 276, 276, 276,
-	276, 276, 335, 335, 336, 336, 440, 440, 440, 440,
-	440, 440, 441, 441, 442, 442, 442, 442, 434, 434,
-	434, 434, 434, 434, 434, 434, 434, 434, 434, 434,
-	434, 434, 434, 434, 434, 434, 434, 434, 434, 434,
-	434, 434, 434, 434, 434, 434, 434, 322, 274, 274,
-	274, 337, 330, 330, 331, 331, 325, 325, 325, 325,
-	325, 325, 325, 327, 327, 327, 327, 327, 327, 327,
-	327, 327, 327, 327, 320, 320, 320, 320, 320, 320,
-	320, 320, 320, 320, 320, 326, 326, 328, 328, 339,
-	339, 339, 338, 338, 338, 338, 338, 338, 338, 220,
-	220, 220, 220, 319, 319, 319, 318, 318, 318, 318,
-	318, 318, 318, 318, 318, 318, 318, 318, 210, 210,
-	210, 210, 214, 214, 216, 216, 216, 216, 216, 216,
-	216, 216, 216, 216, 216, 216, 216, 216, 215, 215,
-	215, 215, 215, 213, 213, 213, 213, 213, 211, 211,
-	211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
-	211, 211, 211, 211, 104, 105, 105, 212, 282, 282,
-	416, 416, 419, 419, 417, 417, 418, 420, 420, 420,
-	421, 421, 421, 422, 422, 422, 425, 425, 289, 289,
-	289, 295, 295, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 294, 294,
-	294, 294, 294, 294, 294, 294, 294, 294, 293, 293,
-	293, 293, 293, 293, 293, 293, 293, 293, 292, 292,
-	292, 292, 292, 292, 292, 292, 292, 292, 292, 292,
-	292, 292, 292, 292, 292, 292, 292, 292, 292, 292,
-	292, 292, 292, 292, 292, 292, 292, 292, 292, 292,
-	292, 292, 292, 292, 292, 292, 292, 292, 292, 292,
-	292,
+	42, 49, 52, 48, 48, 48, 386, 386, 47, 570,
+	570, 321, 321, 60, 59, 51, 61, 62, 63, 64,
+	65, 66, 46, 58, 58, 58, 58, 58, 58, 58,
+	58, 69, 69, 475, 475, 572, 572, 572, 67, 68,
+	457, 457, 457, 57, 56, 55, 54, 53, 53, 45,
+	45, 44, 44, 50, 135, 136, 340, 340, 340, 342,
+	342, 338, 571, 571, 429, 429, 341, 341, 43, 43,
+	43, 43, 70, 339, 339, 320, 337, 337, 337, 12,
+	12, 10, 15, 15, 15, 15, 15, 15, 15, 15,
+	15, 15, 15, 15, 15, 15, 15, 15, 24, 25,
+	27, 394, 394, 391, 26, 18, 17, 17, 21, 20,
+	16, 16, 19, 22, 23, 23, 9, 9, 9, 9,
+	13, 13, 14, 167, 167, 234, 234, 536, 536, 532,
+	532, 533, 533, 533, 534, 534, 535, 535, 103, 469,
+	469, 469, 469, 469, 469, 8, 189, 189, 468, 468,
+	468, 468, 468, 468, 398, 398, 513, 513, 513, 514,
+	188, 188, 183, 183, 470, 470, 362, 515, 515, 478,
+	478, 477, 477, 476, 476, 186, 186, 187, 187, 170,
+	170, 115, 115, 483, 483, 483, 483, 491, 491, 454,
+	454, 271, 271, 313, 313, 314, 314, 160, 160, 161,
+	161, 161, 161, 161, 161, 521, 521, 523, 523, 522,
+	185, 185, 181, 181, 182, 182, 182, 180, 180, 179,
+	178, 178, 177, 175, 175, 175, 176, 176, 176, 193,
+	193, 163, 163, 163, 162, 162, 162, 162, 162, 298,
+	298, 298, 298, 298, 298, 298, 298, 298, 298, 298,
+	298, 164, 164, 529, 529, 529, 459, 459, 459, 466,
+	466, 295, 295, 296, 296, 294, 294, 165, 165, 166,
+	166, 166, 166, 293, 293, 292, 168, 168, 174, 173,
+	173, 169, 169, 169, 169, 303, 303, 302, 302, 302,
+	302, 106, 113, 113, 114, 192, 192, 301, 300, 300,
+	300, 191, 191, 190, 190, 184, 184, 172, 172, 172,
+	172, 172, 299, 171, 171, 221, 223, 223, 222, 222,
+	222, 222, 222, 225, 225, 225, 225, 224, 224, 224,
+	297, 557, 557, 556, 556, 555, 553, 553, 553, 554,
+	554, 554, 554, 505, 505, 505, 505, 505, 327, 327,
+	327, 331, 331, 330, 330, 330, 330, 330, 335, 7,
+	7, 7, 7, 7, 7, 7, 7, 7, 31, 31,
+	31, 31, 31, 31, 31, 31, 31, 31, 37, 201,
+	202, 38, 203, 203, 204, 204, 205, 205, 206, 207,
+	220, 220, 220, 220, 36, 194, 194, 195, 195, 196,
+	196, 197, 198, 198, 198, 200, 199, 35, 35, 28,
+	153, 153, 154, 154, 154, 154, 156, 156, 268, 268,
+	268, 155, 155, 157, 157, 157, 537, 540, 538, 538,
+	542, 542, 542, 543, 543, 543, 543, 544, 544, 29,
+	132, 137, 547, 547, 547, 546, 546, 548, 548, 549,
+	549, 317, 317, 318, 318, 151, 152, 152, 139, 134,
+	159, 159, 159, 159, 159, 133, 138, 140, 142, 142,
+	142, 141, 143, 216, 216, 217, 217, 217, 218, 218,
+	218, 145, 145, 145, 145, 144, 146, 149, 149, 147,
+	150, 150, 150, 148, 215, 215, 208, 208, 210, 210,
+	210, 210, 210, 211, 211, 212, 212, 209, 209, 213,
+	213, 213, 214, 214, 219, 219, 539, 545, 545, 545,
+	395, 395, 392, 393, 393, 390, 389, 389, 389, 551,
+	551, 550, 550, 550, 328, 328, 30, 385, 385, 387,
+	388, 388, 388, 388, 378, 378, 378, 378, 34, 383,
+	383, 384, 384, 384, 384, 384, 384, 384, 380, 380,
+	382, 382, 377, 377, 377, 377, 377, 377, 33, 158,
+	158, 376, 376, 373, 373, 371, 371, 372, 372, 370,
+	370, 370, 374, 374, 41, 40, 40, 40, 375, 375,
+	32, 32, 32, 117, 116, 116, 116, 116, 316, 316,
+	315, 315, 118, 39, 246, 246, 443, 443, 443, 443,
+	443, 461, 461, 461, 444, 444, 444, 445, 445, 445,
+	446, 446, 446, 446, 446, 460, 460, 462, 462, 462,
+	417, 417, 418, 418, 418, 421, 421, 435, 435, 436,
+	436, 434, 434, 441, 441, 440, 440, 439, 439, 438,
+	438, 437, 437, 437, 437, 432, 432, 431, 431, 419,
+	419, 419, 419, 419, 420, 420, 420, 430, 430, 433,
+	433, 256, 256, 291, 291, 254, 254, 255, 255, 290,
+	290, 252, 252, 253, 253, 253, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 289, 289, 289, 289, 289, 289, 289, 289, 289,
+	289, 511, 511, 512, 258, 258, 269, 269, 269, 269,
+	269, 269, 257, 257, 259, 259, 235, 235, 233, 233,
+	226, 226, 227, 227, 228, 228, 228, 232, 232, 231,
+	231, 231, 231, 229, 229, 230, 230, 230, 230, 230,
+	230, 403, 403, 508, 508, 509, 509, 504, 504, 504,
+	507, 507, 507, 507, 507, 507, 510, 510, 510, 506,
+	506, 236, 311, 311, 311, 329, 329, 329, 329, 310,
+	310, 310, 251, 251, 250, 250, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 248, 248,
+	248, 248, 248, 248, 248, 248, 248, 248, 402, 402,
+	344, 344, 345, 345, 278, 277, 277, 277, 277, 277,
+	275, 276, 274, 274, 274, 274, 274, 273, 273, 272,
+	272, 272, 381, 381, 270, 270, 261, 261, 261, 260,
+	260, 260, 442, 351, 351, 351, 351, 351, 351, 351,
+	351, 351, 351, 351, 351, 351, 353, 353, 353, 353,
+	353, 353, 353, 353, 353, 353, 353, 353, 353, 353,
+	353, 353, 353, 353, 353, 353, 353, 353, 379, 379,
+	379, 379, 379, 309, 309, 309, 309, 309, 309, 309,
+	309, 309, 309, 309, 354, 354, 359, 359, 520, 520,
+	519, 237, 237, 237, 238, 238, 238, 238, 238, 238,
+	238, 238, 238, 247, 247, 247, 426, 426, 426, 426,
+	427, 427, 427, 427, 428, 428, 428, 424, 424, 425,
+	425, 363, 364, 364, 467, 467, 422, 422, 423, 308,
+	308, 308, 308, 308, 308, 308, 308, 308, 308, 308,
+	308, 308, 308, 308, 308, 308, 308, 308, 474, 474,
+	474, 305, 305, 305, 305, 305, 305, 305, 305, 305,
+	305, 305, 305, 305, 305, 305, 305, 531, 531, 531,
+	516, 516, 516, 517, 517, 517, 517, 517, 517, 517,
+	517, 517, 517, 517, 517, 518, 518, 518, 518, 518,
+	518, 518, 518, 518, 518, 518, 518, 518, 518, 518,
+	518, 518, 307, 307, 307, 306, 306, 306, 306, 306,
+	306, 306, 306, 306, 306, 306, 306, 306, 306, 306,
+	365, 365, 366, 366, 471, 471, 471, 471, 471, 471,
+	472, 472, 473, 473, 473, 473, 465, 465, 465, 465,
+	465, 465, 465, 465, 465, 465, 465, 465, 465, 465,
+	465, 465, 465, 465, 465, 465, 465, 465, 465, 465,
+	465, 465, 465, 465, 465, 352, 304, 304, 304, 367,
+	360, 360, 361, 361, 355, 355, 355, 355, 355, 355,
+	355, 357, 357, 357, 357, 357, 357, 357, 357, 357,
+	357, 357, 350, 350, 350, 350, 350, 350, 350, 350,
+	350, 350, 350, 356, 356, 358, 358, 369, 369, 369,
+	368, 368, 368, 368, 368, 368, 368, 249, 249, 249,
+	249, 349, 349, 349, 348, 348, 348, 348, 348, 348,
+	348, 348, 348, 348, 348, 348, 239, 239, 239, 239,
+	243, 243, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 244, 244, 244, 244,
+	244, 242, 242, 242, 242, 242, 240, 240, 240, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
+	240, 240, 240, 240, 104, 105, 105, 241, 312, 312,
+	447, 447, 450, 450, 448, 448, 449, 451, 451, 451,
+	452, 452, 452, 453, 453, 453, 456, 456, 319, 319,
+	319, 325, 325, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 324, 324, 324, 324, 324, 324,
+	324, 324, 324, 324, 323, 323, 323, 323, 323, 323,
+	323, 323, 323, 323, 322, 322, 322, 322, 322, 322,
+	322, 322, 322, 322, 322, 322, 322, 322, 322, 322,
+	322, 322, 322, 322, 322, 322, 322, 322, 322, 322,
+	322, 322, 322, 322, 322, 322, 322, 322, 322, 322,
+	322, 322, 322, 322, 322, 322, 322, 322, 322, 322,
+	322, 322, 322, 322, 322,
 }
 
 var yyR2 = [...]int{
@@ -6956,161 +7852,172 @@ var yyR2 = [...]int{
 	1, 1, 1, 0, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 4, 6, 4, 0, 1, 1, 0,
-	1, 5, 2, 2, 6, 9, 6, 9, 4, 7,
-	8, 0, 1, 1, 2, 4, 6, 1, 2, 4,
-	0, 2, 10, 10, 2, 0, 2, 1, 3, 3,
-	3, 0, 2, 1, 3, 5, 0, 2, 3, 1,
-	3, 1, 1, 1, 3, 1, 1, 1, 1, 0,
-	3, 3, 0, 3, 3, 0, 1, 3, 0, 1,
-	3, 0, 2, 1, 2, 3, 4, 3, 3, 1,
-	0, 1, 1, 0, 1, 8, 5, 7, 0, 3,
-	8, 5, 1, 3, 3, 3, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 1, 4, 1, 3, 1,
-	2, 2, 2, 2, 2, 2, 1, 2, 2, 2,
-	2, 1, 1, 2, 2, 1, 1, 1, 1, 1,
-	2, 2, 2, 1, 2, 1, 2, 2, 1, 2,
-	1, 1, 2, 2, 1, 1, 1, 3, 2, 2,
-	2, 2, 2, 2, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 4, 4, 1, 3, 3,
-	1, 2, 2, 2, 1, 2, 2, 3, 4, 4,
-	6, 1, 1, 1, 2, 4, 6, 1, 4, 1,
-	3, 3, 4, 4, 4, 4, 3, 3, 2, 4,
-	4, 2, 2, 2, 1, 1, 1, 1, 1, 1,
-	3, 1, 3, 1, 1, 1, 2, 2, 0, 1,
-	4, 2, 4, 1, 5, 3, 2, 1, 2, 2,
-	4, 4, 5, 2, 1, 3, 4, 4, 1, 2,
-	9, 7, 1, 3, 3, 1, 1, 3, 1, 3,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 4, 4, 2, 4, 3, 3, 1,
-	1, 1, 1, 1, 1, 2, 3, 4, 7, 2,
-	3, 3, 4, 5, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 2, 1,
-	1, 1, 1, 6, 1, 1, 1, 1, 1, 1,
-	1, 7, 4, 1, 3, 2, 4, 5, 5, 6,
-	5, 5, 3, 2, 2, 1, 3, 4, 3, 7,
-	5, 8, 2, 2, 1, 3, 2, 0, 1, 1,
+	1, 1, 1, 1, 5, 7, 0, 3, 4, 0,
+	1, 1, 0, 1, 5, 2, 2, 6, 9, 6,
+	9, 4, 7, 8, 0, 1, 1, 2, 4, 6,
+	1, 2, 4, 0, 2, 10, 10, 2, 0, 2,
+	1, 3, 3, 3, 0, 2, 1, 3, 5, 0,
+	2, 3, 1, 3, 1, 1, 1, 3, 1, 1,
+	1, 1, 0, 3, 3, 0, 3, 3, 0, 1,
+	3, 0, 1, 3, 0, 2, 1, 2, 3, 4,
+	3, 3, 1, 0, 1, 1, 0, 1, 8, 5,
+	7, 0, 3, 8, 5, 1, 3, 3, 3, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 4,
+	1, 3, 1, 2, 2, 2, 2, 2, 2, 1,
+	2, 2, 2, 2, 1, 1, 2, 2, 1, 1,
+	1, 1, 1, 2, 2, 2, 1, 2, 1, 2,
+	2, 1, 2, 1, 1, 2, 2, 1, 1, 1,
+	3, 2, 2, 2, 2, 2, 2, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 4, 4,
+	1, 3, 3, 1, 2, 2, 2, 1, 2, 2,
+	3, 4, 4, 6, 1, 1, 1, 2, 4, 6,
+	1, 4, 1, 3, 3, 4, 4, 4, 4, 3,
+	3, 2, 4, 4, 2, 2, 2, 1, 1, 1,
+	1, 1, 1, 3, 1, 3, 1, 1, 1, 2,
+	2, 0, 1, 4, 2, 4, 1, 5, 3, 2,
+	1, 2, 2, 4, 4, 5, 2, 1, 3, 4,
+	4, 1, 2, 9, 7, 1, 3, 3, 1, 1,
+	3, 1, 3, 2, 1, 2, 1, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 4, 4, 2, 4,
+	3, 3, 1, 1, 1, 1, 1, 1, 2, 3,
+	4, 7, 2, 3, 3, 4, 5, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 1, 0, 1, 2, 1,
-	3, 2, 1, 2, 2, 1, 2, 3, 2, 3,
-	3, 3, 1, 1, 7, 7, 8, 8, 0, 4,
-	7, 0, 3, 0, 2, 0, 1, 1, 1, 1,
-	4, 2, 2, 3, 3, 4, 5, 3, 4, 4,
-	2, 2, 2, 3, 0, 1, 1, 1, 1, 1,
+	1, 3, 2, 1, 1, 1, 1, 6, 1, 1,
+	1, 1, 1, 1, 1, 1, 7, 4, 4, 5,
+	3, 3, 3, 7, 6, 8, 8, 1, 3, 2,
+	4, 5, 5, 6, 5, 5, 3, 2, 2, 1,
+	3, 4, 3, 7, 5, 8, 2, 2, 1, 3,
+	2, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
+	0, 1, 2, 1, 3, 2, 1, 2, 2, 1,
+	2, 3, 2, 2, 2, 3, 3, 3, 1, 1,
+	7, 7, 8, 8, 0, 4, 7, 0, 3, 0,
+	2, 0, 1, 1, 1, 1, 4, 2, 2, 3,
+	3, 4, 5, 3, 4, 4, 2, 2, 2, 3,
+	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 3, 2, 5, 5,
-	0, 2, 7, 0, 1, 0, 1, 5, 5, 3,
-	3, 2, 4, 4, 4, 4, 4, 1, 1, 1,
-	3, 3, 1, 1, 1, 6, 8, 0, 1, 1,
-	1, 1, 5, 5, 0, 1, 1, 3, 3, 3,
-	4, 6, 7, 4, 4, 7, 8, 3, 3, 3,
-	0, 2, 2, 0, 2, 2, 1, 1, 1, 1,
-	0, 1, 4, 4, 5, 4, 3, 1, 3, 1,
-	1, 3, 5, 2, 3, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
-	4, 4, 1, 3, 1, 4, 6, 6, 4, 4,
-	4, 4, 4, 3, 6, 3, 5, 1, 1, 2,
-	2, 11, 8, 9, 1, 3, 2, 4, 0, 2,
-	0, 1, 1, 1, 1, 0, 1, 0, 1, 4,
-	2, 1, 5, 4, 4, 2, 5, 1, 3, 2,
-	1, 5, 4, 4, 2, 0, 5, 0, 1, 3,
-	3, 1, 3, 1, 3, 1, 3, 4, 0, 1,
-	0, 1, 1, 3, 1, 1, 0, 4, 1, 3,
-	2, 1, 0, 8, 0, 4, 7, 4, 0, 2,
-	0, 2, 0, 2, 0, 4, 1, 3, 1, 1,
-	5, 3, 4, 6, 4, 5, 2, 3, 1, 3,
-	6, 0, 3, 0, 1, 2, 4, 4, 0, 1,
-	3, 1, 3, 3, 0, 1, 1, 0, 2, 2,
-	0, 2, 3, 3, 3, 1, 3, 3, 3, 3,
-	1, 2, 2, 1, 2, 2, 1, 2, 2, 1,
-	2, 2, 7, 7, 1, 1, 1, 0, 1, 1,
-	1, 1, 0, 2, 0, 3, 0, 2, 1, 3,
-	1, 2, 3, 5, 0, 1, 2, 1, 3, 1,
-	1, 1, 4, 4, 4, 3, 2, 2, 2, 3,
-	2, 3, 4, 1, 3, 4, 0, 2, 1, 1,
-	2, 2, 0, 1, 2, 4, 1, 3, 1, 3,
-	2, 3, 1, 4, 3, 0, 1, 1, 2, 5,
-	2, 2, 2, 0, 2, 3, 3, 0, 1, 3,
-	1, 3, 0, 1, 2, 1, 1, 0, 1, 2,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 7,
-	1, 1, 7, 1, 3, 0, 1, 1, 3, 1,
-	3, 0, 1, 1, 1, 12, 1, 3, 0, 1,
-	1, 3, 1, 1, 2, 4, 1, 1, 9, 8,
-	7, 1, 2, 2, 3, 3, 3, 0, 4, 1,
-	1, 1, 1, 1, 0, 1, 1, 1, 4, 1,
-	1, 3, 4, 3, 0, 1, 1, 1, 0, 2,
-	7, 8, 8, 0, 3, 3, 0, 2, 0, 3,
-	0, 5, 1, 3, 0, 3, 3, 0, 2, 9,
-	6, 0, 2, 2, 3, 3, 4, 4, 1, 0,
-	2, 2, 1, 3, 2, 1, 3, 2, 1, 3,
-	2, 0, 1, 3, 4, 3, 1, 1, 4, 1,
-	3, 1, 1, 1, 1, 0, 1, 1, 1, 11,
-	0, 2, 3, 2, 3, 1, 1, 1, 3, 3,
-	4, 0, 2, 2, 2, 2, 6, 0, 4, 1,
-	1, 0, 3, 0, 1, 1, 2, 4, 4, 4,
-	0, 1, 7, 9, 10, 8, 0, 2, 11, 9,
-	11, 2, 2, 4, 5, 1, 3, 0, 3, 5,
-	10, 0, 2, 0, 3, 2, 4, 3, 0, 2,
-	1, 0, 2, 3, 0, 2, 3, 0, 3, 2,
-	4, 3, 0, 1, 0, 1, 1, 0, 6, 0,
-	3, 5, 0, 4, 0, 3, 1, 3, 4, 5,
-	0, 3, 1, 3, 2, 3, 1, 2, 0, 4,
-	6, 5, 0, 2, 0, 2, 4, 5, 4, 5,
-	1, 5, 6, 5, 0, 3, 0, 1, 1, 3,
-	3, 3, 0, 4, 1, 3, 3, 3, 0, 1,
-	1, 3, 2, 3, 3, 3, 4, 4, 3, 3,
-	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 3, 3, 3,
-	3, 3, 3, 3, 3, 1, 5, 4, 1, 3,
-	3, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 1, 3, 0, 1, 1,
-	3, 1, 1, 1, 1, 1, 7, 9, 7, 9,
-	2, 1, 7, 9, 7, 9, 8, 5, 0, 1,
-	0, 1, 1, 1, 1, 3, 3, 1, 1, 1,
-	1, 1, 1, 1, 1, 0, 1, 3, 1, 3,
-	5, 1, 1, 1, 1, 1, 3, 5, 0, 1,
-	1, 2, 1, 2, 2, 1, 1, 2, 2, 2,
-	3, 3, 2, 2, 1, 5, 6, 4, 1, 1,
-	1, 5, 4, 1, 1, 2, 0, 1, 1, 2,
-	5, 0, 1, 1, 2, 2, 3, 3, 1, 1,
-	2, 2, 2, 0, 1, 2, 2, 2, 0, 3,
-	0, 3, 1, 1, 1, 1, 1, 1, 1, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 1, 1, 1, 1, 3, 5, 2, 2, 2,
-	2, 1, 1, 2, 5, 6, 6, 6, 1, 1,
-	1, 1, 1, 4, 4, 4, 0, 2, 0, 1,
-	1, 2, 4, 1, 2, 2, 1, 2, 2, 1,
-	2, 2, 2, 2, 2, 0, 1, 1, 1, 2,
-	2, 2, 2, 2, 2, 2, 1, 1, 1, 2,
-	5, 0, 1, 3, 0, 1, 0, 2, 0, 1,
-	6, 8, 6, 6, 5, 5, 5, 6, 6, 6,
-	6, 5, 6, 6, 6, 6, 6, 6, 6, 6,
-	1, 1, 1, 4, 4, 6, 8, 6, 4, 5,
-	4, 4, 4, 3, 4, 6, 6, 7, 4, 1,
+	1, 4, 3, 2, 5, 5, 0, 2, 7, 0,
+	1, 0, 1, 5, 5, 3, 3, 2, 4, 4,
+	4, 4, 4, 1, 1, 1, 3, 3, 1, 1,
+	1, 6, 8, 0, 1, 1, 1, 1, 5, 5,
+	0, 1, 1, 3, 3, 3, 4, 6, 7, 4,
+	4, 7, 8, 3, 3, 3, 0, 2, 2, 0,
+	2, 2, 1, 1, 1, 1, 0, 1, 4, 4,
+	5, 4, 3, 1, 3, 1, 1, 3, 5, 2,
+	3, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
+	4, 1, 3, 1, 4, 6, 6, 4, 4, 4,
+	4, 4, 3, 6, 3, 5, 1, 1, 2, 2,
+	11, 8, 9, 1, 3, 2, 4, 0, 2, 0,
+	1, 1, 1, 1, 0, 1, 0, 1, 4, 2,
+	1, 5, 4, 4, 2, 5, 1, 3, 2, 1,
+	5, 4, 4, 2, 0, 5, 0, 1, 3, 3,
+	1, 3, 1, 3, 1, 3, 4, 0, 1, 0,
+	1, 1, 3, 1, 1, 0, 4, 1, 3, 2,
+	1, 0, 8, 0, 4, 7, 4, 0, 2, 0,
+	2, 0, 2, 0, 4, 1, 3, 1, 1, 5,
+	3, 4, 6, 4, 5, 2, 3, 1, 3, 6,
+	0, 3, 0, 1, 2, 4, 4, 0, 1, 3,
+	1, 3, 3, 0, 1, 1, 0, 2, 2, 0,
+	2, 3, 3, 3, 1, 3, 3, 3, 3, 1,
+	2, 2, 1, 2, 2, 1, 2, 2, 1, 2,
+	2, 7, 7, 1, 1, 1, 0, 1, 1, 1,
+	1, 0, 2, 0, 3, 0, 2, 1, 3, 1,
+	2, 3, 5, 0, 1, 2, 1, 3, 1, 1,
+	1, 4, 4, 4, 3, 2, 2, 2, 3, 2,
+	3, 4, 1, 3, 4, 0, 2, 1, 1, 2,
+	2, 0, 1, 2, 4, 1, 3, 1, 3, 4,
+	2, 3, 1, 4, 6, 5, 1, 3, 3, 5,
+	5, 3, 2, 0, 3, 3, 6, 1, 1, 2,
+	3, 0, 1, 1, 2, 5, 2, 2, 2, 0,
+	2, 3, 3, 0, 1, 3, 1, 3, 0, 1,
+	2, 1, 1, 0, 1, 2, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 7, 1,
+	1, 7, 1, 3, 0, 1, 1, 3, 1, 3,
+	0, 1, 1, 1, 12, 1, 3, 0, 1, 1,
+	3, 1, 1, 2, 4, 1, 1, 9, 8, 7,
+	1, 2, 2, 3, 3, 3, 0, 4, 1, 1,
+	1, 1, 1, 0, 1, 1, 1, 4, 1, 1,
+	3, 4, 3, 0, 1, 1, 1, 0, 2, 7,
+	8, 8, 0, 3, 3, 0, 2, 0, 3, 0,
+	5, 1, 3, 0, 3, 3, 0, 2, 9, 6,
+	0, 2, 2, 3, 3, 4, 4, 12, 7, 8,
+	11, 4, 13, 1, 1, 1, 1, 1, 0, 2,
+	2, 1, 1, 1, 1, 4, 8, 0, 4, 5,
+	0, 1, 1, 5, 1, 3, 1, 3, 2, 3,
+	5, 5, 7, 0, 1, 3, 4, 0, 3, 0,
+	1, 1, 0, 2, 0, 2, 1, 0, 2, 2,
+	1, 3, 2, 1, 3, 2, 1, 3, 2, 0,
+	1, 3, 4, 3, 1, 1, 4, 1, 3, 1,
+	1, 1, 1, 1, 0, 1, 1, 1, 11, 0,
+	2, 3, 2, 3, 1, 1, 3, 2, 1, 3,
+	3, 4, 0, 2, 2, 2, 2, 2, 6, 0,
+	4, 1, 1, 0, 3, 0, 1, 1, 2, 4,
+	4, 4, 0, 1, 7, 9, 10, 8, 0, 2,
+	11, 9, 11, 2, 2, 4, 4, 5, 1, 3,
+	0, 3, 5, 10, 0, 2, 0, 3, 2, 4,
+	3, 0, 2, 1, 0, 2, 3, 0, 2, 3,
+	0, 3, 2, 4, 3, 0, 1, 0, 1, 1,
+	0, 6, 0, 3, 5, 0, 4, 0, 3, 1,
+	3, 4, 5, 0, 3, 1, 3, 2, 3, 1,
+	2, 0, 4, 6, 5, 0, 2, 0, 2, 4,
+	5, 4, 5, 1, 5, 6, 5, 0, 3, 0,
+	1, 1, 3, 3, 3, 0, 4, 1, 3, 3,
+	3, 0, 1, 1, 3, 2, 3, 3, 3, 4,
+	4, 3, 3, 3, 3, 4, 4, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	3, 3, 3, 3, 3, 3, 3, 3, 1, 5,
+	4, 1, 3, 3, 2, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 3,
+	0, 1, 1, 3, 1, 1, 1, 1, 1, 7,
+	9, 7, 9, 2, 1, 7, 9, 7, 9, 8,
+	5, 0, 1, 0, 1, 1, 1, 1, 3, 3,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 3, 1, 3, 5, 1, 1, 1, 1, 1,
+	3, 5, 0, 1, 1, 2, 1, 2, 2, 1,
+	1, 2, 2, 2, 3, 3, 2, 2, 1, 5,
+	6, 4, 1, 1, 1, 5, 4, 1, 1, 2,
+	0, 1, 1, 2, 5, 0, 1, 1, 2, 2,
+	3, 3, 1, 1, 2, 2, 2, 0, 1, 2,
+	2, 2, 0, 3, 0, 3, 1, 1, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 1, 1, 1, 1, 3,
+	5, 2, 2, 2, 2, 1, 1, 2, 5, 6,
+	6, 6, 1, 1, 1, 1, 1, 9, 0, 4,
+	7, 3, 3, 4, 4, 4, 4, 4, 5, 5,
+	5, 5, 5, 5, 0, 2, 0, 1, 1, 2,
+	4, 1, 2, 2, 1, 2, 2, 1, 2, 2,
+	2, 2, 2, 0, 1, 1, 1, 2, 2, 2,
+	2, 2, 2, 2, 1, 1, 1, 2, 5, 0,
+	1, 3, 0, 1, 0, 2, 0, 1, 6, 8,
+	6, 6, 5, 5, 5, 6, 6, 6, 6, 5,
+	6, 6, 6, 6, 6, 6, 6, 6, 1, 1,
+	1, 4, 4, 6, 8, 6, 4, 5, 4, 4,
+	4, 3, 4, 6, 6, 7, 4, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 2, 8, 4, 2, 3, 2, 4,
+	2, 2, 4, 6, 2, 2, 4, 6, 4, 2,
+	0, 1, 2, 3, 1, 1, 1, 1, 1, 1,
+	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 8, 4, 2, 3,
-	2, 4, 2, 2, 4, 6, 2, 2, 4, 6,
-	4, 2, 0, 1, 2, 3, 1, 1, 1, 1,
-	1, 1, 0, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 3, 0, 1, 1, 3,
+	0, 1, 1, 3, 3, 3, 3, 3, 2, 1,
+	1, 3, 4, 3, 4, 3, 4, 3, 4, 3,
+	4, 1, 3, 4, 4, 5, 4, 5, 3, 4,
+	5, 6, 1, 0, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 3, 0, 1,
-	1, 3, 0, 1, 1, 3, 3, 3, 3, 3,
-	2, 1, 1, 3, 4, 3, 4, 3, 4, 3,
-	4, 3, 4, 1, 3, 4, 4, 5, 4, 5,
-	3, 4, 5, 6, 1, 0, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	1, 2, 1, 1, 1, 2, 3, 1, 1, 1,
 	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 2, 1, 1, 1, 2, 3, 1,
-	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	2, 2, 2, 1, 2, 2, 2, 2, 2, 2,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 4, 4, 1, 2, 3, 5, 1, 1, 3,
+	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
+	2, 1, 2, 2, 2, 2, 2, 2, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
+	4, 1, 2, 2, 2, 3, 5, 1, 1, 3,
 	0, 1, 0, 3, 0, 3, 3, 0, 3, 5,
 	0, 3, 5, 0, 1, 1, 0, 1, 1, 2,
 	2, 0, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -7139,677 +8046,742 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -529, -537, -2, -5, 588, -1, -4, -105, -79,
+	-1000, -560, -568, -2, -5, 604, -1, -4, -105, -79,
 	-7, -107, -108, -8, -103, -9, -10, -12, -83, -98,
 	-100, -102, -101, -42, -11, -97, -74, -75, -85, -91,
-	-94, -95, -96, -109, -104, -106, -148, -110, -111, 581,
-	-80, -81, -82, -31, -30, -29, -28, -131, -136, 587,
-	435, 13, 484, -13, -14, -490, -15, 254, -302, -303,
-	-304, -306, -538, -43, -44, -45, -53, -54, -55, -56,
-	-57, -67, -68, -69, -46, -47, -48, -51, -49, -60,
-	-59, -61, -62, -63, -64, -65, -66, -50, -134, -135,
-	-70, -52, -72, -71, -77, -73, -78, -133, -138, 229,
-	-76, 75, -86, -87, -88, -89, -90, -92, -93, 361,
-	367, 424, 580, 60, -149, -151, 591, 592, 157, 158,
-	160, 161, -32, -33, -34, -35, -36, -37, -39, -38,
-	-40, -41, 225, 15, 521, -16, -19, -17, -20, -18,
-	-26, -27, -25, -22, -24, -132, -23, -137, 249, 248,
-	37, 315, 316, 317, 365, 247, 226, 14, 30, 41,
-	341, -150, 81, 227, -152, 12, 595, -6, -3, -2,
-	-118, -122, -126, -129, -130, -127, -128, -4, -105, 115,
-	239, 582, -299, 357, 583, 585, 584, 84, 92, -292,
-	-294, 361, 367, 580, 592, 526, 527, 528, 529, 530,
-	531, 532, 533, 534, 535, 536, 537, 538, 539, 540,
-	541, 542, 543, 544, 545, 546, 547, 548, 550, 551,
-	552, 553, 554, 555, 556, 557, 558, 559, 560, 561,
-	562, 563, 487, 567, 568, 569, 570, 515, 339, 340,
-	518, 266, 290, 392, 296, 303, 157, 176, 170, 198,
-	190, 165, 270, 308, 271, 91, 160, 470, 105, 447,
-	421, 163, 285, 287, 289, 516, 292, 291, 293, 295,
-	272, 342, 186, 181, 284, 268, 179, 273, 39, 274,
-	203, 275, 276, 523, 443, 338, 449, 300, 419, 180,
-	444, 288, 446, 205, 209, 461, 462, 174, 175, 451,
-	464, 202, 246, 42, 520, 258, 465, 207, 201, 197,
-	473, 304, 302, 200, 173, 196, 269, 64, 211, 210,
-	212, 415, 416, 417, 418, 277, 278, 355, 460, 193,
-	182, 343, 166, 22, 468, 253, 448, 368, 279, 297,
-	305, 206, 208, 260, 265, 522, 423, 264, 301, 466,
-	178, 257, 286, 252, 469, 167, 370, 280, 161, 294,
-	463, 472, 63, 383, 172, 164, 243, 158, 262, 267,
-	281, 282, 283, 513, 307, 306, 298, 194, 259, 199,
-	184, 171, 195, 159, 261, 471, 384, 578, 341, 402,
-	192, 189, 263, 236, 467, 450, 162, 406, 385, 187,
-	309, 574, 575, 576, 356, 337, 310, 311, 185, 250,
-	441, 442, 314, 412, 330, 386, 422, 393, 387, 218,
-	318, 453, 455, 204, 577, 319, 320, 321, 445, 322,
-	323, 324, 325, 360, 55, 57, 93, 95, 593, -377,
-	-375, -299, 580, 367, 361, 341, 592, -191, -299, 594,
-	-158, 38, -204, -299, -158, -76, -14, -13, -150, -151,
-	-204, 233, -307, 23, 419, -84, 420, 229, 81, -299,
-	-9, -96, -8, -103, -74, -148, 424, -305, -299, 315,
-	315, -305, 233, -299, 264, 400, -427, 239, -381, -354,
-	265, -380, -356, -383, -357, 31, 225, 227, 226, 261,
-	15, 365, 235, 13, 12, 366, 247, 24, 25, 27,
-	14, 367, 369, 28, 370, 373, 374, 375, 41, 378,
-	379, 254, 84, 92, 87, 272, -190, -299, -331, -325,
-	112, 255, -327, -320, -321, -323, -469, -463, -318, 81,
-	139, 140, 147, 113, -322, -411, 35, 115, 530, 485,
-	-275, -276, -277, -278, -279, -299, -464, -462, 87, 96,
-	99, 102, 103, 101, 100, 151, 183, 98, 88, 152,
-	-151, 84, -485, 539, -293, 560, 567, 568, 569, 570,
-	559, 60, -434, -442, 232, -440, 150, 188, 250, 184,
-	13, 145, 412, 185, 536, 556, 487, 540, 563, 532,
-	533, 534, 527, 528, 529, 531, 541, 543, 555, -443,
-	551, 561, 562, 549, 564, 565, 566, 86, 85, 554,
-	553, 542, 537, 538, 544, 526, 535, 545, 546, 552,
-	557, 558, 349, 105, 350, 351, 477, 342, 352, 239,
-	419, 69, 353, 354, 355, 356, 357, 484, 358, 70,
-	359, 348, 254, 402, 360, 187, 204, 489, 488, 490,
-	481, 478, 476, 479, 480, 482, 483, 547, 548, 550,
-	-112, -113, 572, -167, -168, -268, 11, 6, 7, 8,
-	9, -527, 421, 522, 87, 162, 159, -368, 162, 111,
-	167, 166, -368, 318, 260, 362, -141, 236, 339, 402,
-	322, 93, 264, -348, -346, 516, -345, -429, 312, 308,
-	234, -142, 244, 243, 106, 477, 232, 108, 376, 303,
-	55, 57, -501, -502, 222, 223, 224, -492, 510, -491,
-	-299, 351, 95, 26, 233, 362, 260, 455, 453, 454,
-	456, 457, 458, 459, -58, -444, -426, 450, 449, -311,
-	442, 448, 440, 452, 443, 340, 324, 323, 225, 589,
-	511, -289, 386, 422, 474, 475, 363, 423, 461, 463,
-	444, 105, 191, 188, 236, 233, 339, 477, 402, 93,
-	322, -501, 159, 461, 463, 264, 400, 40, -374, 412,
-	-373, -375, 462, 473, 85, 86, 460, -289, 105, 441,
-	441, -168, -268, -167, -149, -151, -106, -490, 234, 339,
-	402, 264, 235, 233, 514, 236, 477, 232, 315, 362,
-	260, 322, 93, -428, -498, 31, -435, 219, 220, 221,
-	32, 33, -1, 119, 595, -325, -325, -6, 598, -6,
-	-325, -299, -299, 154, -210, -214, -211, -213, -212, -216,
-	-215, 188, 189, 150, 192, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203, 30, 204, 250, 184, 185,
-	186, 187, 205, 170, 190, 519, 213, 171, 214, 172,
-	215, 173, 216, 174, 175, 217, 176, 179, 180, 181,
-	182, 178, 153, 81, 153, 87, -174, 256, -204, 153,
-	-174, -168, -167, -168, -204, -280, -299, 421, 122, -84,
-	-84, 420, -493, -494, -495, -497, 229, 420, 419, 153,
-	272, 16, -305, -305, 79, -204, -356, 264, -381, -354,
-	35, 78, 154, 237, 154, 78, 81, 363, 339, 402,
-	364, 477, 233, 376, 236, 264, 377, 339, 402, 233,
-	236, 477, 264, 339, 233, 236, 402, 264, 377, 440,
-	441, 236, 26, 368, 371, 372, 441, -448, 473, 154,
-	111, 108, 109, 110, -325, 129, -338, 122, 123, 124,
-	125, 126, 127, 128, 136, 135, 146, 139, 140, 141,
-	142, 143, 144, 145, 137, 138, 132, 112, 130, 134,
-	131, 114, -151, -325, -331, 60, -323, -323, -323, -323,
-	-411, -329, -325, 81, 81, 153, 100, 87, -325, 81,
+	-94, -95, -96, -109, -104, -106, -160, -110, -111, -147,
+	597, -80, -81, -82, -31, -30, -29, -28, -132, -137,
+	-140, -143, -146, 603, 442, 13, 491, -13, -14, -521,
+	-15, 256, -332, -333, -334, -336, -569, -43, -44, -45,
+	-53, -54, -55, -56, -57, -67, -68, -69, -46, -47,
+	-48, -51, -49, -60, -59, -61, -62, -63, -64, -65,
+	-66, -50, -135, -136, -70, -52, -72, -71, -77, -73,
+	-78, -134, -139, -142, 231, -76, 75, -86, -87, -88,
+	-89, -90, -92, -93, 368, 374, 431, 596, 60, -161,
+	-163, 607, 608, 623, 157, 158, 160, 161, -32, -33,
+	-34, -35, -36, -37, -39, -38, -40, -41, 227, 15,
+	529, -16, -19, -17, -20, -18, -26, -27, -25, -22,
+	-24, -133, -23, -138, -141, -144, -148, 251, 250, 37,
+	318, 319, 320, 372, 249, 228, 14, 30, 41, 348,
+	-162, 81, 229, -164, 12, 630, -6, -3, -2, -119,
+	-123, -127, -130, -131, -128, -129, -4, -105, 115, 241,
+	598, -329, 364, 599, 601, 600, 84, 92, -322, -324,
+	368, 374, 596, 608, 623, 534, 535, 536, 537, 538,
+	539, 540, 541, 542, 543, 544, 545, 546, 547, 548,
+	549, 550, 551, 552, 553, 554, 555, 556, 558, 559,
+	560, 561, 562, 563, 564, 565, 566, 567, 568, 569,
+	570, 571, 494, 583, 584, 585, 586, 522, 575, 576,
+	577, 578, 579, 580, 581, 582, 346, 347, 526, 269,
+	293, 399, 299, 306, 157, 176, 170, 198, 190, 165,
+	273, 311, 274, 91, 160, 477, 105, 454, 428, 163,
+	288, 290, 292, 523, 295, 294, 296, 298, 275, 349,
+	186, 181, 287, 271, 524, 179, 276, 39, 277, 203,
+	278, 279, 531, 260, 450, 345, 456, 303, 426, 180,
+	451, 291, 453, 207, 211, 468, 469, 174, 175, 458,
+	471, 202, 248, 42, 528, 261, 472, 209, 201, 197,
+	480, 307, 305, 200, 173, 196, 272, 64, 213, 212,
+	214, 422, 423, 424, 425, 280, 281, 362, 467, 193,
+	182, 350, 166, 22, 475, 255, 455, 375, 282, 300,
+	308, 208, 210, 263, 268, 530, 430, 267, 304, 473,
+	178, 259, 289, 254, 476, 167, 377, 283, 161, 297,
+	470, 479, 63, 390, 172, 164, 245, 158, 265, 270,
+	284, 285, 286, 520, 310, 309, 301, 194, 262, 199,
+	184, 171, 195, 159, 264, 618, 619, 620, 621, 622,
+	478, 391, 594, 348, 409, 192, 189, 266, 238, 474,
+	457, 162, 413, 392, 187, 312, 590, 591, 592, 363,
+	343, 313, 314, 185, 252, 448, 449, 317, 419, 333,
+	393, 429, 400, 394, 220, 321, 460, 462, 204, 205,
+	206, 336, 337, 338, 593, 322, 323, 324, 452, 325,
+	326, 327, 328, 367, 55, 57, 93, 95, 609, 610,
+	611, 612, 613, 614, 615, 616, 617, 625, 626, 627,
+	628, 5, -408, -406, -329, 596, 374, 368, 623, 348,
+	608, -203, -329, 629, -170, 38, -233, -329, -170, -76,
+	-14, -13, -162, -163, -233, 235, -337, 23, 426, -84,
+	427, 231, 81, -329, -9, -96, -8, -103, -74, -160,
+	431, -335, -329, 318, 318, -335, 235, -329, 267, 407,
+	-458, 241, -412, -385, 268, -411, -387, -414, -388, 31,
+	227, 229, 228, 264, 15, 372, 237, 13, 12, 373,
+	249, 24, 25, 27, 14, 374, 376, 28, 377, 380,
+	381, 382, 41, 385, 386, 256, 84, -322, 92, 87,
+	275, -202, -329, -361, -355, 112, 257, -357, -350, -351,
+	-353, -500, -494, -348, 81, 139, 140, 147, 113, -352,
+	-442, 35, 115, 538, 492, -305, -306, -307, -308, -309,
+	525, -329, -495, -493, 87, 96, 99, 102, 103, 101,
+	100, 151, 183, 98, 88, 152, -163, 84, -516, 547,
+	-323, 568, 583, 584, 585, 586, 567, 60, -465, -473,
+	234, -471, 150, 188, 252, 184, 13, 145, 419, 185,
+	544, 564, 494, 548, 571, 540, 541, 542, 535, 536,
+	537, 539, 549, 551, 563, -474, 559, 569, 570, 557,
+	572, 573, 574, 581, 582, 580, 575, 576, 577, 578,
+	579, 86, 85, 562, 561, 550, 545, 546, 552, 534,
+	543, 553, 554, 560, 565, 566, 356, 105, 357, 358,
+	484, 349, 359, 241, 426, 69, 360, 361, 362, 363,
+	364, 491, 365, 70, 366, 355, 256, 409, 367, 187,
+	204, 496, 495, 497, 488, 485, 483, 486, 487, 489,
+	490, 555, 556, 558, -113, -114, 588, -179, -180, -298,
+	11, 6, 7, 8, 9, -558, 428, 530, 87, 622,
+	162, 159, -399, 162, 111, 167, 166, -399, 321, 263,
+	369, -153, 238, 346, 409, 325, 93, 385, 264, 622,
+	267, -378, -376, 523, -375, -460, 315, 311, 236, -154,
+	246, 245, 106, 484, 234, 108, 383, 306, 55, 57,
+	-532, -533, 224, 225, 226, -523, 517, -522, -329, 358,
+	95, 26, 235, 369, 263, 462, 460, 461, 463, 464,
+	465, 466, -58, -475, -457, 457, 456, -341, 449, 455,
+	447, 459, 450, 347, 327, 326, 227, 605, 518, -319,
+	393, 429, 481, 482, 370, 430, 468, 470, 451, 105,
+	191, 188, 238, 235, 346, 484, 409, 93, 325, 385,
+	-532, 159, 468, 470, 267, 407, 40, -405, 419, -404,
+	-406, 469, 480, 85, 86, 467, -319, 105, 448, 448,
+	-180, -298, -179, -161, -163, -106, -521, 236, 346, 409,
+	267, 237, 235, 521, 238, 484, 234, 318, 369, 263,
+	325, 93, 385, 264, 622, -459, -529, 31, -466, 221,
+	222, 223, 32, 33, -1, 119, 630, -355, -355, -6,
+	633, -6, -355, -329, -329, 154, -239, -243, -240, -242,
+	-241, -245, -244, 188, 189, 150, 192, 194, 195, 196,
+	197, 198, 199, 200, 201, 202, 203, 30, 204, 205,
+	206, 252, 184, 185, 186, 187, 207, 170, 190, 527,
+	215, 171, 216, 172, 217, 173, 218, 174, 175, 219,
+	176, 179, 180, 181, 182, 178, 153, 81, 153, 87,
+	-186, 258, -233, 153, -186, -180, -179, -180, -233, -310,
+	-329, 428, 122, -84, -84, 427, -524, -525, -526, -528,
+	231, 427, 426, 153, 275, 16, -335, -335, 79, -233,
+	-387, 267, -412, -385, 35, 78, 154, 239, 154, 78,
+	81, 370, 346, 409, 371, 484, 235, 383, 238, 267,
+	384, 346, 409, 235, 238, 484, 267, 346, 235, 238,
+	409, 267, 384, 447, 448, 238, 26, 375, 378, 379,
+	448, -479, 480, 154, 111, 108, 109, 110, -355, 129,
+	-368, 122, 123, 124, 125, 126, 127, 128, 136, 135,
+	146, 139, 140, 141, 142, 143, 144, 145, 137, 138,
+	132, 112, 130, 134, 131, 114, -163, -355, -361, 60,
+	-353, -353, -353, -353, -442, -359, -355, 81, 81, 81,
+	153, 100, 87, -355, 81, 81, 81, 81, 81, 81,
+	81, 81, 81, 81, 81, 81, -472, 81, 81, -365,
+	-366, 81, 81, -348, -310, 81, 87, 87, 81, 81,
+	81, 87, -366, -366, 81, 81, 81, 81, 81, 81,
 	81, 81, 81, 81, 81, 81, 81, 81, 81, 81,
-	81, -441, 81, 81, -335, -336, 81, 81, -318, -280,
-	81, 87, 87, 81, 81, 81, 87, -336, -336, 81,
 	81, 81, 81, 81, 81, 81, 81, 81, 81, 81,
-	81, 81, 81, 81, 81, 81, 81, 81, 81, 81,
-	-168, 154, 81, -114, 38, -170, 21, -152, -151, 20,
-	31, 32, 31, 32, 31, 32, 31, 32, 96, 593,
-	218, 466, 521, 165, 166, 167, -189, -299, -191, -182,
-	-299, 236, -142, 108, -343, 239, -343, -343, -343, -343,
-	-343, 235, -343, -204, 514, 513, 233, 233, 233, -343,
-	484, 122, 122, 58, -503, 169, 154, -492, -173, 81,
-	-310, 130, 134, -310, 23, 262, 262, 262, -310, 302,
-	-539, -540, 16, 132, -308, -540, -308, -308, -310, -541,
-	235, 451, 42, 263, 262, -169, -170, -169, 445, 441,
-	-398, 446, 447, -312, -540, -311, -310, -310, -311, -310,
-	-310, -310, 233, 236, 477, 322, 590, -539, -539, 30,
-	30, -427, -204, -427, 511, -290, -299, -427, -427, -427,
-	-504, 238, -534, -533, 464, -536, 466, 159, -375, 159,
-	-375, -356, 264, 264, 154, 122, 23, -376, 122, 133,
-	-375, -375, -376, -376, -228, 40, -298, 150, -299, 87,
-	-228, 40, -531, -530, -204, -169, -152, -151, 82, 82,
-	82, -427, -427, -427, -427, -427, -429, -427, -427, -427,
-	-427, -305, -182, -191, 239, -427, -427, -153, -154, 141,
-	-325, -299, -153, -3, -120, -119, 116, 117, 119, 583,
-	357, 582, 586, 580, -375, 40, -422, 384, 383, -416,
-	-418, 81, -417, 81, -417, -417, -417, 81, 81, -419,
-	81, -419, -419, -416, -420, 81, -420, -421, 81, -421,
-	-420, -299, -330, -331, -299, 38, -437, 60, -148, 81,
-	30, 81, -299, -438, 60, -148, 81, 30, -169, -114,
-	-170, 153, 96, 87, -84, 82, 154, -496, 102, 103,
-	-499, 202, 194, -299, -99, 87, -7, -8, -9, -10,
-	-42, -74, -148, -465, -463, 81, 31, 411, 78, 16,
-	-382, 233, 477, 362, 260, 236, 339, -380, -363, -360,
-	-358, -298, -356, -359, -358, -385, -280, 441, -115, 425,
-	314, -325, -325, -325, -325, -325, 101, 112, 337, 102,
-	103, -320, -339, 31, 310, 311, -321, -321, -321, -321,
-	-321, -321, -321, -321, -321, -321, -321, -321, -328, -337,
-	-411, 81, 132, 130, 134, 131, 114, -323, -323, -321,
-	-321, 82, 154, -489, -488, 116, -325, -325, -299, -486,
-	-487, 491, 492, 493, 494, 495, 496, 497, 498, 499,
-	500, 501, 353, 348, 354, 352, 342, 360, 355, 356,
-	187, 508, 509, 502, 503, 504, 505, 506, 507, -330,
-	-330, -325, -486, -330, -274, 32, 31, -331, -331, -331,
-	82, -325, -500, 335, 334, 336, -171, -299, -330, 82,
-	82, 82, 96, -331, -331, -330, -321, -330, -487, -274,
-	-274, -274, 141, -331, -331, -274, -274, -274, -274, 141,
-	-274, -274, -274, -274, -274, -274, -274, -274, 82, 82,
-	82, -169, -113, -446, -445, -325, 40, 573, -114, -325,
-	-166, -165, -325, -528, 87, 87, 596, 467, 468, 163,
-	166, 165, 34, 81, 81, 153, -343, 484, -204, 112,
-	-506, -299, -364, -361, -358, -299, -299, -354, -299, -299,
-	521, -343, 514, -343, -343, -343, -204, -239, 52, 53,
-	54, -358, -143, 55, 56, -504, -491, 34, -172, -299,
-	-264, 17, -323, -323, -358, 264, -539, -310, -310, -291,
-	-290, -312, -307, -312, -312, -264, -540, -310, -310, -312,
-	-308, -264, -299, 441, -264, -264, -398, -309, -299, -309,
-	-343, -290, -291, -291, -204, -250, -251, 230, 346, 347,
-	228, 226, 11, 227, -259, 303, -344, 485, -234, -235,
-	76, 41, -237, 388, 381, 266, 270, 91, 271, 421,
-	272, 235, 274, 275, 276, 291, 293, 246, 277, 278,
-	279, 412, 280, 158, 292, 281, 282, 283, 364, -229,
-	6, 325, 40, 50, 51, 434, 433, 524, 521, 267,
-	-506, -506, 30, 30, -364, -299, -299, -161, -156, -160,
-	-157, -162, -267, -269, -159, 81, -204, -151, -299, 154,
-	465, 467, 468, -534, -376, -534, -376, 31, 411, -379,
-	411, 31, -354, -373, 461, 463, -369, 87, 412, -359,
-	-378, 78, 150, -445, -376, -376, -378, -378, 149, 154,
-	-532, 466, 467, 222, -114, -206, -204, -506, -363, -354,
-	-299, -427, -206, -206, -299, -299, 81, 35, -299, -299,
-	-263, 154, -262, 16, -300, -299, 34, 87, 153, -263,
-	-121, -119, 118, -325, -6, 582, -325, -6, -6, -325,
-	-6, -325, -425, 385, 96, 96, -282, 87, -282, 96,
-	96, 96, 82, 87, -367, 78, -439, -332, -484, 572,
-	-176, 82, -171, -482, -483, -171, -175, -299, -439, -176,
-	82, -482, -114, -114, -299, 23, -84, -494, 153, 154,
-	-172, -382, -362, -359, -384, 141, -299, -370, 154, 521,
-	599, 85, 237, -520, -519, 403, 82, 154, -449, 238,
-	484, 87, 596, 218, 101, 337, 102, 103, -411, -331,
-	-328, -323, -323, -321, -321, -326, 251, -326, 111, -325,
-	-324, -488, 118, -325, 34, 154, 79, 153, 82, 82,
-	16, 16, 82, -325, 82, 82, 82, 82, 16, 16,
-	-325, 82, 153, 82, 82, 82, 82, 79, 82, 154,
-	82, 154, -331, -325, -331, 82, 82, 82, -325, -325,
-	-325, -331, 82, -325, -325, -325, -325, -325, -325, -325,
-	-325, -392, 436, -392, -392, 154, 82, 87, 154, 22,
-	154, -163, 36, 37, -286, -285, 87, 164, 167, 167,
-	166, -190, -192, -193, -194, -195, -196, 132, 155, 156,
-	-183, -184, -185, -186, -210, -280, 189, 192, 194, 195,
-	196, 197, 198, 199, 200, 201, 202, 203, 204, 250,
-	184, 185, 186, 187, 205, 170, 190, 171, 172, 173,
-	174, 175, 176, 179, 180, 181, 182, 178, -299, -204,
-	-173, 35, -509, 389, -521, 154, 40, -519, 477, -139,
-	314, -347, 79, -146, 16, 81, -204, -343, -204, -204,
-	-204, -217, 34, 16, -155, -205, -299, 81, 82, 154,
-	-325, -355, 79, -299, -291, -264, -264, -204, -299, -264,
-	-264, -310, -312, -312, -308, 153, -290, -173, 154, -203,
-	-296, -200, -202, 241, -315, -201, 244, -478, 242, 240,
-	106, 245, 299, 107, 235, -296, -296, 241, -238, 237,
-	34, -296, -253, 235, 299, 242, 20, -252, 235, 107,
-	-299, 241, 245, 240, -295, 122, -289, 149, 237, 42,
-	364, -295, 525, -295, -295, -295, -295, -295, -295, -295,
-	273, 273, -295, -295, -295, -295, -295, -295, -295, -295,
-	-295, -295, -295, 159, -295, -295, -295, -295, -295, -295,
-	81, 268, 269, -510, 389, 30, 345, 345, -521, 30,
-	-147, 339, 30, -270, -271, -272, -273, 67, 71, 73,
-	68, 69, 70, 74, 30, 154, -297, -301, 34, -299,
-	87, -297, -151, -156, -161, -297, 81, -533, -535, 469,
-	466, 472, -378, -378, 237, 81, 122, -378, -378, 40,
-	-298, -530, 473, 467, -181, 23, 154, 78, -206, -183,
-	-191, -264, -154, -156, -299, 87, -299, 141, -264, 119,
-	-6, 117, -125, -124, -123, 120, 580, 586, 119, 119,
-	119, 82, 82, 82, 154, 82, 82, 82, 154, 82,
-	154, -452, 446, 39, 154, 81, 82, 154, 60, 154,
-	122, 82, 154, 82, 60, -181, 153, 421, -299, -463,
-	82, -384, 154, 237, 153, 153, -360, 367, -298, -362,
-	20, 521, -280, 38, -286, 596, 82, -326, -326, 111,
-	-323, -320, 82, 119, -325, 117, -208, -210, 383, 384,
-	-209, -215, 150, 188, 250, 187, 186, 184, 383, 384,
-	-228, -299, -325, -325, 82, -325, -325, 16, -299, -228,
-	-321, -325, -168, 82, 82, -391, -392, -391, -391, 82,
-	82, 82, 82, -391, 82, 82, 82, 82, 82, 82,
-	82, 82, 81, -445, -452, -325, -325, -165, -164, 43,
-	597, 154, 122, 167, 375, 82, 154, -280, 82, 154,
-	-210, -173, 34, -512, 448, 391, 401, -295, -317, -316,
-	341, 41, -432, 412, 397, 398, -361, 264, -299, -518,
-	94, 122, 78, 329, 330, 331, 332, -341, -342, -340,
-	-344, -506, -227, -261, -299, 87, 81, -225, 521, -204,
-	81, 81, 81, -412, 319, -210, -204, -155, -299, 16,
-	154, -505, 153, -1, -299, -354, -310, -264, -540, -264,
-	-310, -310, -312, -299, 34, -251, -207, -280, -201, -477,
-	-478, -343, -299, 107, -477, 107, 81, -207, -280, -280,
-	-254, -309, -280, -299, -299, -299, -257, -256, -280, -299,
-	-299, -299, 107, 107, -233, 40, 47, 48, 49, -295,
-	-295, 191, -236, 40, 411, 413, 414, 96, 96, 96,
-	96, 87, 87, 87, -295, -295, 96, 87, -301, 87,
-	-479, 166, 44, 45, 96, 96, 96, 96, 40, 87,
-	-240, 40, 284, 288, 285, 286, 287, 87, 96, 40,
-	96, 40, 96, 40, -299, 81, -480, -481, 87, -512,
-	-295, 345, -375, 122, -317, -517, 314, -140, 91, 31,
-	-177, 230, 227, -506, -366, -365, -280, -160, -160, -160,
-	-160, 67, 67, 67, 72, 67, 72, 67, -272, -366,
-	-162, -173, -301, 82, -526, -525, -524, -522, 75, 238,
-	76, -330, 466, 470, 471, -362, -313, 87, -369, 14,
-	-204, -204, -431, 294, 295, 82, -266, 18, 153, -266,
-	115, -6, -121, -123, -325, -6, -325, 582, 357, 583,
-	87, 96, 96, -460, 431, 426, 428, 107, -332, -447,
-	-446, 60, -148, -171, -439, -483, -445, -299, 60, -148,
-	-439, -299, 96, 16, -359, -354, 141, 141, -299, 368,
-	-370, 87, 390, 87, 233, 597, -286, -320, -325, 82,
-	-218, 175, 174, -218, 82, -417, -417, -416, -419, -416,
-	-218, -218, 82, 82, 23, 82, 82, 82, -325, 82,
-	82, 154, -436, 486, -391, -391, -391, -391, -391, -391,
-	-391, -391, -391, -391, -391, -391, -391, -391, -334, -333,
-	256, -460, 44, 45, -285, 87, 87, 87, -194, -210,
-	231, -185, 40, 34, -148, -513, 91, -507, 87, -299,
-	-514, 91, 392, 152, 343, 40, 393, 394, 409, 338,
-	96, 96, 399, -508, -299, -147, -516, 51, 122, 87,
-	-204, -340, -289, 149, 275, 322, 82, 154, -295, -295,
-	-197, -198, -199, -207, -200, -202, 34, 81, 81, -197,
-	-197, -197, -413, 321, 20, 96, 140, -174, 79, 153,
-	-156, -205, -299, 141, 82, -264, -299, -264, -264, -310,
-	-148, -255, 44, 46, -210, -475, -299, -473, -475, -299,
-	-473, -473, -343, -325, -255, -207, 237, 30, 227, -258,
-	327, 328, -371, 300, 112, -371, 154, -163, -299, -228,
-	-228, 30, 87, 87, -206, 82, 154, 122, -513, -507,
-	122, -376, 87, -514, -518, 122, 87, 154, -177, -177,
-	-264, 154, 122, -179, -178, 78, 79, -180, 78, -178,
-	67, 67, -264, -524, -523, 23, -478, -478, -478, 82,
-	82, -265, 19, 20, 141, -265, 119, 117, 119, 119,
-	-299, 82, 82, -423, 574, -456, -458, 426, 20, 20,
-	14, 82, -439, -439, -354, -370, 412, -204, 597, -325,
-	82, -325, 82, 87, -168, 20, -423, -187, -210, -318,
-	-148, -144, 521, 87, -511, 403, 87, 87, 96, 40,
-	96, 152, 395, -433, -140, -515, 91, 122, 596, 81,
-	-295, -295, -295, -299, -261, -318, -318, 82, 154, -148,
-	-226, -260, -299, 87, -197, 82, 82, 82, -414, 255,
-	96, 140, 96, 140, 96, -297, -156, -299, -264, -505,
-	153, -264, -264, -280, -222, -221, -219, 101, 112, 40,
-	381, -220, 91, 149, 289, 292, 291, 267, 290, -249,
-	-314, 78, 387, 327, 328, -344, 574, 515, 240, 106,
-	107, 369, -315, 81, 81, 79, 309, 81, 81, -475,
-	82, -255, -280, 40, -258, 40, 300, -256, 149, -228,
-	82, -481, 87, -511, 87, -378, -516, 87, -506, -168,
-	-365, -445, -325, 81, -325, 81, 67, 11, 18, -325,
-	-331, 239, -6, 583, 357, -242, 575, 87, 20, 87,
-	-454, 87, -366, -117, -450, -398, 82, 82, -391, -394,
-	-393, -397, 432, 301, 439, -331, -242, 520, -144, -145,
-	59, 473, 20, 521, 342, 40, 96, 40, 396, 87,
-	102, 103, -287, -288, 87, -349, -351, -280, 81, -228,
-	-230, -298, 150, 87, -225, -199, 82, 154, -295, -295,
-	82, -223, -224, -259, -344, -116, -115, -223, -415, 158,
-	96, 140, 96, 96, -264, -264, -219, 101, -321, 87,
-	-230, -231, 40, 288, 284, 122, 122, -232, 40, 268,
-	269, -241, 81, 299, 14, 191, 81, 107, 107, -204,
-	-349, -349, -476, 329, 330, 331, 333, 332, -476, -349,
-	-349, 81, -372, -371, -321, -295, 149, -515, -169, -172,
-	-474, -299, 240, 20, 20, -299, -299, -283, 576, 96,
-	87, 428, -424, 577, -459, 431, -453, -451, 426, 427,
-	428, 429, 82, -396, 114, 395, 399, -319, -322, 96,
-	99, 183, 152, -283, -188, -299, 299, 87, 390, 87,
-	342, 597, 154, 122, 82, 154, -416, -325, -260, -318,
-	-318, -225, -386, 256, 154, -259, -289, -117, -386, -430,
-	166, 320, 521, 96, 140, 96, -168, 87, 87, 96,
-	81, -440, 30, 87, -350, 81, 82, 82, 82, 82,
-	-349, -228, -295, 82, 82, 154, 81, 20, 87, -461,
-	238, -457, -458, 430, -451, 20, 428, 20, 20, -395,
-	-396, 399, -319, -322, 572, 437, 437, 437, 34, 368,
-	412, -288, 87, -352, -351, -163, 82, -387, 308, 20,
-	-259, -295, -387, 320, 96, 140, 96, -169, 82, -325,
-	-335, -295, 82, -244, -243, 517, -349, -352, 79, -352,
-	79, -352, 79, -352, 79, 82, -228, -299, 240, -284,
-	-299, -454, -468, 81, 96, -456, -455, -457, 20, -454,
-	20, -454, -454, 111, 438, 438, 438, 87, -353, 246,
-	91, 521, 327, 328, -163, 20, -388, 301, 302, -389,
-	-402, 304, -228, 96, 82, -299, -248, -246, -247, 78,
-	444, 297, 298, 82, -476, -476, -476, -476, -249, 82,
-	154, -472, 30, 82, -467, -466, -281, -462, -299, 431,
-	432, 87, -454, -395, -295, 87, 326, -280, 81, -400,
-	305, 81, -398, 81, -398, 107, 330, -371, -247, 78,
-	-246, 78, 15, 14, -352, -352, -352, -352, -299, -471,
-	-470, -469, 82, 154, 153, 96, -299, -385, -390, 306,
-	96, -321, 81, -321, 81, -399, 303, 81, -245, 294,
-	295, 30, 166, -245, 154, 122, -466, -299, 82, -404,
-	81, 20, 82, -385, 82, -385, 81, 122, -321, 101,
-	40, 296, -470, 40, -325, 153, -405, -403, 256, -389,
-	82, 82, 82, -385, 96, 82, -299, 82, 154, -299,
-	-401, 307, 82, -403, -406, 60, 96, -410, -407, 81,
-	-259, 258, 132, -410, -259, -409, -408, 306, 259, 81,
-	82, 154, -299, 255, 81, -331, -408, -407, -331, 82,
-	82,
+	81, 81, 81, -180, 154, 81, -115, 38, -182, 21,
+	-164, -163, 20, 31, 32, 31, 32, 31, 32, 31,
+	32, 96, 609, 220, 238, 473, 529, 165, 166, 167,
+	-201, -329, -203, -194, -329, 238, -154, 108, -373, 241,
+	-373, -373, -373, -373, -373, -373, 238, -373, 237, -373,
+	-233, 521, 520, 235, 235, 235, -373, 491, 122, 122,
+	58, -534, 169, 154, -523, -185, 81, -340, 130, 134,
+	-340, 23, 265, 265, 265, -340, 305, -570, -571, 16,
+	132, -338, -571, -338, -338, -340, -572, 237, 458, 42,
+	266, 265, -181, -182, -181, 452, 448, -429, 453, 454,
+	-342, -571, -341, -340, -340, -341, -340, -340, -340, 235,
+	238, 484, 325, 606, -570, -570, 30, 30, -458, -233,
+	-458, 518, -320, -329, -458, -458, -458, -208, -329, -535,
+	240, -565, -564, 471, -567, 473, 159, -406, 159, -406,
+	-387, 267, 267, 154, 122, 23, -407, 122, 133, -406,
+	-406, -407, -407, -257, 40, -328, 150, -329, 87, -257,
+	40, -562, -561, -233, -181, -164, -163, 82, 82, 82,
+	-458, -458, -458, -458, -458, -460, -458, -458, -458, -458,
+	-335, -194, -203, 241, -458, -458, -458, -458, 238, -165,
+	-166, 141, -355, -329, -165, -3, -121, -120, 116, 117,
+	119, 599, 364, 598, 602, 596, -406, 40, -453, 391,
+	390, -447, -449, 81, -448, 81, -448, -448, -448, 81,
+	81, -448, -448, -450, 81, -450, -450, -447, -451, 81,
+	-451, -452, 81, -452, -451, -329, -360, -361, -329, 38,
+	-468, 60, -160, 81, 30, 81, -329, -469, 60, -160,
+	81, 30, -181, -115, -182, 153, 96, 87, -84, 82,
+	154, -527, 102, 103, -530, 202, 194, 524, -329, -99,
+	87, -7, -8, -9, -10, -42, -74, -160, -496, -494,
+	81, 31, 418, 78, 16, -413, 235, 484, 369, 263,
+	238, 346, -411, -394, -391, -389, -328, -387, -390, -389,
+	-416, -310, 448, -116, 432, 317, -355, -355, -355, -355,
+	-355, 101, 112, 343, 102, 103, -350, -369, 31, 313,
+	314, -351, -351, -351, -351, -351, -351, -351, -351, -351,
+	-351, -351, -351, -358, -367, -442, 81, 132, 130, 134,
+	131, 114, -353, -353, -351, -351, 82, 154, -520, -519,
+	116, -355, -355, -416, -329, -517, -518, 498, 499, 500,
+	501, 502, 503, 504, 505, 506, 507, 508, 360, 355,
+	361, 359, 349, 367, 362, 363, 187, 515, 516, 509,
+	510, 511, 512, 513, 514, -360, -360, -355, -517, -360,
+	-304, 32, 31, -361, -361, -361, 82, -355, -531, 341,
+	340, 342, -183, -329, -360, 82, 82, 82, 96, -361,
+	-361, -360, -351, -360, -518, -304, -304, -304, 141, -361,
+	-361, -304, -304, -304, -304, 141, -304, -304, -304, -304,
+	-304, -304, -304, -304, 82, 82, 82, 82, 82, -361,
+	-361, -361, -361, -361, -361, -181, -114, -477, -476, -355,
+	40, 589, -115, -355, -178, -177, -355, -559, 87, 87,
+	631, -233, 474, 475, 163, 166, 165, 34, 81, 81,
+	153, -373, 491, -233, 112, -537, -329, -395, -392, -389,
+	-329, -329, -208, -215, -329, -373, -385, -329, -329, 529,
+	-373, 521, -373, -373, -373, -233, -268, 52, 53, 54,
+	-389, -155, 55, 56, -535, -522, 34, -184, -329, -294,
+	17, -353, -353, -389, 267, -570, -340, -340, -321, -320,
+	-342, -337, -342, -342, -294, -571, -340, -340, -342, -338,
+	-294, -329, 448, -294, -294, -429, -339, -329, -339, -373,
+	-320, -321, -321, -233, -279, -284, -280, 232, 229, 256,
+	259, 260, 353, 354, 230, 228, 11, -289, 306, -374,
+	492, -263, -264, 76, 41, -266, 395, 388, 269, 273,
+	91, 274, 428, 275, 237, 277, 278, 279, 294, 296,
+	248, 280, 281, 282, 419, 283, 158, 295, 284, 285,
+	286, 371, -258, 6, 328, 40, 50, 51, 441, 440,
+	532, 529, 270, -537, -537, 30, 30, -395, -329, -329,
+	-209, -212, 78, 230, 153, -173, -168, -172, -169, -174,
+	-297, -299, 344, -171, 81, -233, -163, -329, 624, 154,
+	472, 474, 475, -565, -407, -565, -407, 31, 418, -410,
+	418, 31, -385, -404, 468, 470, -400, 87, 419, -390,
+	-409, 78, 150, -476, -407, -407, -409, -409, 149, 154,
+	-563, 473, 474, 224, -115, -235, -233, -537, -394, -385,
+	-329, -458, -235, -235, -329, -329, 81, 35, -329, -329,
+	-208, -215, -458, -293, 154, -292, 16, -330, -329, 34,
+	87, 153, -293, -122, -120, 118, -355, -6, 598, -355,
+	-6, -6, -355, -6, -355, -456, 392, 96, 96, -312,
+	87, -312, 96, 96, 96, 82, 87, -398, 78, -470,
+	-362, -515, 588, -188, 82, -183, -513, -514, -183, -187,
+	-329, -470, -188, 82, -513, -115, -115, -329, 23, -84,
+	-525, 153, 154, -184, -413, -393, -390, -415, 141, -329,
+	-401, 154, 529, 634, 85, 239, -551, -550, 410, 82,
+	154, -480, 240, 491, 87, 631, 220, 101, 343, 102,
+	103, -442, -361, -358, -353, -353, -351, -351, -356, 253,
+	-356, 111, -355, -354, -519, 118, -355, 34, 154, 79,
+	82, 153, 82, 82, 16, 16, 82, -355, 82, 82,
+	82, 82, 16, 16, -355, 82, 153, 82, 82, 82,
+	82, 79, 82, 154, 82, 154, -361, -355, -361, 82,
+	82, 82, -355, -355, -355, -361, 82, -355, -355, -355,
+	-355, -355, -355, -355, -355, -423, 443, -423, -423, -423,
+	-423, 82, 82, 82, 82, 82, 82, 154, 82, 87,
+	154, 22, 154, -175, 36, 37, -112, 610, -316, -315,
+	87, -150, 451, 610, 164, 167, 167, 166, -202, -204,
+	-205, -206, -207, -220, 132, 155, 156, -195, -196, -197,
+	-198, -239, -310, 189, 192, 194, 195, 196, 197, 198,
+	199, 200, 201, 202, 203, 204, 205, 206, 252, 184,
+	185, 186, 187, 207, 170, 190, 171, 172, 173, 174,
+	175, 176, 179, 180, 181, 182, 178, -329, -233, -185,
+	35, -540, 396, -552, 154, 40, -550, 484, -151, 317,
+	78, -216, 618, 46, 153, -233, -377, 79, -158, 16,
+	81, -233, -373, -233, -233, -233, -246, 34, 16, -167,
+	-234, -329, 81, 82, 154, -355, -386, 79, -329, -321,
+	-294, -294, -233, -329, -294, -294, -340, -342, -342, -338,
+	153, -320, -185, 154, 258, -232, -326, -229, -231, 243,
+	-345, -230, 246, -509, 244, 242, 106, 247, 302, 107,
+	237, 258, -281, 237, 107, -329, 243, 247, 242, 302,
+	244, 258, 258, 258, -326, -326, 243, -267, 239, 34,
+	-326, -282, 237, 302, 244, 20, -325, 122, -319, 149,
+	239, 42, 371, -325, 533, -325, -325, -325, -325, -325,
+	-325, -325, 276, 276, -325, -325, -325, -325, -325, -325,
+	-325, -325, -325, -325, -325, 159, -325, -325, -325, -325,
+	-325, -325, 81, 271, 272, -541, 396, 30, 352, 352,
+	-552, 30, -159, 346, -213, 51, 50, -209, 611, 616,
+	239, -329, 30, -300, -301, -302, -303, 67, 71, 73,
+	68, 69, 70, 74, 30, 154, -327, -331, 34, -329,
+	87, -299, -327, -163, -168, -173, -327, 81, 81, -564,
+	-566, 476, 473, 479, -409, -409, 239, 81, 122, -409,
+	-409, 40, -328, -561, 480, 474, -193, 23, 154, 78,
+	-235, -195, -203, -233, -294, -166, -168, -329, 87, -329,
+	141, -294, 119, -6, 117, -126, -125, -124, 120, 596,
+	602, 119, 119, 119, 82, 82, 82, 154, 82, 82,
+	82, 154, 82, 154, -483, 453, 39, 154, 81, 82,
+	154, 60, 154, 122, 82, 154, 82, 60, -193, 153,
+	428, -329, -494, 82, -415, 154, 239, 153, 153, -391,
+	374, -328, -393, 20, 529, -310, 38, 426, -316, 631,
+	82, -356, -356, 111, -353, -350, 82, 119, -355, 117,
+	-237, -239, 390, 391, -238, -244, 150, 188, 252, 187,
+	186, 184, 390, 391, -257, 526, -329, -355, -355, 82,
+	-355, -355, 16, -329, -257, -351, -355, -180, 82, 82,
+	-422, -423, -422, -422, 82, 82, 82, 82, -422, 82,
+	82, 82, 82, 82, 82, 82, 82, 81, -423, -423,
+	-423, -423, -423, -423, -476, -483, -355, -355, -177, -176,
+	43, 16, 632, 154, 122, 167, 382, 82, 154, -310,
+	82, 154, -239, -185, 34, -543, 455, 398, 408, -325,
+	-347, -346, 348, 41, -463, 419, 404, 405, -392, 267,
+	-329, -549, 94, 122, 611, -217, 13, 14, 15, -329,
+	-149, 623, 78, 332, 333, 334, 335, 336, -371, -372,
+	-370, -374, -537, -256, -291, -329, 87, 81, -254, 529,
+	-233, 81, 81, 81, -443, 322, -239, -233, -167, -329,
+	16, 154, -536, 153, -1, -329, -385, -340, -294, -571,
+	-294, -340, -340, -342, -329, 34, -280, 232, 229, 81,
+	-236, -310, -230, -508, -509, -373, -329, 107, -508, 107,
+	81, -187, -329, -329, -329, 107, 107, -329, -329, -187,
+	31, -187, -329, -236, -310, -310, -283, -339, -310, -329,
+	-329, -329, -287, -286, -310, -262, 40, 47, 48, 49,
+	-325, -325, 191, -265, 40, 418, 420, 421, 96, 96,
+	96, 96, 87, 87, 87, -325, -325, 96, 87, -331,
+	87, -510, 166, 44, 45, 96, 96, 96, 96, 40,
+	87, -269, 40, 287, 291, 288, 289, 290, 87, 96,
+	40, 96, 40, 96, 40, -329, 81, -511, -512, 87,
+	-543, -325, 352, -406, 122, -347, -548, 317, -152, 91,
+	31, -189, 232, 229, -537, -214, 91, -213, -210, 615,
+	612, 617, 112, -208, -397, -396, -310, -172, -172, -172,
+	-172, 67, 67, 67, 72, 67, 72, 67, -302, -397,
+	-174, -185, -331, -327, 82, -557, -556, -555, -553, 75,
+	240, 76, -360, -355, 473, 477, 478, -393, -343, 87,
+	-400, 14, -233, -233, -462, 297, 298, 82, -296, 18,
+	153, -296, 115, -6, -122, -124, -355, -6, -355, 598,
+	364, 599, 87, 96, 96, -491, 438, 433, 435, 107,
+	-362, -478, -477, 60, -160, -183, -470, -514, -476, -329,
+	60, -160, -470, -329, 96, 16, -390, -385, 141, 141,
+	-329, 375, -401, 87, 397, 87, 235, 87, 632, -316,
+	-350, -355, 82, -247, 175, 174, -247, 82, -448, -448,
+	-447, -450, -447, -247, -247, 82, 81, 82, 23, 82,
+	82, 82, -355, 82, 82, 154, -467, 493, -422, -422,
+	-422, -422, -422, -422, -422, -422, -422, -422, -422, -422,
+	-422, -422, -364, -363, 258, -491, 44, 45, 87, -112,
+	-315, 87, 87, 87, -206, -239, 233, -197, 40, 34,
+	-160, -544, 91, -538, 87, -329, -545, 91, 399, 152,
+	350, 40, 400, 401, 416, 345, 96, 96, 406, -539,
+	-329, -159, -547, 51, 122, 87, -210, 78, 34, 612,
+	-233, -370, -319, 149, 278, 325, 82, 154, -325, -325,
+	-226, -227, -228, -236, -229, -231, 34, 81, 81, -226,
+	-226, -226, -444, 324, 20, 96, 140, -186, 79, 153,
+	-168, -234, -329, 141, 82, -294, -329, -294, -294, -340,
+	-160, -436, -434, 258, -285, 44, 46, -239, -506, -329,
+	-504, -506, -329, -504, -504, -373, -355, -329, 38, 529,
+	-285, -236, 239, 30, 229, -288, 330, 331, -402, 303,
+	112, -402, 154, -175, -257, -257, 30, 87, 87, -235,
+	82, 154, 122, -544, -538, 122, -407, 87, -545, -549,
+	122, 87, 154, -189, -189, -219, 596, 87, -214, -211,
+	-212, 78, -355, -355, 617, -294, 154, 122, -191, -190,
+	78, 79, -192, 78, -190, 67, 67, -294, -185, -555,
+	-554, 23, -509, -509, -509, 82, 154, 82, -295, 19,
+	20, 141, -295, 119, 117, 119, 119, -329, 82, 82,
+	-454, 590, -487, -489, 433, 20, 20, 14, 82, -470,
+	-470, -385, -401, 419, -233, 632, -351, -355, 82, -355,
+	82, 87, -180, 20, -454, -199, -239, -348, -160, -156,
+	529, 87, -542, 410, 87, 87, 96, 40, 96, 152,
+	402, -464, -152, -546, 91, 122, 631, -211, -233, -160,
+	-355, 81, -325, -325, -325, -329, -291, -348, -348, 82,
+	154, -160, -255, -290, -329, 87, -226, 82, 82, 82,
+	-445, 257, 96, 140, 96, 140, 96, -327, -168, -329,
+	-294, -536, 153, -294, -294, 82, 154, -329, -310, -251,
+	-250, -248, 101, 112, 40, 388, -249, 91, 149, 292,
+	295, 294, 270, 293, -278, -344, 78, 394, 330, 331,
+	-374, 590, 522, 242, 106, 107, 376, -345, 81, 81,
+	79, 312, 81, 81, -506, 82, 81, 235, -285, -310,
+	40, -288, 40, 303, -286, 149, -257, 82, -512, 87,
+	-542, 87, -409, -547, 87, -537, -4, -219, -209, -517,
+	-180, -396, -476, -355, 81, -355, 81, 67, 11, 18,
+	-221, 87, -355, -361, 241, -6, 599, 364, -271, 591,
+	87, 20, 87, -485, 87, -397, -118, -481, -429, -379,
+	132, 529, 82, 82, -422, -425, -424, -428, 439, 304,
+	446, -361, -271, 528, -156, -157, 59, 480, 20, 529,
+	349, 40, 96, 40, 403, 87, 102, 103, -317, -318,
+	87, -213, 23, -517, -380, -382, -310, 81, -257, -259,
+	-328, 150, 87, -254, -228, 82, 154, -325, -325, 82,
+	-252, -253, -289, -374, -117, -116, -252, -446, 158, 96,
+	140, 96, 96, -294, -294, -434, -437, 60, -248, 101,
+	-351, 87, -259, -260, 40, 291, 287, 122, 122, -261,
+	40, 271, 272, -270, 81, 302, 14, 191, 81, 107,
+	107, -233, -380, -380, -507, 332, 333, 334, 339, 335,
+	336, -507, -380, -380, 81, -403, -402, -436, -233, -351,
+	-325, 149, -546, -213, 613, 614, -181, -184, -505, -329,
+	242, 20, 20, 82, 454, -329, -329, -313, 592, 96,
+	87, 435, -455, 593, -490, 438, -484, -482, 433, 434,
+	435, 436, 82, 74, 527, 530, 82, -427, 114, 402,
+	406, -349, -352, 96, 99, 183, 152, -313, -200, -329,
+	302, 87, 397, 87, 349, 632, 154, 122, -214, 619,
+	82, 154, -447, -355, -290, -348, -348, -254, -417, 258,
+	154, -289, -319, -118, -417, -461, 166, 323, 529, 96,
+	140, 96, -180, -441, -438, 81, -289, 261, 132, 87,
+	87, 96, 81, -471, 30, 87, -381, 81, 82, 82,
+	82, 82, -380, 82, 529, 532, -257, -325, -214, -355,
+	-355, 82, 82, 154, 81, 81, 20, 87, -492, 240,
+	-488, -489, 437, -482, 20, 435, 20, 20, 528, 64,
+	531, -426, -427, 406, -349, -352, 588, 444, 444, 444,
+	34, 375, 419, -318, 87, 596, 588, -383, -382, -175,
+	82, -418, 311, 20, -289, -325, -418, 323, 96, 140,
+	96, -181, -441, -289, -440, -439, 309, 262, 81, 82,
+	-355, -365, -325, 82, -273, -272, 525, -380, -383, 79,
+	-383, 79, -383, 79, -383, 79, 82, 533, 533, -257,
+	-219, 614, -329, 242, -223, -222, -329, 625, -314, -329,
+	-485, -499, 81, 96, -487, -486, -488, 20, -485, 20,
+	-485, -485, 64, 111, 445, 445, 445, 87, -4, -218,
+	620, 621, -384, 248, 91, 529, 330, 331, 337, 338,
+	-175, 20, -419, 304, 305, -420, -433, 307, -257, 96,
+	82, 154, -329, 257, 81, -361, 82, -329, -277, -275,
+	-276, 78, 451, 300, 301, 82, -507, -507, -507, -507,
+	-278, -355, 82, 154, 23, -239, 626, -221, 82, 154,
+	-503, 30, 82, -498, -497, -311, -493, -329, 438, 439,
+	87, -485, 529, -426, -145, -2, -123, -119, -4, -329,
+	-329, -325, 87, 329, -325, 87, -310, 81, -431, 308,
+	81, -429, 81, -429, 107, 333, -439, -438, -361, 82,
+	-402, -276, 78, -275, 78, 15, 14, -383, -383, -383,
+	-383, -222, 627, 626, 35, -221, -329, -502, -501, -500,
+	82, 154, 153, 530, 96, -329, 96, -416, -421, 309,
+	96, -351, 81, -351, 81, -430, 306, 81, 82, -274,
+	297, 298, 30, 166, -274, 87, 626, 154, 122, -497,
+	-329, 531, 82, -435, 81, 20, 82, -416, 82, -416,
+	81, 122, -351, 101, 40, 299, -225, -224, 101, 628,
+	40, 87, -501, 40, -355, 153, -436, -420, 82, 82,
+	82, -416, 96, 82, 78, 87, -329, 82, -432, 310,
+	82, 5, 628, 96, -224, 78, 628,
 }
 
 var yyDef = [...]int{
 	23, -2, 1, 2, 3, 19, 4, 20, 21, 22,
 	24, 25, 26, 27, 28, 29, 30, 31, 32, 33,
 	34, 35, 36, 37, 38, 39, 40, 41, 42, 43,
-	44, 45, 46, 47, 48, 49, 50, 51, 52, 0,
-	263, 264, 265, 823, 824, 825, 826, 827, 828, 0,
-	0, 0, 0, 597, 598, 0, 565, 0, 0, 0,
-	0, 0, 0, 456, 457, 458, 459, 460, 461, 462,
-	463, 464, 465, 466, 467, 468, 469, 470, 471, 472,
-	473, 474, 475, 476, 477, 478, 479, 480, 481, 482,
-	483, 484, 354, 355, 356, 357, 358, 359, 360, 0,
-	288, 284, 209, 210, 211, 212, 213, 295, 296, 433,
-	0, 0, 0, 0, 678, -2, 56, 0, 277, 0,
-	268, 268, 829, 830, 831, 832, 833, 834, 835, 836,
-	837, 838, -2, 610, 0, 566, 567, 568, 569, 570,
-	571, 572, 573, 574, 575, 576, 577, 578, 343, 344,
-	345, 339, 340, 342, 341, -2, 0, 610, 0, 0,
-	0, 698, 0, 0, 715, 737, 23, 0, 7, 9,
-	10, 11, 12, 13, 14, 15, 16, 17, 18, 0,
-	0, 19, 0, 19, 0, 0, 0, 1191, 1192, 1193,
-	1194, -2, 1683, 1661, 1826, 1838, 1839, 1840, 1841, 1842,
-	1843, 1844, 1845, 1846, 1847, 1848, 1849, 1850, 1851, 1852,
-	1853, 1854, 1855, 1856, 1857, 1858, 1859, 1860, 1861, 1862,
-	1863, 1864, 1865, 1866, 1867, 1868, 1869, 1870, 1871, 1872,
-	1873, 1874, 1875, 1876, 1877, 1878, 1879, 1880, 1623, 1624,
-	1625, 1626, 1627, 1628, 1629, 1630, 1631, 1632, 1633, 1634,
-	1635, 1636, 1637, 1638, 1639, 1640, 1641, 1642, 1643, 1644,
-	1645, 1646, 1647, 1648, 1649, 1650, 1651, 1652, 1653, 1654,
-	1655, 1656, 1657, 1658, 1659, 1660, 1662, 1663, 1664, 1665,
-	1666, 1667, 1668, 1669, 1670, 1671, 1672, 1673, 1674, 1675,
-	1676, 1677, 1678, 1679, 1680, 1681, 1682, 1684, 1685, 1686,
-	1687, 1688, 1689, 1690, 1691, 1692, 1693, 1694, 1695, 1696,
-	1697, 1698, 1699, 1700, 1701, 1702, 1703, 1704, 1705, 1706,
-	1707, 1708, 1709, 1710, 1711, 1712, 1713, 1714, 1715, 1716,
-	1717, 1718, 1719, 1720, 1721, 1722, 1723, 1724, 1725, 1726,
-	1727, 1728, 1729, 1730, 1731, 1732, 1733, 1734, 1735, 1736,
-	1737, 1738, 1739, 1740, 1741, 1742, 1744, 1745, 1746, 1747,
-	1748, 1749, 1750, 1751, 1752, 1753, 1754, 1755, 1756, 1757,
-	1758, 1759, 1760, 1761, 1762, 1763, 1764, 1765, 1766, 1767,
-	1768, 1769, 1770, 1771, 1772, 1773, 1774, 1775, 1776, 1777,
+	44, 45, 46, 47, 48, 49, 50, 51, 52, 53,
+	0, 266, 267, 268, 859, 860, 861, 862, 863, 864,
+	865, 866, 867, 0, 0, 0, 0, 616, 617, 0,
+	581, 0, 0, 0, 0, 0, 0, 472, 473, 474,
+	475, 476, 477, 478, 479, 480, 481, 482, 483, 484,
+	485, 486, 487, 488, 489, 490, 491, 492, 493, 494,
+	495, 496, 497, 498, 499, 500, 358, 359, 360, 361,
+	362, 363, 364, 365, 0, 291, 287, 212, 213, 214,
+	215, 216, 298, 299, 449, 0, 0, 0, 0, 697,
+	-2, 59, 0, 0, 280, 0, 271, 271, 868, 869,
+	870, 871, 872, 873, 874, 875, 876, 877, -2, 629,
+	0, 582, 583, 584, 585, 586, 587, 588, 589, 590,
+	591, 592, 593, 594, 595, 596, 597, 347, 348, 349,
+	343, 344, 346, 345, -2, 0, 629, 0, 0, 0,
+	717, 0, 0, 734, 756, 23, 0, 7, 9, 10,
+	11, 12, 13, 14, 15, 16, 17, 18, 0, 0,
+	19, 0, 19, 0, 0, 0, 1285, 1286, 1287, 1288,
+	-2, 1795, 1771, 1949, 1890, 1974, 1975, 1976, 1977, 1978,
+	1979, 1980, 1981, 1982, 1983, 1984, 1985, 1986, 1987, 1988,
+	1989, 1990, 1991, 1992, 1993, 1994, 1995, 1996, 1997, 1998,
+	1999, 2000, 2001, 2002, 2003, 2004, 2005, 2006, 2007, 2008,
+	2009, 2010, 2011, 2012, 2013, 2014, 2015, 2016, 2017, 2018,
+	2019, 2020, 2021, 2022, 2023, 2024, 1733, 1734, 1735, 1736,
+	1737, 1738, 1739, 1740, 1741, 1742, 1743, 1744, 1745, 1746,
+	1747, 1748, 1749, 1750, 1751, 1752, 1753, 1754, 1755, 1756,
+	1757, 1758, 1759, 1760, 1761, 1762, 1763, 1764, 1765, 1766,
+	1767, 1768, 1769, 1770, 1772, 1773, 1774, 1775, 1776, 1777,
 	1778, 1779, 1780, 1781, 1782, 1783, 1784, 1785, 1786, 1787,
-	1788, 1789, 1790, 1791, 1792, 1793, 1794, 1795, 1796, 1797,
-	1798, 1799, 1800, 1801, 1802, 1803, 1804, 1805, 1806, 1807,
-	1808, 1809, 1810, 1811, 1812, 1813, 1814, 1815, 1816, 1817,
-	1818, 1819, 1820, 1821, 1822, 1823, 1824, 1825, 1827, 0,
-	261, 259, 1661, 1683, 1743, 1776, 1826, 0, 843, 0,
-	656, 0, 661, 1145, 656, 289, 599, 600, 698, 698,
-	563, 0, 325, 0, 1674, 329, 0, 0, 0, 560,
-	319, 320, 321, 322, 323, 324, 0, 0, 822, 0,
-	0, 315, 0, 283, 1734, 0, 0, 0, 0, 0,
-	0, 153, 949, 155, 951, 159, 166, 0, 0, 171,
-	172, 175, 176, 177, 178, 179, 0, 183, 0, 185,
-	188, 0, 190, 191, 0, 194, 195, 196, 0, 206,
-	207, 208, 952, 953, 954, -2, 84, 841, 1594, 1484,
-	0, 1491, 1492, 1503, 1514, 1271, 1272, 1273, 1274, 0,
-	0, 0, 0, 0, 1281, 1282, 0, 1298, 1842, 0,
-	1288, 1289, 1290, 1291, 1292, 93, 105, 106, 1536, 1537,
-	1538, 1539, 1540, 1541, 1542, 0, 1544, 1545, 1546, 1457,
-	1258, 1191, 0, 1851, 0, 1871, 1876, 1877, 1878, 1879,
-	1870, 0, 0, 1442, 0, 1432, 0, 0, -2, -2,
-	0, 0, 1801, -2, 1848, 1867, 1875, 1852, 1874, 1844,
-	1845, 1846, 1839, 1840, 1841, 1843, 1853, 1855, 1866, 0,
-	1862, 1872, 1873, 0, 0, 0, 0, 107, 108, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, 1448, -2, 1450, 1451, 1453, -2, 1455, 1456,
-	-2, 1459, 1460, 1461, -2, -2, 1464, 1465, 1466, 1467,
-	1468, 1469, 1472, -2, -2, -2, -2, 1444, 1445, 1446,
-	1447, 1436, 1437, 1438, 1439, 1440, 1441, -2, -2, -2,
-	698, 773, 0, -2, 0, 0, 0, 720, 723, 726,
-	729, 0, 57, 58, 0, 278, 279, 267, 269, 0,
-	273, 0, 266, 0, 0, 0, 0, 981, 981, 981,
-	981, 981, 981, 0, 981, 0, 0, 0, 0, 0,
-	981, 871, 956, 957, 958, 979, 980, 0, 1033, 0,
-	0, 0, 615, 611, 612, 613, 614, 686, 0, 688,
-	691, 540, 540, 487, 0, 0, 0, 540, 0, 501,
-	493, 0, 0, 0, 540, 0, 0, 693, 693, 0,
-	543, 550, 540, 540, -2, 540, 540, 540, 0, 0,
-	507, 508, 509, 493, 493, 512, 513, 514, 525, 526,
-	551, 1618, 0, 0, 433, 0, 433, 0, 433, 433,
-	433, 617, 1771, 1681, 1748, 1734, 0, 0, 234, 1801,
-	239, 0, 1682, 1697, 0, 0, 1715, 0, -2, 0,
-	305, 693, 0, 699, 0, 698, 0, 0, 433, 433,
-	433, 433, 433, 1032, 433, 433, 433, 433, 0, 0,
-	0, 433, 433, 0, 0, 738, 739, 734, 735, 736,
-	740, 741, 5, 6, 19, 0, 0, 0, 0, 0,
-	0, 63, 62, 0, 1595, 1613, 1549, 1550, 1551, 1600,
-	1553, 1604, 1604, 1604, 1604, 1582, 1583, 1584, 1585, 1586,
-	1587, 1588, 1589, 1590, 0, 0, 1593, 1573, 1602, 1602,
-	1602, 1600, 1597, 1554, 1555, 1556, 1557, 1558, 1559, 1560,
-	1561, 1562, 1563, 1564, 1565, 1566, 1567, 1607, 1607, 1610,
-	1610, 1607, 0, 1482, 0, 0, 0, 0, 660, 0,
-	0, 693, -2, 0, 564, 326, 1195, 0, 0, 330,
-	331, 0, 0, 346, 0, 349, 334, 335, 336, 0,
-	133, 0, 317, 318, 0, 0, 285, 0, 0, 0,
-	434, 0, 0, 0, 0, 0, 0, 163, 160, 167,
-	170, 180, 187, 0, 199, 201, 204, 161, 168, 173,
-	174, 181, 202, 162, 164, 165, 169, 203, 205, 182,
-	186, 200, 184, 189, 192, 193, 198, 0, 134, 0,
-	0, 0, 0, 0, 1490, 0, 0, 1522, 1523, 1524,
-	1525, 1526, 1527, 1528, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, -2, 1484, 0, 0, 1277, 1278, 1279, 1280,
-	1283, 0, 1299, 0, 0, 0, 1543, 1547, 0, 1482,
-	1482, 0, 1482, 1478, 0, 0, 0, 0, 0, 0,
-	1482, 1418, 0, 0, 1420, 1433, 0, 0, 1422, 1423,
-	0, 1426, 1427, 1482, 0, 1482, 1431, 1414, 1415, 0,
-	1478, 1478, 1478, 0, 0, 1478, 1478, 1478, 1478, 1478,
-	1478, 1478, 1478, 1478, 1478, 1478, 1478, 0, 0, 0,
-	693, 0, 0, 681, 0, 662, 0, 717, 719, 0,
-	721, 722, 724, 725, 727, 728, 730, 731, 59, 0,
-	0, 0, 0, 271, 0, 276, 0, 840, 0, 0,
-	856, 981, 872, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 981, 0, 981, 981, 981, 0,
-	-2, 0, 0, 0, 617, 616, 0, 687, 0, 0,
-	746, 0, 0, 486, 0, 493, 540, 540, 499, 500,
-	495, 494, 546, 547, 543, 0, 543, 543, 746, 0,
-	519, 520, 521, 540, 540, 527, 694, 528, 529, 543,
-	0, 548, 549, 746, 0, 0, 746, 746, 0, 537,
-	538, 539, 0, 0, 981, 0, 556, 495, 495, 1619,
-	1620, 0, 990, 0, 0, 0, 559, 0, 0, 0,
-	0, 618, 214, 217, 0, 220, 0, 1771, 0, 1771,
-	0, 227, 0, 0, 0, 0, 0, 0, 257, 258,
-	0, 0, 0, 0, 248, 251, 1139, 1140, 946, 947,
-	252, 253, 297, 298, 0, 662, 716, 718, 712, 713,
-	714, 0, 0, 0, 0, 0, 433, 0, 0, 0,
-	0, 593, 0, 595, 0, 0, 0, 754, 748, 750,
-	817, 93, 754, 8, 80, 77, 0, 19, 0, 0,
-	19, 19, 0, 19, 262, 0, 1616, 1614, 1615, 1552,
-	1601, 0, 1578, 0, 1579, 1580, 1581, 0, 0, 1574,
-	0, 1575, 1576, 1577, 1568, 0, 1569, 1570, 0, 1571,
-	1572, 260, 0, 1483, 844, 0, 635, 648, 630, 0,
-	637, 0, 1146, 619, 648, 621, 0, 637, 662, 684,
-	662, 0, 327, 0, 332, 0, 0, 348, 350, 351,
-	352, 337, 338, 561, 313, 314, 306, 307, 308, 309,
-	310, 311, 312, 316, 103, 0, 286, 287, 0, 0,
-	0, 147, 148, 149, 150, 151, 152, 154, 138, 582,
-	584, 938, 950, 0, 941, 0, 157, 197, 130, 0,
-	0, 1485, 1486, 1487, 1488, 1489, 1493, 0, 1495, 1497,
-	1499, 1501, 0, 1519, -2, -2, 1259, 1260, 1261, 1262,
-	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1504, 1517,
-	1518, 0, 0, 0, 0, 0, 0, 1515, 1515, 1510,
-	0, 1275, 0, 1296, 1300, 0, 0, 0, 94, 1477,
-	1385, 1386, 1387, 1388, 1389, 1390, 1391, 1392, 1393, 1394,
-	1395, 1396, 1397, 1398, 1399, 1400, 1401, 1402, 1403, 1404,
-	1405, 1406, 1407, 1408, 1409, 1410, 1411, 1412, 1413, 0,
-	0, 1484, 0, 0, 0, 1479, 1480, 0, 0, 0,
-	1373, 0, 0, 1379, 1380, 1381, 0, 643, 0, 1443,
-	1419, 1434, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 772, 774, 0, 652, 654, 655, 0, 682, 695,
-	700, 701, 704, 55, 60, 53, 1007, 280, 281, 0,
-	0, 275, 0, -2, 858, 0, 0, 873, 691, 0,
-	0, 887, 431, 932, 0, 0, 0, 948, 971, 977,
-	0, 0, 981, 0, 0, 0, 1011, 874, 879, 880,
-	881, 875, 876, 882, 883, 0, 689, 0, 0, 786,
-	485, 0, 541, 542, 490, 1734, 495, 746, 746, 502,
-	496, 503, 545, 504, 505, 506, 0, 746, 746, 540,
-	543, 530, 544, 543, 533, 534, 0, 552, 557, 553,
-	0, 555, 510, 511, 691, 362, 363, 404, 404, 404,
-	387, 404, 0, 0, 375, 1621, 0, 0, 0, 0,
-	384, 1621, 0, 1621, 1621, 1621, 1621, 1621, 1621, 1621,
-	0, 0, 1621, 1621, 1621, 1621, 1621, 1621, 1621, 1621,
-	1621, 1621, 1621, 0, 1621, 1621, 1621, 1621, 1621, 1125,
-	1621, 0, 991, 394, 395, 396, 397, 402, 403, 0,
-	428, 0, 0, 0, 431, 0, 921, 759, 0, 760,
-	761, 757, 788, 812, 812, 0, 812, 792, 1145, 0,
-	0, 225, 226, 215, 0, 216, 0, 228, 229, 0,
-	231, 232, 233, 240, 1681, 1748, 235, 237, 0, 0,
-	241, 254, 255, 256, 0, 0, 246, 247, 0, 0,
-	300, 301, 303, 0, 710, 579, 1143, 580, 581, 585,
-	0, 0, 588, 590, 591, 592, 858, 0, 926, 927,
-	746, 0, 755, 0, 751, 818, 0, 820, 0, 746,
-	0, 78, 19, 0, 71, 68, 0, 0, 0, 0,
-	0, 1596, 1548, 1617, 0, 0, 0, 1598, 0, 0,
-	0, 0, 61, 664, 626, 0, 629, 645, 0, 649,
-	0, 0, 641, 634, 638, 0, 0, 658, 620, 0,
-	0, 625, 710, 685, 1196, 0, 333, 347, 0, 0,
-	0, 0, 141, 935, 0, 142, 146, 136, 0, 0,
-	0, 940, 0, 937, 942, 0, 156, 0, 0, 131,
-	132, 1002, 1007, 0, 1494, 1496, 1498, 1500, 1502, 0,
-	1505, 1515, 1515, 1511, 0, 1506, 0, 1508, 0, 1485,
-	0, 1301, 0, 0, 0, 0, 0, 0, 1363, 1364,
-	0, 0, 1368, 0, 1370, 1371, 1372, 1374, 0, 0,
-	0, 1378, 0, 1417, 1435, 1421, 1424, 0, 1428, 0,
-	1430, 0, 698, 0, 0, 1338, 1338, 1338, 0, 0,
-	0, 0, 1338, 0, 0, 0, 0, 0, 0, 0,
-	0, 1293, 0, 1294, 1295, 0, 775, 664, 0, 0,
-	0, 707, 705, 706, 0, 1005, 0, 282, 270, 272,
-	0, 0, 0, 846, 847, 849, 0, 852, 853, 854,
-	0, 859, 860, 862, 863, 0, -2, -2, -2, -2,
-	-2, -2, -2, -2, -2, -2, -2, 1666, -2, -2,
+	1788, 1789, 1790, 1791, 1792, 1793, 1794, 1796, 1797, 1798,
+	1799, 1800, 1801, 1802, 1803, 1804, 1805, 1806, 1807, 1808,
+	1809, 1810, 1811, 1812, 1813, 1814, 1815, 1816, 1817, 1818,
+	1819, 1820, 1821, 1822, 1823, 1824, 1825, 1826, 1827, 1828,
+	1829, 1830, 1831, 1832, 1833, 1834, 1835, 1836, 1837, 1838,
+	1839, 1840, 1841, 1842, 1843, 1844, 1845, 1846, 1847, 1848,
+	1849, 1850, 1851, 1852, 1853, 1854, 1856, 1857, 1858, 1859,
+	1860, 1861, 1862, 1863, 1864, 1865, 1866, 1867, 1868, 1869,
+	1870, 1871, 1872, 1873, 1874, 1875, 1876, 1877, 1878, 1879,
+	1880, 1881, 1882, 1883, 1884, 1885, 1886, 1887, 1888, 1889,
+	1891, 1892, 1893, 1894, 1895, 1896, 1897, 1898, 1899, 1900,
+	1901, 1902, 1903, 1904, 1905, 1906, 1907, 1908, 1909, 1910,
+	1911, 1912, 1913, 1914, 1915, 1916, 1917, 1918, 1919, 1920,
+	1921, 1922, 1923, 1924, 1925, 1926, 1927, 1928, 1929, 1930,
+	1931, 1932, 1933, 1934, 1935, 1936, 1937, 1938, 1939, 1940,
+	1941, 1942, 1943, 1944, 1945, 1946, 1947, 1948, 1950, 1951,
+	1952, 1953, 1954, 1955, 1956, 1957, 1958, 1959, 1960, 1961,
+	1962, 1963, 0, 264, 262, 1771, 1795, 1855, 1890, 1894,
+	1949, 0, 882, 0, 675, 0, 680, 1238, 675, 292,
+	618, 619, 717, 717, 579, 0, 328, 0, 1786, 332,
+	0, 0, 0, 576, 322, 323, 324, 325, 326, 327,
+	0, 0, 858, 0, 0, 318, 0, 286, 1846, 0,
+	0, 0, 0, 0, 0, 156, 1037, 158, 1039, 162,
+	169, 0, 0, 174, 175, 178, 179, 180, 181, 182,
+	0, 186, 0, 188, 191, 0, 193, 194, 0, 197,
+	198, 199, 0, 209, 210, 211, 1040, 1041, 1042, 1043,
+	-2, 87, 880, 1704, 1592, 0, 1599, 1600, 1611, 1622,
+	1365, 1366, 1367, 1368, 0, 0, 0, 0, 0, 1375,
+	1376, 0, 1406, 1978, 0, 1382, 1383, 1384, 1385, 1386,
+	0, 96, 108, 109, 1644, 1645, 1646, 1647, 1648, 1649,
+	1650, 0, 1652, 1653, 1654, 1565, 1352, 1285, 0, 1987,
+	0, 2007, 2012, 2013, 2014, 2015, 2006, 0, 0, 1550,
+	0, 1540, 0, 0, -2, -2, 0, 0, 1919, -2,
+	1984, 2003, 2011, 1988, 2010, 1980, 1981, 1982, 1975, 1976,
+	1977, 1979, 1989, 1991, 2002, 0, 1998, 2008, 2009, 0,
+	0, 0, 0, 2023, 2024, 2022, 2017, 2018, 2019, 2020,
+	2021, 110, 111, -2, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, -2, -2, 1556, -2, 1558, 1559,
+	1561, -2, 1563, 1564, -2, 1567, 1568, 1569, -2, -2,
+	1572, 1573, 1574, 1575, 1576, 1577, 1580, -2, -2, -2,
+	-2, 1552, 1553, 1554, 1555, 1544, 1545, 1546, 1547, 1548,
+	1549, -2, -2, -2, 717, 792, 0, -2, 0, 0,
+	0, 739, 742, 745, 748, 0, 60, 61, 0, 0,
+	281, 282, 270, 272, 0, 276, 0, 269, 0, 0,
+	0, 0, 1073, 1073, 1073, 1073, 1073, 1073, 1073, 0,
+	1073, 0, 1073, 0, 0, 0, 0, 0, 1073, 910,
+	1045, 1046, 1047, 1071, 1072, 0, 1126, 0, 0, 0,
+	634, 630, 631, 632, 633, 705, 0, 707, 710, 556,
+	556, 503, 0, 0, 0, 556, 0, 517, 509, 0,
+	0, 0, 556, 0, 0, 712, 712, 0, 559, 566,
+	556, 556, -2, 556, 556, 556, 0, 0, 523, 524,
+	525, 509, 509, 528, 529, 530, 541, 542, 567, 1728,
+	0, 0, 449, 0, 449, 0, 449, 449, 449, 0,
+	636, 1883, 1793, 1860, 1846, 0, 0, 237, 1919, 242,
+	0, 1794, 1809, 0, 0, 1827, 0, -2, 0, 308,
+	712, 0, 718, 0, 717, 0, 0, 449, 449, 449,
+	449, 449, 1125, 449, 449, 449, 449, 0, 0, 0,
+	449, 449, 449, 449, 0, 0, 0, 757, 758, 753,
+	754, 755, 759, 760, 5, 6, 19, 0, 0, 0,
+	0, 0, 0, 66, 65, 0, 1705, 1723, 1657, 1658,
+	1659, 1710, 1661, 1714, 1714, 1714, 1714, 1690, 1691, 1692,
+	1693, 1694, 1695, 1696, 1697, 1698, 0, 0, 1701, 1714,
+	1714, 1681, 1712, 1712, 1712, 1710, 1707, 1662, 1663, 1664,
+	1665, 1666, 1667, 1668, 1669, 1670, 1671, 1672, 1673, 1674,
+	1675, 1717, 1717, 1720, 1720, 1717, 0, 1590, 0, 0,
+	0, 0, 679, 0, 0, 712, -2, 0, 580, 329,
+	1289, 0, 0, 333, 334, 0, 0, 350, 0, 353,
+	337, 338, 339, 0, 136, 0, 320, 321, 0, 0,
+	288, 0, 0, 0, 450, 0, 0, 0, 0, 0,
+	0, 166, 163, 170, 173, 183, 190, 0, 202, 204,
+	207, 164, 171, 176, 177, 184, 205, 165, 167, 168,
+	172, 206, 208, 185, 189, 203, 187, 192, 195, 196,
+	201, 0, 137, 0, 0, 0, 0, 0, 1598, 0,
+	0, 1630, 1631, 1632, 1633, 1634, 1635, 1636, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, -2, 1592, 0, 0,
+	1371, 1372, 1373, 1374, 1377, 0, 1407, 0, 0, 0,
+	0, 1651, 1655, 0, 1590, 1590, 0, 1590, 1586, 0,
+	0, 0, 0, 0, 0, 1590, 1526, 0, 0, 1528,
+	1541, 0, 0, 1530, 1531, 0, 1534, 1535, 1590, 0,
+	1590, 1539, 1522, 1523, 0, 1586, 1586, 1586, 0, 0,
+	1586, 1586, 1586, 1586, 1586, 1586, 1586, 1586, 1586, 1586,
+	1586, 1586, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 712, 0, 0, 700, 0, 681, 0,
+	736, 738, 0, 740, 741, 743, 744, 746, 747, 749,
+	750, 62, 0, 0, 0, 0, 0, 274, 0, 279,
+	0, 879, 0, 0, 895, 1073, 911, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1073, 0, 0, 0,
+	0, 1073, 0, 1073, 1073, 1073, 0, -2, 0, 0,
+	0, 636, 635, 0, 706, 0, 0, 765, 0, 0,
+	502, 0, 509, 556, 556, 515, 516, 511, 510, 562,
+	563, 559, 0, 559, 559, 765, 0, 535, 536, 537,
+	556, 556, 543, 713, 544, 545, 559, 0, 564, 565,
+	765, 0, 0, 765, 765, 0, 553, 554, 555, 0,
+	0, 1073, 0, 572, 511, 511, 1729, 1730, 0, 1082,
+	0, 0, 0, 575, 0, 0, 0, 1007, 996, 0,
+	637, 217, 220, 0, 223, 0, 1883, 0, 1883, 0,
+	230, 0, 0, 0, 0, 0, 0, 260, 261, 0,
+	0, 0, 0, 251, 254, 1232, 1233, 1034, 1035, 255,
+	256, 300, 301, 0, 681, 735, 737, 731, 732, 733,
+	0, 0, 0, 0, 0, 449, 0, 0, 0, 0,
+	612, 0, 614, 0, 0, 0, 0, 0, 449, 773,
+	767, 769, 853, 96, 773, 8, 83, 80, 0, 19,
+	0, 0, 19, 19, 0, 19, 265, 0, 1726, 1724,
+	1725, 1660, 1711, 0, 1686, 0, 1687, 1688, 1689, 0,
+	0, 1702, 1703, 1682, 0, 1683, 1684, 1685, 1676, 0,
+	1677, 1678, 0, 1679, 1680, 263, 0, 1591, 883, 0,
+	654, 667, 649, 0, 656, 0, 1239, 638, 667, 640,
+	0, 656, 681, 703, 681, 0, 330, 0, 335, 0,
+	0, 352, 354, 355, 356, 340, 341, 342, 577, 316,
+	317, 309, 310, 311, 312, 313, 314, 315, 319, 106,
+	0, 289, 290, 0, 0, 0, 150, 151, 152, 153,
+	154, 155, 157, 141, 601, 603, 1026, 1038, 0, 1029,
+	0, 160, 200, 133, 0, 0, 1593, 1594, 1595, 1596,
+	1597, 1601, 0, 1603, 1605, 1607, 1609, 0, 1627, -2,
+	-2, 1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361,
+	1362, 1363, 1364, 1612, 1625, 1626, 0, 0, 0, 0,
+	0, 0, 1623, 1623, 1618, 0, 1369, 0, 1404, 1408,
+	0, 0, 0, 0, 97, 1585, 1493, 1494, 1495, 1496,
+	1497, 1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506,
+	1507, 1508, 1509, 1510, 1511, 1512, 1513, 1514, 1515, 1516,
+	1517, 1518, 1519, 1520, 1521, 0, 0, 1592, 0, 0,
+	0, 1587, 1588, 0, 0, 0, 1481, 0, 0, 1487,
+	1488, 1489, 0, 662, 0, 1551, 1527, 1542, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 791, 793, 0, 671, 673,
+	674, 0, 701, 714, 719, 720, 723, 58, 63, 56,
+	1100, 990, 283, 284, 0, 0, 278, 0, -2, 897,
+	0, 0, 912, 710, 0, 0, 926, 447, 1020, 0,
+	0, 0, 0, 0, 994, 0, 1036, 1062, 1069, 0,
+	0, 1073, 0, 0, 0, 1104, 913, 918, 919, 920,
+	914, 915, 921, 922, 0, 708, 0, 0, 805, 501,
+	0, 557, 558, 506, 1846, 511, 765, 765, 518, 512,
+	519, 561, 520, 521, 522, 0, 765, 765, 556, 559,
+	546, 560, 559, 549, 550, 0, 568, 573, 569, 0,
+	571, 526, 527, 710, 367, 368, 377, 418, 0, 0,
+	0, 0, 418, 418, 401, 418, 0, 389, 1731, 0,
+	0, 0, 0, 398, 1731, 0, 1731, 1731, 1731, 1731,
+	1731, 1731, 1731, 0, 0, 1731, 1731, 1731, 1731, 1731,
+	1731, 1731, 1731, 1731, 1731, 1731, 0, 1731, 1731, 1731,
+	1731, 1731, 1218, 1731, 0, 1083, 408, 409, 410, 411,
+	416, 417, 0, 444, 0, 0, 0, 447, 0, 960,
+	1009, 1007, 0, 0, 0, 778, 0, 779, 780, 776,
+	807, 848, 0, 848, 0, 848, 812, 1238, 0, 0,
+	0, 228, 229, 218, 0, 219, 0, 231, 232, 0,
+	234, 235, 236, 243, 1793, 1860, 238, 240, 0, 0,
+	244, 257, 258, 259, 0, 0, 249, 250, 0, 0,
+	303, 304, 306, 0, 729, 598, 1236, 599, 600, 604,
+	0, 0, 607, 609, 610, 611, 897, 0, 965, 966,
+	971, 985, 0, 765, 0, 774, 0, 770, 854, 0,
+	856, 0, 765, 0, 81, 19, 0, 74, 71, 0,
+	0, 0, 0, 0, 1706, 1656, 1727, 0, 0, 0,
+	1708, 0, 0, 0, 0, 64, 683, 645, 0, 648,
+	664, 0, 668, 0, 0, 660, 653, 657, 0, 0,
+	677, 639, 0, 0, 644, 729, 704, 1290, 0, 336,
+	351, 0, 0, 0, 0, 144, 1023, 0, 145, 149,
+	139, 0, 0, 0, 1028, 0, 1025, 1030, 0, 159,
+	0, 0, 134, 135, 1094, 1100, 0, 1602, 1604, 1606,
+	1608, 1610, 0, 1613, 1623, 1623, 1619, 0, 1614, 0,
+	1616, 0, 1593, 0, 1409, 0, 0, 0, 0, 0,
+	0, 0, 1471, 1472, 0, 0, 1476, 0, 1478, 1479,
+	1480, 1482, 0, 0, 0, 1486, 0, 1525, 1543, 1529,
+	1532, 0, 1536, 0, 1538, 0, 717, 0, 0, 1446,
+	1446, 1446, 0, 0, 0, 0, 1446, 0, 0, 0,
+	0, 0, 0, 0, 0, 1393, 0, 1394, 1395, 1396,
+	1397, 0, 0, 0, 0, 0, 0, 0, 794, 683,
+	0, 0, 0, 726, 724, 725, 54, 0, 0, 1098,
+	0, 989, 991, 992, 285, 273, 275, 0, 0, 0,
+	885, 886, 888, 0, 891, 892, 893, 0, 898, 899,
+	901, 902, 0, -2, -2, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, 1777, -2, -2, -2, -2, -2,
 	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, 857, 691,
-	0, 982, 894, 1621, 435, 0, 0, 934, 0, 910,
-	0, 0, 0, -2, 0, 0, 1082, 0, 0, 0,
-	0, 1013, 0, 0, 0, 604, 608, 23, 692, 0,
-	747, 488, 0, 489, 540, 497, 498, 746, 1145, 522,
-	523, 746, 540, 540, 543, 0, 554, 0, 990, 365,
-	0, 1154, 1155, 405, 0, 1161, 1170, 981, 1228, 0,
-	1170, 0, 0, 1172, 1173, 0, 0, 0, 0, 388,
-	389, 0, 374, 0, 0, 0, 0, 373, 0, 0,
-	415, 0, 0, 0, 0, 1622, 1621, 1621, 0, 382,
-	383, 0, 386, 0, 0, 0, 0, 0, 0, 0,
-	1621, 1621, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1116, 0, 0, 0, 0, 0, 0,
-	0, 1131, 1132, 894, 1621, 0, 0, 0, 435, 908,
-	917, 0, 0, 0, 0, 0, 0, 779, 0, 0,
-	778, 0, 0, 0, 0, 0, 691, 813, 0, 815,
-	816, 790, -2, 0, 759, 795, 1482, 218, 219, 0,
-	0, 224, 242, 244, 0, 0, 0, 243, 245, 249,
-	250, 299, 302, 304, 680, 0, 0, 0, 1034, 0,
-	596, 744, 749, 756, 819, 821, 94, 752, 744, 0,
-	81, 19, 80, 72, 73, 0, 19, 0, 0, 0,
-	0, 1606, 1605, 1591, 0, 1592, 1603, 1608, 0, 1611,
-	0, 668, 0, 0, 648, 650, 0, 0, 648, 0,
-	0, 657, 0, 0, 648, 683, 0, 0, 562, 104,
-	353, 0, 0, 0, 0, 0, 583, 0, 939, 138,
-	0, 0, 158, 0, 0, 1007, 1481, 1507, 1509, 0,
-	1516, 1512, 1276, 1284, 1297, 0, 0, 1303, 1315, 1315,
-	0, 1306, 1604, 1604, 1309, 1600, 1602, 1600, 1315, 1315,
-	0, 95, 0, 0, 1369, 0, 0, 0, 644, 0,
-	0, 0, 1336, 1338, 1338, 1344, 1339, 1345, 1346, 1338,
-	1338, 1338, 1338, 1351, 1338, 1338, 1338, 1338, 1338, 1338,
-	1338, 1338, 1334, 653, 668, 696, 697, 702, 703, 0,
-	54, 1007, 0, 274, 0, 0, 851, 0, 0, 0,
-	864, 0, 0, 898, 895, 896, 897, 0, 929, 436,
-	437, 438, 439, 0, 0, 0, 933, 0, 921, 903,
-	0, 0, 0, 972, 973, 974, 975, 976, -2, 985,
-	0, 0, 0, 1078, 1621, 1621, 1147, 0, 0, 0,
-	1147, 1147, 1147, 1021, 0, 1012, 656, 0, -2, 0,
-	0, 606, 0, 0, 787, 491, 746, 515, 0, 531,
-	746, 746, 540, 558, 0, 364, 406, 0, 1160, 1185,
-	1171, 1185, 1229, 1185, 1185, 981, 0, 406, 0, 0,
-	376, 412, 0, 0, 0, 0, 372, 409, 704, 413,
-	414, 416, 0, 418, 378, 390, 391, 392, 393, 0,
-	0, 0, 385, 398, 399, 400, 401, 1093, 1094, 1095,
-	1098, 1099, 1100, 1101, 0, 0, 1104, 1105, 1106, 1107,
-	1108, 1182, 1183, 1184, 1109, 1110, 1111, 1112, 1113, 1114,
-	1115, 1133, 1134, 1135, 1136, 1137, 1138, 1117, 1118, 1119,
-	1120, 1121, 1122, 1123, 1124, 0, 0, 1128, 0, 898,
-	0, 0, 0, 0, 929, 910, 0, 920, 0, 922,
-	923, 0, 0, 627, 746, 292, 0, 782, 776, 0,
-	765, 780, 781, 768, 0, 770, 0, 766, 767, 746,
-	758, 789, 814, 791, 794, 796, 797, 803, 0, 0,
-	0, 0, 221, 222, 223, 230, 0, 455, 236, 711,
-	1144, 586, 587, 1035, 1036, 594, 742, 0, 0, 742,
-	76, 79, 0, 74, 0, 0, 0, 0, 66, 64,
-	1599, 0, 0, 670, 118, 0, 0, 0, 646, 0,
-	651, 648, 633, 642, 632, 639, 640, 659, 648, 624,
-	623, 1197, 328, 0, 936, 138, 143, 144, 145, 139,
-	137, 943, 0, 945, 0, 1003, 0, 1513, 1302, 1285,
-	1304, 1316, 1317, 1305, 1286, 1307, 1308, 1310, 1311, 1312,
-	1313, 1314, 1287, 1365, 0, 1367, 1375, 1376, 0, 1425,
-	1429, 0, 0, 0, 1342, 1343, 1347, 1348, 1349, 1350,
-	1352, 1353, 1354, 1355, 1356, 1357, 1358, 1359, 698, 1335,
-	0, 670, 708, 709, 1006, 1008, 839, 842, 848, 850,
-	0, 861, 0, 0, 877, 870, 0, 0, 889, 890,
-	900, 0, 0, 0, 441, 442, 0, 0, 0, 454,
-	450, 451, 452, 432, 928, 917, 906, 0, 0, 916,
-	0, 986, 1621, 1621, 1621, 0, 992, 0, 0, 0,
-	0, 1148, 1149, 1151, 1152, 1153, 0, 0, 1147, 0,
-	0, 0, 1024, 0, 0, 1015, 0, 812, 0, 0,
-	746, 605, 608, 609, 690, 492, 746, 535, 532, 746,
-	361, 377, 407, 0, -2, 0, 1186, 0, 1174, 1186,
-	0, 0, 1185, 0, 366, 406, 0, 0, 0, 419,
-	422, 423, 420, 1224, 0, 421, 0, 411, 417, 1096,
-	1097, 0, 1102, 1103, 0, 1127, 0, 0, 424, 0,
-	0, 0, 425, 430, 903, 0, 918, 0, 924, 925,
-	698, 0, 0, 762, 783, 0, 0, 763, 0, 764,
-	769, 771, 291, 798, 0, 0, 800, 801, 802, 793,
-	238, 732, 0, 0, 753, 733, 0, 19, 0, 0,
-	69, 1609, 1612, 672, 0, 669, 119, 0, 0, 0,
-	0, 647, 631, 622, 140, 135, 944, 121, 1004, 0,
-	1377, 0, 1338, 1337, 1331, 0, 672, 0, 867, 865,
-	877, 869, 884, 899, 888, 0, 930, 931, 0, 443,
-	444, 0, 447, 453, 901, 902, 0, 0, 914, 0,
-	0, 0, 0, 978, 1079, 1080, 1081, 1082, 0, 995,
-	0, 1084, 1621, 1621, 0, -2, 0, -2, 1027, 0,
-	1022, 0, 1014, 0, 1017, 746, 746, -2, 602, 607,
-	0, 516, 536, 408, 1187, -2, 1200, 1202, 0, 991,
-	1205, 1206, 0, 0, 0, 0, 0, 0, 1250, 1214,
-	0, 0, 1218, 1219, 1220, 0, 0, 1223, 0, 1531,
-	1532, 0, 1227, 0, 0, 0, 0, 0, 0, 0,
-	1168, 367, 368, 0, 370, 371, 1225, 410, 1621, 380,
-	1126, 1129, 1130, 429, 426, 427, 906, 909, 628, 693,
-	293, 294, 784, 0, 777, 807, 804, 0, 0, 743,
-	745, 70, 75, 0, 0, 674, 0, 671, 0, 665,
-	667, 129, 636, 91, 112, 0, 1366, 1416, 1341, 0,
-	1332, 0, 1326, 1327, 1328, 1333, 674, 0, 868, 0,
-	885, 886, 0, 0, 440, 445, 0, 448, 449, 907,
-	904, 905, 0, 912, 0, 0, 967, 1600, 0, 987,
-	988, 1141, 1142, 989, 993, 1150, 1083, 0, 0, 0,
-	1082, 1037, -2, 1090, 0, 999, 121, 1037, 1018, 0,
-	1025, 0, 1023, 1016, 698, 603, 1201, 1203, 1204, 1207,
-	1208, 1209, 1255, 1256, 1257, 0, 0, 1212, 1252, 1253,
-	1254, 1213, 0, 0, 0, 0, 0, 1529, 1530, 1248,
-	0, 0, 1175, 1177, 1178, 1179, 1180, 1181, 1176, 0,
-	0, 0, 1167, 1169, 369, 0, 1621, 919, 290, 0,
-	0, 808, 810, 805, 806, 65, 67, 82, 0, 673,
-	120, 0, 83, 0, 109, 0, 122, 123, 0, 0,
-	0, 0, 1340, 1329, 0, 0, 0, 0, 0, 1533,
-	1534, 1535, 0, 663, 0, 866, 0, 891, 0, 893,
-	446, 911, 914, 0, 960, 0, 704, 0, 1085, 1086,
-	1087, 994, 1039, 0, 990, 1092, 1621, 1001, 1039, 1010,
-	0, 1020, 0, 1029, 0, 1026, 693, 1210, 1211, 0,
-	0, 1432, 1621, 0, 1243, 0, 960, 960, 960, 960,
-	0, 379, 0, 785, 799, 0, 0, 0, 92, 96,
-	0, 118, 115, 0, 124, 0, 0, 0, 0, 0,
-	1318, 0, 0, 0, 1322, 1323, 1324, 1325, 0, 878,
-	892, 913, 915, 959, 968, 969, 704, 998, 0, 1076,
-	1091, 0, 1000, 1019, 1028, 0, 1031, 601, 1251, 0,
-	1217, 0, 1222, 1231, 1244, 0, 0, 1156, 0, 1158,
-	0, 1162, 0, 1164, 0, 0, 381, 809, 811, 0,
-	676, 666, 85, 0, 0, 113, 114, 116, 0, 125,
-	0, 127, 128, 0, 1319, 1320, 1321, 855, 961, 1621,
-	0, 0, 965, 966, 970, 0, 1064, 0, 0, 1070,
-	0, 1077, 1096, 1030, 1215, 1221, 1230, 1232, 1233, 0,
-	1245, 1246, 1247, 1249, 960, 960, 960, 960, 1166, 675,
-	0, 1009, 0, 97, 0, 99, 101, 102, 1188, 110,
-	111, 117, 126, 1330, 0, 963, 0, 1040, 0, 1042,
-	0, 0, 0, 0, 0, 1074, 0, 1216, 1234, 0,
-	1235, 0, 0, 0, 1157, 1159, 1163, 1165, 677, 86,
-	87, 0, 98, 0, 0, 962, 964, 0, 1044, 0,
-	1065, 0, 0, 0, 0, 0, 0, 0, 1236, 1238,
-	1239, 0, 0, 1237, 0, 0, 100, 1189, 1041, 1038,
-	0, 1076, 1066, 0, 1068, 0, 0, 0, 0, 1240,
-	1242, 1241, 88, 89, 90, 0, 0, 1046, 0, 1062,
-	1067, 1069, 1071, 0, 1075, 1073, 1190, 1045, 0, 1058,
-	1043, 0, 1072, 1047, -2, 0, 1063, 1048, -2, 0,
-	1056, 0, 0, 1049, 1057, 0, 1052, 0, 0, 0,
-	1051, 0, -2, 1059, 0, 0, 1053, -2, 0, 1061,
-	1060,
+	-2, -2, -2, -2, -2, -2, -2, 896, 710, 0,
+	1074, 933, 1731, 451, 0, 0, 1022, 0, 949, 0,
+	0, 0, 973, 974, 0, 987, 0, 0, -2, 0,
+	0, 1175, 0, 0, 0, 0, 1106, 0, 0, 0,
+	623, 627, 23, 711, 0, 766, 504, 0, 505, 556,
+	513, 514, 765, 1238, 538, 539, 765, 556, 556, 559,
+	0, 570, 0, 1082, 0, 379, 0, 1247, 1248, 419,
+	0, 1254, 1263, 1073, 1322, 0, 1263, 0, 0, 1265,
+	1266, 0, 387, 0, 0, 429, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 402, 403,
+	0, 388, 0, 0, 0, 0, 0, 1732, 1731, 1731,
+	0, 396, 397, 0, 400, 0, 0, 0, 0, 0,
+	0, 0, 1731, 1731, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1209, 0, 0, 0, 0,
+	0, 0, 0, 1224, 1225, 933, 1731, 0, 0, 0,
+	451, 947, 956, 0, 1012, 1010, 1011, 1009, 0, 0,
+	0, 997, 0, 0, 0, 0, 0, 798, 0, 0,
+	797, 0, 0, 0, 0, 0, 710, 849, 0, 851,
+	852, 848, 810, -2, 0, 778, 831, 1590, 0, 221,
+	222, 0, 0, 227, 245, 247, 0, 0, 0, 246,
+	248, 252, 253, 302, 305, 307, 699, 0, 0, 0,
+	1127, 0, 615, 993, 763, 768, 775, 855, 857, 97,
+	771, 763, 0, 84, 19, 83, 75, 76, 0, 19,
+	0, 0, 0, 0, 1716, 1715, 1699, 0, 1700, 1713,
+	1718, 0, 1721, 0, 687, 0, 0, 667, 669, 0,
+	0, 667, 0, 0, 676, 0, 0, 667, 702, 0,
+	0, 578, 107, 357, 0, 0, 0, 0, 0, 602,
+	0, 1027, 141, 0, 0, 161, 0, 0, 0, 1100,
+	1589, 1615, 1617, 0, 1624, 1620, 1370, 1378, 1405, 0,
+	0, 1411, 1423, 1423, 0, 1414, 1714, 1714, 1417, 1710,
+	1712, 1710, 1423, 1423, 0, 0, 98, 0, 0, 1477,
+	0, 0, 0, 663, 0, 0, 0, 1444, 1446, 1446,
+	1452, 1447, 1453, 1454, 1446, 1446, 1446, 1446, 1459, 1446,
+	1446, 1446, 1446, 1446, 1446, 1446, 1446, 1442, 1398, 1399,
+	1400, 1401, 1402, 1403, 672, 687, 715, 716, 721, 722,
+	0, 0, 56, 1100, 0, 277, 0, 0, 890, 0,
+	0, 0, 903, 0, 0, 937, 934, 935, 936, 0,
+	1017, 452, 453, 454, 455, 0, 0, 0, 1021, 0,
+	960, 942, 0, 0, 0, 0, 975, 976, 977, 995,
+	0, 0, 0, 1063, 1064, 1065, 1066, 1067, 1068, -2,
+	1077, 0, 0, 0, 1171, 1731, 1731, 1240, 0, 0,
+	0, 1240, 1240, 1240, 1114, 0, 1105, 675, 0, -2,
+	0, 0, 625, 0, 0, 806, 507, 765, 531, 0,
+	547, 765, 765, 556, 574, 0, 378, 418, 0, 0,
+	420, 0, 1253, 1279, 1264, 1279, 1323, 1279, 1279, 1073,
+	0, 370, 427, 428, 430, 0, 432, 433, 434, 371,
+	372, 0, 0, 420, 0, 0, 390, 426, 0, 0,
+	0, 0, 386, 423, 723, 392, 404, 405, 406, 407,
+	0, 0, 0, 399, 412, 413, 414, 415, 1186, 1187,
+	1188, 1191, 1192, 1193, 1194, 0, 0, 1197, 1198, 1199,
+	1200, 1201, 1276, 1277, 1278, 1202, 1203, 1204, 1205, 1206,
+	1207, 1208, 1226, 1227, 1228, 1229, 1230, 1231, 1210, 1211,
+	1212, 1213, 1214, 1215, 1216, 1217, 0, 0, 1221, 0,
+	937, 0, 0, 0, 0, 1017, 949, 0, 959, 0,
+	961, 962, 0, 0, 646, 1014, 0, 1012, 1003, 0,
+	0, 1005, 0, 1008, 765, 295, 0, 801, 795, 0,
+	784, 799, 800, 787, 0, 789, 0, 785, 786, 765,
+	777, 808, 850, 710, 811, 830, 832, 833, 839, 0,
+	0, 0, 0, 0, 224, 225, 226, 233, 0, 471,
+	239, 730, 1237, 605, 606, 1128, 1129, 613, 761, 0,
+	0, 761, 79, 82, 0, 77, 0, 0, 0, 0,
+	69, 67, 1709, 0, 0, 689, 121, 0, 0, 0,
+	665, 0, 670, 667, 652, 661, 651, 658, 659, 678,
+	667, 643, 642, 1291, 331, 0, 1024, 141, 146, 147,
+	148, 142, 140, 1031, 0, 1033, 0, 1095, 1096, 0,
+	1621, 1410, 1379, 1412, 1424, 1425, 1413, 1380, 1415, 1416,
+	1418, 1419, 1420, 1421, 1422, 1381, 0, 1473, 0, 1475,
+	1483, 1484, 0, 1533, 1537, 0, 0, 0, 1450, 1451,
+	1455, 1456, 1457, 1458, 1460, 1461, 1462, 1463, 1464, 1465,
+	1466, 1467, 717, 1443, 0, 689, 727, 728, 57, 55,
+	1099, 1101, 878, 881, 887, 889, 0, 900, 0, 0,
+	916, 909, 0, 0, 928, 929, 939, 0, 0, 0,
+	457, 458, 0, 0, 0, 470, 466, 467, 468, 448,
+	1016, 956, 945, 0, 0, 955, 1003, 0, 0, 0,
+	0, 1078, 1731, 1731, 1731, 0, 1084, 0, 0, 0,
+	0, 1241, 1242, 1244, 1245, 1246, 0, 0, 1240, 0,
+	0, 0, 1117, 0, 0, 1108, 0, 848, 0, 0,
+	765, 624, 627, 628, 709, 508, 765, 551, 548, 765,
+	366, 0, 1139, 0, 391, 421, 0, -2, 0, 1280,
+	0, 1267, 1280, 0, 0, 1279, 0, 431, 0, 0,
+	380, 420, 0, 0, 0, 435, 438, 439, 436, 1318,
+	0, 437, 0, 425, 1189, 1190, 0, 1195, 1196, 0,
+	1220, 0, 0, 440, 0, 0, 0, 441, 446, 942,
+	0, 957, 0, 963, 964, 968, 0, 1013, 1014, 1007,
+	1004, 0, 998, 0, 1006, 717, 0, 0, 781, 802,
+	0, 0, 782, 0, 783, 788, 790, 294, 809, 834,
+	0, 0, 836, 837, 838, 813, 0, 241, 751, 0,
+	0, 772, 752, 0, 19, 0, 0, 72, 1719, 1722,
+	691, 0, 688, 122, 0, 0, 0, 0, 666, 650,
+	641, 143, 138, 1032, 124, 1097, 1388, 0, 1485, 0,
+	1446, 1445, 1439, 0, 691, 0, 906, 904, 916, 908,
+	923, 938, 927, 0, 1018, 1019, 0, 459, 460, 0,
+	463, 469, 940, 941, 0, 0, 953, 1009, 0, 986,
+	0, 0, 0, 0, 0, 1070, 1172, 1173, 1174, 1175,
+	0, 1087, 0, 1177, 1731, 1731, 0, -2, 0, -2,
+	1120, 0, 1115, 0, 1107, 0, 1110, 765, 765, -2,
+	621, 626, 0, 532, 552, 369, 0, 1151, 422, 1281,
+	-2, 1294, 1296, 0, 1083, 1299, 1300, 0, 0, 0,
+	0, 0, 0, 1344, 1308, 0, 0, 1312, 1313, 1314,
+	0, 0, 1317, 0, 1639, 1640, 0, 1321, 0, 0,
+	0, 0, 0, 0, 0, 1261, 0, 0, 381, 382,
+	0, 384, 385, 1319, 424, 1731, 394, 1219, 1222, 1223,
+	445, 442, 443, 945, 948, 647, 1015, 969, 1009, 999,
+	712, 296, 297, 803, 0, 796, 843, 840, 0, 0,
+	0, 0, 762, 764, 73, 78, 0, 0, 693, 0,
+	690, 0, 684, 686, 132, 655, 94, 115, 0, 0,
+	0, 0, 1474, 1524, 1449, 0, 1440, 0, 1434, 1435,
+	1436, 1441, 693, 0, 907, 0, 924, 925, 0, 0,
+	456, 461, 0, 464, 465, 946, 943, 944, 0, 951,
+	0, 1012, 0, 988, 0, 1058, 1710, 0, 1079, 1080,
+	1234, 1235, 1081, 1085, 1243, 1176, 0, 0, 0, 1175,
+	1130, -2, 1183, 0, 1091, 124, 1130, 1111, 0, 1118,
+	0, 1116, 1109, 717, 622, 1140, -2, 0, 1295, 1297,
+	1298, 1301, 1302, 1303, 1349, 1350, 1351, 0, 0, 1306,
+	1346, 1347, 1348, 1307, 0, 0, 0, 0, 0, 1637,
+	1638, 1342, 0, 0, 1268, 1270, 1271, 1272, 1273, 1274,
+	1275, 1269, 0, 0, 0, 1260, 1262, 0, 374, 383,
+	0, 1731, 958, 1012, 0, 0, 293, 0, 0, 844,
+	846, 841, 842, 814, 0, 68, 70, 85, 0, 692,
+	123, 0, 86, 0, 112, 0, 125, 126, 0, 0,
+	0, 0, 1387, 0, 0, 0, 1448, 1437, 0, 0,
+	0, 0, 0, 1641, 1642, 1643, 0, 682, 0, 905,
+	0, 930, 0, 932, 462, 950, 953, 0, 0, 0,
+	1049, 0, 723, 0, 1178, 1179, 1180, 1086, 1132, 0,
+	1082, 1185, 1731, 1093, 1132, 1103, 0, 1113, 0, 1122,
+	0, 1119, 712, 1141, -2, 0, 1149, 0, 0, 1304,
+	1305, 0, 0, 1540, 1731, 0, 1337, 0, 1049, 1049,
+	1049, 1049, 0, 373, 0, 0, 393, 0, 1014, 1000,
+	1001, 804, 835, 0, 0, 0, 0, 95, 99, 0,
+	121, 118, 0, 127, 0, 0, 0, 0, 0, 1391,
+	1392, 0, 1426, 0, 0, 0, 1430, 1431, 1432, 1433,
+	0, 917, 931, 952, 954, 0, 978, 1048, 1059, 1060,
+	723, 1090, 0, 1169, 1184, 0, 1092, 1112, 1121, 0,
+	1124, 620, 1142, 1150, 0, 1145, 0, 0, 0, 1345,
+	0, 1311, 0, 1316, 1325, 1338, 0, 0, 1249, 0,
+	1251, 0, 1255, 0, 1257, 0, 0, 375, 376, 395,
+	970, 0, 845, 847, 0, 816, 0, 1959, 0, 695,
+	685, 88, 0, 0, 116, 117, 119, 0, 128, 0,
+	130, 131, 1389, 0, 1427, 1428, 1429, 894, 967, 0,
+	0, 0, 1050, 1731, 0, 0, 1054, 1055, 1731, 0,
+	1061, 0, 1157, 0, 0, 1163, 0, 1170, 1189, 1123,
+	1144, 0, -2, 1152, 0, 0, 1309, 1315, 1324, 1326,
+	1327, 0, 1339, 1340, 1341, 1343, 1049, 1049, 1049, 1049,
+	1259, 1002, 815, 0, 0, 0, 0, 822, 694, 0,
+	1102, 0, 100, 0, 102, 104, 105, 1282, 113, 114,
+	120, 129, 0, 1438, 972, 981, 982, 983, 984, 979,
+	980, 0, 1052, 0, 0, 1057, 1133, 0, 1135, 0,
+	0, 0, 0, 0, 1167, 0, 1146, -2, 0, 1154,
+	1310, 1328, 0, 1329, 0, 0, 0, 1250, 1252, 1256,
+	1258, 817, 818, 0, 0, 821, 696, 89, 90, 0,
+	101, 0, 0, 0, 1051, 1053, 1056, 0, 1137, 0,
+	1158, 0, 0, 0, 0, 0, 0, 0, 1153, 1330,
+	1332, 1333, 0, 0, 1331, 823, 0, 0, 0, 103,
+	1283, 1390, 1134, 1131, 0, 1169, 1159, 0, 1161, 0,
+	0, 0, 0, 1334, 1336, 1335, 819, 0, 827, 828,
+	0, 820, 91, 92, 93, 0, 0, 1155, 1160, 1162,
+	1164, 0, 1168, 1166, 0, 829, 1284, 1138, 1136, 0,
+	1165, 824, 825, 1156, 0, 0, 826,
 }
 
 var yyTok1 = [...]int{
@@ -7818,14 +8790,14 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 113, 3, 3, 3, 144, 136, 3,
 	81, 82, 141, 139, 154, 140, 153, 142, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 598, 595,
-	123, 122, 124, 3, 599, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 633, 630,
+	123, 122, 124, 3, 634, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 146, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 596, 135, 597, 147,
+	3, 3, 3, 631, 135, 632, 147,
 }
 
 var yyTok2 = [...]int{
@@ -7922,6 +8894,13 @@ var yyTok3 = [...]int{
 	57905, 580, 57906, 581, 57907, 582, 57908, 583, 57909, 584,
 	57910, 585, 57911, 586, 57912, 587, 57913, 588, 57914, 589,
 	57915, 590, 57916, 591, 57917, 592, 57918, 593, 57919, 594,
+	57920, 595, 57921, 596, 57922, 597, 57923, 598, 57924, 599,
+	57925, 600, 57926, 601, 57927, 602, 57928, 603, 57929, 604,
+	57930, 605, 57931, 606, 57932, 607, 57933, 608, 57934, 609,
+	57935, 610, 57936, 611, 57937, 612, 57938, 613, 57939, 614,
+	57940, 615, 57941, 616, 57942, 617, 57943, 618, 57944, 619,
+	57945, 620, 57946, 621, 57947, 622, 57948, 623, 57949, 624,
+	57950, 625, 57951, 626, 57952, 627, 57953, 628, 57954, 629,
 	0,
 }
 
@@ -8272,13 +9251,13 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:751
+//line mysql_sql.y:798
 		{
 			yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:758
+//line mysql_sql.y:805
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -8286,7 +9265,7 @@ yydefault:
 		}
 	case 5:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:764
+//line mysql_sql.y:811
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
@@ -8295,7 +9274,7 @@ yydefault:
 	case 6:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:772
+//line mysql_sql.y:819
 		{
 			yyLOCAL = tree.NewCompoundStmt(yyDollar[2].statementsUnion())
 		}
@@ -8303,7 +9282,7 @@ yydefault:
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:778
+//line mysql_sql.y:825
 		{
 			yyLOCAL = []tree.Statement{yyDollar[1].statementUnion()}
 		}
@@ -8311,7 +9290,7 @@ yydefault:
 	case 8:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:782
+//line mysql_sql.y:829
 		{
 			yyLOCAL = append(yyDollar[1].statementsUnion(), yyDollar[3].statementUnion())
 		}
@@ -8319,7 +9298,7 @@ yydefault:
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:797
+//line mysql_sql.y:844
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -8327,7 +9306,7 @@ yydefault:
 	case 19:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:801
+//line mysql_sql.y:848
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -8335,7 +9314,7 @@ yydefault:
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:807
+//line mysql_sql.y:854
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -8343,7 +9322,7 @@ yydefault:
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:812
+//line mysql_sql.y:859
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
@@ -8351,7 +9330,7 @@ yydefault:
 	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:816
+//line mysql_sql.y:863
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
@@ -8359,39 +9338,53 @@ yydefault:
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:848
+//line mysql_sql.y:895
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
-		yyDollar = yyS[yypt-4 : yypt+1]
+	case 54:
+		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:856
+//line mysql_sql.y:904
 		{
 			yyLOCAL = &tree.BackupStart{
 				Timestamp: yyDollar[2].str,
 				IsS3:      false,
 				Dir:       yyDollar[4].str,
+				Parent:    yyDollar[5].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
-		yyDollar = yyS[yypt-6 : yypt+1]
+	case 55:
+		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:864
+//line mysql_sql.y:913
 		{
 			yyLOCAL = &tree.BackupStart{
 				Timestamp: yyDollar[2].str,
 				IsS3:      true,
 				Option:    yyDollar[5].strsUnion(),
+				Parent:    yyDollar[7].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 56:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line mysql_sql.y:923
+		{
+			yyVAL.str = ""
+		}
+	case 57:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line mysql_sql.y:927
+		{
+			yyVAL.str = yyDollar[3].str
+		}
+	case 58:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:874
+//line mysql_sql.y:933
 		{
 			var connectionId uint64
 			switch v := yyDollar[3].item.(type) {
@@ -8411,20 +9404,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 59:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:894
+//line mysql_sql.y:953
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:900
+//line mysql_sql.y:959
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -8432,10 +9425,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.KillOption
-//line mysql_sql.y:907
+//line mysql_sql.y:966
 		{
 			yyLOCAL = tree.KillOption{
 				Exist: true,
@@ -8443,20 +9436,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 62:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:915
+//line mysql_sql.y:974
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.StatementOption
-//line mysql_sql.y:921
+//line mysql_sql.y:980
 		{
 			yyLOCAL = tree.StatementOption{
 				Exist:       true,
@@ -8464,10 +9457,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 64:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:930
+//line mysql_sql.y:989
 		{
 			yyLOCAL = &tree.CallStmt{
 				Name: yyDollar[2].procNameUnion(),
@@ -8475,30 +9468,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 65:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:940
+//line mysql_sql.y:999
 		{
 			yyLOCAL = &tree.LeaveStmt{
 				Name: tree.Identifier(yyDollar[2].cstrUnion().ToLower()),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 66:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:948
+//line mysql_sql.y:1007
 		{
 			yyLOCAL = &tree.IterateStmt{
 				Name: tree.Identifier(yyDollar[2].cstrUnion().ToLower()),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 67:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:956
+//line mysql_sql.y:1015
 		{
 			yyLOCAL = &tree.WhileStmt{
 				Name: "",
@@ -8507,10 +9500,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 68:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:964
+//line mysql_sql.y:1023
 		{
 			yyLOCAL = &tree.WhileStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -8519,10 +9512,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 69:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:974
+//line mysql_sql.y:1033
 		{
 			yyLOCAL = &tree.RepeatStmt{
 				Name: "",
@@ -8531,10 +9524,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 70:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:982
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = &tree.RepeatStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -8543,10 +9536,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 71:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:992
+//line mysql_sql.y:1051
 		{
 			yyLOCAL = &tree.LoopStmt{
 				Name: "",
@@ -8554,10 +9547,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 72:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:999
+//line mysql_sql.y:1058
 		{
 			yyLOCAL = &tree.LoopStmt{
 				Name: tree.Identifier(yyDollar[1].cstrUnion().ToLower()),
@@ -8565,10 +9558,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 73:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1008
+//line mysql_sql.y:1067
 		{
 			yyLOCAL = &tree.IfStmt{
 				Cond:  yyDollar[2].exprUnion(),
@@ -8578,42 +9571,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 74:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:1018
+//line mysql_sql.y:1077
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:1022
+//line mysql_sql.y:1081
 		{
 			yyLOCAL = yyDollar[1].elseIfClauseListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:1028
+//line mysql_sql.y:1087
 		{
 			yyLOCAL = []*tree.ElseIfStmt{yyDollar[1].elseIfClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.ElseIfStmt
-//line mysql_sql.y:1032
+//line mysql_sql.y:1091
 		{
 			yyLOCAL = append(yyDollar[1].elseIfClauseListUnion(), yyDollar[2].elseIfClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 78:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ElseIfStmt
-//line mysql_sql.y:1038
+//line mysql_sql.y:1097
 		{
 			yyLOCAL = &tree.ElseIfStmt{
 				Cond: yyDollar[2].exprUnion(),
@@ -8621,10 +9614,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 79:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1047
+//line mysql_sql.y:1106
 		{
 			yyLOCAL = &tree.CaseStmt{
 				Expr:  yyDollar[2].exprUnion(),
@@ -8633,26 +9626,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 80:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.WhenStmt
-//line mysql_sql.y:1057
+//line mysql_sql.y:1116
 		{
 			yyLOCAL = []*tree.WhenStmt{yyDollar[1].whenClause2Union()}
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.WhenStmt
-//line mysql_sql.y:1061
+//line mysql_sql.y:1120
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseList2Union(), yyDollar[2].whenClause2Union())
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 82:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.WhenStmt
-//line mysql_sql.y:1067
+//line mysql_sql.y:1126
 		{
 			yyLOCAL = &tree.WhenStmt{
 				Cond: yyDollar[2].exprUnion(),
@@ -8660,26 +9653,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 83:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:1076
+//line mysql_sql.y:1135
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.Statement
-//line mysql_sql.y:1080
+//line mysql_sql.y:1139
 		{
 			yyLOCAL = yyDollar[2].statementsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 85:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1086
+//line mysql_sql.y:1145
 		{
 			ep := &tree.ExportParam{
 				Outfile:     true,
@@ -8696,10 +9689,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 86:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1107
+//line mysql_sql.y:1166
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -8711,52 +9704,52 @@ yydefault:
 			yyLOCAL.(*tree.Load).Param.Parallel = yyDollar[10].unsignedOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1120
+//line mysql_sql.y:1179
 		{
 			yyLOCAL = &tree.LoadExtension{
 				Name: tree.Identifier(yyDollar[2].str),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 88:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1127
+//line mysql_sql.y:1186
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 89:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1131
+//line mysql_sql.y:1190
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1137
+//line mysql_sql.y:1196
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 91:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1141
+//line mysql_sql.y:1200
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1147
+//line mysql_sql.y:1206
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -8764,10 +9757,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1154
+//line mysql_sql.y:1213
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -8775,18 +9768,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 94:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1162
+//line mysql_sql.y:1221
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 95:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1166
+//line mysql_sql.y:1225
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -8799,58 +9792,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1180
+//line mysql_sql.y:1239
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1184
+//line mysql_sql.y:1243
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 98:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:1188
+//line mysql_sql.y:1247
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 99:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1193
+//line mysql_sql.y:1252
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1197
+//line mysql_sql.y:1256
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1201
+//line mysql_sql.y:1260
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1207
+//line mysql_sql.y:1266
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -8860,10 +9853,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:1216
+//line mysql_sql.y:1275
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -8873,58 +9866,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:1227
+//line mysql_sql.y:1286
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:1231
+//line mysql_sql.y:1290
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:1237
+//line mysql_sql.y:1296
 		{
 			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 107:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarExpr
-//line mysql_sql.y:1241
+//line mysql_sql.y:1300
 		{
 			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1247
+//line mysql_sql.y:1306
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1251
+//line mysql_sql.y:1310
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1257
+//line mysql_sql.y:1316
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -8947,10 +9940,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:1281
+//line mysql_sql.y:1340
 		{
 			//        vs := strings.Split($1, ".")
 			//        var r string
@@ -8969,42 +9962,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 112:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1300
+//line mysql_sql.y:1359
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 113:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1304
+//line mysql_sql.y:1363
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 114:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:1308
+//line mysql_sql.y:1367
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 115:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1313
+//line mysql_sql.y:1372
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 116:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1317
+//line mysql_sql.y:1376
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -9012,10 +10005,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 117:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:1324
+//line mysql_sql.y:1383
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[3].str,
@@ -9023,42 +10016,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 118:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1332
+//line mysql_sql.y:1391
 		{
 			yyVAL.str = ""
 		}
-	case 117:
+	case 120:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1339
+//line mysql_sql.y:1398
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 118:
+	case 121:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1344
+//line mysql_sql.y:1403
 		{
 			yyVAL.str = "\n"
 		}
-	case 120:
+	case 123:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1351
+//line mysql_sql.y:1410
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 121:
+	case 124:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1356
+//line mysql_sql.y:1415
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 122:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1360
+//line mysql_sql.y:1419
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -9081,36 +10074,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1384
+//line mysql_sql.y:1443
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:1388
+//line mysql_sql.y:1447
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1394
+//line mysql_sql.y:1453
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 129:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1400
+//line mysql_sql.y:1459
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -9129,10 +10122,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 130:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1418
+//line mysql_sql.y:1477
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -9150,10 +10143,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:1435
+//line mysql_sql.y:1494
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -9171,50 +10164,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 133:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1458
+//line mysql_sql.y:1517
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1462
+//line mysql_sql.y:1521
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:1466
+//line mysql_sql.y:1525
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 136:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1471
+//line mysql_sql.y:1530
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1475
+//line mysql_sql.y:1534
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 138:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1481
+//line mysql_sql.y:1540
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypePrivilege,
@@ -9228,10 +10221,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 139:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1494
+//line mysql_sql.y:1553
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeRole,
@@ -9243,10 +10236,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 140:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1505
+//line mysql_sql.y:1564
 		{
 			yyLOCAL = &tree.Grant{
 				Typ: tree.GrantTypeProxy,
@@ -9259,26 +10252,26 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 141:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1518
+//line mysql_sql.y:1577
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 142:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1522
+//line mysql_sql.y:1581
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 143:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1532
+//line mysql_sql.y:1591
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypePrivilege,
@@ -9292,10 +10285,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 144:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1545
+//line mysql_sql.y:1604
 		{
 			yyLOCAL = &tree.Revoke{
 				Typ: tree.RevokeTypeRole,
@@ -9307,30 +10300,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1558
+//line mysql_sql.y:1617
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1564
+//line mysql_sql.y:1623
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_STAR_STAR,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 147:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1570
+//line mysql_sql.y:1629
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE_STAR,
@@ -9338,10 +10331,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1577
+//line mysql_sql.y:1636
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_DATABASE_TABLE,
@@ -9350,10 +10343,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:1585
+//line mysql_sql.y:1644
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -9361,74 +10354,74 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1594
+//line mysql_sql.y:1653
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1598
+//line mysql_sql.y:1657
 		{
 			yyLOCAL = tree.OBJECT_TYPE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1602
+//line mysql_sql.y:1661
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1606
+//line mysql_sql.y:1665
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 151:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1610
+//line mysql_sql.y:1669
 		{
 			yyLOCAL = tree.OBJECT_TYPE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 152:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:1614
+//line mysql_sql.y:1673
 		{
 			yyLOCAL = tree.OBJECT_TYPE_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1621
+//line mysql_sql.y:1680
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:1625
+//line mysql_sql.y:1684
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1631
+//line mysql_sql.y:1690
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -9436,10 +10429,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 159:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:1638
+//line mysql_sql.y:1697
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -9447,426 +10440,426 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 157:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1647
+//line mysql_sql.y:1706
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 158:
+	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1651
+//line mysql_sql.y:1710
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 159:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1657
+//line mysql_sql.y:1716
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 160:
+	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1661
+//line mysql_sql.y:1720
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 161:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1665
+//line mysql_sql.y:1724
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 162:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1669
+//line mysql_sql.y:1728
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ACCOUNT
 		}
 		yyVAL.union = yyLOCAL
-	case 163:
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1673
+//line mysql_sql.y:1732
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1677
+//line mysql_sql.y:1736
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 168:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1681
+//line mysql_sql.y:1740
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1685
+//line mysql_sql.y:1744
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1689
+//line mysql_sql.y:1748
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1693
+//line mysql_sql.y:1752
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 172:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1697
+//line mysql_sql.y:1756
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1701
+//line mysql_sql.y:1760
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1705
+//line mysql_sql.y:1764
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1709
+//line mysql_sql.y:1768
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1713
+//line mysql_sql.y:1772
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1717
+//line mysql_sql.y:1776
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1721
+//line mysql_sql.y:1780
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1725
+//line mysql_sql.y:1784
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1729
+//line mysql_sql.y:1788
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1733
+//line mysql_sql.y:1792
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1737
+//line mysql_sql.y:1796
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 183:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1741
+//line mysql_sql.y:1800
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1745
+//line mysql_sql.y:1804
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_DATABASE
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1749
+//line mysql_sql.y:1808
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1753
+//line mysql_sql.y:1812
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CONNECT
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1757
+//line mysql_sql.y:1816
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_MANAGE_GRANTS
 		}
 		yyVAL.union = yyLOCAL
-	case 185:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1761
+//line mysql_sql.y:1820
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_OWNERSHIP
 		}
 		yyVAL.union = yyLOCAL
-	case 186:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1765
+//line mysql_sql.y:1824
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 187:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1769
+//line mysql_sql.y:1828
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1773
+//line mysql_sql.y:1832
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1777
+//line mysql_sql.y:1836
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1781
+//line mysql_sql.y:1840
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1785
+//line mysql_sql.y:1844
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCE
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1789
+//line mysql_sql.y:1848
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1793
+//line mysql_sql.y:1852
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1797
+//line mysql_sql.y:1856
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1801
+//line mysql_sql.y:1860
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1805
+//line mysql_sql.y:1864
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1809
+//line mysql_sql.y:1868
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 201:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1813
+//line mysql_sql.y:1872
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1817
+//line mysql_sql.y:1876
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 200:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1821
+//line mysql_sql.y:1880
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1825
+//line mysql_sql.y:1884
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1829
+//line mysql_sql.y:1888
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1833
+//line mysql_sql.y:1892
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 207:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1837
+//line mysql_sql.y:1896
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1841
+//line mysql_sql.y:1900
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1845
+//line mysql_sql.y:1904
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 210:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1849
+//line mysql_sql.y:1908
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 211:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1853
+//line mysql_sql.y:1912
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRUNCATE
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 217:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1866
+//line mysql_sql.y:1925
 		{
 			yyLOCAL = &tree.SetTransaction{
 				Global:        false,
@@ -9874,10 +10867,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 218:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1873
+//line mysql_sql.y:1932
 		{
 			yyLOCAL = &tree.SetTransaction{
 				Global:        true,
@@ -9885,10 +10878,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 219:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1880
+//line mysql_sql.y:1939
 		{
 			yyLOCAL = &tree.SetTransaction{
 				Global:        false,
@@ -9896,26 +10889,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.TransactionCharacteristic
-//line mysql_sql.y:1890
+//line mysql_sql.y:1949
 		{
 			yyLOCAL = []*tree.TransactionCharacteristic{yyDollar[1].transactionCharacteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.TransactionCharacteristic
-//line mysql_sql.y:1894
+//line mysql_sql.y:1953
 		{
 			yyLOCAL = append(yyDollar[1].transactionCharacteristicListUnion(), yyDollar[3].transactionCharacteristicUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TransactionCharacteristic
-//line mysql_sql.y:1900
+//line mysql_sql.y:1959
 		{
 			yyLOCAL = &tree.TransactionCharacteristic{
 				IsLevel:   true,
@@ -9923,68 +10916,68 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TransactionCharacteristic
-//line mysql_sql.y:1907
+//line mysql_sql.y:1966
 		{
 			yyLOCAL = &tree.TransactionCharacteristic{
 				Access: yyDollar[1].accessModeUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IsolationLevelType
-//line mysql_sql.y:1915
+//line mysql_sql.y:1974
 		{
 			yyLOCAL = tree.ISOLATION_LEVEL_REPEATABLE_READ
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 225:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IsolationLevelType
-//line mysql_sql.y:1919
+//line mysql_sql.y:1978
 		{
 			yyLOCAL = tree.ISOLATION_LEVEL_READ_COMMITTED
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IsolationLevelType
-//line mysql_sql.y:1923
+//line mysql_sql.y:1982
 		{
 			yyLOCAL = tree.ISOLATION_LEVEL_READ_UNCOMMITTED
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IsolationLevelType
-//line mysql_sql.y:1927
+//line mysql_sql.y:1986
 		{
 			yyLOCAL = tree.ISOLATION_LEVEL_SERIALIZABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 225:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccessModeType
-//line mysql_sql.y:1933
+//line mysql_sql.y:1992
 		{
 			yyLOCAL = tree.ACCESS_MODE_READ_WRITE
 		}
 		yyVAL.union = yyLOCAL
-	case 226:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccessModeType
-//line mysql_sql.y:1937
+//line mysql_sql.y:1996
 		{
 			yyLOCAL = tree.ACCESS_MODE_READ_ONLY
 		}
 		yyVAL.union = yyLOCAL
-	case 227:
+	case 230:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1943
+//line mysql_sql.y:2002
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole: false,
@@ -9992,10 +10985,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 228:
+	case 231:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1950
+//line mysql_sql.y:2009
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -10003,10 +10996,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 232:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1957
+//line mysql_sql.y:2016
 		{
 			yyLOCAL = &tree.SetRole{
 				SecondaryRole:     true,
@@ -10014,90 +11007,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 233:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1966
+//line mysql_sql.y:2025
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1996
+//line mysql_sql.y:2055
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 232:
+	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:2000
+//line mysql_sql.y:2059
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 233:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:2004
+//line mysql_sql.y:2063
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 234:
+	case 237:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2010
+//line mysql_sql.y:2069
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 235:
+	case 238:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2016
+//line mysql_sql.y:2075
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 236:
+	case 239:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2020
+//line mysql_sql.y:2079
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 238:
+	case 241:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:2027
+//line mysql_sql.y:2086
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 239:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:2033
+//line mysql_sql.y:2092
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 240:
+	case 243:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:2037
+//line mysql_sql.y:2096
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 244:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2043
+//line mysql_sql.y:2102
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -10106,10 +11099,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 245:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2051
+//line mysql_sql.y:2110
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -10119,10 +11112,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 246:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2060
+//line mysql_sql.y:2119
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -10132,10 +11125,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 247:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2069
+//line mysql_sql.y:2128
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -10144,10 +11137,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 248:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2077
+//line mysql_sql.y:2136
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -10156,10 +11149,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 249:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2085
+//line mysql_sql.y:2144
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -10183,10 +11176,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2108
+//line mysql_sql.y:2167
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -10210,10 +11203,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 251:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2131
+//line mysql_sql.y:2190
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -10221,10 +11214,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 252:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2138
+//line mysql_sql.y:2197
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -10232,10 +11225,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 253:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2145
+//line mysql_sql.y:2204
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     strings.ToLower(yyDollar[1].str),
@@ -10244,10 +11237,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 254:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2153
+//line mysql_sql.y:2212
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -10255,10 +11248,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 255:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2160
+//line mysql_sql.y:2219
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -10266,10 +11259,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:2167
+//line mysql_sql.y:2226
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  strings.ToLower(yyDollar[1].str),
@@ -10277,212 +11270,212 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 257:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2176
+//line mysql_sql.y:2235
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2180
+//line mysql_sql.y:2239
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2184
+//line mysql_sql.y:2243
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2190
+//line mysql_sql.y:2249
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 258:
+	case 261:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2194
+//line mysql_sql.y:2253
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 259:
+	case 262:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2200
+//line mysql_sql.y:2259
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 260:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2204
+//line mysql_sql.y:2263
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare() + "." + yyDollar[3].cstrUnion().Compare()
 		}
-	case 261:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2210
+//line mysql_sql.y:2269
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 265:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2214
+//line mysql_sql.y:2273
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 269:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2225
+//line mysql_sql.y:2284
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 270:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2231
+//line mysql_sql.y:2290
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 271:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2236
+//line mysql_sql.y:2295
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2240
+//line mysql_sql.y:2299
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2244
+//line mysql_sql.y:2303
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 274:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2248
+//line mysql_sql.y:2307
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 275:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2252
+//line mysql_sql.y:2311
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 273:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2256
+//line mysql_sql.y:2315
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 274:
+	case 277:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2260
+//line mysql_sql.y:2319
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2264
+//line mysql_sql.y:2323
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 276:
+	case 279:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:2268
+//line mysql_sql.y:2327
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2274
+//line mysql_sql.y:2333
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2278
+//line mysql_sql.y:2337
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2282
+//line mysql_sql.y:2341
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2286
+//line mysql_sql.y:2345
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 284:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2291
+//line mysql_sql.y:2350
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 285:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2296
+//line mysql_sql.y:2355
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 286:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2302
+//line mysql_sql.y:2361
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -10490,20 +11483,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2309
+//line mysql_sql.y:2368
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2315
+//line mysql_sql.y:2374
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole: false,
@@ -10511,10 +11504,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2322
+//line mysql_sql.y:2381
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -10522,10 +11515,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2329
+//line mysql_sql.y:2388
 		{
 			yyLOCAL = &tree.Use{
 				SecondaryRole:     true,
@@ -10533,19 +11526,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 292:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2339
+//line mysql_sql.y:2398
 		{
 			yyDollar[2].statementUnion().(*tree.Update).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 293:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2346
+//line mysql_sql.y:2405
 		{
 			// Single-table syntax
 			yyLOCAL = &tree.Update{
@@ -10557,10 +11550,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 294:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2357
+//line mysql_sql.y:2416
 		{
 			// Multiple-table syntax
 			yyLOCAL = &tree.Update{
@@ -10570,210 +11563,210 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:2368
+//line mysql_sql.y:2427
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:2372
+//line mysql_sql.y:2431
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:2378
+//line mysql_sql.y:2437
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2388
+//line mysql_sql.y:2447
 		{
 			yyLOCAL = &tree.LockTableStmt{TableLocks: yyDollar[3].tableLocksUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:2394
+//line mysql_sql.y:2453
 		{
 			yyLOCAL = []tree.TableLock{yyDollar[1].tableLockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableLock
-//line mysql_sql.y:2398
+//line mysql_sql.y:2457
 		{
 			yyLOCAL = append(yyDollar[1].tableLocksUnion(), yyDollar[3].tableLockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 303:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLock
-//line mysql_sql.y:2404
+//line mysql_sql.y:2463
 		{
 			yyLOCAL = tree.TableLock{Table: *yyDollar[1].tableNameUnion(), LockType: yyDollar[2].tableLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2410
+//line mysql_sql.y:2469
 		{
 			yyLOCAL = tree.TableLockRead
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 305:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2414
+//line mysql_sql.y:2473
 		{
 			yyLOCAL = tree.TableLockReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2418
+//line mysql_sql.y:2477
 		{
 			yyLOCAL = tree.TableLockWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableLockType
-//line mysql_sql.y:2422
+//line mysql_sql.y:2481
 		{
 			yyLOCAL = tree.TableLockLowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2428
+//line mysql_sql.y:2487
 		{
 			yyLOCAL = &tree.UnLockTableStmt{}
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2440
+//line mysql_sql.y:2499
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 316:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2446
+//line mysql_sql.y:2505
 		{
 			yyLOCAL = tree.NewPrepareStmt(tree.Identifier(yyDollar[2].str), yyDollar[4].statementUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 317:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2450
+//line mysql_sql.y:2509
 		{
 			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2456
+//line mysql_sql.y:2515
 		{
 			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 319:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2460
+//line mysql_sql.y:2519
 		{
 			yyLOCAL = tree.NewExecuteWithVariables(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2466
+//line mysql_sql.y:2525
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 321:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2472
+//line mysql_sql.y:2531
 		{
 			yyLOCAL = tree.NewReset(tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 327:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2483
+//line mysql_sql.y:2542
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2489
+//line mysql_sql.y:2548
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 329:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2493
+//line mysql_sql.y:2552
 		{
 			yyLOCAL = &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 330:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2497
+//line mysql_sql.y:2556
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 331:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2501
+//line mysql_sql.y:2560
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 332:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2505
+//line mysql_sql.y:2564
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2509
+//line mysql_sql.y:2568
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -10782,10 +11775,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2517
+//line mysql_sql.y:2576
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -10794,10 +11787,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 335:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2525
+//line mysql_sql.y:2584
 		{
 			explainStmt := tree.NewExplainAnalyze(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -10808,10 +11801,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 336:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2535
+//line mysql_sql.y:2594
 		{
 			if tree.IsContainAnalyze(yyDollar[3].epxlainOptionsUnion()) {
 				explainStmt := tree.NewExplainAnalyze(yyDollar[5].statementUnion(), "text")
@@ -10824,66 +11817,66 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2576
+//line mysql_sql.y:2636
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:2580
+//line mysql_sql.y:2640
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:2586
+//line mysql_sql.y:2646
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2592
+//line mysql_sql.y:2652
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 350:
+	case 354:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2597
+//line mysql_sql.y:2657
 		{
 			yyVAL.str = "true"
 		}
-	case 351:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2598
+//line mysql_sql.y:2658
 		{
 			yyVAL.str = "false"
 		}
-	case 352:
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2599
+//line mysql_sql.y:2659
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 353:
+	case 357:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2604
+//line mysql_sql.y:2664
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 366:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2620
+//line mysql_sql.y:2681
 		{
 			yyLOCAL = &tree.AlterView{
 				Name:     yyDollar[4].tableNameUnion(),
@@ -10893,10 +11886,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 367:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2631
+//line mysql_sql.y:2692
 		{
 			yyLOCAL = &tree.AlterTable{
 				Table:   yyDollar[3].tableNameUnion(),
@@ -10904,37 +11897,140 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2640
+	case 368:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:2699
 		{
-			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
+			yyLOCAL = &tree.AlterTable{
+				Table:   yyDollar[3].tableNameUnion(),
+				Options: []tree.AlterTableOption{yyDollar[4].alterTableOptionUnion()},
+			}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL tree.AlterTableOptions
-//line mysql_sql.y:2644
+	case 369:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2708
 		{
-			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+			yyLOCAL = &tree.AlterPartitionOption{
+				Typ:        tree.AlterPartitionAdd,
+				Partitions: yyDollar[4].partitionsUnion(),
+			}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
-		yyDollar = yyS[yypt-2 : yypt+1]
+	case 370:
+		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2650
+//line mysql_sql.y:2715
 		{
-			opt := &tree.AlterOptionAdd{
-				Def: yyDollar[2].tableDefUnion(),
+			yyLOCAL = &tree.AlterPartitionOption{
+				Typ:            tree.AlterPartitionDrop,
+				PartitionNames: yyDollar[3].identifierListUnion(),
 			}
-			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 371:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2722
+		{
+			yyLOCAL = &tree.AlterPartitionOption{
+				Typ:            tree.AlterPartitionTruncate,
+				PartitionNames: yyDollar[3].identifierListUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 372:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2729
+		{
+			yyLOCAL = &tree.AlterPartitionOption{
+				Typ: tree.AlterPartitionTruncate,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 373:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2735
+		{
+			yyLOCAL = &tree.AlterPartitionOption{
+				Typ:            tree.AlterPartitionReorganize,
+				PartitionNames: yyDollar[3].identifierListUnion(),
+				Partitions:     yyDollar[6].partitionsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 374:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2743
+		{
+			yyLOCAL = &tree.AlterPartitionOption{
+				Typ:            tree.AlterPartitionExchange,
+				PartitionNames: tree.IdentifierList{tree.Identifier(yyDollar[3].cstrUnion().Compare())},
+				Table:          yyDollar[6].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 375:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2751
+		{
+			yyLOCAL = &tree.AlterPartitionOption{
+				Typ:            tree.AlterPartitionExchange,
+				PartitionNames: tree.IdentifierList{tree.Identifier(yyDollar[3].cstrUnion().Compare())},
+				Table:          yyDollar[6].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 376:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2759
+		{
+			yyLOCAL = &tree.AlterPartitionOption{
+				Typ:               tree.AlterPartitionExchange,
+				PartitionNames:    tree.IdentifierList{tree.Identifier(yyDollar[3].cstrUnion().Compare())},
+				Table:             yyDollar[6].tableNameUnion(),
+				WithoutValidation: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 377:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:2770
+		{
+			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 378:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOptions
+//line mysql_sql.y:2774
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 379:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:2780
+		{
+			opt := &tree.AlterOptionAdd{
+				Def: yyDollar[2].tableDefUnion(),
+			}
+			yyLOCAL = tree.AlterTableOption(opt)
+		}
+		yyVAL.union = yyLOCAL
+	case 380:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2657
+//line mysql_sql.y:2787
 		{
 			opt := &tree.AlterTableModifyColumnClause{
 				Typ:       tree.AlterTableModifyColumn,
@@ -10944,10 +12040,10 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 381:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2666
+//line mysql_sql.y:2796
 		{
 			opt := &tree.AlterTableChangeColumnClause{
 				Typ:           tree.AlterTableChangeColumn,
@@ -10958,10 +12054,10 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 382:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2676
+//line mysql_sql.y:2806
 		{
 			opt := &tree.AlterTableRenameColumnClause{
 				Typ:           tree.AlterTableRenameColumn,
@@ -10971,10 +12067,10 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 383:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2685
+//line mysql_sql.y:2815
 		{
 			opt := &tree.AlterTableAlterColumnClause{
 				Typ:         tree.AlterTableAlterColumn,
@@ -10985,10 +12081,10 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 384:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2695
+//line mysql_sql.y:2825
 		{
 			opt := &tree.AlterTableAlterColumnClause{
 				Typ:        tree.AlterTableAlterColumn,
@@ -10999,10 +12095,10 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 385:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2705
+//line mysql_sql.y:2835
 		{
 			opt := &tree.AlterTableAlterColumnClause{
 				Typ:        tree.AlterTableAlterColumn,
@@ -11012,10 +12108,10 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2714
+//line mysql_sql.y:2844
 		{
 			opt := &tree.AlterTableOrderByColumnClause{
 				Typ:              tree.AlterTableOrderByColumn,
@@ -11024,42 +12120,42 @@ yydefault:
 			yyLOCAL = tree.AlterTableOption(opt)
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 387:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2722
+//line mysql_sql.y:2852
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 388:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2726
+//line mysql_sql.y:2856
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[2].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2730
+//line mysql_sql.y:2860
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[1].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2734
+//line mysql_sql.y:2864
 		{
 			yyLOCAL = tree.AlterTableOption(yyDollar[3].alterTableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 391:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2738
+//line mysql_sql.y:2868
 		{
 			yyLOCAL = tree.AlterTableOption(
 				&tree.AlterAddCol{
@@ -11069,122 +12165,122 @@ yydefault:
 			)
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2747
+//line mysql_sql.y:2877
 		{
 			yyLOCAL = &tree.AlterOptionAlterCheck{
 				Type: yyDollar[1].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 393:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2753
+//line mysql_sql.y:2883
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 394:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2757
+//line mysql_sql.y:2887
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 395:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2761
+//line mysql_sql.y:2891
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2765
+//line mysql_sql.y:2895
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2769
+//line mysql_sql.y:2899
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2773
+//line mysql_sql.y:2903
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2777
+//line mysql_sql.y:2907
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2781
+//line mysql_sql.y:2911
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 401:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2786
+//line mysql_sql.y:2916
 		{
 			yyVAL.str = ""
 		}
-	case 404:
+	case 418:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2817
+//line mysql_sql.y:2947
 		{
 			yyVAL.str = ""
 		}
-	case 405:
+	case 419:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2821
+//line mysql_sql.y:2951
 		{
 			yyVAL.str = string("COLUMN")
 		}
-	case 406:
+	case 420:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ColumnPosition
-//line mysql_sql.y:2826
+//line mysql_sql.y:2956
 		{
 			yyLOCAL = &tree.ColumnPosition{
 				Typ: tree.ColumnPositionNone,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ColumnPosition
-//line mysql_sql.y:2832
+//line mysql_sql.y:2962
 		{
 			yyLOCAL = &tree.ColumnPosition{
 				Typ: tree.ColumnPositionFirst,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 422:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ColumnPosition
-//line mysql_sql.y:2838
+//line mysql_sql.y:2968
 		{
 			yyLOCAL = &tree.ColumnPosition{
 				Typ:            tree.ColumnPositionAfter,
@@ -11192,44 +12288,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 423:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.AlterColumnOrder
-//line mysql_sql.y:2847
+//line mysql_sql.y:2977
 		{
 			yyLOCAL = []*tree.AlterColumnOrder{yyDollar[1].alterColumnOrderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.AlterColumnOrder
-//line mysql_sql.y:2851
+//line mysql_sql.y:2981
 		{
 			yyLOCAL = append(yyDollar[1].alterColumnOrderByUnion(), yyDollar[3].alterColumnOrderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AlterColumnOrder
-//line mysql_sql.y:2857
+//line mysql_sql.y:2987
 		{
 			yyLOCAL = &tree.AlterColumnOrder{Column: yyDollar[1].unresolvedNameUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2864
+//line mysql_sql.y:2994
 		{
 			yyLOCAL = &tree.AlterTableName{
 				Name: yyDollar[1].unresolvedObjectNameUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 427:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2872
+//line mysql_sql.y:3002
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropIndex,
@@ -11237,10 +12333,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2879
+//line mysql_sql.y:3009
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropKey,
@@ -11248,10 +12344,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 429:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2886
+//line mysql_sql.y:3016
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropColumn,
@@ -11259,10 +12355,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2893
+//line mysql_sql.y:3023
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropColumn,
@@ -11270,10 +12366,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2900
+//line mysql_sql.y:3030
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ:  tree.AlterTableDropForeignKey,
@@ -11281,20 +12377,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2907
+//line mysql_sql.y:3037
 		{
 			yyLOCAL = &tree.AlterOptionDrop{
 				Typ: tree.AlterTableDropPrimaryKey,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 433:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:3043
+		{
+			yyLOCAL = &tree.AlterOptionDrop{
+				Typ:  tree.AlterTableDropCheck,
+				Name: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 434:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:3050
+		{
+			yyLOCAL = &tree.AlterOptionDrop{
+				Typ:  tree.AlterTableDropConstraint,
+				Name: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 435:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2915
+//line mysql_sql.y:3059
 		{
 			yyLOCAL = &tree.AlterOptionAlterIndex{
 				Visibility: yyDollar[3].indexVisibilityUnion(),
@@ -11302,10 +12420,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2922
+//line mysql_sql.y:3066
 		{
 			yyLOCAL = &tree.AlterOptionAlterCheck{
 				Type:    yyDollar[1].str,
@@ -11313,10 +12431,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AlterTableOption
-//line mysql_sql.y:2929
+//line mysql_sql.y:3073
 		{
 			yyLOCAL = &tree.AlterOptionAlterCheck{
 				Type:    yyDollar[1].str,
@@ -11324,26 +12442,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.VisibleType
-//line mysql_sql.y:2938
+//line mysql_sql.y:3082
 		{
 			yyLOCAL = tree.VISIBLE_TYPE_VISIBLE
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 439:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.VisibleType
-//line mysql_sql.y:2942
+//line mysql_sql.y:3086
 		{
 			yyLOCAL = tree.VISIBLE_TYPE_INVISIBLE
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 440:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2949
+//line mysql_sql.y:3093
 		{
 			yyLOCAL = &tree.AlterAccount{
 				IfExists:     yyDollar[3].boolValUnion(),
@@ -11354,10 +12472,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 441:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2961
+//line mysql_sql.y:3105
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				DbName:         yyDollar[3].str,
@@ -11366,10 +12484,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 442:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2969
+//line mysql_sql.y:3113
 		{
 			yyLOCAL = &tree.AlterDataBaseConfig{
 				AccountName:    yyDollar[4].str,
@@ -11378,10 +12496,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 443:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2977
+//line mysql_sql.y:3121
 		{
 			assignments := []*tree.VarAssignmentExpr{
 				&tree.VarAssignmentExpr{
@@ -11394,20 +12512,20 @@ yydefault:
 			yyLOCAL = &tree.SetVar{Assignments: assignments}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 444:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2990
+//line mysql_sql.y:3134
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AlterAccountAuthOption
-//line mysql_sql.y:2996
+//line mysql_sql.y:3140
 		{
 			yyLOCAL = tree.AlterAccountAuthOption{
 				Exist:          true,
@@ -11417,10 +12535,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 446:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3007
+//line mysql_sql.y:3151
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:           yyDollar[3].boolValUnion(),
@@ -11431,232 +12549,232 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 447:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3018
+//line mysql_sql.y:3162
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 448:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3022
+//line mysql_sql.y:3166
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 449:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3027
+//line mysql_sql.y:3171
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3031
+//line mysql_sql.y:3175
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 451:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3036
+//line mysql_sql.y:3180
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3040
+//line mysql_sql.y:3184
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3056
+//line mysql_sql.y:3200
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3060
+//line mysql_sql.y:3204
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3064
+//line mysql_sql.y:3208
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 456:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3068
+//line mysql_sql.y:3212
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3072
+//line mysql_sql.y:3216
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3076
+//line mysql_sql.y:3220
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 459:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3080
+//line mysql_sql.y:3224
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 460:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3084
+//line mysql_sql.y:3228
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordHistoryCount{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 461:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3088
+//line mysql_sql.y:3232
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 462:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3092
+//line mysql_sql.y:3236
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordReuseIntervalCount{Value: yyDollar[4].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3096
+//line mysql_sql.y:3240
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 464:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3100
+//line mysql_sql.y:3244
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 465:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3104
+//line mysql_sql.y:3248
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordRequireCurrentOptional{}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 466:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3108
+//line mysql_sql.y:3252
 		{
 			yyLOCAL = &tree.UserMiscOptionFailedLoginAttempts{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 467:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3112
+//line mysql_sql.y:3256
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeCount{Value: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 468:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:3116
+//line mysql_sql.y:3260
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordLockTimeUnbounded{}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:3122
+//line mysql_sql.y:3266
 		{
 			yyVAL.item = nil
 		}
-	case 454:
+	case 470:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3127
+//line mysql_sql.y:3271
 		{
 			yyVAL.item = nil
 		}
-	case 485:
+	case 501:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3167
+//line mysql_sql.y:3311
 		{
 			yyLOCAL = &tree.ShowCollation{}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3173
+//line mysql_sql.y:3317
 		{
 			yyLOCAL = &tree.ShowStages{
 				Like: yyDollar[3].comparisionExprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3181
+//line mysql_sql.y:3325
 		{
 			yyLOCAL = &tree.ShowGrants{ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 488:
+	case 504:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3185
+//line mysql_sql.y:3329
 		{
 			yyLOCAL = &tree.ShowGrants{Username: yyDollar[4].usernameRecordUnion().Username, Hostname: yyDollar[4].usernameRecordUnion().Hostname, Roles: yyDollar[5].rolesUnion(), ShowGrantType: tree.GrantForUser}
 		}
 		yyVAL.union = yyLOCAL
-	case 489:
+	case 505:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3189
+//line mysql_sql.y:3333
 		{
 			s := &tree.ShowGrants{}
 			roles := []*tree.Role{tree.NewRole(yyDollar[5].cstrUnion().Compare())}
@@ -11665,44 +12783,44 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 506:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3198
+//line mysql_sql.y:3342
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 507:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3202
+//line mysql_sql.y:3346
 		{
 			yyLOCAL = yyDollar[2].rolesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 508:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3208
+//line mysql_sql.y:3352
 		{
 			yyLOCAL = &tree.ShowTableStatus{DbName: yyDollar[5].str, Like: yyDollar[6].comparisionExprUnion(), Where: yyDollar[7].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 509:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3213
+//line mysql_sql.y:3357
 		{
 		}
-	case 495:
+	case 511:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3217
+//line mysql_sql.y:3361
 		{
 		}
-	case 497:
+	case 513:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3222
+//line mysql_sql.y:3366
 		{
 			yyLOCAL = &tree.ShowFunctionOrProcedureStatus{
 				Like:       yyDollar[4].comparisionExprUnion(),
@@ -11711,10 +12829,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 514:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3232
+//line mysql_sql.y:3376
 		{
 			yyLOCAL = &tree.ShowFunctionOrProcedureStatus{
 				Like:       yyDollar[4].comparisionExprUnion(),
@@ -11723,68 +12841,68 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3242
+//line mysql_sql.y:3386
 		{
 			yyLOCAL = &tree.ShowRolesStmt{
 				Like: yyDollar[3].comparisionExprUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3250
+//line mysql_sql.y:3394
 		{
 			yyLOCAL = &tree.ShowNodeList{}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3256
+//line mysql_sql.y:3400
 		{
 			yyLOCAL = &tree.ShowLocks{}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 518:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3262
+//line mysql_sql.y:3406
 		{
 			yyLOCAL = &tree.ShowTableNumber{DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 519:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3268
+//line mysql_sql.y:3412
 		{
 			yyLOCAL = &tree.ShowColumnNumber{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 520:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3274
+//line mysql_sql.y:3418
 		{
 			yyLOCAL = &tree.ShowTableValues{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 521:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3280
+//line mysql_sql.y:3424
 		{
 			yyLOCAL = &tree.ShowTableSize{Table: yyDollar[3].unresolvedObjectNameUnion(), DbName: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 522:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3286
+//line mysql_sql.y:3430
 		{
 			s := yyDollar[2].statementUnion().(*tree.ShowTarget)
 			s.Like = yyDollar[3].comparisionExprUnion()
@@ -11792,74 +12910,74 @@ yydefault:
 			yyLOCAL = s
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3295
+//line mysql_sql.y:3439
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowConfig}
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3299
+//line mysql_sql.y:3443
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3303
+//line mysql_sql.y:3447
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowEngines}
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3307
+//line mysql_sql.y:3451
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowTriggers}
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 527:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3311
+//line mysql_sql.y:3455
 		{
 			yyLOCAL = &tree.ShowTarget{DbName: yyDollar[3].str, Type: tree.ShowEvents}
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3315
+//line mysql_sql.y:3459
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPlugins}
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3319
+//line mysql_sql.y:3463
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowPrivileges}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 530:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3323
+//line mysql_sql.y:3467
 		{
 			yyLOCAL = &tree.ShowTarget{Type: tree.ShowProfiles}
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 531:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3329
+//line mysql_sql.y:3473
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[5].tableNameUnion(),
@@ -11867,10 +12985,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 532:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3336
+//line mysql_sql.y:3480
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[7].cstrUnion().Compare()), ExplicitSchema: true}
 			tbl := tree.NewTableName(tree.Identifier(yyDollar[5].cstrUnion().Compare()), prefix)
@@ -11880,20 +12998,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 533:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3346
+//line mysql_sql.y:3490
 		{
 		}
-	case 518:
+	case 534:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3348
+//line mysql_sql.y:3492
 		{
 		}
-	case 522:
+	case 538:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3357
+//line mysql_sql.y:3501
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -11902,10 +13020,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 539:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3367
+//line mysql_sql.y:3511
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -11914,58 +13032,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 540:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3376
+//line mysql_sql.y:3520
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 541:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3380
+//line mysql_sql.y:3524
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 542:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3384
+//line mysql_sql.y:3528
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3390
+//line mysql_sql.y:3534
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3396
+//line mysql_sql.y:3540
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 545:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3402
+//line mysql_sql.y:3546
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 546:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3408
+//line mysql_sql.y:3552
 		{
 			yyLOCAL = &tree.ShowSequences{
 				DBName: yyDollar[3].str,
@@ -11973,10 +13091,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 547:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3417
+//line mysql_sql.y:3561
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -11987,10 +13105,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 548:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3427
+//line mysql_sql.y:3571
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -12001,26 +13119,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 549:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3439
+//line mysql_sql.y:3583
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 550:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3443
+//line mysql_sql.y:3587
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 551:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3449
+//line mysql_sql.y:3593
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -12033,10 +13151,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 552:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3461
+//line mysql_sql.y:3605
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -12049,196 +13167,196 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3475
+//line mysql_sql.y:3619
 		{
 			yyLOCAL = &tree.ShowAccounts{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 554:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3481
+//line mysql_sql.y:3625
 		{
 			yyLOCAL = &tree.ShowPublications{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 555:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3488
+//line mysql_sql.y:3632
 		{
 			yyLOCAL = &tree.ShowSubscriptions{Like: yyDollar[3].comparisionExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 556:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3493
+//line mysql_sql.y:3637
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 557:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3497
+//line mysql_sql.y:3641
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:3501
+//line mysql_sql.y:3645
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.ILIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 559:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3506
+//line mysql_sql.y:3650
 		{
 			yyVAL.str = ""
 		}
-	case 544:
+	case 560:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3510
+//line mysql_sql.y:3654
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 545:
+	case 561:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3516
+//line mysql_sql.y:3660
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 566:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3529
+//line mysql_sql.y:3673
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3533
+//line mysql_sql.y:3677
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 568:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3539
+//line mysql_sql.y:3683
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 569:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3544
+//line mysql_sql.y:3688
 		{
 			yyLOCAL = &tree.ShowCreateView{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 570:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3548
+//line mysql_sql.y:3692
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 571:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3552
+//line mysql_sql.y:3696
 		{
 			yyLOCAL = &tree.ShowCreatePublications{Name: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 556:
+	case 572:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3558
+//line mysql_sql.y:3702
 		{
 			yyLOCAL = &tree.ShowBackendServers{}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3564
+//line mysql_sql.y:3708
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 574:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3568
+//line mysql_sql.y:3712
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 575:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3574
+//line mysql_sql.y:3718
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 560:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3580
+//line mysql_sql.y:3724
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3584
+//line mysql_sql.y:3728
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 578:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:3588
+//line mysql_sql.y:3732
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare()})
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3594
+//line mysql_sql.y:3738
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[2].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3598
+//line mysql_sql.y:3742
 		{
 			yyLOCAL = tree.NewTruncateTable(yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 598:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3622
+//line mysql_sql.y:3769
 		{
 			yyLOCAL = &tree.DropSequence{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -12246,10 +13364,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 599:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3631
+//line mysql_sql.y:3778
 		{
 			yyLOCAL = &tree.DropAccount{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -12257,10 +13375,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 600:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3640
+//line mysql_sql.y:3787
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -12268,26 +13386,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 601:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3649
+//line mysql_sql.y:3796
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 602:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:3653
+//line mysql_sql.y:3800
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 603:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:3659
+//line mysql_sql.y:3806
 		{
 			yyLOCAL = &tree.User{
 				Username: yyDollar[1].usernameRecordUnion().Username,
@@ -12295,10 +13413,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 604:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3668
+//line mysql_sql.y:3815
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -12306,10 +13424,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 605:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3677
+//line mysql_sql.y:3824
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].cstrUnion().Compare()),
@@ -12318,66 +13436,66 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 606:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3687
+//line mysql_sql.y:3834
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[4].boolValUnion(), Names: yyDollar[5].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 607:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3691
+//line mysql_sql.y:3838
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 608:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3697
+//line mysql_sql.y:3844
 		{
 			yyLOCAL = &tree.DropConnector{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 609:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3703
+//line mysql_sql.y:3850
 		{
 			yyLOCAL = &tree.DropView{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 610:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3709
+//line mysql_sql.y:3856
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].cstrUnion().Compare()), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 611:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3713
+//line mysql_sql.y:3860
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].cstrUnion().Compare()), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3719
+//line mysql_sql.y:3866
 		{
 			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 613:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3725
+//line mysql_sql.y:3872
 		{
 			yyLOCAL = &tree.DropFunction{
 				Name: yyDollar[3].functionNameUnion(),
@@ -12385,10 +13503,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3734
+//line mysql_sql.y:3881
 		{
 			yyLOCAL = &tree.DropProcedure{
 				Name:     yyDollar[3].procNameUnion(),
@@ -12396,10 +13514,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 615:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3741
+//line mysql_sql.y:3888
 		{
 			yyLOCAL = &tree.DropProcedure{
 				Name:     yyDollar[5].procNameUnion(),
@@ -12407,28 +13525,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 618:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3752
+//line mysql_sql.y:3899
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 619:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3757
+//line mysql_sql.y:3904
 		{
 			yyDollar[2].statementUnion().(*tree.Delete).With = yyDollar[1].withClauseUnion()
 			yyLOCAL = yyDollar[2].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 601:
+	case 620:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3764
+//line mysql_sql.y:3911
 		{
 			// Single-Table Syntax
 			t := &tree.AliasedTableExpr{
@@ -12445,10 +13563,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 621:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3780
+//line mysql_sql.y:3927
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -12458,10 +13576,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 622:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3793
+//line mysql_sql.y:3940
 		{
 			// Multiple-Table Syntax
 			yyLOCAL = &tree.Delete{
@@ -12471,69 +13589,69 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3804
+//line mysql_sql.y:3951
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:3808
+//line mysql_sql.y:3955
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 625:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3814
+//line mysql_sql.y:3961
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 626:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3819
+//line mysql_sql.y:3966
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 627:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3826
+//line mysql_sql.y:3973
 		{
 		}
-	case 609:
+	case 628:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3828
+//line mysql_sql.y:3975
 		{
 		}
-	case 610:
+	case 629:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3831
+//line mysql_sql.y:3978
 		{
 		}
-	case 615:
+	case 634:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3840
+//line mysql_sql.y:3987
 		{
 		}
-	case 617:
+	case 636:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3844
+//line mysql_sql.y:3991
 		{
 		}
-	case 619:
+	case 638:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3849
+//line mysql_sql.y:3996
 		{
 			rep := yyDollar[4].replaceUnion()
 			rep.Table = yyDollar[2].tableExprUnion()
@@ -12541,10 +13659,10 @@ yydefault:
 			yyLOCAL = rep
 		}
 		yyVAL.union = yyLOCAL
-	case 620:
+	case 639:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3858
+//line mysql_sql.y:4005
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -12552,20 +13670,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 640:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3865
+//line mysql_sql.y:4012
 		{
 			yyLOCAL = &tree.Replace{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 641:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3871
+//line mysql_sql.y:4018
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -12574,10 +13692,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 642:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3879
+//line mysql_sql.y:4026
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Replace{
@@ -12585,10 +13703,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 643:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3886
+//line mysql_sql.y:4033
 		{
 			yyLOCAL = &tree.Replace{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -12596,10 +13714,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Replace
-//line mysql_sql.y:3893
+//line mysql_sql.y:4040
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of replace can not be empty")
@@ -12618,10 +13736,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 645:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3913
+//line mysql_sql.y:4060
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -12630,26 +13748,26 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3923
+//line mysql_sql.y:4070
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 647:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:3927
+//line mysql_sql.y:4074
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 648:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3933
+//line mysql_sql.y:4080
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -12657,20 +13775,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3940
+//line mysql_sql.y:4087
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: yyDollar[1].selectUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 650:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3946
+//line mysql_sql.y:4093
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -12679,10 +13797,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 651:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3954
+//line mysql_sql.y:4101
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -12690,10 +13808,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 652:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3961
+//line mysql_sql.y:4108
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -12701,10 +13819,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 653:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:3968
+//line mysql_sql.y:4115
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -12723,50 +13841,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 654:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3987
+//line mysql_sql.y:4134
 		{
 			yyLOCAL = []*tree.UpdateExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 655:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:3991
+//line mysql_sql.y:4138
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 656:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:3996
+//line mysql_sql.y:4143
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:4000
+//line mysql_sql.y:4147
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:4004
+//line mysql_sql.y:4151
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:4010
+//line mysql_sql.y:4157
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -12774,155 +13892,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4019
+//line mysql_sql.y:4166
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 642:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4023
+//line mysql_sql.y:4170
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 643:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4029
+//line mysql_sql.y:4176
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 644:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4033
+//line mysql_sql.y:4180
 		{
 			yyVAL.str = yyDollar[3].cstrUnion().Compare()
 		}
-	case 645:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4039
+//line mysql_sql.y:4186
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 665:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4043
+//line mysql_sql.y:4190
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 666:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4049
+//line mysql_sql.y:4196
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4054
+//line mysql_sql.y:4201
 		{
 		}
-	case 650:
+	case 669:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4058
+//line mysql_sql.y:4205
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 671:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4065
+//line mysql_sql.y:4212
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4069
+//line mysql_sql.y:4216
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4076
+//line mysql_sql.y:4223
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 675:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4081
+//line mysql_sql.y:4228
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 676:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4085
+//line mysql_sql.y:4232
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4091
+//line mysql_sql.y:4238
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4095
+//line mysql_sql.y:4242
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 679:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4101
+//line mysql_sql.y:4248
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4105
+//line mysql_sql.y:4252
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 681:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:4110
+//line mysql_sql.y:4257
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 682:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:4114
+//line mysql_sql.y:4261
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -12935,10 +14053,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 683:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:4127
+//line mysql_sql.y:4274
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -12946,10 +14064,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 684:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:4134
+//line mysql_sql.y:4281
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -12957,10 +14075,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 685:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:4141
+//line mysql_sql.y:4288
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -12979,10 +14097,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 686:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:4159
+//line mysql_sql.y:4306
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -13001,38 +14119,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 687:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:4178
+//line mysql_sql.y:4325
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:4184
+//line mysql_sql.y:4331
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 689:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4191
+//line mysql_sql.y:4338
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4195
+//line mysql_sql.y:4342
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -13045,115 +14163,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 691:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4208
+//line mysql_sql.y:4355
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 692:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4212
+//line mysql_sql.y:4359
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 693:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4217
+//line mysql_sql.y:4364
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 694:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4221
+//line mysql_sql.y:4368
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4228
+//line mysql_sql.y:4375
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 696:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:4233
+//line mysql_sql.y:4380
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:4240
+//line mysql_sql.y:4387
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 699:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:4246
+//line mysql_sql.y:4393
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion(), SelectLockInfo: yyDollar[5].selectLockInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 700:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:4250
+//line mysql_sql.y:4397
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 701:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:4254
+//line mysql_sql.y:4401
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 702:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:4258
+//line mysql_sql.y:4405
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), SelectLockInfo: yyDollar[6].selectLockInfoUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 703:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:4262
+//line mysql_sql.y:4409
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 704:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:4266
+//line mysql_sql.y:4413
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 705:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:4272
+//line mysql_sql.y:4419
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -13161,10 +14279,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:4279
+//line mysql_sql.y:4426
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -13172,26 +14290,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 707:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:4288
+//line mysql_sql.y:4435
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:4292
+//line mysql_sql.y:4439
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 709:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:4298
+//line mysql_sql.y:4445
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Cols: yyDollar[2].identifierListUnion()},
@@ -13199,196 +14317,196 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 710:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4306
+//line mysql_sql.y:4453
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 711:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4310
+//line mysql_sql.y:4457
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 712:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:4315
+//line mysql_sql.y:4462
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 713:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:4319
+//line mysql_sql.y:4466
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:4325
+//line mysql_sql.y:4472
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 715:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:4329
+//line mysql_sql.y:4476
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 716:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:4333
+//line mysql_sql.y:4480
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 717:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:4338
+//line mysql_sql.y:4485
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:4342
+//line mysql_sql.y:4489
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 719:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:4348
+//line mysql_sql.y:4495
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 720:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:4354
+//line mysql_sql.y:4501
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 721:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:4358
+//line mysql_sql.y:4505
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 722:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:4364
+//line mysql_sql.y:4511
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion(), NullsPosition: yyDollar[3].nullsPositionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 723:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:4369
+//line mysql_sql.y:4516
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:4373
+//line mysql_sql.y:4520
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 725:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:4377
+//line mysql_sql.y:4524
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 726:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:4382
+//line mysql_sql.y:4529
 		{
 			yyLOCAL = tree.DefaultNullsPosition
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:4386
+//line mysql_sql.y:4533
 		{
 			yyLOCAL = tree.NullsFirst
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.NullsPosition
-//line mysql_sql.y:4390
+//line mysql_sql.y:4537
 		{
 			yyLOCAL = tree.NullsLast
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 729:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.SelectLockInfo
-//line mysql_sql.y:4395
+//line mysql_sql.y:4542
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SelectLockInfo
-//line mysql_sql.y:4399
+//line mysql_sql.y:4546
 		{
 			yyLOCAL = &tree.SelectLockInfo{
 				LockType: tree.SelectLockForUpdate,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 731:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4407
+//line mysql_sql.y:4554
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 732:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4411
+//line mysql_sql.y:4558
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 733:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4415
+//line mysql_sql.y:4562
 		{
 			valuesStmt := yyDollar[2].statementUnion().(*tree.ValuesStatement)
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{
@@ -13401,18 +14519,18 @@ yydefault:
 			}}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 734:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4429
+//line mysql_sql.y:4576
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 735:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4433
+//line mysql_sql.y:4580
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -13423,10 +14541,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 736:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4443
+//line mysql_sql.y:4590
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -13437,10 +14555,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 737:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4453
+//line mysql_sql.y:4600
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -13451,10 +14569,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4463
+//line mysql_sql.y:4610
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -13465,10 +14583,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 739:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4475
+//line mysql_sql.y:4622
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -13477,10 +14595,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 740:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4483
+//line mysql_sql.y:4630
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -13489,10 +14607,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 741:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4491
+//line mysql_sql.y:4638
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -13501,10 +14619,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 742:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4500
+//line mysql_sql.y:4647
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -13513,10 +14631,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 743:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4508
+//line mysql_sql.y:4655
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -13525,10 +14643,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 744:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4516
+//line mysql_sql.y:4663
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.EXCEPT,
@@ -13537,10 +14655,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 745:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4524
+//line mysql_sql.y:4671
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -13549,10 +14667,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 746:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4532
+//line mysql_sql.y:4679
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -13561,10 +14679,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 747:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4540
+//line mysql_sql.y:4687
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.INTERSECT,
@@ -13573,10 +14691,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 748:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4548
+//line mysql_sql.y:4695
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -13585,10 +14703,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 749:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4556
+//line mysql_sql.y:4703
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -13597,10 +14715,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 750:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:4564
+//line mysql_sql.y:4711
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UT_MINUS,
@@ -13609,10 +14727,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 751:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4574
+//line mysql_sql.y:4721
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -13624,10 +14742,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 752:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:4585
+//line mysql_sql.y:4732
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: false,
@@ -13640,149 +14758,149 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 753:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4599
+//line mysql_sql.y:4746
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 735:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4603
+//line mysql_sql.y:4750
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 736:
+	case 755:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4607
+//line mysql_sql.y:4754
 		{
 			yyVAL.str = strings.ToLower(yyDollar[1].str)
 		}
-	case 737:
+	case 756:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4612
+//line mysql_sql.y:4759
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4616
+//line mysql_sql.y:4763
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4620
+//line mysql_sql.y:4767
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 761:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4629
+//line mysql_sql.y:4776
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4633
+//line mysql_sql.y:4780
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 763:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:4638
+//line mysql_sql.y:4785
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 764:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:4642
+//line mysql_sql.y:4789
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 765:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4647
+//line mysql_sql.y:4794
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 766:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:4651
+//line mysql_sql.y:4798
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 767:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:4657
+//line mysql_sql.y:4804
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 768:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:4661
+//line mysql_sql.y:4808
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4667
+//line mysql_sql.y:4814
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 770:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4671
+//line mysql_sql.y:4818
 		{
 			yyDollar[2].cstrUnion().SetConfig(0)
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].cstrUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 771:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4676
+//line mysql_sql.y:4823
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 772:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:4680
+//line mysql_sql.y:4827
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 773:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4685
+//line mysql_sql.y:4832
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier(""), prefix)
@@ -13791,28 +14909,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4693
+//line mysql_sql.y:4840
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 775:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:4699
+//line mysql_sql.y:4846
 		{
 			yyLOCAL = &tree.From{
 				Tables: tree.TableExprs{yyDollar[2].joinTableExprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4707
+//line mysql_sql.y:4854
 		{
 			if t, ok := yyDollar[1].tableExprUnion().(*tree.JoinTableExpr); ok {
 				yyLOCAL = t
@@ -13821,26 +14939,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 777:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4715
+//line mysql_sql.y:4862
 		{
 			yyLOCAL = &tree.JoinTableExpr{Left: yyDollar[1].joinTableExprUnion(), Right: yyDollar[3].tableExprUnion(), JoinType: tree.JOIN_TYPE_CROSS}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 780:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4725
+//line mysql_sql.y:4872
 		{
 			yyLOCAL = yyDollar[1].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 781:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4731
+//line mysql_sql.y:4878
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -13850,10 +14968,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 763:
+	case 782:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4740
+//line mysql_sql.y:4887
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -13863,10 +14981,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 764:
+	case 783:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4749
+//line mysql_sql.y:4896
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -13876,10 +14994,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 784:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.JoinTableExpr
-//line mysql_sql.y:4758
+//line mysql_sql.y:4905
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -13888,15 +15006,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4768
+//line mysql_sql.y:4915
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 767:
+	case 786:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4772
+//line mysql_sql.y:4919
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -13904,34 +15022,34 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 768:
+	case 787:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4782
+//line mysql_sql.y:4929
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 769:
+	case 788:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4786
+//line mysql_sql.y:4933
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 770:
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4790
+//line mysql_sql.y:4937
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 771:
+	case 790:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:4794
+//line mysql_sql.y:4941
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 772:
+	case 791:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:4800
+//line mysql_sql.y:4947
 		{
 			yyLOCAL = &tree.ValuesStatement{
 				Rows:    yyDollar[2].rowsExprsUnion(),
@@ -13940,130 +15058,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4810
+//line mysql_sql.y:4957
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 793:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:4814
+//line mysql_sql.y:4961
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 794:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4820
+//line mysql_sql.y:4967
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 795:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4826
+//line mysql_sql.y:4973
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4830
+//line mysql_sql.y:4977
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 797:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4836
+//line mysql_sql.y:4983
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 779:
+	case 798:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4842
+//line mysql_sql.y:4989
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 780:
+	case 799:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4846
+//line mysql_sql.y:4993
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 781:
+	case 800:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4850
+//line mysql_sql.y:4997
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 782:
+	case 801:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4856
+//line mysql_sql.y:5003
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 802:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4860
+//line mysql_sql.y:5007
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 803:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4866
+//line mysql_sql.y:5013
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 804:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:4870
+//line mysql_sql.y:5017
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 805:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4876
+//line mysql_sql.y:5023
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 806:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:4880
+//line mysql_sql.y:5027
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 807:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4886
+//line mysql_sql.y:5033
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 808:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4890
+//line mysql_sql.y:5037
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -14074,10 +15192,25 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 809:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:5047
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[2].parenTableExprUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[3].str),
+					Cols:  yyDollar[4].identifierListUnion(),
+				},
+				Lateral: true,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 810:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4900
+//line mysql_sql.y:5058
 		{
 			if yyDollar[2].str != "" {
 				yyLOCAL = &tree.AliasedTableExpr{
@@ -14091,26 +15224,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 811:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4913
+//line mysql_sql.y:5071
 		{
 			yyLOCAL = yyDollar[2].joinTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 812:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:4919
+//line mysql_sql.y:5077
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[1].selectStatementUnion().(*tree.ParenSelect).Select}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 813:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:4925
+//line mysql_sql.y:5083
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].cstrUnion().Compare()))
 			yyLOCAL = &tree.TableFunction{
@@ -14122,48 +15255,211 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:4938
+	case 814:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line mysql_sql.y:5094
 		{
-			yyLOCAL = &tree.AliasedTableExpr{
-				Expr: yyDollar[1].tableNameUnion(),
-				As: tree.AliasClause{
-					Alias: tree.Identifier(yyDollar[2].str),
+			name := tree.SetUnresolvedName("json_table")
+			yyLOCAL = &tree.TableFunction{
+				Func: &tree.FuncExpr{
+					Func:  tree.FuncName2ResolvableFunctionReference(name),
+					Exprs: tree.Exprs{yyDollar[3].exprUnion()},
+					Type:  tree.FUNC_TYPE_TABLE,
 				},
-				IndexHints: yyDollar[3].indexHintListUnion(),
+				JsonTable: yyDollar[5].jsonTableUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
-		yyDollar = yyS[yypt-0 : yypt+1]
-		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4949
+	case 815:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.JsonTable
+//line mysql_sql.y:5108
 		{
-			yyLOCAL = nil
+			yyLOCAL = &tree.JsonTable{
+				Path:    yyDollar[1].str,
+				Columns: yyDollar[4].jsonTableColumnsUnion(),
+			}
 		}
 		yyVAL.union = yyLOCAL
-	case 797:
+	case 816:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.JsonTableColumn
+//line mysql_sql.y:5117
+		{
+			yyLOCAL = []*tree.JsonTableColumn{yyDollar[1].jsonTableColumnUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 817:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.JsonTableColumn
+//line mysql_sql.y:5121
+		{
+			yyLOCAL = append(yyDollar[1].jsonTableColumnsUnion(), yyDollar[3].jsonTableColumnUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 818:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:5127
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Kind: tree.JsonTableColumnOrdinality,
+				Name: tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 819:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:5134
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Kind:    tree.JsonTableColumnPath,
+				Name:    tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+				Type:    yyDollar[2].columnTypeUnion(),
+				Path:    yyDollar[4].str,
+				OnEmpty: yyDollar[5].jsonTableOnResponsesUnion()[0],
+				OnError: yyDollar[5].jsonTableOnResponsesUnion()[1],
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 820:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:5145
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Kind: tree.JsonTableColumnExists,
+				Name: tree.Identifier(yyDollar[1].cstrUnion().Compare()),
+				Type: yyDollar[2].columnTypeUnion(),
+				Path: yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 821:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:5154
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Kind:   tree.JsonTableColumnNested,
+				Nested: yyDollar[3].jsonTableUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 822:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.JsonTableColumn
+//line mysql_sql.y:5161
+		{
+			yyLOCAL = &tree.JsonTableColumn{
+				Kind:   tree.JsonTableColumnNested,
+				Nested: yyDollar[2].jsonTableUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 823:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL [2]*tree.JsonTableOnResponse
+//line mysql_sql.y:5169
+		{
+			yyLOCAL = [2]*tree.JsonTableOnResponse{}
+		}
+		yyVAL.union = yyLOCAL
+	case 824:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL [2]*tree.JsonTableOnResponse
+//line mysql_sql.y:5173
+		{
+			yyLOCAL = [2]*tree.JsonTableOnResponse{yyDollar[1].jsonTableOnResponseUnion(), nil}
+		}
+		yyVAL.union = yyLOCAL
+	case 825:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL [2]*tree.JsonTableOnResponse
+//line mysql_sql.y:5177
+		{
+			yyLOCAL = [2]*tree.JsonTableOnResponse{nil, yyDollar[1].jsonTableOnResponseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 826:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL [2]*tree.JsonTableOnResponse
+//line mysql_sql.y:5181
+		{
+			yyLOCAL = [2]*tree.JsonTableOnResponse{yyDollar[1].jsonTableOnResponseUnion(), yyDollar[4].jsonTableOnResponseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 827:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.JsonTableOnResponse
+//line mysql_sql.y:5187
+		{
+			yyLOCAL = &tree.JsonTableOnResponse{Kind: tree.JsonTableOnResponseNull}
+		}
+		yyVAL.union = yyLOCAL
+	case 828:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.JsonTableOnResponse
+//line mysql_sql.y:5191
+		{
+			yyLOCAL = &tree.JsonTableOnResponse{Kind: tree.JsonTableOnResponseError}
+		}
+		yyVAL.union = yyLOCAL
+	case 829:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.JsonTableOnResponse
+//line mysql_sql.y:5195
+		{
+			yyLOCAL = &tree.JsonTableOnResponse{
+				Kind:  tree.JsonTableOnResponseDefault,
+				Value: yyDollar[2].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 830:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line mysql_sql.y:5204
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[2].str),
+				},
+				IndexHints: yyDollar[3].indexHintListUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 831:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []*tree.IndexHint
+//line mysql_sql.y:5215
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 833:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4956
+//line mysql_sql.y:5222
 		{
 			yyLOCAL = []*tree.IndexHint{yyDollar[1].indexHintUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 798:
+	case 834:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.IndexHint
-//line mysql_sql.y:4960
+//line mysql_sql.y:5226
 		{
 			yyLOCAL = append(yyDollar[1].indexHintListUnion(), yyDollar[2].indexHintUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 835:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.IndexHint
-//line mysql_sql.y:4966
+//line mysql_sql.y:5232
 		{
 			yyLOCAL = &tree.IndexHint{
 				IndexNames: yyDollar[4].strsUnion(),
@@ -14172,176 +15468,176 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 800:
+	case 836:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4976
+//line mysql_sql.y:5242
 		{
 			yyLOCAL = tree.HintUse
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 837:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4980
+//line mysql_sql.y:5246
 		{
 			yyLOCAL = tree.HintIgnore
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 838:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintType
-//line mysql_sql.y:4984
+//line mysql_sql.y:5250
 		{
 			yyLOCAL = tree.HintForce
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 839:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4989
+//line mysql_sql.y:5255
 		{
 			yyLOCAL = tree.HintForScan
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 840:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4993
+//line mysql_sql.y:5259
 		{
 			yyLOCAL = tree.HintForJoin
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 841:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:4997
+//line mysql_sql.y:5263
 		{
 			yyLOCAL = tree.HintForOrderBy
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 842:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IndexHintScope
-//line mysql_sql.y:5001
+//line mysql_sql.y:5267
 		{
 			yyLOCAL = tree.HintForGroupBy
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 843:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5006
+//line mysql_sql.y:5272
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 844:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5010
+//line mysql_sql.y:5276
 		{
 			yyLOCAL = []string{yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 845:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5014
+//line mysql_sql.y:5280
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5018
+//line mysql_sql.y:5284
 		{
 			yyLOCAL = []string{yyDollar[1].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 847:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5022
+//line mysql_sql.y:5288
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 848:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5027
+//line mysql_sql.y:5293
 		{
 			yyVAL.str = ""
 		}
-	case 813:
+	case 849:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5031
+//line mysql_sql.y:5297
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 814:
+	case 850:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5035
+//line mysql_sql.y:5301
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 815:
+	case 851:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5041
+//line mysql_sql.y:5307
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 817:
+	case 853:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5047
+//line mysql_sql.y:5313
 		{
 			yyLOCAL = tree.NewCStr("", yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5051
+//line mysql_sql.y:5317
 		{
 			yyLOCAL = yyDollar[1].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 855:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5055
+//line mysql_sql.y:5321
 		{
 			yyLOCAL = yyDollar[2].cstrUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 856:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5059
+//line mysql_sql.y:5325
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 857:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5063
+//line mysql_sql.y:5329
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[2].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 858:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5069
+//line mysql_sql.y:5335
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 839:
+	case 878:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5105
+//line mysql_sql.y:5374
 		{
 			yyLOCAL = &tree.CreateExtension{
 				Language: yyDollar[3].str,
@@ -14350,22 +15646,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 879:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5115
+//line mysql_sql.y:5384
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 841:
+	case 880:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5121
+//line mysql_sql.y:5390
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 842:
+	case 881:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5127
+//line mysql_sql.y:5396
 		{
 			yyLOCAL = &tree.CreateProcedure{
 				Name: yyDollar[3].procNameUnion(),
@@ -14374,100 +15670,100 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ProcedureName
-//line mysql_sql.y:5137
+//line mysql_sql.y:5406
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[1].cstrUnion().ToLower()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 883:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ProcedureName
-//line mysql_sql.y:5142
+//line mysql_sql.y:5411
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().ToLower()), ExplicitSchema: true}
 			yyLOCAL = tree.NewProcedureName(tree.Identifier(yyDollar[3].cstrUnion().ToLower()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 884:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:5148
+//line mysql_sql.y:5417
 		{
 			yyLOCAL = tree.ProcedureArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 847:
+	case 886:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:5155
+//line mysql_sql.y:5424
 		{
 			yyLOCAL = tree.ProcedureArgs{yyDollar[1].procArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 848:
+	case 887:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ProcedureArgs
-//line mysql_sql.y:5159
+//line mysql_sql.y:5428
 		{
 			yyLOCAL = append(yyDollar[1].procArgsUnion(), yyDollar[3].procArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 849:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ProcedureArg
-//line mysql_sql.y:5165
+//line mysql_sql.y:5434
 		{
 			yyLOCAL = tree.ProcedureArg(yyDollar[1].procArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 850:
+	case 889:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ProcedureArgDecl
-//line mysql_sql.y:5171
+//line mysql_sql.y:5440
 		{
 			yyLOCAL = tree.NewProcedureArgDecl(yyDollar[1].procArgTypeUnion(), yyDollar[2].unresolvedNameUnion(), yyDollar[3].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 851:
+	case 890:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:5176
+//line mysql_sql.y:5445
 		{
 			yyLOCAL = tree.TYPE_IN
 		}
 		yyVAL.union = yyLOCAL
-	case 852:
+	case 891:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:5180
+//line mysql_sql.y:5449
 		{
 			yyLOCAL = tree.TYPE_IN
 		}
 		yyVAL.union = yyLOCAL
-	case 853:
+	case 892:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:5184
+//line mysql_sql.y:5453
 		{
 			yyLOCAL = tree.TYPE_OUT
 		}
 		yyVAL.union = yyLOCAL
-	case 854:
+	case 893:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.InOutArgType
-//line mysql_sql.y:5188
+//line mysql_sql.y:5457
 		{
 			yyLOCAL = tree.TYPE_INOUT
 		}
 		yyVAL.union = yyLOCAL
-	case 855:
+	case 894:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5195
+//line mysql_sql.y:5464
 		{
 			yyLOCAL = &tree.CreateFunction{
 				Name:       yyDollar[3].functionNameUnion(),
@@ -14478,98 +15774,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 856:
+	case 895:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:5207
+//line mysql_sql.y:5476
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 857:
+	case 896:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FunctionName
-//line mysql_sql.y:5212
+//line mysql_sql.y:5481
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewFuncName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 858:
+	case 897:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:5218
+//line mysql_sql.y:5487
 		{
 			yyLOCAL = tree.FunctionArgs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 860:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:5225
+//line mysql_sql.y:5494
 		{
 			yyLOCAL = tree.FunctionArgs{yyDollar[1].funcArgUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 861:
+	case 900:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.FunctionArgs
-//line mysql_sql.y:5229
+//line mysql_sql.y:5498
 		{
 			yyLOCAL = append(yyDollar[1].funcArgsUnion(), yyDollar[3].funcArgUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 862:
+	case 901:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FunctionArg
-//line mysql_sql.y:5235
+//line mysql_sql.y:5504
 		{
 			yyLOCAL = tree.FunctionArg(yyDollar[1].funcArgDeclUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 863:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:5241
+//line mysql_sql.y:5510
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(nil, yyDollar[1].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 864:
+	case 903:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:5245
+//line mysql_sql.y:5514
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 904:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FunctionArgDecl
-//line mysql_sql.y:5249
+//line mysql_sql.y:5518
 		{
 			yyLOCAL = tree.NewFunctionArgDecl(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 905:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5255
+//line mysql_sql.y:5524
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 867:
+	case 906:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReturnType
-//line mysql_sql.y:5261
+//line mysql_sql.y:5530
 		{
 			yyLOCAL = tree.NewReturnType(yyDollar[1].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 907:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5267
+//line mysql_sql.y:5536
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[5].tableNameUnion(),
@@ -14579,10 +15875,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 908:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5276
+//line mysql_sql.y:5545
 		{
 			yyLOCAL = &tree.CreateView{
 				Name:        yyDollar[4].tableNameUnion(),
@@ -14592,10 +15888,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 909:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5287
+//line mysql_sql.y:5556
 		{
 			yyLOCAL = &tree.CreateAccount{
 				IfNotExists:  yyDollar[3].ifNotExistsUnion(),
@@ -14606,70 +15902,70 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 910:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5299
+//line mysql_sql.y:5568
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 872:
+	case 911:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5303
+//line mysql_sql.y:5572
 		{
 			yyVAL.str = yyVAL.str + yyDollar[2].str
 		}
-	case 873:
+	case 912:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5309
+//line mysql_sql.y:5578
 		{
 			yyVAL.str = "OR REPLACE"
 		}
-	case 874:
+	case 913:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:5313
+//line mysql_sql.y:5582
 		{
 			yyVAL.str = "ALGORITHM = " + yyDollar[3].str
 		}
-	case 875:
+	case 914:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:5317
+//line mysql_sql.y:5586
 		{
 			yyVAL.str = "DEFINER = "
 		}
-	case 876:
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:5321
+//line mysql_sql.y:5590
 		{
 			yyVAL.str = "SQL SECURITY " + yyDollar[3].str
 		}
-	case 877:
+	case 916:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5326
+//line mysql_sql.y:5595
 		{
 			yyVAL.str = ""
 		}
-	case 878:
+	case 917:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:5330
+//line mysql_sql.y:5599
 		{
 			yyVAL.str = "WITH " + yyDollar[2].str + " CHECK OPTION"
 		}
-	case 884:
+	case 923:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5344
+//line mysql_sql.y:5613
 		{
 			yyVAL.str = ""
 		}
-	case 887:
+	case 926:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5352
+//line mysql_sql.y:5621
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 888:
+	case 927:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountAuthOption
-//line mysql_sql.y:5358
+//line mysql_sql.y:5627
 		{
 			yyLOCAL = tree.AccountAuthOption{
 				Equal:          yyDollar[2].str,
@@ -14678,22 +15974,22 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 928:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5368
+//line mysql_sql.y:5637
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 890:
+	case 929:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5372
+//line mysql_sql.y:5641
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 891:
+	case 930:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:5378
+//line mysql_sql.y:5647
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -14701,20 +15997,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 931:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:5385
+//line mysql_sql.y:5654
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 932:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.AccountIdentified
-//line mysql_sql.y:5391
+//line mysql_sql.y:5660
 		{
 			yyLOCAL = tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -14722,20 +16018,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 894:
+	case 933:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:5399
+//line mysql_sql.y:5668
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:5405
+//line mysql_sql.y:5674
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -14743,10 +16039,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:5412
+//line mysql_sql.y:5681
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -14754,10 +16050,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 936:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.AccountStatus
-//line mysql_sql.y:5419
+//line mysql_sql.y:5688
 		{
 			yyLOCAL = tree.AccountStatus{
 				Exist:  true,
@@ -14765,20 +16061,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 937:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:5427
+//line mysql_sql.y:5696
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 938:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountComment
-//line mysql_sql.y:5433
+//line mysql_sql.y:5702
 		{
 			yyLOCAL = tree.AccountComment{
 				Exist:   true,
@@ -14786,10 +16082,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 939:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5442
+//line mysql_sql.y:5711
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -14800,10 +16096,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 940:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5454
+//line mysql_sql.y:5723
 		{
 			yyLOCAL = &tree.CreatePublication{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -14814,10 +16110,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 941:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5466
+//line mysql_sql.y:5735
 		{
 			yyLOCAL = &tree.CreateStage{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -14829,20 +16125,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 942:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StageStatus
-//line mysql_sql.y:5478
+//line mysql_sql.y:5747
 		{
 			yyLOCAL = tree.StageStatus{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 943:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.StageStatus
-//line mysql_sql.y:5484
+//line mysql_sql.y:5753
 		{
 			yyLOCAL = tree.StageStatus{
 				Exist:  true,
@@ -14850,10 +16146,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 944:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.StageStatus
-//line mysql_sql.y:5491
+//line mysql_sql.y:5760
 		{
 			yyLOCAL = tree.StageStatus{
 				Exist:  true,
@@ -14861,20 +16157,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 945:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StageComment
-//line mysql_sql.y:5499
+//line mysql_sql.y:5768
 		{
 			yyLOCAL = tree.StageComment{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 946:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.StageComment
-//line mysql_sql.y:5505
+//line mysql_sql.y:5774
 		{
 			yyLOCAL = tree.StageComment{
 				Exist:   true,
@@ -14882,20 +16178,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 947:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StageUrl
-//line mysql_sql.y:5513
+//line mysql_sql.y:5782
 		{
 			yyLOCAL = tree.StageUrl{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 948:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.StageUrl
-//line mysql_sql.y:5519
+//line mysql_sql.y:5788
 		{
 			yyLOCAL = tree.StageUrl{
 				Exist: true,
@@ -14903,20 +16199,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 949:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.StageCredentials
-//line mysql_sql.y:5527
+//line mysql_sql.y:5796
 		{
 			yyLOCAL = tree.StageCredentials{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 950:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.StageCredentials
-//line mysql_sql.y:5533
+//line mysql_sql.y:5802
 		{
 			yyLOCAL = tree.StageCredentials{
 				Exist:       true,
@@ -14924,61 +16220,61 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 951:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5542
+//line mysql_sql.y:5811
 		{
 			yyLOCAL = yyDollar[1].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 952:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5546
+//line mysql_sql.y:5815
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].strsUnion()...)
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 953:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5551
+//line mysql_sql.y:5820
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 954:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5555
+//line mysql_sql.y:5824
 		{
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 			yyLOCAL = append(yyLOCAL, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 955:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:5562
+//line mysql_sql.y:5831
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 917:
+	case 956:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5567
+//line mysql_sql.y:5836
 		{
 			yyVAL.str = ""
 		}
-	case 918:
+	case 957:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:5571
+//line mysql_sql.y:5840
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 919:
+	case 958:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5577
+//line mysql_sql.y:5846
 		{
 			yyLOCAL = &tree.AlterStage{
 				IfNotExists:       yyDollar[3].boolValUnion(),
@@ -14990,10 +16286,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 959:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5591
+//line mysql_sql.y:5860
 		{
 			yyLOCAL = &tree.AlterPublication{
 				IfExists:    yyDollar[3].boolValUnion(),
@@ -15003,58 +16299,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 960:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5601
+//line mysql_sql.y:5870
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 961:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5605
+//line mysql_sql.y:5874
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				All: true,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 962:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5611
+//line mysql_sql.y:5880
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				SetAccounts: yyDollar[2].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 963:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5617
+//line mysql_sql.y:5886
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				AddAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 964:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountsSetOption
-//line mysql_sql.y:5623
+//line mysql_sql.y:5892
 		{
 			yyLOCAL = &tree.AccountsSetOption{
 				DropAccounts: yyDollar[3].identifierListUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 965:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5632
+//line mysql_sql.y:5901
 		{
 			yyLOCAL = &tree.DropPublication{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -15062,10 +16358,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 927:
+	case 966:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5641
+//line mysql_sql.y:5910
 		{
 			yyLOCAL = &tree.DropStage{
 				IfNotExists: yyDollar[3].boolValUnion(),
@@ -15073,26 +16369,467 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 928:
+	case 967:
+		yyDollar = yyS[yypt-12 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5919
+		{
+			yyLOCAL = &tree.CreateEvent{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        yyDollar[4].eventNameUnion(),
+				Schedule:    yyDollar[7].eventScheduleUnion(),
+				Completion:  yyDollar[8].eventCompletionUnion(),
+				Status:      yyDollar[9].eventStatusUnion(),
+				Comment:     yyDollar[10].eventCommentUnion(),
+				Body:        yyDollar[12].statementUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 968:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5933
+		{
+			yyLOCAL = &tree.AlterEvent{
+				Name:     yyDollar[3].eventNameUnion(),
+				RenameTo: yyDollar[4].eventNameUnion(),
+				Status:   yyDollar[5].eventStatusUnion(),
+				Comment:  yyDollar[6].eventCommentUnion(),
+				Body:     yyDollar[7].statementUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 969:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5943
+		{
+			yyLOCAL = &tree.AlterEvent{
+				Name:       yyDollar[3].eventNameUnion(),
+				Completion: yyDollar[4].eventCompletionUnion(),
+				RenameTo:   yyDollar[5].eventNameUnion(),
+				Status:     yyDollar[6].eventStatusUnion(),
+				Comment:    yyDollar[7].eventCommentUnion(),
+				Body:       yyDollar[8].statementUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 970:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5954
+		{
+			yyLOCAL = &tree.AlterEvent{
+				Name:       yyDollar[3].eventNameUnion(),
+				Schedule:   yyDollar[6].eventScheduleUnion(),
+				Completion: yyDollar[7].eventCompletionUnion(),
+				RenameTo:   yyDollar[8].eventNameUnion(),
+				Status:     yyDollar[9].eventStatusUnion(),
+				Comment:    yyDollar[10].eventCommentUnion(),
+				Body:       yyDollar[11].statementUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 971:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5968
+		{
+			yyLOCAL = &tree.DropEvent{
+				IfExists: yyDollar[3].boolValUnion(),
+				Name:     yyDollar[4].eventNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 972:
+		yyDollar = yyS[yypt-13 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:5977
+		{
+			yyLOCAL = &tree.CreateTrigger{
+				IfNotExists: yyDollar[3].ifNotExistsUnion(),
+				Name:        yyDollar[4].triggerNameUnion(),
+				Time:        yyDollar[5].triggerTimeUnion(),
+				Event:       yyDollar[6].triggerEventUnion(),
+				Table:       yyDollar[8].tableNameUnion(),
+				Order:       yyDollar[12].triggerOrderUnion(),
+				Body:        yyDollar[13].statementUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 973:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TriggerTime
+//line mysql_sql.y:5991
+		{
+			yyLOCAL = tree.TriggerTimeBefore
+		}
+		yyVAL.union = yyLOCAL
+	case 974:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TriggerTime
+//line mysql_sql.y:5995
+		{
+			yyLOCAL = tree.TriggerTimeAfter
+		}
+		yyVAL.union = yyLOCAL
+	case 975:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TriggerEvent
+//line mysql_sql.y:6001
+		{
+			yyLOCAL = tree.TriggerEventInsert
+		}
+		yyVAL.union = yyLOCAL
+	case 976:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TriggerEvent
+//line mysql_sql.y:6005
+		{
+			yyLOCAL = tree.TriggerEventUpdate
+		}
+		yyVAL.union = yyLOCAL
+	case 977:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TriggerEvent
+//line mysql_sql.y:6009
+		{
+			yyLOCAL = tree.TriggerEventDelete
+		}
+		yyVAL.union = yyLOCAL
+	case 978:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.TriggerOrder
+//line mysql_sql.y:6014
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 979:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.TriggerOrder
+//line mysql_sql.y:6018
+		{
+			yyLOCAL = &tree.TriggerOrder{
+				Follows: true,
+				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 980:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.TriggerOrder
+//line mysql_sql.y:6025
+		{
+			yyLOCAL = &tree.TriggerOrder{
+				Name: tree.Identifier(yyDollar[2].cstrUnion().Compare()),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 985:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:6039
+		{
+			yyLOCAL = &tree.DropTrigger{
+				IfExists: yyDollar[3].boolValUnion(),
+				Name:     yyDollar[4].triggerNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 986:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:6048
+		{
+			yyLOCAL = &tree.CreateMaterializedView{
+				IfNotExists: yyDollar[4].ifNotExistsUnion(),
+				Name:        yyDollar[5].tableNameUnion(),
+				Refresh:     yyDollar[6].eventScheduleUnion(),
+				AsSource:    yyDollar[8].selectUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 987:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.EventSchedule
+//line mysql_sql.y:6058
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 988:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.EventSchedule
+//line mysql_sql.y:6062
+		{
+			yyLOCAL = &tree.EventSchedule{
+				Every: yyDollar[3].exprUnion(),
+				Unit:  yyDollar[4].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 989:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:6071
+		{
+			yyLOCAL = &tree.RefreshMaterializedView{
+				Name:        yyDollar[4].tableNameUnion(),
+				Incremental: yyDollar[5].boolValUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 990:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:6079
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 991:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5650
+		var yyLOCAL bool
+//line mysql_sql.y:6083
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 992:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line mysql_sql.y:6087
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 993:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:6093
+		{
+			yyLOCAL = &tree.DropMaterializedView{
+				IfExists: yyDollar[4].boolValUnion(),
+				Name:     yyDollar[5].tableNameUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 994:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.TriggerName
+//line mysql_sql.y:6102
+		{
+			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+			yyLOCAL = tree.NewTriggerName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 995:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.TriggerName
+//line mysql_sql.y:6107
+		{
+			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
+			yyLOCAL = tree.NewTriggerName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 996:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.EventName
+//line mysql_sql.y:6114
+		{
+			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+			yyLOCAL = tree.NewEventName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 997:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.EventName
+//line mysql_sql.y:6119
+		{
+			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
+			yyLOCAL = tree.NewEventName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 998:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.EventSchedule
+//line mysql_sql.y:6126
+		{
+			yyLOCAL = &tree.EventSchedule{
+				At: yyDollar[2].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 999:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.EventSchedule
+//line mysql_sql.y:6132
+		{
+			yyLOCAL = &tree.EventSchedule{
+				Every: yyDollar[2].exprUnion(),
+				Unit:  yyDollar[3].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1000:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.EventSchedule
+//line mysql_sql.y:6139
+		{
+			yyLOCAL = &tree.EventSchedule{
+				Every:  yyDollar[2].exprUnion(),
+				Unit:   yyDollar[3].str,
+				Starts: yyDollar[5].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1001:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.EventSchedule
+//line mysql_sql.y:6147
+		{
+			yyLOCAL = &tree.EventSchedule{
+				Every: yyDollar[2].exprUnion(),
+				Unit:  yyDollar[3].str,
+				Ends:  yyDollar[5].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1002:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL *tree.EventSchedule
+//line mysql_sql.y:6155
+		{
+			yyLOCAL = &tree.EventSchedule{
+				Every:  yyDollar[2].exprUnion(),
+				Unit:   yyDollar[3].str,
+				Starts: yyDollar[5].exprUnion(),
+				Ends:   yyDollar[7].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1003:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.EventCompletion
+//line mysql_sql.y:6165
+		{
+			yyLOCAL = tree.EventCompletionUnspecified
+		}
+		yyVAL.union = yyLOCAL
+	case 1005:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.EventCompletion
+//line mysql_sql.y:6172
+		{
+			yyLOCAL = tree.EventCompletionPreserve
+		}
+		yyVAL.union = yyLOCAL
+	case 1006:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.EventCompletion
+//line mysql_sql.y:6176
+		{
+			yyLOCAL = tree.EventCompletionNotPreserve
+		}
+		yyVAL.union = yyLOCAL
+	case 1007:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.EventName
+//line mysql_sql.y:6181
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 1008:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.EventName
+//line mysql_sql.y:6185
+		{
+			yyLOCAL = yyDollar[3].eventNameUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 1009:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.EventStatus
+//line mysql_sql.y:6190
+		{
+			yyLOCAL = tree.EventStatusUnspecified
+		}
+		yyVAL.union = yyLOCAL
+	case 1010:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.EventStatus
+//line mysql_sql.y:6194
+		{
+			yyLOCAL = tree.EventStatusEnable
+		}
+		yyVAL.union = yyLOCAL
+	case 1011:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.EventStatus
+//line mysql_sql.y:6198
+		{
+			yyLOCAL = tree.EventStatusDisable
+		}
+		yyVAL.union = yyLOCAL
+	case 1012:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.EventComment
+//line mysql_sql.y:6203
+		{
+			yyLOCAL = tree.EventComment{
+				Exist: false,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1013:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.EventComment
+//line mysql_sql.y:6209
+		{
+			yyLOCAL = tree.EventComment{
+				Exist:   true,
+				Comment: yyDollar[2].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1014:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:6217
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 1015:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:6221
+		{
+			yyLOCAL = yyDollar[2].statementUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 1016:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line mysql_sql.y:6227
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 929:
+	case 1017:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5655
+//line mysql_sql.y:6232
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist: false,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 1018:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5661
+//line mysql_sql.y:6238
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -15101,10 +16838,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 1019:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.AccountCommentOrAttribute
-//line mysql_sql.y:5669
+//line mysql_sql.y:6246
 		{
 			yyLOCAL = tree.AccountCommentOrAttribute{
 				Exist:     true,
@@ -15113,26 +16850,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 1020:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5775
+//line mysql_sql.y:6352
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 1021:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5779
+//line mysql_sql.y:6356
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 1022:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:5785
+//line mysql_sql.y:6362
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -15141,26 +16878,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5795
+//line mysql_sql.y:6372
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 1024:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:5799
+//line mysql_sql.y:6376
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 1025:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:5805
+//line mysql_sql.y:6382
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -15169,50 +16906,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 1026:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5815
+//line mysql_sql.y:6392
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 1027:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5819
+//line mysql_sql.y:6396
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 1028:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:5823
+//line mysql_sql.y:6400
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 1029:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5828
+//line mysql_sql.y:6405
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 1030:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5832
+//line mysql_sql.y:6409
 		{
 			yyLOCAL = yyDollar[1].userIdentifiedUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 1031:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5838
+//line mysql_sql.y:6415
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByPassword,
@@ -15220,20 +16957,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 1032:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5845
+//line mysql_sql.y:6422
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedByRandomPassword,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 1033:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AccountIdentified
-//line mysql_sql.y:5851
+//line mysql_sql.y:6428
 		{
 			yyLOCAL = &tree.AccountIdentified{
 				Typ: tree.AccountIdentifiedWithSSL,
@@ -15241,16 +16978,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1034:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5860
+//line mysql_sql.y:6437
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 948:
+	case 1036:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5867
+//line mysql_sql.y:6444
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -15258,90 +16995,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 1037:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:5876
+//line mysql_sql.y:6453
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1038:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:5880
+//line mysql_sql.y:6457
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1039:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:5886
+//line mysql_sql.y:6463
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1040:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5900
+//line mysql_sql.y:6477
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1041:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5904
+//line mysql_sql.y:6481
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1042:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:5908
+//line mysql_sql.y:6485
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1043:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.CStr
+//line mysql_sql.y:6489
+		{
+			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
+		}
+		yyVAL.union = yyLOCAL
+	case 1044:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5913
+//line mysql_sql.y:6494
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1045:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5917
+//line mysql_sql.y:6498
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1046:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5921
+//line mysql_sql.y:6502
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1047:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:5925
+//line mysql_sql.y:6506
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1048:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:5931
+//line mysql_sql.y:6512
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -15360,18 +17105,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1049:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5950
+//line mysql_sql.y:6531
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1050:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5954
+//line mysql_sql.y:6535
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -15387,128 +17132,156 @@ yydefault:
 					opt1.ParserName = opt2.ParserName
 				} else if opt2.Visible != tree.VISIBLE_TYPE_INVALID {
 					opt1.Visible = opt2.Visible
+				} else if opt2.AlgoParamList > 0 {
+					opt1.AlgoParamList = opt2.AlgoParamList
+				} else if len(opt2.AlgoParamVectorOpType) > 0 {
+					opt1.AlgoParamVectorOpType = opt2.AlgoParamVectorOpType
 				}
 				yyLOCAL = opt1
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1051:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5976
+//line mysql_sql.y:6561
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1052:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5980
+//line mysql_sql.y:6565
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1053:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5984
+//line mysql_sql.y:6569
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].cstrUnion().Compare()}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1054:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5988
+//line mysql_sql.y:6573
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1055:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:5992
+//line mysql_sql.y:6577
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1056:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line mysql_sql.y:6581
+		{
+			yyLOCAL = &tree.IndexOption{AlgoParamList: yyDollar[3].item.(int64)}
+		}
+		yyVAL.union = yyLOCAL
+	case 1057:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.IndexOption
+//line mysql_sql.y:6585
+		{
+			yyLOCAL = &tree.IndexOption{AlgoParamVectorOpType: yyDollar[2].str}
+		}
+		yyVAL.union = yyLOCAL
+	case 1058:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:5998
+//line mysql_sql.y:6591
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1059:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:6002
+//line mysql_sql.y:6595
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1060:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:6008
+//line mysql_sql.y:6601
 		{
 			// Order is parsed but just ignored as MySQL dtree.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1061:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:6013
+//line mysql_sql.y:6606
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1062:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:6018
+//line mysql_sql.y:6611
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1063:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:6022
+//line mysql_sql.y:6615
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1064:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:6026
+//line mysql_sql.y:6619
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 974:
+	case 1065:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:6030
+//line mysql_sql.y:6623
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1066:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:6034
+//line mysql_sql.y:6627
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1067:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.IndexType
+//line mysql_sql.y:6631
+		{
+			yyLOCAL = tree.INDEX_TYPE_IVFFLAT
+		}
+		yyVAL.union = yyLOCAL
+	case 1068:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:6040
+//line mysql_sql.y:6637
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:        yyDollar[3].ifNotExistsUnion(),
@@ -15518,114 +17291,114 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1069:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:6051
+//line mysql_sql.y:6648
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1070:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.SubscriptionOption
-//line mysql_sql.y:6055
+//line mysql_sql.y:6652
 		{
 			yyLOCAL = &tree.SubscriptionOption{From: tree.Identifier(yyDollar[2].str), Publication: tree.Identifier(yyDollar[4].cstrUnion().Compare())}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1073:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6065
+//line mysql_sql.y:6662
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1074:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6069
+//line mysql_sql.y:6666
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1075:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:6074
+//line mysql_sql.y:6671
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1076:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:6078
+//line mysql_sql.y:6675
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1077:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:6084
+//line mysql_sql.y:6681
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1078:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:6088
+//line mysql_sql.y:6685
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1079:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:6094
+//line mysql_sql.y:6691
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1080:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:6098
+//line mysql_sql.y:6695
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1081:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:6102
+//line mysql_sql.y:6699
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 990:
+	case 1082:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6107
+//line mysql_sql.y:6704
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 991:
+	case 1083:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6111
+//line mysql_sql.y:6708
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 992:
+	case 1084:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:6117
+//line mysql_sql.y:6714
 		{
 			yyLOCAL = &tree.CreateConnector{
 				ConnectorName: yyDollar[3].tableNameUnion(),
@@ -15633,10 +17406,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 993:
+	case 1085:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:6126
+//line mysql_sql.y:6723
 		{
 			yyLOCAL = &tree.CreateStream{
 				Replace:     yyDollar[2].streamOptionalUnion(),
@@ -15648,10 +17421,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1086:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:6137
+//line mysql_sql.y:6734
 		{
 			yyLOCAL = &tree.CreateStream{
 				Replace:     yyDollar[2].streamOptionalUnion(),
@@ -15663,10 +17436,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 995:
+	case 1087:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:6148
+//line mysql_sql.y:6745
 		{
 			yyLOCAL = &tree.CreateStream{
 				Replace:     yyDollar[2].streamOptionalUnion(),
@@ -15677,26 +17450,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 996:
+	case 1088:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6159
+//line mysql_sql.y:6756
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 997:
+	case 1089:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6163
+//line mysql_sql.y:6760
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 998:
+	case 1090:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:6169
+//line mysql_sql.y:6766
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -15709,10 +17482,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 999:
+	case 1091:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:6181
+//line mysql_sql.y:6778
 		{
 			yyLOCAL = &tree.CreateTable{
 				IfNotExists: yyDollar[4].ifNotExistsUnion(),
@@ -15722,10 +17495,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1000:
+	case 1092:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:6190
+//line mysql_sql.y:6787
 		{
 			yyLOCAL = &tree.CreateTable{
 				IsClusterTable:  true,
@@ -15738,19 +17511,19 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1001:
+	case 1093:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:6203
+//line mysql_sql.y:6800
 		{
 			yyLOCAL = yyDollar[1].loadParamUnion()
 			yyLOCAL.Tail = yyDollar[2].tailParamUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1002:
+	case 1094:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:6210
+//line mysql_sql.y:6807
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -15761,10 +17534,24 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1003:
+	case 1095:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.ExternParam
+//line mysql_sql.y:6817
+		{
+			yyLOCAL = &tree.ExternParam{
+				ExParamConst: tree.ExParamConst{
+					Filepath:     yyDollar[2].str,
+					CompressType: tree.AUTO,
+					Format:       strings.ToLower(yyDollar[4].str),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1096:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:6220
+//line mysql_sql.y:6827
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -15773,10 +17560,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1004:
+	case 1097:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ExternParam
-//line mysql_sql.y:6228
+//line mysql_sql.y:6835
 		{
 			yyLOCAL = &tree.ExternParam{
 				ExParamConst: tree.ExParamConst{
@@ -15786,43 +17573,43 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1005:
+	case 1098:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6239
+//line mysql_sql.y:6846
 		{
 			yyLOCAL = yyDollar[1].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1006:
+	case 1099:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6243
+//line mysql_sql.y:6850
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].strsUnion()...)
 		}
 		yyVAL.union = yyLOCAL
-	case 1007:
+	case 1100:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6248
+//line mysql_sql.y:6855
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 1008:
+	case 1101:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:6252
+//line mysql_sql.y:6859
 		{
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 			yyLOCAL = append(yyLOCAL, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1009:
+	case 1102:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.TailParameter
-//line mysql_sql.y:6259
+//line mysql_sql.y:6866
 		{
 			yyLOCAL = &tree.TailParameter{
 				Fields:       yyDollar[1].fieldsUnion(),
@@ -15833,10 +17620,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1010:
+	case 1103:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:6270
+//line mysql_sql.y:6877
 		{
 			yyLOCAL = &tree.CreateSequence{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -15850,10 +17637,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1011:
+	case 1104:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6283
+//line mysql_sql.y:6890
 		{
 			locale := ""
 			fstr := "bigint"
@@ -15868,26 +17655,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1012:
+	case 1105:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:6297
+//line mysql_sql.y:6904
 		{
 			yyLOCAL = yyDollar[2].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1013:
+	case 1106:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:6301
+//line mysql_sql.y:6908
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1014:
+	case 1107:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:6305
+//line mysql_sql.y:6912
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -15895,10 +17682,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1015:
+	case 1108:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:6312
+//line mysql_sql.y:6919
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: false,
@@ -15906,10 +17693,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1016:
+	case 1109:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:6319
+//line mysql_sql.y:6926
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -15917,10 +17704,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1017:
+	case 1110:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IncrementByOption
-//line mysql_sql.y:6326
+//line mysql_sql.y:6933
 		{
 			yyLOCAL = &tree.IncrementByOption{
 				Minus: true,
@@ -15928,42 +17715,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1018:
+	case 1111:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6333
+//line mysql_sql.y:6940
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1019:
+	case 1112:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6337
+//line mysql_sql.y:6944
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1020:
+	case 1113:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6341
+//line mysql_sql.y:6948
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1021:
+	case 1114:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:6345
+//line mysql_sql.y:6952
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1022:
+	case 1115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:6349
+//line mysql_sql.y:6956
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: false,
@@ -15971,10 +17758,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1023:
+	case 1116:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MinValueOption
-//line mysql_sql.y:6356
+//line mysql_sql.y:6963
 		{
 			yyLOCAL = &tree.MinValueOption{
 				Minus: true,
@@ -15982,18 +17769,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1024:
+	case 1117:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:6363
+//line mysql_sql.y:6970
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1025:
+	case 1118:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:6367
+//line mysql_sql.y:6974
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: false,
@@ -16001,10 +17788,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1026:
+	case 1119:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.MaxValueOption
-//line mysql_sql.y:6374
+//line mysql_sql.y:6981
 		{
 			yyLOCAL = &tree.MaxValueOption{
 				Minus: true,
@@ -16012,18 +17799,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1027:
+	case 1120:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:6381
+//line mysql_sql.y:6988
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1028:
+	case 1121:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:6385
+//line mysql_sql.y:6992
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -16031,10 +17818,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1029:
+	case 1122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:6392
+//line mysql_sql.y:6999
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: false,
@@ -16042,10 +17829,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1030:
+	case 1123:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:6399
+//line mysql_sql.y:7006
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -16053,10 +17840,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1031:
+	case 1124:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.StartWithOption
-//line mysql_sql.y:6406
+//line mysql_sql.y:7013
 		{
 			yyLOCAL = &tree.StartWithOption{
 				Minus: true,
@@ -16064,58 +17851,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1032:
+	case 1125:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6413
+//line mysql_sql.y:7020
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1033:
+	case 1126:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6417
+//line mysql_sql.y:7024
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1034:
+	case 1127:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6422
+//line mysql_sql.y:7029
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1035:
+	case 1128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6426
+//line mysql_sql.y:7033
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1036:
+	case 1129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6430
+//line mysql_sql.y:7037
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1037:
+	case 1130:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:6435
+//line mysql_sql.y:7042
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1038:
+	case 1131:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:6439
+//line mysql_sql.y:7046
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -16125,18 +17912,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1039:
+	case 1132:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:6449
+//line mysql_sql.y:7056
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1040:
+	case 1133:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:6453
+//line mysql_sql.y:7060
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: []*tree.UnresolvedName{yyDollar[3].unresolvedNameUnion()},
@@ -16144,28 +17931,28 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 1041:
+	case 1134:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.ClusterByOption
-//line mysql_sql.y:6460
+//line mysql_sql.y:7067
 		{
 			yyLOCAL = &tree.ClusterByOption{
 				ColumnList: yyDollar[4].unresolveNamesUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1042:
+	case 1135:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6467
+//line mysql_sql.y:7074
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1043:
+	case 1136:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6471
+//line mysql_sql.y:7078
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -16174,42 +17961,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1044:
+	case 1137:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:6480
+//line mysql_sql.y:7087
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1045:
+	case 1138:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:6484
+//line mysql_sql.y:7091
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1046:
+	case 1139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:6490
+//line mysql_sql.y:7097
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1047:
+	case 1140:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:6494
+//line mysql_sql.y:7101
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1048:
+	case 1141:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:6500
+//line mysql_sql.y:7107
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -16219,10 +18006,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1049:
+	case 1142:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:6509
+//line mysql_sql.y:7116
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -16232,42 +18019,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1050:
+	case 1143:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:6519
+//line mysql_sql.y:7126
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1051:
+	case 1144:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:6523
+//line mysql_sql.y:7130
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1052:
+	case 1145:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:6529
+//line mysql_sql.y:7136
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1053:
+	case 1146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:6533
+//line mysql_sql.y:7140
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1054:
+	case 1147:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:6539
+//line mysql_sql.y:7146
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -16275,10 +18062,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1055:
+	case 1148:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:6546
+//line mysql_sql.y:7153
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].cstrUnion().Compare()),
@@ -16286,67 +18073,67 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1056:
+	case 1149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6555
+//line mysql_sql.y:7162
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1057:
+	case 1150:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6559
+//line mysql_sql.y:7166
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1058:
+	case 1151:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:6564
+//line mysql_sql.y:7171
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1059:
+	case 1152:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:6568
+//line mysql_sql.y:7175
 		{
 			expr := tree.NewMaxValue()
 			yyLOCAL = &tree.ValuesLessThan{ValueList: tree.Exprs{expr}}
 		}
 		yyVAL.union = yyLOCAL
-	case 1060:
+	case 1153:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:6573
+//line mysql_sql.y:7180
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1061:
+	case 1154:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:6577
+//line mysql_sql.y:7184
 		{
 			yyLOCAL = &tree.ValuesIn{ValueList: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1062:
+	case 1155:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6582
+//line mysql_sql.y:7189
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1063:
+	case 1156:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6586
+//line mysql_sql.y:7193
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -16356,18 +18143,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 1064:
+	case 1157:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6596
+//line mysql_sql.y:7203
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1065:
+	case 1158:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6600
+//line mysql_sql.y:7207
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -16377,10 +18164,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 1066:
+	case 1159:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6611
+//line mysql_sql.y:7218
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -16389,10 +18176,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1067:
+	case 1160:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6619
+//line mysql_sql.y:7226
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -16401,10 +18188,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1068:
+	case 1161:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6627
+//line mysql_sql.y:7234
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -16413,10 +18200,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1069:
+	case 1162:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6635
+//line mysql_sql.y:7242
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -16425,10 +18212,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1071:
+	case 1164:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6646
+//line mysql_sql.y:7253
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -16438,10 +18225,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1072:
+	case 1165:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6655
+//line mysql_sql.y:7262
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -16452,10 +18239,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1073:
+	case 1166:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:6665
+//line mysql_sql.y:7272
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -16465,625 +18252,625 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1074:
+	case 1167:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6675
+//line mysql_sql.y:7282
 		{
 			yyLOCAL = 2
 		}
 		yyVAL.union = yyLOCAL
-	case 1075:
+	case 1168:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:6679
+//line mysql_sql.y:7286
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1076:
+	case 1169:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6684
+//line mysql_sql.y:7291
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1077:
+	case 1170:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:6688
+//line mysql_sql.y:7295
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1078:
+	case 1171:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6694
+//line mysql_sql.y:7301
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1079:
+	case 1172:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6698
+//line mysql_sql.y:7305
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1080:
+	case 1173:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6704
+//line mysql_sql.y:7311
 		{
 			yyLOCAL = &tree.CreateConnectorWithOption{Key: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Val: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1081:
+	case 1174:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6708
+//line mysql_sql.y:7315
 		{
 			yyLOCAL = &tree.CreateConnectorWithOption{Key: tree.Identifier(yyDollar[1].str), Val: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1082:
+	case 1175:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6713
+//line mysql_sql.y:7320
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1083:
+	case 1176:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6717
+//line mysql_sql.y:7324
 		{
 			yyLOCAL = yyDollar[3].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1084:
+	case 1177:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6723
+//line mysql_sql.y:7330
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1085:
+	case 1178:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6727
+//line mysql_sql.y:7334
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1086:
+	case 1179:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6733
+//line mysql_sql.y:7340
 		{
 			yyLOCAL = &tree.CreateStreamWithOption{Key: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Val: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1087:
+	case 1180:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6737
+//line mysql_sql.y:7344
 		{
 			yyLOCAL = &tree.CreateStreamWithOption{Key: tree.Identifier(yyDollar[1].str), Val: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1088:
+	case 1181:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6742
+//line mysql_sql.y:7349
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1089:
+	case 1182:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6746
+//line mysql_sql.y:7353
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1090:
+	case 1183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6752
+//line mysql_sql.y:7359
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1091:
+	case 1184:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6756
+//line mysql_sql.y:7363
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1092:
+	case 1185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:6760
+//line mysql_sql.y:7367
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1093:
+	case 1186:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6766
+//line mysql_sql.y:7373
 		{
 			yyLOCAL = tree.NewTableOptionAUTOEXTEND_SIZE(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1094:
+	case 1187:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6770
+//line mysql_sql.y:7377
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1095:
+	case 1188:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6774
+//line mysql_sql.y:7381
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1096:
+	case 1189:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6778
+//line mysql_sql.y:7385
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1097:
+	case 1190:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6782
+//line mysql_sql.y:7389
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1098:
+	case 1191:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6786
+//line mysql_sql.y:7393
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1099:
+	case 1192:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6790
+//line mysql_sql.y:7397
 		{
 			str := util.DealCommentString(yyDollar[3].str)
 			yyLOCAL = tree.NewTableOptionComment(str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1100:
+	case 1193:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6795
+//line mysql_sql.y:7402
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1101:
+	case 1194:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6799
+//line mysql_sql.y:7406
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1102:
+	case 1195:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6803
+//line mysql_sql.y:7410
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1103:
+	case 1196:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6807
+//line mysql_sql.y:7414
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1104:
+	case 1197:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6811
+//line mysql_sql.y:7418
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1105:
+	case 1198:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6815
+//line mysql_sql.y:7422
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1106:
+	case 1199:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6819
+//line mysql_sql.y:7426
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1107:
+	case 1200:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6823
+//line mysql_sql.y:7430
 		{
 			yyLOCAL = tree.NewTableOptionEngineAttr(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1108:
+	case 1201:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6827
+//line mysql_sql.y:7434
 		{
 			yyLOCAL = tree.NewTableOptionInsertMethod(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1109:
+	case 1202:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6831
+//line mysql_sql.y:7438
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1110:
+	case 1203:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6835
+//line mysql_sql.y:7442
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1111:
+	case 1204:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6839
+//line mysql_sql.y:7446
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1112:
+	case 1205:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6843
+//line mysql_sql.y:7450
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 1113:
+	case 1206:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6847
+//line mysql_sql.y:7454
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 1114:
+	case 1207:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6851
+//line mysql_sql.y:7458
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1115:
+	case 1208:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6855
+//line mysql_sql.y:7462
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1116:
+	case 1209:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6859
+//line mysql_sql.y:7466
 		{
 			yyLOCAL = tree.NewTTableOptionStartTrans(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 1117:
+	case 1210:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6863
+//line mysql_sql.y:7470
 		{
 			yyLOCAL = tree.NewTTableOptionSecondaryEngineAttr(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1118:
+	case 1211:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6867
+//line mysql_sql.y:7474
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 1119:
+	case 1212:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6871
+//line mysql_sql.y:7478
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 1120:
+	case 1213:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6875
+//line mysql_sql.y:7482
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 1121:
+	case 1214:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6879
+//line mysql_sql.y:7486
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 1122:
+	case 1215:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6883
+//line mysql_sql.y:7490
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 1123:
+	case 1216:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6887
+//line mysql_sql.y:7494
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 1124:
+	case 1217:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6891
+//line mysql_sql.y:7498
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].cstrUnion().Compare(), "")
 		}
 		yyVAL.union = yyLOCAL
-	case 1125:
+	case 1218:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6895
+//line mysql_sql.y:7502
 		{
 			yyLOCAL = tree.NewTableOptionTablespace("", yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1126:
+	case 1219:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6899
+//line mysql_sql.y:7506
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1127:
+	case 1220:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:6903
+//line mysql_sql.y:7510
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1128:
+	case 1221:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:6909
+//line mysql_sql.y:7516
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1129:
+	case 1222:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:6913
+//line mysql_sql.y:7520
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1130:
+	case 1223:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:6919
+//line mysql_sql.y:7526
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 1131:
+	case 1224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6925
+//line mysql_sql.y:7532
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 1132:
+	case 1225:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:6929
+//line mysql_sql.y:7536
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 1133:
+	case 1226:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6935
+//line mysql_sql.y:7542
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1134:
+	case 1227:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6939
+//line mysql_sql.y:7546
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 1135:
+	case 1228:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6943
+//line mysql_sql.y:7550
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 1136:
+	case 1229:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6947
+//line mysql_sql.y:7554
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 1137:
+	case 1230:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6951
+//line mysql_sql.y:7558
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 1138:
+	case 1231:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:6955
+//line mysql_sql.y:7562
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 1143:
+	case 1236:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:6969
+//line mysql_sql.y:7576
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1144:
+	case 1237:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:6973
+//line mysql_sql.y:7580
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1145:
+	case 1238:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:6982
+//line mysql_sql.y:7589
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 1146:
+	case 1239:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:6987
+//line mysql_sql.y:7594
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 1147:
+	case 1240:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:6993
+//line mysql_sql.y:7600
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 1149:
+	case 1242:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:7000
+//line mysql_sql.y:7607
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1150:
+	case 1243:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:7004
+//line mysql_sql.y:7611
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1151:
+	case 1244:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7010
+//line mysql_sql.y:7617
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1152:
+	case 1245:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7014
+//line mysql_sql.y:7621
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1153:
+	case 1246:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7018
+//line mysql_sql.y:7625
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1154:
+	case 1247:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7024
+//line mysql_sql.y:7631
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1155:
+	case 1248:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7028
+//line mysql_sql.y:7635
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1156:
+	case 1249:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7034
+//line mysql_sql.y:7641
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -17093,10 +18880,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1157:
+	case 1250:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7043
+//line mysql_sql.y:7650
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -17106,10 +18893,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1158:
+	case 1251:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7052
+//line mysql_sql.y:7659
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -17119,6 +18906,8 @@ yydefault:
 					keyTyp = tree.INDEX_TYPE_ZONEMAP
 				case "bsi":
 					keyTyp = tree.INDEX_TYPE_BSI
+				case "ivfflat":
+					keyTyp = tree.INDEX_TYPE_IVFFLAT
 				default:
 					yylex.Error("Invail the type of index")
 					return 1
@@ -17133,10 +18922,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1159:
+	case 1252:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7075
+//line mysql_sql.y:7684
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -17146,6 +18935,8 @@ yydefault:
 					keyTyp = tree.INDEX_TYPE_ZONEMAP
 				case "bsi":
 					keyTyp = tree.INDEX_TYPE_BSI
+				case "ivfflat":
+					keyTyp = tree.INDEX_TYPE_IVFFLAT
 				default:
 					yylex.Error("Invail the type of index")
 					return 1
@@ -17160,10 +18951,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1160:
+	case 1253:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7100
+//line mysql_sql.y:7711
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -17173,23 +18964,25 @@ yydefault:
 					v.ConstraintSymbol = yyDollar[1].str
 				case *tree.UniqueIndex:
 					v.ConstraintSymbol = yyDollar[1].str
+				case *tree.CheckIndex:
+					v.Name = yyDollar[1].str
 				}
 			}
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1161:
+	case 1254:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7114
+//line mysql_sql.y:7727
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1162:
+	case 1255:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7120
+//line mysql_sql.y:7733
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -17199,10 +18992,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1163:
+	case 1256:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7129
+//line mysql_sql.y:7742
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -17212,10 +19005,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1164:
+	case 1257:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7138
+//line mysql_sql.y:7751
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -17225,10 +19018,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1165:
+	case 1258:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7147
+//line mysql_sql.y:7760
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -17238,10 +19031,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1166:
+	case 1259:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7156
+//line mysql_sql.y:7769
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -17252,10 +19045,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1167:
+	case 1260:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:7166
+//line mysql_sql.y:7779
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -17263,313 +19056,313 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1168:
+	case 1261:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:7174
+//line mysql_sql.y:7787
 		{
-			yyLOCAL = false
+			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1170:
+	case 1263:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7180
+//line mysql_sql.y:7793
 		{
 			yyVAL.str = ""
 		}
-	case 1171:
+	case 1264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:7184
+//line mysql_sql.y:7797
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1174:
+	case 1267:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:7194
+//line mysql_sql.y:7807
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 1175:
+	case 1268:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:7200
+//line mysql_sql.y:7813
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 1176:
+	case 1269:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:7206
+//line mysql_sql.y:7819
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].cstrUnion().Compare()
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 1185:
+	case 1279:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7225
+//line mysql_sql.y:7839
 		{
 			yyVAL.str = ""
 		}
-	case 1186:
+	case 1280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:7229
+//line mysql_sql.y:7843
 		{
 			yyVAL.str = yyDollar[1].cstrUnion().Compare()
 		}
-	case 1187:
+	case 1281:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:7235
+//line mysql_sql.y:7849
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1188:
+	case 1282:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:7241
+//line mysql_sql.y:7855
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1189:
+	case 1283:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:7245
+//line mysql_sql.y:7859
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1190:
+	case 1284:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:7249
+//line mysql_sql.y:7863
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1191:
+	case 1285:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:7255
+//line mysql_sql.y:7869
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1192:
+	case 1286:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:7259
+//line mysql_sql.y:7873
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1193:
+	case 1287:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:7263
+//line mysql_sql.y:7877
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1194:
+	case 1288:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.CStr
-//line mysql_sql.y:7267
+//line mysql_sql.y:7881
 		{
 			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
 		}
 		yyVAL.union = yyLOCAL
-	case 1195:
+	case 1289:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:7273
+//line mysql_sql.y:7887
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1196:
+	case 1290:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:7277
+//line mysql_sql.y:7891
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1197:
+	case 1291:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:7281
+//line mysql_sql.y:7895
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
 		}
 		yyVAL.union = yyLOCAL
-	case 1198:
+	case 1292:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:7286
+//line mysql_sql.y:7900
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1199:
+	case 1293:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:7290
+//line mysql_sql.y:7904
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1200:
+	case 1294:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:7296
+//line mysql_sql.y:7910
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1201:
+	case 1295:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:7300
+//line mysql_sql.y:7914
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1202:
+	case 1296:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7306
+//line mysql_sql.y:7920
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 1203:
+	case 1297:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7310
+//line mysql_sql.y:7924
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 1204:
+	case 1298:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7314
+//line mysql_sql.y:7928
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1205:
+	case 1299:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7318
+//line mysql_sql.y:7932
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 1206:
+	case 1300:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7322
+//line mysql_sql.y:7936
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1207:
+	case 1301:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7326
+//line mysql_sql.y:7940
 		{
 			str := util.DealCommentString(yyDollar[2].str)
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumValWithType(constant.MakeString(str), str, false, tree.P_char))
 		}
 		yyVAL.union = yyLOCAL
-	case 1208:
+	case 1302:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7331
+//line mysql_sql.y:7945
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1209:
+	case 1303:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7335
+//line mysql_sql.y:7949
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1210:
+	case 1304:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7339
+//line mysql_sql.y:7953
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1211:
+	case 1305:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7343
+//line mysql_sql.y:7957
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1212:
+	case 1306:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7347
+//line mysql_sql.y:7961
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1213:
+	case 1307:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7351
+//line mysql_sql.y:7965
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1214:
+	case 1308:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7355
+//line mysql_sql.y:7969
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1215:
+	case 1309:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7359
+//line mysql_sql.y:7973
 		{
-			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
+			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), true, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1216:
+	case 1310:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7363
+//line mysql_sql.y:7977
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1217:
+	case 1311:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7367
+//line mysql_sql.y:7981
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			var es tree.Exprs = nil
@@ -17583,98 +19376,98 @@ yydefault:
 			yyLOCAL = tree.NewAttributeOnUpdate(expr)
 		}
 		yyVAL.union = yyLOCAL
-	case 1218:
+	case 1312:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7380
+//line mysql_sql.y:7994
 		{
 			yyLOCAL = tree.NewAttributeLowCardinality()
 		}
 		yyVAL.union = yyLOCAL
-	case 1219:
+	case 1313:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7384
+//line mysql_sql.y:7998
 		{
 			yyLOCAL = tree.NewAttributeVisable(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 1220:
+	case 1314:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7388
+//line mysql_sql.y:8002
 		{
 			yyLOCAL = tree.NewAttributeVisable(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 1221:
+	case 1315:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7392
+//line mysql_sql.y:8006
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1222:
+	case 1316:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7396
+//line mysql_sql.y:8010
 		{
 			yyLOCAL = tree.NewAttributeHeader(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1223:
+	case 1317:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:7400
+//line mysql_sql.y:8014
 		{
 			yyLOCAL = tree.NewAttributeHeaders()
 		}
 		yyVAL.union = yyLOCAL
-	case 1224:
+	case 1318:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:7406
+//line mysql_sql.y:8020
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1225:
+	case 1319:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:7410
+//line mysql_sql.y:8024
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1226:
+	case 1320:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7415
+//line mysql_sql.y:8029
 		{
 			yyVAL.str = ""
 		}
-	case 1227:
+	case 1321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:7419
+//line mysql_sql.y:8033
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1228:
+	case 1322:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:7425
+//line mysql_sql.y:8039
 		{
 			yyVAL.str = ""
 		}
-	case 1229:
+	case 1323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:7429
+//line mysql_sql.y:8043
 		{
 			yyVAL.str = yyDollar[2].cstrUnion().Compare()
 		}
-	case 1230:
+	case 1324:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:7435
+//line mysql_sql.y:8049
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -17685,10 +19478,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1231:
+	case 1325:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:7447
+//line mysql_sql.y:8061
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -17696,10 +19489,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1232:
+	case 1326:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:7454
+//line mysql_sql.y:8068
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -17707,10 +19500,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1233:
+	case 1327:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:7461
+//line mysql_sql.y:8075
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -17718,10 +19511,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1234:
+	case 1328:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:7468
+//line mysql_sql.y:8082
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -17729,10 +19522,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1235:
+	case 1329:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:7475
+//line mysql_sql.y:8089
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -17740,339 +19533,339 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1236:
+	case 1330:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:7484
+//line mysql_sql.y:8098
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1237:
+	case 1331:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:7490
+//line mysql_sql.y:8104
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1238:
+	case 1332:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:7496
+//line mysql_sql.y:8110
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 1239:
+	case 1333:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:7500
+//line mysql_sql.y:8114
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 1240:
+	case 1334:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:7504
+//line mysql_sql.y:8118
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1241:
+	case 1335:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:7508
+//line mysql_sql.y:8122
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 1242:
+	case 1336:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:7512
+//line mysql_sql.y:8126
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1243:
+	case 1337:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:7517
+//line mysql_sql.y:8131
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 1245:
+	case 1339:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:7524
+//line mysql_sql.y:8138
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 1246:
+	case 1340:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:7528
+//line mysql_sql.y:8142
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1247:
+	case 1341:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:7532
+//line mysql_sql.y:8146
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 1248:
+	case 1342:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:7537
+//line mysql_sql.y:8151
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1249:
+	case 1343:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:7541
+//line mysql_sql.y:8155
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1250:
+	case 1344:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:7546
+//line mysql_sql.y:8160
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 1251:
+	case 1345:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:7550
+//line mysql_sql.y:8164
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1258:
+	case 1352:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:7566
+//line mysql_sql.y:8180
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 1259:
+	case 1353:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7572
+//line mysql_sql.y:8186
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1260:
+	case 1354:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7576
+//line mysql_sql.y:8190
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1261:
+	case 1355:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7580
+//line mysql_sql.y:8194
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1262:
+	case 1356:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7584
+//line mysql_sql.y:8198
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1263:
+	case 1357:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7588
+//line mysql_sql.y:8202
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1264:
+	case 1358:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7592
+//line mysql_sql.y:8206
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1265:
+	case 1359:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7596
+//line mysql_sql.y:8210
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1266:
+	case 1360:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7600
+//line mysql_sql.y:8214
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1267:
+	case 1361:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7604
+//line mysql_sql.y:8218
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1268:
+	case 1362:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7608
+//line mysql_sql.y:8222
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1269:
+	case 1363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7612
+//line mysql_sql.y:8226
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1270:
+	case 1364:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7616
+//line mysql_sql.y:8230
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1271:
+	case 1365:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7620
+//line mysql_sql.y:8234
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1272:
+	case 1366:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7626
+//line mysql_sql.y:8240
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1273:
+	case 1367:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7630
+//line mysql_sql.y:8244
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1274:
+	case 1368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7634
+//line mysql_sql.y:8248
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1275:
+	case 1369:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7638
+//line mysql_sql.y:8252
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1276:
+	case 1370:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7642
+//line mysql_sql.y:8256
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 1277:
+	case 1371:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7646
+//line mysql_sql.y:8260
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1278:
+	case 1372:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7650
+//line mysql_sql.y:8264
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1279:
+	case 1373:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7654
+//line mysql_sql.y:8268
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1280:
+	case 1374:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7658
+//line mysql_sql.y:8272
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1281:
+	case 1375:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7662
+//line mysql_sql.y:8276
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1282:
+	case 1376:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7666
+//line mysql_sql.y:8280
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1283:
+	case 1377:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7670
+//line mysql_sql.y:8284
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1284:
+	case 1378:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7675
+//line mysql_sql.y:8289
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -18081,26 +19874,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1285:
+	case 1379:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7683
+//line mysql_sql.y:8297
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1286:
+	case 1380:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7688
+//line mysql_sql.y:8302
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1287:
+	case 1381:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7692
+//line mysql_sql.y:8306
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
@@ -18110,50 +19903,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1288:
+	case 1382:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7701
+//line mysql_sql.y:8315
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1289:
+	case 1383:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7705
+//line mysql_sql.y:8319
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1290:
+	case 1384:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7709
+//line mysql_sql.y:8323
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1291:
+	case 1385:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7713
+//line mysql_sql.y:8327
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1292:
+	case 1386:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7717
+//line mysql_sql.y:8331
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1293:
+	case 1387:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:8335
+		{
+			yyLOCAL = tree.NewFullTextMatchExpr(yyDollar[3].unresolveNamesUnion(), yyDollar[7].exprUnion(), yyDollar[8].fullTextSearchTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 1388:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:8340
+		{
+			yyLOCAL = tree.FULLTEXT_NL
+		}
+		yyVAL.union = yyLOCAL
+	case 1389:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:8344
+		{
+			yyLOCAL = tree.FULLTEXT_NL
+		}
+		yyVAL.union = yyLOCAL
+	case 1390:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:8348
+		{
+			yyLOCAL = tree.FULLTEXT_NL_QUERY_EXPANSION
+		}
+		yyVAL.union = yyLOCAL
+	case 1391:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:8352
+		{
+			yyLOCAL = tree.FULLTEXT_BOOLEAN
+		}
+		yyVAL.union = yyLOCAL
+	case 1392:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.FullTextSearchType
+//line mysql_sql.y:8356
+		{
+			yyLOCAL = tree.FULLTEXT_QUERY_EXPANSION
+		}
+		yyVAL.union = yyLOCAL
+	case 1393:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7723
+//line mysql_sql.y:8362
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18162,10 +20003,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1294:
+	case 1394:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7731
+//line mysql_sql.y:8370
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18174,10 +20015,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1295:
+	case 1395:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:7739
+//line mysql_sql.y:8378
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18186,58 +20027,160 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1296:
+	case 1396:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:8386
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				WindowSpec: yyDollar[4].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1397:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:8394
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				WindowSpec: yyDollar[4].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1398:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:8402
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1399:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:8411
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1400:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:8420
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1401:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:8429
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1402:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:8438
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1403:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:8447
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:       tree.FuncName2ResolvableFunctionReference(name),
+				Exprs:      yyDollar[3].exprsUnion(),
+				WindowSpec: yyDollar[5].windowSpecUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1404:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7748
+//line mysql_sql.y:8457
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1297:
+	case 1405:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7752
+//line mysql_sql.y:8461
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1298:
+	case 1406:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7757
+//line mysql_sql.y:8466
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1299:
+	case 1407:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:7761
+//line mysql_sql.y:8470
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1300:
+	case 1408:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:7767
+//line mysql_sql.y:8476
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1301:
+	case 1409:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:7771
+//line mysql_sql.y:8480
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1302:
+	case 1410:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:7777
+//line mysql_sql.y:8486
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -18245,9 +20188,9 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1303:
+	case 1411:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:7786
+//line mysql_sql.y:8495
 		{
 			t := yyVAL.columnTypeUnion()
 			str := strings.ToLower(t.InternalType.FamilyString)
@@ -18260,10 +20203,10 @@ yydefault:
 				}
 			}
 		}
-	case 1304:
+	case 1412:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7798
+//line mysql_sql.y:8507
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -18281,10 +20224,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1305:
+	case 1413:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7815
+//line mysql_sql.y:8524
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18299,10 +20242,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1307:
+	case 1415:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7832
+//line mysql_sql.y:8541
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18316,10 +20259,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1308:
+	case 1416:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7845
+//line mysql_sql.y:8554
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18333,10 +20276,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1309:
+	case 1417:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7858
+//line mysql_sql.y:8567
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18349,10 +20292,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1310:
+	case 1418:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7870
+//line mysql_sql.y:8579
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18367,10 +20310,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1311:
+	case 1419:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7884
+//line mysql_sql.y:8593
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18386,10 +20329,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1312:
+	case 1420:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7899
+//line mysql_sql.y:8608
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18405,10 +20348,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1313:
+	case 1421:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7914
+//line mysql_sql.y:8623
 		{
 			name := yyDollar[1].str
 			if yyDollar[2].str != "" {
@@ -18426,10 +20369,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1314:
+	case 1422:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:7931
+//line mysql_sql.y:8640
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -18444,95 +20387,95 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1315:
+	case 1423:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:7946
+//line mysql_sql.y:8655
 		{
 		}
-	case 1319:
+	case 1427:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FrameBound
-//line mysql_sql.y:7953
+//line mysql_sql.y:8662
 		{
 			yyLOCAL = &tree.FrameBound{Type: tree.Following, UnBounded: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 1320:
+	case 1428:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FrameBound
-//line mysql_sql.y:7957
+//line mysql_sql.y:8666
 		{
 			yyLOCAL = &tree.FrameBound{Type: tree.Following, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1321:
+	case 1429:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FrameBound
-//line mysql_sql.y:7961
+//line mysql_sql.y:8670
 		{
 			yyLOCAL = &tree.FrameBound{Type: tree.Following, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1322:
+	case 1430:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FrameBound
-//line mysql_sql.y:7967
+//line mysql_sql.y:8676
 		{
 			yyLOCAL = &tree.FrameBound{Type: tree.CurrentRow}
 		}
 		yyVAL.union = yyLOCAL
-	case 1323:
+	case 1431:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FrameBound
-//line mysql_sql.y:7971
+//line mysql_sql.y:8680
 		{
 			yyLOCAL = &tree.FrameBound{Type: tree.Preceding, UnBounded: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 1324:
+	case 1432:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FrameBound
-//line mysql_sql.y:7975
+//line mysql_sql.y:8684
 		{
 			yyLOCAL = &tree.FrameBound{Type: tree.Preceding, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1325:
+	case 1433:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FrameBound
-//line mysql_sql.y:7979
+//line mysql_sql.y:8688
 		{
 			yyLOCAL = &tree.FrameBound{Type: tree.Preceding, Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1326:
+	case 1434:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FrameType
-//line mysql_sql.y:7985
+//line mysql_sql.y:8694
 		{
 			yyLOCAL = tree.Rows
 		}
 		yyVAL.union = yyLOCAL
-	case 1327:
+	case 1435:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FrameType
-//line mysql_sql.y:7989
+//line mysql_sql.y:8698
 		{
 			yyLOCAL = tree.Range
 		}
 		yyVAL.union = yyLOCAL
-	case 1328:
+	case 1436:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FrameType
-//line mysql_sql.y:7993
+//line mysql_sql.y:8702
 		{
 			yyLOCAL = tree.Groups
 		}
 		yyVAL.union = yyLOCAL
-	case 1329:
+	case 1437:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FrameClause
-//line mysql_sql.y:7999
+//line mysql_sql.y:8708
 		{
 			yyLOCAL = &tree.FrameClause{
 				Type:  yyDollar[1].frameTypeUnion(),
@@ -18541,10 +20484,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1330:
+	case 1438:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FrameClause
-//line mysql_sql.y:8007
+//line mysql_sql.y:8716
 		{
 			yyLOCAL = &tree.FrameClause{
 				Type:   yyDollar[1].frameTypeUnion(),
@@ -18554,70 +20497,70 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1331:
+	case 1439:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.FrameClause
-//line mysql_sql.y:8017
+//line mysql_sql.y:8726
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1332:
+	case 1440:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.FrameClause
-//line mysql_sql.y:8021
+//line mysql_sql.y:8730
 		{
 			yyLOCAL = yyDollar[1].frameClauseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1333:
+	case 1441:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:8028
+//line mysql_sql.y:8737
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1334:
+	case 1442:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:8033
+//line mysql_sql.y:8742
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1335:
+	case 1443:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:8037
+//line mysql_sql.y:8746
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1336:
+	case 1444:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8042
+//line mysql_sql.y:8751
 		{
 			yyVAL.str = ","
 		}
-	case 1337:
+	case 1445:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:8046
+//line mysql_sql.y:8755
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 1338:
+	case 1446:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:8051
+//line mysql_sql.y:8760
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1340:
+	case 1448:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.WindowSpec
-//line mysql_sql.y:8058
+//line mysql_sql.y:8767
 		{
 			hasFrame := true
 			var f *tree.FrameClause
@@ -18642,10 +20585,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1341:
+	case 1449:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8084
+//line mysql_sql.y:8793
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18657,10 +20600,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1342:
+	case 1450:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8095
+//line mysql_sql.y:8804
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18671,10 +20614,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1343:
+	case 1451:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8105
+//line mysql_sql.y:8814
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18685,10 +20628,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1344:
+	case 1452:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8115
+//line mysql_sql.y:8824
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -18699,10 +20642,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1345:
+	case 1453:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8125
+//line mysql_sql.y:8834
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18712,10 +20655,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1346:
+	case 1454:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8134
+//line mysql_sql.y:8843
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18725,10 +20668,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1347:
+	case 1455:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8143
+//line mysql_sql.y:8852
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18739,10 +20682,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1348:
+	case 1456:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8153
+//line mysql_sql.y:8862
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18753,10 +20696,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1349:
+	case 1457:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8163
+//line mysql_sql.y:8872
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18767,10 +20710,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1350:
+	case 1458:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8173
+//line mysql_sql.y:8882
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18781,10 +20724,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1351:
+	case 1459:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8183
+//line mysql_sql.y:8892
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
@@ -18795,10 +20738,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1352:
+	case 1460:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8193
+//line mysql_sql.y:8902
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18809,10 +20752,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1353:
+	case 1461:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8203
+//line mysql_sql.y:8912
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18823,10 +20766,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1354:
+	case 1462:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8213
+//line mysql_sql.y:8922
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18837,10 +20780,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1355:
+	case 1463:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8223
+//line mysql_sql.y:8932
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18851,10 +20794,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1356:
+	case 1464:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8233
+//line mysql_sql.y:8942
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18865,10 +20808,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1357:
+	case 1465:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8243
+//line mysql_sql.y:8952
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18879,10 +20822,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1358:
+	case 1466:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8253
+//line mysql_sql.y:8962
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18893,10 +20836,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1359:
+	case 1467:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8263
+//line mysql_sql.y:8972
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18907,10 +20850,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1363:
+	case 1471:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8282
+//line mysql_sql.y:8991
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18919,10 +20862,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1364:
+	case 1472:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8290
+//line mysql_sql.y:8999
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18931,10 +20874,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1365:
+	case 1473:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8298
+//line mysql_sql.y:9007
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18943,10 +20886,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1366:
+	case 1474:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8306
+//line mysql_sql.y:9015
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18955,10 +20898,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1367:
+	case 1475:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8314
+//line mysql_sql.y:9023
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -18969,10 +20912,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1368:
+	case 1476:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8324
+//line mysql_sql.y:9033
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18981,10 +20924,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1369:
+	case 1477:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8332
+//line mysql_sql.y:9041
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -18994,10 +20937,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1370:
+	case 1478:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8341
+//line mysql_sql.y:9050
 		{
 			name := tree.SetUnresolvedName("nextval")
 			yyLOCAL = &tree.FuncExpr{
@@ -19006,10 +20949,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1371:
+	case 1479:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8349
+//line mysql_sql.y:9058
 		{
 			name := tree.SetUnresolvedName("setval")
 			yyLOCAL = &tree.FuncExpr{
@@ -19018,10 +20961,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1372:
+	case 1480:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8357
+//line mysql_sql.y:9066
 		{
 			name := tree.SetUnresolvedName("currval")
 			yyLOCAL = &tree.FuncExpr{
@@ -19030,10 +20973,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1373:
+	case 1481:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8365
+//line mysql_sql.y:9074
 		{
 			name := tree.SetUnresolvedName("lastval")
 			yyLOCAL = &tree.FuncExpr{
@@ -19042,10 +20985,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1374:
+	case 1482:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8373
+//line mysql_sql.y:9082
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64)
@@ -19057,10 +21000,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1375:
+	case 1483:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8384
+//line mysql_sql.y:9093
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(1), "1", false, tree.P_int64)
@@ -19071,10 +21014,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1376:
+	case 1484:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8394
+//line mysql_sql.y:9103
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(2), "2", false, tree.P_int64)
@@ -19087,10 +21030,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1377:
+	case 1485:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8406
+//line mysql_sql.y:9115
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			arg0 := tree.NewNumValWithType(constant.MakeInt64(3), "3", false, tree.P_int64)
@@ -19102,10 +21045,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1378:
+	case 1486:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8417
+//line mysql_sql.y:9126
 		{
 			column := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
@@ -19115,16 +21058,16 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1385:
+	case 1493:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:8439
+//line mysql_sql.y:9148
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 1414:
+	case 1522:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8475
+//line mysql_sql.y:9184
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -19137,10 +21080,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1415:
+	case 1523:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8487
+//line mysql_sql.y:9196
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -19153,10 +21096,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1416:
+	case 1524:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8499
+//line mysql_sql.y:9208
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			str := strings.ToLower(yyDollar[3].str)
@@ -19167,10 +21110,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1417:
+	case 1525:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8510
+//line mysql_sql.y:9219
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -19179,10 +21122,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1418:
+	case 1526:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8518
+//line mysql_sql.y:9227
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -19190,10 +21133,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1419:
+	case 1527:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8525
+//line mysql_sql.y:9234
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -19201,10 +21144,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1420:
+	case 1528:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8532
+//line mysql_sql.y:9241
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -19217,10 +21160,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1421:
+	case 1529:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8544
+//line mysql_sql.y:9253
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -19229,10 +21172,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1422:
+	case 1530:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8552
+//line mysql_sql.y:9261
 		{
 			name := tree.SetUnresolvedName("binary")
 			exprs := make([]tree.Expr, 1)
@@ -19243,10 +21186,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1423:
+	case 1531:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8562
+//line mysql_sql.y:9271
 		{
 			name := tree.SetUnresolvedName("binary")
 			exprs := make([]tree.Expr, 1)
@@ -19257,10 +21200,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1424:
+	case 1532:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8572
+//line mysql_sql.y:9281
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -19269,10 +21212,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1425:
+	case 1533:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8580
+//line mysql_sql.y:9289
 		{
 			cn := tree.NewNumValWithType(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false, tree.P_char)
 			es := yyDollar[3].exprsUnion()
@@ -19284,10 +21227,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1426:
+	case 1534:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8591
+//line mysql_sql.y:9300
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("date")
@@ -19297,10 +21240,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1427:
+	case 1535:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8600
+//line mysql_sql.y:9309
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("time")
@@ -19310,10 +21253,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1428:
+	case 1536:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8609
+//line mysql_sql.y:9318
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -19322,10 +21265,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1429:
+	case 1537:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8617
+//line mysql_sql.y:9326
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -19336,10 +21279,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1430:
+	case 1538:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8627
+//line mysql_sql.y:9336
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -19348,10 +21291,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1431:
+	case 1539:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:8635
+//line mysql_sql.y:9344
 		{
 			val := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
 			name := tree.SetUnresolvedName("timestamp")
@@ -19361,34 +21304,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1432:
+	case 1540:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8645
+//line mysql_sql.y:9354
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1433:
+	case 1541:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8649
+//line mysql_sql.y:9358
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1434:
+	case 1542:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8655
+//line mysql_sql.y:9364
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1435:
+	case 1543:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8659
+//line mysql_sql.y:9368
 		{
 			ival, errStr := util.GetInt64(yyDollar[2].item)
 			if errStr != "" {
@@ -19399,20 +21342,20 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(ival), str, false, tree.P_int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1442:
+	case 1550:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:8678
+//line mysql_sql.y:9387
 		{
 		}
-	case 1443:
+	case 1551:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:8680
+//line mysql_sql.y:9389
 		{
 		}
-	case 1477:
+	case 1585:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8721
+//line mysql_sql.y:9430
 		{
 			name := tree.SetUnresolvedName("interval")
 			str := strings.ToLower(yyDollar[3].str)
@@ -19423,90 +21366,90 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1478:
+	case 1586:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:8732
+//line mysql_sql.y:9441
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 1479:
+	case 1587:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:8736
+//line mysql_sql.y:9445
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 1480:
+	case 1588:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:8740
+//line mysql_sql.y:9449
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1481:
+	case 1589:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:8746
+//line mysql_sql.y:9455
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1482:
+	case 1590:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:8751
+//line mysql_sql.y:9460
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1483:
+	case 1591:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:8755
+//line mysql_sql.y:9464
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1484:
+	case 1592:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:8761
+//line mysql_sql.y:9470
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 1485:
+	case 1593:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:8765
+//line mysql_sql.y:9474
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1486:
+	case 1594:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8772
+//line mysql_sql.y:9481
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1487:
+	case 1595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8776
+//line mysql_sql.y:9485
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1488:
+	case 1596:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8780
+//line mysql_sql.y:9489
 		{
 			name := tree.SetUnresolvedName(strings.ToLower("concat"))
 			yyLOCAL = &tree.FuncExpr{
@@ -19515,347 +21458,347 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1489:
+	case 1597:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8788
+//line mysql_sql.y:9497
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1490:
+	case 1598:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8792
+//line mysql_sql.y:9501
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1491:
+	case 1599:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8796
+//line mysql_sql.y:9505
 		{
 			yyLOCAL = tree.NewMaxValue()
 		}
 		yyVAL.union = yyLOCAL
-	case 1492:
+	case 1600:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8800
+//line mysql_sql.y:9509
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1493:
+	case 1601:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8806
+//line mysql_sql.y:9515
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1494:
+	case 1602:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8810
+//line mysql_sql.y:9519
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1495:
+	case 1603:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8814
+//line mysql_sql.y:9523
 		{
 			yyLOCAL = tree.NewIsUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1496:
+	case 1604:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8818
+//line mysql_sql.y:9527
 		{
 			yyLOCAL = tree.NewIsNotUnknownExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1497:
+	case 1605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8822
+//line mysql_sql.y:9531
 		{
 			yyLOCAL = tree.NewIsTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1498:
+	case 1606:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8826
+//line mysql_sql.y:9535
 		{
 			yyLOCAL = tree.NewIsNotTrueExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1499:
+	case 1607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8830
+//line mysql_sql.y:9539
 		{
 			yyLOCAL = tree.NewIsFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1500:
+	case 1608:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8834
+//line mysql_sql.y:9543
 		{
 			yyLOCAL = tree.NewIsNotFalseExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1501:
+	case 1609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8838
+//line mysql_sql.y:9547
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1502:
+	case 1610:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8842
+//line mysql_sql.y:9551
 		{
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1504:
+	case 1612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8850
+//line mysql_sql.y:9559
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1505:
+	case 1613:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8854
+//line mysql_sql.y:9563
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1506:
+	case 1614:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8858
+//line mysql_sql.y:9567
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1507:
+	case 1615:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8862
+//line mysql_sql.y:9571
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1508:
+	case 1616:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8866
+//line mysql_sql.y:9575
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.ILIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1509:
+	case 1617:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8870
+//line mysql_sql.y:9579
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_ILIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1510:
+	case 1618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8874
+//line mysql_sql.y:9583
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1511:
+	case 1619:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8878
+//line mysql_sql.y:9587
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1512:
+	case 1620:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8882
+//line mysql_sql.y:9591
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1513:
+	case 1621:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8886
+//line mysql_sql.y:9595
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 1515:
+	case 1623:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8892
+//line mysql_sql.y:9601
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 1516:
+	case 1624:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8896
+//line mysql_sql.y:9605
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1517:
+	case 1625:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8902
+//line mysql_sql.y:9611
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1518:
+	case 1626:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8906
+//line mysql_sql.y:9615
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1519:
+	case 1627:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8913
+//line mysql_sql.y:9622
 		{
 			yyLOCAL = tree.ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 1520:
+	case 1628:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8917
+//line mysql_sql.y:9626
 		{
 			yyLOCAL = tree.ANY
 		}
 		yyVAL.union = yyLOCAL
-	case 1521:
+	case 1629:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8921
+//line mysql_sql.y:9630
 		{
 			yyLOCAL = tree.SOME
 		}
 		yyVAL.union = yyLOCAL
-	case 1522:
+	case 1630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8927
+//line mysql_sql.y:9636
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1523:
+	case 1631:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8931
+//line mysql_sql.y:9640
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1524:
+	case 1632:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8935
+//line mysql_sql.y:9644
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 1525:
+	case 1633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8939
+//line mysql_sql.y:9648
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1526:
+	case 1634:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8943
+//line mysql_sql.y:9652
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1527:
+	case 1635:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8947
+//line mysql_sql.y:9656
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1528:
+	case 1636:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:8951
+//line mysql_sql.y:9660
 		{
 			yyLOCAL = tree.NULL_SAFE_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 1529:
+	case 1637:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8957
+//line mysql_sql.y:9666
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1530:
+	case 1638:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8961
+//line mysql_sql.y:9670
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1531:
+	case 1639:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8965
+//line mysql_sql.y:9674
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 1532:
+	case 1640:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:8969
+//line mysql_sql.y:9678
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 1533:
+	case 1641:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8975
+//line mysql_sql.y:9684
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -19869,35 +21812,35 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1534:
+	case 1642:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8988
+//line mysql_sql.y:9697
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1535:
+	case 1643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8993
+//line mysql_sql.y:9702
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1536:
+	case 1644:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:8999
+//line mysql_sql.y:9708
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
 		}
 		yyVAL.union = yyLOCAL
-	case 1537:
+	case 1645:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9003
+//line mysql_sql.y:9712
 		{
 			str := fmt.Sprintf("%v", yyDollar[1].item)
 			switch v := yyDollar[1].item.(type) {
@@ -19911,51 +21854,51 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1538:
+	case 1646:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9016
+//line mysql_sql.y:9725
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
 		}
 		yyVAL.union = yyLOCAL
-	case 1539:
+	case 1647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9021
+//line mysql_sql.y:9730
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1540:
+	case 1648:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9025
+//line mysql_sql.y:9734
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
 		}
 		yyVAL.union = yyLOCAL
-	case 1541:
+	case 1649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9029
+//line mysql_sql.y:9738
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
 		}
 		yyVAL.union = yyLOCAL
-	case 1542:
+	case 1650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9033
+//line mysql_sql.y:9742
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_hexnum)
 		}
 		yyVAL.union = yyLOCAL
-	case 1543:
+	case 1651:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9037
+//line mysql_sql.y:9746
 		{
 			if strings.HasPrefix(yyDollar[2].str, "0x") {
 				yyDollar[2].str = yyDollar[2].str[2:]
@@ -19963,18 +21906,18 @@ yydefault:
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_bit)
 		}
 		yyVAL.union = yyLOCAL
-	case 1544:
+	case 1652:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9044
+//line mysql_sql.y:9753
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
 		}
 		yyVAL.union = yyLOCAL
-	case 1545:
+	case 1653:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9048
+//line mysql_sql.y:9757
 		{
 			switch v := yyDollar[1].item.(type) {
 			case uint64:
@@ -19989,53 +21932,53 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1546:
+	case 1654:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9062
+//line mysql_sql.y:9771
 		{
 			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
 		}
 		yyVAL.union = yyLOCAL
-	case 1547:
+	case 1655:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:9066
+//line mysql_sql.y:9775
 		{
 			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_ScoreBinary)
 		}
 		yyVAL.union = yyLOCAL
-	case 1548:
+	case 1656:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9073
+//line mysql_sql.y:9782
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1552:
+	case 1660:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9084
+//line mysql_sql.y:9793
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1553:
+	case 1661:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9089
+//line mysql_sql.y:9798
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 1554:
+	case 1662:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9095
+//line mysql_sql.y:9804
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20048,10 +21991,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1555:
+	case 1663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9107
+//line mysql_sql.y:9816
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20064,10 +22007,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1556:
+	case 1664:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9119
+//line mysql_sql.y:9828
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20080,10 +22023,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1557:
+	case 1665:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9131
+//line mysql_sql.y:9840
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20097,10 +22040,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1558:
+	case 1666:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9144
+//line mysql_sql.y:9853
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20114,10 +22057,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1559:
+	case 1667:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9157
+//line mysql_sql.y:9866
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20131,10 +22074,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1560:
+	case 1668:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9170
+//line mysql_sql.y:9879
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20148,10 +22091,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1561:
+	case 1669:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9183
+//line mysql_sql.y:9892
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20165,10 +22108,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1562:
+	case 1670:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9196
+//line mysql_sql.y:9905
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20182,10 +22125,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1563:
+	case 1671:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9209
+//line mysql_sql.y:9918
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20199,10 +22142,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1564:
+	case 1672:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9222
+//line mysql_sql.y:9931
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20216,10 +22159,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1565:
+	case 1673:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9235
+//line mysql_sql.y:9944
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20233,10 +22176,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1566:
+	case 1674:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9248
+//line mysql_sql.y:9957
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20250,10 +22193,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1567:
+	case 1675:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9261
+//line mysql_sql.y:9970
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20267,10 +22210,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1568:
+	case 1676:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9276
+//line mysql_sql.y:9985
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -20298,10 +22241,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1569:
+	case 1677:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9303
+//line mysql_sql.y:10012
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -20343,10 +22286,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1570:
+	case 1678:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9345
+//line mysql_sql.y:10054
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Scale != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Scale > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -20383,10 +22326,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1571:
+	case 1679:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9381
+//line mysql_sql.y:10090
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Scale != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Scale > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -20423,10 +22366,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1572:
+	case 1680:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9417
+//line mysql_sql.y:10126
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20442,10 +22385,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1573:
+	case 1681:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9434
+//line mysql_sql.y:10143
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20458,10 +22401,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1574:
+	case 1682:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9446
+//line mysql_sql.y:10155
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -20482,10 +22425,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1575:
+	case 1683:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9466
+//line mysql_sql.y:10175
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -20506,10 +22449,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1576:
+	case 1684:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9486
+//line mysql_sql.y:10195
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -20530,10 +22473,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1577:
+	case 1685:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9506
+//line mysql_sql.y:10215
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20548,10 +22491,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1578:
+	case 1686:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9522
+//line mysql_sql.y:10231
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20565,10 +22508,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1579:
+	case 1687:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9535
+//line mysql_sql.y:10244
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20582,10 +22525,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1580:
+	case 1688:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9548
+//line mysql_sql.y:10257
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20599,10 +22542,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1581:
+	case 1689:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9561
+//line mysql_sql.y:10270
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20616,10 +22559,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1582:
+	case 1690:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9574
+//line mysql_sql.y:10283
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20632,10 +22575,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1583:
+	case 1691:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9586
+//line mysql_sql.y:10295
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20648,10 +22591,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1584:
+	case 1692:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9598
+//line mysql_sql.y:10307
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20664,10 +22607,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1585:
+	case 1693:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9610
+//line mysql_sql.y:10319
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20680,10 +22623,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1586:
+	case 1694:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9622
+//line mysql_sql.y:10331
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20696,10 +22639,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1587:
+	case 1695:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9634
+//line mysql_sql.y:10343
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20712,10 +22655,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1588:
+	case 1696:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9646
+//line mysql_sql.y:10355
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20728,10 +22671,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1589:
+	case 1697:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9658
+//line mysql_sql.y:10367
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20744,10 +22687,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1590:
+	case 1698:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9670
+//line mysql_sql.y:10379
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20760,10 +22703,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1591:
+	case 1699:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9682
+//line mysql_sql.y:10391
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20777,10 +22720,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1592:
+	case 1700:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9695
+//line mysql_sql.y:10404
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20794,10 +22737,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1593:
+	case 1701:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9708
+//line mysql_sql.y:10417
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20811,20 +22754,54 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1594:
+	case 1702:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:10430
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.ArrayFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+					Oid:          uint32(defines.MYSQL_TYPE_VECF32),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1703:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line mysql_sql.y:10443
+		{
+			locale := ""
+			yyLOCAL = &tree.T{
+				InternalType: tree.InternalType{
+					Family:       tree.ArrayFamily,
+					FamilyString: yyDollar[1].str,
+					Locale:       &locale,
+					DisplayWith:  yyDollar[2].lengthOptUnion(),
+					Oid:          uint32(defines.MYSQL_TYPE_VECF64),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 1704:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:9723
+//line mysql_sql.y:10458
 		{
 			yyLOCAL = &tree.Do{
 				Exprs: yyDollar[2].exprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1595:
+	case 1705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:9731
+//line mysql_sql.y:10466
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -20833,10 +22810,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1596:
+	case 1706:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:9740
+//line mysql_sql.y:10475
 		{
 			yyLOCAL = &tree.Declare{
 				Variables:  yyDollar[2].strsUnion(),
@@ -20845,10 +22822,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1597:
+	case 1707:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:9750
+//line mysql_sql.y:10485
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -20861,75 +22838,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1598:
+	case 1708:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:9773
+//line mysql_sql.y:10508
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1599:
+	case 1709:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:9778
+//line mysql_sql.y:10513
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 1600:
+	case 1710:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:9784
+//line mysql_sql.y:10519
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1602:
+	case 1712:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:9791
+//line mysql_sql.y:10526
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 1603:
+	case 1713:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:9795
+//line mysql_sql.y:10530
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1604:
+	case 1714:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:9800
+//line mysql_sql.y:10535
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 1605:
+	case 1715:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:9804
+//line mysql_sql.y:10539
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 1606:
+	case 1716:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:9810
+//line mysql_sql.y:10545
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 1607:
+	case 1717:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:9816
+//line mysql_sql.y:10551
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -20937,10 +22914,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1608:
+	case 1718:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:9823
+//line mysql_sql.y:10558
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -20948,10 +22925,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1609:
+	case 1719:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:9830
+//line mysql_sql.y:10565
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -20959,10 +22936,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1610:
+	case 1720:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:9839
+//line mysql_sql.y:10574
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 38, // this is the default precision for decimal
@@ -20970,10 +22947,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1611:
+	case 1721:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:9846
+//line mysql_sql.y:10581
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -20981,10 +22958,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1612:
+	case 1722:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:9853
+//line mysql_sql.y:10588
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -20992,52 +22969,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 1613:
+	case 1723:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:9862
+//line mysql_sql.y:10597
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1614:
+	case 1724:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:9866
+//line mysql_sql.y:10601
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1615:
+	case 1725:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:9870
+//line mysql_sql.y:10605
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 1616:
+	case 1726:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:9876
+//line mysql_sql.y:10611
 		{
 		}
-	case 1617:
+	case 1727:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:9878
+//line mysql_sql.y:10613
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 1621:
+	case 1731:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:9888
+//line mysql_sql.y:10623
 		{
 			yyVAL.str = ""
 		}
-	case 1622:
+	case 1732:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:9892
+//line mysql_sql.y:10627
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
@@ -68,8 +68,24 @@ import (
     procArg tree.ProcedureArg
     procArgs tree.ProcedureArgs
     procArgDecl *tree.ProcedureArgDecl
+
+    eventName *tree.EventName
+    eventSchedule *tree.EventSchedule
+    eventCompletion tree.EventCompletion
+    eventStatus tree.EventStatus
+    eventComment tree.EventComment
+    triggerName *tree.TriggerName
+    triggerTime tree.TriggerTime
+    triggerEvent tree.TriggerEvent
+    triggerOrder *tree.TriggerOrder
     procArgType tree.InOutArgType
 
+    jsonTable *tree.JsonTable
+    jsonTableColumn *tree.JsonTableColumn
+    jsonTableColumns []*tree.JsonTableColumn
+    jsonTableOnResponse *tree.JsonTableOnResponse
+    jsonTableOnResponses [2]*tree.JsonTableOnResponse
+
     from *tree.From
     where *tree.Where
     groupBy tree.GroupBy
@@ -112,6 +128,7 @@ import (
     createOptions []tree.CreateOption
     indexType tree.IndexType
     indexCategory tree.IndexCategory
+    fullTextSearchType tree.FullTextSearchType
     keyParts []*tree.KeyPart
     keyPart *tree.KeyPart
     indexOption *tree.IndexOption
@@ -243,7 +260,7 @@ import (
 }
 
 %token LEX_ERROR
-%nonassoc EMPTY
+%nonassoc <str> EMPTY
 %left <str> UNION EXCEPT INTERSECT MINUS
 %nonassoc LOWER_THAN_ORDER
 %nonassoc ORDER
@@ -300,7 +317,7 @@ import (
 %token <str> TIME TIMESTAMP DATETIME YEAR
 %token <str> CHAR VARCHAR BOOL CHARACTER VARBINARY NCHAR
 %token <str> TEXT TINYTEXT MEDIUMTEXT LONGTEXT
-%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID
+%token <str> BLOB TINYBLOB MEDIUMBLOB LONGBLOB JSON ENUM UUID VECF32 VECF64
 %token <str> GEOMETRY POINT LINESTRING POLYGON GEOMETRYCOLLECTION MULTIPOINT MULTILINESTRING MULTIPOLYGON
 %token <str> INT1 INT2 INT3 INT4 INT8 S3OPTION
 
@@ -312,7 +329,7 @@ import (
 %token <str> CREATE ALTER DROP RENAME ANALYZE ADD RETURNS
 %token <str> SCHEMA TABLE SEQUENCE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
-%token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
+%token <str> MAXVALUE PARTITION REORGANIZE EXCHANGE LESS THAN PROCEDURE TRIGGER
 %token <str> STATUS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
 %token <str> CHECKSUM COMPRESSION DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
@@ -333,8 +350,9 @@ import (
 %token <str> PROPERTIES
 
 // Index
-%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI
-%token <str> ZONEMAP LEADING BOTH TRAILING UNKNOWN
+%token <str> PARSER VISIBLE INVISIBLE BTREE HASH RTREE BSI IVFFLAT
+%token <str> LISTS OP_TYPE
+%token <str> ZONEMAP LEADING BOTH TRAILING UNKNOWN LATERAL
 
 // Alter
 %token <str> EXPIRE ACCOUNT ACCOUNTS UNLOCK DAY NEVER PUMP MYSQL_COMPATIBILITY_MODE
@@ -398,6 +416,9 @@ import (
 // Stream
 %token <str> SOURCE STREAM HEADERS CONNECTOR
 
+// Explain
+%token <str> DOT
+
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION WITHOUT VALIDATION
 
@@ -407,7 +428,7 @@ import (
 %token <str> GROUP_CONCAT MAX MID MIN NOW POSITION SESSION_USER STD STDDEV MEDIAN
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
 %token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG RANK ROW_NUMBER
-%token <str> DENSE_RANK
+%token <str> DENSE_RANK LAG LEAD FIRST_VALUE LAST_VALUE NTH_VALUE NTILE PERCENT_RANK CUME_DIST
 
 // Sequence function
 %token <str> NEXTVAL SETVAL CURRVAL LASTVAL
@@ -470,6 +491,7 @@ import (
 %type <statement> load_extension_stmt
 %type <statement> kill_stmt
 %type <statement> backup_stmt
+%type <str> backup_incremental_opt
 %type <rowsExprs> row_constructor_list
 %type <exprs>  row_constructor
 %type <exportParm> export_data_param_opt
@@ -491,6 +513,11 @@ import (
 %type <statement> loop_stmt iterate_stmt leave_stmt repeat_stmt while_stmt
 %type <statement> create_publication_stmt drop_publication_stmt alter_publication_stmt show_publications_stmt show_subscriptions_stmt
 %type <statement> create_stage_stmt drop_stage_stmt alter_stage_stmt
+%type <statement> create_event_stmt drop_event_stmt alter_event_stmt
+%type <statement> create_trigger_stmt drop_trigger_stmt trigger_body
+%type <statement> create_mview_stmt refresh_mview_stmt drop_mview_stmt
+%type <eventSchedule> mview_refresh_opt
+%type <boolVal> mview_refresh_mode_opt
 %type <str> urlparams
 %type <str> comment_opt view_list_opt view_opt security_opt view_tail check_type
 %type <subscriptionOption> subcription_opt
@@ -524,7 +551,22 @@ import (
 %type <procArgs> proc_args_list_opt proc_args_list
 %type <procArg> proc_arg
 %type <procArgDecl> proc_arg_decl
+%type <eventName> event_name event_rename_opt
+%type <eventSchedule> event_schedule
+%type <eventCompletion> event_completion_opt event_completion
+%type <eventStatus> event_status_opt
+%type <eventComment> event_comment_opt
+%type <triggerName> trigger_name
+%type <triggerTime> trigger_time
+%type <triggerEvent> trigger_event
+%type <triggerOrder> trigger_order_opt
+%type <statement> event_body_opt
 %type <procArgType> proc_arg_in_out_type
+%type <jsonTable> json_table_spec
+%type <jsonTableColumn> json_table_column
+%type <jsonTableColumns> json_table_column_list
+%type <jsonTableOnResponse> json_table_on_response
+%type <jsonTableOnResponses> json_table_on_response_opt
 
 %type <tableDefs> table_elem_list_opt table_elem_list
 %type <tableDef> table_elem constaint_def constraint_elem index_def table_elem_2
@@ -545,7 +587,7 @@ import (
 %type <referenceOnRecord> on_delete_update_opt
 %type <attributeReference> references_def
 %type <alterTableOptions> alter_option_list
-%type <alterTableOption> alter_option alter_table_drop alter_table_alter alter_table_rename
+%type <alterTableOption> alter_option alter_table_drop alter_table_alter alter_table_rename alter_partition_option
 %type <alterColPosition> column_position
 %type <alterColumnOrder> alter_column_order
 %type <alterColumnOrderBy> alter_column_order_list
@@ -601,6 +643,7 @@ import (
 %type <str> database_or_schema
 %type <indexType> using_opt
 %type <indexCategory> index_prefix
+%type <fullTextSearchType> fulltext_search_opt
 %type <keyParts> index_column_list index_column_list_opt
 %type <keyPart> index_column
 %type <indexOption> index_option_list index_option
@@ -727,7 +770,11 @@ import (
 
 %token <str> KILL
 %type <killOption> kill_opt
-%token <str> BACKUP FILESYSTEM
+%token <str> BACKUP FILESYSTEM INCREMENTAL
+%token <str> SCHEDULE EVERY STARTS ENDS AT COMPLETION PRESERVE
+%token <str> BEFORE EACH FOLLOWS PRECEDES
+%token <str> MATERIALIZED REFRESH
+%token <str> JSON_TABLE NESTED PATH ORDINALITY ERROR
 %type <statementOption> statement_id_opt
 %token <str> QUERY_RESULT
 %start start_command
@@ -850,25 +897,37 @@ normal_stmt:
     }
 |   kill_stmt
 |   backup_stmt
+|   refresh_mview_stmt
 
 backup_stmt:
-    BACKUP STRING FILESYSTEM STRING
+    BACKUP STRING FILESYSTEM STRING backup_incremental_opt
 	{
 		$$ = &tree.BackupStart{
 		    Timestamp: $2,
 		    IsS3 : false,
 		    Dir: $4,
+		    Parent: $5,
 		}
 	}
-    | BACKUP STRING S3OPTION '{' infile_or_s3_params '}'
+    | BACKUP STRING S3OPTION '{' infile_or_s3_params '}' backup_incremental_opt
     {
     	$$ = &tree.BackupStart{
         	    Timestamp: $2,
         	    IsS3 : true,
         	    Option : $5,
+        	    Parent: $7,
         	}
     }
 
+backup_incremental_opt:
+    {
+        $$ = ""
+    }
+|   INCREMENTAL FROM STRING
+    {
+        $$ = $3
+    }
+
 kill_stmt:
     KILL kill_opt INTEGRAL statement_id_opt
     {
@@ -2552,6 +2611,7 @@ explain_option_key:
 explain_foramt_value:
     JSON
 |   TEXT
+|   DOT
 
 
 prepare_sym:
@@ -2613,6 +2673,7 @@ alter_stmt:
 |   alter_table_stmt
 |   alter_publication_stmt
 |   alter_stage_stmt
+|   alter_event_stmt
 // |    alter_ddl_stmt
 
 alter_view_stmt:
@@ -2634,6 +2695,75 @@ alter_table_stmt:
             Options: $4,
         }
     }
+|   ALTER TABLE table_name alter_partition_option
+    {
+        $$ = &tree.AlterTable{
+            Table: $3,
+            Options: []tree.AlterTableOption{$4},
+        }
+    }
+
+alter_partition_option:
+    ADD PARTITION '(' partition_list ')'
+    {
+        $$ = &tree.AlterPartitionOption{
+            Typ: tree.AlterPartitionAdd,
+            Partitions: $4,
+        }
+    }
+|   DROP PARTITION partition_id_list
+    {
+        $$ = &tree.AlterPartitionOption{
+            Typ: tree.AlterPartitionDrop,
+            PartitionNames: $3,
+        }
+    }
+|   TRUNCATE PARTITION partition_id_list
+    {
+        $$ = &tree.AlterPartitionOption{
+            Typ: tree.AlterPartitionTruncate,
+            PartitionNames: $3,
+        }
+    }
+|   TRUNCATE PARTITION ALL
+    {
+        $$ = &tree.AlterPartitionOption{
+            Typ: tree.AlterPartitionTruncate,
+        }
+    }
+|   REORGANIZE PARTITION partition_id_list INTO '(' partition_list ')'
+    {
+        $$ = &tree.AlterPartitionOption{
+            Typ: tree.AlterPartitionReorganize,
+            PartitionNames: $3,
+            Partitions: $6,
+        }
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name
+    {
+        $$ = &tree.AlterPartitionOption{
+            Typ: tree.AlterPartitionExchange,
+            PartitionNames: tree.IdentifierList{tree.Identifier($3.Compare())},
+            Table: $6,
+        }
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name WITH VALIDATION
+    {
+        $$ = &tree.AlterPartitionOption{
+            Typ: tree.AlterPartitionExchange,
+            PartitionNames: tree.IdentifierList{tree.Identifier($3.Compare())},
+            Table: $6,
+        }
+    }
+|   EXCHANGE PARTITION ident WITH TABLE table_name WITHOUT VALIDATION
+    {
+        $$ = &tree.AlterPartitionOption{
+            Typ: tree.AlterPartitionExchange,
+            PartitionNames: tree.IdentifierList{tree.Identifier($3.Compare())},
+            Table: $6,
+            WithoutValidation: true,
+        }
+    }
 
 alter_option_list:
     alter_option
@@ -2909,6 +3039,20 @@ alter_table_drop:
             Typ:  tree.AlterTableDropPrimaryKey,
         }
     }
+|   CHECK ident
+    {
+        $$ = &tree.AlterOptionDrop{
+            Typ:  tree.AlterTableDropCheck,
+            Name: tree.Identifier($2.Compare()),
+        }
+    }
+|   CONSTRAINT ident
+    {
+        $$ = &tree.AlterOptionDrop{
+            Typ:  tree.AlterTableDropConstraint,
+            Name: tree.Identifier($2.Compare()),
+        }
+    }
 
 alter_table_alter:
     INDEX ident visibility
@@ -3616,6 +3760,9 @@ drop_ddl_stmt:
 |   drop_publication_stmt
 |   drop_procedure_stmt
 |   drop_stage_stmt
+|   drop_event_stmt
+|   drop_trigger_stmt
+|   drop_mview_stmt
 
 drop_sequence_stmt:
     DROP SEQUENCE exists_opt table_name_list
@@ -4896,6 +5043,17 @@ table_factor:
             },
         }
     }
+|   LATERAL table_subquery as_opt_id column_list_opt
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $2,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($3),
+                Cols: $4,
+            },
+            Lateral: true,
+        }
+    }
 |   table_function as_opt_id
     {
         if $2 != "" {
@@ -4932,6 +5090,114 @@ table_function:
             },
         }
     }
+|   JSON_TABLE '(' expression ',' json_table_spec ')'
+    {
+        name := tree.SetUnresolvedName("json_table")
+        $$ = &tree.TableFunction{
+            Func: &tree.FuncExpr{
+                Func: tree.FuncName2ResolvableFunctionReference(name),
+                Exprs: tree.Exprs{$3},
+                Type: tree.FUNC_TYPE_TABLE,
+            },
+            JsonTable: $5,
+        }
+    }
+
+json_table_spec:
+    STRING COLUMNS '(' json_table_column_list ')'
+    {
+        $$ = &tree.JsonTable{
+            Path: $1,
+            Columns: $4,
+        }
+    }
+
+json_table_column_list:
+    json_table_column
+    {
+        $$ = []*tree.JsonTableColumn{$1}
+    }
+|   json_table_column_list ',' json_table_column
+    {
+        $$ = append($1, $3)
+    }
+
+json_table_column:
+    ident FOR ORDINALITY
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JsonTableColumnOrdinality,
+            Name: tree.Identifier($1.Compare()),
+        }
+    }
+|   ident column_type PATH STRING json_table_on_response_opt
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JsonTableColumnPath,
+            Name: tree.Identifier($1.Compare()),
+            Type: $2,
+            Path: $4,
+            OnEmpty: $5[0],
+            OnError: $5[1],
+        }
+    }
+|   ident column_type EXISTS PATH STRING
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JsonTableColumnExists,
+            Name: tree.Identifier($1.Compare()),
+            Type: $2,
+            Path: $5,
+        }
+    }
+|   NESTED PATH json_table_spec
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JsonTableColumnNested,
+            Nested: $3,
+        }
+    }
+|   NESTED json_table_spec
+    {
+        $$ = &tree.JsonTableColumn{
+            Kind: tree.JsonTableColumnNested,
+            Nested: $2,
+        }
+    }
+
+json_table_on_response_opt:
+    {
+        $$ = [2]*tree.JsonTableOnResponse{}
+    }
+|   json_table_on_response ON EMPTY
+    {
+        $$ = [2]*tree.JsonTableOnResponse{$1, nil}
+    }
+|   json_table_on_response ON ERROR
+    {
+        $$ = [2]*tree.JsonTableOnResponse{nil, $1}
+    }
+|   json_table_on_response ON EMPTY json_table_on_response ON ERROR
+    {
+        $$ = [2]*tree.JsonTableOnResponse{$1, $4}
+    }
+
+json_table_on_response:
+    NULL
+    {
+        $$ = &tree.JsonTableOnResponse{Kind: tree.JsonTableOnResponseNull}
+    }
+|   ERROR
+    {
+        $$ = &tree.JsonTableOnResponse{Kind: tree.JsonTableOnResponseError}
+    }
+|   DEFAULT STRING
+    {
+        $$ = &tree.JsonTableOnResponse{
+            Kind: tree.JsonTableOnResponseDefault,
+            Value: $2,
+        }
+    }
 
 aliased_table_name:
     table_name as_opt_id index_hint_list_opt
@@ -5087,6 +5353,9 @@ create_stmt:
 |   create_account_stmt
 |   create_publication_stmt
 |   create_stage_stmt
+|   create_event_stmt
+|   create_trigger_stmt
+|   create_mview_stmt
 
 create_ddl_stmt:
     create_table_stmt
@@ -5645,6 +5914,314 @@ DROP STAGE exists_opt ident
         }
     }
 
+create_event_stmt:
+    CREATE EVENT not_exists_opt event_name ON SCHEDULE event_schedule event_completion_opt event_status_opt event_comment_opt DO normal_stmt
+    {
+        $$ = &tree.CreateEvent{
+            IfNotExists: $3,
+            Name: $4,
+            Schedule: $7,
+            Completion: $8,
+            Status: $9,
+            Comment: $10,
+            Body: $12,
+        }
+    }
+
+alter_event_stmt:
+    ALTER EVENT event_name event_rename_opt event_status_opt event_comment_opt event_body_opt
+    {
+        $$ = &tree.AlterEvent{
+            Name: $3,
+            RenameTo: $4,
+            Status: $5,
+            Comment: $6,
+            Body: $7,
+        }
+    }
+|   ALTER EVENT event_name event_completion event_rename_opt event_status_opt event_comment_opt event_body_opt
+    {
+        $$ = &tree.AlterEvent{
+            Name: $3,
+            Completion: $4,
+            RenameTo: $5,
+            Status: $6,
+            Comment: $7,
+            Body: $8,
+        }
+    }
+|   ALTER EVENT event_name ON SCHEDULE event_schedule event_completion_opt event_rename_opt event_status_opt event_comment_opt event_body_opt
+    {
+        $$ = &tree.AlterEvent{
+            Name: $3,
+            Schedule: $6,
+            Completion: $7,
+            RenameTo: $8,
+            Status: $9,
+            Comment: $10,
+            Body: $11,
+        }
+    }
+
+drop_event_stmt:
+    DROP EVENT exists_opt event_name
+    {
+        $$ = &tree.DropEvent{
+            IfExists: $3,
+            Name: $4,
+        }
+    }
+
+create_trigger_stmt:
+    CREATE TRIGGER not_exists_opt trigger_name trigger_time trigger_event ON table_name FOR EACH ROW trigger_order_opt trigger_body
+    {
+        $$ = &tree.CreateTrigger{
+            IfNotExists: $3,
+            Name: $4,
+            Time: $5,
+            Event: $6,
+            Table: $8,
+            Order: $12,
+            Body: $13,
+        }
+    }
+
+trigger_time:
+    BEFORE
+    {
+        $$ = tree.TriggerTimeBefore
+    }
+|   AFTER
+    {
+        $$ = tree.TriggerTimeAfter
+    }
+
+trigger_event:
+    INSERT
+    {
+        $$ = tree.TriggerEventInsert
+    }
+|   UPDATE
+    {
+        $$ = tree.TriggerEventUpdate
+    }
+|   DELETE
+    {
+        $$ = tree.TriggerEventDelete
+    }
+
+trigger_order_opt:
+    {
+        $$ = nil
+    }
+|   FOLLOWS ident
+    {
+        $$ = &tree.TriggerOrder{
+            Follows: true,
+            Name: tree.Identifier($2.Compare()),
+        }
+    }
+|   PRECEDES ident
+    {
+        $$ = &tree.TriggerOrder{
+            Name: tree.Identifier($2.Compare()),
+        }
+    }
+
+trigger_body:
+    block_stmt
+|   if_stmt
+|   case_stmt
+|   normal_stmt
+
+drop_trigger_stmt:
+    DROP TRIGGER exists_opt trigger_name
+    {
+        $$ = &tree.DropTrigger{
+            IfExists: $3,
+            Name: $4,
+        }
+    }
+
+create_mview_stmt:
+    CREATE MATERIALIZED VIEW not_exists_opt table_name mview_refresh_opt AS select_stmt
+    {
+        $$ = &tree.CreateMaterializedView{
+            IfNotExists: $4,
+            Name: $5,
+            Refresh: $6,
+            AsSource: $8,
+        }
+    }
+
+mview_refresh_opt:
+    {
+        $$ = nil
+    }
+|   REFRESH EVERY expression time_unit
+    {
+        $$ = &tree.EventSchedule{
+            Every: $3,
+            Unit: $4,
+        }
+    }
+
+refresh_mview_stmt:
+    REFRESH MATERIALIZED VIEW table_name mview_refresh_mode_opt
+    {
+        $$ = &tree.RefreshMaterializedView{
+            Name: $4,
+            Incremental: $5,
+        }
+    }
+
+mview_refresh_mode_opt:
+    {
+        $$ = false
+    }
+|   FULL
+    {
+        $$ = false
+    }
+|   INCREMENTAL
+    {
+        $$ = true
+    }
+
+drop_mview_stmt:
+    DROP MATERIALIZED VIEW exists_opt table_name
+    {
+        $$ = &tree.DropMaterializedView{
+            IfExists: $4,
+            Name: $5,
+        }
+    }
+
+trigger_name:
+    ident
+    {
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        $$ = tree.NewTriggerName(tree.Identifier($1.Compare()), prefix)
+    }
+|   ident '.' ident
+    {
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier($1.Compare()), ExplicitSchema: true}
+        $$ = tree.NewTriggerName(tree.Identifier($3.Compare()), prefix)
+    }
+
+event_name:
+    ident
+    {
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        $$ = tree.NewEventName(tree.Identifier($1.Compare()), prefix)
+    }
+|   ident '.' ident
+    {
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier($1.Compare()), ExplicitSchema: true}
+        $$ = tree.NewEventName(tree.Identifier($3.Compare()), prefix)
+    }
+
+event_schedule:
+    AT expression
+    {
+        $$ = &tree.EventSchedule{
+            At: $2,
+        }
+    }
+|   EVERY expression time_unit
+    {
+        $$ = &tree.EventSchedule{
+            Every: $2,
+            Unit: $3,
+        }
+    }
+|   EVERY expression time_unit STARTS expression
+    {
+        $$ = &tree.EventSchedule{
+            Every: $2,
+            Unit: $3,
+            Starts: $5,
+        }
+    }
+|   EVERY expression time_unit ENDS expression
+    {
+        $$ = &tree.EventSchedule{
+            Every: $2,
+            Unit: $3,
+            Ends: $5,
+        }
+    }
+|   EVERY expression time_unit STARTS expression ENDS expression
+    {
+        $$ = &tree.EventSchedule{
+            Every: $2,
+            Unit: $3,
+            Starts: $5,
+            Ends: $7,
+        }
+    }
+
+event_completion_opt:
+    {
+        $$ = tree.EventCompletionUnspecified
+    }
+|   event_completion
+
+event_completion:
+    ON COMPLETION PRESERVE
+    {
+        $$ = tree.EventCompletionPreserve
+    }
+|   ON COMPLETION NOT PRESERVE
+    {
+        $$ = tree.EventCompletionNotPreserve
+    }
+
+event_rename_opt:
+    {
+        $$ = nil
+    }
+|   RENAME TO event_name
+    {
+        $$ = $3
+    }
+
+event_status_opt:
+    {
+        $$ = tree.EventStatusUnspecified
+    }
+|   ENABLE
+    {
+        $$ = tree.EventStatusEnable
+    }
+|   DISABLE
+    {
+        $$ = tree.EventStatusDisable
+    }
+
+event_comment_opt:
+    {
+        $$ = tree.EventComment{
+            Exist: false,
+        }
+    }
+|   COMMENT_KEYWORD STRING
+    {
+        $$ = tree.EventComment{
+            Exist: true,
+            Comment: $2,
+        }
+    }
+
+event_body_opt:
+    {
+        $$ = nil
+    }
+|   DO normal_stmt
+    {
+        $$ = $2
+    }
+
 account_role_name:
     ident
     {
@@ -5900,6 +6477,10 @@ role_name:
 	{
 		$$ = tree.NewCStr($1, yylex.(*Lexer).lower)
     }
+|   not_keyword
+	{
+		$$ = tree.NewCStr($1, yylex.(*Lexer).lower)
+    }
 |   QUOTE_ID
 	{
 		$$ = tree.NewCStr($1, yylex.(*Lexer).lower)
@@ -5966,6 +6547,10 @@ index_option_list:
                 opt1.ParserName = opt2.ParserName
             } else if opt2.Visible != tree.VISIBLE_TYPE_INVALID {
                 opt1.Visible = opt2.Visible
+            } else if opt2.AlgoParamList > 0 {
+                opt1.AlgoParamList = opt2.AlgoParamList
+            } else if len(opt2.AlgoParamVectorOpType) > 0 {
+                opt1.AlgoParamVectorOpType = opt2.AlgoParamVectorOpType
             }
             $$ = opt1
         }
@@ -5992,6 +6577,14 @@ index_option:
     {
         $$ = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
     }
+|   LISTS equal_opt INTEGRAL
+    {
+        $$ = &tree.IndexOption{AlgoParamList: $3.(int64)}
+    }
+|   OP_TYPE STRING
+    {
+        $$ = &tree.IndexOption{AlgoParamVectorOpType: $2}
+    }
 
 index_column_list:
     index_column
@@ -6034,6 +6627,10 @@ using_opt:
     {
         $$ = tree.INDEX_TYPE_BSI
     }
+|   USING IVFFLAT
+    {
+        $$ = tree.INDEX_TYPE_IVFFLAT
+    }
 
 create_database_stmt:
     CREATE database_or_schema not_exists_opt ident subcription_opt create_option_list_opt
@@ -6216,6 +6813,16 @@ load_param_opt:
             },
         }
     }
+|   INFILE STRING FORMAT STRING
+    {
+        $$ = &tree.ExternParam{
+            ExParamConst: tree.ExParamConst{
+                Filepath: $2,
+                CompressType: tree.AUTO,
+                Format: strings.ToLower($4),
+            },
+        }
+    }
 |   INFILE '{' infile_or_s3_params '}'
     {
         $$ = &tree.ExternParam{
@@ -7058,6 +7665,8 @@ index_def:
                 keyTyp = tree.INDEX_TYPE_ZONEMAP
             case "bsi":
                 keyTyp = tree.INDEX_TYPE_BSI
+            case "ivfflat":
+                keyTyp = tree.INDEX_TYPE_IVFFLAT
             default:
                 yylex.Error("Invail the type of index")
                 return 1
@@ -7081,6 +7690,8 @@ index_def:
                 keyTyp = tree.INDEX_TYPE_ZONEMAP
             case "bsi":
                 keyTyp = tree.INDEX_TYPE_BSI
+            case "ivfflat":
+                keyTyp = tree.INDEX_TYPE_IVFFLAT
             default:
                 yylex.Error("Invail the type of index")
                 return 1
@@ -7106,6 +7717,8 @@ constaint_def:
                 v.ConstraintSymbol = $1
             case *tree.UniqueIndex:
                 v.ConstraintSymbol = $1
+            case *tree.CheckIndex:
+                v.Name = $1
             }
         }
         $$ = $2
@@ -7172,7 +7785,7 @@ constraint_elem:
 
 enforce_opt:
     {
-        $$ = false
+        $$ = true
     }
 |    enforce
 
@@ -7215,6 +7828,7 @@ index_type:
 |   RTREE
 |   ZONEMAP
 |   BSI
+|   IVFFLAT
 
 insert_method_options:
     NO
@@ -7357,7 +7971,7 @@ column_attribute_elem:
     }
 |   constraint_keyword_opt CHECK '(' expression ')'
     {
-        $$ = tree.NewAttributeCheck($4, false, $1)
+        $$ = tree.NewAttributeCheck($4, true, $1)
     }
 |   constraint_keyword_opt CHECK '(' expression ')' enforce
     {
@@ -7717,6 +8331,31 @@ simple_expr:
     {
         $$ = $1
     }
+|   MATCH '(' column_name_list ')' AGAINST '(' bit_expr fulltext_search_opt ')'
+    {
+        $$ = tree.NewFullTextMatchExpr($3, $7, $8)
+    }
+
+fulltext_search_opt:
+    {
+        $$ = tree.FULLTEXT_NL
+    }
+|   IN NATURAL LANGUAGE MODE
+    {
+        $$ = tree.FULLTEXT_NL
+    }
+|   IN NATURAL LANGUAGE MODE WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_NL_QUERY_EXPANSION
+    }
+|   IN BOOLEAN MODE
+    {
+        $$ = tree.FULLTEXT_BOOLEAN
+    }
+|   WITH QUERY EXPANSION
+    {
+        $$ = tree.FULLTEXT_QUERY_EXPANSION
+    }
 
 function_call_window:
 	RANK '(' ')' window_spec
@@ -7743,6 +8382,76 @@ function_call_window:
             WindowSpec: $4,
         }
     }
+|	PERCENT_RANK '(' ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            WindowSpec: $4,
+        }
+    }
+|	CUME_DIST '(' ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            WindowSpec: $4,
+        }
+    }
+|	NTILE '(' expression_list ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|	LAG '(' expression_list ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|	LEAD '(' expression_list ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|	FIRST_VALUE '(' expression_list ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|	LAST_VALUE '(' expression_list ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
+|	NTH_VALUE '(' expression_list ')' window_spec
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+            WindowSpec: $5,
+        }
+    }
 
 else_opt:
     {
@@ -9718,6 +10427,32 @@ char_type:
     },
     }
 }
+|   VECF32 length_option_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.ArrayFamily,
+                FamilyString: $1,
+                Locale: &locale,
+                DisplayWith: $2,
+                Oid:    uint32(defines.MYSQL_TYPE_VECF32),
+            },
+        }
+    }
+|   VECF64 length_option_opt
+    {
+        locale := ""
+        $$ = &tree.T{
+            InternalType: tree.InternalType{
+                Family: tree.ArrayFamily,
+                FamilyString: $1,
+                Locale: &locale,
+                DisplayWith: $2,
+                Oid:    uint32(defines.MYSQL_TYPE_VECF64),
+            },
+        }
+    }
 
 do_stmt:
     DO expression_list
@@ -10125,6 +10860,7 @@ non_reserved_keyword:
 |   DYNAMIC
 |   DISK
 |   DO
+|   DOT
 |   DOUBLE
 |   DIRECTORY
 |   DUPLICATE
@@ -10133,6 +10869,7 @@ non_reserved_keyword:
 |   ENCRYPTION
 |   ENGINE
 |   EXPANSION
+|   EXCHANGE
 |   EXTENDED
 |   EXPIRE
 |   ERRORS
@@ -10236,6 +10973,12 @@ non_reserved_keyword:
 |   TINYTEXT
 |   TRANSACTION
 |   TRIGGER
+|   BEFORE
+|   EACH
+|   FOLLOWS
+|   PRECEDES
+|   MATERIALIZED
+|   REFRESH
 |   UNCOMMITTED
 |   UNSIGNED
 |   UNUSED
@@ -10275,6 +11018,11 @@ non_reserved_keyword:
 |   NODE
 |   ROLES
 |   UUID
+|   VECF32
+|   VECF64
+|   IVFFLAT
+|   LISTS
+|   OP_TYPE
 |   PARALLEL
 |   INCREMENT
 |   CYCLE
@@ -10291,6 +11039,19 @@ non_reserved_keyword:
 |   STAGES
 |   BACKUP
 | FILESYSTEM
+|   INCREMENTAL
+|   SCHEDULE
+|   EVERY
+|   STARTS
+|   ENDS
+|   AT
+|   COMPLETION
+|   PRESERVE
+|   NESTED
+|   PATH
+|   ORDINALITY
+|   ERROR
+|   EMPTY
 
 func_not_keyword:
     DATE_ADD
@@ -10348,6 +11109,14 @@ not_keyword:
 |   CURRVAL
 |   LASTVAL
 |	HEADERS
+|   LAG
+|   LEAD
+|   FIRST_VALUE
+|   LAST_VALUE
+|   NTH_VALUE
+|   NTILE
+|   PERCENT_RANK
+|   CUME_DIST
 
 //mo_keywords:
 //    PROPERTIES
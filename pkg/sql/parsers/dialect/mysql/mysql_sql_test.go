@@ -107,6 +107,18 @@ var (
 	}, {
 		input:  "select dense_rank() over (partition by col1, col2 order by col3 desc range unbounded preceding) from t1",
 		output: "select dense_rank() over (partition by col1, col2 order by col3 desc range unbounded preceding) from t1",
+	}, {
+		input:  "select lag(a) over (partition by b order by c), lead(a, 2, 0) over (order by c) from t1",
+		output: "select lag(a) over (partition by b order by c), lead(a, 2, 0) over (order by c) from t1",
+	}, {
+		input:  "select first_value(a) over (order by c rows between 1 preceding and 1 following), last_value(a) over (partition by b), nth_value(a, 2) over (partition by b) from t1",
+		output: "select first_value(a) over (order by c rows between 1 preceding and 1 following), last_value(a) over (partition by b), nth_value(a, 2) over (partition by b) from t1",
+	}, {
+		input:  "select ntile(4) over (order by a), percent_rank() over (partition by b order by a), cume_dist() over (order by a) from t1",
+		output: "select ntile(4) over (order by a), percent_rank() over (partition by b order by a), cume_dist() over (order by a) from t1",
+	}, {
+		input:  "select lag, lead, ntile from t1",
+		output: "select lag, lead, ntile from t1",
 	}, {
 		input:  "select day_key,day_date,day,month,quarter,year,week,day_of_week from bi_date where 1=2;",
 		output: "select day_key, day_date, day, month, quarter, year, week, day_of_week from bi_date where 1 = 2",
@@ -267,6 +279,9 @@ var (
 	}, {
 		input:  "explain (analyze true,verbose false,format json) select * from emp",
 		output: "explain (analyze true,verbose false,format json) select * from emp",
+	}, {
+		input:  "explain (verbose true,format dot) select * from emp",
+		output: "explain (verbose true,format dot) select * from emp",
 	}, {
 		input:  "with t11 as (select * from t1) update t11 join t2 on t11.a = t2.b set t11.b = 1 where t2.a > 1",
 		output: "with t11 as (select * from t1) update t11 inner join t2 on t11.a = t2.b set t11.b = 1 where t2.a > 1",
@@ -693,6 +708,9 @@ var (
 		output: "create table t (a int) properties(a = b)",
 	}, {
 		input: "create table t (a int, b char, check (1 + 1) enforced)",
+	}, {
+		input:  "create table t (a int, b char, constraint c1 check (a > 0), check (b <> 'x') not enforced)",
+		output: "create table t (a int, b char, constraint c1 check (a > 0) enforced, check (b != x) not enforced)",
 	}, {
 		input: "create table t (a int, b char, foreign key sdf (a, b) references b(a asc, b desc))",
 	}, {
@@ -715,6 +733,11 @@ var (
 	}, {
 		input:  "create external table t (a int) infile 'data.txt'",
 		output: "create external table t (a int) infile 'data.txt'",
+	}, {
+		input:  "create external table t (a int) infile 'data.parquet' format 'PARQUET'",
+		output: "create external table t (a int) infile 'data.parquet' format 'parquet'",
+	}, {
+		input: "create external table t (a int) infile {'filepath'='data.parquet', 'format'='parquet'}",
 	}, {
 		input: "create external table t (a int) infile {'filepath'='data.txt', 'compression'='none'}",
 	}, {
@@ -755,6 +778,9 @@ var (
 	}, {
 		input:  "SELECT userID as user, MAX(score) as max FROM t1 GROUP BY userID order by user",
 		output: "select userid as user, max(score) as max from t1 group by userid order by user",
+	}, {
+		input:  "load data infile 'test/t.parquet' format 'parquet' into table t",
+		output: "load data infile test/t.parquet format 'parquet' into table t",
 	}, {
 		input:  "load data infile 'test/loadfile5' ignore INTO TABLE T.A FIELDS TERMINATED BY  ',' (@,@,c,d,e,f)",
 		output: "load data infile test/loadfile5 ignore into table t.a fields terminated by , (, , c, d, e, f)",
@@ -1373,6 +1399,8 @@ var (
 		}, {
 			input:  "create role 'admin', 'developer'",
 			output: "create role admin, developer",
+		}, {
+			input: "create role intern, lead",
 		}, {
 			input:  "create index idx1 on a (a) KEY_BLOCK_SIZE 10 with parser x comment 'x' invisible",
 			output: "create index idx1 on a (a) KEY_BLOCK_SIZE 10 with parser x comment x invisible",
@@ -2161,6 +2189,13 @@ var (
 		{
 			input: "alter table tbl1 drop foreign key fk_name",
 		},
+		{
+			input: "alter table tbl1 drop check chk_name, drop constraint fk_name",
+		},
+		{
+			input:  "alter table tbl1 add constraint chk_name check (a > 0)",
+			output: "alter table tbl1 add constraint chk_name check (a > 0) enforced",
+		},
 		{
 			input: "alter table tbl1 add foreign key sdf (a, b) references b(a asc, b desc)",
 		},
@@ -2168,6 +2203,30 @@ var (
 			input:  "alter table tbl1 checksum = 0, COMMENT = 'asdf'",
 			output: "alter table tbl1 checksum = 0, comment = asdf",
 		},
+		{
+			input:  "alter table t1 add partition (partition p3 values less than (2025), partition p4 values less than maxvalue)",
+			output: "alter table t1 add partition (partition p3 values less than (2025), partition p4 values less than (MAXVALUE))",
+		},
+		{
+			input: "alter table t1 drop partition p0, p1",
+		},
+		{
+			input: "alter table t1 truncate partition p2",
+		},
+		{
+			input:  "alter table t1 truncate partition ALL",
+			output: "alter table t1 truncate partition all",
+		},
+		{
+			input: "alter table t1 reorganize partition p0, p1 into (partition p0 values in (1, 2), partition p1 values in (3))",
+		},
+		{
+			input:  "alter table db1.t1 exchange partition p0 with table db1.t2 with validation",
+			output: "alter table db1.t1 exchange partition p0 with table db1.t2",
+		},
+		{
+			input: "alter table t1 exchange partition p0 with table t2 without validation",
+		},
 		{
 			input:  "alter table t1 alter index c visible",
 			output: "alter table t1 alter index c visible",
@@ -2292,6 +2351,154 @@ var (
 			input:  "SHOW STAGES LIKE 'my_stage'",
 			output: "show stages like my_stage",
 		},
+		{
+			input:  "CREATE EVENT e1 ON SCHEDULE EVERY 1 HOUR DO INSERT INTO t1 VALUES (1)",
+			output: "create event e1 on schedule every 1 hour do insert into t1 values (1)",
+		},
+		{
+			input:  "CREATE EVENT IF NOT EXISTS db1.e1 ON SCHEDULE EVERY 30 MINUTE STARTS '2023-01-01 00:00:00' ENDS CURRENT_TIMESTAMP + INTERVAL 1 DAY ON COMPLETION PRESERVE DISABLE COMMENT 'purge' DO DELETE FROM t1 WHERE a < 10",
+			output: "create event if not exists db1.e1 on schedule every 30 minute starts 2023-01-01 00:00:00 ends current_timestamp() + interval(1, day) on completion preserve disable comment 'purge' do delete from t1 where a < 10",
+		},
+		{
+			input:  "CREATE EVENT e1 ON SCHEDULE AT '2023-01-01 00:00:00' ON COMPLETION NOT PRESERVE DO UPDATE t1 SET a = 1",
+			output: "create event e1 on schedule at 2023-01-01 00:00:00 on completion not preserve do update t1 set a = 1",
+		},
+		{
+			input:  "ALTER EVENT e1 ON SCHEDULE EVERY 2 DAY ENABLE",
+			output: "alter event e1 on schedule every 2 day enable",
+		},
+		{
+			input:  "ALTER EVENT db1.e1 ON COMPLETION PRESERVE RENAME TO e2 COMMENT 'x' DO INSERT INTO t1 SELECT 1",
+			output: "alter event db1.e1 on completion preserve rename to e2 comment 'x' do insert into t1 select 1",
+		},
+		{
+			input:  "ALTER EVENT e1 DISABLE",
+			output: "alter event e1 disable",
+		},
+		{
+			input:  "DROP EVENT IF EXISTS db1.e1",
+			output: "drop event if exists db1.e1",
+		},
+		{
+			input:  "CREATE TRIGGER trg1 BEFORE INSERT ON t1 FOR EACH ROW SET NEW.a = NEW.a + 1",
+			output: "create trigger trg1 before insert on t1 for each row set new.a = new.a + 1",
+		},
+		{
+			input:  "CREATE TRIGGER IF NOT EXISTS db1.trg1 AFTER UPDATE ON db1.t1 FOR EACH ROW FOLLOWS trg0 BEGIN INSERT INTO log VALUES (OLD.a, NEW.a); END",
+			output: "create trigger if not exists db1.trg1 after update on db1.t1 for each row follows trg0 begin insert into log values (old.a, new.a); end",
+		},
+		{
+			input:  "create trigger trg1 after delete on t1 for each row precedes trg0 if old.a > 10 then delete from t2 where b = old.a; end if",
+			output: "create trigger trg1 after delete on t1 for each row precedes trg0 if old.a > 10 then delete from t2 where b = old.a; end if",
+		},
+		{
+			input:  "DROP TRIGGER IF EXISTS db1.trg1",
+			output: "drop trigger if exists db1.trg1",
+		},
+		{
+			input:  "drop trigger trg1",
+			output: "drop trigger trg1",
+		},
+		{
+			input:  "select each, before, follows, precedes from t1",
+			output: "select each, before, follows, precedes from t1",
+		},
+		{
+			input:  "CREATE MATERIALIZED VIEW mv1 AS SELECT a, sum(b), count(*) FROM t1 GROUP BY a",
+			output: "create materialized view mv1 as select a, sum(b), count(*) from t1 group by a",
+		},
+		{
+			input:  "create materialized view if not exists db1.mv1 refresh every 1 minute as select a, min(b) as mb, max(b) from db1.t1 where c > 0 group by a",
+			output: "create materialized view if not exists db1.mv1 refresh every 1 minute as select a, min(b) as mb, max(b) from db1.t1 where c > 0 group by a",
+		},
+		{
+			input:  "refresh materialized view mv1",
+			output: "refresh materialized view mv1",
+		},
+		{
+			input:  "REFRESH MATERIALIZED VIEW db1.mv1 FULL",
+			output: "refresh materialized view db1.mv1",
+		},
+		{
+			input:  "refresh materialized view mv1 incremental",
+			output: "refresh materialized view mv1 incremental",
+		},
+		{
+			input:  "drop materialized view if exists db1.mv1",
+			output: "drop materialized view if exists db1.mv1",
+		},
+		{
+			input:  "select materialized, refresh from t1",
+			output: "select materialized, refresh from t1",
+		},
+		{
+			input:  "select at, every, starts, ends from schedule",
+			output: "select at, every, starts, ends from schedule",
+		},
+		{
+			input:  `select * from json_table('[{"a": 1}]', '$[*]' columns (id for ordinality, a int path '$.a', b varchar(64) path '$.b' default '"x"' on empty error on error, c int exists path '$.c')) as jt`,
+			output: `select * from json_table([{"a": 1}], '$[*]' columns (id for ordinality, a int path '$.a', b varchar(64) path '$.b' default '"x"' on empty error on error, c int exists path '$.c')) as jt`,
+		},
+		{
+			input:  "select jt.* from t1, JSON_TABLE(t1.doc, '$.items[*]' COLUMNS (name TEXT PATH '$.name' NULL ON ERROR, NESTED PATH '$.tags[*]' COLUMNS (tag VARCHAR(10) PATH '$'), NESTED '$.x' COLUMNS (x JSON PATH '$'))) AS jt",
+			output: "select jt.* from t1 cross join json_table(t1.doc, '$.items[*]' columns (name text path '$.name' null on error, nested path '$.tags[*]' columns (tag varchar(10) path '$'), nested path '$.x' columns (x json path '$'))) as jt",
+		},
+		{
+			input:  "select nested, path, ordinality, error, empty from t1",
+			output: "select nested, path, ordinality, error, empty from t1",
+		},
+		{
+			input:  "select c.id, o.amount from customers c, lateral (select amount from orders o where o.cust = c.id order by amount desc limit 3) as o",
+			output: "select c.id, o.amount from customers as c cross join lateral (select amount from orders as o where o.cust = c.id order by amount desc limit 3) as o",
+		},
+		{
+			input:  "select * from t1 left join lateral (select max(b) from t2 where t2.a = t1.a) as x(m) on true",
+			output: "select * from t1 left join lateral (select max(b) from t2 where t2.a = t1.a) as x(m) on true",
+		},
+		{
+			input:  "select id, match(title, body) against('database') as score from articles where match(title, body) against('database' in natural language mode)",
+			output: "select id, match(title, body) against(database) as score from articles where match(title, body) against(database)",
+		},
+		{
+			input:  "select * from articles where match(a.body) against('+mysql -oracle data*' in boolean mode)",
+			output: "select * from articles where match(a.body) against(+mysql -oracle data* in boolean mode)",
+		},
+		{
+			input:  "select * from articles where match(body) against('database' with query expansion)",
+			output: "select * from articles where match(body) against(database with query expansion)",
+		},
+		{
+			input:  "create table articles (id int primary key, body text, fulltext ft_body (body) with parser ngram)",
+			output: "create table articles (id int primary key, body text, fulltext ft_body (body) with parser ngram )",
+		},
+		{
+			input:  "create fulltext index ft_body on articles (title, body)",
+			output: "create fulltext index ft_body on articles (title, body)",
+		},
+		{
+			input:  "create table items (id int primary key, embedding vecf32(3), v64 VECF64(128))",
+			output: "create table items (id int primary key, embedding vecf32(3), v64 vecf64(128))",
+		},
+		{
+			input:  "create table items (id int primary key, embedding vecf32(3), index idx using ivfflat (embedding) lists = 16 op_type 'vector_l2_ops')",
+			output: "create table items (id int primary key, embedding vecf32(3), index idx using ivfflat (embedding) lists = 16 op_type vector_l2_ops )",
+		},
+		{
+			input:  "create index idx using ivfflat on items (embedding) lists 4 op_type 'vector_cosine_ops'",
+			output: "create index idx using ivfflat on items (embedding) lists = 4 op_type vector_cosine_ops ",
+		},
+		{
+			input:  "alter table items add index idx using ivfflat (embedding) lists = 2",
+			output: "alter table items add index idx using ivfflat (embedding) lists = 2 ",
+		},
+		{
+			input:  "select id from items order by l2_distance(embedding, '[1, 2, 3]') limit 5",
+			output: "select id from items order by l2_distance(embedding, [1, 2, 3]) limit 5",
+		},
+		{
+			input:  "select cast('[1,2]' as vecf64(2)), lists, op_type from t",
+			output: "select cast([1,2] as vecf64(2)), lists, op_type from t",
+		},
 		{
 			input: "create database db1 from acc0 publication pub1",
 		},
@@ -2589,6 +2796,14 @@ var (
 			input:  "backup '123' filesystem '/home/abc'",
 			output: "backup 123 filesystem /home/abc",
 		},
+		{
+			input:  "backup '123' filesystem '/home/abc/2' incremental from '/home/abc/1'",
+			output: "backup 123 filesystem /home/abc/2 incremental from /home/abc/1",
+		},
+		{
+			input:  "backup '123' s3option {'bucket'='test', 'filepath'='b2'} incremental from 'b1'",
+			output: "backup 123 s3option {'bucket'='test', 'filepath'='b2'} incremental from b1",
+		},
 		{
 			input:  "backup '123' s3option {\"bucket\"='dan-test1', \"filepath\"='ex_table_dan_gzip.gz',\"role_arn\"='arn:aws:iam::468413122987:role/dev-cross-s3', \"external_id\"='5404f91c_4e59_4898_85b3', \"compression\"='auto'}",
 			output: "backup 123 s3option {'bucket'='dan-test1', 'filepath'='ex_table_dan_gzip.gz', 'role_arn'='arn:aws:iam::468413122987:role/dev-cross-s3', 'external_id'='5404f91c_4e59_4898_85b3', 'compression'='auto'}",
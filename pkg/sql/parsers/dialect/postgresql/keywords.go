@@ -18,6 +18,100 @@ var keywords map[string]int
 
 func init() {
 	keywords = map[string]int{
-		"use": USE,
+		"abort":             ABORT,
+		"all":               ALL,
+		"analyze":           ANALYZE,
+		"and":               AND,
+		"any":               ANY,
+		"as":                AS,
+		"asc":               ASC,
+		"begin":             BEGIN,
+		"between":           BETWEEN,
+		"by":                BY,
+		"case":              CASE,
+		"cast":              CAST,
+		"character":         CHARACTER,
+		"commit":            COMMIT,
+		"create":            CREATE,
+		"cross":             CROSS,
+		"current_date":      CURRENT_DATE,
+		"current_timestamp": CURRENT_TIMESTAMP,
+		"current_user":      CURRENT_USER,
+		"database":          DATABASE,
+		"databases":         DATABASES,
+		"default":           DEFAULT,
+		"delete":            DELETE,
+		"desc":              DESC,
+		"distinct":          DISTINCT,
+		"double":            DOUBLE,
+		"drop":              DROP,
+		"else":              ELSE,
+		"end":               END,
+		"escape":            ESCAPE,
+		"except":            EXCEPT,
+		"exists":            EXISTS,
+		"explain":           EXPLAIN,
+		"false":             FALSE,
+		"first":             FIRST,
+		"for":               FOR,
+		"from":              FROM,
+		"full":              FULL,
+		"group":             GROUP,
+		"having":            HAVING,
+		"if":                IF,
+		"ilike":             ILIKE,
+		"in":                IN,
+		"inner":             INNER,
+		"insert":            INSERT,
+		"intersect":         INTERSECT,
+		"into":              INTO,
+		"is":                IS,
+		"join":              JOIN,
+		"key":               KEY,
+		"last":              LAST,
+		"left":              LEFT,
+		"like":              LIKE,
+		"limit":             LIMIT,
+		"local":             LOCAL,
+		"natural":           NATURAL,
+		"not":               NOT,
+		"null":              NULL,
+		"nulls":             NULLS,
+		"offset":            OFFSET,
+		"on":                ON,
+		"or":                OR,
+		"order":             ORDER,
+		"outer":             OUTER,
+		"precision":         PRECISION,
+		"primary":           PRIMARY,
+		"recursive":         RECURSIVE,
+		"right":             RIGHT,
+		"rollback":          ROLLBACK,
+		"schema":            SCHEMA,
+		"select":            SELECT,
+		"session":           SESSION,
+		"set":               SET,
+		"show":              SHOW,
+		"some":              SOME,
+		"start":             START,
+		"table":             TABLE,
+		"tables":            TABLES,
+		"then":              THEN,
+		"to":                TO,
+		"transaction":       TRANSACTION,
+		"true":              TRUE,
+		"truncate":          TRUNCATE,
+		"union":             UNION,
+		"unique":            UNIQUE,
+		"update":            UPDATE,
+		"use":               USE,
+		"using":             USING,
+		"values":            VALUES,
+		"varying":           VARYING,
+		"verbose":           VERBOSE,
+		"when":              WHEN,
+		"where":             WHERE,
+		"with":              WITH,
+		"work":              WORK,
 	}
 }
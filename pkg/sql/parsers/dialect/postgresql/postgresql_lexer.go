@@ -16,6 +16,7 @@ package postgresql
 
 import (
 	"context"
+	"math"
 	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
@@ -23,16 +24,16 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
-func Parse(ctx context.Context, sql string) ([]tree.Statement, error) {
-	lexer := NewLexer(dialect.POSTGRESQL, sql)
+func Parse(ctx context.Context, sql string, lower int64) ([]tree.Statement, error) {
+	lexer := NewLexer(dialect.POSTGRESQL, sql, lower)
 	if yyParse(lexer) != 0 {
 		return nil, lexer.scanner.LastError
 	}
 	return lexer.stmts, nil
 }
 
-func ParseOne(ctx context.Context, sql string) (tree.Statement, error) {
-	lexer := NewLexer(dialect.POSTGRESQL, sql)
+func ParseOne(ctx context.Context, sql string, lower int64) (tree.Statement, error) {
+	lexer := NewLexer(dialect.POSTGRESQL, sql, lower)
 	if yyParse(lexer) != 0 {
 		return nil, lexer.scanner.LastError
 	}
@@ -43,16 +44,25 @@ func ParseOne(ctx context.Context, sql string) (tree.Statement, error) {
 }
 
 type Lexer struct {
-	scanner *Scanner
-	stmts   []tree.Statement
+	scanner    *Scanner
+	stmts      []tree.Statement
+	paramIndex int
+	lower      int64
 }
 
-func NewLexer(dialectType dialect.DialectType, sql string) *Lexer {
+func NewLexer(dialectType dialect.DialectType, sql string, lower int64) *Lexer {
 	return &Lexer{
-		scanner: NewScanner(dialectType, sql),
+		scanner:    NewScanner(dialectType, sql),
+		paramIndex: 0,
+		lower:      lower,
 	}
 }
 
+func (l *Lexer) GetParamIndex() int {
+	l.paramIndex = l.paramIndex + 1
+	return l.paramIndex
+}
+
 func (l *Lexer) Lex(lval *yySymType) int {
 	typ, str := l.scanner.Scan()
 	l.scanner.LastToken = str
@@ -83,11 +93,19 @@ func (l *Lexer) AppendStmt(stmt tree.Statement) {
 }
 
 func (l *Lexer) toInt(lval *yySymType, str string) int {
-	ival, err := strconv.ParseInt(str, 10, 64)
+	ival, err := strconv.ParseUint(str, 10, 64)
 	if err != nil {
-		l.scanner.LastError = err
+		// the integer out of the range of uint64 is a decimal
+		lval.str = str
+		return DECIMAL_VALUE
 	}
-	lval.item = ival
+	switch {
+	case ival <= math.MaxInt64:
+		lval.item = int64(ival)
+	default:
+		lval.item = ival
+	}
+	lval.str = str
 	return INTEGRAL
 }
 
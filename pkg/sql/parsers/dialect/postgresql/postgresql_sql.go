@@ -11,110 +11,137 @@ import (
 //line postgresql_sql.y:16
 
 import (
+	"fmt"
+	"go/constant"
+	"strings"
+
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
 const LEX_ERROR = 57346
 const EMPTY = 57347
 const UNION = 57348
-const SELECT = 57349
-const STREAM = 57350
-const INSERT = 57351
-const UPDATE = 57352
-const DELETE = 57353
-const FROM = 57354
-const WHERE = 57355
-const GROUP = 57356
-const HAVING = 57357
-const ORDER = 57358
-const BY = 57359
-const LIMIT = 57360
-const OFFSET = 57361
-const FOR = 57362
-const LOWER_THAN_SET = 57363
-const SET = 57364
-const ALL = 57365
-const DISTINCT = 57366
-const DISTINCTROW = 57367
+const EXCEPT = 57349
+const INTERSECT = 57350
+const SELECT = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const LOWER_THAN_SET = 57364
+const SET = 57365
+const ALL = 57366
+const DISTINCT = 57367
 const AS = 57368
-const EXISTS = 57369
-const ASC = 57370
-const DESC = 57371
-const INTO = 57372
-const DUPLICATE = 57373
-const DEFAULT = 57374
-const LOCK = 57375
-const KEYS = 57376
-const VALUES = 57377
-const NEXT = 57378
-const VALUE = 57379
-const SHARE = 57380
-const MODE = 57381
-const SQL_NO_CACHE = 57382
-const SQL_CACHE = 57383
-const JOIN = 57384
-const STRAIGHT_JOIN = 57385
-const LEFT = 57386
-const RIGHT = 57387
-const INNER = 57388
-const OUTER = 57389
-const CROSS = 57390
-const NATURAL = 57391
-const USE = 57392
-const FORCE = 57393
-const ON = 57394
-const USING = 57395
-const SUBQUERY_AS_EXPR = 57396
-const LOWER_THAN_STRING = 57397
-const ID = 57398
-const AT_ID = 57399
-const AT_AT_ID = 57400
-const STRING = 57401
-const VALUE_ARG = 57402
-const LIST_ARG = 57403
-const COMMENT = 57404
-const COMMENT_KEYWORD = 57405
-const INTEGRAL = 57406
-const HEX = 57407
-const BIT_LITERAL = 57408
-const FLOAT = 57409
-const HEXNUM = 57410
-const NULL = 57411
-const TRUE = 57412
-const FALSE = 57413
-const LOWER_THAN_CHARSET = 57414
-const CHARSET = 57415
-const UNIQUE = 57416
-const KEY = 57417
-const OR = 57418
-const PIPE_CONCAT = 57419
-const XOR = 57420
-const AND = 57421
-const NOT = 57422
-const BETWEEN = 57423
-const CASE = 57424
-const WHEN = 57425
-const THEN = 57426
-const ELSE = 57427
-const END = 57428
-const LE = 57429
-const GE = 57430
-const NE = 57431
-const NULL_SAFE_EQUAL = 57432
-const IS = 57433
-const LIKE = 57434
-const REGEXP = 57435
-const IN = 57436
-const ASSIGNMENT = 57437
-const SHIFT_LEFT = 57438
-const SHIFT_RIGHT = 57439
-const DIV = 57440
-const MOD = 57441
-const UNARY = 57442
-const COLLATE = 57443
-const BINARY = 57444
-const UNDERSCORE_BINARY = 57445
-const INTERVAL = 57446
+const ASC = 57369
+const DESC = 57370
+const INTO = 57371
+const DEFAULT = 57372
+const VALUES = 57373
+const NULLS = 57374
+const FIRST = 57375
+const LAST = 57376
+const RECURSIVE = 57377
+const WITH = 57378
+const JOIN = 57379
+const LEFT = 57380
+const RIGHT = 57381
+const INNER = 57382
+const OUTER = 57383
+const CROSS = 57384
+const NATURAL = 57385
+const FULL = 57386
+const USE = 57387
+const ON = 57388
+const USING = 57389
+const SUBQUERY_AS_EXPR = 57390
+const ID = 57391
+const QUOTE_ID = 57392
+const AT_ID = 57393
+const AT_AT_ID = 57394
+const STRING = 57395
+const VALUE_ARG = 57396
+const LIST_ARG = 57397
+const COMMENT = 57398
+const COMMENT_KEYWORD = 57399
+const INTEGRAL = 57400
+const HEX = 57401
+const BIT_LITERAL = 57402
+const FLOAT = 57403
+const HEXNUM = 57404
+const DECIMAL_VALUE = 57405
+const NULL = 57406
+const TRUE = 57407
+const FALSE = 57408
+const OR = 57409
+const AND = 57410
+const NOT = 57411
+const BETWEEN = 57412
+const CASE = 57413
+const WHEN = 57414
+const THEN = 57415
+const ELSE = 57416
+const END = 57417
+const LE = 57418
+const GE = 57419
+const NE = 57420
+const NULL_SAFE_EQUAL = 57421
+const IS = 57422
+const LIKE = 57423
+const ILIKE = 57424
+const IN = 57425
+const ASSIGNMENT = 57426
+const PIPE_CONCAT = 57427
+const SHIFT_LEFT = 57428
+const SHIFT_RIGHT = 57429
+const UNARY = 57430
+const TYPECAST = 57431
+const BEGIN = 57432
+const START = 57433
+const TRANSACTION = 57434
+const COMMIT = 57435
+const ROLLBACK = 57436
+const ABORT = 57437
+const WORK = 57438
+const CREATE = 57439
+const DROP = 57440
+const TRUNCATE = 57441
+const DATABASE = 57442
+const SCHEMA = 57443
+const TABLE = 57444
+const IF = 57445
+const EXISTS = 57446
+const PRIMARY = 57447
+const KEY = 57448
+const UNIQUE = 57449
+const SHOW = 57450
+const TABLES = 57451
+const DATABASES = 57452
+const TO = 57453
+const SESSION = 57454
+const LOCAL = 57455
+const EXPLAIN = 57456
+const ANALYZE = 57457
+const VERBOSE = 57458
+const CAST = 57459
+const ESCAPE = 57460
+const ANY = 57461
+const SOME = 57462
+const DOUBLE = 57463
+const PRECISION = 57464
+const CHARACTER = 57465
+const VARYING = 57466
+const CURRENT_DATE = 57467
+const CURRENT_TIMESTAMP = 57468
+const CURRENT_USER = 57469
 
 var yyToknames = [...]string{
 	"$end",
@@ -123,8 +150,9 @@ var yyToknames = [...]string{
 	"LEX_ERROR",
 	"EMPTY",
 	"UNION",
+	"EXCEPT",
+	"INTERSECT",
 	"SELECT",
-	"STREAM",
 	"INSERT",
 	"UPDATE",
 	"DELETE",
@@ -141,41 +169,34 @@ var yyToknames = [...]string{
 	"SET",
 	"ALL",
 	"DISTINCT",
-	"DISTINCTROW",
 	"AS",
-	"EXISTS",
 	"ASC",
 	"DESC",
 	"INTO",
-	"DUPLICATE",
 	"DEFAULT",
-	"LOCK",
-	"KEYS",
 	"VALUES",
-	"NEXT",
-	"VALUE",
-	"SHARE",
-	"MODE",
-	"SQL_NO_CACHE",
-	"SQL_CACHE",
+	"NULLS",
+	"FIRST",
+	"LAST",
+	"RECURSIVE",
+	"WITH",
 	"JOIN",
-	"STRAIGHT_JOIN",
 	"LEFT",
 	"RIGHT",
 	"INNER",
 	"OUTER",
 	"CROSS",
 	"NATURAL",
+	"FULL",
 	"USE",
-	"FORCE",
 	"ON",
 	"USING",
 	"SUBQUERY_AS_EXPR",
 	"'('",
 	"','",
 	"')'",
-	"LOWER_THAN_STRING",
 	"ID",
+	"QUOTE_ID",
 	"AT_ID",
 	"AT_AT_ID",
 	"STRING",
@@ -188,19 +209,13 @@ var yyToknames = [...]string{
 	"BIT_LITERAL",
 	"FLOAT",
 	"HEXNUM",
+	"DECIMAL_VALUE",
 	"NULL",
 	"TRUE",
 	"FALSE",
-	"LOWER_THAN_CHARSET",
-	"CHARSET",
-	"UNIQUE",
-	"KEY",
 	"OR",
-	"PIPE_CONCAT",
-	"XOR",
 	"AND",
 	"NOT",
-	"'!'",
 	"BETWEEN",
 	"CASE",
 	"WHEN",
@@ -216,9 +231,10 @@ var yyToknames = [...]string{
 	"NULL_SAFE_EQUAL",
 	"IS",
 	"LIKE",
-	"REGEXP",
+	"ILIKE",
 	"IN",
 	"ASSIGNMENT",
+	"PIPE_CONCAT",
 	"'|'",
 	"'&'",
 	"SHIFT_LEFT",
@@ -227,17 +243,49 @@ var yyToknames = [...]string{
 	"'-'",
 	"'*'",
 	"'/'",
-	"DIV",
 	"'%'",
-	"MOD",
 	"'^'",
-	"'~'",
 	"UNARY",
-	"COLLATE",
-	"BINARY",
-	"UNDERSCORE_BINARY",
-	"INTERVAL",
+	"TYPECAST",
 	"'.'",
+	"BEGIN",
+	"START",
+	"TRANSACTION",
+	"COMMIT",
+	"ROLLBACK",
+	"ABORT",
+	"WORK",
+	"CREATE",
+	"DROP",
+	"TRUNCATE",
+	"DATABASE",
+	"SCHEMA",
+	"TABLE",
+	"IF",
+	"EXISTS",
+	"PRIMARY",
+	"KEY",
+	"UNIQUE",
+	"SHOW",
+	"TABLES",
+	"DATABASES",
+	"TO",
+	"SESSION",
+	"LOCAL",
+	"EXPLAIN",
+	"ANALYZE",
+	"VERBOSE",
+	"CAST",
+	"ESCAPE",
+	"ANY",
+	"SOME",
+	"DOUBLE",
+	"PRECISION",
+	"CHARACTER",
+	"VARYING",
+	"CURRENT_DATE",
+	"CURRENT_TIMESTAMP",
+	"CURRENT_USER",
 	"';'",
 }
 
@@ -247,33 +295,342 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line postgresql_sql.y:110
+//line postgresql_sql.y:1742
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
+	-1, 17,
+	19, 136,
+	20, 136,
+	-2, 114,
+	-1, 48,
+	19, 137,
+	20, 137,
+	-2, 116,
+	-1, 185,
+	49, 292,
+	-2, 322,
+	-1, 186,
+	49, 293,
+	-2, 324,
+	-1, 294,
+	19, 136,
+	20, 136,
+	-2, 286,
+	-1, 431,
+	19, 136,
+	20, 136,
+	-2, 207,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 11
+const yyLast = 2209
 
 var yyAct = [...]int{
-	6, 7, 5, 3, 1, 4, 2, 0, 0, 0,
-	8,
+	186, 256, 531, 524, 532, 537, 296, 200, 408, 311,
+	176, 337, 380, 399, 421, 450, 401, 359, 446, 387,
+	61, 187, 61, 143, 61, 336, 95, 4, 3, 338,
+	98, 7, 230, 61, 126, 219, 189, 222, 195, 97,
+	6, 16, 96, 5, 216, 316, 61, 179, 242, 144,
+	124, 47, 159, 452, 451, 58, 61, 82, 61, 91,
+	85, 86, 236, 122, 170, 555, 527, 476, 58, 92,
+	46, 18, 19, 20, 4, 166, 129, 106, 7, 57,
+	328, 125, 61, 250, 171, 61, 167, 6, 324, 111,
+	5, 58, 464, 146, 119, 120, 121, 45, 110, 433,
+	305, 61, 109, 525, 116, 117, 118, 50, 526, 237,
+	35, 267, 99, 48, 154, 147, 293, 58, 290, 319,
+	91, 513, 128, 127, 61, 46, 18, 19, 20, 285,
+	286, 287, 288, 289, 290, 141, 58, 287, 288, 289,
+	290, 102, 388, 61, 454, 49, 61, 103, 61, 61,
+	231, 246, 45, 151, 152, 61, 528, 263, 529, 125,
+	553, 148, 182, 155, 156, 35, 90, 61, 226, 61,
+	61, 61, 234, 61, 61, 61, 150, 551, 61, 101,
+	158, 146, 61, 233, 146, 172, 173, 175, 238, 239,
+	240, 94, 93, 168, 169, 232, 391, 44, 388, 561,
+	458, 510, 245, 550, 247, 58, 249, 517, 251, 58,
+	125, 502, 102, 257, 478, 181, 244, 265, 103, 468,
+	217, 220, 188, 463, 223, 254, 164, 241, 61, 261,
+	297, 298, 264, 263, 106, 299, 282, 284, 283, 291,
+	292, 285, 286, 287, 288, 289, 290, 157, 133, 248,
+	163, 149, 323, 253, 291, 292, 285, 286, 287, 288,
+	289, 290, 61, 314, 398, 153, 259, 61, 61, 106,
+	560, 512, 268, 365, 46, 460, 264, 263, 447, 353,
+	320, 295, 515, 469, 309, 310, 301, 363, 364, 362,
+	264, 263, 354, 327, 61, 182, 470, 58, 333, 565,
+	161, 45, 346, 348, 334, 306, 61, 335, 271, 272,
+	273, 274, 275, 276, 35, 269, 361, 419, 61, 264,
+	263, 349, 351, 352, 385, 231, 350, 264, 263, 381,
+	61, 61, 330, 136, 61, 384, 406, 411, 390, 437,
+	307, 393, 61, 61, 174, 395, 558, 562, 181, 318,
+	404, 394, 344, 345, 264, 263, 264, 263, 4, 530,
+	415, 160, 7, 558, 557, 430, 58, 440, 432, 416,
+	312, 6, 548, 547, 5, 502, 503, 146, 58, 46,
+	442, 434, 435, 436, 106, 317, 448, 139, 140, 419,
+	497, 389, 451, 392, 439, 217, 223, 462, 177, 453,
+	477, 361, 106, 46, 61, 46, 457, 405, 474, 473,
+	414, 130, 61, 467, 52, 53, 54, 403, 402, 35,
+	61, 61, 61, 61, 475, 51, 228, 225, 360, 471,
+	45, 482, 45, 35, 61, 333, 332, 331, 329, 472,
+	303, 496, 302, 35, 495, 227, 543, 381, 542, 441,
+	483, 484, 485, 538, 539, 58, 58, 58, 58, 165,
+	501, 61, 504, 505, 492, 61, 541, 487, 493, 499,
+	511, 508, 490, 516, 455, 61, 491, 459, 61, 441,
+	520, 518, 411, 521, 507, 533, 519, 488, 535, 486,
+	540, 489, 46, 18, 19, 20, 381, 465, 466, 397,
+	499, 315, 366, 367, 368, 369, 370, 371, 372, 373,
+	374, 375, 376, 377, 378, 379, 209, 17, 55, 45,
+	33, 255, 544, 494, 426, 427, 142, 552, 546, 243,
+	428, 61, 35, 556, 423, 426, 427, 424, 533, 425,
+	429, 428, 137, 138, 17, 128, 127, 514, 131, 132,
+	304, 105, 107, 108, 340, 104, 278, 281, 481, 61,
+	135, 61, 564, 563, 17, 480, 533, 134, 418, 534,
+	441, 279, 280, 277, 56, 282, 284, 283, 291, 292,
+	285, 286, 287, 288, 289, 290, 443, 444, 445, 318,
+	500, 545, 112, 113, 114, 115, 257, 52, 53, 54,
+	438, 81, 1, 326, 549, 46, 84, 235, 51, 210,
+	17, 17, 52, 53, 54, 262, 523, 522, 407, 162,
+	410, 409, 83, 229, 509, 386, 355, 559, 70, 67,
+	69, 71, 45, 270, 214, 215, 400, 461, 300, 456,
+	321, 78, 199, 190, 191, 192, 184, 34, 185, 60,
+	396, 308, 201, 208, 221, 506, 313, 202, 17, 422,
+	203, 420, 204, 207, 205, 206, 536, 252, 183, 339,
+	197, 341, 417, 479, 17, 282, 284, 283, 291, 292,
+	285, 286, 287, 288, 289, 290, 260, 258, 224, 178,
+	15, 31, 193, 194, 30, 14, 29, 28, 13, 26,
+	25, 64, 75, 76, 65, 72, 62, 80, 24, 294,
+	77, 66, 73, 11, 12, 196, 10, 68, 9, 74,
+	8, 2, 0, 0, 0, 0, 63, 79, 198, 0,
+	0, 0, 0, 0, 0, 0, 211, 212, 213, 0,
+	0, 0, 0, 0, 107, 0, 0, 356, 0, 0,
+	554, 0, 0, 0, 0, 70, 67, 69, 71, 0,
+	0, 214, 215, 0, 0, 0, 0, 0, 78, 0,
+	0, 0, 192, 0, 0, 185, 60, 0, 343, 201,
+	208, 449, 0, 0, 202, 0, 0, 203, 0, 204,
+	207, 205, 206, 0, 0, 0, 0, 197, 0, 0,
+	0, 282, 284, 283, 291, 292, 285, 286, 287, 288,
+	289, 290, 0, 0, 0, 0, 0, 0, 0, 193,
+	194, 0, 0, 0, 0, 0, 0, 0, 64, 75,
+	76, 65, 72, 62, 80, 0, 0, 77, 66, 73,
+	0, 0, 196, 0, 68, 0, 74, 0, 17, 0,
+	0, 0, 0, 63, 79, 198, 0, 357, 358, 431,
+	0, 0, 0, 211, 212, 213, 325, 0, 70, 67,
+	69, 71, 0, 0, 214, 215, 0, 294, 0, 0,
+	0, 78, 322, 0, 0, 192, 0, 0, 185, 60,
+	0, 0, 201, 208, 0, 0, 0, 202, 0, 0,
+	203, 0, 204, 207, 205, 206, 0, 0, 183, 0,
+	197, 0, 0, 0, 0, 0, 0, 0, 0, 17,
+	282, 284, 283, 291, 292, 285, 286, 287, 288, 289,
+	290, 0, 193, 194, 0, 0, 343, 343, 343, 343,
+	0, 64, 75, 76, 65, 72, 62, 80, 0, 0,
+	77, 66, 73, 0, 0, 196, 0, 68, 0, 74,
+	0, 0, 0, 0, 0, 0, 63, 79, 198, 0,
+	0, 0, 0, 0, 0, 0, 211, 212, 213, 325,
+	0, 70, 67, 69, 71, 0, 0, 214, 215, 0,
+	0, 0, 0, 0, 78, 0, 0, 0, 192, 0,
+	0, 185, 60, 0, 0, 201, 208, 0, 0, 0,
+	202, 0, 0, 203, 0, 204, 207, 205, 206, 0,
+	0, 183, 0, 197, 284, 283, 291, 292, 285, 286,
+	287, 288, 289, 290, 283, 291, 292, 285, 286, 287,
+	288, 289, 290, 0, 0, 193, 194, 0, 0, 0,
+	0, 0, 0, 0, 64, 75, 76, 65, 72, 62,
+	80, 0, 0, 77, 66, 73, 0, 0, 196, 0,
+	68, 0, 74, 0, 0, 0, 0, 0, 0, 63,
+	79, 198, 0, 0, 218, 0, 0, 0, 0, 211,
+	212, 213, 70, 67, 69, 71, 0, 0, 214, 215,
+	0, 0, 0, 0, 0, 78, 0, 0, 0, 192,
+	0, 0, 185, 60, 0, 0, 201, 208, 0, 0,
+	0, 202, 0, 0, 203, 0, 204, 207, 205, 206,
+	0, 0, 183, 0, 197, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 193, 194, 0, 0,
+	0, 0, 0, 0, 0, 64, 75, 76, 65, 72,
+	62, 80, 0, 0, 77, 66, 73, 0, 0, 196,
+	0, 68, 0, 74, 0, 0, 0, 0, 0, 0,
+	63, 79, 198, 0, 0, 0, 0, 0, 0, 0,
+	211, 212, 213, 70, 67, 69, 71, 0, 0, 214,
+	215, 0, 0, 0, 0, 0, 78, 0, 0, 0,
+	192, 0, 0, 185, 60, 0, 0, 201, 208, 0,
+	0, 0, 202, 0, 0, 203, 0, 204, 207, 205,
+	206, 0, 0, 183, 0, 197, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 193, 194, 180,
+	0, 0, 0, 0, 0, 0, 64, 75, 76, 65,
+	72, 62, 80, 0, 0, 77, 66, 73, 0, 0,
+	196, 0, 68, 0, 74, 0, 0, 0, 0, 0,
+	0, 63, 79, 198, 0, 0, 0, 0, 0, 0,
+	0, 211, 212, 213, 70, 67, 69, 71, 0, 0,
+	214, 215, 0, 0, 0, 0, 0, 78, 0, 0,
+	0, 192, 0, 0, 185, 60, 0, 0, 201, 208,
+	0, 0, 0, 202, 0, 0, 203, 0, 204, 207,
+	205, 206, 0, 0, 183, 0, 197, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 193, 194,
+	0, 0, 0, 0, 0, 0, 0, 64, 75, 76,
+	65, 72, 62, 80, 0, 0, 77, 66, 73, 0,
+	0, 196, 0, 68, 0, 74, 0, 0, 0, 0,
+	0, 0, 63, 79, 198, 0, 0, 0, 0, 0,
+	0, 0, 211, 212, 213, 70, 67, 69, 71, 0,
+	0, 214, 215, 0, 0, 0, 0, 0, 78, 0,
+	0, 0, 192, 0, 0, 185, 60, 0, 0, 201,
+	208, 0, 0, 0, 202, 0, 0, 203, 0, 204,
+	207, 205, 206, 70, 67, 69, 71, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 78, 0, 0, 0,
+	0, 0, 0, 59, 60, 0, 0, 0, 0, 193,
+	194, 0, 0, 0, 0, 0, 0, 0, 64, 75,
+	76, 65, 72, 62, 80, 0, 0, 77, 66, 73,
+	0, 0, 196, 0, 68, 0, 74, 0, 0, 0,
+	0, 0, 0, 63, 79, 198, 0, 0, 0, 0,
+	0, 0, 46, 211, 212, 213, 64, 75, 76, 65,
+	72, 62, 80, 0, 0, 77, 66, 73, 0, 0,
+	0, 0, 68, 0, 74, 70, 67, 69, 71, 45,
+	46, 63, 79, 0, 0, 0, 0, 382, 78, 383,
+	0, 0, 342, 0, 0, 59, 60, 0, 0, 0,
+	0, 0, 0, 70, 67, 69, 71, 45, 0, 0,
+	0, 0, 0, 0, 0, 0, 78, 0, 0, 0,
+	35, 0, 0, 59, 60, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 64, 75,
+	76, 65, 72, 62, 80, 0, 0, 77, 66, 73,
+	0, 0, 0, 0, 68, 0, 74, 0, 0, 0,
+	0, 0, 0, 63, 79, 0, 64, 75, 76, 65,
+	72, 62, 80, 0, 0, 77, 66, 73, 0, 266,
+	0, 0, 68, 0, 74, 70, 67, 69, 71, 0,
+	0, 63, 79, 0, 0, 0, 0, 0, 78, 0,
+	0, 0, 0, 0, 89, 59, 60, 0, 0, 0,
+	0, 0, 70, 67, 69, 71, 0, 0, 0, 0,
+	0, 0, 0, 264, 263, 78, 0, 0, 0, 0,
+	0, 0, 59, 60, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 70, 67, 69, 71, 0, 0, 64, 75,
+	76, 65, 72, 62, 80, 78, 0, 77, 66, 73,
+	0, 0, 59, 60, 68, 0, 74, 0, 0, 70,
+	67, 69, 71, 63, 79, 64, 75, 76, 65, 72,
+	62, 80, 78, 0, 77, 66, 73, 0, 0, 59,
+	60, 68, 0, 74, 87, 88, 0, 0, 0, 0,
+	63, 79, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 64, 75, 76, 65, 72,
+	62, 80, 0, 0, 77, 66, 73, 0, 0, 0,
+	412, 68, 413, 74, 0, 498, 0, 0, 0, 0,
+	63, 79, 64, 75, 76, 65, 72, 62, 80, 0,
+	0, 77, 66, 73, 0, 0, 0, 0, 68, 0,
+	74, 70, 67, 69, 71, 0, 0, 63, 79, 0,
+	0, 0, 0, 0, 78, 0, 0, 0, 342, 0,
+	0, 59, 60, 145, 0, 0, 0, 0, 0, 70,
+	67, 69, 71, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 78, 0, 0, 0, 0, 0, 0, 59,
+	60, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 70, 67,
+	69, 71, 0, 0, 64, 75, 76, 65, 72, 62,
+	80, 78, 0, 77, 66, 73, 0, 0, 59, 60,
+	68, 0, 74, 0, 0, 0, 0, 0, 0, 63,
+	79, 0, 64, 75, 76, 65, 72, 62, 80, 0,
+	0, 77, 66, 73, 0, 0, 0, 0, 68, 0,
+	74, 0, 0, 0, 0, 0, 0, 63, 79, 0,
+	0, 0, 0, 0, 347, 70, 67, 69, 71, 0,
+	0, 64, 75, 76, 65, 72, 62, 80, 78, 0,
+	77, 66, 73, 0, 0, 59, 60, 68, 0, 74,
+	0, 0, 70, 67, 69, 71, 63, 79, 0, 0,
+	0, 0, 0, 0, 0, 78, 0, 0, 0, 0,
+	0, 0, 59, 60, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 70, 67, 69, 123, 0, 0, 64, 75,
+	76, 65, 72, 62, 80, 78, 0, 77, 66, 73,
+	100, 0, 59, 60, 68, 0, 74, 0, 46, 18,
+	19, 20, 0, 63, 79, 64, 75, 76, 65, 72,
+	62, 80, 22, 0, 77, 66, 73, 0, 0, 0,
+	0, 68, 0, 74, 0, 45, 0, 0, 0, 0,
+	63, 79, 0, 0, 21, 0, 0, 0, 35, 0,
+	0, 0, 0, 0, 0, 64, 75, 76, 65, 72,
+	62, 80, 0, 0, 77, 66, 73, 0, 0, 0,
+	0, 68, 0, 74, 0, 0, 0, 39, 0, 0,
+	63, 79, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 36, 37, 0, 38, 40, 41,
+	0, 42, 43, 32, 0, 0, 0, 0, 0, 0,
+	0, 0, 23, 0, 0, 0, 0, 0, 27,
 }
 
 var yyPact = [...]int{
-	-48, -1000, -122, -1000, -1000, -58, -48, -1000, -1000,
+	2079, -1000, -92, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 591, 489, 1990,
+	588, 1990, -67, 1670, -1000, -1000, -1000, 61, -1000, -1000,
+	-1000, -1000, 1963, 591, 370, 394, -9, -18, -9, -9,
+	-9, -9, -11, -21, -1000, 2030, 521, 2079, -1000, 529,
+	370, 542, 518, 518, 518, 1990, 503, 1857, 11, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1990, -1000, -1000, 1990, -1000, -1000, 66, 66, -1000,
+	-1000, 10, -1000, 483, 116, -1000, -1000, -1000, -1000, -1000,
+	1990, 529, 370, -1000, 591, 606, 175, 408, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -32, -32, -32, -34,
+	-34, -34, 294, 1990, -1000, 349, 1171, -1000, -1000, -1000,
+	-1000, 1060, 1282, -1000, -1000, 1282, -1000, -1000, -1000, -1000,
+	-1000, 396, 1990, -1000, -1000, 1990, -1000, 1990, 1857, -17,
+	-1000, 1393, 1393, -1000, 1990, -1000, -1000, 483, -1000, 508,
+	-1000, -1000, -1000, 529, -1000, -1000, 1990, 79, 1990, 1990,
+	1990, -36, 1990, 1990, 1990, 294, 495, 1990, 216, -1000,
+	-1000, 1643, 7, 1282, 229, -1000, -1000, -1000, 484, 13,
+	-1000, -1000, 596, 1393, 1393, -1000, 384, 1282, 393, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	391, -1000, -1000, -1000, -1000, -1000, 530, 162, -1000, -4,
+	286, 290, -1000, 257, -1000, 321, -1000, 1551, 470, 335,
+	-1000, 40, -1000, -1000, 575, 836, -1000, -1000, 13, 13,
+	-1000, -1000, -1000, 592, 508, -1000, -39, -1000, 389, -1000,
+	-1000, -1000, 282, -1000, -1000, 388, 385, -1000, 575, 1171,
+	-1000, 1829, -1000, 1282, 1282, -1000, 1990, 1896, -1000, 254,
+	723, -1000, -1000, -1000, -1000, -1000, -1000, 379, 200, 1393,
+	1393, 1393, 1393, 1393, 1393, 1393, 1393, 1393, 1393, 1393,
+	1393, 1393, 1393, 1431, 408, 284, 274, 13, 13, -1000,
+	67, 162, 1282, 98, 1282, 1990, 1060, 1282, 467, -1000,
+	-1000, 214, 949, 367, -1000, -1000, -1000, 1990, 1282, 949,
+	-1000, -1000, -1000, -1000, 162, -1000, -1000, -1000, -1000, 1710,
+	1990, 2079, -1000, 1990, 553, -1000, 267, 497, -1000, -1000,
+	-1000, 1857, 1523, -1000, -1000, 86, -1000, -1000, -5, -1000,
+	314, -1000, -1000, 587, -1000, 384, -1000, -1000, -1000, -1000,
+	596, -1000, 379, 1393, 1393, 1393, 145, 145, 710, 932,
+	160, 941, 39, 39, 17, 17, 17, -1000, 33, 33,
+	-1000, 343, -84, 5, -1000, 1282, 123, -1000, 1282, 249,
+	1282, 172, 162, -12, -1000, -1000, -1000, 464, 364, 168,
+	233, -1000, 265, 1990, -1000, 162, -1000, 358, -1000, -1000,
+	-1000, 1431, -54, 351, -1000, 163, -1000, 549, 540, 1829,
+	1829, 1829, 1829, -1000, 452, 430, 450, 435, 427, 486,
+	349, 408, 339, 1737, -1000, -1000, -1000, 577, 1393, -1000,
+	325, 162, -1000, 145, 145, 584, -1000, 1393, -1000, 1393,
+	-1000, 140, -1000, -1000, 343, 220, 43, -1000, 1282, 206,
+	1431, 156, 161, -1000, 1990, -1000, -1000, 949, -1000, 949,
+	321, -1000, -1000, -1000, 1710, 36, 310, 1990, -1000, -1000,
+	1282, 1282, 497, 407, 407, -1000, -1000, -1000, -1000, 429,
+	-1000, 411, -1000, 409, -1000, -1000, -1000, -1000, -1000, -1000,
+	1393, -1000, 1282, -1000, -1000, -1000, 1393, 13, -1000, 322,
+	-1000, -1000, -1000, -1000, 162, 1282, 152, -1000, 126, -1000,
+	214, -1000, -1000, 36, -1000, -1000, 93, 1393, -56, -1000,
+	1990, 313, -1000, -1000, 162, 161, -1000, -1000, 1282, 221,
+	-1000, -1000, -1000, -1000, -1000, 162, -1000, -1000, 138, 162,
+	-1000, -1000, -1000, -1000, 829, -1000, 296, -1000, 1990, 162,
+	1990, -1000, -1000, -1000, 248, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 3, 6, 5, 4,
+	0, 28, 721, 720, 718, 716, 714, 69, 713, 708,
+	700, 699, 698, 697, 696, 695, 694, 691, 690, 42,
+	39, 30, 26, 41, 520, 197, 516, 689, 47, 688,
+	687, 686, 45, 673, 672, 11, 29, 554, 671, 25,
+	669, 79, 667, 5, 666, 661, 14, 659, 1, 10,
+	656, 145, 113, 654, 37, 651, 650, 52, 361, 107,
+	48, 647, 50, 63, 88, 646, 21, 222, 36, 644,
+	643, 642, 16, 640, 639, 638, 18, 17, 44, 6,
+	637, 636, 13, 9, 38, 633, 626, 176, 34, 19,
+	625, 7, 12, 15, 624, 32, 623, 622, 8, 621,
+	620, 618, 3, 617, 616, 2, 4, 35, 615, 0,
+	23, 49, 166, 609, 607, 606, 553, 333, 75, 64,
+	602,
 }
 
-//line postgresql_sql.y:110
+//line postgresql_sql.y:1742
 type yySymType struct {
 	union interface{}
 	id    int
@@ -282,6 +639,166 @@ type yySymType struct {
 	yys   int
 }
 
+func (st *yySymType) boolValUnion() bool {
+	v, _ := st.union.(bool)
+	return v
+}
+
+func (st *yySymType) columnAttributeUnion() tree.ColumnAttribute {
+	v, _ := st.union.(tree.ColumnAttribute)
+	return v
+}
+
+func (st *yySymType) columnAttributesUnion() []tree.ColumnAttribute {
+	v, _ := st.union.([]tree.ColumnAttribute)
+	return v
+}
+
+func (st *yySymType) columnTypeUnion() *tree.T {
+	v, _ := st.union.(*tree.T)
+	return v
+}
+
+func (st *yySymType) comparisonExprUnion() *tree.ComparisonExpr {
+	v, _ := st.union.(*tree.ComparisonExpr)
+	return v
+}
+
+func (st *yySymType) comparisonOpUnion() tree.ComparisonOp {
+	v, _ := st.union.(tree.ComparisonOp)
+	return v
+}
+
+func (st *yySymType) cstrUnion() *tree.CStr {
+	v, _ := st.union.(*tree.CStr)
+	return v
+}
+
+func (st *yySymType) cteUnion() *tree.CTE {
+	v, _ := st.union.(*tree.CTE)
+	return v
+}
+
+func (st *yySymType) cteListUnion() []*tree.CTE {
+	v, _ := st.union.([]*tree.CTE)
+	return v
+}
+
+func (st *yySymType) directionUnion() tree.Direction {
+	v, _ := st.union.(tree.Direction)
+	return v
+}
+
+func (st *yySymType) exprUnion() tree.Expr {
+	v, _ := st.union.(tree.Expr)
+	return v
+}
+
+func (st *yySymType) exprsUnion() tree.Exprs {
+	v, _ := st.union.(tree.Exprs)
+	return v
+}
+
+func (st *yySymType) fromUnion() *tree.From {
+	v, _ := st.union.(*tree.From)
+	return v
+}
+
+func (st *yySymType) funcTypeUnion() tree.FuncType {
+	v, _ := st.union.(tree.FuncType)
+	return v
+}
+
+func (st *yySymType) groupByUnion() tree.GroupBy {
+	v, _ := st.union.(tree.GroupBy)
+	return v
+}
+
+func (st *yySymType) identifierListUnion() tree.IdentifierList {
+	v, _ := st.union.(tree.IdentifierList)
+	return v
+}
+
+func (st *yySymType) insertUnion() *tree.Insert {
+	v, _ := st.union.(*tree.Insert)
+	return v
+}
+
+func (st *yySymType) joinCondUnion() tree.JoinCond {
+	v, _ := st.union.(tree.JoinCond)
+	return v
+}
+
+func (st *yySymType) joinTableExprUnion() *tree.JoinTableExpr {
+	v, _ := st.union.(*tree.JoinTableExpr)
+	return v
+}
+
+func (st *yySymType) joinTypeUnion() string {
+	v, _ := st.union.(string)
+	return v
+}
+
+func (st *yySymType) keyPartUnion() *tree.KeyPart {
+	v, _ := st.union.(*tree.KeyPart)
+	return v
+}
+
+func (st *yySymType) keyPartsUnion() []*tree.KeyPart {
+	v, _ := st.union.([]*tree.KeyPart)
+	return v
+}
+
+func (st *yySymType) limitUnion() *tree.Limit {
+	v, _ := st.union.(*tree.Limit)
+	return v
+}
+
+func (st *yySymType) nullsPositionUnion() tree.NullsPosition {
+	v, _ := st.union.(tree.NullsPosition)
+	return v
+}
+
+func (st *yySymType) orderUnion() *tree.Order {
+	v, _ := st.union.(*tree.Order)
+	return v
+}
+
+func (st *yySymType) orderByUnion() tree.OrderBy {
+	v, _ := st.union.(tree.OrderBy)
+	return v
+}
+
+func (st *yySymType) rowsExprsUnion() []tree.Exprs {
+	v, _ := st.union.([]tree.Exprs)
+	return v
+}
+
+func (st *yySymType) selectUnion() *tree.Select {
+	v, _ := st.union.(*tree.Select)
+	return v
+}
+
+func (st *yySymType) selectExprUnion() tree.SelectExpr {
+	v, _ := st.union.(tree.SelectExpr)
+	return v
+}
+
+func (st *yySymType) selectExprsUnion() tree.SelectExprs {
+	v, _ := st.union.(tree.SelectExprs)
+	return v
+}
+
+func (st *yySymType) selectLockInfoUnion() *tree.SelectLockInfo {
+	v, _ := st.union.(*tree.SelectLockInfo)
+	return v
+}
+
+func (st *yySymType) selectStatementUnion() tree.SelectStatement {
+	v, _ := st.union.(tree.SelectStatement)
+	return v
+}
+
 func (st *yySymType) statementUnion() tree.Statement {
 	v, _ := st.union.(tree.Statement)
 	return v
@@ -292,36 +809,301 @@ func (st *yySymType) statementsUnion() []tree.Statement {
 	return v
 }
 
+func (st *yySymType) subqueryUnion() *tree.Subquery {
+	v, _ := st.union.(*tree.Subquery)
+	return v
+}
+
+func (st *yySymType) tableDefUnion() tree.TableDef {
+	v, _ := st.union.(tree.TableDef)
+	return v
+}
+
+func (st *yySymType) tableDefsUnion() tree.TableDefs {
+	v, _ := st.union.(tree.TableDefs)
+	return v
+}
+
+func (st *yySymType) tableExprUnion() tree.TableExpr {
+	v, _ := st.union.(tree.TableExpr)
+	return v
+}
+
+func (st *yySymType) tableExprsUnion() tree.TableExprs {
+	v, _ := st.union.(tree.TableExprs)
+	return v
+}
+
+func (st *yySymType) tableNameUnion() *tree.TableName {
+	v, _ := st.union.(*tree.TableName)
+	return v
+}
+
+func (st *yySymType) tableNamesUnion() tree.TableNames {
+	v, _ := st.union.(tree.TableNames)
+	return v
+}
+
+func (st *yySymType) typeModifiersUnion() []int32 {
+	v, _ := st.union.([]int32)
+	return v
+}
+
+func (st *yySymType) unionTypeRecordUnion() *tree.UnionTypeRecord {
+	v, _ := st.union.(*tree.UnionTypeRecord)
+	return v
+}
+
+func (st *yySymType) unresolvedNameUnion() *tree.UnresolvedName {
+	v, _ := st.union.(*tree.UnresolvedName)
+	return v
+}
+
+func (st *yySymType) updateExprUnion() *tree.UpdateExpr {
+	v, _ := st.union.(*tree.UpdateExpr)
+	return v
+}
+
+func (st *yySymType) updateExprsUnion() tree.UpdateExprs {
+	v, _ := st.union.(tree.UpdateExprs)
+	return v
+}
+
+func (st *yySymType) varAssignmentExprUnion() *tree.VarAssignmentExpr {
+	v, _ := st.union.(*tree.VarAssignmentExpr)
+	return v
+}
+
+func (st *yySymType) whenClauseUnion() *tree.When {
+	v, _ := st.union.(*tree.When)
+	return v
+}
+
+func (st *yySymType) whenClauseListUnion() []*tree.When {
+	v, _ := st.union.([]*tree.When)
+	return v
+}
+
+func (st *yySymType) whereUnion() *tree.Where {
+	v, _ := st.union.(*tree.Where)
+	return v
+}
+
+func (st *yySymType) withClauseUnion() *tree.With {
+	v, _ := st.union.(*tree.With)
+	return v
+}
+
 var yyR1 = [...]int{
-	0, 4, 2, 2, 1, 3, 3,
+	0, 120, 2, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 3, 4,
+	97, 115, 115, 115, 114, 114, 112, 112, 73, 73,
+	5, 5, 5, 5, 87, 87, 87, 8, 8, 8,
+	9, 9, 10, 10, 11, 11, 116, 116, 116, 6,
+	6, 6, 6, 7, 7, 7, 7, 12, 12, 13,
+	13, 14, 101, 101, 98, 98, 99, 103, 103, 104,
+	104, 102, 102, 102, 102, 102, 100, 100, 105, 105,
+	106, 15, 15, 16, 16, 17, 18, 18, 118, 118,
+	119, 119, 19, 29, 29, 29, 29, 29, 50, 50,
+	83, 83, 82, 82, 81, 81, 72, 72, 20, 96,
+	96, 95, 21, 22, 22, 23, 23, 23, 23, 61,
+	61, 63, 63, 62, 49, 49, 48, 48, 57, 57,
+	58, 58, 58, 58, 78, 78, 51, 51, 52, 53,
+	53, 54, 55, 55, 55, 56, 56, 56, 60, 60,
+	26, 26, 24, 24, 24, 24, 24, 59, 59, 59,
+	117, 117, 117, 25, 33, 33, 34, 34, 32, 32,
+	27, 27, 28, 28, 28, 28, 108, 108, 108, 30,
+	30, 31, 39, 39, 35, 35, 40, 40, 40, 47,
+	47, 46, 46, 46, 46, 46, 46, 45, 45, 45,
+	44, 44, 43, 43, 36, 36, 36, 38, 37, 110,
+	110, 110, 111, 42, 42, 41, 41, 80, 80, 79,
+	79, 64, 64, 64, 64, 65, 65, 65, 65, 65,
+	65, 65, 65, 65, 65, 65, 66, 66, 66, 66,
+	66, 66, 66, 66, 66, 76, 76, 77, 77, 86,
+	86, 86, 85, 85, 85, 85, 85, 85, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
+	68, 68, 68, 68, 68, 68, 68, 68, 68, 68,
+	68, 68, 70, 91, 91, 91, 84, 71, 71, 71,
+	71, 71, 113, 113, 113, 113, 88, 88, 88, 74,
+	74, 75, 75, 90, 90, 89, 69, 69, 69, 69,
+	69, 69, 69, 69, 92, 92, 92, 92, 93, 93,
+	94, 94, 107, 107, 107, 109, 109, 109, 109, 109,
+	109, 109, 109, 109, 109, 109, 109, 109, 109, 109,
+	109, 109, 109, 109,
 }
 
 var yyR2 = [...]int{
-	0, 1, 1, 3, 1, 2, 1,
+	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 2, 1, 2,
+	4, 0, 1, 1, 1, 1, 1, 3, 1, 1,
+	3, 3, 2, 2, 0, 2, 2, 1, 1, 1,
+	2, 2, 2, 2, 2, 2, 0, 1, 1, 2,
+	3, 3, 4, 1, 1, 1, 1, 1, 1, 4,
+	4, 7, 1, 3, 1, 1, 3, 0, 1, 1,
+	2, 1, 2, 2, 2, 1, 5, 4, 1, 3,
+	1, 1, 1, 4, 4, 4, 2, 3, 0, 3,
+	0, 2, 4, 2, 1, 5, 4, 2, 1, 3,
+	3, 5, 0, 1, 1, 3, 1, 1, 5, 1,
+	3, 3, 5, 1, 1, 4, 2, 3, 5, 2,
+	3, 1, 3, 6, 0, 3, 1, 3, 0, 1,
+	2, 4, 2, 4, 1, 1, 0, 1, 3, 1,
+	3, 3, 0, 1, 1, 0, 2, 2, 0, 2,
+	3, 3, 1, 3, 3, 3, 3, 2, 2, 2,
+	0, 1, 1, 7, 0, 2, 0, 3, 0, 2,
+	1, 3, 1, 2, 3, 5, 0, 1, 2, 0,
+	1, 2, 1, 3, 1, 1, 4, 4, 3, 2,
+	2, 2, 3, 2, 3, 2, 3, 1, 2, 2,
+	0, 1, 2, 4, 1, 3, 3, 1, 2, 0,
+	1, 2, 1, 1, 3, 1, 3, 0, 1, 1,
+	3, 3, 3, 2, 1, 3, 4, 3, 4, 3,
+	4, 5, 6, 3, 4, 1, 3, 4, 4, 5,
+	4, 5, 5, 6, 1, 0, 2, 3, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 1,
+	1, 1, 3, 5, 2, 2, 3, 1, 2, 5,
+	6, 1, 1, 1, 3, 5, 1, 5, 4, 1,
+	1, 1, 1, 1, 1, 1, 0, 1, 1, 0,
+	2, 0, 1, 1, 2, 4, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 2, 3, 0, 3,
+	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -4, -2, -1, -3, 50, 122, 59, -1,
+	-1000, -120, -2, -1, -22, -19, -20, -21, -3, -4,
+	-5, -8, -6, -12, -15, -18, -23, -26, 10, 11,
+	12, 45, 23, 123, -9, -10, -11, 129, -13, -14,
+	-16, -17, 114, -24, -61, 49, 105, 106, 108, 78,
+	109, 110, 112, 113, -25, 36, 9, 143, -52, -51,
+	-59, 17, 6, 7, 8, 29, -37, -41, -107, 52,
+	53, -109, 110, 130, 105, 108, 115, 33, 121, 34,
+	32, 35, 109, 116, 123, 106, 107, 114, 45, 131,
+	111, 13, -107, -97, -115, 127, 128, 124, 125, 24,
+	-112, -107, -7, 131, 130, -22, -19, -20, -21, -41,
+	117, -51, -59, -52, -24, -26, -23, -26, -116, 111,
+	107, 107, -116, -116, -116, -116, 115, 116, 117, 115,
+	116, 117, -63, 35, -62, -107, -88, 25, 24, -1,
+	-58, 19, 20, -25, -26, 18, -117, 24, 25, -117,
+	-117, -41, 23, -110, -111, 26, -107, 104, -41, -112,
+	-87, 87, 88, -87, 104, -7, -7, 131, -41, -57,
+	-58, -25, -26, -51, 51, 51, -118, 118, -118, -118,
+	-119, 118, -119, -119, 50, -63, -49, 49, -27, -28,
+	98, -64, -107, 72, -65, 52, -109, -66, -67, -68,
+	-70, -69, 49, 96, 97, -84, 119, 74, 132, -71,
+	-91, 56, 61, 64, 66, 68, 69, 67, 57, -26,
+	-113, 140, 141, 142, 38, 39, -78, -64, 24, -107,
+	-64, -53, -54, -64, -29, 31, -22, 49, 30, -96,
+	-95, -91, -111, -107, -110, -114, 79, 126, -68, -68,
+	-107, -7, -60, 21, -57, -107, 72, -107, -41, -107,
+	119, -107, -42, -41, -62, 26, -48, -107, -30, 50,
+	-31, 13, -108, 71, 70, -107, 26, 104, -64, 86,
+	-85, 79, 80, 81, 82, 83, 84, 89, 72, 87,
+	88, 73, 91, 93, 92, 96, 97, 98, 99, 100,
+	101, 94, 95, 103, -26, -64, -79, -68, -68, -84,
+	-75, -64, 49, 49, 20, 104, 19, 50, -55, 27,
+	28, -83, 49, -50, -107, 31, -32, 50, 14, 79,
+	-32, -73, 46, -72, -64, 30, 11, -60, 119, 49,
+	50, 49, 51, 50, -32, -28, -39, -35, -36, -40,
+	-37, -38, 49, -26, -64, -64, -107, 98, -107, 67,
+	72, 68, 69, 25, -66, -86, 24, 134, 135, -77,
+	49, -84, 89, 87, 88, 73, -67, -67, -67, -67,
+	-67, -67, -67, -67, -67, -67, -67, -67, -67, -67,
+	-92, -107, 136, 138, 51, 50, -90, -89, 75, -64,
+	-88, 98, -64, -107, -78, -54, -56, 32, 50, -82,
+	-81, -72, 51, 50, -95, -64, -72, -101, -98, -99,
+	-100, -91, 120, 122, -41, -1, -107, -34, 15, 50,
+	-45, -46, -47, 37, 40, 42, 38, 39, 44, 43,
+	-110, -26, -39, 104, 67, 68, 69, 25, 13, -84,
+	-79, -64, -77, -67, -67, -67, -76, 133, -76, 71,
+	-93, 49, 137, -93, 139, -64, -74, -89, 77, -64,
+	26, -80, -79, 51, 104, 33, 34, 49, 51, 50,
+	31, -22, -107, 51, 50, -92, 121, 49, 51, -33,
+	16, 18, -35, -36, -36, -36, 37, 37, 37, 41,
+	37, 41, 37, 41, 37, -46, -49, 51, 98, -107,
+	13, -66, 50, 51, -76, -76, 71, -68, -66, -94,
+	61, -93, 51, 78, -64, 76, -92, 51, -82, -72,
+	-83, -98, -103, -104, -102, 67, 72, 30, 120, 122,
+	49, -105, -106, -91, -64, -79, -44, -43, 46, 47,
+	-43, 37, 37, 37, -66, -64, -66, 51, 50, -64,
+	51, 51, -102, 67, -67, 121, -105, 51, 50, -64,
+	49, 61, 51, -106, -48, 51,
 }
 
 var yyDef = [...]int{
-	0, -2, 1, 2, 4, 6, 0, 5, 3,
+	16, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	10, 11, 12, 13, 14, 15, 113, -2, 0, 0,
+	0, 18, 21, 0, 37, 38, 39, 0, 57, 58,
+	81, 82, 0, 136, 0, 0, 46, 0, 46, 46,
+	46, 46, 0, 0, 152, 0, 296, 16, -2, 0,
+	0, 0, 160, 160, 160, 0, 0, 209, 215, 322,
+	323, 324, 325, 326, 327, 328, 329, 330, 331, 332,
+	333, 334, 335, 336, 337, 338, 339, 340, 341, 342,
+	343, 0, 17, 19, 0, 22, 23, 34, 34, 32,
+	33, 26, 49, 0, 0, 53, 54, 55, 56, 86,
+	0, 128, 0, 137, 136, 0, 0, 136, 40, 47,
+	48, 41, 42, 43, 44, 45, 88, 88, 88, 90,
+	90, 90, 119, 334, 121, 124, 0, 297, 298, 3,
+	117, 0, 0, 154, 156, 0, 157, 161, 162, 158,
+	159, 0, 0, 208, 210, 0, 212, 0, 209, 0,
+	30, 0, 0, 31, 0, 50, 51, 0, 87, 148,
+	129, 153, 155, 128, 150, 151, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 120, 0, 0, 179, 170,
+	172, 176, 283, 0, 224, -2, -2, 235, 244, 269,
+	270, 271, 0, 0, 0, 277, 0, 301, 0, 281,
+	282, 306, 307, 308, 309, 310, 311, 312, 313, 286,
+	0, 289, 290, 291, 294, 295, 130, 134, 135, 283,
+	132, 138, 139, 142, 92, 0, 94, 0, 0, 168,
+	109, 0, 211, 216, 168, 0, 24, 25, 35, 36,
+	27, 52, 115, 0, 148, 59, 0, 60, 0, 83,
+	91, 84, 85, 213, 122, 0, 0, 126, 168, 0,
+	180, 0, 173, 0, 0, 177, 0, 0, 223, 0,
+	0, 252, 253, 254, 255, 256, 257, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, -2, 219, 0, 274, 275, 278,
+	0, 302, 0, 296, 0, 0, 0, 0, 145, 143,
+	144, 93, 102, 0, 98, 97, 108, 0, 0, 0,
+	112, 20, 28, 29, 106, 107, 149, 118, 89, 0,
+	0, 16, 125, 0, 166, 171, 181, 182, 184, 185,
+	204, 209, 0, 207, 221, 222, 178, 174, 284, 225,
+	0, 227, 229, 0, 233, 0, 249, 250, 251, 236,
+	0, 248, 0, 0, 0, 0, 245, 245, 0, 258,
+	259, 260, 261, 262, 263, 264, 265, 266, 267, 268,
+	276, 318, 0, 318, 272, 0, 299, 303, 0, 0,
+	217, 0, 131, 284, 133, 140, 141, 0, 0, 0,
+	103, 104, 0, 0, 110, 169, 111, 0, 62, 64,
+	65, 0, 0, 0, 214, 0, 127, 164, 0, 0,
+	0, 0, 0, 197, 0, 0, 0, 0, 0, 0,
+	124, -2, 0, 0, 226, 228, 230, 0, 0, 234,
+	0, 219, 237, 245, 245, 0, 238, 0, 240, 0,
+	314, 0, 315, 316, 318, 220, 0, 304, 0, 0,
+	0, 0, 218, 288, 0, 146, 147, 102, 100, 0,
+	0, 96, 99, 61, 0, 67, 0, 0, 123, 163,
+	0, 0, 183, 200, 0, 188, 198, 199, 191, 0,
+	193, 0, 195, 0, 189, 190, 205, 206, 175, 285,
+	0, 231, 0, 247, 239, 241, 0, 246, 242, 0,
+	320, 317, 273, 279, 300, 0, 0, 287, 0, 105,
+	95, 63, 66, 68, 69, 71, 0, 0, 0, 75,
+	0, 0, 78, 80, 165, 167, 186, 201, 0, 0,
+	187, 192, 194, 196, 232, 220, 243, 319, 0, 305,
+	280, 101, 70, 72, 73, 74, 0, 77, 0, 202,
+	0, 321, 76, 79, 0, 203,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 84, 3, 3, 3, 112, 104, 3,
-	55, 57, 109, 107, 56, 108, 121, 110, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 122,
-	92, 91, 93, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 100, 93, 3,
+	49, 51, 98, 96, 50, 97, 104, 99, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 143,
+	80, 79, 81, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 114, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 101, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 103, 3, 115,
+	3, 3, 3, 3, 92,
 }
 
 var yyTok2 = [...]int{
@@ -329,13 +1111,15 @@ var yyTok2 = [...]int{
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
 	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
-	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
-	52, 53, 54, 58, 59, 60, 61, 62, 63, 64,
+	42, 43, 44, 45, 46, 47, 48, 52, 53, 54,
+	55, 56, 57, 58, 59, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 82, 83, 85,
-	86, 87, 88, 89, 90, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 105, 106, 111, 113, 116, 117,
-	118, 119, 120,
+	75, 76, 77, 78, 82, 83, 84, 85, 86, 87,
+	88, 89, 90, 91, 94, 95, 102, 103, 105, 106,
+	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
+	117, 118, 119, 120, 121, 122, 123, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 135, 136,
+	137, 138, 139, 140, 141, 142,
 }
 
 var yyTok3 = [...]int{
@@ -689,7 +1473,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line postgresql_sql.y:86
+//line postgresql_sql.y:197
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -697,28 +1481,2302 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line postgresql_sql.y:92
+//line postgresql_sql.y:203
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 5:
+	case 4:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:211
+		{
+			yyLOCAL = yyDollar[1].selectUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 16:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:226
+		{
+			yyLOCAL = tree.Statement(nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 17:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line postgresql_sql.y:103
+//line postgresql_sql.y:232
 		{
-			yyLOCAL = &tree.Use{Name: tree.NewCStr(yyDollar[2].str, 1)}
+			yyLOCAL = &tree.Use{Name: yyDollar[2].cstrUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 6:
+	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line postgresql_sql.y:107
+//line postgresql_sql.y:236
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
+	case 19:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:242
+		{
+			yyLOCAL = &tree.SetVar{Assignments: []*tree.VarAssignmentExpr{yyDollar[2].varAssignmentExprUnion()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 20:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line postgresql_sql.y:248
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				System: true,
+				Name:   yyDollar[2].str,
+				Value:  yyDollar[4].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 21:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:257
+		{
+			yyVAL.str = ""
+		}
+	case 24:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:265
+		{
+			yyVAL.str = "="
+		}
+	case 26:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:272
+		{
+			yyVAL.str = yyDollar[1].cstrUnion().Compare()
+		}
+	case 27:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line postgresql_sql.y:276
+		{
+			yyVAL.str = yyDollar[1].cstrUnion().Compare() + "." + yyDollar[3].cstrUnion().Compare()
+		}
+	case 28:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:282
+		{
+			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
+		}
+		yyVAL.union = yyLOCAL
+	case 30:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:289
+		{
+			yyLOCAL = &tree.ShowTables{Like: yyDollar[3].comparisonExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 31:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:293
+		{
+			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisonExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 32:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:297
+		{
+			yyLOCAL = &tree.ShowVariables{}
+		}
+		yyVAL.union = yyLOCAL
+	case 33:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:301
+		{
+			name := tree.NewNumValWithType(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false, tree.P_char)
+			yyLOCAL = &tree.ShowVariables{Like: tree.NewComparisonExpr(tree.LIKE, nil, name)}
+		}
+		yyVAL.union = yyLOCAL
+	case 34:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.ComparisonExpr
+//line postgresql_sql.y:307
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 35:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.ComparisonExpr
+//line postgresql_sql.y:311
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 36:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.ComparisonExpr
+//line postgresql_sql.y:315
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.ILIKE, nil, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 40:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:326
+		{
+			yyLOCAL = &tree.BeginTransaction{}
+		}
+		yyVAL.union = yyLOCAL
+	case 41:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:330
+		{
+			yyLOCAL = &tree.BeginTransaction{}
+		}
+		yyVAL.union = yyLOCAL
+	case 42:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:336
+		{
+			yyLOCAL = &tree.CommitTransaction{Type: tree.COMPLETION_TYPE_NO_CHAIN}
+		}
+		yyVAL.union = yyLOCAL
+	case 43:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:340
+		{
+			yyLOCAL = &tree.CommitTransaction{Type: tree.COMPLETION_TYPE_NO_CHAIN}
+		}
+		yyVAL.union = yyLOCAL
+	case 44:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:346
+		{
+			yyLOCAL = &tree.RollbackTransaction{Type: tree.COMPLETION_TYPE_NO_CHAIN}
+		}
+		yyVAL.union = yyLOCAL
+	case 45:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:350
+		{
+			yyLOCAL = &tree.RollbackTransaction{Type: tree.COMPLETION_TYPE_NO_CHAIN}
+		}
+		yyVAL.union = yyLOCAL
+	case 46:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:355
+		{
+			yyVAL.str = ""
+		}
+	case 49:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:363
+		{
+			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
+		}
+		yyVAL.union = yyLOCAL
+	case 50:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:367
+		{
+			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
+			explainStmt.Options = tree.MakeOptions(tree.MakeOptionElem("verbose", "NULL"))
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 51:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:373
+		{
+			explainStmt := tree.NewExplainAnalyze(yyDollar[3].statementUnion(), "text")
+			explainStmt.Options = tree.MakeOptions(tree.MakeOptionElem("analyze", "NULL"))
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 52:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:379
+		{
+			explainStmt := tree.NewExplainAnalyze(yyDollar[4].statementUnion(), "text")
+			explainStmt.Options = append(tree.MakeOptions(tree.MakeOptionElem("analyze", "NULL")), tree.MakeOptionElem("verbose", "NULL"))
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 53:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:387
+		{
+			yyLOCAL = yyDollar[1].selectUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 59:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:400
+		{
+			yyLOCAL = &tree.CreateDatabase{IfNotExists: yyDollar[3].boolValUnion(), Name: tree.Identifier(yyDollar[4].cstrUnion().Compare())}
+		}
+		yyVAL.union = yyLOCAL
+	case 60:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:404
+		{
+			yyLOCAL = &tree.CreateDatabase{IfNotExists: yyDollar[3].boolValUnion(), Name: tree.Identifier(yyDollar[4].cstrUnion().Compare())}
+		}
+		yyVAL.union = yyLOCAL
+	case 61:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:410
+		{
+			yyLOCAL = &tree.CreateTable{
+				IfNotExists: yyDollar[3].boolValUnion(),
+				Table:       *yyDollar[4].tableNameUnion(),
+				Defs:        yyDollar[6].tableDefsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 62:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableDefs
+//line postgresql_sql.y:420
+		{
+			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 63:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableDefs
+//line postgresql_sql.y:424
+		{
+			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 66:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:434
+		{
+			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 67:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []tree.ColumnAttribute
+//line postgresql_sql.y:439
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 69:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.ColumnAttribute
+//line postgresql_sql.y:446
+		{
+			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 70:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []tree.ColumnAttribute
+//line postgresql_sql.y:450
+		{
+			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 71:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:456
+		{
+			yyLOCAL = tree.NewAttributeNull(true)
+		}
+		yyVAL.union = yyLOCAL
+	case 72:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:460
+		{
+			yyLOCAL = tree.NewAttributeNull(false)
+		}
+		yyVAL.union = yyLOCAL
+	case 73:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:464
+		{
+			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 74:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:468
+		{
+			yyLOCAL = tree.NewAttributePrimaryKey()
+		}
+		yyVAL.union = yyLOCAL
+	case 75:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ColumnAttribute
+//line postgresql_sql.y:472
+		{
+			yyLOCAL = tree.NewAttributeUnique()
+		}
+		yyVAL.union = yyLOCAL
+	case 76:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:478
+		{
+			yyLOCAL = &tree.PrimaryKeyIndex{KeyParts: yyDollar[4].keyPartsUnion(), Empty: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 77:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.TableDef
+//line postgresql_sql.y:482
+		{
+			yyLOCAL = &tree.UniqueIndex{KeyParts: yyDollar[3].keyPartsUnion(), Empty: true}
+		}
+		yyVAL.union = yyLOCAL
+	case 78:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.KeyPart
+//line postgresql_sql.y:488
+		{
+			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 79:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.KeyPart
+//line postgresql_sql.y:492
+		{
+			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 80:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.KeyPart
+//line postgresql_sql.y:498
+		{
+			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 83:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:508
+		{
+			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].cstrUnion().Compare()), IfExists: yyDollar[3].boolValUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 84:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:512
+		{
+			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].cstrUnion().Compare()), IfExists: yyDollar[3].boolValUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 85:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:518
+		{
+			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 86:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:524
+		{
+			yyLOCAL = tree.NewTruncateTable(yyDollar[2].tableNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 87:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:528
+		{
+			yyLOCAL = tree.NewTruncateTable(yyDollar[3].tableNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 88:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:533
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 89:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:537
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 90:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:542
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 91:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:546
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 92:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:552
+		{
+			ins := yyDollar[4].insertUnion()
+			ins.Table = yyDollar[3].tableNameUnion()
+			yyLOCAL = ins
+		}
+		yyVAL.union = yyLOCAL
+	case 93:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:560
+		{
+			yyLOCAL = &tree.Insert{Rows: tree.NewSelect(tree.NewValuesClause(yyDollar[2].rowsExprsUnion()), nil, nil)}
+		}
+		yyVAL.union = yyLOCAL
+	case 94:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:564
+		{
+			yyLOCAL = &tree.Insert{Rows: yyDollar[1].selectUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 95:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:568
+		{
+			yyLOCAL = &tree.Insert{
+				Columns: yyDollar[2].identifierListUnion(),
+				Rows:    tree.NewSelect(tree.NewValuesClause(yyDollar[5].rowsExprsUnion()), nil, nil),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 96:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:575
+		{
+			yyLOCAL = &tree.Insert{
+				Columns: yyDollar[2].identifierListUnion(),
+				Rows:    yyDollar[4].selectUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 97:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Insert
+//line postgresql_sql.y:582
+		{
+			yyLOCAL = &tree.Insert{Rows: tree.NewSelect(tree.NewValuesClause([]tree.Exprs{nil}), nil, nil)}
+		}
+		yyVAL.union = yyLOCAL
+	case 98:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:588
+		{
+			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
+		}
+		yyVAL.union = yyLOCAL
+	case 99:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:592
+		{
+			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
+		}
+		yyVAL.union = yyLOCAL
+	case 100:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.Exprs
+//line postgresql_sql.y:598
+		{
+			yyLOCAL = []tree.Exprs{yyDollar[2].exprsUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 101:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL []tree.Exprs
+//line postgresql_sql.y:602
+		{
+			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[4].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 102:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:607
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 104:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:614
+		{
+			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 105:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:618
+		{
+			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 107:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:625
+		{
+			yyLOCAL = &tree.DefaultVal{}
+		}
+		yyVAL.union = yyLOCAL
+	case 108:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:631
+		{
+			yyLOCAL = &tree.Update{
+				Tables: tree.TableExprs{yyDollar[2].tableExprUnion()},
+				Exprs:  yyDollar[4].updateExprsUnion(),
+				Where:  yyDollar[5].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 109:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line postgresql_sql.y:641
+		{
+			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 110:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.UpdateExprs
+//line postgresql_sql.y:645
+		{
+			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 111:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UpdateExpr
+//line postgresql_sql.y:651
+		{
+			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 112:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line postgresql_sql.y:657
+		{
+			t := &tree.AliasedTableExpr{
+				Expr: yyDollar[3].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[4].str),
+				},
+			}
+			yyLOCAL = &tree.Delete{
+				Tables: tree.TableExprs{t},
+				Where:  yyDollar[5].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 114:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.Select
+//line postgresql_sql.y:673
+		{
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 115:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Select
+//line postgresql_sql.y:679
+		{
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), SelectLockInfo: yyDollar[4].selectLockInfoUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 116:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Select
+//line postgresql_sql.y:683
+		{
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 117:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Select
+//line postgresql_sql.y:687
+		{
+			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 118:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.Select
+//line postgresql_sql.y:691
+		{
+			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), SelectLockInfo: yyDollar[5].selectLockInfoUnion(), With: yyDollar[1].withClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 119:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.With
+//line postgresql_sql.y:697
+		{
+			yyLOCAL = &tree.With{CTEs: yyDollar[2].cteListUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 120:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.With
+//line postgresql_sql.y:701
+		{
+			yyLOCAL = &tree.With{IsRecursive: true, CTEs: yyDollar[3].cteListUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 121:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.CTE
+//line postgresql_sql.y:707
+		{
+			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 122:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.CTE
+//line postgresql_sql.y:711
+		{
+			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 123:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.CTE
+//line postgresql_sql.y:717
+		{
+			yyLOCAL = &tree.CTE{
+				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].cstrUnion().Compare()), Cols: yyDollar[2].identifierListUnion()},
+				Stmt: yyDollar[5].statementUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 124:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:725
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 125:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:729
+		{
+			yyLOCAL = yyDollar[2].identifierListUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 126:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:735
+		{
+			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].cstrUnion().Compare())}
+		}
+		yyVAL.union = yyLOCAL
+	case 127:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.IdentifierList
+//line postgresql_sql.y:739
+		{
+			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].cstrUnion().Compare()))
+		}
+		yyVAL.union = yyLOCAL
+	case 128:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:744
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 130:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:751
+		{
+			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 131:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:755
+		{
+			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 132:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:759
+		{
+			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 133:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.Limit
+//line postgresql_sql.y:763
+		{
+			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 135:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:770
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 136:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.OrderBy
+//line postgresql_sql.y:775
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 138:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.OrderBy
+//line postgresql_sql.y:782
+		{
+			yyLOCAL = yyDollar[3].orderByUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 139:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.OrderBy
+//line postgresql_sql.y:788
+		{
+			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 140:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.OrderBy
+//line postgresql_sql.y:792
+		{
+			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 141:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.Order
+//line postgresql_sql.y:798
+		{
+			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion(), NullsPosition: yyDollar[3].nullsPositionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 142:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Direction
+//line postgresql_sql.y:803
+		{
+			yyLOCAL = tree.DefaultDirection
+		}
+		yyVAL.union = yyLOCAL
+	case 143:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Direction
+//line postgresql_sql.y:807
+		{
+			yyLOCAL = tree.Ascending
+		}
+		yyVAL.union = yyLOCAL
+	case 144:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Direction
+//line postgresql_sql.y:811
+		{
+			yyLOCAL = tree.Descending
+		}
+		yyVAL.union = yyLOCAL
+	case 145:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.NullsPosition
+//line postgresql_sql.y:816
+		{
+			yyLOCAL = tree.DefaultNullsPosition
+		}
+		yyVAL.union = yyLOCAL
+	case 146:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.NullsPosition
+//line postgresql_sql.y:820
+		{
+			yyLOCAL = tree.NullsFirst
+		}
+		yyVAL.union = yyLOCAL
+	case 147:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.NullsPosition
+//line postgresql_sql.y:824
+		{
+			yyLOCAL = tree.NullsLast
+		}
+		yyVAL.union = yyLOCAL
+	case 148:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.SelectLockInfo
+//line postgresql_sql.y:829
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 149:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.SelectLockInfo
+//line postgresql_sql.y:833
+		{
+			yyLOCAL = &tree.SelectLockInfo{LockType: tree.SelectLockForUpdate}
+		}
+		yyVAL.union = yyLOCAL
+	case 150:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:839
+		{
+			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 151:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:843
+		{
+			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
+		}
+		yyVAL.union = yyLOCAL
+	case 153:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:850
+		{
+			yyLOCAL = &tree.UnionClause{
+				Type:     yyDollar[2].unionTypeRecordUnion().Type,
+				Left:     yyDollar[1].selectStatementUnion(),
+				Right:    yyDollar[3].selectStatementUnion(),
+				All:      yyDollar[2].unionTypeRecordUnion().All,
+				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 154:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:860
+		{
+			yyLOCAL = &tree.UnionClause{
+				Type:     yyDollar[2].unionTypeRecordUnion().Type,
+				Left:     yyDollar[1].selectStatementUnion(),
+				Right:    yyDollar[3].selectStatementUnion(),
+				All:      yyDollar[2].unionTypeRecordUnion().All,
+				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 155:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:870
+		{
+			yyLOCAL = &tree.UnionClause{
+				Type:     yyDollar[2].unionTypeRecordUnion().Type,
+				Left:     yyDollar[1].selectStatementUnion(),
+				Right:    yyDollar[3].selectStatementUnion(),
+				All:      yyDollar[2].unionTypeRecordUnion().All,
+				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 156:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:880
+		{
+			yyLOCAL = &tree.UnionClause{
+				Type:     yyDollar[2].unionTypeRecordUnion().Type,
+				Left:     yyDollar[1].selectStatementUnion(),
+				Right:    yyDollar[3].selectStatementUnion(),
+				All:      yyDollar[2].unionTypeRecordUnion().All,
+				Distinct: yyDollar[2].unionTypeRecordUnion().Distinct,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 157:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:892
+		{
+			yyLOCAL = &tree.UnionTypeRecord{Type: tree.UNION, All: !yyDollar[2].boolValUnion(), Distinct: yyDollar[2].boolValUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 158:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:896
+		{
+			yyLOCAL = &tree.UnionTypeRecord{Type: tree.EXCEPT, All: !yyDollar[2].boolValUnion(), Distinct: yyDollar[2].boolValUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 159:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.UnionTypeRecord
+//line postgresql_sql.y:900
+		{
+			yyLOCAL = &tree.UnionTypeRecord{Type: tree.INTERSECT, All: !yyDollar[2].boolValUnion(), Distinct: yyDollar[2].boolValUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 160:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:906
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 161:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:910
+		{
+			yyLOCAL = false
+		}
+		yyVAL.union = yyLOCAL
+	case 162:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL bool
+//line postgresql_sql.y:914
+		{
+			yyLOCAL = true
+		}
+		yyVAL.union = yyLOCAL
+	case 163:
+		yyDollar = yyS[yypt-7 : yypt+1]
+		var yyLOCAL tree.SelectStatement
+//line postgresql_sql.y:920
+		{
+			yyLOCAL = &tree.SelectClause{
+				Distinct: yyDollar[2].funcTypeUnion() == tree.FUNC_TYPE_DISTINCT,
+				Exprs:    yyDollar[3].selectExprsUnion(),
+				From:     yyDollar[4].fromUnion(),
+				Where:    yyDollar[5].whereUnion(),
+				GroupBy:  yyDollar[6].groupByUnion(),
+				Having:   yyDollar[7].whereUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 164:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Where
+//line postgresql_sql.y:932
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 165:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Where
+//line postgresql_sql.y:936
+		{
+			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 166:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line postgresql_sql.y:941
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 167:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.GroupBy
+//line postgresql_sql.y:945
+		{
+			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 168:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.Where
+//line postgresql_sql.y:950
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 169:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.Where
+//line postgresql_sql.y:954
+		{
+			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 170:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SelectExprs
+//line postgresql_sql.y:960
+		{
+			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 171:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectExprs
+//line postgresql_sql.y:964
+		{
+			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 172:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.SelectExpr
+//line postgresql_sql.y:970
+		{
+			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
+		}
+		yyVAL.union = yyLOCAL
+	case 173:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.SelectExpr
+//line postgresql_sql.y:974
+		{
+			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].cstrUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 174:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.SelectExpr
+//line postgresql_sql.y:978
+		{
+			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].cstrUnion().Compare())}
+		}
+		yyVAL.union = yyLOCAL
+	case 175:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.SelectExpr
+//line postgresql_sql.y:982
+		{
+			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].cstrUnion().Compare(), yyDollar[1].cstrUnion().Compare())}
+		}
+		yyVAL.union = yyLOCAL
+	case 176:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.CStr
+//line postgresql_sql.y:987
+		{
+			yyLOCAL = tree.NewCStr("", 0)
+		}
+		yyVAL.union = yyLOCAL
+	case 178:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.CStr
+//line postgresql_sql.y:992
+		{
+			yyLOCAL = yyDollar[2].cstrUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 179:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.From
+//line postgresql_sql.y:997
+		{
+			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+			tn := tree.NewTableName(tree.Identifier(""), prefix)
+			yyLOCAL = &tree.From{
+				Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: tn}},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 181:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.From
+//line postgresql_sql.y:1008
+		{
+			yyLOCAL = &tree.From{
+				Tables: tree.TableExprs{yyDollar[2].joinTableExprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 182:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.JoinTableExpr
+//line postgresql_sql.y:1016
+		{
+			if t, ok := yyDollar[1].tableExprUnion().(*tree.JoinTableExpr); ok {
+				yyLOCAL = t
+			} else {
+				yyLOCAL = &tree.JoinTableExpr{Left: yyDollar[1].tableExprUnion(), Right: nil, JoinType: tree.JOIN_TYPE_CROSS}
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 183:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.JoinTableExpr
+//line postgresql_sql.y:1024
+		{
+			yyLOCAL = &tree.JoinTableExpr{Left: yyDollar[1].joinTableExprUnion(), Right: yyDollar[3].tableExprUnion(), JoinType: tree.JOIN_TYPE_CROSS}
+		}
+		yyVAL.union = yyLOCAL
+	case 185:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:1031
+		{
+			yyLOCAL = yyDollar[1].joinTableExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 186:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.JoinTableExpr
+//line postgresql_sql.y:1037
+		{
+			yyLOCAL = &tree.JoinTableExpr{
+				Left:     yyDollar[1].tableExprUnion(),
+				JoinType: yyDollar[2].joinTypeUnion(),
+				Right:    yyDollar[3].tableExprUnion(),
+				Cond:     yyDollar[4].joinCondUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 187:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.JoinTableExpr
+//line postgresql_sql.y:1046
+		{
+			yyLOCAL = &tree.JoinTableExpr{
+				Left:     yyDollar[1].tableExprUnion(),
+				JoinType: yyDollar[2].joinTypeUnion(),
+				Right:    yyDollar[3].tableExprUnion(),
+				Cond:     yyDollar[4].joinCondUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 188:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.JoinTableExpr
+//line postgresql_sql.y:1055
+		{
+			yyLOCAL = &tree.JoinTableExpr{
+				Left:     yyDollar[1].tableExprUnion(),
+				JoinType: yyDollar[2].joinTypeUnion(),
+				Right:    yyDollar[3].tableExprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 189:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1065
+		{
+			yyLOCAL = tree.JOIN_TYPE_NATURAL
+		}
+		yyVAL.union = yyLOCAL
+	case 190:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1069
+		{
+			if yyDollar[2].joinTypeUnion() == tree.JOIN_TYPE_LEFT {
+				yyLOCAL = tree.JOIN_TYPE_NATURAL_LEFT
+			} else {
+				yyLOCAL = tree.JOIN_TYPE_NATURAL_RIGHT
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 191:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1079
+		{
+			yyLOCAL = tree.JOIN_TYPE_LEFT
+		}
+		yyVAL.union = yyLOCAL
+	case 192:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1083
+		{
+			yyLOCAL = tree.JOIN_TYPE_LEFT
+		}
+		yyVAL.union = yyLOCAL
+	case 193:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1087
+		{
+			yyLOCAL = tree.JOIN_TYPE_RIGHT
+		}
+		yyVAL.union = yyLOCAL
+	case 194:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1091
+		{
+			yyLOCAL = tree.JOIN_TYPE_RIGHT
+		}
+		yyVAL.union = yyLOCAL
+	case 195:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1095
+		{
+			yyLOCAL = tree.JOIN_TYPE_FULL
+		}
+		yyVAL.union = yyLOCAL
+	case 196:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1099
+		{
+			yyLOCAL = tree.JOIN_TYPE_FULL
+		}
+		yyVAL.union = yyLOCAL
+	case 197:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1105
+		{
+			yyLOCAL = tree.JOIN_TYPE_INNER
+		}
+		yyVAL.union = yyLOCAL
+	case 198:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1109
+		{
+			yyLOCAL = tree.JOIN_TYPE_INNER
+		}
+		yyVAL.union = yyLOCAL
+	case 199:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL string
+//line postgresql_sql.y:1113
+		{
+			yyLOCAL = tree.JOIN_TYPE_CROSS
+		}
+		yyVAL.union = yyLOCAL
+	case 200:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.JoinCond
+//line postgresql_sql.y:1119
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 202:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.JoinCond
+//line postgresql_sql.y:1126
+		{
+			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 203:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.JoinCond
+//line postgresql_sql.y:1130
+		{
+			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 205:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:1137
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableExprUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[2].str),
+					Cols:  yyDollar[3].identifierListUnion(),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 206:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:1147
+		{
+			yyLOCAL = yyDollar[2].joinTableExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 207:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:1153
+		{
+			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[1].selectStatementUnion().(*tree.ParenSelect).Select}
+		}
+		yyVAL.union = yyLOCAL
+	case 208:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.TableExpr
+//line postgresql_sql.y:1159
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[2].str),
+				},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 209:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line postgresql_sql.y:1169
+		{
+			yyVAL.str = ""
+		}
+	case 211:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line postgresql_sql.y:1174
+		{
+			yyVAL.str = yyDollar[2].str
+		}
+	case 212:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line postgresql_sql.y:1180
+		{
+			yyVAL.str = yyDollar[1].cstrUnion().Compare()
+		}
+	case 213:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.TableNames
+//line postgresql_sql.y:1186
+		{
+			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 214:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.TableNames
+//line postgresql_sql.y:1190
+		{
+			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 215:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.TableName
+//line postgresql_sql.y:1196
+		{
+			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].cstrUnion().Compare()), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 216:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.TableName
+//line postgresql_sql.y:1201
+		{
+			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].cstrUnion().Compare()), ExplicitSchema: true}
+			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].cstrUnion().Compare()), prefix)
+		}
+		yyVAL.union = yyLOCAL
+	case 217:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:1207
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 219:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:1214
+		{
+			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 220:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line postgresql_sql.y:1218
+		{
+			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 221:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1225
+		{
+			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 222:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1229
+		{
+			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 223:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1233
+		{
+			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 225:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1240
+		{
+			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 226:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1244
+		{
+			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 227:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1248
+		{
+			yyLOCAL = tree.NewIsTrueExpr(yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 228:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1252
+		{
+			yyLOCAL = tree.NewIsNotTrueExpr(yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 229:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1256
+		{
+			yyLOCAL = tree.NewIsFalseExpr(yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 230:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1260
+		{
+			yyLOCAL = tree.NewIsNotFalseExpr(yyDollar[1].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 231:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1264
+		{
+			yyLOCAL = tree.NewNotExpr(tree.NewComparisonExpr(tree.NULL_SAFE_EQUAL, yyDollar[1].exprUnion(), yyDollar[5].exprUnion()))
+		}
+		yyVAL.union = yyLOCAL
+	case 232:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1268
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.NULL_SAFE_EQUAL, yyDollar[1].exprUnion(), yyDollar[6].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 233:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1272
+		{
+			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 234:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1276
+		{
+			yyLOCAL = tree.NewSubqueryComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[3].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[4].subqueryUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 236:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1283
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 237:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1287
+		{
+			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 238:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1291
+		{
+			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 239:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1295
+		{
+			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 240:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1299
+		{
+			yyLOCAL = tree.NewComparisonExprWithEscape(tree.ILIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 241:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1303
+		{
+			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_ILIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 242:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1307
+		{
+			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 243:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1311
+		{
+			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 245:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1317
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 246:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1321
+		{
+			yyLOCAL = yyDollar[2].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 247:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1327
+		{
+			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 248:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1331
+		{
+			yyLOCAL = yyDollar[1].subqueryUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 249:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:1337
+		{
+			yyLOCAL = tree.ALL
+		}
+		yyVAL.union = yyLOCAL
+	case 250:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:1341
+		{
+			yyLOCAL = tree.ANY
+		}
+		yyVAL.union = yyLOCAL
+	case 251:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:1345
+		{
+			yyLOCAL = tree.SOME
+		}
+		yyVAL.union = yyLOCAL
+	case 252:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:1351
+		{
+			yyLOCAL = tree.EQUAL
+		}
+		yyVAL.union = yyLOCAL
+	case 253:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:1355
+		{
+			yyLOCAL = tree.LESS_THAN
+		}
+		yyVAL.union = yyLOCAL
+	case 254:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:1359
+		{
+			yyLOCAL = tree.GREAT_THAN
+		}
+		yyVAL.union = yyLOCAL
+	case 255:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:1363
+		{
+			yyLOCAL = tree.LESS_THAN_EQUAL
+		}
+		yyVAL.union = yyLOCAL
+	case 256:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:1367
+		{
+			yyLOCAL = tree.GREAT_THAN_EQUAL
+		}
+		yyVAL.union = yyLOCAL
+	case 257:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.ComparisonOp
+//line postgresql_sql.y:1371
+		{
+			yyLOCAL = tree.NOT_EQUAL
+		}
+		yyVAL.union = yyLOCAL
+	case 258:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1377
+		{
+			name := tree.SetUnresolvedName("concat")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].exprUnion(), yyDollar[3].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 259:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1385
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 260:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1389
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 261:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1393
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 262:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1397
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 263:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1401
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 264:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1405
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 265:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1409
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 266:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1413
+		{
+			name := tree.SetUnresolvedName("power")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].exprUnion(), yyDollar[3].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 267:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1421
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 268:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1425
+		{
+			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 272:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1434
+		{
+			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 273:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1438
+		{
+			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
+		}
+		yyVAL.union = yyLOCAL
+	case 274:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1442
+		{
+			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 275:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1446
+		{
+			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 276:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1450
+		{
+			yyLOCAL = tree.NewCastExpr(yyDollar[1].exprUnion(), yyDollar[3].columnTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 277:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1454
+		{
+			yyLOCAL = yyDollar[1].subqueryUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 278:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1458
+		{
+			yyDollar[2].subqueryUnion().Exists = true
+			yyLOCAL = yyDollar[2].subqueryUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 279:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1463
+		{
+			yyLOCAL = &tree.CaseExpr{
+				Expr:  yyDollar[2].exprUnion(),
+				Whens: yyDollar[3].whenClauseListUnion(),
+				Else:  yyDollar[4].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 280:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1471
+		{
+			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 282:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1478
+		{
+			yyLOCAL = yyDollar[1].unresolvedNameUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 283:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:1484
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare())
+		}
+		yyVAL.union = yyLOCAL
+	case 284:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:1488
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare())
+		}
+		yyVAL.union = yyLOCAL
+	case 285:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.UnresolvedName
+//line postgresql_sql.y:1492
+		{
+			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].cstrUnion().Compare(), yyDollar[3].cstrUnion().Compare(), yyDollar[5].cstrUnion().Compare())
+		}
+		yyVAL.union = yyLOCAL
+	case 286:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.Subquery
+//line postgresql_sql.y:1498
+		{
+			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
+		}
+		yyVAL.union = yyLOCAL
+	case 287:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1504
+		{
+			name := tree.SetUnresolvedName(yyDollar[1].str)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[4].exprsUnion(),
+				Type:  yyDollar[3].funcTypeUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 288:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1513
+		{
+			name := tree.SetUnresolvedName(yyDollar[1].str)
+			es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{es},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 289:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1522
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func: tree.FuncName2ResolvableFunctionReference(name),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 290:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1529
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func: tree.FuncName2ResolvableFunctionReference(name),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 291:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1536
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func: tree.FuncName2ResolvableFunctionReference(name),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 296:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.FuncType
+//line postgresql_sql.y:1550
+		{
+			yyLOCAL = tree.FUNC_TYPE_DEFAULT
+		}
+		yyVAL.union = yyLOCAL
+	case 297:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FuncType
+//line postgresql_sql.y:1554
+		{
+			yyLOCAL = tree.FUNC_TYPE_DISTINCT
+		}
+		yyVAL.union = yyLOCAL
+	case 298:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.FuncType
+//line postgresql_sql.y:1558
+		{
+			yyLOCAL = tree.FUNC_TYPE_ALL
+		}
+		yyVAL.union = yyLOCAL
+	case 299:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1563
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 300:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1567
+		{
+			yyLOCAL = yyDollar[2].exprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 301:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1572
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 303:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.When
+//line postgresql_sql.y:1579
+		{
+			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 304:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL []*tree.When
+//line postgresql_sql.y:1583
+		{
+			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 305:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.When
+//line postgresql_sql.y:1589
+		{
+			yyLOCAL = &tree.When{
+				Cond: yyDollar[2].exprUnion(),
+				Val:  yyDollar[4].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 306:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1598
+		{
+			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_char)
+		}
+		yyVAL.union = yyLOCAL
+	case 307:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1602
+		{
+			str := fmt.Sprintf("%v", yyDollar[1].item)
+			switch v := yyDollar[1].item.(type) {
+			case uint64:
+				yyLOCAL = tree.NewNumValWithType(constant.MakeUint64(v), str, false, tree.P_uint64)
+			case int64:
+				yyLOCAL = tree.NewNumValWithType(constant.MakeInt64(v), str, false, tree.P_int64)
+			default:
+				yylex.Error("parse integral fail")
+				return 1
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 308:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1615
+		{
+			fval := yyDollar[1].item.(float64)
+			yyLOCAL = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
+		}
+		yyVAL.union = yyLOCAL
+	case 309:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1620
+		{
+			yyLOCAL = tree.NewNumValWithType(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false, tree.P_decimal)
+		}
+		yyVAL.union = yyLOCAL
+	case 310:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1624
+		{
+			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
+		}
+		yyVAL.union = yyLOCAL
+	case 311:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1628
+		{
+			yyLOCAL = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
+		}
+		yyVAL.union = yyLOCAL
+	case 312:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1632
+		{
+			yyLOCAL = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
+		}
+		yyVAL.union = yyLOCAL
+	case 313:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line postgresql_sql.y:1636
+		{
+			yyLOCAL = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
+		}
+		yyVAL.union = yyLOCAL
+	case 314:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:1642
+		{
+			t, err := makeType(yyDollar[1].cstrUnion().Compare(), yyDollar[2].typeModifiersUnion())
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyLOCAL = t
+		}
+		yyVAL.union = yyLOCAL
+	case 315:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:1651
+		{
+			t, err := makeType("float8", nil)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyLOCAL = t
+		}
+		yyVAL.union = yyLOCAL
+	case 316:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:1660
+		{
+			t, err := makeType("bpchar", yyDollar[2].typeModifiersUnion())
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyLOCAL = t
+		}
+		yyVAL.union = yyLOCAL
+	case 317:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.T
+//line postgresql_sql.y:1669
+		{
+			t, err := makeType("varchar", yyDollar[3].typeModifiersUnion())
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyLOCAL = t
+		}
+		yyVAL.union = yyLOCAL
+	case 318:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL []int32
+//line postgresql_sql.y:1679
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 319:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []int32
+//line postgresql_sql.y:1683
+		{
+			yyLOCAL = yyDollar[2].typeModifiersUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 320:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []int32
+//line postgresql_sql.y:1689
+		{
+			v, ok := yyDollar[1].item.(int64)
+			if !ok || v > int64(^uint32(0)>>1) {
+				yylex.Error("type modifier is out of range")
+				return 1
+			}
+			yyLOCAL = []int32{int32(v)}
+		}
+		yyVAL.union = yyLOCAL
+	case 321:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []int32
+//line postgresql_sql.y:1698
+		{
+			v, ok := yyDollar[3].item.(int64)
+			if !ok || v > int64(^uint32(0)>>1) {
+				yylex.Error("type modifier is out of range")
+				return 1
+			}
+			yyLOCAL = append(yyDollar[1].typeModifiersUnion(), int32(v))
+		}
+		yyVAL.union = yyLOCAL
+	case 322:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.CStr
+//line postgresql_sql.y:1709
+		{
+			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
+		}
+		yyVAL.union = yyLOCAL
+	case 323:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.CStr
+//line postgresql_sql.y:1713
+		{
+			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
+		}
+		yyVAL.union = yyLOCAL
+	case 324:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.CStr
+//line postgresql_sql.y:1717
+		{
+			yyLOCAL = tree.NewCStr(yyDollar[1].str, yylex.(*Lexer).lower)
+		}
+		yyVAL.union = yyLOCAL
 	}
 	goto yystack /* stack new state and value */
 }
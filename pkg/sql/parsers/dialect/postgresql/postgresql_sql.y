@@ -14,8 +14,12 @@
 
 %{
 package postgresql
-    
+
 import (
+    "fmt"
+    "go/constant"
+    "strings"
+
     "github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 %}
@@ -29,50 +33,157 @@ import (
 %union {
     statement tree.Statement
     statements []tree.Statement
+
+    select *tree.Select
+    selectStatement tree.SelectStatement
+    selectExprs tree.SelectExprs
+    selectExpr tree.SelectExpr
+    insert *tree.Insert
+    from *tree.From
+    where *tree.Where
+    groupBy tree.GroupBy
+    tableExprs tree.TableExprs
+    tableExpr tree.TableExpr
+    joinTableExpr *tree.JoinTableExpr
+    tableName *tree.TableName
+    tableNames tree.TableNames
+    joinCond tree.JoinCond
+    joinType string
+    identifierList tree.IdentifierList
+    orderBy tree.OrderBy
+    order *tree.Order
+    direction tree.Direction
+    nullsPosition tree.NullsPosition
+    limit *tree.Limit
+    unionTypeRecord *tree.UnionTypeRecord
+    selectLockInfo *tree.SelectLockInfo
+    withClause *tree.With
+    cte *tree.CTE
+    cteList []*tree.CTE
+
+    expr tree.Expr
+    exprs tree.Exprs
+    rowsExprs []tree.Exprs
+    subquery *tree.Subquery
+    comparisonOp tree.ComparisonOp
+    comparisonExpr *tree.ComparisonExpr
+    funcType tree.FuncType
+    whenClause *tree.When
+    whenClauseList []*tree.When
+    unresolvedName *tree.UnresolvedName
+    columnType *tree.T
+    typeModifiers []int32
+    updateExpr *tree.UpdateExpr
+    updateExprs tree.UpdateExprs
+    varAssignmentExpr *tree.VarAssignmentExpr
+    tableDef tree.TableDef
+    tableDefs tree.TableDefs
+    columnAttribute tree.ColumnAttribute
+    columnAttributes []tree.ColumnAttribute
+    keyParts []*tree.KeyPart
+    keyPart *tree.KeyPart
+    cstr *tree.CStr
+    boolVal bool
 }
 
 %token LEX_ERROR
 %nonassoc EMPTY
-%left <str> UNION
-%token <str> SELECT STREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
+%left <str> UNION EXCEPT INTERSECT
+%token <str> SELECT INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %nonassoc LOWER_THAN_SET
 %nonassoc <str> SET
-%token <str> ALL DISTINCT DISTINCTROW AS EXISTS ASC DESC INTO DUPLICATE DEFAULT LOCK KEYS
-%token <str> VALUES
-%token <str> NEXT VALUE SHARE MODE
-%token <str> SQL_NO_CACHE SQL_CACHE
-%left <str> JOIN STRAIGHT_JOIN LEFT RIGHT INNER OUTER CROSS NATURAL USE FORCE
+%token <str> ALL DISTINCT AS ASC DESC INTO DEFAULT VALUES NULLS FIRST LAST RECURSIVE WITH
+%left <str> JOIN LEFT RIGHT INNER OUTER CROSS NATURAL FULL USE
 %left <str> ON USING
 %left <str> SUBQUERY_AS_EXPR
 %left <str> '(' ',' ')'
-%nonassoc LOWER_THAN_STRING
-%nonassoc <str> ID AT_ID AT_AT_ID STRING VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD
+%nonassoc <str> ID QUOTE_ID AT_ID AT_AT_ID STRING VALUE_ARG LIST_ARG COMMENT COMMENT_KEYWORD
 %token <item> INTEGRAL HEX BIT_LITERAL FLOAT HEXNUM
+%token <str> DECIMAL_VALUE
 %token <str> NULL TRUE FALSE
-%nonassoc LOWER_THAN_CHARSET
-%nonassoc <str> CHARSET
-%right <str> UNIQUE KEY
-%left <str> OR PIPE_CONCAT
-%left <str> XOR
+%left <str> OR
 %left <str> AND
-%right <str> NOT '!'
+%right <str> NOT
 %left <str> BETWEEN CASE WHEN THEN ELSE END
-%left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE REGEXP IN ASSIGNMENT
+%left <str> '=' '<' '>' LE GE NE NULL_SAFE_EQUAL IS LIKE ILIKE IN ASSIGNMENT
+%left <str> PIPE_CONCAT
 %left <str> '|'
 %left <str> '&'
 %left <str> SHIFT_LEFT SHIFT_RIGHT
 %left <str> '+' '-'
-%left <str> '*' '/' DIV '%' MOD
+%left <str> '*' '/' '%'
 %left <str> '^'
-%right <str> '~' UNARY
-%left <str> COLLATE
-%right <str> BINARY UNDERSCORE_BINARY
-%right <str> INTERVAL
+%right <str> UNARY
+%left <str> TYPECAST
 %nonassoc <str> '.'
 
+%token <str> BEGIN START TRANSACTION COMMIT ROLLBACK ABORT WORK
+%token <str> CREATE DROP TRUNCATE DATABASE SCHEMA TABLE IF EXISTS PRIMARY KEY UNIQUE
+%token <str> SHOW TABLES DATABASES TO SESSION LOCAL EXPLAIN ANALYZE VERBOSE
+%token <str> CAST ESCAPE ANY SOME
+%token <str> DOUBLE PRECISION CHARACTER VARYING
+%token <str> CURRENT_DATE CURRENT_TIMESTAMP CURRENT_USER
+
 %type <statement> stmt
 %type <statements> stmt_list
-%type <statement> use_stmt
+%type <statement> use_stmt set_stmt show_stmt explain_stmt explainable_stmt
+%type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
+%type <statement> create_stmt create_database_stmt create_table_stmt
+%type <statement> drop_stmt drop_database_stmt drop_table_stmt truncate_table_stmt
+%type <statement> insert_stmt update_stmt delete_stmt
+%type <select> select_stmt select_no_parens
+%type <selectStatement> simple_select simple_select_clause select_with_parens
+%type <selectExprs> select_expression_list
+%type <selectExpr> select_expression
+%type <insert> insert_data
+%type <from> from_opt from_clause
+%type <where> where_expression_opt having_opt
+%type <groupBy> group_by_opt
+%type <tableExpr> table_reference table_factor aliased_table_name table_subquery
+%type <joinTableExpr> table_references join_table
+%type <tableName> table_name
+%type <tableNames> table_name_list
+%type <joinCond> join_condition join_condition_opt
+%type <joinType> inner_join outer_join natural_join
+%type <identifierList> column_list column_list_opt insert_column_list
+%type <orderBy> order_by_opt order_by_clause order_list
+%type <order> order
+%type <direction> asc_desc_opt
+%type <nullsPosition> nulls_first_last_opt
+%type <limit> limit_opt limit_clause
+%type <unionTypeRecord> union_op
+%type <selectLockInfo> select_lock_opt
+%type <withClause> with_clause
+%type <cte> common_table_expr
+%type <cteList> cte_list
+
+%type <expr> expression boolean_primary predicate bit_expr simple_expr literal column_ref
+%type <expr> function_call expr_or_default set_expr else_opt expression_opt like_escape_opt
+%type <expr> col_tuple limit_count
+%type <exprs> expression_list expression_list_opt data_values data_opt
+%type <rowsExprs> values_list
+%type <subquery> subquery
+%type <comparisonOp> comparison_operator and_or_some
+%type <comparisonExpr> like_opt
+%type <funcType> func_type_opt
+%type <whenClause> when_clause
+%type <whenClauseList> when_clause_list
+%type <unresolvedName> column_name
+%type <columnType> type_name
+%type <typeModifiers> type_modifiers_opt type_modifier_list
+%type <updateExpr> update_value
+%type <updateExprs> update_list
+%type <varAssignmentExpr> var_assignment
+%type <tableDef> table_elem column_def constraint_def
+%type <tableDefs> table_elem_list
+%type <columnAttribute> column_attribute
+%type <columnAttributes> column_attribute_list_opt column_attribute_list
+%type <keyParts> index_column_list
+%type <keyPart> index_column
+%type <cstr> ident as_name_opt
+%type <str> non_reserved_keyword as_opt_id table_alias var_name func_name
+%type <str> equal_or_to session_scope_opt work_opt
+%type <boolVal> distinct_opt not_exists_opt exists_opt
 
 %start start_command
 
@@ -96,15 +207,1536 @@ stmt_list:
     }
 
 stmt:
-   use_stmt 
+    select_stmt
+    {
+        $$ = $1
+    }
+|   insert_stmt
+|   update_stmt
+|   delete_stmt
+|   use_stmt
+|   set_stmt
+|   show_stmt
+|   transaction_stmt
+|   explain_stmt
+|   create_stmt
+|   drop_stmt
+|   truncate_table_stmt
+|   /* EMPTY */
+    {
+        $$ = tree.Statement(nil)
+    }
 
 use_stmt:
-    USE ID
+    USE ident
     {
-        $$ = &tree.Use{Name: tree.NewCStr($2, 1)}
+        $$ = &tree.Use{Name: $2}
     }
 |   USE
     {
         $$ = &tree.Use{}
     }
+
+set_stmt:
+    SET var_assignment
+    {
+        $$ = &tree.SetVar{Assignments: []*tree.VarAssignmentExpr{$2}}
+    }
+
+var_assignment:
+    session_scope_opt var_name equal_or_to set_expr
+    {
+        $$ = &tree.VarAssignmentExpr{
+            System: true,
+            Name: $2,
+            Value: $4,
+        }
+    }
+
+session_scope_opt:
+    {
+        $$ = ""
+    }
+|   SESSION
+|   LOCAL
+
+equal_or_to:
+    '='
+    {
+        $$ = "="
+    }
+|   TO
+
+var_name:
+    ident
+    {
+        $$ = $1.Compare()
+    }
+|   ident '.' ident
+    {
+        $$ = $1.Compare() + "." + $3.Compare()
+    }
+
+set_expr:
+    ON
+    {
+        $$ = tree.NewNumValWithType(constant.MakeString($1), $1, false, tree.P_char)
+    }
+|   expr_or_default
+
+show_stmt:
+    SHOW TABLES like_opt
+    {
+        $$ = &tree.ShowTables{Like: $3}
+    }
+|   SHOW DATABASES like_opt
+    {
+        $$ = &tree.ShowDatabases{Like: $3}
+    }
+|   SHOW ALL
+    {
+        $$ = &tree.ShowVariables{}
+    }
+|   SHOW var_name
+    {
+        name := tree.NewNumValWithType(constant.MakeString($2), $2, false, tree.P_char)
+        $$ = &tree.ShowVariables{Like: tree.NewComparisonExpr(tree.LIKE, nil, name)}
+    }
+
+like_opt:
+    {
+        $$ = nil
+    }
+|   LIKE simple_expr
+    {
+        $$ = tree.NewComparisonExpr(tree.LIKE, nil, $2)
+    }
+|   ILIKE simple_expr
+    {
+        $$ = tree.NewComparisonExpr(tree.ILIKE, nil, $2)
+    }
+
+transaction_stmt:
+    begin_stmt
+|   commit_stmt
+|   rollback_stmt
+
+begin_stmt:
+    BEGIN work_opt
+    {
+        $$ = &tree.BeginTransaction{}
+    }
+|   START TRANSACTION
+    {
+        $$ = &tree.BeginTransaction{}
+    }
+
+commit_stmt:
+    COMMIT work_opt
+    {
+        $$ = &tree.CommitTransaction{Type: tree.COMPLETION_TYPE_NO_CHAIN}
+    }
+|   END work_opt
+    {
+        $$ = &tree.CommitTransaction{Type: tree.COMPLETION_TYPE_NO_CHAIN}
+    }
+
+rollback_stmt:
+    ROLLBACK work_opt
+    {
+        $$ = &tree.RollbackTransaction{Type: tree.COMPLETION_TYPE_NO_CHAIN}
+    }
+|   ABORT work_opt
+    {
+        $$ = &tree.RollbackTransaction{Type: tree.COMPLETION_TYPE_NO_CHAIN}
+    }
+
+work_opt:
+    {
+        $$ = ""
+    }
+|   WORK
+|   TRANSACTION
+
+explain_stmt:
+    EXPLAIN explainable_stmt
+    {
+        $$ = tree.NewExplainStmt($2, "text")
+    }
+|   EXPLAIN VERBOSE explainable_stmt
+    {
+        explainStmt := tree.NewExplainStmt($3, "text")
+        explainStmt.Options = tree.MakeOptions(tree.MakeOptionElem("verbose", "NULL"))
+        $$ = explainStmt
+    }
+|   EXPLAIN ANALYZE explainable_stmt
+    {
+        explainStmt := tree.NewExplainAnalyze($3, "text")
+        explainStmt.Options = tree.MakeOptions(tree.MakeOptionElem("analyze", "NULL"))
+        $$ = explainStmt
+    }
+|   EXPLAIN ANALYZE VERBOSE explainable_stmt
+    {
+        explainStmt := tree.NewExplainAnalyze($4, "text")
+        explainStmt.Options = append(tree.MakeOptions(tree.MakeOptionElem("analyze", "NULL")), tree.MakeOptionElem("verbose", "NULL"))
+        $$ = explainStmt
+    }
+
+explainable_stmt:
+    select_stmt
+    {
+        $$ = $1
+    }
+|   insert_stmt
+|   update_stmt
+|   delete_stmt
+
+create_stmt:
+    create_database_stmt
+|   create_table_stmt
+
+create_database_stmt:
+    CREATE DATABASE not_exists_opt ident
+    {
+        $$ = &tree.CreateDatabase{IfNotExists: $3, Name: tree.Identifier($4.Compare())}
+    }
+|   CREATE SCHEMA not_exists_opt ident
+    {
+        $$ = &tree.CreateDatabase{IfNotExists: $3, Name: tree.Identifier($4.Compare())}
+    }
+
+create_table_stmt:
+    CREATE TABLE not_exists_opt table_name '(' table_elem_list ')'
+    {
+        $$ = &tree.CreateTable{
+            IfNotExists: $3,
+            Table: *$4,
+            Defs: $6,
+        }
+    }
+
+table_elem_list:
+    table_elem
+    {
+        $$ = tree.TableDefs{$1}
+    }
+|   table_elem_list ',' table_elem
+    {
+        $$ = append($1, $3)
+    }
+
+table_elem:
+    column_def
+|   constraint_def
+
+column_def:
+    column_name type_name column_attribute_list_opt
+    {
+        $$ = tree.NewColumnTableDef($1, $2, $3)
+    }
+
+column_attribute_list_opt:
+    {
+        $$ = nil
+    }
+|   column_attribute_list
+
+column_attribute_list:
+    column_attribute
+    {
+        $$ = []tree.ColumnAttribute{$1}
+    }
+|   column_attribute_list column_attribute
+    {
+        $$ = append($1, $2)
+    }
+
+column_attribute:
+    NULL
+    {
+        $$ = tree.NewAttributeNull(true)
+    }
+|   NOT NULL
+    {
+        $$ = tree.NewAttributeNull(false)
+    }
+|   DEFAULT bit_expr
+    {
+        $$ = tree.NewAttributeDefault($2)
+    }
+|   PRIMARY KEY
+    {
+        $$ = tree.NewAttributePrimaryKey()
+    }
+|   UNIQUE
+    {
+        $$ = tree.NewAttributeUnique()
+    }
+
+constraint_def:
+    PRIMARY KEY '(' index_column_list ')'
+    {
+        $$ = &tree.PrimaryKeyIndex{KeyParts: $4, Empty: true}
+    }
+|   UNIQUE '(' index_column_list ')'
+    {
+        $$ = &tree.UniqueIndex{KeyParts: $3, Empty: true}
+    }
+
+index_column_list:
+    index_column
+    {
+        $$ = []*tree.KeyPart{$1}
+    }
+|   index_column_list ',' index_column
+    {
+        $$ = append($1, $3)
+    }
+
+index_column:
+    column_name
+    {
+        $$ = &tree.KeyPart{ColName: $1}
+    }
+
+drop_stmt:
+    drop_database_stmt
+|   drop_table_stmt
+
+drop_database_stmt:
+    DROP DATABASE exists_opt ident
+    {
+        $$ = &tree.DropDatabase{Name: tree.Identifier($4.Compare()), IfExists: $3}
+    }
+|   DROP SCHEMA exists_opt ident
+    {
+        $$ = &tree.DropDatabase{Name: tree.Identifier($4.Compare()), IfExists: $3}
+    }
+
+drop_table_stmt:
+    DROP TABLE exists_opt table_name_list
+    {
+        $$ = &tree.DropTable{IfExists: $3, Names: $4}
+    }
+
+truncate_table_stmt:
+    TRUNCATE table_name
+    {
+        $$ = tree.NewTruncateTable($2)
+    }
+|   TRUNCATE TABLE table_name
+    {
+        $$ = tree.NewTruncateTable($3)
+    }
+
+not_exists_opt:
+    {
+        $$ = false
+    }
+|   IF NOT EXISTS
+    {
+        $$ = true
+    }
+
+exists_opt:
+    {
+        $$ = false
+    }
+|   IF EXISTS
+    {
+        $$ = true
+    }
+
+insert_stmt:
+    INSERT INTO table_name insert_data
+    {
+        ins := $4
+        ins.Table = $3
+        $$ = ins
+    }
+
+insert_data:
+    VALUES values_list
+    {
+        $$ = &tree.Insert{Rows: tree.NewSelect(tree.NewValuesClause($2), nil, nil)}
+    }
+|   select_stmt
+    {
+        $$ = &tree.Insert{Rows: $1}
+    }
+|   '(' insert_column_list ')' VALUES values_list
+    {
+        $$ = &tree.Insert{
+            Columns: $2,
+            Rows: tree.NewSelect(tree.NewValuesClause($5), nil, nil),
+        }
+    }
+|   '(' insert_column_list ')' select_stmt
+    {
+        $$ = &tree.Insert{
+            Columns: $2,
+            Rows: $4,
+        }
+    }
+|   DEFAULT VALUES
+    {
+        $$ = &tree.Insert{Rows: tree.NewSelect(tree.NewValuesClause([]tree.Exprs{nil}), nil, nil)}
+    }
+
+insert_column_list:
+    ident
+    {
+        $$ = tree.IdentifierList{tree.Identifier($1.Compare())}
+    }
+|   insert_column_list ',' ident
+    {
+        $$ = append($1, tree.Identifier($3.Compare()))
+    }
+
+values_list:
+    '(' data_opt ')'
+    {
+        $$ = []tree.Exprs{$2}
+    }
+|   values_list ',' '(' data_opt ')'
+    {
+        $$ = append($1, $4)
+    }
+
+data_opt:
+    {
+        $$ = nil
+    }
+|   data_values
+
+data_values:
+    expr_or_default
+    {
+        $$ = tree.Exprs{$1}
+    }
+|   data_values ',' expr_or_default
+    {
+        $$ = append($1, $3)
+    }
+
+expr_or_default:
+    expression
+|   DEFAULT
+    {
+        $$ = &tree.DefaultVal{}
+    }
+
+update_stmt:
+    UPDATE aliased_table_name SET update_list where_expression_opt
+    {
+        $$ = &tree.Update{
+            Tables: tree.TableExprs{$2},
+            Exprs: $4,
+            Where: $5,
+        }
+    }
+
+update_list:
+    update_value
+    {
+        $$ = tree.UpdateExprs{$1}
+    }
+|   update_list ',' update_value
+    {
+        $$ = append($1, $3)
+    }
+
+update_value:
+    column_name '=' expr_or_default
+    {
+        $$ = &tree.UpdateExpr{Names: []*tree.UnresolvedName{$1}, Expr: $3}
+    }
+
+delete_stmt:
+    DELETE FROM table_name as_opt_id where_expression_opt
+    {
+        t := &tree.AliasedTableExpr{
+            Expr: $3,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($4),
+            },
+        }
+        $$ = &tree.Delete{
+            Tables: tree.TableExprs{t},
+            Where: $5,
+        }
+    }
+
+select_stmt:
+    select_no_parens
+|   select_with_parens
+    {
+        $$ = &tree.Select{Select: $1}
+    }
+
+select_no_parens:
+    simple_select order_by_opt limit_opt select_lock_opt
+    {
+        $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3, SelectLockInfo: $4}
+    }
+|   select_with_parens order_by_clause
+    {
+        $$ = &tree.Select{Select: $1, OrderBy: $2}
+    }
+|   select_with_parens order_by_opt limit_clause
+    {
+        $$ = &tree.Select{Select: $1, OrderBy: $2, Limit: $3}
+    }
+|   with_clause simple_select order_by_opt limit_opt select_lock_opt
+    {
+        $$ = &tree.Select{Select: $2, OrderBy: $3, Limit: $4, SelectLockInfo: $5, With: $1}
+    }
+
+with_clause:
+    WITH cte_list
+    {
+        $$ = &tree.With{CTEs: $2}
+    }
+|   WITH RECURSIVE cte_list
+    {
+        $$ = &tree.With{IsRecursive: true, CTEs: $3}
+    }
+
+cte_list:
+    common_table_expr
+    {
+        $$ = []*tree.CTE{$1}
+    }
+|   cte_list ',' common_table_expr
+    {
+        $$ = append($1, $3)
+    }
+
+common_table_expr:
+    ident column_list_opt AS '(' stmt ')'
+    {
+        $$ = &tree.CTE{
+            Name: &tree.AliasClause{Alias: tree.Identifier($1.Compare()), Cols: $2},
+            Stmt: $5,
+        }
+    }
+
+column_list_opt:
+    {
+        $$ = nil
+    }
+|   '(' column_list ')'
+    {
+        $$ = $2
+    }
+
+column_list:
+    ident
+    {
+        $$ = tree.IdentifierList{tree.Identifier($1.Compare())}
+    }
+|   column_list ',' ident
+    {
+        $$ = append($1, tree.Identifier($3.Compare()))
+    }
+
+limit_opt:
+    {
+        $$ = nil
+    }
+|   limit_clause
+
+limit_clause:
+    LIMIT limit_count
+    {
+        $$ = &tree.Limit{Count: $2}
+    }
+|   LIMIT limit_count OFFSET expression
+    {
+        $$ = &tree.Limit{Offset: $4, Count: $2}
+    }
+|   OFFSET expression
+    {
+        $$ = &tree.Limit{Offset: $2}
+    }
+|   OFFSET expression LIMIT limit_count
+    {
+        $$ = &tree.Limit{Offset: $2, Count: $4}
+    }
+
+limit_count:
+    expression
+|   ALL
+    {
+        $$ = nil
+    }
+
+order_by_opt:
+    {
+        $$ = nil
+    }
+|   order_by_clause
+
+order_by_clause:
+    ORDER BY order_list
+    {
+        $$ = $3
+    }
+
+order_list:
+    order
+    {
+        $$ = tree.OrderBy{$1}
+    }
+|   order_list ',' order
+    {
+        $$ = append($1, $3)
+    }
+
+order:
+    expression asc_desc_opt nulls_first_last_opt
+    {
+        $$ = &tree.Order{Expr: $1, Direction: $2, NullsPosition: $3}
+    }
+
+asc_desc_opt:
+    {
+        $$ = tree.DefaultDirection
+    }
+|   ASC
+    {
+        $$ = tree.Ascending
+    }
+|   DESC
+    {
+        $$ = tree.Descending
+    }
+
+nulls_first_last_opt:
+    {
+        $$ = tree.DefaultNullsPosition
+    }
+|   NULLS FIRST
+    {
+        $$ = tree.NullsFirst
+    }
+|   NULLS LAST
+    {
+        $$ = tree.NullsLast
+    }
+
+select_lock_opt:
+    {
+        $$ = nil
+    }
+|   FOR UPDATE
+    {
+        $$ = &tree.SelectLockInfo{LockType: tree.SelectLockForUpdate}
+    }
+
+select_with_parens:
+    '(' select_no_parens ')'
+    {
+        $$ = &tree.ParenSelect{Select: $2}
+    }
+|   '(' select_with_parens ')'
+    {
+        $$ = &tree.ParenSelect{Select: &tree.Select{Select: $2}}
+    }
+
+simple_select:
+    simple_select_clause
+|   simple_select union_op simple_select_clause
+    {
+        $$ = &tree.UnionClause{
+            Type: $2.Type,
+            Left: $1,
+            Right: $3,
+            All: $2.All,
+            Distinct: $2.Distinct,
+        }
+    }
+|   select_with_parens union_op simple_select_clause
+    {
+        $$ = &tree.UnionClause{
+            Type: $2.Type,
+            Left: $1,
+            Right: $3,
+            All: $2.All,
+            Distinct: $2.Distinct,
+        }
+    }
+|   simple_select union_op select_with_parens
+    {
+        $$ = &tree.UnionClause{
+            Type: $2.Type,
+            Left: $1,
+            Right: $3,
+            All: $2.All,
+            Distinct: $2.Distinct,
+        }
+    }
+|   select_with_parens union_op select_with_parens
+    {
+        $$ = &tree.UnionClause{
+            Type: $2.Type,
+            Left: $1,
+            Right: $3,
+            All: $2.All,
+            Distinct: $2.Distinct,
+        }
+    }
+
+union_op:
+    UNION distinct_opt
+    {
+        $$ = &tree.UnionTypeRecord{Type: tree.UNION, All: !$2, Distinct: $2}
+    }
+|   EXCEPT distinct_opt
+    {
+        $$ = &tree.UnionTypeRecord{Type: tree.EXCEPT, All: !$2, Distinct: $2}
+    }
+|   INTERSECT distinct_opt
+    {
+        $$ = &tree.UnionTypeRecord{Type: tree.INTERSECT, All: !$2, Distinct: $2}
+    }
+
+// distinct_opt of the set operations is true unless ALL is given
+distinct_opt:
+    {
+        $$ = true
+    }
+|   ALL
+    {
+        $$ = false
+    }
+|   DISTINCT
+    {
+        $$ = true
+    }
+
+simple_select_clause:
+    SELECT func_type_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+    {
+        $$ = &tree.SelectClause{
+            Distinct: $2 == tree.FUNC_TYPE_DISTINCT,
+            Exprs: $3,
+            From: $4,
+            Where: $5,
+            GroupBy: $6,
+            Having: $7,
+        }
+    }
+
+having_opt:
+    {
+        $$ = nil
+    }
+|   HAVING expression
+    {
+        $$ = &tree.Where{Type: tree.AstHaving, Expr: $2}
+    }
+
+group_by_opt:
+    {
+        $$ = nil
+    }
+|   GROUP BY expression_list
+    {
+        $$ = tree.GroupBy($3)
+    }
+
+where_expression_opt:
+    {
+        $$ = nil
+    }
+|   WHERE expression
+    {
+        $$ = &tree.Where{Type: tree.AstWhere, Expr: $2}
+    }
+
+select_expression_list:
+    select_expression
+    {
+        $$ = tree.SelectExprs{$1}
+    }
+|   select_expression_list ',' select_expression
+    {
+        $$ = append($1, $3)
+    }
+
+select_expression:
+    '*' %prec '*'
+    {
+        $$ = tree.SelectExpr{Expr: tree.StarExpr()}
+    }
+|   expression as_name_opt
+    {
+        $$ = tree.SelectExpr{Expr: $1, As: $2}
+    }
+|   ident '.' '*' %prec '*'
+    {
+        $$ = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar($1.Compare())}
+    }
+|   ident '.' ident '.' '*' %prec '*'
+    {
+        $$ = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar($3.Compare(), $1.Compare())}
+    }
+
+as_name_opt:
+    {
+        $$ = tree.NewCStr("", 0)
+    }
+|   ident
+|   AS ident
+    {
+        $$ = $2
+    }
+
+from_opt:
+    {
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        tn := tree.NewTableName(tree.Identifier(""), prefix)
+        $$ = &tree.From{
+            Tables: tree.TableExprs{&tree.AliasedTableExpr{Expr: tn}},
+        }
+    }
+|   from_clause
+
+from_clause:
+    FROM table_references
+    {
+        $$ = &tree.From{
+            Tables: tree.TableExprs{$2},
+        }
+    }
+
+table_references:
+    table_reference %prec LOWER_THAN_SET
+    {
+        if t, ok := $1.(*tree.JoinTableExpr); ok {
+            $$ = t
+        } else {
+            $$ = &tree.JoinTableExpr{Left: $1, Right: nil, JoinType: tree.JOIN_TYPE_CROSS}
+        }
+    }
+|   table_references ',' table_reference %prec LOWER_THAN_SET
+    {
+        $$ = &tree.JoinTableExpr{Left: $1, Right: $3, JoinType: tree.JOIN_TYPE_CROSS}
+    }
+
+table_reference:
+    table_factor
+|   join_table
+    {
+        $$ = $1
+    }
+
+join_table:
+    table_reference inner_join table_factor join_condition_opt
+    {
+        $$ = &tree.JoinTableExpr{
+            Left: $1,
+            JoinType: $2,
+            Right: $3,
+            Cond: $4,
+        }
+    }
+|   table_reference outer_join table_factor join_condition
+    {
+        $$ = &tree.JoinTableExpr{
+            Left: $1,
+            JoinType: $2,
+            Right: $3,
+            Cond: $4,
+        }
+    }
+|   table_reference natural_join table_factor
+    {
+        $$ = &tree.JoinTableExpr{
+            Left: $1,
+            JoinType: $2,
+            Right: $3,
+        }
+    }
+
+natural_join:
+    NATURAL JOIN
+    {
+        $$ = tree.JOIN_TYPE_NATURAL
+    }
+|   NATURAL outer_join
+    {
+        if $2 == tree.JOIN_TYPE_LEFT {
+            $$ = tree.JOIN_TYPE_NATURAL_LEFT
+        } else {
+            $$ = tree.JOIN_TYPE_NATURAL_RIGHT
+        }
+    }
+
+outer_join:
+    LEFT JOIN
+    {
+        $$ = tree.JOIN_TYPE_LEFT
+    }
+|   LEFT OUTER JOIN
+    {
+        $$ = tree.JOIN_TYPE_LEFT
+    }
+|   RIGHT JOIN
+    {
+        $$ = tree.JOIN_TYPE_RIGHT
+    }
+|   RIGHT OUTER JOIN
+    {
+        $$ = tree.JOIN_TYPE_RIGHT
+    }
+|   FULL JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
+|   FULL OUTER JOIN
+    {
+        $$ = tree.JOIN_TYPE_FULL
+    }
+
+inner_join:
+    JOIN
+    {
+        $$ = tree.JOIN_TYPE_INNER
+    }
+|   INNER JOIN
+    {
+        $$ = tree.JOIN_TYPE_INNER
+    }
+|   CROSS JOIN
+    {
+        $$ = tree.JOIN_TYPE_CROSS
+    }
+
+join_condition_opt:
+    %prec JOIN
+    {
+        $$ = nil
+    }
+|   join_condition
+
+join_condition:
+    ON expression
+    {
+        $$ = &tree.OnJoinCond{Expr: $2}
+    }
+|   USING '(' column_list ')'
+    {
+        $$ = &tree.UsingJoinCond{Cols: $3}
+    }
+
+table_factor:
+    aliased_table_name
+|   table_subquery as_opt_id column_list_opt
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($2),
+                Cols: $3,
+            },
+        }
+    }
+|   '(' table_references ')'
+    {
+        $$ = $2
+    }
+
+table_subquery:
+    select_with_parens %prec SUBQUERY_AS_EXPR
+    {
+        $$ = &tree.ParenTableExpr{Expr: $1.(*tree.ParenSelect).Select}
+    }
+
+aliased_table_name:
+    table_name as_opt_id
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($2),
+            },
+        }
+    }
+
+as_opt_id:
+    {
+        $$ = ""
+    }
+|   table_alias
+|   AS table_alias
+    {
+        $$ = $2
+    }
+
+table_alias:
+    ident
+    {
+        $$ = $1.Compare()
+    }
+
+table_name_list:
+    table_name
+    {
+        $$ = tree.TableNames{$1}
+    }
+|   table_name_list ',' table_name
+    {
+        $$ = append($1, $3)
+    }
+
+table_name:
+    ident
+    {
+        prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
+        $$ = tree.NewTableName(tree.Identifier($1.Compare()), prefix)
+    }
+|   ident '.' ident
+    {
+        prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier($1.Compare()), ExplicitSchema: true}
+        $$ = tree.NewTableName(tree.Identifier($3.Compare()), prefix)
+    }
+
+expression_list_opt:
+    {
+        $$ = nil
+    }
+|   expression_list
+
+expression_list:
+    expression
+    {
+        $$ = tree.Exprs{$1}
+    }
+|   expression_list ',' expression
+    {
+        $$ = append($1, $3)
+    }
+
+// See https://www.postgresql.org/docs/current/sql-syntax-lexical.html#SQL-PRECEDENCE
+expression:
+    expression AND expression %prec AND
+    {
+        $$ = tree.NewAndExpr($1, $3)
+    }
+|   expression OR expression %prec OR
+    {
+        $$ = tree.NewOrExpr($1, $3)
+    }
+|   NOT expression %prec NOT
+    {
+        $$ = tree.NewNotExpr($2)
+    }
+|   boolean_primary
+
+boolean_primary:
+    boolean_primary IS NULL %prec IS
+    {
+        $$ = tree.NewIsNullExpr($1)
+    }
+|   boolean_primary IS NOT NULL %prec IS
+    {
+        $$ = tree.NewIsNotNullExpr($1)
+    }
+|   boolean_primary IS TRUE %prec IS
+    {
+        $$ = tree.NewIsTrueExpr($1)
+    }
+|   boolean_primary IS NOT TRUE %prec IS
+    {
+        $$ = tree.NewIsNotTrueExpr($1)
+    }
+|   boolean_primary IS FALSE %prec IS
+    {
+        $$ = tree.NewIsFalseExpr($1)
+    }
+|   boolean_primary IS NOT FALSE %prec IS
+    {
+        $$ = tree.NewIsNotFalseExpr($1)
+    }
+|   boolean_primary IS DISTINCT FROM predicate %prec IS
+    {
+        $$ = tree.NewNotExpr(tree.NewComparisonExpr(tree.NULL_SAFE_EQUAL, $1, $5))
+    }
+|   boolean_primary IS NOT DISTINCT FROM predicate %prec IS
+    {
+        $$ = tree.NewComparisonExpr(tree.NULL_SAFE_EQUAL, $1, $6)
+    }
+|   boolean_primary comparison_operator predicate %prec '='
+    {
+        $$ = tree.NewComparisonExpr($2, $1, $3)
+    }
+|   boolean_primary comparison_operator and_or_some subquery %prec '='
+    {
+        $$ = tree.NewSubqueryComparisonExpr($2, $3, $1, $4)
+    }
+|   predicate
+
+predicate:
+    bit_expr IN col_tuple
+    {
+        $$ = tree.NewComparisonExpr(tree.IN, $1, $3)
+    }
+|   bit_expr NOT IN col_tuple
+    {
+        $$ = tree.NewComparisonExpr(tree.NOT_IN, $1, $4)
+    }
+|   bit_expr LIKE bit_expr like_escape_opt
+    {
+        $$ = tree.NewComparisonExprWithEscape(tree.LIKE, $1, $3, $4)
+    }
+|   bit_expr NOT LIKE bit_expr like_escape_opt
+    {
+        $$ = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, $1, $4, $5)
+    }
+|   bit_expr ILIKE bit_expr like_escape_opt
+    {
+        $$ = tree.NewComparisonExprWithEscape(tree.ILIKE, $1, $3, $4)
+    }
+|   bit_expr NOT ILIKE bit_expr like_escape_opt
+    {
+        $$ = tree.NewComparisonExprWithEscape(tree.NOT_ILIKE, $1, $4, $5)
+    }
+|   bit_expr BETWEEN bit_expr AND predicate
+    {
+        $$ = tree.NewRangeCond(false, $1, $3, $5)
+    }
+|   bit_expr NOT BETWEEN bit_expr AND predicate
+    {
+        $$ = tree.NewRangeCond(true, $1, $4, $6)
+    }
+|   bit_expr
+
+like_escape_opt:
+    {
+        $$ = nil
+    }
+|   ESCAPE simple_expr
+    {
+        $$ = $2
+    }
+
+col_tuple:
+    '(' expression_list ')'
+    {
+        $$ = tree.NewTuple($2)
+    }
+|   subquery
+    {
+        $$ = $1
+    }
+
+and_or_some:
+    ALL
+    {
+        $$ = tree.ALL
+    }
+|   ANY
+    {
+        $$ = tree.ANY
+    }
+|   SOME
+    {
+        $$ = tree.SOME
+    }
+
+comparison_operator:
+    '='
+    {
+        $$ = tree.EQUAL
+    }
+|   '<'
+    {
+        $$ = tree.LESS_THAN
+    }
+|   '>'
+    {
+        $$ = tree.GREAT_THAN
+    }
+|   LE
+    {
+        $$ = tree.LESS_THAN_EQUAL
+    }
+|   GE
+    {
+        $$ = tree.GREAT_THAN_EQUAL
+    }
+|   NE
+    {
+        $$ = tree.NOT_EQUAL
+    }
+
+bit_expr:
+    bit_expr PIPE_CONCAT bit_expr %prec PIPE_CONCAT
+    {
+        name := tree.SetUnresolvedName("concat")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, $3},
+        }
+    }
+|   bit_expr '&' bit_expr %prec '&'
+    {
+        $$ = tree.NewBinaryExpr(tree.BIT_AND, $1, $3)
+    }
+|   bit_expr '|' bit_expr %prec '|'
+    {
+        $$ = tree.NewBinaryExpr(tree.BIT_OR, $1, $3)
+    }
+|   bit_expr '+' bit_expr %prec '+'
+    {
+        $$ = tree.NewBinaryExpr(tree.PLUS, $1, $3)
+    }
+|   bit_expr '-' bit_expr %prec '-'
+    {
+        $$ = tree.NewBinaryExpr(tree.MINUS, $1, $3)
+    }
+|   bit_expr '*' bit_expr %prec '*'
+    {
+        $$ = tree.NewBinaryExpr(tree.MULTI, $1, $3)
+    }
+|   bit_expr '/' bit_expr %prec '/'
+    {
+        $$ = tree.NewBinaryExpr(tree.DIV, $1, $3)
+    }
+|   bit_expr '%' bit_expr %prec '%'
+    {
+        $$ = tree.NewBinaryExpr(tree.MOD, $1, $3)
+    }
+|   bit_expr '^' bit_expr %prec '^'
+    {
+        name := tree.SetUnresolvedName("power")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, $3},
+        }
+    }
+|   bit_expr SHIFT_LEFT bit_expr %prec SHIFT_LEFT
+    {
+        $$ = tree.NewBinaryExpr(tree.LEFT_SHIFT, $1, $3)
+    }
+|   bit_expr SHIFT_RIGHT bit_expr %prec SHIFT_RIGHT
+    {
+        $$ = tree.NewBinaryExpr(tree.RIGHT_SHIFT, $1, $3)
+    }
+|   simple_expr
+
+simple_expr:
+    column_ref
+|   literal
+|   '(' expression ')'
+    {
+        $$ = tree.NewParenExpr($2)
+    }
+|   '(' expression_list ',' expression ')'
+    {
+        $$ = tree.NewTuple(append($2, $4))
+    }
+|   '+' simple_expr %prec UNARY
+    {
+        $$ = tree.NewUnaryExpr(tree.UNARY_PLUS, $2)
+    }
+|   '-' simple_expr %prec UNARY
+    {
+        $$ = tree.NewUnaryExpr(tree.UNARY_MINUS, $2)
+    }
+|   simple_expr TYPECAST type_name
+    {
+        $$ = tree.NewCastExpr($1, $3)
+    }
+|   subquery
+    {
+        $$ = $1
+    }
+|   EXISTS subquery
+    {
+        $2.Exists = true
+        $$ = $2
+    }
+|   CASE expression_opt when_clause_list else_opt END
+    {
+        $$ = &tree.CaseExpr{
+            Expr: $2,
+            Whens: $3,
+            Else: $4,
+        }
+    }
+|   CAST '(' expression AS type_name ')'
+    {
+        $$ = tree.NewCastExpr($3, $5)
+    }
+|   function_call
+
+column_ref:
+    column_name
+    {
+        $$ = $1
+    }
+
+column_name:
+    ident
+    {
+        $$ = tree.SetUnresolvedName($1.Compare())
+    }
+|   ident '.' ident
+    {
+        $$ = tree.SetUnresolvedName($1.Compare(), $3.Compare())
+    }
+|   ident '.' ident '.' ident
+    {
+        $$ = tree.SetUnresolvedName($1.Compare(), $3.Compare(), $5.Compare())
+    }
+
+subquery:
+    select_with_parens %prec SUBQUERY_AS_EXPR
+    {
+        $$ = &tree.Subquery{Select: $1, Exists: false}
+    }
+
+function_call:
+    func_name '(' func_type_opt expression_list_opt ')'
+    {
+        name := tree.SetUnresolvedName($1)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $4,
+            Type: $3,
+        }
+    }
+|   func_name '(' '*' ')'
+    {
+        name := tree.SetUnresolvedName($1)
+        es := tree.NewNumValWithType(constant.MakeString("*"), "*", false, tree.P_char)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{es},
+        }
+    }
+|   CURRENT_DATE
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+        }
+    }
+|   CURRENT_TIMESTAMP
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+        }
+    }
+|   CURRENT_USER
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+        }
+    }
+
+func_name:
+    ID
+|   non_reserved_keyword
+|   LEFT
+|   RIGHT
+
+func_type_opt:
+    {
+        $$ = tree.FUNC_TYPE_DEFAULT
+    }
+|   DISTINCT
+    {
+        $$ = tree.FUNC_TYPE_DISTINCT
+    }
+|   ALL
+    {
+        $$ = tree.FUNC_TYPE_ALL
+    }
+
+else_opt:
+    {
+        $$ = nil
+    }
+|   ELSE expression
+    {
+        $$ = $2
+    }
+
+expression_opt:
+    {
+        $$ = nil
+    }
+|   expression
+
+when_clause_list:
+    when_clause
+    {
+        $$ = []*tree.When{$1}
+    }
+|   when_clause_list when_clause
+    {
+        $$ = append($1, $2)
+    }
+
+when_clause:
+    WHEN expression THEN expression
+    {
+        $$ = &tree.When{
+            Cond: $2,
+            Val: $4,
+        }
+    }
+
+literal:
+    STRING
+    {
+        $$ = tree.NewNumValWithType(constant.MakeString($1), $1, false, tree.P_char)
+    }
+|   INTEGRAL
+    {
+        str := fmt.Sprintf("%v", $1)
+        switch v := $1.(type) {
+        case uint64:
+            $$ = tree.NewNumValWithType(constant.MakeUint64(v), str, false, tree.P_uint64)
+        case int64:
+            $$ = tree.NewNumValWithType(constant.MakeInt64(v), str, false, tree.P_int64)
+        default:
+            yylex.Error("parse integral fail")
+            return 1
+        }
+    }
+|   FLOAT
+    {
+        fval := $1.(float64)
+        $$ = tree.NewNumValWithType(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, tree.P_float64)
+    }
+|   DECIMAL_VALUE
+    {
+        $$ = tree.NewNumValWithType(constant.MakeString($1), $1, false, tree.P_decimal)
+    }
+|   TRUE
+    {
+        $$ = tree.NewNumValWithType(constant.MakeBool(true), "true", false, tree.P_bool)
+    }
+|   FALSE
+    {
+        $$ = tree.NewNumValWithType(constant.MakeBool(false), "false", false, tree.P_bool)
+    }
+|   NULL
+    {
+        $$ = tree.NewNumValWithType(constant.MakeUnknown(), "null", false, tree.P_null)
+    }
+|   VALUE_ARG
+    {
+        $$ = tree.NewParamExpr(yylex.(*Lexer).GetParamIndex())
+    }
+
+type_name:
+    ident type_modifiers_opt
+    {
+        t, err := makeType($1.Compare(), $2)
+        if err != nil {
+            yylex.Error(err.Error())
+            return 1
+        }
+        $$ = t
+    }
+|   DOUBLE PRECISION
+    {
+        t, err := makeType("float8", nil)
+        if err != nil {
+            yylex.Error(err.Error())
+            return 1
+        }
+        $$ = t
+    }
+|   CHARACTER type_modifiers_opt
+    {
+        t, err := makeType("bpchar", $2)
+        if err != nil {
+            yylex.Error(err.Error())
+            return 1
+        }
+        $$ = t
+    }
+|   CHARACTER VARYING type_modifiers_opt
+    {
+        t, err := makeType("varchar", $3)
+        if err != nil {
+            yylex.Error(err.Error())
+            return 1
+        }
+        $$ = t
+    }
+
+type_modifiers_opt:
+    {
+        $$ = nil
+    }
+|   '(' type_modifier_list ')'
+    {
+        $$ = $2
+    }
+
+type_modifier_list:
+    INTEGRAL
+    {
+        v, ok := $1.(int64)
+        if !ok || v > int64(^uint32(0)>>1) {
+            yylex.Error("type modifier is out of range")
+            return 1
+        }
+        $$ = []int32{int32(v)}
+    }
+|   type_modifier_list ',' INTEGRAL
+    {
+        v, ok := $3.(int64)
+        if !ok || v > int64(^uint32(0)>>1) {
+            yylex.Error("type modifier is out of range")
+            return 1
+        }
+        $$ = append($1, int32(v))
+    }
+
+ident:
+    ID
+    {
+        $$ = tree.NewCStr($1, yylex.(*Lexer).lower)
+    }
+|   QUOTE_ID
+    {
+        $$ = tree.NewCStr($1, yylex.(*Lexer).lower)
+    }
+|   non_reserved_keyword
+    {
+        $$ = tree.NewCStr($1, yylex.(*Lexer).lower)
+    }
+
+// The keywords can be used as the identifiers
+non_reserved_keyword:
+    ABORT
+|   ANALYZE
+|   BEGIN
+|   COMMIT
+|   DATABASE
+|   FIRST
+|   KEY
+|   LAST
+|   NULLS
+|   RECURSIVE
+|   ROLLBACK
+|   SCHEMA
+|   SHOW
+|   START
+|   TRANSACTION
+|   TRUNCATE
+|   USE
+|   VERBOSE
+|   WORK
 %%
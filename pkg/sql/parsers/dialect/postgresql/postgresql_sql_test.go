@@ -35,7 +35,7 @@ func TestDebug(t *testing.T) {
 	if debugSQL.output == "" {
 		debugSQL.output = debugSQL.input
 	}
-	ast, err := ParseOne(context.TODO(), debugSQL.input)
+	ast, err := ParseOne(context.TODO(), debugSQL.input, 1)
 	if err != nil {
 		t.Errorf("Parse(%q) err: %v", debugSQL.input, err)
 		return
@@ -45,3 +45,254 @@ func TestDebug(t *testing.T) {
 		t.Errorf("Parsing failed. \nExpected/Got:\n%s\n%s", debugSQL.output, out)
 	}
 }
+
+var (
+	validSQL = []struct {
+		input  string
+		output string
+	}{
+		{
+			input: "use db1",
+		},
+		{
+			input: "use",
+		},
+		{
+			input: "select 1",
+		},
+		{
+			input:  "select 1::int",
+			output: "select cast(1 as int)",
+		},
+		{
+			input:  "select a::varchar(10), cast(b as numeric(10, 2)) from t1",
+			output: "select cast(a as varchar(10)), cast(b as decimal(10, 2)) from t1",
+		},
+		{
+			input:  "select \"Quoted Col\", \"a\"\"b\" from \"T1\"",
+			output: "select quoted col, a\"b from t1",
+		},
+		{
+			input:  "select * from t1 where a is not distinct from b and c is distinct from d",
+			output: "select * from t1 where a <=> b and not c <=> d",
+		},
+		{
+			input:  "select 'a' || 'b' || c from t1",
+			output: "select concat(concat(a, b), c) from t1",
+		},
+		{
+			input:  "select 2 ^ 3",
+			output: "select power(2, 3)",
+		},
+		{
+			input:  "select a from t1 where b ilike 'x%' and c not like 'y!%' escape '!'",
+			output: "select a from t1 where b ilike x% and c not like y!% escape !",
+		},
+		{
+			input: "select distinct a, count(*), count(distinct b) from t1 group by a having count(*) > 1",
+		},
+		{
+			input: "select t1.*, t2.a as b from t1 inner join t2 on t1.a = t2.a left join t3 using (a) where t1.b between 1 and 10",
+		},
+		{
+			input:  "select a from t1 order by a desc nulls last, b limit all offset 10",
+			output: "select a from t1 order by a desc nulls last, b offset 10",
+		},
+		{
+			input: "select a from t1 limit 10 offset 5",
+		},
+		{
+			input:  "select a from t1 union all select a from t2 except select a from t3",
+			output: "select a from t1 union all select a from t2 except distinct select a from t3",
+		},
+		{
+			input:  "with recursive c (n) as (select 1 union all select n + 1 from c where n < 10) select n from c",
+			output: "with recursive c(n) as (select 1 union all select n + 1 from c where n < 10) select n from c",
+		},
+		{
+			input: "select a from t1 where a in (select a from t2) and exists (select 1 from t2) and b > any (select b from t3)",
+		},
+		{
+			input:  "select case when a > 1 then 'x' else 'y' end from t1 for update",
+			output: "select case when a > 1 then x else y end from t1 for update",
+		},
+		{
+			input:  "select current_date, current_timestamp, current_user",
+			output: "select current_date(), current_timestamp(), current_user()",
+		},
+		{
+			input:  "select a from (select a from t1) as s (a)",
+			output: "select a from (select a from t1) as s(a)",
+		},
+		{
+			input: "select a from t1 where b = ? and c = ?",
+		},
+		{
+			input: "select 18446744073709551615, 18446744073709551616, 1.5, true, null",
+		},
+		{
+			input:  "insert into t1 (a, b) values (1, default), (2, 'x')",
+			output: "insert into t1 (a, b) values (1, default), (2, x)",
+		},
+		{
+			input: "insert into t1 select * from t2",
+		},
+		{
+			input:  "insert into t1 default values",
+			output: "insert into t1 values ()",
+		},
+		{
+			input: "update t1 set a = a + 1, b = default where c is null",
+		},
+		{
+			input: "delete from t1 as t where t.a = 1",
+		},
+		{
+			input:  "set search_path to public",
+			output: "set search_path = public",
+		},
+		{
+			input:  "set session statement_timeout = 0",
+			output: "set statement_timeout = 0",
+		},
+		{
+			input:  "set client_encoding = 'UTF8'",
+			output: "set client_encoding = UTF8",
+		},
+		{
+			input:  "set local x to default",
+			output: "set x = default",
+		},
+		{
+			input:  "show all",
+			output: "show variables",
+		},
+		{
+			input:  "show server_version",
+			output: "show variables like server_version",
+		},
+		{
+			input: "show tables",
+		},
+		{
+			input:  "begin",
+			output: "start transaction",
+		},
+		{
+			input: "start transaction",
+		},
+		{
+			input:  "commit work",
+			output: "commit",
+		},
+		{
+			input:  "end",
+			output: "commit",
+		},
+		{
+			input: "rollback",
+		},
+		{
+			input:  "abort transaction",
+			output: "rollback",
+		},
+		{
+			input: "explain select 1",
+		},
+		{
+			input:  "explain analyze verbose select a from t1",
+			output: "explain (analyze,verbose) select a from t1",
+		},
+		{
+			input: "create database if not exists db1",
+		},
+		{
+			input:  "create schema s1",
+			output: "create database s1",
+		},
+		{
+			input:  "create table if not exists t1 (a int primary key, b varchar(20) not null default 'x', c timestamptz, d double precision, e character varying(5), f text, unique (b, c))",
+			output: "create table if not exists t1 (a int primary key, b varchar(20) not null default x, c timestamp(26), d double, e varchar(5), f text, unique key (b, c))",
+		},
+		{
+			input:  "create table t2 (a int8, b bool, c numeric, d jsonb, e bytea, f uuid, g timestamp(3), h real, primary key (a))",
+			output: "create table t2 (a bigint, b bool, c decimal(38), d json, e blob, f uuid, g datetime(3, 3), h float, primary key (a))",
+		},
+		{
+			input: "drop table if exists t1, t2",
+		},
+		{
+			input: "drop database db1",
+		},
+		{
+			input:  "drop schema if exists s1",
+			output: "drop database if exists s1",
+		},
+		{
+			input: "truncate table t1",
+		},
+		{
+			input:  "truncate t1",
+			output: "truncate table t1",
+		},
+		{
+			input:  "select a from t1 -- comment",
+			output: "select a from t1",
+		},
+		{
+			input:  "select a --comment\nfrom t1",
+			output: "select a from t1",
+		},
+	}
+)
+
+func TestValid(t *testing.T) {
+	ctx := context.TODO()
+	for _, tcase := range validSQL {
+		if tcase.output == "" {
+			tcase.output = tcase.input
+		}
+		ast, err := ParseOne(ctx, tcase.input, 1)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v", tcase.input, err)
+			continue
+		}
+		out := tree.String(ast, dialect.POSTGRESQL)
+		if tcase.output != out {
+			t.Errorf("Parsing failed. \nExpected/Got:\n%s\n%s", tcase.output, out)
+		}
+	}
+}
+
+var (
+	invalidSQL = []struct {
+		input string
+	}{
+		{
+			input: "select \"\" from t1",
+		},
+		{
+			input: "select 1::nosuchtype",
+		},
+		{
+			input: "select a::numeric(40) from t1",
+		},
+		{
+			input: "select a from t1 limit 1, 2",
+		},
+		{
+			input: "show variables like 'x'",
+		},
+	}
+)
+
+func TestFaultTolerance(t *testing.T) {
+	ctx := context.TODO()
+	for _, tcase := range invalidSQL {
+		_, err := ParseOne(ctx, tcase.input, 1)
+		if err == nil {
+			t.Errorf("Fault tolerant ases (%q) should parse errors", tcase.input)
+			continue
+		}
+	}
+}
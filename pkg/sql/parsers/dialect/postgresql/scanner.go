@@ -102,6 +102,10 @@ func (s *Scanner) Scan() (int, string) {
 			s.skip(2)
 			return ASSIGNMENT, ""
 		}
+		if s.peek(1) == ':' {
+			s.skip(2)
+			return TYPECAST, ""
+		}
 		// Like mysql -h ::1 ?
 		return s.scanBindVar()
 	case ch == ';':
@@ -173,11 +177,13 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 	case '-':
 		switch s.cur() {
 		case '-':
-			nextChar := s.peek(1)
-			if nextChar == ' ' || nextChar == '\n' || nextChar == '\t' || nextChar == '\r' || nextChar == eofChar {
-				s.skip(1)
-				return s.scanCommentTypeLine(2)
+			// unlike mysql, "--" starts a comment even if no blank follows it
+			s.skip(1)
+			id, str := s.scanCommentTypeLine(2)
+			if id == LEX_ERROR {
+				return id, str
 			}
+			return s.Scan()
 		case '>':
 			s.skip(1)
 			// TODO:
@@ -223,8 +229,15 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 			return NE, ""
 		}
 		return int(ch), ""
-	case '\'', '"':
+	case '\'':
 		return s.scanString(ch, STRING)
+	case '"':
+		// the double quoted string is an identifier, and it can not be empty
+		typ, str := s.scanString(ch, QUOTE_ID)
+		if typ == QUOTE_ID && str == "" {
+			return LEX_ERROR, str
+		}
+		return typ, str
 	case '`':
 		return s.scanLiteralIdentifier()
 	default:
@@ -0,0 +1,157 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgresql
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// makeType maps the postgresql type name and its modifiers to the type of matrixone.
+// The family strings are the ones of mysql, so that the statements formatted from
+// the ast can be handled as those parsed by the mysql dialect.
+func makeType(name string, mods []int32) (*tree.T, error) {
+	locale := ""
+	t := &tree.T{
+		InternalType: tree.InternalType{
+			Locale: &locale,
+		},
+	}
+	typ := &t.InternalType
+
+	switch name {
+	case "smallint", "int2":
+		typ.Family, typ.FamilyString, typ.Width = tree.IntFamily, "smallint", 16
+		typ.Oid = uint32(defines.MYSQL_TYPE_SHORT)
+	case "integer", "int", "int4":
+		typ.Family, typ.FamilyString, typ.Width = tree.IntFamily, "int", 32
+		typ.Oid = uint32(defines.MYSQL_TYPE_LONG)
+	case "bigint", "int8":
+		typ.Family, typ.FamilyString, typ.Width = tree.IntFamily, "bigint", 64
+		typ.Oid = uint32(defines.MYSQL_TYPE_LONGLONG)
+	case "real", "float4", "float8", "float":
+		if len(mods) > 1 {
+			return nil, fmt.Errorf("type %s accepts only the precision", name)
+		}
+		// float(p) is real if p is at most 24, and double precision otherwise
+		double := name == "float8" || (name == "float" && (len(mods) == 0 || mods[0] > 24))
+		if len(mods) == 1 && (mods[0] < 1 || mods[0] > 53) {
+			return nil, fmt.Errorf("precision for type float must be between 1 and 53")
+		}
+		typ.Family, typ.DisplayWith, typ.Scale = tree.FloatFamily, tree.NotDefineDisplayWidth, tree.NotDefineDec
+		if double {
+			typ.FamilyString, typ.Width = "double", 64
+			typ.Oid = uint32(defines.MYSQL_TYPE_DOUBLE)
+		} else {
+			typ.FamilyString, typ.Width = "float", 32
+			typ.Oid = uint32(defines.MYSQL_TYPE_FLOAT)
+		}
+	case "numeric", "decimal":
+		precision, scale := int32(38), int32(0)
+		switch len(mods) {
+		case 0:
+		case 1:
+			precision = mods[0]
+		case 2:
+			precision, scale = mods[0], mods[1]
+		default:
+			return nil, fmt.Errorf("type %s accepts only the precision and scale", name)
+		}
+		if precision < 1 || precision > 38 {
+			return nil, fmt.Errorf("precision for type %s must be between 1 and 38", name)
+		}
+		if scale > precision {
+			return nil, fmt.Errorf("scale for type %s must be between 0 and precision %d", name, precision)
+		}
+		typ.Family, typ.FamilyString = tree.FloatFamily, "decimal"
+		typ.DisplayWith, typ.Scale = precision, scale
+		typ.Width = 64
+		if precision > 16 {
+			typ.Width = 128
+		}
+		typ.Oid = uint32(defines.MYSQL_TYPE_DECIMAL)
+	case "boolean", "bool":
+		typ.Family, typ.FamilyString = tree.BoolFamily, "bool"
+		typ.Oid = uint32(defines.MYSQL_TYPE_BOOL)
+	case "text":
+		typ.Family, typ.FamilyString = tree.BlobFamily, "text"
+		typ.Oid = uint32(defines.MYSQL_TYPE_TEXT)
+	case "varchar", "bpchar", "char":
+		if len(mods) > 1 {
+			return nil, fmt.Errorf("type %s accepts only the length", name)
+		}
+		typ.Family, typ.DisplayWith = tree.StringFamily, -1
+		if len(mods) == 1 {
+			if mods[0] < 1 {
+				return nil, fmt.Errorf("length for type %s must be at least 1", name)
+			}
+			typ.DisplayWith = mods[0]
+		}
+		if name == "varchar" {
+			typ.FamilyString = "varchar"
+			typ.Oid = uint32(defines.MYSQL_TYPE_VARCHAR)
+		} else {
+			typ.FamilyString = "char"
+			typ.Oid = uint32(defines.MYSQL_TYPE_STRING)
+		}
+	case "bytea":
+		typ.Family, typ.FamilyString = tree.BlobFamily, "blob"
+		typ.Oid = uint32(defines.MYSQL_TYPE_BLOB)
+	case "date":
+		typ.Family, typ.FamilyString = tree.DateFamily, "date"
+		typ.Oid = uint32(defines.MYSQL_TYPE_DATE)
+	case "time", "timestamp", "timestamptz":
+		if len(mods) > 1 {
+			return nil, fmt.Errorf("type %s accepts only the precision", name)
+		}
+		if len(mods) == 1 {
+			if mods[0] < 0 || mods[0] > 6 {
+				return nil, fmt.Errorf("precision for type %s must be between 0 and 6", name)
+			}
+			typ.Scale, typ.TimePrecisionIsSet = mods[0], true
+		}
+		switch name {
+		case "time":
+			typ.Family, typ.FamilyString, typ.DisplayWith = tree.TimeFamily, "time", 26
+			typ.Oid = uint32(defines.MYSQL_TYPE_TIME)
+		case "timestamp":
+			// timestamp without time zone is the datetime of matrixone
+			typ.Family, typ.FamilyString, typ.DisplayWith = tree.TimestampFamily, "datetime", typ.Scale
+			typ.Oid = uint32(defines.MYSQL_TYPE_DATETIME)
+		default:
+			typ.Family, typ.FamilyString, typ.DisplayWith = tree.TimestampFamily, "timestamp", 26
+			typ.Oid = uint32(defines.MYSQL_TYPE_TIMESTAMP)
+		}
+	case "json", "jsonb":
+		typ.Family, typ.FamilyString = tree.JsonFamily, "json"
+		typ.Oid = uint32(defines.MYSQL_TYPE_JSON)
+	case "uuid":
+		typ.Family, typ.FamilyString, typ.Width = tree.UuidFamily, "uuid", 128
+		typ.Oid = uint32(defines.MYSQL_TYPE_UUID)
+	default:
+		return nil, fmt.Errorf("type %q does not exist", name)
+	}
+
+	switch name {
+	case "numeric", "decimal", "varchar", "bpchar", "char", "float", "time", "timestamp", "timestamptz":
+	default:
+		if len(mods) != 0 {
+			return nil, fmt.Errorf("type %s does not accept the modifiers", name)
+		}
+	}
+	return t, nil
+}
@@ -33,7 +33,7 @@ func Parse(ctx context.Context, dialectType dialect.DialectType, sql string, low
 	case dialect.MYSQL:
 		return mysql.Parse(ctx, sql, lower)
 	case dialect.POSTGRESQL:
-		return postgresql.Parse(ctx, sql)
+		return postgresql.Parse(ctx, sql, lower)
 	default:
 		return nil, moerr.NewInternalError(ctx, "type of dialect error")
 	}
@@ -44,7 +44,7 @@ func ParseOne(ctx context.Context, dialectType dialect.DialectType, sql string,
 	case dialect.MYSQL:
 		return mysql.ParseOne(ctx, sql, lower)
 	case dialect.POSTGRESQL:
-		return postgresql.ParseOne(ctx, sql)
+		return postgresql.ParseOne(ctx, sql, lower)
 	default:
 		return nil, moerr.NewInternalError(ctx, "type of dialect error")
 	}
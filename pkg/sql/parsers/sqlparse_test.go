@@ -55,7 +55,7 @@ func TestPostgresql(t *testing.T) {
 	if debugSQL.output == "" {
 		debugSQL.output = debugSQL.input
 	}
-	ast, err := postgresql.ParseOne(ctx, debugSQL.input)
+	ast, err := postgresql.ParseOne(ctx, debugSQL.input, 1)
 	if err != nil {
 		t.Errorf("Parse(%q) err: %v", debugSQL.input, err)
 		return
@@ -256,6 +256,8 @@ const (
 	AlterTableDropKey
 	AlterTableDropPrimaryKey
 	AlterTableDropForeignKey
+	AlterTableDropCheck
+	AlterTableDropConstraint
 )
 
 type AlterOptionDrop struct {
@@ -281,6 +283,12 @@ func (node *AlterOptionDrop) Format(ctx *FmtCtx) {
 	case AlterTableDropForeignKey:
 		ctx.WriteString("foreign key ")
 		node.Name.Format(ctx)
+	case AlterTableDropCheck:
+		ctx.WriteString("check ")
+		node.Name.Format(ctx)
+	case AlterTableDropConstraint:
+		ctx.WriteString("constraint ")
+		node.Name.Format(ctx)
 	}
 }
 
@@ -310,6 +318,71 @@ func (node *AlterAddCol) Format(ctx *FmtCtx) {
 	node.Position.Format(ctx)
 }
 
+type AlterPartitionType int
+
+const (
+	AlterPartitionAdd AlterPartitionType = iota
+	AlterPartitionDrop
+	AlterPartitionTruncate
+	AlterPartitionReorganize
+	AlterPartitionExchange
+)
+
+// AlterPartitionOption is the partition management clause of ALTER TABLE
+type AlterPartitionOption struct {
+	alterOptionImpl
+	Typ AlterPartitionType
+	// the partitions dropped, truncated, reorganized or exchanged,
+	// it's empty for TRUNCATE PARTITION ALL
+	PartitionNames IdentifierList
+	// the partitions added or the new partitions of REORGANIZE PARTITION
+	Partitions []*Partition
+	// the table exchanged with the partition
+	Table             *TableName
+	WithoutValidation bool
+}
+
+func (node *AlterPartitionOption) Format(ctx *FmtCtx) {
+	formatPartitions := func() {
+		prefix := "("
+		for _, p := range node.Partitions {
+			ctx.WriteString(prefix)
+			p.Format(ctx)
+			prefix = ", "
+		}
+		ctx.WriteByte(')')
+	}
+
+	switch node.Typ {
+	case AlterPartitionAdd:
+		ctx.WriteString("add partition ")
+		formatPartitions()
+	case AlterPartitionDrop:
+		ctx.WriteString("drop partition ")
+		node.PartitionNames.Format(ctx)
+	case AlterPartitionTruncate:
+		ctx.WriteString("truncate partition ")
+		if len(node.PartitionNames) == 0 {
+			ctx.WriteString("all")
+		} else {
+			node.PartitionNames.Format(ctx)
+		}
+	case AlterPartitionReorganize:
+		ctx.WriteString("reorganize partition ")
+		node.PartitionNames.Format(ctx)
+		ctx.WriteString(" into ")
+		formatPartitions()
+	case AlterPartitionExchange:
+		ctx.WriteString("exchange partition ")
+		node.PartitionNames.Format(ctx)
+		ctx.WriteString(" with table ")
+		node.Table.Format(ctx)
+		if node.WithoutValidation {
+			ctx.WriteString(" without validation")
+		}
+	}
+}
+
 type AccountsSetOption struct {
 	All          bool
 	SetAccounts  IdentifierList
@@ -21,6 +21,8 @@ type BackupStart struct {
 	Dir       string
 	//s3 option
 	Option []string
+	// Parent is the previous backup of an incremental backup
+	Parent string
 }
 
 func (node *BackupStart) Format(ctx *FmtCtx) {
@@ -34,6 +36,10 @@ func (node *BackupStart) Format(ctx *FmtCtx) {
 		ctx.WriteString("filesystem ")
 		ctx.WriteString(node.Dir)
 	}
+	if node.Parent != "" {
+		ctx.WriteString(" incremental from ")
+		ctx.WriteString(node.Parent)
+	}
 }
 
 func (node *BackupStart) GetStatementType() string { return "Backup Start" }
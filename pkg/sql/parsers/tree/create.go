@@ -204,6 +204,9 @@ func (node *CreateTable) Format(ctx *FmtCtx) {
 		if len(node.Param.Option) == 0 {
 			ctx.WriteString(" infile ")
 			ctx.WriteString("'" + node.Param.Filepath + "'")
+			if node.Param.Format != "" && node.Param.Format != CSV {
+				ctx.WriteString(" format '" + node.Param.Format + "'")
+			}
 		} else {
 			if node.Param.ScanType == S3 {
 				ctx.WriteString(" url s3option ")
@@ -736,6 +739,8 @@ func (it IndexType) ToString() string {
 		return "bsi"
 	case INDEX_TYPE_ZONEMAP:
 		return "zonemap"
+	case INDEX_TYPE_IVFFLAT:
+		return "ivfflat"
 	default:
 		return "Unknown IndexType"
 	}
@@ -748,6 +753,7 @@ const (
 	INDEX_TYPE_RTREE
 	INDEX_TYPE_BSI
 	INDEX_TYPE_ZONEMAP
+	INDEX_TYPE_IVFFLAT
 )
 
 type VisibleType int
@@ -778,6 +784,10 @@ type IndexOption struct {
 	Visible                  VisibleType
 	EngineAttribute          string
 	SecondaryEngineAttribute string
+	// the number of lists of an ivfflat index
+	AlgoParamList int64
+	// the distance of an ivfflat index, e.g. vector_l2_ops
+	AlgoParamVectorOpType string
 }
 
 // Must follow the following sequence when test
@@ -797,6 +807,16 @@ func (node *IndexOption) Format(ctx *FmtCtx) {
 		ctx.WriteString(node.Comment)
 		ctx.WriteByte(' ')
 	}
+	if node.AlgoParamList != 0 {
+		ctx.WriteString("lists = ")
+		ctx.WriteString(strconv.FormatInt(node.AlgoParamList, 10))
+		ctx.WriteByte(' ')
+	}
+	if node.AlgoParamVectorOpType != "" {
+		ctx.WriteString("op_type ")
+		ctx.WriteString(node.AlgoParamVectorOpType)
+		ctx.WriteByte(' ')
+	}
 	if node.Visible != VISIBLE_TYPE_INVALID {
 		ctx.WriteString(node.Visible.ToString())
 	}
@@ -1051,16 +1071,24 @@ func NewFullTextIndex(k []*KeyPart, n string, e bool, io *IndexOption) *FullText
 
 type CheckIndex struct {
 	tableDefImpl
+	Name     string
 	Expr     Expr
 	Enforced bool
 }
 
 func (node *CheckIndex) Format(ctx *FmtCtx) {
+	if node.Name != "" {
+		ctx.WriteString("constraint ")
+		ctx.WriteString(node.Name)
+		ctx.WriteByte(' ')
+	}
 	ctx.WriteString("check (")
 	node.Expr.Format(ctx)
 	ctx.WriteByte(')')
 	if node.Enforced {
 		ctx.WriteString(" enforced")
+	} else {
+		ctx.WriteString(" not enforced")
 	}
 }
 
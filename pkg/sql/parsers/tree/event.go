@@ -0,0 +1,203 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import (
+	"fmt"
+	"strings"
+)
+
+type EventName struct {
+	Name objName
+}
+
+func NewEventName(name Identifier, prefix ObjectNamePrefix) *EventName {
+	return &EventName{
+		Name: objName{
+			ObjectName:       name,
+			ObjectNamePrefix: prefix,
+		},
+	}
+}
+
+func (node *EventName) Format(ctx *FmtCtx) {
+	if node.Name.ExplicitSchema {
+		ctx.WriteString(string(node.Name.SchemaName))
+		ctx.WriteByte('.')
+	}
+	ctx.WriteString(string(node.Name.ObjectName))
+}
+
+func (node *EventName) HasNoNameQualifier() bool {
+	return !node.Name.ExplicitCatalog && !node.Name.ExplicitSchema
+}
+
+// EventSchedule is the ON SCHEDULE clause of the event.
+// Either At or Every is set.
+type EventSchedule struct {
+	// At is the timestamp of the one-time event
+	At Expr
+	// Every and Unit are the interval of the recurring event
+	Every  Expr
+	Unit   string
+	Starts Expr
+	Ends   Expr
+}
+
+func (node *EventSchedule) Format(ctx *FmtCtx) {
+	ctx.WriteString(" on schedule")
+	if node.At != nil {
+		ctx.WriteString(" at ")
+		node.At.Format(ctx)
+		return
+	}
+	ctx.WriteString(" every ")
+	node.Every.Format(ctx)
+	ctx.WriteByte(' ')
+	ctx.WriteString(strings.ToLower(node.Unit))
+	if node.Starts != nil {
+		ctx.WriteString(" starts ")
+		node.Starts.Format(ctx)
+	}
+	if node.Ends != nil {
+		ctx.WriteString(" ends ")
+		node.Ends.Format(ctx)
+	}
+}
+
+type EventCompletion int
+
+const (
+	EventCompletionUnspecified EventCompletion = iota
+	EventCompletionNotPreserve
+	EventCompletionPreserve
+)
+
+func (node EventCompletion) Format(ctx *FmtCtx) {
+	switch node {
+	case EventCompletionNotPreserve:
+		ctx.WriteString(" on completion not preserve")
+	case EventCompletionPreserve:
+		ctx.WriteString(" on completion preserve")
+	}
+}
+
+type EventStatus int
+
+const (
+	EventStatusUnspecified EventStatus = iota
+	EventStatusEnable
+	EventStatusDisable
+)
+
+func (node EventStatus) Format(ctx *FmtCtx) {
+	switch node {
+	case EventStatusEnable:
+		ctx.WriteString(" enable")
+	case EventStatusDisable:
+		ctx.WriteString(" disable")
+	}
+}
+
+type EventComment struct {
+	Exist   bool
+	Comment string
+}
+
+func (node *EventComment) Format(ctx *FmtCtx) {
+	if node.Exist {
+		ctx.WriteString(" comment ")
+		ctx.WriteString(fmt.Sprintf("'%s'", node.Comment))
+	}
+}
+
+type CreateEvent struct {
+	statementImpl
+	IfNotExists bool
+	Name        *EventName
+	Schedule    *EventSchedule
+	Completion  EventCompletion
+	Status      EventStatus
+	Comment     EventComment
+	Body        Statement
+}
+
+func (node *CreateEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("create event ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	node.Schedule.Format(ctx)
+	node.Completion.Format(ctx)
+	node.Status.Format(ctx)
+	node.Comment.Format(ctx)
+	ctx.WriteString(" do ")
+	node.Body.Format(ctx)
+}
+
+func (node *CreateEvent) GetStatementType() string { return "Create Event" }
+func (node *CreateEvent) GetQueryType() string     { return QueryTypeOth }
+
+// AlterEvent changes the parts of the event which are specified.
+type AlterEvent struct {
+	statementImpl
+	Name       *EventName
+	Schedule   *EventSchedule
+	Completion EventCompletion
+	RenameTo   *EventName
+	Status     EventStatus
+	Comment    EventComment
+	Body       Statement
+}
+
+func (node *AlterEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter event ")
+	node.Name.Format(ctx)
+	if node.Schedule != nil {
+		node.Schedule.Format(ctx)
+	}
+	node.Completion.Format(ctx)
+	if node.RenameTo != nil {
+		ctx.WriteString(" rename to ")
+		node.RenameTo.Format(ctx)
+	}
+	node.Status.Format(ctx)
+	node.Comment.Format(ctx)
+	if node.Body != nil {
+		ctx.WriteString(" do ")
+		node.Body.Format(ctx)
+	}
+}
+
+func (node *AlterEvent) GetStatementType() string { return "Alter Event" }
+func (node *AlterEvent) GetQueryType() string     { return QueryTypeOth }
+
+type DropEvent struct {
+	statementImpl
+	IfExists bool
+	Name     *EventName
+}
+
+func (node *DropEvent) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop event ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropEvent) GetStatementType() string { return "Drop Event" }
+func (node *DropEvent) GetQueryType() string     { return QueryTypeOth }
@@ -1530,6 +1530,10 @@ type ParamExpr struct {
 }
 
 func (node *ParamExpr) Format(ctx *FmtCtx) {
+	if node.Offset > 0 && node.Offset <= len(ctx.params) {
+		ctx.WriteString(ctx.params[node.Offset-1])
+		return
+	}
 	ctx.WriteByte('?')
 }
 
@@ -1564,3 +1568,75 @@ func (node *MaxValue) Accept(v Visitor) (Expr, bool) {
 	}
 	return v.Exit(node)
 }
+
+type FullTextSearchType int
+
+const (
+	FULLTEXT_NL FullTextSearchType = iota
+	FULLTEXT_NL_QUERY_EXPANSION
+	FULLTEXT_BOOLEAN
+	FULLTEXT_QUERY_EXPANSION
+)
+
+func (s FullTextSearchType) ToString() string {
+	switch s {
+	case FULLTEXT_NL:
+		return "in natural language mode"
+	case FULLTEXT_NL_QUERY_EXPANSION:
+		return "in natural language mode with query expansion"
+	case FULLTEXT_BOOLEAN:
+		return "in boolean mode"
+	case FULLTEXT_QUERY_EXPANSION:
+		return "with query expansion"
+	default:
+		return "unknown FullTextSearchType"
+	}
+}
+
+// MATCH(col1, col2, ...) AGAINST(expr [search_modifier])
+type FullTextMatchExpr struct {
+	exprImpl
+	KeyParts []*UnresolvedName
+	Pattern  Expr
+	Mode     FullTextSearchType
+}
+
+func (node *FullTextMatchExpr) Format(ctx *FmtCtx) {
+	ctx.WriteString("match(")
+	for i, k := range node.KeyParts {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		k.Format(ctx)
+	}
+	ctx.WriteString(") against(")
+	node.Pattern.Format(ctx)
+	if node.Mode != FULLTEXT_NL {
+		ctx.WriteByte(' ')
+		ctx.WriteString(node.Mode.ToString())
+	}
+	ctx.WriteByte(')')
+}
+
+// Accept implements NodeChecker interface
+func (node *FullTextMatchExpr) Accept(v Visitor) (Expr, bool) {
+	newNode, skipChildren := v.Enter(node)
+	if skipChildren {
+		return v.Exit(newNode)
+	}
+	node = newNode.(*FullTextMatchExpr)
+	tmpNode, ok := node.Pattern.Accept(v)
+	if !ok {
+		return node, false
+	}
+	node.Pattern = tmpNode
+	return v.Exit(node)
+}
+
+func NewFullTextMatchExpr(k []*UnresolvedName, p Expr, m FullTextSearchType) *FullTextMatchExpr {
+	return &FullTextMatchExpr{
+		KeyParts: k,
+		Pattern:  p,
+		Mode:     m,
+	}
+}
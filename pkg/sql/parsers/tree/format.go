@@ -28,6 +28,8 @@ type FmtCtx struct {
 	// quoteString string
 	quoteString       bool
 	singleQuoteString bool
+	// params are the values written for the parameters of the prepared statement
+	params []string
 }
 
 func NewFmtCtx(dialectType dialect.DialectType, opts ...FmtCtxOption) *FmtCtx {
@@ -58,6 +60,14 @@ func WithSingleQuoteString() FmtCtxOption {
 	})
 }
 
+// WithParams writes the values for the parameters of the prepared statement instead of '?',
+// the i-th value is written for the parameter at offset i+1.
+func WithParams(params []string) FmtCtxOption {
+	return FmtCtxOption(func(ctx *FmtCtx) {
+		ctx.params = params
+	})
+}
+
 // NodeFormatter for formatted output of the node.
 type NodeFormatter interface {
 	Format(ctx *FmtCtx)
@@ -0,0 +1,123 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+// JsonTable is the JSON_TABLE(doc, path COLUMNS (...)) table function.
+type JsonTable struct {
+	// Path is the row path evaluated against the document
+	Path    string
+	Columns []*JsonTableColumn
+}
+
+func (node *JsonTable) Format(ctx *FmtCtx) {
+	ctx.WriteString(quoteJsonTableString(node.Path))
+	ctx.WriteString(" columns (")
+	for i, col := range node.Columns {
+		if i > 0 {
+			ctx.WriteString(", ")
+		}
+		col.Format(ctx)
+	}
+	ctx.WriteByte(')')
+}
+
+type JsonTableColumnKind int
+
+const (
+	// JsonTableColumnPath is `name type PATH path [on_empty] [on_error]`
+	JsonTableColumnPath JsonTableColumnKind = iota
+	// JsonTableColumnExists is `name type EXISTS PATH path`
+	JsonTableColumnExists
+	// JsonTableColumnOrdinality is `name FOR ORDINALITY`
+	JsonTableColumnOrdinality
+	// JsonTableColumnNested is `NESTED [PATH] path COLUMNS (...)`
+	JsonTableColumnNested
+)
+
+type JsonTableColumn struct {
+	Kind JsonTableColumnKind
+	Name Identifier
+	Type ResolvableTypeReference
+	Path string
+	// OnEmpty and OnError are nil if the clauses are omitted
+	OnEmpty *JsonTableOnResponse
+	OnError *JsonTableOnResponse
+	// Nested is set for the NESTED PATH column
+	Nested *JsonTable
+}
+
+func (node *JsonTableColumn) Format(ctx *FmtCtx) {
+	switch node.Kind {
+	case JsonTableColumnNested:
+		ctx.WriteString("nested path ")
+		node.Nested.Format(ctx)
+		return
+	case JsonTableColumnOrdinality:
+		ctx.WriteString(string(node.Name))
+		ctx.WriteString(" for ordinality")
+		return
+	}
+	ctx.WriteString(string(node.Name))
+	ctx.WriteByte(' ')
+	node.Type.(*T).InternalType.Format(ctx)
+	if node.Kind == JsonTableColumnExists {
+		ctx.WriteString(" exists")
+	}
+	ctx.WriteString(" path ")
+	ctx.WriteString(quoteJsonTableString(node.Path))
+	if node.OnEmpty != nil {
+		ctx.WriteByte(' ')
+		node.OnEmpty.Format(ctx)
+		ctx.WriteString(" on empty")
+	}
+	if node.OnError != nil {
+		ctx.WriteByte(' ')
+		node.OnError.Format(ctx)
+		ctx.WriteString(" on error")
+	}
+}
+
+type JsonTableOnResponseKind int
+
+const (
+	JsonTableOnResponseNull JsonTableOnResponseKind = iota
+	JsonTableOnResponseError
+	JsonTableOnResponseDefault
+)
+
+// JsonTableOnResponse is the {NULL | ERROR | DEFAULT json_string} part of
+// the ON EMPTY and ON ERROR clauses.
+type JsonTableOnResponse struct {
+	Kind  JsonTableOnResponseKind
+	Value string
+}
+
+func (node *JsonTableOnResponse) Format(ctx *FmtCtx) {
+	switch node.Kind {
+	case JsonTableOnResponseNull:
+		ctx.WriteString("null")
+	case JsonTableOnResponseError:
+		ctx.WriteString("error")
+	case JsonTableOnResponseDefault:
+		ctx.WriteString("default ")
+		ctx.WriteString(quoteJsonTableString(node.Value))
+	}
+}
+
+func quoteJsonTableString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
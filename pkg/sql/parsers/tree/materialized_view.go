@@ -0,0 +1,84 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "strings"
+
+// CreateMaterializedView creates a table with the result of the query, which is
+// refreshed by REFRESH MATERIALIZED VIEW or every interval of Refresh.
+type CreateMaterializedView struct {
+	statementImpl
+	IfNotExists bool
+	Name        *TableName
+	// Refresh is nil if the view is only refreshed manually, otherwise only
+	// Every and Unit are set
+	Refresh  *EventSchedule
+	AsSource *Select
+}
+
+func (node *CreateMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("create materialized view ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	if node.Refresh != nil {
+		ctx.WriteString(" refresh every ")
+		node.Refresh.Every.Format(ctx)
+		ctx.WriteByte(' ')
+		ctx.WriteString(strings.ToLower(node.Refresh.Unit))
+	}
+	ctx.WriteString(" as ")
+	node.AsSource.Format(ctx)
+}
+
+func (node *CreateMaterializedView) GetStatementType() string { return "Create Materialized View" }
+func (node *CreateMaterializedView) GetQueryType() string     { return QueryTypeDDL }
+
+// RefreshMaterializedView recomputes the materialized view from its query, or
+// applies the changes of its base table since the last refresh if Incremental.
+type RefreshMaterializedView struct {
+	statementImpl
+	Name        *TableName
+	Incremental bool
+}
+
+func (node *RefreshMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("refresh materialized view ")
+	node.Name.Format(ctx)
+	if node.Incremental {
+		ctx.WriteString(" incremental")
+	}
+}
+
+func (node *RefreshMaterializedView) GetStatementType() string { return "Refresh Materialized View" }
+func (node *RefreshMaterializedView) GetQueryType() string     { return QueryTypeDML }
+
+type DropMaterializedView struct {
+	statementImpl
+	IfExists bool
+	Name     *TableName
+}
+
+func (node *DropMaterializedView) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop materialized view ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+}
+
+func (node *DropMaterializedView) GetStatementType() string { return "Drop Materialized View" }
+func (node *DropMaterializedView) GetQueryType() string     { return QueryTypeDDL }
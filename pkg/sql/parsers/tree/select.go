@@ -458,9 +458,15 @@ type AliasedTableExpr struct {
 	Expr       TableExpr
 	As         AliasClause
 	IndexHints []*IndexHint
+	// Lateral is set for a derived table which may refer to the columns of
+	// the preceding tables in the FROM clause.
+	Lateral bool
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
+	if node.Lateral {
+		ctx.WriteString("lateral ")
+	}
 	node.Expr.Format(ctx)
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
@@ -41,24 +41,29 @@ func TestQueryType(t *testing.T) {
 					&ValuesStatement{}: QueryTypeDQL,
 					&With{}:            QueryTypeDQL,
 					// DDL
-					&CreateDatabase{}:      QueryTypeDDL,
-					&CreateTable{}:         QueryTypeDDL,
-					&CreateView{}:          QueryTypeDDL,
-					&CreateIndex{}:         QueryTypeDDL,
-					&CreateFunction{}:      QueryTypeDDL,
-					&AlterView{}:           QueryTypeDDL,
-					&AlterDataBaseConfig{}: QueryTypeDDL,
-					&DropDatabase{}:        QueryTypeDDL,
-					&DropTable{}:           QueryTypeDDL,
-					&DropView{}:            QueryTypeDDL,
-					&DropIndex{}:           QueryTypeDDL,
-					&DropFunction{}:        QueryTypeDDL,
-					&TruncateTable{}:       QueryTypeDDL,
+					&CreateDatabase{}:         QueryTypeDDL,
+					&CreateTable{}:            QueryTypeDDL,
+					&CreateView{}:             QueryTypeDDL,
+					&CreateIndex{}:            QueryTypeDDL,
+					&CreateFunction{}:         QueryTypeDDL,
+					&AlterView{}:              QueryTypeDDL,
+					&AlterDataBaseConfig{}:    QueryTypeDDL,
+					&DropDatabase{}:           QueryTypeDDL,
+					&DropTable{}:              QueryTypeDDL,
+					&DropView{}:               QueryTypeDDL,
+					&DropIndex{}:              QueryTypeDDL,
+					&DropFunction{}:           QueryTypeDDL,
+					&CreateTrigger{}:          QueryTypeDDL,
+					&DropTrigger{}:            QueryTypeDDL,
+					&CreateMaterializedView{}: QueryTypeDDL,
+					&DropMaterializedView{}:   QueryTypeDDL,
+					&TruncateTable{}:          QueryTypeDDL,
 					// DML
-					&Insert{}: QueryTypeDML,
-					&Update{}: QueryTypeDML,
-					&Delete{}: QueryTypeDML,
-					&Load{}:   QueryTypeDML,
+					&Insert{}:                  QueryTypeDML,
+					&Update{}:                  QueryTypeDML,
+					&Delete{}:                  QueryTypeDML,
+					&Load{}:                    QueryTypeDML,
+					&RefreshMaterializedView{}: QueryTypeDML,
 					// DCL
 					&CreateAccount{}:     QueryTypeDCL,
 					&CreateRole{}:        QueryTypeDCL,
@@ -18,9 +18,19 @@ type TableFunction struct {
 	statementImpl
 	Func       *FuncExpr
 	SelectStmt *Select
+	// JsonTable is the path and the columns of JSON_TABLE
+	JsonTable *JsonTable
 }
 
 func (t *TableFunction) Format(ctx *FmtCtx) {
+	if t.JsonTable != nil {
+		ctx.WriteString("json_table(")
+		t.Func.Exprs[0].Format(ctx)
+		ctx.WriteString(", ")
+		t.JsonTable.Format(ctx)
+		ctx.WriteByte(')')
+		return
+	}
 	if t.Func != nil {
 		t.Func.Format(ctx)
 	}
@@ -42,6 +42,8 @@ const (
 	//test
 	BlobFamily
 
+	ArrayFamily
+
 	GeometryFamily
 )
 
@@ -116,12 +116,15 @@ const (
 	LZW        = "lzw"
 	ZLIB       = "zlib"
 	LZ4        = "lz4"
+	ZSTD       = "zstd"
+	ZST        = "zst" // alias for zstd
 )
 
 // load data fotmat
 const (
 	CSV      = "csv"
 	JSONLINE = "jsonline"
+	PARQUET  = "parquet"
 )
 
 // if $format is jsonline
@@ -207,6 +210,9 @@ func (node *Load) Format(ctx *FmtCtx) {
 	if len(node.Param.Option) == 0 {
 		ctx.WriteString(" infile ")
 		ctx.WriteString(node.Param.Filepath)
+		if node.Param.Format != "" && node.Param.Format != CSV {
+			ctx.WriteString(" format '" + node.Param.Format + "'")
+		}
 	} else {
 		if node.Param.ScanType == S3 {
 			ctx.WriteString(" url s3option ")
@@ -103,6 +103,11 @@ func checkIsDroppableColumn(tableDef *TableDef, colName string, ctx CompilerCont
 	if err != nil {
 		return err
 	}
+	// The column used by check constraints can't be dropped.
+	err = checkColumnWithCheck(ctx, tableDef, colName)
+	if err != nil {
+		return err
+	}
 
 	// We do not support drop column for partitioned table now
 	err = checkAlterColumnWithPartitionKeys(colName, tableDef, ctx)
@@ -46,6 +46,9 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 	case *tree.ParenExpr:
 		expr, err = b.impl.BindExpr(exprImpl.Expr, depth, isRoot)
 
+	case *tree.FullTextMatchExpr:
+		expr, err = b.bindFullTextMatch(exprImpl, depth, isRoot)
+
 	case *tree.OrExpr:
 		expr, err = b.bindFuncExprImplByAstExpr("or", []tree.Expr{exprImpl.Left, exprImpl.Right}, depth)
 
@@ -91,15 +94,26 @@ func (b *baseBinder) baseBindExpr(astExpr tree.Expr, depth int32, isRoot bool) (
 		// check existence
 		if b.GetContext() != nil && b.GetContext().Value(defines.InSp{}) != nil && b.GetContext().Value(defines.InSp{}).(bool) {
 			tmpScope := b.GetContext().Value(defines.VarScopeKey{}).(*[]map[string]interface{})
+			// the row of the trigger is bound as new.col / old.col
+			name := exprImpl.Parts[0]
+			if exprImpl.NumParts > 1 {
+				name = exprImpl.Parts[1] + "." + exprImpl.Parts[0]
+			}
 			for i := len(*tmpScope) - 1; i >= 0; i-- {
 				curScope := (*tmpScope)[i]
-				if _, ok := curScope[strings.ToLower(exprImpl.Parts[0])]; ok {
+				if val, ok := curScope[strings.ToLower(name)]; ok {
+					// the column of the row is a typed constant
+					if e, ok := val.(*Expr); ok {
+						expr = DeepCopyExpr(e)
+						err = nil
+						return
+					}
 					typ := types.T_text.ToType()
 					expr = &Expr{
 						Typ: makePlan2Type(&typ),
 						Expr: &plan.Expr_V{
 							V: &plan.VarRef{
-								Name:   exprImpl.Parts[0],
+								Name:   name,
 								System: false,
 								Global: false,
 							},
@@ -1500,6 +1514,20 @@ func bindFuncExprImplByPlanExpr(ctx context.Context, name string, args []*Expr)
 		args = []*plan.Expr{compactCol, separator}
 	}
 
+	// json_arrayagg and json_objectagg aggregate the json value built from the arguments,
+	// so that the NULL value is kept as json null rather than skipped.
+	if name == NameJsonArrayAgg || name == NameJsonObjectAgg {
+		builder := "json_array"
+		if name == NameJsonObjectAgg {
+			builder = "json_object"
+		}
+		jsonCol, e := bindFuncExprImplByPlanExpr(ctx, builder, args)
+		if e != nil {
+			return nil, e
+		}
+		args = []*plan.Expr{jsonCol}
+	}
+
 	// return new expr
 	Typ := makePlan2Type(&returnType)
 	Typ.NotNullable = function.DeduceNotNullable(funcID, args)
@@ -219,11 +219,21 @@ func (bc *BindContext) unfoldStar(ctx context.Context, table string, isSysAccoun
 	}
 }
 
+func (bc *BindContext) addHiddenTable(table string) {
+	if bc.hiddenTables == nil {
+		bc.hiddenTables = make(map[string]bool)
+	}
+	bc.hiddenTables[table] = true
+}
+
 func (bc *BindContext) doUnfoldStar(ctx context.Context, root *BindingTreeNode, visitedUsingCols map[string]any, exprs *[]tree.SelectExpr, names *[]string, isSysAccount bool) {
 	if root == nil {
 		return
 	}
 	if root.binding != nil {
+		if bc.hiddenTables[root.binding.table] {
+			return
+		}
 		for i, col := range root.binding.cols {
 			if root.binding.colIsHidden[i] {
 				continue
@@ -108,6 +108,14 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement, isPrepareStmt bool) (*P
 		return buildCreateIndex(stmt, ctx)
 	case *tree.DropIndex:
 		return buildDropIndex(stmt, ctx)
+	case *tree.CreateTrigger:
+		return buildCreateTrigger(stmt, ctx)
+	case *tree.DropTrigger:
+		return buildDropTrigger(stmt, ctx)
+	case *tree.CreateMaterializedView:
+		return buildCreateMaterializedView(stmt, ctx)
+	case *tree.DropMaterializedView:
+		return buildDropMaterializedView(stmt, ctx)
 	case *tree.ShowCreateDatabase:
 		return buildShowCreateDatabase(stmt, ctx)
 	case *tree.ShowCreateTable:
@@ -304,6 +304,10 @@ func DropColumn(ctx CompilerContext, alterPlan *plan.AlterTable, colName string,
 	if err := checkDropColumnWithForeignKey(ctx, tableDef, col); err != nil {
 		return err
 	}
+	// Check the column with check constraints.
+	if err := checkColumnWithCheck(ctx, tableDef, colName); err != nil {
+		return err
+	}
 	if err := checkVisibleColumnCnt(ctx.GetContext(), tableDef, 0, 1); err != nil {
 		return err
 	}
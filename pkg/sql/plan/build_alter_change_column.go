@@ -53,6 +53,9 @@ func ChangeColumn(ctx CompilerContext, alterPlan *plan.AlterTable, spec *tree.Al
 		if newcol != nil {
 			return moerr.NewErrDupFieldName(ctx.GetContext(), newColName)
 		}
+		if err := checkColumnWithCheck(ctx, tableDef, originalColName); err != nil {
+			return err
+		}
 	}
 
 	colType, err := getTypeFromAst(ctx.GetContext(), specNewColumn.Type)
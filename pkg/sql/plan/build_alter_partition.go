@@ -0,0 +1,484 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// buildAlterTablePartition builds the partition management of a partitioned table:
+// ADD, DROP, TRUNCATE, REORGANIZE and EXCHANGE PARTITION.
+// The data of a partition is stored in a hidden table, so the partition management is
+// translated into creating, dropping and truncating the hidden tables, moving the rows between
+// them by sql, and updating the partition info of the main table.
+func buildAlterTablePartition(ctx CompilerContext, schemaName string, tableDef *TableDef, option *tree.AlterPartitionOption) (*plan.AlterPartition, error) {
+	partitionDef := tableDef.Partition
+	if partitionDef == nil {
+		return nil, moerr.NewPartitionMgmtOnNonpartitioned(ctx.GetContext())
+	}
+	// the index tables are not partitioned, the rows of a partition can't be moved alone
+	for _, indexDef := range tableDef.Indexes {
+		if indexDef.TableExist {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "partition management of the table with secondary or unique index")
+		}
+	}
+
+	partitionOption, err := parsePartitionOption(ctx, partitionDef)
+	if err != nil {
+		return nil, err
+	}
+
+	switch option.Typ {
+	case tree.AlterPartitionAdd:
+		return buildAddPartition(ctx, tableDef, partitionOption, option)
+	case tree.AlterPartitionDrop:
+		return buildDropPartition(ctx, tableDef, partitionOption, option)
+	case tree.AlterPartitionTruncate:
+		return buildTruncatePartition(ctx, tableDef, option)
+	case tree.AlterPartitionReorganize:
+		return buildReorganizePartition(ctx, schemaName, tableDef, partitionOption, option)
+	case tree.AlterPartitionExchange:
+		return buildExchangePartition(ctx, schemaName, tableDef, partitionOption, option)
+	}
+	return nil, moerr.NewNotSupported(ctx.GetContext(), "the partition management '%s'", tree.String(option, dialect.MYSQL))
+}
+
+func buildAddPartition(ctx CompilerContext, tableDef *TableDef, partitionOption *tree.PartitionOption, option *tree.AlterPartitionOption) (*plan.AlterPartition, error) {
+	if !isRangeOrListPartition(tableDef.Partition) {
+		return nil, moerr.NewOnlyOnRangeListPartition(ctx.GetContext(), "ADD")
+	}
+	oldNum := len(partitionOption.Partitions)
+	defs := make([]*tree.Partition, 0, oldNum+len(option.Partitions))
+	defs = append(defs, partitionOption.Partitions...)
+	defs = append(defs, option.Partitions...)
+	newPartitionDef, err := rebuildPartitionDef(ctx, tableDef, partitionOption, defs)
+	if err != nil {
+		return nil, err
+	}
+
+	alter := &plan.AlterPartition{
+		Typ:          plan.AlterPartition_ADD,
+		PartitionDef: newPartitionDef,
+	}
+	usedNames := usedPartitionTableNames(tableDef.Partition)
+	for i, item := range newPartitionDef.Partitions {
+		if i < oldNum {
+			setPartitionTableName(newPartitionDef, i, tableDef.Partition.Partitions[i].PartitionTableName)
+			continue
+		}
+		newTableDef, err := makeNewPartitionTableDef(ctx, tableDef, item.PartitionName, usedNames)
+		if err != nil {
+			return nil, err
+		}
+		setPartitionTableName(newPartitionDef, i, newTableDef.Name)
+		alter.CreateTables = append(alter.CreateTables, newTableDef)
+	}
+	return alter, nil
+}
+
+func buildDropPartition(ctx CompilerContext, tableDef *TableDef, partitionOption *tree.PartitionOption, option *tree.AlterPartitionOption) (*plan.AlterPartition, error) {
+	if !isRangeOrListPartition(tableDef.Partition) {
+		return nil, moerr.NewOnlyOnRangeListPartition(ctx.GetContext(), "DROP")
+	}
+	positions, err := findPartitions(ctx, tableDef.Partition, option.PartitionNames, "DROP")
+	if err != nil {
+		return nil, err
+	}
+	if len(positions) == len(tableDef.Partition.Partitions) {
+		return nil, moerr.NewDropLastPartition(ctx.GetContext())
+	}
+
+	dropped := make(map[int]bool, len(positions))
+	for _, pos := range positions {
+		dropped[pos] = true
+	}
+	alter := &plan.AlterPartition{
+		Typ: plan.AlterPartition_DROP,
+	}
+	defs := make([]*tree.Partition, 0, len(partitionOption.Partitions)-len(positions))
+	keptTableNames := make([]string, 0, len(defs))
+	for i, def := range partitionOption.Partitions {
+		if dropped[i] {
+			alter.DropTableNames = append(alter.DropTableNames, tableDef.Partition.Partitions[i].PartitionTableName)
+			continue
+		}
+		defs = append(defs, def)
+		keptTableNames = append(keptTableNames, tableDef.Partition.Partitions[i].PartitionTableName)
+	}
+	alter.PartitionDef, err = rebuildPartitionDef(ctx, tableDef, partitionOption, defs)
+	if err != nil {
+		return nil, err
+	}
+	for i, name := range keptTableNames {
+		setPartitionTableName(alter.PartitionDef, i, name)
+	}
+	return alter, nil
+}
+
+func buildTruncatePartition(ctx CompilerContext, tableDef *TableDef, option *tree.AlterPartitionOption) (*plan.AlterPartition, error) {
+	alter := &plan.AlterPartition{
+		Typ: plan.AlterPartition_TRUNCATE,
+	}
+	// TRUNCATE PARTITION ALL
+	if len(option.PartitionNames) == 0 {
+		for _, item := range tableDef.Partition.Partitions {
+			alter.TruncateTableNames = append(alter.TruncateTableNames, item.PartitionTableName)
+		}
+		return alter, nil
+	}
+
+	positions, err := findPartitions(ctx, tableDef.Partition, option.PartitionNames, "TRUNCATE")
+	if err != nil {
+		return nil, err
+	}
+	for _, pos := range positions {
+		alter.TruncateTableNames = append(alter.TruncateTableNames, tableDef.Partition.Partitions[pos].PartitionTableName)
+	}
+	return alter, nil
+}
+
+func buildReorganizePartition(ctx CompilerContext, schemaName string, tableDef *TableDef, partitionOption *tree.PartitionOption, option *tree.AlterPartitionOption) (*plan.AlterPartition, error) {
+	if !isRangeOrListPartition(tableDef.Partition) {
+		return nil, moerr.NewOnlyOnRangeListPartition(ctx.GetContext(), "REORGANIZE")
+	}
+	positions, err := findPartitions(ctx, tableDef.Partition, option.PartitionNames, "REORGANIZE")
+	if err != nil {
+		return nil, err
+	}
+	first, last := positions[0], positions[len(positions)-1]
+	for i, pos := range positions {
+		if pos != first+i {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "When reorganizing a set of partitions they must be in consecutive order")
+		}
+	}
+
+	defs := make([]*tree.Partition, 0, len(partitionOption.Partitions)-len(positions)+len(option.Partitions))
+	defs = append(defs, partitionOption.Partitions[:first]...)
+	defs = append(defs, option.Partitions...)
+	defs = append(defs, partitionOption.Partitions[last+1:]...)
+	newPartitionDef, err := rebuildPartitionDef(ctx, tableDef, partitionOption, defs)
+	if err != nil {
+		return nil, err
+	}
+
+	alter := &plan.AlterPartition{
+		Typ:          plan.AlterPartition_REORGANIZE,
+		PartitionDef: newPartitionDef,
+	}
+	usedNames := usedPartitionTableNames(tableDef.Partition)
+	for i := range newPartitionDef.Partitions {
+		switch {
+		case i < first:
+			setPartitionTableName(newPartitionDef, i, tableDef.Partition.Partitions[i].PartitionTableName)
+		case i >= first+len(option.Partitions):
+			setPartitionTableName(newPartitionDef, i, tableDef.Partition.Partitions[i-len(option.Partitions)+len(positions)].PartitionTableName)
+		default:
+			newTableDef, err := makeNewPartitionTableDef(ctx, tableDef, newPartitionDef.Partitions[i].PartitionName, usedNames)
+			if err != nil {
+				return nil, err
+			}
+			setPartitionTableName(newPartitionDef, i, newTableDef.Name)
+			alter.CreateTables = append(alter.CreateTables, newTableDef)
+		}
+	}
+
+	// move the rows of the reorganized partitions into the new partitions
+	caseExpr, err := buildPartitionIndexExpr(partitionOption, newPartitionDef)
+	if err != nil {
+		return nil, err
+	}
+	partitionIndex := tree.String(caseExpr, dialect.MYSQL)
+	cols := visibleColumnList(tableDef)
+	sources := make([]string, len(positions))
+	for i, pos := range positions {
+		oldTableName := tableDef.Partition.Partitions[pos].PartitionTableName
+		sources[i] = fmt.Sprintf("select %s from `%s`.`%s`", cols, schemaName, oldTableName)
+		alter.DropTableNames = append(alter.DropTableNames, oldTableName)
+	}
+	source := strings.Join(sources, " union all ")
+	newIndexes := make([]string, len(option.Partitions))
+	for i := range option.Partitions {
+		newIndexes[i] = fmt.Sprintf("%d", first+i)
+		alter.MoveDataSqls = append(alter.MoveDataSqls,
+			fmt.Sprintf("insert into `%s`.`%s` (%s) select %s from (%s) as %s where (%s) = %d",
+				schemaName, newPartitionDef.Partitions[first+i].PartitionTableName, cols, cols, source, reorganizeSourceAlias, partitionIndex, first+i))
+	}
+	// the rows of the reorganized partitions must be kept in the new partitions
+	alter.ValidateSql = fmt.Sprintf("select 1 from (%s) as %s where (%s) not in (%s) limit 1",
+		source, reorganizeSourceAlias, partitionIndex, strings.Join(newIndexes, ", "))
+	return alter, nil
+}
+
+const reorganizeSourceAlias = "reorganized_partitions"
+
+func buildExchangePartition(ctx CompilerContext, schemaName string, tableDef *TableDef, partitionOption *tree.PartitionOption, option *tree.AlterPartitionOption) (*plan.AlterPartition, error) {
+	positions, err := findPartitions(ctx, tableDef.Partition, option.PartitionNames, "EXCHANGE")
+	if err != nil {
+		return nil, err
+	}
+	pos := positions[0]
+
+	exchangeSchema, exchangeName := string(option.Table.Schema()), string(option.Table.Name())
+	if exchangeSchema == "" {
+		exchangeSchema = ctx.DefaultDatabase()
+	}
+	exchangeObjRef, exchangeDef := ctx.Resolve(exchangeSchema, exchangeName)
+	if exchangeDef == nil {
+		return nil, moerr.NewNoSuchTable(ctx.GetContext(), exchangeSchema, exchangeName)
+	}
+	if exchangeDef.Partition != nil {
+		return nil, moerr.NewPartitionExchangePartTable(ctx.GetContext(), exchangeName)
+	}
+	if exchangeDef.IsTemporary {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "exchange partition with temporary table")
+	}
+	if exchangeDef.ViewSql != nil || exchangeDef.TableType == catalog.SystemExternalRel ||
+		util.TableIsClusterTable(exchangeDef.TableType) || exchangeObjRef.PubInfo != nil {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "exchange partition with the table '%s'", exchangeName)
+	}
+	if len(exchangeDef.Fkeys) > 0 || len(exchangeDef.RefChildTbls) > 0 {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "exchange partition with the table which has foreign keys")
+	}
+	for _, indexDef := range exchangeDef.Indexes {
+		if indexDef.TableExist {
+			return nil, moerr.NewNotSupported(ctx.GetContext(), "exchange partition with the table which has secondary or unique index")
+		}
+	}
+	if !sameTableMetadata(tableDef, exchangeDef) {
+		return nil, moerr.NewTablesDifferentMetadata(ctx.GetContext())
+	}
+
+	partitionTableName := tableDef.Partition.Partitions[pos].PartitionTableName
+	newTableDef, err := makeNewPartitionTableDef(ctx, tableDef, tableDef.Partition.Partitions[pos].PartitionName, usedPartitionTableNames(tableDef.Partition))
+	if err != nil {
+		return nil, err
+	}
+	newPartitionDef := DeepCopyPartitionByDef(tableDef.Partition)
+	setPartitionTableName(newPartitionDef, pos, newTableDef.Name)
+
+	alter := &plan.AlterPartition{
+		Typ:              plan.AlterPartition_EXCHANGE,
+		PartitionDef:     newPartitionDef,
+		CreateTables:     []*TableDef{newTableDef},
+		DropTableNames:   []string{partitionTableName},
+		ExchangeDatabase: exchangeSchema,
+		ExchangeTable:    exchangeName,
+	}
+	if !option.WithoutValidation {
+		caseExpr, err := buildPartitionIndexExpr(partitionOption, tableDef.Partition)
+		if err != nil {
+			return nil, err
+		}
+		alter.ValidateSql = fmt.Sprintf("select 1 from `%s`.`%s` where not ((%s) = %d) limit 1",
+			exchangeSchema, exchangeName, tree.String(caseExpr, dialect.MYSQL), pos)
+	}
+	// the rows of the table are moved into a new hidden table, and the rows of the partition
+	// are moved into the table, then the old hidden table is dropped
+	cols := visibleColumnList(tableDef)
+	alter.MoveDataSqls = []string{
+		fmt.Sprintf("insert into `%s`.`%s` (%s) select %s from `%s`.`%s`", schemaName, newTableDef.Name, cols, cols, exchangeSchema, exchangeName),
+		fmt.Sprintf("delete from `%s`.`%s`", exchangeSchema, exchangeName),
+		fmt.Sprintf("insert into `%s`.`%s` (%s) select %s from `%s`.`%s`", exchangeSchema, exchangeName, cols, cols, schemaName, partitionTableName),
+	}
+	return alter, nil
+}
+
+// parsePartitionOption recovers the partition clause of the table from the partition info
+func parsePartitionOption(ctx CompilerContext, partitionDef *plan.PartitionByDef) (*tree.PartitionOption, error) {
+	stmts, err := parsers.Parse(ctx.GetContext(), dialect.MYSQL, "create table t (a int) "+partitionDef.PartitionMsg, 1)
+	if err != nil {
+		return nil, err
+	}
+	createTable, ok := stmts[0].(*tree.CreateTable)
+	if !ok || createTable.PartitionOption == nil {
+		return nil, moerr.NewInternalError(ctx.GetContext(), "invalid partition info '%s'", partitionDef.PartitionMsg)
+	}
+	return createTable.PartitionOption, nil
+}
+
+// rebuildPartitionDef builds the partition info of the table with the new partition definitions
+func rebuildPartitionDef(ctx CompilerContext, tableDef *TableDef, partitionOption *tree.PartitionOption, defs []*tree.Partition) (*plan.PartitionByDef, error) {
+	partitionOption.Partitions = defs
+	if partitionOption.PartBy.Num != 0 {
+		partitionOption.PartBy.Num = uint64(len(defs))
+	}
+
+	newTableDef := DeepCopyTableDef(tableDef)
+	newTableDef.Partition = nil
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		Stats:       nil,
+		ObjRef:      nil,
+		TableDef:    newTableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext)
+	if err != nil {
+		return nil, err
+	}
+	partitionBinder := NewPartitionBinder(builder, bindContext)
+	err = buildPartitionByClause(ctx.GetContext(), partitionBinder, &tree.CreateTable{PartitionOption: partitionOption}, newTableDef)
+	if err != nil {
+		return nil, err
+	}
+	newTableDef.Partition.PartitionTableNames = make([]string, len(newTableDef.Partition.Partitions))
+	return newTableDef.Partition, nil
+}
+
+// buildPartitionIndexExpr builds the expression which evaluates the partition index of a row,
+// it's the same as the partition expression used by the insert
+func buildPartitionIndexExpr(partitionOption *tree.PartitionOption, partitionDef *plan.PartitionByDef) (tree.Expr, error) {
+	switch partitionType := partitionOption.PartBy.PType.(type) {
+	case *tree.RangeType:
+		if len(partitionType.ColumnList) == 0 {
+			return buildRangeCaseWhenExpr(partitionType.Expr, partitionOption.Partitions)
+		}
+		return buildRangeColumnsCaseWhenExpr(partitionType.ColumnList, partitionOption.Partitions)
+	case *tree.ListType:
+		if len(partitionType.ColumnList) == 0 {
+			return buildListCaseWhenExpr(partitionType.Expr, partitionOption.Partitions)
+		}
+		return buildListColumnsCaseWhenExpr(partitionType.ColumnList, partitionOption.Partitions)
+	case *tree.HashType:
+		return genPartitionAst(tree.Exprs{partitionType.Expr}, int64(partitionDef.PartitionNum)), nil
+	case *tree.KeyType:
+		exprs := make(tree.Exprs, len(partitionType.ColumnList))
+		for i, column := range partitionType.ColumnList {
+			exprs[i] = column
+		}
+		return genPartitionAst(exprs, int64(partitionDef.PartitionNum)), nil
+	}
+	return nil, moerr.NewNYINoCtx("partition type %T", partitionOption.PartBy.PType)
+}
+
+func isRangeOrListPartition(partitionDef *plan.PartitionByDef) bool {
+	switch partitionDef.Type {
+	case plan.PartitionType_RANGE, plan.PartitionType_RANGE_COLUMNS, plan.PartitionType_LIST, plan.PartitionType_LIST_COLUMNS:
+		return true
+	}
+	return false
+}
+
+// findPartitions returns the positions of the partitions in the order of the partition definitions
+func findPartitions(ctx CompilerContext, partitionDef *plan.PartitionByDef, names tree.IdentifierList, op string) ([]int, error) {
+	found := make(map[int]bool, len(names))
+	for _, name := range names {
+		pos := -1
+		for i, item := range partitionDef.Partitions {
+			if strings.EqualFold(item.PartitionName, string(name)) {
+				pos = i
+				break
+			}
+		}
+		if pos < 0 {
+			return nil, moerr.NewDropPartitionNonExistent(ctx.GetContext(), op)
+		}
+		found[pos] = true
+	}
+	positions := make([]int, 0, len(found))
+	for i := range partitionDef.Partitions {
+		if found[i] {
+			positions = append(positions, i)
+		}
+	}
+	return positions, nil
+}
+
+func usedPartitionTableNames(partitionDef *plan.PartitionByDef) map[string]bool {
+	usedNames := make(map[string]bool, len(partitionDef.Partitions))
+	for _, item := range partitionDef.Partitions {
+		usedNames[item.PartitionTableName] = true
+	}
+	return usedNames
+}
+
+func setPartitionTableName(partitionDef *plan.PartitionByDef, i int, name string) {
+	partitionDef.Partitions[i].PartitionTableName = name
+	partitionDef.PartitionTableNames[i] = name
+}
+
+// makeNewPartitionTableDef constructs the hidden table of a new partition. The hidden table of the old
+// partition with the same name is dropped at the end of the alter, so the name gets a suffix if it is used.
+func makeNewPartitionTableDef(ctx CompilerContext, tableDef *TableDef, partitionName string, usedNames map[string]bool) (*TableDef, error) {
+	name := partitionName
+	for i := 1; ; i++ {
+		ok, partitionTableName := util.MakeNameOfPartitionTable(name, tableDef.Name)
+		if !ok {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "invalid partition table name %s", partitionTableName)
+		}
+		if !usedNames[partitionTableName] {
+			usedNames[partitionTableName] = true
+			return makePartitionTableDef(partitionTableName, tableDef), nil
+		}
+		name = fmt.Sprintf("%s_%d", partitionName, i)
+	}
+}
+
+func visibleColumns(tableDef *TableDef) []*ColDef {
+	cols := make([]*ColDef, 0, len(tableDef.Cols))
+	for _, col := range tableDef.Cols {
+		if col.Hidden || col.Name == catalog.Row_ID {
+			continue
+		}
+		cols = append(cols, col)
+	}
+	return cols
+}
+
+func visibleColumnList(tableDef *TableDef) string {
+	cols := visibleColumns(tableDef)
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = "`" + col.Name + "`"
+	}
+	return strings.Join(names, ", ")
+}
+
+// sameTableMetadata checks the partitioned table and the table exchanged with its partition
+// have the same columns and primary key
+func sameTableMetadata(tableDef, exchangeDef *TableDef) bool {
+	cols, exchangeCols := visibleColumns(tableDef), visibleColumns(exchangeDef)
+	if len(cols) != len(exchangeCols) {
+		return false
+	}
+	for i, col := range cols {
+		exchangeCol := exchangeCols[i]
+		if !strings.EqualFold(col.Name, exchangeCol.Name) ||
+			col.Typ.Id != exchangeCol.Typ.Id ||
+			col.Typ.Width != exchangeCol.Typ.Width ||
+			col.Typ.Scale != exchangeCol.Typ.Scale ||
+			col.Default.GetNullAbility() != exchangeCol.Default.GetNullAbility() {
+			return false
+		}
+	}
+	pkNames := func(def *TableDef) string {
+		if def.Pkey == nil || onlyHasHiddenPrimaryKey(def) {
+			return ""
+		}
+		return strings.ToLower(strings.Join(def.Pkey.Names, ","))
+	}
+	return pkNames(tableDef) == pkNames(exchangeDef)
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlterTablePartition(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionTables(t, mock, map[string]string{
+		"pt_range": "create table pt_range (a int, d date) partition by range (year(d)) (partition p0 values less than (2020), partition p1 values less than (2022), partition p2 values less than (2024))",
+		"pt_list":  "create table pt_list (a int, b varchar(10)) partition by list (a) (partition p0 values in (1, 2), partition p1 values in (3, 4))",
+		"pt_hash":  "create table pt_hash (a int, b varchar(10)) partition by hash (a) partitions 4",
+		"t_range":  "create table t_range (a int, d date)",
+		"t_other":  "create table t_other (a int, d datetime)",
+	})
+
+	buildAlter := func(sql string) (*plan.AlterPartition, error) {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			return nil, err
+		}
+		alter := logicPlan.GetDdl().GetAlterTable()
+		require.Equal(t, plan.AlterTable_INPLACE, alter.AlgorithmType)
+		require.NotNil(t, alter.AlterPartition)
+		// the generated sqls are run by the compile
+		for _, sql := range append(alter.AlterPartition.MoveDataSqls, alter.AlterPartition.ValidateSql) {
+			if sql != "" {
+				_, err = parsers.Parse(mock.CurrentContext().GetContext(), dialect.MYSQL, sql, 1)
+				require.NoError(t, err, sql)
+			}
+		}
+		return alter.AlterPartition, nil
+	}
+	partitionNames := func(def *plan.PartitionByDef) []string {
+		names := make([]string, len(def.Partitions))
+		for i, item := range def.Partitions {
+			names[i] = item.PartitionName
+			require.Equal(t, item.PartitionTableName, def.PartitionTableNames[i])
+		}
+		return names
+	}
+
+	alter, err := buildAlter("alter table pt_range add partition (partition p3 values less than (2026))")
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0", "p1", "p2", "p3"}, partitionNames(alter.PartitionDef))
+	require.Equal(t, "%!%p0%!%pt_range", alter.PartitionDef.PartitionTableNames[0])
+	require.Len(t, alter.CreateTables, 1)
+	require.Equal(t, "%!%p3%!%pt_range", alter.CreateTables[0].Name)
+	require.Empty(t, alter.MoveDataSqls)
+
+	alter, err = buildAlter("alter table pt_range drop partition p1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0", "p2"}, partitionNames(alter.PartitionDef))
+	require.Equal(t, "%!%p2%!%pt_range", alter.PartitionDef.PartitionTableNames[1])
+	require.Equal(t, []string{"%!%p1%!%pt_range"}, alter.DropTableNames)
+
+	alter, err = buildAlter("alter table pt_hash truncate partition p1, p3")
+	require.NoError(t, err)
+	require.Nil(t, alter.PartitionDef)
+	require.Equal(t, []string{"%!%p1%!%pt_hash", "%!%p3%!%pt_hash"}, alter.TruncateTableNames)
+
+	alter, err = buildAlter("alter table pt_list truncate partition all")
+	require.NoError(t, err)
+	require.Len(t, alter.TruncateTableNames, 2)
+
+	alter, err = buildAlter("alter table pt_range reorganize partition p1 into (partition p1 values less than (2021), partition p1a values less than (2022))")
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0", "p1", "p1a", "p2"}, partitionNames(alter.PartitionDef))
+	// the hidden table of p1 is dropped at the end, the new p1 uses another one
+	require.Equal(t, "%!%p1_1%!%pt_range", alter.PartitionDef.PartitionTableNames[1])
+	require.Equal(t, "%!%p2%!%pt_range", alter.PartitionDef.PartitionTableNames[3])
+	require.Len(t, alter.CreateTables, 2)
+	require.Equal(t, []string{"%!%p1%!%pt_range"}, alter.DropTableNames)
+	require.Len(t, alter.MoveDataSqls, 2)
+	require.Contains(t, alter.MoveDataSqls[0], "insert into `tpch`.`%!%p1_1%!%pt_range` (`a`, `d`)")
+	require.Contains(t, alter.ValidateSql, "not in (1, 2)")
+
+	alter, err = buildAlter("alter table pt_range exchange partition p1 with table t_range")
+	require.NoError(t, err)
+	require.Equal(t, "%!%p1_1%!%pt_range", alter.PartitionDef.PartitionTableNames[1])
+	require.Equal(t, []string{"%!%p1%!%pt_range"}, alter.DropTableNames)
+	require.Equal(t, "t_range", alter.ExchangeTable)
+	require.Len(t, alter.MoveDataSqls, 3)
+	require.Contains(t, alter.ValidateSql, "select 1 from `tpch`.`t_range` where not (")
+
+	alter, err = buildAlter("alter table pt_range exchange partition p1 with table t_range without validation")
+	require.NoError(t, err)
+	require.Empty(t, alter.ValidateSql)
+
+	errCases := map[string]uint16{
+		"alter table t_range add partition (partition p3 values less than (2026))":                  moerr.ErrPartitionMgmtOnNonpartitioned,
+		"alter table pt_range drop partition p5":                                                    moerr.ErrDropPartitionNonExistent,
+		"alter table pt_hash truncate partition p5":                                                 moerr.ErrDropPartitionNonExistent,
+		"alter table pt_list drop partition p0, p1":                                                 moerr.ErrDropLastPartition,
+		"alter table pt_hash add partition (partition p4 values less than (10))":                    moerr.ErrOnlyOnRangeListPartition,
+		"alter table pt_hash drop partition p0":                                                     moerr.ErrOnlyOnRangeListPartition,
+		"alter table pt_range exchange partition p0 with table pt_list":                             moerr.ErrPartitionExchangePartTable,
+		"alter table pt_range exchange partition p0 with table t_other":                             moerr.ErrTablesDifferentMetadata,
+		"alter table pt_range reorganize partition p0, p2 into (partition p0 values less than (1))": moerr.ErrInvalidInput,
+		"alter table pt_range add partition (partition p3 values less than (2000))":                 moerr.ErrRangeNotIncreasing,
+	}
+	for sql, code := range errCases {
+		_, err := buildAlter(sql)
+		require.Error(t, err, sql)
+		require.True(t, moerr.IsMoErrCode(err, code), "%s: %v", sql, err)
+	}
+}
@@ -57,6 +57,9 @@ func RenameColumn(ctx CompilerContext, alterPlan *plan.AlterTable, spec *tree.Al
 		if newcol != nil {
 			return moerr.NewErrDupFieldName(ctx.GetContext(), newColName)
 		}
+		if err := checkColumnWithCheck(ctx, tableDef, originalColName); err != nil {
+			return err
+		}
 
 		// If the column name of the table changes, it is necessary to check if it is associated
 		// with the index key. If it is an index key column, column name replacement is required.
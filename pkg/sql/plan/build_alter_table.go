@@ -277,6 +277,8 @@ func restoreDDL(ctx CompilerContext, tableDef *TableDef, schemaName string, tblN
 			var indexStr string
 			if indexdef.Unique {
 				indexStr = "UNIQUE KEY "
+			} else if isFullTextIndex(indexdef) {
+				indexStr = "FULLTEXT KEY "
 			} else {
 				indexStr = "KEY "
 			}
@@ -289,6 +291,9 @@ func restoreDDL(ctx CompilerContext, tableDef *TableDef, schemaName string, tblN
 				}
 			}
 			indexStr += ")"
+			if isFullTextIndex(indexdef) && indexdef.IndexAlgoParams != "" {
+				indexStr += fmt.Sprintf(" WITH PARSER %s", indexdef.IndexAlgoParams)
+			}
 			if indexdef.Comment != "" {
 				indexdef.Comment = strings.Replace(indexdef.Comment, "'", "\\'", -1)
 				indexStr += fmt.Sprintf(" COMMENT '%s'", formatStr(indexdef.Comment))
@@ -330,6 +335,13 @@ func restoreDDL(ctx CompilerContext, tableDef *TableDef, schemaName string, tblN
 		}
 	}
 
+	for _, check := range tableDef.Checks {
+		if rowCount != 0 {
+			createStr += ",\n"
+		}
+		createStr += formatCheckDef(check)
+	}
+
 	if rowCount != 0 {
 		createStr += "\n"
 	}
@@ -356,12 +368,15 @@ func restoreDDL(ctx CompilerContext, tableDef *TableDef, schemaName string, tblN
 	}
 
 	var comment string
+	var compression string
 	var partition string
 	for _, def := range tableDef.Defs {
 		if proDef, ok := def.Def.(*plan.TableDef_DefType_Properties); ok {
 			for _, kv := range proDef.Properties.Properties {
 				if kv.Key == catalog.SystemRelAttr_Comment {
 					comment = " COMMENT='" + kv.Value + "'"
+				} else if kv.Key == catalog.PropCompression {
+					compression = " COMPRESSION='" + kv.Value + "'"
 				}
 			}
 		}
@@ -371,6 +386,7 @@ func restoreDDL(ctx CompilerContext, tableDef *TableDef, schemaName string, tblN
 		partition = ` ` + tableDef.Partition.PartitionMsg
 	}
 
+	createStr += compression
 	createStr += comment
 	createStr += partition
 
@@ -534,6 +550,9 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 	if tableDef.ViewSql != nil {
 		return nil, moerr.NewInternalError(ctx.GetContext(), "you should use alter view statemnt for View")
 	}
+	if tableDef.TableType == catalog.SystemMaterializedRel {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "alter table for materialized view")
+	}
 	if objRef.PubInfo != nil {
 		return nil, moerr.NewInternalError(ctx.GetContext(), "cannot alter table in subscription database")
 	}
@@ -541,6 +560,33 @@ func buildAlterTable(stmt *tree.AlterTable, ctx CompilerContext) (*Plan, error)
 	if isClusterTable && ctx.GetAccountId() != catalog.System_Account {
 		return nil, moerr.NewInternalError(ctx.GetContext(), "only the sys account can alter the cluster table")
 	}
+	for _, option := range stmt.Options {
+		if partitionOption, ok := option.(*tree.AlterPartitionOption); ok {
+			if len(stmt.Options) > 1 {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "partition management with other alter options")
+			}
+			alterPartition, err := buildAlterTablePartition(ctx, schemaName, tableDef, partitionOption)
+			if err != nil {
+				return nil, err
+			}
+			return &Plan{
+				Plan: &plan.Plan_Ddl{
+					Ddl: &plan.DataDefinition{
+						DdlType: plan.DataDefinition_ALTER_TABLE,
+						Definition: &plan.DataDefinition_AlterTable{
+							AlterTable: &plan.AlterTable{
+								Database:       schemaName,
+								TableDef:       tableDef,
+								IsClusterTable: isClusterTable,
+								AlgorithmType:  plan.AlterTable_INPLACE,
+								AlterPartition: alterPartition,
+							},
+						},
+					},
+				},
+			}, nil
+		}
+	}
 	if tableDef.Partition != nil {
 		return nil, moerr.NewInvalidInput(ctx.GetContext(), "can't add/drop column for partition table now")
 	}
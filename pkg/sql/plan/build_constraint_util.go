@@ -247,6 +247,8 @@ func setTableExprToDmlTableInfo(ctx CompilerContext, tbl tree.TableExpr, tblInfo
 		return moerr.NewInvalidInput(ctx.GetContext(), "cannot insert/update/delete from view")
 	} else if tableDef.TableType == catalog.SystemSequenceRel && ctx.GetContext().Value(defines.BgKey{}) == nil {
 		return moerr.NewInvalidInput(ctx.GetContext(), "Cannot insert/update/delete from sequence")
+	} else if tableDef.TableType == catalog.SystemMaterializedRel && ctx.GetContext().Value(defines.MViewRefreshKey{}) == nil {
+		return moerr.NewInvalidInput(ctx.GetContext(), "cannot insert/update/delete from materialized view")
 	}
 
 	var newCols []*ColDef
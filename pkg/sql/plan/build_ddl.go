@@ -23,18 +23,19 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/ivfflat"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
 )
 
-func genViewTableDef(ctx CompilerContext, stmt *tree.Select) (*plan.TableDef, error) {
-	var tableDef plan.TableDef
-
-	// check view statement
+// genSelectColDefs returns the columns of the result of the select statement
+func genSelectColDefs(ctx CompilerContext, stmt *tree.Select) ([]*plan.ColDef, error) {
 	stmtPlan, err := runBuildSelectByBinder(plan.Query_SELECT, ctx, stmt, false)
 	if err != nil {
 		return nil, err
@@ -54,6 +55,17 @@ func genViewTableDef(ctx CompilerContext, stmt *tree.Select) (*plan.TableDef, er
 			},
 		}
 	}
+	return cols, nil
+}
+
+func genViewTableDef(ctx CompilerContext, stmt *tree.Select) (*plan.TableDef, error) {
+	var tableDef plan.TableDef
+
+	// check view statement
+	cols, err := genSelectColDefs(ctx, stmt)
+	if err != nil {
+		return nil, err
+	}
 	tableDef.Cols = cols
 
 	// Check alter and change the viewsql.
@@ -505,6 +517,27 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 			if opt.Value != 0 {
 				createTable.TableDef.AutoIncrOffset = opt.Value - 1
 			}
+		case *tree.TableOptionCompression:
+			alg, level, err := compress.ParseAlgorithm(opt.Compression)
+			if err != nil {
+				return nil, err
+			}
+			value := strings.ToLower(alg.String())
+			if level != 0 {
+				value = fmt.Sprintf("%s:%d", value, level)
+			}
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{
+								Key:   catalog.PropCompression,
+								Value: value,
+							},
+						},
+					},
+				},
+			})
 
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
@@ -518,7 +551,7 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		// 	*tree.TableOptionUnion, *tree.TableOptionEncryption:
 		// 	return nil, moerr.NewNotSupported("statement: '%v'", tree.String(stmt, dialect.MYSQL))
 		case *tree.TableOptionAUTOEXTEND_SIZE, *tree.TableOptionAvgRowLength,
-			*tree.TableOptionCharset, *tree.TableOptionChecksum, *tree.TableOptionCollate, *tree.TableOptionCompression,
+			*tree.TableOptionCharset, *tree.TableOptionChecksum, *tree.TableOptionCollate,
 			*tree.TableOptionConnection, *tree.TableOptionDataDirectory, *tree.TableOptionIndexDirectory,
 			*tree.TableOptionDelayKeyWrite, *tree.TableOptionEncryption, *tree.TableOptionEngine, *tree.TableOptionEngineAttr,
 			*tree.TableOptionKeyBlockSize, *tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionPackKeys,
@@ -649,6 +682,30 @@ func addPartitionTableDef(ctx context.Context, mainTableName string, createTable
 		return moerr.NewInvalidInput(ctx, "invalid main table name %s", mainTableName)
 	}
 
+	partitionDef := createTable.TableDef.Partition
+	partitionTableDefs := make([]*TableDef, partitionDef.PartitionNum)
+
+	partitionTableNames := make([]string, partitionDef.PartitionNum)
+	for i := 0; i < int(partitionDef.PartitionNum); i++ {
+		part := partitionDef.Partitions[i]
+		ok, partitionTableName := util.MakeNameOfPartitionTable(part.GetPartitionName(), mainTableName)
+		if !ok {
+			return moerr.NewInvalidInput(ctx, "invalid partition table name %s", partitionTableName)
+		}
+
+		//save the table name for a partition
+		part.PartitionTableName = partitionTableName
+		partitionTableNames[i] = partitionTableName
+
+		partitionTableDefs[i] = makePartitionTableDef(partitionTableName, createTable.TableDef)
+	}
+	partitionDef.PartitionTableNames = partitionTableNames
+	createTable.PartitionTables = partitionTableDefs
+	return nil
+}
+
+// makePartitionTableDef constructs the table def of the hidden table which stores the data of a partition
+func makePartitionTableDef(partitionTableName string, mainTableDef *TableDef) *TableDef {
 	//common properties
 	partitionProps := []*plan.Property{
 		{
@@ -667,31 +724,20 @@ func addPartitionTableDef(ctx context.Context, mainTableName string, createTable
 			},
 		}}
 
-	partitionDef := createTable.TableDef.Partition
-	partitionTableDefs := make([]*TableDef, partitionDef.PartitionNum)
-
-	partitionTableNames := make([]string, partitionDef.PartitionNum)
-	for i := 0; i < int(partitionDef.PartitionNum); i++ {
-		part := partitionDef.Partitions[i]
-		ok, partitionTableName := util.MakeNameOfPartitionTable(part.GetPartitionName(), mainTableName)
-		if !ok {
-			return moerr.NewInvalidInput(ctx, "invalid partition table name %s", partitionTableName)
+	cols := make([]*ColDef, 0, len(mainTableDef.Cols))
+	for _, col := range mainTableDef.Cols {
+		//the row id is not a column stored by the engine
+		if col.Name == catalog.Row_ID {
+			continue
 		}
-
-		//save the table name for a partition
-		part.PartitionTableName = partitionTableName
-		partitionTableNames[i] = partitionTableName
-
-		partitionTableDefs[i] = &TableDef{
-			Name: partitionTableName,
-			Cols: createTable.TableDef.Cols, //same as the main table's column defs
-		}
-		partitionTableDefs[i].Pkey = createTable.TableDef.GetPkey()
-		partitionTableDefs[i].Defs = append(partitionTableDefs[i].Defs, partitionPropsDef)
+		cols = append(cols, col)
+	}
+	return &TableDef{
+		Name: partitionTableName,
+		Cols: cols, //same as the main table's column defs
+		Pkey: mainTableDef.GetPkey(),
+		Defs: []*plan.TableDef_DefType{partitionPropsDef},
 	}
-	partitionDef.PartitionTableNames = partitionTableNames
-	createTable.PartitionTables = partitionTableDefs
-	return nil
 }
 
 // buildPartitionByClause build partition by clause info and semantic check.
@@ -717,6 +763,8 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 	colMap := make(map[string]*ColDef)
 	uniqueIndexInfos := make([]*tree.UniqueIndex, 0)
 	secondaryIndexInfos := make([]*tree.Index, 0)
+	fullTextIndexInfos := make([]*tree.FullTextIndex, 0)
+	var checks []checkConstraint
 	for _, item := range stmt.Defs {
 		switch def := item.(type) {
 		case *tree.ColumnTableDef:
@@ -745,6 +793,9 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 					if colType.GetId() == int32(types.T_json) {
 						return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in primary key", def.Name.Parts[0]))
 					}
+					if types.T(colType.GetId()).IsArrayRelate() {
+						return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in primary key", def.Name.Parts[0]))
+					}
 					pks = append(pks, def.Name.Parts[0])
 				case *tree.AttributeComment:
 					comment = attribute.CMT.String()
@@ -766,6 +817,15 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 						Name: def.Name.Parts[0],
 					})
 					indexs = append(indexs, def.Name.Parts[0])
+				case *tree.AttributeCheckConstraint:
+					checks = append(checks, checkConstraint{
+						CheckIndex: &tree.CheckIndex{
+							Name:     attribute.Name,
+							Expr:     attribute.Expr,
+							Enforced: attribute.Enforced,
+						},
+						column: def.Name.Parts[0],
+					})
 				}
 			}
 			if len(pks) > 0 {
@@ -824,9 +884,12 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			}
 
 			secondaryIndexInfos = append(secondaryIndexInfos, def)
-			for _, key := range def.KeyParts {
-				name := key.ColName.Parts[0]
-				indexs = append(indexs, name)
+			// the column of an ivfflat index is checked when the index table is built
+			if def.KeyType != tree.INDEX_TYPE_IVFFLAT {
+				for _, key := range def.KeyParts {
+					name := key.ColName.Parts[0]
+					indexs = append(indexs, name)
+				}
 			}
 
 		case *tree.UniqueIndex:
@@ -853,9 +916,15 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			createTable.FkCols = append(createTable.FkCols, fkData.Cols)
 			createTable.TableDef.Fkeys = append(createTable.TableDef.Fkeys, fkData.Def)
 
-		case *tree.CheckIndex, *tree.FullTextIndex:
-			// unsupport in plan. will support in next version.
-			// return moerr.NewNYI(ctx.GetContext(), "table def: '%v'", def)
+		case *tree.FullTextIndex:
+			err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts)
+			if err != nil {
+				return err
+			}
+
+			fullTextIndexInfos = append(fullTextIndexInfos, def)
+		case *tree.CheckIndex:
+			checks = append(checks, checkConstraint{CheckIndex: def})
 		default:
 			return moerr.NewNYI(ctx.GetContext(), "table def: '%v'", def)
 		}
@@ -1035,14 +1104,26 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 		if colMap[str].Typ.Id == int32(types.T_json) {
 			return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", str))
 		}
+		if isIvfFlatIndexType(colMap[str].Typ) {
+			return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", str))
+		}
 	}
 
-	// check Constraint Name (include index/ unique)
-	err := checkConstraintNames(uniqueIndexInfos, secondaryIndexInfos, ctx.GetContext())
+	// check Constraint Name (include index/ unique/ fulltext)
+	err := checkConstraintNames(uniqueIndexInfos, secondaryIndexInfos, fullTextIndexInfos, ctx.GetContext())
 	if err != nil {
 		return err
 	}
 
+	// build check constraints, they're bound against the columns of the table
+	if len(checks) != 0 {
+		checkDefs, err := buildCheckDefs(ctx, createTable.TableDef, checks)
+		if err != nil {
+			return err
+		}
+		createTable.TableDef.Checks = append(createTable.TableDef.Checks, checkDefs...)
+	}
+
 	// build index table
 	if len(uniqueIndexInfos) != 0 {
 		err = buildUniqueIndexTable(createTable, uniqueIndexInfos, colMap, pkeyName, ctx)
@@ -1050,12 +1131,25 @@ func buildTableDefs(stmt *tree.CreateTable, ctx CompilerContext, createTable *pl
 			return err
 		}
 	}
+	secondaryIndexInfos, ivfFlatIndexInfos := splitIvfFlatIndexInfos(secondaryIndexInfos)
 	if len(secondaryIndexInfos) != 0 {
 		err = buildSecondaryIndexDef(createTable, secondaryIndexInfos, colMap, ctx)
 		if err != nil {
 			return err
 		}
 	}
+	if len(fullTextIndexInfos) != 0 {
+		err = buildFullTextIndexTable(createTable, fullTextIndexInfos, colMap, pkeyName, ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if len(ivfFlatIndexInfos) != 0 {
+		err = buildIvfFlatIndexTable(createTable, ivfFlatIndexInfos, colMap, pkeyName, ctx)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1105,6 +1199,9 @@ func buildUniqueIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Uni
 			if colMap[name].Typ.Id == int32(types.T_json) {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", name))
 			}
+			if isIvfFlatIndexType(colMap[name].Typ) {
+				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", name))
+			}
 			indexParts = append(indexParts, name)
 		}
 
@@ -1203,6 +1300,9 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 			if colMap[name].Typ.Id == int32(types.T_json) {
 				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("JSON column '%s' cannot be in index", name))
 			}
+			if isIvfFlatIndexType(colMap[name].Typ) {
+				return moerr.NewNotSupported(ctx.GetContext(), fmt.Sprintf("VECTOR column '%s' cannot be in index", name))
+			}
 			indexParts = append(indexParts, name)
 		}
 
@@ -1231,6 +1331,156 @@ func buildSecondaryIndexDef(createTable *plan.CreateTable, indexInfos []*tree.In
 	return nil
 }
 
+// buildFullTextIndexTable builds the hidden table of the fulltext indexes, which is an inverted
+// index from the tokens of the columns to the primary key of the table.
+func buildFullTextIndexTable(createTable *plan.CreateTable, indexInfos []*tree.FullTextIndex, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
+	if pkeyName == "" || pkeyName == catalog.FakePrimaryKeyColName {
+		return moerr.NewNotSupported(ctx.GetContext(), "fulltext index on the table without primary key")
+	}
+
+	for _, indexInfo := range indexInfos {
+		var parser, comment string
+		if indexInfo.IndexOption != nil {
+			parser = strings.ToLower(indexInfo.IndexOption.ParserName)
+			comment = indexInfo.IndexOption.Comment
+		}
+		if !fulltext.IsValidParser(parser) {
+			return moerr.NewNotSupported(ctx.GetContext(), "fulltext parser '%s'", parser)
+		}
+
+		indexParts := make([]string, 0, len(indexInfo.KeyParts))
+		for _, keyPart := range indexInfo.KeyParts {
+			name := keyPart.ColName.Parts[0]
+			if _, ok := colMap[name]; !ok {
+				return moerr.NewInvalidInput(ctx.GetContext(), "column '%s' is not exist", name)
+			}
+			if !isFullTextIndexType(colMap[name].Typ) {
+				return moerr.NewInvalidInput(ctx.GetContext(), "column '%s' cannot be part of FULLTEXT index", name)
+			}
+			indexParts = append(indexParts, name)
+		}
+
+		indexTableName, err := util.BuildFullTextIndexTableName(ctx.GetContext())
+		if err != nil {
+			return err
+		}
+		tableDef := &TableDef{
+			Name: indexTableName,
+			Cols: makeFullTextIndexCols(colMap[pkeyName].Typ),
+		}
+		pkeyCol := MakeHiddenColDefByName(catalog.CPrimaryKeyColName)
+		pkeyCol.Primary = true
+		tableDef.Cols = append(tableDef.Cols, pkeyCol)
+		tableDef.Pkey = &PrimaryKeyDef{
+			Names: []string{
+				catalog.FullTextIndexWordColName,
+				catalog.FullTextIndexDocIdColName,
+				catalog.FullTextIndexPosColName,
+			},
+			PkeyColName: catalog.CPrimaryKeyColName,
+			CompPkeyCol: pkeyCol,
+		}
+
+		indexDef := &plan.IndexDef{
+			IndexName:       indexInfo.Name,
+			IndexTableName:  indexTableName,
+			Parts:           indexParts,
+			TableExist:      true,
+			Comment:         comment,
+			IndexAlgo:       catalog.MoIndexFullTextAlgo,
+			IndexAlgoParams: parser,
+		}
+		createTable.IndexTables = append(createTable.IndexTables, tableDef)
+		createTable.TableDef.Indexes = append(createTable.TableDef.Indexes, indexDef)
+	}
+	return nil
+}
+
+// buildIvfFlatIndexTable builds the two hidden tables of the ivfflat indexes, the centroids
+// table keeps the centroids of the lists, and the entries table maps the primary key of
+// each row to the centroid of the list it belongs to.
+func buildIvfFlatIndexTable(createTable *plan.CreateTable, indexInfos []*tree.Index, colMap map[string]*ColDef, pkeyName string, ctx CompilerContext) error {
+	if pkeyName == "" || pkeyName == catalog.FakePrimaryKeyColName {
+		return moerr.NewNotSupported(ctx.GetContext(), "ivfflat index on the table without primary key")
+	}
+
+	for _, indexInfo := range indexInfos {
+		if len(indexInfo.KeyParts) != 1 {
+			return moerr.NewNotSupported(ctx.GetContext(), "ivfflat index on more than one column")
+		}
+		name := indexInfo.KeyParts[0].ColName.Parts[0]
+		if _, ok := colMap[name]; !ok {
+			return moerr.NewInvalidInput(ctx.GetContext(), "column '%s' is not exist", name)
+		}
+		if !isIvfFlatIndexType(colMap[name].Typ) {
+			return moerr.NewInvalidInput(ctx.GetContext(), "column '%s' cannot be part of IVFFLAT index", name)
+		}
+
+		var lists int64
+		var opType, comment string
+		if indexInfo.IndexOption != nil {
+			lists = indexInfo.IndexOption.AlgoParamList
+			opType = indexInfo.IndexOption.AlgoParamVectorOpType
+			comment = indexInfo.IndexOption.Comment
+		}
+		params, err := ivfflat.NewParams(ctx.GetContext(), lists, opType)
+		if err != nil {
+			return err
+		}
+
+		centroidsTableName, err := util.BuildIvfFlatIndexTableName(ctx.GetContext(), catalog.IvfFlatIndexCentroidsType)
+		if err != nil {
+			return err
+		}
+		centroidsTableDef := &TableDef{
+			Name: centroidsTableName,
+			Cols: makeIvfFlatCentroidsCols(colMap[name].Typ),
+			Pkey: &PrimaryKeyDef{
+				Names:       []string{catalog.IvfFlatIndexCentroidIdColName},
+				PkeyColName: catalog.IvfFlatIndexCentroidIdColName,
+			},
+		}
+
+		entriesTableName, err := util.BuildIvfFlatIndexTableName(ctx.GetContext(), catalog.IvfFlatIndexEntriesType)
+		if err != nil {
+			return err
+		}
+		entriesTableDef := &TableDef{
+			Name: entriesTableName,
+			Cols: makeIvfFlatEntriesCols(colMap[pkeyName].Typ),
+		}
+		pkeyCol := MakeHiddenColDefByName(catalog.CPrimaryKeyColName)
+		pkeyCol.Primary = true
+		entriesTableDef.Cols = append(entriesTableDef.Cols, pkeyCol)
+		entriesTableDef.Pkey = &PrimaryKeyDef{
+			Names: []string{
+				catalog.IvfFlatIndexCentroidIdColName,
+				catalog.IndexTablePrimaryColName,
+			},
+			PkeyColName: catalog.CPrimaryKeyColName,
+			CompPkeyCol: pkeyCol,
+		}
+
+		tableDefs := []*TableDef{centroidsTableDef, entriesTableDef}
+		tableTypes := []string{catalog.IvfFlatIndexCentroidsType, catalog.IvfFlatIndexEntriesType}
+		for i, tableDef := range tableDefs {
+			indexDef := &plan.IndexDef{
+				IndexName:          indexInfo.Name,
+				IndexTableName:     tableDef.Name,
+				Parts:              []string{name},
+				TableExist:         true,
+				Comment:            comment,
+				IndexAlgo:          catalog.MoIndexIvfFlatAlgo,
+				IndexAlgoParams:    params.String(),
+				IndexAlgoTableType: tableTypes[i],
+			}
+			createTable.IndexTables = append(createTable.IndexTables, tableDef)
+			createTable.TableDef.Indexes = append(createTable.TableDef.Indexes, indexDef)
+		}
+	}
+	return nil
+}
+
 func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, error) {
 	truncateTable := &plan.TruncateTable{}
 
@@ -1250,6 +1500,9 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 		if tableDef.ViewSql != nil {
 			return nil, moerr.NewNoSuchTable(ctx.GetContext(), truncateTable.Database, truncateTable.Table)
 		}
+		if tableDef.TableType == catalog.SystemMaterializedRel {
+			return nil, moerr.NewInternalError(ctx.GetContext(), "can not truncate materialized view '%v'", truncateTable.Table)
+		}
 
 		truncateTable.TableId = tableDef.TblId
 		if tableDef.Fkeys != nil {
@@ -1274,6 +1527,10 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 		truncateTable.IndexTableNames = make([]string, 0)
 		if tableDef.Indexes != nil {
 			for _, indexdef := range tableDef.Indexes {
+				// the centroids of an ivfflat index are kept for the new data
+				if isIvfFlatIndex(indexdef) && indexdef.IndexAlgoTableType == catalog.IvfFlatIndexCentroidsType {
+					continue
+				}
 				if indexdef.TableExist {
 					truncateTable.IndexTableNames = append(truncateTable.IndexTableNames, indexdef.IndexTableName)
 				}
@@ -1299,6 +1556,11 @@ func buildTruncateTable(stmt *tree.TruncateTable, ctx CompilerContext) (*Plan, e
 }
 
 func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
+	return doBuildDropTable(stmt, ctx, false)
+}
+
+// doBuildDropTable builds the plan of DROP TABLE, or DROP MATERIALIZED VIEW if mview is true.
+func doBuildDropTable(stmt *tree.DropTable, ctx CompilerContext, mview bool) (*Plan, error) {
 	dropTable := &plan.DropTable{
 		IfExists: stmt.IfExists,
 	}
@@ -1333,6 +1595,17 @@ func buildDropTable(stmt *tree.DropTable, ctx CompilerContext) (*Plan, error) {
 			dropTable.Table = ""
 		}
 
+		if mview && tableDef.TableType != catalog.SystemMaterializedRel {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "'%s.%s' is not a materialized view", dropTable.Database, dropTable.Table)
+		}
+		// Can not use drop table to drop materialized view.
+		if !mview && tableDef.TableType == catalog.SystemMaterializedRel && !dropTable.IfExists {
+			return nil, moerr.NewInternalError(ctx.GetContext(), "Should use 'drop materialized view' to drop a materialized view")
+		} else if !mview && tableDef.TableType == catalog.SystemMaterializedRel {
+			// If exists, don't drop anything.
+			dropTable.Table = ""
+		}
+
 		// Can not use drop table to drop sequence.
 		if tableDef.TableType == catalog.SystemSequenceRel && !dropTable.IfExists {
 			return nil, moerr.NewInternalError(ctx.GetContext(), "Should use 'drop sequence' to drop a sequence")
@@ -1519,6 +1792,7 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 	// build index
 	var uIdx *tree.UniqueIndex
 	var sIdx *tree.Index
+	var ftIdx *tree.FullTextIndex
 	switch stmt.IndexCat {
 	case tree.INDEX_CATEGORY_UNIQUE:
 		uIdx = &tree.UniqueIndex{
@@ -1532,6 +1806,15 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 			KeyParts:    stmt.KeyParts,
 			IndexOption: stmt.IndexOption,
 		}
+		if stmt.IndexOption != nil {
+			sIdx.KeyType = stmt.IndexOption.IType
+		}
+	case tree.INDEX_CATEGORY_FULLTEXT:
+		ftIdx = &tree.FullTextIndex{
+			Name:        indexName,
+			KeyParts:    stmt.KeyParts,
+			IndexOption: stmt.IndexOption,
+		}
 	default:
 		return nil, moerr.NewNotSupported(ctx.GetContext(), "statement: '%v'", tree.String(stmt, dialect.MYSQL))
 	}
@@ -1557,12 +1840,23 @@ func buildCreateIndex(stmt *tree.CreateIndex, ctx CompilerContext) (*Plan, error
 		}
 		createIndex.TableExist = true
 	}
-	if sIdx != nil {
+	if sIdx != nil && sIdx.KeyType == tree.INDEX_TYPE_IVFFLAT {
+		if err := buildIvfFlatIndexTable(indexInfo, []*tree.Index{sIdx}, colMap, oriPriKeyName, ctx); err != nil {
+			return nil, err
+		}
+		createIndex.TableExist = true
+	} else if sIdx != nil {
 		if err := buildSecondaryIndexDef(indexInfo, []*tree.Index{sIdx}, colMap, ctx); err != nil {
 			return nil, err
 		}
 		createIndex.TableExist = false
 	}
+	if ftIdx != nil {
+		if err := buildFullTextIndexTable(indexInfo, []*tree.FullTextIndex{ftIdx}, colMap, oriPriKeyName, ctx); err != nil {
+			return nil, err
+		}
+		createIndex.TableExist = true
+	}
 	createIndex.Index = indexInfo
 	createIndex.Table = tableName
 	createIndex.TableDef = tableDef
@@ -1744,6 +2038,7 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 	var indexs []string
 	uniqueIndexInfos := make([]*tree.UniqueIndex, 0)
 	secondaryIndexInfos := make([]*tree.Index, 0)
+	var checkTableDef *TableDef
 	for i, option := range stmt.Options {
 		switch opt := option.(type) {
 		case *tree.AlterOptionDrop:
@@ -1789,6 +2084,25 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 						break
 					}
 				}
+			case tree.AlterTableDropCheck:
+				alterTableDrop.Typ = plan.AlterTableDrop_CHECK
+				if !hasCheckDef(tableDef, constraintName) {
+					return nil, moerr.NewCheckNotFound(ctx.GetContext(), constraintName)
+				}
+				name_not_found = false
+			case tree.AlterTableDropConstraint:
+				// the constraint may be a foreign key or a check constraint
+				for _, fk := range tableDef.Fkeys {
+					if fk.Name == constraintName {
+						alterTableDrop.Typ = plan.AlterTableDrop_FOREIGN_KEY
+						name_not_found = false
+						break
+					}
+				}
+				if name_not_found && hasCheckDef(tableDef, constraintName) {
+					alterTableDrop.Typ = plan.AlterTableDrop_CHECK
+					name_not_found = false
+				}
 			}
 			if name_not_found {
 				return nil, moerr.NewInternalError(ctx.GetContext(), "Can't DROP '%s'; check that column/key exists", constraintName)
@@ -1884,7 +2198,13 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 				oriPriKeyName := getTablePriKeyName(tableDef.Pkey)
 
 				indexInfo := &plan.CreateTable{TableDef: &TableDef{}}
-				if err := buildSecondaryIndexDef(indexInfo, []*tree.Index{def}, colMap, ctx); err != nil {
+				indexTableExist := def.KeyType == tree.INDEX_TYPE_IVFFLAT
+				if indexTableExist {
+					err = buildIvfFlatIndexTable(indexInfo, []*tree.Index{def}, colMap, oriPriKeyName, ctx)
+				} else {
+					err = buildSecondaryIndexDef(indexInfo, []*tree.Index{def}, colMap, ctx)
+				}
+				if err != nil {
 					return nil, err
 				}
 
@@ -1895,18 +2215,67 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 							TableName:             tableName,
 							OriginTablePrimaryKey: oriPriKeyName,
 							IndexInfo:             indexInfo,
-							IndexTableExist:       false,
+							IndexTableExist:       indexTableExist,
 						},
 					},
 				}
-			case *tree.CheckIndex:
+			case *tree.FullTextIndex:
+				err := checkIndexKeypartSupportability(ctx.GetContext(), def.KeyParts)
+				if err != nil {
+					return nil, err
+				}
+
+				constrNames := map[string]bool{}
+				// Check not empty constraint name whether is duplicated.
+				for _, idx := range tableDef.Indexes {
+					nameLower := strings.ToLower(idx.IndexName)
+					constrNames[nameLower] = true
+				}
+
+				err = checkDuplicateConstraint(constrNames, def.Name, false, ctx.GetContext())
+				if err != nil {
+					return nil, err
+				}
+				if len(def.Name) == 0 {
+					setEmptyFullTextIndexName(constrNames, def)
+				}
+
+				oriPriKeyName := getTablePriKeyName(tableDef.Pkey)
+				indexInfo := &plan.CreateTable{TableDef: &TableDef{}}
+				if err = buildFullTextIndexTable(indexInfo, []*tree.FullTextIndex{def}, colMap, oriPriKeyName, ctx); err != nil {
+					return nil, err
+				}
+
 				alterTable.Actions[i] = &plan.AlterTable_Action{
-					Action: &plan.AlterTable_Action_AlterComment{
-						AlterComment: &plan.AlterTableComment{
-							NewComment: comment,
+					Action: &plan.AlterTable_Action_AddIndex{
+						AddIndex: &plan.AlterTableAddIndex{
+							DbName:                databaseName,
+							TableName:             tableName,
+							OriginTablePrimaryKey: oriPriKeyName,
+							IndexInfo:             indexInfo,
+							IndexTableExist:       true,
 						},
 					},
 				}
+			case *tree.CheckIndex:
+				if checkTableDef == nil {
+					checkTableDef = &TableDef{
+						Name:   tableDef.Name,
+						Cols:   tableDef.Cols,
+						Checks: append([]*plan.CheckDef(nil), tableDef.Checks...),
+					}
+				}
+				checkDefs, err := buildCheckDefs(ctx, checkTableDef, []checkConstraint{{CheckIndex: def}})
+				if err != nil {
+					return nil, err
+				}
+				// the later checks of the statement can't use the same name
+				checkTableDef.Checks = append(checkTableDef.Checks, checkDefs...)
+				alterTable.Actions[i] = &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_AddCheck{
+						AddCheck: checkDefs[0],
+					},
+				}
 			default:
 				return nil, moerr.NewInternalError(ctx.GetContext(), "unsupported alter option: %T", def)
 			}
@@ -2093,7 +2462,7 @@ func buildAlterTableInplace(stmt *tree.AlterTable, ctx CompilerContext) (*Plan,
 	}
 
 	// check Constraint Name (include index/ unique)
-	err := checkConstraintNames(uniqueIndexInfos, secondaryIndexInfos, ctx.GetContext())
+	err := checkConstraintNames(uniqueIndexInfos, secondaryIndexInfos, nil, ctx.GetContext())
 	if err != nil {
 		return nil, err
 	}
@@ -20,6 +20,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/ivfflat"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/util"
 	"golang.org/x/exp/slices"
@@ -350,6 +351,83 @@ func buildDeletePlans(ctx CompilerContext, builder *QueryBuilder, bindCtx *BindC
 		}
 	}
 
+	// delete fulltext index table
+	for _, indexdef := range delCtx.tableDef.Indexes {
+		if !isFullTextIndex(indexdef) {
+			continue
+		}
+		if isUpdate && !isIndexTableUpdated(delCtx.tableDef, indexdef, delCtx.updateColPosMap) {
+			continue
+		}
+		idxObjRef, idxTableDef := builder.compCtx.Resolve(delCtx.objRef.SchemaName, indexdef.IndexTableName)
+		if idxTableDef == nil {
+			return moerr.NewNoSuchTable(builder.GetContext(), delCtx.objRef.SchemaName, indexdef.IndexTableName)
+		}
+
+		pkPos, pkTyp := getPkPos(delCtx.tableDef, false)
+		lastNodeId := appendSinkScanNode(builder, bindCtx, delCtx.sourceStep)
+		lastNodeId, idxPkTyp, err := appendDeleteHiddenIndexTablePlan(builder, bindCtx, idxObjRef, idxTableDef, catalog.FullTextIndexDocIdColName, pkPos, pkTyp, lastNodeId)
+		if err != nil {
+			return err
+		}
+		delNodeInfo := makeDeleteNodeInfo(builder.compCtx, idxObjRef, idxTableDef, 0, -1, false, 1, idxPkTyp, delCtx.lockTable)
+		lastNodeId, err = makeOneDeletePlan(builder, bindCtx, lastNodeId, delNodeInfo)
+		putDeleteNodeInfo(delNodeInfo)
+		if err != nil {
+			return err
+		}
+		builder.appendStep(lastNodeId)
+
+		if isUpdate {
+			// insert the tokens of the new values
+			lastNodeId = appendSinkScanNode(builder, bindCtx, delCtx.sourceStep)
+			newSourceStep, insertTableDef, err := appendPreInsertFullTextPlan(builder, bindCtx, delCtx.tableDef, lastNodeId, indexdef, delCtx.updateColPosMap, idxObjRef, idxTableDef)
+			if err != nil {
+				return err
+			}
+			err = makeInsertPlan(ctx, builder, bindCtx, idxObjRef, insertTableDef, 0, newSourceStep, false, false, false, true, nil, false)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// delete entries table of ivfflat index
+	for _, indexdef := range delCtx.tableDef.Indexes {
+		if !isIvfFlatIndex(indexdef) || indexdef.IndexAlgoTableType != catalog.IvfFlatIndexEntriesType {
+			continue
+		}
+		if isUpdate && !isIndexTableUpdated(delCtx.tableDef, indexdef, delCtx.updateColPosMap) {
+			continue
+		}
+		idxObjRef, idxTableDef := builder.compCtx.Resolve(delCtx.objRef.SchemaName, indexdef.IndexTableName)
+		if idxTableDef == nil {
+			return moerr.NewNoSuchTable(builder.GetContext(), delCtx.objRef.SchemaName, indexdef.IndexTableName)
+		}
+
+		pkPos, pkTyp := getPkPos(delCtx.tableDef, false)
+		lastNodeId := appendSinkScanNode(builder, bindCtx, delCtx.sourceStep)
+		lastNodeId, idxPkTyp, err := appendDeleteHiddenIndexTablePlan(builder, bindCtx, idxObjRef, idxTableDef, catalog.IndexTablePrimaryColName, pkPos, pkTyp, lastNodeId)
+		if err != nil {
+			return err
+		}
+		delNodeInfo := makeDeleteNodeInfo(builder.compCtx, idxObjRef, idxTableDef, 0, -1, false, 1, idxPkTyp, delCtx.lockTable)
+		lastNodeId, err = makeOneDeletePlan(builder, bindCtx, lastNodeId, delNodeInfo)
+		putDeleteNodeInfo(delNodeInfo)
+		if err != nil {
+			return err
+		}
+		builder.appendStep(lastNodeId)
+
+		if isUpdate {
+			// assign the new values to the nearest centroids
+			err = appendInsertIvfFlatTablePlan(ctx, builder, bindCtx, delCtx.objRef, delCtx.tableDef, delCtx.sourceStep, indexdef, delCtx.updateColPosMap)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	// delete origin table
 	lastNodeId := appendSinkScanNode(builder, bindCtx, delCtx.sourceStep)
 	partExprIdx := -1
@@ -817,6 +895,41 @@ func makeInsertPlan(
 		}
 	}
 
+	// append plan for the hidden tables of fulltext indexes
+	if updateColLength == 0 {
+		for _, indexdef := range tableDef.Indexes {
+			if !isFullTextIndex(indexdef) {
+				continue
+			}
+			idxRef, idxTableDef := ctx.Resolve(objRef.SchemaName, indexdef.IndexTableName)
+			if idxTableDef == nil {
+				return moerr.NewNoSuchTable(builder.GetContext(), objRef.SchemaName, indexdef.IndexTableName)
+			}
+
+			lastNodeId = appendSinkScanNode(builder, bindCtx, sourceStep)
+			newSourceStep, insertTableDef, err := appendPreInsertFullTextPlan(builder, bindCtx, tableDef, lastNodeId, indexdef, nil, idxRef, idxTableDef)
+			if err != nil {
+				return err
+			}
+
+			err = makeInsertPlan(ctx, builder, bindCtx, idxRef, insertTableDef, 0, newSourceStep, false, false, false, true, nil, false)
+			if err != nil {
+				return err
+			}
+		}
+
+		// append plan for the entries tables of ivfflat indexes
+		for _, indexdef := range tableDef.Indexes {
+			if !isIvfFlatIndex(indexdef) || indexdef.IndexAlgoTableType != catalog.IvfFlatIndexEntriesType {
+				continue
+			}
+			err = appendInsertIvfFlatTablePlan(ctx, builder, bindCtx, objRef, tableDef, sourceStep, indexdef, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	// if table have fk. then append join node & filter node
 	// sink_scan -> join -> filter
 	if !isFkRecursionCall && len(tableDef.Fkeys) > 0 {
@@ -866,6 +979,27 @@ func makeInsertPlan(
 		builder.appendStep(lastNodeId)
 	}
 
+	// if table have check constraints. then append filter node
+	// sink_scan -> filter
+	if len(tableDef.Checks) > 0 {
+		filters, err := makeCheckFilterExprs(ctx, tableDef)
+		if err != nil {
+			return err
+		}
+		if len(filters) > 0 {
+			lastNodeId = appendSinkScanNode(builder, bindCtx, sourceStep)
+			filterNode := &Node{
+				NodeType:    plan.Node_FILTER,
+				Children:    []int32{lastNodeId},
+				FilterList:  filters,
+				ProjectList: getProjectionByLastNode(builder, lastNodeId),
+			}
+			lastNodeId = builder.appendNode(filterNode, bindCtx)
+
+			builder.appendStep(lastNodeId)
+		}
+	}
+
 	// make plan: sink_scan -> group_by -> filter  //check if pk is unique in rows
 	if checkInsertPkDup {
 		if pkPos, pkTyp := getPkPos(tableDef, true); pkPos != -1 {
@@ -2211,6 +2345,400 @@ func appendDeleteUniqueTablePlan(
 	return lastNodeId, nil
 }
 
+// isIndexTableUpdated returns true if the update stmt sets the primary key or any column of the
+// fulltext or ivfflat index, whose hidden table must be updated.
+func isIndexTableUpdated(tableDef *TableDef, indexdef *IndexDef, updateColPosMap map[string]int) bool {
+	for _, part := range indexdef.Parts {
+		if _, ok := updateColPosMap[part]; ok {
+			return true
+		}
+	}
+	if tableDef.Pkey != nil {
+		for _, name := range tableDef.Pkey.Names {
+			if _, ok := updateColPosMap[name]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// makeIndexSourceExprs returns the primary key and the columns of the index of the rows,
+// which are the source of the hidden tables of a fulltext or ivfflat index.
+// updateColPosMap is the positions of the new values if it's an update stmt.
+func makeIndexSourceExprs(builder *QueryBuilder, tableDef *TableDef, indexdef *IndexDef, updateColPosMap map[string]int) (*Expr, []*Expr, error) {
+	posMap := make(map[string]int)
+	typMap := make(map[string]*plan.Type)
+	for idx, col := range tableDef.Cols {
+		posMap[col.Name] = idx
+		typMap[col.Name] = col.Typ
+	}
+	for name, idx := range updateColPosMap {
+		posMap[name] = idx
+	}
+	colExpr := func(name string) (*Expr, error) {
+		idx, ok := posMap[name]
+		if !ok {
+			return nil, moerr.NewInternalError(builder.GetContext(), "column '%s' of index %s not found", name, indexdef.IndexName)
+		}
+		return &Expr{
+			Typ: typMap[name],
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					ColPos: int32(idx),
+					Name:   name,
+				},
+			},
+		}, nil
+	}
+
+	pkUpdated := false
+	for _, name := range tableDef.Pkey.Names {
+		if _, ok := updateColPosMap[name]; ok {
+			pkUpdated = true
+		}
+	}
+
+	var err error
+	var docId *Expr
+	pkeyName := tableDef.Pkey.PkeyColName
+	if pkeyName == catalog.CPrimaryKeyColName && pkUpdated {
+		// the composite primary key must be computed again from the new values
+		args := make([]*Expr, len(tableDef.Pkey.Names))
+		for i, name := range tableDef.Pkey.Names {
+			if args[i], err = colExpr(name); err != nil {
+				return nil, nil, err
+			}
+		}
+		if docId, err = bindFuncExprImplByPlanExpr(builder.GetContext(), "serial", args); err != nil {
+			return nil, nil, err
+		}
+	} else if docId, err = colExpr(pkeyName); err != nil {
+		return nil, nil, err
+	}
+	cols := make([]*Expr, len(indexdef.Parts))
+	for i, part := range indexdef.Parts {
+		if cols[i], err = colExpr(part); err != nil {
+			return nil, nil, err
+		}
+	}
+	return docId, cols, nil
+}
+
+// appendPreInsertFullTextPlan  build the plan to tokenize the rows into the hidden table of a fulltext index.
+// sink_scan -> function_scan(fulltext_index_tokenize) -> preinsert -> sink
+// updateColPosMap is the positions of the new values if it's an update stmt.
+func appendPreInsertFullTextPlan(
+	builder *QueryBuilder,
+	bindCtx *BindContext,
+	tableDef *TableDef,
+	lastNodeId int32,
+	indexdef *IndexDef,
+	updateColPosMap map[string]int,
+	idxObjRef *ObjectRef,
+	idxTableDef *TableDef) (int32, *TableDef, error) {
+	docId, cols, err := makeIndexSourceExprs(builder, tableDef, indexdef, updateColPosMap)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	lastNodeId, err = builder.appendFullTextIndexTokenizeNode(indexdef.IndexAlgoParams, docId, cols, lastNodeId, bindCtx)
+	if err != nil {
+		return -1, nil, err
+	}
+	tokenizeNode := builder.qry.Nodes[lastNodeId]
+	tokenizeNode.ProjectList = make([]*Expr, len(tokenizeNode.TableDef.Cols))
+	for i, col := range tokenizeNode.TableDef.Cols {
+		tokenizeNode.ProjectList[i] = &Expr{
+			Typ: col.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					ColPos: int32(i),
+					Name:   col.Name,
+				},
+			},
+		}
+	}
+
+	// the hidden columns are appended by the preinsert node
+	insertTableDef := DeepCopyTableDef(idxTableDef)
+	insertTableDef.Cols = insertTableDef.Cols[:0]
+	for _, col := range idxTableDef.Cols {
+		if !col.Hidden {
+			insertTableDef.Cols = append(insertTableDef.Cols, DeepCopyColDef(col))
+		}
+	}
+	lastNodeId = appendPreInsertNode(builder, bindCtx, idxObjRef, insertTableDef, lastNodeId, false)
+	lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
+	return builder.appendStep(lastNodeId), insertTableDef, nil
+}
+
+// appendInsertIvfFlatTablePlan  build the plan to insert the rows of the source step into the entries
+// table of an ivfflat index.
+func appendInsertIvfFlatTablePlan(
+	ctx CompilerContext,
+	builder *QueryBuilder,
+	bindCtx *BindContext,
+	objRef *ObjectRef,
+	tableDef *TableDef,
+	sourceStep int32,
+	indexdef *IndexDef,
+	updateColPosMap map[string]int) error {
+	centroids, _ := getIvfFlatIndexTables(tableDef, indexdef.IndexName)
+	if centroids == nil {
+		return moerr.NewInternalError(builder.GetContext(), "centroids table of ivfflat index %s not found", indexdef.IndexName)
+	}
+	centroidsRef, centroidsTableDef := ctx.Resolve(objRef.SchemaName, centroids.IndexTableName)
+	if centroidsTableDef == nil {
+		return moerr.NewNoSuchTable(builder.GetContext(), objRef.SchemaName, centroids.IndexTableName)
+	}
+	idxRef, idxTableDef := ctx.Resolve(objRef.SchemaName, indexdef.IndexTableName)
+	if idxTableDef == nil {
+		return moerr.NewNoSuchTable(builder.GetContext(), objRef.SchemaName, indexdef.IndexTableName)
+	}
+
+	lastNodeId := appendSinkScanNode(builder, bindCtx, sourceStep)
+	newSourceStep, insertTableDef, err := appendPreInsertIvfFlatPlan(builder, bindCtx, tableDef, lastNodeId, indexdef, updateColPosMap, centroidsRef, centroidsTableDef, idxRef, idxTableDef)
+	if err != nil {
+		return err
+	}
+	return makeInsertPlan(ctx, builder, bindCtx, idxRef, insertTableDef, 0, newSourceStep, false, false, false, true, nil, false)
+}
+
+// appendPreInsertIvfFlatPlan  build the plan to assign the rows to the nearest centroids of an ivfflat index.
+// sink_scan -> filter(col is not null) -> left join(centroids) -> agg(min distance) -> project -> preinsert -> sink
+// the rows are in the unassigned list if the index has no centroid yet.
+func appendPreInsertIvfFlatPlan(
+	builder *QueryBuilder,
+	bindCtx *BindContext,
+	tableDef *TableDef,
+	lastNodeId int32,
+	indexdef *IndexDef,
+	updateColPosMap map[string]int,
+	centroidsObjRef *ObjectRef,
+	centroidsTableDef *TableDef,
+	idxObjRef *ObjectRef,
+	idxTableDef *TableDef) (int32, *TableDef, error) {
+	params, err := ivfflat.ParseParams(builder.GetContext(), indexdef.IndexAlgoParams)
+	if err != nil {
+		return -1, nil, err
+	}
+	docId, cols, err := makeIndexSourceExprs(builder, tableDef, indexdef, updateColPosMap)
+	if err != nil {
+		return -1, nil, err
+	}
+	isNotNull, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "isnotnull", []*Expr{DeepCopyExpr(cols[0])})
+	if err != nil {
+		return -1, nil, err
+	}
+	lastNodeId = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_FILTER,
+		Children:    []int32{lastNodeId},
+		FilterList:  []*Expr{isNotNull},
+		ProjectList: []*Expr{docId, cols[0]},
+	}, bindCtx)
+
+	// scan the id and the centroid of the centroids table
+	scanTableDef := DeepCopyTableDef(centroidsTableDef)
+	scanTableDef.Cols = scanTableDef.Cols[:0]
+	for _, col := range centroidsTableDef.Cols {
+		if col.Name == catalog.IvfFlatIndexCentroidIdColName || col.Name == catalog.IvfFlatIndexCentroidColName {
+			scanTableDef.Cols = append(scanTableDef.Cols, DeepCopyColDef(col))
+		}
+	}
+	scanNodeProject := make([]*Expr, len(scanTableDef.Cols))
+	for colIdx, col := range scanTableDef.Cols {
+		scanNodeProject[colIdx] = &plan.Expr{
+			Typ: col.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					ColPos: int32(colIdx),
+					Name:   col.Name,
+				},
+			},
+		}
+	}
+	rightId := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		Stats:       &plan.Stats{},
+		ObjRef:      centroidsObjRef,
+		TableDef:    scanTableDef,
+		ProjectList: scanNodeProject,
+	}, bindCtx)
+
+	colRef := func(relPos int32, colPos int32, typ *Type) *Expr {
+		return &plan.Expr{
+			Typ: typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: relPos,
+					ColPos: colPos,
+				},
+			},
+		}
+	}
+	var centroidIdTyp, centroidTyp *Type
+	var centroidIdPos, centroidPos int32
+	for i, col := range scanTableDef.Cols {
+		if col.Name == catalog.IvfFlatIndexCentroidIdColName {
+			centroidIdPos, centroidIdTyp = int32(i), col.Typ
+		} else {
+			centroidPos, centroidTyp = int32(i), col.Typ
+		}
+	}
+	dist, err := bindFuncExprImplByPlanExpr(builder.GetContext(), params.DistanceFunc(), []*Expr{
+		colRef(1, centroidPos, centroidTyp),
+		colRef(0, 1, cols[0].Typ),
+	})
+	if err != nil {
+		return -1, nil, err
+	}
+	// the serial of the distance and the id is ordered by the distance first
+	distAndId, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "serial", []*Expr{dist, colRef(1, centroidIdPos, centroidIdTyp)})
+	if err != nil {
+		return -1, nil, err
+	}
+	lastNodeId = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_JOIN,
+		Children:    []int32{lastNodeId, rightId},
+		JoinType:    plan.Node_LEFT,
+		ProjectList: []*Expr{colRef(0, 0, docId.Typ), distAndId},
+	}, bindCtx)
+
+	minDistAndId, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "min", []*Expr{colRef(0, 1, distAndId.Typ)})
+	if err != nil {
+		return -1, nil, err
+	}
+	lastNodeId = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_AGG,
+		Children:    []int32{lastNodeId},
+		GroupBy:     []*Expr{colRef(0, 0, docId.Typ)},
+		AggList:     []*Expr{minDistAndId},
+		ProjectList: []*Expr{colRef(-2, 0, docId.Typ), colRef(-2, 1, minDistAndId.Typ)},
+	}, bindCtx)
+
+	nullId := makePlan2NullConstExprWithType()
+	nullId.Typ.Id = int32(types.T_int64)
+	centroidId, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "serial_extract", []*Expr{
+		colRef(0, 1, minDistAndId.Typ),
+		makePlan2Int64ConstExprWithType(1),
+		nullId,
+	})
+	if err != nil {
+		return -1, nil, err
+	}
+	centroidId, err = bindFuncExprImplByPlanExpr(builder.GetContext(), "coalesce", []*Expr{
+		centroidId,
+		makePlan2Int64ConstExprWithType(ivfflat.UnassignedCentroidId),
+	})
+	if err != nil {
+		return -1, nil, err
+	}
+	lastNodeId = builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_PROJECT,
+		Children:    []int32{lastNodeId},
+		ProjectList: []*Expr{centroidId, colRef(0, 0, docId.Typ)},
+	}, bindCtx)
+
+	// the hidden columns are appended by the preinsert node
+	insertTableDef := DeepCopyTableDef(idxTableDef)
+	insertTableDef.Cols = insertTableDef.Cols[:0]
+	for _, col := range idxTableDef.Cols {
+		if !col.Hidden {
+			insertTableDef.Cols = append(insertTableDef.Cols, DeepCopyColDef(col))
+		}
+	}
+	lastNodeId = appendPreInsertNode(builder, bindCtx, idxObjRef, insertTableDef, lastNodeId, false)
+	lastNodeId = appendSinkNode(builder, bindCtx, lastNodeId)
+	return builder.appendStep(lastNodeId), insertTableDef, nil
+}
+
+// appendDeleteHiddenIndexTablePlan  join the deleted rows with the hidden table of a fulltext or
+// ivfflat index on the doc id column, which is the primary key of the rows, and returns the row id
+// and the primary key of the hidden table rows of the deleted rows.
+func appendDeleteHiddenIndexTablePlan(
+	builder *QueryBuilder,
+	bindCtx *BindContext,
+	idxObjRef *ObjectRef,
+	idxTableDef *TableDef,
+	docIdColName string,
+	pkPos int,
+	pkTyp *Type,
+	baseNodeId int32,
+) (int32, *Type, error) {
+	// only scan the doc id, the primary key and the row id of the hidden table
+	scanTableDef := DeepCopyTableDef(idxTableDef)
+	scanTableDef.Cols = scanTableDef.Cols[:0]
+	var docIdPos, idxPkPos, rowIdPos int32
+	for _, col := range idxTableDef.Cols {
+		switch col.Name {
+		case docIdColName:
+			docIdPos = int32(len(scanTableDef.Cols))
+		case catalog.CPrimaryKeyColName:
+			idxPkPos = int32(len(scanTableDef.Cols))
+		case catalog.Row_ID:
+			rowIdPos = int32(len(scanTableDef.Cols))
+		default:
+			continue
+		}
+		scanTableDef.Cols = append(scanTableDef.Cols, DeepCopyColDef(col))
+	}
+	scanNodeProject := make([]*Expr, len(scanTableDef.Cols))
+	for colIdx, col := range scanTableDef.Cols {
+		scanNodeProject[colIdx] = &plan.Expr{
+			Typ: col.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					ColPos: int32(colIdx),
+					Name:   col.Name,
+				},
+			},
+		}
+	}
+	rightId := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		Stats:       &plan.Stats{},
+		ObjRef:      idxObjRef,
+		TableDef:    scanTableDef,
+		ProjectList: scanNodeProject,
+	}, bindCtx)
+
+	rightColExpr := func(pos int32) *Expr {
+		return &plan.Expr{
+			Typ: scanTableDef.Cols[pos].Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					RelPos: 1,
+					ColPos: pos,
+					Name:   scanTableDef.Cols[pos].Name,
+				},
+			},
+		}
+	}
+	leftExpr := &plan.Expr{
+		Typ: pkTyp,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				RelPos: 0,
+				ColPos: int32(pkPos),
+			},
+		},
+	}
+	condExpr, err := bindFuncExprImplByPlanExpr(builder.GetContext(), "=", []*Expr{leftExpr, rightColExpr(docIdPos)})
+	if err != nil {
+		return -1, nil, err
+	}
+
+	lastNodeId := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_JOIN,
+		Children:    []int32{baseNodeId, rightId},
+		JoinType:    plan.Node_INNER,
+		OnList:      []*Expr{condExpr},
+		ProjectList: []*Expr{rightColExpr(rowIdPos), rightColExpr(idxPkPos)},
+	}, bindCtx)
+	return lastNodeId, scanTableDef.Cols[idxPkPos].Typ, nil
+}
+
 // makePreUpdateDeletePlan
 // sink_scan -> project -> [agg] -> [filter] -> sink
 func makePreUpdateDeletePlan(
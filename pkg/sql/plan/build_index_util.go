@@ -23,7 +23,7 @@ import (
 )
 
 // checkConstraintNames Check whether the name of the constraint(index,unqiue etc) is legal, and handle constraints without a name
-func checkConstraintNames(uniqueConstraints []*tree.UniqueIndex, indexConstraints []*tree.Index, ctx context.Context) error {
+func checkConstraintNames(uniqueConstraints []*tree.UniqueIndex, indexConstraints []*tree.Index, fullTextConstraints []*tree.FullTextIndex, ctx context.Context) error {
 	constrNames := map[string]bool{}
 	// Check not empty constraint name whether is duplicated.
 	for _, constr := range indexConstraints {
@@ -38,13 +38,22 @@ func checkConstraintNames(uniqueConstraints []*tree.UniqueIndex, indexConstraint
 			return err
 		}
 	}
-	// set empty constraint names(index and unique index)
+	for _, constr := range fullTextConstraints {
+		err := checkDuplicateConstraint(constrNames, constr.Name, false, ctx)
+		if err != nil {
+			return err
+		}
+	}
+	// set empty constraint names(index, unique index and fulltext index)
 	for _, constr := range indexConstraints {
 		setEmptyIndexName(constrNames, constr)
 	}
 	for _, constr := range uniqueConstraints {
 		setEmptyUniqueIndexName(constrNames, constr)
 	}
+	for _, constr := range fullTextConstraints {
+		setEmptyFullTextIndexName(constrNames, constr)
+	}
 	return nil
 }
 
@@ -107,6 +116,26 @@ func setEmptyIndexName(namesMap map[string]bool, indexConstr *tree.Index) {
 	}
 }
 
+// setEmptyFullTextIndexName Set name for fulltext index constraint with an empty name
+func setEmptyFullTextIndexName(namesMap map[string]bool, indexConstr *tree.FullTextIndex) {
+	if indexConstr.Name == "" && len(indexConstr.KeyParts) > 0 {
+		colName := indexConstr.KeyParts[0].ColName.Parts[0]
+		constrName := colName
+		i := 2
+		if strings.EqualFold(constrName, "PRIMARY") {
+			constrName = fmt.Sprintf("%s_%d", constrName, 2)
+			i = 3
+		}
+		for namesMap[constrName] {
+			//  loop forever until we find constrName that haven't been used.
+			constrName = fmt.Sprintf("%s_%d", colName, i)
+			i++
+		}
+		indexConstr.Name = constrName
+		namesMap[constrName] = true
+	}
+}
+
 // TODO
 // Currently, using expression as index keyparts are not supported in matrixone
 func checkIndexKeypartSupportability(context context.Context, keyParts []*tree.KeyPart) error {
@@ -36,6 +36,10 @@ func buildLoad(stmt *tree.Load, ctx CompilerContext, isPrepareStmt bool) (*Plan,
 	if err != nil {
 		return nil, err
 	}
+	if getExternFormat(stmt.Param) == tree.PARQUET {
+		// a parquet file is read from its footer, it can not be split by offsets
+		stmt.Param.Parallel = false
+	}
 
 	if err := InitNullMap(stmt.Param, ctx); err != nil {
 		return nil, err
@@ -284,6 +288,15 @@ func checkNullMap(stmt *tree.Load, Cols []*ColDef, ctx CompilerContext) error {
 	return nil
 }
 
+func getExternFormat(param *tree.ExternParam) string {
+	for i := 0; i+1 < len(param.Option); i += 2 {
+		if strings.ToLower(param.Option[i]) == "format" {
+			return strings.ToLower(param.Option[i+1])
+		}
+	}
+	return param.Format
+}
+
 func getCompressType(param *tree.ExternParam, filepath string) string {
 	if param.CompressType != "" && param.CompressType != tree.AUTO {
 		return param.CompressType
@@ -300,6 +313,8 @@ func getCompressType(param *tree.ExternParam, filepath string) string {
 		return tree.BZIP2
 	case "lz4":
 		return tree.LZ4
+	case "zst", "zstd":
+		return tree.ZSTD
 	default:
 		return tree.NOCOMPRESS
 	}
@@ -0,0 +1,329 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// MaterializedViewSpec is the query of a materialized view which can be refreshed
+// incrementally, that is
+//
+//	select <group by exprs and aggregates> from <base table> [where ...] [group by ...]
+//
+// where the aggregates are SUM, COUNT, MIN and MAX without DISTINCT.
+type MaterializedViewSpec struct {
+	// Database and Table are the base table, and Alias is how the query refers to it
+	Database string
+	Table    string
+	Alias    string
+	Where    tree.Expr
+	// GroupBy is empty if the query aggregates all the rows into one row
+	GroupBy []tree.Expr
+	Columns []MaterializedViewColumn
+}
+
+// MaterializedViewColumn is a column of the result of the query of the materialized view.
+type MaterializedViewColumn struct {
+	// Agg is one of sum, count, min and max, or empty if the column is a group by expr
+	Agg string
+	// Expr is the group by expr or the argument of the aggregate, it is nil for count(*)
+	Expr tree.Expr
+}
+
+// MaterializedViewNotNullColName returns the name of the column which has the number of the
+// not null values of the SUM in the i-th column of the materialized view.
+func MaterializedViewNotNullColName(i int) string {
+	return catalog.MViewNotNullColPrefix + strconv.Itoa(i)
+}
+
+// GetMaterializedViewSpec analyzes the query of the materialized view, it returns nil if the
+// materialized view can only be refreshed by running the query again.
+func GetMaterializedViewSpec(stmt *tree.Select, defaultDB string) *MaterializedViewSpec {
+	if stmt.With != nil || len(stmt.OrderBy) > 0 || stmt.Limit != nil || stmt.Ep != nil || stmt.SelectLockInfo != nil {
+		return nil
+	}
+	clause, ok := stmt.Select.(*tree.SelectClause)
+	if !ok || clause.Distinct || clause.Having != nil || clause.From == nil || len(clause.From.Tables) != 1 {
+		return nil
+	}
+
+	spec := &MaterializedViewSpec{}
+	table := clause.From.Tables[0]
+	if join, ok := table.(*tree.JoinTableExpr); ok {
+		if join.Right != nil {
+			return nil
+		}
+		table = join.Left
+	}
+	aliased, ok := table.(*tree.AliasedTableExpr)
+	if !ok || aliased.As.Cols != nil {
+		return nil
+	}
+	name, ok := aliased.Expr.(*tree.TableName)
+	if !ok || name.ExplicitCatalog {
+		return nil
+	}
+	spec.Database, spec.Table = string(name.SchemaName), string(name.ObjectName)
+	if spec.Database == "" {
+		spec.Database = defaultDB
+	}
+	spec.Alias = spec.Table
+	if aliased.As.Alias != "" {
+		spec.Alias = string(aliased.As.Alias)
+	}
+	if spec.Database == "" {
+		return nil
+	}
+	if clause.Where != nil {
+		spec.Where = clause.Where.Expr
+	}
+
+	// the group by items can be the position or the alias of a select expr
+	for _, expr := range clause.GroupBy {
+		switch e := expr.(type) {
+		case *tree.NumVal:
+			pos, err := strconv.Atoi(e.String())
+			if err != nil || pos < 1 || pos > len(clause.Exprs) {
+				return nil
+			}
+			expr = clause.Exprs[pos-1].Expr
+		case *tree.UnresolvedName:
+			if e.NumParts == 1 && !e.Star {
+				for _, selectExpr := range clause.Exprs {
+					if selectExpr.As != nil && !selectExpr.As.Empty() && selectExpr.As.Compare() == e.Parts[0] {
+						expr = selectExpr.Expr
+						break
+					}
+				}
+			}
+		}
+		spec.GroupBy = append(spec.GroupBy, expr)
+	}
+	groupBy := make(map[string]bool, len(spec.GroupBy))
+	for _, expr := range spec.GroupBy {
+		groupBy[tree.String(expr, dialect.MYSQL)] = false
+	}
+
+	for _, selectExpr := range clause.Exprs {
+		if name, ok := selectExpr.Expr.(*tree.UnresolvedName); ok && name.Star {
+			return nil
+		}
+		if text := tree.String(selectExpr.Expr, dialect.MYSQL); len(spec.GroupBy) > 0 {
+			if _, ok := groupBy[text]; ok {
+				groupBy[text] = true
+				spec.Columns = append(spec.Columns, MaterializedViewColumn{Expr: selectExpr.Expr})
+				continue
+			}
+		}
+		column, ok := getMaterializedViewAggregate(selectExpr.Expr)
+		if !ok {
+			return nil
+		}
+		spec.Columns = append(spec.Columns, column)
+	}
+	// the rows of the materialized view must be identified by the group by exprs
+	for _, selected := range groupBy {
+		if !selected {
+			return nil
+		}
+	}
+	return spec
+}
+
+func getMaterializedViewAggregate(expr tree.Expr) (MaterializedViewColumn, bool) {
+	f, ok := expr.(*tree.FuncExpr)
+	if !ok || (f.Type != tree.FUNC_TYPE_DEFAULT && f.Type != tree.FUNC_TYPE_ALL) || f.WindowSpec != nil || len(f.OrderBy) > 0 || len(f.Exprs) != 1 {
+		return MaterializedViewColumn{}, false
+	}
+	name, ok := f.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok || name.NumParts != 1 {
+		return MaterializedViewColumn{}, false
+	}
+	agg := strings.ToLower(name.Parts[0])
+	switch agg {
+	case "count":
+		if v, ok := f.Exprs[0].(*tree.NumVal); ok && v.String() == "*" {
+			return MaterializedViewColumn{Agg: agg}, true
+		}
+	case "sum", "min", "max":
+	default:
+		return MaterializedViewColumn{}, false
+	}
+	return MaterializedViewColumn{Agg: agg, Expr: f.Exprs[0]}, true
+}
+
+// getIncrementalMaterializedViewSpec returns the spec of the query if the materialized view can
+// be refreshed incrementally by the changes of its base table.
+func getIncrementalMaterializedViewSpec(ctx CompilerContext, stmt *tree.Select) *MaterializedViewSpec {
+	spec := GetMaterializedViewSpec(stmt, ctx.DefaultDatabase())
+	if spec == nil {
+		return nil
+	}
+	obj, tableDef := ctx.Resolve(spec.Database, spec.Table)
+	if tableDef == nil || tableDef.TableType != catalog.SystemOrdinaryRel || tableDef.IsTemporary ||
+		tableDef.Partition != nil || obj.PubInfo != nil {
+		return nil
+	}
+	// the query must read nothing but the base table, e.g. no subquery in the filter
+	stmtPlan, err := runBuildSelectByBinder(plan.Query_SELECT, ctx, stmt, false)
+	if err != nil {
+		return nil
+	}
+	scans := 0
+	for _, node := range stmtPlan.GetQuery().Nodes {
+		switch node.NodeType {
+		case plan.Node_TABLE_SCAN:
+			scans++
+		case plan.Node_PROJECT, plan.Node_AGG, plan.Node_FILTER:
+		default:
+			return nil
+		}
+	}
+	if scans != 1 {
+		return nil
+	}
+	return spec
+}
+
+func makeMaterializedViewCountCol(name string) *ColDef {
+	return &ColDef{
+		Name: name,
+		Alg:  plan.CompressType_Lz4,
+		Typ: &plan.Type{
+			Id:          int32(types.T_int64),
+			NotNullable: true,
+		},
+		NotNull: true,
+		Default: &plan.Default{},
+	}
+}
+
+// buildCreateMaterializedView builds the table of the materialized view, which has the columns
+// of the result of its query. The materialized view is populated and recorded in
+// mo_catalog.mo_mviews after the table is created.
+func buildCreateMaterializedView(stmt *tree.CreateMaterializedView, ctx CompilerContext) (*Plan, error) {
+	createTable := &plan.CreateTable{
+		IfNotExists: stmt.IfNotExists,
+		Database:    string(stmt.Name.SchemaName),
+		TableDef: &TableDef{
+			TableType: catalog.SystemMaterializedRel,
+			Name:      string(stmt.Name.ObjectName),
+		},
+	}
+	if createTable.Database == "" {
+		createTable.Database = ctx.DefaultDatabase()
+	}
+	if createTable.Database == catalog.MO_CATALOG || createTable.Database == INFORMATION_SCHEMA {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "create materialized view in the system database '%s'", createTable.Database)
+	}
+	if sub, err := ctx.GetSubscriptionMeta(createTable.Database); err != nil {
+		if moerr.IsMoErrCode(err, moerr.OkExpectedEOB) {
+			return nil, moerr.NewNoDB(ctx.GetContext())
+		}
+		return nil, err
+	} else if sub != nil {
+		return nil, moerr.NewInternalError(ctx.GetContext(), "cannot create materialized view in subscription database")
+	}
+
+	cols, err := genSelectColDefs(ctx, stmt.AsSource)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool, len(cols))
+	for _, col := range cols {
+		if names[col.Name] {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "duplicate column name '%s'", col.Name)
+		}
+		if strings.HasPrefix(col.Name, "__mo_") {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "column name '%s' is illegal and conflicts with internal keyword", col.Name)
+		}
+		names[col.Name] = true
+	}
+	createTable.TableDef.Cols = cols
+
+	// the incremental refresh needs the number of the rows of each group,
+	// and the number of the not null values of each SUM
+	if spec := getIncrementalMaterializedViewSpec(ctx, stmt.AsSource); spec != nil {
+		createTable.TableDef.Cols = append(createTable.TableDef.Cols, makeMaterializedViewCountCol(catalog.MViewCountColName))
+		for i, column := range spec.Columns {
+			if column.Agg == "sum" {
+				createTable.TableDef.Cols = append(createTable.TableDef.Cols, makeMaterializedViewCountCol(MaterializedViewNotNullColName(i)))
+			}
+		}
+	}
+
+	// the rows are locked by the hidden primary key like the table without a primary key
+	createTable.TableDef.Cols = append(createTable.TableDef.Cols, &ColDef{
+		ColId:  uint64(len(createTable.TableDef.Cols)),
+		Name:   catalog.FakePrimaryKeyColName,
+		Hidden: true,
+		Typ: &Type{
+			Id:       int32(types.T_uint64),
+			AutoIncr: true,
+		},
+		Default: &plan.Default{},
+		NotNull: true,
+		Primary: true,
+	})
+	createTable.TableDef.Pkey = &PrimaryKeyDef{
+		Names:       []string{catalog.FakePrimaryKeyColName},
+		PkeyColName: catalog.FakePrimaryKeyColName,
+	}
+
+	properties := []*plan.Property{
+		{
+			Key:   catalog.SystemRelAttr_Kind,
+			Value: catalog.SystemMaterializedRel,
+		},
+		{
+			Key:   catalog.SystemRelAttr_CreateSQL,
+			Value: ctx.GetRootSql(),
+		},
+	}
+	createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+		Def: &plan.TableDef_DefType_Properties{
+			Properties: &plan.PropertiesDef{
+				Properties: properties,
+			},
+		},
+	})
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_CREATE_TABLE,
+				Definition: &plan.DataDefinition_CreateTable{
+					CreateTable: createTable,
+				},
+			},
+		},
+	}, nil
+}
+
+func buildDropMaterializedView(stmt *tree.DropMaterializedView, ctx CompilerContext) (*Plan, error) {
+	return doBuildDropTable(&tree.DropTable{
+		IfExists: stmt.IfExists,
+		Names:    tree.TableNames{stmt.Name},
+	}, ctx, true)
+}
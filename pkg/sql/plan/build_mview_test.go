@@ -0,0 +1,166 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMaterializedViewSpec(t *testing.T) {
+	getSpec := func(sql string) *MaterializedViewSpec {
+		stmts, err := parsers.Parse(context.TODO(), dialect.MYSQL, sql, 1)
+		require.NoError(t, err, sql)
+		return GetMaterializedViewSpec(stmts[0].(*tree.Select), "db1")
+	}
+
+	spec := getSpec("select a, b + 1 as c, sum(d), count(*), count(e), min(f), max(f) from t1 as x where g > 0 group by a, c")
+	require.NotNil(t, spec)
+	require.Equal(t, "db1", spec.Database)
+	require.Equal(t, "t1", spec.Table)
+	require.Equal(t, "x", spec.Alias)
+	require.Equal(t, "g > 0", tree.String(spec.Where, dialect.MYSQL))
+	require.Len(t, spec.GroupBy, 2)
+	require.Equal(t, "b + 1", tree.String(spec.GroupBy[1], dialect.MYSQL))
+	var aggs []string
+	for _, column := range spec.Columns {
+		aggs = append(aggs, column.Agg)
+	}
+	require.Equal(t, []string{"", "", "sum", "count", "count", "min", "max"}, aggs)
+	require.Nil(t, spec.Columns[3].Expr)
+	require.Equal(t, "e", tree.String(spec.Columns[4].Expr, dialect.MYSQL))
+
+	spec = getSpec("select sum(a), count(*) from db2.t1")
+	require.NotNil(t, spec)
+	require.Equal(t, "db2", spec.Database)
+	require.Equal(t, "t1", spec.Alias)
+	require.Empty(t, spec.GroupBy)
+
+	spec = getSpec("select a, count(*) from t1 group by 1")
+	require.NotNil(t, spec)
+	require.Equal(t, "a", tree.String(spec.GroupBy[0], dialect.MYSQL))
+
+	sqls := []string{
+		"select a, b from t1",
+		"select a, sum(b) from t1 group by a, c",
+		"select a, avg(b) from t1 group by a",
+		"select a, count(distinct b) from t1 group by a",
+		"select a, sum(b) + 1 from t1 group by a",
+		"select a, sum(b) from t1 group by a having sum(b) > 0",
+		"select distinct a, sum(b) from t1 group by a",
+		"select a, sum(b) from t1 group by a order by a",
+		"select a, sum(b) from t1 group by a limit 1",
+		"select t1.a, sum(t2.b) from t1 join t2 on t1.a = t2.a group by t1.a",
+		"select a, sum(b) from (select * from t1) as x group by a",
+		"select a, sum(b) from t1 group by a union all select a, sum(b) from t2 group by a",
+		"select *, count(*) from t1",
+	}
+	for _, sql := range sqls {
+		require.Nil(t, getSpec(sql), sql)
+	}
+}
+
+func TestCreateMaterializedView(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionTables(t, mock, map[string]string{
+		"mv_t": "create table mv_t (a int, b int, c decimal(10, 2))",
+	})
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	ctx.tables["mv_t"].TableType = catalog.SystemOrdinaryRel
+
+	buildCreate := func(sql string) *plan.CreateTable {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		testDeepCopy(logicPlan)
+		require.Equal(t, plan.DataDefinition_CREATE_TABLE, logicPlan.GetDdl().DdlType)
+		createTable := logicPlan.GetDdl().GetCreateTable()
+		require.Equal(t, catalog.SystemMaterializedRel, createTable.TableDef.TableType)
+		require.Equal(t, catalog.FakePrimaryKeyColName, createTable.TableDef.Pkey.PkeyColName)
+		return createTable
+	}
+	colNames := func(createTable *plan.CreateTable) []string {
+		var names []string
+		for _, col := range createTable.TableDef.Cols {
+			if !col.Hidden {
+				names = append(names, col.Name)
+			}
+		}
+		return names
+	}
+
+	createTable := buildCreate("create materialized view mv1 as select a, sum(b) as sb, count(*) as cnt, min(c) as mc from mv_t group by a")
+	require.Equal(t, "tpch", createTable.Database)
+	require.Equal(t, "mv1", createTable.TableDef.Name)
+	require.Equal(t, []string{"a", "sb", "cnt", "mc", catalog.MViewCountColName, MaterializedViewNotNullColName(1)}, colNames(createTable))
+
+	// the query can only be refreshed by running it again
+	createTable = buildCreate("create materialized view if not exists mv2 refresh every 1 minute as select a, avg(b) as ab from mv_t group by a")
+	require.True(t, createTable.IfNotExists)
+	require.Equal(t, []string{"a", "ab"}, colNames(createTable))
+
+	// the filter reads another table
+	createTable = buildCreate("create materialized view mv3 as select a, sum(b) from mv_t where a in (select a from mv_t where b > 0) group by a")
+	require.Equal(t, []string{"a", "sum(b)"}, colNames(createTable))
+
+	runTestShouldError(mock, t, []string{
+		"create materialized view mv3 as select a, a from mv_t",
+		"create materialized view mv3 as select a as __mo_x from mv_t",
+		"create materialized view mv3 as select a from no_such_table",
+		"create materialized view mo_catalog.mv3 as select a from tpch.mv_t",
+	})
+}
+
+func TestMaterializedViewTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionTables(t, mock, map[string]string{
+		"mv_t":  "create table mv_t (a int, b int)",
+		"mv_mv": "create table mv_mv (a int, sb bigint)",
+	})
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	ctx.tables["mv_t"].TableType = catalog.SystemOrdinaryRel
+	ctx.tables["mv_mv"].TableType = catalog.SystemMaterializedRel
+
+	logicPlan, err := runOneStmt(mock, t, "drop materialized view if exists mv_mv")
+	require.NoError(t, err)
+	dropTable := logicPlan.GetDdl().GetDropTable()
+	require.Equal(t, "mv_mv", dropTable.Table)
+	require.Equal(t, catalog.SystemMaterializedRel, dropTable.TableDef.TableType)
+
+	// drop table if exists skips the materialized view
+	logicPlan, err = runOneStmt(mock, t, "drop table if exists mv_mv")
+	require.NoError(t, err)
+	require.Empty(t, logicPlan.GetDdl().GetDropTable().Table)
+
+	_, err = runOneStmt(mock, t, "select a, sb from mv_mv where a > 1")
+	require.NoError(t, err)
+
+	runTestShouldError(mock, t, []string{
+		"drop materialized view mv_t",
+		"drop table mv_mv",
+		"truncate table mv_mv",
+		"alter table mv_mv add column c int",
+		"insert into mv_mv values (1, 2)",
+		"update mv_mv set sb = 1",
+		"delete from mv_mv",
+		"create trigger trg before insert on mv_mv for each row set new.a = 1",
+	})
+}
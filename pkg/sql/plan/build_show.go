@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/ivfflat"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
@@ -161,7 +162,8 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 			typeStr = fmt.Sprintf("DECIMAL(%d,%d)", col.Typ.Width, col.Typ.Scale)
 		}
 		if typ.Oid == types.T_varchar || typ.Oid == types.T_char ||
-			typ.Oid == types.T_binary || typ.Oid == types.T_varbinary {
+			typ.Oid == types.T_binary || typ.Oid == types.T_varbinary ||
+			typ.Oid == types.T_array_float32 || typ.Oid == types.T_array_float64 {
 			typeStr += fmt.Sprintf("(%d)", col.Typ.Width)
 		}
 		if typ.Oid.IsFloat() && col.Typ.Scale != -1 {
@@ -202,13 +204,23 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 
 	if tableDef.Indexes != nil {
 		for _, indexdef := range tableDef.Indexes {
+			// the two index tables of an ivfflat index are one index
+			if isIvfFlatIndex(indexdef) && indexdef.IndexAlgoTableType == catalog.IvfFlatIndexEntriesType {
+				continue
+			}
 			var indexStr string
 			if indexdef.Unique {
 				indexStr = "UNIQUE KEY "
+			} else if isFullTextIndex(indexdef) {
+				indexStr = "FULLTEXT KEY "
 			} else {
 				indexStr = "KEY "
 			}
-			indexStr += fmt.Sprintf("`%s` (", formatStr(indexdef.IndexName))
+			indexStr += fmt.Sprintf("`%s` ", formatStr(indexdef.IndexName))
+			if isIvfFlatIndex(indexdef) {
+				indexStr += "USING IVFFLAT "
+			}
+			indexStr += "("
 			for num, part := range indexdef.Parts {
 				if num == len(indexdef.Parts)-1 {
 					indexStr += fmt.Sprintf("`%s`", formatStr(part))
@@ -217,6 +229,16 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 				}
 			}
 			indexStr += ")"
+			if isFullTextIndex(indexdef) && indexdef.IndexAlgoParams != "" {
+				indexStr += fmt.Sprintf(" WITH PARSER %s", indexdef.IndexAlgoParams)
+			}
+			if isIvfFlatIndex(indexdef) {
+				params, err := ivfflat.ParseParams(ctx.GetContext(), indexdef.IndexAlgoParams)
+				if err != nil {
+					return nil, err
+				}
+				indexStr += fmt.Sprintf(" lists = %d op_type '%s'", params.Lists, params.OpType)
+			}
 			if indexdef.Comment != "" {
 				indexdef.Comment = strings.Replace(indexdef.Comment, "'", "\\'", -1)
 				indexStr += fmt.Sprintf(" COMMENT '%s'", formatStr(indexdef.Comment))
@@ -250,18 +272,28 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 			formatStr(fk.Name), strings.Join(colNames, "`,`"), formatStr(fkTableDef.Name), strings.Join(fkColNames, "`,`"), fk.OnDelete.String(), fk.OnUpdate.String())
 	}
 
+	for _, check := range tableDef.Checks {
+		if rowCount != 0 {
+			createStr += ",\n"
+		}
+		createStr += formatCheckDef(check)
+	}
+
 	if rowCount != 0 {
 		createStr += "\n"
 	}
 	createStr += ")"
 
 	var comment string
+	var compression string
 	var partition string
 	for _, def := range tableDef.Defs {
 		if proDef, ok := def.Def.(*plan.TableDef_DefType_Properties); ok {
 			for _, kv := range proDef.Properties.Properties {
 				if kv.Key == catalog.SystemRelAttr_Comment {
 					comment = " COMMENT='" + kv.Value + "'"
+				} else if kv.Key == catalog.PropCompression {
+					compression = " COMPRESSION='" + kv.Value + "'"
 				}
 			}
 		}
@@ -271,6 +303,7 @@ func buildShowCreateTable(stmt *tree.ShowCreateTable, ctx CompilerContext) (*Pla
 		partition = ` ` + tableDef.Partition.PartitionMsg
 	}
 
+	createStr += compression
 	createStr += comment
 	createStr += partition
 
@@ -450,7 +483,7 @@ func buildShowTables(stmt *tree.ShowTables, ctx CompilerContext) (*Plan, error)
 	ddlType := plan.DataDefinition_SHOW_TABLES
 	var tableType string
 	if stmt.Full {
-		tableType = fmt.Sprintf(", case relkind when 'v' then 'VIEW' when '%s' then 'CLUSTER TABLE' else 'BASE TABLE' end as Table_type", catalog.SystemClusterRel)
+		tableType = fmt.Sprintf(", case relkind when 'v' then 'VIEW' when '%s' then 'CLUSTER TABLE' when '%s' then 'MATERIALIZED VIEW' else 'BASE TABLE' end as Table_type", catalog.SystemClusterRel, catalog.SystemMaterializedRel)
 	}
 	sub, err := ctx.GetSubscriptionMeta(dbName)
 	if err != nil {
@@ -863,7 +896,7 @@ func buildShowTriggers(stmt *tree.ShowTarget, ctx CompilerContext) (*Plan, error
 	stmt.DbName = dbName
 
 	ddlType := plan.DataDefinition_SHOW_TARGET
-	sql := fmt.Sprintf("SELECT trigger_name as `Trigger`, event_manipulation as `Event`, event_object_table as `Table`, action_statement as `Statement`, action_timing as `Timing`, created as `Created`, sql_mode, definer as `Definer`, character_set_client, collation_connection, database_collation as `Database Collation` FROM %s.TRIGGERS ", INFORMATION_SCHEMA)
+	sql := fmt.Sprintf("SELECT trigger_name as `Trigger`, event_manipulation as `Event`, table_name as `Table`, action_statement as `Statement`, action_timing as `Timing`, created_time as `Created`, '' as sql_mode, definer as `Definer`, 'utf8mb4' as character_set_client, 'utf8mb4_0900_ai_ci' as collation_connection, 'utf8mb4_0900_ai_ci' as `Database Collation` FROM %s.%s WHERE trigger_db = '%s' ORDER BY table_name, event_manipulation, action_timing, action_order", MO_CATALOG_DB_NAME, catalog.MO_TRIGGERS, dbName)
 
 	if stmt.Where != nil {
 		return returnByWhereAndBaseSQL(ctx, sql, stmt.Where, ddlType)
@@ -872,7 +905,7 @@ func buildShowTriggers(stmt *tree.ShowTarget, ctx CompilerContext) (*Plan, error
 	if stmt.Like != nil {
 		// append filter [AND ma.attname like stmt.Like] to WHERE clause
 		likeExpr := stmt.Like
-		likeExpr.Left = tree.SetUnresolvedName("event_object_table")
+		likeExpr.Left = tree.SetUnresolvedName("table_name")
 		return returnByLikeAndSQL(ctx, sql, likeExpr, ddlType)
 	}
 
@@ -434,6 +434,8 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"select n_name, count(*) from nation group by n_name order by 2 asc",
 		"select count(distinct 12)",
 		"select nullif(n_name, n_comment), ifnull(n_comment, n_name) from nation",
+		"select json_set(json_object('k', n_name), '$.r', n_regionkey), json_array(n_nationkey, null) from nation",
+		"select n_regionkey, json_arrayagg(n_name), json_objectagg(n_name, n_nationkey) from nation group by n_regionkey",
 
 		"select 18446744073709551500",
 		"select 0xffffffffffffffff",
@@ -483,6 +485,10 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"select date('2022-01-01'), adddate(time'00:00:00', interval 1 day), subdate(time'00:00:00', interval 1 week), '2007-01-01' + interval 1 month, '2007-01-01' -  interval 1 hour",
 		"select 2222332222222223333333333333333333, 0x616263,-10, bit_and(2), bit_or(2), bit_xor(10.1), 'aaa' like '%a',str_to_date('04/31/2004', '%m/%d/%Y'),unix_timestamp(from_unixtime(2147483647))",
 		"select max(n_nationkey) over  (partition by N_REGIONKEY) from nation",
+		"select lag(n_name) over (partition by n_regionkey order by n_nationkey), lead(n_nationkey, 2, -1) over (order by n_nationkey) from nation",
+		"select first_value(n_name) over (order by n_nationkey rows between 1 preceding and 1 following), last_value(n_name) over (order by n_nationkey), nth_value(n_name, 1+1) over (partition by n_regionkey) from nation",
+		"select lag(n_name, cast(1 as unsigned)) over (order by n_nationkey), ntile(cast(4 as tinyint)) over (order by n_nationkey) from nation",
+		"select ntile(4) over (order by n_nationkey), percent_rank() over (partition by n_regionkey order by n_nationkey), cume_dist() over (order by n_nationkey) from nation",
 		"select * from generate_series(1, 5) g",
 		"select * from nation where n_name like ? or n_nationkey > 10 order by 2 limit '10'",
 
@@ -507,6 +513,14 @@ func TestSingleTableSQLBuilder(t *testing.T) {
 		"SELECT DISTINCT N_NAME FROM NATION GROUP BY N_REGIONKEY", //test distinct with group by
 		"SELECT DISTINCT N_NAME FROM NATION ORDER BY N_REGIONKEY", //test distinct with order by
 		"select count(n_name) from nation limit 10 for update",
+		"select lag(n_name, -1) over (order by n_nationkey) from nation",           // negative offset
+		"select lead(n_name, n_nationkey) over (order by n_nationkey) from nation", // non-constant offset
+		"select nth_value(n_name, 0) over (order by n_nationkey) from nation",      // n must be positive
+		"select ntile(null) over (order by n_nationkey) from nation",
+		"select ntile(0) over (order by n_nationkey) from nation",
+		"select nth_value(n_name, 18446744073709551615) over (order by n_nationkey) from nation", // n out of the range of int64
+		"select lag(n_name, 1.0) over (order by n_nationkey) from nation",                        // non-integer offset
+		"select ntile('2') over (order by n_nationkey) from nation",
 		//"select 18446744073709551500",                             //over int64
 		//"select 0xffffffffffffffff",                               //over int64
 	}
@@ -813,6 +827,9 @@ func TestDdl(t *testing.T) {
 		"unlock tables",
 		"alter table emp drop foreign key fk1",
 		"alter table nation add FOREIGN KEY fk_t1(n_nationkey) REFERENCES nation2(n_nationkey)",
+		"create table tbl_name (a int, b varchar(20)) compression = 'zstd'",
+		"create table tbl_name (a int, b varchar(20)) compression = 'ZSTD:19' comment 'zstd'",
+		"create table tbl_name (a int, b varchar(20)) compression 'snappy'",
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -832,6 +849,8 @@ func TestDdl(t *testing.T) {
 		"alter table nation drop foreign key fk1", //key not exists
 		"alter table nation add FOREIGN KEY fk_t1(col_not_exist) REFERENCES nation2(n_nationkey)",
 		"alter table nation add FOREIGN KEY fk_t1(n_nationkey) REFERENCES nation2(col_not_exist)",
+		"create table tbl_name (a int) compression = 'gzip'",
+		"create table tbl_name (a int) compression = 'zstd:30'",
 	}
 	runTestShouldError(mock, t, sqls)
 }
@@ -1026,6 +1045,61 @@ func TestBuildUnnest(t *testing.T) {
 	runTestShouldError(mock, t, errSqls)
 }
 
+func TestBuildJsonTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		`select * from json_table('[{"a": 1}]', '$[*]' columns (id for ordinality, a int path '$.a' default '0' on empty, e int exists path '$.e')) as jt`,
+		`select jt.a, jt.b from json_table('[{"a": 1}]', '$[*]' columns (a decimal(10, 2) path '$.a', nested path '$.b[*]' columns (b varchar(10) path '$' null on error))) as jt where jt.a > 0`,
+		`select n_name, jt.v from nation, json_table(n_comment, '$' columns (v json path '$.v')) as jt`,
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+	errSqls := []string{
+		`select * from json_table('[{"a": 1}]', '$[*]' columns (a int path '$.a'))`,
+		`select * from json_table('[{"a": 1}]', '$[*]' columns (a int path '$.a', a int path '$.b')) as jt`,
+		`select * from json_table('[{"a": 1}]', '$[*' columns (a int path '$.a')) as jt`,
+		`select * from json_table('[{"a": 1}]', '$[*]' columns (a int path 'a')) as jt`,
+		`select * from json_table(1, '$[*]' columns (a int path '$.a')) as jt`,
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
+func TestBuildLateral(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	sqls := []string{
+		"select c_name, o.o_totalprice from customer c, lateral (select o_totalprice from orders where o_custkey = c.c_custkey) as o",
+		"select c_name, o.o_totalprice from customer c, lateral (select o_totalprice from orders where o_custkey = c.c_custkey order by o_totalprice desc limit 3) as o",
+		"select c_name, o.o_totalprice from customer c left join lateral (select o_totalprice from orders where o_custkey = c.c_custkey order by o_totalprice desc limit 1 offset 1) as o on true",
+		"select c_name, o.m from customer c join lateral (select max(o_totalprice) as m from orders where o_custkey = c.c_custkey) as o on o.m > 100",
+		"select c_name, o.cnt from customer c left join lateral (select o_orderstatus, count(*) as cnt from orders where o_custkey = c.c_custkey group by o_orderstatus) as o on true",
+		"select n_name from nation join region on n_regionkey = r_regionkey and r_regionkey in (select r_regionkey from region where r_name = 'ASIA')",
+		"select n_name from nation left join region on n_regionkey = r_regionkey and exists (select 1 from nation2 where n_regionkey = r_regionkey)",
+		"select n_name from nation left join region on n_regionkey = r_regionkey and n_nationkey > (select max(r_regionkey) from region)",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	logicPlan, err := runOneStmt(mock, t, sqls[1])
+	assert.NoError(t, err)
+	var apply *plan.Node
+	for _, node := range logicPlan.GetQuery().Nodes {
+		if node.NodeType == plan.Node_JOIN && node.JoinType == plan.Node_APPLY {
+			apply = node
+		}
+	}
+	assert.NotNil(t, apply)
+	assert.NotNil(t, apply.Limit)
+
+	errSqls := []string{
+		"select * from customer c right join lateral (select o_totalprice from orders where o_custkey = c.c_custkey) as o on true",
+		"select * from customer c, lateral (select o_totalprice from orders where o_custkey = c.c_custkey)",
+		"select * from customer c, lateral (select count(*) from orders where o_custkey = c.c_custkey) as o",
+		"select * from customer c, lateral (select c.c_name, o_totalprice from orders where o_custkey = c.c_custkey) as o",
+		"select * from customer c left join lateral (select o_totalprice from orders where o_custkey = c.c_custkey limit 1) as o on o.o_totalprice > 10",
+		"select * from customer c, (select o_totalprice from orders where o_custkey = c.c_custkey) as o",
+		"select n_name from nation left join region on n_regionkey = r_regionkey and exists (select 1 from supplier where s_nationkey = n_nationkey and s_suppkey = r_regionkey)",
+	}
+	runTestShouldError(mock, t, errSqls)
+}
+
 func TestVisitRule(t *testing.T) {
 	sql := "select * from nation where n_nationkey > ? or n_nationkey=@int_var or abs(-1) > 1"
 	mock := NewMockOptimizer(false)
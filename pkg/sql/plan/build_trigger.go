@@ -0,0 +1,189 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/util"
+)
+
+// buildCreateTrigger builds the trigger definition. The trigger is stored in the
+// constraint of its table, so the DML on the table knows its triggers from the table def,
+// and in mo_catalog.mo_triggers, which serves SHOW TRIGGERS and DROP TRIGGER.
+func buildCreateTrigger(stmt *tree.CreateTrigger, ctx CompilerContext) (*Plan, error) {
+	tableSchema := string(stmt.Table.SchemaName)
+	triggerSchema := tableSchema
+	if !stmt.Name.HasNoNameQualifier() {
+		triggerSchema = string(stmt.Name.Name.SchemaName)
+	}
+	if tableSchema == "" {
+		tableSchema = triggerSchema
+	}
+	if tableSchema == "" {
+		tableSchema = ctx.DefaultDatabase()
+		if tableSchema == "" {
+			return nil, moerr.NewNoDB(ctx.GetContext())
+		}
+	}
+	if triggerSchema != "" && triggerSchema != tableSchema {
+		return nil, moerr.NewInvalidInput(ctx.GetContext(), "trigger in wrong schema")
+	}
+	if tableSchema == catalog.MO_CATALOG || tableSchema == INFORMATION_SCHEMA {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "create trigger on the table in the system database '%s'", tableSchema)
+	}
+
+	tableName := string(stmt.Table.ObjectName)
+	obj, tableDef := ctx.Resolve(tableSchema, tableName)
+	if tableDef == nil {
+		return nil, moerr.NewNoSuchTable(ctx.GetContext(), tableSchema, tableName)
+	}
+	if tableDef.IsTemporary {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "create trigger on temporary table")
+	}
+	if tableDef.ViewSql != nil || tableDef.TableType == catalog.SystemExternalRel ||
+		tableDef.TableType == catalog.SystemMaterializedRel ||
+		util.TableIsClusterTable(tableDef.TableType) || obj.PubInfo != nil {
+		return nil, moerr.NewNotSupported(ctx.GetContext(), "create trigger on the table '%s'", tableName)
+	}
+
+	// the trigger name is unique in the schema, it's checked in mo_catalog.mo_triggers
+	// when the trigger is created.
+	trigger := &plan.TriggerDef{
+		Name:   string(stmt.Name.Name.ObjectName),
+		Timing: stmt.Time.String(),
+		Event:  stmt.Event.String(),
+	}
+
+	createTrigger := &plan.CreateTrigger{
+		IfNotExists: stmt.IfNotExists,
+		Database:    tableSchema,
+		Table:       tableName,
+		Trigger:     trigger,
+	}
+	if stmt.Order != nil {
+		orderTrigger := string(stmt.Order.Name)
+		found := false
+		for _, t := range tableDef.Triggers {
+			if strings.EqualFold(t.Name, orderTrigger) && t.Timing == trigger.Timing && t.Event == trigger.Event {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, moerr.NewInvalidInput(ctx.GetContext(), "referenced trigger '%s' for the given action time and event type does not exist", orderTrigger)
+		}
+		createTrigger.OrderTrigger = orderTrigger
+		createTrigger.Precedes = !stmt.Order.Follows
+	}
+
+	if err := checkTriggerBody(ctx, stmt.Body); err != nil {
+		return nil, err
+	}
+	// the body is stored as a compound statement, so it can be parsed again as a whole
+	// when the trigger fires.
+	body := stmt.Body
+	if _, ok := body.(*tree.CompoundStmt); !ok {
+		body = tree.NewCompoundStmt([]tree.Statement{body})
+	}
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	body.Format(fmtCtx)
+	trigger.Body = fmtCtx.String()
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_CREATE_TRIGGER,
+				Definition: &plan.DataDefinition_CreateTrigger{
+					CreateTrigger: createTrigger,
+				},
+			},
+		},
+	}, nil
+}
+
+// checkTriggerBody checks the statements in the trigger body. Only the statements that
+// change data and the statements of the stored procedure language are allowed.
+func checkTriggerBody(ctx CompilerContext, stmt tree.Statement) error {
+	var stmts []tree.Statement
+	switch st := stmt.(type) {
+	case nil, *tree.Insert, *tree.Update, *tree.Delete, *tree.Replace,
+		*tree.SetVar, *tree.Declare, *tree.LeaveStmt, *tree.IterateStmt:
+		return nil
+	case *tree.Select:
+		return moerr.NewNotSupported(ctx.GetContext(), "return a result set from a trigger")
+	case *tree.CompoundStmt:
+		stmts = st.Stmts
+	case *tree.IfStmt:
+		stmts = append(stmts, st.Body...)
+		for _, elif := range st.Elifs {
+			stmts = append(stmts, elif.Body...)
+		}
+		stmts = append(stmts, st.Else...)
+	case *tree.CaseStmt:
+		for _, when := range st.Whens {
+			stmts = append(stmts, when.Body...)
+		}
+		stmts = append(stmts, st.Else...)
+	case *tree.WhileStmt:
+		stmts = st.Body
+	case *tree.RepeatStmt:
+		stmts = st.Body
+	case *tree.LoopStmt:
+		stmts = st.Body
+	default:
+		return moerr.NewNotSupported(ctx.GetContext(), "statement '%s' in trigger", stmt.GetStatementType())
+	}
+	for _, s := range stmts {
+		if err := checkTriggerBody(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func buildDropTrigger(stmt *tree.DropTrigger, ctx CompilerContext) (*Plan, error) {
+	dropTrigger := &plan.DropTrigger{
+		IfExists: stmt.IfExists,
+		Name:     string(stmt.Name.Name.ObjectName),
+	}
+	if stmt.Name.HasNoNameQualifier() {
+		dropTrigger.Database = ctx.DefaultDatabase()
+		if dropTrigger.Database == "" {
+			return nil, moerr.NewNoDB(ctx.GetContext())
+		}
+	} else {
+		dropTrigger.Database = string(stmt.Name.Name.SchemaName)
+	}
+	if !ctx.DatabaseExists(dropTrigger.Database) {
+		return nil, moerr.NewBadDB(ctx.GetContext(), dropTrigger.Database)
+	}
+
+	return &Plan{
+		Plan: &plan.Plan_Ddl{
+			Ddl: &plan.DataDefinition{
+				DdlType: plan.DataDefinition_DROP_TRIGGER,
+				Definition: &plan.DataDefinition_DropTrigger{
+					DropTrigger: dropTrigger,
+				},
+			},
+		},
+	}, nil
+}
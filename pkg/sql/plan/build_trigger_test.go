@@ -0,0 +1,100 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTrigger(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionTables(t, mock, map[string]string{
+		"trg_t": "create table trg_t (a int primary key, b varchar(10))",
+	})
+	ctx := mock.CurrentContext().(*MockCompilerContext)
+	ctx.tables["trg_t"].Triggers = []*plan.TriggerDef{
+		{Name: "trg_t_bi", Timing: "BEFORE", Event: "INSERT"},
+	}
+
+	buildCreate := func(sql string) (*plan.CreateTrigger, error) {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			return nil, err
+		}
+		testDeepCopy(logicPlan)
+		require.Equal(t, plan.DataDefinition_CREATE_TRIGGER, logicPlan.GetDdl().DdlType)
+		createTrigger := logicPlan.GetDdl().GetCreateTrigger()
+		// the body is parsed again when the trigger fires
+		stmts, err := parsers.Parse(ctx.GetContext(), dialect.MYSQL, createTrigger.Trigger.Body, 1)
+		require.NoError(t, err, createTrigger.Trigger.Body)
+		require.IsType(t, &tree.CompoundStmt{}, stmts[0])
+		return createTrigger, nil
+	}
+
+	createTrigger, err := buildCreate("create trigger trg_t_ai after insert on trg_t for each row insert into trg_t values (new.a + 100, 'x')")
+	require.NoError(t, err)
+	require.Equal(t, "tpch", createTrigger.Database)
+	require.Equal(t, "trg_t", createTrigger.Table)
+	require.Equal(t, "trg_t_ai", createTrigger.Trigger.Name)
+	require.Equal(t, "AFTER", createTrigger.Trigger.Timing)
+	require.Equal(t, "INSERT", createTrigger.Trigger.Event)
+	require.Equal(t, `begin insert into trg_t values (new.a + 100, "x"); end`, createTrigger.Trigger.Body)
+
+	createTrigger, err = buildCreate("create trigger tpch.trg_t_bi2 before insert on trg_t for each row precedes trg_t_bi begin if new.a < 0 then set new.a = 0; end if; end")
+	require.NoError(t, err)
+	require.Equal(t, "trg_t_bi", createTrigger.OrderTrigger)
+	require.True(t, createTrigger.Precedes)
+
+	createTrigger, err = buildCreate("create trigger if not exists trg_t_bu before update on trg_t for each row set new.b = old.b")
+	require.NoError(t, err)
+	require.True(t, createTrigger.IfNotExists)
+	require.Equal(t, "UPDATE", createTrigger.Trigger.Event)
+
+	sqls := []string{
+		// the referenced trigger has other action time
+		"create trigger trg_t_ai after insert on trg_t for each row follows trg_t_bi set @a = 1",
+		"create trigger trg_t_bi2 before insert on trg_t for each row follows no_such_trigger set @a = 1",
+		"create trigger db1.trg_t_bi2 before insert on tpch.trg_t for each row set @a = 1",
+		"create trigger trg_t_bi2 before insert on no_such_table for each row set @a = 1",
+		"create trigger trg_t_bi2 before insert on trg_t for each row select 1",
+		"create trigger trg_t_bi2 before insert on trg_t for each row begin if new.a > 0 then select 1; end if; end",
+		"create trigger trg_t_bi2 before insert on trg_t for each row create table t2 (a int)",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestDropTrigger(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	logicPlan, err := runOneStmt(mock, t, "drop trigger if exists tpch.trg_t_ai")
+	require.NoError(t, err)
+	dropTrigger := logicPlan.GetDdl().GetDropTrigger()
+	require.Equal(t, plan.DataDefinition_DROP_TRIGGER, logicPlan.GetDdl().DdlType)
+	require.True(t, dropTrigger.IfExists)
+	require.Equal(t, "tpch", dropTrigger.Database)
+	require.Equal(t, "trg_t_ai", dropTrigger.Name)
+
+	logicPlan, err = runOneStmt(mock, t, "drop trigger trg_t_ai")
+	require.NoError(t, err)
+	require.Equal(t, "tpch", logicPlan.GetDdl().GetDropTrigger().Database)
+
+	runTestShouldError(mock, t, []string{"drop trigger no_such_db.trg_t_ai"})
+}
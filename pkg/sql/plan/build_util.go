@@ -155,6 +155,17 @@ func getTypeFromAst(ctx context.Context, typ tree.ResolvableTypeReference) (*pla
 			return &plan.Type{Id: int32(types.T_json)}, nil
 		case defines.MYSQL_TYPE_UUID:
 			return &plan.Type{Id: int32(types.T_uuid)}, nil
+		case defines.MYSQL_TYPE_VECF32, defines.MYSQL_TYPE_VECF64:
+			if n.InternalType.DisplayWith <= 0 {
+				return nil, moerr.NewSyntaxError(ctx, "vector type '%s' requires a dimension", tree.String(&n.InternalType, dialect.MYSQL))
+			}
+			if n.InternalType.DisplayWith > types.MaxArrayDimension {
+				return nil, moerr.NewNotSupported(ctx, "vector dimension %d exceeds the max dimension %d", n.InternalType.DisplayWith, types.MaxArrayDimension)
+			}
+			if defines.MysqlType(n.InternalType.Oid) == defines.MYSQL_TYPE_VECF32 {
+				return &plan.Type{Id: int32(types.T_array_float32), Width: n.InternalType.DisplayWith}, nil
+			}
+			return &plan.Type{Id: int32(types.T_array_float64), Width: n.InternalType.DisplayWith}, nil
 		case defines.MYSQL_TYPE_TINY_BLOB:
 			return &plan.Type{Id: int32(types.T_blob)}, nil
 		case defines.MYSQL_TYPE_MEDIUM_BLOB:
@@ -0,0 +1,289 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func NewCheckBinder(builder *QueryBuilder, ctx *BindContext, name string, column string) *CheckBinder {
+	c := &CheckBinder{
+		name:   name,
+		column: column,
+	}
+	c.sysCtx = builder.GetContext()
+	c.builder = builder
+	c.ctx = ctx
+	c.impl = c
+	return c
+}
+
+// The functions whose result is not determined by the row, from:
+// https://dev.mysql.com/doc/refman/8.0/en/create-table-check-constraints.html
+var unsupportedFunctionsInCheckExpr = map[string]int{
+	"connection_id":     0,
+	"current_account":   0,
+	"current_date":      0,
+	"current_role":      0,
+	"current_time":      0,
+	"current_timestamp": 0,
+	"current_user":      0,
+	"curdate":           0,
+	"curtime":           0,
+	"currval":           0,
+	"database":          0,
+	"found_rows":        0,
+	"last_insert_id":    0,
+	"lastval":           0,
+	"load_file":         0,
+	"localtime":         0,
+	"localtimestamp":    0,
+	"nextval":           0,
+	"now":               0,
+	"rand":              0,
+	"row_count":         0,
+	"schema":            0,
+	"session_user":      0,
+	"setval":            0,
+	"sleep":             0,
+	"sysdate":           0,
+	"system_user":       0,
+	"unix_timestamp":    0,
+	"user":              0,
+	"utc_date":          0,
+	"utc_time":          0,
+	"utc_timestamp":     0,
+	"uuid":              0,
+}
+
+func (c *CheckBinder) BindExpr(expr tree.Expr, i int32, b bool) (*plan.Expr, error) {
+	switch exprImpl := expr.(type) {
+	case *tree.FuncExpr:
+		funcRef, ok := exprImpl.Func.FunctionReference.(*tree.UnresolvedName)
+		if !ok {
+			return nil, moerr.NewNYI(c.GetContext(), "invalid function expr '%v'", exprImpl)
+		}
+		funcName := strings.ToLower(funcRef.Parts[0])
+		if _, ok := unsupportedFunctionsInCheckExpr[funcName]; ok {
+			return nil, moerr.NewInvalidInput(c.GetContext(), "an expression of the check constraint '%s' contains disallowed function: %s", c.name, funcName)
+		}
+	case *tree.VarExpr:
+		return nil, moerr.NewInvalidInput(c.GetContext(), "an expression of the check constraint '%s' cannot refer to a user or system variable", c.name)
+	}
+	return c.baseBindExpr(expr, i, b)
+}
+
+func (c *CheckBinder) BindColRef(name *tree.UnresolvedName, i int32, b bool) (*plan.Expr, error) {
+	if c.column != "" && name.Parts[0] != c.column {
+		return nil, moerr.NewInvalidInput(c.GetContext(), "column check constraint '%s' references other column", c.name)
+	}
+	return c.baseBindColRef(name, i, b)
+}
+
+func (c *CheckBinder) BindAggFunc(s string, expr *tree.FuncExpr, i int32, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(c.GetContext(), "aggregate functions not allowed in check constraint")
+}
+
+func (c *CheckBinder) BindWinFunc(s string, expr *tree.FuncExpr, i int32, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(c.GetContext(), "window functions not allowed in check constraint")
+}
+
+func (c *CheckBinder) BindSubquery(subquery *tree.Subquery, b bool) (*plan.Expr, error) {
+	return nil, moerr.NewSyntaxError(c.GetContext(), "subquery not allowed in check constraint")
+}
+
+// checkConstraint is a check constraint of the table or of a column.
+type checkConstraint struct {
+	*tree.CheckIndex
+	// column is the column of a column check constraint, which can only refer to the column
+	column string
+}
+
+// buildCheckDefs binds the check constraints against the columns of the table, and names the
+// anonymous ones like MySQL does, the names are unique in the table.
+func buildCheckDefs(ctx CompilerContext, tableDef *TableDef, checks []checkConstraint) ([]*plan.CheckDef, error) {
+	names := make(map[string]bool)
+	for _, check := range tableDef.Checks {
+		names[strings.ToLower(check.Name)] = true
+	}
+	for _, check := range checks {
+		if check.Name == "" {
+			continue
+		}
+		name := strings.ToLower(check.Name)
+		if names[name] {
+			return nil, moerr.NewCheckDupName(ctx.GetContext(), check.Name)
+		}
+		names[name] = true
+	}
+
+	// the anonymous constraints are named as <table>_chk_<n>, n starts from the max one in use
+	prefix := strings.ToLower(tableDef.Name) + "_chk_"
+	seq := 0
+	for name := range names {
+		if suffix, ok := strings.CutPrefix(name, prefix); ok {
+			if n, err := strconv.Atoi(suffix); err == nil && n > seq {
+				seq = n
+			}
+		}
+	}
+
+	defs := make([]*plan.CheckDef, 0, len(checks))
+	for _, check := range checks {
+		name := check.Name
+		if name == "" {
+			seq++
+			name = fmt.Sprintf("%s%d", prefix, seq)
+		}
+		expr, err := bindCheckExpr(ctx, tableDef, name, check.column, check.Expr)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, &plan.CheckDef{
+			Name:        name,
+			Check:       expr,
+			ExprStr:     formatCheckExpr(check.Expr),
+			NotEnforced: !check.Enforced,
+		})
+	}
+	return defs, nil
+}
+
+// bindCheckExpr binds the check expression to a boolean expression, the columns of
+// the expression are positioned as the columns of the table def.
+func bindCheckExpr(ctx CompilerContext, tableDef *TableDef, name string, column string, astExpr tree.Expr) (*Expr, error) {
+	builder := NewQueryBuilder(plan.Query_SELECT, ctx, false)
+	bindContext := NewBindContext(builder, nil)
+	nodeID := builder.appendNode(&plan.Node{
+		NodeType:    plan.Node_TABLE_SCAN,
+		TableDef:    tableDef,
+		BindingTags: []int32{builder.genNewTag()},
+	}, bindContext)
+	if err := builder.addBinding(nodeID, tree.AliasClause{}, bindContext); err != nil {
+		return nil, err
+	}
+
+	expr, err := NewCheckBinder(builder, bindContext, name, column).BindExpr(astExpr, 0, true)
+	if err != nil {
+		return nil, err
+	}
+	boolTyp := types.T_bool.ToType()
+	return appendCastBeforeExpr(ctx.GetContext(), expr, makePlan2Type(&boolTyp))
+}
+
+func formatCheckExpr(expr tree.Expr) string {
+	fmtCtx := tree.NewFmtCtx(dialect.MYSQL, tree.WithQuoteString(true))
+	expr.Format(fmtCtx)
+	return fmtCtx.String()
+}
+
+// formatCheckDef formats the check constraint as SHOW CREATE TABLE of MySQL does.
+func formatCheckDef(check *plan.CheckDef) string {
+	str := fmt.Sprintf("CONSTRAINT `%s` CHECK (%s)", formatStr(check.Name), check.ExprStr)
+	if check.NotEnforced {
+		str += " /*!80016 NOT ENFORCED */"
+	}
+	return str
+}
+
+func parseCheckExpr(ctx context.Context, exprStr string) (tree.Expr, error) {
+	stmts, err := parsers.Parse(ctx, dialect.MYSQL, "select "+exprStr, 1)
+	if err != nil {
+		return nil, err
+	}
+	return stmts[0].(*tree.Select).Select.(*tree.SelectClause).Exprs[0].Expr, nil
+}
+
+func hasCheckDef(tableDef *TableDef, name string) bool {
+	for _, check := range tableDef.Checks {
+		if strings.EqualFold(check.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkColumnWithCheck returns an error if the column is used by any check constraint of the table,
+// the column can't be dropped or renamed then.
+func checkColumnWithCheck(ctx CompilerContext, tableDef *TableDef, colName string) error {
+	for _, check := range tableDef.Checks {
+		astExpr, err := parseCheckExpr(ctx.GetContext(), check.ExprStr)
+		if err != nil {
+			return err
+		}
+		expr, err := bindCheckExpr(ctx, tableDef, check.Name, "", astExpr)
+		if err != nil {
+			return err
+		}
+		cols := make(map[int32]bool)
+		getCheckColumns(expr, cols)
+		for pos := range cols {
+			if strings.EqualFold(tableDef.Cols[pos].Name, colName) {
+				return moerr.NewDependentByCheck(ctx.GetContext(), check.Name, colName)
+			}
+		}
+	}
+	return nil
+}
+
+func getCheckColumns(expr *Expr, cols map[int32]bool) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		cols[exprImpl.Col.ColPos] = true
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			getCheckColumns(arg, cols)
+		}
+	case *plan.Expr_List:
+		for _, arg := range exprImpl.List.List {
+			getCheckColumns(arg, cols)
+		}
+	}
+}
+
+// makeCheckFilterExprs makes the filters raising the error of the violated check constraints,
+// the check expressions are bound again since the columns may be changed after they're created.
+func makeCheckFilterExprs(ctx CompilerContext, tableDef *TableDef) ([]*Expr, error) {
+	var filters []*Expr
+	for _, check := range tableDef.Checks {
+		if check.NotEnforced {
+			continue
+		}
+		astExpr, err := parseCheckExpr(ctx.GetContext(), check.ExprStr)
+		if err != nil {
+			return nil, err
+		}
+		expr, err := bindCheckExpr(ctx, tableDef, check.Name, "", astExpr)
+		if err != nil {
+			return nil, err
+		}
+		filter, err := bindFuncExprImplByPlanExpr(ctx.GetContext(), "check_constraint", []*Expr{expr, makePlan2StringConstExprWithType(check.Name)})
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
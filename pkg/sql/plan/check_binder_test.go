@@ -0,0 +1,133 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckConstraintDDL(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"create table t1 (a int check (a > 0), b varchar(10), check (b in ('x', 'y')))",
+		"create table t1 (a int, b int, constraint c1 check (a < b) not enforced, check (a + b > 0))",
+		"alter table constraint_test.stock add constraint chk_qty check (qty < 1000)",
+		"alter table constraint_test.stock add check (id > 0), add check (price < 100)",
+		"alter table constraint_test.stock drop check stock_chk_1",
+		"alter table constraint_test.stock drop constraint chk_price",
+		"alter table constraint_test.stock drop check CHK_PRICE",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"create table t1 (a int, b int check (a > 0))",
+		"create table t1 (a int, check (c > 0))",
+		"create table t1 (a int, check (a > rand()))",
+		"create table t1 (a datetime, check (a < now()))",
+		"create table t1 (a int, check (a > @x))",
+		"create table t1 (a int, check (sum(a) > 0))",
+		"create table t1 (a int, check (a in (select 1)))",
+		"create table t1 (a int, constraint c1 check (a > 0), constraint c1 check (a < 10))",
+		"alter table constraint_test.stock add constraint stock_chk_1 check (qty < 1000)",
+		"alter table constraint_test.stock add constraint c1 check (qty < 1000), add constraint c1 check (qty > 1)",
+		"alter table constraint_test.stock drop check chk_none",
+		"alter table constraint_test.stock drop constraint chk_none",
+		"alter table constraint_test.stock drop column qty",
+		"alter table constraint_test.stock rename column qty to quantity",
+	}
+	runTestShouldError(mock, t, sqls)
+
+	_, err := runOneStmt(mock, t, "alter table constraint_test.stock drop check chk_none")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrCheckNotFound))
+	_, err = runOneStmt(mock, t, "alter table constraint_test.stock drop column qty")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDependentByCheck))
+	_, err = runOneStmt(mock, t, "create table t1 (a int, constraint c1 check (a > 0), constraint C1 check (a < 10))")
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrCheckDupName))
+}
+
+func TestCheckConstraintDefs(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	logicPlan, err := runOneStmt(mock, t, "create table t1 (a int check (a > 0), b int, constraint t1_chk_5 check (b > 0), check (a < b) not enforced, check (b <> 'x'))")
+	require.NoError(t, err)
+	checks := logicPlan.GetDdl().GetCreateTable().GetTableDef().GetChecks()
+	require.Equal(t, 4, len(checks))
+	require.Equal(t, "t1_chk_6", checks[0].Name)
+	require.Equal(t, "a > 0", checks[0].ExprStr)
+	require.False(t, checks[0].NotEnforced)
+	require.Equal(t, "t1_chk_5", checks[1].Name)
+	require.Equal(t, "t1_chk_7", checks[2].Name)
+	require.True(t, checks[2].NotEnforced)
+	require.Equal(t, "t1_chk_8", checks[3].Name)
+	require.Equal(t, `b != "x"`, checks[3].ExprStr)
+	for _, check := range checks {
+		require.NotNil(t, check.Check)
+	}
+
+	logicPlan, err = runOneStmt(mock, t, "alter table constraint_test.stock add check (qty < 1000)")
+	require.NoError(t, err)
+	actions := logicPlan.GetDdl().GetAlterTable().GetActions()
+	require.Equal(t, 1, len(actions))
+	require.Equal(t, "stock_chk_2", actions[0].GetAddCheck().Name)
+
+	logicPlan, err = runOneStmt(mock, t, "alter table constraint_test.stock drop constraint chk_price")
+	require.NoError(t, err)
+	actions = logicPlan.GetDdl().GetAlterTable().GetActions()
+	require.Equal(t, plan.AlterTableDrop_CHECK, actions[0].GetDrop().Typ)
+
+	logicPlan, err = runOneStmt(mock, t, "show create table constraint_test.stock")
+	require.NoError(t, err)
+	require.Contains(t, logicPlan.String(), "CONSTRAINT `stock_chk_1` CHECK (qty >= 0)")
+	require.Contains(t, logicPlan.String(), "CONSTRAINT `chk_price` CHECK (price > 0 and price < qty * 100) /*!80016 NOT ENFORCED */")
+}
+
+func TestCheckConstraintDML(t *testing.T) {
+	mock := NewMockOptimizer(true)
+
+	sqls := map[string]int{
+		"insert into constraint_test.stock values (1, 10, 5)":                   1,
+		"insert into constraint_test.stock (id) values (1)":                     1,
+		"insert into constraint_test.stock select * from constraint_test.stock": 1,
+		"update constraint_test.stock set qty = qty - 1 where id = 1":           1,
+		"update constraint_test.stock set price = 1":                            1,
+		"delete from constraint_test.stock where id = 1":                        0,
+		"insert into constraint_test.emp (empno) values (1)":                    0,
+	}
+	for sql, cnt := range sqls {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		require.Equal(t, cnt, countCheckFilters(logicPlan.GetQuery()), sql)
+	}
+}
+
+func countCheckFilters(qry *plan.Query) int {
+	cnt := 0
+	for _, node := range qry.Nodes {
+		if node.NodeType != plan.Node_FILTER {
+			continue
+		}
+		for _, expr := range node.FilterList {
+			if f, ok := expr.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "check_constraint" {
+				cnt++
+			}
+		}
+	}
+	return cnt
+}
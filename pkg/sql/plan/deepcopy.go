@@ -207,6 +207,7 @@ func DeepCopyNode(node *plan.Node) *plan.Node {
 		LockTargets:     make([]*plan.LockTarget, len(node.LockTargets)),
 		AnalyzeInfo:     DeepCopyAnalyzeInfo(node.AnalyzeInfo),
 		IsEnd:           node.IsEnd,
+		PartitionPrune:  DeepCopyPartitionPrune(node.PartitionPrune),
 	}
 	newNode.Uuid = append(newNode.Uuid, node.Uuid...)
 
@@ -350,12 +351,15 @@ func DeepCopyIndexDef(indexDef *plan.IndexDef) *plan.IndexDef {
 		return nil
 	}
 	newindexDef := &plan.IndexDef{
-		IdxId:          indexDef.IdxId,
-		IndexName:      indexDef.IndexName,
-		Unique:         indexDef.Unique,
-		TableExist:     indexDef.TableExist,
-		IndexTableName: indexDef.IndexTableName,
-		Comment:        indexDef.Comment,
+		IdxId:              indexDef.IdxId,
+		IndexName:          indexDef.IndexName,
+		Unique:             indexDef.Unique,
+		TableExist:         indexDef.TableExist,
+		IndexTableName:     indexDef.IndexTableName,
+		Comment:            indexDef.Comment,
+		IndexAlgo:          indexDef.IndexAlgo,
+		IndexAlgoParams:    indexDef.IndexAlgoParams,
+		IndexAlgoTableType: indexDef.IndexAlgoTableType,
 	}
 
 	newParts := make([]string, len(indexDef.Parts))
@@ -385,6 +389,86 @@ func DeepCopyTableDefList(src []*plan.TableDef) []*plan.TableDef {
 	return ret
 }
 
+func DeepCopyPartitionItem(item *plan.PartitionItem) *plan.PartitionItem {
+	if item == nil {
+		return nil
+	}
+	return &plan.PartitionItem{
+		PartitionName:      item.PartitionName,
+		OrdinalPosition:    item.OrdinalPosition,
+		Description:        item.Description,
+		Comment:            item.Comment,
+		LessThan:           DeepCopyExprList(item.LessThan),
+		InValues:           DeepCopyExprList(item.InValues),
+		PartitionTableName: item.PartitionTableName,
+	}
+}
+
+func DeepCopyPartitionPrune(prune *plan.PartitionPrune) *plan.PartitionPrune {
+	if prune == nil {
+		return nil
+	}
+	newPrune := &plan.PartitionPrune{
+		IsPruned:           prune.IsPruned,
+		SelectedPartitions: make([]*plan.PartitionItem, len(prune.SelectedPartitions)),
+	}
+	for i, item := range prune.SelectedPartitions {
+		newPrune.SelectedPartitions[i] = DeepCopyPartitionItem(item)
+	}
+	return newPrune
+}
+
+func DeepCopyPartitionByDef(partition *plan.PartitionByDef) *plan.PartitionByDef {
+	if partition == nil {
+		return nil
+	}
+	partitionDef := &plan.PartitionByDef{
+		Type:                partition.GetType(),
+		PartitionExpression: DeepCopyExpr(partition.GetPartitionExpression()),
+		PartitionNum:        partition.GetPartitionNum(),
+		Partitions:          make([]*plan.PartitionItem, len(partition.Partitions)),
+		Algorithm:           partition.GetAlgorithm(),
+		IsSubPartition:      partition.GetIsSubPartition(),
+		PartitionMsg:        partition.GetPartitionMsg(),
+		PartitionTableNames: DeepCopyStringList(partition.GetPartitionTableNames()),
+	}
+	if partition.PartitionExpr != nil {
+		partitionDef.PartitionExpr = &plan.PartitionExpr{
+			Expr:    DeepCopyExpr(partition.PartitionExpr.Expr),
+			ExprStr: partition.PartitionExpr.GetExprStr(),
+		}
+	}
+
+	if partition.PartitionColumns != nil {
+		partitionDef.PartitionColumns = &plan.PartitionColumns{
+			Columns:          DeepCopyExprList(partition.PartitionColumns.Columns),
+			PartitionColumns: DeepCopyStringList(partition.PartitionColumns.PartitionColumns),
+		}
+	}
+
+	for i, e := range partition.Partitions {
+		partitionDef.Partitions[i] = DeepCopyPartitionItem(e)
+	}
+	return partitionDef
+}
+
+func DeepCopyAlterPartition(alter *plan.AlterPartition) *plan.AlterPartition {
+	if alter == nil {
+		return nil
+	}
+	return &plan.AlterPartition{
+		Typ:                alter.Typ,
+		PartitionDef:       DeepCopyPartitionByDef(alter.PartitionDef),
+		CreateTables:       DeepCopyTableDefList(alter.CreateTables),
+		DropTableNames:     DeepCopyStringList(alter.DropTableNames),
+		TruncateTableNames: DeepCopyStringList(alter.TruncateTableNames),
+		ValidateSql:        alter.ValidateSql,
+		MoveDataSqls:       DeepCopyStringList(alter.MoveDataSqls),
+		ExchangeDatabase:   alter.ExchangeDatabase,
+		ExchangeTable:      alter.ExchangeTable,
+	}
+}
+
 func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 	if table == nil {
 		return nil
@@ -402,6 +486,7 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		Fkeys:          make([]*plan.ForeignKeyDef, len(table.Fkeys)),
 		RefChildTbls:   make([]uint64, len(table.RefChildTbls)),
 		Checks:         make([]*plan.CheckDef, len(table.Checks)),
+		Triggers:       make([]*plan.TriggerDef, len(table.Triggers)),
 		Props:          make([]*plan.PropertyDef, len(table.Props)),
 		Defs:           make([]*plan.TableDef_DefType, len(table.Defs)),
 		Name2ColIndex:  table.Name2ColIndex,
@@ -423,11 +508,17 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 
 	for idx, col := range table.Checks {
 		newTable.Checks[idx] = &plan.CheckDef{
-			Name:  col.Name,
-			Check: DeepCopyExpr(col.Check),
+			Name:        col.Name,
+			Check:       DeepCopyExpr(col.Check),
+			ExprStr:     col.ExprStr,
+			NotEnforced: col.NotEnforced,
 		}
 	}
 
+	for idx, trigger := range table.Triggers {
+		newTable.Triggers[idx] = DeepCopyTriggerDef(trigger)
+	}
+
 	for idx, prop := range table.Props {
 		newTable.Props[idx] = &plan.PropertyDef{
 			Key:   prop.Key,
@@ -460,44 +551,7 @@ func DeepCopyTableDef(table *plan.TableDef) *plan.TableDef {
 		}
 	}
 
-	if table.Partition != nil {
-		partitionDef := &plan.PartitionByDef{
-			Type:                table.Partition.GetType(),
-			PartitionExpression: DeepCopyExpr(table.Partition.GetPartitionExpression()),
-			PartitionNum:        table.Partition.GetPartitionNum(),
-			Partitions:          make([]*plan.PartitionItem, len(table.Partition.Partitions)),
-			Algorithm:           table.Partition.GetAlgorithm(),
-			IsSubPartition:      table.Partition.GetIsSubPartition(),
-			PartitionMsg:        table.Partition.GetPartitionMsg(),
-			PartitionTableNames: DeepCopyStringList(table.GetPartition().GetPartitionTableNames()),
-		}
-		if table.Partition.PartitionExpr != nil {
-			partitionDef.PartitionExpr = &plan.PartitionExpr{
-				Expr:    DeepCopyExpr(table.Partition.PartitionExpr.Expr),
-				ExprStr: table.Partition.PartitionExpr.GetExprStr(),
-			}
-		}
-
-		if table.Partition.PartitionColumns != nil {
-			partitionDef.PartitionColumns = &plan.PartitionColumns{
-				Columns:          DeepCopyExprList(table.Partition.PartitionColumns.Columns),
-				PartitionColumns: DeepCopyStringList(table.Partition.PartitionColumns.PartitionColumns),
-			}
-		}
-
-		for i, e := range table.Partition.Partitions {
-			partitionDef.Partitions[i] = &plan.PartitionItem{
-				PartitionName:      e.PartitionName,
-				OrdinalPosition:    e.OrdinalPosition,
-				Description:        e.Description,
-				Comment:            e.Comment,
-				LessThan:           DeepCopyExprList(e.LessThan),
-				InValues:           DeepCopyExprList(e.InValues),
-				PartitionTableName: e.PartitionTableName,
-			}
-		}
-		newTable.Partition = partitionDef
-	}
+	newTable.Partition = DeepCopyPartitionByDef(table.Partition)
 
 	if table.Indexes != nil {
 		for i, indexdef := range table.Indexes {
@@ -586,6 +640,18 @@ func DeepCopyPlan(pl *Plan) *Plan {
 	}
 }
 
+func DeepCopyTriggerDef(trigger *plan.TriggerDef) *plan.TriggerDef {
+	if trigger == nil {
+		return nil
+	}
+	return &plan.TriggerDef{
+		Name:   trigger.Name,
+		Timing: trigger.Timing,
+		Event:  trigger.Event,
+		Body:   trigger.Body,
+	}
+}
+
 func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 	newDf := &plan.DataDefinition{
 		DdlType: old.DdlType,
@@ -653,6 +719,7 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 			CreateTableSql: df.AlterTable.CreateTableSql,
 			InsertDataSql:  df.AlterTable.InsertDataSql,
 			Actions:        make([]*plan.AlterTable_Action, len(df.AlterTable.Actions)),
+			AlterPartition: DeepCopyAlterPartition(df.AlterTable.AlterPartition),
 		}
 		for i, action := range df.AlterTable.Actions {
 			switch act := action.Action.(type) {
@@ -678,6 +745,17 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 				AlterTable.Actions[i] = &plan.AlterTable_Action{
 					Action: AddFk,
 				}
+			case *plan.AlterTable_Action_AddCheck:
+				AlterTable.Actions[i] = &plan.AlterTable_Action{
+					Action: &plan.AlterTable_Action_AddCheck{
+						AddCheck: &plan.CheckDef{
+							Name:        act.AddCheck.Name,
+							Check:       DeepCopyExpr(act.AddCheck.Check),
+							ExprStr:     act.AddCheck.ExprStr,
+							NotEnforced: act.AddCheck.NotEnforced,
+						},
+					},
+				}
 			}
 		}
 
@@ -733,6 +811,27 @@ func DeepCopyDataDefinition(old *plan.DataDefinition) *plan.DataDefinition {
 			},
 		}
 
+	case *plan.DataDefinition_CreateTrigger:
+		newDf.Definition = &plan.DataDefinition_CreateTrigger{
+			CreateTrigger: &plan.CreateTrigger{
+				IfNotExists:  df.CreateTrigger.IfNotExists,
+				Database:     df.CreateTrigger.Database,
+				Table:        df.CreateTrigger.Table,
+				Trigger:      DeepCopyTriggerDef(df.CreateTrigger.Trigger),
+				OrderTrigger: df.CreateTrigger.OrderTrigger,
+				Precedes:     df.CreateTrigger.Precedes,
+			},
+		}
+
+	case *plan.DataDefinition_DropTrigger:
+		newDf.Definition = &plan.DataDefinition_DropTrigger{
+			DropTrigger: &plan.DropTrigger{
+				IfExists: df.DropTrigger.IfExists,
+				Database: df.DropTrigger.Database,
+				Name:     df.DropTrigger.Name,
+			},
+		}
+
 	case *plan.DataDefinition_TruncateTable:
 		truncateTable := &plan.TruncateTable{
 			Database:        df.TruncateTable.Database,
@@ -1015,6 +1114,8 @@ func DeepCopyAnalyzeInfo(analyzeinfo *plan.AnalyzeInfo) *plan.AnalyzeInfo {
 		NetworkIO:        analyzeinfo.GetNetworkIO(),
 		ScanTime:         analyzeinfo.GetScanTime(),
 		InsertTime:       analyzeinfo.GetInsertTime(),
+		SpillCount:       analyzeinfo.GetSpillCount(),
+		SpillSize:        analyzeinfo.GetSpillSize(),
 	}
 }
 
@@ -0,0 +1,112 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// explainPlanJson writes the plan in the model marshaled for the statement
+// info, as one json document, so that the client gets it in a single row.
+func (e *ExplainQueryImpl) explainPlanJson(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	expdata := NewExplainData(uuid.Nil)
+	if err := buildExplainSteps(ctx, expdata, textOptions(options), e.QueryPlan); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(expdata, "", "  ")
+	if err != nil {
+		return err
+	}
+	buffer.PushLine(string(data))
+	return nil
+}
+
+// explainPlanDot writes the plan as a graphviz digraph, one statement per
+// line. Edges follow the data flow, from child to parent, and every step is
+// drawn as a cluster.
+func (e *ExplainQueryImpl) explainPlanDot(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	expdata := NewExplainData(uuid.Nil)
+	if err := buildExplainSteps(ctx, expdata, textOptions(options), e.QueryPlan); err != nil {
+		return err
+	}
+	buffer.PushLine("digraph plan {")
+	buffer.PushLine("  rankdir=BT;")
+	buffer.PushLine("  node [shape=box, fontname=\"Helvetica\"];")
+	for _, step := range expdata.Steps {
+		buffer.PushLine(fmt.Sprintf("  subgraph cluster_%d {", step.Step))
+		buffer.PushLine(fmt.Sprintf("    label=\"Plan %d\";", step.Step))
+		for i := range step.GraphData.Nodes {
+			node := &step.GraphData.Nodes[i]
+			buffer.PushLine(fmt.Sprintf("    n%s [label=\"%s\"];", node.NodeId, dotNodeLabel(node, options)))
+		}
+		for _, edge := range step.GraphData.Edges {
+			buffer.PushLine(fmt.Sprintf("    n%s -> n%s;", edge.Src, edge.Dst))
+		}
+		buffer.PushLine("  }")
+	}
+	// the steps are linked by the nodes which read the result of another step
+	for _, node := range e.QueryPlan.Nodes {
+		for _, step := range node.SourceStep {
+			if int(step) < len(e.QueryPlan.Steps) {
+				buffer.PushLine(fmt.Sprintf("  n%d -> n%d [style=dashed];", e.QueryPlan.Steps[step], node.NodeId))
+			}
+		}
+	}
+	buffer.PushLine("}")
+	return nil
+}
+
+func dotNodeLabel(node *Node, options *ExplainOptions) string {
+	lines := []string{fmt.Sprintf("#%s %s %s", node.NodeId, node.Name, node.Title)}
+	lines = append(lines, fmt.Sprintf("rows=%.0f cost=%.2f", node.Stats.Outcnt, node.Stats.Cost))
+	if options.Analyze {
+		lines = append(lines, fmt.Sprintf("time=%dms", node.TotalStats.Value/1000000))
+	}
+	if options.Verbose {
+		for _, label := range node.Labels {
+			lines = append(lines, fmt.Sprintf("%s: %v", label.Name, label.Value))
+		}
+	}
+	var buf bytes.Buffer
+	for i, line := range lines {
+		if i > 0 {
+			buf.WriteString("\\l")
+		}
+		buf.WriteString(dotEscape(line))
+	}
+	buf.WriteString("\\l")
+	return buf.String()
+}
+
+var dotEscaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\l")
+
+func dotEscape(s string) string {
+	return dotEscaper.Replace(s)
+}
+
+// textOptions returns a copy of options that renders the text form of a node.
+func textOptions(options *ExplainOptions) *ExplainOptions {
+	return &ExplainOptions{
+		Verbose: options.Verbose,
+		Analyze: options.Analyze,
+		Format:  EXPLAIN_FORMAT_TEXT,
+	}
+}
@@ -302,6 +302,15 @@ func (ndesc *NodeDescribeImpl) GetExtraInfo(ctx context.Context, options *Explai
 		lines = append(lines, filterInfo)
 	}
 
+	// Get the partitions left after partition pruning
+	if ndesc.Node.PartitionPrune != nil && ndesc.Node.PartitionPrune.IsPruned {
+		partitionInfo, err := ndesc.GetPartitionPruneInfo(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, partitionInfo)
+	}
+
 	if len(ndesc.Node.RuntimeFilterProbeList) > 0 {
 		filterInfo, err := ndesc.GetRuntimeFilteProbeInfo(ctx, options)
 		if err != nil {
@@ -461,6 +470,28 @@ func (ndesc *NodeDescribeImpl) GetBlockFilterConditionInfo(ctx context.Context,
 	return buf.String(), nil
 }
 
+func (ndesc *NodeDescribeImpl) GetPartitionPruneInfo(ctx context.Context, options *ExplainOptions) (string, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, 100))
+	buf.WriteString("Partitions: ")
+	if options.Format == EXPLAIN_FORMAT_TEXT {
+		partitions := ndesc.Node.PartitionPrune.SelectedPartitions
+		if len(partitions) == 0 {
+			buf.WriteString("none")
+		}
+		for i, partition := range partitions {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString(partition.PartitionName)
+		}
+	} else if options.Format == EXPLAIN_FORMAT_JSON {
+		return "", moerr.NewNYI(ctx, "explain format json")
+	} else if options.Format == EXPLAIN_FORMAT_DOT {
+		return "", moerr.NewNYI(ctx, "explain format dot")
+	}
+	return buf.String(), nil
+}
+
 func (ndesc *NodeDescribeImpl) GetRuntimeFilteProbeInfo(ctx context.Context, options *ExplainOptions) (string, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, 300))
 	buf.WriteString("Runtime Filter Probe: ")
@@ -665,6 +696,17 @@ func (a AnalyzeInfoDescribeImpl) GetDescription(ctx context.Context, options *Ex
 		fmt.Fprintf(buf, " MemorySize=%dgb", a.AnalyzeInfo.MemorySize/GB)
 	}
 
+	if a.AnalyzeInfo.SpillCount > 0 {
+		fmt.Fprintf(buf, " SpillCount=%d", a.AnalyzeInfo.SpillCount)
+		if a.AnalyzeInfo.SpillSize < MB {
+			fmt.Fprintf(buf, " SpillSize=%dbytes", a.AnalyzeInfo.SpillSize)
+		} else if a.AnalyzeInfo.SpillSize < 10*GB {
+			fmt.Fprintf(buf, " SpillSize=%dmb", a.AnalyzeInfo.SpillSize/MB)
+		} else {
+			fmt.Fprintf(buf, " SpillSize=%dgb", a.AnalyzeInfo.SpillSize/GB)
+		}
+	}
+
 	return nil
 }
 
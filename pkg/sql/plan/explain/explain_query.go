@@ -38,6 +38,12 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 }
 
 func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataBuffer, options *ExplainOptions) error {
+	switch options.Format {
+	case EXPLAIN_FORMAT_JSON:
+		return e.explainPlanJson(ctx, buffer, options)
+	case EXPLAIN_FORMAT_DOT:
+		return e.explainPlanDot(ctx, buffer, options)
+	}
 	nodes := e.QueryPlan.Nodes
 
 	isForest := false
@@ -68,31 +74,32 @@ func (e *ExplainQueryImpl) ExplainPlan(ctx context.Context, buffer *ExplainDataB
 }
 
 func BuildJsonPlan(ctx context.Context, uuid uuid.UUID, options *ExplainOptions, query *plan.Query) *ExplainData {
-	nodes := query.Nodes
 	expdata := NewExplainData(uuid)
+	if err := buildExplainSteps(ctx, expdata, options, query); err != nil {
+		var errdata *ExplainData
+		if moErr, ok := err.(*moerr.Error); ok {
+			errdata = NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
+		} else {
+			newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
+			errdata = NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
+		}
+		return errdata
+	}
+	return expdata
+}
+
+// buildExplainSteps converts every step of the query into the graph of the marshal model.
+func buildExplainSteps(ctx context.Context, expdata *ExplainData, options *ExplainOptions, query *plan.Query) error {
+	nodes := query.Nodes
 	for index, rootNodeId := range query.Steps {
 		graphData := NewGraphData(len(nodes))
 		err := PreOrderPlan(ctx, nodes[rootNodeId], nodes, graphData, options)
 		if err != nil {
-			var errdata *ExplainData
-			if moErr, ok := err.(*moerr.Error); ok {
-				errdata = NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
-			} else {
-				newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
-				errdata = NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
-			}
-			return errdata
+			return err
 		}
 		err = graphData.StatisticsGlobalResource(ctx)
 		if err != nil {
-			var errdata *ExplainData
-			if moErr, ok := err.(*moerr.Error); ok {
-				errdata = NewExplainDataFail(uuid, moErr.MySQLCode(), moErr.Error())
-			} else {
-				newError := moerr.NewInternalError(ctx, "An error occurred when plan is serialized to json")
-				errdata = NewExplainDataFail(uuid, newError.MySQLCode(), newError.Error())
-			}
-			return errdata
+			return err
 		}
 
 		step := NewStep(index)
@@ -100,7 +107,7 @@ func BuildJsonPlan(ctx context.Context, uuid uuid.UUID, options *ExplainOptions,
 
 		expdata.Steps = append(expdata.Steps, *step)
 	}
-	return expdata
+	return nil
 }
 
 func DebugPlan(pl *plan.Plan) string {
@@ -220,10 +227,6 @@ func explainStep(ctx context.Context, step *plan.Node, settings *FormatSettings,
 		for _, line := range extraInfo {
 			settings.buffer.PushNewLine(line, false, settings.level)
 		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		return moerr.NewNYI(ctx, "explain format json")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		return moerr.NewNYI(ctx, "explain format dot")
 	}
 	return nil
 }
@@ -336,6 +339,8 @@ func (graphData *GraphData) StatisticsGlobalResource(ctx context.Context) error
 		gS3IOByte := NewStatisticValue(S3IOByte, "byte")
 		gS3IOInputCount := NewStatisticValue(S3IOInputCount, "count")
 		gS3IOOutputCount := NewStatisticValue(S3IOOutputCount, "count")
+		gSpillCount := NewStatisticValue(SpillCount, "count")
+		gSpillSize := NewStatisticValue(SpillSize, "byte")
 
 		// network
 		gNetwork := NewStatisticValue(Network, "byte")
@@ -390,6 +395,12 @@ func (graphData *GraphData) StatisticsGlobalResource(ctx context.Context) error
 				if ioValue.Name == S3IOOutputCount {
 					gS3IOOutputCount.Value += ioValue.Value
 				}
+				if ioValue.Name == SpillCount {
+					gSpillCount.Value += ioValue.Value
+				}
+				if ioValue.Name == SpillSize {
+					gSpillSize.Value += ioValue.Value
+				}
 			}
 
 			for _, networkValue := range node.Statistics.Network {
@@ -403,7 +414,7 @@ func (graphData *GraphData) StatisticsGlobalResource(ctx context.Context) error
 		times := []StatisticValue{*gtimeConsumed, *gwaitTime}
 		mbps := []StatisticValue{*ginputRows, *goutputRows, *ginputSize, *goutputSize}
 		mems := []StatisticValue{*gMemorySize}
-		io := []StatisticValue{*gDiskIO, *gS3IOByte, *gS3IOInputCount, *gS3IOOutputCount}
+		io := []StatisticValue{*gDiskIO, *gS3IOByte, *gS3IOInputCount, *gS3IOOutputCount, *gSpillCount, *gSpillSize}
 		nw := []StatisticValue{*gNetwork}
 
 		graphData.Global.Statistics.Time = append(graphData.Global.Statistics.Time, times...)
@@ -16,14 +16,19 @@ package explain
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	plan2 "github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSingleSql(t *testing.T) {
@@ -311,6 +316,101 @@ func TestMultiTableDeleteSQL(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestExplainFormat(t *testing.T) {
+	sqls := []string{
+		"explain (format json) SELECT N_NAME, N_REGIONKEY a FROM NATION WHERE N_REGIONKEY > 0 ORDER BY a DESC",
+		"explain (verbose true, format json) SELECT N_NAME, MAX(N_REGIONKEY) FROM NATION GROUP BY N_NAME HAVING MAX(N_REGIONKEY) > 10",
+		"explain (format dot) select c_custkey from (select c_custkey from CUSTOMER group by c_custkey ) a",
+		"explain (verbose true, format dot) SELECT l.L_ORDERKEY a FROM CUSTOMER c, ORDERS o, LINEITEM l WHERE c.C_CUSTKEY = o.O_CUSTKEY and l.L_ORDERKEY = o.O_ORDERKEY and o.O_ORDERKEY < 10",
+	}
+	mockOptimizer := plan.NewMockOptimizer(false)
+	runTestShouldPass(mockOptimizer, t, sqls)
+}
+
+func TestExplainJsonPlan(t *testing.T) {
+	mock := plan.NewMockOptimizer(false)
+	sql := "SELECT N_NAME, count(*) FROM NATION, REGION WHERE N_REGIONKEY = R_REGIONKEY GROUP BY N_NAME"
+	stmts, err := mysql.Parse(mock.CurrentContext().GetContext(), sql, 1)
+	require.NoError(t, err)
+	logicPlan, err := plan.BuildPlan(mock.CurrentContext(), stmts[0], false)
+	require.NoError(t, err)
+	query := logicPlan.GetQuery()
+
+	ctx := context.TODO()
+	options := &ExplainOptions{Analyze: true, Format: EXPLAIN_FORMAT_JSON}
+	buffer := NewExplainDataBuffer()
+	require.NoError(t, NewExplainQueryImpl(query).ExplainPlan(ctx, buffer, options))
+	require.Equal(t, 1, len(buffer.Lines))
+
+	var expdata ExplainData
+	require.NoError(t, json.Unmarshal([]byte(buffer.Lines[0]), &expdata))
+	require.True(t, expdata.Success)
+	require.Equal(t, len(query.Steps), len(expdata.Steps))
+	graph := expdata.Steps[0].GraphData
+	require.Equal(t, strconv.Itoa(int(query.Steps[0])), graph.Nodes[0].NodeId)
+	ids := make(map[string]struct{})
+	scans := 0
+	for _, node := range graph.Nodes {
+		ids[node.NodeId] = struct{}{}
+		if node.Name == TableScan {
+			scans++
+		}
+	}
+	require.Equal(t, 2, scans)
+	require.Equal(t, len(graph.Nodes)-1, len(graph.Edges))
+	for _, edge := range graph.Edges {
+		require.Contains(t, ids, edge.Src)
+		require.Contains(t, ids, edge.Dst)
+	}
+
+	options.Format = EXPLAIN_FORMAT_DOT
+	buffer = NewExplainDataBuffer()
+	require.NoError(t, NewExplainQueryImpl(query).ExplainPlan(ctx, buffer, options))
+	dot := buffer.ToString()
+	require.True(t, strings.HasPrefix(dot, "digraph plan {"))
+	require.True(t, strings.HasSuffix(dot, "}\n"))
+	for _, edge := range graph.Edges {
+		require.Contains(t, dot, fmt.Sprintf("n%s -> n%s;", edge.Src, edge.Dst))
+	}
+}
+
+func TestExplainPartitionPrune(t *testing.T) {
+	mock := plan.NewMockOptimizer(false)
+	stmts, err := mysql.Parse(mock.CurrentContext().GetContext(), "SELECT N_NAME FROM NATION WHERE N_NATIONKEY = 1", 1)
+	require.NoError(t, err)
+	logicPlan, err := plan.BuildPlan(mock.CurrentContext(), stmts[0], false)
+	require.NoError(t, err)
+	query := logicPlan.GetQuery()
+
+	var scan *plan2.Node
+	for _, node := range query.Nodes {
+		if node.NodeType == plan2.Node_TABLE_SCAN {
+			scan = node
+		}
+	}
+	require.NotNil(t, scan)
+
+	ctx := context.TODO()
+	explain := func() string {
+		buffer := NewExplainDataBuffer()
+		require.NoError(t, NewExplainQueryImpl(query).ExplainPlan(ctx, buffer, NewExplainDefaultOptions()))
+		return buffer.ToString()
+	}
+	require.NotContains(t, explain(), "Partitions:")
+
+	scan.PartitionPrune = &plan2.PartitionPrune{
+		IsPruned: true,
+		SelectedPartitions: []*plan2.PartitionItem{
+			{PartitionName: "p0"},
+			{PartitionName: "p2"},
+		},
+	}
+	require.Contains(t, explain(), "Partitions: p0, p2")
+
+	scan.PartitionPrune.SelectedPartitions = nil
+	require.Contains(t, explain(), "Partitions: none")
+}
+
 func runTestShouldPass(opt plan.Optimizer, t *testing.T, sqls []string) {
 	for _, sql := range sqls {
 		err := runOneStmt(opt, t, sql)
@@ -354,6 +454,8 @@ func runOneStmt(opt plan.Optimizer, t *testing.T, sql string) error {
 					es.Format = EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
 					es.Format = EXPLAIN_FORMAT_JSON
+				} else if strings.EqualFold(v.Value, "DOT") {
+					es.Format = EXPLAIN_FORMAT_DOT
 				} else {
 					return moerr.NewInvalidInput(ctx, "explain format %v", v.Value)
 				}
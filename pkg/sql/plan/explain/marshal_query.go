@@ -529,6 +529,8 @@ const S3IOByte = "S3 IO Byte"
 const S3IOInputCount = "S3 IO Input Count"
 const S3IOOutputCount = "S3 IO Output Count"
 const Network = "Network"
+const SpillCount = "Spill Count"
+const SpillSize = "Spill Size"
 
 func GetStatistic4Trace(ctx context.Context, node *plan.Node, options *ExplainOptions) (s statistic.StatsArray) {
 	s.Reset()
@@ -629,6 +631,16 @@ func (m MarshalNodeImpl) GetStatistics(ctx context.Context, options *ExplainOpti
 				Value: analyzeInfo.S3IOOutputCount,
 				Unit:  Statistic_Unit_count, //"count",
 			},
+			{
+				Name:  SpillCount,
+				Value: analyzeInfo.SpillCount,
+				Unit:  Statistic_Unit_count, //"count",
+			},
+			{
+				Name:  SpillSize,
+				Value: analyzeInfo.SpillSize,
+				Unit:  Statistic_Unit_byte, //"byte",
+			},
 		}
 
 		nw := []StatisticValue{
@@ -119,6 +119,16 @@ func (buf *ExplainDataBuffer) PushPlanTitle(title string) {
 	buf.End++
 }
 
+// PushLine appends a line which is already formatted
+func (buf *ExplainDataBuffer) PushLine(line string) {
+	if buf.Start == -1 {
+		buf.Start++
+	}
+	buf.CurrentLine++
+	buf.Lines = append(buf.Lines, line)
+	buf.End++
+}
+
 func (buf *ExplainDataBuffer) ToString() string {
 	strBuffer := bytes.NewBufferString("")
 	rs := buf.Lines
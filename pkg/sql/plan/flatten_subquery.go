@@ -574,3 +574,124 @@ func (builder *QueryBuilder) pullupThroughProj(ctx *BindContext, node *plan.Node
 
 	return expr
 }
+
+// flattenJoinConditions flattens the subqueries in the ON condition of a join.
+// The conditions of an inner join are evaluated above the join like WHERE,
+// the others are flattened into the child which provides all the columns they
+// refer to.
+func (builder *QueryBuilder) flattenJoinConditions(nodeID int32, ctx *BindContext) (int32, error) {
+	node := builder.qry.Nodes[nodeID]
+
+	var onList, subConds []*plan.Expr
+	for _, cond := range node.OnList {
+		if hasSubquery(cond) {
+			subConds = append(subConds, cond)
+		} else {
+			onList = append(onList, cond)
+		}
+	}
+	if len(subConds) == 0 {
+		return nodeID, nil
+	}
+	node.OnList = onList
+
+	if node.JoinType == plan.Node_INNER {
+		return builder.appendJoinFilter(nodeID, subConds, ctx)
+	}
+
+	leftTags := make(map[int32]any)
+	for _, tag := range builder.enumerateTags(node.Children[0]) {
+		leftTags[tag] = nil
+	}
+
+	rightTags := make(map[int32]any)
+	for _, tag := range builder.enumerateTags(node.Children[1]) {
+		rightTags[tag] = nil
+	}
+
+	for _, cond := range subConds {
+		tags := make(map[int32]any)
+		builder.collectJoinCondTags(cond, tags)
+
+		var onLeft, onRight bool
+		for tag := range tags {
+			if _, ok := leftTags[tag]; ok {
+				onLeft = true
+			} else if _, ok := rightTags[tag]; ok {
+				onRight = true
+			}
+		}
+		if onLeft && onRight {
+			return 0, moerr.NewNYI(builder.GetContext(), "subquery in %s JOIN condition referring to both sides", node.JoinType.String())
+		}
+
+		side := 1
+		if onLeft {
+			side = 0
+		}
+
+		var err error
+		node.Children[side], cond, err = builder.flattenSubqueries(node.Children[side], cond, ctx)
+		if err != nil {
+			return 0, err
+		}
+		node.OnList = append(node.OnList, cond)
+	}
+
+	return nodeID, nil
+}
+
+// collectJoinCondTags collects the tags of the columns a join condition refers
+// to, including the correlated columns of its subqueries.
+func (builder *QueryBuilder) collectJoinCondTags(expr *plan.Expr, tags map[int32]any) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		tags[exprImpl.Col.RelPos] = nil
+
+	case *plan.Expr_Corr:
+		tags[exprImpl.Corr.RelPos] = nil
+
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			builder.collectJoinCondTags(arg, tags)
+		}
+
+	case *plan.Expr_List:
+		for _, arg := range exprImpl.List.List {
+			builder.collectJoinCondTags(arg, tags)
+		}
+
+	case *plan.Expr_Sub:
+		if exprImpl.Sub.Child != nil {
+			builder.collectJoinCondTags(exprImpl.Sub.Child, tags)
+		}
+		builder.collectCorrTags(exprImpl.Sub.NodeId, tags)
+	}
+}
+
+func (builder *QueryBuilder) collectCorrTags(nodeID int32, tags map[int32]any) {
+	node := builder.qry.Nodes[nodeID]
+	for _, expr := range getNodeExprs(node) {
+		collectCorrColTags(expr, tags)
+	}
+	for _, childID := range node.Children {
+		builder.collectCorrTags(childID, tags)
+	}
+}
+
+func collectCorrColTags(expr *plan.Expr, tags map[int32]any) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Corr:
+		tags[exprImpl.Corr.RelPos] = nil
+
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			collectCorrColTags(arg, tags)
+		}
+
+	case *plan.Expr_List:
+		for _, arg := range exprImpl.List.List {
+			collectCorrColTags(arg, tags)
+		}
+	}
+}
@@ -0,0 +1,397 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"go/constant"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	fullTextScoreTableAlias = "__mo_fulltext_%d"
+	fullTextScoreColName    = "score"
+
+	// the parameters of BM25
+	fullTextBM25K1 = "1.2"
+	fullTextBM25B  = "0.75"
+)
+
+// fullTextTable is a base table in the FROM clause which MATCH ... AGAINST may search.
+type fullTextTable struct {
+	alias    string
+	schema   string
+	tableDef *TableDef
+}
+
+// rewriteFullTextMatch joins the FROM clause with a derived table for every MATCH ... AGAINST
+// of the select, which scans the hidden table of the fulltext index and returns the BM25
+// score of the matched rows, then the MATCH expressions are bound to the score column.
+// The join is an inner join if the MATCH is a conjunct of the WHERE clause, otherwise a
+// left join and the score of the unmatched rows is 0.
+func (builder *QueryBuilder) rewriteFullTextMatch(clause *tree.SelectClause, orderBy tree.OrderBy, ctx *BindContext) (tree.TableExprs, error) {
+	var matches []*tree.FullTextMatchExpr
+	for _, selectExpr := range clause.Exprs {
+		collectFullTextMatch(selectExpr.Expr, &matches)
+	}
+	if clause.Where != nil {
+		collectFullTextMatch(clause.Where.Expr, &matches)
+	}
+	if clause.Having != nil {
+		collectFullTextMatch(clause.Having.Expr, &matches)
+	}
+	for _, order := range orderBy {
+		collectFullTextMatch(order.Expr, &matches)
+	}
+	if len(matches) == 0 {
+		return clause.From.Tables, nil
+	}
+
+	innerMatches := make(map[string]bool)
+	if clause.Where != nil {
+		for _, cond := range splitAstConjunction(clause.Where.Expr) {
+			if match, ok := cond.(*tree.FullTextMatchExpr); ok {
+				innerMatches[tree.String(match, dialect.MYSQL)] = true
+			}
+		}
+	}
+
+	var tables []*fullTextTable
+	builder.collectFullTextTables(clause.From.Tables[0], ctx, &tables)
+
+	ctx.fullTextMatches = make(map[string]tree.Expr)
+	from := clause.From.Tables[0]
+	for _, match := range matches {
+		key := tree.String(match, dialect.MYSQL)
+		if _, ok := ctx.fullTextMatches[key]; ok {
+			continue
+		}
+
+		table, indexDef, err := builder.findFullTextIndex(match, tables)
+		if err != nil {
+			return nil, err
+		}
+		pattern, ok := match.Pattern.(*tree.NumVal)
+		if !ok || pattern.ValType != tree.P_char {
+			return nil, moerr.NewNotSupported(builder.GetContext(), "the search string of MATCH ... AGAINST must be a string literal")
+		}
+		if match.Mode != tree.FULLTEXT_NL && match.Mode != tree.FULLTEXT_BOOLEAN {
+			return nil, moerr.NewNYI(builder.GetContext(), "MATCH ... AGAINST %s", match.Mode.ToString())
+		}
+
+		terms := fulltext.ParseQuery(indexDef.IndexAlgoParams, pattern.String(), match.Mode == tree.FULLTEXT_BOOLEAN)
+		sql := genFullTextScoreSql(table.schema, indexDef.IndexTableName, terms, builder.getFullTextStats(table, indexDef))
+		stmts, err := parsers.Parse(builder.GetContext(), dialect.MYSQL, sql, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		alias := fmt.Sprintf(fullTextScoreTableAlias, len(ctx.fullTextMatches))
+		ctx.addHiddenTable(alias)
+		scoreTable := tree.NewAliasedTableExpr(&tree.ParenTableExpr{Expr: stmts[0].(*tree.Select)}, tree.AliasClause{Alias: tree.Identifier(alias)})
+		joinCond := tree.NewComparisonExpr(tree.EQUAL,
+			tree.SetUnresolvedName(alias, catalog.FullTextIndexDocIdColName),
+			tree.SetUnresolvedName(table.alias, table.tableDef.Pkey.PkeyColName))
+		score := tree.Expr(tree.SetUnresolvedName(alias, fullTextScoreColName))
+		if innerMatches[key] {
+			from = tree.NewJoinTableExpr(tree.JOIN_TYPE_INNER, from, scoreTable, tree.NewOnJoinCond(joinCond))
+		} else {
+			from = tree.NewJoinTableExpr(tree.JOIN_TYPE_LEFT, from, scoreTable, tree.NewOnJoinCond(joinCond))
+			score = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName("ifnull")),
+				Exprs: tree.Exprs{score, tree.NewNumValWithType(constant.MakeInt64(0), "0", false, tree.P_int64)},
+			}
+		}
+		ctx.fullTextMatches[key] = score
+	}
+	return tree.TableExprs{from}, nil
+}
+
+// findFullTextIndex finds the table and its fulltext index on the columns of MATCH.
+func (builder *QueryBuilder) findFullTextIndex(match *tree.FullTextMatchExpr, tables []*fullTextTable) (*fullTextTable, *plan.IndexDef, error) {
+	var foundTable *fullTextTable
+	var foundIndex *plan.IndexDef
+	for _, table := range tables {
+		cols := make(map[string]struct{})
+		for _, keyPart := range match.KeyParts {
+			if keyPart.NumParts > 1 && keyPart.Parts[1] != table.alias {
+				break
+			}
+			cols[keyPart.Parts[0]] = struct{}{}
+		}
+		if len(cols) != len(match.KeyParts) {
+			continue
+		}
+
+		for _, indexDef := range table.tableDef.Indexes {
+			if !isFullTextIndex(indexDef) || len(indexDef.Parts) != len(cols) {
+				continue
+			}
+			found := true
+			for _, part := range indexDef.Parts {
+				if _, ok := cols[part]; !ok {
+					found = false
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+			if foundTable != nil && foundTable != table {
+				return nil, nil, moerr.NewInvalidInput(builder.GetContext(), "columns of '%s' are ambiguous", tree.String(match, dialect.MYSQL))
+			}
+			foundTable, foundIndex = table, indexDef
+		}
+	}
+	if foundTable == nil {
+		return nil, nil, moerr.NewInvalidInput(builder.GetContext(), "Can't find FULLTEXT index matching the column list")
+	}
+	return foundTable, foundIndex, nil
+}
+
+// collectFullTextTables collects the base tables of the FROM clause.
+func (builder *QueryBuilder) collectFullTextTables(tbl tree.TableExpr, ctx *BindContext, tables *[]*fullTextTable) {
+	switch tbl := tbl.(type) {
+	case *tree.JoinTableExpr:
+		builder.collectFullTextTables(tbl.Left, ctx, tables)
+		if tbl.Right != nil {
+			builder.collectFullTextTables(tbl.Right, ctx, tables)
+		}
+	case *tree.ParenTableExpr:
+		builder.collectFullTextTables(tbl.Expr, ctx, tables)
+	case *tree.AliasedTableExpr:
+		tableName, ok := tbl.Expr.(*tree.TableName)
+		if !ok {
+			return
+		}
+		schema := string(tableName.SchemaName)
+		table := string(tableName.ObjectName)
+		if schema == "" {
+			if ctx.findCTE(table) != nil {
+				return
+			}
+			schema = ctx.defaultDatabase
+		}
+		obj, tableDef := builder.compCtx.Resolve(schema, table)
+		if tableDef == nil || tableDef.Pkey == nil {
+			return
+		}
+		alias := string(tbl.As.Alias)
+		if alias == "" {
+			alias = table
+		}
+		*tables = append(*tables, &fullTextTable{
+			alias:    alias,
+			schema:   obj.SchemaName,
+			tableDef: tableDef,
+		})
+	}
+}
+
+// collectFullTextMatch collects the MATCH ... AGAINST expressions, but not those of the subqueries.
+func collectFullTextMatch(astExpr tree.Expr, matches *[]*tree.FullTextMatchExpr) {
+	switch expr := astExpr.(type) {
+	case *tree.FullTextMatchExpr:
+		*matches = append(*matches, expr)
+	case *tree.ParenExpr:
+		collectFullTextMatch(expr.Expr, matches)
+	case *tree.OrExpr:
+		collectFullTextMatch(expr.Left, matches)
+		collectFullTextMatch(expr.Right, matches)
+	case *tree.AndExpr:
+		collectFullTextMatch(expr.Left, matches)
+		collectFullTextMatch(expr.Right, matches)
+	case *tree.XorExpr:
+		collectFullTextMatch(expr.Left, matches)
+		collectFullTextMatch(expr.Right, matches)
+	case *tree.NotExpr:
+		collectFullTextMatch(expr.Expr, matches)
+	case *tree.UnaryExpr:
+		collectFullTextMatch(expr.Expr, matches)
+	case *tree.BinaryExpr:
+		collectFullTextMatch(expr.Left, matches)
+		collectFullTextMatch(expr.Right, matches)
+	case *tree.ComparisonExpr:
+		collectFullTextMatch(expr.Left, matches)
+		collectFullTextMatch(expr.Right, matches)
+	case *tree.RangeCond:
+		collectFullTextMatch(expr.Left, matches)
+		collectFullTextMatch(expr.From, matches)
+		collectFullTextMatch(expr.To, matches)
+	case *tree.IsNullExpr:
+		collectFullTextMatch(expr.Expr, matches)
+	case *tree.IsNotNullExpr:
+		collectFullTextMatch(expr.Expr, matches)
+	case *tree.CastExpr:
+		collectFullTextMatch(expr.Expr, matches)
+	case *tree.FuncExpr:
+		for _, arg := range expr.Exprs {
+			collectFullTextMatch(arg, matches)
+		}
+	case *tree.CaseExpr:
+		collectFullTextMatch(expr.Expr, matches)
+		for _, when := range expr.Whens {
+			collectFullTextMatch(when.Cond, matches)
+			collectFullTextMatch(when.Val, matches)
+		}
+		collectFullTextMatch(expr.Else, matches)
+	case *tree.Tuple:
+		for _, e := range expr.Exprs {
+			collectFullTextMatch(e, matches)
+		}
+	}
+}
+
+// fullTextStats are the statistics of the documents used by BM25.
+type fullTextStats struct {
+	// n is the number of the documents
+	n float64
+	// avgdl is the average number of the words in a document
+	avgdl float64
+}
+
+// getFullTextStats gets the statistics of the documents from the table stats, the number of the
+// documents is the row count of the table, and the total number of the words is the row count of
+// the hidden table of the index. it returns nil if the stats are not available yet.
+func (builder *QueryBuilder) getFullTextStats(table *fullTextTable, indexDef *plan.IndexDef) *fullTextStats {
+	docs := builder.getTableCnt(table.schema, table.tableDef.Name)
+	words := builder.getTableCnt(table.schema, indexDef.IndexTableName)
+	if docs <= 0 || words <= 0 {
+		return nil
+	}
+	return &fullTextStats{
+		n:     docs,
+		avgdl: words / docs,
+	}
+}
+
+// getTableCnt gets the row count of the table from the table stats, or 0 if they are not available.
+func (builder *QueryBuilder) getTableCnt(schema string, name string) float64 {
+	objRef, tableDef := builder.compCtx.Resolve(schema, name)
+	if objRef == nil || tableDef == nil || !builder.compCtx.Stats(objRef) {
+		return 0
+	}
+	sc := builder.compCtx.GetStatsCache()
+	if sc == nil {
+		return 0
+	}
+	return sc.GetStatsInfoMap(tableDef.TblId).TableCnt
+}
+
+// genFullTextScoreSql generates the sql to compute the BM25 score of the documents matching the terms:
+//
+//	score = sum(idf * tf * (k1 + 1) / (tf + k1 * (1 - b + b * dl / avgdl)))
+//	idf = ln(1 + (N - df + 0.5) / (df + 0.5))
+//
+// the words of a phrase must be at consecutive positions, the documents must contain all the
+// terms with '+' and none of the terms with '-'. The hidden table of the index is filtered by the
+// words of the terms before joining the words of a phrase. N and avgdl are taken from stats, or
+// computed by scanning the whole hidden table if stats is nil.
+func genFullTextScoreSql(schema string, indexTable string, terms []fulltext.Term, stats *fullTextStats) string {
+	table := fmt.Sprintf("`%s`.`%s`", schema, indexTable)
+	var must, mustNot, positive []string
+	for i, term := range terms {
+		switch term.Op {
+		case fulltext.OpMust:
+			must = append(must, fmt.Sprintf("%d", i))
+			positive = append(positive, fmt.Sprintf("%d", i))
+		case fulltext.OpMustNot:
+			mustNot = append(mustNot, fmt.Sprintf("%d", i))
+		default:
+			positive = append(positive, fmt.Sprintf("%d", i))
+		}
+	}
+	if len(positive) == 0 {
+		return fmt.Sprintf("select %s, cast(0 as double) as %s from %s where 1 = 0",
+			catalog.FullTextIndexDocIdColName, fullTextScoreColName, table)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("with __mo_terms as (")
+	for i, term := range terms {
+		if i > 0 {
+			sb.WriteString(" union all ")
+		}
+		fmt.Fprintf(&sb, "select %d as term, a0.%s as doc_id, cast(count(*) as double) as tf, cast(max(a0.%s) as double) as dl from ",
+			i, catalog.FullTextIndexDocIdColName, catalog.FullTextIndexDocLenColName)
+		for j, word := range term.Words {
+			if j > 0 {
+				sb.WriteString(" join ")
+			}
+			fmt.Fprintf(&sb, "(select %s, %s, %s from %s where ",
+				catalog.FullTextIndexDocIdColName, catalog.FullTextIndexPosColName, catalog.FullTextIndexDocLenColName, table)
+			if term.Prefix {
+				fmt.Fprintf(&sb, "startswith(%s, '%s')", catalog.FullTextIndexWordColName, word)
+			} else {
+				fmt.Fprintf(&sb, "%s = '%s'", catalog.FullTextIndexWordColName, word)
+			}
+			fmt.Fprintf(&sb, ") as a%d", j)
+			if j > 0 {
+				fmt.Fprintf(&sb, " on a%d.%s = a0.%s and a%d.%s = a0.%s + %d",
+					j, catalog.FullTextIndexDocIdColName, catalog.FullTextIndexDocIdColName,
+					j, catalog.FullTextIndexPosColName, catalog.FullTextIndexPosColName, j)
+			}
+		}
+		fmt.Fprintf(&sb, " group by a0.%s", catalog.FullTextIndexDocIdColName)
+	}
+	sb.WriteString(") ")
+
+	weight := fmt.Sprintf("ln(1 + (s.n - d.df + 0.5) / (d.df + 0.5)) * t.tf * (%s + 1) / (t.tf + %s * (1 - %s + %s * t.dl / s.avgdl))",
+		fullTextBM25K1, fullTextBM25K1, fullTextBM25B, fullTextBM25B)
+	if len(mustNot) > 0 {
+		weight = fmt.Sprintf("case when t.term in (%s) then 0 else %s end", strings.Join(mustNot, ", "), weight)
+	}
+	fmt.Fprintf(&sb, "select t.doc_id as %s, sum(%s) as %s from __mo_terms as t", catalog.FullTextIndexDocIdColName, weight, fullTextScoreColName)
+	sb.WriteString(" join (select term, cast(count(*) as double) as df from __mo_terms group by term) as d on t.term = d.term")
+	if stats != nil {
+		fmt.Fprintf(&sb, " cross join (select cast(%v as double) as n, cast(%v as double) as avgdl) as s", stats.n, stats.avgdl)
+	} else {
+		fmt.Fprintf(&sb, " cross join (select cast(count(*) as double) as n, avg(dl) as avgdl from (select cast(max(%s) as double) as dl from %s group by %s) as x) as s",
+			catalog.FullTextIndexDocLenColName, table, catalog.FullTextIndexDocIdColName)
+	}
+	sb.WriteString(" group by t.doc_id")
+
+	var having []string
+	if len(must) > 0 {
+		having = append(having, fmt.Sprintf("sum(case when t.term in (%s) then 1 else 0 end) = %d", strings.Join(must, ", "), len(must)))
+	}
+	if len(mustNot) > 0 {
+		having = append(having, fmt.Sprintf("sum(case when t.term in (%s) then 1 else 0 end) = 0", strings.Join(mustNot, ", ")))
+		having = append(having, fmt.Sprintf("sum(case when t.term in (%s) then 1 else 0 end) > 0", strings.Join(positive, ", ")))
+	}
+	if len(having) > 0 {
+		sb.WriteString(" having ")
+		sb.WriteString(strings.Join(having, " and "))
+	}
+	return sb.String()
+}
+
+func (b *baseBinder) bindFullTextMatch(astExpr *tree.FullTextMatchExpr, depth int32, isRoot bool) (*plan.Expr, error) {
+	key := tree.String(astExpr, dialect.MYSQL)
+	for ctx := b.ctx; ctx != nil; ctx = ctx.parent {
+		if score, ok := ctx.fullTextMatches[key]; ok {
+			return b.impl.BindExpr(score, depth, isRoot)
+		}
+	}
+	return nil, moerr.NewNotSupported(b.GetContext(), "MATCH ... AGAINST in '%s'", key)
+}
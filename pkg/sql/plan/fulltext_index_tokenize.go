@@ -0,0 +1,147 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const fulltextIndexTokenize = "fulltext_index_tokenize"
+
+// FullTextIndexTokenizeParam is the param of the fulltext_index_tokenize function scan.
+type FullTextIndexTokenizeParam struct {
+	Parser string `json:"parser"`
+}
+
+// buildFullTextIndexTokenize builds fulltext_index_tokenize(parser, doc_id, col1, col2, ...),
+// which returns a row of the hidden table of a fulltext index for each token of the columns.
+func (builder *QueryBuilder) buildFullTextIndexTokenize(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if len(exprs) < 3 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "%s: invalid arguments", fulltextIndexTokenize)
+	}
+	parser, ok := getConstStringValue(exprs[0])
+	if !ok || !fulltext.IsValidParser(parser) {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "%s: the parser must be a constant string of a supported parser", fulltextIndexTokenize)
+	}
+	for _, expr := range exprs[2:] {
+		if !isFullTextIndexType(expr.Typ) {
+			return 0, moerr.NewInvalidInput(builder.GetContext(), "%s: the columns must be strings, but got %s", fulltextIndexTokenize, types.T(expr.Typ.Id).String())
+		}
+	}
+	return builder.appendFullTextIndexTokenizeNode(parser, exprs[1], exprs[2:], childId, ctx)
+}
+
+func (builder *QueryBuilder) appendFullTextIndexTokenizeNode(parser string, docId *plan.Expr, cols []*plan.Expr, childId int32, ctx *BindContext) (int32, error) {
+	data, err := json.Marshal(&FullTextIndexTokenizeParam{Parser: parser})
+	if err != nil {
+		return 0, err
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  fulltextIndexTokenize,
+				Param: data,
+			},
+			Cols: makeFullTextIndexCols(docId.Typ),
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: append([]*plan.Expr{docId}, cols...),
+		Children:        []int32{childId},
+	}
+	return builder.appendNode(node, ctx), nil
+}
+
+// makeFullTextIndexCols returns the columns of the hidden table of a fulltext index,
+// which are also the columns returned by fulltext_index_tokenize.
+func makeFullTextIndexCols(docIdTyp *plan.Type) []*plan.ColDef {
+	return []*plan.ColDef{
+		{
+			Name: catalog.FullTextIndexWordColName,
+			Alg:  plan.CompressType_Lz4,
+			Typ: &plan.Type{
+				Id:          int32(types.T_varchar),
+				Width:       types.MaxVarcharLen,
+				NotNullable: true,
+			},
+			NotNull: true,
+			Default: &plan.Default{},
+		},
+		{
+			Name: catalog.FullTextIndexDocIdColName,
+			Alg:  plan.CompressType_Lz4,
+			Typ: &plan.Type{
+				Id:          docIdTyp.Id,
+				Width:       docIdTyp.Width,
+				Scale:       docIdTyp.Scale,
+				NotNullable: true,
+			},
+			NotNull: true,
+			Default: &plan.Default{},
+		},
+		{
+			Name: catalog.FullTextIndexPosColName,
+			Alg:  plan.CompressType_Lz4,
+			Typ: &plan.Type{
+				Id:          int32(types.T_int32),
+				NotNullable: true,
+			},
+			NotNull: true,
+			Default: &plan.Default{},
+		},
+		{
+			Name: catalog.FullTextIndexDocLenColName,
+			Alg:  plan.CompressType_Lz4,
+			Typ: &plan.Type{
+				Id:          int32(types.T_int32),
+				NotNullable: true,
+			},
+			NotNull: true,
+			Default: &plan.Default{},
+		},
+	}
+}
+
+func isFullTextIndex(indexDef *plan.IndexDef) bool {
+	return indexDef.IndexAlgo == catalog.MoIndexFullTextAlgo
+}
+
+func isFullTextIndexType(typ *plan.Type) bool {
+	switch types.T(typ.Id) {
+	case types.T_char, types.T_varchar, types.T_text:
+		return true
+	}
+	return false
+}
+
+func getConstStringValue(expr *plan.Expr) (string, bool) {
+	if c, ok := expr.Expr.(*plan.Expr_C); ok {
+		if s, ok := c.C.Value.(*plan.Const_Sval); ok {
+			return strings.ToLower(s.Sval), true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/fulltext"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullTextIndexDDL(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"create table t1 (a int primary key, b varchar(100), c text, fulltext(b, c))",
+		"create table t1 (a int primary key, b varchar(100), c text, fulltext key ft(c) with parser ngram)",
+		"create table t1 (a int, b int, c text, primary key (a, b), fulltext index ft(c) comment 'ft')",
+		"create fulltext index ft on emp(ename)",
+		"create fulltext index ft on emp(ename, job) with parser ngram",
+		"alter table emp add fulltext index ft(ename)",
+		"alter table emp add fulltext(job) with parser ngram",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"create table t1 (a int, b text, fulltext(b))",
+		"create table t1 (a int primary key, b int, fulltext(b))",
+		"create table t1 (a int primary key, b text, fulltext(d))",
+		"create table t1 (a int primary key, b text, fulltext ft(b) with parser foo)",
+		"create table t1 (a int primary key, b text, fulltext ft(b), fulltext ft(b))",
+		"create fulltext index ft on emp(sal)",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestFullTextIndexDML(t *testing.T) {
+	mock := NewMockOptimizer(true)
+
+	sqls := []string{
+		"insert into articles values (1, 'MySQL Tutorial', 'DBMS stands for DataBase')",
+		"insert into articles (id, title) values (2, 'How To Use MySQL')",
+		"insert into articles select id + 10, title, body from articles",
+		"update articles set body = 'full text search' where id = 1",
+		"update articles set title = concat(title, ' 2') where id > 10",
+		"update articles set id = id + 100 where id = 1",
+		"delete from articles where id = 1",
+		"delete from articles",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+}
+
+func TestFullTextMatch(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"select * from constraint_test.articles where match(title, body) against('database')",
+		"select id, match(title, body) against('database tutorial') as score from constraint_test.articles",
+		"select id from constraint_test.articles a where match(a.title, a.body) against('mysql' in natural language mode) order by match(a.title, a.body) against('mysql') desc",
+		"select id from constraint_test.articles where match(title, body) against('+mysql -yoursql' in boolean mode)",
+		"select id from constraint_test.articles where match(title, body) against('\"full text\" data*' in boolean mode) and id > 1",
+		"select id from constraint_test.articles where match(title, body) against('-mysql' in boolean mode)",
+		"select id from constraint_test.articles where id in (select id from constraint_test.articles where match(title, body) against('mysql'))",
+		"select a.id from constraint_test.articles a join constraint_test.emp e on a.id = e.empno where match(a.title, a.body) against('mysql') or e.sal > 1000",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"select * from constraint_test.articles where match(title) against('database')",
+		"select * from constraint_test.articles where match(title, body) against('database' with query expansion)",
+		"select * from constraint_test.articles where match(title, body) against(concat('a', 'b'))",
+		"select * from constraint_test.emp where match(ename) against('smith')",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestGenFullTextScoreSql(t *testing.T) {
+	terms := fulltext.ParseQuery("", "+mysql -\"full text\" data*", true)
+	sql := genFullTextScoreSql("db", "__mo_index_fulltext_x", terms, nil)
+	require.Contains(t, sql, "`db`.`__mo_index_fulltext_x`")
+	require.Contains(t, sql, "startswith(")
+	require.Contains(t, sql, "avg(dl)")
+	_, err := parsers.Parse(context.TODO(), dialect.MYSQL, sql, 1)
+	require.NoError(t, err)
+
+	sql = genFullTextScoreSql("db", "__mo_index_fulltext_x", terms, &fullTextStats{n: 100, avgdl: 12.5})
+	require.Contains(t, sql, "cast(100 as double) as n, cast(12.5 as double) as avgdl")
+	require.NotContains(t, sql, "avg(dl)")
+	_, err = parsers.Parse(context.TODO(), dialect.MYSQL, sql, 1)
+	require.NoError(t, err)
+
+	sql = genFullTextScoreSql("db", "__mo_index_fulltext_x", fulltext.ParseQuery("", "-mysql", true), nil)
+	require.Contains(t, sql, "1 = 0")
+}
+
+func TestFullTextMatchStar(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	for _, sql := range []string{
+		"select * from constraint_test.articles where match(title, body) against('database')",
+		"select * from constraint_test.articles order by match(title, body) against('database') desc",
+	} {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		require.Equal(t, []string{"id", "title", "body"}, logicPlan.GetQuery().Headings, sql)
+	}
+}
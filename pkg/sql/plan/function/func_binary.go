@@ -17,6 +17,10 @@ package function
 import (
 	"bytes"
 	"context"
+	"crypto/aes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
@@ -27,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vectorize/floor"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/format"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/instr"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/moarray"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"golang.org/x/exp/constraints"
 	"math"
@@ -2446,3 +2451,149 @@ func SplitSingle(str, sep string, cnt uint32) (string, bool) {
 	}
 	return strSlice[cnt-1], false
 }
+
+// Sha2 returns the sha-2 checksum of the string as hex string, the second parameter is the
+// length of the hash, 224, 256, 384, 512 or 0 which means 256. the result is null for other lengths.
+func Sha2(ivecs []*vector.Vector, result vector.FunctionResultWrapper, _ *process.Process, length int) error {
+	p1 := vector.GenerateFunctionStrParameter(ivecs[0])
+	p2 := vector.GenerateFunctionFixedTypeParameter[int64](ivecs[1])
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		v1, null1 := p1.GetStrValue(i)
+		v2, null2 := p2.GetValue(i)
+		if !(null1 || null2) {
+			if sum, ok := sha2Hex(v1, v2); ok {
+				if err := rs.AppendBytes([]byte(sum), false); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if err := rs.AppendBytes(nil, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sha2Hex(xs []byte, hashLength int64) (string, bool) {
+	switch hashLength {
+	case 224:
+		sum := sha256.Sum224(xs)
+		return hex.EncodeToString(sum[:]), true
+	case 0, 256:
+		sum := sha256.Sum256(xs)
+		return hex.EncodeToString(sum[:]), true
+	case 384:
+		sum := sha512.Sum384(xs)
+		return hex.EncodeToString(sum[:]), true
+	case 512:
+		sum := sha512.Sum512(xs)
+		return hex.EncodeToString(sum[:]), true
+	default:
+		return "", false
+	}
+}
+
+// AesEncrypt encrypts the string with the key in mysql's default block_encryption_mode aes-128-ecb.
+func AesEncrypt(ivecs []*vector.Vector, result vector.FunctionResultWrapper, _ *process.Process, length int) error {
+	return aesCrypt(ivecs, result, length, aesEncrypt)
+}
+
+// AesDecrypt decrypts the string encrypted by aes_encrypt, the result is null if the string
+// is not encrypted with the key.
+func AesDecrypt(ivecs []*vector.Vector, result vector.FunctionResultWrapper, _ *process.Process, length int) error {
+	return aesCrypt(ivecs, result, length, aesDecrypt)
+}
+
+func aesCrypt(ivecs []*vector.Vector, result vector.FunctionResultWrapper, length int,
+	cryptFn func(src, key []byte) ([]byte, bool)) error {
+	p1 := vector.GenerateFunctionStrParameter(ivecs[0])
+	p2 := vector.GenerateFunctionStrParameter(ivecs[1])
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		v1, null1 := p1.GetStrValue(i)
+		v2, null2 := p2.GetStrValue(i)
+		if !(null1 || null2) {
+			if res, ok := cryptFn(v1, v2); ok {
+				if err := rs.AppendBytes(res, false); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if err := rs.AppendBytes(nil, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aesKey folds the key into 16 bytes by xor like mysql
+func aesKey(key []byte) []byte {
+	res := make([]byte, aes.BlockSize)
+	for i, b := range key {
+		res[i%aes.BlockSize] ^= b
+	}
+	return res
+}
+
+func aesEncrypt(src, key []byte) ([]byte, bool) {
+	block, err := aes.NewCipher(aesKey(key))
+	if err != nil {
+		return nil, false
+	}
+	// pkcs7 padding, there is always at least one byte of padding
+	padding := aes.BlockSize - len(src)%aes.BlockSize
+	res := make([]byte, len(src)+padding)
+	copy(res, src)
+	for i := len(src); i < len(res); i++ {
+		res[i] = byte(padding)
+	}
+	for i := 0; i < len(res); i += aes.BlockSize {
+		block.Encrypt(res[i:i+aes.BlockSize], res[i:i+aes.BlockSize])
+	}
+	return res, true
+}
+
+func aesDecrypt(src, key []byte) ([]byte, bool) {
+	if len(src) == 0 || len(src)%aes.BlockSize != 0 {
+		return nil, false
+	}
+	block, err := aes.NewCipher(aesKey(key))
+	if err != nil {
+		return nil, false
+	}
+	res := make([]byte, len(src))
+	for i := 0; i < len(src); i += aes.BlockSize {
+		block.Decrypt(res[i:i+aes.BlockSize], src[i:i+aes.BlockSize])
+	}
+	padding := int(res[len(res)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		return nil, false
+	}
+	for _, b := range res[len(res)-padding:] {
+		if int(b) != padding {
+			return nil, false
+		}
+	}
+	return res[:len(res)-padding], true
+}
+
+func L2DistanceArray[T types.RealNumbers](parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return opBinaryBytesBytesToFixedWithErrorCheck[float64](parameters, result, proc, length, func(v1, v2 []byte) (float64, error) {
+		return moarray.L2Distance[T](types.BytesToArray[T](v1), types.BytesToArray[T](v2))
+	})
+}
+
+func CosineDistanceArray[T types.RealNumbers](parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return opBinaryBytesBytesToFixedWithErrorCheck[float64](parameters, result, proc, length, func(v1, v2 []byte) (float64, error) {
+		return moarray.CosineDistance[T](types.BytesToArray[T](v1), types.BytesToArray[T](v2))
+	})
+}
+
+func InnerProductArray[T types.RealNumbers](parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return opBinaryBytesBytesToFixedWithErrorCheck[float64](parameters, result, proc, length, func(v1, v2 []byte) (float64, error) {
+		return moarray.InnerProduct[T](types.BytesToArray[T](v1), types.BytesToArray[T](v2))
+	})
+}
@@ -2726,3 +2726,120 @@ func TestSplitPart(t *testing.T) {
 		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
 	}
 }
+
+func TestSha2(t *testing.T) {
+	proc := testutil.NewProcess()
+	fcTC := testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+				[]string{"abc", "abc", "abc", "abc", "abc", "", "abc"},
+				[]bool{false, false, false, false, false, true, false}),
+			testutil.NewFunctionTestInput(types.T_int64.ToType(),
+				[]int64{256, 0, 224, 384, 100, 256, 0},
+				[]bool{false, false, false, false, false, false, true}),
+		},
+		testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+			[]string{
+				"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+				"ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+				"23097d223405d8228642a477bda255b32aadbce4bda0b3f7e36c9da7",
+				"cb00753f45a35e8bb5a03d699ac65007272c32ab0eded1631a8b605a43ff5bed8086072ba1e7cc2358baeca134c825a7",
+				"", "", "",
+			},
+			[]bool{false, false, false, false, true, true, true}),
+		Sha2)
+	s, info := fcTC.Run()
+	require.True(t, s, info)
+}
+
+func TestAesEncryptDecrypt(t *testing.T) {
+	encrypted, ok := aesEncrypt([]byte("text"), []byte("password"))
+	require.True(t, ok)
+	require.Equal(t, 16, len(encrypted))
+
+	// a full block of padding is added for the aligned string
+	aligned, ok := aesEncrypt([]byte("0123456789abcdef"), []byte("password"))
+	require.True(t, ok)
+	require.Equal(t, 32, len(aligned))
+
+	proc := testutil.NewProcess()
+	fcTC := testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+				[]string{"text", "0123456789abcdef", "", "text"},
+				[]bool{false, false, true, false}),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+				[]string{"password", "password", "password", ""},
+				[]bool{false, false, false, true}),
+		},
+		testutil.NewFunctionTestResult(types.T_blob.ToType(), false,
+			[]string{string(encrypted), string(aligned), "", ""},
+			[]bool{false, false, true, true}),
+		AesEncrypt)
+	s, info := fcTC.Run()
+	require.True(t, s, info)
+
+	fcTC = testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_blob.ToType(),
+				[]string{string(encrypted), string(aligned), "text", ""},
+				[]bool{false, false, false, false}),
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+				[]string{"password", "password", "password", "password"},
+				[]bool{false, false, false, false}),
+		},
+		testutil.NewFunctionTestResult(types.T_blob.ToType(), false,
+			[]string{"text", "0123456789abcdef", "", ""},
+			[]bool{false, false, true, true}),
+		AesDecrypt)
+	s, info = fcTC.Run()
+	require.True(t, s, info)
+
+	// the key is folded into 16 bytes by xor
+	res, ok := aesDecrypt(encrypted, []byte("password\x00\x00\x00\x00\x00\x00\x00\x00"))
+	require.True(t, ok)
+	require.Equal(t, "text", string(res))
+}
+
+func TestArrayDistances(t *testing.T) {
+	proc := testutil.NewProcess()
+	vecs := []string{
+		string(types.ArrayToBytes[float32]([]float32{1, 2, 3})),
+		string(types.ArrayToBytes[float32]([]float32{0, 3, 4})),
+		"",
+	}
+	query := string(types.ArrayToBytes[float32]([]float32{1, 2, 3}))
+	inputs := func() []testutil.FunctionTestInput {
+		return []testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_array_float32.ToType(), vecs, []bool{false, false, true}),
+			testutil.NewFunctionTestConstInput(types.T_array_float32.ToType(), []string{query}, []bool{false}),
+		}
+	}
+
+	fcTC := testutil.NewFunctionTestCase(proc, inputs(),
+		testutil.NewFunctionTestResult(types.T_float64.ToType(), false,
+			[]float64{0, math.Sqrt(3), 0}, []bool{false, false, true}),
+		L2DistanceArray[float32])
+	s, info := fcTC.Run()
+	require.True(t, s, info)
+
+	fcTC = testutil.NewFunctionTestCase(proc, inputs(),
+		testutil.NewFunctionTestResult(types.T_float64.ToType(), false,
+			[]float64{14, 18, 0}, []bool{false, false, true}),
+		InnerProductArray[float32])
+	s, info = fcTC.Run()
+	require.True(t, s, info)
+
+	// the dimensions of the vectors must be the same
+	fcTC = testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_array_float64.ToType(),
+				[]string{string(types.ArrayToBytes[float64]([]float64{1, 2}))}, []bool{false}),
+			testutil.NewFunctionTestInput(types.T_array_float64.ToType(),
+				[]string{string(types.ArrayToBytes[float64]([]float64{1, 2, 3}))}, []bool{false}),
+		},
+		testutil.NewFunctionTestResult(types.T_float64.ToType(), true, []float64{0}, []bool{false}),
+		CosineDistanceArray[float64])
+	s, info = fcTC.Run()
+	require.True(t, s, info)
+}
@@ -1119,6 +1119,134 @@ func builtInSerial(parameters []*vector.Vector, result vector.FunctionResultWrap
 	return nil
 }
 
+// builtInCheckConstraint raises the error of the check constraint named by the second
+// parameter if the first one is false, a null value doesn't violate the constraint.
+func builtInCheckConstraint(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	p1 := vector.GenerateFunctionFixedTypeParameter[bool](parameters[0])
+	p2 := vector.GenerateFunctionStrParameter(parameters[1])
+	name, null := p2.GetStrValue(0)
+	if null {
+		return moerr.NewInternalError(proc.Ctx, "the second parameter of check_constraint() should not be null")
+	}
+
+	rs := vector.MustFunctionResult[bool](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null := p1.GetValue(i)
+		if !null && !v {
+			return moerr.NewCheckViolated(proc.Ctx, string(name))
+		}
+		if err := rs.Append(true, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// builtInSerialExtract returns the element at the position of the serial value.
+// the third parameter only gives the type of the result, for example:
+// serial_extract(serial(1.5, 'a'), 1, cast(null as varchar)) is 'a'
+func builtInSerialExtract(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	p1 := vector.GenerateFunctionStrParameter(parameters[0])
+	p2 := vector.GenerateFunctionFixedTypeParameter[int64](parameters[1])
+
+	switch typ := result.GetResultVector().GetType(); typ.Oid {
+	case types.T_bool:
+		return serialExtractFixed[bool](p1, p2, result, proc, length)
+	case types.T_int8:
+		return serialExtractFixed[int8](p1, p2, result, proc, length)
+	case types.T_int16:
+		return serialExtractFixed[int16](p1, p2, result, proc, length)
+	case types.T_int32:
+		return serialExtractFixed[int32](p1, p2, result, proc, length)
+	case types.T_int64:
+		return serialExtractFixed[int64](p1, p2, result, proc, length)
+	case types.T_uint8:
+		return serialExtractFixed[uint8](p1, p2, result, proc, length)
+	case types.T_uint16:
+		return serialExtractFixed[uint16](p1, p2, result, proc, length)
+	case types.T_uint32:
+		return serialExtractFixed[uint32](p1, p2, result, proc, length)
+	case types.T_uint64:
+		return serialExtractFixed[uint64](p1, p2, result, proc, length)
+	case types.T_float32:
+		return serialExtractFixed[float32](p1, p2, result, proc, length)
+	case types.T_float64:
+		return serialExtractFixed[float64](p1, p2, result, proc, length)
+	case types.T_char, types.T_varchar, types.T_text:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		for i := uint64(0); i < uint64(length); i++ {
+			v, null, err := serialExtractElement(p1, p2, i, proc)
+			if err != nil {
+				return err
+			}
+			if null {
+				if err = rs.AppendBytes(nil, true); err != nil {
+					return err
+				}
+				continue
+			}
+			bs, ok := v.([]byte)
+			if !ok {
+				return moerr.NewInvalidInput(proc.Ctx, "the element of serial is not of type %s", typ)
+			}
+			if err = rs.AppendBytes(bs, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return moerr.NewNotSupported(proc.Ctx, "serial_extract as type %s", typ)
+	}
+}
+
+func serialExtractFixed[T types.FixedSizeTExceptStrType](
+	p1 vector.FunctionParameterWrapper[types.Varlena], p2 vector.FunctionParameterWrapper[int64],
+	result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[T](result)
+	var dft T
+	for i := uint64(0); i < uint64(length); i++ {
+		v, null, err := serialExtractElement(p1, p2, i, proc)
+		if err != nil {
+			return err
+		}
+		if null {
+			if err = rs.Append(dft, true); err != nil {
+				return err
+			}
+			continue
+		}
+		val, ok := v.(T)
+		if !ok {
+			return moerr.NewInvalidInput(proc.Ctx, "the element of serial is not of type %s", rs.GetType())
+		}
+		if err = rs.Append(val, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func serialExtractElement(
+	p1 vector.FunctionParameterWrapper[types.Varlena], p2 vector.FunctionParameterWrapper[int64],
+	i uint64, proc *process.Process) (any, bool, error) {
+	v1, null1 := p1.GetStrValue(i)
+	pos, null2 := p2.GetValue(i)
+	if null1 || null2 {
+		return nil, true, nil
+	}
+	tuple, err := types.Unpack(v1)
+	if err != nil {
+		return nil, false, err
+	}
+	if pos < 0 || pos >= int64(len(tuple)) {
+		return nil, false, moerr.NewInvalidInput(proc.Ctx, "index %d out of range of the serial with %d elements", pos, len(tuple))
+	}
+	if tuple[pos] == nil {
+		return nil, true, nil
+	}
+	return tuple[pos], false, nil
+}
+
 // 24-hour seconds
 const SecondsIn24Hours = 86400
 
@@ -0,0 +1,474 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// jsonValueSupported returns true if the value of the type can be converted to a json value.
+func jsonValueSupported(t types.T) bool {
+	switch t {
+	case types.T_any, types.T_bool,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64,
+		types.T_decimal64, types.T_decimal128,
+		types.T_char, types.T_varchar, types.T_text, types.T_blob,
+		types.T_binary, types.T_varbinary, types.T_json, types.T_uuid,
+		types.T_date, types.T_datetime, types.T_time, types.T_timestamp:
+		return true
+	}
+	return false
+}
+
+// jsonDocCheck returns the type the json document argument should be cast to.
+func jsonDocCheck(t types.Type) (types.Type, bool) {
+	if t.Oid == types.T_json || t.Oid.IsMySQLString() {
+		return t, true
+	}
+	if t.Oid == types.T_any {
+		return types.T_varchar.ToType(), true
+	}
+	return t, false
+}
+
+// jsonStrCheck returns the type the path or key argument should be cast to.
+func jsonStrCheck(t types.Type) (types.Type, bool) {
+	if t.Oid.IsMySQLString() {
+		return t, true
+	}
+	if canCast, _ := fixedImplicitTypeCast(t, types.T_varchar); canCast || t.Oid == types.T_any {
+		return types.T_varchar.ToType(), true
+	}
+	return t, false
+}
+
+// jsonArgsCheck checks the arguments with the check function of each position.
+func jsonArgsCheck(inputs []types.Type, check func(i int, t types.Type) (types.Type, bool)) checkResult {
+	ts := make([]types.Type, len(inputs))
+	allMatch := true
+	for i, input := range inputs {
+		t, ok := check(i, input)
+		if !ok {
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		}
+		if t.Oid != input.Oid {
+			allMatch = false
+		}
+		ts[i] = t
+	}
+	if allMatch {
+		return newCheckResultWithSuccess(0)
+	}
+	return newCheckResultWithCast(0, ts)
+}
+
+func jsonValueCheck(t types.Type) (types.Type, bool) {
+	return t, jsonValueSupported(t.Oid)
+}
+
+// JSON_SET, JSON_INSERT, JSON_REPLACE: json_set(doc, path, val[, path, val] ...)
+func jsonModifyCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 3 || len(inputs)%2 == 0 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int, t types.Type) (types.Type, bool) {
+		if i == 0 {
+			return jsonDocCheck(t)
+		}
+		if i%2 == 1 {
+			return jsonStrCheck(t)
+		}
+		return jsonValueCheck(t)
+	})
+}
+
+// JSON_REMOVE: json_remove(doc, path[, path] ...)
+func jsonRemoveCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int, t types.Type) (types.Type, bool) {
+		if i == 0 {
+			return jsonDocCheck(t)
+		}
+		return jsonStrCheck(t)
+	})
+}
+
+// JSON_ARRAY: json_array([val[, val] ...])
+func jsonArrayCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	return jsonArgsCheck(inputs, func(i int, t types.Type) (types.Type, bool) {
+		return jsonValueCheck(t)
+	})
+}
+
+// JSON_OBJECT: json_object([key, val[, key, val] ...])
+func jsonObjectCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs)%2 == 1 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int, t types.Type) (types.Type, bool) {
+		if i%2 == 0 {
+			return jsonStrCheck(t)
+		}
+		return jsonValueCheck(t)
+	})
+}
+
+// JSON_CONTAINS: json_contains(target, candidate[, path])
+func jsonContainsCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 2 && len(inputs) != 3 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int, t types.Type) (types.Type, bool) {
+		if i < 2 {
+			return jsonDocCheck(t)
+		}
+		return jsonStrCheck(t)
+	})
+}
+
+// JSON_KEYS, JSON_LENGTH: json_keys(doc[, path])
+func jsonDocPathCheckFn(overloads []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 1 && len(inputs) != 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return jsonArgsCheck(inputs, func(i int, t types.Type) (types.Type, bool) {
+		if i == 0 {
+			return jsonDocCheck(t)
+		}
+		return jsonStrCheck(t)
+	})
+}
+
+func jsonIsNull(vec *vector.Vector, idx uint64) bool {
+	return vec.IsConstNull() || vec.GetNulls().Contains(idx)
+}
+
+func jsonRowIndex(vec *vector.Vector, idx uint64) uint64 {
+	if vec.IsConst() {
+		return 0
+	}
+	return idx
+}
+
+// getJsonDoc returns the json document of the row, the string is parsed as json text.
+func getJsonDoc(vec *vector.Vector, idx uint64) (bj bytejson.ByteJson, isNull bool, err error) {
+	idx = jsonRowIndex(vec, idx)
+	if jsonIsNull(vec, idx) {
+		return bytejson.Null, true, nil
+	}
+	data := vec.GetBytesAt(int(idx))
+	if vec.GetType().Oid == types.T_json {
+		return types.DecodeJson(data), false, nil
+	}
+	bj, err = types.ParseSliceToByteJson(data)
+	return bj, false, err
+}
+
+// getJsonPath returns the json path of the row.
+func getJsonPath(vec *vector.Vector, idx uint64) (p *bytejson.Path, isNull bool, err error) {
+	idx = jsonRowIndex(vec, idx)
+	if jsonIsNull(vec, idx) {
+		return nil, true, nil
+	}
+	path, err := types.ParseStringToPath(vec.GetStringAt(int(idx)))
+	if err != nil {
+		return nil, false, err
+	}
+	return &path, false, nil
+}
+
+// getJsonValue converts the value of the row to a json value, the NULL is converted to json null
+// and the string is converted to json string rather than parsed as json text.
+func getJsonValue(vec *vector.Vector, idx uint64, loc *time.Location) (bj bytejson.ByteJson, err error) {
+	idx = jsonRowIndex(vec, idx)
+	if jsonIsNull(vec, idx) {
+		return bytejson.Null, nil
+	}
+	typ := vec.GetType()
+	var v interface{}
+	switch typ.Oid {
+	case types.T_bool:
+		v = vector.GetFixedAt[bool](vec, int(idx))
+	case types.T_int8:
+		v = int64(vector.GetFixedAt[int8](vec, int(idx)))
+	case types.T_int16:
+		v = int64(vector.GetFixedAt[int16](vec, int(idx)))
+	case types.T_int32:
+		v = int64(vector.GetFixedAt[int32](vec, int(idx)))
+	case types.T_int64:
+		v = vector.GetFixedAt[int64](vec, int(idx))
+	case types.T_uint8:
+		v = uint64(vector.GetFixedAt[uint8](vec, int(idx)))
+	case types.T_uint16:
+		v = uint64(vector.GetFixedAt[uint16](vec, int(idx)))
+	case types.T_uint32:
+		v = uint64(vector.GetFixedAt[uint32](vec, int(idx)))
+	case types.T_uint64:
+		v = vector.GetFixedAt[uint64](vec, int(idx))
+	case types.T_float32:
+		v = float64(vector.GetFixedAt[float32](vec, int(idx)))
+	case types.T_float64:
+		v = vector.GetFixedAt[float64](vec, int(idx))
+	case types.T_decimal64:
+		v = json.Number(vector.GetFixedAt[types.Decimal64](vec, int(idx)).Format(typ.Scale))
+	case types.T_decimal128:
+		v = json.Number(vector.GetFixedAt[types.Decimal128](vec, int(idx)).Format(typ.Scale))
+	case types.T_char, types.T_varchar, types.T_text, types.T_blob, types.T_binary, types.T_varbinary:
+		v = vec.GetStringAt(int(idx))
+	case types.T_json:
+		return types.DecodeJson(vec.GetBytesAt(int(idx))), nil
+	case types.T_uuid:
+		v = vector.GetFixedAt[types.Uuid](vec, int(idx)).ToString()
+	case types.T_date:
+		v = vector.GetFixedAt[types.Date](vec, int(idx)).String()
+	case types.T_datetime:
+		v = vector.GetFixedAt[types.Datetime](vec, int(idx)).String2(typ.Scale)
+	case types.T_time:
+		v = vector.GetFixedAt[types.Time](vec, int(idx)).String2(typ.Scale)
+	case types.T_timestamp:
+		v = vector.GetFixedAt[types.Timestamp](vec, int(idx)).String2(loc, typ.Scale)
+	default:
+		return bytejson.Null, moerr.NewNotSupportedNoCtx("json value of type %s", typ.String())
+	}
+	err = bj.UnmarshalObject(v)
+	return
+}
+
+func sessionTimeZone(proc *process.Process) *time.Location {
+	if proc != nil && proc.SessionInfo.TimeZone != nil {
+		return proc.SessionInfo.TimeZone
+	}
+	return time.Local
+}
+
+func appendJsonResult(rs *vector.FunctionResult[types.Varlena], bj bytejson.ByteJson, isNull bool) error {
+	if isNull {
+		return rs.AppendBytes(nil, true)
+	}
+	dt, err := bj.Marshal()
+	if err != nil {
+		return err
+	}
+	return rs.AppendBytes(dt, false)
+}
+
+func JsonSet(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, proc, length, bytejson.ModifySet)
+}
+
+func JsonInsert(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, proc, length, bytejson.ModifyInsert)
+}
+
+func JsonReplace(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return jsonModify(parameters, result, proc, length, bytejson.ModifyReplace)
+}
+
+func jsonModify(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int, tp bytejson.ModifyType) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	loc := sessionTimeZone(proc)
+	cnt := (len(parameters) - 1) / 2
+	paths := make([]*bytejson.Path, cnt)
+	vals := make([]bytejson.ByteJson, cnt)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, isNull, err := getJsonDoc(parameters[0], i)
+		if err != nil {
+			return err
+		}
+		for j := 0; j < cnt && !isNull; j++ {
+			if paths[j], isNull, err = getJsonPath(parameters[2*j+1], i); err != nil {
+				return err
+			}
+			if vals[j], err = getJsonValue(parameters[2*j+2], i, loc); err != nil {
+				return err
+			}
+		}
+		if !isNull {
+			if doc, err = doc.Modify(paths, vals, tp); err != nil {
+				return err
+			}
+		}
+		if err = appendJsonResult(rs, doc, isNull); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonRemove(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	paths := make([]*bytejson.Path, len(parameters)-1)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, isNull, err := getJsonDoc(parameters[0], i)
+		if err != nil {
+			return err
+		}
+		for j := 1; j < len(parameters) && !isNull; j++ {
+			if paths[j-1], isNull, err = getJsonPath(parameters[j], i); err != nil {
+				return err
+			}
+		}
+		if !isNull {
+			if doc, err = doc.Remove(paths); err != nil {
+				return err
+			}
+		}
+		if err = appendJsonResult(rs, doc, isNull); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonArray(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	loc := sessionTimeZone(proc)
+	elems := make([]bytejson.ByteJson, len(parameters))
+	for i := uint64(0); i < uint64(length); i++ {
+		var err error
+		for j := range parameters {
+			if elems[j], err = getJsonValue(parameters[j], i, loc); err != nil {
+				return err
+			}
+		}
+		if err = appendJsonResult(rs, bytejson.CreateArray(elems), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonObject(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	loc := sessionTimeZone(proc)
+	cnt := len(parameters) / 2
+	keys := make([]string, cnt)
+	vals := make([]bytejson.ByteJson, cnt)
+	for i := uint64(0); i < uint64(length); i++ {
+		var err error
+		for j := 0; j < cnt; j++ {
+			keyVec := parameters[2*j]
+			idx := jsonRowIndex(keyVec, i)
+			if jsonIsNull(keyVec, idx) {
+				return moerr.NewInvalidInput(proc.Ctx, "JSON documents may not contain NULL member names")
+			}
+			keys[j] = keyVec.GetStringAt(int(idx))
+			if vals[j], err = getJsonValue(parameters[2*j+1], i, loc); err != nil {
+				return err
+			}
+		}
+		obj, err := bytejson.CreateObject(keys, vals)
+		if err != nil {
+			return err
+		}
+		if err = appendJsonResult(rs, obj, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getJsonDocAtPath returns the value of the document at the optional path argument.
+func getJsonDocAtPath(parameters []*vector.Vector, pathIdx int, idx uint64) (bytejson.ByteJson, bool, error) {
+	doc, isNull, err := getJsonDoc(parameters[0], idx)
+	if err != nil || isNull || len(parameters) <= pathIdx {
+		return doc, isNull, err
+	}
+	path, isNull, err := getJsonPath(parameters[pathIdx], idx)
+	if err != nil || isNull {
+		return doc, isNull, err
+	}
+	if err = bytejson.CheckSimplePath(path); err != nil {
+		return doc, false, err
+	}
+	doc, ok := doc.Lookup(path)
+	return doc, !ok, nil
+}
+
+func JsonContains(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[bool](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		candidate, isNull, err := getJsonDoc(parameters[1], i)
+		if err != nil {
+			return err
+		}
+		var target bytejson.ByteJson
+		if !isNull {
+			if target, isNull, err = getJsonDocAtPath(parameters, 2, i); err != nil {
+				return err
+			}
+		}
+		if isNull {
+			if err = rs.Append(false, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.Append(target.Contains(candidate), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonKeys(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, isNull, err := getJsonDocAtPath(parameters, 1, i)
+		if err != nil {
+			return err
+		}
+		var keys bytejson.ByteJson
+		isObject := false
+		if !isNull {
+			keys, isObject = doc.Keys()
+		}
+		if err = appendJsonResult(rs, keys, !isObject); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func JsonLength(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	rs := vector.MustFunctionResult[int64](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		doc, isNull, err := getJsonDocAtPath(parameters, 1, i)
+		if err != nil {
+			return err
+		}
+		if isNull {
+			if err = rs.Append(0, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = rs.Append(int64(doc.Length()), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,244 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonBytes returns the storage format of the json texts.
+func jsonBytes(docs ...string) []string {
+	ret := make([]string, len(docs))
+	for i, doc := range docs {
+		if doc == "" {
+			continue
+		}
+		bj, err := types.ParseStringToByteJson(doc)
+		if err != nil {
+			panic(err)
+		}
+		data, _ := bj.Marshal()
+		ret[i] = string(data)
+	}
+	return ret
+}
+
+func runJsonTestCases(t *testing.T, testCases []tcTemp, fn func(parameters []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error) {
+	proc := testutil.NewProcess()
+	for _, tc := range testCases {
+		fcTC := testutil.NewFunctionTestCase(proc, tc.inputs, tc.expect, fn)
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+}
+
+func TestJsonModify(t *testing.T) {
+	docs := []string{`{"a": 1, "b": [1, 2]}`, `[1]`, `{"a": 1}`}
+	paths := []string{"$.a", "$[3]", "$.c"}
+	vals := []int64{10, 20, 30}
+	nulls := []bool{false, false, true}
+	inputs := []testutil.FunctionTestInput{
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, []bool{}),
+		testutil.NewFunctionTestInput(types.T_varchar.ToType(), paths, []bool{}),
+		testutil.NewFunctionTestInput(types.T_int64.ToType(), vals, nulls),
+	}
+
+	runJsonTestCases(t, []tcTemp{
+		{
+			info:   "test json_set",
+			inputs: inputs,
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				jsonBytes(`{"a": 10, "b": [1, 2]}`, `[1, 20]`, `{"a": 1, "c": null}`), []bool{}),
+		},
+	}, JsonSet)
+	runJsonTestCases(t, []tcTemp{
+		{
+			info:   "test json_insert",
+			inputs: inputs,
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				jsonBytes(`{"a": 1, "b": [1, 2]}`, `[1, 20]`, `{"a": 1, "c": null}`), []bool{}),
+		},
+	}, JsonInsert)
+	runJsonTestCases(t, []tcTemp{
+		{
+			info:   "test json_replace",
+			inputs: inputs,
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				jsonBytes(`{"a": 10, "b": [1, 2]}`, `[1]`, `{"a": 1}`), []bool{}),
+		},
+		{
+			info: "test json_replace with null path",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1}`}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{""}, []bool{true}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"x"}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false, []string{""}, []bool{true}),
+		},
+		{
+			info: "test json_replace with wildcard",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 1}`}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.*"}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"x"}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), true, nil, nil),
+		},
+	}, JsonReplace)
+}
+
+func TestJsonRemove(t *testing.T) {
+	runJsonTestCases(t, []tcTemp{
+		{
+			info: "test json_remove",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_json.ToType(), jsonBytes(`{"a": 1, "b": [1, 2]}`, `[1, 2]`, ""), []bool{false, false, true}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.b[0]", "$[0]", "$"}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$.a", "$[0]", "$"}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				jsonBytes(`{"b": [2]}`, `[]`, ""), []bool{false, false, true}),
+		},
+		{
+			info: "test json_remove the document",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`[1]`}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"$"}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), true, nil, nil),
+		},
+	}, JsonRemove)
+}
+
+func TestJsonArrayObject(t *testing.T) {
+	runJsonTestCases(t, []tcTemp{
+		{
+			info: "test json_array",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1, 2}, []bool{false, true}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"a", `{"b": 1}`}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_json.ToType(), jsonBytes(`{"b": 1}`, `[true]`), []bool{}),
+				testutil.NewFunctionTestInput(types.T_float64.ToType(), []float64{1.5, 0}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_bool.ToType(), []bool{true, false}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				jsonBytes(`[1, "a", {"b": 1}, 1.5, true]`, `[null, "{\"b\": 1}", [true], 0.0, false]`), []bool{}),
+		},
+	}, JsonArray)
+
+	runJsonTestCases(t, []tcTemp{
+		{
+			info: "test json_object",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"k1", "k2"}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1, 2}, []bool{false, true}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"a", "k2"}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{"x", "y"}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				jsonBytes(`{"a": "x", "k1": 1}`, `{"k2": "y"}`), []bool{}),
+		},
+		{
+			info: "test json_object with null key",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{""}, []bool{true}),
+				testutil.NewFunctionTestInput(types.T_int64.ToType(), []int64{1}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), true, nil, nil),
+		},
+	}, JsonObject)
+}
+
+func TestJsonContains(t *testing.T) {
+	runJsonTestCases(t, []tcTemp{
+		{
+			info: "test json_contains",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": [1, 2]}`, `[1, 2]`, `[1]`}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": 2}`, `3`, `1`}, []bool{false, false, true}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_bool.ToType(), false,
+				[]bool{true, false, false}, []bool{false, false, true}),
+		},
+		{
+			info: "test json_contains with path",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`{"a": [1, 2]}`, `{"a": [1, 2]}`}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`2`, `2`}, []bool{}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{`$.a`, `$.b`}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_bool.ToType(), false,
+				[]bool{true, false}, []bool{false, true}),
+		},
+	}, JsonContains)
+}
+
+func TestJsonKeysLength(t *testing.T) {
+	docs := []string{`{"b": {"c": 1}, "a": [1, 2, 3]}`, `[1, 2]`, `"x"`}
+	runJsonTestCases(t, []tcTemp{
+		{
+			info: "test json_keys",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				jsonBytes(`["a", "b"]`, "", ""), []bool{false, true, true}),
+		},
+		{
+			info: "test json_keys with path",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, []bool{}),
+				testutil.NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$.b"}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_json.ToType(), false,
+				jsonBytes(`["c"]`, "", ""), []bool{false, true, true}),
+		},
+	}, JsonKeys)
+
+	runJsonTestCases(t, []tcTemp{
+		{
+			info: "test json_length",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_int64.ToType(), false,
+				[]int64{2, 2, 1}, []bool{}),
+		},
+		{
+			info: "test json_length with path",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, []bool{}),
+				testutil.NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$.a"}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_int64.ToType(), false,
+				[]int64{3, 0, 0}, []bool{false, true, true}),
+		},
+		{
+			info: "test json_length with wildcard",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), docs, []bool{}),
+				testutil.NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"$**.a"}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_int64.ToType(), true, nil, nil),
+		},
+	}, JsonLength)
+}
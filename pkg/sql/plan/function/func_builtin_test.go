@@ -559,3 +559,68 @@ func Test_BuiltIn_Math(t *testing.T) {
 		require.True(t, succeed, tc.info, info)
 	}
 }
+
+func TestSerialExtract(t *testing.T) {
+	proc := testutil.NewProcess()
+	ps := types.NewPackerArray(2, proc.Mp())
+	defer func() {
+		for _, p := range ps {
+			p.FreeMem()
+		}
+	}()
+	ps[0].EncodeFloat64(1.5)
+	ps[0].EncodeInt64(7)
+	ps[1].EncodeFloat64(0.5)
+	ps[1].EncodeInt64(3)
+
+	fcTC := testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+				[]string{string(ps[0].GetBuf()), string(ps[1].GetBuf()), ""}, []bool{false, false, true}),
+			testutil.NewFunctionTestConstInput(types.T_int64.ToType(), []int64{1}, []bool{false}),
+			testutil.NewFunctionTestConstInput(types.T_int64.ToType(), []int64{0}, []bool{true}),
+		},
+		testutil.NewFunctionTestResult(types.T_int64.ToType(), false,
+			[]int64{7, 3, 0}, []bool{false, false, true}),
+		builtInSerialExtract)
+	s, info := fcTC.Run()
+	require.True(t, s, info)
+
+	// the position is out of range
+	fcTC = testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+				[]string{string(ps[0].GetBuf())}, []bool{false}),
+			testutil.NewFunctionTestConstInput(types.T_int64.ToType(), []int64{2}, []bool{false}),
+			testutil.NewFunctionTestConstInput(types.T_int64.ToType(), []int64{0}, []bool{true}),
+		},
+		testutil.NewFunctionTestResult(types.T_int64.ToType(), true, []int64{0}, []bool{false}),
+		builtInSerialExtract)
+	s, info = fcTC.Run()
+	require.True(t, s, info)
+}
+
+func TestCheckConstraint(t *testing.T) {
+	proc := testutil.NewProcess()
+
+	// null doesn't violate the constraint
+	fcTC := testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_bool.ToType(), []bool{true, false}, []bool{false, true}),
+			testutil.NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"t_chk_1"}, []bool{false}),
+		},
+		testutil.NewFunctionTestResult(types.T_bool.ToType(), false, []bool{true, true}, []bool{false, false}),
+		builtInCheckConstraint)
+	s, info := fcTC.Run()
+	require.True(t, s, info)
+
+	fcTC = testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_bool.ToType(), []bool{true, false}, []bool{false, false}),
+			testutil.NewFunctionTestConstInput(types.T_varchar.ToType(), []string{"t_chk_1"}, []bool{false}),
+		},
+		testutil.NewFunctionTestResult(types.T_bool.ToType(), true, []bool{}, []bool{}),
+		builtInCheckConstraint)
+	s, info = fcTC.Run()
+	require.True(t, s, info)
+}
@@ -46,6 +46,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_decimal64, types.T_decimal128,
 		types.T_date, types.T_datetime,
 		types.T_time, types.T_timestamp,
+		types.T_array_float32, types.T_array_float64,
 	},
 
 	types.T_bool: {
@@ -231,6 +232,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_array_float32, types.T_array_float64,
 	},
 
 	types.T_varchar: {
@@ -245,6 +247,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_array_float32, types.T_array_float64,
 	},
 
 	types.T_binary: {
@@ -285,6 +288,7 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_array_float32, types.T_array_float64,
 	},
 
 	types.T_text: {
@@ -299,12 +303,23 @@ var supportedTypeCast = map[types.T][]types.T{
 		types.T_time, types.T_timestamp,
 		types.T_char, types.T_varchar, types.T_blob, types.T_text,
 		types.T_binary, types.T_varbinary,
+		types.T_array_float32, types.T_array_float64,
 	},
 
 	types.T_json: {
 		types.T_char, types.T_varchar, types.T_text,
 	},
 
+	types.T_array_float32: {
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_array_float32, types.T_array_float64,
+	},
+
+	types.T_array_float64: {
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_array_float32, types.T_array_float64,
+	},
+
 	types.T_uuid: {
 		types.T_char, types.T_varchar, types.T_blob,
 		types.T_binary, types.T_varbinary, types.T_text,
@@ -418,6 +433,12 @@ func NewCast(parameters []*vector.Vector, result vector.FunctionResultWrapper, p
 	case types.T_enum:
 		s := vector.GenerateFunctionFixedTypeParameter[types.Enum](from)
 		err = enumToOthers(proc.Ctx, s, *toType, result, length)
+	case types.T_array_float32:
+		s := vector.GenerateFunctionStrParameter(from)
+		err = arrayTypeToOthers[float32](proc.Ctx, s, *toType, result, length)
+	case types.T_array_float64:
+		s := vector.GenerateFunctionStrParameter(from)
+		err = arrayTypeToOthers[float64](proc.Ctx, s, *toType, result, length)
 	default:
 		// XXX we set the function here to adapt to the BVT cases.
 		err = formatCastError(proc.Ctx, from, *toType, "")
@@ -447,7 +468,8 @@ func scalarNullToOthers(ctx context.Context,
 	case types.T_uint64:
 		return appendNulls[uint64](result, length)
 	case types.T_char, types.T_varchar, types.T_blob,
-		types.T_binary, types.T_varbinary, types.T_text, types.T_json:
+		types.T_binary, types.T_varbinary, types.T_text, types.T_json,
+		types.T_array_float32, types.T_array_float64:
 		return appendNulls[types.Varlena](result, length)
 	case types.T_float32:
 		return appendNulls[float32](result, length)
@@ -1464,6 +1486,12 @@ func strTypeToOthers(proc *process.Process,
 		types.T_binary, types.T_varbinary, types.T_blob:
 		rs := vector.MustFunctionResult[types.Varlena](result)
 		return strToStr(proc.Ctx, source, rs, length, toType)
+	case types.T_array_float32:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return strToArray[float32](ctx, source, rs, length, toType)
+	case types.T_array_float64:
+		rs := vector.MustFunctionResult[types.Varlena](result)
+		return strToArray[float64](ctx, source, rs, length, toType)
 	}
 	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from %s to %s", source.GetType(), toType))
 }
@@ -1521,6 +1549,21 @@ func jsonToOthers(ctx context.Context,
 	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from json to %s", toType))
 }
 
+func arrayTypeToOthers[T types.RealNumbers](ctx context.Context,
+	source vector.FunctionParameterWrapper[types.Varlena],
+	toType types.Type, result vector.FunctionResultWrapper, length int) error {
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	switch toType.Oid {
+	case types.T_char, types.T_varchar, types.T_blob, types.T_text:
+		return arrayToStr[T](source, rs, length, toType)
+	case types.T_array_float32:
+		return arrayToArray[T, float32](ctx, source, rs, length, toType)
+	case types.T_array_float64:
+		return arrayToArray[T, float64](ctx, source, rs, length, toType)
+	}
+	return moerr.NewInternalError(ctx, fmt.Sprintf("unsupported cast from %s to %s", source.GetType(), toType))
+}
+
 func enumToOthers(ctx context.Context,
 	source vector.FunctionParameterWrapper[types.Enum],
 	toType types.Type, result vector.FunctionResultWrapper, length int) error {
@@ -3937,6 +3980,95 @@ func jsonToStr(
 	return nil
 }
 
+// checkArrayDimension returns an error if the vector does not fit the dimension
+// of the target type. The default width means any dimension.
+func checkArrayDimension(ctx context.Context, dim int, toType types.Type) error {
+	if toType.Width != types.MaxArrayDimension && int32(dim) != toType.Width {
+		return moerr.NewInvalidInput(ctx, "expected vector dimension %d != actual dimension %d", toType.Width, dim)
+	}
+	return nil
+}
+
+func strToArray[T types.RealNumbers](
+	ctx context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+	var i uint64
+	for i = 0; i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		arr, err := types.StringToArray[T](string(v))
+		if err != nil {
+			return err
+		}
+		if err = checkArrayDimension(ctx, len(arr), toType); err != nil {
+			return err
+		}
+		if err = to.AppendBytes(types.ArrayToBytes[T](arr), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func arrayToStr[T types.RealNumbers](
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+	var i uint64
+	for i = 0; i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		str := types.BytesToArrayToString[T](v)
+		if toType.Oid == types.T_char || toType.Oid == types.T_varchar {
+			if int32(utf8.RuneCountInString(str)) > toType.Width {
+				return moerr.NewDataTruncatedNoCtx("Vector", "truncated for char/varchar")
+			}
+		}
+		if err := to.AppendBytes([]byte(str), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func arrayToArray[T1, T2 types.RealNumbers](
+	ctx context.Context,
+	from vector.FunctionParameterWrapper[types.Varlena],
+	to *vector.FunctionResult[types.Varlena], length int, toType types.Type) error {
+	var i uint64
+	for i = 0; i < uint64(length); i++ {
+		v, null := from.GetStrValue(i)
+		if null {
+			if err := to.AppendBytes(nil, true); err != nil {
+				return err
+			}
+			continue
+		}
+		arr := types.BytesToArray[T1](v)
+		if err := checkArrayDimension(ctx, len(arr), toType); err != nil {
+			return err
+		}
+		res := make([]T2, len(arr))
+		for j, f := range arr {
+			res[j] = T2(f)
+		}
+		if err := to.AppendBytes(types.ArrayToBytes[T2](res), false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func enumToUint16(
 	from vector.FunctionParameterWrapper[types.Enum],
 	to *vector.FunctionResult[uint16], length int) error {
@@ -1481,6 +1481,51 @@ func initCastTestCase() []tcTemp {
 	testCases = append(testCases, castDecToOthers...)
 	testCases = append(testCases, castTimestampToOthers...)
 
+	vecf32 := types.New(types.T_array_float32, 3, 0)
+	vecf64 := types.New(types.T_array_float64, 3, 0)
+	castArrayCases := []tcTemp{
+		{
+			info: "str to vecf32",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"[1, 2.5, -3]", ""}, []bool{false, true}),
+				testutil.NewFunctionTestInput(vecf32, []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(vecf32, false,
+				[]string{string(types.ArrayToBytes[float32]([]float32{1, 2.5, -3})), ""}, []bool{false, true}),
+		},
+		{
+			info: "str to vecf32 with a wrong dimension",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"[1, 2]"}, []bool{false}),
+				testutil.NewFunctionTestInput(vecf32, []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(vecf32, true, []string{""}, []bool{false}),
+		},
+		{
+			info: "vecf32 to vecf64",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(vecf32,
+					[]string{string(types.ArrayToBytes[float32]([]float32{1, 2.5, -3}))}, []bool{false}),
+				testutil.NewFunctionTestInput(vecf64, []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(vecf64, false,
+				[]string{string(types.ArrayToBytes[float64]([]float64{1, 2.5, -3}))}, []bool{false}),
+		},
+		{
+			info: "vecf64 to str",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(vecf64,
+					[]string{string(types.ArrayToBytes[float64]([]float64{1, 2.5, -3}))}, []bool{false}),
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(), []string{}, []bool{}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"[1, 2.5, -3]"}, []bool{false}),
+		},
+	}
+	testCases = append(testCases, castArrayCases...)
+
 	return testCases
 }
 
@@ -425,6 +425,9 @@ var (
 		"mo_indexes":                  0,
 		"mo_pubs":                     0,
 		"mo_stages":                   0,
+		"mo_event_history":            0,
+		"mo_triggers":                 0,
+		"mo_mviews":                   0,
 	}
 )
 
@@ -16,8 +16,12 @@ package function
 
 import (
 	"context"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"hash/crc32"
 	"io"
 	"math"
 	"strconv"
@@ -604,6 +608,93 @@ func hexEncodeInt64(xs int64) string {
 	return fmt.Sprintf("%X", xs)
 }
 
+func Md5(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return opUnaryBytesToStr(ivecs, result, proc, length, md5Hex)
+}
+
+func md5Hex(xs []byte) string {
+	sum := md5.Sum(xs)
+	return hex.EncodeToString(sum[:])
+}
+
+func Sha1(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return opUnaryBytesToStr(ivecs, result, proc, length, sha1Hex)
+}
+
+func sha1Hex(xs []byte) string {
+	sum := sha1.Sum(xs)
+	return hex.EncodeToString(sum[:])
+}
+
+func Crc32(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return opUnaryBytesToFixed[uint64](ivecs, result, proc, length, crc32IEEE)
+}
+
+func crc32IEEE(xs []byte) uint64 {
+	return uint64(crc32.ChecksumIEEE(xs))
+}
+
+func ToBase64(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	return opUnaryBytesToStr(ivecs, result, proc, length, toBase64)
+}
+
+// base64LineLength is the max length of a line in the result of to_base64, same as mysql
+const base64LineLength = 76
+
+// toBase64 encodes xs like mysql, a newline is added after every 76 characters of the output
+func toBase64(xs []byte) string {
+	encoded := base64.StdEncoding.EncodeToString(xs)
+	if len(encoded) <= base64LineLength {
+		return encoded
+	}
+	var sb strings.Builder
+	sb.Grow(len(encoded) + len(encoded)/base64LineLength)
+	for len(encoded) > base64LineLength {
+		sb.WriteString(encoded[:base64LineLength])
+		sb.WriteByte('\n')
+		encoded = encoded[base64LineLength:]
+	}
+	sb.WriteString(encoded)
+	return sb.String()
+}
+
+// FromBase64 decodes the string encoded by to_base64, the result is null if the string is not valid.
+func FromBase64(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
+	p1 := vector.GenerateFunctionStrParameter(ivecs[0])
+	rs := vector.MustFunctionResult[types.Varlena](result)
+	for i := uint64(0); i < uint64(length); i++ {
+		v1, null1 := p1.GetStrValue(i)
+		if !null1 {
+			if decoded, ok := fromBase64(v1); ok {
+				if err := rs.AppendBytes(decoded, false); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if err := rs.AppendBytes(nil, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fromBase64 decodes xs, the whitespaces like the newlines added by to_base64 are ignored
+func fromBase64(xs []byte) ([]byte, bool) {
+	str := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\t', '\r', '\n':
+			return -1
+		}
+		return r
+	}, functionUtil.QuickBytesToStr(xs))
+	decoded, err := base64.StdEncoding.DecodeString(str)
+	if err != nil {
+		return nil, false
+	}
+	return decoded, true
+}
+
 func Length(ivecs []*vector.Vector, result vector.FunctionResultWrapper, proc *process.Process, length int) error {
 	return opUnaryStrToFixed[int64](ivecs, result, proc, length, strLength)
 }
@@ -21,11 +21,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/stretchr/testify/assert"
 	"math"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"github.com/stretchr/testify/require"
 )
 
@@ -2617,3 +2620,88 @@ func TestSleep(t *testing.T) {
 		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
 	}
 }
+
+func initHashTestCase() []tcTemp {
+	return []tcTemp{
+		{
+			info: "test md5",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"abc", "", ""},
+					[]bool{false, false, true}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"900150983cd24fb0d6963f7d28e17f72", "d41d8cd98f00b204e9800998ecf8427e", ""},
+				[]bool{false, false, true}),
+		},
+		{
+			info: "test sha1",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"abc", ""},
+					[]bool{false, true}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+				[]string{"a9993e364706816aba3e25717850c26c9cd0d89d", ""},
+				[]bool{false, true}),
+		},
+		{
+			info: "test crc32",
+			inputs: []testutil.FunctionTestInput{
+				testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+					[]string{"MySQL", "", ""},
+					[]bool{false, false, true}),
+			},
+			expect: testutil.NewFunctionTestResult(types.T_uint64.ToType(), false,
+				[]uint64{3259397556, 0, 0},
+				[]bool{false, false, true}),
+		},
+	}
+}
+
+func TestHash(t *testing.T) {
+	testCases := initHashTestCase()
+	fns := []func([]*vector.Vector, vector.FunctionResultWrapper, *process.Process, int) error{
+		Md5, Sha1, Crc32,
+	}
+
+	proc := testutil.NewProcess()
+	for i, tc := range testCases {
+		fcTC := testutil.NewFunctionTestCase(proc, tc.inputs, tc.expect, fns[i])
+		s, info := fcTC.Run()
+		require.True(t, s, fmt.Sprintf("case is '%s', err info is '%s'", tc.info, info))
+	}
+}
+
+func TestBase64(t *testing.T) {
+	long := strings.Repeat("a", 60)
+	longEncoded := strings.Repeat("YWFh", 20)
+	longEncoded = longEncoded[:76] + "\n" + longEncoded[76:]
+
+	proc := testutil.NewProcess()
+	fcTC := testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+				[]string{"abc", "", long, ""},
+				[]bool{false, false, false, true}),
+		},
+		testutil.NewFunctionTestResult(types.T_varchar.ToType(), false,
+			[]string{"YWJj", "", longEncoded, ""},
+			[]bool{false, false, false, true}),
+		ToBase64)
+	s, info := fcTC.Run()
+	require.True(t, s, info)
+
+	fcTC = testutil.NewFunctionTestCase(proc,
+		[]testutil.FunctionTestInput{
+			testutil.NewFunctionTestInput(types.T_varchar.ToType(),
+				[]string{"YWJj", "", longEncoded, "YWJ", "!!!!", ""},
+				[]bool{false, false, false, false, false, true}),
+		},
+		testutil.NewFunctionTestResult(types.T_blob.ToType(), false,
+			[]string{"abc", "", long, "", "", ""},
+			[]bool{false, false, false, true, true, true}),
+		FromBase64)
+	s, info = fcTC.Run()
+	require.True(t, s, info)
+}
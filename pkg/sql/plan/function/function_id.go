@@ -325,6 +325,31 @@ const (
 	CURRVAL
 	LASTVAL
 
+	MD5
+	SHA1
+	SHA2
+	CRC32
+	TO_BASE64
+	FROM_BASE64
+
+	JSON_SET
+	JSON_INSERT
+	JSON_REPLACE
+	JSON_REMOVE
+	JSON_ARRAY
+	JSON_OBJECT
+	JSON_CONTAINS
+	JSON_KEYS
+	JSON_LENGTH
+	JSON_ARRAYAGG
+	JSON_OBJECTAGG
+
+	L2_DISTANCE
+	COSINE_DISTANCE
+	INNER_PRODUCT
+	SERIAL_EXTRACT
+	CHECK_CONSTRAINT
+
 	// FUNCTION_END_NUMBER is not a function, just a flag to record the max number of function.
 	// TODO: every one should put the new function id in front of this one if you want to make a new function.
 	FUNCTION_END_NUMBER
@@ -387,6 +412,8 @@ var functionIdRegister = map[string]int32{
 	"min":                   MIN,
 	"sum":                   SUM,
 	"group_concat":          GROUP_CONCAT,
+	"json_arrayagg":         JSON_ARRAYAGG,
+	"json_objectagg":        JSON_OBJECTAGG,
 	"avg":                   AVG,
 	"count":                 COUNT,
 	"starcount":             STARCOUNT,
@@ -401,7 +428,15 @@ var functionIdRegister = map[string]int32{
 	"any_value":             ANY_VALUE,
 	"median":                MEDIAN,
 	// count window
-	"rank": RANK,
+	"rank":         RANK,
+	"lag":          LAG,
+	"lead":         LEAD,
+	"first_value":  FIRST_VALUE,
+	"last_value":   LAST_VALUE,
+	"nth_value":    NTH_VALUE,
+	"ntile":        NTILE,
+	"percent_rank": PERCENT_RANK,
+	"cume_dist":    CUME_DIST,
 	// builtin
 	// whoever edit this, please follow the lexical order, or come up with a better ordering method
 	// binary functions
@@ -508,6 +543,20 @@ var functionIdRegister = map[string]int32{
 	"collation":                      COLLATION,
 	"json_extract":                   JSON_EXTRACT,
 	"json_quote":                     JSON_QUOTE,
+	"json_set":                       JSON_SET,
+	"json_insert":                    JSON_INSERT,
+	"json_replace":                   JSON_REPLACE,
+	"json_remove":                    JSON_REMOVE,
+	"json_array":                     JSON_ARRAY,
+	"json_object":                    JSON_OBJECT,
+	"json_contains":                  JSON_CONTAINS,
+	"json_keys":                      JSON_KEYS,
+	"json_length":                    JSON_LENGTH,
+	"l2_distance":                    L2_DISTANCE,
+	"cosine_distance":                COSINE_DISTANCE,
+	"inner_product":                  INNER_PRODUCT,
+	"serial_extract":                 SERIAL_EXTRACT,
+	"check_constraint":               CHECK_CONSTRAINT,
 	"enable_fault_injection":         ENABLE_FAULT_INJECTION,
 	"disable_fault_injection":        DISABLE_FAULT_INJECTION,
 	"dense_rank":                     DENSE_RANK,
@@ -517,6 +566,15 @@ var functionIdRegister = map[string]int32{
 	"uuid":                           UUID,
 	"load_file":                      LOAD_FILE,
 	"hex":                            HEX,
+	"md5":                            MD5,
+	"sha1":                           SHA1,
+	"sha":                            SHA1,
+	"sha2":                           SHA2,
+	"crc32":                          CRC32,
+	"to_base64":                      TO_BASE64,
+	"from_base64":                    FROM_BASE64,
+	"aes_encrypt":                    AES_ENCRYPT,
+	"aes_decrypt":                    AES_DECRYPT,
 	"serial":                         SERIAL,
 	"hash_value":                     HASH,
 	"bin":                            BIN,
@@ -332,4 +332,46 @@ var supportedAggregateFunctions = []FuncNew{
 			},
 		},
 	},
+
+	{
+		functionId: JSON_ARRAYAGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 1 {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			return jsonArrayCheckFn(overloads, inputs)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    agg.JsonArrayAggReturnType,
+				specialId:  agg.AggregateJsonArrayAgg,
+			},
+		},
+	},
+
+	{
+		functionId: JSON_OBJECTAGG,
+		class:      plan.Function_AGG,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 2 {
+				return newCheckResultWithFailure(failedAggParametersWrong)
+			}
+			return jsonObjectCheckFn(overloads, inputs)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isAgg:      true,
+				retType:    agg.JsonObjectAggReturnType,
+				specialId:  agg.AggregateJsonObjectAgg,
+			},
+		},
+	},
 }
@@ -674,6 +674,195 @@ var supportedStringBuiltIns = []FuncNew{
 		},
 	},
 
+	// function `json_set`
+	{
+		functionId: JSON_SET,
+		class:      plan.Function_NONE,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonModifyCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JsonSet
+				},
+			},
+		},
+	},
+
+	// function `json_insert`
+	{
+		functionId: JSON_INSERT,
+		class:      plan.Function_NONE,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonModifyCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JsonInsert
+				},
+			},
+		},
+	},
+
+	// function `json_replace`
+	{
+		functionId: JSON_REPLACE,
+		class:      plan.Function_NONE,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonModifyCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JsonReplace
+				},
+			},
+		},
+	},
+
+	// function `json_remove`
+	{
+		functionId: JSON_REMOVE,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonRemoveCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JsonRemove
+				},
+			},
+		},
+	},
+
+	// function `json_array`
+	{
+		functionId: JSON_ARRAY,
+		class:      plan.Function_PRODUCE_NO_NULL,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonArrayCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JsonArray
+				},
+			},
+		},
+	},
+
+	// function `json_object`
+	{
+		functionId: JSON_OBJECT,
+		class:      plan.Function_PRODUCE_NO_NULL,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonObjectCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JsonObject
+				},
+			},
+		},
+	},
+
+	// function `json_contains`
+	{
+		functionId: JSON_CONTAINS,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonContainsCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_bool.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JsonContains
+				},
+			},
+		},
+	},
+
+	// function `json_keys`
+	{
+		functionId: JSON_KEYS,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonDocPathCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_json.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JsonKeys
+				},
+			},
+		},
+	},
+
+	// function `json_length`
+	{
+		functionId: JSON_LENGTH,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    jsonDocPathCheckFn,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_int64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return JsonLength
+				},
+			},
+		},
+	},
+
 	// function `left`
 	{
 		functionId: LEFT,
@@ -1247,6 +1436,124 @@ var supportedStringBuiltIns = []FuncNew{
 		},
 	},
 
+	// function `l2_distance`
+	{
+		functionId: L2_DISTANCE,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_array_float32, types.T_array_float32},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return L2DistanceArray[float32]
+				},
+			},
+			{
+				overloadId: 1,
+				args:       []types.T{types.T_array_float64, types.T_array_float64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return L2DistanceArray[float64]
+				},
+			},
+		},
+	},
+
+	// function `cosine_distance`
+	{
+		functionId: COSINE_DISTANCE,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_array_float32, types.T_array_float32},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return CosineDistanceArray[float32]
+				},
+			},
+			{
+				overloadId: 1,
+				args:       []types.T{types.T_array_float64, types.T_array_float64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return CosineDistanceArray[float64]
+				},
+			},
+		},
+	},
+
+	// function `inner_product`
+	{
+		functionId: INNER_PRODUCT,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_array_float32, types.T_array_float32},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return InnerProductArray[float32]
+				},
+			},
+			{
+				overloadId: 1,
+				args:       []types.T{types.T_array_float64, types.T_array_float64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return InnerProductArray[float64]
+				},
+			},
+		},
+	},
+
+	// function `serial_extract`
+	{
+		functionId: SERIAL_EXTRACT,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 3 && inputs[0].Oid == types.T_varchar && inputs[1].Oid == types.T_int64 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[2]
+				},
+				newOp: func() executeLogicOfOverload {
+					return builtInSerialExtract
+				},
+			},
+		},
+	},
+
 	// function `space`
 	{
 		functionId: SPACE,
@@ -1756,75 +2063,402 @@ var supportedMathBuiltIns = []FuncNew{
 					return types.T_float64.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return CeilFloat64
+					return CeilFloat64
+				},
+			},
+			{
+				overloadId: 5,
+				args:       []types.T{types.T_float64, types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return CeilFloat64
+				},
+			},
+			{
+				overloadId: 6,
+				args:       []types.T{types.T_decimal64},
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				newOp: func() executeLogicOfOverload {
+					return CeilDecimal64
+				},
+			},
+			{
+				overloadId: 7,
+				args:       []types.T{types.T_decimal64, types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				newOp: func() executeLogicOfOverload {
+					return CeilDecimal64
+				},
+			},
+			{
+				overloadId: 8,
+				args:       []types.T{types.T_decimal128},
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				newOp: func() executeLogicOfOverload {
+					return CeilDecimal128
+				},
+			},
+			{
+				overloadId: 9,
+				args:       []types.T{types.T_decimal128, types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				newOp: func() executeLogicOfOverload {
+					return CeilDecimal128
+				},
+			},
+			{
+				overloadId: 10,
+				args:       []types.T{types.T_varchar},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return CeilStr
+				},
+			},
+		},
+	},
+
+	// function `cos`
+	{
+		functionId: COS,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_float64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return builtInCos
+				},
+			},
+		},
+	},
+
+	// function `cot`
+	{
+		functionId: COT,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_float64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return builtInCot
+				},
+			},
+		},
+	},
+
+	// function `exp`
+	{
+		functionId: EXP,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_float64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return builtInExp
+				},
+			},
+		},
+	},
+
+	// function `floor`
+	{
+		functionId: FLOOR,
+		class:      plan.Function_STRICT | plan.Function_MONOTONIC,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_uint64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_uint64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorUInt64
+				},
+			},
+			{
+				overloadId: 1,
+				args:       []types.T{types.T_uint64, types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_uint64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorUInt64
+				},
+			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_int64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorInt64
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_int64, types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_int64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorInt64
+				},
+			},
+			{
+				overloadId: 4,
+				args:       []types.T{types.T_float64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorFloat64
+				},
+			},
+			{
+				overloadId: 5,
+				args:       []types.T{types.T_float64, types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorFloat64
+				},
+			},
+			{
+				overloadId: 6,
+				args:       []types.T{types.T_decimal64},
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorDecimal64
+				},
+			},
+			{
+				overloadId: 7,
+				args:       []types.T{types.T_decimal64, types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorDecimal64
+				},
+			},
+			{
+				overloadId: 8,
+				args:       []types.T{types.T_decimal128},
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorDecimal128
+				},
+			},
+			{
+				overloadId: 9,
+				args:       []types.T{types.T_decimal128, types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorDecimal128
+				},
+			},
+			{
+				overloadId: 10,
+				args:       []types.T{types.T_varchar},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return FloorStr
+				},
+			},
+		},
+	},
+
+	// function `hex`
+	{
+		functionId: HEX,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_varchar},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_varchar.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return HexString
+				},
+			},
+			{
+				overloadId: 1,
+				args:       []types.T{types.T_char},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_varchar.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return HexString
+				},
+			},
+			{
+				overloadId: 2,
+				args:       []types.T{types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_varchar.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return HexInt64
+				},
+			},
+		},
+	},
+
+	// function `md5`
+	{
+		functionId: MD5,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_varchar},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_varchar.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return Md5
+				},
+			},
+			{
+				overloadId: 1,
+				args:       []types.T{types.T_char},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_varchar.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return Md5
 				},
 			},
 			{
-				overloadId: 5,
-				args:       []types.T{types.T_float64, types.T_int64},
+				overloadId: 2,
+				args:       []types.T{types.T_text},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_float64.ToType()
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return CeilFloat64
+					return Md5
 				},
 			},
 			{
-				overloadId: 6,
-				args:       []types.T{types.T_decimal64},
+				overloadId: 3,
+				args:       []types.T{types.T_blob},
 				retType: func(parameters []types.Type) types.Type {
-					return parameters[0]
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return CeilDecimal64
+					return Md5
 				},
 			},
+		},
+	},
+
+	// function `sha1`
+	{
+		functionId: SHA1,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
 			{
-				overloadId: 7,
-				args:       []types.T{types.T_decimal64, types.T_int64},
+				overloadId: 0,
+				args:       []types.T{types.T_varchar},
 				retType: func(parameters []types.Type) types.Type {
-					return parameters[0]
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return CeilDecimal64
+					return Sha1
 				},
 			},
 			{
-				overloadId: 8,
-				args:       []types.T{types.T_decimal128},
+				overloadId: 1,
+				args:       []types.T{types.T_char},
 				retType: func(parameters []types.Type) types.Type {
-					return parameters[0]
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return CeilDecimal128
+					return Sha1
 				},
 			},
 			{
-				overloadId: 9,
-				args:       []types.T{types.T_decimal128, types.T_int64},
+				overloadId: 2,
+				args:       []types.T{types.T_text},
 				retType: func(parameters []types.Type) types.Type {
-					return parameters[0]
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return CeilDecimal128
+					return Sha1
 				},
 			},
 			{
-				overloadId: 10,
-				args:       []types.T{types.T_varchar},
+				overloadId: 3,
+				args:       []types.T{types.T_blob},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_float64.ToType()
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return CeilStr
+					return Sha1
 				},
 			},
 		},
 	},
 
-	// function `cos`
+	// function `sha2`
 	{
-		functionId: COS,
+		functionId: SHA2,
 		class:      plan.Function_STRICT,
 		layout:     STANDARD_FUNCTION,
 		checkFn:    fixedTypeMatch,
@@ -1832,183 +2466,203 @@ var supportedMathBuiltIns = []FuncNew{
 		Overloads: []overload{
 			{
 				overloadId: 0,
-				args:       []types.T{types.T_float64},
+				args:       []types.T{types.T_varchar, types.T_int64},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_float64.ToType()
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return builtInCos
+					return Sha2
 				},
 			},
-		},
-	},
-
-	// function `cot`
-	{
-		functionId: COT,
-		class:      plan.Function_STRICT,
-		layout:     STANDARD_FUNCTION,
-		checkFn:    fixedTypeMatch,
-
-		Overloads: []overload{
 			{
-				overloadId: 0,
-				args:       []types.T{types.T_float64},
+				overloadId: 1,
+				args:       []types.T{types.T_char, types.T_int64},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_float64.ToType()
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return builtInCot
+					return Sha2
 				},
 			},
-		},
-	},
-
-	// function `exp`
-	{
-		functionId: EXP,
-		class:      plan.Function_STRICT,
-		layout:     STANDARD_FUNCTION,
-		checkFn:    fixedTypeMatch,
-
-		Overloads: []overload{
 			{
-				overloadId: 0,
-				args:       []types.T{types.T_float64},
+				overloadId: 2,
+				args:       []types.T{types.T_text, types.T_int64},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_float64.ToType()
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return builtInExp
+					return Sha2
+				},
+			},
+			{
+				overloadId: 3,
+				args:       []types.T{types.T_blob, types.T_int64},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_varchar.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return Sha2
 				},
 			},
 		},
 	},
 
-	// function `floor`
+	// function `crc32`
 	{
-		functionId: FLOOR,
-		class:      plan.Function_STRICT | plan.Function_MONOTONIC,
+		functionId: CRC32,
+		class:      plan.Function_STRICT,
 		layout:     STANDARD_FUNCTION,
 		checkFn:    fixedTypeMatch,
 
 		Overloads: []overload{
 			{
 				overloadId: 0,
-				args:       []types.T{types.T_uint64},
+				args:       []types.T{types.T_varchar},
 				retType: func(parameters []types.Type) types.Type {
 					return types.T_uint64.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorUInt64
+					return Crc32
 				},
 			},
 			{
 				overloadId: 1,
-				args:       []types.T{types.T_uint64, types.T_int64},
+				args:       []types.T{types.T_char},
 				retType: func(parameters []types.Type) types.Type {
 					return types.T_uint64.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorUInt64
+					return Crc32
 				},
 			},
 			{
 				overloadId: 2,
-				args:       []types.T{types.T_int64},
+				args:       []types.T{types.T_text},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_int64.ToType()
+					return types.T_uint64.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorInt64
+					return Crc32
 				},
 			},
 			{
 				overloadId: 3,
-				args:       []types.T{types.T_int64, types.T_int64},
+				args:       []types.T{types.T_blob},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_int64.ToType()
+					return types.T_uint64.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorInt64
+					return Crc32
+				},
+			},
+		},
+	},
+
+	// function `to_base64`
+	{
+		functionId: TO_BASE64,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				args:       []types.T{types.T_varchar},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_varchar.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return ToBase64
 				},
 			},
 			{
-				overloadId: 4,
-				args:       []types.T{types.T_float64},
+				overloadId: 1,
+				args:       []types.T{types.T_char},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_float64.ToType()
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorFloat64
+					return ToBase64
 				},
 			},
 			{
-				overloadId: 5,
-				args:       []types.T{types.T_float64, types.T_int64},
+				overloadId: 2,
+				args:       []types.T{types.T_text},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_float64.ToType()
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorFloat64
+					return ToBase64
 				},
 			},
 			{
-				overloadId: 6,
-				args:       []types.T{types.T_decimal64},
+				overloadId: 3,
+				args:       []types.T{types.T_blob},
 				retType: func(parameters []types.Type) types.Type {
-					return parameters[0]
+					return types.T_varchar.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorDecimal64
+					return ToBase64
 				},
 			},
+		},
+	},
+
+	// function `from_base64`
+	{
+		functionId: FROM_BASE64,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
 			{
-				overloadId: 7,
-				args:       []types.T{types.T_decimal64, types.T_int64},
+				overloadId: 0,
+				args:       []types.T{types.T_varchar},
 				retType: func(parameters []types.Type) types.Type {
-					return parameters[0]
+					return types.T_blob.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorDecimal64
+					return FromBase64
 				},
 			},
 			{
-				overloadId: 8,
-				args:       []types.T{types.T_decimal128},
+				overloadId: 1,
+				args:       []types.T{types.T_char},
 				retType: func(parameters []types.Type) types.Type {
-					return parameters[0]
+					return types.T_blob.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorDecimal128
+					return FromBase64
 				},
 			},
 			{
-				overloadId: 9,
-				args:       []types.T{types.T_decimal128, types.T_int64},
+				overloadId: 2,
+				args:       []types.T{types.T_text},
 				retType: func(parameters []types.Type) types.Type {
-					return parameters[0]
+					return types.T_blob.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorDecimal128
+					return FromBase64
 				},
 			},
 			{
-				overloadId: 10,
-				args:       []types.T{types.T_varchar},
+				overloadId: 3,
+				args:       []types.T{types.T_blob},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_float64.ToType()
+					return types.T_blob.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return FloorStr
+					return FromBase64
 				},
 			},
 		},
 	},
 
-	// function `hex`
+	// function `aes_encrypt`
 	{
-		functionId: HEX,
+		functionId: AES_ENCRYPT,
 		class:      plan.Function_STRICT,
 		layout:     STANDARD_FUNCTION,
 		checkFn:    fixedTypeMatch,
@@ -2016,32 +2670,53 @@ var supportedMathBuiltIns = []FuncNew{
 		Overloads: []overload{
 			{
 				overloadId: 0,
-				args:       []types.T{types.T_varchar},
+				args:       []types.T{types.T_varchar, types.T_varchar},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_varchar.ToType()
+					return types.T_blob.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return HexString
+					return AesEncrypt
 				},
 			},
 			{
 				overloadId: 1,
-				args:       []types.T{types.T_char},
+				args:       []types.T{types.T_blob, types.T_varchar},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_varchar.ToType()
+					return types.T_blob.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return HexString
+					return AesEncrypt
 				},
 			},
+		},
+	},
+
+	// function `aes_decrypt`
+	{
+		functionId: AES_DECRYPT,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    fixedTypeMatch,
+
+		Overloads: []overload{
 			{
-				overloadId: 2,
-				args:       []types.T{types.T_int64},
+				overloadId: 0,
+				args:       []types.T{types.T_blob, types.T_varchar},
 				retType: func(parameters []types.Type) types.Type {
-					return types.T_varchar.ToType()
+					return types.T_blob.ToType()
 				},
 				newOp: func() executeLogicOfOverload {
-					return HexInt64
+					return AesDecrypt
+				},
+			},
+			{
+				overloadId: 1,
+				args:       []types.T{types.T_varchar, types.T_varchar},
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_blob.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return AesDecrypt
 				},
 			},
 		},
@@ -4980,6 +5655,31 @@ var supportedOthersBuiltIns = []FuncNew{
 		},
 	},
 
+	// function `check_constraint`
+	{
+		functionId: CHECK_CONSTRAINT,
+		class:      plan.Function_STRICT,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) != 2 || inputs[0].Oid != types.T_bool || !inputs[1].Oid.IsMySQLString() {
+				return newCheckResultWithFailure(failedFunctionParametersWrong)
+			}
+			return newCheckResultWithSuccess(0)
+		},
+
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_bool.ToType()
+				},
+				newOp: func() executeLogicOfOverload {
+					return builtInCheckConstraint
+				},
+			},
+		},
+	},
+
 	// function `isempty`
 	{
 		functionId: ISEMPTY,
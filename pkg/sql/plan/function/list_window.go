@@ -84,4 +84,225 @@ var supportedWindowFunctions = []FuncNew{
 			},
 		},
 	},
+	{
+		functionId: NTILE,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    ntileTypeCheck,
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_uint64.ToType()
+				},
+				specialId: agg.WinNtile,
+			},
+		},
+	},
+	{
+		functionId: PERCENT_RANK,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 0 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				specialId: agg.WinPercentRank,
+			},
+		},
+	},
+	{
+		functionId: CUME_DIST,
+		class:      plan.Function_WIN_ORDER,
+		layout:     STANDARD_FUNCTION,
+		checkFn: func(overloads []overload, inputs []types.Type) checkResult {
+			if len(inputs) == 0 {
+				return newCheckResultWithSuccess(0)
+			}
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		},
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return types.T_float64.ToType()
+				},
+				specialId: agg.WinCumeDist,
+			},
+		},
+	},
+	{
+		functionId: LAG,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    lagLeadTypeCheck,
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				specialId: agg.WinLag,
+			},
+		},
+	},
+	{
+		functionId: LEAD,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    lagLeadTypeCheck,
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				specialId: agg.WinLead,
+			},
+		},
+	},
+	{
+		functionId: FIRST_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    winValueTypeCheck,
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				specialId: agg.WinFirstValue,
+			},
+		},
+	},
+	{
+		functionId: LAST_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    winValueTypeCheck,
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				specialId: agg.WinLastValue,
+			},
+		},
+	},
+	{
+		functionId: NTH_VALUE,
+		class:      plan.Function_WIN_VALUE,
+		layout:     STANDARD_FUNCTION,
+		checkFn:    winValueTypeCheck,
+		Overloads: []overload{
+			{
+				overloadId: 0,
+				isWin:      true,
+				retType: func(parameters []types.Type) types.Type {
+					return parameters[0]
+				},
+				specialId: agg.WinNthValue,
+			},
+		},
+	},
+}
+
+// ntileTypeCheck accepts ntile(N), and N will be cast to int64.
+func ntileTypeCheck(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) != 1 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	if inputs[0].Oid == types.T_int64 {
+		return newCheckResultWithSuccess(0)
+	}
+	if winConstArgCastable(inputs[0]) {
+		return newCheckResultWithCast(0, []types.Type{types.T_int64.ToType()})
+	}
+	return newCheckResultWithFailure(failedFunctionParametersWrong)
+}
+
+// winConstArgCastable reports whether the N of lag, lead, nth_value and ntile
+// can be cast to int64. Only the integers are accepted, as a decimal or a string
+// would be rounded or truncated silently.
+func winConstArgCastable(typ types.Type) bool {
+	if !typ.Oid.IsInteger() && typ.Oid != types.T_any {
+		return false
+	}
+	can, _ := fixedImplicitTypeCast(typ, types.T_int64)
+	return can
+}
+
+// lagLeadTypeCheck accepts lag(expr [, N [, default]]) and lead(expr [, N [, default]]).
+// N will be cast to int64 and default will be cast to the type of expr.
+func lagLeadTypeCheck(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 1 || len(inputs) > 3 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return winValueCast(inputs)
+}
+
+// winValueTypeCheck accepts first_value(expr), last_value(expr) and nth_value(expr, N).
+func winValueTypeCheck(_ []overload, inputs []types.Type) checkResult {
+	if len(inputs) < 1 || len(inputs) > 2 {
+		return newCheckResultWithFailure(failedFunctionParametersWrong)
+	}
+	return winValueCast(inputs)
+}
+
+func winValueCast(inputs []types.Type) checkResult {
+	needCast := false
+	castType := make([]types.Type, len(inputs))
+	copy(castType, inputs)
+
+	// if select lag(null), just match the int64.
+	if inputs[0].Oid == types.T_any {
+		castType[0] = types.T_int64.ToType()
+		needCast = true
+	} else {
+		supported := false
+		for _, t := range agg.WinValueSupported {
+			if inputs[0].Oid == t {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		}
+	}
+	if len(inputs) > 1 && inputs[1].Oid != types.T_int64 {
+		if !winConstArgCastable(inputs[1]) {
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		}
+		castType[1] = types.T_int64.ToType()
+		needCast = true
+	}
+	if len(inputs) > 2 && inputs[2].Oid != castType[0].Oid {
+		if can, _ := fixedImplicitTypeCast(inputs[2], castType[0].Oid); !can {
+			return newCheckResultWithFailure(failedFunctionParametersWrong)
+		}
+		castType[2] = castType[0]
+		needCast = true
+	}
+	if needCast {
+		return newCheckResultWithCast(0, castType)
+	}
+	return newCheckResultWithSuccess(0)
 }
@@ -1734,6 +1734,8 @@ func initFixed3() {
 				{toType: types.T_varbinary, preferLevel: 2},
 				{toType: types.T_blob, preferLevel: 2},
 				{toType: types.T_text, preferLevel: 2},
+				{toType: types.T_array_float32, preferLevel: 2},
+				{toType: types.T_array_float64, preferLevel: 2},
 			},
 		},
 
@@ -1760,6 +1762,8 @@ func initFixed3() {
 				{toType: types.T_varbinary, preferLevel: 2},
 				{toType: types.T_blob, preferLevel: 2},
 				{toType: types.T_text, preferLevel: 2},
+				{toType: types.T_array_float32, preferLevel: 2},
+				{toType: types.T_array_float64, preferLevel: 2},
 			},
 		},
 
@@ -1876,6 +1880,20 @@ func initFixed3() {
 				{toType: types.T_binary, preferLevel: 2},
 				{toType: types.T_varbinary, preferLevel: 2},
 				{toType: types.T_blob, preferLevel: 2},
+				{toType: types.T_array_float32, preferLevel: 2},
+				{toType: types.T_array_float64, preferLevel: 2},
+			},
+		},
+		{
+			from: types.T_array_float32,
+			toList: []toRule{
+				{toType: types.T_array_float64, preferLevel: 1},
+			},
+		},
+		{
+			from: types.T_array_float64,
+			toList: []toRule{
+				{toType: types.T_array_float32, preferLevel: 2},
 			},
 		},
 		{
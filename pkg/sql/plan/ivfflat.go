@@ -0,0 +1,283 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/ivfflat"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	ivfFlatEntriesTableAlias = "__mo_ivfflat_entries"
+	ivfFlatProbesTableAlias  = "__mo_ivfflat_probes"
+)
+
+func isIvfFlatIndex(indexDef *plan.IndexDef) bool {
+	return indexDef.IndexAlgo == catalog.MoIndexIvfFlatAlgo
+}
+
+func isIvfFlatIndexType(typ *plan.Type) bool {
+	switch types.T(typ.Id) {
+	case types.T_array_float32, types.T_array_float64:
+		return true
+	}
+	return false
+}
+
+// splitIvfFlatIndexInfos splits the ivfflat indexes from the secondary indexes.
+func splitIvfFlatIndexInfos(indexInfos []*tree.Index) ([]*tree.Index, []*tree.Index) {
+	var secondaryIndexInfos, ivfFlatIndexInfos []*tree.Index
+	for _, indexInfo := range indexInfos {
+		if indexInfo.KeyType == tree.INDEX_TYPE_IVFFLAT {
+			ivfFlatIndexInfos = append(ivfFlatIndexInfos, indexInfo)
+		} else {
+			secondaryIndexInfos = append(secondaryIndexInfos, indexInfo)
+		}
+	}
+	return secondaryIndexInfos, ivfFlatIndexInfos
+}
+
+// makeIvfFlatCentroidsCols returns the columns of the centroids table of an ivfflat index.
+func makeIvfFlatCentroidsCols(vecTyp *plan.Type) []*plan.ColDef {
+	return []*plan.ColDef{
+		{
+			Name: catalog.IvfFlatIndexCentroidIdColName,
+			Alg:  plan.CompressType_Lz4,
+			Typ: &plan.Type{
+				Id:          int32(types.T_int64),
+				NotNullable: true,
+			},
+			NotNull: true,
+			Default: &plan.Default{},
+		},
+		{
+			Name: catalog.IvfFlatIndexCentroidColName,
+			Alg:  plan.CompressType_Lz4,
+			Typ: &plan.Type{
+				Id:          vecTyp.Id,
+				Width:       vecTyp.Width,
+				NotNullable: true,
+			},
+			NotNull: true,
+			Default: &plan.Default{},
+		},
+	}
+}
+
+// makeIvfFlatEntriesCols returns the columns of the entries table of an ivfflat index.
+func makeIvfFlatEntriesCols(pkTyp *plan.Type) []*plan.ColDef {
+	return []*plan.ColDef{
+		{
+			Name: catalog.IvfFlatIndexCentroidIdColName,
+			Alg:  plan.CompressType_Lz4,
+			Typ: &plan.Type{
+				Id:          int32(types.T_int64),
+				NotNullable: true,
+			},
+			NotNull: true,
+			Default: &plan.Default{},
+		},
+		{
+			Name: catalog.IndexTablePrimaryColName,
+			Alg:  plan.CompressType_Lz4,
+			Typ: &plan.Type{
+				Id:          pkTyp.Id,
+				Width:       pkTyp.Width,
+				Scale:       pkTyp.Scale,
+				NotNullable: true,
+			},
+			NotNull: true,
+			Default: &plan.Default{},
+		},
+	}
+}
+
+// getIvfFlatIndexTables returns the centroids and entries table of the ivfflat index.
+func getIvfFlatIndexTables(tableDef *TableDef, indexName string) (centroids *plan.IndexDef, entries *plan.IndexDef) {
+	for _, indexDef := range tableDef.Indexes {
+		if !isIvfFlatIndex(indexDef) || indexDef.IndexName != indexName {
+			continue
+		}
+		if indexDef.IndexAlgoTableType == catalog.IvfFlatIndexCentroidsType {
+			centroids = indexDef
+		} else {
+			entries = indexDef
+		}
+	}
+	return
+}
+
+// rewriteIvfFlatSearch rewrites the nearest neighbor search
+//
+//	select ... from t order by l2_distance(col, q) limit k
+//
+// to search only the rows in the probe_limit nearest lists of the ivfflat index on col,
+// the FROM clause is joined with the entries table of the index and the ids of the
+// nearest centroids.
+func (builder *QueryBuilder) rewriteIvfFlatSearch(clause *tree.SelectClause, fromTables tree.TableExprs, orderBy tree.OrderBy, limit *tree.Limit, ctx *BindContext) (tree.TableExprs, error) {
+	if len(fromTables) != 1 || clause.Where != nil || len(clause.GroupBy) != 0 || clause.Having != nil || clause.Distinct {
+		return fromTables, nil
+	}
+	if len(orderBy) != 1 || orderBy[0].Direction == tree.Descending || limit == nil || limit.Count == nil {
+		return fromTables, nil
+	}
+	from := fromTables[0]
+	if join, ok := from.(*tree.JoinTableExpr); ok && join.Right == nil {
+		from = join.Left
+	}
+	tbl, ok := from.(*tree.AliasedTableExpr)
+	if !ok {
+		return fromTables, nil
+	}
+	tableName, ok := tbl.Expr.(*tree.TableName)
+	if !ok {
+		return fromTables, nil
+	}
+	distFunc, ok := orderBy[0].Expr.(*tree.FuncExpr)
+	if !ok || len(distFunc.Exprs) != 2 {
+		return fromTables, nil
+	}
+	funcRef, ok := distFunc.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return fromTables, nil
+	}
+
+	schema := string(tableName.SchemaName)
+	table := string(tableName.ObjectName)
+	if schema == "" {
+		if ctx.findCTE(table) != nil {
+			return fromTables, nil
+		}
+		schema = ctx.defaultDatabase
+	}
+	obj, tableDef := builder.compCtx.Resolve(schema, table)
+	if tableDef == nil || tableDef.Pkey == nil || tableDef.Pkey.PkeyColName == catalog.FakePrimaryKeyColName {
+		return fromTables, nil
+	}
+	alias := string(tbl.As.Alias)
+	if alias == "" {
+		alias = table
+	}
+
+	// the distance is between the indexed column and a constant vector
+	var col string
+	var query tree.Expr
+	for i, arg := range distFunc.Exprs {
+		name, ok := arg.(*tree.UnresolvedName)
+		if !ok || (name.NumParts > 1 && name.Parts[1] != alias) {
+			continue
+		}
+		if other := distFunc.Exprs[1-i]; isIvfFlatQueryVector(other) {
+			col, query = name.Parts[0], other
+		}
+	}
+	if query == nil {
+		return fromTables, nil
+	}
+
+	for _, indexDef := range tableDef.Indexes {
+		if !isIvfFlatIndex(indexDef) || indexDef.Parts[0] != col {
+			continue
+		}
+		params, err := ivfflat.ParseParams(builder.GetContext(), indexDef.IndexAlgoParams)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(funcRef.Parts[0], params.DistanceFunc()) {
+			continue
+		}
+		probeLimit, err := builder.getIvfFlatProbeLimit()
+		if err != nil {
+			return nil, err
+		}
+		centroids, entries := getIvfFlatIndexTables(tableDef, indexDef.IndexName)
+		return builder.joinIvfFlatIndexTables(fromTables[0], alias, obj.SchemaName, tableDef, centroids, entries, params, query, probeLimit, ctx)
+	}
+	return fromTables, nil
+}
+
+// joinIvfFlatIndexTables joins the table with the entries table of the index and the ids of
+// the probe_limit nearest centroids to the query vector.
+func (builder *QueryBuilder) joinIvfFlatIndexTables(from tree.TableExpr, alias string, schema string, tableDef *TableDef,
+	centroids, entries *plan.IndexDef, params ivfflat.Params, query tree.Expr, probeLimit int64, ctx *BindContext) (tree.TableExprs, error) {
+	// the rows inserted before the index has any centroid are always searched
+	sql := fmt.Sprintf("(select %s from `%s`.`%s` order by %s(%s, null) limit %d) union all select %d",
+		catalog.IvfFlatIndexCentroidIdColName, schema, centroids.IndexTableName,
+		params.DistanceFunc(), catalog.IvfFlatIndexCentroidColName, probeLimit, ivfflat.UnassignedCentroidId)
+	stmts, err := parsers.Parse(builder.GetContext(), dialect.MYSQL, sql, 1)
+	if err != nil {
+		return nil, err
+	}
+	probes := stmts[0].(*tree.Select)
+	nearest, ok := probes.Select.(*tree.UnionClause).Left.(*tree.ParenSelect)
+	if !ok {
+		return nil, moerr.NewInternalError(builder.GetContext(), "invalid probes of ivfflat index: %s", sql)
+	}
+	// the distance to the query vector, which may be a parameter and can't be formatted in sql
+	nearest.Select.OrderBy[0].Expr.(*tree.FuncExpr).Exprs[1] = query
+
+	ctx.addHiddenTable(ivfFlatEntriesTableAlias)
+	ctx.addHiddenTable(ivfFlatProbesTableAlias)
+	entriesTable := tree.NewAliasedTableExpr(
+		tree.NewTableName(tree.Identifier(entries.IndexTableName), tree.ObjectNamePrefix{SchemaName: tree.Identifier(schema), ExplicitSchema: true}),
+		tree.AliasClause{Alias: ivfFlatEntriesTableAlias})
+	probesTable := tree.NewAliasedTableExpr(&tree.ParenTableExpr{Expr: probes}, tree.AliasClause{Alias: ivfFlatProbesTableAlias})
+
+	from = tree.NewJoinTableExpr(tree.JOIN_TYPE_INNER, from, entriesTable, tree.NewOnJoinCond(tree.NewComparisonExpr(tree.EQUAL,
+		tree.SetUnresolvedName(ivfFlatEntriesTableAlias, catalog.IndexTablePrimaryColName),
+		tree.SetUnresolvedName(alias, tableDef.Pkey.PkeyColName))))
+	from = tree.NewJoinTableExpr(tree.JOIN_TYPE_INNER, from, probesTable, tree.NewOnJoinCond(tree.NewComparisonExpr(tree.EQUAL,
+		tree.SetUnresolvedName(ivfFlatEntriesTableAlias, catalog.IvfFlatIndexCentroidIdColName),
+		tree.SetUnresolvedName(ivfFlatProbesTableAlias, catalog.IvfFlatIndexCentroidIdColName))))
+	return tree.TableExprs{from}, nil
+}
+
+func (builder *QueryBuilder) getIvfFlatProbeLimit() (int64, error) {
+	val, err := builder.compCtx.ResolveVariable("probe_limit", true, false)
+	if err != nil {
+		return 0, err
+	}
+	switch v := val.(type) {
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	}
+	return ivfflat.DefaultProbeLimit, nil
+}
+
+// isIvfFlatQueryVector returns whether the expression is a constant vector of a search.
+func isIvfFlatQueryVector(expr tree.Expr) bool {
+	switch expr := expr.(type) {
+	case *tree.NumVal:
+		return expr.ValType == tree.P_char
+	case *tree.ParamExpr, *tree.VarExpr:
+		return true
+	case *tree.CastExpr:
+		return isIvfFlatQueryVector(expr.Expr)
+	case *tree.ParenExpr:
+		return isIvfFlatQueryVector(expr.Expr)
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIvfFlatIndexDDL(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	sqls := []string{
+		"create table t1 (a int primary key, b vecf32(3), index idx using ivfflat (b) lists = 4)",
+		"create table t1 (a int, b int, c vecf64(128), primary key (a, b), key idx using ivfflat (c) op_type 'vector_cosine_ops')",
+		"create index idx2 using ivfflat on constraint_test.items (embedding) lists 8",
+		"alter table constraint_test.items add index idx2 using ivfflat (embedding) op_type 'vector_cosine_ops'",
+		"drop index idx_embedding on constraint_test.items",
+		"truncate table constraint_test.items",
+		"show create table constraint_test.items",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	sqls = []string{
+		"create table t1 (a int, b vecf32(3), index idx using ivfflat (b))",
+		"create table t1 (a int primary key, b int, index idx using ivfflat (b))",
+		"create table t1 (a int primary key, b vecf32(3), c vecf32(3), index idx using ivfflat (b, c))",
+		"create table t1 (a int primary key, b vecf32(3), index idx using ivfflat (b) op_type 'vector_ip_ops')",
+		"create table t1 (a int primary key, b vecf32(3), index idx (b))",
+		"create table t1 (a int primary key, b vecf32(3), unique key idx (b))",
+		"create table t1 (a vecf32(3) primary key)",
+		"create index idx2 on constraint_test.items (embedding)",
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
+func TestIvfFlatIndexTable(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	logicPlan, err := runOneStmt(mock, t, "create table t1 (a int primary key, b vecf32(3), index idx using ivfflat (b) lists = 4 op_type 'vector_cosine_ops')")
+	require.NoError(t, err)
+
+	createTable := logicPlan.GetDdl().GetCreateTable()
+	require.Equal(t, 2, len(createTable.IndexTables))
+	require.Equal(t, 2, len(createTable.TableDef.Indexes))
+	centroids, entries := getIvfFlatIndexTables(createTable.TableDef, "idx")
+	require.NotNil(t, centroids)
+	require.NotNil(t, entries)
+	require.Equal(t, `{"lists":4,"op_type":"vector_cosine_ops"}`, centroids.IndexAlgoParams)
+	require.Equal(t, []string{catalog.IvfFlatIndexCentroidIdColName, catalog.IndexTablePrimaryColName}, createTable.IndexTables[1].Pkey.Names)
+	require.Equal(t, int32(3), createTable.IndexTables[0].Cols[1].Typ.Width)
+}
+
+func TestIvfFlatIndexDML(t *testing.T) {
+	mock := NewMockOptimizer(true)
+
+	sqls := []string{
+		"insert into items values (1, '[1, 2, 3]')",
+		"insert into items (id) values (2)",
+		"insert into items select id + 10, embedding from items",
+		"update items set embedding = '[3, 2, 1]' where id = 1",
+		"update items set id = id + 100 where id = 1",
+		"delete from items where id = 1",
+		"delete from items",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+}
+
+func TestIvfFlatSearch(t *testing.T) {
+	mock := NewMockOptimizer(false)
+
+	scansEntries := func(sql string) bool {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+		for _, node := range logicPlan.GetQuery().Nodes {
+			if node.NodeType == plan.Node_TABLE_SCAN && strings.Contains(node.TableDef.Name, "ivfflat_entries") {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, sql := range []string{
+		"select id from constraint_test.items order by l2_distance(embedding, '[1, 2, 3]') limit 5",
+		"select * from constraint_test.items i order by l2_distance('[1, 2, 3]', i.embedding) asc limit 1",
+		"select id, l2_distance(embedding, cast('[1, 2, 3]' as vecf32(3))) from constraint_test.items order by l2_distance(embedding, cast('[1, 2, 3]' as vecf32(3))) limit 10",
+	} {
+		require.True(t, scansEntries(sql), sql)
+	}
+
+	for _, sql := range []string{
+		"select id from constraint_test.items order by l2_distance(embedding, '[1, 2, 3]')",
+		"select id from constraint_test.items order by l2_distance(embedding, '[1, 2, 3]') desc limit 5",
+		"select id from constraint_test.items order by cosine_distance(embedding, '[1, 2, 3]') limit 5",
+		"select id from constraint_test.items where id > 1 order by l2_distance(embedding, '[1, 2, 3]') limit 5",
+		"select id from constraint_test.items order by l2_distance(embedding, embedding) limit 5",
+	} {
+		require.False(t, scansEntries(sql), sql)
+	}
+
+	logicPlan, err := runOneStmt(mock, t, "select * from constraint_test.items order by l2_distance(embedding, '[1, 2, 3]') limit 5")
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "embedding"}, logicPlan.GetQuery().Headings)
+}
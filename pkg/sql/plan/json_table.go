@@ -0,0 +1,151 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+)
+
+// JsonTableParam is the param of the json_table function scan. The column types
+// are not kept here, the executor finds them in the column definitions by name.
+type JsonTableParam struct {
+	Path    string             `json:"path"`
+	Columns []*JsonTableColumn `json:"columns"`
+}
+
+type JsonTableColumn struct {
+	Kind    tree.JsonTableColumnKind  `json:"kind"`
+	Name    string                    `json:"name,omitempty"`
+	Path    string                    `json:"path,omitempty"`
+	OnEmpty *tree.JsonTableOnResponse `json:"onEmpty,omitempty"`
+	OnError *tree.JsonTableOnResponse `json:"onError,omitempty"`
+	Nested  *JsonTableParam           `json:"nested,omitempty"`
+}
+
+func (builder *QueryBuilder) buildJsonTable(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if len(exprs) != 1 || tbl.JsonTable == nil {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "json_table: invalid arguments")
+	}
+	switch types.T(exprs[0].Typ.Id) {
+	case types.T_json, types.T_char, types.T_varchar, types.T_text, types.T_any:
+	default:
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "json_table: the document must be json or string, but got %s", types.T(exprs[0].Typ.Id).String())
+	}
+
+	var colDefs []*plan.ColDef
+	param, err := builder.buildJsonTableParam(tbl.JsonTable, &colDefs, make(map[string]struct{}))
+	if err != nil {
+		return 0, err
+	}
+	data, err := json.Marshal(param)
+	if err != nil {
+		return 0, err
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  "json_table",
+				Param: data,
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: exprs,
+		Children:        []int32{childId},
+	}
+	return builder.appendNode(node, ctx), nil
+}
+
+// buildJsonTableParam checks the paths and the columns, and flattens the columns
+// of the nested paths into colDefs in the order of declaration.
+func (builder *QueryBuilder) buildJsonTableParam(spec *tree.JsonTable, colDefs *[]*plan.ColDef, names map[string]struct{}) (*JsonTableParam, error) {
+	if _, err := types.ParseStringToPath(spec.Path); err != nil {
+		return nil, err
+	}
+	param := &JsonTableParam{
+		Path:    spec.Path,
+		Columns: make([]*JsonTableColumn, 0, len(spec.Columns)),
+	}
+	for _, col := range spec.Columns {
+		if col.Kind == tree.JsonTableColumnNested {
+			nested, err := builder.buildJsonTableParam(col.Nested, colDefs, names)
+			if err != nil {
+				return nil, err
+			}
+			param.Columns = append(param.Columns, &JsonTableColumn{
+				Kind:   col.Kind,
+				Nested: nested,
+			})
+			continue
+		}
+
+		name := string(col.Name)
+		if _, ok := names[name]; ok {
+			return nil, moerr.NewInvalidInput(builder.GetContext(), "json_table: duplicate column name '%s'", name)
+		}
+		names[name] = struct{}{}
+
+		var typ *plan.Type
+		var err error
+		if col.Kind == tree.JsonTableColumnOrdinality {
+			typ = &plan.Type{Id: int32(types.T_uint32)}
+		} else {
+			if _, err = types.ParseStringToPath(col.Path); err != nil {
+				return nil, err
+			}
+			if typ, err = getTypeFromAst(builder.GetContext(), col.Type); err != nil {
+				return nil, err
+			}
+			if err = builder.checkJsonTableColumnType(typ); err != nil {
+				return nil, err
+			}
+		}
+		*colDefs = append(*colDefs, &plan.ColDef{
+			Name: name,
+			Typ:  typ,
+		})
+		param.Columns = append(param.Columns, &JsonTableColumn{
+			Kind:    col.Kind,
+			Name:    name,
+			Path:    col.Path,
+			OnEmpty: col.OnEmpty,
+			OnError: col.OnError,
+		})
+	}
+	return param, nil
+}
+
+// checkJsonTableColumnType makes sure the executor is able to cast the text of
+// the json value to the column type.
+func (builder *QueryBuilder) checkJsonTableColumnType(typ *plan.Type) error {
+	t := makeTypeByPlan2Type(typ)
+	if t.Oid == types.T_json || t.Oid.IsMySQLString() {
+		return nil
+	}
+	if _, err := function.GetFunctionByName(builder.GetContext(), "cast", []types.Type{types.T_varchar.ToType(), t}); err != nil {
+		return moerr.NewNotSupported(builder.GetContext(), "json_table column of type %s", t.String())
+	}
+	return nil
+}
@@ -0,0 +1,258 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// lateralInfo describes the path from the root of a LATERAL derived table to
+// its correlated predicates.
+type lateralInfo struct {
+	correlated bool
+	// grouped is set if the predicates are pulled up through AGG or DISTINCT
+	grouped bool
+	// scalarAgg is set if the predicates are pulled up through an AGG
+	// without GROUP BY, which returns one row even for an empty input
+	scalarAgg bool
+}
+
+func isLateralTable(tbl tree.TableExpr) bool {
+	aliased, ok := tbl.(*tree.AliasedTableExpr)
+	return ok && aliased.Lateral
+}
+
+// buildLateralTable builds the derived table of a LATERAL clause, which may
+// refer to the columns of the preceding tables bound in leftCtx.
+func (builder *QueryBuilder) buildLateralTable(tbl *tree.AliasedTableExpr, ctx, leftCtx *BindContext) (int32, error) {
+	var stmt *tree.Select
+	if paren, ok := tbl.Expr.(*tree.ParenTableExpr); ok {
+		stmt, _ = paren.Expr.(*tree.Select)
+	}
+	if stmt == nil {
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "LATERAL must be followed by a derived table")
+	}
+	if tbl.As.Alias == "" {
+		return 0, moerr.NewSyntaxError(builder.GetContext(), "subquery in FROM must have an alias: %T", tbl)
+	}
+	if builder.isForUpdate {
+		return 0, moerr.NewInternalError(builder.GetContext(), "not support select from derived table for update")
+	}
+
+	if leftCtx.binder == nil {
+		leftCtx.binder = NewTableBinder(builder, leftCtx)
+	}
+
+	subCtx := NewBindContext(builder, leftCtx)
+	nodeID, err := builder.buildSelect(stmt, subCtx, false)
+	if err != nil {
+		return 0, err
+	}
+
+	if subCtx.hasSingleRow && !subCtx.isCorrelated {
+		ctx.hasSingleRow = true
+	}
+
+	return nodeID, nil
+}
+
+// buildLateralJoin decorrelates the LATERAL derived table on the right side of
+// the join. The correlated predicates are pulled up into the ON condition, and
+// the join turns into an apply if the derived table has a LIMIT clause, which
+// has to be evaluated for each row of the left side.
+func (builder *QueryBuilder) buildLateralJoin(nodeID int32, ctx *BindContext) (int32, error) {
+	node := builder.qry.Nodes[nodeID]
+	subID := node.Children[1]
+	subCtx := builder.ctxByNode[subID]
+	if !subCtx.isCorrelated {
+		return builder.flattenJoinConditions(nodeID, ctx)
+	}
+
+	// the LIMIT clause is on the root or under the result PROJECT
+	limitNode := builder.qry.Nodes[subID]
+	if limitNode.Limit == nil && limitNode.Offset == nil && limitNode.NodeType == plan.Node_PROJECT {
+		limitNode = builder.qry.Nodes[limitNode.Children[0]]
+	}
+	limit, offset := limitNode.Limit, limitNode.Offset
+	limitNode.Limit, limitNode.Offset = nil, nil
+
+	info, err := builder.checkLateralCorrelation(subID)
+	if err != nil {
+		return 0, err
+	}
+	if info.scalarAgg && (limit != nil || offset != nil) {
+		return 0, moerr.NewNYI(builder.GetContext(), "LIMIT on aggregation without GROUP BY in LATERAL derived table")
+	}
+
+	subID, preds, err := builder.pullupCorrelatedPredicates(subID, subCtx)
+	if err != nil {
+		return 0, err
+	}
+	node.Children[1] = subID
+
+	if info.grouped && builder.findNonEqPred(preds) {
+		return 0, moerr.NewNYI(builder.GetContext(), "aggregation with non equal predicate in LATERAL derived table")
+	}
+
+	filterPreds, joinPreds := decreaseDepthAndDispatch(preds)
+	if len(filterPreds) > 0 {
+		return 0, moerr.NewNYI(builder.GetContext(), "correlated columns in LATERAL derived table deeper than 1 level")
+	}
+
+	conds := node.OnList
+	switch {
+	case limit != nil || offset != nil:
+		node.Limit, node.Offset = limit, offset
+		node.OnList = joinPreds
+		if node.JoinType == plan.Node_INNER {
+			node.JoinType = plan.Node_APPLY
+			return builder.appendJoinFilter(nodeID, conds, ctx)
+		}
+
+		for _, cond := range conds {
+			if !isConstTrue(cond) {
+				return 0, moerr.NewNYI(builder.GetContext(), "ON condition of LEFT JOIN LATERAL with LIMIT clause")
+			}
+		}
+		node.JoinType = plan.Node_OUTER_APPLY
+		return nodeID, nil
+
+	case info.scalarAgg && node.JoinType == plan.Node_INNER:
+		// the aggregation returns one row for each row of the left side
+		node.JoinType = plan.Node_LEFT
+		node.OnList = joinPreds
+		return builder.appendJoinFilter(nodeID, conds, ctx)
+
+	default:
+		node.OnList = append(conds, joinPreds...)
+		return builder.flattenJoinConditions(nodeID, ctx)
+	}
+}
+
+// checkLateralCorrelation checks whether the correlated predicates in the
+// LATERAL derived table can be pulled up to its root.
+func (builder *QueryBuilder) checkLateralCorrelation(nodeID int32) (info lateralInfo, err error) {
+	node := builder.qry.Nodes[nodeID]
+
+	for i, childID := range node.Children {
+		childInfo, err := builder.checkLateralCorrelation(childID)
+		if err != nil {
+			return info, err
+		}
+		if !childInfo.correlated {
+			continue
+		}
+
+		switch node.NodeType {
+		case plan.Node_PROJECT, plan.Node_SORT:
+
+		case plan.Node_FILTER:
+			if childInfo.scalarAgg {
+				return info, moerr.NewNYI(builder.GetContext(), "HAVING on correlated aggregation in LATERAL derived table")
+			}
+
+		case plan.Node_AGG, plan.Node_DISTINCT:
+			info.grouped = true
+
+		case plan.Node_JOIN:
+			if i > 0 && node.JoinType != plan.Node_INNER {
+				return info, moerr.NewNYI(builder.GetContext(), "correlated columns in the inner side of %s join in LATERAL derived table", node.JoinType.String())
+			}
+
+		default:
+			return info, moerr.NewNYI(builder.GetContext(), "correlated columns under %s in LATERAL derived table", node.NodeType.String())
+		}
+
+		if node.Limit != nil || node.Offset != nil {
+			return info, moerr.NewNYI(builder.GetContext(), "correlated columns under nested LIMIT clause in LATERAL derived table")
+		}
+
+		info.correlated = true
+		info.grouped = info.grouped || childInfo.grouped
+		info.scalarAgg = info.scalarAgg || childInfo.scalarAgg
+	}
+
+	for _, expr := range getNodeExprs(node) {
+		if !hasCorrCol(expr) {
+			continue
+		}
+		if node.NodeType != plan.Node_FILTER {
+			return info, moerr.NewNYI(builder.GetContext(), "correlated columns in %s of LATERAL derived table", node.NodeType.String())
+		}
+		info.correlated = true
+	}
+
+	if info.correlated && node.NodeType == plan.Node_AGG && len(node.GroupBy) == 0 {
+		if builder.findAggrCount(node.AggList) {
+			return info, moerr.NewNYI(builder.GetContext(), "COUNT without GROUP BY in LATERAL derived table")
+		}
+		info.scalarAgg = true
+	}
+
+	return info, nil
+}
+
+// appendJoinFilter evaluates the ON conditions above the join like WHERE.
+func (builder *QueryBuilder) appendJoinFilter(nodeID int32, conds []*plan.Expr, ctx *BindContext) (int32, error) {
+	if len(conds) == 0 {
+		return nodeID, nil
+	}
+
+	var err error
+	for i, cond := range conds {
+		nodeID, conds[i], err = builder.flattenSubqueries(nodeID, cond, ctx)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return builder.appendNode(&plan.Node{
+		NodeType:   plan.Node_FILTER,
+		Children:   []int32{nodeID},
+		FilterList: conds,
+	}, ctx), nil
+}
+
+func getNodeExprs(node *plan.Node) []*plan.Expr {
+	var exprs []*plan.Expr
+	exprs = append(exprs, node.ProjectList...)
+	exprs = append(exprs, node.OnList...)
+	exprs = append(exprs, node.FilterList...)
+	exprs = append(exprs, node.GroupBy...)
+	exprs = append(exprs, node.AggList...)
+	exprs = append(exprs, node.WinSpecList...)
+	exprs = append(exprs, node.TblFuncExprList...)
+	for _, orderBy := range node.OrderBy {
+		exprs = append(exprs, orderBy.Expr)
+	}
+	if node.Limit != nil {
+		exprs = append(exprs, node.Limit)
+	}
+	if node.Offset != nil {
+		exprs = append(exprs, node.Offset)
+	}
+	return exprs
+}
+
+func isConstTrue(expr *plan.Expr) bool {
+	if c, ok := expr.Expr.(*plan.Expr_C); ok {
+		if b, ok := c.C.Value.(*plan.Const_Bval); ok {
+			return b.Bval
+		}
+	}
+	return false
+}
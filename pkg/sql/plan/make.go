@@ -255,6 +255,24 @@ func MakePlan2NullTextConstExprWithType(v string) *plan.Expr {
 	}
 }
 
+// MakePlan2TypedConstExpr returns the string value cast to the type of the column, or the null
+// of the type. It's used to bind the value of the row to the variable of the trigger.
+func MakePlan2TypedConstExpr(ctx context.Context, v string, isNull bool, typ *Type) (*plan.Expr, error) {
+	targetType := &plan.Type{
+		Id:         typ.Id,
+		Width:      typ.Width,
+		Scale:      typ.Scale,
+		Enumvalues: typ.Enumvalues,
+	}
+	if isNull {
+		return &plan.Expr{
+			Expr: &plan.Expr_C{C: &plan.Const{Isnull: true}},
+			Typ:  targetType,
+		}, nil
+	}
+	return appendCastBeforeExpr(ctx, makePlan2StringConstExprWithType(v), targetType)
+}
+
 func makePlan2CastExpr(ctx context.Context, expr *Expr, targetType *Type) (*Expr, error) {
 	var err error
 	if isSameColumnType(expr.Typ, targetType) {
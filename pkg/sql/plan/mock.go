@@ -63,6 +63,7 @@ func (m *MockCompilerContext) ResolveVariable(varName string, isSystemVar, isGlo
 	dec, _ := types.ParseDecimal128("200.001", 38, 3)
 	vars["decimal_var"] = dec
 	vars["null_var"] = nil
+	vars["probe_limit"] = int64(5)
 
 	if m.mysqlCompatible {
 		vars["sql_mode"] = ""
@@ -585,6 +586,70 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 		outcnt: 12,
 	}
 
+	constraintTestSchema["articles"] = &Schema{
+		cols: []col{
+			{"id", types.T_int64, false, 0, 0},
+			{"title", types.T_varchar, true, 200, 0},
+			{"body", types.T_text, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 100,
+	}
+
+	constraintTestSchema[catalog.PrefixIndexTableName+"fulltext_7c6e1a52-8b3c-11ee-b9d1-0242ac120002"] = &Schema{
+		cols: []col{
+			{catalog.FullTextIndexWordColName, types.T_varchar, false, 65535, 0},
+			{catalog.FullTextIndexDocIdColName, types.T_int64, false, 0, 0},
+			{catalog.FullTextIndexPosColName, types.T_int32, false, 0, 0},
+			{catalog.FullTextIndexDocLenColName, types.T_int32, false, 0, 0},
+			{catalog.CPrimaryKeyColName, types.T_varchar, false, 65535, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		outcnt: 1000,
+	}
+
+	constraintTestSchema["stock"] = &Schema{
+		cols: []col{
+			{"id", types.T_int64, false, 0, 0},
+			{"qty", types.T_int64, true, 0, 0},
+			{"price", types.T_int64, true, 0, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 100,
+	}
+
+	constraintTestSchema["items"] = &Schema{
+		cols: []col{
+			{"id", types.T_int64, false, 0, 0},
+			{"embedding", types.T_array_float32, true, 3, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 100,
+	}
+
+	constraintTestSchema[catalog.PrefixIndexTableName+"ivfflat_centroids_1b2c6a8e-8f2d-11ee-b9d1-0242ac120002"] = &Schema{
+		cols: []col{
+			{catalog.IvfFlatIndexCentroidIdColName, types.T_int64, false, 0, 0},
+			{catalog.IvfFlatIndexCentroidColName, types.T_array_float32, false, 3, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		pks:    []int{0},
+		outcnt: 4,
+	}
+
+	constraintTestSchema[catalog.PrefixIndexTableName+"ivfflat_entries_1b2c6d7c-8f2d-11ee-b9d1-0242ac120002"] = &Schema{
+		cols: []col{
+			{catalog.IvfFlatIndexCentroidIdColName, types.T_int64, false, 0, 0},
+			{catalog.IndexTablePrimaryColName, types.T_int64, false, 0, 0},
+			{catalog.CPrimaryKeyColName, types.T_varchar, false, 65535, 0},
+			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
+		},
+		outcnt: 100,
+	}
+
 	constraintTestSchema["t1"] = &Schema{
 		cols: []col{
 			{"a", types.T_int64, false, 0, 0},
@@ -711,6 +776,65 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 				tableDef.Indexes = []*plan.IndexDef{p}
 			}
 
+			if tableName == "articles" {
+				tableDef.Indexes = []*plan.IndexDef{
+					{
+						IndexName:      "ft_title_body",
+						Parts:          []string{"title", "body"},
+						IndexTableName: catalog.PrefixIndexTableName + "fulltext_7c6e1a52-8b3c-11ee-b9d1-0242ac120002",
+						TableExist:     true,
+						IndexAlgo:      catalog.MoIndexFullTextAlgo,
+					},
+				}
+			}
+
+			if tableName == "stock" {
+				tableDef.Checks = []*plan.CheckDef{
+					{Name: "stock_chk_1", ExprStr: "qty >= 0"},
+					{Name: "chk_price", ExprStr: "price > 0 and price < qty * 100", NotEnforced: true},
+				}
+			}
+
+			if tableName == "items" {
+				params := `{"lists":4,"op_type":"vector_l2_ops"}`
+				tableDef.Indexes = []*plan.IndexDef{
+					{
+						IndexName:          "idx_embedding",
+						Parts:              []string{"embedding"},
+						IndexTableName:     catalog.PrefixIndexTableName + "ivfflat_centroids_1b2c6a8e-8f2d-11ee-b9d1-0242ac120002",
+						TableExist:         true,
+						IndexAlgo:          catalog.MoIndexIvfFlatAlgo,
+						IndexAlgoParams:    params,
+						IndexAlgoTableType: catalog.IvfFlatIndexCentroidsType,
+					},
+					{
+						IndexName:          "idx_embedding",
+						Parts:              []string{"embedding"},
+						IndexTableName:     catalog.PrefixIndexTableName + "ivfflat_entries_1b2c6d7c-8f2d-11ee-b9d1-0242ac120002",
+						TableExist:         true,
+						IndexAlgo:          catalog.MoIndexIvfFlatAlgo,
+						IndexAlgoParams:    params,
+						IndexAlgoTableType: catalog.IvfFlatIndexEntriesType,
+					},
+				}
+			}
+
+			if tableName == catalog.PrefixIndexTableName+"ivfflat_entries_1b2c6d7c-8f2d-11ee-b9d1-0242ac120002" {
+				tableDef.Pkey = &plan.PrimaryKeyDef{
+					PkeyColName: catalog.CPrimaryKeyColName,
+					Names:       []string{catalog.IvfFlatIndexCentroidIdColName, catalog.IndexTablePrimaryColName},
+					CompPkeyCol: colDefs[2],
+				}
+			}
+
+			if tableName == catalog.PrefixIndexTableName+"fulltext_7c6e1a52-8b3c-11ee-b9d1-0242ac120002" {
+				tableDef.Pkey = &plan.PrimaryKeyDef{
+					PkeyColName: catalog.CPrimaryKeyColName,
+					Names:       []string{catalog.FullTextIndexWordColName, catalog.FullTextIndexDocIdColName, catalog.FullTextIndexPosColName},
+					CompPkeyCol: colDefs[4],
+				}
+			}
+
 			if tableName == "v1" {
 				tableDef.TableType = catalog.SystemViewRel
 				viewData, _ := json.Marshal(ViewData{
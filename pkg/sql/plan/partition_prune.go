@@ -0,0 +1,375 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/rule"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// The functions which never decrease when the argument increases, the range conditions on the
+// argument column can prune the range partitions defined by them, see
+// https://dev.mysql.com/doc/refman/8.0/en/partitioning-pruning.html
+var monotonicPartitionFuncs = map[string]bool{
+	"year":           true,
+	"to_days":        true,
+	"to_seconds":     true,
+	"unix_timestamp": true,
+}
+
+// partitionPrune evaluates the filters of the scans on the partitioned tables against the partition
+// expression, and records the partitions which may contain the matched rows in the scan node.
+// It must be called before the column references are remapped.
+func (builder *QueryBuilder) partitionPrune(nodeID int32) {
+	node := builder.qry.Nodes[nodeID]
+	for _, childID := range node.Children {
+		builder.partitionPrune(childID)
+	}
+
+	if node.NodeType != plan.Node_TABLE_SCAN || node.TableDef == nil || node.TableDef.Partition == nil ||
+		len(node.FilterList) == 0 || len(node.BindingTags) == 0 {
+		return
+	}
+	partition := node.TableDef.Partition
+	if partition.PartitionExpression == nil || len(partition.Partitions) != int(partition.PartitionNum) {
+		return
+	}
+
+	pruner := newPartitionPruner(builder.compCtx.GetProcess(), node.BindingTags[0], partition)
+	selected := pruner.prune(node.FilterList)
+	if selected == nil {
+		return
+	}
+
+	prune := &plan.PartitionPrune{
+		IsPruned: true,
+	}
+	for i, ok := range selected {
+		if ok {
+			item := partition.Partitions[i]
+			prune.SelectedPartitions = append(prune.SelectedPartitions, &plan.PartitionItem{
+				PartitionName:      item.PartitionName,
+				OrdinalPosition:    item.OrdinalPosition,
+				PartitionTableName: item.PartitionTableName,
+			})
+		}
+	}
+	node.PartitionPrune = prune
+}
+
+type partitionPruner struct {
+	proc      *process.Process
+	tag       int32
+	partition *plan.PartitionByDef
+	// cols are the columns used by the partition expression
+	cols map[int32]bool
+	// rangeCol is the column whose range conditions can prune the partitions, -1 if there is none
+	rangeCol int32
+}
+
+func newPartitionPruner(proc *process.Process, tag int32, partition *plan.PartitionByDef) *partitionPruner {
+	p := &partitionPruner{
+		proc:      proc,
+		tag:       tag,
+		partition: partition,
+		cols:      make(map[int32]bool),
+		rangeCol:  -1,
+	}
+	getPartitionColumns(partition.PartitionExpression, p.cols)
+
+	switch partition.Type {
+	case plan.PartitionType_RANGE:
+		expr := partition.GetPartitionExpr().GetExpr()
+		if f, ok := expr.GetExpr().(*plan.Expr_F); ok && monotonicPartitionFuncs[f.F.Func.ObjName] && len(f.F.Args) == 1 {
+			expr = unwrapTemporalCast(f.F.Args[0])
+		}
+		if col, ok := expr.GetExpr().(*plan.Expr_Col); ok {
+			p.rangeCol = col.Col.ColPos
+		}
+	case plan.PartitionType_RANGE_COLUMNS:
+		columns := partition.GetPartitionColumns().GetColumns()
+		if len(columns) == 1 {
+			if col, ok := columns[0].GetExpr().(*plan.Expr_Col); ok {
+				p.rangeCol = col.Col.ColPos
+			}
+		}
+	}
+	return p
+}
+
+// prune returns the partitions selected by the filters, or nil if no partition can be pruned.
+func (p *partitionPruner) prune(filters []*Expr) []bool {
+	var selected []bool
+	for _, filter := range filters {
+		selected = intersectPartitions(selected, p.pruneExpr(filter))
+	}
+
+	// the partitions by multiple columns can only be located by the equal conditions on all the columns
+	if len(p.cols) > 1 {
+		values := make(map[int32]*Expr)
+		for _, filter := range filters {
+			if f, ok := filter.Expr.(*plan.Expr_F); ok && f.F.Func.ObjName == "=" {
+				if col, c, _, ok := p.getColumnAndConst(f.F.Args); ok {
+					values[col] = c
+				}
+			}
+		}
+		if len(values) == len(p.cols) {
+			selected = intersectPartitions(selected, p.selectByValues(values))
+		}
+	}
+
+	for _, ok := range selected {
+		if !ok {
+			return selected
+		}
+	}
+	return nil
+}
+
+// pruneExpr returns the partitions selected by the expression, or nil if it selects all.
+func (p *partitionPruner) pruneExpr(expr *Expr) []bool {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil
+	}
+	args := f.F.Args
+
+	switch name := f.F.Func.ObjName; name {
+	case "and":
+		var selected []bool
+		for _, arg := range args {
+			selected = intersectPartitions(selected, p.pruneExpr(arg))
+		}
+		return selected
+
+	case "or":
+		selected := make([]bool, p.partition.PartitionNum)
+		for _, arg := range args {
+			s := p.pruneExpr(arg)
+			if s == nil {
+				return nil
+			}
+			for i := range s {
+				selected[i] = selected[i] || s[i]
+			}
+		}
+		return selected
+
+	case "=":
+		col, c, _, ok := p.getColumnAndConst(args)
+		if !ok || len(p.cols) != 1 {
+			return nil
+		}
+		return p.selectByValues(map[int32]*Expr{col: c})
+
+	case "in":
+		if len(args) != 2 || len(p.cols) != 1 {
+			return nil
+		}
+		col, ok := args[0].Expr.(*plan.Expr_Col)
+		if !ok || col.Col.RelPos != p.tag || !p.cols[col.Col.ColPos] {
+			return nil
+		}
+		bin, ok := args[1].Expr.(*plan.Expr_Bin)
+		if !ok {
+			return nil
+		}
+		vec := vector.NewVec(types.T_any.ToType())
+		if err := vec.UnmarshalBinary(bin.Bin.Data); err != nil {
+			return nil
+		}
+		typ := makePlan2Type(vec.GetType())
+		selected := make([]bool, p.partition.PartitionNum)
+		for i := 0; i < vec.Length(); i++ {
+			c := rule.GetConstantValue(vec, true, uint64(i))
+			if c == nil {
+				return nil
+			}
+			s := p.selectByValues(map[int32]*Expr{col.Col.ColPos: {Typ: typ, Expr: &plan.Expr_C{C: c}}})
+			if s == nil {
+				return nil
+			}
+			for j := range s {
+				selected[j] = selected[j] || s[j]
+			}
+		}
+		return selected
+
+	case "<", "<=", ">", ">=":
+		col, c, flipped, ok := p.getColumnAndConst(args)
+		if !ok || col != p.rangeCol {
+			return nil
+		}
+		idx, ok := p.locate(map[int32]*Expr{col: c})
+		if !ok {
+			return nil
+		}
+		// the partitions are ordered by the range, and the partition function never decreases
+		if (name[0] == '<') != flipped {
+			if idx < 0 {
+				return nil
+			}
+			return selectPartitionRange(int(p.partition.PartitionNum), 0, int(idx))
+		}
+		if idx < 0 {
+			return make([]bool, p.partition.PartitionNum)
+		}
+		return selectPartitionRange(int(p.partition.PartitionNum), int(idx), int(p.partition.PartitionNum)-1)
+	}
+	return nil
+}
+
+// getColumnAndConst returns the partition column and the constant compared, flipped is true if the
+// constant is on the left.
+func (p *partitionPruner) getColumnAndConst(args []*Expr) (col int32, c *Expr, flipped bool, ok bool) {
+	if len(args) != 2 {
+		return
+	}
+	colExpr, constExpr := args[0], args[1]
+	if _, isConst := colExpr.Expr.(*plan.Expr_C); isConst {
+		colExpr, constExpr = constExpr, colExpr
+		flipped = true
+	}
+	colRef, isCol := colExpr.Expr.(*plan.Expr_Col)
+	if !isCol || colRef.Col.RelPos != p.tag || !p.cols[colRef.Col.ColPos] {
+		return
+	}
+	if _, isConst := constExpr.Expr.(*plan.Expr_C); !isConst {
+		return
+	}
+	return colRef.Col.ColPos, constExpr, flipped, true
+}
+
+// selectByValues returns the partition the values of the columns belong to, or nil if it's unknown.
+func (p *partitionPruner) selectByValues(values map[int32]*Expr) []bool {
+	idx, ok := p.locate(values)
+	if !ok {
+		return nil
+	}
+	selected := make([]bool, p.partition.PartitionNum)
+	// -1 means the values belong to no partition, the rows can't exist
+	if idx >= 0 && int(idx) < len(selected) {
+		selected[idx] = true
+	}
+	return selected
+}
+
+// locate evaluates the partition expression with the values of the columns.
+func (p *partitionPruner) locate(values map[int32]*Expr) (int32, bool) {
+	if p.proc == nil {
+		return 0, false
+	}
+	expr, ok := p.replaceColumns(DeepCopyExpr(p.partition.PartitionExpression), values)
+	if !ok {
+		return 0, false
+	}
+	expr, err := ConstantFold(batch.EmptyForConstFoldBatch, expr, p.proc, false)
+	if err != nil {
+		return 0, false
+	}
+	c, ok := expr.Expr.(*plan.Expr_C)
+	if !ok || c.C.Isnull {
+		return 0, false
+	}
+	v, ok := c.C.Value.(*plan.Const_I32Val)
+	if !ok {
+		return 0, false
+	}
+	return v.I32Val, true
+}
+
+func (p *partitionPruner) replaceColumns(expr *Expr, values map[int32]*Expr) (*Expr, bool) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		v, ok := values[exprImpl.Col.ColPos]
+		if !ok {
+			return nil, false
+		}
+		c := DeepCopyExpr(v)
+		if c.Typ.Id != expr.Typ.Id {
+			var err error
+			if c, err = appendCastBeforeExpr(p.proc.Ctx, c, DeepCopyType(expr.Typ)); err != nil {
+				return nil, false
+			}
+		}
+		return c, true
+	case *plan.Expr_F:
+		for i, arg := range exprImpl.F.Args {
+			newArg, ok := p.replaceColumns(arg, values)
+			if !ok {
+				return nil, false
+			}
+			exprImpl.F.Args[i] = newArg
+		}
+	}
+	return expr, true
+}
+
+// unwrapTemporalCast removes the cast between the date, datetime and timestamp, which keeps the order.
+func unwrapTemporalCast(expr *Expr) *Expr {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok || f.F.Func.ObjName != "cast" || len(f.F.Args) != 2 {
+		return expr
+	}
+	isTemporal := func(typ *plan.Type) bool {
+		switch types.T(typ.Id) {
+		case types.T_date, types.T_datetime, types.T_timestamp:
+			return true
+		}
+		return false
+	}
+	if isTemporal(expr.Typ) && isTemporal(f.F.Args[0].Typ) {
+		return f.F.Args[0]
+	}
+	return expr
+}
+
+func getPartitionColumns(expr *Expr, cols map[int32]bool) {
+	switch exprImpl := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		cols[exprImpl.Col.ColPos] = true
+	case *plan.Expr_F:
+		for _, arg := range exprImpl.F.Args {
+			getPartitionColumns(arg, cols)
+		}
+	}
+}
+
+// intersectPartitions intersects the selected partitions, nil means all the partitions.
+func intersectPartitions(a, b []bool) []bool {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	for i := range a {
+		a[i] = a[i] && b[i]
+	}
+	return a
+}
+
+func selectPartitionRange(num, start, end int) []bool {
+	selected := make([]bool, num)
+	for i := start; i <= end && i < num; i++ {
+		selected[i] = true
+	}
+	return selected
+}
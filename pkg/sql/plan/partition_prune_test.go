@@ -0,0 +1,88 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionPrune(t *testing.T) {
+	mock := NewMockOptimizer(false)
+	mockPartitionTables(t, mock, map[string]string{
+		"pt_range": "create table pt_range (a int, d date) partition by range (year(d)) (partition p0 values less than (2020), partition p1 values less than (2022), partition p2 values less than maxvalue)",
+		"pt_days":  "create table pt_days (a int, d date) partition by range (to_days(d)) (partition p0 values less than (to_days('2023-01-01')), partition p1 values less than (to_days('2023-07-01')))",
+		"pt_list":  "create table pt_list (a int, b varchar(10)) partition by list (a) (partition p0 values in (1, 2), partition p1 values in (3, 4), partition p2 values in (5, 6))",
+		"pt_hash":  "create table pt_hash (a int, b varchar(10)) partition by hash (a) partitions 4",
+		"pt_cols":  "create table pt_cols (a int, b varchar(10)) partition by range columns (a, b) (partition p0 values less than (10, 'a'), partition p1 values less than (maxvalue, maxvalue))",
+	})
+
+	// nil means the partitions are not pruned
+	sqls := map[string][]string{
+		"select * from pt_range where d = '2021-03-01'":                            {"p1"},
+		"select * from pt_range where d >= '2021-03-01'":                           {"p1", "p2"},
+		"select * from pt_range where '2021-03-01' > d":                            {"p0", "p1"},
+		"select * from pt_range where d between '2020-01-01' and '2021-12-31'":     {"p1"},
+		"select * from pt_range where d < '2019-03-01' or d > '2023-01-01'":        {"p0", "p2"},
+		"select * from pt_range where a = 1":                                       nil,
+		"select * from pt_range where d < '2025-01-01'":                            nil,
+		"select * from pt_days where d > '2024-01-01'":                             {},
+		"select * from pt_days where d <= '2022-06-01'":                            {"p0"},
+		"select * from pt_list where a in (1, 2)":                                  {"p0"},
+		"select * from pt_list where a = 3 or a = 6":                               {"p1", "p2"},
+		"select * from pt_list where a = 7":                                        {},
+		"select * from pt_list where a = 3 or b = 'x'":                             nil,
+		"select * from pt_list where a > 3":                                        nil,
+		"select * from pt_hash where a = 7 and b = 'x'":                            {"p2"},
+		"select * from pt_cols where a = 3 and b = 'b'":                            {"p0"},
+		"select * from pt_cols where a = 3":                                        nil,
+		"select * from pt_range r join pt_list l on r.a = l.a where l.a in (5, 6)": {"p2"},
+	}
+	for sql, expected := range sqls {
+		logicPlan, err := runOneStmt(mock, t, sql)
+		require.NoError(t, err, sql)
+
+		var prune *plan.PartitionPrune
+		for _, node := range logicPlan.GetQuery().GetNodes() {
+			if node.NodeType == plan.Node_TABLE_SCAN && node.PartitionPrune != nil {
+				prune = node.PartitionPrune
+			}
+		}
+		if expected == nil {
+			require.Nil(t, prune, sql)
+			continue
+		}
+		require.NotNil(t, prune, sql)
+		require.True(t, prune.IsPruned, sql)
+		names := make([]string, 0, len(prune.SelectedPartitions))
+		for _, partition := range prune.SelectedPartitions {
+			names = append(names, partition.PartitionName)
+			require.NotEmpty(t, partition.PartitionTableName)
+		}
+		require.Equal(t, expected, names, sql)
+	}
+}
+
+func mockPartitionTables(t *testing.T, opt *MockOptimizer, tables map[string]string) {
+	ctx := opt.CurrentContext().(*MockCompilerContext)
+	for name, sql := range tables {
+		logicPlan, err := runOneStmt(opt, t, sql)
+		require.NoError(t, err, sql)
+		ctx.tables[name] = logicPlan.GetDdl().GetCreateTable().GetTableDef()
+		ctx.objects[name] = &ObjectRef{SchemaName: "tpch", ObjName: name}
+	}
+}
@@ -105,6 +105,9 @@ func (b *ProjectionBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr,
 		return nil, err
 	}
 	w.Name = funcName
+	if err = b.foldWinFuncConstArg(funcName, w.WindowFunc.GetF()); err != nil {
+		return nil, err
+	}
 	// partition by
 	for _, group := range ws.PartitionBy {
 		expr, err := b.BindExpr(group, depth, isRoot)
@@ -265,6 +268,56 @@ func (b *ProjectionBinder) makeFrameConstValue(expr tree.Expr, typ *plan.Type) (
 	}, nil
 }
 
+// foldWinFuncConstArg folds the N of lag, lead, nth_value and ntile into an
+// int64 constant. As in MySQL, N must be a constant non-negative integer, and
+// it must be positive for nth_value and ntile.
+func (b *ProjectionBinder) foldWinFuncConstArg(funcName string, f *plan.Function) error {
+	var pos int
+	var positive bool
+	switch funcName {
+	case "lag", "lead":
+		pos = 1
+	case "nth_value":
+		pos, positive = 1, true
+	case "ntile":
+		pos, positive = 0, true
+	default:
+		return nil
+	}
+	if f == nil || len(f.Args) <= pos {
+		return nil
+	}
+	e := f.Args[pos]
+	if !rule.IsConstant(e, false) {
+		return moerr.NewInvalidArg(b.GetContext(), funcName, "non-constant expression")
+	}
+	executor, err := colexec.NewExpressionExecutor(b.builder.compCtx.GetProcess(), e)
+	if err != nil {
+		return err
+	}
+	defer executor.Free()
+	vec, err := executor.Eval(b.builder.compCtx.GetProcess(), []*batch.Batch{batch.EmptyForConstFoldBatch})
+	if err != nil {
+		return err
+	}
+	c := rule.GetConstantValue(vec, false, 0)
+	if c.Isnull {
+		return moerr.NewInvalidArg(b.GetContext(), funcName, "NULL")
+	}
+	// N has been cast to int64 by the type check of the function, anything else
+	// would be read as 0 here.
+	v, ok := c.Value.(*plan.Const_I64Val)
+	if !ok {
+		return moerr.NewInvalidArg(b.GetContext(), funcName, "non-integer expression")
+	}
+	n := v.I64Val
+	if n < 0 || (positive && n == 0) {
+		return moerr.NewInvalidArg(b.GetContext(), funcName, n)
+	}
+	f.Args[pos] = makePlan2Int64ConstExprWithType(n)
+	return nil
+}
+
 func (b *ProjectionBinder) resetInterval(e *Expr) (*Expr, error) {
 	e1 := e.Expr.(*plan.Expr_List).List.List[0]
 	e2 := e.Expr.(*plan.Expr_List).List.List[1]
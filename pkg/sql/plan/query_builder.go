@@ -280,6 +280,7 @@ func (builder *QueryBuilder) remapAllColRefs(nodeID int32, step int32, colRefCnt
 			Fkeys:          node.TableDef.Fkeys,
 			RefChildTbls:   node.TableDef.RefChildTbls,
 			Checks:         node.TableDef.Checks,
+			Triggers:       node.TableDef.Triggers,
 			Partition:      node.TableDef.Partition,
 			ClusterBy:      node.TableDef.ClusterBy,
 			Props:          node.TableDef.Props,
@@ -1222,6 +1223,7 @@ func (builder *QueryBuilder) createQuery() (*Query, error) {
 		if err != nil {
 			return nil, err
 		}
+		builder.partitionPrune(rootID)
 
 		builder.removeSimpleProjections(rootID, plan.Node_UNKNOWN, false, make(map[[2]int32]int))
 
@@ -1632,7 +1634,11 @@ func (builder *QueryBuilder) buildUnion(stmt *tree.UnionClause, astOrderBy tree.
 	return lastNodeID, nil
 }
 
-const NameGroupConcat = "group_concat"
+const (
+	NameGroupConcat   = "group_concat"
+	NameJsonArrayAgg  = "json_arrayagg"
+	NameJsonObjectAgg = "json_objectagg"
+)
 
 func (bc *BindContext) generateForceWinSpecList() ([]*plan.Expr, error) {
 	windowsSpecList := make([]*plan.Expr, 0, len(bc.aggregates))
@@ -1932,7 +1938,15 @@ func (builder *QueryBuilder) buildSelect(stmt *tree.Select, ctx *BindContext, is
 		}
 	} else {
 		// build FROM clause
-		nodeID, err = builder.buildFrom(clause.From.Tables, ctx)
+		fromTables, err := builder.rewriteFullTextMatch(clause, astOrderBy, ctx)
+		if err != nil {
+			return 0, err
+		}
+		fromTables, err = builder.rewriteIvfFlatSearch(clause, fromTables, astOrderBy, astLimit, ctx)
+		if err != nil {
+			return 0, err
+		}
+		nodeID, err = builder.buildFrom(fromTables, ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -2885,7 +2899,11 @@ func (builder *QueryBuilder) buildTable(stmt tree.TableExpr, ctx *BindContext, p
 			}
 		}
 
-		nodeID, err = builder.buildTable(tbl.Expr, ctx, preNodeId, leftCtx)
+		if tbl.Lateral && preNodeId != -1 {
+			nodeID, err = builder.buildLateralTable(tbl, ctx, leftCtx)
+		} else {
+			nodeID, err = builder.buildTable(tbl.Expr, ctx, preNodeId, leftCtx)
+		}
 		if err != nil {
 			return
 		}
@@ -3119,6 +3137,11 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 		joinType = plan.Node_OUTER
 	}
 
+	lateral := isLateralTable(tbl.Right)
+	if lateral && (joinType == plan.Node_RIGHT || joinType == plan.Node_OUTER) {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "LATERAL derived table can not be used in %s JOIN", tbl.JoinType)
+	}
+
 	leftCtx := NewBindContext(builder, ctx)
 	rightCtx := NewBindContext(builder, ctx)
 
@@ -3152,11 +3175,14 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 	}, ctx)
 	node := builder.qry.Nodes[nodeID]
 
-	ctx.binder = NewTableBinder(builder, ctx)
+	binder := NewTableBinder(builder, ctx)
+	ctx.binder = binder
 
 	switch cond := tbl.Cond.(type) {
 	case *tree.OnJoinCond:
+		binder.joinCond = true
 		joinConds, err := splitAndBindCondition(cond.Expr, NoAlias, ctx)
+		binder.joinCond = false
 		if err != nil {
 			return 0, err
 		}
@@ -3205,7 +3231,10 @@ func (builder *QueryBuilder) buildJoinTable(tbl *tree.JoinTableExpr, ctx *BindCo
 		}
 	}
 
-	return nodeID, nil
+	if lateral {
+		return builder.buildLateralJoin(nodeID, ctx)
+	}
+	return builder.flattenJoinConditions(nodeID, ctx)
 }
 
 func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *BindContext, preNodeId int32, leftCtx *BindContext) (int32, error) {
@@ -3248,6 +3277,12 @@ func (builder *QueryBuilder) buildTableFunction(tbl *tree.TableFunction, ctx *Bi
 		nodeId = builder.buildMetadataScan(tbl, ctx, exprs, childId)
 	case "processlist":
 		nodeId, err = builder.buildProcesslist(tbl, ctx, exprs, childId)
+	case "json_table":
+		nodeId, err = builder.buildJsonTable(tbl, ctx, exprs, childId)
+	case fulltextIndexTokenize:
+		nodeId, err = builder.buildFullTextIndexTokenize(tbl, ctx, exprs, childId)
+	case tableChanges:
+		nodeId, err = builder.buildTableChanges(tbl, ctx, exprs, childId)
 	default:
 		err = moerr.NewNotSupported(builder.GetContext(), "table function '%s' not supported", id)
 	}
@@ -469,7 +469,7 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 		}
 
 		switch node.JoinType {
-		case plan.Node_INNER:
+		case plan.Node_INNER, plan.Node_APPLY:
 			outcnt := leftStats.Outcnt * rightStats.Outcnt / ndv
 			if !isCrossJoin {
 				outcnt *= selectivity
@@ -484,7 +484,7 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 				Selectivity:  selectivity_out,
 			}
 
-		case plan.Node_LEFT:
+		case plan.Node_LEFT, plan.Node_OUTER_APPLY:
 			outcnt := leftStats.Outcnt
 			node.Stats = &plan.Stats{
 				Outcnt:       outcnt,
@@ -47,5 +47,8 @@ func (b *TableBinder) BindWinFunc(funcName string, astExpr *tree.FuncExpr, depth
 }
 
 func (b *TableBinder) BindSubquery(astExpr *tree.Subquery, isRoot bool) (*plan.Expr, error) {
+	if b.joinCond {
+		return b.baseBindSubquery(astExpr, isRoot)
+	}
 	return nil, moerr.NewNYI(b.GetContext(), "subquery in JOIN condition")
 }
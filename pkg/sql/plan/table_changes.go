@@ -0,0 +1,113 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"encoding/json"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const tableChanges = "mo_table_changes"
+
+// TableChangesParam is the param of the mo_table_changes function scan.
+type TableChangesParam struct {
+	Database string `json:"database"`
+	Table    string `json:"table"`
+}
+
+// buildTableChanges builds mo_table_changes(db, table, from_ts, to_ts), which returns the rows
+// of the table inserted and deleted after from_ts and before or at to_ts, with the columns of
+// the table and TableChangesSignColName. It is only used to refresh the materialized views.
+func (builder *QueryBuilder) buildTableChanges(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	if builder.GetContext().Value(defines.MViewRefreshKey{}) == nil {
+		return 0, moerr.NewNotSupported(builder.GetContext(), "table function '%s' is only used to refresh materialized views", tableChanges)
+	}
+	if len(exprs) != 4 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "%s: invalid arguments", tableChanges)
+	}
+	dbName, ok1 := getConstStringValue(exprs[0])
+	tblName, ok2 := getConstStringValue(exprs[1])
+	if !ok1 || !ok2 {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "%s: the database and the table must be constant strings", tableChanges)
+	}
+	for _, expr := range exprs[2:] {
+		if !isFullTextIndexType(expr.Typ) {
+			return 0, moerr.NewInvalidInput(builder.GetContext(), "%s: the timestamps must be strings, but got %s", tableChanges, types.T(expr.Typ.Id).String())
+		}
+	}
+	_, tableDef := builder.compCtx.Resolve(dbName, tblName)
+	if tableDef == nil {
+		return 0, moerr.NewNoSuchTable(builder.GetContext(), dbName, tblName)
+	}
+	if tableDef.TableType != catalog.SystemOrdinaryRel {
+		return 0, moerr.NewInvalidInput(builder.GetContext(), "%s: '%s.%s' is not a base table", tableChanges, dbName, tblName)
+	}
+
+	data, err := json.Marshal(&TableChangesParam{Database: dbName, Table: tblName})
+	if err != nil {
+		return 0, err
+	}
+	cols := make([]*plan.ColDef, 0, len(tableDef.Cols)+1)
+	for _, col := range tableDef.Cols {
+		if col.Hidden {
+			continue
+		}
+		cols = append(cols, &plan.ColDef{
+			Name: col.Name,
+			Alg:  plan.CompressType_Lz4,
+			Typ: &plan.Type{
+				Id:          col.Typ.Id,
+				Width:       col.Typ.Width,
+				Scale:       col.Typ.Scale,
+				NotNullable: col.Typ.NotNullable,
+			},
+			NotNull: col.NotNull,
+			Default: &plan.Default{NullAbility: !col.Typ.NotNullable},
+		})
+	}
+	cols = append(cols, &plan.ColDef{
+		Name: catalog.TableChangesSignColName,
+		Alg:  plan.CompressType_Lz4,
+		Typ: &plan.Type{
+			Id:          int32(types.T_int8),
+			NotNullable: true,
+		},
+		NotNull: true,
+		Default: &plan.Default{},
+	})
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name:  tableChanges,
+				Param: data,
+			},
+			Cols: cols,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		TblFuncExprList: exprs[2:],
+		Children:        []int32{childId},
+	}
+	return builder.appendNode(node, ctx), nil
+}
@@ -65,6 +65,8 @@ type ClusterByDef = plan.ClusterByDef
 type OrderBySpec = plan.OrderBySpec
 type FkColName = plan.FkColName
 type ForeignKeyDef = plan.ForeignKeyDef
+type CheckDef = plan.CheckDef
+type TriggerDef = plan.TriggerDef
 type ClusterTable = plan.ClusterTable
 type PrimaryKeyDef = plan.PrimaryKeyDef
 type IndexDef = plan.IndexDef
@@ -239,6 +241,13 @@ type BindContext struct {
 	defaultDatabase string
 
 	forceWindows bool
+
+	// the score column of MATCH ... AGAINST, keyed by the expr string
+	fullTextMatches map[string]tree.Expr
+
+	// the tables joined into the FROM clause by the planner itself, which are
+	// not visible to the unqualified *
+	hiddenTables map[string]bool
 }
 
 type NameTuple struct {
@@ -285,6 +294,9 @@ type UpdateBinder struct {
 
 type TableBinder struct {
 	baseBinder
+	// joinCond is set while binding the ON condition of a join, where
+	// subqueries are allowed.
+	joinCond bool
 }
 
 type WhereBinder struct {
@@ -318,6 +330,12 @@ type PartitionBinder struct {
 	baseBinder
 }
 
+type CheckBinder struct {
+	baseBinder
+	name   string
+	column string
+}
+
 // SetBinder for 'set @var = expr'
 type SetBinder struct {
 	baseBinder
@@ -1325,7 +1325,7 @@ func InitInfileParam(param *tree.ExternParam) error {
 			param.CompressType = param.Option[i+1]
 		case "format":
 			format := strings.ToLower(param.Option[i+1])
-			if format != tree.CSV && format != tree.JSONLINE {
+			if !isSupportedExternFormat(format) {
 				return moerr.NewBadConfig(param.Ctx, "the format '%s' is not supported", format)
 			}
 			param.Format = format
@@ -1343,6 +1343,9 @@ func InitInfileParam(param *tree.ExternParam) error {
 	if len(param.Filepath) == 0 {
 		return moerr.NewBadConfig(param.Ctx, "the filepath must be specified")
 	}
+	if len(param.Format) != 0 && !isSupportedExternFormat(param.Format) {
+		return moerr.NewBadConfig(param.Ctx, "the format '%s' is not supported", param.Format)
+	}
 	if param.Format == tree.JSONLINE && len(param.JsonData) == 0 {
 		return moerr.NewBadConfig(param.Ctx, "the jsondata must be specified")
 	}
@@ -1352,6 +1355,10 @@ func InitInfileParam(param *tree.ExternParam) error {
 	return nil
 }
 
+func isSupportedExternFormat(format string) bool {
+	return format == tree.CSV || format == tree.JSONLINE || format == tree.PARQUET
+}
+
 func InitS3Param(param *tree.ExternParam) error {
 	param.S3Param = &tree.S3Parameter{}
 	for i := 0; i < len(param.Option); i += 2 {
@@ -1378,7 +1385,7 @@ func InitS3Param(param *tree.ExternParam) error {
 			param.S3Param.ExternalId = param.Option[i+1]
 		case "format":
 			format := strings.ToLower(param.Option[i+1])
-			if format != tree.CSV && format != tree.JSONLINE {
+			if !isSupportedExternFormat(format) {
 				return moerr.NewBadConfig(param.Ctx, "the format '%s' is not supported", format)
 			}
 			param.Format = format
@@ -167,6 +167,18 @@ func SetBytesToAnyVector(ctx context.Context, val string, row int,
 		return vector.SetBytesAt(vec, row, []byte(val), proc.Mp())
 	case types.T_json:
 		return vector.SetBytesAt(vec, row, []byte(val), proc.Mp())
+	case types.T_array_float32:
+		v, err := stringToArrayBytes[float32](ctx, val, vec.GetType())
+		if err != nil {
+			return err
+		}
+		return vector.SetBytesAt(vec, row, v, proc.Mp())
+	case types.T_array_float64:
+		v, err := stringToArrayBytes[float64](ctx, val, vec.GetType())
+		if err != nil {
+			return err
+		}
+		return vector.SetBytesAt(vec, row, v, proc.Mp())
 	case types.T_time:
 		v, err := types.ParseTime(val, vec.GetType().Scale)
 		if err != nil {
@@ -228,6 +240,10 @@ func SetInsertValue(proc *process.Process, numVal *tree.NumVal, vec *vector.Vect
 		return setInsertValueString(proc, numVal, vec)
 	case types.T_json:
 		return setInsertValueJSON(proc, numVal, vec)
+	case types.T_array_float32:
+		return setInsertValueArray[float32](proc, numVal, vec)
+	case types.T_array_float64:
+		return setInsertValueArray[float64](proc, numVal, vec)
 	case types.T_uuid:
 		return setInsertValueUuid(proc, numVal, vec)
 	case types.T_time:
@@ -677,6 +693,36 @@ func setInsertValueJSON(proc *process.Process, numVal *tree.NumVal, vec *vector.
 	return
 }
 
+func setInsertValueArray[T types.RealNumbers](proc *process.Process, numVal *tree.NumVal, vec *vector.Vector) (canInsert bool, err error) {
+	canInsert = true
+	switch numVal.ValType {
+	case tree.P_null:
+		err = vector.AppendBytes(vec, nil, true, proc.Mp())
+	case tree.P_char:
+		var val []byte
+		val, err = stringToArrayBytes[T](proc.Ctx, numVal.OrigString(), vec.GetType())
+		if err != nil {
+			return false, err
+		}
+		err = vector.AppendBytes(vec, val, false, proc.Mp())
+	default:
+		canInsert = false
+	}
+	return
+}
+
+// stringToArrayBytes parses the text format of a vector, and checks its dimension.
+func stringToArrayBytes[T types.RealNumbers](ctx context.Context, val string, typ *types.Type) ([]byte, error) {
+	arr, err := types.StringToArray[T](val)
+	if err != nil {
+		return nil, err
+	}
+	if int32(len(arr)) != typ.Width {
+		return nil, moerr.NewInvalidInput(ctx, "expected vector dimension %d != actual dimension %d", typ.Width, len(arr))
+	}
+	return types.ArrayToBytes[T](arr), nil
+}
+
 func checkOverFlow[T1, T2 constraints.Integer | constraints.Float](ctx context.Context, typ *types.Type, val T1, n *nulls.Nulls) error {
 	if typ.Scale >= 0 && typ.Width > 0 {
 		var max_value float64
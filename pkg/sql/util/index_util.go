@@ -32,19 +32,28 @@ var CompactSingleIndexCol = compactSingleIndexCol
 var CompactPrimaryCol = compactPrimaryCol
 
 func BuildIndexTableName(ctx context.Context, unique bool) (string, error) {
-	var name string
-	name = catalog.PrefixIndexTableName
 	if unique {
-		name += "unique_"
-	} else {
-		name += "secondary_"
+		return buildIndexTableName(ctx, "unique_")
 	}
+	return buildIndexTableName(ctx, "secondary_")
+}
+
+func BuildFullTextIndexTableName(ctx context.Context) (string, error) {
+	return buildIndexTableName(ctx, "fulltext_")
+}
+
+// BuildIvfFlatIndexTableName returns the name of a hidden table of an ivfflat index, the
+// tableType is catalog.IvfFlatIndexCentroidsType or catalog.IvfFlatIndexEntriesType.
+func BuildIvfFlatIndexTableName(ctx context.Context, tableType string) (string, error) {
+	return buildIndexTableName(ctx, "ivfflat_"+tableType+"_")
+}
+
+func buildIndexTableName(ctx context.Context, kind string) (string, error) {
 	id, err := uuid.NewUUID()
 	if err != nil {
 		return "", moerr.NewInternalError(ctx, "newuuid failed")
 	}
-	name += id.String()
-	return name, nil
+	return catalog.PrefixIndexTableName + kind + id.String(), nil
 }
 
 func BuildUniqueKeyBatch(vecs []*vector.Vector, attrs []string, parts []string, originTablePrimaryKey string, proc *process.Process) (*batch.Batch, int) {
@@ -16,13 +16,14 @@ package util
 
 import (
 	"context"
-	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
+	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan/function"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 
@@ -53,6 +54,14 @@ func TestBuildIndexTableName(t *testing.T) {
 		require.Equal(t, indexTableName[:len(test.indexTableName)], test.indexTableName)
 		require.Equal(t, err, nil)
 	}
+
+	indexTableName, err := BuildFullTextIndexTableName(context.TODO())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(indexTableName, catalog.PrefixIndexTableName+"fulltext_"))
+
+	indexTableName, err = BuildIvfFlatIndexTableName(context.TODO(), catalog.IvfFlatIndexCentroidsType)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(indexTableName, catalog.PrefixIndexTableName+"ivfflat_centroids_"))
 }
 
 func TestBuildUniqueKeyBatch(t *testing.T) {
@@ -210,9 +210,12 @@ func BuildMoColumnsFilter(curAccountId uint64) tree.Expr {
 	mo_pubs := tree.NewNumValWithType(constant.MakeString("mo_pubs"), "mo_pubs", false, tree.P_char)
 	mo_stored_procedure := tree.NewNumValWithType(constant.MakeString("mo_stored_procedure"), "mo_stored_procedure", false, tree.P_char)
 	mo_stages := tree.NewNumValWithType(constant.MakeString("mo_stages"), "mo_stages", false, tree.P_char)
+	mo_event_history := tree.NewNumValWithType(constant.MakeString("mo_event_history"), "mo_event_history", false, tree.P_char)
+	mo_triggers := tree.NewNumValWithType(constant.MakeString("mo_triggers"), "mo_triggers", false, tree.P_char)
+	mo_mviews := tree.NewNumValWithType(constant.MakeString("mo_mviews"), "mo_mviews", false, tree.P_char)
 
 	notInValues := tree.NewTuple(tree.Exprs{mo_userConst, mo_roleConst, mo_user_grantConst, mo_role_grantConst, mo_role_privsConst,
-		mo_user_defined_functionConst, mo_mysql_compatibility_modeConst, mo_indexes, mo_table_partitions, mo_pubs, mo_stored_procedure, mo_stages})
+		mo_user_defined_functionConst, mo_mysql_compatibility_modeConst, mo_indexes, mo_table_partitions, mo_pubs, mo_stored_procedure, mo_stages, mo_event_history, mo_triggers, mo_mviews})
 
 	notInexpr := tree.NewComparisonExpr(tree.NOT_IN, att_relnameColName, notInValues)
 
@@ -320,3 +323,9 @@ func SplitNameOfPartitionTable(name string) (bool, string, string) {
 	tableName := left[tableNameIdx:]
 	return true, partName, tableName
 }
+
+// EscapeSqlString escapes the string to be written inside the single quotes of a sql literal.
+func EscapeSqlString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, "'", "''")
+}
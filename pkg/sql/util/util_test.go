@@ -74,3 +74,9 @@ func Test_SplitNameOfPartitionTable(t *testing.T) {
 		}
 	}
 }
+
+func Test_EscapeSqlString(t *testing.T) {
+	require.Equal(t, "abc", EscapeSqlString("abc"))
+	require.Equal(t, "it''s", EscapeSqlString("it's"))
+	require.Equal(t, `a\\''b`, EscapeSqlString(`a\'b`))
+}
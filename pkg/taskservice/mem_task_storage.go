@@ -195,6 +195,19 @@ func (s *memTaskStorage) UpdateCronTask(ctx context.Context, cron task.CronTask,
 	return 2, nil
 }
 
+func (s *memTaskStorage) DeleteCronTask(ctx context.Context, taskMetadataID string) (int, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	id, ok := s.cronTaskIndexes[taskMetadataID]
+	if !ok {
+		return 0, nil
+	}
+	delete(s.cronTasks, id)
+	delete(s.cronTaskIndexes, taskMetadataID)
+	return 1, nil
+}
+
 func (s *memTaskStorage) nextIDLocked() uint64 {
 	s.id++
 	return s.id
@@ -113,6 +113,8 @@ var (
 	getTriggerTimes = `select trigger_times from %s.sys_cron_task where task_metadata_id=?`
 
 	deleteTask = `delete from %s.sys_async_task where `
+
+	deleteCronTask = `delete from %s.sys_cron_task where task_metadata_id=?`
 )
 
 var (
@@ -364,6 +366,38 @@ func (m *mysqlTaskStorage) Delete(ctx context.Context, condition ...Condition) (
 	return int(affected), nil
 }
 
+func (m *mysqlTaskStorage) DeleteCronTask(ctx context.Context, taskMetadataID string) (int, error) {
+	if taskFrameworkDisabled() {
+		return 0, nil
+	}
+
+	db, release, err := m.getDB()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = release()
+	}()
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	exec, err := conn.ExecContext(ctx, fmt.Sprintf(deleteCronTask, m.dbname), taskMetadataID)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := exec.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
+
 func (m *mysqlTaskStorage) Query(ctx context.Context, condition ...Condition) ([]task.Task, error) {
 	if taskFrameworkDisabled() {
 		return nil, nil
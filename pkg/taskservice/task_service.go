@@ -209,6 +209,11 @@ func (s *taskService) QueryCronTask(ctx context.Context) ([]task.CronTask, error
 	return s.store.QueryCronTask(ctx)
 }
 
+func (s *taskService) DeleteCronTask(ctx context.Context, taskMetadataID string) error {
+	_, err := s.store.DeleteCronTask(ctx, taskMetadataID)
+	return err
+}
+
 func (s *taskService) Close() error {
 	s.StopScheduleCronTask()
 	return s.store.Close()
@@ -289,6 +289,23 @@ func (s *refreshableTaskStorage) UpdateCronTask(ctx context.Context, cronTask ta
 	return v, err
 }
 
+func (s *refreshableTaskStorage) DeleteCronTask(ctx context.Context, taskMetadataID string) (int, error) {
+	var v int
+	var err error
+	s.mu.RLock()
+	lastAddress := s.mu.lastAddress
+	if s.mu.store == nil {
+		err = errNotReady
+	} else {
+		v, err = s.mu.store.DeleteCronTask(ctx, taskMetadataID)
+	}
+	s.mu.RUnlock()
+	if err != nil {
+		s.maybeRefresh(lastAddress)
+	}
+	return v, err
+}
+
 func (s *refreshableTaskStorage) maybeRefresh(lastAddress string) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
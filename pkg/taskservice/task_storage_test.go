@@ -177,6 +177,37 @@ func TestAddAndQueryCronTask(t *testing.T) {
 	}
 }
 
+func TestDeleteCronTask(t *testing.T) {
+	for name, factory := range storages {
+		t.Run(name, func(t *testing.T) {
+			s := factory(t)
+			defer func() {
+				assert.NoError(t, s.Close())
+			}()
+
+			ctx, cancel := context.WithTimeout(context.TODO(), time.Second*10)
+			defer cancel()
+
+			v1 := newTestCronTask("t1", "cron1")
+			v2 := newTestCronTask("t2", "cron2")
+			mustAddTestCronTask(t, s, 2, v1, v2)
+
+			n, err := s.DeleteCronTask(ctx, "t1")
+			assert.NoError(t, err)
+			assert.Equal(t, 1, n)
+			mustQueryTestCronTask(t, s, 1)
+
+			n, err = s.DeleteCronTask(ctx, "t1")
+			assert.NoError(t, err)
+			assert.Equal(t, 0, n)
+
+			// the deleted cron task can be added again
+			mustAddTestCronTask(t, s, 1, v1)
+			mustQueryTestCronTask(t, s, 2)
+		})
+	}
+}
+
 func TestUpdateCronTask(t *testing.T) {
 	for name, factory := range storages {
 		t.Run(name, func(t *testing.T) {
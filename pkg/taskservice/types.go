@@ -173,6 +173,9 @@ type TaskService interface {
 	QueryTask(context.Context, ...Condition) ([]task.Task, error)
 	// QueryCronTask returns all cron task metadata
 	QueryCronTask(context.Context) ([]task.CronTask, error)
+	// DeleteCronTask delete the cron task by task metadata id, the cron task will no longer
+	// be triggered after the scheduler pulls the latest CronTasks.
+	DeleteCronTask(ctx context.Context, taskMetadataID string) error
 
 	// StartScheduleCronTask start schedule cron tasks. A timer will be started to pull the latest CronTask
 	// from the TaskStore at regular intervals, and a timer will be maintained in memory for all Cron's to be
@@ -231,6 +234,9 @@ type TaskStorage interface {
 	// This update must be transactional and needs to be done conditionally
 	// using CronTask.TriggerTimes and the task.Metadata.ID field.
 	UpdateCronTask(context.Context, task.CronTask, task.Task) (int, error)
+	// DeleteCronTask delete the cron task by task metadata id and returns number of
+	// successful deleted. The tasks already generated by the cron task are kept.
+	DeleteCronTask(ctx context.Context, taskMetadataID string) (int, error)
 }
 
 // TaskServiceHolder create and hold the task service in the cn, dn and log node. Create
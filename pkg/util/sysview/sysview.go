@@ -261,30 +261,30 @@ var (
 			"DESCRIPTION varchar(2048)," +
 			"MAXLEN int unsigned" +
 			");",
-		"CREATE TABLE IF NOT EXISTS TRIGGERS(" +
-			"TRIGGER_CATALOG varchar(64)," +
-			"TRIGGER_SCHEMA varchar(64)," +
-			"TRIGGER_NAME varchar(64)," +
-			"EVENT_MANIPULATION varchar(10)," +
-			"EVENT_OBJECT_CATALOG varchar(64)," +
-			"EVENT_OBJECT_SCHEMA varchar(64)," +
-			"EVENT_OBJECT_TABLE varchar(64)," +
-			"ACTION_ORDER int unsigned," +
-			"ACTION_CONDITION binary(0)," +
-			"ACTION_STATEMENT longtext," +
-			"ACTION_ORIENTATION varchar(3)," +
-			"ACTION_TIMING varchar(10)," +
-			"ACTION_REFERENCE_OLD_TABLE binary(0)," +
-			"ACTION_REFERENCE_NEW_TABLE binary(0)," +
-			"ACTION_REFERENCE_OLD_ROW varchar(3)," +
-			"ACTION_REFERENCE_NEW_ROW varchar(3)," +
-			"CREATED timestamp(2)," +
-			"SQL_MODE varchar(10)," +
-			"DEFINER varchar(288)," +
-			"CHARACTER_SET_CLIENT varchar(64)," +
-			"COLLATION_CONNECTION varchar(64)," +
-			"DATABASE_COLLATION varchar(64)" +
-			");",
+		"CREATE VIEW IF NOT EXISTS TRIGGERS AS " +
+			"SELECT 'def' AS TRIGGER_CATALOG," +
+			"trigger_db AS TRIGGER_SCHEMA," +
+			"trigger_name AS TRIGGER_NAME," +
+			"event_manipulation AS EVENT_MANIPULATION," +
+			"'def' AS EVENT_OBJECT_CATALOG," +
+			"trigger_db AS EVENT_OBJECT_SCHEMA," +
+			"table_name AS EVENT_OBJECT_TABLE," +
+			"action_order AS ACTION_ORDER," +
+			"NULL AS ACTION_CONDITION," +
+			"action_statement AS ACTION_STATEMENT," +
+			"'ROW' AS ACTION_ORIENTATION," +
+			"action_timing AS ACTION_TIMING," +
+			"NULL AS ACTION_REFERENCE_OLD_TABLE," +
+			"NULL AS ACTION_REFERENCE_NEW_TABLE," +
+			"'OLD' AS ACTION_REFERENCE_OLD_ROW," +
+			"'NEW' AS ACTION_REFERENCE_NEW_ROW," +
+			"created_time AS CREATED," +
+			"'' AS SQL_MODE," +
+			"definer AS DEFINER," +
+			"'utf8mb4' AS CHARACTER_SET_CLIENT," +
+			"'utf8mb4_0900_ai_ci' AS COLLATION_CONNECTION," +
+			"'utf8mb4_0900_ai_ci' AS DATABASE_COLLATION " +
+			"FROM mo_catalog.mo_triggers",
 
 		fmt.Sprintf("CREATE VIEW IF NOT EXISTS TABLES AS "+
 			"SELECT 'def' AS TABLE_CATALOG,"+
@@ -370,6 +370,17 @@ var (
 			"FROM mo_catalog.mo_tables tbl LEFT JOIN mo_catalog.mo_user usr ON tbl.creator = usr.user_id " +
 			"WHERE tbl.relkind = 'v' and tbl.reldatabase != 'information_schema'",
 
+		"CREATE VIEW IF NOT EXISTS `EVENTS_HISTORY` AS " +
+			"SELECT 'def' AS `EVENT_CATALOG`," +
+			"`event_db` AS `EVENT_SCHEMA`," +
+			"`event_name` AS `EVENT_NAME`," +
+			"`start_time` AS `START_TIME`," +
+			"`end_time` AS `END_TIME`," +
+			"`duration` AS `DURATION`," +
+			"`status` AS `STATUS`," +
+			"`error` AS `ERROR` " +
+			"FROM `mo_catalog`.`mo_event_history`",
+
 		"CREATE TABLE IF NOT EXISTS ENGINES (" +
 			"ENGINE varchar(64)," +
 			"SUPPORT varchar(8)," +
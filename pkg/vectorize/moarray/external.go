@@ -0,0 +1,98 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moarray
+
+import (
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+func errDimensionMismatch(dim1, dim2 int) error {
+	return moerr.NewInvalidInputNoCtx("vector dimensions should be the same, got %d and %d", dim1, dim2)
+}
+
+// The distances are always computed in float64, even for vecf32, to keep the
+// precision of the sums.
+
+func L2Distance[T types.RealNumbers](v1, v2 []T) (float64, error) {
+	if len(v1) != len(v2) {
+		return 0, errDimensionMismatch(len(v1), len(v2))
+	}
+	var sum float64
+	for i := range v1 {
+		diff := float64(v1[i]) - float64(v2[i])
+		sum += diff * diff
+	}
+	return math.Sqrt(sum), nil
+}
+
+func InnerProduct[T types.RealNumbers](v1, v2 []T) (float64, error) {
+	if len(v1) != len(v2) {
+		return 0, errDimensionMismatch(len(v1), len(v2))
+	}
+	var sum float64
+	for i := range v1 {
+		sum += float64(v1[i]) * float64(v2[i])
+	}
+	return sum, nil
+}
+
+func CosineDistance[T types.RealNumbers](v1, v2 []T) (float64, error) {
+	if len(v1) != len(v2) {
+		return 0, errDimensionMismatch(len(v1), len(v2))
+	}
+	var dot, norm1, norm2 float64
+	for i := range v1 {
+		a, b := float64(v1[i]), float64(v2[i])
+		dot += a * b
+		norm1 += a * a
+		norm2 += b * b
+	}
+	if norm1 == 0 || norm2 == 0 {
+		return 0, moerr.NewInvalidArgNoCtx("cosine_distance", "zero vector")
+	}
+	similarity := dot / math.Sqrt(norm1*norm2)
+	// clamp the rounding errors, so that the distance is always in [0, 2]
+	if similarity > 1 {
+		similarity = 1
+	} else if similarity < -1 {
+		similarity = -1
+	}
+	return 1 - similarity, nil
+}
+
+func L2Norm[T types.RealNumbers](v []T) float64 {
+	var sum float64
+	for i := range v {
+		sum += float64(v[i]) * float64(v[i])
+	}
+	return math.Sqrt(sum)
+}
+
+// NormalizeL2 returns the unit vector of v, the zero vector is returned as it is.
+func NormalizeL2[T types.RealNumbers](v []T) []T {
+	res := make([]T, len(v))
+	norm := L2Norm(v)
+	if norm == 0 {
+		copy(res, v)
+		return res
+	}
+	for i := range v {
+		res[i] = T(float64(v[i]) / norm)
+	}
+	return res
+}
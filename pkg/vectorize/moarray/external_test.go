@@ -0,0 +1,51 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moarray
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistances(t *testing.T) {
+	d, err := L2Distance([]float32{1, 2, 3}, []float32{4, 6, 3})
+	require.NoError(t, err)
+	require.Equal(t, float64(5), d)
+
+	d, err = InnerProduct([]float64{1, 2, 3}, []float64{4, 5, 6})
+	require.NoError(t, err)
+	require.Equal(t, float64(32), d)
+
+	d, err = CosineDistance([]float64{1, 0}, []float64{0, 2})
+	require.NoError(t, err)
+	require.Equal(t, float64(1), d)
+	d, err = CosineDistance([]float32{1, 1}, []float32{2, 2})
+	require.NoError(t, err)
+	require.InDelta(t, 0, d, 1e-12)
+
+	_, err = CosineDistance([]float32{0, 0}, []float32{1, 1})
+	require.Error(t, err)
+	_, err = L2Distance([]float32{1}, []float32{1, 2})
+	require.Error(t, err)
+	_, err = InnerProduct([]float32{1}, []float32{1, 2})
+	require.Error(t, err)
+}
+
+func TestNormalizeL2(t *testing.T) {
+	require.Equal(t, []float64{0.6, 0.8}, NormalizeL2([]float64{3, 4}))
+	require.Equal(t, []float32{0, 0}, NormalizeL2([]float32{0, 0}))
+	require.Equal(t, float64(5), L2Norm([]float32{3, 4}))
+}
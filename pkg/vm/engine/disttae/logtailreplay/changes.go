@@ -0,0 +1,140 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logtailreplay
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+)
+
+// RowChange is a row inserted or deleted in a time range
+type RowChange struct {
+	RowID   types.Rowid
+	Time    types.TS
+	Deleted bool
+
+	// Batch and Offset locate the values of the row in the memory. Batch is nil if
+	// the deleted row has been flushed, and the values are in the block of Location.
+	Batch    *batch.Batch
+	Offset   int64
+	Location objectio.Location
+}
+
+// CollectRowChanges returns the rows inserted and deleted in (from, to]. The rows
+// inserted and then deleted in the range are not returned, so the deleted rows are
+// the rows visible at from, and the inserted rows are the rows visible at to.
+// It returns false if some changes in the range are not kept in the memory, because
+// they have been flushed and truncated, the blocks have been written or compacted in
+// the range, or the partition doesn't retain the data.
+func (p *PartitionState) CollectRowChanges(from, to types.TS) ([]RowChange, bool) {
+	if p.noData {
+		return nil, false
+	}
+	p.shared.Lock()
+	lastTruncateTimestamp := p.shared.lastTruncateTimestamp
+	p.shared.Unlock()
+	if from.Less(lastTruncateTimestamp) {
+		return nil, false
+	}
+
+	// the rows of the non-appendable blocks created in the range are not in the rows,
+	// and the blocks may be written by CN directly or compacted from the other blocks
+	blocks := p.blocks.Copy().Iter()
+	for ok := blocks.First(); ok; ok = blocks.Next() {
+		block := blocks.Item()
+		inRange := func(ts types.TS) bool {
+			return ts.Greater(from) && ts.LessEq(to)
+		}
+		if (!block.EntryState && inRange(block.CreateTime)) || inRange(block.DeleteTime) {
+			blocks.Release()
+			return nil, false
+		}
+	}
+	blocks.Release()
+
+	var changes []RowChange
+	iter := p.rows.Copy().Iter()
+	defer iter.Release()
+
+	// the versions of a row are iterated from the newest to the oldest
+	var (
+		rowID    types.Rowid
+		inserted *RowEntry
+		deleted  *RowEntry
+		origin   *RowEntry
+	)
+	flush := func() bool {
+		switch {
+		case inserted != nil && deleted != nil:
+		case inserted != nil:
+			changes = append(changes, RowChange{
+				RowID:  inserted.RowID,
+				Time:   inserted.Time,
+				Batch:  inserted.Batch,
+				Offset: inserted.Offset,
+			})
+		case deleted != nil:
+			change := RowChange{
+				RowID:   deleted.RowID,
+				Time:    deleted.Time,
+				Deleted: true,
+			}
+			if origin != nil {
+				change.Batch, change.Offset = origin.Batch, origin.Offset
+			} else {
+				block, ok := p.blocks.Get(BlockEntry{
+					BlockInfo: catalog.BlockInfo{
+						BlockID: deleted.BlockID,
+					},
+				})
+				if !ok || block.MetaLocation().IsEmpty() {
+					return false
+				}
+				change.Location = block.MetaLocation()
+			}
+			changes = append(changes, change)
+		}
+		inserted, deleted, origin = nil, nil, nil
+		return true
+	}
+
+	for ok := iter.First(); ok; ok = iter.Next() {
+		entry := iter.Item()
+		if entry.RowID != rowID {
+			if !flush() {
+				return nil, false
+			}
+			rowID = entry.RowID
+		}
+		if entry.Time.Greater(to) {
+			continue
+		}
+		inRange := entry.Time.Greater(from)
+		switch {
+		case entry.Deleted && inRange:
+			deleted = &entry
+		case !entry.Deleted && inRange:
+			inserted = &entry
+		case !entry.Deleted && origin == nil:
+			origin = &entry
+		}
+	}
+	if !flush() {
+		return nil, false
+	}
+	return changes, true
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logtailreplay
+
+import (
+	"context"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectRowChanges(t *testing.T) {
+	state := NewPartitionState(false)
+	ctx := context.Background()
+	pool := mpool.MustNewZero()
+	packer := types.NewPacker(pool)
+	defer packer.FreeMem()
+
+	sid := objectio.NewSegmentid()
+	buildRowID := func(i int) types.Rowid {
+		blk := objectio.NewBlockid(sid, uint16(i), 0)
+		return *objectio.NewRowid(blk, uint32(0))
+	}
+	insert := func(row int, ts int64) {
+		rowIDVec := vector.NewVec(types.T_Rowid.ToType())
+		tsVec := vector.NewVec(types.T_TS.ToType())
+		vec1 := vector.NewVec(types.T_int64.ToType())
+		vector.AppendFixed(rowIDVec, buildRowID(row), false, pool)
+		vector.AppendFixed(tsVec, types.BuildTS(ts, 0), false, pool)
+		vector.AppendFixed(vec1, int64(row), false, pool)
+		state.HandleRowsInsert(ctx, &api.Batch{
+			Attrs: []string{"rowid", "time", "a"},
+			Vecs: []*api.Vector{
+				mustVectorToProto(rowIDVec),
+				mustVectorToProto(tsVec),
+				mustVectorToProto(vec1),
+			},
+		}, 0, packer)
+	}
+	del := func(row int, ts int64) {
+		rowIDVec := vector.NewVec(types.T_Rowid.ToType())
+		tsVec := vector.NewVec(types.T_TS.ToType())
+		vector.AppendFixed(rowIDVec, buildRowID(row), false, pool)
+		vector.AppendFixed(tsVec, types.BuildTS(ts, 0), false, pool)
+		state.HandleRowsDelete(ctx, &api.Batch{
+			Attrs: []string{"rowid", "time"},
+			Vecs: []*api.Vector{
+				mustVectorToProto(rowIDVec),
+				mustVectorToProto(tsVec),
+			},
+		}, packer)
+	}
+	collect := func(from, to int64) (inserted, deleted []int64, ok bool) {
+		changes, ok := state.CollectRowChanges(types.BuildTS(from, 0), types.BuildTS(to, 0))
+		for _, change := range changes {
+			v := vector.MustFixedCol[int64](change.Batch.Vecs[2])[change.Offset]
+			if change.Deleted {
+				deleted = append(deleted, v)
+			} else {
+				inserted = append(inserted, v)
+			}
+		}
+		return inserted, deleted, ok
+	}
+
+	for i := 1; i <= 4; i++ {
+		insert(i, int64(i))
+	}
+	del(2, 6)
+	del(4, 7)
+	insert(5, 8)
+	del(5, 9)
+
+	inserted, deleted, ok := collect(0, 5)
+	require.True(t, ok)
+	require.Equal(t, []int64{1, 2, 3, 4}, inserted)
+	require.Empty(t, deleted)
+
+	// the rows inserted and deleted in the range are not changed
+	inserted, deleted, ok = collect(3, 9)
+	require.True(t, ok)
+	require.Empty(t, inserted)
+	require.Equal(t, []int64{2}, deleted)
+
+	inserted, deleted, ok = collect(4, 7)
+	require.True(t, ok)
+	require.Empty(t, inserted)
+	require.Equal(t, []int64{2, 4}, deleted)
+
+	// the rows committed before the truncated point may be flushed
+	state.shared.lastTruncateTimestamp = types.BuildTS(5, 0)
+	_, _, ok = collect(3, 9)
+	require.False(t, ok)
+	_, _, ok = collect(5, 9)
+	require.True(t, ok)
+
+	// the deleted row is neither in the memory nor in a block
+	del(9, 10)
+	_, _, ok = collect(5, 10)
+	require.False(t, ok)
+	_, _, ok = collect(5, 9)
+	require.True(t, ok)
+
+	// the rows of the non-appendable block are not in the memory
+	state.blocks.Set(BlockEntry{
+		BlockInfo: catalog.BlockInfo{
+			BlockID: *objectio.NewBlockid(sid, 100, 0),
+		},
+		CreateTime: types.BuildTS(11, 0),
+	})
+	_, _, ok = collect(10, 11)
+	require.False(t, ok)
+	_, _, ok = collect(11, 12)
+	require.True(t, ok)
+
+	_, ok = NewPartitionState(true).CollectRowChanges(types.BuildTS(0, 0), types.BuildTS(10, 0))
+	require.False(t, ok)
+}
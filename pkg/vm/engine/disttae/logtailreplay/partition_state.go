@@ -75,6 +75,8 @@ type sharedStates struct {
 	sync.Mutex
 	// last block flush timestamp for table
 	lastFlushTimestamp types.TS
+	// the rows committed before or at the timestamp may be truncated from the memory
+	lastTruncateTimestamp types.TS
 }
 
 // RowEntry represents a version of a row
@@ -518,6 +520,13 @@ func (p *PartitionState) HandleMetadataInsert(ctx context.Context, input *api.Ba
 			{
 				scanCnt := int64(0)
 				trunctPoint := memTruncTSVector[i]
+				if isAppendable || !isEmptyDelta {
+					p.shared.Lock()
+					if trunctPoint.Greater(p.shared.lastTruncateTimestamp) {
+						p.shared.lastTruncateTimestamp = trunctPoint
+					}
+					p.shared.Unlock()
+				}
 				iter := p.rows.Copy().Iter()
 				pivot := RowEntry{
 					BlockID: blockID,
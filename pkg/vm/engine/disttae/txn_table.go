@@ -20,6 +20,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -27,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/deletion"
@@ -1056,6 +1058,17 @@ func (tbl *txnTable) AlterTable(ctx context.Context, c *engine.ConstraintDef, co
 		return err
 	}
 	tbl.constraint = ct
+	// the partition info is read by the later statements of the txn
+	for _, data := range constraint {
+		req := &api.AlterTableReq{}
+		if err = req.Unmarshal(data); err != nil {
+			return err
+		}
+		if req.Kind == api.AlterKind_UpdatePartition {
+			tbl.partitioned = 1
+			tbl.partition = req.GetUpdatePartition().GetPartition()
+		}
+	}
 	return nil
 }
 
@@ -1191,6 +1204,7 @@ func (tbl *txnTable) mergeCompaction(
 	s3writer := &colexec.S3Writer{}
 	s3writer.SetTableName(tbl.tableName)
 	s3writer.SetSchemaVer(tbl.version)
+	s3writer.SetCompression(catalog.GetCompressionFromConstraint(tbl.constraint))
 	_, err := s3writer.GenerateWriter(tbl.db.txn.proc)
 	if err != nil {
 		return nil, err
@@ -1693,6 +1707,110 @@ func (tbl *txnTable) PrimaryKeysMayBeModified(ctx context.Context, from types.TS
 	return false, nil
 }
 
+func (tbl *txnTable) CollectChanges(ctx context.Context, from types.TS, to types.TS,
+	attrs []string, mp *mpool.MPool) (*batch.Batch, *batch.Batch, error) {
+	unavailable := moerr.NewTableChangesUnavailable(ctx, tbl.tableName, from.ToString())
+	switch tbl.tableId {
+	case catalog.MO_DATABASE_ID, catalog.MO_TABLES_ID, catalog.MO_COLUMNS_ID:
+		return nil, nil, unavailable
+	}
+	if _, created := tbl.db.txn.createMap.Load(genTableKey(ctx, tbl.tableName, tbl.db.databaseId)); created {
+		return nil, nil, unavailable
+	}
+
+	seqnums := make([]uint16, len(attrs))
+	typs := make([]types.Type, len(attrs))
+	for i, name := range attrs {
+		found := false
+		for _, def := range tbl.defs {
+			if attr, ok := def.(*engine.AttributeDef); ok && attr.Attr.Name == name {
+				seqnums[i] = attr.Attr.Seqnum
+				typs[i] = attr.Attr.Type
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, nil, moerr.NewBadFieldError(ctx, name, tbl.tableName)
+		}
+	}
+
+	part, err := tbl.db.txn.engine.lazyLoad(ctx, tbl)
+	if err != nil {
+		return nil, nil, err
+	}
+	changes, ok := part.Snapshot().CollectRowChanges(from, to)
+	if !ok {
+		return nil, nil, unavailable
+	}
+
+	newBatch := func() *batch.Batch {
+		bat := batch.NewWithSize(len(attrs))
+		bat.SetAttributes(attrs)
+		for i := range typs {
+			bat.Vecs[i] = vector.NewVec(typs[i])
+		}
+		return bat
+	}
+	inserts, deletes := newBatch(), newBatch()
+	var insertRows, deleteRows int
+	// the deleted rows flushed are loaded from the blocks
+	loaded := make(map[types.Blockid]*batch.Batch)
+	defer func() {
+		for _, bat := range loaded {
+			bat.Clean(mp)
+		}
+	}()
+	for _, change := range changes {
+		bat := inserts
+		if change.Deleted {
+			bat = deletes
+			deleteRows++
+		} else {
+			insertRows++
+		}
+		if change.Batch != nil {
+			for i, seqnum := range seqnums {
+				idx := 2 /*rowid and commits*/ + int(seqnum)
+				if idx >= len(change.Batch.Vecs) /*add column*/ ||
+					change.Batch.Attrs[idx] == "" /*drop column*/ {
+					err = vector.AppendAny(bat.Vecs[i], nil, true, mp)
+				} else {
+					err = bat.Vecs[i].UnionOne(change.Batch.Vecs[idx], change.Offset, mp)
+				}
+				if err != nil {
+					inserts.Clean(mp)
+					deletes.Clean(mp)
+					return nil, nil, err
+				}
+			}
+			continue
+		}
+		blockID, offset := change.RowID.Decode()
+		block, ok := loaded[blockID]
+		if !ok {
+			if block, err = blockio.LoadColumns(
+				ctx, seqnums, typs, tbl.db.txn.engine.fs, change.Location, mp,
+			); err != nil {
+				inserts.Clean(mp)
+				deletes.Clean(mp)
+				return nil, nil, err
+			}
+			loaded[blockID] = block
+		}
+		for i := range seqnums {
+			if err = bat.Vecs[i].UnionOne(block.Vecs[i], int64(offset), mp); err != nil {
+				inserts.Clean(mp)
+				deletes.Clean(mp)
+				return nil, nil, err
+			}
+		}
+	}
+	inserts.SetRowCount(insertRows)
+	deletes.SetRowCount(deleteRows)
+	return inserts, deletes, nil
+}
+
 func (tbl *txnTable) updateDeleteInfo(deleteBlks, createBlks []types.Blockid,
 	commitedblocks []catalog.BlockInfo) error {
 	var blks []catalog.BlockInfo
@@ -17,6 +17,8 @@ package memoryengine
 import (
 	"context"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -390,3 +392,7 @@ func (t *Table) GetColumMetadataScanInfo(ctx context.Context, name string) ([]*p
 func (t *Table) PrimaryKeysMayBeModified(ctx context.Context, from types.TS, to types.TS, keyVector *vector.Vector) (bool, error) {
 	return true, nil
 }
+
+func (t *Table) CollectChanges(ctx context.Context, from types.TS, to types.TS, attrs []string, mp *mpool.MPool) (*batch.Batch, *batch.Batch, error) {
+	return nil, nil, moerr.NewTableChangesUnavailable(ctx, t.GetTableName(), from.ToString())
+}
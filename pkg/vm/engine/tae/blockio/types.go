@@ -38,6 +38,7 @@ func init() {
 const (
 	CheckpointExt = "ckp"
 	GCFullExt     = "fgc"
+	WalBackupExt  = "wal"
 )
 
 func EncodeCheckpointMetadataFileName(dir, prefix string, start, end types.TS) string {
@@ -48,6 +49,10 @@ func EncodeGCMetadataFileName(dir, prefix string, start, end types.TS) string {
 	return fmt.Sprintf("%s/%s_%s_%s.%s", dir, prefix, start.ToString(), end.ToString(), GCFullExt)
 }
 
+func EncodeWalBackupFileName(dir, prefix string, start, end types.TS) string {
+	return fmt.Sprintf("%s/%s_%s_%s.%s", dir, prefix, start.ToString(), end.ToString(), WalBackupExt)
+}
+
 func DecodeCheckpointMetadataFileName(name string) (start, end types.TS) {
 	fileName := strings.Split(name, ".")
 	info := strings.Split(fileName[0], "_")
@@ -65,6 +70,14 @@ func DecodeGCMetadataFileName(name string) (start, end types.TS, ext string) {
 	return
 }
 
+func DecodeWalBackupFileName(name string) (start, end types.TS) {
+	fileName := strings.Split(name, ".")
+	info := strings.Split(fileName[0], "_")
+	start = types.StringToTS(info[1])
+	end = types.StringToTS(info[2])
+	return
+}
+
 func GetObjectSizeWithBlocks(blocks []objectio.BlockObject) (uint32, error) {
 	objectSize := uint32(0)
 	for _, block := range blocks {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
@@ -64,6 +65,11 @@ func NewBlockWriterNew(fs fileservice.FileService, name objectio.ObjectName, sch
 	}, nil
 }
 
+// SetCompression sets the compression of the column data
+func (w *BlockWriter) SetCompression(alg compress.T, level int) {
+	w.writer.SetCompression(alg, level)
+}
+
 func (w *BlockWriter) SetPrimaryKey(idx uint16) {
 	w.isSetPK = true
 	w.pk = idx
@@ -414,4 +414,12 @@ func TestAlterSchema(t *testing.T) {
 	require.Equal(t, uint16(5), schema.GetSingleSortKey().SeqNum)
 	require.Equal(t, 5, schema.GetSingleSortKeyIdx())
 
+	req = api.NewUpdatePartitionReq(0, 0, "partition info")
+	data, err := req.Marshal()
+	require.NoError(t, err)
+	req = &api.AlterTableReq{}
+	require.NoError(t, req.Unmarshal(data))
+	require.NoError(t, schema.ApplyAlterTable(req))
+	require.Equal(t, int8(1), schema.Partitioned)
+	require.Equal(t, "partition info", schema.Partition)
 }
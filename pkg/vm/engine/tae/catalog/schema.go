@@ -24,6 +24,7 @@ import (
 
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -166,6 +167,9 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		s.Constraint = req.GetUpdateCstr().GetConstraints()
 	case apipb.AlterKind_UpdateComment:
 		s.Comment = req.GetUpdateComment().GetComment()
+	case apipb.AlterKind_UpdatePartition:
+		s.Partitioned = 1
+		s.Partition = req.GetUpdatePartition().GetPartition()
 	case apipb.AlterKind_AddColumn:
 		add := req.GetAddColumn()
 		logutil.Infof("[Alter] add column %s(%s)@%d", add.Column.Name, types.T(add.Column.Typ.Id), add.InsertPosition)
@@ -249,6 +253,12 @@ func (s *Schema) removeDroppedName(name string) {
 	}
 }
 
+// GetCompression returns the compression of the column data set by the
+// COMPRESSION table option, which is kept in the constraint of the table.
+func (s *Schema) GetCompression() (compress.T, int) {
+	return pkgcatalog.GetCompressionFromConstraint(s.Constraint)
+}
+
 func (s *Schema) HasPK() bool      { return s.SortKey != nil && s.SortKey.IsPrimary() }
 func (s *Schema) HasSortKey() bool { return s.SortKey != nil }
 
@@ -146,6 +146,10 @@ func TypeStringValue(t types.Type, v any, isNull bool, opts ...TypePrintOpt) str
 		val := v.([]byte)
 		j := types.DecodeJson(val)
 		return j.String()
+	case types.T_array_float32:
+		return types.BytesToArrayToString[float32](v.([]byte))
+	case types.T_array_float64:
+		return types.BytesToArrayToString[float64](v.([]byte))
 	case types.T_uuid:
 		val := v.(types.Uuid)
 		return val.ToString()
@@ -0,0 +1,138 @@
+// Copyright 2023 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/entry"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnimpl"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+const (
+	// WalBackupDir is the dir of the shared fs holding the files of BackupWal
+	WalBackupDir    = "walbackup"
+	PrefixWalBackup = "wal"
+)
+
+// BackupWal writes the txn entries of the wal which are not truncated by a
+// checkpoint yet into a file of the shared fs, and returns the name of it.
+// The file is named by the end of the newest checkpoint and the max commit ts,
+// every entry in it is the payload of a wal entry prefixed by its length.
+// Checkpoints must be disabled by the caller, or the wal may be truncated while
+// it is read. Only the entries of GroupPrepare are written, so the txns committed
+// by 2PC are replayed as prepared.
+func (db *DB) BackupWal(ctx context.Context) (string, error) {
+	var start types.TS
+	if ckp := db.BGCheckpointRunner.MaxCheckpoint(); ckp != nil {
+		start = ckp.GetEnd()
+	}
+	end := db.TxnMgr.StatMaxCommitTS()
+
+	var buf []byte
+	for lsn := db.Wal.GetCheckpointed() + 1; lsn <= db.Wal.GetCurrSeqNum(); lsn++ {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		e, err := db.Wal.LoadEntry(wal.GroupPrepare, lsn)
+		if err != nil {
+			return "", err
+		}
+		payload := e.GetPayload()
+		buf = binary.BigEndian.AppendUint32(buf, uint32(len(payload)))
+		buf = append(buf, payload...)
+		e.Free()
+	}
+
+	name := blockio.EncodeWalBackupFileName(WalBackupDir, PrefixWalBackup, start, end)
+	err := db.Opts.Fs.Write(ctx, fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   int64(len(buf)),
+				Data:   buf,
+			},
+		},
+	})
+	return name, err
+}
+
+// DecodeWalBackup splits a file written by BackupWal into the payloads of the
+// wal entries, and returns the prepare ts of each.
+func DecodeWalBackup(ctx context.Context, data []byte) ([][]byte, []types.TS, error) {
+	var payloads [][]byte
+	var tss []types.TS
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, nil, moerr.NewInternalError(ctx, "malformed wal backup")
+		}
+		size := int(binary.BigEndian.Uint32(data))
+		if len(data) < 4+size || size < 4 {
+			return nil, nil, moerr.NewInternalError(ctx, "malformed wal backup")
+		}
+		payload := data[4 : 4+size]
+		data = data[4+size:]
+
+		head := objectio.DecodeIOEntryHeader(payload)
+		codec := objectio.GetIOEntryCodec(*head)
+		e, err := codec.Decode(payload[4:])
+		if err != nil {
+			return nil, nil, err
+		}
+		cmd, ok := e.(*txnbase.TxnCmd)
+		if !ok {
+			return nil, nil, moerr.NewInternalError(ctx, "malformed wal backup")
+		}
+		tss = append(tss, cmd.PrepareTS)
+		cmd.Close()
+		payloads = append(payloads, payload)
+	}
+	return payloads, tss, nil
+}
+
+// WriteWal appends the payloads of wal entries to the local wal of the tae in
+// dirname, the tae replays them after its checkpoints when it is opened.
+func WriteWal(ctx context.Context, dirname string, payloads [][]byte) (err error) {
+	driver := wal.NewDriverWithBatchStore(ctx, dirname, WALDir, nil)
+	defer func() {
+		if closeErr := driver.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	for _, payload := range payloads {
+		e := entry.GetBase()
+		e.SetType(txnimpl.IOET_WALEntry_TxnRecord)
+		if err = e.SetPayload(payload); err != nil {
+			return
+		}
+		e.SetInfo(&entry.Info{Group: wal.GroupPrepare})
+		if _, err = driver.AppendEntry(wal.GroupPrepare, e); err != nil {
+			return
+		}
+		if err = e.WaitDone(); err != nil {
+			return
+		}
+	}
+	return
+}
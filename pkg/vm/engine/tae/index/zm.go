@@ -354,7 +354,7 @@ func (zm ZM) getValue(buf []byte) any {
 	case types.T_Blockid:
 		return types.DecodeFixed[types.Rowid](buf)
 	case types.T_char, types.T_varchar, types.T_json,
-		types.T_binary, types.T_varbinary, types.T_blob, types.T_text:
+		types.T_binary, types.T_varbinary, types.T_blob, types.T_text, types.T_array_float32, types.T_array_float64:
 		return buf
 	}
 	panic(fmt.Sprintf("unsupported type: %v", zm.GetType()))
@@ -440,6 +440,15 @@ func (h *Handle) HandleBackup(
 	if err != nil {
 		return nil, err
 	}
+	// the wal after the checkpoints is backed up for point-in-time restore.
+	// no checkpoint is made until the checkpoints are listed, so that the
+	// wal is not truncated beyond them.
+	h.db.BGCheckpointRunner.DisableCheckpoint()
+	defer h.db.BGCheckpointRunner.EnableCheckpoint()
+	walFile, err := h.db.BackupWal(ctx)
+	if err != nil {
+		return nil, err
+	}
 	data := h.db.BGCheckpointRunner.GetAllCheckpoints()
 	var locations string
 	for i := range data {
@@ -448,6 +457,8 @@ func (h *Handle) HandleBackup(
 		locations += fmt.Sprintf("%d", data[i].GetVersion())
 		locations += ";"
 	}
+	locations += walFile
+	locations += ";"
 	resp.CkpLocation = locations
 	return nil, err
 }
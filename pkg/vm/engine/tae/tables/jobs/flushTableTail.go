@@ -511,6 +511,7 @@ func (task *flushTableTailTask) mergeAblks(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	writer.SetCompression(schema.GetCompression())
 	if schema.HasPK() {
 		pkIdx := schema.GetSingleSortKeyIdx()
 		writer.SetPrimaryKey(uint16(pkIdx))
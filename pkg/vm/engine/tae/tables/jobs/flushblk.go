@@ -71,6 +71,7 @@ func (task *flushBlkTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	writer.SetCompression(task.meta.GetSchema().GetCompression())
 	if task.meta.GetSchema().HasPK() {
 		writer.SetPrimaryKey(uint16(task.meta.GetSchema().GetSingleSortKeyIdx()))
 	}
@@ -371,6 +371,7 @@ func (task *mergeBlocksTask) Execute(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	writer.SetCompression(schema.GetCompression())
 	if schema.HasPK() {
 		pkIdx := schema.GetSingleSortKeyIdx()
 		writer.SetPrimaryKey(uint16(pkIdx))
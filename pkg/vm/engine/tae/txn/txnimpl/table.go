@@ -897,6 +897,7 @@ func (tbl *txnTable) AlterTable(ctx context.Context, req *apipb.AlterTableReq) e
 	switch req.Kind {
 	case apipb.AlterKind_UpdateConstraint,
 		apipb.AlterKind_UpdateComment,
+		apipb.AlterKind_UpdatePartition,
 		apipb.AlterKind_AddColumn,
 		apipb.AlterKind_DropColumn,
 		apipb.AlterKind_RenameTable:
@@ -157,6 +157,14 @@ type StreamConfigsDef struct {
 	Configs []*plan.Property
 }
 
+type CheckDef struct {
+	Checks []*plan.CheckDef
+}
+
+type TriggerDef struct {
+	Triggers []*plan.TriggerDef
+}
+
 type TableDef interface {
 	tableDef()
 
@@ -293,6 +301,8 @@ const (
 	ForeignKey
 	PrimaryKey
 	StreamConfig
+	Check
+	Trigger
 )
 
 type EngineType int8
@@ -385,6 +395,40 @@ func (def *ConstraintDef) MarshalBinary() (data []byte, err error) {
 				}
 				buf.Write(bytes)
 			}
+		case *CheckDef:
+			if err := binary.Write(buf, binary.BigEndian, Check); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Checks))); err != nil {
+				return nil, err
+			}
+			for _, c := range def.Checks {
+				bytes, err := c.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
+		case *TriggerDef:
+			if err := binary.Write(buf, binary.BigEndian, Trigger); err != nil {
+				return nil, err
+			}
+			if err := binary.Write(buf, binary.BigEndian, uint64(len(def.Triggers))); err != nil {
+				return nil, err
+			}
+			for _, t := range def.Triggers {
+				bytes, err := t.Marshal()
+				if err != nil {
+					return nil, err
+				}
+				if err := binary.Write(buf, binary.BigEndian, uint64(len(bytes))); err != nil {
+					return nil, err
+				}
+				buf.Write(bytes)
+			}
 		}
 	}
 	return buf.Bytes(), nil
@@ -470,6 +514,40 @@ func (def *ConstraintDef) UnmarshalBinary(data []byte) error {
 				configs[i] = config
 			}
 			def.Cts = append(def.Cts, &StreamConfigsDef{configs})
+		case Check:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			checks := make([]*plan.CheckDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				check := &plan.CheckDef{}
+				err := check.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				checks[i] = check
+			}
+			def.Cts = append(def.Cts, &CheckDef{checks})
+		case Trigger:
+			length = binary.BigEndian.Uint64(data[l : l+8])
+			l += 8
+			triggers := make([]*plan.TriggerDef, length)
+
+			for i := 0; i < int(length); i++ {
+				dataLength := binary.BigEndian.Uint64(data[l : l+8])
+				l += 8
+				trigger := &plan.TriggerDef{}
+				err := trigger.Unmarshal(data[l : l+int(dataLength)])
+				if err != nil {
+					return err
+				}
+				l += int(dataLength)
+				triggers[i] = trigger
+			}
+			def.Cts = append(def.Cts, &TriggerDef{triggers})
 		}
 	}
 	return nil
@@ -501,6 +579,12 @@ func (def *ConstraintPB) FromPBVersion() Constraint {
 	if r := def.GetStreamConfigsDef(); r != nil {
 		return r
 	}
+	if r := def.GetCheckDef(); r != nil {
+		return r
+	}
+	if r := def.GetTriggerDef(); r != nil {
+		return r
+	}
 	panic("no corresponding type")
 }
 
@@ -527,6 +611,8 @@ func (*PrimaryKeyDef) constraint()    {}
 func (*RefChildTableDef) constraint() {}
 func (*IndexDef) constraint()         {}
 func (*StreamConfigsDef) constraint() {}
+func (*CheckDef) constraint()         {}
+func (*TriggerDef) constraint()       {}
 
 func (def *ForeignKeyDef) ToPBVersion() ConstraintPB {
 	return ConstraintPB{
@@ -565,6 +651,22 @@ func (def *StreamConfigsDef) ToPBVersion() ConstraintPB {
 	}
 }
 
+func (def *CheckDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_CheckDef{
+			CheckDef: def,
+		},
+	}
+}
+
+func (def *TriggerDef) ToPBVersion() ConstraintPB {
+	return ConstraintPB{
+		Ct: &ConstraintPB_TriggerDef{
+			TriggerDef: def,
+		},
+	}
+}
+
 type Relation interface {
 	Statistics
 
@@ -616,6 +718,11 @@ type Relation interface {
 	// If not sure, returns true
 	// Initially added for implementing locking rows by primary keys
 	PrimaryKeysMayBeModified(ctx context.Context, from types.TS, to types.TS, keyVector *vector.Vector) (bool, error)
+
+	// CollectChanges returns the values of attrs of the rows inserted and deleted during `from` to `to`.
+	// The rows inserted and then deleted in the range are not returned.
+	// If the changes are not available anymore, returns ErrTableChangesUnavailable
+	CollectChanges(ctx context.Context, from types.TS, to types.TS, attrs []string, mp *mpool.MPool) (inserts *batch.Batch, deletes *batch.Batch, err error)
 }
 
 type Reader interface {
@@ -613,6 +613,8 @@ type ConstraintPB struct {
 	//	*ConstraintPB_RefChildTableDef
 	//	*ConstraintPB_IndexDef
 	//	*ConstraintPB_StreamConfigsDef
+	//	*ConstraintPB_CheckDef
+	//	*ConstraintPB_TriggerDef
 	Ct isConstraintPB_Ct `protobuf_oneof:"ct"`
 }
 
@@ -670,12 +672,20 @@ type ConstraintPB_IndexDef struct {
 type ConstraintPB_StreamConfigsDef struct {
 	StreamConfigsDef *StreamConfigsDef `protobuf:"bytes,5,opt,name=StreamConfigsDef,proto3,oneof" json:"StreamConfigsDef,omitempty"`
 }
+type ConstraintPB_CheckDef struct {
+	CheckDef *CheckDef `protobuf:"bytes,6,opt,name=CheckDef,proto3,oneof" json:"CheckDef,omitempty"`
+}
+type ConstraintPB_TriggerDef struct {
+	TriggerDef *TriggerDef `protobuf:"bytes,7,opt,name=TriggerDef,proto3,oneof" json:"TriggerDef,omitempty"`
+}
 
 func (*ConstraintPB_ForeignKeyDef) isConstraintPB_Ct()    {}
 func (*ConstraintPB_PrimaryKeyDef) isConstraintPB_Ct()    {}
 func (*ConstraintPB_RefChildTableDef) isConstraintPB_Ct() {}
 func (*ConstraintPB_IndexDef) isConstraintPB_Ct()         {}
 func (*ConstraintPB_StreamConfigsDef) isConstraintPB_Ct() {}
+func (*ConstraintPB_CheckDef) isConstraintPB_Ct()         {}
+func (*ConstraintPB_TriggerDef) isConstraintPB_Ct()       {}
 
 func (m *ConstraintPB) GetCt() isConstraintPB_Ct {
 	if m != nil {
@@ -719,6 +729,20 @@ func (m *ConstraintPB) GetStreamConfigsDef() *StreamConfigsDef {
 	return nil
 }
 
+func (m *ConstraintPB) GetCheckDef() *CheckDef {
+	if x, ok := m.GetCt().(*ConstraintPB_CheckDef); ok {
+		return x.CheckDef
+	}
+	return nil
+}
+
+func (m *ConstraintPB) GetTriggerDef() *TriggerDef {
+	if x, ok := m.GetCt().(*ConstraintPB_TriggerDef); ok {
+		return x.TriggerDef
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ConstraintPB) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -727,6 +751,8 @@ func (*ConstraintPB) XXX_OneofWrappers() []interface{} {
 		(*ConstraintPB_RefChildTableDef)(nil),
 		(*ConstraintPB_IndexDef)(nil),
 		(*ConstraintPB_StreamConfigsDef)(nil),
+		(*ConstraintPB_CheckDef)(nil),
+		(*ConstraintPB_TriggerDef)(nil),
 	}
 }
 
@@ -1064,6 +1090,86 @@ func (m *Attribute) GetAutoIncrement() bool {
 	return false
 }
 
+func (m *CheckDef) Reset()         { *m = CheckDef{} }
+func (m *CheckDef) String() string { return proto.CompactTextString(m) }
+func (*CheckDef) ProtoMessage()    {}
+func (*CheckDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{18}
+}
+func (m *CheckDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckDef.Merge(m, src)
+}
+func (m *CheckDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CheckDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckDef proto.InternalMessageInfo
+
+func (m *CheckDef) GetChecks() []*plan.CheckDef {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *TriggerDef) Reset()         { *m = TriggerDef{} }
+func (m *TriggerDef) String() string { return proto.CompactTextString(m) }
+func (*TriggerDef) ProtoMessage()    {}
+func (*TriggerDef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d938547f84707355, []int{19}
+}
+func (m *TriggerDef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerDef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerDef.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerDef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerDef.Merge(m, src)
+}
+func (m *TriggerDef) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *TriggerDef) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerDef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerDef proto.InternalMessageInfo
+
+func (m *TriggerDef) GetTriggers() []*plan.TriggerDef {
+	if m != nil {
+		return m.Triggers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CommentDef)(nil), "engine.CommentDef")
 	proto.RegisterType((*VersionDef)(nil), "engine.VersionDef")
@@ -1083,75 +1189,82 @@ func init() {
 	proto.RegisterType((*TableDefPB)(nil), "engine.TableDefPB")
 	proto.RegisterType((*Property)(nil), "engine.Property")
 	proto.RegisterType((*Attribute)(nil), "engine.Attribute")
+	proto.RegisterType((*CheckDef)(nil), "engine.CheckDef")
+	proto.RegisterType((*TriggerDef)(nil), "engine.TriggerDef")
 }
 
 func init() { proto.RegisterFile("types.proto", fileDescriptor_d938547f84707355) }
 
 var fileDescriptor_d938547f84707355 = []byte{
-	// 1005 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0xe2, 0x56,
-	0x10, 0x37, 0x60, 0x08, 0x0c, 0xb0, 0x9b, 0x7a, 0xb3, 0xad, 0x15, 0x55, 0x10, 0xa5, 0x95, 0x96,
-	0x76, 0xb7, 0xb0, 0x4a, 0xa3, 0xaa, 0x42, 0x4a, 0xd5, 0x98, 0x14, 0x81, 0x56, 0x6d, 0xd1, 0x6b,
-	0x9a, 0xbb, 0x81, 0x07, 0x6b, 0x05, 0x6c, 0xcb, 0x7e, 0x68, 0x97, 0x6f, 0xd1, 0x8f, 0xd0, 0x1e,
-	0xfb, 0x4d, 0xf6, 0x98, 0x63, 0xd4, 0x43, 0xd4, 0x26, 0x5f, 0xa0, 0xe7, 0x9c, 0xaa, 0x37, 0x9e,
-	0xe7, 0x7f, 0x5c, 0x7a, 0x7b, 0x33, 0xf3, 0x9b, 0x79, 0xe3, 0xf9, 0x8d, 0x7f, 0x36, 0xd4, 0xc5,
-	0xd6, 0xe7, 0x61, 0xd7, 0x0f, 0x3c, 0xe1, 0x19, 0x15, 0xee, 0x2e, 0x1d, 0x97, 0x1f, 0x7e, 0xb5,
-	0x74, 0xc4, 0xdb, 0xcd, 0xb4, 0x3b, 0xf3, 0xd6, 0xbd, 0xa5, 0xb7, 0xf4, 0x7a, 0x18, 0x9e, 0x6e,
-	0x16, 0x68, 0xa1, 0x81, 0xa7, 0x28, 0xed, 0x10, 0xfc, 0x95, 0xed, 0x46, 0xe7, 0xe3, 0x57, 0x00,
-	0x03, 0x6f, 0xbd, 0xe6, 0xae, 0xb8, 0xe0, 0x0b, 0xc3, 0x84, 0x3d, 0xb2, 0xcc, 0xc2, 0x51, 0xa1,
-	0x53, 0x63, 0xca, 0xec, 0xeb, 0xff, 0xfe, 0xd1, 0xd6, 0x24, 0xfa, 0x8a, 0x07, 0xa1, 0xe3, 0xb9,
-	0x84, 0x26, 0x0b, 0xd1, 0x4d, 0xa6, 0x4c, 0x42, 0x9f, 0x40, 0x63, 0x62, 0x07, 0xc2, 0x11, 0x84,
-	0xff, 0x14, 0x6a, 0xb1, 0x4d, 0xf5, 0x13, 0x07, 0xe5, 0x7c, 0x06, 0x7b, 0x57, 0x0e, 0x7f, 0x27,
-	0xe1, 0x06, 0xe8, 0xf2, 0x48, 0x48, 0x3c, 0x13, 0xe8, 0x1c, 0x1a, 0xe7, 0x42, 0x04, 0xce, 0x74,
-	0x23, 0xb8, 0x44, 0xbe, 0x04, 0x5d, 0xda, 0x88, 0xac, 0x9f, 0x7c, 0xd4, 0x8d, 0xc6, 0xd2, 0x8d,
-	0x31, 0x96, 0xfe, 0xe1, 0xae, 0xad, 0x31, 0x04, 0x51, 0x89, 0x19, 0x34, 0xc7, 0xee, 0x9c, 0xbf,
-	0xbf, 0xb4, 0xa7, 0x2b, 0x1e, 0x35, 0x57, 0xba, 0xdc, 0xfa, 0x58, 0xa2, 0x6c, 0xc1, 0xe3, 0x5d,
-	0xbb, 0x12, 0xc5, 0x99, 0x74, 0x1b, 0x87, 0x50, 0x1d, 0x78, 0xab, 0x9f, 0xec, 0x35, 0x0f, 0xcd,
-	0xe2, 0x51, 0xa9, 0x53, 0x63, 0xb1, 0x2d, 0xfb, 0x94, 0x07, 0xb3, 0x14, 0xf5, 0x29, 0xcf, 0x74,
-	0xc9, 0x8f, 0xd0, 0x9c, 0x04, 0x9e, 0xcf, 0x03, 0xe1, 0xf0, 0x50, 0x5e, 0xf2, 0x0d, 0x40, 0xe2,
-	0x30, 0x0b, 0x47, 0xa5, 0x4e, 0xfd, 0x64, 0x5f, 0xb5, 0x4b, 0x91, 0x2d, 0x75, 0x9b, 0x42, 0x52,
-	0xb9, 0x0e, 0x34, 0x06, 0xab, 0x4d, 0x28, 0x78, 0x60, 0x6d, 0x69, 0x40, 0x78, 0x71, 0x61, 0xe7,
-	0xe2, 0xef, 0xa1, 0x39, 0xf4, 0x02, 0xee, 0x2c, 0xdd, 0x37, 0x1c, 0xa1, 0x5f, 0x40, 0x79, 0x78,
-	0xcd, 0xb7, 0xea, 0xce, 0x67, 0x5d, 0x5c, 0x81, 0x0c, 0x86, 0x45, 0x08, 0xaa, 0x60, 0xc1, 0xfe,
-	0x2f, 0x22, 0xe0, 0xf6, 0x7a, 0xe0, 0xb9, 0x0b, 0x67, 0x89, 0xdd, 0x77, 0xe4, 0x76, 0xa0, 0x45,
-	0x65, 0x9e, 0x44, 0x65, 0x54, 0xe3, 0x4c, 0x85, 0xa9, 0xc6, 0x77, 0xf2, 0xf1, 0x9d, 0xb5, 0x1d,
-	0x6c, 0xa9, 0x8b, 0x17, 0xa0, 0x4f, 0xae, 0xf9, 0x96, 0x78, 0x7a, 0xa6, 0xb2, 0x53, 0x10, 0x86,
-	0x00, 0xca, 0x7f, 0x0d, 0xfb, 0x8c, 0x2f, 0x06, 0x6f, 0x9d, 0xd5, 0x3c, 0xa6, 0xe9, 0x63, 0xa8,
-	0xe0, 0x39, 0x6a, 0x41, 0x67, 0x64, 0x51, 0x46, 0x1f, 0xaa, 0xc8, 0x1a, 0x75, 0x8b, 0x67, 0x9e,
-	0xeb, 0x56, 0x01, 0x98, 0x0a, 0x53, 0xee, 0x0f, 0xf0, 0x74, 0xe0, 0xb9, 0xa1, 0x08, 0x6c, 0x07,
-	0x5f, 0x86, 0x89, 0x65, 0xbc, 0x82, 0xd2, 0x40, 0xa8, 0xf4, 0x03, 0xc5, 0x53, 0x82, 0x9a, 0x58,
-	0xc4, 0x95, 0x84, 0x61, 0x99, 0xc2, 0xf1, 0x6d, 0x11, 0x1a, 0x69, 0x84, 0x71, 0x96, 0xe3, 0x82,
-	0x9e, 0xfe, 0xb9, 0x2a, 0x97, 0x09, 0x8e, 0x34, 0x96, 0x63, 0xee, 0x2c, 0x37, 0x44, 0xb3, 0x98,
-	0x4d, 0xcf, 0x04, 0x65, 0x7a, 0x76, 0xe4, 0xc3, 0xdd, 0x19, 0xe2, 0xa2, 0xd6, 0x4f, 0x4c, 0x55,
-	0x21, 0x1f, 0x1f, 0x69, 0x6c, 0x77, 0xee, 0xdd, 0x64, 0xb2, 0xa6, 0x8e, 0xf9, 0xf1, 0xde, 0x2a,
-	0xff, 0x48, 0x63, 0xc9, 0xf4, 0x87, 0xbb, 0xfb, 0x63, 0x96, 0xb3, 0xf7, 0xe6, 0xe3, 0xf2, 0xde,
-	0xbc, 0x2f, 0x1a, 0xaa, 0xa5, 0x43, 0x71, 0x26, 0x8e, 0xff, 0xd4, 0x01, 0x54, 0x43, 0x13, 0xcb,
-	0x38, 0x4d, 0x4b, 0x17, 0x4d, 0xd5, 0x48, 0x48, 0x52, 0x91, 0x91, 0xc6, 0xd2, 0x12, 0xd7, 0xcf,
-	0x8a, 0x12, 0x8d, 0x33, 0x26, 0x37, 0x1d, 0x1b, 0x69, 0x2c, 0x2b, 0x60, 0x2f, 0x63, 0x71, 0xa2,
-	0x19, 0x3e, 0x55, 0x69, 0xe4, 0x1e, 0x69, 0x2c, 0x96, 0xaf, 0x7e, 0x56, 0xa4, 0x68, 0x6a, 0x07,
-	0x3b, 0xe2, 0x44, 0x17, 0x65, 0x04, 0xed, 0x2c, 0xa7, 0x4e, 0x34, 0xba, 0xe7, 0x99, 0x91, 0xa7,
-	0xf8, 0xca, 0x69, 0xd9, 0x59, 0x4e, 0x77, 0xcc, 0x4a, 0x7e, 0x67, 0x52, 0xc1, 0x68, 0x67, 0xd2,
-	0x2a, 0xd5, 0xcf, 0xea, 0x8c, 0xb9, 0x97, 0xed, 0x3c, 0x1d, 0x93, 0x9d, 0x67, 0x34, 0x69, 0xb0,
-	0xf3, 0x16, 0x99, 0x55, 0x4c, 0xff, 0x64, 0xf7, 0xf5, 0xc1, 0xf0, 0x48, 0x63, 0x3b, 0xef, 0xdd,
-	0x69, 0xfa, 0x33, 0x63, 0xd6, 0xb2, 0xcc, 0x26, 0x11, 0xc9, 0x6c, 0x62, 0xd1, 0xaa, 0x94, 0xa1,
-	0x34, 0xe7, 0x8b, 0xe3, 0x6f, 0xa1, 0xaa, 0x64, 0xc9, 0xd8, 0x87, 0xd2, 0x1b, 0x52, 0x9d, 0x1a,
-	0x93, 0x47, 0xe3, 0x00, 0xca, 0x57, 0xf6, 0x6a, 0xc3, 0x91, 0xfd, 0x1a, 0x8b, 0x0c, 0xd2, 0x81,
-	0xdb, 0x12, 0xd4, 0x62, 0x32, 0xa4, 0xf0, 0x8f, 0xc3, 0x91, 0x33, 0x9f, 0xf3, 0xe8, 0x93, 0x55,
-	0x65, 0xb1, 0x2d, 0xbf, 0x7f, 0xe3, 0x90, 0x79, 0xef, 0xc6, 0x73, 0xac, 0x53, 0x65, 0xca, 0x34,
-	0x9e, 0x40, 0x71, 0x7c, 0x81, 0x3b, 0xa2, 0xb3, 0xe2, 0xf8, 0x22, 0x56, 0x6a, 0x3d, 0x51, 0x6a,
-	0x63, 0x08, 0xa5, 0xf3, 0xd5, 0x12, 0x99, 0x6d, 0x5a, 0xa7, 0x8f, 0x77, 0xed, 0xd7, 0xa9, 0xaf,
-	0xf8, 0xda, 0x16, 0x81, 0xf3, 0xde, 0x0b, 0x9c, 0xa5, 0xe3, 0x2a, 0xc3, 0xe5, 0x3d, 0xff, 0x7a,
-	0xd9, 0x9b, 0x79, 0x6b, 0x3f, 0xe0, 0x61, 0xd8, 0xbd, 0x64, 0xb2, 0x80, 0x71, 0x05, 0xfa, 0xe5,
-	0xd6, 0xe7, 0xc8, 0x71, 0xc3, 0xb2, 0xa4, 0x1e, 0xfd, 0x75, 0xd7, 0xee, 0xff, 0xdf, 0x62, 0xae,
-	0xb0, 0x1d, 0x97, 0x07, 0xbd, 0xe8, 0xbf, 0x42, 0x56, 0x62, 0x58, 0xcf, 0x78, 0x01, 0x7b, 0x17,
-	0x7c, 0x61, 0x6f, 0x56, 0x82, 0x16, 0xa0, 0x19, 0xe9, 0x27, 0x39, 0x99, 0x8a, 0x1a, 0x5f, 0x42,
-	0xf5, 0x67, 0xf7, 0x57, 0x7f, 0x6e, 0x0b, 0x4e, 0x5c, 0x93, 0xd2, 0x2a, 0x2f, 0x8b, 0xe3, 0x72,
-	0x64, 0xa4, 0x4f, 0x48, 0x6b, 0x95, 0x29, 0x53, 0xfe, 0x1c, 0xc4, 0x8b, 0x64, 0x02, 0xc6, 0x12,
-	0x47, 0xfa, 0xc7, 0xa4, 0x9e, 0xf9, 0x31, 0x31, 0x3e, 0x87, 0xe6, 0xf9, 0x46, 0x78, 0x63, 0x77,
-	0x16, 0x70, 0x8c, 0x37, 0x30, 0x37, 0xeb, 0x8c, 0xa8, 0xb5, 0x8e, 0x6e, 0xfe, 0x69, 0x69, 0x1f,
-	0xee, 0x5b, 0x85, 0x9b, 0xfb, 0x56, 0xe1, 0xef, 0xfb, 0x96, 0xf6, 0xdb, 0x43, 0x4b, 0xfb, 0xfd,
-	0xa1, 0x55, 0xb8, 0x79, 0x68, 0x69, 0xb7, 0x0f, 0x2d, 0x6d, 0x5a, 0xc1, 0xbf, 0xa2, 0xaf, 0xff,
-	0x0b, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x0b, 0xa0, 0xb7, 0x67, 0x09, 0x00, 0x00,
+	// 1073 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xb7, 0x6b, 0x27, 0x71, 0x5e, 0x93, 0xdd, 0x32, 0xbb, 0x80, 0xb5, 0x42, 0x69, 0x55, 0x10,
+	0x5b, 0xd8, 0x92, 0xae, 0x4a, 0x85, 0x56, 0x91, 0x16, 0x51, 0xa7, 0x44, 0x89, 0x56, 0x40, 0x34,
+	0x84, 0xde, 0x9d, 0x64, 0xe2, 0x5a, 0x4d, 0xec, 0xc8, 0x9e, 0x68, 0x37, 0xdf, 0x82, 0x8f, 0x00,
+	0x47, 0xbe, 0x04, 0xe7, 0x3d, 0xf6, 0xb8, 0xe2, 0x50, 0x41, 0xfb, 0x05, 0x38, 0xef, 0x09, 0xcd,
+	0xf8, 0x8d, 0xed, 0x49, 0x2e, 0xdc, 0xde, 0x7b, 0xbf, 0xdf, 0xfb, 0xe3, 0x79, 0xcf, 0x6f, 0x06,
+	0x76, 0xf9, 0x7a, 0xc9, 0xd2, 0xf6, 0x32, 0x89, 0x79, 0x4c, 0xaa, 0x2c, 0x0a, 0xc2, 0x88, 0x3d,
+	0xf9, 0x2a, 0x08, 0xf9, 0xd5, 0x6a, 0xdc, 0x9e, 0xc4, 0x8b, 0x93, 0x20, 0x0e, 0xe2, 0x13, 0x09,
+	0x8f, 0x57, 0x33, 0xa9, 0x49, 0x45, 0x4a, 0x99, 0xdb, 0x13, 0x58, 0xce, 0xfd, 0x28, 0x93, 0x0f,
+	0x8f, 0x01, 0xba, 0xf1, 0x62, 0xc1, 0x22, 0x7e, 0xc1, 0x66, 0xc4, 0x85, 0x1a, 0x6a, 0xae, 0x79,
+	0x60, 0x1e, 0xd5, 0xa9, 0x52, 0x3b, 0xf6, 0xbf, 0xbf, 0xef, 0x1b, 0x82, 0x7d, 0xc9, 0x92, 0x34,
+	0x8c, 0x23, 0x64, 0xa3, 0x26, 0xd9, 0x4d, 0xaa, 0x54, 0x64, 0x9f, 0x42, 0x63, 0xe8, 0x27, 0x3c,
+	0xe4, 0xc8, 0xff, 0x04, 0xea, 0xb9, 0x8e, 0xf1, 0x0b, 0x03, 0xfa, 0x7c, 0x0a, 0xb5, 0xcb, 0x90,
+	0xbd, 0x16, 0x74, 0x02, 0xb6, 0x10, 0x91, 0x29, 0x65, 0x24, 0x9d, 0x43, 0xe3, 0x9c, 0xf3, 0x24,
+	0x1c, 0xaf, 0x38, 0x13, 0xcc, 0x67, 0x60, 0x0b, 0x5d, 0x32, 0x77, 0x4f, 0x3f, 0x68, 0x67, 0xc7,
+	0xd2, 0xce, 0x39, 0x9e, 0xfd, 0xf6, 0x76, 0xdf, 0xa0, 0x92, 0x84, 0x21, 0x26, 0xd0, 0x1c, 0x44,
+	0x53, 0xf6, 0x66, 0xe4, 0x8f, 0xe7, 0x2c, 0x2b, 0xce, 0x1a, 0xad, 0x97, 0x32, 0x44, 0xc5, 0x83,
+	0xf7, 0xb7, 0xfb, 0xd5, 0x0c, 0xa7, 0xc2, 0x4c, 0x9e, 0x80, 0xd3, 0x8d, 0xe7, 0x3f, 0xfa, 0x0b,
+	0x96, 0xba, 0x3b, 0x07, 0xd6, 0x51, 0x9d, 0xe6, 0xba, 0xa8, 0x53, 0x08, 0xae, 0x95, 0xd5, 0x29,
+	0x64, 0x4c, 0xf2, 0x03, 0x34, 0x87, 0x49, 0xbc, 0x64, 0x09, 0x0f, 0x59, 0x2a, 0x92, 0x7c, 0x03,
+	0x50, 0x18, 0x5c, 0xf3, 0xc0, 0x3a, 0xda, 0x3d, 0xdd, 0x53, 0xe5, 0x22, 0xb2, 0xc6, 0x6a, 0x4b,
+	0x4c, 0x0c, 0x77, 0x04, 0x8d, 0xee, 0x7c, 0x95, 0x72, 0x96, 0x78, 0x6b, 0x3c, 0x20, 0x99, 0xd8,
+	0xdc, 0x4a, 0xfc, 0x1d, 0x34, 0x7b, 0x71, 0xc2, 0xc2, 0x20, 0x7a, 0xc5, 0x24, 0xf5, 0x0b, 0xa8,
+	0xf4, 0xae, 0xd9, 0x5a, 0xe5, 0x7c, 0xd4, 0x96, 0x23, 0xa0, 0x71, 0x68, 0xc6, 0xc0, 0x08, 0x1e,
+	0xec, 0xfd, 0xcc, 0x13, 0xe6, 0x2f, 0xba, 0x71, 0x34, 0x0b, 0x03, 0x59, 0xfd, 0x11, 0xd4, 0x50,
+	0xc3, 0x30, 0x0f, 0xb2, 0x30, 0xaa, 0x70, 0xaa, 0x60, 0x8c, 0xf1, 0xad, 0xf8, 0xfc, 0x70, 0xe1,
+	0x27, 0x6b, 0xac, 0xe2, 0x29, 0xd8, 0xc3, 0x6b, 0xb6, 0xc6, 0x3e, 0x3d, 0x52, 0xde, 0x25, 0x0a,
+	0x95, 0x04, 0xf4, 0x7f, 0x0e, 0x7b, 0x94, 0xcd, 0xba, 0x57, 0xe1, 0x7c, 0x9a, 0xb7, 0xe9, 0x23,
+	0xa8, 0x4a, 0x39, 0x2b, 0xc1, 0xa6, 0xa8, 0xa1, 0x47, 0x07, 0x1c, 0xd9, 0x35, 0xac, 0x56, 0xca,
+	0x6c, 0xa3, 0x5a, 0x45, 0xa0, 0x0a, 0x46, 0xdf, 0xef, 0xe1, 0x61, 0x37, 0x8e, 0x52, 0x9e, 0xf8,
+	0xa1, 0xfc, 0x19, 0x86, 0x1e, 0x39, 0x06, 0xab, 0xcb, 0x95, 0xfb, 0x63, 0xd5, 0xa7, 0x82, 0x35,
+	0xf4, 0xb0, 0x57, 0x82, 0x26, 0xc3, 0x98, 0x87, 0x7f, 0x5a, 0xd0, 0x28, 0x33, 0xc8, 0xcb, 0x8d,
+	0x5e, 0xe0, 0xd7, 0x7f, 0xa8, 0xc2, 0x69, 0x60, 0xdf, 0xa0, 0x1b, 0x9d, 0x7b, 0xb9, 0x71, 0x88,
+	0xee, 0x8e, 0xee, 0xae, 0x81, 0xc2, 0x5d, 0x3f, 0xf2, 0xde, 0xf6, 0x19, 0xca, 0x41, 0xdd, 0x3d,
+	0x75, 0x55, 0x84, 0x4d, 0xbc, 0x6f, 0xd0, 0xed, 0x73, 0x6f, 0x17, 0x27, 0xeb, 0xda, 0x07, 0x66,
+	0x79, 0x6e, 0x95, 0xbd, 0x6f, 0xd0, 0xe2, 0xf4, 0x7b, 0xdb, 0xf3, 0xe3, 0x56, 0xf4, 0xbc, 0x9b,
+	0xb8, 0xc8, 0xbb, 0x35, 0x73, 0x6d, 0x70, 0xba, 0x57, 0x6c, 0x72, 0x2d, 0xfc, 0xab, 0x7a, 0x5e,
+	0x65, 0x17, 0x79, 0x95, 0x4c, 0xce, 0x00, 0x46, 0x49, 0x18, 0x04, 0x2c, 0x11, 0x1e, 0x35, 0xe9,
+	0x41, 0x94, 0x47, 0x81, 0xf4, 0x0d, 0x5a, 0xe2, 0x65, 0xad, 0xf3, 0x6c, 0xd8, 0x99, 0xf0, 0xc3,
+	0x3f, 0x6c, 0x00, 0xf5, 0xd9, 0x43, 0x4f, 0x04, 0x2c, 0x16, 0xa4, 0x6b, 0xea, 0x01, 0x0b, 0x44,
+	0x04, 0x2c, 0x34, 0xd2, 0xd1, 0x57, 0x1f, 0x36, 0x2d, 0x1f, 0xa1, 0x32, 0xd6, 0x37, 0xa8, 0xbe,
+	0x26, 0x9f, 0xe5, 0x2b, 0x10, 0x3b, 0xf5, 0x50, 0xb9, 0xa1, 0xb9, 0x6f, 0xd0, 0x7c, 0x49, 0x76,
+	0xf4, 0x55, 0xe8, 0xda, 0x7a, 0xa2, 0x32, 0x26, 0x12, 0x95, 0x75, 0x31, 0x5a, 0xda, 0x0e, 0x74,
+	0x2b, 0xfa, 0x68, 0x69, 0xa0, 0x18, 0x2d, 0xcd, 0x90, 0x4d, 0x66, 0x69, 0xbb, 0xb9, 0x55, 0xdd,
+	0x5d, 0x03, 0xb3, 0xc9, 0x2c, 0x19, 0x48, 0x47, 0xdf, 0x66, 0x6e, 0x4d, 0xaf, 0xbc, 0x8c, 0x89,
+	0xca, 0xcb, 0x3a, 0xe9, 0x6e, 0xfd, 0xab, 0xae, 0x23, 0xdd, 0x3f, 0xde, 0xfe, 0x49, 0x25, 0xdc,
+	0x37, 0xe8, 0xd6, 0xdf, 0x7d, 0x56, 0xbe, 0xcc, 0xdc, 0xba, 0xde, 0xd9, 0x02, 0x11, 0x9d, 0x2d,
+	0x34, 0x1c, 0x95, 0x0a, 0x58, 0x53, 0x36, 0x3b, 0x7c, 0x01, 0x8e, 0x5a, 0x7e, 0x64, 0x0f, 0xac,
+	0x57, 0xb8, 0xdb, 0xea, 0x54, 0x88, 0xe4, 0x31, 0x54, 0x2e, 0xfd, 0xf9, 0x8a, 0xc9, 0xee, 0xd7,
+	0x69, 0xa6, 0xe0, 0xb6, 0x79, 0x67, 0x41, 0x3d, 0x6f, 0x86, 0xb8, 0x5e, 0x06, 0x69, 0x3f, 0x9c,
+	0x4e, 0x59, 0x76, 0x31, 0x3a, 0x34, 0xd7, 0xc5, 0x2d, 0x3b, 0x48, 0x69, 0xfc, 0x7a, 0x30, 0x95,
+	0x71, 0x1c, 0xaa, 0x54, 0xf2, 0x00, 0x76, 0x06, 0x17, 0x72, 0x46, 0x6c, 0xba, 0x33, 0xb8, 0xc8,
+	0xef, 0x03, 0xbb, 0xb8, 0x0f, 0x48, 0x0f, 0xac, 0xf3, 0x79, 0x20, 0x3b, 0xdb, 0xf4, 0xce, 0xde,
+	0xdf, 0xee, 0x3f, 0x2f, 0xbd, 0x15, 0x16, 0x3e, 0x4f, 0xc2, 0x37, 0x71, 0x12, 0x06, 0x61, 0xa4,
+	0x94, 0x88, 0x9d, 0x2c, 0xaf, 0x83, 0x93, 0x49, 0xbc, 0x58, 0x26, 0x2c, 0x4d, 0xdb, 0x23, 0x2a,
+	0x02, 0x90, 0x4b, 0xb0, 0x47, 0xeb, 0x25, 0x93, 0x3d, 0x6e, 0x78, 0x9e, 0xd8, 0x7a, 0x7f, 0xdd,
+	0xee, 0x77, 0xfe, 0x6f, 0xb0, 0x88, 0xfb, 0x61, 0xc4, 0x92, 0x93, 0xec, 0xf5, 0x22, 0x22, 0x51,
+	0x19, 0x8f, 0x3c, 0x85, 0xda, 0x05, 0x9b, 0xf9, 0xab, 0x39, 0xc7, 0x01, 0x68, 0x66, 0x5b, 0x1a,
+	0x8d, 0x54, 0xa1, 0xe4, 0x4b, 0x70, 0x7e, 0x8a, 0x7e, 0x59, 0x4e, 0x7d, 0xce, 0xb0, 0xd7, 0xb8,
+	0xcf, 0x95, 0x95, 0xe6, 0xb8, 0x38, 0x32, 0xdc, 0x82, 0xb2, 0xad, 0x0e, 0x55, 0xaa, 0x78, 0x82,
+	0xe4, 0x83, 0xe4, 0x82, 0xc4, 0x0a, 0x43, 0xf9, 0xf9, 0xb3, 0xab, 0x3d, 0x7f, 0xc8, 0x67, 0xd0,
+	0x3c, 0x5f, 0xf1, 0x78, 0x10, 0x4d, 0x12, 0x26, 0xf1, 0x86, 0xf4, 0xd5, 0x8d, 0xd8, 0xda, 0x17,
+	0xc5, 0xca, 0x22, 0x9f, 0x43, 0x55, 0xca, 0x1b, 0x77, 0x90, 0xc2, 0x29, 0xa2, 0xf9, 0xb5, 0x5d,
+	0x5a, 0x4a, 0xe4, 0x18, 0x1c, 0xd4, 0x8a, 0xa7, 0x82, 0xf4, 0x2e, 0x38, 0x34, 0x67, 0x64, 0x11,
+	0xbc, 0x83, 0x9b, 0x7f, 0x5a, 0xc6, 0xdb, 0xbb, 0x96, 0x79, 0x73, 0xd7, 0x32, 0xff, 0xbe, 0x6b,
+	0x19, 0xbf, 0xde, 0xb7, 0x8c, 0xdf, 0xee, 0x5b, 0xe6, 0xcd, 0x7d, 0xcb, 0x78, 0x77, 0xdf, 0x32,
+	0xc6, 0x55, 0xf9, 0xee, 0xfb, 0xfa, 0xbf, 0x01, 0x00, 0xf2, 0xc5, 0x49, 0x2d, 0x49, 0x0a, 0x00,
+	0x00,
 }
 
 func (m *CommentDef) Marshal() (dAtA []byte, err error) {
@@ -1777,6 +1890,48 @@ func (m *ConstraintPB_StreamConfigsDef) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ConstraintPB_CheckDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CheckDef != nil {
+		{
+			size, err := m.CheckDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConstraintPB_TriggerDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConstraintPB_TriggerDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TriggerDef != nil {
+		{
+			size, err := m.TriggerDef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
 func (m *TableDefPB) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -2166,6 +2321,80 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CheckDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerDef) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerDef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerDef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Triggers) > 0 {
+		for iNdEx := len(m.Triggers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Triggers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -2450,6 +2679,30 @@ func (m *ConstraintPB_StreamConfigsDef) ProtoSize() (n int) {
 	}
 	return n
 }
+func (m *ConstraintPB_CheckDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CheckDef != nil {
+		l = m.CheckDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *ConstraintPB_TriggerDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TriggerDef != nil {
+		l = m.TriggerDef.ProtoSize()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
 func (m *TableDefPB) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2635,6 +2888,36 @@ func (m *Attribute) ProtoSize() (n int) {
 	return n
 }
 
+func (m *CheckDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.ProtoSize()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TriggerDef) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Triggers) > 0 {
+		for _, e := range m.Triggers {
+			l = e.ProtoSize()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4090,6 +4373,76 @@ func (m *ConstraintPB) Unmarshal(dAtA []byte) error {
 			}
 			m.Ct = &ConstraintPB_StreamConfigsDef{v}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CheckDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_CheckDef{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerDef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TriggerDef{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Ct = &ConstraintPB_TriggerDef{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -4947,6 +5300,174 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CheckDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, &plan.CheckDef{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerDef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerDef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerDef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Triggers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Triggers = append(m.Triggers, &plan.TriggerDef{})
+			if err := m.Triggers[len(m.Triggers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
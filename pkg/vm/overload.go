@@ -37,6 +37,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/limit"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/lockop"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopanti"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopapply"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopjoin"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopleft"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/loopmark"
@@ -130,6 +131,8 @@ var stringFunc = [...]func(any, *bytes.Buffer){
 
 	Shuffle: shuffle.String,
 	Stream:  stream.String,
+
+	LoopApply: loopapply.String,
 }
 
 var prepareFunc = [...]func(*process.Process, any) error{
@@ -191,6 +194,8 @@ var prepareFunc = [...]func(*process.Process, any) error{
 
 	Shuffle: shuffle.Prepare,
 	Stream:  stream.Prepare,
+
+	LoopApply: loopapply.Prepare,
 }
 
 var execFunc = [...]func(int, *process.Process, any, bool, bool) (process.ExecStatus, error){
@@ -253,4 +258,6 @@ var execFunc = [...]func(int, *process.Process, any, bool, bool) (process.ExecSt
 
 	Shuffle: shuffle.Call,
 	Stream:  stream.Call,
+
+	LoopApply: loopapply.Call,
 }
@@ -38,6 +38,8 @@ func NewAnalyzeInfo(nodeId int32) *AnalyzeInfo {
 		NetworkIO:        0,
 		ScanTime:         0,
 		InsertTime:       0,
+		SpillCount:       0,
+		SpillSize:        0,
 	}
 }
 
@@ -117,3 +119,10 @@ func (a *analyze) AddInsertTime(t time.Time) {
 		atomic.AddInt64(&a.analInfo.InsertTime, int64(time.Since(t)))
 	}
 }
+
+func (a *analyze) Spill(size int64) {
+	if a.analInfo != nil {
+		atomic.AddInt64(&a.analInfo.SpillCount, 1)
+		atomic.AddInt64(&a.analInfo.SpillSize, size)
+	}
+}
@@ -60,6 +60,7 @@ func New(
 		},
 		valueScanBatch: make(map[[16]byte]*batch.Batch),
 		QueryService:   queryService,
+		memUsed:        new(atomic.Int64),
 	}
 }
 
@@ -92,6 +93,7 @@ func NewFromProc(p *Process, ctx context.Context, regNumber int) *Process {
 	proc.LockService = p.LockService
 	proc.Aicm = p.Aicm
 	proc.LoadTag = p.LoadTag
+	proc.memUsed = p.memUsed
 
 	proc.prepareParams = p.prepareParams
 	proc.resolveVariableFunc = p.resolveVariableFunc
@@ -274,6 +276,27 @@ func (proc *Process) FreeVectors() {
 	proc.vp.freeVectors(proc.Mp())
 }
 
+// ReserveMemory reserves size bytes of the memory threshold of the query for a hash table,
+// it returns false if the threshold is exceeded, and the data should be spilled to disk.
+func (proc *Process) ReserveMemory(size int64) bool {
+	if proc.Lim.Size <= 0 || proc.memUsed == nil || size <= 0 {
+		return true
+	}
+	if proc.memUsed.Add(size) > proc.Lim.Size {
+		proc.memUsed.Add(-size)
+		return false
+	}
+	return true
+}
+
+// ReleaseMemory releases the memory reserved by ReserveMemory.
+func (proc *Process) ReleaseMemory(size int64) {
+	if proc.Lim.Size <= 0 || proc.memUsed == nil || size <= 0 {
+		return
+	}
+	proc.memUsed.Add(-size)
+}
+
 func (proc *Process) PutVector(vec *vector.Vector) {
 	if !proc.vp.putVector(vec) {
 		vec.Free(proc.Mp())
@@ -56,6 +56,7 @@ type Analyze interface {
 	Network(*batch.Batch)
 	AddScanTime(t time.Time)
 	AddInsertTime(t time.Time)
+	Spill(size int64)
 }
 
 // WaitRegister channel
@@ -78,7 +79,8 @@ type Register struct {
 
 // Limitation specifies the maximum resources that can be used in one query.
 type Limitation struct {
-	// Size, memory threshold for operator.
+	// Size, memory threshold for the hash tables of a query, hash operators
+	// spill their data to disk once it is exceeded.
 	Size int64
 	// BatchRows, max rows for batch.
 	BatchRows int64
@@ -148,6 +150,10 @@ type AnalyzeInfo struct {
 	ScanTime int64
 	// InsertTime, insert cost time in load flow
 	InsertTime int64
+	// SpillCount, number of files the node spilled to disk
+	SpillCount int64
+	// SpillSize, data size spilled to disk
+	SpillSize int64
 }
 
 type ExecStatus int
@@ -207,6 +213,10 @@ type Process struct {
 	prepareParams       *vector.Vector
 
 	QueryService queryservice.QueryService
+
+	// memUsed is the memory reserved by the hash tables of the query,
+	// it is shared by all the processes of the query.
+	memUsed *atomic.Int64
 }
 
 type vectorPool struct {
@@ -372,4 +382,6 @@ func (a *AnalyzeInfo) Reset() {
 	a.NetworkIO = 0
 	a.ScanTime = 0
 	a.InsertTime = 0
+	a.SpillCount = 0
+	a.SpillSize = 0
 }
@@ -88,6 +88,10 @@ const (
 	LockOp
 
 	Shuffle
+
+	// LoopApply is the nested loop join of a LATERAL derived table which
+	// can't be decorrelated.
+	LoopApply
 )
 
 // Instruction contains relational algebra